@@ -0,0 +1,152 @@
+(function () {
+  'use strict';
+
+  var TOKEN_KEY = 'nsl-admin-token';
+  var REFRESH_MS = 15000;
+  var timer = null;
+
+  function $(id) { return document.getElementById(id); }
+
+  function show(signedIn) {
+    $('login').hidden = signedIn;
+    $('dashboard').hidden = !signedIn;
+    $('logout').hidden = !signedIn;
+  }
+
+  function text(value) {
+    return value === null || value === undefined ? '' : String(value);
+  }
+
+  function cell(row, value, className) {
+    var td = row.insertCell();
+    td.textContent = text(value);
+    if (className) { td.className = className; }
+  }
+
+  function fillTable(id, rows, render) {
+    var body = $(id).tBodies[0];
+    body.innerHTML = '';
+    (rows || []).forEach(function (item) { render(body.insertRow(), item); });
+  }
+
+  function fillList(id, entries) {
+    var list = $(id);
+    list.innerHTML = '';
+    entries.forEach(function (entry) {
+      var dt = document.createElement('dt');
+      var dd = document.createElement('dd');
+      dt.textContent = entry[0];
+      dd.textContent = text(entry[1]);
+      list.appendChild(dt);
+      list.appendChild(dd);
+    });
+  }
+
+  function render(summary) {
+    var health = summary.health;
+    $('health-status').textContent = health ? health.status : 'Health checks are not configured';
+    $('health-status').className = health ? health.status : '';
+    fillTable('health', health && health.checks, function (row, check) {
+      cell(row, check.name);
+      cell(row, check.status, check.status);
+      cell(row, check.description);
+      cell(row, Math.round(check.durationMs));
+    });
+
+    var triggers = summary.triggers;
+    fillList('triggers', [
+      ['Event monitoring', triggers.isMonitoring ? 'running' : 'stopped'],
+      ['Current block', triggers.currentBlockHeight],
+      ['Last processed block', triggers.lastProcessedBlockHeight],
+      ['Active subscriptions', triggers.activeSubscriptions],
+      ['Pending notifications', triggers.totalNotificationsPending],
+      ['Failed notifications', triggers.totalNotificationsFailed],
+      ['Maintenance pause', triggers.paused ? 'active' : 'off'],
+      ['Queued invocations', triggers.queuedInvocations]
+    ]);
+
+    var gas = summary.gasBank;
+    fillList('gasbank', [
+      ['Accounts', gas.accountCount],
+      ['Balance', gas.balance],
+      ['Allocated', gas.allocatedAmount],
+      ['Hot wallets', gas.hotBalance],
+      ['Queued withdrawals', gas.queuedWithdrawals + ' (' + gas.queuedWithdrawalAmount + ' GAS)'],
+      ['In-doubt withdrawals', gas.inDoubtWithdrawals],
+      ['Unconfirmed withdrawals', gas.unconfirmedWithdrawals + ' (' + gas.withdrawalConfirmations + ' confirmations required)']
+    ]);
+
+    fillTable('executions', summary.recentExecutions, function (row, execution) {
+      cell(row, new Date(execution.startTime).toLocaleString());
+      cell(row, execution.functionId);
+      cell(row, execution.status, execution.status);
+      cell(row, Math.round(execution.durationMs));
+      cell(row, execution.error);
+    });
+
+    $('updated').textContent = 'Updated ' + new Date(summary.generatedAt).toLocaleTimeString();
+  }
+
+  function signOut() {
+    sessionStorage.removeItem(TOKEN_KEY);
+    clearTimeout(timer);
+    $('updated').textContent = '';
+    show(false);
+  }
+
+  function refresh() {
+    var token = sessionStorage.getItem(TOKEN_KEY);
+    if (!token) { signOut(); return; }
+
+    fetch('/admin/ui/api/summary', { headers: { Authorization: 'Bearer ' + token } })
+      .then(function (response) {
+        if (response.status === 401 || response.status === 403) {
+          signOut();
+          $('login-error').textContent = response.status === 403 ? 'This account is not an admin' : '';
+          return null;
+        }
+        if (!response.ok) { throw new Error('Summary request failed with status ' + response.status); }
+        return response.json();
+      })
+      .then(function (summary) {
+        if (!summary) { return; }
+        show(true);
+        render(summary);
+        timer = setTimeout(refresh, REFRESH_MS);
+      })
+      .catch(function (error) {
+        $('updated').textContent = error.message;
+        timer = setTimeout(refresh, REFRESH_MS);
+      });
+  }
+
+  $('login').addEventListener('submit', function (event) {
+    event.preventDefault();
+    var form = event.target;
+    $('login-error').textContent = '';
+
+    fetch('/api/Auth/login', {
+      method: 'POST',
+      headers: { 'Content-Type': 'application/json' },
+      body: JSON.stringify({ username: form.username.value, password: form.password.value })
+    })
+      .then(function (response) {
+        return response.json().then(function (body) {
+          if (!response.ok) { throw new Error(body.message || 'Sign in failed'); }
+          return body;
+        });
+      })
+      .then(function (token) {
+        form.reset();
+        sessionStorage.setItem(TOKEN_KEY, token.accessToken);
+        refresh();
+      })
+      .catch(function (error) {
+        $('login-error').textContent = error.message;
+      });
+  });
+
+  $('logout').addEventListener('click', signOut);
+
+  refresh();
+})();
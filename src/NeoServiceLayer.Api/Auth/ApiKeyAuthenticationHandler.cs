@@ -7,16 +7,33 @@ using System.Threading.Tasks;
 using Microsoft.AspNetCore.Authentication;
 using Microsoft.Extensions.Logging;
 using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
 
 namespace NeoServiceLayer.API.Auth
 {
     /// <summary>
     /// Authentication handler for API keys
     /// </summary>
+    /// <remarks>
+    /// Keys from configuration are checked first; other keys are looked up among the API keys accounts
+    /// created for themselves, which authenticate as the account and carry their scopes as claims.
+    /// </remarks>
     public class ApiKeyAuthenticationHandler : AuthenticationHandler<ApiKeyAuthenticationOptions>
     {
+        /// <summary>
+        /// Claim carrying each scope of an account API key
+        /// </summary>
+        public const string ScopeClaimType = "scope";
+
+        /// <summary>
+        /// Claim marking a principal authenticated with an account API key
+        /// </summary>
+        public const string CredentialTypeClaimType = "credential_type";
+
         private readonly ILogger<ApiKeyAuthenticationHandler> _logger;
         private readonly AuthOptions _authOptions;
+        private readonly IApiCredentialService _credentialService;
 
         /// <summary>
         /// Initializes a new instance of the <see cref="ApiKeyAuthenticationHandler"/> class
@@ -26,20 +43,23 @@ namespace NeoServiceLayer.API.Auth
         /// <param name="encoder">URL encoder</param>
         /// <param name="clock">System clock</param>
         /// <param name="authOptions">Authentication options</param>
+        /// <param name="credentialService">Account API key service</param>
         public ApiKeyAuthenticationHandler(
             IOptionsMonitor<ApiKeyAuthenticationOptions> options,
             ILoggerFactory logger,
             UrlEncoder encoder,
             ISystemClock clock,
-            IOptions<AuthOptions> authOptions)
+            IOptions<AuthOptions> authOptions,
+            IApiCredentialService credentialService = null)
             : base(options, logger, encoder, clock)
         {
             _logger = logger.CreateLogger<ApiKeyAuthenticationHandler>();
             _authOptions = authOptions.Value;
+            _credentialService = credentialService;
         }
 
         /// <inheritdoc/>
-        protected override Task<AuthenticateResult> HandleAuthenticateAsync()
+        protected override async Task<AuthenticateResult> HandleAuthenticateAsync()
         {
             try
             {
@@ -51,43 +71,49 @@ namespace NeoServiceLayer.API.Auth
                     // If header not found, try query parameter if allowed
                     if (_authOptions.ApiKey.AllowQueryParam && Request.Query.TryGetValue(_authOptions.ApiKey.QueryParamName, out var apiKeyQueryValues))
                     {
-                        return ValidateApiKey(apiKeyQueryValues.FirstOrDefault());
+                        return await ValidateApiKeyAsync(apiKeyQueryValues.FirstOrDefault());
                     }
 
                     _logger.LogWarning("API key not found in request");
-                    return Task.FromResult(AuthenticateResult.Fail("API key not found"));
+                    return AuthenticateResult.Fail("API key not found");
                 }
 
                 var apiKey = apiKeyHeaderValues.FirstOrDefault();
-                return ValidateApiKey(apiKey);
+                return await ValidateApiKeyAsync(apiKey);
             }
             catch (Exception ex)
             {
                 _logger.LogError(ex, "Error authenticating request with API key: {Message}", ex.Message);
-                return Task.FromResult(AuthenticateResult.Fail("Authentication failed"));
+                return AuthenticateResult.Fail("Authentication failed");
             }
         }
 
-        private Task<AuthenticateResult> ValidateApiKey(string apiKey)
+        private async Task<AuthenticateResult> ValidateApiKeyAsync(string apiKey)
         {
             if (string.IsNullOrEmpty(apiKey))
             {
                 _logger.LogWarning("API key is empty");
-                return Task.FromResult(AuthenticateResult.Fail("API key is empty"));
+                return AuthenticateResult.Fail("API key is empty");
             }
 
             // Check if API key exists
             if (!_authOptions.ApiKey.ApiKeys.TryGetValue(apiKey, out var apiKeyInfo))
             {
+                var credential = _credentialService != null ? await _credentialService.AuthenticateAsync(apiKey) : null;
+                if (credential != null)
+                {
+                    return CreateAccountKeyResult(credential);
+                }
+
                 _logger.LogWarning("Invalid API key");
-                return Task.FromResult(AuthenticateResult.Fail("Invalid API key"));
+                return AuthenticateResult.Fail("Invalid API key");
             }
 
             // Check if API key is active
             if (!apiKeyInfo.IsActive)
             {
                 _logger.LogWarning("API key is inactive");
-                return Task.FromResult(AuthenticateResult.Fail("API key is inactive"));
+                return AuthenticateResult.Fail("API key is inactive");
             }
 
             // Create claims
@@ -98,6 +124,11 @@ namespace NeoServiceLayer.API.Auth
                 new Claim("api_key", apiKey)
             };
 
+            if (!string.IsNullOrEmpty(apiKeyInfo.Id))
+            {
+                claims.Add(new Claim("api_key_id", apiKeyInfo.Id));
+            }
+
             // Add roles
             foreach (var role in apiKeyInfo.Roles)
             {
@@ -118,7 +149,32 @@ namespace NeoServiceLayer.API.Auth
             var ticket = new AuthenticationTicket(principal, Scheme.Name);
 
             _logger.LogInformation("API key authentication successful for {OwnerName}", apiKeyInfo.OwnerName);
-            return Task.FromResult(AuthenticateResult.Success(ticket));
+            return AuthenticateResult.Success(ticket);
+        }
+
+        private AuthenticateResult CreateAccountKeyResult(ApiCredential credential)
+        {
+            // Account keys act as the account, limited to their scopes; they never carry roles
+            var claims = new List<Claim>
+            {
+                new Claim(ClaimTypes.NameIdentifier, credential.AccountId.ToString()),
+                new Claim(ClaimTypes.Name, credential.Name),
+                new Claim("api_key_id", credential.Id.ToString()),
+                new Claim(CredentialTypeClaimType, "account")
+            };
+
+            foreach (var scope in credential.Scopes)
+            {
+                claims.Add(new Claim(ScopeClaimType, scope));
+            }
+
+            var identity = new ClaimsIdentity(claims, Scheme.Name);
+            var ticket = new AuthenticationTicket(new ClaimsPrincipal(identity), Scheme.Name);
+            Context.Items[typeof(ApiCredential)] = credential;
+
+            _logger.LogInformation("API key authentication successful for account {AccountId} with key {CredentialId}",
+                credential.AccountId, credential.Id);
+            return AuthenticateResult.Success(ticket);
         }
     }
 
@@ -15,6 +15,16 @@ namespace NeoServiceLayer.API.Auth
     /// </summary>
     public static class AuthExtensions
     {
+        /// <summary>
+        /// Name of the API key authentication scheme
+        /// </summary>
+        public const string ApiKeyScheme = "ApiKey";
+
+        /// <summary>
+        /// Name of the default scheme, which forwards to the API key or JWT scheme
+        /// </summary>
+        public const string DefaultScheme = "JwtOrApiKey";
+
         /// <summary>
         /// Adds authentication and authorization services to the service collection
         /// </summary>
@@ -37,8 +47,16 @@ namespace NeoServiceLayer.API.Auth
             // Add token service
             services.AddScoped<ITokenService, JwtTokenService>();
 
-            // Add authentication
-            var authBuilder = services.AddAuthentication();
+            // Add authentication; requests carrying an API key use the API key scheme, others a bearer token
+            var authBuilder = services.AddAuthentication(DefaultScheme);
+            authBuilder.AddPolicyScheme(DefaultScheme, DefaultScheme, options =>
+            {
+                options.ForwardDefaultSelector = context =>
+                    context.Request.Headers.ContainsKey(authOptions.ApiKey.HeaderName) ||
+                    (authOptions.ApiKey.AllowQueryParam && context.Request.Query.ContainsKey(authOptions.ApiKey.QueryParamName))
+                        ? ApiKeyScheme
+                        : JwtBearerDefaults.AuthenticationScheme;
+            });
 
             // Add JWT authentication
             authBuilder.AddJwtBearer(JwtBearerDefaults.AuthenticationScheme, options =>
@@ -57,7 +75,7 @@ namespace NeoServiceLayer.API.Auth
             });
 
             // Add API key authentication
-            authBuilder.AddScheme<ApiKeyAuthenticationOptions, ApiKeyAuthenticationHandler>("ApiKey", options => { });
+            authBuilder.AddScheme<ApiKeyAuthenticationOptions, ApiKeyAuthenticationHandler>(ApiKeyScheme, options => { });
 
             // Add authorization
             services.AddAuthorization(options =>
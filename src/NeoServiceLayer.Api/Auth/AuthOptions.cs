@@ -145,6 +145,11 @@ namespace NeoServiceLayer.API.Auth
     /// </summary>
     public class ApiKeyInfo
     {
+        /// <summary>
+        /// Gets or sets the key ID that function owners use to grant this key access
+        /// </summary>
+        public string Id { get; set; }
+
         /// <summary>
         /// Gets or sets the owner name
         /// </summary>
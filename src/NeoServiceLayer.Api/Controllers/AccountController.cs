@@ -4,9 +4,12 @@ using System.Threading.Tasks;
 using Microsoft.AspNetCore.Authorization;
 using Microsoft.AspNetCore.Mvc;
 using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.API.RateLimiting;
 using NeoServiceLayer.Api.Models;
 using NeoServiceLayer.Core.Exceptions;
 using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
 
 namespace NeoServiceLayer.Api.Controllers
 {
@@ -19,16 +22,26 @@ namespace NeoServiceLayer.Api.Controllers
     {
         private readonly ILogger<AccountController> _logger;
         private readonly IAccountService _accountService;
+        private readonly IAccountLimitsService _limitsService;
+        private readonly RateLimitingOptions _rateLimitingOptions;
 
         /// <summary>
         /// Initializes a new instance of the <see cref="AccountController"/> class
         /// </summary>
         /// <param name="logger">Logger</param>
         /// <param name="accountService">Account service</param>
-        public AccountController(ILogger<AccountController> logger, IAccountService accountService)
+        /// <param name="limitsService">Account limits service</param>
+        /// <param name="rateLimitingOptions">Rate limiting options</param>
+        public AccountController(
+            ILogger<AccountController> logger,
+            IAccountService accountService,
+            IAccountLimitsService limitsService = null,
+            IOptions<RateLimitingOptions> rateLimitingOptions = null)
         {
             _logger = logger;
             _accountService = accountService;
+            _limitsService = limitsService;
+            _rateLimitingOptions = rateLimitingOptions?.Value;
         }
 
         /// <summary>
@@ -138,6 +151,48 @@ namespace NeoServiceLayer.Api.Controllers
             }
         }
 
+        /// <summary>
+        /// Gets the current user's effective limits and how much of each is used
+        /// </summary>
+        /// <returns>The account limits</returns>
+        [HttpGet("limits")]
+        [Authorize]
+        public async Task<IActionResult> GetLimits()
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var id))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            if (_limitsService == null)
+            {
+                return StatusCode(503, new { Message = "Account limits are not available" });
+            }
+
+            _logger.LogInformation("Getting limits for user: {UserId}", userId);
+
+            try
+            {
+                var limits = await _limitsService.GetLimitsAsync(id);
+
+                // Report the limit the rate limiter applies to this caller outside endpoint-specific overrides
+                if (_rateLimitingOptions?.Enabled == true)
+                {
+                    var clientId = RateLimitingMiddleware.GetClientIdentifier(HttpContext, _rateLimitingOptions);
+                    var (limit, periodSeconds) = RateLimitingMiddleware.GetRateLimit(_rateLimitingOptions, clientId, string.Empty);
+                    limits.RateLimit = new RateLimitInfo { Limit = limit, PeriodSeconds = periodSeconds };
+                }
+
+                return Ok(limits);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting limits for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
         /// <summary>
         /// Changes the password for the current user
         /// </summary>
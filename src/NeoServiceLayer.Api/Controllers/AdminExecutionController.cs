@@ -0,0 +1,155 @@
+using System;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for inspecting and interrupting running function executions and for execution scheduling
+    /// </summary>
+    [ApiController]
+    [Route("api/admin/executions")]
+    [Authorize(Roles = "Admin")]
+    public class AdminExecutionController : ControllerBase
+    {
+        private readonly ILogger<AdminExecutionController> _logger;
+        private readonly IFunctionService _functionService;
+        private readonly IExecutionScheduler _executionScheduler;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AdminExecutionController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="functionService">Function service</param>
+        /// <param name="executionScheduler">Execution scheduler</param>
+        public AdminExecutionController(ILogger<AdminExecutionController> logger, IFunctionService functionService, IExecutionScheduler executionScheduler)
+        {
+            _logger = logger;
+            _functionService = functionService;
+            _executionScheduler = executionScheduler;
+        }
+
+        /// <summary>
+        /// Gets the function executions that are currently running
+        /// </summary>
+        /// <returns>List of running executions</returns>
+        [HttpGet("active")]
+        public async Task<IActionResult> GetActiveExecutions()
+        {
+            _logger.LogInformation("Getting active executions");
+
+            try
+            {
+                var executions = await _functionService.GetActiveExecutionsAsync();
+
+                return Ok(executions.Select(e => new
+                {
+                    ExecutionId = e.ExecutionId,
+                    FunctionId = e.FunctionId,
+                    FunctionName = e.FunctionName,
+                    Owner = e.AccountId,
+                    Runtime = e.Runtime,
+                    Source = e.Source,
+                    Status = e.Status,
+                    StartTime = e.StartTime,
+                    ElapsedMs = e.ElapsedMs,
+                    MaxExecutionTime = e.MaxExecutionTime,
+                    MemoryUsageMb = e.MemoryUsageMb,
+                    MaxMemoryMb = e.MaxMemoryMb
+                }));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting active executions");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Interrupts a running function execution
+        /// </summary>
+        /// <param name="id">Execution ID</param>
+        /// <returns>Kill result</returns>
+        [HttpPost("{id}/kill")]
+        public async Task<IActionResult> KillExecution(Guid id)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+
+            _logger.LogWarning("Killing execution: {ExecutionId} requested by user: {UserId}", id, userId);
+
+            try
+            {
+                var killed = await _functionService.KillExecutionAsync(id, userId);
+                if (!killed)
+                {
+                    return NotFound(new { Message = "Execution not found or already being killed" });
+                }
+
+                return Ok(new { ExecutionId = id, Message = "Execution kill requested" });
+            }
+            catch (FunctionException ex)
+            {
+                _logger.LogError(ex, "Error killing execution: {ExecutionId}", id);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error killing execution: {ExecutionId}", id);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the execution queue of the instance serving the request, with waits and starvation counters per tier
+        /// </summary>
+        /// <returns>Scheduler status</returns>
+        [HttpGet("scheduler")]
+        public IActionResult GetSchedulerStatus()
+        {
+            try
+            {
+                return Ok(_executionScheduler.GetStatus());
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting execution scheduler status");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Assigns an account to an execution tier
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <param name="request">Tier request</param>
+        /// <returns>The account's tier</returns>
+        [HttpPut("accounts/{accountId}/tier")]
+        public async Task<IActionResult> SetAccountTier(Guid accountId, [FromBody] SetExecutionTierRequest request)
+        {
+            try
+            {
+                var account = await _executionScheduler.SetAccountTierAsync(accountId, request?.Tier);
+                return Ok(new { AccountId = account.Id, Tier = account.ExecutionTier });
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException)
+            {
+                return NotFound(new { Message = "Account not found" });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error setting execution tier of account {AccountId}", accountId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
@@ -0,0 +1,101 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for runtime feature flags
+    /// </summary>
+    [ApiController]
+    [Route("api/admin/feature-flags")]
+    [Authorize(Roles = "Admin")]
+    public class AdminFeatureFlagController : ControllerBase
+    {
+        private readonly ILogger<AdminFeatureFlagController> _logger;
+        private readonly IFeatureFlagService _featureFlagService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AdminFeatureFlagController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="featureFlagService">Feature flag service</param>
+        public AdminFeatureFlagController(ILogger<AdminFeatureFlagController> logger, IFeatureFlagService featureFlagService)
+        {
+            _logger = logger;
+            _featureFlagService = featureFlagService;
+        }
+
+        /// <summary>
+        /// Gets all feature flags
+        /// </summary>
+        /// <returns>The flags, by name</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetFlags()
+        {
+            try
+            {
+                return Ok(await _featureFlagService.GetAllAsync());
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting feature flags");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Creates or changes a feature flag
+        /// </summary>
+        /// <param name="name">Flag name</param>
+        /// <param name="request">Flag state</param>
+        /// <returns>The flag</returns>
+        [HttpPut("{name}")]
+        public async Task<IActionResult> SetFlag(string name, [FromBody] SetFeatureFlagRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+
+            try
+            {
+                return Ok(await _featureFlagService.SetAsync(name, request.Enabled, request.Value, userId));
+            }
+            catch (ArgumentException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error setting feature flag {Name}", name);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Removes a feature flag, so code checking it falls back to its default
+        /// </summary>
+        /// <param name="name">Flag name</param>
+        /// <returns>No content</returns>
+        [HttpDelete("{name}")]
+        public async Task<IActionResult> DeleteFlag(string name)
+        {
+            try
+            {
+                if (!await _featureFlagService.DeleteAsync(name))
+                {
+                    return NotFound(new { Message = "Feature flag not found" });
+                }
+
+                return NoContent();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error deleting feature flag {Name}", name);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
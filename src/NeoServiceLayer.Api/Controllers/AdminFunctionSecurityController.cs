@@ -0,0 +1,62 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for reviewing functions disabled by the sandbox security monitor
+    /// </summary>
+    [ApiController]
+    [Route("api/admin/functions")]
+    [Authorize(Roles = "Admin")]
+    public class AdminFunctionSecurityController : ControllerBase
+    {
+        private readonly ILogger<AdminFunctionSecurityController> _logger;
+        private readonly IFunctionSecurityMonitor _securityMonitor;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AdminFunctionSecurityController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="securityMonitor">Function security monitor</param>
+        public AdminFunctionSecurityController(ILogger<AdminFunctionSecurityController> logger, IFunctionSecurityMonitor securityMonitor)
+        {
+            _logger = logger;
+            _securityMonitor = securityMonitor;
+        }
+
+        /// <summary>
+        /// Re-enables a function disabled pending a security review
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <returns>The re-enabled function</returns>
+        [HttpPost("{id}/security-review/approve")]
+        public async Task<IActionResult> ApproveSecurityReview(Guid id)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+
+            _logger.LogWarning("Approving security review of function: {FunctionId} by user: {UserId}", id, userId);
+
+            try
+            {
+                var function = await _securityMonitor.ApproveAsync(id, userId);
+                return Ok(function);
+            }
+            catch (FunctionException ex)
+            {
+                _logger.LogError(ex, "Error approving security review of function: {FunctionId}", id);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error approving security review of function: {FunctionId}", id);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
@@ -0,0 +1,234 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for operating the GasBank hot and cold wallets
+    /// </summary>
+    /// <remarks>
+    /// Replenishing a hot wallet is a manual transfer from the cold wallet; an operator confirms it
+    /// here once it has arrived, which sends the withdrawals that were queued for lack of hot GAS.
+    /// </remarks>
+    [ApiController]
+    [Route("api/admin/gasbank")]
+    [Authorize(Roles = "Admin")]
+    public class AdminGasBankController : ControllerBase
+    {
+        private readonly ILogger<AdminGasBankController> _logger;
+        private readonly IGasBankService _gasBankService;
+        private readonly IGasPaymentStreamService _paymentStreamService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AdminGasBankController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="gasBankService">GasBank service</param>
+        /// <param name="paymentStreamService">Payment stream service</param>
+        public AdminGasBankController(
+            ILogger<AdminGasBankController> logger,
+            IGasBankService gasBankService,
+            IGasPaymentStreamService paymentStreamService)
+        {
+            _logger = logger;
+            _gasBankService = gasBankService;
+            _paymentStreamService = paymentStreamService;
+        }
+
+        /// <summary>
+        /// Gets the withdrawals waiting for a hot wallet to be replenished
+        /// </summary>
+        /// <returns>List of queued withdrawals, oldest first</returns>
+        [HttpGet("withdrawals/queued")]
+        public async Task<IActionResult> GetQueuedWithdrawals()
+        {
+            try
+            {
+                return Ok(await _gasBankService.GetQueuedWithdrawalsAsync());
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting queued GasBank withdrawals");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the withdrawals that were sending when the service stopped
+        /// </summary>
+        /// <returns>List of in-doubt withdrawals, oldest first</returns>
+        [HttpGet("withdrawals/in-doubt")]
+        public async Task<IActionResult> GetInDoubtWithdrawals()
+        {
+            try
+            {
+                return Ok(await _gasBankService.GetInDoubtWithdrawalsAsync());
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting in-doubt GasBank withdrawals");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Resolves an in-doubt withdrawal after checking the chain for its transfer
+        /// </summary>
+        /// <param name="id">Withdrawal ID</param>
+        /// <param name="request">The transfer hash if the GAS was sent</param>
+        /// <returns>The resolved withdrawal</returns>
+        [HttpPost("withdrawals/{id}/resolve")]
+        public async Task<IActionResult> ResolveWithdrawal(Guid id, [FromBody] ResolveWithdrawalRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+
+            try
+            {
+                var withdrawal = await _gasBankService.ResolveInDoubtWithdrawalAsync(id, request?.TransactionHash, userId);
+                return Ok(withdrawal);
+            }
+            catch (ArgumentException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (GasBankException ex) when (ex.InnerException == null)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error resolving GasBank withdrawal {Id}, user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the payment streams that were settling when the service stopped
+        /// </summary>
+        /// <returns>List of in-doubt payment streams</returns>
+        [HttpGet("streams/in-doubt")]
+        public async Task<IActionResult> GetInDoubtStreams()
+        {
+            try
+            {
+                return Ok(await _paymentStreamService.GetInDoubtAsync());
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting in-doubt GasBank payment streams");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Resolves an in-doubt payment stream after checking the chain for its settlement transfer
+        /// </summary>
+        /// <param name="id">Payment stream ID</param>
+        /// <param name="request">The settlement transfer hash if the GAS was sent</param>
+        /// <returns>The resolved payment stream</returns>
+        [HttpPost("streams/{id}/resolve")]
+        public async Task<IActionResult> ResolveStream(Guid id, [FromBody] ResolveWithdrawalRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+
+            try
+            {
+                return Ok(await _paymentStreamService.ResolveInDoubtAsync(id, request?.TransactionHash, userId));
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (GasBankException ex) when (ex.InnerException == null)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error resolving GasBank payment stream {Id}, user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Checks sent withdrawals on chain now
+        /// </summary>
+        /// <returns>The withdrawals whose status changed</returns>
+        [HttpPost("withdrawals/confirm")]
+        public async Task<IActionResult> ConfirmWithdrawals()
+        {
+            try
+            {
+                return Ok(await _gasBankService.ConfirmWithdrawalsAsync());
+            }
+            catch (GasBankException ex) when (ex.InnerException == null)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error checking GasBank withdrawals on chain");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Sweeps GAS above the working float from the hot wallets to the cold wallet now
+        /// </summary>
+        /// <returns>The sweep transactions</returns>
+        [HttpPost("sweep")]
+        public async Task<IActionResult> Sweep()
+        {
+            try
+            {
+                return Ok(await _gasBankService.SweepToColdWalletAsync());
+            }
+            catch (GasBankException ex) when (ex.InnerException == null)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error sweeping GasBank hot wallets");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Confirms that GAS from the cold wallet arrived in a GasBank account's hot wallet
+        /// </summary>
+        /// <param name="id">GasBank account ID</param>
+        /// <param name="request">Replenishment details</param>
+        /// <returns>The updated GasBank account</returns>
+        [HttpPost("accounts/{id}/replenishments")]
+        public async Task<IActionResult> ConfirmReplenishment(Guid id, [FromBody] ConfirmReplenishmentRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+
+            try
+            {
+                var account = await _gasBankService.ConfirmReplenishmentAsync(id, request.Amount, request.TransactionHash, userId);
+                return Ok(account);
+            }
+            catch (ArgumentException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (GasBankException ex) when (ex.InnerException == null)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error confirming GasBank replenishment for account {Id}, user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
@@ -0,0 +1,125 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for break-glass approval of support access and review of the impersonation audit log
+    /// </summary>
+    [ApiController]
+    [Route("api/admin/impersonation")]
+    [Authorize(Roles = "Admin")]
+    public class AdminImpersonationController : ControllerBase
+    {
+        private readonly ILogger<AdminImpersonationController> _logger;
+        private readonly IImpersonationService _impersonationService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AdminImpersonationController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="impersonationService">Impersonation service</param>
+        public AdminImpersonationController(ILogger<AdminImpersonationController> logger, IImpersonationService impersonationService)
+        {
+            _logger = logger;
+            _impersonationService = impersonationService;
+        }
+
+        /// <summary>
+        /// Approves a support user viewing an account without the owner's consent
+        /// </summary>
+        /// <param name="request">Approval request</param>
+        /// <returns>The grant</returns>
+        [HttpPost("break-glass")]
+        public async Task<IActionResult> ApproveBreakGlass([FromBody] ApproveBreakGlassRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+
+            try
+            {
+                var grant = await _impersonationService.ApproveBreakGlassAsync(
+                    request.AccountId, request.SupportUserId, request.Reason, userId, request.DurationMinutes);
+                return Ok(grant);
+            }
+            catch (ArgumentException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error approving break-glass access to account {AccountId}, user: {UserId}", request.AccountId, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the grants given for an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>The grants, newest first</returns>
+        [HttpGet("accounts/{accountId}/grants")]
+        public async Task<IActionResult> GetGrants(Guid accountId)
+        {
+            try
+            {
+                return Ok(await _impersonationService.GetGrantsAsync(accountId));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting impersonation grants for account {AccountId}", accountId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Revokes a grant
+        /// </summary>
+        /// <param name="id">Grant ID</param>
+        /// <returns>The revoked grant</returns>
+        [HttpDelete("grants/{id}")]
+        public async Task<IActionResult> RevokeGrant(Guid id)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+
+            try
+            {
+                return Ok(await _impersonationService.RevokeAsync(id, userId));
+            }
+            catch (ResourceNotFoundException)
+            {
+                return NotFound(new { Message = "Grant not found" });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error revoking impersonation grant {Id}, user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the requests support users made, or were refused, while impersonating an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <param name="since">Only requests at or after this time</param>
+        /// <returns>The audit entries, newest first</returns>
+        [HttpGet("accounts/{accountId}/audit")]
+        public async Task<IActionResult> GetAudit(Guid accountId, [FromQuery] DateTime? since = null)
+        {
+            try
+            {
+                return Ok(await _impersonationService.GetAuditAsync(accountId, since));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting impersonation audit for account {AccountId}", accountId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
@@ -0,0 +1,82 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for the leadership lease of the Functions/Trigger service
+    /// </summary>
+    [ApiController]
+    [Route("api/admin/leadership")]
+    [Authorize(Roles = "Admin")]
+    public class AdminLeadershipController : ControllerBase
+    {
+        private readonly ILogger<AdminLeadershipController> _logger;
+        private readonly ILeadershipService _leadership;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AdminLeadershipController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="leadership">Leadership service</param>
+        public AdminLeadershipController(ILogger<AdminLeadershipController> logger, ILeadershipService leadership)
+        {
+            _logger = logger;
+            _leadership = leadership;
+        }
+
+        /// <summary>
+        /// Gets the lease and whether the instance serving the request holds it
+        /// </summary>
+        /// <returns>The leadership status</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetStatus()
+        {
+            try
+            {
+                var lease = await _leadership.GetLeaseAsync();
+                return Ok(new
+                {
+                    _leadership.InstanceId,
+                    _leadership.IsLeader,
+                    Lease = lease
+                });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting leadership status");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Releases the lease from the current leader so a standby takes over
+        /// </summary>
+        /// <returns>The released lease</returns>
+        [HttpPost("failover")]
+        public async Task<IActionResult> ForceFailover()
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+
+            try
+            {
+                var lease = await _leadership.ForceFailoverAsync(userId);
+                return Ok(lease);
+            }
+            catch (InvalidOperationException ex)
+            {
+                _logger.LogError(ex, "Error forcing failover");
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error forcing failover");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
@@ -0,0 +1,189 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for alert rules over the service's own log stream
+    /// </summary>
+    [ApiController]
+    [Route("api/admin/log-alert-rules")]
+    [Authorize(Roles = "Admin")]
+    public class AdminLogAlertController : ControllerBase
+    {
+        private readonly ILogger<AdminLogAlertController> _logger;
+        private readonly ILogAlertService _logAlertService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AdminLogAlertController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="logAlertService">Log alert service</param>
+        public AdminLogAlertController(ILogger<AdminLogAlertController> logger, ILogAlertService logAlertService)
+        {
+            _logger = logger;
+            _logAlertService = logAlertService;
+        }
+
+        /// <summary>
+        /// Gets all log alert rules
+        /// </summary>
+        /// <returns>List of alert rules</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetAlertRules()
+        {
+            try
+            {
+                var rules = await _logAlertService.GetAlertRulesAsync();
+                return Ok(rules);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting log alert rules");
+                return StatusCode(500, new { Message = "An error occurred while getting alert rules" });
+            }
+        }
+
+        /// <summary>
+        /// Gets a log alert rule by ID
+        /// </summary>
+        /// <param name="id">Rule ID</param>
+        /// <returns>Alert rule</returns>
+        [HttpGet("{id}")]
+        public async Task<IActionResult> GetAlertRule(Guid id)
+        {
+            try
+            {
+                var rule = await _logAlertService.GetAlertRuleAsync(id);
+                if (rule == null)
+                {
+                    return NotFound(new { Message = "Alert rule not found" });
+                }
+
+                return Ok(rule);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting log alert rule: {Id}", id);
+                return StatusCode(500, new { Message = "An error occurred while getting the alert rule" });
+            }
+        }
+
+        /// <summary>
+        /// Creates a log alert rule; its notifications go to the calling administrator
+        /// </summary>
+        /// <param name="rule">Rule to create</param>
+        /// <returns>Created alert rule</returns>
+        [HttpPost]
+        public async Task<IActionResult> CreateAlertRule([FromBody] LogAlertRule rule)
+        {
+            _logger.LogInformation("Creating log alert rule: {Name}", rule?.Name);
+
+            try
+            {
+                var accountId = GetAccountId();
+                if (accountId == Guid.Empty)
+                {
+                    return Unauthorized(new { Message = "Invalid account ID" });
+                }
+
+                rule.AccountId = accountId;
+
+                var createdRule = await _logAlertService.CreateAlertRuleAsync(rule);
+                return CreatedAtAction(nameof(GetAlertRule), new { id = createdRule.Id }, createdRule);
+            }
+            catch (ArgumentException ex)
+            {
+                _logger.LogWarning(ex, "Invalid log alert rule data: {Message}", ex.Message);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error creating log alert rule: {Name}", rule?.Name);
+                return StatusCode(500, new { Message = "An error occurred while creating the alert rule" });
+            }
+        }
+
+        /// <summary>
+        /// Updates a log alert rule
+        /// </summary>
+        /// <param name="id">Rule ID</param>
+        /// <param name="rule">Updated rule</param>
+        /// <returns>Updated alert rule</returns>
+        [HttpPut("{id}")]
+        public async Task<IActionResult> UpdateAlertRule(Guid id, [FromBody] LogAlertRule rule)
+        {
+            _logger.LogInformation("Updating log alert rule: {Id}", id);
+
+            try
+            {
+                rule.Id = id;
+
+                var updatedRule = await _logAlertService.UpdateAlertRuleAsync(rule);
+                if (updatedRule == null)
+                {
+                    return NotFound(new { Message = "Alert rule not found" });
+                }
+
+                return Ok(updatedRule);
+            }
+            catch (ArgumentException ex)
+            {
+                _logger.LogWarning(ex, "Invalid log alert rule data: {Message}", ex.Message);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error updating log alert rule: {Id}", id);
+                return StatusCode(500, new { Message = "An error occurred while updating the alert rule" });
+            }
+        }
+
+        /// <summary>
+        /// Deletes a log alert rule
+        /// </summary>
+        /// <param name="id">Rule ID</param>
+        /// <returns>Success status</returns>
+        [HttpDelete("{id}")]
+        public async Task<IActionResult> DeleteAlertRule(Guid id)
+        {
+            _logger.LogInformation("Deleting log alert rule: {Id}", id);
+
+            try
+            {
+                var success = await _logAlertService.DeleteAlertRuleAsync(id);
+                if (!success)
+                {
+                    return NotFound(new { Message = "Alert rule not found" });
+                }
+
+                return Ok(new { Message = "Alert rule deleted successfully" });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error deleting log alert rule: {Id}", id);
+                return StatusCode(500, new { Message = "An error occurred while deleting the alert rule" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the account ID from the authenticated user
+        /// </summary>
+        /// <returns>Account ID</returns>
+        private Guid GetAccountId()
+        {
+            var accountIdClaim = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(accountIdClaim) || !Guid.TryParse(accountIdClaim, out var accountId))
+            {
+                return Guid.Empty;
+            }
+
+            return accountId;
+        }
+    }
+}
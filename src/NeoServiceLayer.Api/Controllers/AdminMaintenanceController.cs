@@ -0,0 +1,171 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for switching maintenance mode and scheduling maintenance windows
+    /// </summary>
+    /// <remarks>
+    /// While maintenance is active trigger firing is paused, function invocations are queued and
+    /// withdrawals are rejected with 503.
+    /// </remarks>
+    [ApiController]
+    [Route("api/admin/maintenance")]
+    [Authorize(Roles = "Admin")]
+    public class AdminMaintenanceController : ControllerBase
+    {
+        private readonly ILogger<AdminMaintenanceController> _logger;
+        private readonly IMaintenanceService _maintenanceService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AdminMaintenanceController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="maintenanceService">Maintenance service</param>
+        public AdminMaintenanceController(ILogger<AdminMaintenanceController> logger, IMaintenanceService maintenanceService)
+        {
+            _logger = logger;
+            _maintenanceService = maintenanceService;
+        }
+
+        /// <summary>
+        /// Gets the maintenance status, including the number of queued invocations
+        /// </summary>
+        /// <returns>Maintenance status</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetStatus()
+        {
+            try
+            {
+                return Ok(await _maintenanceService.GetStatusAsync());
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting maintenance status");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Switches maintenance mode on immediately
+        /// </summary>
+        /// <param name="request">Enable request</param>
+        /// <returns>Maintenance status</returns>
+        [HttpPost("enable")]
+        public async Task<IActionResult> Enable([FromBody] EnableMaintenanceRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+
+            try
+            {
+                var status = await _maintenanceService.EnableAsync(request?.Reason, userId, request?.ExpectedEndTime);
+                return Ok(status);
+            }
+            catch (ArgumentException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error enabling maintenance mode, user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Switches maintenance mode off immediately and releases queued invocations
+        /// </summary>
+        /// <returns>Maintenance status</returns>
+        [HttpPost("disable")]
+        public async Task<IActionResult> Disable()
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+
+            try
+            {
+                var status = await _maintenanceService.DisableAsync(userId);
+                return Ok(status);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error disabling maintenance mode, user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the maintenance windows that are active or scheduled
+        /// </summary>
+        /// <returns>List of windows</returns>
+        [HttpGet("windows")]
+        public async Task<IActionResult> GetWindows()
+        {
+            try
+            {
+                return Ok(await _maintenanceService.GetWindowsAsync());
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting maintenance windows");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Schedules a maintenance window
+        /// </summary>
+        /// <param name="request">Schedule request</param>
+        /// <returns>The scheduled window</returns>
+        [HttpPost("windows")]
+        public async Task<IActionResult> ScheduleWindow([FromBody] ScheduleMaintenanceRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+
+            try
+            {
+                var window = await _maintenanceService.ScheduleAsync(request.StartTime, request.EndTime, request.Reason, userId);
+                return Ok(window);
+            }
+            catch (ArgumentException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error scheduling maintenance window, user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Cancels a maintenance window
+        /// </summary>
+        /// <param name="id">Window ID</param>
+        /// <returns>No content if cancelled</returns>
+        [HttpDelete("windows/{id}")]
+        public async Task<IActionResult> CancelWindow(Guid id)
+        {
+            try
+            {
+                var cancelled = await _maintenanceService.CancelWindowAsync(id);
+                if (!cancelled)
+                {
+                    return NotFound(new { Message = "Maintenance window not found" });
+                }
+
+                return NoContent();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error cancelling maintenance window: {Id}", id);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
@@ -0,0 +1,62 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for operator checks of a running deployment
+    /// </summary>
+    /// <remarks>
+    /// The checks do real work against live components: they read the chain, have the enclave sign, attest and run a
+    /// built-in function, write a probe record and fetch from every active price source. Run them after a deploy or
+    /// when triaging an incident, not from a monitoring loop.
+    /// </remarks>
+    [ApiController]
+    [Route("api/admin/ops")]
+    [Authorize(Roles = "Admin")]
+    public class AdminOpsController : ControllerBase
+    {
+        private readonly ILogger<AdminOpsController> _logger;
+        private readonly ISystemVerificationService _verificationService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AdminOpsController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="verificationService">System verification service</param>
+        public AdminOpsController(ILogger<AdminOpsController> logger, ISystemVerificationService verificationService)
+        {
+            _logger = logger;
+            _verificationService = verificationService;
+        }
+
+        /// <summary>
+        /// Runs live end-to-end checks of the components the service depends on
+        /// </summary>
+        /// <param name="checks">Comma-separated names of the checks to run; every check when omitted</param>
+        /// <returns>The verification report; failed checks are reported in it rather than as an error status</returns>
+        [HttpPost("verify")]
+        public async Task<IActionResult> Verify([FromQuery] string checks = null)
+        {
+            try
+            {
+                var names = string.IsNullOrWhiteSpace(checks) ? null : checks.Split(',', StringSplitOptions.RemoveEmptyEntries | StringSplitOptions.TrimEntries);
+                return Ok(await _verificationService.VerifyAsync(names));
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error verifying the system");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
@@ -0,0 +1,141 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for deploying the on-chain PriceFeed contract and auditing its rounds
+    /// </summary>
+    [ApiController]
+    [Route("api/admin/pricefeed")]
+    [Authorize(Roles = "Admin")]
+    public class AdminPriceFeedController : ControllerBase
+    {
+        private readonly ILogger<AdminPriceFeedController> _logger;
+        private readonly IPriceFeedContractService _contractService;
+        private readonly IPriceFeedRoundAuditor _roundAuditor;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AdminPriceFeedController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="contractService">PriceFeed contract service</param>
+        /// <param name="roundAuditor">PriceFeed round auditor</param>
+        public AdminPriceFeedController(
+            ILogger<AdminPriceFeedController> logger,
+            IPriceFeedContractService contractService,
+            IPriceFeedRoundAuditor roundAuditor)
+        {
+            _logger = logger;
+            _contractService = contractService;
+            _roundAuditor = roundAuditor;
+        }
+
+        /// <summary>
+        /// Validates the configured NEF and manifest without deploying them
+        /// </summary>
+        /// <returns>The contract name, NEF checksum and ABI methods</returns>
+        [HttpGet("contract")]
+        public async Task<IActionResult> GetContractArtifacts()
+        {
+            try
+            {
+                var artifacts = await _contractService.LoadArtifactsAsync();
+                return Ok(new { artifacts.Name, artifacts.NefChecksum, artifacts.Methods });
+            }
+            catch (PriceFeedException ex)
+            {
+                _logger.LogError(ex, "Error validating PriceFeed contract artifacts");
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error validating PriceFeed contract artifacts");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Deploys the PriceFeed contract
+        /// </summary>
+        /// <param name="request">Wallet to sign with</param>
+        /// <returns>The submitted deployment</returns>
+        [HttpPost("contract/deploy")]
+        public Task<IActionResult> DeployContract([FromBody] PriceFeedContractDeploymentRequest request)
+        {
+            return SubmitAsync("deploy", () => _contractService.DeployAsync(request));
+        }
+
+        /// <summary>
+        /// Updates the deployed PriceFeed contract in place
+        /// </summary>
+        /// <param name="request">Wallet of the contract owner</param>
+        /// <returns>The submitted update</returns>
+        [HttpPost("contract/update")]
+        public Task<IActionResult> UpdateContract([FromBody] PriceFeedContractDeploymentRequest request)
+        {
+            return SubmitAsync("update", () => _contractService.UpdateAsync(request));
+        }
+
+        /// <summary>
+        /// Compares the most recent on-chain rounds of a pair against the service's price history
+        /// </summary>
+        /// <param name="symbol">Asset symbol</param>
+        /// <param name="baseCurrency">Base currency</param>
+        /// <param name="rounds">Number of rounds to audit</param>
+        /// <returns>The audit report</returns>
+        [HttpGet("audit/{symbol}")]
+        public async Task<IActionResult> AuditRounds(string symbol, [FromQuery] string baseCurrency = "USD", [FromQuery] int? rounds = null)
+        {
+            try
+            {
+                var report = await _roundAuditor.AuditAsync(symbol, baseCurrency, rounds);
+                return Ok(report);
+            }
+            catch (PriceFeedException ex)
+            {
+                _logger.LogError(ex, "Error auditing PriceFeed rounds of {Symbol}/{BaseCurrency}", symbol, baseCurrency);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (NeoRpcException ex)
+            {
+                _logger.LogError(ex, "Neo RPC error auditing PriceFeed rounds of {Symbol}/{BaseCurrency}", symbol, baseCurrency);
+                return StatusCode(502, new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error auditing PriceFeed rounds of {Symbol}/{BaseCurrency}", symbol, baseCurrency);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        private async Task<IActionResult> SubmitAsync(string operation, Func<Task<PriceFeedContractDeployment>> submit)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+
+            _logger.LogWarning("PriceFeed contract {Operation} requested by user: {UserId}", operation, userId);
+
+            try
+            {
+                var deployment = await submit();
+                return Ok(deployment);
+            }
+            catch (PriceFeedException ex)
+            {
+                _logger.LogError(ex, "Error submitting PriceFeed contract {Operation}", operation);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error submitting PriceFeed contract {Operation}", operation);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
@@ -0,0 +1,54 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for upgrading stored records to their current schema version
+    /// </summary>
+    /// <remarks>
+    /// Records are upgraded on read regardless; the migration writes the upgraded records back. It is safe to run
+    /// while the service is up and to run again.
+    /// </remarks>
+    [ApiController]
+    [Route("api/admin/records")]
+    [Authorize(Roles = "Admin")]
+    public class AdminRecordController : ControllerBase
+    {
+        private readonly ILogger<AdminRecordController> _logger;
+        private readonly IRecordMigrationService _migrationService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AdminRecordController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="migrationService">Record migration service</param>
+        public AdminRecordController(ILogger<AdminRecordController> logger, IRecordMigrationService migrationService)
+        {
+            _logger = logger;
+            _migrationService = migrationService;
+        }
+
+        /// <summary>
+        /// Upgrades and rewrites every stored record below its current schema version
+        /// </summary>
+        /// <returns>The migration report</returns>
+        [HttpPost("migrate")]
+        public async Task<IActionResult> Migrate()
+        {
+            try
+            {
+                return Ok(await _migrationService.MigrateAsync());
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error migrating stored records");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
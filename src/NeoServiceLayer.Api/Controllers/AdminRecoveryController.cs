@@ -0,0 +1,54 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for rerunning startup recovery
+    /// </summary>
+    /// <remarks>
+    /// Recovery runs once before the API starts; running it again picks up anything a failed step left behind.
+    /// It only touches work started before the current process, so it is safe while the service is up.
+    /// </remarks>
+    [ApiController]
+    [Route("api/admin/recovery")]
+    [Authorize(Roles = "Admin")]
+    public class AdminRecoveryController : ControllerBase
+    {
+        private readonly ILogger<AdminRecoveryController> _logger;
+        private readonly IStartupRecoveryService _recoveryService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AdminRecoveryController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="recoveryService">Startup recovery service</param>
+        public AdminRecoveryController(ILogger<AdminRecoveryController> logger, IStartupRecoveryService recoveryService)
+        {
+            _logger = logger;
+            _recoveryService = recoveryService;
+        }
+
+        /// <summary>
+        /// Runs every recovery step again
+        /// </summary>
+        /// <returns>The recovery report</returns>
+        [HttpPost]
+        public async Task<IActionResult> Recover()
+        {
+            try
+            {
+                return Ok(await _recoveryService.RecoverAsync());
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error running startup recovery");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
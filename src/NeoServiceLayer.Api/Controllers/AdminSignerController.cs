@@ -0,0 +1,54 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for the pool of accounts that sign trigger transactions
+    /// </summary>
+    [ApiController]
+    [Route("api/admin/signers")]
+    [Authorize(Roles = "Admin")]
+    public class AdminSignerController : ControllerBase
+    {
+        private readonly ILogger<AdminSignerController> _logger;
+        private readonly ISignerPool _signerPool;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AdminSignerController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="signerPool">Signer pool</param>
+        public AdminSignerController(ILogger<AdminSignerController> logger, ISignerPool signerPool)
+        {
+            _logger = logger;
+            _signerPool = signerPool;
+        }
+
+        /// <summary>
+        /// Gets the signers with their balances, pending transactions and health
+        /// </summary>
+        /// <returns>The signer pool status</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetSigners()
+        {
+            try
+            {
+                return Ok(new
+                {
+                    Enabled = _signerPool.IsEnabled,
+                    Signers = await _signerPool.GetStatusAsync()
+                });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting signer pool status");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
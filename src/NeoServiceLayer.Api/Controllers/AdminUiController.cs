@@ -0,0 +1,265 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Diagnostics.HealthChecks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for the admin dashboard embedded in the API service
+    /// </summary>
+    /// <remarks>
+    /// The page and its scripts are compiled into the API assembly so single-binary deployments do not need the
+    /// separate frontend. They hold no data and are served to anyone; the page signs in and reads everything it
+    /// shows from the admin-only summary endpoint.
+    /// </remarks>
+    [ApiController]
+    [Route("admin/ui")]
+    [Authorize(Roles = "Admin")]
+    [ApiExplorerSettings(IgnoreApi = true)]
+    public class AdminUiController : ControllerBase
+    {
+        private const string ResourcePrefix = "AdminUi/";
+
+        private static readonly Dictionary<string, string> ContentTypes = new Dictionary<string, string>(StringComparer.OrdinalIgnoreCase)
+        {
+            [".html"] = "text/html; charset=utf-8",
+            [".js"] = "text/javascript; charset=utf-8",
+            [".css"] = "text/css; charset=utf-8"
+        };
+
+        private readonly ILogger<AdminUiController> _logger;
+        private readonly AdminUiConfiguration _configuration;
+        private readonly IFunctionExecutionRepository _executionRepository;
+        private readonly IGasBankService _gasBankService;
+        private readonly IEventMonitoringService _eventMonitoringService;
+        private readonly IMaintenanceService _maintenanceService;
+        private readonly HealthCheckService _healthCheckService;
+        private readonly IMetricsService _metricsService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AdminUiController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="configuration">Admin dashboard configuration</param>
+        /// <param name="executionRepository">Function execution repository</param>
+        /// <param name="gasBankService">GasBank service</param>
+        /// <param name="eventMonitoringService">Event monitoring service</param>
+        /// <param name="maintenanceService">Maintenance service</param>
+        /// <param name="healthCheckService">Health check service</param>
+        /// <param name="metricsService">Metrics service</param>
+        public AdminUiController(
+            ILogger<AdminUiController> logger,
+            IOptions<AdminUiConfiguration> configuration,
+            IFunctionExecutionRepository executionRepository,
+            IGasBankService gasBankService,
+            IEventMonitoringService eventMonitoringService = null,
+            IMaintenanceService maintenanceService = null,
+            HealthCheckService healthCheckService = null,
+            IMetricsService metricsService = null)
+        {
+            _logger = logger;
+            _configuration = configuration.Value;
+            _executionRepository = executionRepository;
+            _gasBankService = gasBankService;
+            _eventMonitoringService = eventMonitoringService;
+            _maintenanceService = maintenanceService;
+            _healthCheckService = healthCheckService;
+            _metricsService = metricsService;
+        }
+
+        /// <summary>
+        /// Serves the dashboard page
+        /// </summary>
+        /// <returns>The dashboard page</returns>
+        [HttpGet]
+        [AllowAnonymous]
+        public IActionResult Index()
+        {
+            return Asset("index.html");
+        }
+
+        /// <summary>
+        /// Serves a script or stylesheet used by the dashboard page
+        /// </summary>
+        /// <param name="name">The asset file name</param>
+        /// <returns>The asset</returns>
+        [HttpGet("{name}")]
+        [AllowAnonymous]
+        public IActionResult Asset(string name)
+        {
+            if (!_configuration.Enabled || !ContentTypes.TryGetValue(System.IO.Path.GetExtension(name), out var contentType))
+            {
+                return NotFound();
+            }
+
+            var stream = typeof(AdminUiController).Assembly.GetManifestResourceStream(ResourcePrefix + name);
+            if (stream == null)
+            {
+                return NotFound();
+            }
+
+            return File(stream, contentType);
+        }
+
+        /// <summary>
+        /// Gets the service health, recent executions, trigger status and GasBank totals shown on the dashboard
+        /// </summary>
+        /// <returns>The dashboard summary</returns>
+        [HttpGet("api/summary")]
+        public async Task<IActionResult> GetSummary()
+        {
+            if (!_configuration.Enabled)
+            {
+                return NotFound();
+            }
+
+            try
+            {
+                var executions = await _executionRepository.GetAllAsync(_configuration.RecentExecutionCount);
+
+                return Ok(new
+                {
+                    GeneratedAt = DateTime.UtcNow,
+                    Health = await GetHealthAsync(),
+                    RecentExecutions = executions.Select(e => new
+                    {
+                        e.ExecutionId,
+                        e.FunctionId,
+                        e.AccountId,
+                        e.Status,
+                        e.StartTime,
+                        e.EndTime,
+                        e.DurationMs,
+                        e.Error
+                    }),
+                    Triggers = await GetTriggerStatusAsync(),
+                    GasBank = await _gasBankService.GetTotalsAsync()
+                });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error building admin dashboard summary");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the stored buckets of a metric, one series per tag combination
+        /// </summary>
+        /// <param name="name">Metric name</param>
+        /// <param name="startTime">Start of the range (UTC); defaults to 24 hours before the end</param>
+        /// <param name="endTime">End of the range (UTC); defaults to now</param>
+        /// <param name="resolution">Minute, Hour or Day; picked from the range when omitted</param>
+        /// <param name="tag">Tags a series must have, as key:value</param>
+        /// <returns>The series</returns>
+        [HttpGet("api/metrics")]
+        public async Task<IActionResult> GetMetrics(
+            [FromQuery] string name,
+            [FromQuery] DateTime? startTime = null,
+            [FromQuery] DateTime? endTime = null,
+            [FromQuery] MetricResolution? resolution = null,
+            [FromQuery] List<string> tag = null)
+        {
+            if (!_configuration.Enabled || _metricsService == null)
+            {
+                return NotFound();
+            }
+
+            var tags = new Dictionary<string, string>();
+            foreach (var pair in tag ?? new List<string>())
+            {
+                var separator = pair.IndexOf(':');
+                if (separator <= 0)
+                {
+                    return BadRequest(new { Message = $"Tag must be key:value: {pair}" });
+                }
+
+                tags[pair.Substring(0, separator)] = pair.Substring(separator + 1);
+            }
+
+            var end = endTime ?? DateTime.UtcNow;
+
+            try
+            {
+                var buckets = await _metricsService.QueryMetricsAsync(new MetricQuery
+                {
+                    Name = name,
+                    StartTime = startTime ?? end.AddHours(-24),
+                    EndTime = end,
+                    Tags = tags,
+                    Resolution = resolution
+                });
+
+                return Ok(buckets
+                    .GroupBy(b => string.Join(",", b.Tags.OrderBy(t => t.Key, StringComparer.Ordinal).Select(t => $"{t.Key}={t.Value}")))
+                    .Select(series => new
+                    {
+                        Name = name,
+                        series.First().Tags,
+                        series.First().Resolution,
+                        Points = series.Select(b => new { b.Timestamp, b.Count, b.Sum, b.Min, b.Max, b.Average })
+                    }));
+            }
+            catch (ArgumentException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (InvalidOperationException)
+            {
+                return NotFound(new { Message = "Metrics storage is not configured" });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error querying metric {Name}", name);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        private async Task<object> GetHealthAsync()
+        {
+            if (_healthCheckService == null)
+            {
+                return null;
+            }
+
+            var report = await _healthCheckService.CheckHealthAsync(HttpContext.RequestAborted);
+            return new
+            {
+                Status = report.Status.ToString(),
+                Checks = report.Entries.Select(e => new
+                {
+                    Name = e.Key,
+                    Status = e.Value.Status.ToString(),
+                    e.Value.Description,
+                    DurationMs = e.Value.Duration.TotalMilliseconds
+                })
+            };
+        }
+
+        private async Task<object> GetTriggerStatusAsync()
+        {
+            var statistics = _eventMonitoringService != null ? await _eventMonitoringService.GetStatisticsAsync() : null;
+            var maintenance = _maintenanceService != null ? await _maintenanceService.GetStatusAsync() : null;
+
+            return new
+            {
+                IsMonitoring = statistics?.IsMonitoring ?? false,
+                statistics?.CurrentBlockHeight,
+                statistics?.LastProcessedBlockHeight,
+                statistics?.ActiveSubscriptions,
+                statistics?.TotalNotificationsPending,
+                statistics?.TotalNotificationsFailed,
+                Paused = maintenance?.IsActive ?? false,
+                maintenance?.QueuedInvocations
+            };
+        }
+    }
+}
@@ -0,0 +1,122 @@
+using System;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Api.Models.Responses;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for accounts to manage API keys for their own integrations
+    /// </summary>
+    [ApiController]
+    [Route("api/account/api-keys")]
+    [Authorize]
+    public class ApiKeyController : ControllerBase
+    {
+        private readonly ILogger<ApiKeyController> _logger;
+        private readonly IApiCredentialService _credentialService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ApiKeyController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="credentialService">Account API key service</param>
+        public ApiKeyController(ILogger<ApiKeyController> logger, IApiCredentialService credentialService)
+        {
+            _logger = logger;
+            _credentialService = credentialService;
+        }
+
+        /// <summary>
+        /// Creates an API key for the caller's account
+        /// </summary>
+        /// <param name="request">Key request</param>
+        /// <returns>The key; the key itself is only returned here</returns>
+        [HttpPost]
+        public async Task<IActionResult> CreateApiKey([FromBody] CreateApiKeyRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var created = await _credentialService.CreateAsync(
+                    accountId, request.Name, request.Scopes, request.RateLimit, request.RateLimitPeriodSeconds, request.ExpiresAt);
+                return Ok(ApiKeyResponse.FromCredential(created.Credential, created.Key));
+            }
+            catch (Exception ex) when (ex is ArgumentException || ex is ValidationException)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error creating API key, user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the caller's API keys, including revoked and expired ones
+        /// </summary>
+        /// <returns>The keys, newest first</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetApiKeys()
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var credentials = await _credentialService.GetByAccountIdAsync(accountId);
+                return Ok(credentials.Select(c => ApiKeyResponse.FromCredential(c)));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting API keys, user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Revokes one of the caller's API keys
+        /// </summary>
+        /// <param name="id">Key ID</param>
+        /// <returns>The revoked key</returns>
+        [HttpDelete("{id}")]
+        public async Task<IActionResult> RevokeApiKey(Guid id)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var credential = await _credentialService.RevokeAsync(id, accountId);
+                return Ok(ApiKeyResponse.FromCredential(credential));
+            }
+            catch (ResourceNotFoundException)
+            {
+                return NotFound(new { Message = "API key not found" });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error revoking API key {Id}, user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
@@ -0,0 +1,260 @@
+using System;
+using System.Security.Claims;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for contract upkeeps run by the automation service
+    /// </summary>
+    [ApiController]
+    [Route("api/automation/upkeeps")]
+    [Authorize]
+    public class AutomationController : ControllerBase
+    {
+        private readonly ILogger<AutomationController> _logger;
+        private readonly IAutomationService _automationService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AutomationController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="automationService">Automation service</param>
+        public AutomationController(ILogger<AutomationController> logger, IAutomationService automationService)
+        {
+            _logger = logger;
+            _automationService = automationService;
+        }
+
+        /// <summary>
+        /// Registers an upkeep
+        /// </summary>
+        /// <param name="request">Upkeep registration request</param>
+        /// <returns>The registered upkeep</returns>
+        [HttpPost]
+        public async Task<IActionResult> RegisterUpkeep([FromBody] RegisterUpkeepRequest request)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Registering upkeep: {Name} for user: {UserId}", request.Name, userId);
+
+            try
+            {
+                var upkeep = await _automationService.RegisterAsync(new Upkeep
+                {
+                    AccountId = accountId,
+                    Name = request.Name,
+                    ContractHash = request.ContractHash,
+                    CheckData = request.CheckData,
+                    GasBankAccountId = request.GasBankAccountId,
+                    GasLimit = request.GasLimit,
+                    CheckIntervalSeconds = request.CheckIntervalSeconds
+                });
+
+                return CreatedAtAction(nameof(GetUpkeep), new { id = upkeep.Id }, upkeep);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error registering upkeep: {Name} for user: {UserId}", request.Name, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the upkeeps of the current user
+        /// </summary>
+        /// <returns>List of upkeeps</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetUpkeeps()
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                return Ok(await _automationService.GetByAccountIdAsync(accountId));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting upkeeps for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets an upkeep by ID
+        /// </summary>
+        /// <param name="id">Upkeep ID</param>
+        /// <returns>The upkeep</returns>
+        [HttpGet("{id}")]
+        public async Task<IActionResult> GetUpkeep(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var upkeep = await _automationService.GetByIdAsync(id);
+                if (upkeep == null)
+                {
+                    return NotFound(new { Message = "Upkeep not found" });
+                }
+
+                if (upkeep.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(upkeep);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting upkeep: {Id}", id);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Test-invokes an upkeep's checkUpkeep, and performUpkeep if it is needed, without sending a transaction
+        /// </summary>
+        /// <param name="id">Upkeep ID</param>
+        /// <returns>The decoded invocation results</returns>
+        [HttpPost("{id}/simulate")]
+        public async Task<IActionResult> SimulateUpkeep(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var upkeep = await _automationService.GetByIdAsync(id);
+                if (upkeep == null)
+                {
+                    return NotFound(new { Message = "Upkeep not found" });
+                }
+
+                if (upkeep.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(await _automationService.SimulateAsync(id));
+            }
+            catch (NeoRpcException ex)
+            {
+                _logger.LogWarning(ex, "Error simulating upkeep: {Id}", id);
+                return StatusCode(502, new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error simulating upkeep: {Id}", id);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Pauses an upkeep
+        /// </summary>
+        /// <param name="id">Upkeep ID</param>
+        /// <returns>The paused upkeep</returns>
+        [HttpPost("{id}/pause")]
+        public Task<IActionResult> PauseUpkeep(Guid id)
+        {
+            return ChangeStatusAsync(id, "pausing", _automationService.PauseAsync);
+        }
+
+        /// <summary>
+        /// Resumes a paused upkeep
+        /// </summary>
+        /// <param name="id">Upkeep ID</param>
+        /// <returns>The resumed upkeep</returns>
+        [HttpPost("{id}/resume")]
+        public Task<IActionResult> ResumeUpkeep(Guid id)
+        {
+            return ChangeStatusAsync(id, "resuming", _automationService.ResumeAsync);
+        }
+
+        /// <summary>
+        /// Cancels an upkeep; a cancelled upkeep is never checked again
+        /// </summary>
+        /// <param name="id">Upkeep ID</param>
+        /// <returns>The cancelled upkeep</returns>
+        [HttpPost("{id}/cancel")]
+        public Task<IActionResult> CancelUpkeep(Guid id)
+        {
+            return ChangeStatusAsync(id, "cancelling", _automationService.CancelAsync);
+        }
+
+        private async Task<IActionResult> ChangeStatusAsync(Guid id, string action, Func<Guid, Task<Upkeep>> change)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var upkeep = await _automationService.GetByIdAsync(id);
+                if (upkeep == null)
+                {
+                    return NotFound(new { Message = "Upkeep not found" });
+                }
+
+                if (upkeep.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                _logger.LogInformation("User {UserId} {Action} upkeep {Id}", userId, action, id);
+
+                return Ok(await change(id));
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException)
+            {
+                return NotFound(new { Message = "Upkeep not found" });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error {Action} upkeep: {Id}", action, id);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
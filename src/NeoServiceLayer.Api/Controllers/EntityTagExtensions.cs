@@ -0,0 +1,45 @@
+using Microsoft.AspNetCore.Mvc;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Extension methods for the <c>ETag</c> and <c>If-Match</c> headers of resources updated with optimistic concurrency
+    /// </summary>
+    public static class EntityTagExtensions
+    {
+        /// <summary>
+        /// Sets the <c>ETag</c> header of the response
+        /// </summary>
+        /// <param name="controller">Controller</param>
+        /// <param name="revision">Revision of the resource in the response</param>
+        public static void SetEntityTag(this ControllerBase controller, long revision)
+        {
+            controller.Response.Headers["ETag"] = EntityTagUtility.Format(revision);
+        }
+
+        /// <summary>
+        /// Checks the <c>If-Match</c> header of the request against the current revision of a resource
+        /// </summary>
+        /// <param name="controller">Controller</param>
+        /// <param name="revision">Current revision of the resource</param>
+        /// <returns>True if the header is absent or matches</returns>
+        public static bool IfMatch(this ControllerBase controller, long revision)
+        {
+            return EntityTagUtility.Matches(controller.Request.Headers["If-Match"].ToString(), revision);
+        }
+
+        /// <summary>
+        /// Rejects an update made against a stale revision, returning the current tag so the client can re-read
+        /// </summary>
+        /// <param name="controller">Controller</param>
+        /// <param name="resourceType">Resource type, as shown in the message</param>
+        /// <param name="currentRevision">Revision the resource is currently at</param>
+        /// <returns>A 409 Conflict result</returns>
+        public static IActionResult RevisionConflict(this ControllerBase controller, string resourceType, long currentRevision)
+        {
+            controller.SetEntityTag(currentRevision);
+            return controller.Conflict(new { Message = $"The {resourceType} was modified by another request; fetch it again and retry" });
+        }
+    }
+}
@@ -1,9 +1,11 @@
 using System;
 using System.Collections.Generic;
+using System.Linq;
 using System.Threading.Tasks;
 using Microsoft.AspNetCore.Authorization;
 using Microsoft.AspNetCore.Mvc;
 using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Extensions;
 using NeoServiceLayer.Core.Interfaces;
 using NeoServiceLayer.Core.Models;
 
@@ -197,9 +199,10 @@ namespace NeoServiceLayer.Api.Controllers
         /// <summary>
         /// Gets subscriptions for the authenticated user
         /// </summary>
+        /// <param name="tag">Tags the subscriptions must all carry</param>
         /// <returns>List of subscriptions</returns>
         [HttpGet("subscriptions")]
-        public async Task<IActionResult> GetSubscriptions()
+        public async Task<IActionResult> GetSubscriptions([FromQuery] List<string> tag)
         {
             _logger.LogInformation("Getting subscriptions for authenticated user");
 
@@ -212,7 +215,7 @@ namespace NeoServiceLayer.Api.Controllers
                 }
 
                 var subscriptions = await _eventMonitoringService.GetSubscriptionsByAccountAsync(accountId);
-                return Ok(subscriptions);
+                return Ok(subscriptions.Where(s => s.Tags.HasAllTags(tag)));
             }
             catch (Exception ex)
             {
@@ -1,5 +1,8 @@
 using System;
 using System.Collections.Generic;
+using System.Globalization;
+using System.Linq;
+using System.Text;
 using System.Threading.Tasks;
 using Microsoft.AspNetCore.Authorization;
 using Microsoft.AspNetCore.Mvc;
@@ -7,8 +10,10 @@ using Microsoft.Extensions.Logging;
 using NeoServiceLayer.Api.Models;
 using NeoServiceLayer.Core.Enums;
 using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Extensions;
 using NeoServiceLayer.Core.Interfaces;
 using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.API.Filters;
 
 namespace NeoServiceLayer.Api.Controllers
 {
@@ -22,16 +27,42 @@ namespace NeoServiceLayer.Api.Controllers
     {
         private readonly ILogger<FunctionController> _logger;
         private readonly IFunctionService _functionService;
+        private readonly IMaintenanceService _maintenanceService;
+        private readonly IFunctionAccessControlService _accessControlService;
+        private readonly IFunctionCapabilityAnalyzer _capabilityAnalyzer;
+        private readonly IFunctionSecurityMonitor _securityMonitor;
+        private readonly IFunctionUsageService _usageService;
+        private readonly IAsyncInvocationService _asyncInvocationService;
 
         /// <summary>
         /// Initializes a new instance of the <see cref="FunctionController"/> class
         /// </summary>
         /// <param name="logger">Logger</param>
         /// <param name="functionService">Function service</param>
-        public FunctionController(ILogger<FunctionController> logger, IFunctionService functionService)
+        /// <param name="maintenanceService">Maintenance service</param>
+        /// <param name="accessControlService">Access control service for delegated invocation</param>
+        /// <param name="capabilityAnalyzer">Analyzer for the capabilities function code uses</param>
+        /// <param name="securityMonitor">Monitor for sandbox security events</param>
+        /// <param name="usageService">Service reporting daily function usage</param>
+        /// <param name="asyncInvocationService">Service running invocations in the background</param>
+        public FunctionController(
+            ILogger<FunctionController> logger,
+            IFunctionService functionService,
+            IMaintenanceService maintenanceService,
+            IFunctionAccessControlService accessControlService = null,
+            IFunctionCapabilityAnalyzer capabilityAnalyzer = null,
+            IFunctionSecurityMonitor securityMonitor = null,
+            IFunctionUsageService usageService = null,
+            IAsyncInvocationService asyncInvocationService = null)
         {
             _logger = logger;
             _functionService = functionService;
+            _maintenanceService = maintenanceService;
+            _accessControlService = accessControlService;
+            _capabilityAnalyzer = capabilityAnalyzer;
+            _securityMonitor = securityMonitor;
+            _usageService = usageService;
+            _asyncInvocationService = asyncInvocationService;
         }
 
         /// <summary>
@@ -62,7 +93,8 @@ namespace NeoServiceLayer.Api.Controllers
                     request.MaxExecutionTime,
                     request.MaxMemory,
                     request.SecretIds,
-                    request.EnvironmentVariables);
+                    request.EnvironmentVariables,
+                    request.Permissions);
 
                 return Ok(new
                 {
@@ -73,10 +105,16 @@ namespace NeoServiceLayer.Api.Controllers
                     EntryPoint = function.EntryPoint,
                     MaxExecutionTime = function.MaxExecutionTime,
                     MaxMemory = function.MaxMemory,
+                    Permissions = function.Permissions,
+                    DetectedCapabilities = function.DetectedCapabilities,
                     Status = function.Status,
                     CreatedAt = function.CreatedAt
                 });
             }
+            catch (FunctionPermissionException ex)
+            {
+                return BadRequest(new { Message = ex.Message, ex.Report });
+            }
             catch (FunctionException ex)
             {
                 _logger.LogError(ex, "Error creating function: {Name}, Runtime: {Runtime}, AccountId: {AccountId}", request.Name, request.Runtime, accountId);
@@ -92,9 +130,10 @@ namespace NeoServiceLayer.Api.Controllers
         /// <summary>
         /// Gets all functions for the current user
         /// </summary>
+        /// <param name="tag">Tags the functions must all carry</param>
         /// <returns>List of functions</returns>
         [HttpGet]
-        public async Task<IActionResult> GetFunctions()
+        public async Task<IActionResult> GetFunctions([FromQuery] List<string> tag)
         {
             var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
             if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
@@ -109,7 +148,7 @@ namespace NeoServiceLayer.Api.Controllers
                 var functions = await _functionService.GetByAccountIdAsync(accountId);
                 var result = new List<object>();
 
-                foreach (var function in functions)
+                foreach (var function in functions.Where(f => f.Tags.HasAllTags(tag)))
                 {
                     result.Add(new
                     {
@@ -121,6 +160,7 @@ namespace NeoServiceLayer.Api.Controllers
                         MaxExecutionTime = function.MaxExecutionTime,
                         MaxMemory = function.MaxMemory,
                         Status = function.Status,
+                        Tags = function.Tags,
                         CreatedAt = function.CreatedAt,
                         UpdatedAt = function.UpdatedAt,
                         LastExecutedAt = function.LastExecutedAt
@@ -166,6 +206,7 @@ namespace NeoServiceLayer.Api.Controllers
                     return Forbid();
                 }
 
+                this.SetEntityTag(function.Revision);
                 return Ok(new
                 {
                     Id = function.Id,
@@ -179,8 +220,10 @@ namespace NeoServiceLayer.Api.Controllers
                     SecretIds = function.SecretIds,
                     EnvironmentVariables = function.EnvironmentVariables,
                     Status = function.Status,
+                    Tags = function.Tags,
                     CreatedAt = function.CreatedAt,
                     UpdatedAt = function.UpdatedAt,
+                    Revision = function.Revision,
                     LastExecutedAt = function.LastExecutedAt
                 });
             }
@@ -222,6 +265,11 @@ namespace NeoServiceLayer.Api.Controllers
                     return Forbid();
                 }
 
+                if (!this.IfMatch(function.Revision))
+                {
+                    return this.RevisionConflict("function", function.Revision);
+                }
+
                 // Update function properties
                 function.Name = request.Name;
                 function.Description = request.Description;
@@ -230,6 +278,7 @@ namespace NeoServiceLayer.Api.Controllers
                 function.MaxMemory = request.MaxMemory;
 
                 var updatedFunction = await _functionService.UpdateAsync(function);
+                this.SetEntityTag(updatedFunction.Revision);
                 return Ok(new
                 {
                     Id = updatedFunction.Id,
@@ -240,9 +289,14 @@ namespace NeoServiceLayer.Api.Controllers
                     MaxExecutionTime = updatedFunction.MaxExecutionTime,
                     MaxMemory = updatedFunction.MaxMemory,
                     Status = updatedFunction.Status,
-                    UpdatedAt = updatedFunction.UpdatedAt
+                    UpdatedAt = updatedFunction.UpdatedAt,
+                    Revision = updatedFunction.Revision
                 });
             }
+            catch (ConcurrencyConflictException ex)
+            {
+                return this.RevisionConflict("function", ex.CurrentRevision);
+            }
             catch (FunctionException ex)
             {
                 _logger.LogError(ex, "Error updating function: {FunctionId} for user: {UserId}", id, userId);
@@ -286,14 +340,25 @@ namespace NeoServiceLayer.Api.Controllers
                     return Forbid();
                 }
 
+                if (!this.IfMatch(function.Revision))
+                {
+                    return this.RevisionConflict("function", function.Revision);
+                }
+
                 var updatedFunction = await _functionService.UpdateSourceCodeAsync(id, request.SourceCode);
+                this.SetEntityTag(updatedFunction.Revision);
                 return Ok(new
                 {
                     Id = updatedFunction.Id,
                     Name = updatedFunction.Name,
-                    UpdatedAt = updatedFunction.UpdatedAt
+                    UpdatedAt = updatedFunction.UpdatedAt,
+                    Revision = updatedFunction.Revision
                 });
             }
+            catch (FunctionPermissionException ex)
+            {
+                return BadRequest(new { Message = ex.Message, ex.Report });
+            }
             catch (FunctionException ex)
             {
                 _logger.LogError(ex, "Error updating source code for function: {FunctionId} for user: {UserId}", id, userId);
@@ -337,13 +402,20 @@ namespace NeoServiceLayer.Api.Controllers
                     return Forbid();
                 }
 
+                if (!this.IfMatch(function.Revision))
+                {
+                    return this.RevisionConflict("function", function.Revision);
+                }
+
                 var updatedFunction = await _functionService.UpdateEnvironmentVariablesAsync(id, request.EnvironmentVariables);
+                this.SetEntityTag(updatedFunction.Revision);
                 return Ok(new
                 {
                     Id = updatedFunction.Id,
                     Name = updatedFunction.Name,
                     EnvironmentVariables = updatedFunction.EnvironmentVariables,
-                    UpdatedAt = updatedFunction.UpdatedAt
+                    UpdatedAt = updatedFunction.UpdatedAt,
+                    Revision = updatedFunction.Revision
                 });
             }
             catch (FunctionException ex)
@@ -358,6 +430,69 @@ namespace NeoServiceLayer.Api.Controllers
             }
         }
 
+        /// <summary>
+        /// Replaces the tags of a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="request">Update request</param>
+        /// <returns>The updated function</returns>
+        [HttpPut("{id}/tags")]
+        public async Task<IActionResult> UpdateTags(Guid id, [FromBody] UpdateTagsRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Updating tags for function: {FunctionId} for user: {UserId}", id, userId);
+
+            try
+            {
+                var function = await _functionService.GetByIdAsync(id);
+                if (function == null)
+                {
+                    return NotFound(new { Message = "Function not found" });
+                }
+
+                // Check if the function belongs to the current user
+                if (function.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                if (!this.IfMatch(function.Revision))
+                {
+                    return this.RevisionConflict("function", function.Revision);
+                }
+
+                var updatedFunction = await _functionService.UpdateTagsAsync(id, request.Tags);
+                this.SetEntityTag(updatedFunction.Revision);
+                return Ok(new
+                {
+                    Id = updatedFunction.Id,
+                    Name = updatedFunction.Name,
+                    Tags = updatedFunction.Tags,
+                    UpdatedAt = updatedFunction.UpdatedAt,
+                    Revision = updatedFunction.Revision
+                });
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (FunctionException ex)
+            {
+                _logger.LogError(ex, "Error updating tags for function: {FunctionId} for user: {UserId}", id, userId);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error updating tags for function: {FunctionId} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
         /// <summary>
         /// Updates the secret access of a function
         /// </summary>
@@ -389,13 +524,20 @@ namespace NeoServiceLayer.Api.Controllers
                     return Forbid();
                 }
 
+                if (!this.IfMatch(function.Revision))
+                {
+                    return this.RevisionConflict("function", function.Revision);
+                }
+
                 var updatedFunction = await _functionService.UpdateSecretAccessAsync(id, request.SecretIds);
+                this.SetEntityTag(updatedFunction.Revision);
                 return Ok(new
                 {
                     Id = updatedFunction.Id,
                     Name = updatedFunction.Name,
                     SecretIds = updatedFunction.SecretIds,
-                    UpdatedAt = updatedFunction.UpdatedAt
+                    UpdatedAt = updatedFunction.UpdatedAt,
+                    Revision = updatedFunction.Revision
                 });
             }
             catch (FunctionException ex)
@@ -410,13 +552,321 @@ namespace NeoServiceLayer.Api.Controllers
             }
         }
 
+        /// <summary>
+        /// Updates the declared permissions of a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="request">Update request</param>
+        /// <returns>The updated function</returns>
+        [HttpPut("{id}/permissions")]
+        public async Task<IActionResult> UpdatePermissions(Guid id, [FromBody] UpdatePermissionsRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Updating permissions for function: {FunctionId} for user: {UserId}", id, userId);
+
+            try
+            {
+                var function = await _functionService.GetByIdAsync(id);
+                if (function == null)
+                {
+                    return NotFound(new { Message = "Function not found" });
+                }
+
+                // Check if the function belongs to the current user
+                if (function.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                if (!this.IfMatch(function.Revision))
+                {
+                    return this.RevisionConflict("function", function.Revision);
+                }
+
+                var updatedFunction = await _functionService.UpdatePermissionsAsync(id, request.Permissions);
+                this.SetEntityTag(updatedFunction.Revision);
+                return Ok(new
+                {
+                    Id = updatedFunction.Id,
+                    Name = updatedFunction.Name,
+                    Permissions = updatedFunction.Permissions,
+                    DetectedCapabilities = updatedFunction.DetectedCapabilities,
+                    UpdatedAt = updatedFunction.UpdatedAt,
+                    Revision = updatedFunction.Revision
+                });
+            }
+            catch (FunctionPermissionException ex)
+            {
+                return BadRequest(new { Message = ex.Message, ex.Report });
+            }
+            catch (FunctionException ex)
+            {
+                _logger.LogError(ex, "Error updating permissions for function: {FunctionId} for user: {UserId}", id, userId);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error updating permissions for function: {FunctionId} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the capability report of a function's current code
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <returns>The capabilities the code uses, compared against the declared permissions</returns>
+        [HttpGet("{id}/capabilities")]
+        public async Task<IActionResult> GetCapabilities(Guid id)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var function = await _functionService.GetByIdAsync(id);
+                if (function == null)
+                {
+                    return NotFound(new { Message = "Function not found" });
+                }
+
+                // Check if the function belongs to the current user
+                if (function.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(await _functionService.GetCapabilityReportAsync(id));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting capabilities for function: {FunctionId} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the recent sandbox security events of a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <returns>List of security events, newest first</returns>
+        [HttpGet("{id}/security-events")]
+        public async Task<IActionResult> GetSecurityEvents(Guid id)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            if (_securityMonitor == null)
+            {
+                return StatusCode(503, new { Message = "Security monitoring is not available" });
+            }
+
+            try
+            {
+                var function = await _functionService.GetByIdAsync(id);
+                if (function == null)
+                {
+                    return NotFound(new { Message = "Function not found" });
+                }
+
+                // Check if the function belongs to the current user
+                if (function.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(await _securityMonitor.GetEventsAsync(id));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting security events for function: {FunctionId} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the daily usage statistics of a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="from">First day; defaults to 30 days before the last day</param>
+        /// <param name="to">Last day; defaults to today</param>
+        /// <returns>One entry per UTC day with executions, oldest first</returns>
+        [HttpGet("{id}/stats")]
+        public async Task<IActionResult> GetStats(Guid id, [FromQuery] DateTime? from = null, [FromQuery] DateTime? to = null)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            if (_usageService == null)
+            {
+                return StatusCode(503, new { Message = "Usage reports are not available" });
+            }
+
+            try
+            {
+                var function = await _functionService.GetByIdAsync(id);
+                if (function == null)
+                {
+                    return NotFound(new { Message = "Function not found" });
+                }
+
+                // Check if the function belongs to the current user
+                if (function.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                var (first, last) = GetStatsRange(from, to);
+                var stats = await _usageService.GetDailyStatsAsync(id, first, last);
+                return Ok(new { FunctionId = id, From = first, To = last, Days = stats });
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting stats for function: {FunctionId} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Exports the daily usage statistics of a function as CSV
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="from">First day; defaults to 30 days before the last day</param>
+        /// <param name="to">Last day; defaults to today</param>
+        /// <returns>A CSV file with one row per UTC day with executions</returns>
+        [HttpGet("{id}/stats/export")]
+        public async Task<IActionResult> ExportStats(Guid id, [FromQuery] DateTime? from = null, [FromQuery] DateTime? to = null)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            if (_usageService == null)
+            {
+                return StatusCode(503, new { Message = "Usage reports are not available" });
+            }
+
+            try
+            {
+                var function = await _functionService.GetByIdAsync(id);
+                if (function == null)
+                {
+                    return NotFound(new { Message = "Function not found" });
+                }
+
+                // Check if the function belongs to the current user
+                if (function.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                var (first, last) = GetStatsRange(from, to);
+                var stats = await _usageService.GetDailyStatsAsync(id, first, last);
+                return File(Encoding.UTF8.GetBytes(ToCsv(stats)), "text/csv", $"function-{id}-stats.csv");
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error exporting stats for function: {FunctionId} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the recent sandbox security events of the current user's functions, per function
+        /// </summary>
+        /// <returns>List of per-function summaries</returns>
+        [HttpGet("security-summary")]
+        public async Task<IActionResult> GetSecuritySummary()
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            if (_securityMonitor == null)
+            {
+                return StatusCode(503, new { Message = "Security monitoring is not available" });
+            }
+
+            try
+            {
+                return Ok(await _securityMonitor.GetSummariesAsync(accountId));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting security summary for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Checks function code against permissions without deploying it
+        /// </summary>
+        /// <param name="request">Code and permissions to check</param>
+        /// <returns>The capability report</returns>
+        [HttpPost("capabilities/analyze")]
+        public IActionResult AnalyzeCapabilities([FromBody] AnalyzeCapabilitiesRequest request)
+        {
+            if (_capabilityAnalyzer == null)
+            {
+                return StatusCode(503, new { Message = "Capability analysis is not available" });
+            }
+
+            try
+            {
+                var unknown = request.Permissions?.Where(p => !FunctionCapabilities.IsKnown(p)).ToList();
+                if (unknown != null && unknown.Count > 0)
+                {
+                    return BadRequest(new { Message = $"Unknown permissions: {string.Join(", ", unknown)}" });
+                }
+
+                return Ok(_capabilityAnalyzer.Analyze(request.Runtime.ToString(), request.SourceCode, request.Permissions));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error analyzing function capabilities");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
         /// <summary>
         /// Executes a function
         /// </summary>
+        /// <remarks>
+        /// With <see cref="ExecuteFunctionRequest.Async"/> set the function runs in the background: an execution ID is
+        /// returned at once, its outcome is polled from <c>executions/{executionId}</c> and, if a callback URL is given,
+        /// posted to it once the execution completes or fails.
+        /// </remarks>
         /// <param name="id">Function ID</param>
         /// <param name="request">Execution request</param>
-        /// <returns>Function execution result</returns>
+        /// <returns>Function execution result, or the execution ID of an asynchronous execution</returns>
         [HttpPost("{id}/execute")]
+        [ApiScope(ApiScopes.FunctionsInvoke)]
         public async Task<IActionResult> ExecuteFunction(Guid id, [FromBody] ExecuteFunctionRequest request)
         {
             var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
@@ -435,19 +885,80 @@ namespace NeoServiceLayer.Api.Controllers
                     return NotFound(new { Message = "Function not found" });
                 }
 
-                // Check if the function belongs to the current user
+                // Other users and API keys need an invoke grant from the owner
                 if (function.AccountId != accountId && !User.IsInRole("Admin"))
                 {
-                    return Forbid();
+                    var authorization = await AuthorizeDelegatedInvokeAsync(id, userId);
+                    if (authorization == InvokeAuthorizationResult.RateLimited)
+                    {
+                        return StatusCode(429, new { Message = "Invoke rate limit for this function reached" });
+                    }
+
+                    if (authorization != InvokeAuthorizationResult.Allowed)
+                    {
+                        return Forbid();
+                    }
                 }
 
-                var result = await _functionService.ExecuteAsync(id, request.Parameters);
+                // Sessions are scoped to the caller, so a delegated invoker never sees the state of another caller's session
+                var sessionKey = string.IsNullOrEmpty(request.SessionKey) ? null : $"{accountId:N}/{request.SessionKey}";
+
+                // Asynchronous invocations wait out maintenance in their own queue
+                if (request.Async)
+                {
+                    if (_asyncInvocationService == null)
+                    {
+                        return StatusCode(503, new { Message = "Asynchronous execution is not available" });
+                    }
+
+                    var invocation = await _asyncInvocationService.EnqueueAsync(id, accountId, request.Parameters, sessionKey, request.CallbackUrl);
+                    return AcceptedAtAction(nameof(GetExecution), new { executionId = invocation.Id }, new
+                    {
+                        ExecutionId = invocation.Id,
+                        Status = invocation.Status.ToString()
+                    });
+                }
+
+                // Invocations are queued while the platform is in maintenance and run once it ends
+                var maintenance = await _maintenanceService.GetStatusAsync();
+                if (maintenance.IsActive)
+                {
+                    var queued = await _maintenanceService.EnqueueInvocationAsync(id, accountId, request.Parameters);
+
+                    Response.Headers["Retry-After"] = maintenance.RetryAfterSeconds.ToString();
+                    return Accepted(new
+                    {
+                        Message = "The platform is in maintenance; the invocation has been queued",
+                        QueuedInvocationId = queued.Id,
+                        queued.Position,
+                        Maintenance = new { maintenance.Reason, maintenance.ExpectedEndTime }
+                    });
+                }
+
+                var result = await _functionService.ExecuteAsync(id, request.Parameters, sessionKey);
                 return Ok(new { Result = result });
             }
+            catch (MaintenanceException ex)
+            {
+                _logger.LogWarning("Rejected execution of function: {FunctionId} for user: {UserId}: {Message}", id, userId, ex.Message);
+                return StatusCode(503, new { Message = ex.Message, ex.ExpectedEndTime });
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
             catch (FunctionException ex)
             {
                 _logger.LogError(ex, "Error executing function: {FunctionId} for user: {UserId}", id, userId);
-                return BadRequest(new { Message = ex.Message });
+
+                // Sandbox failures carry a code so clients can tell transient failures from bugs in the function
+                var errorCode = FunctionErrorCodes.Find(ex);
+                return BadRequest(new
+                {
+                    Message = ex.Message,
+                    ErrorCode = errorCode,
+                    Retryable = errorCode != null && FunctionErrorCodes.IsTransient(errorCode)
+                });
             }
             catch (Exception ex)
             {
@@ -456,6 +967,72 @@ namespace NeoServiceLayer.Api.Controllers
             }
         }
 
+        /// <summary>
+        /// Gets the status and outcome of an asynchronous execution
+        /// </summary>
+        /// <param name="executionId">Execution ID returned when the execution was accepted</param>
+        /// <returns>The execution</returns>
+        [HttpGet("executions/{executionId}")]
+        public async Task<IActionResult> GetExecution(Guid executionId)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            if (_asyncInvocationService == null)
+            {
+                return StatusCode(503, new { Message = "Asynchronous execution is not available" });
+            }
+
+            try
+            {
+                var invocation = await _asyncInvocationService.GetAsync(executionId);
+                if (invocation == null)
+                {
+                    return NotFound(new { Message = "Execution not found" });
+                }
+
+                // The invoker and the function's owner may both follow the execution
+                if (invocation.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    var function = await _functionService.GetByIdAsync(invocation.FunctionId);
+                    if (function == null || function.AccountId != accountId)
+                    {
+                        return NotFound(new { Message = "Execution not found" });
+                    }
+                }
+
+                return Ok(new
+                {
+                    ExecutionId = invocation.Id,
+                    invocation.FunctionId,
+                    Status = invocation.Status.ToString(),
+                    invocation.Result,
+                    invocation.Error,
+                    invocation.ErrorCode,
+                    Retryable = invocation.ErrorCode != null && FunctionErrorCodes.IsTransient(invocation.ErrorCode),
+                    invocation.CreatedAt,
+                    invocation.StartedAt,
+                    invocation.CompletedAt,
+                    Callback = invocation.CallbackUrl == null ? null : new
+                    {
+                        invocation.CallbackUrl,
+                        CallbackStatus = invocation.CallbackStatus.ToString(),
+                        invocation.CallbackAttempts,
+                        invocation.CallbackError,
+                        invocation.CallbackDeliveredAt
+                    }
+                });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error getting execution: {ExecutionId} for user: {UserId}", executionId, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
         /// <summary>
         /// Gets the execution history of a function
         /// </summary>
@@ -657,5 +1234,190 @@ namespace NeoServiceLayer.Api.Controllers
                 return StatusCode(500, new { Message = "An unexpected error occurred" });
             }
         }
+
+        /// <summary>
+        /// Lets another user or API key invoke a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="request">Grant request</param>
+        /// <returns>The grant</returns>
+        [HttpPost("{id}/grants")]
+        public async Task<IActionResult> GrantInvoke(Guid id, [FromBody] GrantInvokeRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Granting invoke on function: {FunctionId} to {PrincipalType} {PrincipalId} for user: {UserId}", id, request.PrincipalType, request.PrincipalId, userId);
+
+            try
+            {
+                var ownerCheck = await CheckOwnerAsync(id, accountId);
+                if (ownerCheck != null)
+                {
+                    return ownerCheck;
+                }
+
+                var grant = await _accessControlService.GrantInvokeAsync(
+                    id,
+                    request.PrincipalId,
+                    request.PrincipalType,
+                    accountId,
+                    request.RateLimit,
+                    request.RateLimitPeriodSeconds,
+                    request.ExpiresAt?.ToUniversalTime());
+
+                return Ok(grant);
+            }
+            catch (ArgumentException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error granting invoke on function: {FunctionId} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Lists the grants of a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <returns>The grants</returns>
+        [HttpGet("{id}/grants")]
+        public async Task<IActionResult> GetGrants(Guid id)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var ownerCheck = await CheckOwnerAsync(id, accountId);
+                if (ownerCheck != null)
+                {
+                    return ownerCheck;
+                }
+
+                return Ok(await _accessControlService.GetPermissionsAsync(id));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error getting grants of function: {FunctionId} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Revokes a grant of a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="grantId">Grant ID</param>
+        /// <returns>No content</returns>
+        [HttpDelete("{id}/grants/{grantId}")]
+        public async Task<IActionResult> RevokeGrant(Guid id, Guid grantId)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Revoking grant: {GrantId} of function: {FunctionId} for user: {UserId}", grantId, id, userId);
+
+            try
+            {
+                var ownerCheck = await CheckOwnerAsync(id, accountId);
+                if (ownerCheck != null)
+                {
+                    return ownerCheck;
+                }
+
+                var grants = await _accessControlService.GetPermissionsAsync(id);
+                if (!grants.Any(g => g.Id == grantId))
+                {
+                    return NotFound(new { Message = "Grant not found" });
+                }
+
+                await _accessControlService.RevokePermissionAsync(grantId);
+                return NoContent();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error revoking grant: {GrantId} of function: {FunctionId} for user: {UserId}", grantId, id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        private async Task<IActionResult> CheckOwnerAsync(Guid functionId, Guid accountId)
+        {
+            if (_accessControlService == null)
+            {
+                return StatusCode(503, new { Message = "Function grants are not available" });
+            }
+
+            var function = await _functionService.GetByIdAsync(functionId);
+            if (function == null)
+            {
+                return NotFound(new { Message = "Function not found" });
+            }
+
+            return function.AccountId != accountId && !User.IsInRole("Admin") ? Forbid() : null;
+        }
+
+        private async Task<InvokeAuthorizationResult> AuthorizeDelegatedInvokeAsync(Guid functionId, string userId)
+        {
+            if (_accessControlService == null)
+            {
+                return InvokeAuthorizationResult.Denied;
+            }
+
+            // A grant to the calling API key applies before grants to the key's owner
+            var apiKeyId = User.FindFirst("api_key_id")?.Value;
+            if (!string.IsNullOrEmpty(apiKeyId))
+            {
+                var keyAuthorization = await _accessControlService.AuthorizeInvokeAsync(functionId, apiKeyId, "apikey");
+                if (keyAuthorization != InvokeAuthorizationResult.Denied)
+                {
+                    return keyAuthorization;
+                }
+            }
+
+            return await _accessControlService.AuthorizeInvokeAsync(functionId, userId, "user");
+        }
+
+        private static (DateTime From, DateTime To) GetStatsRange(DateTime? from, DateTime? to)
+        {
+            var last = (to ?? DateTime.UtcNow).Date;
+            var first = (from ?? last.AddDays(-30)).Date;
+            return (first, last);
+        }
+
+        private static string ToCsv(IEnumerable<FunctionDailyStats> stats)
+        {
+            var csv = new StringBuilder();
+            csv.AppendLine("date,invocations,errors,error_rate,avg_duration_ms,p50_duration_ms,p95_duration_ms,max_duration_ms,peak_memory_mb,gas_charged");
+            foreach (var day in stats)
+            {
+                csv.AppendLine(string.Join(",",
+                    day.Date.ToString("yyyy-MM-dd", CultureInfo.InvariantCulture),
+                    day.Invocations.ToString(CultureInfo.InvariantCulture),
+                    day.Errors.ToString(CultureInfo.InvariantCulture),
+                    day.ErrorRate.ToString("0.####", CultureInfo.InvariantCulture),
+                    day.AverageDurationMs.ToString("0.#", CultureInfo.InvariantCulture),
+                    day.P50DurationMs.ToString("0.#", CultureInfo.InvariantCulture),
+                    day.P95DurationMs.ToString("0.#", CultureInfo.InvariantCulture),
+                    day.MaxDurationMs.ToString("0.#", CultureInfo.InvariantCulture),
+                    day.PeakMemoryMb.ToString("0.#", CultureInfo.InvariantCulture),
+                    day.GasCharged.ToString(CultureInfo.InvariantCulture)));
+            }
+
+            return csv.ToString();
+        }
     }
 }
@@ -0,0 +1,304 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for the versions of a function in the function registry
+    /// </summary>
+    [ApiController]
+    [Route("api/function/{functionId}/versions")]
+    [Authorize]
+    public class FunctionVersionController : ControllerBase
+    {
+        private readonly ILogger<FunctionVersionController> _logger;
+        private readonly IFunctionVersionService _versionService;
+        private readonly IFunctionService _functionService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="FunctionVersionController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="versionService">Function version service</param>
+        /// <param name="functionService">Function service</param>
+        public FunctionVersionController(
+            ILogger<FunctionVersionController> logger,
+            IFunctionVersionService versionService,
+            IFunctionService functionService)
+        {
+            _logger = logger;
+            _versionService = versionService;
+            _functionService = functionService;
+        }
+
+        /// <summary>
+        /// Deploys a new version of a function
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="request">Version to deploy</param>
+        /// <returns>The deployed version</returns>
+        [HttpPost]
+        public async Task<IActionResult> DeployVersion(Guid functionId, [FromBody] DeployFunctionVersionRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Deploying a version of function: {FunctionId} for user: {UserId}", functionId, userId);
+
+            try
+            {
+                var denied = await CheckFunctionAccessAsync(functionId, accountId);
+                if (denied != null)
+                {
+                    return denied;
+                }
+
+                var version = await _versionService.DeployAsync(functionId, new FunctionVersion
+                {
+                    SourceCode = request.SourceCode,
+                    Label = request.Label,
+                    Notes = request.Notes,
+                    EntryPoint = request.EntryPoint,
+                    MaxExecutionTime = request.MaxExecutionTime,
+                    MaxMemory = request.MaxMemory,
+                    EnvironmentVariables = request.EnvironmentVariables,
+                    CreatedBy = userId
+                }, request.Promote);
+
+                return CreatedAtAction(nameof(GetVersion), new { functionId, number = version.Number }, version);
+            }
+            catch (Exception ex) when (ex is ValidationException || ex is FunctionException)
+            {
+                return BadRequest(new { Message = ex.Message, Report = (ex as FunctionPermissionException)?.Report });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error deploying a version of function: {FunctionId} for user: {UserId}", functionId, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Lists the versions of a function
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <returns>The versions, newest first</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetVersions(Guid functionId)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var denied = await CheckFunctionAccessAsync(functionId, accountId);
+                if (denied != null)
+                {
+                    return denied;
+                }
+
+                return Ok(await _versionService.GetVersionsAsync(functionId));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error getting versions of function: {FunctionId} for user: {UserId}", functionId, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets a version of a function
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="number">Version number</param>
+        /// <returns>The version</returns>
+        [HttpGet("{number:int}")]
+        public async Task<IActionResult> GetVersion(Guid functionId, int number)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var denied = await CheckFunctionAccessAsync(functionId, accountId);
+                if (denied != null)
+                {
+                    return denied;
+                }
+
+                var version = await _versionService.GetVersionAsync(functionId, number);
+                if (version == null)
+                {
+                    return NotFound(new { Message = "Function version not found" });
+                }
+
+                return Ok(version);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error getting version {Number} of function: {FunctionId} for user: {UserId}", number, functionId, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Makes a version the one the function runs
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="number">Version number</param>
+        /// <returns>The promoted version</returns>
+        [HttpPost("{number:int}/promote")]
+        public async Task<IActionResult> PromoteVersion(Guid functionId, int number)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Promoting version {Number} of function: {FunctionId} for user: {UserId}", number, functionId, userId);
+
+            try
+            {
+                var denied = await CheckFunctionAccessAsync(functionId, accountId);
+                if (denied != null)
+                {
+                    return denied;
+                }
+
+                return Ok(await _versionService.PromoteAsync(functionId, number));
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (Exception ex) when (ex is ValidationException || ex is FunctionException)
+            {
+                return BadRequest(new { Message = ex.Message, Report = (ex as FunctionPermissionException)?.Report });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error promoting version {Number} of function: {FunctionId} for user: {UserId}", number, functionId, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Rolls a function back to the newest version below the live one that has been live before
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <returns>The version now live</returns>
+        [HttpPost("rollback")]
+        public async Task<IActionResult> Rollback(Guid functionId)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Rolling back function: {FunctionId} for user: {UserId}", functionId, userId);
+
+            try
+            {
+                var denied = await CheckFunctionAccessAsync(functionId, accountId);
+                if (denied != null)
+                {
+                    return denied;
+                }
+
+                return Ok(await _versionService.RollbackAsync(functionId));
+            }
+            catch (Exception ex) when (ex is ValidationException || ex is FunctionException)
+            {
+                return BadRequest(new { Message = ex.Message, Report = (ex as FunctionPermissionException)?.Report });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error rolling back function: {FunctionId} for user: {UserId}", functionId, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Deletes a version that is not live
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="number">Version number</param>
+        /// <returns>No content</returns>
+        [HttpDelete("{number:int}")]
+        public async Task<IActionResult> DeleteVersion(Guid functionId, int number)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Deleting version {Number} of function: {FunctionId} for user: {UserId}", number, functionId, userId);
+
+            try
+            {
+                var denied = await CheckFunctionAccessAsync(functionId, accountId);
+                if (denied != null)
+                {
+                    return denied;
+                }
+
+                if (!await _versionService.DeleteVersionAsync(functionId, number))
+                {
+                    return NotFound(new { Message = "Function version not found" });
+                }
+
+                return NoContent();
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error deleting version {Number} of function: {FunctionId} for user: {UserId}", number, functionId, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Checks that the function exists and belongs to the caller
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="accountId">Caller's account ID</param>
+        /// <returns>The result to return instead, or null if the caller may manage the function</returns>
+        private async Task<IActionResult> CheckFunctionAccessAsync(Guid functionId, Guid accountId)
+        {
+            var function = await _functionService.GetByIdAsync(functionId);
+            if (function == null)
+            {
+                return NotFound(new { Message = "Function not found" });
+            }
+
+            if (function.AccountId != accountId && !User.IsInRole("Admin"))
+            {
+                return Forbid();
+            }
+
+            return null;
+        }
+    }
+}
@@ -0,0 +1,385 @@
+using System;
+using System.Security.Claims;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.API.Filters;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for GasBank fee policy templates and the policies created from them
+    /// </summary>
+    [ApiController]
+    [Route("api/gasbank/fee-policies")]
+    [Authorize]
+    [ApiReadScope(ApiScopes.GasBankRead)]
+    public class GasFeePolicyController : ControllerBase
+    {
+        private readonly ILogger<GasFeePolicyController> _logger;
+        private readonly IGasFeePolicyService _feePolicyService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasFeePolicyController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="feePolicyService">Fee policy service</param>
+        public GasFeePolicyController(ILogger<GasFeePolicyController> logger, IGasFeePolicyService feePolicyService)
+        {
+            _logger = logger;
+            _feePolicyService = feePolicyService;
+        }
+
+        /// <summary>
+        /// Creates a fee policy template owned by the current user
+        /// </summary>
+        /// <param name="request">Template request</param>
+        /// <returns>The created template</returns>
+        [HttpPost("templates")]
+        public async Task<IActionResult> CreateTemplate([FromBody] CreateFeePolicyTemplateRequest request)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var template = await _feePolicyService.CreateTemplateAsync(accountId, request.Name, request.Description, request.IsPublic, request.Rules);
+                return CreatedAtAction(nameof(GetTemplate), new { id = template.Id }, template);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error creating fee policy template for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the templates the current user owns and the public templates
+        /// </summary>
+        /// <returns>List of templates</returns>
+        [HttpGet("templates")]
+        public async Task<IActionResult> GetTemplates()
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                return Ok(await _feePolicyService.GetTemplatesAsync(accountId));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting fee policy templates for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets a template with all of its versions
+        /// </summary>
+        /// <param name="id">Template ID</param>
+        /// <returns>The template</returns>
+        [HttpGet("templates/{id}")]
+        public async Task<IActionResult> GetTemplate(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var template = await _feePolicyService.GetTemplateAsync(id);
+                if (template == null)
+                {
+                    return NotFound(new { Message = "Fee policy template not found" });
+                }
+
+                if (!template.IsPublic && template.OwnerAccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(template);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting fee policy template: {Id}", id);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Publishes a new version of a template; policies following the latest version pick it up at once
+        /// </summary>
+        /// <param name="id">Template ID</param>
+        /// <param name="request">Version request</param>
+        /// <returns>The template</returns>
+        [HttpPost("templates/{id}/versions")]
+        public async Task<IActionResult> PublishVersion(Guid id, [FromBody] PublishFeePolicyTemplateVersionRequest request)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Publishing fee policy template version: {Id} for user: {UserId}", id, userId);
+
+            try
+            {
+                return Ok(await _feePolicyService.PublishVersionAsync(id, accountId, request.Rules, request.Notes));
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error publishing fee policy template version: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Applies a template owned by the current user to many users, paid from one of the current user's GasBank accounts
+        /// </summary>
+        /// <param name="id">Template ID</param>
+        /// <param name="request">Apply request</param>
+        /// <returns>The created and updated policies</returns>
+        [HttpPost("templates/{id}/apply")]
+        public async Task<IActionResult> ApplyTemplate(Guid id, [FromBody] ApplyFeePolicyTemplateRequest request)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Applying fee policy template: {Id} to {Count} users for user: {UserId}", id, request.AccountIds?.Count, userId);
+
+            try
+            {
+                return Ok(await _feePolicyService.ApplyTemplateAsync(id, accountId, request.GasBankAccountId, request.AccountIds, request.TemplateVersion));
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error applying fee policy template: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Creates a fee policy from a template, paid from one of the current user's GasBank accounts
+        /// </summary>
+        /// <param name="request">Policy request</param>
+        /// <returns>The created policy</returns>
+        [HttpPost]
+        public async Task<IActionResult> CreatePolicy([FromBody] CreateFeePolicyRequest request)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var policy = await _feePolicyService.CreatePolicyAsync(
+                    request.TemplateId,
+                    accountId,
+                    request.GasBankAccountId,
+                    request.AccountId ?? accountId,
+                    request.TemplateVersion,
+                    request.Overrides);
+
+                return CreatedAtAction(nameof(GetPolicy), new { id = policy.Id }, policy);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error creating fee policy for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the policies covering or sponsored by the current user, with their effective rules
+        /// </summary>
+        /// <returns>List of policies</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetPolicies()
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                return Ok(await _feePolicyService.GetPoliciesAsync(accountId));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting fee policies for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets a policy with its effective rules
+        /// </summary>
+        /// <param name="id">Policy ID</param>
+        /// <returns>The policy</returns>
+        [HttpGet("{id}")]
+        public async Task<IActionResult> GetPolicy(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var policy = await _feePolicyService.GetPolicyAsync(id);
+                if (policy == null)
+                {
+                    return NotFound(new { Message = "Fee policy not found" });
+                }
+
+                if (policy.AccountId != accountId && policy.SponsorAccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(policy);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting fee policy: {Id}", id);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Customizes a policy's overrides or moves it to another template version; only the sponsor may change it
+        /// </summary>
+        /// <param name="id">Policy ID</param>
+        /// <param name="request">Update request</param>
+        /// <returns>The updated policy</returns>
+        [HttpPut("{id}")]
+        public async Task<IActionResult> UpdatePolicy(Guid id, [FromBody] UpdateFeePolicyRequest request)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                return Ok(await _feePolicyService.UpdatePolicyAsync(id, accountId, request.TemplateVersion, request.Overrides));
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error updating fee policy: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Deletes a policy; its sponsor or the covered user may delete it
+        /// </summary>
+        /// <param name="id">Policy ID</param>
+        /// <returns>No content</returns>
+        [HttpDelete("{id}")]
+        public async Task<IActionResult> DeletePolicy(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                if (!await _feePolicyService.DeletePolicyAsync(id, accountId))
+                {
+                    return NotFound(new { Message = "Fee policy not found" });
+                }
+
+                return NoContent();
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error deleting fee policy: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
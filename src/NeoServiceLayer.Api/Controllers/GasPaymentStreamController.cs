@@ -0,0 +1,186 @@
+using System;
+using System.Security.Claims;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.API.Filters;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for GasBank payment streams between users
+    /// </summary>
+    [ApiController]
+    [Route("api/gasbank/streams")]
+    [Authorize]
+    [ApiReadScope(ApiScopes.GasBankRead)]
+    public class GasPaymentStreamController : ControllerBase
+    {
+        private readonly ILogger<GasPaymentStreamController> _logger;
+        private readonly IGasPaymentStreamService _streamService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasPaymentStreamController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="streamService">Payment stream service</param>
+        public GasPaymentStreamController(ILogger<GasPaymentStreamController> logger, IGasPaymentStreamService streamService)
+        {
+            _logger = logger;
+            _streamService = streamService;
+        }
+
+        /// <summary>
+        /// Opens a payment stream from one of the current user's GasBank accounts
+        /// </summary>
+        /// <param name="request">Payment stream request</param>
+        /// <returns>The open stream</returns>
+        [HttpPost]
+        [ApiScope(ApiScopes.GasBankWithdraw)]
+        public async Task<IActionResult> OpenStream([FromBody] OpenPaymentStreamRequest request)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Opening payment stream from GasBank account: {PayerId} to {PayeeId} for user: {UserId}",
+                request.PayerGasBankAccountId, request.PayeeGasBankAccountId, userId);
+
+            try
+            {
+                var stream = await _streamService.OpenAsync(new GasPaymentStream
+                {
+                    PayerAccountId = accountId,
+                    PayerGasBankAccountId = request.PayerGasBankAccountId,
+                    PayeeGasBankAccountId = request.PayeeGasBankAccountId,
+                    RatePerSecond = request.RatePerSecond,
+                    Cap = request.Cap,
+                    ExpiresAt = DateTime.UtcNow.AddSeconds(request.DurationSeconds),
+                    Description = request.Description
+                });
+
+                return CreatedAtAction(nameof(GetStream), new { id = stream.Id }, stream);
+            }
+            catch (Exception ex) when (ex is ValidationException || ex is GasBankException)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error opening payment stream for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the payment streams the current user pays or receives
+        /// </summary>
+        /// <returns>List of streams, newest first</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetStreams()
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                return Ok(await _streamService.GetByAccountIdAsync(accountId));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting payment streams for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets a payment stream with the GAS accrued so far
+        /// </summary>
+        /// <param name="id">Stream ID</param>
+        /// <returns>The stream</returns>
+        [HttpGet("{id}")]
+        public async Task<IActionResult> GetStream(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var stream = await _streamService.GetByIdAsync(id);
+                if (stream == null)
+                {
+                    return NotFound(new { Message = "Payment stream not found" });
+                }
+
+                if (stream.PayerAccountId != accountId && stream.PayeeAccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(stream);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting payment stream: {Id}", id);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Closes a payment stream and settles the GAS accrued so far; either the payer or the payee may close it
+        /// </summary>
+        /// <param name="id">Stream ID</param>
+        /// <returns>The stream</returns>
+        [HttpPost("{id}/close")]
+        [ApiScope(ApiScopes.GasBankWithdraw)]
+        public async Task<IActionResult> CloseStream(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Closing payment stream: {Id} for user: {UserId}", id, userId);
+
+            try
+            {
+                return Ok(await _streamService.CloseAsync(id, accountId));
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error closing payment stream: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
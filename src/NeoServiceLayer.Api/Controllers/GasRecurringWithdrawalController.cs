@@ -0,0 +1,259 @@
+using System;
+using System.Security.Claims;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.API.Filters;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for scheduled recurring GasBank withdrawals
+    /// </summary>
+    [ApiController]
+    [Route("api/gasbank/recurring-withdrawals")]
+    [Authorize]
+    [ApiReadScope(ApiScopes.GasBankRead)]
+    public class GasRecurringWithdrawalController : ControllerBase
+    {
+        private readonly ILogger<GasRecurringWithdrawalController> _logger;
+        private readonly IGasRecurringWithdrawalService _recurringWithdrawalService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasRecurringWithdrawalController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="recurringWithdrawalService">Recurring withdrawal service</param>
+        public GasRecurringWithdrawalController(
+            ILogger<GasRecurringWithdrawalController> logger,
+            IGasRecurringWithdrawalService recurringWithdrawalService)
+        {
+            _logger = logger;
+            _recurringWithdrawalService = recurringWithdrawalService;
+        }
+
+        /// <summary>
+        /// Schedules a recurring withdrawal from one of the current user's GasBank accounts
+        /// </summary>
+        /// <param name="request">Recurring withdrawal request</param>
+        /// <returns>The recurring withdrawal with its first occurrence scheduled</returns>
+        [HttpPost]
+        [ApiScope(ApiScopes.GasBankWithdraw)]
+        public async Task<IActionResult> CreateRecurringWithdrawal([FromBody] CreateRecurringWithdrawalRequest request)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Scheduling recurring withdrawal from GasBank account: {GasBankAccountId} for user: {UserId}",
+                request.GasBankAccountId, userId);
+
+            try
+            {
+                var withdrawal = await _recurringWithdrawalService.CreateAsync(new GasRecurringWithdrawal
+                {
+                    AccountId = accountId,
+                    GasBankAccountId = request.GasBankAccountId,
+                    ToAddress = request.ToAddress,
+                    Schedule = request.Schedule,
+                    Amount = request.Amount,
+                    Floor = request.Floor,
+                    MinimumAmount = request.MinimumAmount,
+                    Description = request.Description
+                });
+
+                return CreatedAtAction(nameof(GetRecurringWithdrawal), new { id = withdrawal.Id }, withdrawal);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error scheduling recurring withdrawal for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the current user's recurring withdrawals
+        /// </summary>
+        /// <returns>List of recurring withdrawals, newest first</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetRecurringWithdrawals()
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                return Ok(await _recurringWithdrawalService.GetByAccountIdAsync(accountId));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting recurring withdrawals for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets a recurring withdrawal
+        /// </summary>
+        /// <param name="id">Recurring withdrawal ID</param>
+        /// <returns>The recurring withdrawal</returns>
+        [HttpGet("{id}")]
+        public async Task<IActionResult> GetRecurringWithdrawal(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var withdrawal = await _recurringWithdrawalService.GetByIdAsync(id);
+                if (withdrawal == null)
+                {
+                    return NotFound(new { Message = "Recurring withdrawal not found" });
+                }
+
+                if (withdrawal.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(withdrawal);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting recurring withdrawal: {Id}", id);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the occurrences of a recurring withdrawal with their outcomes
+        /// </summary>
+        /// <param name="id">Recurring withdrawal ID</param>
+        /// <param name="limit">Most occurrences to return</param>
+        /// <returns>List of occurrences, newest first</returns>
+        [HttpGet("{id}/occurrences")]
+        public async Task<IActionResult> GetOccurrences(Guid id, [FromQuery] int limit = 50)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var withdrawal = await _recurringWithdrawalService.GetByIdAsync(id);
+                if (withdrawal == null)
+                {
+                    return NotFound(new { Message = "Recurring withdrawal not found" });
+                }
+
+                if (withdrawal.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(await _recurringWithdrawalService.GetOccurrencesAsync(id, limit));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting occurrences of recurring withdrawal: {Id}", id);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Pauses a recurring withdrawal
+        /// </summary>
+        /// <param name="id">Recurring withdrawal ID</param>
+        /// <returns>The paused recurring withdrawal</returns>
+        [HttpPost("{id}/pause")]
+        [ApiScope(ApiScopes.GasBankWithdraw)]
+        public Task<IActionResult> PauseRecurringWithdrawal(Guid id)
+        {
+            return ChangeStatusAsync(id, "pause", _recurringWithdrawalService.PauseAsync);
+        }
+
+        /// <summary>
+        /// Resumes a paused recurring withdrawal from its next occurrence
+        /// </summary>
+        /// <param name="id">Recurring withdrawal ID</param>
+        /// <returns>The active recurring withdrawal</returns>
+        [HttpPost("{id}/resume")]
+        [ApiScope(ApiScopes.GasBankWithdraw)]
+        public Task<IActionResult> ResumeRecurringWithdrawal(Guid id)
+        {
+            return ChangeStatusAsync(id, "resume", _recurringWithdrawalService.ResumeAsync);
+        }
+
+        /// <summary>
+        /// Cancels a recurring withdrawal; withdrawals already made are not affected
+        /// </summary>
+        /// <param name="id">Recurring withdrawal ID</param>
+        /// <returns>The cancelled recurring withdrawal</returns>
+        [HttpDelete("{id}")]
+        [ApiScope(ApiScopes.GasBankWithdraw)]
+        public Task<IActionResult> CancelRecurringWithdrawal(Guid id)
+        {
+            return ChangeStatusAsync(id, "cancel", _recurringWithdrawalService.CancelAsync);
+        }
+
+        private async Task<IActionResult> ChangeStatusAsync(Guid id, string action, Func<Guid, Guid, Task<GasRecurringWithdrawal>> change)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Recurring withdrawal {Action} requested: {Id} by user: {UserId}", action, id, userId);
+
+            try
+            {
+                return Ok(await change(id, accountId));
+            }
+            catch (Exception ex) when (ex is ValidationException || ex is GasBankException)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error handling recurring withdrawal {Action}: {Id} for user: {UserId}", action, id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
@@ -6,6 +6,7 @@ using Microsoft.AspNetCore.Authorization;
 using Microsoft.AspNetCore.Mvc;
 using Microsoft.Extensions.Logging;
 using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
 using NeoServiceLayer.Services.Storage.CircuitBreaker;
 
 namespace NeoServiceLayer.Api.Controllers
@@ -20,6 +21,8 @@ namespace NeoServiceLayer.Api.Controllers
         private readonly ILogger<HealthCheckController> _logger;
         private readonly IDatabaseService _databaseService;
         private readonly CircuitBreakerFactory _circuitBreakerFactory;
+        private readonly INeoRpcClient _neoRpcClient;
+        private readonly IServiceRunner _serviceRunner;
 
         /// <summary>
         /// Initializes a new instance of the <see cref="HealthCheckController"/> class
@@ -27,14 +30,20 @@ namespace NeoServiceLayer.Api.Controllers
         /// <param name="logger">Logger</param>
         /// <param name="databaseService">Database service</param>
         /// <param name="circuitBreakerFactory">Circuit breaker factory</param>
+        /// <param name="neoRpcClient">Neo RPC client, whose nodes are reported</param>
+        /// <param name="serviceRunner">Service runner, whose background services are reported</param>
         public HealthCheckController(
             ILogger<HealthCheckController> logger,
             IDatabaseService databaseService,
-            CircuitBreakerFactory circuitBreakerFactory)
+            CircuitBreakerFactory circuitBreakerFactory,
+            INeoRpcClient neoRpcClient = null,
+            IServiceRunner serviceRunner = null)
         {
             _logger = logger;
             _databaseService = databaseService;
             _circuitBreakerFactory = circuitBreakerFactory;
+            _neoRpcClient = neoRpcClient;
+            _serviceRunner = serviceRunner;
         }
 
         /// <summary>
@@ -139,6 +148,28 @@ namespace NeoServiceLayer.Api.Controllers
 
                 result.Services["CircuitBreakers"] = circuitBreakerStatus;
 
+                // Check Neo nodes; the client fails over while at least one is in rotation
+                if (_neoRpcClient != null)
+                {
+                    var nodes = _neoRpcClient.GetNodeStatuses();
+                    result.Services["NeoNodes"] = new ServiceHealthStatus
+                    {
+                        Status = nodes.All(n => n.IsHealthy) ? "Healthy" : nodes.Any(n => n.IsHealthy) ? "Degraded" : "Unhealthy",
+                        Components = nodes.ToDictionary(n => n.Url, n => n.IsHealthy ? "Healthy" : "Unhealthy")
+                    };
+                }
+
+                // Check background services; one that is stopped while the API serves requests failed to start
+                if (_serviceRunner != null)
+                {
+                    var backgroundServices = _serviceRunner.GetHealth();
+                    result.Services["BackgroundServices"] = new ServiceHealthStatus
+                    {
+                        Status = backgroundServices.Any(s => s.Status == ManagedServiceStatus.Stopped) ? "Degraded" : "Healthy",
+                        Components = backgroundServices.ToDictionary(s => s.Name, s => s.Status.ToString())
+                    };
+                }
+
                 // If any service is unhealthy, mark the overall status as unhealthy
                 if (result.Services.Values.Any(v => v.Status == "Unhealthy"))
                 {
@@ -158,6 +189,22 @@ namespace NeoServiceLayer.Api.Controllers
             }
         }
 
+        /// <summary>
+        /// Gets the health, latency and error rate of each Neo node the RPC client uses
+        /// </summary>
+        /// <returns>The node statuses</returns>
+        [HttpGet("neo-nodes")]
+        [Authorize(Roles = "Admin")]
+        public IActionResult GetNeoNodes()
+        {
+            if (_neoRpcClient == null)
+            {
+                return StatusCode(503, new { Message = "The Neo RPC client is not available" });
+            }
+
+            return Ok(_neoRpcClient.GetNodeStatuses());
+        }
+
         /// <summary>
         /// Resets all circuit breakers
         /// </summary>
@@ -0,0 +1,142 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for account owners to consent to support access and review what support viewed
+    /// </summary>
+    [ApiController]
+    [Route("api/account/impersonation")]
+    [Authorize]
+    public class ImpersonationController : ControllerBase
+    {
+        private readonly ILogger<ImpersonationController> _logger;
+        private readonly IImpersonationService _impersonationService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ImpersonationController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="impersonationService">Impersonation service</param>
+        public ImpersonationController(ILogger<ImpersonationController> logger, IImpersonationService impersonationService)
+        {
+            _logger = logger;
+            _impersonationService = impersonationService;
+        }
+
+        /// <summary>
+        /// Consents to a support user viewing the caller's functions, executions and triggers
+        /// </summary>
+        /// <param name="request">Consent request</param>
+        /// <returns>The grant</returns>
+        [HttpPost("grants")]
+        public async Task<IActionResult> GrantConsent([FromBody] GrantImpersonationRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var grant = await _impersonationService.GrantConsentAsync(accountId, request.SupportUserId, request.Reason, request.DurationMinutes);
+                return Ok(grant);
+            }
+            catch (ArgumentException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error granting impersonation consent, user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the consents and break-glass approvals given for the caller's account
+        /// </summary>
+        /// <returns>The grants, newest first</returns>
+        [HttpGet("grants")]
+        public async Task<IActionResult> GetGrants()
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                return Ok(await _impersonationService.GetGrantsAsync(accountId));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting impersonation grants, user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Revokes a grant for the caller's account, ending any impersonation under it
+        /// </summary>
+        /// <param name="id">Grant ID</param>
+        /// <returns>The revoked grant</returns>
+        [HttpDelete("grants/{id}")]
+        public async Task<IActionResult> RevokeGrant(Guid id)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                return Ok(await _impersonationService.RevokeAsync(id, userId, accountId));
+            }
+            catch (ResourceNotFoundException)
+            {
+                return NotFound(new { Message = "Grant not found" });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error revoking impersonation grant {Id}, user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the requests support users made while viewing the caller's account
+        /// </summary>
+        /// <param name="since">Only requests at or after this time</param>
+        /// <returns>The audit entries, newest first</returns>
+        [HttpGet("audit")]
+        public async Task<IActionResult> GetAudit([FromQuery] DateTime? since = null)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                return Ok(await _impersonationService.GetAuditAsync(accountId, since));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting impersonation audit, user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
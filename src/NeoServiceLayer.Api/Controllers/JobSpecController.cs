@@ -0,0 +1,83 @@
+using System;
+using System.Security.Claims;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for importing Chainlink job specs
+    /// </summary>
+    [ApiController]
+    [Route("api/jobspecs")]
+    [Authorize]
+    public class JobSpecController : ControllerBase
+    {
+        private readonly ILogger<JobSpecController> _logger;
+        private readonly IJobSpecImportService _jobSpecImportService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="JobSpecController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="jobSpecImportService">Job spec import service</param>
+        public JobSpecController(ILogger<JobSpecController> logger, IJobSpecImportService jobSpecImportService)
+        {
+            _logger = logger;
+            _jobSpecImportService = jobSpecImportService;
+        }
+
+        /// <summary>
+        /// Imports a Chainlink cron, webhook or keeper job spec as a function with a trigger, or as an upkeep
+        /// </summary>
+        /// <param name="request">Import request</param>
+        /// <returns>What the spec was converted into, with the parts that need porting by hand</returns>
+        [HttpPost("import")]
+        public async Task<IActionResult> Import([FromBody] ImportJobSpecRequest request)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Importing job spec for user: {UserId}, dry run: {DryRun}", userId, request.DryRun);
+
+            try
+            {
+                var result = await _jobSpecImportService.ImportAsync(accountId, request.Spec, new JobSpecImportOptions
+                {
+                    DryRun = request.DryRun,
+                    Name = request.Name,
+                    ContractHash = request.ContractHash,
+                    GasBankAccountId = request.GasBankAccountId
+                });
+
+                return result.Created ? StatusCode(201, result) : Ok(result);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error importing job spec for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
@@ -0,0 +1,64 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for the user-facing maintenance status
+    /// </summary>
+    [ApiController]
+    [Route("api/maintenance")]
+    public class MaintenanceController : ControllerBase
+    {
+        private readonly ILogger<MaintenanceController> _logger;
+        private readonly IMaintenanceService _maintenanceService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="MaintenanceController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="maintenanceService">Maintenance service</param>
+        public MaintenanceController(ILogger<MaintenanceController> logger, IMaintenanceService maintenanceService)
+        {
+            _logger = logger;
+            _maintenanceService = maintenanceService;
+        }
+
+        /// <summary>
+        /// Gets the current maintenance status and upcoming maintenance windows
+        /// </summary>
+        /// <returns>Maintenance status</returns>
+        [HttpGet("status")]
+        [AllowAnonymous]
+        public async Task<IActionResult> GetStatus()
+        {
+            try
+            {
+                var status = await _maintenanceService.GetStatusAsync();
+
+                return Ok(new
+                {
+                    status.IsActive,
+                    status.Reason,
+                    status.StartedAt,
+                    status.ExpectedEndTime,
+                    UpcomingWindows = status.UpcomingWindows.ConvertAll(w => new
+                    {
+                        w.StartTime,
+                        w.EndTime,
+                        w.Reason
+                    })
+                });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting maintenance status");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
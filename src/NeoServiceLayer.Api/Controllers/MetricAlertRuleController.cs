@@ -0,0 +1,223 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for user-defined metric alert rules
+    /// </summary>
+    [ApiController]
+    [Route("api/metrics/alert-rules")]
+    [Authorize]
+    public class MetricAlertRuleController : ControllerBase
+    {
+        private readonly ILogger<MetricAlertRuleController> _logger;
+        private readonly IMetricsService _metricsService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="MetricAlertRuleController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="metricsService">Metrics service</param>
+        public MetricAlertRuleController(ILogger<MetricAlertRuleController> logger, IMetricsService metricsService)
+        {
+            _logger = logger;
+            _metricsService = metricsService;
+        }
+
+        /// <summary>
+        /// Gets the alert rules of the authenticated account
+        /// </summary>
+        /// <returns>List of alert rules</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetAlertRules()
+        {
+            _logger.LogInformation("Getting metric alert rules for authenticated user");
+
+            try
+            {
+                var accountId = GetAccountId();
+                if (accountId == Guid.Empty)
+                {
+                    return Unauthorized(new { Message = "Invalid account ID" });
+                }
+
+                var rules = await _metricsService.GetAlertRulesByAccountAsync(accountId);
+                return Ok(rules);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting metric alert rules for authenticated user");
+                return StatusCode(500, new { Message = "An error occurred while getting alert rules" });
+            }
+        }
+
+        /// <summary>
+        /// Gets an alert rule by ID
+        /// </summary>
+        /// <param name="id">Rule ID</param>
+        /// <returns>Alert rule</returns>
+        [HttpGet("{id}")]
+        public async Task<IActionResult> GetAlertRule(Guid id)
+        {
+            _logger.LogInformation("Getting metric alert rule: {Id}", id);
+
+            try
+            {
+                var rule = await _metricsService.GetAlertRuleAsync(id);
+                if (rule == null)
+                {
+                    return NotFound(new { Message = "Alert rule not found" });
+                }
+
+                if (rule.AccountId != GetAccountId() && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(rule);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting metric alert rule: {Id}", id);
+                return StatusCode(500, new { Message = "An error occurred while getting the alert rule" });
+            }
+        }
+
+        /// <summary>
+        /// Creates an alert rule
+        /// </summary>
+        /// <param name="rule">Rule to create</param>
+        /// <returns>Created alert rule</returns>
+        [HttpPost]
+        public async Task<IActionResult> CreateAlertRule([FromBody] MetricAlertRule rule)
+        {
+            _logger.LogInformation("Creating metric alert rule: {Name}", rule?.Name);
+
+            try
+            {
+                var accountId = GetAccountId();
+                if (accountId == Guid.Empty)
+                {
+                    return Unauthorized(new { Message = "Invalid account ID" });
+                }
+
+                rule.AccountId = accountId;
+
+                var createdRule = await _metricsService.CreateAlertRuleAsync(rule);
+                return CreatedAtAction(nameof(GetAlertRule), new { id = createdRule.Id }, createdRule);
+            }
+            catch (ArgumentException ex)
+            {
+                _logger.LogWarning(ex, "Invalid alert rule data: {Message}", ex.Message);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error creating metric alert rule: {Name}", rule?.Name);
+                return StatusCode(500, new { Message = "An error occurred while creating the alert rule" });
+            }
+        }
+
+        /// <summary>
+        /// Updates an alert rule
+        /// </summary>
+        /// <param name="id">Rule ID</param>
+        /// <param name="rule">Updated rule</param>
+        /// <returns>Updated alert rule</returns>
+        [HttpPut("{id}")]
+        public async Task<IActionResult> UpdateAlertRule(Guid id, [FromBody] MetricAlertRule rule)
+        {
+            _logger.LogInformation("Updating metric alert rule: {Id}", id);
+
+            try
+            {
+                var existingRule = await _metricsService.GetAlertRuleAsync(id);
+                if (existingRule == null)
+                {
+                    return NotFound(new { Message = "Alert rule not found" });
+                }
+
+                if (existingRule.AccountId != GetAccountId() && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                rule.Id = id;
+
+                var updatedRule = await _metricsService.UpdateAlertRuleAsync(rule);
+                return Ok(updatedRule);
+            }
+            catch (ArgumentException ex)
+            {
+                _logger.LogWarning(ex, "Invalid alert rule data: {Message}", ex.Message);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error updating metric alert rule: {Id}", id);
+                return StatusCode(500, new { Message = "An error occurred while updating the alert rule" });
+            }
+        }
+
+        /// <summary>
+        /// Deletes an alert rule
+        /// </summary>
+        /// <param name="id">Rule ID</param>
+        /// <returns>Success status</returns>
+        [HttpDelete("{id}")]
+        public async Task<IActionResult> DeleteAlertRule(Guid id)
+        {
+            _logger.LogInformation("Deleting metric alert rule: {Id}", id);
+
+            try
+            {
+                var existingRule = await _metricsService.GetAlertRuleAsync(id);
+                if (existingRule == null)
+                {
+                    return NotFound(new { Message = "Alert rule not found" });
+                }
+
+                if (existingRule.AccountId != GetAccountId() && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                var success = await _metricsService.DeleteAlertRuleAsync(id);
+                if (success)
+                {
+                    return Ok(new { Message = "Alert rule deleted successfully" });
+                }
+                else
+                {
+                    return BadRequest(new { Message = "Failed to delete alert rule" });
+                }
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error deleting metric alert rule: {Id}", id);
+                return StatusCode(500, new { Message = "An error occurred while deleting the alert rule" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the account ID from the authenticated user
+        /// </summary>
+        /// <returns>Account ID</returns>
+        private Guid GetAccountId()
+        {
+            var accountIdClaim = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(accountIdClaim) || !Guid.TryParse(accountIdClaim, out var accountId))
+            {
+                return Guid.Empty;
+            }
+
+            return accountId;
+        }
+    }
+}
@@ -0,0 +1,332 @@
+using System;
+using System.Security.Claims;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for contract invocations sent from multi-signature accounts
+    /// </summary>
+    [ApiController]
+    [Route("api/wallet/multisig")]
+    [Authorize]
+    public class MultiSigController : ControllerBase
+    {
+        private readonly ILogger<MultiSigController> _logger;
+        private readonly IMultiSigService _multiSigService;
+        private readonly IWalletService _walletService;
+        private readonly IMaintenanceService _maintenanceService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="MultiSigController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="multiSigService">Multi-signature service</param>
+        /// <param name="walletService">Wallet service</param>
+        /// <param name="maintenanceService">Maintenance service</param>
+        public MultiSigController(
+            ILogger<MultiSigController> logger,
+            IMultiSigService multiSigService,
+            IWalletService walletService,
+            IMaintenanceService maintenanceService)
+        {
+            _logger = logger;
+            _multiSigService = multiSigService;
+            _walletService = walletService;
+            _maintenanceService = maintenanceService;
+        }
+
+        /// <summary>
+        /// Proposes a contract invocation from the multi-signature account of the given keys and threshold
+        /// </summary>
+        /// <param name="request">Proposal request</param>
+        /// <returns>The pending transaction</returns>
+        [HttpPost]
+        public async Task<IActionResult> Propose([FromBody] ProposeMultiSigRequest request)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Proposing multi-signature invocation of {Operation} on {ContractHash} for user: {UserId}",
+                request.Operation, request.ContractHash, userId);
+
+            try
+            {
+                var transaction = await _multiSigService.ProposeAsync(
+                    accountId,
+                    request.Threshold,
+                    request.PublicKeys,
+                    request.ContractHash,
+                    request.Operation,
+                    request.Args,
+                    request.SystemFeeLimit,
+                    request.ExpiresInHours > 0 ? TimeSpan.FromHours(request.ExpiresInHours) : (TimeSpan?)null);
+
+                return CreatedAtAction(nameof(GetTransaction), new { id = transaction.Id }, transaction);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error proposing multi-signature invocation for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the multi-signature transactions the current user proposed
+        /// </summary>
+        /// <returns>List of transactions, newest first</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetTransactions()
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                return Ok(await _multiSigService.GetByAccountIdAsync(accountId));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting multi-signature transactions for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the pending multi-signature transactions the current user's wallets can sign
+        /// </summary>
+        /// <returns>List of transactions, oldest first</returns>
+        [HttpGet("awaiting")]
+        public async Task<IActionResult> GetAwaitingSignature()
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                return Ok(await _multiSigService.GetAwaitingSignatureAsync(accountId));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting multi-signature transactions awaiting user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets a multi-signature transaction
+        /// </summary>
+        /// <param name="id">Transaction ID</param>
+        /// <returns>The transaction</returns>
+        [HttpGet("{id}")]
+        public async Task<IActionResult> GetTransaction(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var transaction = await _multiSigService.GetByIdAsync(id);
+                if (transaction == null)
+                {
+                    return NotFound(new { Message = "Multi-signature transaction not found" });
+                }
+
+                // The proposer and the holders of the signers' keys can see the transaction
+                if (!User.IsInRole("Admin") && !await _multiSigService.IsParticipantAsync(transaction, accountId))
+                {
+                    return Forbid();
+                }
+
+                return Ok(transaction);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting multi-signature transaction: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Signs a multi-signature transaction with one of the current user's wallets
+        /// </summary>
+        /// <param name="id">Transaction ID</param>
+        /// <param name="request">Signing request</param>
+        /// <returns>The transaction, sent if this signature reached the threshold</returns>
+        [HttpPost("{id}/sign")]
+        public async Task<IActionResult> Sign(Guid id, [FromBody] SignMultiSigRequest request)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Signing multi-signature transaction: {Id} with wallet: {WalletId} for user: {UserId}", id, request.WalletId, userId);
+
+            try
+            {
+                var wallet = await _walletService.GetByIdAsync(request.WalletId);
+                if (wallet == null)
+                {
+                    return NotFound(new { Message = "Wallet not found" });
+                }
+
+                // Only the owner of a wallet can sign with it
+                if (wallet.AccountId != accountId)
+                {
+                    return Forbid();
+                }
+
+                // The signature that reaches the threshold sends the transaction, which maintenance blocks
+                var maintenance = await _maintenanceService.GetStatusAsync();
+                if (maintenance.IsActive)
+                {
+                    return MaintenanceUnavailable(maintenance);
+                }
+
+                return Ok(await _multiSigService.SignAsync(id, request.WalletId, request.Password));
+            }
+            catch (Exception ex) when (ex is ValidationException || ex is WalletException)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error signing multi-signature transaction: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Retries sending a multi-signature transaction that failed to send
+        /// </summary>
+        /// <param name="id">Transaction ID</param>
+        /// <returns>The transaction</returns>
+        [HttpPost("{id}/retry")]
+        public async Task<IActionResult> Retry(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var transaction = await _multiSigService.GetByIdAsync(id);
+                if (transaction == null)
+                {
+                    return NotFound(new { Message = "Multi-signature transaction not found" });
+                }
+
+                if (!User.IsInRole("Admin") && !await _multiSigService.IsParticipantAsync(transaction, accountId))
+                {
+                    return Forbid();
+                }
+
+                var maintenance = await _maintenanceService.GetStatusAsync();
+                if (maintenance.IsActive)
+                {
+                    return MaintenanceUnavailable(maintenance);
+                }
+
+                return Ok(await _multiSigService.RetrySendAsync(id));
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error retrying multi-signature transaction: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Withdraws a multi-signature transaction that has not been sent
+        /// </summary>
+        /// <param name="id">Transaction ID</param>
+        /// <returns>The cancelled transaction</returns>
+        [HttpPost("{id}/cancel")]
+        public async Task<IActionResult> Cancel(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var transaction = await _multiSigService.GetByIdAsync(id);
+                if (transaction == null)
+                {
+                    return NotFound(new { Message = "Multi-signature transaction not found" });
+                }
+
+                // Only the proposer can withdraw a proposal
+                if (transaction.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(await _multiSigService.CancelAsync(id));
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error cancelling multi-signature transaction: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        private IActionResult MaintenanceUnavailable(Core.Models.MaintenanceStatus maintenance)
+        {
+            Response.Headers["Retry-After"] = maintenance.RetryAfterSeconds.ToString();
+            return StatusCode(503, new
+            {
+                Message = "Withdrawals are paused during maintenance",
+                Maintenance = new { maintenance.Reason, maintenance.ExpectedEndTime }
+            });
+        }
+    }
+}
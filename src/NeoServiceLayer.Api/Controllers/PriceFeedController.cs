@@ -1,5 +1,7 @@
 using System;
 using System.Collections.Generic;
+using System.Linq;
+using System.Security.Claims;
 using System.Threading.Tasks;
 using Microsoft.AspNetCore.Authorization;
 using Microsoft.AspNetCore.Mvc;
@@ -8,6 +10,7 @@ using NeoServiceLayer.Api.Models;
 using NeoServiceLayer.Core.Exceptions;
 using NeoServiceLayer.Core.Interfaces;
 using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
 
 namespace NeoServiceLayer.Api.Controllers
 {
@@ -20,16 +23,34 @@ namespace NeoServiceLayer.Api.Controllers
     {
         private readonly ILogger<PriceFeedController> _logger;
         private readonly IPriceFeedService _priceFeedService;
+        private readonly IPriceFeedAccessService _accessService;
+        private readonly IPriceAggregationService _aggregationService;
+        private readonly IPricePublicationService _publicationService;
+        private readonly IGasPriceOracleService _gasPriceOracle;
 
         /// <summary>
         /// Initializes a new instance of the <see cref="PriceFeedController"/> class
         /// </summary>
         /// <param name="logger">Logger</param>
         /// <param name="priceFeedService">Price feed service</param>
-        public PriceFeedController(ILogger<PriceFeedController> logger, IPriceFeedService priceFeedService)
+        /// <param name="accessService">Price feed tier access service</param>
+        /// <param name="aggregationService">Price source aggregation service</param>
+        /// <param name="publicationService">Deviation and heartbeat price publication service</param>
+        /// <param name="gasPriceOracle">Cross-chain gas price oracle</param>
+        public PriceFeedController(
+            ILogger<PriceFeedController> logger,
+            IPriceFeedService priceFeedService,
+            IPriceFeedAccessService accessService = null,
+            IPriceAggregationService aggregationService = null,
+            IPricePublicationService publicationService = null,
+            IGasPriceOracleService gasPriceOracle = null)
         {
             _logger = logger;
             _priceFeedService = priceFeedService;
+            _accessService = accessService;
+            _aggregationService = aggregationService;
+            _publicationService = publicationService;
+            _gasPriceOracle = gasPriceOracle;
         }
 
         /// <summary>
@@ -45,6 +66,12 @@ namespace NeoServiceLayer.Api.Controllers
 
             try
             {
+                var denied = await CheckSymbolAccessAsync(symbol);
+                if (denied != null)
+                {
+                    return denied;
+                }
+
                 var price = await _priceFeedService.GetLatestPriceAsync(symbol, baseCurrency);
                 if (price == null)
                 {
@@ -78,6 +105,15 @@ namespace NeoServiceLayer.Api.Controllers
             try
             {
                 var prices = await _priceFeedService.GetAllLatestPricesAsync(baseCurrency);
+                if (_accessService != null && prices != null)
+                {
+                    var deniedSymbols = await _accessService.GetDeniedSymbolsAsync(GetCallerId());
+                    foreach (var symbol in deniedSymbols)
+                    {
+                        prices.Remove(prices.Keys.FirstOrDefault(k => string.Equals(k, symbol, StringComparison.OrdinalIgnoreCase)) ?? symbol);
+                    }
+                }
+
                 return Ok(prices);
             }
             catch (PriceFeedException ex)
@@ -115,6 +151,12 @@ namespace NeoServiceLayer.Api.Controllers
 
             try
             {
+                var denied = await CheckSymbolAccessAsync(symbol);
+                if (denied != null)
+                {
+                    return denied;
+                }
+
                 var prices = await _priceFeedService.GetHistoricalPricesAsync(symbol, baseCurrency, start, end);
                 return Ok(prices);
             }
@@ -155,6 +197,12 @@ namespace NeoServiceLayer.Api.Controllers
 
             try
             {
+                var denied = await CheckSymbolAccessAsync(symbol);
+                if (denied != null)
+                {
+                    return denied;
+                }
+
                 var history = await _priceFeedService.GetPriceHistoryAsync(symbol, baseCurrency, interval, start, end);
                 if (history == null || history.DataPoints.Count == 0)
                 {
@@ -227,6 +275,84 @@ namespace NeoServiceLayer.Api.Controllers
             }
         }
 
+        /// <summary>
+        /// Gets the fee level of Neo and every configured external chain
+        /// </summary>
+        /// <returns>Fee levels, with the USD cost of a plain transfer on each chain</returns>
+        [HttpGet("gas-prices")]
+        public async Task<IActionResult> GetGasPrices()
+        {
+            if (_gasPriceOracle == null)
+            {
+                return StatusCode(503, new { Message = "The gas price oracle is not enabled" });
+            }
+
+            try
+            {
+                return Ok(await _gasPriceOracle.GetGasPricesAsync());
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error getting gas prices");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the chain where a plain transfer currently costs the fewest USD
+        /// </summary>
+        /// <param name="chains">Comma-separated chains to choose from; every chain when omitted</param>
+        /// <returns>The cheapest chain's fee level</returns>
+        [HttpGet("gas-prices/cheapest")]
+        public async Task<IActionResult> GetCheapestChain([FromQuery] string chains = null)
+        {
+            if (_gasPriceOracle == null)
+            {
+                return StatusCode(503, new { Message = "The gas price oracle is not enabled" });
+            }
+
+            try
+            {
+                var candidates = chains?.Split(',', StringSplitOptions.RemoveEmptyEntries | StringSplitOptions.TrimEntries);
+                var cheapest = await _gasPriceOracle.GetCheapestChainAsync(candidates);
+                if (cheapest == null)
+                {
+                    return NotFound(new { Message = "No chain has a fresh gas price with a USD cost" });
+                }
+
+                return Ok(cheapest);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error getting the cheapest chain");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Refreshes the fee level of every chain now
+        /// </summary>
+        /// <returns>The fee levels read</returns>
+        [HttpPost("gas-prices/refresh")]
+        [Authorize(Roles = "Admin")]
+        public async Task<IActionResult> RefreshGasPrices()
+        {
+            if (_gasPriceOracle == null)
+            {
+                return StatusCode(503, new { Message = "The gas price oracle is not enabled" });
+            }
+
+            try
+            {
+                return Ok(await _gasPriceOracle.RefreshAsync());
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error refreshing gas prices");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
         /// <summary>
         /// Adds a new price source
         /// </summary>
@@ -467,6 +593,139 @@ namespace NeoServiceLayer.Api.Controllers
             }
         }
 
+        /// <summary>
+        /// Aggregates a price from the configured HTTP price sources
+        /// </summary>
+        /// <param name="symbol">Symbol to aggregate</param>
+        /// <param name="baseCurrency">Base currency for the price</param>
+        /// <param name="publish">Whether to store the aggregated price and publish it to the oracle contract</param>
+        /// <returns>The aggregated price with what each source reported</returns>
+        /// <remarks>
+        /// Stale prices and prices too far from the median are rejected. With <paramref name="publish"/> set, the
+        /// request fails and nothing is published unless the configured minimum number of sources agree.
+        /// </remarks>
+        [HttpPost("aggregate/{symbol}")]
+        [Authorize(Roles = "Admin")]
+        public async Task<IActionResult> AggregatePrice(string symbol, [FromQuery] string baseCurrency = "USD", [FromQuery] bool publish = false)
+        {
+            if (_aggregationService == null)
+            {
+                return StatusCode(503, new { Message = "Price source aggregation is not enabled" });
+            }
+
+            _logger.LogInformation("Aggregating price for symbol: {Symbol}, base currency: {BaseCurrency}, publish: {Publish}", symbol, baseCurrency, publish);
+
+            try
+            {
+                var aggregation = publish
+                    ? await _aggregationService.AggregateAndPublishAsync(symbol, baseCurrency)
+                    : await _aggregationService.AggregateAsync(symbol, baseCurrency);
+                return Ok(aggregation);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (PriceFeedException ex)
+            {
+                _logger.LogError(ex, "Error aggregating price for symbol: {Symbol}", symbol);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error aggregating price for symbol: {Symbol}", symbol);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the health of each price source, built from its fetches during aggregation
+        /// </summary>
+        /// <returns>Source health, by source name</returns>
+        [HttpGet("sources/health")]
+        [Authorize]
+        public async Task<IActionResult> GetSourceHealth()
+        {
+            if (_aggregationService == null)
+            {
+                return StatusCode(503, new { Message = "Price source aggregation is not enabled" });
+            }
+
+            try
+            {
+                var health = await _aggregationService.GetSourceHealthAsync();
+                return Ok(health);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error getting price source health");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the last on-chain publication of every pair
+        /// </summary>
+        /// <returns>Last publication per pair, with its reason and deviation from the one before</returns>
+        [HttpGet("publications")]
+        [Authorize]
+        public async Task<IActionResult> GetPublications()
+        {
+            if (_publicationService == null)
+            {
+                return StatusCode(503, new { Message = "Price publication is not enabled" });
+            }
+
+            try
+            {
+                var publications = await _publicationService.GetPublicationsAsync();
+                return Ok(publications);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error getting price publications");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Aggregates a pair and publishes it only if it deviated or its heartbeat elapsed
+        /// </summary>
+        /// <param name="symbol">Symbol to check</param>
+        /// <param name="baseCurrency">Base currency for the price</param>
+        /// <returns>What was decided and why</returns>
+        [HttpPost("publications/{symbol}/check")]
+        [Authorize(Roles = "Admin")]
+        public async Task<IActionResult> CheckPublication(string symbol, [FromQuery] string baseCurrency = "USD")
+        {
+            if (_publicationService == null)
+            {
+                return StatusCode(503, new { Message = "Price publication is not enabled" });
+            }
+
+            _logger.LogInformation("Checking price publication for symbol: {Symbol}, base currency: {BaseCurrency}", symbol, baseCurrency);
+
+            try
+            {
+                var result = await _publicationService.PublishIfDueAsync(symbol, baseCurrency);
+                return Ok(result);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (PriceFeedException ex)
+            {
+                _logger.LogError(ex, "Error publishing price for symbol: {Symbol}", symbol);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error publishing price for symbol: {Symbol}", symbol);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
         /// <summary>
         /// Submits a price to the Neo N3 oracle contract
         /// </summary>
@@ -504,5 +763,198 @@ namespace NeoServiceLayer.Api.Controllers
                 return StatusCode(500, new { Message = "An unexpected error occurred" });
             }
         }
+
+        /// <summary>
+        /// Publishes an emergency price round to the Neo N3 oracle contract
+        /// </summary>
+        /// <param name="symbol">Symbol to publish</param>
+        /// <param name="request">Publish request</param>
+        /// <returns>Transaction hash and the published price</returns>
+        /// <remarks>
+        /// Publishes the latest aggregated price, or an explicit value, outside the normal update cycle.
+        /// Every attempt is written to the security audit log with the operator and reason.
+        /// </remarks>
+        [HttpPost("publish/{symbol}")]
+        [Authorize(Roles = "Admin")]
+        public async Task<IActionResult> PublishPrice(string symbol, [FromBody] PublishPriceRequest request)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+
+            _logger.LogWarning("Manual price publish requested by {User} for {Symbol}, {BaseCurrency}: {Reason}",
+                userId, symbol, request.BaseCurrency, request.Reason);
+
+            if (!request.Force)
+            {
+                return BadRequest(new { Message = "Manual publishing must be confirmed by setting Force" });
+            }
+
+            var auditData = new Dictionary<string, object>
+            {
+                ["BaseCurrency"] = request.BaseCurrency,
+                ["Reason"] = request.Reason,
+                ["ExplicitValue"] = request.Value.HasValue
+            };
+
+            try
+            {
+                Price price;
+                if (request.Value.HasValue)
+                {
+                    price = new Price
+                    {
+                        Symbol = symbol,
+                        BaseCurrency = request.BaseCurrency,
+                        Value = request.Value.Value,
+                        Source = "manual",
+                        Timestamp = DateTime.UtcNow,
+                        CreatedAt = DateTime.UtcNow
+                    };
+                }
+                else
+                {
+                    price = await _priceFeedService.GetLatestPriceAsync(symbol, request.BaseCurrency);
+                    if (price == null)
+                    {
+                        return NotFound(new { Message = $"No price found for {symbol}" });
+                    }
+                }
+
+                auditData["Value"] = price.Value;
+
+                var transactionHash = await _priceFeedService.SubmitToOracleAsync(price);
+                auditData["TransactionHash"] = transactionHash;
+
+                LoggingUtility.LogSecurityEvent(_logger, "PricePublishedManually", requestId, userId,
+                    "Price", symbol, "Publish", "Success", auditData);
+
+                return Ok(new { TransactionHash = transactionHash, Price = price });
+            }
+            catch (PriceFeedException ex)
+            {
+                LoggingUtility.LogSecurityEvent(_logger, "PricePublishedManually", requestId, userId,
+                    "Price", symbol, "Publish", "Failed", auditData);
+
+                _logger.LogError(ex, "Error publishing price: {Symbol}", symbol);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                LoggingUtility.LogSecurityEvent(_logger, "PricePublishedManually", requestId, userId,
+                    "Price", symbol, "Publish", "Failed", auditData);
+
+                _logger.LogError(ex, "Unexpected error publishing price: {Symbol}", symbol);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the price feed subscription tiers
+        /// </summary>
+        /// <returns>Tiers from lowest to highest</returns>
+        [HttpGet("tiers")]
+        public IActionResult GetTiers()
+        {
+            if (_accessService == null)
+            {
+                return StatusCode(503, new { Message = "Price feed tiers are not available" });
+            }
+
+            return Ok(_accessService.GetTiers());
+        }
+
+        /// <summary>
+        /// Gets the caller's price feed subscription tier
+        /// </summary>
+        /// <returns>The tier and the premium symbols it cannot read</returns>
+        [HttpGet("tier")]
+        [Authorize]
+        public async Task<IActionResult> GetTier()
+        {
+            if (_accessService == null)
+            {
+                return StatusCode(503, new { Message = "Price feed tiers are not available" });
+            }
+
+            var accountId = GetCallerId();
+            if (accountId == null)
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var tier = await _accessService.GetTierAsync(accountId.Value);
+                var deniedSymbols = await _accessService.GetDeniedSymbolsAsync(accountId);
+                return Ok(new { Tier = tier, DeniedSymbols = deniedSymbols });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error getting price feed tier for account: {AccountId}", accountId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Changes the caller's price feed subscription tier, charging the tier price to the account's credits
+        /// </summary>
+        /// <param name="request">Tier to subscribe to</param>
+        /// <returns>The new tier and remaining credits</returns>
+        [HttpPut("tier")]
+        [Authorize]
+        public async Task<IActionResult> ChangeTier([FromBody] ChangePriceFeedTierRequest request)
+        {
+            if (_accessService == null)
+            {
+                return StatusCode(503, new { Message = "Price feed tiers are not available" });
+            }
+
+            var accountId = GetCallerId();
+            if (accountId == null)
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Changing price feed tier for account {AccountId} to {Tier}", accountId, request?.Tier);
+
+            try
+            {
+                var account = await _accessService.ChangeTierAsync(accountId.Value, request?.Tier);
+                return Ok(new { Tier = account.PriceFeedTier, account.Credits });
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error changing price feed tier for account: {AccountId}", accountId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        private Guid? GetCallerId()
+        {
+            var userIdClaim = User?.FindFirst(ClaimTypes.NameIdentifier);
+            return userIdClaim != null && Guid.TryParse(userIdClaim.Value, out var userId) ? userId : null;
+        }
+
+        /// <summary>
+        /// Returns a 403 result if the caller's tier does not include the symbol, or null if it does
+        /// </summary>
+        private async Task<IActionResult> CheckSymbolAccessAsync(string symbol)
+        {
+            if (_accessService == null || await _accessService.CanAccessAsync(GetCallerId(), symbol))
+            {
+                return null;
+            }
+
+            var requiredTier = _accessService.GetRequiredTier(symbol);
+            return StatusCode(403, new { Message = $"{symbol} requires the {requiredTier} price feed tier", RequiredTier = requiredTier });
+        }
     }
 }
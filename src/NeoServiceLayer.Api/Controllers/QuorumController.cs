@@ -0,0 +1,232 @@
+using System;
+using System.Security.Claims;
+using System.Security.Cryptography;
+using System.Text;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Quorum;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for running critical functions on several independent nodes
+    /// </summary>
+    [ApiController]
+    [Route("api/quorum")]
+    [Authorize]
+    public class QuorumController : ControllerBase
+    {
+        private readonly ILogger<QuorumController> _logger;
+        private readonly IQuorumExecutionService _quorumExecutionService;
+        private readonly IFunctionService _functionService;
+        private readonly QuorumConfiguration _configuration;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="QuorumController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="quorumExecutionService">Quorum execution service</param>
+        /// <param name="functionService">Function service</param>
+        /// <param name="configuration">Quorum configuration</param>
+        public QuorumController(
+            ILogger<QuorumController> logger,
+            IQuorumExecutionService quorumExecutionService,
+            IFunctionService functionService,
+            IOptions<QuorumConfiguration> configuration)
+        {
+            _logger = logger;
+            _quorumExecutionService = quorumExecutionService;
+            _functionService = functionService;
+            _configuration = configuration.Value;
+        }
+
+        /// <summary>
+        /// Sets the quorum policy of a function, so each execution runs on several nodes and only an agreed result is used
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="request">Quorum policy</param>
+        /// <returns>The function's quorum policy</returns>
+        [HttpPut("functions/{functionId}")]
+        public async Task<IActionResult> SetPolicy(Guid functionId, [FromBody] UpdateQuorumPolicyRequest request)
+        {
+            return await UpdatePolicyAsync(functionId, new QuorumPolicy
+            {
+                Nodes = request.Nodes,
+                Threshold = request.Threshold,
+                Comparison = request.Comparison,
+                ResultPath = request.ResultPath,
+                Tolerance = request.Tolerance
+            });
+        }
+
+        /// <summary>
+        /// Removes the quorum policy of a function, so it runs on the local enclave only
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <returns>No content</returns>
+        [HttpDelete("functions/{functionId}")]
+        public async Task<IActionResult> RemovePolicy(Guid functionId)
+        {
+            return await UpdatePolicyAsync(functionId, null);
+        }
+
+        /// <summary>
+        /// Gets the quorum executions of a function, with the result each node returned
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <returns>The function's quorum executions, newest first</returns>
+        [HttpGet("functions/{functionId}/executions")]
+        public async Task<IActionResult> GetExecutions(Guid functionId)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var function = await _functionService.GetByIdAsync(functionId);
+                if (function == null)
+                {
+                    return NotFound(new { Message = "Function not found" });
+                }
+
+                if (function.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(await _quorumExecutionService.GetExecutionsAsync(functionId));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting quorum executions of function: {FunctionId} for user: {UserId}", functionId, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the quorum execution of a function execution
+        /// </summary>
+        /// <param name="executionId">Execution ID</param>
+        /// <returns>The quorum execution</returns>
+        [HttpGet("executions/{executionId}")]
+        public async Task<IActionResult> GetExecution(Guid executionId)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var execution = await _quorumExecutionService.GetByExecutionIdAsync(executionId);
+                if (execution == null)
+                {
+                    return NotFound(new { Message = "Quorum execution not found" });
+                }
+
+                if (execution.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(execution);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting quorum execution: {ExecutionId} for user: {UserId}", executionId, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Runs a peer's quorum execution on this instance's enclave
+        /// </summary>
+        /// <remarks>
+        /// Called by peer instances rather than users, so it is authenticated with the node key instead of a token.
+        /// </remarks>
+        /// <param name="request">Request from the peer</param>
+        /// <returns>This node's result</returns>
+        [HttpPost("node/execute")]
+        [AllowAnonymous]
+        public async Task<IActionResult> ExecuteAsNode([FromBody] QuorumNodeRequest request)
+        {
+            if (!IsPeer())
+            {
+                return Unauthorized(new { Message = "Invalid node key" });
+            }
+
+            try
+            {
+                return Ok(await _quorumExecutionService.ExecuteOnLocalNodeAsync(request));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error running quorum execution {ExecutionId} for a peer", request.ExecutionId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        private async Task<IActionResult> UpdatePolicyAsync(Guid functionId, QuorumPolicy policy)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Updating quorum policy of function: {FunctionId} for user: {UserId}", functionId, userId);
+
+            try
+            {
+                var function = await _functionService.GetByIdAsync(functionId);
+                if (function == null)
+                {
+                    return NotFound(new { Message = "Function not found" });
+                }
+
+                if (function.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                var updated = await _quorumExecutionService.SetPolicyAsync(functionId, policy);
+                return policy == null ? NoContent() : Ok(updated.Quorum);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error updating quorum policy of function: {FunctionId} for user: {UserId}", functionId, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        private bool IsPeer()
+        {
+            var presented = Request.Headers[HttpQuorumNode.NodeKeyHeader].ToString();
+            if (string.IsNullOrEmpty(_configuration.NodeKey) || string.IsNullOrEmpty(presented))
+            {
+                return false;
+            }
+
+            return CryptographicOperations.FixedTimeEquals(Encoding.UTF8.GetBytes(presented), Encoding.UTF8.GetBytes(_configuration.NodeKey));
+        }
+    }
+}
@@ -0,0 +1,450 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Security.Claims;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.API.Filters;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for cron schedule triggers
+    /// </summary>
+    [ApiController]
+    [Route("api/schedules")]
+    [Authorize]
+    public class ScheduleTriggerController : ControllerBase
+    {
+        private readonly ILogger<ScheduleTriggerController> _logger;
+        private readonly IScheduleTriggerService _scheduleTriggerService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ScheduleTriggerController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="scheduleTriggerService">Schedule trigger service</param>
+        public ScheduleTriggerController(ILogger<ScheduleTriggerController> logger, IScheduleTriggerService scheduleTriggerService)
+        {
+            _logger = logger;
+            _scheduleTriggerService = scheduleTriggerService;
+        }
+
+        /// <summary>
+        /// Creates a schedule trigger
+        /// </summary>
+        /// <param name="request">Schedule trigger request</param>
+        /// <returns>The created trigger</returns>
+        [HttpPost]
+        [ApiScope(ApiScopes.TriggersManage)]
+        public async Task<IActionResult> CreateSchedule([FromBody] ScheduleTriggerRequest request)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Creating schedule trigger: {Name} for user: {UserId}", request.Name, userId);
+
+            try
+            {
+                var trigger = await _scheduleTriggerService.CreateAsync(ToTrigger(request, accountId));
+                return CreatedAtAction(nameof(GetSchedule), new { id = trigger.Id }, trigger);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error creating schedule trigger: {Name} for user: {UserId}", request.Name, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the schedule triggers of the current user
+        /// </summary>
+        /// <param name="tag">Only return triggers with all of these tags</param>
+        /// <returns>List of schedule triggers</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetSchedules([FromQuery] string[] tag = null)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var triggers = await _scheduleTriggerService.GetByAccountIdAsync(accountId);
+                return Ok(triggers.Where(t => t.Tags.HasAllTags(tag)));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting schedule triggers for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets a schedule trigger by ID
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>The trigger</returns>
+        [HttpGet("{id}")]
+        public async Task<IActionResult> GetSchedule(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var trigger = await _scheduleTriggerService.GetByIdAsync(id);
+                if (trigger == null)
+                {
+                    return NotFound(new { Message = "Schedule trigger not found" });
+                }
+
+                if (trigger.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                this.SetEntityTag(trigger.Revision);
+                return Ok(trigger);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting schedule trigger: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Updates a schedule trigger
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <param name="request">Schedule trigger request</param>
+        /// <returns>The updated trigger</returns>
+        [HttpPut("{id}")]
+        [ApiScope(ApiScopes.TriggersManage)]
+        public async Task<IActionResult> UpdateSchedule(Guid id, [FromBody] ScheduleTriggerRequest request)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var existing = await _scheduleTriggerService.GetByIdAsync(id);
+                if (existing == null)
+                {
+                    return NotFound(new { Message = "Schedule trigger not found" });
+                }
+
+                if (existing.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                if (!this.IfMatch(existing.Revision))
+                {
+                    return this.RevisionConflict("schedule trigger", existing.Revision);
+                }
+
+                var trigger = ToTrigger(request, existing.AccountId);
+                trigger.Id = id;
+                trigger.Revision = existing.Revision;
+
+                var updated = await _scheduleTriggerService.UpdateAsync(trigger);
+                this.SetEntityTag(updated.Revision);
+                return Ok(updated);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ConcurrencyConflictException ex)
+            {
+                return this.RevisionConflict("schedule trigger", ex.CurrentRevision);
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error updating schedule trigger: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Deletes a schedule trigger
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>No content</returns>
+        [HttpDelete("{id}")]
+        [ApiScope(ApiScopes.TriggersManage)]
+        public async Task<IActionResult> DeleteSchedule(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var existing = await _scheduleTriggerService.GetByIdAsync(id);
+                if (existing == null)
+                {
+                    return NotFound(new { Message = "Schedule trigger not found" });
+                }
+
+                if (existing.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                await _scheduleTriggerService.DeleteAsync(id);
+                return NoContent();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error deleting schedule trigger: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets a page of a schedule trigger's firing history, newest first
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <param name="status">Status the firings must have</param>
+        /// <param name="from">Earliest scheduled fire time to include</param>
+        /// <param name="to">Scheduled fire time to stop before</param>
+        /// <param name="cursor">Cursor returned with the previous page</param>
+        /// <param name="limit">Maximum number of firings</param>
+        /// <returns>The firings and the cursor of the next page</returns>
+        [HttpGet("{id}/firings")]
+        public async Task<IActionResult> GetFirings(
+            Guid id,
+            [FromQuery] TriggerFiringStatus? status = null,
+            [FromQuery] DateTime? from = null,
+            [FromQuery] DateTime? to = null,
+            [FromQuery] string cursor = null,
+            [FromQuery] int limit = 50)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var trigger = await _scheduleTriggerService.GetByIdAsync(id);
+                if (trigger == null)
+                {
+                    return NotFound(new { Message = "Schedule trigger not found" });
+                }
+
+                if (trigger.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                var page = await _scheduleTriggerService.GetFiringsAsync(new TriggerFiringQuery
+                {
+                    TriggerId = id,
+                    Status = status,
+                    From = from?.ToUniversalTime(),
+                    To = to?.ToUniversalTime(),
+                    Cursor = cursor,
+                    Limit = limit
+                });
+                return Ok(page);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting firings of schedule trigger: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the outcome counts of all of a schedule trigger's firings, including those pruned from the history
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>The statistics</returns>
+        [HttpGet("{id}/firings/statistics")]
+        public async Task<IActionResult> GetFiringStatistics(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var trigger = await _scheduleTriggerService.GetByIdAsync(id);
+                if (trigger == null)
+                {
+                    return NotFound(new { Message = "Schedule trigger not found" });
+                }
+
+                if (trigger.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(await _scheduleTriggerService.GetFiringStatisticsAsync(id));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting firing statistics of schedule trigger: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets how late schedule triggers ran on this instance compared with their fire times
+        /// </summary>
+        /// <returns>Lateness statistics</returns>
+        [HttpGet("lateness")]
+        [Authorize(Roles = "Admin")]
+        public async Task<IActionResult> GetLateness()
+        {
+            try
+            {
+                var lateness = await _scheduleTriggerService.GetLatenessAsync();
+                return Ok(lateness);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting schedule trigger lateness");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the scheduler's decisions in a time window from the replay log
+        /// </summary>
+        /// <param name="from">Start of the window, inclusive</param>
+        /// <param name="to">End of the window, exclusive; defaults to now</param>
+        /// <param name="triggerId">Trigger the decisions must be about</param>
+        /// <returns>The decisions, in the order they were made</returns>
+        [HttpGet("decisions")]
+        [Authorize(Roles = "Admin")]
+        public async Task<IActionResult> GetDecisions([FromQuery] DateTime from, [FromQuery] DateTime? to = null, [FromQuery] Guid? triggerId = null)
+        {
+            try
+            {
+                var decisions = await _scheduleTriggerService.GetDecisionsAsync(
+                    from.ToUniversalTime(), to?.ToUniversalTime() ?? DateTime.UtcNow, triggerId);
+                return Ok(decisions);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting scheduler decisions");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Replays the scheduler's decisions in a time window against a set of triggers to find missed and duplicate firings
+        /// </summary>
+        /// <param name="request">Replay request</param>
+        /// <returns>The replay report</returns>
+        [HttpPost("replay")]
+        [Authorize(Roles = "Admin")]
+        public async Task<IActionResult> Replay([FromBody] SchedulerReplayRequest request)
+        {
+            try
+            {
+                IEnumerable<ScheduleTrigger> triggers = request.Triggers;
+                if (triggers == null && request.TriggerIds?.Count > 0)
+                {
+                    var stored = new List<ScheduleTrigger>();
+                    foreach (var id in request.TriggerIds.Distinct())
+                    {
+                        var trigger = await _scheduleTriggerService.GetByIdAsync(id);
+                        if (trigger == null)
+                        {
+                            return NotFound(new { Message = $"Schedule trigger {id} not found" });
+                        }
+
+                        stored.Add(trigger);
+                    }
+
+                    triggers = stored;
+                }
+
+                var report = await _scheduleTriggerService.ReplayAsync(
+                    request.From.ToUniversalTime(), request.To?.ToUniversalTime() ?? DateTime.UtcNow, triggers);
+                return Ok(report);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error replaying scheduler decisions");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        private static ScheduleTrigger ToTrigger(ScheduleTriggerRequest request, Guid accountId)
+        {
+            return new ScheduleTrigger
+            {
+                AccountId = accountId,
+                FunctionId = request.FunctionId,
+                Name = request.Name,
+                Description = request.Description,
+                CronExpression = request.CronExpression,
+                Parameters = request.Parameters ?? new Dictionary<string, object>(),
+                Enabled = request.Enabled,
+                Tags = request.Tags
+            };
+        }
+    }
+}
@@ -1,5 +1,6 @@
 using System;
 using System.Collections.Generic;
+using System.Linq;
 using System.Threading.Tasks;
 using Microsoft.AspNetCore.Authorization;
 using Microsoft.AspNetCore.Mvc;
@@ -7,6 +8,8 @@ using Microsoft.Extensions.Logging;
 using NeoServiceLayer.Api.Models;
 using NeoServiceLayer.Core.Exceptions;
 using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.API.Filters;
+using NeoServiceLayer.Core.Models;
 
 namespace NeoServiceLayer.Api.Controllers
 {
@@ -16,20 +19,31 @@ namespace NeoServiceLayer.Api.Controllers
     [ApiController]
     [Route("api/[controller]")]
     [Authorize]
+    [ApiReadScope(ApiScopes.SecretsRead)]
     public class SecretsController : ControllerBase
     {
         private readonly ILogger<SecretsController> _logger;
         private readonly ISecretsService _secretsService;
+        private readonly ISecretGrantService _grantService;
+        private readonly ISecretTransferService _transferService;
 
         /// <summary>
         /// Initializes a new instance of the <see cref="SecretsController"/> class
         /// </summary>
         /// <param name="logger">Logger</param>
         /// <param name="secretsService">Secrets service</param>
-        public SecretsController(ILogger<SecretsController> logger, ISecretsService secretsService)
+        /// <param name="grantService">Secret grant service</param>
+        /// <param name="transferService">Secret transfer service</param>
+        public SecretsController(
+            ILogger<SecretsController> logger,
+            ISecretsService secretsService,
+            ISecretGrantService grantService = null,
+            ISecretTransferService transferService = null)
         {
             _logger = logger;
             _secretsService = secretsService;
+            _grantService = grantService;
+            _transferService = transferService;
         }
 
         /// <summary>
@@ -38,6 +52,7 @@ namespace NeoServiceLayer.Api.Controllers
         /// <param name="request">Secret creation request</param>
         /// <returns>The created secret</returns>
         [HttpPost]
+        [ApiScope(ApiScopes.SecretsWrite)]
         public async Task<IActionResult> CreateSecret([FromBody] CreateSecretRequest request)
         {
             var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
@@ -81,6 +96,70 @@ namespace NeoServiceLayer.Api.Controllers
             }
         }
 
+        /// <summary>
+        /// Creates or updates multiple secrets atomically
+        /// </summary>
+        /// <param name="request">Bulk secret request</param>
+        /// <returns>Per-item results</returns>
+        [HttpPost("bulk")]
+        [ApiScope(ApiScopes.SecretsWrite)]
+        public async Task<IActionResult> BulkUpsertSecrets([FromBody] BulkSecretsRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Bulk provisioning {Count} secrets for user: {UserId}", request.Secrets?.Count ?? 0, userId);
+
+            try
+            {
+                var items = request.Secrets?.Select(s => s == null ? null : new Core.Models.BulkSecretItem
+                {
+                    Name = s.Name,
+                    Value = s.Value,
+                    Description = s.Description,
+                    AllowedFunctionIds = s.AllowedFunctionIds,
+                    ExpiresAt = s.ExpiresAt
+                }).ToList();
+
+                var result = await _secretsService.BulkUpsertAsync(items, accountId);
+
+                var response = new
+                {
+                    Success = result.Success,
+                    RolledBack = result.RolledBack,
+                    Items = result.Items.Select(i => new
+                    {
+                        Name = i.Name,
+                        Id = i.SecretId,
+                        Action = i.Action,
+                        Version = i.Version,
+                        Success = i.Success,
+                        Error = i.Error
+                    })
+                };
+
+                if (!result.Success)
+                {
+                    return result.RolledBack ? StatusCode(500, response) : BadRequest(response);
+                }
+
+                return Ok(response);
+            }
+            catch (SecretsException ex)
+            {
+                _logger.LogError(ex, "Error bulk provisioning secrets for user: {UserId}", userId);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error bulk provisioning secrets for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
         /// <summary>
         /// Gets all secrets for the current user
         /// </summary>
@@ -155,6 +234,7 @@ namespace NeoServiceLayer.Api.Controllers
                     return Forbid();
                 }
 
+                this.SetEntityTag(secret.Revision);
                 return Ok(new
                 {
                     Id = secret.Id,
@@ -164,7 +244,8 @@ namespace NeoServiceLayer.Api.Controllers
                     AllowedFunctionIds = secret.AllowedFunctionIds,
                     ExpiresAt = secret.ExpiresAt,
                     CreatedAt = secret.CreatedAt,
-                    UpdatedAt = secret.UpdatedAt
+                    UpdatedAt = secret.UpdatedAt,
+                    Revision = secret.Revision
                 });
             }
             catch (Exception ex)
@@ -181,6 +262,7 @@ namespace NeoServiceLayer.Api.Controllers
         /// <param name="request">Update request</param>
         /// <returns>The updated secret</returns>
         [HttpPut("{id}/value")]
+        [ApiScope(ApiScopes.SecretsWrite)]
         public async Task<IActionResult> UpdateSecretValue(Guid id, [FromBody] UpdateSecretValueRequest request)
         {
             var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
@@ -205,7 +287,13 @@ namespace NeoServiceLayer.Api.Controllers
                     return Forbid();
                 }
 
+                if (!this.IfMatch(secret.Revision))
+                {
+                    return this.RevisionConflict("secret", secret.Revision);
+                }
+
                 var updatedSecret = await _secretsService.UpdateValueAsync(id, request.Value);
+                this.SetEntityTag(updatedSecret.Revision);
                 return Ok(new
                 {
                     Id = updatedSecret.Id,
@@ -214,7 +302,8 @@ namespace NeoServiceLayer.Api.Controllers
                     Version = updatedSecret.Version,
                     AllowedFunctionIds = updatedSecret.AllowedFunctionIds,
                     ExpiresAt = updatedSecret.ExpiresAt,
-                    UpdatedAt = updatedSecret.UpdatedAt
+                    UpdatedAt = updatedSecret.UpdatedAt,
+                    Revision = updatedSecret.Revision
                 });
             }
             catch (SecretsException ex)
@@ -236,6 +325,7 @@ namespace NeoServiceLayer.Api.Controllers
         /// <param name="request">Update request</param>
         /// <returns>The updated secret</returns>
         [HttpPut("{id}/functions")]
+        [ApiScope(ApiScopes.SecretsWrite)]
         public async Task<IActionResult> UpdateAllowedFunctions(Guid id, [FromBody] UpdateAllowedFunctionsRequest request)
         {
             var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
@@ -260,7 +350,13 @@ namespace NeoServiceLayer.Api.Controllers
                     return Forbid();
                 }
 
+                if (!this.IfMatch(secret.Revision))
+                {
+                    return this.RevisionConflict("secret", secret.Revision);
+                }
+
                 var updatedSecret = await _secretsService.UpdateAllowedFunctionsAsync(id, request.AllowedFunctionIds);
+                this.SetEntityTag(updatedSecret.Revision);
                 return Ok(new
                 {
                     Id = updatedSecret.Id,
@@ -269,7 +365,8 @@ namespace NeoServiceLayer.Api.Controllers
                     Version = updatedSecret.Version,
                     AllowedFunctionIds = updatedSecret.AllowedFunctionIds,
                     ExpiresAt = updatedSecret.ExpiresAt,
-                    UpdatedAt = updatedSecret.UpdatedAt
+                    UpdatedAt = updatedSecret.UpdatedAt,
+                    Revision = updatedSecret.Revision
                 });
             }
             catch (SecretsException ex)
@@ -291,6 +388,7 @@ namespace NeoServiceLayer.Api.Controllers
         /// <param name="request">Rotation request</param>
         /// <returns>The rotated secret</returns>
         [HttpPost("{id}/rotate")]
+        [ApiScope(ApiScopes.SecretsWrite)]
         public async Task<IActionResult> RotateSecret(Guid id, [FromBody] RotateSecretRequest request)
         {
             var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
@@ -316,6 +414,7 @@ namespace NeoServiceLayer.Api.Controllers
                 }
 
                 var rotatedSecret = await _secretsService.RotateSecretAsync(id, request.NewValue);
+                this.SetEntityTag(rotatedSecret.Revision);
                 return Ok(new
                 {
                     Id = rotatedSecret.Id,
@@ -324,7 +423,8 @@ namespace NeoServiceLayer.Api.Controllers
                     Version = rotatedSecret.Version,
                     AllowedFunctionIds = rotatedSecret.AllowedFunctionIds,
                     ExpiresAt = rotatedSecret.ExpiresAt,
-                    UpdatedAt = rotatedSecret.UpdatedAt
+                    UpdatedAt = rotatedSecret.UpdatedAt,
+                    Revision = rotatedSecret.Revision
                 });
             }
             catch (SecretsException ex)
@@ -345,6 +445,7 @@ namespace NeoServiceLayer.Api.Controllers
         /// <param name="id">Secret ID</param>
         /// <returns>Success status</returns>
         [HttpDelete("{id}")]
+        [ApiScope(ApiScopes.SecretsWrite)]
         public async Task<IActionResult> DeleteSecret(Guid id)
         {
             var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
@@ -384,6 +485,297 @@ namespace NeoServiceLayer.Api.Controllers
             }
         }
 
+        /// <summary>
+        /// Grants a function, or every function of another user, read access to a secret
+        /// </summary>
+        /// <param name="id">Secret ID</param>
+        /// <param name="request">Grant request</param>
+        /// <returns>The grant</returns>
+        [HttpPost("{id}/grants")]
+        [ApiScope(ApiScopes.SecretsWrite)]
+        public async Task<IActionResult> GrantSecret(Guid id, [FromBody] GrantSecretRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            if (_grantService == null)
+            {
+                return StatusCode(503, new { Message = "Secret sharing is not enabled" });
+            }
+
+            if (request.FunctionId.HasValue == !string.IsNullOrWhiteSpace(request.Address))
+            {
+                return BadRequest(new { Message = "Set either functionId or address" });
+            }
+
+            _logger.LogInformation("Granting secret: {SecretId} for user: {UserId}", id, userId);
+
+            try
+            {
+                var secret = await _secretsService.GetByIdAsync(id);
+                if (secret == null)
+                {
+                    return NotFound(new { Message = "Secret not found" });
+                }
+
+                // Only the owner can share a secret
+                if (secret.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                var grant = request.FunctionId.HasValue
+                    ? await _grantService.GrantToFunctionAsync(id, request.FunctionId.Value, request.ExpiresAt)
+                    : await _grantService.GrantToAddressAsync(id, request.Address, request.ExpiresAt);
+
+                return Ok(grant);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error granting secret: {SecretId} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the grants of a secret
+        /// </summary>
+        /// <param name="id">Secret ID</param>
+        /// <returns>The grants, newest first, expired ones included</returns>
+        [HttpGet("{id}/grants")]
+        public async Task<IActionResult> GetSecretGrants(Guid id)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            if (_grantService == null)
+            {
+                return StatusCode(503, new { Message = "Secret sharing is not enabled" });
+            }
+
+            try
+            {
+                var secret = await _secretsService.GetByIdAsync(id);
+                if (secret == null)
+                {
+                    return NotFound(new { Message = "Secret not found" });
+                }
+
+                if (secret.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(await _grantService.GetBySecretIdAsync(id));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting grants of secret: {SecretId} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Revokes a grant of a secret
+        /// </summary>
+        /// <param name="id">Secret ID</param>
+        /// <param name="grantId">Grant ID</param>
+        /// <returns>No content if revoked</returns>
+        [HttpDelete("{id}/grants/{grantId}")]
+        [ApiScope(ApiScopes.SecretsWrite)]
+        public async Task<IActionResult> RevokeSecretGrant(Guid id, Guid grantId)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            if (_grantService == null)
+            {
+                return StatusCode(503, new { Message = "Secret sharing is not enabled" });
+            }
+
+            _logger.LogInformation("Revoking grant: {GrantId} of secret: {SecretId} for user: {UserId}", grantId, id, userId);
+
+            try
+            {
+                var grant = await _grantService.GetByIdAsync(grantId);
+                if (grant == null || grant.SecretId != id)
+                {
+                    return NotFound(new { Message = "Grant not found" });
+                }
+
+                // Only the owner can revoke a grant
+                if (grant.OwnerAccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                await _grantService.RevokeAsync(grantId);
+                return NoContent();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error revoking grant: {GrantId} of secret: {SecretId} for user: {UserId}", grantId, id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the active grants other users gave to the current user or to the user's functions
+        /// </summary>
+        /// <returns>The grants, newest first</returns>
+        [HttpGet("shared")]
+        public async Task<IActionResult> GetSharedSecrets()
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            if (_grantService == null)
+            {
+                return StatusCode(503, new { Message = "Secret sharing is not enabled" });
+            }
+
+            try
+            {
+                return Ok(await _grantService.GetSharedWithAccountAsync(accountId));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting secrets shared with user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the public key other environments export secrets to this environment under
+        /// </summary>
+        /// <returns>The transfer key</returns>
+        [HttpGet("transfer-key")]
+        public async Task<IActionResult> GetTransferKey()
+        {
+            if (_transferService == null)
+            {
+                return StatusCode(503, new { Message = "Secret transfer is not enabled" });
+            }
+
+            try
+            {
+                return Ok(await _transferService.GetTransferKeyAsync());
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting secret transfer key");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Exports the current user's secrets encrypted to another environment's transfer key
+        /// </summary>
+        /// <param name="request">Export request</param>
+        /// <returns>The bundle, which only the target environment's enclave can decrypt</returns>
+        [HttpPost("export")]
+        [ApiScope(ApiScopes.SecretsWrite)]
+        public async Task<IActionResult> ExportSecrets([FromBody] ExportSecretsRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            if (_transferService == null)
+            {
+                return StatusCode(503, new { Message = "Secret transfer is not enabled" });
+            }
+
+            _logger.LogInformation("Exporting secrets for user: {UserId}", userId);
+
+            try
+            {
+                return Ok(await _transferService.ExportAsync(accountId, request?.RecipientPublicKey, request?.Names));
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (SecretsException ex)
+            {
+                _logger.LogError(ex, "Error exporting secrets for user: {UserId}", userId);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error exporting secrets for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Imports secrets exported to this environment, creating or updating them by name
+        /// </summary>
+        /// <param name="request">Import request</param>
+        /// <returns>What was done to each secret</returns>
+        [HttpPost("import")]
+        [ApiScope(ApiScopes.SecretsWrite)]
+        public async Task<IActionResult> ImportSecrets([FromBody] ImportSecretsRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            if (_transferService == null)
+            {
+                return StatusCode(503, new { Message = "Secret transfer is not enabled" });
+            }
+
+            _logger.LogInformation("Importing {Count} secrets for user: {UserId}", request?.Bundle?.Names?.Count ?? 0, userId);
+
+            try
+            {
+                return Ok(await _transferService.ImportAsync(accountId, request?.Bundle, request?.Overwrite ?? false));
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (SecretsException ex)
+            {
+                _logger.LogError(ex, "Error importing secrets for user: {UserId}", userId);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error importing secrets for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
         /// <summary>
         /// Gets secrets accessible by a function
         /// </summary>
@@ -0,0 +1,52 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for reporting what code is serving requests
+    /// </summary>
+    [ApiController]
+    [Route("version")]
+    [AllowAnonymous]
+    public class VersionController : ControllerBase
+    {
+        private readonly ILogger<VersionController> _logger;
+        private readonly IProvenanceService _provenanceService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="VersionController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="provenanceService">Provenance service</param>
+        public VersionController(ILogger<VersionController> logger, IProvenanceService provenanceService)
+        {
+            _logger = logger;
+            _provenanceService = provenanceService;
+        }
+
+        /// <summary>
+        /// Gets the build provenance statement, signed by the enclave when running in a TEE
+        /// </summary>
+        /// <returns>The provenance statement</returns>
+        [HttpGet]
+        [ProducesResponseType(typeof(ProvenanceStatement), 200)]
+        public async Task<IActionResult> Get()
+        {
+            try
+            {
+                return Ok(await _provenanceService.GetStatementAsync());
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting provenance statement");
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
@@ -20,16 +20,19 @@ namespace NeoServiceLayer.Api.Controllers
     {
         private readonly ILogger<WalletController> _logger;
         private readonly IWalletService _walletService;
+        private readonly IMaintenanceService _maintenanceService;
 
         /// <summary>
         /// Initializes a new instance of the <see cref="WalletController"/> class
         /// </summary>
         /// <param name="logger">Logger</param>
         /// <param name="walletService">Wallet service</param>
-        public WalletController(ILogger<WalletController> logger, IWalletService walletService)
+        /// <param name="maintenanceService">Maintenance service</param>
+        public WalletController(ILogger<WalletController> logger, IWalletService walletService, IMaintenanceService maintenanceService)
         {
             _logger = logger;
             _walletService = walletService;
+            _maintenanceService = maintenanceService;
         }
 
         /// <summary>
@@ -331,6 +334,19 @@ namespace NeoServiceLayer.Api.Controllers
                     return Forbid();
                 }
 
+                // Withdrawals are blocked while the platform is in maintenance
+
+                var maintenance = await _maintenanceService.GetStatusAsync();
+
+                if (maintenance.IsActive)
+
+                {
+
+                    return MaintenanceUnavailable(maintenance);
+
+                }
+
+
                 var transactionHash = await _walletService.TransferNeoAsync(id, request.Password, request.ToAddress, request.Amount);
                 return Ok(new { TransactionHash = transactionHash });
             }
@@ -377,6 +393,19 @@ namespace NeoServiceLayer.Api.Controllers
                     return Forbid();
                 }
 
+                // Withdrawals are blocked while the platform is in maintenance
+
+                var maintenance = await _maintenanceService.GetStatusAsync();
+
+                if (maintenance.IsActive)
+
+                {
+
+                    return MaintenanceUnavailable(maintenance);
+
+                }
+
+
                 var transactionHash = await _walletService.TransferGasAsync(id, request.Password, request.ToAddress, request.Amount);
                 return Ok(new { TransactionHash = transactionHash });
             }
@@ -424,6 +453,19 @@ namespace NeoServiceLayer.Api.Controllers
                     return Forbid();
                 }
 
+                // Withdrawals are blocked while the platform is in maintenance
+
+                var maintenance = await _maintenanceService.GetStatusAsync();
+
+                if (maintenance.IsActive)
+
+                {
+
+                    return MaintenanceUnavailable(maintenance);
+
+                }
+
+
                 var transactionHash = await _walletService.TransferTokenAsync(id, request.Password, request.ToAddress, tokenHash, request.Amount);
                 return Ok(new { TransactionHash = transactionHash });
             }
@@ -600,5 +642,15 @@ namespace NeoServiceLayer.Api.Controllers
                 return StatusCode(500, new { Message = "An unexpected error occurred" });
             }
         }
+
+        private IActionResult MaintenanceUnavailable(Core.Models.MaintenanceStatus maintenance)
+        {
+            Response.Headers["Retry-After"] = maintenance.RetryAfterSeconds.ToString();
+            return StatusCode(503, new
+            {
+                Message = "Withdrawals are paused during maintenance",
+                Maintenance = new { maintenance.Reason, maintenance.ExpectedEndTime }
+            });
+        }
     }
 }
@@ -0,0 +1,176 @@
+using System;
+using System.Security.Claims;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.API.Filters;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for the contracts a user watches for upgrades
+    /// </summary>
+    [ApiController]
+    [Route("api/contracts/watched")]
+    [Authorize]
+    public class WatchedContractController : ControllerBase
+    {
+        private readonly ILogger<WatchedContractController> _logger;
+        private readonly IContractWatchService _contractWatchService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="WatchedContractController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="contractWatchService">Contract watch service</param>
+        public WatchedContractController(
+            ILogger<WatchedContractController> logger,
+            IContractWatchService contractWatchService)
+        {
+            _logger = logger;
+            _contractWatchService = contractWatchService;
+        }
+
+        /// <summary>
+        /// Starts watching a contract for the current user
+        /// </summary>
+        /// <param name="request">Watch request</param>
+        /// <returns>The created watch</returns>
+        [HttpPost]
+        [ApiScope(ApiScopes.TriggersManage)]
+        public async Task<IActionResult> WatchContract([FromBody] WatchContractRequest request)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Watching contract: {ContractHash} for user: {UserId}", request.ContractHash, userId);
+
+            try
+            {
+                var watch = await _contractWatchService.WatchAsync(new WatchedContract
+                {
+                    AccountId = accountId,
+                    ContractHash = request.ContractHash,
+                    Label = request.Label,
+                    PauseDependentSubscriptions = request.PauseDependentSubscriptions
+                });
+
+                return CreatedAtAction(nameof(GetWatchedContract), new { id = watch.Id }, watch);
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error watching contract: {ContractHash} for user: {UserId}", request.ContractHash, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets the contracts the current user watches
+        /// </summary>
+        /// <returns>List of watches, newest first</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetWatchedContracts()
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                return Ok(await _contractWatchService.GetByAccountIdAsync(accountId));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting watched contracts for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets a watch with the last change observed
+        /// </summary>
+        /// <param name="id">Watch ID</param>
+        /// <returns>The watch</returns>
+        [HttpGet("{id}")]
+        public async Task<IActionResult> GetWatchedContract(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var watch = await _contractWatchService.GetByIdAsync(id);
+                if (watch == null)
+                {
+                    return NotFound(new { Message = "Watched contract not found" });
+                }
+
+                if (watch.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                return Ok(watch);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting watched contract: {Id}", id);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Stops watching a contract
+        /// </summary>
+        /// <param name="id">Watch ID</param>
+        /// <returns>No content</returns>
+        [HttpDelete("{id}")]
+        [ApiScope(ApiScopes.TriggersManage)]
+        public async Task<IActionResult> UnwatchContract(Guid id)
+        {
+            var userId = User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Unwatching contract: {Id} for user: {UserId}", id, userId);
+
+            try
+            {
+                await _contractWatchService.UnwatchAsync(id, accountId);
+                return NoContent();
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error unwatching contract: {Id} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+    }
+}
@@ -0,0 +1,422 @@
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Api.Models;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.API.Filters;
+
+namespace NeoServiceLayer.Api.Controllers
+{
+    /// <summary>
+    /// Controller for webhook ingestion triggers
+    /// </summary>
+    [ApiController]
+    [Route("api/webhooks")]
+    [Authorize]
+    public class WebhookTriggerController : ControllerBase
+    {
+        private readonly ILogger<WebhookTriggerController> _logger;
+        private readonly IWebhookTriggerService _webhookTriggerService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="WebhookTriggerController"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="webhookTriggerService">Webhook trigger service</param>
+        public WebhookTriggerController(ILogger<WebhookTriggerController> logger, IWebhookTriggerService webhookTriggerService)
+        {
+            _logger = logger;
+            _webhookTriggerService = webhookTriggerService;
+        }
+
+        /// <summary>
+        /// Creates a webhook trigger
+        /// </summary>
+        /// <param name="request">Trigger definition</param>
+        /// <returns>The created trigger</returns>
+        [HttpPost]
+        [ApiScope(ApiScopes.TriggersManage)]
+        public async Task<IActionResult> CreateTrigger([FromBody] WebhookTriggerRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Creating webhook trigger for function: {FunctionId} for user: {UserId}", request.FunctionId, userId);
+
+            try
+            {
+                var trigger = await _webhookTriggerService.CreateAsync(ToTrigger(request, Guid.Empty, accountId));
+                return CreatedAtAction(nameof(GetTrigger), new { id = trigger.Id }, Describe(trigger));
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error creating webhook trigger for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Lists the webhook triggers of the current account
+        /// </summary>
+        /// <param name="tag">Tags the triggers must all carry</param>
+        /// <returns>The triggers</returns>
+        [HttpGet]
+        public async Task<IActionResult> GetTriggers([FromQuery] List<string> tag)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var triggers = await _webhookTriggerService.GetByAccountIdAsync(accountId);
+                return Ok(triggers.Where(t => t.Tags.HasAllTags(tag)).Select(Describe));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error getting webhook triggers for user: {UserId}", userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Gets a webhook trigger
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>The trigger</returns>
+        [HttpGet("{id}")]
+        public async Task<IActionResult> GetTrigger(Guid id)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var trigger = await _webhookTriggerService.GetByIdAsync(id);
+                if (trigger == null)
+                {
+                    return NotFound(new { Message = "Webhook trigger not found" });
+                }
+
+                if (trigger.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                this.SetEntityTag(trigger.Revision);
+                return Ok(Describe(trigger));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error getting webhook trigger: {TriggerId} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Updates a webhook trigger
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <param name="request">Trigger definition</param>
+        /// <returns>The updated trigger</returns>
+        [HttpPut("{id}")]
+        [ApiScope(ApiScopes.TriggersManage)]
+        public async Task<IActionResult> UpdateTrigger(Guid id, [FromBody] WebhookTriggerRequest request)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Updating webhook trigger: {TriggerId} for user: {UserId}", id, userId);
+
+            try
+            {
+                var existing = await _webhookTriggerService.GetByIdAsync(id);
+                if (existing == null)
+                {
+                    return NotFound(new { Message = "Webhook trigger not found" });
+                }
+
+                if (existing.AccountId != accountId)
+                {
+                    return Forbid();
+                }
+
+                if (!this.IfMatch(existing.Revision))
+                {
+                    return this.RevisionConflict("webhook trigger", existing.Revision);
+                }
+
+                var update = ToTrigger(request, id, accountId);
+                update.Revision = existing.Revision;
+
+                var trigger = await _webhookTriggerService.UpdateAsync(update);
+                this.SetEntityTag(trigger.Revision);
+                return Ok(Describe(trigger));
+            }
+            catch (ResourceNotFoundException ex)
+            {
+                return NotFound(new { Message = ex.Message });
+            }
+            catch (ConcurrencyConflictException ex)
+            {
+                return this.RevisionConflict("webhook trigger", ex.CurrentRevision);
+            }
+            catch (ForbiddenAccessException)
+            {
+                return Forbid();
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error updating webhook trigger: {TriggerId} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Deletes a webhook trigger
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>No content</returns>
+        [HttpDelete("{id}")]
+        [ApiScope(ApiScopes.TriggersManage)]
+        public async Task<IActionResult> DeleteTrigger(Guid id)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            _logger.LogInformation("Deleting webhook trigger: {TriggerId} for user: {UserId}", id, userId);
+
+            try
+            {
+                var trigger = await _webhookTriggerService.GetByIdAsync(id);
+                if (trigger == null)
+                {
+                    return NotFound(new { Message = "Webhook trigger not found" });
+                }
+
+                if (trigger.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                await _webhookTriggerService.DeleteAsync(id);
+                return NoContent();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error deleting webhook trigger: {TriggerId} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Shows the function parameters a sample delivery would produce, without executing the function
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>The transformed parameters</returns>
+        [HttpPost("{id}/preview")]
+        [ApiScope(ApiScopes.TriggersManage)]
+        public async Task<IActionResult> PreviewDelivery(Guid id)
+        {
+            var userId = User.FindFirst(System.Security.Claims.ClaimTypes.NameIdentifier)?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                return Unauthorized(new { Message = "Invalid user ID" });
+            }
+
+            try
+            {
+                var trigger = await _webhookTriggerService.GetByIdAsync(id);
+                if (trigger == null)
+                {
+                    return NotFound(new { Message = "Webhook trigger not found" });
+                }
+
+                if (trigger.AccountId != accountId && !User.IsInRole("Admin"))
+                {
+                    return Forbid();
+                }
+
+                var body = await ReadBodyAsync();
+                return Ok(new { Parameters = _webhookTriggerService.Transform(trigger, body, ReadHeaders()) });
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error previewing webhook trigger: {TriggerId} for user: {UserId}", id, userId);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        /// <summary>
+        /// Receives a webhook delivery and executes the trigger's function
+        /// </summary>
+        /// <remarks>
+        /// This endpoint is called by third parties, so it does not require authentication. Set a signing
+        /// secret on the trigger to reject deliveries that were not signed by the sender.
+        /// </remarks>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>Function execution result, or the function's own HTTP response for triggers in Http response mode</returns>
+        [HttpPost("{id}/deliveries")]
+        [AllowAnonymous]
+        public async Task<IActionResult> ReceiveDelivery(Guid id)
+        {
+            _logger.LogInformation("Received webhook delivery for trigger: {TriggerId}", id);
+
+            try
+            {
+                var body = await ReadBodyAsync();
+                var result = await _webhookTriggerService.IngestAsync(id, body, ReadHeaders());
+                if (result is FunctionHttpResponse response)
+                {
+                    return ToActionResult(response);
+                }
+
+                return Ok(new { Result = result });
+            }
+            catch (ResourceNotFoundException)
+            {
+                return NotFound(new { Message = "Webhook trigger not found" });
+            }
+            catch (ForbiddenAccessException ex)
+            {
+                return Unauthorized(new { Message = ex.Message });
+            }
+            catch (ValidationException ex)
+            {
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (MaintenanceException ex)
+            {
+                return StatusCode(503, new { Message = ex.Message, ex.ExpectedEndTime });
+            }
+            catch (FunctionException ex)
+            {
+                _logger.LogError(ex, "Error executing function for webhook trigger: {TriggerId}", id);
+                return BadRequest(new { Message = ex.Message });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Unexpected error handling webhook delivery for trigger: {TriggerId}", id);
+                return StatusCode(500, new { Message = "An unexpected error occurred" });
+            }
+        }
+
+        private async Task<string> ReadBodyAsync()
+        {
+            using var reader = new StreamReader(Request.Body);
+            return await reader.ReadToEndAsync();
+        }
+
+        private Dictionary<string, string> ReadHeaders()
+        {
+            return Request.Headers.ToDictionary(h => h.Key, h => h.Value.ToString(), StringComparer.OrdinalIgnoreCase);
+        }
+
+        private IActionResult ToActionResult(FunctionHttpResponse response)
+        {
+            string contentType = null;
+            foreach (var header in response.Headers)
+            {
+                if (string.Equals(header.Key, "Content-Type", StringComparison.OrdinalIgnoreCase))
+                {
+                    contentType = header.Value;
+                    continue;
+                }
+
+                Response.Headers[header.Key] = header.Value;
+            }
+
+            return new ContentResult
+            {
+                StatusCode = response.StatusCode,
+                ContentType = contentType,
+                Content = response.Body
+            };
+        }
+
+        private static WebhookTrigger ToTrigger(WebhookTriggerRequest request, Guid id, Guid accountId)
+        {
+            return new WebhookTrigger
+            {
+                Id = id,
+                AccountId = accountId,
+                FunctionId = request.FunctionId,
+                Name = request.Name,
+                Description = request.Description,
+                Enabled = request.Enabled,
+                SigningSecret = request.SigningSecret,
+                SignatureHeader = request.SignatureHeader,
+                Transformation = request.Transformation ?? new WebhookTransformation(),
+                ResponseMode = request.ResponseMode,
+                Tags = request.Tags ?? new List<string>()
+            };
+        }
+
+        // The signing secret is write-only
+        private static object Describe(WebhookTrigger trigger)
+        {
+            return new
+            {
+                trigger.Id,
+                trigger.AccountId,
+                trigger.FunctionId,
+                trigger.Name,
+                trigger.Description,
+                trigger.Enabled,
+                HasSigningSecret = !string.IsNullOrEmpty(trigger.SigningSecret),
+                trigger.SignatureHeader,
+                trigger.Transformation,
+                trigger.ResponseMode,
+                DeliveryPath = $"/api/webhooks/{trigger.Id}/deliveries",
+                trigger.CreatedAt,
+                trigger.UpdatedAt,
+                trigger.Revision,
+                trigger.LastTriggeredAt,
+                trigger.TriggerCount,
+                trigger.Tags
+            };
+        }
+    }
+}
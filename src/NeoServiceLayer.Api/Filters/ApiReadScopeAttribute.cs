@@ -0,0 +1,29 @@
+using System;
+
+namespace NeoServiceLayer.API.Filters
+{
+    /// <summary>
+    /// Marks a controller whose reads account API keys may only make with the given scope
+    /// </summary>
+    /// <remarks>
+    /// Enforced by <see cref="ApiScopeFilter"/> for GET and HEAD actions without an <see cref="ApiScopeAttribute"/>.
+    /// Reads of controllers without it need <see cref="Core.Models.ApiScopes.AccountRead"/>.
+    /// </remarks>
+    [AttributeUsage(AttributeTargets.Class, AllowMultiple = false)]
+    public class ApiReadScopeAttribute : Attribute
+    {
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ApiReadScopeAttribute"/> class
+        /// </summary>
+        /// <param name="scope">Required scope; see <see cref="Core.Models.ApiScopes"/></param>
+        public ApiReadScopeAttribute(string scope)
+        {
+            Scope = scope;
+        }
+
+        /// <summary>
+        /// Gets the required scope
+        /// </summary>
+        public string Scope { get; }
+    }
+}
@@ -0,0 +1,29 @@
+using System;
+
+namespace NeoServiceLayer.API.Filters
+{
+    /// <summary>
+    /// Marks an action that account API keys may only call with the given scope
+    /// </summary>
+    /// <remarks>
+    /// Enforced by <see cref="ApiScopeFilter"/>. Users signed in with a bearer token and configured service keys
+    /// are not affected.
+    /// </remarks>
+    [AttributeUsage(AttributeTargets.Class | AttributeTargets.Method, AllowMultiple = false)]
+    public class ApiScopeAttribute : Attribute
+    {
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ApiScopeAttribute"/> class
+        /// </summary>
+        /// <param name="scope">Required scope; see <see cref="Core.Models.ApiScopes"/></param>
+        public ApiScopeAttribute(string scope)
+        {
+            Scope = scope;
+        }
+
+        /// <summary>
+        /// Gets the required scope
+        /// </summary>
+        public string Scope { get; }
+    }
+}
@@ -0,0 +1,60 @@
+using System.Linq;
+using Microsoft.AspNetCore.Http;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.AspNetCore.Mvc.Filters;
+using NeoServiceLayer.API.Auth;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.API.Filters
+{
+    /// <summary>
+    /// Authorization filter that holds account API keys to their scopes
+    /// </summary>
+    /// <remarks>
+    /// A key may call an action marked with <see cref="ApiScopeAttribute"/> only if it has that scope, and may
+    /// not call any other action that changes state. Reads need the scope of the controller's
+    /// <see cref="ApiReadScopeAttribute"/>, or <see cref="ApiScopes.AccountRead"/> if it has none, so a key only
+    /// reads what it was created to read. Other principals pass unchecked.
+    /// </remarks>
+    public class ApiScopeFilter : IAuthorizationFilter
+    {
+        /// <inheritdoc/>
+        public void OnAuthorization(AuthorizationFilterContext context)
+        {
+            var user = context.HttpContext.User;
+            if (user?.FindFirst(ApiKeyAuthenticationHandler.CredentialTypeClaimType)?.Value != "account")
+            {
+                return;
+            }
+
+            var required = context.ActionDescriptor.EndpointMetadata.OfType<ApiScopeAttribute>().LastOrDefault();
+            if (required == null)
+            {
+                var method = context.HttpContext.Request.Method;
+                if (!HttpMethods.IsGet(method) && !HttpMethods.IsHead(method))
+                {
+                    context.Result = new ObjectResult(new { Message = "API keys cannot call this endpoint" }) { StatusCode = StatusCodes.Status403Forbidden };
+                    return;
+                }
+
+                var readScope = context.ActionDescriptor.EndpointMetadata.OfType<ApiReadScopeAttribute>().LastOrDefault()?.Scope ?? ApiScopes.AccountRead;
+                if (!user.HasClaim(ApiKeyAuthenticationHandler.ScopeClaimType, readScope))
+                {
+                    Forbid(context, readScope);
+                }
+
+                return;
+            }
+
+            if (!user.HasClaim(ApiKeyAuthenticationHandler.ScopeClaimType, required.Scope))
+            {
+                Forbid(context, required.Scope);
+            }
+        }
+
+        private static void Forbid(AuthorizationFilterContext context, string scope)
+        {
+            context.Result = new ObjectResult(new { Message = $"API key lacks the {scope} scope" }) { StatusCode = StatusCodes.Status403Forbidden };
+        }
+    }
+}
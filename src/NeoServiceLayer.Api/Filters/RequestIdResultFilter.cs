@@ -0,0 +1,63 @@
+using System.Collections.Generic;
+using System.Text.Json;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.AspNetCore.Mvc.Filters;
+using Microsoft.Extensions.Options;
+
+namespace NeoServiceLayer.API.Filters
+{
+    /// <summary>
+    /// Result filter that adds the request ID to error bodies returned by controllers
+    /// </summary>
+    /// <remarks>
+    /// Controllers return error bodies such as <c>new { Message = ... }</c>; the filter re-serializes
+    /// object bodies of 4xx and 5xx results and appends a <c>requestId</c> property, so support can
+    /// correlate a user's report with the server logs.
+    /// </remarks>
+    public class RequestIdResultFilter : IAsyncResultFilter
+    {
+        private readonly JsonSerializerOptions _serializerOptions;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="RequestIdResultFilter"/> class
+        /// </summary>
+        /// <param name="jsonOptions">MVC JSON options used to name the body's properties</param>
+        public RequestIdResultFilter(IOptions<JsonOptions> jsonOptions)
+        {
+            _serializerOptions = jsonOptions.Value.JsonSerializerOptions;
+        }
+
+        /// <inheritdoc/>
+        public async Task OnResultExecutionAsync(ResultExecutingContext context, ResultExecutionDelegate next)
+        {
+            if (context.Result is ObjectResult result && result.StatusCode >= 400 && result.Value != null)
+            {
+                var requestId = context.HttpContext.TraceIdentifier;
+
+                if (result.Value is ProblemDetails problemDetails)
+                {
+                    problemDetails.Extensions["requestId"] = requestId;
+                }
+                else
+                {
+                    var element = JsonSerializer.SerializeToElement(result.Value, result.Value.GetType(), _serializerOptions);
+                    if (element.ValueKind == JsonValueKind.Object)
+                    {
+                        var body = new Dictionary<string, object>();
+                        foreach (var property in element.EnumerateObject())
+                        {
+                            body[property.Name] = property.Value;
+                        }
+
+                        body["requestId"] = requestId;
+                        result.Value = body;
+                        result.DeclaredType = typeof(Dictionary<string, object>);
+                    }
+                }
+            }
+
+            await next();
+        }
+    }
+}
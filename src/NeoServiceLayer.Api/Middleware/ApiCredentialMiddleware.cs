@@ -0,0 +1,73 @@
+using System;
+using System.Net;
+using System.Security.Claims;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Http;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.API.Middleware
+{
+    /// <summary>
+    /// Middleware that passes the authenticated principal on to services and applies account API keys' rate limits
+    /// </summary>
+    /// <remarks>
+    /// The caller's ID and, for account API keys, the key's ID are set on <see cref="RequestContext"/> so services
+    /// can attribute what they record without the controllers passing them through. A key over its own rate limit
+    /// is refused with 429. It must run after authentication and impersonation and before authorization.
+    /// </remarks>
+    public class ApiCredentialMiddleware
+    {
+        private readonly RequestDelegate _next;
+        private readonly ILogger<ApiCredentialMiddleware> _logger;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ApiCredentialMiddleware"/> class
+        /// </summary>
+        /// <param name="next">The next middleware in the pipeline</param>
+        /// <param name="logger">Logger</param>
+        public ApiCredentialMiddleware(RequestDelegate next, ILogger<ApiCredentialMiddleware> logger)
+        {
+            _next = next;
+            _logger = logger;
+        }
+
+        /// <summary>
+        /// Invokes the middleware
+        /// </summary>
+        /// <param name="context">HTTP context</param>
+        /// <param name="credentialService">Account API key service</param>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task InvokeAsync(HttpContext context, IApiCredentialService credentialService)
+        {
+            if (context.User?.Identity?.IsAuthenticated != true)
+            {
+                await _next(context);
+                return;
+            }
+
+            RequestContext.PrincipalId = context.User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+
+            if (context.Items.TryGetValue(typeof(ApiCredential), out var item) && item is ApiCredential credential)
+            {
+                RequestContext.CredentialId = credential.Id;
+
+                if (credential.RateLimit.HasValue &&
+                    !credentialService.TryCountRequest(credential.Id, credential.RateLimit.Value, credential.RateLimitPeriodSeconds))
+                {
+                    _logger.LogWarning("API key {CredentialId} of account {AccountId} exceeded its rate limit of {RateLimit} requests per {Period} seconds",
+                        credential.Id, credential.AccountId, credential.RateLimit.Value, credential.RateLimitPeriodSeconds);
+
+                    context.Response.StatusCode = (int)HttpStatusCode.TooManyRequests;
+                    context.Response.Headers["Retry-After"] = credential.RateLimitPeriodSeconds.ToString();
+                    await context.Response.WriteAsJsonAsync(new { Message = "API key rate limit exceeded" });
+                    return;
+                }
+            }
+
+            await _next(context);
+        }
+    }
+}
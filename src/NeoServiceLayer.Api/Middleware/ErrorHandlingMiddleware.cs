@@ -68,7 +68,8 @@ namespace NeoServiceLayer.API.Middleware
                         message,
                         details,
                         validationErrors = validationEx.Errors,
-                        traceId = context.TraceIdentifier
+                        traceId = context.TraceIdentifier,
+                        requestId = context.TraceIdentifier
                     }
                 }));
             }
@@ -82,6 +83,11 @@ namespace NeoServiceLayer.API.Middleware
                 code = HttpStatusCode.Conflict; // 409
                 message = "Resource already exists.";
             }
+            else if (exception is ConcurrencyConflictException)
+            {
+                code = HttpStatusCode.Conflict; // 409
+                message = "Resource was modified concurrently.";
+            }
             else if (exception is ForbiddenAccessException)
             {
                 code = HttpStatusCode.Forbidden; // 403
@@ -97,6 +103,17 @@ namespace NeoServiceLayer.API.Middleware
                 code = HttpStatusCode.Unauthorized; // 401
                 message = "Unauthorized access.";
             }
+            else if (exception is MaintenanceException maintenanceEx)
+            {
+                code = HttpStatusCode.ServiceUnavailable; // 503
+                message = "Service is in maintenance.";
+
+                if (maintenanceEx.ExpectedEndTime.HasValue)
+                {
+                    var retryAfter = Math.Max(1, (int)Math.Ceiling((maintenanceEx.ExpectedEndTime.Value - DateTime.UtcNow).TotalSeconds));
+                    context.Response.Headers["Retry-After"] = retryAfter.ToString();
+                }
+            }
             else if (exception is InvalidOperationException)
             {
                 code = HttpStatusCode.BadRequest; // 400
@@ -111,7 +128,8 @@ namespace NeoServiceLayer.API.Middleware
                     code = (int)code,
                     message,
                     details,
-                    traceId = context.TraceIdentifier
+                    traceId = context.TraceIdentifier,
+                    requestId = context.TraceIdentifier
                 }
             });
 
@@ -0,0 +1,197 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Net;
+using System.Security.Claims;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Http;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.API.Middleware
+{
+    /// <summary>
+    /// Middleware that lets a support user view an account as its owner, after the owner consented or an
+    /// administrator approved break-glass access
+    /// </summary>
+    /// <remarks>
+    /// A request carrying the X-Impersonate-Account header runs as the named account, without the support
+    /// user's roles, when the caller is in a support role and holds an active grant for the account. Only
+    /// reads of the configured paths are allowed. Every impersonated request, allowed or refused, is recorded
+    /// in the impersonation audit log and tagged with the support user's ID. It must run after authentication
+    /// and before authorization.
+    /// </remarks>
+    public class ImpersonationMiddleware
+    {
+        /// <summary>
+        /// Header naming the account to impersonate
+        /// </summary>
+        public const string ImpersonateHeader = "X-Impersonate-Account";
+
+        /// <summary>
+        /// Claim carrying the ID of the support user behind an impersonated request
+        /// </summary>
+        public const string ImpersonatorClaimType = "impersonator";
+
+        /// <summary>
+        /// Claim carrying the ID of the grant an impersonated request was made under
+        /// </summary>
+        public const string ImpersonationGrantClaimType = "impersonation_grant";
+
+        private readonly RequestDelegate _next;
+        private readonly ILogger<ImpersonationMiddleware> _logger;
+        private readonly ImpersonationConfiguration _configuration;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ImpersonationMiddleware"/> class
+        /// </summary>
+        /// <param name="next">The next middleware in the pipeline</param>
+        /// <param name="logger">Logger</param>
+        /// <param name="configuration">Configuration</param>
+        public ImpersonationMiddleware(
+            RequestDelegate next,
+            ILogger<ImpersonationMiddleware> logger,
+            IOptions<ImpersonationConfiguration> configuration)
+        {
+            _next = next;
+            _logger = logger;
+            _configuration = configuration.Value;
+        }
+
+        /// <summary>
+        /// Invokes the middleware
+        /// </summary>
+        /// <param name="context">HTTP context</param>
+        /// <param name="impersonationService">Impersonation service</param>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task InvokeAsync(HttpContext context, IImpersonationService impersonationService)
+        {
+            var header = context.Request.Headers[ImpersonateHeader].FirstOrDefault();
+            if (string.IsNullOrEmpty(header) || context.User?.Identity?.IsAuthenticated != true)
+            {
+                await _next(context);
+                return;
+            }
+
+            if (!Guid.TryParse(header, out var accountId))
+            {
+                await RespondAsync(context, HttpStatusCode.BadRequest, $"{ImpersonateHeader} must be an account ID");
+                return;
+            }
+
+            var supportUserId = context.User.FindFirst(ClaimTypes.NameIdentifier)?.Value;
+            var entry = new ImpersonationAuditEntry
+            {
+                AccountId = accountId,
+                SupportUserId = supportUserId,
+                Method = context.Request.Method,
+                Path = context.Request.Path + context.Request.QueryString,
+                RequestId = context.TraceIdentifier,
+                Timestamp = DateTime.UtcNow
+            };
+
+            var deniedReason = await GetDeniedReasonAsync(context, impersonationService, accountId, supportUserId, entry);
+            if (deniedReason != null)
+            {
+                entry.DeniedReason = deniedReason;
+                entry.StatusCode = (int)HttpStatusCode.Forbidden;
+                await TryRecordAsync(impersonationService, entry);
+
+                _logger.LogWarning("Refused impersonation of account {AccountId} by {SupportUserId} for {Method} {Path}: {Reason}",
+                    accountId, supportUserId, entry.Method, entry.Path, deniedReason);
+
+                await RespondAsync(context, HttpStatusCode.Forbidden, deniedReason);
+                return;
+            }
+
+            // The request runs as the owner would see it: the owner's ID, none of the support user's roles
+            var identity = new ClaimsIdentity(new[]
+            {
+                new Claim(ClaimTypes.NameIdentifier, accountId.ToString()),
+                new Claim(ImpersonatorClaimType, supportUserId),
+                new Claim(ImpersonationGrantClaimType, entry.GrantId.ToString())
+            }, context.User.Identity.AuthenticationType);
+            context.User = new ClaimsPrincipal(identity);
+            entry.Allowed = true;
+
+            context.Response.OnStarting(() =>
+            {
+                context.Response.Headers["X-Impersonated-By"] = supportUserId;
+                return Task.CompletedTask;
+            });
+
+            using (_logger.BeginScope(new Dictionary<string, object> { ["Impersonator"] = supportUserId, ["ImpersonatedAccount"] = accountId }))
+            {
+                try
+                {
+                    await _next(context);
+                }
+                finally
+                {
+                    entry.StatusCode = context.Response.StatusCode;
+                    await TryRecordAsync(impersonationService, entry);
+                }
+            }
+        }
+
+        private async Task<string> GetDeniedReasonAsync(
+            HttpContext context,
+            IImpersonationService impersonationService,
+            Guid accountId,
+            string supportUserId,
+            ImpersonationAuditEntry entry)
+        {
+            if (string.IsNullOrEmpty(supportUserId) || !_configuration.SupportRoles.Any(context.User.IsInRole))
+            {
+                return "Only support users may impersonate an account";
+            }
+
+            if (!HttpMethods.IsGet(context.Request.Method) && !HttpMethods.IsHead(context.Request.Method))
+            {
+                return "Impersonation is read-only";
+            }
+
+            if (!IsAllowedPath(context.Request.Path))
+            {
+                return "This path cannot be viewed while impersonating";
+            }
+
+            var grant = await impersonationService.GetActiveGrantAsync(accountId, supportUserId);
+            if (grant == null)
+            {
+                return "No active consent or break-glass approval for this account";
+            }
+
+            entry.GrantId = grant.Id;
+            return null;
+        }
+
+        private bool IsAllowedPath(PathString path)
+        {
+            // Match whole segments so /api/function does not also allow /api/functionmarketplace
+            return _configuration.AllowedPathPrefixes.Any(prefix =>
+                path.StartsWithSegments(prefix, StringComparison.OrdinalIgnoreCase));
+        }
+
+        private async Task TryRecordAsync(IImpersonationService impersonationService, ImpersonationAuditEntry entry)
+        {
+            try
+            {
+                await impersonationService.RecordAsync(entry);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error recording impersonation of account {AccountId} by {SupportUserId} for {Method} {Path}",
+                    entry.AccountId, entry.SupportUserId, entry.Method, entry.Path);
+            }
+        }
+
+        private static Task RespondAsync(HttpContext context, HttpStatusCode code, string message)
+        {
+            context.Response.StatusCode = (int)code;
+            return context.Response.WriteAsJsonAsync(new { Message = message });
+        }
+    }
+}
@@ -17,6 +17,37 @@ namespace NeoServiceLayer.API.Middleware
             return builder.UseMiddleware<ErrorHandlingMiddleware>();
         }
 
+        /// <summary>
+        /// Adds request ID middleware to the application; it should run before other middleware so their logs carry the ID
+        /// </summary>
+        /// <param name="builder">Application builder</param>
+        /// <returns>Application builder</returns>
+        public static IApplicationBuilder UseRequestId(this IApplicationBuilder builder)
+        {
+            return builder.UseMiddleware<RequestIdMiddleware>();
+        }
+
+        /// <summary>
+        /// Adds support impersonation middleware to the application; it must run between authentication and authorization
+        /// </summary>
+        /// <param name="builder">Application builder</param>
+        /// <returns>Application builder</returns>
+        public static IApplicationBuilder UseImpersonation(this IApplicationBuilder builder)
+        {
+            return builder.UseMiddleware<ImpersonationMiddleware>();
+        }
+
+        /// <summary>
+        /// Adds middleware that passes the authenticated principal on to services and applies API key rate limits;
+        /// it must run after impersonation and before authorization
+        /// </summary>
+        /// <param name="builder">Application builder</param>
+        /// <returns>Application builder</returns>
+        public static IApplicationBuilder UseApiCredentials(this IApplicationBuilder builder)
+        {
+            return builder.UseMiddleware<ApiCredentialMiddleware>();
+        }
+
         /// <summary>
         /// Adds performance monitoring middleware to the application
         /// </summary>
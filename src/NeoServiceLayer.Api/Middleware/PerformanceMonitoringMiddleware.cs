@@ -36,9 +36,8 @@ namespace NeoServiceLayer.API.Middleware
         {
             _stopwatch.Restart();
 
-            // Add a unique request ID to the response headers
-            var requestId = Guid.NewGuid().ToString();
-            context.Response.Headers.Add("X-Request-ID", requestId);
+            // The request ID is assigned and returned to the caller by RequestIdMiddleware
+            var requestId = context.TraceIdentifier;
 
             // Log the request
             _logger.LogInformation("Request {RequestId} started: {Method} {Path}{QueryString}",
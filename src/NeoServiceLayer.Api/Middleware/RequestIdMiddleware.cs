@@ -0,0 +1,73 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Http;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.API.Middleware
+{
+    /// <summary>
+    /// Middleware that assigns every request an ID and returns it in the X-Request-ID header
+    /// </summary>
+    /// <remarks>
+    /// A well-formed X-Request-ID sent by the caller is reused so that IDs can be correlated across
+    /// services; otherwise a new one is generated. The ID becomes the request's trace identifier, is
+    /// added to the logging scope and is available to services through <see cref="RequestContext"/>.
+    /// </remarks>
+    public class RequestIdMiddleware
+    {
+        private const int MaxRequestIdLength = 64;
+
+        private readonly RequestDelegate _next;
+        private readonly ILogger<RequestIdMiddleware> _logger;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="RequestIdMiddleware"/> class
+        /// </summary>
+        /// <param name="next">The next middleware in the pipeline</param>
+        /// <param name="logger">Logger</param>
+        public RequestIdMiddleware(RequestDelegate next, ILogger<RequestIdMiddleware> logger)
+        {
+            _next = next;
+            _logger = logger;
+        }
+
+        /// <summary>
+        /// Invokes the middleware
+        /// </summary>
+        /// <param name="context">HTTP context</param>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task InvokeAsync(HttpContext context)
+        {
+            var requestId = context.Request.Headers[RequestContext.RequestIdHeader].FirstOrDefault();
+            if (!IsValidRequestId(requestId))
+            {
+                requestId = Guid.NewGuid().ToString("N");
+            }
+
+            context.TraceIdentifier = requestId;
+            RequestContext.RequestId = requestId;
+
+            context.Response.OnStarting(() =>
+            {
+                context.Response.Headers[RequestContext.RequestIdHeader] = requestId;
+                return Task.CompletedTask;
+            });
+
+            using (_logger.BeginScope(new Dictionary<string, object> { ["RequestId"] = requestId }))
+            {
+                await _next(context);
+            }
+        }
+
+        private static bool IsValidRequestId(string requestId)
+        {
+            // Caller-supplied IDs end up in logs and headers, so only accept a conservative character set
+            return !string.IsNullOrEmpty(requestId) &&
+                requestId.Length <= MaxRequestIdLength &&
+                requestId.All(c => char.IsAsciiLetterOrDigit(c) || c == '-' || c == '_' || c == '.');
+        }
+    }
+}
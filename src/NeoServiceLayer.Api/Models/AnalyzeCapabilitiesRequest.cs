@@ -0,0 +1,28 @@
+using System.Collections.Generic;
+using System.ComponentModel.DataAnnotations;
+using NeoServiceLayer.Core.Enums;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for checking function code against permissions before deploying it
+    /// </summary>
+    public class AnalyzeCapabilitiesRequest
+    {
+        /// <summary>
+        /// Runtime of the code
+        /// </summary>
+        public FunctionRuntime Runtime { get; set; } = FunctionRuntime.JavaScript;
+
+        /// <summary>
+        /// Source code to analyze
+        /// </summary>
+        [Required]
+        public string SourceCode { get; set; }
+
+        /// <summary>
+        /// Permissions to compare against; null reports the capabilities only
+        /// </summary>
+        public List<string> Permissions { get; set; }
+    }
+}
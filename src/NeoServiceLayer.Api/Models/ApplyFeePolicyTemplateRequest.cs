@@ -0,0 +1,32 @@
+using System;
+using System.Collections.Generic;
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for applying a GasBank fee policy template to many users
+    /// </summary>
+    public class ApplyFeePolicyTemplateRequest
+    {
+        /// <summary>
+        /// GasBank account of the current user that pays the users' fees
+        /// </summary>
+        [Required]
+        public Guid GasBankAccountId { get; set; }
+
+        /// <summary>
+        /// Users whose fees are covered
+        /// </summary>
+        [Required]
+        [MinLength(1)]
+        [MaxLength(1000)]
+        public List<Guid> AccountIds { get; set; }
+
+        /// <summary>
+        /// Template version to pin; the policies follow the latest version if not set
+        /// </summary>
+        [Range(1, int.MaxValue)]
+        public int? TemplateVersion { get; set; }
+    }
+}
@@ -0,0 +1,30 @@
+using System;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for approving break-glass access to an account
+    /// </summary>
+    public class ApproveBreakGlassRequest
+    {
+        /// <summary>
+        /// ID of the account to view
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// ID of the support user
+        /// </summary>
+        public string SupportUserId { get; set; }
+
+        /// <summary>
+        /// Why access is needed without the owner's consent
+        /// </summary>
+        public string Reason { get; set; }
+
+        /// <summary>
+        /// How long the approval lasts, in minutes; the configured default if omitted
+        /// </summary>
+        public int? DurationMinutes { get; set; }
+    }
+}
@@ -0,0 +1,18 @@
+using System.Collections.Generic;
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for bulk secret provisioning
+    /// </summary>
+    public class BulkSecretsRequest
+    {
+        /// <summary>
+        /// Secrets to create or update, matched by name
+        /// </summary>
+        [Required]
+        [MinLength(1)]
+        public List<CreateSecretRequest> Secrets { get; set; }
+    }
+}
@@ -0,0 +1,13 @@
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for changing the caller's price feed subscription tier
+    /// </summary>
+    public class ChangePriceFeedTierRequest
+    {
+        /// <summary>
+        /// Name of the tier to subscribe to
+        /// </summary>
+        public string Tier { get; set; }
+    }
+}
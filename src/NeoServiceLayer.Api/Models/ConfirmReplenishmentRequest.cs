@@ -0,0 +1,23 @@
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for confirming that GAS was moved from the cold wallet to a hot wallet
+    /// </summary>
+    public class ConfirmReplenishmentRequest
+    {
+        /// <summary>
+        /// Amount of GAS that arrived in the hot wallet
+        /// </summary>
+        [Required]
+        [Range(0.00000001, double.MaxValue, ErrorMessage = "Amount must be greater than zero")]
+        public decimal Amount { get; set; }
+
+        /// <summary>
+        /// Hash of the transaction from the cold wallet
+        /// </summary>
+        [Required]
+        public string TransactionHash { get; set; }
+    }
+}
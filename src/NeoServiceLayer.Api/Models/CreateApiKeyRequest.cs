@@ -0,0 +1,36 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for creating an account API key
+    /// </summary>
+    public class CreateApiKeyRequest
+    {
+        /// <summary>
+        /// Name of the key, e.g. the integration using it
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Scopes the key may use: account:read, functions:invoke, secrets:read, secrets:write, gasbank:read, gasbank:withdraw, triggers:manage
+        /// </summary>
+        public List<string> Scopes { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Maximum requests per period, or null for no key-specific limit
+        /// </summary>
+        public int? RateLimit { get; set; }
+
+        /// <summary>
+        /// Rate limit period in seconds
+        /// </summary>
+        public int RateLimitPeriodSeconds { get; set; } = 60;
+
+        /// <summary>
+        /// When the key expires, or null if it does not
+        /// </summary>
+        public DateTime? ExpiresAt { get; set; }
+    }
+}
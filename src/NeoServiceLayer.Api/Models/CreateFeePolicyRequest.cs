@@ -0,0 +1,40 @@
+using System;
+using System.ComponentModel.DataAnnotations;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for creating a GasBank fee policy from a template
+    /// </summary>
+    public class CreateFeePolicyRequest
+    {
+        /// <summary>
+        /// Template to create the policy from
+        /// </summary>
+        [Required]
+        public Guid TemplateId { get; set; }
+
+        /// <summary>
+        /// GasBank account of the current user that pays the fees
+        /// </summary>
+        [Required]
+        public Guid GasBankAccountId { get; set; }
+
+        /// <summary>
+        /// User whose fees are covered; the current user if not set. Only the template owner may cover other users
+        /// </summary>
+        public Guid? AccountId { get; set; }
+
+        /// <summary>
+        /// Template version to pin; the policy follows the latest version if not set
+        /// </summary>
+        [Range(1, int.MaxValue)]
+        public int? TemplateVersion { get; set; }
+
+        /// <summary>
+        /// Rules replacing the template's
+        /// </summary>
+        public GasFeePolicyRules Overrides { get; set; }
+    }
+}
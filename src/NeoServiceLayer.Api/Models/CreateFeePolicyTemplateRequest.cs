@@ -0,0 +1,35 @@
+using System.ComponentModel.DataAnnotations;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for creating a GasBank fee policy template
+    /// </summary>
+    public class CreateFeePolicyTemplateRequest
+    {
+        /// <summary>
+        /// Template name
+        /// </summary>
+        [Required]
+        [StringLength(100)]
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Template description
+        /// </summary>
+        [StringLength(500)]
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Whether other users may create policies from the template
+        /// </summary>
+        public bool IsPublic { get; set; }
+
+        /// <summary>
+        /// Rules of the first version
+        /// </summary>
+        [Required]
+        public GasFeePolicyRules Rules { get; set; }
+    }
+}
@@ -62,5 +62,10 @@ namespace NeoServiceLayer.Api.Models
         /// Environment variables for the function
         /// </summary>
         public Dictionary<string, string> EnvironmentVariables { get; set; } = new Dictionary<string, string>();
+
+        /// <summary>
+        /// Capabilities the function may use ("transactions", "secrets", "network", "staking"); omit to leave the code unrestricted
+        /// </summary>
+        public List<string> Permissions { get; set; }
     }
 }
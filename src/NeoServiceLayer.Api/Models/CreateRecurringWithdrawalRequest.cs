@@ -0,0 +1,53 @@
+using System;
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for scheduling a recurring GasBank withdrawal
+    /// </summary>
+    public class CreateRecurringWithdrawalRequest
+    {
+        /// <summary>
+        /// GasBank account of the current user to withdraw from
+        /// </summary>
+        [Required]
+        public Guid GasBankAccountId { get; set; }
+
+        /// <summary>
+        /// Neo address the GAS is sent to
+        /// </summary>
+        [Required]
+        public string ToAddress { get; set; }
+
+        /// <summary>
+        /// Five-field cron expression, evaluated in UTC; "0 0 * * 1" runs every Monday at midnight
+        /// </summary>
+        [Required]
+        public string Schedule { get; set; }
+
+        /// <summary>
+        /// GAS withdrawn per occurrence; leave empty to sweep the unallocated balance above the floor
+        /// </summary>
+        [Range(0.00000001, double.MaxValue)]
+        public decimal? Amount { get; set; }
+
+        /// <summary>
+        /// Unallocated balance left in the account after each withdrawal
+        /// </summary>
+        [Range(0, double.MaxValue)]
+        public decimal Floor { get; set; }
+
+        /// <summary>
+        /// Smallest sweep worth sending; smaller sweeps are skipped
+        /// </summary>
+        [Range(0, double.MaxValue)]
+        public decimal MinimumAmount { get; set; }
+
+        /// <summary>
+        /// Description of the recurring withdrawal
+        /// </summary>
+        [StringLength(200)]
+        public string Description { get; set; }
+    }
+}
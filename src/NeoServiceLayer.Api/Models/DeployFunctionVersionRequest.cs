@@ -0,0 +1,54 @@
+using System.Collections.Generic;
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for deploying a new version of a function
+    /// </summary>
+    public class DeployFunctionVersionRequest
+    {
+        /// <summary>
+        /// Source code of the version
+        /// </summary>
+        [Required]
+        public string SourceCode { get; set; }
+
+        /// <summary>
+        /// Version label, such as "1.2.0"; the version number if not set
+        /// </summary>
+        [StringLength(64)]
+        public string Label { get; set; }
+
+        /// <summary>
+        /// Notes describing the change
+        /// </summary>
+        [StringLength(1000)]
+        public string Notes { get; set; }
+
+        /// <summary>
+        /// Entry point; the function's current one if not set
+        /// </summary>
+        public string EntryPoint { get; set; }
+
+        /// <summary>
+        /// Maximum execution time in milliseconds; the function's current one if not set
+        /// </summary>
+        public int MaxExecutionTime { get; set; }
+
+        /// <summary>
+        /// Maximum memory in megabytes; the function's current one if not set
+        /// </summary>
+        public int MaxMemory { get; set; }
+
+        /// <summary>
+        /// Environment variables; the function's current ones if not set
+        /// </summary>
+        public Dictionary<string, string> EnvironmentVariables { get; set; }
+
+        /// <summary>
+        /// Whether to make the version live straight away
+        /// </summary>
+        public bool Promote { get; set; }
+    }
+}
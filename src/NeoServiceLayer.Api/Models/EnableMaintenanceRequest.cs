@@ -0,0 +1,20 @@
+using System;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for switching maintenance mode on
+    /// </summary>
+    public class EnableMaintenanceRequest
+    {
+        /// <summary>
+        /// Reason shown to users
+        /// </summary>
+        public string Reason { get; set; }
+
+        /// <summary>
+        /// When maintenance is expected to end; maintenance ends automatically at this time if set
+        /// </summary>
+        public DateTime? ExpectedEndTime { get; set; }
+    }
+}
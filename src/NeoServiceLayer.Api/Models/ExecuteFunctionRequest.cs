@@ -1,4 +1,5 @@
 using System.Collections.Generic;
+using System.ComponentModel.DataAnnotations;
 
 namespace NeoServiceLayer.Api.Models
 {
@@ -11,5 +12,23 @@ namespace NeoServiceLayer.Api.Models
         /// Parameters for the function execution
         /// </summary>
         public Dictionary<string, object> Parameters { get; set; }
+
+        /// <summary>
+        /// Optional session key; invocations with the same key run in the same warm sandbox and see the state earlier ones kept in memory
+        /// </summary>
+        [StringLength(128)]
+        public string SessionKey { get; set; }
+
+        /// <summary>
+        /// Whether to return an execution ID at once and run the function in the background
+        /// </summary>
+        public bool Async { get; set; }
+
+        /// <summary>
+        /// Optional URL the outcome of an asynchronous execution is posted to once it completes or fails
+        /// </summary>
+        [Url]
+        [StringLength(2048)]
+        public string CallbackUrl { get; set; }
     }
 }
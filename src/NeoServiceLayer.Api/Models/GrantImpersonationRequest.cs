@@ -0,0 +1,23 @@
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for consenting to a support user viewing the caller's account
+    /// </summary>
+    public class GrantImpersonationRequest
+    {
+        /// <summary>
+        /// ID of the support user
+        /// </summary>
+        public string SupportUserId { get; set; }
+
+        /// <summary>
+        /// Why the support user needs access, e.g. a ticket reference
+        /// </summary>
+        public string Reason { get; set; }
+
+        /// <summary>
+        /// How long the consent lasts, in minutes; the configured default if omitted
+        /// </summary>
+        public int? DurationMinutes { get; set; }
+    }
+}
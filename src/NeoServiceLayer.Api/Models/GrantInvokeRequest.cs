@@ -0,0 +1,41 @@
+using System;
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for letting another user or API key invoke a function
+    /// </summary>
+    public class GrantInvokeRequest
+    {
+        /// <summary>
+        /// Account ID of the user, or ID of the API key
+        /// </summary>
+        [Required]
+        public string PrincipalId { get; set; }
+
+        /// <summary>
+        /// Principal type, "user" or "apikey"
+        /// </summary>
+        [Required]
+        [RegularExpression("^(user|apikey)$", ErrorMessage = "Principal type must be \"user\" or \"apikey\"")]
+        public string PrincipalType { get; set; } = "user";
+
+        /// <summary>
+        /// Maximum invocations per period; omit for no limit
+        /// </summary>
+        [Range(1, int.MaxValue, ErrorMessage = "Rate limit must be greater than zero")]
+        public int? RateLimit { get; set; }
+
+        /// <summary>
+        /// Rate limit period in seconds
+        /// </summary>
+        [Range(1, int.MaxValue, ErrorMessage = "Rate limit period must be greater than zero")]
+        public int RateLimitPeriodSeconds { get; set; } = 60;
+
+        /// <summary>
+        /// When the grant expires; omit for no expiry
+        /// </summary>
+        public DateTime? ExpiresAt { get; set; }
+    }
+}
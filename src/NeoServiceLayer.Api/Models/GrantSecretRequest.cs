@@ -0,0 +1,25 @@
+using System;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for granting read access to a secret
+    /// </summary>
+    public class GrantSecretRequest
+    {
+        /// <summary>
+        /// ID of the function to grant, which may belong to another user; set this or <see cref="Address"/>
+        /// </summary>
+        public Guid? FunctionId { get; set; }
+
+        /// <summary>
+        /// Neo address of the user whose functions are granted; set this or <see cref="FunctionId"/>
+        /// </summary>
+        public string Address { get; set; }
+
+        /// <summary>
+        /// Time the grant expires; it lasts until revoked when omitted
+        /// </summary>
+        public DateTime? ExpiresAt { get; set; }
+    }
+}
@@ -0,0 +1,39 @@
+using System;
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for importing a Chainlink job spec
+    /// </summary>
+    public class ImportJobSpecRequest
+    {
+        /// <summary>
+        /// Job spec in TOML, as written for a Chainlink node
+        /// </summary>
+        [Required]
+        [StringLength(65536)]
+        public string Spec { get; set; }
+
+        /// <summary>
+        /// Whether to only return what the spec converts into without creating it
+        /// </summary>
+        public bool DryRun { get; set; }
+
+        /// <summary>
+        /// Name of the imported function and trigger; the job's name if not set
+        /// </summary>
+        [StringLength(100, MinimumLength = 3)]
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Script hash of the Neo contract a keeper job's upkeep calls
+        /// </summary>
+        public string ContractHash { get; set; }
+
+        /// <summary>
+        /// GasBank account that pays for a keeper job's performUpkeep transactions
+        /// </summary>
+        public Guid? GasBankAccountId { get; set; }
+    }
+}
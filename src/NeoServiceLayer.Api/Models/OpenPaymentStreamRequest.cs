@@ -0,0 +1,47 @@
+using System;
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for opening a GasBank payment stream
+    /// </summary>
+    public class OpenPaymentStreamRequest
+    {
+        /// <summary>
+        /// GasBank account of the current user that pays the stream
+        /// </summary>
+        [Required]
+        public Guid PayerGasBankAccountId { get; set; }
+
+        /// <summary>
+        /// GasBank account that receives the stream; it may belong to another user
+        /// </summary>
+        [Required]
+        public Guid PayeeGasBankAccountId { get; set; }
+
+        /// <summary>
+        /// GAS accrued per second
+        /// </summary>
+        [Range(0.00000001, double.MaxValue)]
+        public decimal RatePerSecond { get; set; }
+
+        /// <summary>
+        /// Most GAS the stream can pay; reserved in the payer's account until the stream settles
+        /// </summary>
+        [Range(0.00000001, double.MaxValue)]
+        public decimal Cap { get; set; }
+
+        /// <summary>
+        /// Seconds until the stream expires and is settled
+        /// </summary>
+        [Range(1, int.MaxValue)]
+        public int DurationSeconds { get; set; }
+
+        /// <summary>
+        /// Description of the stream
+        /// </summary>
+        [StringLength(200)]
+        public string Description { get; set; }
+    }
+}
@@ -0,0 +1,54 @@
+using System.Collections.Generic;
+using System.ComponentModel.DataAnnotations;
+using NeoServiceLayer.Core.Models.Blockchain;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for proposing a contract invocation from a multi-signature account
+    /// </summary>
+    public class ProposeMultiSigRequest
+    {
+        /// <summary>
+        /// Number of signatures required
+        /// </summary>
+        [Range(1, 1024)]
+        public int Threshold { get; set; }
+
+        /// <summary>
+        /// Compressed public keys of the signers, as hex
+        /// </summary>
+        [Required]
+        [MinLength(1)]
+        public List<string> PublicKeys { get; set; }
+
+        /// <summary>
+        /// Script hash of the contract to invoke
+        /// </summary>
+        [Required]
+        public string ContractHash { get; set; }
+
+        /// <summary>
+        /// Method to invoke
+        /// </summary>
+        [Required]
+        public string Operation { get; set; }
+
+        /// <summary>
+        /// Method arguments
+        /// </summary>
+        public List<NeoContractParameter> Args { get; set; }
+
+        /// <summary>
+        /// Most GAS the invocation may consume
+        /// </summary>
+        [Range(0.00000001, double.MaxValue)]
+        public decimal SystemFeeLimit { get; set; }
+
+        /// <summary>
+        /// Hours signatures are collected for; a day if not set
+        /// </summary>
+        [Range(0, 168)]
+        public int ExpiresInHours { get; set; }
+    }
+}
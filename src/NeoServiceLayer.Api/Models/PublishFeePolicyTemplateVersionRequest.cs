@@ -0,0 +1,23 @@
+using System.ComponentModel.DataAnnotations;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for publishing a new version of a GasBank fee policy template
+    /// </summary>
+    public class PublishFeePolicyTemplateVersionRequest
+    {
+        /// <summary>
+        /// Rules of the new version
+        /// </summary>
+        [Required]
+        public GasFeePolicyRules Rules { get; set; }
+
+        /// <summary>
+        /// Notes describing what changed
+        /// </summary>
+        [StringLength(500)]
+        public string Notes { get; set; }
+    }
+}
@@ -0,0 +1,33 @@
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for manually publishing a price round
+    /// </summary>
+    public class PublishPriceRequest
+    {
+        /// <summary>
+        /// Gets or sets the base currency (e.g., "USD", "EUR")
+        /// </summary>
+        [Required]
+        public string BaseCurrency { get; set; } = "USD";
+
+        /// <summary>
+        /// Gets or sets the value to publish; the latest aggregated price is published if not set
+        /// </summary>
+        [Range(0.00000001, double.MaxValue)]
+        public decimal? Value { get; set; }
+
+        /// <summary>
+        /// Gets or sets a value indicating whether the operator confirmed the manual publish
+        /// </summary>
+        public bool Force { get; set; }
+
+        /// <summary>
+        /// Gets or sets the reason for the manual publish, recorded in the audit log
+        /// </summary>
+        [Required]
+        public string Reason { get; set; }
+    }
+}
@@ -0,0 +1,47 @@
+using System;
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for registering an upkeep
+    /// </summary>
+    public class RegisterUpkeepRequest
+    {
+        /// <summary>
+        /// Name of the upkeep
+        /// </summary>
+        [Required]
+        [StringLength(100, MinimumLength = 3)]
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Script hash of the contract implementing checkUpkeep and performUpkeep
+        /// </summary>
+        [Required]
+        public string ContractHash { get; set; }
+
+        /// <summary>
+        /// Hex-encoded data passed to checkUpkeep
+        /// </summary>
+        public string CheckData { get; set; }
+
+        /// <summary>
+        /// GasBank account that pays for performUpkeep transactions
+        /// </summary>
+        [Required]
+        public Guid GasBankAccountId { get; set; }
+
+        /// <summary>
+        /// Maximum GAS a single performUpkeep may consume; the service default if not set
+        /// </summary>
+        [Range(0, double.MaxValue)]
+        public decimal GasLimit { get; set; }
+
+        /// <summary>
+        /// Seconds between checkUpkeep calls; the service minimum if not set
+        /// </summary>
+        [Range(0, int.MaxValue)]
+        public int CheckIntervalSeconds { get; set; }
+    }
+}
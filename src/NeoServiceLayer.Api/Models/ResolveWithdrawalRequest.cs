@@ -0,0 +1,14 @@
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for resolving an in-doubt GasBank withdrawal or payment stream settlement
+    /// </summary>
+    public class ResolveWithdrawalRequest
+    {
+        /// <summary>
+        /// Hash of the transfer if it reached the chain; omit to return a withdrawal's amount to the balance or to
+        /// settle a stream again
+        /// </summary>
+        public string TransactionHash { get; set; }
+    }
+}
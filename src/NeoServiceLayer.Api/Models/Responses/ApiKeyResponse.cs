@@ -0,0 +1,91 @@
+using System;
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Models.Responses
+{
+    /// <summary>
+    /// Response model for an account API key
+    /// </summary>
+    public class ApiKeyResponse
+    {
+        /// <summary>
+        /// Gets or sets the key ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the name of the key
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the key itself; only set when the key is created
+        /// </summary>
+        public string Key { get; set; }
+
+        /// <summary>
+        /// Gets or sets the start of the key
+        /// </summary>
+        public string KeyPrefix { get; set; }
+
+        /// <summary>
+        /// Gets or sets the scopes of the key
+        /// </summary>
+        public List<string> Scopes { get; set; }
+
+        /// <summary>
+        /// Gets or sets the maximum requests per period, or null for no key-specific limit
+        /// </summary>
+        public int? RateLimit { get; set; }
+
+        /// <summary>
+        /// Gets or sets the rate limit period in seconds
+        /// </summary>
+        public int RateLimitPeriodSeconds { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the key was created
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the key expires
+        /// </summary>
+        public DateTime? ExpiresAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the key was revoked
+        /// </summary>
+        public DateTime? RevokedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the key was last used, to the minute
+        /// </summary>
+        public DateTime? LastUsedAt { get; set; }
+
+        /// <summary>
+        /// Creates a response for a credential, leaving out its hash
+        /// </summary>
+        /// <param name="credential">Credential</param>
+        /// <param name="key">The key, only given when it was just created</param>
+        /// <returns>The response</returns>
+        public static ApiKeyResponse FromCredential(ApiCredential credential, string key = null)
+        {
+            return new ApiKeyResponse
+            {
+                Id = credential.Id,
+                Name = credential.Name,
+                Key = key,
+                KeyPrefix = credential.KeyPrefix,
+                Scopes = credential.Scopes,
+                RateLimit = credential.RateLimit,
+                RateLimitPeriodSeconds = credential.RateLimitPeriodSeconds,
+                CreatedAt = credential.CreatedAt,
+                ExpiresAt = credential.ExpiresAt,
+                RevokedAt = credential.RevokedAt,
+                LastUsedAt = credential.LastUsedAt
+            };
+        }
+    }
+}
@@ -0,0 +1,25 @@
+using System;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for scheduling a maintenance window
+    /// </summary>
+    public class ScheduleMaintenanceRequest
+    {
+        /// <summary>
+        /// Start time of the window
+        /// </summary>
+        public DateTime StartTime { get; set; }
+
+        /// <summary>
+        /// End time of the window
+        /// </summary>
+        public DateTime EndTime { get; set; }
+
+        /// <summary>
+        /// Reason shown to users
+        /// </summary>
+        public string Reason { get; set; }
+    }
+}
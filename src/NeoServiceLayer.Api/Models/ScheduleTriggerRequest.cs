@@ -0,0 +1,51 @@
+using System;
+using System.Collections.Generic;
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for creating or updating a schedule trigger
+    /// </summary>
+    public class ScheduleTriggerRequest
+    {
+        /// <summary>
+        /// Name of the trigger
+        /// </summary>
+        [Required]
+        [StringLength(100, MinimumLength = 3)]
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Description of the trigger
+        /// </summary>
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Function to run
+        /// </summary>
+        [Required]
+        public Guid FunctionId { get; set; }
+
+        /// <summary>
+        /// Five-field cron expression in UTC, e.g. "*/15 * * * *"
+        /// </summary>
+        [Required]
+        public string CronExpression { get; set; }
+
+        /// <summary>
+        /// Parameters passed to the function on every run
+        /// </summary>
+        public Dictionary<string, object> Parameters { get; set; }
+
+        /// <summary>
+        /// Whether the trigger fires; true if not set
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Tags on the trigger
+        /// </summary>
+        public List<string> Tags { get; set; }
+    }
+}
@@ -0,0 +1,32 @@
+using System;
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for replaying the scheduler's decisions
+    /// </summary>
+    public class SchedulerReplayRequest
+    {
+        /// <summary>
+        /// Start of the window, inclusive
+        /// </summary>
+        public DateTime From { get; set; }
+
+        /// <summary>
+        /// End of the window, exclusive; defaults to now
+        /// </summary>
+        public DateTime? To { get; set; }
+
+        /// <summary>
+        /// IDs of the stored triggers to replay; every trigger is replayed when neither this nor <see cref="Triggers"/> is set
+        /// </summary>
+        public List<Guid> TriggerIds { get; set; }
+
+        /// <summary>
+        /// Trigger definitions to replay against instead of the stored triggers, e.g. as they were before an update
+        /// </summary>
+        public List<ScheduleTrigger> Triggers { get; set; }
+    }
+}
@@ -0,0 +1,37 @@
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for exporting secrets to another environment
+    /// </summary>
+    public class ExportSecretsRequest
+    {
+        /// <summary>
+        /// Base64 public key from the target environment's GET api/secrets/transfer-key
+        /// </summary>
+        public string RecipientPublicKey { get; set; }
+
+        /// <summary>
+        /// Names of the secrets to export; all of the user's secrets when omitted
+        /// </summary>
+        public List<string> Names { get; set; }
+    }
+
+    /// <summary>
+    /// Request model for importing secrets exported from another environment
+    /// </summary>
+    public class ImportSecretsRequest
+    {
+        /// <summary>
+        /// Bundle returned by the source environment's export
+        /// </summary>
+        public SecretTransferBundle Bundle { get; set; }
+
+        /// <summary>
+        /// Whether secrets that already exist are updated; otherwise the import is rejected
+        /// </summary>
+        public bool Overwrite { get; set; }
+    }
+}
@@ -0,0 +1,13 @@
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for assigning an account to an execution tier
+    /// </summary>
+    public class SetExecutionTierRequest
+    {
+        /// <summary>
+        /// Name of the execution tier
+        /// </summary>
+        public string Tier { get; set; }
+    }
+}
@@ -0,0 +1,18 @@
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for creating or changing a feature flag
+    /// </summary>
+    public class SetFeatureFlagRequest
+    {
+        /// <summary>
+        /// Whether the flag is on
+        /// </summary>
+        public bool Enabled { get; set; }
+
+        /// <summary>
+        /// Optional value, e.g. a weight
+        /// </summary>
+        public string Value { get; set; }
+    }
+}
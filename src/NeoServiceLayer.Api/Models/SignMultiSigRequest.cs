@@ -0,0 +1,23 @@
+using System;
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for signing a multi-signature transaction
+    /// </summary>
+    public class SignMultiSigRequest
+    {
+        /// <summary>
+        /// Wallet holding one of the signers' keys
+        /// </summary>
+        [Required]
+        public Guid WalletId { get; set; }
+
+        /// <summary>
+        /// Wallet password
+        /// </summary>
+        [Required]
+        public string Password { get; set; }
+    }
+}
@@ -0,0 +1,22 @@
+using System.ComponentModel.DataAnnotations;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for customizing a GasBank fee policy
+    /// </summary>
+    public class UpdateFeePolicyRequest
+    {
+        /// <summary>
+        /// Template version to pin; the policy follows the latest version if not set
+        /// </summary>
+        [Range(1, int.MaxValue)]
+        public int? TemplateVersion { get; set; }
+
+        /// <summary>
+        /// Rules replacing the template's
+        /// </summary>
+        public GasFeePolicyRules Overrides { get; set; }
+    }
+}
@@ -0,0 +1,15 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for updating a function's declared permissions
+    /// </summary>
+    public class UpdatePermissionsRequest
+    {
+        /// <summary>
+        /// Capabilities the function may use; null leaves the code unrestricted
+        /// </summary>
+        public List<string> Permissions { get; set; }
+    }
+}
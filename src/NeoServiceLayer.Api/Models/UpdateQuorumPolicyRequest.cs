@@ -0,0 +1,39 @@
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for setting the quorum policy of a function
+    /// </summary>
+    public class UpdateQuorumPolicyRequest
+    {
+        /// <summary>
+        /// How many nodes run each execution, including the local enclave
+        /// </summary>
+        [Range(2, 100)]
+        public int Nodes { get; set; } = 3;
+
+        /// <summary>
+        /// How many nodes must agree; more than half of the nodes
+        /// </summary>
+        [Range(2, 100)]
+        public int Threshold { get; set; } = 2;
+
+        /// <summary>
+        /// How results are compared: exact or numeric
+        /// </summary>
+        public string Comparison { get; set; } = "exact";
+
+        /// <summary>
+        /// JSON path of the compared value within the function's result; the whole result if not set
+        /// </summary>
+        [StringLength(200)]
+        public string ResultPath { get; set; }
+
+        /// <summary>
+        /// How far a numeric result may be from the median, as a fraction of the median
+        /// </summary>
+        [Range(0, 1)]
+        public decimal Tolerance { get; set; }
+    }
+}
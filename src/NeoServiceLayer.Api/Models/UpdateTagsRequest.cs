@@ -0,0 +1,17 @@
+using System.Collections.Generic;
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for replacing the tags of a function or trigger
+    /// </summary>
+    public class UpdateTagsRequest
+    {
+        /// <summary>
+        /// New tags; an empty list removes all tags
+        /// </summary>
+        [Required]
+        public List<string> Tags { get; set; }
+    }
+}
@@ -0,0 +1,27 @@
+using System.ComponentModel.DataAnnotations;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for watching a deployed contract for upgrades
+    /// </summary>
+    public class WatchContractRequest
+    {
+        /// <summary>
+        /// Script hash of the contract, 0x-prefixed
+        /// </summary>
+        [Required]
+        public string ContractHash { get; set; }
+
+        /// <summary>
+        /// Label shown in alerts
+        /// </summary>
+        [StringLength(100)]
+        public string Label { get; set; }
+
+        /// <summary>
+        /// Whether to pause the current user's active event subscriptions on the contract when it is updated or destroyed
+        /// </summary>
+        public bool PauseDependentSubscriptions { get; set; }
+    }
+}
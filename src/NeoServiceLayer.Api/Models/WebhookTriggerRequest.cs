@@ -0,0 +1,61 @@
+using System;
+using System.Collections.Generic;
+using System.ComponentModel.DataAnnotations;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Api.Models
+{
+    /// <summary>
+    /// Request model for creating or updating a webhook trigger
+    /// </summary>
+    public class WebhookTriggerRequest
+    {
+        /// <summary>
+        /// Name of the trigger
+        /// </summary>
+        [Required]
+        [StringLength(100, MinimumLength = 1)]
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Description of the trigger
+        /// </summary>
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Function to execute for each delivery
+        /// </summary>
+        [Required]
+        public Guid FunctionId { get; set; }
+
+        /// <summary>
+        /// Whether deliveries are accepted
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Shared secret for HMAC-SHA256 signature verification. On update, omit to keep the current secret or send an empty string to accept unsigned deliveries
+        /// </summary>
+        public string SigningSecret { get; set; }
+
+        /// <summary>
+        /// Header carrying the signature
+        /// </summary>
+        public string SignatureHeader { get; set; } = "X-Hub-Signature-256";
+
+        /// <summary>
+        /// Rules that shape the delivery into function parameters
+        /// </summary>
+        public WebhookTransformation Transformation { get; set; } = new WebhookTransformation();
+
+        /// <summary>
+        /// How the function result is returned to the sender: "Envelope" (default) or "Http" to use the function's own {status, headers, body}
+        /// </summary>
+        public string ResponseMode { get; set; } = WebhookResponseModes.Envelope;
+
+        /// <summary>
+        /// Tags used to organize and filter triggers
+        /// </summary>
+        public List<string> Tags { get; set; } = new List<string>();
+    }
+}
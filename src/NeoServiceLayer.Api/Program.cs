@@ -123,6 +123,36 @@ catch (Exception ex)
     logger.LogError(ex, "An error occurred during database initialization");
 }
 
+// Compete for the leadership lease before recovering: only the holder resolves work left in doubt, so a standby
+// starting up, or a replica restarting while another instance leads, leaves the leader's in-flight work alone
+var leadership = app.Services.GetRequiredService<ILeadershipService>();
+await leadership.StartAsync();
+
+// Resume or resolve work the previous process left in doubt before taking new requests; a standby does this once it
+// takes the lease over
+var recoveryService = app.Services.GetRequiredService<IStartupRecoveryService>();
+async Task RecoverAsync()
+{
+    try
+    {
+        await recoveryService.RecoverAsync();
+    }
+    catch (Exception ex)
+    {
+        var logger = app.Services.GetRequiredService<ILogger<Program>>();
+        logger.LogError(ex, "An error occurred during startup recovery");
+    }
+}
+
+leadership.LeadershipAcquired += (_, _) => _ = RecoverAsync();
+await RecoverAsync();
+
+// Start the background services in dependency order; on shutdown they stop in reverse, so leader-only work finishes
+// before the leadership lease is released, and each stop is bounded by its own timeout
+var serviceRunner = app.Services.GetRequiredService<IServiceRunner>();
+await serviceRunner.StartAllAsync();
+app.Lifetime.ApplicationStopping.Register(() => serviceRunner.StopAllAsync().GetAwaiter().GetResult());
+
 // Configure the HTTP request pipeline
 startup.Configure(app, app.Environment);
 
@@ -62,11 +62,11 @@ namespace NeoServiceLayer.API.RateLimiting
             }
 
             // Get client identifier
-            var clientId = GetClientIdentifier(context);
+            var clientId = GetClientIdentifier(context, _options);
             var endpoint = $"{context.Request.Method}:{context.Request.Path}";
 
             // Get rate limit for this client and endpoint
-            var (limit, periodSeconds) = GetRateLimit(clientId, endpoint);
+            var (limit, periodSeconds) = GetRateLimit(_options, clientId, endpoint);
 
             // Get or create counter
             var counter = await GetCounterAsync(clientId, endpoint, limit, periodSeconds);
@@ -109,10 +109,16 @@ namespace NeoServiceLayer.API.RateLimiting
             await _next(context);
         }
 
-        private string GetClientIdentifier(HttpContext context)
+        /// <summary>
+        /// Gets the identifier requests are counted under
+        /// </summary>
+        /// <param name="context">HTTP context</param>
+        /// <param name="options">Rate limiting options</param>
+        /// <returns>The client ID header, user name or IP address</returns>
+        internal static string GetClientIdentifier(HttpContext context, RateLimitingOptions options)
         {
             // Try to get client ID from header
-            if (context.Request.Headers.TryGetValue(_options.ClientIdHeader, out var clientId) && !string.IsNullOrEmpty(clientId))
+            if (context.Request.Headers.TryGetValue(options.ClientIdHeader, out var clientId) && !string.IsNullOrEmpty(clientId))
             {
                 return clientId;
             }
@@ -127,10 +133,17 @@ namespace NeoServiceLayer.API.RateLimiting
             return context.Connection.RemoteIpAddress?.ToString() ?? "unknown";
         }
 
-        private (int limit, int periodSeconds) GetRateLimit(string clientId, string endpoint)
+        /// <summary>
+        /// Gets the rate limit for a client on an endpoint
+        /// </summary>
+        /// <param name="options">Rate limiting options</param>
+        /// <param name="clientId">Client identifier</param>
+        /// <param name="endpoint">Endpoint path</param>
+        /// <returns>The limit and its period in seconds</returns>
+        internal static (int limit, int periodSeconds) GetRateLimit(RateLimitingOptions options, string clientId, string endpoint)
         {
             // Check for endpoint-specific rate limit
-            foreach (var endpointLimit in _options.EndpointRateLimits)
+            foreach (var endpointLimit in options.EndpointRateLimits)
             {
                 if (endpoint.StartsWith(endpointLimit.Key, StringComparison.OrdinalIgnoreCase))
                 {
@@ -139,19 +152,19 @@ namespace NeoServiceLayer.API.RateLimiting
             }
 
             // Check for client-specific rate limit
-            if (_options.ClientRateLimits.TryGetValue(clientId, out var clientLimit))
+            if (options.ClientRateLimits.TryGetValue(clientId, out var clientLimit))
             {
                 return (clientLimit.Limit, clientLimit.PeriodSeconds);
             }
 
             // Check for IP-specific rate limit
-            if (_options.IpRateLimits.TryGetValue(clientId, out var ipLimit))
+            if (options.IpRateLimits.TryGetValue(clientId, out var ipLimit))
             {
-                return (ipLimit, _options.DefaultPeriodSeconds);
+                return (ipLimit, options.DefaultPeriodSeconds);
             }
 
             // Use default rate limit
-            return (_options.DefaultLimit, _options.DefaultPeriodSeconds);
+            return (options.DefaultLimit, options.DefaultPeriodSeconds);
         }
 
         private async Task<RateLimitCounter> GetCounterAsync(string clientId, string endpoint, int limit, int periodSeconds)
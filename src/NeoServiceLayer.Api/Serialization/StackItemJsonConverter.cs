@@ -0,0 +1,37 @@
+using System;
+using System.Text.Json;
+using System.Text.Json.Serialization;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.API.Serialization
+{
+    /// <summary>
+    /// Writes Neo VM stack items in API responses as decoded values instead of raw type and Base64 pairs
+    /// </summary>
+    /// <remarks>
+    /// See <see cref="StackItemDecoder"/> for how each stack item type is decoded. Stack items are never accepted
+    /// in requests, so reading is not supported.
+    /// </remarks>
+    public class StackItemJsonConverter : JsonConverter<NeoStackItem>
+    {
+        /// <inheritdoc/>
+        public override NeoStackItem Read(ref Utf8JsonReader reader, Type typeToConvert, JsonSerializerOptions options)
+        {
+            throw new NotSupportedException("Stack items cannot be read from JSON");
+        }
+
+        /// <inheritdoc/>
+        public override void Write(Utf8JsonWriter writer, NeoStackItem value, JsonSerializerOptions options)
+        {
+            var decoded = StackItemDecoder.Decode(value);
+            if (decoded == null)
+            {
+                writer.WriteNullValue();
+                return;
+            }
+
+            JsonSerializer.Serialize(writer, decoded, decoded.GetType(), options);
+        }
+    }
+}
@@ -1,5 +1,6 @@
 using System;
 using System.Linq;
+using System.Net.Http;
 using FluentValidation;
 using FluentValidation.AspNetCore;
 using Microsoft.AspNetCore.Authentication.JwtBearer;
@@ -13,22 +14,55 @@ using Microsoft.Extensions.Logging;
 using Microsoft.Extensions.Options;
 using Microsoft.OpenApi.Models;
 using NeoServiceLayer.API.Auth;
+using NeoServiceLayer.API.Filters;
 using NeoServiceLayer.API.HealthChecks;
 using NeoServiceLayer.API.Middleware;
 using NeoServiceLayer.API.RateLimiting;
+using NeoServiceLayer.API.Serialization;
 using NeoServiceLayer.API.Swagger;
 using NeoServiceLayer.API.Tracing;
 using NeoServiceLayer.API.Validation;
+using NeoServiceLayer.API.WebSockets;
 using NeoServiceLayer.Core.Interfaces;
 using NeoServiceLayer.Core.Models;
 using NeoServiceLayer.Core.Models.Analytics;
 using NeoServiceLayer.Services.Account;
 using NeoServiceLayer.Services.Account.Repositories;
+using NeoServiceLayer.Services.Automation;
+using NeoServiceLayer.Services.Automation.Repositories;
+using NeoServiceLayer.Services.Blockchain;
+using NeoServiceLayer.Services.Diagnostics;
 using NeoServiceLayer.Services.Enclave;
+using NeoServiceLayer.Services.Encryption;
+using NeoServiceLayer.Services.Encryption.Repositories;
+using NeoServiceLayer.Services.Events;
 using NeoServiceLayer.Services.Function;
 using NeoServiceLayer.Services.Function.Repositories;
+using NeoServiceLayer.Services.GasBank;
+using NeoServiceLayer.Services.JobSpecs;
+using NeoServiceLayer.Services.Lifecycle;
+using NeoServiceLayer.Services.Impersonation;
+using NeoServiceLayer.Services.Impersonation.Repositories;
+using NeoServiceLayer.Services.ApiCredentials;
+using NeoServiceLayer.Services.ApiCredentials.Repositories;
+using NeoServiceLayer.Services.FeatureFlags;
+using NeoServiceLayer.Services.FeatureFlags.Repositories;
+using NeoServiceLayer.Services.Maintenance;
+using NeoServiceLayer.Services.Maintenance.Repositories;
+using NeoServiceLayer.Services.Logging;
+using NeoServiceLayer.Services.Logging.Repositories;
+using NeoServiceLayer.Services.Metrics;
 using NeoServiceLayer.Services.PriceFeed;
+using NeoServiceLayer.Services.PriceFeed.Aggregation;
+using NeoServiceLayer.Services.PriceFeed.Contract;
+using NeoServiceLayer.Services.PriceFeed.DataSources;
+using NeoServiceLayer.Services.PriceFeed.GasPrices;
 using NeoServiceLayer.Services.PriceFeed.Repositories;
+using NeoServiceLayer.Services.Provenance;
+using NeoServiceLayer.Services.Quorum;
+using NeoServiceLayer.Services.Recovery;
+using NeoServiceLayer.Services.Scheduling;
+using NeoServiceLayer.Services.Scheduling.Repositories;
 using NeoServiceLayer.Services.Secrets;
 using NeoServiceLayer.Services.Secrets.Repositories;
 using NeoServiceLayer.Services.Storage;
@@ -40,6 +74,8 @@ using NeoServiceLayer.Services.Storage.Migration;
 using NeoServiceLayer.Services.Storage.Monitoring;
 using NeoServiceLayer.Services.Storage.Providers;
 using NeoServiceLayer.Services.Storage.Sharding;
+using NeoServiceLayer.Services.Storage.Versioning;
+using NeoServiceLayer.Services.Storage.Versioning.Upgraders;
 using NeoServiceLayer.Services.EventMonitoring;
 using NeoServiceLayer.Services.EventMonitoring.Repositories;
 using NeoServiceLayer.Services.Notification;
@@ -66,7 +102,19 @@ namespace NeoServiceLayer.Api
         public void ConfigureServices(IServiceCollection services)
         {
             // Add controllers with FluentValidation
-            services.AddControllers()
+            services.AddControllers(options =>
+                {
+                    // Return the request ID in error bodies
+                    options.Filters.Add<RequestIdResultFilter>();
+
+                    // Hold account API keys to their scopes
+                    options.Filters.Add<ApiScopeFilter>();
+                })
+                .AddJsonOptions(options =>
+                {
+                    // Return Neo VM stack items as decoded values
+                    options.JsonSerializerOptions.Converters.Add(new StackItemJsonConverter());
+                })
                 .AddFluentValidation(fv =>
                 {
                     fv.RegisterValidatorsFromAssemblyContaining<FunctionTestValidator>();
@@ -106,6 +154,9 @@ namespace NeoServiceLayer.Api
                 // Add extended function API documentation
                 c.AddFunctionExtendedApiDocumentation();
 
+                // Security requirements, operation IDs and schema IDs for SDK generators
+                c.AddOpenApiConventions();
+
                 // Enable XML comments
                 var xmlFiles = System.IO.Directory.GetFiles(AppContext.BaseDirectory, "*.xml");
                 foreach (var xmlFile in xmlFiles)
@@ -114,17 +165,52 @@ namespace NeoServiceLayer.Api
                 }
             });
 
+            services.Configure<OpenApiConfiguration>(Configuration.GetSection("OpenApi"));
+
             // Add service registrations
+            // Crash reporting
+            services.AddSingleton<CrashReporter>();
+            services.AddSingleton<ICrashReporter>(provider => provider.GetRequiredService<CrashReporter>());
+            services.Configure<CrashReportingConfiguration>(Configuration.GetSection("CrashReporting"));
+
+            // Live end-to-end checks for post-deploy validation; scoped because the wallet and price services are
+            services.AddScoped<ISystemVerificationService, SystemVerificationService>();
+            services.Configure<SystemVerificationConfiguration>(Configuration.GetSection("SystemVerification"));
+
             // Core services
             services.AddSingleton<IEnclaveService, EnclaveService>();
 
+            // Build provenance
+            services.AddSingleton<IProvenanceService, ProvenanceService>();
+            services.Configure<ProvenanceConfiguration>(Configuration.GetSection("Provenance"));
+
+            // Data encryption services
+            services.AddSingleton<IDataKeyRepository, DataKeyRepository>();
+            services.AddSingleton<IDataKeyService, DataKeyService>();
+            services.AddSingleton<IExecutionDataProtector, ExecutionDataProtector>();
+            services.Configure<DataEncryptionConfiguration>(Configuration.GetSection("DataEncryption"));
+
+            // Server events pushed to WebSocket subscribers
+            services.AddServerEventServices();
+            services.AddSingleton<ServerEventWebSocketHandler>();
+            services.Configure<ServerEventConfiguration>(Configuration.GetSection("ServerEvents"));
+
+            // Blockchain services
+            services.AddSingleton<NeoRpcResponseCache>();
+            services.AddSingleton<INeoRpcClient, NeoRpcClient>();
+            services.Configure<NeoRpcConfiguration>(Configuration.GetSection("NeoRpc"));
+
             // Account services
             services.AddScoped<IAccountRepository, AccountRepository>();
             services.AddScoped<IAccountService, AccountService>();
+            services.AddScoped<IAccountLimitsService, AccountLimitsService>();
+            services.Configure<AccountLimitsConfiguration>(Configuration.GetSection("AccountLimits"));
 
             // Wallet services
             services.AddScoped<IWalletRepository, WalletRepository>();
             services.AddScoped<IWalletService, WalletService>();
+            services.AddSingleton<IMultiSigTransactionRepository, MultiSigTransactionRepository>();
+            services.AddScoped<IMultiSigService, MultiSigService>();
 
             // Secrets services
             services.AddScoped<ISecretsRepository>(provider => {
@@ -134,10 +220,49 @@ namespace NeoServiceLayer.Api
                 return new SecretsRepository(logger, storageProvider);
             });
             services.AddScoped<ISecretsService, SecretsService>();
+            services.AddSingleton<ISecretGrantRepository, SecretGrantRepository>();
+            services.AddScoped<ISecretGrantService, SecretGrantService>();
+            services.AddScoped<ISecretTransferService, SecretTransferService>();
 
             // Function services
             services.AddFunctionServices(Configuration);
 
+            // Quorum execution of critical functions across independent nodes
+            services.AddQuorumServices(Configuration);
+
+            // GasBank services
+            services.AddGasBankRepositories(Configuration);
+            services.AddSingleton<IGasBankService, GasBankService>();
+            services.AddSingleton<IGasPaymentStreamService, GasPaymentStreamService>();
+            services.AddSingleton<IGasRecurringWithdrawalService, GasRecurringWithdrawalService>();
+            services.AddSingleton<IGasFeePolicyService, GasFeePolicyService>();
+            services.AddGasBankRecovery();
+            services.Configure<GasBankConfiguration>(Configuration.GetSection("GasBank"));
+
+            // Maintenance services
+            services.AddSingleton<IMaintenanceWindowRepository, MaintenanceWindowRepository>();
+            services.AddSingleton<IMaintenanceService, MaintenanceService>();
+            services.Configure<MaintenanceConfiguration>(Configuration.GetSection("Maintenance"));
+
+            // Support impersonation
+            services.AddSingleton<IImpersonationGrantRepository, ImpersonationGrantRepository>();
+            services.AddSingleton<IImpersonationAuditRepository, ImpersonationAuditRepository>();
+            services.AddSingleton<IImpersonationService, ImpersonationService>();
+            services.Configure<ImpersonationConfiguration>(Configuration.GetSection("Impersonation"));
+
+            // Feature flags
+            services.AddSingleton<IFeatureFlagRepository, FeatureFlagRepository>();
+            services.AddSingleton<IFeatureFlagService, FeatureFlagService>();
+            services.Configure<FeatureFlagConfiguration>(Configuration.GetSection("FeatureFlags"));
+
+            // Account API keys
+            services.AddSingleton<IApiCredentialRepository, ApiCredentialRepository>();
+            services.AddSingleton<IApiCredentialService, ApiCredentialService>();
+            services.Configure<ApiCredentialConfiguration>(Configuration.GetSection("ApiCredentials"));
+
+            // Admin dashboard
+            services.Configure<AdminUiConfiguration>(Configuration.GetSection("AdminUi"));
+
             // Price feed services
             services.AddScoped<PriceRepository>();
             services.AddScoped<IPriceRepository>(serviceProvider => {
@@ -158,6 +283,21 @@ namespace NeoServiceLayer.Api
             services.AddScoped<IPriceSourceRepository, PriceSourceRepository>();
             services.AddScoped<IPriceHistoryRepository, PriceHistoryRepository>();
             services.AddScoped<IPriceFeedService, PriceFeedService>();
+            services.AddScoped<IPriceFeedAccessService, PriceFeedAccessService>();
+            services.Configure<PriceFeedAccessConfiguration>(Configuration.GetSection("PriceFeedAccess"));
+            services.AddScoped<IPriceFeedContractService, PriceFeedContractService>();
+            services.AddScoped<IPriceFeedRoundAuditor, PriceFeedRoundAuditor>();
+            services.Configure<PriceFeedContractConfiguration>(Configuration.GetSection("PriceFeed:Contract"));
+            services.AddSingleton<HttpClient>();
+            services.AddSingleton<PriceDataSourceFactory>();
+            services.AddSingleton<PriceSourceHealthTracker>();
+            services.AddScoped<IPriceAggregationService, PriceAggregationService>();
+            services.Configure<PriceAggregationConfiguration>(Configuration.GetSection("PriceFeed:Aggregation"));
+            services.AddSingleton<IPricePublicationRepository, PricePublicationRepository>();
+            services.AddSingleton<IPricePublicationService, PricePublicationService>();
+            services.Configure<PricePublicationConfiguration>(Configuration.GetSection("PriceFeed:Publication"));
+            services.AddSingleton<IGasPriceOracleService, GasPriceOracleService>();
+            services.Configure<GasPriceOracleConfiguration>(Configuration.GetSection("PriceFeed:GasPrices"));
 
             // Storage services
             var storageConfig = Configuration.GetSection("Storage").Get<StorageConfiguration>();
@@ -180,6 +320,13 @@ namespace NeoServiceLayer.Api
             // Register database migration service
             services.AddSingleton<DatabaseMigrationService>();
 
+            // Register record upgraders, applied on read by the versioned storage decorator
+            services.AddSingleton<IRecordUpgrader, ScheduleTriggerUpgrader>();
+            services.AddSingleton<IRecordUpgrader, GasBankAccountUpgrader>();
+            services.AddSingleton<IRecordUpgrader, GasBankAllocationUpgrader>();
+            services.AddSingleton<IRecordUpgrader, SecretUpgrader>();
+            services.AddSingleton<IRecordMigrationService, RecordMigrationService>();
+
             // Register circuit breaker factory
             services.AddSingleton<CircuitBreakerFactory>();
 
@@ -202,7 +349,10 @@ namespace NeoServiceLayer.Api
                         var innerProvider = provider.GetRequiredService<S3StorageProvider>();
                         var metricsCollector = provider.GetRequiredService<DatabaseMetricsCollector>();
                         var logger = provider.GetRequiredService<ILogger<MetricsStorageProviderDecorator>>();
-                        return new MetricsStorageProviderDecorator(innerProvider, metricsCollector, logger);
+                        var metricsDecorator = new MetricsStorageProviderDecorator(innerProvider, metricsCollector, logger);
+
+                        var versionedLogger = provider.GetRequiredService<ILogger<VersionedStorageProviderDecorator>>();
+                        return new VersionedStorageProviderDecorator(metricsDecorator, provider.GetServices<IRecordUpgrader>(), versionedLogger);
                     });
                 }
 
@@ -220,7 +370,10 @@ namespace NeoServiceLayer.Api
                         var circuitBreakerFactory = provider.GetRequiredService<CircuitBreakerFactory>();
                         var circuitBreakerLogger = provider.GetRequiredService<ILogger<CircuitBreakerStorageProviderDecorator>>();
                         var circuitBreakerConfig = provider.GetRequiredService<IOptions<CircuitBreakerConfiguration>>();
-                        return new CircuitBreakerStorageProviderDecorator(metricsDecorator, circuitBreakerFactory, circuitBreakerLogger, circuitBreakerConfig);
+                        var circuitBreakerDecorator = new CircuitBreakerStorageProviderDecorator(metricsDecorator, circuitBreakerFactory, circuitBreakerLogger, circuitBreakerConfig);
+
+                        var versionedLogger = provider.GetRequiredService<ILogger<VersionedStorageProviderDecorator>>();
+                        return new VersionedStorageProviderDecorator(circuitBreakerDecorator, provider.GetServices<IRecordUpgrader>(), versionedLogger);
                     });
                 }
 
@@ -238,7 +391,10 @@ namespace NeoServiceLayer.Api
                         var circuitBreakerFactory = provider.GetRequiredService<CircuitBreakerFactory>();
                         var circuitBreakerLogger = provider.GetRequiredService<ILogger<CircuitBreakerStorageProviderDecorator>>();
                         var circuitBreakerConfig = provider.GetRequiredService<IOptions<CircuitBreakerConfiguration>>();
-                        return new CircuitBreakerStorageProviderDecorator(metricsDecorator, circuitBreakerFactory, circuitBreakerLogger, circuitBreakerConfig);
+                        var circuitBreakerDecorator = new CircuitBreakerStorageProviderDecorator(metricsDecorator, circuitBreakerFactory, circuitBreakerLogger, circuitBreakerConfig);
+
+                        var versionedLogger = provider.GetRequiredService<ILogger<VersionedStorageProviderDecorator>>();
+                        return new VersionedStorageProviderDecorator(circuitBreakerDecorator, provider.GetServices<IRecordUpgrader>(), versionedLogger);
                     });
                 }
 
@@ -256,7 +412,10 @@ namespace NeoServiceLayer.Api
                         var circuitBreakerFactory = provider.GetRequiredService<CircuitBreakerFactory>();
                         var circuitBreakerLogger = provider.GetRequiredService<ILogger<CircuitBreakerStorageProviderDecorator>>();
                         var circuitBreakerConfig = provider.GetRequiredService<IOptions<CircuitBreakerConfiguration>>();
-                        return new CircuitBreakerStorageProviderDecorator(metricsDecorator, circuitBreakerFactory, circuitBreakerLogger, circuitBreakerConfig);
+                        var circuitBreakerDecorator = new CircuitBreakerStorageProviderDecorator(metricsDecorator, circuitBreakerFactory, circuitBreakerLogger, circuitBreakerConfig);
+
+                        var versionedLogger = provider.GetRequiredService<ILogger<VersionedStorageProviderDecorator>>();
+                        return new VersionedStorageProviderDecorator(circuitBreakerDecorator, provider.GetServices<IRecordUpgrader>(), versionedLogger);
                     });
                 }
 
@@ -274,7 +433,10 @@ namespace NeoServiceLayer.Api
                         var circuitBreakerFactory = provider.GetRequiredService<CircuitBreakerFactory>();
                         var circuitBreakerLogger = provider.GetRequiredService<ILogger<CircuitBreakerStorageProviderDecorator>>();
                         var circuitBreakerConfig = provider.GetRequiredService<IOptions<CircuitBreakerConfiguration>>();
-                        return new CircuitBreakerStorageProviderDecorator(metricsDecorator, circuitBreakerFactory, circuitBreakerLogger, circuitBreakerConfig);
+                        var circuitBreakerDecorator = new CircuitBreakerStorageProviderDecorator(metricsDecorator, circuitBreakerFactory, circuitBreakerLogger, circuitBreakerConfig);
+
+                        var versionedLogger = provider.GetRequiredService<ILogger<VersionedStorageProviderDecorator>>();
+                        return new VersionedStorageProviderDecorator(circuitBreakerDecorator, provider.GetServices<IRecordUpgrader>(), versionedLogger);
                     });
                 }
             }
@@ -282,6 +444,10 @@ namespace NeoServiceLayer.Api
             // Event monitoring services
             services.AddScoped<IEventSubscriptionRepository, EventSubscriptionRepository>();
             services.AddScoped<IEventLogRepository, EventLogRepository>();
+            services.AddSingleton<IWatchedContractRepository, WatchedContractRepository>();
+            services.AddSingleton<GovernanceEventSource>();
+            services.AddSingleton<NotificationEventSource>();
+            services.AddSingleton<IContractWatchService, ContractWatchService>();
             services.AddSingleton<IEventMonitoringService, EventMonitoringService>();
             services.Configure<EventMonitoringConfiguration>(Configuration.GetSection("EventMonitoring"));
 
@@ -290,6 +456,7 @@ namespace NeoServiceLayer.Api
             services.AddScoped<INotificationTemplateRepository, NotificationTemplateRepository>();
             services.AddScoped<IUserNotificationPreferencesRepository, UserNotificationPreferencesRepository>();
             services.AddSingleton<INotificationService, NotificationService>();
+            services.AddNotificationRecovery();
             services.Configure<NotificationConfiguration>(Configuration.GetSection("Notification"));
 
             // Notification providers
@@ -297,8 +464,40 @@ namespace NeoServiceLayer.Api
             services.AddSingleton<INotificationProvider, SmsNotificationProvider>();
             services.AddSingleton<INotificationProvider, PushNotificationProvider>();
             services.AddSingleton<INotificationProvider, WebhookNotificationProvider>();
+            services.AddSingleton<INotificationProvider, TelegramNotificationProvider>();
             services.AddSingleton<INotificationProvider, InAppNotificationProvider>();
 
+            // Idempotency tokens for scheduled trigger firings
+            services.AddSingleton<ITriggerFiringRepository, TriggerFiringRepository>();
+            services.AddSingleton<TriggerFiringCoordinator>();
+            services.AddSingleton<TriggerFiringRetentionService>();
+
+            // Leadership lease electing the active Functions/Trigger instance
+            services.AddSingleton<IServiceLeaseRepository, ServiceLeaseRepository>();
+            services.AddSingleton<ILeadershipService, LeadershipService>();
+            services.Configure<LeadershipConfiguration>(Configuration.GetSection("Leadership"));
+
+            // Cron schedule triggers, fired once per fire time by the leader
+            services.AddSingleton<IScheduleTriggerRepository, ScheduleTriggerRepository>();
+            services.AddSingleton<IScheduleTriggerService, ScheduleTriggerService>();
+            services.Configure<SchedulerConfiguration>(Configuration.GetSection("Scheduler"));
+
+            // Replay log of the scheduler's decisions, for diagnosing missed or duplicate firings
+            services.AddSingleton<ISchedulerDecisionRepository, SchedulerDecisionRepository>();
+            services.AddSingleton<SchedulerReplayLog>();
+
+            // Automation of contract upkeeps, paid from GasBank accounts
+            services.AddSingleton<IUpkeepRepository, UpkeepRepository>();
+            services.AddSingleton<IAutomationService, AutomationService>();
+            services.Configure<AutomationConfiguration>(Configuration.GetSection("Automation"));
+
+            // Pool of service wallet accounts that sign trigger transactions
+            services.AddSingleton<ISignerPool, SignerPool>();
+            services.Configure<SignerPoolConfiguration>(Configuration.GetSection("SignerPool"));
+
+            // Import of Chainlink job specs as functions, triggers and upkeeps
+            services.AddSingleton<IJobSpecImportService, JobSpecImportService>();
+
             // Analytics services
             services.AddScoped<IMetricRepository, MetricRepository>();
             services.AddScoped<IEventRepository, EventRepository>();
@@ -307,13 +506,53 @@ namespace NeoServiceLayer.Api
             services.AddScoped<IAlertRepository, AlertRepository>();
             services.AddSingleton<IAnalyticsService, AnalyticsService>();
             services.Configure<AnalyticsConfiguration>(Configuration.GetSection("Analytics"));
+
+            // Metrics services
+            services.AddSingleton<NeoServiceLayer.Services.Metrics.Repositories.IMetricAlertRuleRepository, NeoServiceLayer.Services.Metrics.Repositories.MetricAlertRuleRepository>();
+            services.AddSingleton<IMetricsService, MetricsService>();
+            services.Configure<MetricAlertConfiguration>(Configuration.GetSection("MetricAlerts"));
+            services.Configure<MetricsStorageConfiguration>(Configuration.GetSection("MetricsStorage"));
+
+            // Log alert rules over the service's own log stream
+            services.AddSingleton<LogAlertQueue>();
+            services.AddSingleton<ILoggerProvider, LogAlertLoggerProvider>();
+            services.AddSingleton<ILogAlertRuleRepository, LogAlertRuleRepository>();
+            services.AddSingleton<ILogAlertService, LogAlertService>();
+            services.Configure<LogAlertConfiguration>(Configuration.GetSection("LogAlerts"));
+            services.AddMetricStore(Configuration);
+
+            // Startup recovery of state a previous process left in doubt
+            services.AddStartupRecovery();
+
+            // Background services, started in dependency order and stopped in reverse; independent services start in
+            // the order listed here, so log alerts start first and stop last to catch errors from the others' shutdown
+            services.AddSingleton<IManagedService>(p => (IManagedService)p.GetRequiredService<ILogAlertService>());
+            services.AddSingleton<IManagedService>(p => (IManagedService)p.GetRequiredService<IGasBankService>());
+            services.AddSingleton<IManagedService>(p => (IManagedService)p.GetRequiredService<IFunctionService>());
+            services.AddSingleton<IManagedService>(p => (IManagedService)p.GetRequiredService<ILeadershipService>());
+            services.AddSingleton<IManagedService>(p => (IManagedService)p.GetRequiredService<IScheduleTriggerService>());
+            services.AddSingleton<IManagedService>(p => p.GetRequiredService<TriggerFiringRetentionService>());
+            services.AddSingleton<IManagedService>(p => (IManagedService)p.GetRequiredService<IAutomationService>());
+            services.AddSingleton<IManagedService>(p => (IManagedService)p.GetRequiredService<IGasPaymentStreamService>());
+            services.AddSingleton<IManagedService>(p => (IManagedService)p.GetRequiredService<IGasRecurringWithdrawalService>());
+            services.AddSingleton<IManagedService>(p => (IManagedService)p.GetRequiredService<IPricePublicationService>());
+            services.AddSingleton<IManagedService>(p => (IManagedService)p.GetRequiredService<IGasPriceOracleService>());
+            services.AddSingleton<IManagedService>(p => (IManagedService)p.GetRequiredService<IAsyncInvocationService>());
+            services.AddSingleton<IServiceRunner, ServiceRunner>();
+            services.Configure<ServiceRunnerConfiguration>(Configuration.GetSection("ServiceRunner"));
         }
 
         public void Configure(IApplicationBuilder app, IWebHostEnvironment env)
         {
+            // Report exceptions that escape background work
+            app.ApplicationServices.GetRequiredService<CrashReporter>().RegisterGlobalHandlers();
+
             // Initialize database service
             _databaseService = app.ApplicationServices.GetRequiredService<IDatabaseService>();
 
+            // Assign request IDs first so every later middleware logs them
+            app.UseRequestId();
+
             // Use performance monitoring middleware
             app.UsePerformanceMonitoring();
 
@@ -344,14 +583,32 @@ namespace NeoServiceLayer.Api
                 app.UseGlobalErrorHandling();
             }
 
+            var openApi = app.ApplicationServices.GetRequiredService<IOptions<OpenApiConfiguration>>().Value;
+            if (openApi.Enabled)
+            {
+                app.UseOpenApiDocs(openApi);
+            }
+
             app.UseHttpsRedirection();
+            app.UseWebSockets(new WebSocketOptions { KeepAliveInterval = TimeSpan.FromSeconds(30) });
             app.UseRouting();
             app.UseAuthentication();
+            app.UseImpersonation();
+            app.UseApiCredentials();
             app.UseAuthorization();
 
             app.UseEndpoints(endpoints =>
             {
                 endpoints.MapControllers();
+
+                if (openApi.Enabled)
+                {
+                    endpoints.MapOpenApiDocument(openApi).AllowAnonymous();
+                }
+
+                // Server event stream; authenticates with the JWT itself so browsers can pass it in the query string
+                var serverEvents = app.ApplicationServices.GetRequiredService<ServerEventWebSocketHandler>();
+                endpoints.Map("/ws/events", serverEvents.HandleAsync);
             });
 
             // Use health checks
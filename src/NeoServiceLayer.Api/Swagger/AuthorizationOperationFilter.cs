@@ -0,0 +1,107 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Reflection;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.OpenApi.Any;
+using Microsoft.OpenApi.Models;
+using NeoServiceLayer.API.Filters;
+using NeoServiceLayer.Core.Models;
+using Swashbuckle.AspNetCore.SwaggerGen;
+
+namespace NeoServiceLayer.API.Swagger
+{
+    /// <summary>
+    /// Operation filter that documents how each endpoint is authorized, read from its attributes
+    /// </summary>
+    /// <remarks>
+    /// Endpoints needing authentication accept a bearer token or an API key and can answer 401; those
+    /// limited to roles can answer 403. The scope a key needs, from an <see cref="ApiScopeAttribute"/> or for reads
+    /// from the controller's <see cref="ApiReadScopeAttribute"/>, is listed in the description and as the
+    /// <c>x-api-scope</c> extension, so SDK generators can tell which scope a key needs.
+    /// </remarks>
+    public class AuthorizationOperationFilter : IOperationFilter
+    {
+        /// <summary>
+        /// Name of the bearer token security scheme
+        /// </summary>
+        public const string BearerScheme = "Bearer";
+
+        /// <summary>
+        /// Name of the API key security scheme
+        /// </summary>
+        public const string ApiKeyScheme = "ApiKey";
+
+        /// <inheritdoc/>
+        public void Apply(OpenApiOperation operation, OperationFilterContext context)
+        {
+            var method = context.MethodInfo;
+            if (method == null)
+            {
+                return;
+            }
+
+            var attributes = method.GetCustomAttributes(true)
+                .Concat(method.DeclaringType?.GetCustomAttributes(true) ?? new object[0])
+                .ToList();
+
+            if (attributes.OfType<AllowAnonymousAttribute>().Any() || !attributes.OfType<AuthorizeAttribute>().Any())
+            {
+                return;
+            }
+
+            // Either scheme is accepted, so each gets its own requirement
+            operation.Security = new List<OpenApiSecurityRequirement>
+            {
+                CreateRequirement(BearerScheme),
+                CreateRequirement(ApiKeyScheme)
+            };
+
+            operation.Responses.TryAdd("401", new OpenApiResponse { Description = "Not authenticated" });
+
+            var roles = attributes.OfType<AuthorizeAttribute>()
+                .Where(a => !string.IsNullOrEmpty(a.Roles))
+                .SelectMany(a => a.Roles.Split(','))
+                .Select(r => r.Trim())
+                .Distinct()
+                .ToList();
+            if (roles.Any())
+            {
+                operation.Responses.TryAdd("403", new OpenApiResponse { Description = $"Requires role {string.Join(" or ", roles)}" });
+            }
+
+            var scope = (method.GetCustomAttribute<ApiScopeAttribute>() ?? method.DeclaringType?.GetCustomAttribute<ApiScopeAttribute>())?.Scope;
+            if (scope == null && attributes.OfType<HttpGetAttribute>().Any())
+            {
+                scope = method.DeclaringType?.GetCustomAttribute<ApiReadScopeAttribute>()?.Scope ?? ApiScopes.AccountRead;
+            }
+
+            if (scope != null)
+            {
+                operation.Extensions["x-api-scope"] = new OpenApiString(scope);
+                operation.Description = string.IsNullOrEmpty(operation.Description)
+                    ? $"Account API keys need the `{scope}` scope."
+                    : $"{operation.Description}\n\nAccount API keys need the `{scope}` scope.";
+                operation.Responses.TryAdd("403", new OpenApiResponse { Description = $"API key lacks the {scope} scope" });
+            }
+        }
+
+        private static OpenApiSecurityRequirement CreateRequirement(string scheme)
+        {
+            return new OpenApiSecurityRequirement
+            {
+                {
+                    new OpenApiSecurityScheme
+                    {
+                        Reference = new OpenApiReference
+                        {
+                            Type = ReferenceType.SecurityScheme,
+                            Id = scheme
+                        }
+                    },
+                    new string[] { }
+                }
+            };
+        }
+    }
+}
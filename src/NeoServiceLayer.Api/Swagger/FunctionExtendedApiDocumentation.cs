@@ -103,21 +103,6 @@ namespace NeoServiceLayer.API.Swagger
                 Type = SecuritySchemeType.ApiKey,
                 Scheme = "Bearer"
             });
-
-            options.AddSecurityRequirement(new OpenApiSecurityRequirement
-            {
-                {
-                    new OpenApiSecurityScheme
-                    {
-                        Reference = new OpenApiReference
-                        {
-                            Type = ReferenceType.SecurityScheme,
-                            Id = "Bearer"
-                        }
-                    },
-                    new string[] { }
-                }
-            });
         }
 
         /// <summary>
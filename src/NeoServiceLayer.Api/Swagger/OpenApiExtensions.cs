@@ -0,0 +1,143 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using Microsoft.AspNetCore.Builder;
+using Microsoft.AspNetCore.Http;
+using Microsoft.AspNetCore.Mvc.ApiExplorer;
+using Microsoft.AspNetCore.Routing;
+using Microsoft.Extensions.DependencyInjection;
+using Microsoft.OpenApi;
+using Microsoft.OpenApi.Extensions;
+using Microsoft.OpenApi.Models;
+using NeoServiceLayer.Core.Models;
+using Swashbuckle.AspNetCore.Swagger;
+using Swashbuckle.AspNetCore.SwaggerGen;
+
+namespace NeoServiceLayer.API.Swagger
+{
+    /// <summary>
+    /// Extension methods for the OpenAPI document served to clients and SDK generators
+    /// </summary>
+    /// <remarks>
+    /// The document is generated from the registered controllers on each request, so it always matches the
+    /// routes the service serves.
+    /// </remarks>
+    public static class OpenApiExtensions
+    {
+        /// <summary>
+        /// Name of the document covering every endpoint
+        /// </summary>
+        public const string DocumentName = "v1";
+
+        /// <summary>
+        /// Configures the settings SDK generators rely on: security schemes, unique operation IDs and schema IDs
+        /// </summary>
+        /// <param name="options">Swagger generation options</param>
+        public static void AddOpenApiConventions(this SwaggerGenOptions options)
+        {
+            options.AddSecurityDefinition(AuthorizationOperationFilter.ApiKeyScheme, new OpenApiSecurityScheme
+            {
+                Description = "API key header. Example: \"X-API-Key: {key}\"",
+                Name = "X-API-Key",
+                In = ParameterLocation.Header,
+                Type = SecuritySchemeType.ApiKey
+            });
+
+            options.OperationFilter<AuthorizationOperationFilter>();
+
+            // Generators name client methods after operation IDs, so every operation needs a stable, unique one
+            options.CustomOperationIds(GetOperationId);
+
+            var schemaIds = new SchemaIdSelector();
+            options.CustomSchemaIds(schemaIds.GetSchemaId);
+        }
+
+        /// <summary>
+        /// Serves the OpenAPI 3 document
+        /// </summary>
+        /// <param name="endpoints">Endpoint route builder</param>
+        /// <param name="configuration">OpenAPI configuration</param>
+        /// <returns>Endpoint convention builder</returns>
+        public static IEndpointConventionBuilder MapOpenApiDocument(this IEndpointRouteBuilder endpoints, OpenApiConfiguration configuration)
+        {
+            return endpoints.MapGet(configuration.DocumentPath, async context =>
+            {
+                var provider = context.RequestServices.GetRequiredService<ISwaggerProvider>();
+                var serverUrl = string.IsNullOrEmpty(configuration.ServerUrl)
+                    ? $"{context.Request.Scheme}://{context.Request.Host}{context.Request.PathBase}"
+                    : configuration.ServerUrl;
+
+                var document = provider.GetSwagger(DocumentName);
+                document.Servers = new List<OpenApiServer> { new OpenApiServer { Url = serverUrl } };
+
+                context.Response.ContentType = "application/json; charset=utf-8";
+                await context.Response.WriteAsync(document.SerializeAsJson(OpenApiSpecVersion.OpenApi3_0));
+            });
+        }
+
+        /// <summary>
+        /// Serves the documentation UI for the OpenAPI document
+        /// </summary>
+        /// <param name="app">Application builder</param>
+        /// <param name="configuration">OpenAPI configuration</param>
+        /// <returns>Application builder</returns>
+        public static IApplicationBuilder UseOpenApiDocs(this IApplicationBuilder app, OpenApiConfiguration configuration)
+        {
+            return app.UseSwaggerUI(c =>
+            {
+                c.RoutePrefix = configuration.UiPath.Trim('/');
+                c.DocumentTitle = "Neo Service Layer API";
+                c.SwaggerEndpoint(configuration.DocumentPath, "Neo Service Layer API v1");
+            });
+        }
+
+        private static string GetOperationId(ApiDescription description)
+        {
+            var routeValues = description.ActionDescriptor.RouteValues;
+            routeValues.TryGetValue("controller", out var controller);
+            routeValues.TryGetValue("action", out var action);
+            return $"{controller}_{action}";
+        }
+
+        /// <summary>
+        /// Names schemas after their types, qualifying a name with its namespace only when another type already has it
+        /// </summary>
+        private class SchemaIdSelector
+        {
+            private readonly Dictionary<Type, string> _ids = new Dictionary<Type, string>();
+            private readonly HashSet<string> _used = new HashSet<string>(StringComparer.Ordinal);
+
+            public string GetSchemaId(Type type)
+            {
+                lock (_ids)
+                {
+                    if (_ids.TryGetValue(type, out var id))
+                    {
+                        return id;
+                    }
+
+                    id = GetName(type);
+                    if (!_used.Add(id))
+                    {
+                        id = (type.Namespace ?? string.Empty).Replace("NeoServiceLayer.", string.Empty).Replace(".", string.Empty) + id;
+                        _used.Add(id);
+                    }
+
+                    _ids[type] = id;
+                    return id;
+                }
+            }
+
+            private static string GetName(Type type)
+            {
+                if (!type.IsGenericType)
+                {
+                    return type.Name;
+                }
+
+                var name = type.Name.Substring(0, type.Name.IndexOf('`'));
+                return $"{name}Of{string.Join("And", type.GetGenericArguments().Select(GetName))}";
+            }
+        }
+    }
+}
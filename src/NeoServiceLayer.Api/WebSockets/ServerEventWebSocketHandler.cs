@@ -0,0 +1,281 @@
+using System;
+using System.Buffers;
+using System.Linq;
+using System.Net.WebSockets;
+using System.Security.Claims;
+using System.Text.Json;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Http;
+using Microsoft.Extensions.DependencyInjection;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.API.WebSockets
+{
+    /// <summary>
+    /// Serves the server event stream over WebSockets
+    /// </summary>
+    /// <remarks>
+    /// Clients authenticate with the same JWT as the REST API, in the Authorization header or, for browsers,
+    /// the access_token query parameter. They choose topics with the topics query parameter and then with
+    /// {"action":"subscribe"|"unsubscribe","topics":[...]} messages. Events arrive as {"type":"event","event":{...}};
+    /// {"type":"dropped","count":N} reports events lost because the client read too slowly.
+    /// </remarks>
+    public class ServerEventWebSocketHandler
+    {
+        private const int MaxMessageSize = 4096;
+
+        private static readonly JsonSerializerOptions SerializerOptions = new JsonSerializerOptions(JsonSerializerDefaults.Web);
+
+        private readonly ILogger<ServerEventWebSocketHandler> _logger;
+        private readonly IServerEventHub _hub;
+        private readonly ServerEventConfiguration _configuration;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ServerEventWebSocketHandler"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="hub">Server event hub</param>
+        /// <param name="configuration">Server event configuration</param>
+        public ServerEventWebSocketHandler(
+            ILogger<ServerEventWebSocketHandler> logger,
+            IServerEventHub hub,
+            IOptions<ServerEventConfiguration> configuration = null)
+        {
+            _logger = logger;
+            _hub = hub;
+            _configuration = configuration?.Value ?? new ServerEventConfiguration();
+        }
+
+        /// <summary>
+        /// Handles a request to the event stream endpoint
+        /// </summary>
+        /// <param name="context">HTTP context</param>
+        /// <returns>A task that completes when the connection closes</returns>
+        public async Task HandleAsync(HttpContext context)
+        {
+            if (!_configuration.Enabled)
+            {
+                context.Response.StatusCode = StatusCodes.Status404NotFound;
+                return;
+            }
+
+            if (!context.WebSockets.IsWebSocketRequest)
+            {
+                context.Response.StatusCode = StatusCodes.Status400BadRequest;
+                await context.Response.WriteAsJsonAsync(new { Message = "WebSocket connection required" });
+                return;
+            }
+
+            var principal = Authenticate(context);
+            var userId = principal?.FindFirst(ClaimTypes.NameIdentifier)?.Value ?? principal?.FindFirst("sub")?.Value;
+            if (string.IsNullOrEmpty(userId) || !Guid.TryParse(userId, out var accountId))
+            {
+                context.Response.StatusCode = StatusCodes.Status401Unauthorized;
+                await context.Response.WriteAsJsonAsync(new { Message = "Invalid or missing access token" });
+                return;
+            }
+
+            if (_hub.GetSubscriptionCount(accountId) >= _configuration.MaxConnectionsPerAccount)
+            {
+                context.Response.StatusCode = StatusCodes.Status429TooManyRequests;
+                await context.Response.WriteAsJsonAsync(new { Message = "Too many event stream connections" });
+                return;
+            }
+
+            using var socket = await context.WebSockets.AcceptWebSocketAsync();
+            using var subscription = _hub.Subscribe(accountId, principal.IsInRole("Admin"));
+            using var connectionCancellation = CancellationTokenSource.CreateLinkedTokenSource(context.RequestAborted);
+
+            var initialTopics = context.Request.Query["topics"].ToString();
+            if (!string.IsNullOrEmpty(initialTopics))
+            {
+                subscription.Subscribe(initialTopics.Split(',', StringSplitOptions.RemoveEmptyEntries | StringSplitOptions.TrimEntries));
+            }
+
+            _logger.LogInformation("Event stream opened for account {AccountId} with topics {Topics}", accountId, string.Join(",", subscription.Topics));
+
+            var sendLock = new SemaphoreSlim(1, 1);
+            await SendAsync(socket, sendLock, new { Type = "subscribed", Topics = subscription.Topics }, connectionCancellation.Token);
+
+            var sending = SendEventsAsync(socket, sendLock, subscription, connectionCancellation.Token);
+            var receiving = ReceiveCommandsAsync(socket, sendLock, subscription, connectionCancellation.Token);
+
+            // Whichever side ends first ends the connection
+            await Task.WhenAny(sending, receiving);
+            connectionCancellation.Cancel();
+
+            try
+            {
+                await Task.WhenAll(sending, receiving);
+            }
+            catch (Exception ex) when (ex is OperationCanceledException || ex is WebSocketException)
+            {
+                // Expected when the client disconnects or is too slow
+            }
+
+            if (socket.State == WebSocketState.Open || socket.State == WebSocketState.CloseReceived)
+            {
+                using var closeTimeout = new CancellationTokenSource(TimeSpan.FromSeconds(_configuration.SendTimeoutSeconds));
+                await socket.CloseOutputAsync(WebSocketCloseStatus.NormalClosure, null, closeTimeout.Token);
+            }
+
+            _logger.LogInformation("Event stream closed for account {AccountId}", accountId);
+        }
+
+        private async Task SendEventsAsync(WebSocket socket, SemaphoreSlim sendLock, IServerEventSubscription subscription, CancellationToken cancellationToken)
+        {
+            while (await subscription.Events.WaitToReadAsync(cancellationToken))
+            {
+                while (subscription.Events.TryRead(out var serverEvent))
+                {
+                    var dropped = subscription.TakeDroppedCount();
+                    if (dropped > 0)
+                    {
+                        await SendAsync(socket, sendLock, new { Type = "dropped", Count = dropped }, cancellationToken);
+                    }
+
+                    await SendAsync(socket, sendLock, new { Type = "event", Event = serverEvent }, cancellationToken);
+                }
+            }
+        }
+
+        private async Task ReceiveCommandsAsync(WebSocket socket, SemaphoreSlim sendLock, IServerEventSubscription subscription, CancellationToken cancellationToken)
+        {
+            var buffer = ArrayPool<byte>.Shared.Rent(MaxMessageSize);
+            try
+            {
+                while (socket.State == WebSocketState.Open)
+                {
+                    var length = 0;
+                    WebSocketReceiveResult result;
+                    do
+                    {
+                        if (length == MaxMessageSize)
+                        {
+                            await socket.CloseAsync(WebSocketCloseStatus.MessageTooBig, "Message too big", cancellationToken);
+                            return;
+                        }
+
+                        result = await socket.ReceiveAsync(new ArraySegment<byte>(buffer, length, MaxMessageSize - length), cancellationToken);
+                        length += result.Count;
+                    }
+                    while (!result.EndOfMessage && result.MessageType != WebSocketMessageType.Close);
+
+                    if (result.MessageType == WebSocketMessageType.Close)
+                    {
+                        return;
+                    }
+
+                    await HandleCommandAsync(socket, sendLock, subscription, buffer.AsMemory(0, length), cancellationToken);
+                }
+            }
+            finally
+            {
+                ArrayPool<byte>.Shared.Return(buffer);
+            }
+        }
+
+        private async Task HandleCommandAsync(WebSocket socket, SemaphoreSlim sendLock, IServerEventSubscription subscription, ReadOnlyMemory<byte> message, CancellationToken cancellationToken)
+        {
+            SubscriptionCommand command;
+            try
+            {
+                command = JsonSerializer.Deserialize<SubscriptionCommand>(message.Span, SerializerOptions);
+            }
+            catch (JsonException)
+            {
+                command = null;
+            }
+
+            var topics = command?.Topics ?? Array.Empty<string>();
+            var unknown = topics.Where(t => !ServerEventTopics.All.Contains(t, StringComparer.OrdinalIgnoreCase)).ToList();
+
+            if (command == null || unknown.Count > 0)
+            {
+                var error = unknown.Count > 0 ? $"Unknown topics: {string.Join(", ", unknown)}" : "Invalid message";
+                await SendAsync(socket, sendLock, new { Type = "error", Message = error }, cancellationToken);
+                return;
+            }
+
+            switch (command.Action?.ToLowerInvariant())
+            {
+                case "subscribe":
+                    subscription.Subscribe(topics);
+                    break;
+                case "unsubscribe":
+                    subscription.Unsubscribe(topics);
+                    break;
+                default:
+                    await SendAsync(socket, sendLock, new { Type = "error", Message = "Action must be subscribe or unsubscribe" }, cancellationToken);
+                    return;
+            }
+
+            await SendAsync(socket, sendLock, new { Type = "subscribed", Topics = subscription.Topics }, cancellationToken);
+        }
+
+        private async Task SendAsync(WebSocket socket, SemaphoreSlim sendLock, object message, CancellationToken cancellationToken)
+        {
+            var payload = JsonSerializer.SerializeToUtf8Bytes(message, SerializerOptions);
+
+            // A client that stops reading fills the TCP window and blocks the send; give up on it after the timeout
+            using var timeout = CancellationTokenSource.CreateLinkedTokenSource(cancellationToken);
+            timeout.CancelAfter(TimeSpan.FromSeconds(_configuration.SendTimeoutSeconds));
+
+            await sendLock.WaitAsync(cancellationToken);
+            try
+            {
+                await socket.SendAsync(new ArraySegment<byte>(payload), WebSocketMessageType.Text, true, timeout.Token);
+            }
+            catch (OperationCanceledException) when (!cancellationToken.IsCancellationRequested)
+            {
+                _logger.LogWarning("Closing event stream: the client did not read within {Timeout} seconds", _configuration.SendTimeoutSeconds);
+                socket.Abort();
+                throw;
+            }
+            finally
+            {
+                sendLock.Release();
+            }
+        }
+
+        private ClaimsPrincipal Authenticate(HttpContext context)
+        {
+            string token = null;
+            var authorization = context.Request.Headers.Authorization.ToString();
+            if (authorization.StartsWith("Bearer ", StringComparison.OrdinalIgnoreCase))
+            {
+                token = authorization.Substring("Bearer ".Length).Trim();
+            }
+            else if (context.Request.Query.TryGetValue("access_token", out var queryToken))
+            {
+                token = queryToken.ToString();
+            }
+
+            if (string.IsNullOrEmpty(token))
+            {
+                return null;
+            }
+
+            try
+            {
+                return context.RequestServices.GetRequiredService<ITokenService>().ValidateToken(token);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogWarning("Rejected event stream connection: {Message}", ex.Message);
+                return null;
+            }
+        }
+
+        private class SubscriptionCommand
+        {
+            public string Action { get; set; }
+
+            public string[] Topics { get; set; }
+        }
+    }
+}
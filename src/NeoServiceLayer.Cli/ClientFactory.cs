@@ -0,0 +1,129 @@
+using System;
+using System.Net.Http;
+using System.Threading.Tasks;
+using NeoServiceLayer.Cli.Profiles;
+using NeoServiceLayer.Client;
+
+namespace NeoServiceLayer.Cli
+{
+    /// <summary>
+    /// Creates API clients for the selected profile
+    /// </summary>
+    /// <remarks>
+    /// The URL comes from --url, then $NSL_API_URL, then the profile. The credential comes from --token, then
+    /// $NSL_API_TOKEN, then the profile's cached token or API key. An expired cached token is refreshed and
+    /// stored again, so a profile stays logged in for as long as its refresh token is valid.
+    /// </remarks>
+    public class ClientFactory
+    {
+        /// <summary>
+        /// URL used when neither the command line, the environment nor the profile sets one
+        /// </summary>
+        public const string DefaultApiUrl = "http://localhost:5000";
+
+        // Tokens this close to expiry are refreshed up front rather than failing mid-command
+        private static readonly TimeSpan RefreshMargin = TimeSpan.FromMinutes(1);
+
+        private readonly ProfileStore _profiles;
+        private readonly ICredentialStore _credentials;
+        private readonly Func<HttpClient> _httpClientFactory;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ClientFactory"/> class
+        /// </summary>
+        /// <param name="profiles">Profile store</param>
+        /// <param name="credentials">Credential store</param>
+        /// <param name="httpClientFactory">Creates the HTTP client of each API client; a default one is used if not provided</param>
+        public ClientFactory(ProfileStore profiles, ICredentialStore credentials, Func<HttpClient> httpClientFactory = null)
+        {
+            _profiles = profiles;
+            _credentials = credentials;
+            _httpClientFactory = httpClientFactory;
+        }
+
+        /// <summary>
+        /// Creates a client without credentials, e.g. to log in
+        /// </summary>
+        /// <param name="url">API base URL</param>
+        /// <returns>The client</returns>
+        public NeoServiceLayerClient CreateAnonymous(string url)
+        {
+            return Create(new NeoServiceLayerClientOptions { BaseUrl = url });
+        }
+
+        /// <summary>
+        /// Creates a client for the profile selected on the command line
+        /// </summary>
+        /// <param name="arguments">Arguments</param>
+        /// <returns>The client</returns>
+        public async Task<NeoServiceLayerClient> CreateAsync(CommandLineArguments arguments)
+        {
+            var configuration = _profiles.Load();
+            var requested = arguments.GetOption("profile");
+            var profileName = configuration.ResolveName(requested);
+            configuration.Profiles.TryGetValue(profileName, out var profile);
+
+            if (profile == null && !string.IsNullOrEmpty(requested))
+            {
+                throw new CommandException($"Unknown profile: {requested}. Run 'nsl login --profile {requested}' to create it.");
+            }
+
+            var options = new NeoServiceLayerClientOptions
+            {
+                BaseUrl = arguments.GetOption("url", Environment.GetEnvironmentVariable("NSL_API_URL") ?? profile?.Url ?? DefaultApiUrl),
+                AccessToken = arguments.GetOption("token", Environment.GetEnvironmentVariable("NSL_API_TOKEN"))
+            };
+
+            if (string.IsNullOrEmpty(options.AccessToken) && profile != null)
+            {
+                var credential = await _credentials.GetAsync(profileName);
+                if (!string.IsNullOrEmpty(credential?.ApiKey))
+                {
+                    options.ApiKey = credential.ApiKey;
+                }
+                else if (credential != null && !string.IsNullOrEmpty(credential.AccessToken))
+                {
+                    options.AccessToken = (await RefreshIfExpiringAsync(profileName, options.BaseUrl, credential)).AccessToken;
+                }
+            }
+
+            return Create(options);
+        }
+
+        private async Task<StoredCredential> RefreshIfExpiringAsync(string profileName, string url, StoredCredential credential)
+        {
+            if (!credential.ExpiresAt.HasValue || credential.ExpiresAt.Value.ToUniversalTime() - RefreshMargin > DateTime.UtcNow)
+            {
+                return credential;
+            }
+
+            if (string.IsNullOrEmpty(credential.RefreshToken))
+            {
+                throw new CommandException($"The session of profile {profileName} has expired. Run 'nsl login --profile {profileName}'.");
+            }
+
+            try
+            {
+                var token = await CreateAnonymous(url).Auth.RefreshAsync(credential.RefreshToken);
+                var refreshed = new StoredCredential
+                {
+                    AccessToken = token.AccessToken,
+                    RefreshToken = string.IsNullOrEmpty(token.RefreshToken) ? credential.RefreshToken : token.RefreshToken,
+                    ExpiresAt = token.ExpiresAt
+                };
+
+                await _credentials.SaveAsync(profileName, refreshed);
+                return refreshed;
+            }
+            catch (NeoServiceLayerApiException ex) when (ex.StatusCode == System.Net.HttpStatusCode.Unauthorized)
+            {
+                throw new CommandException($"The session of profile {profileName} has expired. Run 'nsl login --profile {profileName}'.", ex);
+            }
+        }
+
+        private NeoServiceLayerClient Create(NeoServiceLayerClientOptions options)
+        {
+            return new NeoServiceLayerClient(options, _httpClientFactory?.Invoke());
+        }
+    }
+}
@@ -0,0 +1,34 @@
+using System;
+
+namespace NeoServiceLayer.Cli
+{
+    /// <summary>
+    /// Exception thrown when a command is used incorrectly
+    /// </summary>
+    public class CommandException : Exception
+    {
+        /// <summary>
+        /// Initializes a new instance of the <see cref="CommandException"/> class
+        /// </summary>
+        public CommandException() : base()
+        {
+        }
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="CommandException"/> class
+        /// </summary>
+        /// <param name="message">Error message</param>
+        public CommandException(string message) : base(message)
+        {
+        }
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="CommandException"/> class
+        /// </summary>
+        /// <param name="message">Error message</param>
+        /// <param name="innerException">Inner exception</param>
+        public CommandException(string message, Exception innerException) : base(message, innerException)
+        {
+        }
+    }
+}
@@ -0,0 +1,83 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Cli
+{
+    /// <summary>
+    /// Parsed command line arguments
+    /// </summary>
+    /// <remarks>
+    /// Options take the form <c>--name value</c> or <c>--name=value</c>. Names listed as flags never take a
+    /// value, so <c>price get NEO --history</c> and <c>price publish --force NEO</c> parse the same way.
+    /// </remarks>
+    public class CommandLineArguments
+    {
+        private readonly Dictionary<string, string> _options = new Dictionary<string, string>(StringComparer.OrdinalIgnoreCase);
+        private readonly HashSet<string> _flags = new HashSet<string>(StringComparer.OrdinalIgnoreCase);
+
+        /// <summary>
+        /// Gets the positional arguments, i.e. the command path followed by its operands
+        /// </summary>
+        public List<string> Positionals { get; } = new List<string>();
+
+        /// <summary>
+        /// Parses command line arguments
+        /// </summary>
+        /// <param name="args">Arguments</param>
+        /// <param name="flags">Names of options that never take a value</param>
+        /// <returns>The parsed arguments</returns>
+        public static CommandLineArguments Parse(IReadOnlyList<string> args, params string[] flags)
+        {
+            var knownFlags = new HashSet<string>(flags, StringComparer.OrdinalIgnoreCase);
+            var result = new CommandLineArguments();
+
+            for (var i = 0; i < args.Count; i++)
+            {
+                var arg = args[i];
+                if (!arg.StartsWith("--", StringComparison.Ordinal) || arg.Length == 2)
+                {
+                    result.Positionals.Add(arg);
+                    continue;
+                }
+
+                var name = arg.Substring(2);
+                var separator = name.IndexOf('=');
+                if (separator >= 0)
+                {
+                    result._options[name.Substring(0, separator)] = name.Substring(separator + 1);
+                }
+                else if (knownFlags.Contains(name) || i + 1 >= args.Count || args[i + 1].StartsWith("--", StringComparison.Ordinal))
+                {
+                    result._flags.Add(name);
+                }
+                else
+                {
+                    result._options[name] = args[++i];
+                }
+            }
+
+            return result;
+        }
+
+        /// <summary>
+        /// Checks if a flag was given
+        /// </summary>
+        /// <param name="name">Flag name without the leading dashes</param>
+        /// <returns>True if the flag was given, false otherwise</returns>
+        public bool HasFlag(string name)
+        {
+            return _flags.Contains(name);
+        }
+
+        /// <summary>
+        /// Gets the value of an option
+        /// </summary>
+        /// <param name="name">Option name without the leading dashes</param>
+        /// <param name="defaultValue">Value returned if the option was not given</param>
+        /// <returns>The option value</returns>
+        public string GetOption(string name, string defaultValue = null)
+        {
+            return _options.TryGetValue(name, out var value) ? value : defaultValue;
+        }
+    }
+}
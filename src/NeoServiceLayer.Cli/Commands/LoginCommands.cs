@@ -0,0 +1,208 @@
+using System;
+using System.IO;
+using System.Linq;
+using System.Threading.Tasks;
+using NeoServiceLayer.Cli.Profiles;
+
+namespace NeoServiceLayer.Cli.Commands
+{
+    /// <summary>
+    /// Login, logout and profile commands
+    /// </summary>
+    public class LoginCommands
+    {
+        /// <summary>
+        /// Usage text for the login commands
+        /// </summary>
+        public const string Usage =
+            "  login [--profile NAME] [--url URL]             Log in and cache the session for the profile\n" +
+            "        [--username USER]                        Prompts for the password\n" +
+            "        [--api-key KEY | --api-key-stdin]        Store an API key instead of logging in\n" +
+            "  logout [--profile NAME]                        Remove the profile's cached credential\n" +
+            "  profile list                                   Profiles and the one used by default\n" +
+            "  profile use NAME                               Use NAME when no --profile is given\n";
+
+        private readonly ProfileStore _profiles;
+        private readonly ICredentialStore _credentials;
+        private readonly ClientFactory _clientFactory;
+        private readonly TextReader _input;
+        private readonly TextWriter _output;
+        private readonly Func<string, string> _readSecret;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="LoginCommands"/> class
+        /// </summary>
+        /// <param name="profiles">Profile store</param>
+        /// <param name="credentials">Credential store</param>
+        /// <param name="clientFactory">Client factory</param>
+        /// <param name="input">Input read for prompts</param>
+        /// <param name="output">Output</param>
+        /// <param name="readSecret">Prompts for a secret without echoing it; reads a line from <paramref name="input"/> if not provided</param>
+        public LoginCommands(
+            ProfileStore profiles,
+            ICredentialStore credentials,
+            ClientFactory clientFactory,
+            TextReader input,
+            TextWriter output,
+            Func<string, string> readSecret = null)
+        {
+            _profiles = profiles;
+            _credentials = credentials;
+            _clientFactory = clientFactory;
+            _input = input;
+            _output = output;
+            _readSecret = readSecret ?? (prompt =>
+            {
+                _output.Write(prompt);
+                return _input.ReadLine();
+            });
+        }
+
+        /// <summary>
+        /// Runs a login, logout or profile command
+        /// </summary>
+        /// <param name="arguments">Arguments; the first positional is the command</param>
+        /// <returns>The exit code</returns>
+        public Task<int> RunAsync(CommandLineArguments arguments)
+        {
+            switch (arguments.Positionals[0])
+            {
+                case "login":
+                    return LoginAsync(arguments);
+                case "logout":
+                    return LogoutAsync(arguments);
+                default:
+                    return Task.FromResult(RunProfileCommand(arguments));
+            }
+        }
+
+        private async Task<int> LoginAsync(CommandLineArguments arguments)
+        {
+            var configuration = _profiles.Load();
+            var profileName = configuration.ResolveName(arguments.GetOption("profile"));
+            configuration.Profiles.TryGetValue(profileName, out var existing);
+
+            var url = arguments.GetOption("url") ?? existing?.Url ?? Environment.GetEnvironmentVariable("NSL_API_URL") ?? ClientFactory.DefaultApiUrl;
+            var profile = new CliProfile { Url = url };
+            StoredCredential credential;
+
+            var apiKey = arguments.HasFlag("api-key-stdin") ? _input.ReadLine()?.Trim() : arguments.GetOption("api-key");
+            if (arguments.HasFlag("api-key-stdin") || apiKey != null)
+            {
+                if (string.IsNullOrEmpty(apiKey))
+                {
+                    throw new CommandException("The API key is empty");
+                }
+
+                // API keys are validated by the API on each request; there is no session to obtain
+                profile.AuthMethod = CliProfile.ApiKeyAuth;
+                credential = new StoredCredential { ApiKey = apiKey };
+            }
+            else
+            {
+                var username = arguments.GetOption("username") ?? existing?.Username;
+                if (string.IsNullOrEmpty(username))
+                {
+                    _output.Write("Username: ");
+                    username = _input.ReadLine()?.Trim();
+                }
+
+                if (string.IsNullOrEmpty(username))
+                {
+                    throw new CommandException("A username is required");
+                }
+
+                var password = _readSecret("Password: ");
+                if (string.IsNullOrEmpty(password))
+                {
+                    throw new CommandException("A password is required");
+                }
+
+                var token = await _clientFactory.CreateAnonymous(url).Auth.LoginAsync(username, password);
+
+                profile.AuthMethod = CliProfile.PasswordAuth;
+                profile.Username = username;
+                credential = new StoredCredential
+                {
+                    AccessToken = token.AccessToken,
+                    RefreshToken = token.RefreshToken,
+                    ExpiresAt = token.ExpiresAt
+                };
+            }
+
+            await _credentials.SaveAsync(profileName, credential);
+
+            configuration.Profiles[profileName] = profile;
+            if (string.IsNullOrEmpty(configuration.CurrentProfile))
+            {
+                configuration.CurrentProfile = profileName;
+            }
+
+            _profiles.Save(configuration);
+
+            _output.WriteLine(profile.AuthMethod == CliProfile.ApiKeyAuth
+                ? $"Stored an API key for {url} in profile {profileName}"
+                : $"Logged in to {url} as {profile.Username} in profile {profileName}");
+            _output.WriteLine($"Credential stored in {_credentials.Name}");
+
+            return ExitCodes.Success;
+        }
+
+        private async Task<int> LogoutAsync(CommandLineArguments arguments)
+        {
+            var profileName = _profiles.Load().ResolveName(arguments.GetOption("profile"));
+
+            _output.WriteLine(await _credentials.DeleteAsync(profileName)
+                ? $"Logged out of profile {profileName}"
+                : $"Profile {profileName} has no stored credential");
+
+            return ExitCodes.Success;
+        }
+
+        private int RunProfileCommand(CommandLineArguments arguments)
+        {
+            var subcommand = arguments.Positionals.ElementAtOrDefault(1);
+            var configuration = _profiles.Load();
+
+            switch (subcommand)
+            {
+                case "list":
+                    if (configuration.Profiles.Count == 0)
+                    {
+                        _output.WriteLine("No profiles. Run 'nsl login' to create one.");
+                        return ExitCodes.Success;
+                    }
+
+                    var current = configuration.ResolveName(null);
+                    _output.WriteLine($"  {"NAME",-16} {"AUTH",-9} {"USER",-16} URL");
+                    foreach (var (name, profile) in configuration.Profiles.OrderBy(p => p.Key, StringComparer.OrdinalIgnoreCase))
+                    {
+                        var marker = string.Equals(name, current, StringComparison.OrdinalIgnoreCase) ? "*" : " ";
+                        _output.WriteLine($"{marker} {name,-16} {profile.AuthMethod,-9} {profile.Username ?? "-",-16} {profile.Url}");
+                    }
+
+                    return ExitCodes.Success;
+
+                case "use":
+                    var profileName = arguments.Positionals.ElementAtOrDefault(2);
+                    if (string.IsNullOrEmpty(profileName))
+                    {
+                        throw new CommandException("Missing NAME");
+                    }
+
+                    if (!configuration.Profiles.ContainsKey(profileName))
+                    {
+                        throw new CommandException($"Unknown profile: {profileName}. Run 'nsl login --profile {profileName}' to create it.");
+                    }
+
+                    configuration.CurrentProfile = profileName;
+                    _profiles.Save(configuration);
+                    _output.WriteLine($"Using profile {profileName}");
+                    return ExitCodes.Success;
+
+                default:
+                    throw new CommandException(subcommand == null ? "Missing profile command" : $"Unknown profile command: {subcommand}");
+            }
+        }
+    }
+}
@@ -0,0 +1,81 @@
+using System;
+using System.IO;
+using System.Linq;
+using System.Threading.Tasks;
+using NeoServiceLayer.Client;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Cli.Commands
+{
+    /// <summary>
+    /// Operator commands for checking a running deployment
+    /// </summary>
+    public class OpsCommands
+    {
+        /// <summary>
+        /// Usage text for the ops commands
+        /// </summary>
+        public const string Usage =
+            "  ops verify [--check NAME,...]                  Run live checks of RPC, wallet, attestation, storage, prices and sandbox (Admin)\n" +
+            "                                                 Fails if any check failed; skipped checks are not configured\n";
+
+        private readonly NeoServiceLayerClient _client;
+        private readonly TextWriter _output;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="OpsCommands"/> class
+        /// </summary>
+        /// <param name="client">API client</param>
+        /// <param name="output">Output</param>
+        public OpsCommands(NeoServiceLayerClient client, TextWriter output)
+        {
+            _client = client;
+            _output = output;
+        }
+
+        /// <summary>
+        /// Runs an ops command
+        /// </summary>
+        /// <param name="arguments">Arguments; the first positional is "ops"</param>
+        /// <returns>The exit code</returns>
+        public Task<int> RunAsync(CommandLineArguments arguments)
+        {
+            var subcommand = arguments.Positionals.ElementAtOrDefault(1);
+            switch (subcommand)
+            {
+                case "verify":
+                    return VerifyAsync(arguments);
+                default:
+                    throw new CommandException(subcommand == null ? "Missing ops command" : $"Unknown ops command: {subcommand}");
+            }
+        }
+
+        private async Task<int> VerifyAsync(CommandLineArguments arguments)
+        {
+            var checks = arguments.GetOption("check")?.Split(',', StringSplitOptions.RemoveEmptyEntries | StringSplitOptions.TrimEntries);
+            var unknown = checks?.Where(c => !SystemVerificationChecks.All.Contains(c.ToLowerInvariant())).ToList();
+            if (unknown?.Count > 0)
+            {
+                throw new CommandException($"Unknown check: {string.Join(", ", unknown)}; checks are {string.Join(", ", SystemVerificationChecks.All)}");
+            }
+
+            var report = await _client.Ops.VerifyAsync(checks);
+
+            _output.WriteLine($"{"CHECK",-12} {"STATUS",-8} {"TIME",8} DETAIL");
+            foreach (var check in report.Checks)
+            {
+                _output.WriteLine($"{check.Name,-12} {check.Status.ToString().ToUpperInvariant(),-8} {check.DurationMs,6}ms {check.Detail}");
+            }
+
+            var passed = report.Checks.Count(c => c.Status == SystemVerificationStatus.Passed);
+            var failed = report.Checks.Count(c => c.Status == SystemVerificationStatus.Failed);
+            var skipped = report.Checks.Count(c => c.Status == SystemVerificationStatus.Skipped);
+
+            _output.WriteLine();
+            _output.WriteLine($"{(report.Succeeded ? "PASS" : "FAIL")}: {passed} passed, {failed} failed, {skipped} skipped " +
+                $"in {(report.CompletedAt - report.StartedAt).TotalSeconds:0.0}s");
+
+            return report.Succeeded ? ExitCodes.Success : ExitCodes.Failure;
+        }
+    }
+}
@@ -0,0 +1,244 @@
+using System;
+using System.Globalization;
+using System.IO;
+using System.Linq;
+using System.Threading.Tasks;
+using NeoServiceLayer.Client;
+using NeoServiceLayer.Client.Models;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Cli.Commands
+{
+    /// <summary>
+    /// Price feed inspection and manual publish commands
+    /// </summary>
+    public class PriceCommands
+    {
+        /// <summary>
+        /// Usage text for the price commands
+        /// </summary>
+        public const string Usage =
+            "  price list [--base USD]                        Latest aggregated price of every symbol\n" +
+            "  price get SYMBOL [--base USD] [--history]      Latest price with its source breakdown\n" +
+            "           [--hours 24]                          Hours of history shown with --history\n" +
+            "  price publish SYMBOL --force --reason TEXT     Push an emergency round to the oracle (Admin)\n" +
+            "           [--value N] [--base USD] [--yes]      Publishes the latest price unless --value is set\n";
+
+        private readonly NeoServiceLayerClient _client;
+        private readonly TextReader _input;
+        private readonly TextWriter _output;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PriceCommands"/> class
+        /// </summary>
+        /// <param name="client">API client</param>
+        /// <param name="input">Input read for confirmation prompts</param>
+        /// <param name="output">Output</param>
+        public PriceCommands(NeoServiceLayerClient client, TextReader input, TextWriter output)
+        {
+            _client = client;
+            _input = input;
+            _output = output;
+        }
+
+        /// <summary>
+        /// Runs a price command
+        /// </summary>
+        /// <param name="arguments">Arguments; the first positional is "price"</param>
+        /// <returns>The exit code</returns>
+        public Task<int> RunAsync(CommandLineArguments arguments)
+        {
+            var subcommand = arguments.Positionals.ElementAtOrDefault(1);
+            switch (subcommand)
+            {
+                case "list":
+                    return ListAsync(arguments);
+                case "get":
+                    return GetAsync(arguments);
+                case "publish":
+                    return PublishAsync(arguments);
+                default:
+                    throw new CommandException(subcommand == null ? "Missing price command" : $"Unknown price command: {subcommand}");
+            }
+        }
+
+        private async Task<int> ListAsync(CommandLineArguments arguments)
+        {
+            var baseCurrency = arguments.GetOption("base", "USD");
+            var prices = await _client.Prices.GetAllLatestAsync(baseCurrency);
+
+            if (prices.Count == 0)
+            {
+                _output.WriteLine($"No prices available in {baseCurrency}");
+                return ExitCodes.Success;
+            }
+
+            _output.WriteLine($"{"SYMBOL",-10} {"VALUE",18} {"SOURCES",8} {"CONFIDENCE",11} UPDATED");
+            foreach (var price in prices.Values.OrderBy(p => p.Symbol, StringComparer.OrdinalIgnoreCase))
+            {
+                _output.WriteLine($"{price.Symbol,-10} {FormatValue(price.Value),18} {price.SourcePrices.Count,8} {price.ConfidenceScore,11} {FormatAge(price.Timestamp)}");
+            }
+
+            return ExitCodes.Success;
+        }
+
+        private async Task<int> GetAsync(CommandLineArguments arguments)
+        {
+            var symbol = RequireSymbol(arguments);
+            var baseCurrency = arguments.GetOption("base", "USD");
+
+            var price = await _client.Prices.GetLatestAsync(symbol, baseCurrency);
+            WritePrice(price);
+
+            if (!arguments.HasFlag("history"))
+            {
+                return ExitCodes.Success;
+            }
+
+            if (!int.TryParse(arguments.GetOption("hours", "24"), out var hours) || hours <= 0)
+            {
+                throw new CommandException("--hours must be a positive number");
+            }
+
+            var endTime = DateTime.UtcNow;
+            var history = await _client.Prices.GetHistoryAsync(symbol, endTime.AddHours(-hours), endTime, baseCurrency);
+
+            _output.WriteLine();
+            _output.WriteLine($"History for the last {hours}h ({history.Count} rounds):");
+            _output.WriteLine($"{"TIME",-20} {"VALUE",18} {"SOURCES",8} {"CONFIDENCE",11}");
+            foreach (var round in history.OrderByDescending(p => p.Timestamp))
+            {
+                _output.WriteLine($"{round.Timestamp.ToUniversalTime():yyyy-MM-dd HH:mm:ss} {FormatValue(round.Value),18} {round.SourcePrices.Count,8} {round.ConfidenceScore,11}");
+            }
+
+            return ExitCodes.Success;
+        }
+
+        private async Task<int> PublishAsync(CommandLineArguments arguments)
+        {
+            var symbol = RequireSymbol(arguments);
+            var baseCurrency = arguments.GetOption("base", "USD");
+            var reason = arguments.GetOption("reason");
+
+            if (!arguments.HasFlag("force"))
+            {
+                throw new CommandException("price publish pushes a round outside the normal update cycle and requires --force");
+            }
+
+            if (string.IsNullOrWhiteSpace(reason))
+            {
+                throw new CommandException("price publish requires --reason, which is recorded in the audit log");
+            }
+
+            decimal? value = null;
+            var valueOption = arguments.GetOption("value");
+            if (valueOption != null)
+            {
+                if (!decimal.TryParse(valueOption, NumberStyles.Number, CultureInfo.InvariantCulture, out var parsed) || parsed <= 0)
+                {
+                    throw new CommandException("--value must be a positive number");
+                }
+
+                value = parsed;
+            }
+
+            if (value.HasValue)
+            {
+                _output.WriteLine($"About to publish {symbol}/{baseCurrency} = {FormatValue(value.Value)} (manual value)");
+            }
+            else
+            {
+                var latest = await _client.Prices.GetLatestAsync(symbol, baseCurrency);
+                _output.WriteLine($"About to publish the latest aggregated round for {symbol}/{baseCurrency}:");
+                WritePrice(latest);
+            }
+
+            _output.WriteLine($"Reason: {reason}");
+
+            if (!arguments.HasFlag("yes"))
+            {
+                _output.Write($"Type {symbol} to confirm: ");
+                var confirmation = _input.ReadLine();
+                if (!string.Equals(confirmation?.Trim(), symbol, StringComparison.Ordinal))
+                {
+                    _output.WriteLine("Aborted, nothing was published");
+                    return ExitCodes.Failure;
+                }
+            }
+
+            var result = await _client.Prices.PublishAsync(symbol, new PublishPriceRequest
+            {
+                BaseCurrency = baseCurrency,
+                Value = value,
+                Force = true,
+                Reason = reason
+            });
+
+            _output.WriteLine($"Published {symbol}/{baseCurrency} = {FormatValue(result.Price?.Value ?? value ?? 0)}");
+            _output.WriteLine($"Transaction: {result.TransactionHash}");
+
+            return ExitCodes.Success;
+        }
+
+        private void WritePrice(Price price)
+        {
+            _output.WriteLine($"{price.Symbol}/{price.BaseCurrency} = {FormatValue(price.Value)}");
+            _output.WriteLine($"  Updated:    {price.Timestamp.ToUniversalTime():yyyy-MM-dd HH:mm:ss} UTC ({FormatAge(price.Timestamp)})");
+            _output.WriteLine($"  Confidence: {price.ConfidenceScore}");
+
+            if (price.SourcePrices.Count == 0)
+            {
+                _output.WriteLine("  Sources:    none reported");
+                return;
+            }
+
+            // Spread between sources is the first thing to check when aggregation looks wrong
+            var values = price.SourcePrices.Select(s => s.Value).ToList();
+            var spread = price.Value == 0 ? 0 : (values.Max() - values.Min()) / price.Value * 100;
+
+            _output.WriteLine($"  Sources:    {price.SourcePrices.Count} (spread {spread:0.##}%)");
+            foreach (var source in price.SourcePrices.OrderBy(s => s.SourceName, StringComparer.OrdinalIgnoreCase))
+            {
+                var deviation = price.Value == 0 ? 0 : (source.Value - price.Value) / price.Value * 100;
+                _output.WriteLine($"    {source.SourceName,-20} {FormatValue(source.Value),18} {deviation,8:+0.00;-0.00;0.00}% weight {source.Weight} {FormatAge(source.Timestamp)}");
+            }
+        }
+
+        private static string RequireSymbol(CommandLineArguments arguments)
+        {
+            var symbol = arguments.Positionals.ElementAtOrDefault(2);
+            if (string.IsNullOrEmpty(symbol))
+            {
+                throw new CommandException("Missing SYMBOL");
+            }
+
+            return symbol.ToUpperInvariant();
+        }
+
+        private static string FormatValue(decimal value)
+        {
+            return value.ToString("0.########", CultureInfo.InvariantCulture);
+        }
+
+        private static string FormatAge(DateTime timestamp)
+        {
+            var age = DateTime.UtcNow - timestamp.ToUniversalTime();
+            if (age.TotalSeconds < 0)
+            {
+                return "just now";
+            }
+
+            if (age.TotalMinutes < 1)
+            {
+                return $"{(int)age.TotalSeconds}s ago";
+            }
+
+            if (age.TotalHours < 1)
+            {
+                return $"{(int)age.TotalMinutes}m ago";
+            }
+
+            return age.TotalDays < 1 ? $"{(int)age.TotalHours}h ago" : $"{(int)age.TotalDays}d ago";
+        }
+    }
+}
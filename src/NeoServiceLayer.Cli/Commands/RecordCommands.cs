@@ -0,0 +1,73 @@
+using System.IO;
+using System.Linq;
+using System.Threading.Tasks;
+using NeoServiceLayer.Client;
+
+namespace NeoServiceLayer.Cli.Commands
+{
+    /// <summary>
+    /// Stored record administration commands
+    /// </summary>
+    public class RecordCommands
+    {
+        /// <summary>
+        /// Usage text for the record commands
+        /// </summary>
+        public const string Usage =
+            "  records migrate                                Rewrite stored records at their current schema version (Admin)\n";
+
+        private readonly NeoServiceLayerClient _client;
+        private readonly TextWriter _output;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="RecordCommands"/> class
+        /// </summary>
+        /// <param name="client">API client</param>
+        /// <param name="output">Output</param>
+        public RecordCommands(NeoServiceLayerClient client, TextWriter output)
+        {
+            _client = client;
+            _output = output;
+        }
+
+        /// <summary>
+        /// Runs a record command
+        /// </summary>
+        /// <param name="arguments">Arguments; the first positional is "records"</param>
+        /// <returns>The exit code</returns>
+        public Task<int> RunAsync(CommandLineArguments arguments)
+        {
+            var subcommand = arguments.Positionals.ElementAtOrDefault(1);
+            switch (subcommand)
+            {
+                case "migrate":
+                    return MigrateAsync();
+                default:
+                    throw new CommandException(subcommand == null ? "Missing records command" : $"Unknown records command: {subcommand}");
+            }
+        }
+
+        private async Task<int> MigrateAsync()
+        {
+            var report = await _client.Records.MigrateAsync();
+
+            _output.WriteLine($"{"TYPE",-20} {"COLLECTION",-22} {"VERSION",7} {"SCANNED",8} {"UPGRADED",9} {"FAILED",7} {"NEWER",6}");
+            foreach (var result in report.Results)
+            {
+                _output.WriteLine($"{result.RecordType,-20} {result.Collection,-22} {result.CurrentVersion,7} {result.Scanned,8} {result.Upgraded,9} {result.Failed,7} {result.Newer,6}");
+
+                if (result.Error != null)
+                {
+                    _output.WriteLine($"  Error: {result.Error}");
+                }
+
+                foreach (var detail in result.Details)
+                {
+                    _output.WriteLine($"  {detail}");
+                }
+            }
+
+            return report.Succeeded ? ExitCodes.Success : ExitCodes.Failure;
+        }
+    }
+}
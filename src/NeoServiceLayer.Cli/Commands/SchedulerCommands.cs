@@ -0,0 +1,196 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.IO;
+using System.Linq;
+using System.Text.Json;
+using System.Threading.Tasks;
+using NeoServiceLayer.Client;
+using NeoServiceLayer.Client.Models;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Cli.Commands
+{
+    /// <summary>
+    /// Cron scheduler diagnostics commands
+    /// </summary>
+    public class SchedulerCommands
+    {
+        /// <summary>
+        /// Usage text for the scheduler commands
+        /// </summary>
+        public const string Usage =
+            "  scheduler replay [--hours 24 | --from TIME]    Replay logged decisions to find missed or duplicate firings (Admin)\n" +
+            "           [--to TIME] [--trigger ID]            Fails if any fire time was missed or ran twice\n" +
+            "           [--triggers FILE] [--all]             Replay against trigger definitions from a JSON file; --all lists every fire time\n" +
+            "  scheduler decisions [--hours 1 | --from TIME]  Scheduler decisions from the replay log (Admin)\n" +
+            "           [--to TIME] [--trigger ID]\n";
+
+        private static readonly JsonSerializerOptions FileSerializerOptions = new JsonSerializerOptions { PropertyNameCaseInsensitive = true };
+
+        private readonly NeoServiceLayerClient _client;
+        private readonly TextWriter _output;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SchedulerCommands"/> class
+        /// </summary>
+        /// <param name="client">API client</param>
+        /// <param name="output">Output</param>
+        public SchedulerCommands(NeoServiceLayerClient client, TextWriter output)
+        {
+            _client = client;
+            _output = output;
+        }
+
+        /// <summary>
+        /// Runs a scheduler command
+        /// </summary>
+        /// <param name="arguments">Arguments; the first positional is "scheduler"</param>
+        /// <returns>The exit code</returns>
+        public Task<int> RunAsync(CommandLineArguments arguments)
+        {
+            var subcommand = arguments.Positionals.ElementAtOrDefault(1);
+            switch (subcommand)
+            {
+                case "replay":
+                    return ReplayAsync(arguments);
+                case "decisions":
+                    return DecisionsAsync(arguments);
+                default:
+                    throw new CommandException(subcommand == null ? "Missing scheduler command" : $"Unknown scheduler command: {subcommand}");
+            }
+        }
+
+        private async Task<int> ReplayAsync(CommandLineArguments arguments)
+        {
+            var (from, to) = ParseWindow(arguments, 24);
+            var request = new SchedulerReplayRequest { From = from, To = to };
+
+            var triggerId = ParseTriggerId(arguments);
+            if (triggerId.HasValue)
+            {
+                request.TriggerIds = new List<Guid> { triggerId.Value };
+            }
+
+            var file = arguments.GetOption("triggers");
+            if (file != null)
+            {
+                request.Triggers = ReadTriggers(file);
+            }
+
+            var report = await _client.Scheduler.ReplayAsync(request);
+
+            _output.WriteLine($"Replayed {report.DecisionCount} decisions against {report.Triggers.Count} triggers " +
+                $"from {report.From:yyyy-MM-dd HH:mm:ss} to {report.To:yyyy-MM-dd HH:mm:ss} UTC");
+            _output.WriteLine($"Missed: {report.Missed}  Duplicates: {report.Duplicates}  Unexpected: {report.Unexpected}");
+
+            var all = arguments.HasFlag("all");
+            foreach (var trigger in report.Triggers)
+            {
+                var fireTimes = all ? trigger.FireTimes : trigger.FireTimes.Where(f => f.Outcome != SchedulerReplayOutcome.Fired).ToList();
+                if (fireTimes.Count == 0)
+                {
+                    continue;
+                }
+
+                _output.WriteLine();
+                _output.WriteLine($"{trigger.Name} ({trigger.TriggerId}) '{trigger.CronExpression}'");
+                foreach (var fireTime in fireTimes)
+                {
+                    _output.WriteLine($"  {fireTime.ScheduledFor:yyyy-MM-dd HH:mm} {fireTime.Outcome,-10} {fireTime.Runs,2} {fireTime.Reason}");
+                }
+            }
+
+            return report.Missed > 0 || report.Duplicates > 0 ? ExitCodes.Failure : ExitCodes.Success;
+        }
+
+        private async Task<int> DecisionsAsync(CommandLineArguments arguments)
+        {
+            var (from, to) = ParseWindow(arguments, 1);
+            var decisions = await _client.Scheduler.GetDecisionsAsync(from, to, ParseTriggerId(arguments));
+
+            if (decisions.Count == 0)
+            {
+                _output.WriteLine("No decisions in the window");
+                return ExitCodes.Success;
+            }
+
+            _output.WriteLine($"{"TIME",-23} {"INSTANCE",-24} {"KIND",-10} {"TRIGGER",-36} {"FIRE TIME",-16} DETAIL");
+            foreach (var decision in decisions)
+            {
+                var detail = decision.NextRunAt.HasValue ? $"next {decision.NextRunAt:yyyy-MM-dd HH:mm} " : string.Empty;
+                _output.WriteLine($"{decision.At:yyyy-MM-dd HH:mm:ss.fff} {decision.InstanceId,-24} {decision.Kind,-10} {decision.TriggerId,-36} " +
+                    $"{decision.ScheduledFor?.ToString("yyyy-MM-dd HH:mm", CultureInfo.InvariantCulture) ?? "-",-16} {detail}{decision.Reason}");
+            }
+
+            return ExitCodes.Success;
+        }
+
+        private static (DateTime From, DateTime To) ParseWindow(CommandLineArguments arguments, int defaultHours)
+        {
+            var to = ParseTime(arguments, "to") ?? DateTime.UtcNow;
+            var from = ParseTime(arguments, "from");
+            if (from == null)
+            {
+                if (!int.TryParse(arguments.GetOption("hours", defaultHours.ToString(CultureInfo.InvariantCulture)), out var hours) || hours <= 0)
+                {
+                    throw new CommandException("--hours must be a positive number");
+                }
+
+                from = to.AddHours(-hours);
+            }
+
+            if (from >= to)
+            {
+                throw new CommandException("--from must be before --to");
+            }
+
+            return (from.Value, to);
+        }
+
+        private static DateTime? ParseTime(CommandLineArguments arguments, string name)
+        {
+            var value = arguments.GetOption(name);
+            if (value == null)
+            {
+                return null;
+            }
+
+            if (!DateTime.TryParse(value, CultureInfo.InvariantCulture, DateTimeStyles.AssumeUniversal | DateTimeStyles.AdjustToUniversal, out var time))
+            {
+                throw new CommandException($"--{name} must be a time, e.g. 2024-01-31T12:00:00Z");
+            }
+
+            return time;
+        }
+
+        private static Guid? ParseTriggerId(CommandLineArguments arguments)
+        {
+            var value = arguments.GetOption("trigger");
+            if (value == null)
+            {
+                return null;
+            }
+
+            if (!Guid.TryParse(value, out var id))
+            {
+                throw new CommandException("--trigger must be a trigger ID");
+            }
+
+            return id;
+        }
+
+        private static List<ScheduleTrigger> ReadTriggers(string path)
+        {
+            try
+            {
+                return JsonSerializer.Deserialize<List<ScheduleTrigger>>(File.ReadAllText(path), FileSerializerOptions)
+                    ?? throw new CommandException($"{path} does not contain a list of triggers");
+            }
+            catch (Exception ex) when (ex is IOException || ex is UnauthorizedAccessException || ex is JsonException)
+            {
+                throw new CommandException($"Cannot read triggers from {path}: {ex.Message}");
+            }
+        }
+    }
+}
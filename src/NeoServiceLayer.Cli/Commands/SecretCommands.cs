@@ -0,0 +1,179 @@
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Linq;
+using System.Text.Json;
+using System.Threading.Tasks;
+using NeoServiceLayer.Client;
+using NeoServiceLayer.Client.Models;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Cli.Commands
+{
+    /// <summary>
+    /// Commands for promoting secrets between environments
+    /// </summary>
+    /// <remarks>
+    /// Run <c>secrets transfer-key</c> against the target environment, <c>secrets export</c> against the source with
+    /// that key, and <c>secrets import</c> against the target with the bundle. The bundle can only be decrypted by the
+    /// target's enclave, so it is safe to store or pass around, but values never pass through this tool in plaintext.
+    /// </remarks>
+    public class SecretCommands
+    {
+        /// <summary>
+        /// Usage text for the secrets commands
+        /// </summary>
+        public const string Usage =
+            "  secrets transfer-key [--out FILE]              Public key other environments export secrets to this one under\n" +
+            "  secrets export --recipient-key KEY|FILE        Export secrets encrypted to another environment's transfer key\n" +
+            "           [--names A,B] [--out FILE]            Only the named secrets; write the bundle to a file instead of stdout\n" +
+            "  secrets import FILE [--overwrite]              Import a bundle exported to this environment; --overwrite updates existing secrets\n";
+
+        private static readonly JsonSerializerOptions FileSerializerOptions = new JsonSerializerOptions
+        {
+            PropertyNamingPolicy = JsonNamingPolicy.CamelCase,
+            PropertyNameCaseInsensitive = true,
+            WriteIndented = true
+        };
+
+        private readonly NeoServiceLayerClient _client;
+        private readonly TextWriter _output;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SecretCommands"/> class
+        /// </summary>
+        /// <param name="client">API client</param>
+        /// <param name="output">Output</param>
+        public SecretCommands(NeoServiceLayerClient client, TextWriter output)
+        {
+            _client = client;
+            _output = output;
+        }
+
+        /// <summary>
+        /// Runs a secrets command
+        /// </summary>
+        /// <param name="arguments">Arguments; the first positional is "secrets"</param>
+        /// <returns>The exit code</returns>
+        public Task<int> RunAsync(CommandLineArguments arguments)
+        {
+            var subcommand = arguments.Positionals.ElementAtOrDefault(1);
+            switch (subcommand)
+            {
+                case "transfer-key":
+                    return TransferKeyAsync(arguments);
+                case "export":
+                    return ExportAsync(arguments);
+                case "import":
+                    return ImportAsync(arguments);
+                default:
+                    throw new CommandException(subcommand == null ? "Missing secrets command" : $"Unknown secrets command: {subcommand}");
+            }
+        }
+
+        private async Task<int> TransferKeyAsync(CommandLineArguments arguments)
+        {
+            var key = await _client.Secrets.GetTransferKeyAsync();
+
+            var file = arguments.GetOption("out");
+            if (file == null)
+            {
+                _output.WriteLine($"Key ID:     {key.KeyId}");
+                _output.WriteLine($"Algorithm:  {key.Algorithm}");
+                _output.WriteLine($"Public key: {key.PublicKey}");
+                return ExitCodes.Success;
+            }
+
+            WriteJson(file, key);
+            _output.WriteLine($"Wrote transfer key {key.KeyId} to {file}");
+            return ExitCodes.Success;
+        }
+
+        private async Task<int> ExportAsync(CommandLineArguments arguments)
+        {
+            var recipientKey = arguments.GetOption("recipient-key")
+                ?? throw new CommandException("--recipient-key is required; run 'nsl secrets transfer-key' against the target environment");
+
+            var request = new ExportSecretsRequest
+            {
+                RecipientPublicKey = ReadRecipientKey(recipientKey),
+                Names = arguments.GetOption("names")?
+                    .Split(',', StringSplitOptions.RemoveEmptyEntries | StringSplitOptions.TrimEntries)
+                    .ToList()
+            };
+
+            var bundle = await _client.Secrets.ExportAsync(request);
+
+            var file = arguments.GetOption("out");
+            if (file == null)
+            {
+                _output.WriteLine(JsonSerializer.Serialize(bundle, FileSerializerOptions));
+                return ExitCodes.Success;
+            }
+
+            WriteJson(file, bundle);
+            _output.WriteLine($"Exported {bundle.Names.Count} secrets for key {bundle.RecipientKeyId} to {file}: {string.Join(", ", bundle.Names)}");
+            return ExitCodes.Success;
+        }
+
+        private async Task<int> ImportAsync(CommandLineArguments arguments)
+        {
+            var file = arguments.Positionals.ElementAtOrDefault(2)
+                ?? throw new CommandException("Missing bundle file");
+
+            var bundle = ReadJson<SecretTransferBundle>(file);
+            var result = await _client.Secrets.ImportAsync(new ImportSecretsRequest
+            {
+                Bundle = bundle,
+                Overwrite = arguments.HasFlag("overwrite")
+            });
+
+            foreach (var item in result.Items)
+            {
+                _output.WriteLine($"{item.Action,-8} {item.Name} ({item.SecretId}) v{item.Version}");
+            }
+
+            _output.WriteLine($"Imported {result.Items.Count} secrets; allowed functions and grants are not transferred");
+            return ExitCodes.Success;
+        }
+
+        // Accepts the key itself or a file written by 'secrets transfer-key --out'
+        private static string ReadRecipientKey(string value)
+        {
+            if (!File.Exists(value))
+            {
+                return value;
+            }
+
+            var content = File.ReadAllText(value).Trim();
+            return content.StartsWith("{", StringComparison.Ordinal)
+                ? ReadJson<SecretTransferKey>(value).PublicKey
+                : content;
+        }
+
+        private static T ReadJson<T>(string path)
+        {
+            try
+            {
+                return JsonSerializer.Deserialize<T>(File.ReadAllText(path), FileSerializerOptions)
+                    ?? throw new CommandException($"{path} is empty");
+            }
+            catch (Exception ex) when (ex is IOException || ex is UnauthorizedAccessException || ex is JsonException)
+            {
+                throw new CommandException($"Cannot read {path}: {ex.Message}");
+            }
+        }
+
+        private static void WriteJson<T>(string path, T value)
+        {
+            try
+            {
+                File.WriteAllText(path, JsonSerializer.Serialize(value, FileSerializerOptions));
+            }
+            catch (Exception ex) when (ex is IOException || ex is UnauthorizedAccessException)
+            {
+                throw new CommandException($"Cannot write {path}: {ex.Message}");
+            }
+        }
+    }
+}
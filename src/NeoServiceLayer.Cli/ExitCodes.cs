@@ -0,0 +1,23 @@
+namespace NeoServiceLayer.Cli
+{
+    /// <summary>
+    /// Process exit codes
+    /// </summary>
+    public static class ExitCodes
+    {
+        /// <summary>
+        /// The command succeeded
+        /// </summary>
+        public const int Success = 0;
+
+        /// <summary>
+        /// The command failed or was aborted
+        /// </summary>
+        public const int Failure = 1;
+
+        /// <summary>
+        /// The command line was invalid
+        /// </summary>
+        public const int Usage = 2;
+    }
+}
@@ -0,0 +1,36 @@
+namespace NeoServiceLayer.Cli.Profiles
+{
+    /// <summary>
+    /// A named environment the tool can talk to
+    /// </summary>
+    /// <remarks>
+    /// Only non-secret settings are kept here; tokens and API keys live in an <see cref="ICredentialStore"/>.
+    /// </remarks>
+    public class CliProfile
+    {
+        /// <summary>
+        /// Authentication method of a profile logged in with a username and password
+        /// </summary>
+        public const string PasswordAuth = "Password";
+
+        /// <summary>
+        /// Authentication method of a profile logged in with an API key
+        /// </summary>
+        public const string ApiKeyAuth = "ApiKey";
+
+        /// <summary>
+        /// Gets or sets the API base URL
+        /// </summary>
+        public string Url { get; set; }
+
+        /// <summary>
+        /// Gets or sets how the profile authenticates: "Password" or "ApiKey"
+        /// </summary>
+        public string AuthMethod { get; set; }
+
+        /// <summary>
+        /// Gets or sets the username the profile last logged in as
+        /// </summary>
+        public string Username { get; set; }
+    }
+}
@@ -0,0 +1,118 @@
+using System;
+using System.Diagnostics;
+using System.IO;
+using System.Text.Json;
+using System.Threading.Tasks;
+
+namespace NeoServiceLayer.Cli.Profiles
+{
+    /// <summary>
+    /// Base class for credential stores backed by an OS keyring command line tool
+    /// </summary>
+    public abstract class CommandCredentialStore : ICredentialStore
+    {
+        /// <summary>
+        /// Service name credentials are stored under in the keyring
+        /// </summary>
+        protected const string ServiceName = "nsl";
+
+        /// <inheritdoc/>
+        public abstract string Name { get; }
+
+        /// <inheritdoc/>
+        public abstract Task<StoredCredential> GetAsync(string profile);
+
+        /// <inheritdoc/>
+        public abstract Task SaveAsync(string profile, StoredCredential credential);
+
+        /// <inheritdoc/>
+        public abstract Task<bool> DeleteAsync(string profile);
+
+        /// <summary>
+        /// Serializes a credential to the secret stored in the keyring
+        /// </summary>
+        protected static string Serialize(StoredCredential credential)
+        {
+            return JsonSerializer.Serialize(credential);
+        }
+
+        /// <summary>
+        /// Deserializes a secret read from the keyring
+        /// </summary>
+        protected static StoredCredential Deserialize(string secret)
+        {
+            if (string.IsNullOrWhiteSpace(secret))
+            {
+                return null;
+            }
+
+            try
+            {
+                return JsonSerializer.Deserialize<StoredCredential>(secret.Trim());
+            }
+            catch (JsonException)
+            {
+                // Not written by this tool; treat the profile as logged out
+                return null;
+            }
+        }
+
+        /// <summary>
+        /// Runs the keyring tool
+        /// </summary>
+        /// <param name="fileName">Executable</param>
+        /// <param name="input">Text written to standard input, if any</param>
+        /// <param name="arguments">Arguments</param>
+        /// <returns>The exit code and standard output</returns>
+        protected static async Task<(int ExitCode, string Output)> RunAsync(string fileName, string input, params string[] arguments)
+        {
+            var startInfo = new ProcessStartInfo(fileName)
+            {
+                RedirectStandardInput = true,
+                RedirectStandardOutput = true,
+                RedirectStandardError = true,
+                UseShellExecute = false
+            };
+
+            foreach (var argument in arguments)
+            {
+                startInfo.ArgumentList.Add(argument);
+            }
+
+            using var process = Process.Start(startInfo) ?? throw new CommandException($"Could not start {fileName}");
+
+            if (input != null)
+            {
+                await process.StandardInput.WriteAsync(input);
+            }
+
+            process.StandardInput.Close();
+
+            // Both streams are drained so a chatty tool can't block on a full pipe
+            var output = process.StandardOutput.ReadToEndAsync();
+            var error = process.StandardError.ReadToEndAsync();
+            await Task.WhenAll(output, error, process.WaitForExitAsync());
+
+            return (process.ExitCode, output.Result);
+        }
+
+        /// <summary>
+        /// Checks if an executable is on the PATH
+        /// </summary>
+        /// <param name="fileName">Executable name</param>
+        /// <returns>True if found, false otherwise</returns>
+        protected static bool IsOnPath(string fileName)
+        {
+            var path = Environment.GetEnvironmentVariable("PATH") ?? string.Empty;
+            foreach (var directory in path.Split(Path.PathSeparator, StringSplitOptions.RemoveEmptyEntries))
+            {
+                if (File.Exists(Path.Combine(directory, fileName)))
+                {
+                    return true;
+                }
+            }
+
+            return false;
+        }
+    }
+}
@@ -0,0 +1,41 @@
+using System;
+
+namespace NeoServiceLayer.Cli.Profiles
+{
+    /// <summary>
+    /// Picks the credential store for the current platform
+    /// </summary>
+    public static class CredentialStores
+    {
+        /// <summary>
+        /// Creates the OS keyring store for the current platform, or a file store if there is none
+        /// </summary>
+        /// <param name="directory">Configuration directory used by the file store</param>
+        /// <returns>The credential store</returns>
+        public static ICredentialStore CreateDefault(string directory)
+        {
+            // NSL_CREDENTIAL_STORE=file forces the file store, e.g. in CI where unlocking a keyring isn't possible
+            if (string.Equals(Environment.GetEnvironmentVariable("NSL_CREDENTIAL_STORE"), "file", StringComparison.OrdinalIgnoreCase))
+            {
+                return new FileCredentialStore(directory);
+            }
+
+            if (OperatingSystem.IsWindows())
+            {
+                return new WindowsCredentialStore();
+            }
+
+            if (OperatingSystem.IsMacOS())
+            {
+                return new MacKeychainCredentialStore();
+            }
+
+            if (SecretServiceCredentialStore.IsAvailable)
+            {
+                return new SecretServiceCredentialStore();
+            }
+
+            return new FileCredentialStore(directory);
+        }
+    }
+}
@@ -0,0 +1,96 @@
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Text.Json;
+using System.Threading.Tasks;
+
+namespace NeoServiceLayer.Cli.Profiles
+{
+    /// <summary>
+    /// Stores credentials in a file readable only by the current user
+    /// </summary>
+    /// <remarks>
+    /// Used when no OS keyring is available, e.g. on a headless Linux host without a Secret Service.
+    /// </remarks>
+    public class FileCredentialStore : ICredentialStore
+    {
+        private const string FileName = "credentials.json";
+
+        private readonly string _path;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="FileCredentialStore"/> class
+        /// </summary>
+        /// <param name="directory">Configuration directory</param>
+        public FileCredentialStore(string directory)
+        {
+            _path = Path.Combine(directory, FileName);
+        }
+
+        /// <inheritdoc/>
+        public string Name => _path;
+
+        /// <inheritdoc/>
+        public Task<StoredCredential> GetAsync(string profile)
+        {
+            return Task.FromResult(Load().TryGetValue(profile, out var credential) ? credential : null);
+        }
+
+        /// <inheritdoc/>
+        public Task SaveAsync(string profile, StoredCredential credential)
+        {
+            var credentials = Load();
+            credentials[profile] = credential;
+            Save(credentials);
+            return Task.CompletedTask;
+        }
+
+        /// <inheritdoc/>
+        public Task<bool> DeleteAsync(string profile)
+        {
+            var credentials = Load();
+            if (!credentials.Remove(profile))
+            {
+                return Task.FromResult(false);
+            }
+
+            Save(credentials);
+            return Task.FromResult(true);
+        }
+
+        private Dictionary<string, StoredCredential> Load()
+        {
+            if (!File.Exists(_path))
+            {
+                return new Dictionary<string, StoredCredential>(StringComparer.OrdinalIgnoreCase);
+            }
+
+            try
+            {
+                var credentials = JsonSerializer.Deserialize<Dictionary<string, StoredCredential>>(File.ReadAllText(_path));
+                return new Dictionary<string, StoredCredential>(credentials ?? new Dictionary<string, StoredCredential>(), StringComparer.OrdinalIgnoreCase);
+            }
+            catch (JsonException ex)
+            {
+                throw new CommandException($"Credential file {_path} is not valid JSON: {ex.Message}", ex);
+            }
+        }
+
+        private void Save(Dictionary<string, StoredCredential> credentials)
+        {
+            Directory.CreateDirectory(Path.GetDirectoryName(_path));
+
+            // Create the file with owner-only permissions before any secret is written to it
+            if (!OperatingSystem.IsWindows())
+            {
+                using (File.Open(_path, FileMode.OpenOrCreate, FileAccess.Write))
+                {
+                }
+
+                File.SetUnixFileMode(_path, UnixFileMode.UserRead | UnixFileMode.UserWrite);
+            }
+
+            File.WriteAllText(_path, JsonSerializer.Serialize(credentials, new JsonSerializerOptions { WriteIndented = true }));
+        }
+    }
+}
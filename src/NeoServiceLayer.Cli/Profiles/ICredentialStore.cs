@@ -0,0 +1,36 @@
+using System.Threading.Tasks;
+
+namespace NeoServiceLayer.Cli.Profiles
+{
+    /// <summary>
+    /// Stores the credentials of each profile
+    /// </summary>
+    public interface ICredentialStore
+    {
+        /// <summary>
+        /// Gets a description of where credentials are kept, shown after logging in
+        /// </summary>
+        string Name { get; }
+
+        /// <summary>
+        /// Gets the credential of a profile
+        /// </summary>
+        /// <param name="profile">Profile name</param>
+        /// <returns>The credential, or null if none is stored</returns>
+        Task<StoredCredential> GetAsync(string profile);
+
+        /// <summary>
+        /// Stores the credential of a profile, replacing any existing one
+        /// </summary>
+        /// <param name="profile">Profile name</param>
+        /// <param name="credential">Credential to store</param>
+        Task SaveAsync(string profile, StoredCredential credential);
+
+        /// <summary>
+        /// Removes the credential of a profile
+        /// </summary>
+        /// <param name="profile">Profile name</param>
+        /// <returns>True if a credential was removed, false if none was stored</returns>
+        Task<bool> DeleteAsync(string profile);
+    }
+}
@@ -0,0 +1,41 @@
+using System.Threading.Tasks;
+
+namespace NeoServiceLayer.Cli.Profiles
+{
+    /// <summary>
+    /// Stores credentials in the macOS login keychain through the security tool
+    /// </summary>
+    public class MacKeychainCredentialStore : CommandCredentialStore
+    {
+        private const string Tool = "/usr/bin/security";
+
+        /// <inheritdoc/>
+        public override string Name => "the macOS keychain";
+
+        /// <inheritdoc/>
+        public override async Task<StoredCredential> GetAsync(string profile)
+        {
+            var (exitCode, output) = await RunAsync(Tool, null, "find-generic-password", "-s", ServiceName, "-a", profile, "-w");
+            return exitCode == 0 ? Deserialize(output) : null;
+        }
+
+        /// <inheritdoc/>
+        public override async Task SaveAsync(string profile, StoredCredential credential)
+        {
+            // security only reads a password from the terminal, so it has to be passed as an argument.
+            // It is visible to the user's own processes for the moment the tool runs.
+            var (exitCode, _) = await RunAsync(Tool, null, "add-generic-password", "-U", "-s", ServiceName, "-a", profile, "-l", $"nsl ({profile})", "-w", Serialize(credential));
+            if (exitCode != 0)
+            {
+                throw new CommandException($"Could not store the credential in {Name} (security exited with {exitCode})");
+            }
+        }
+
+        /// <inheritdoc/>
+        public override async Task<bool> DeleteAsync(string profile)
+        {
+            var (exitCode, _) = await RunAsync(Tool, null, "delete-generic-password", "-s", ServiceName, "-a", profile);
+            return exitCode == 0;
+        }
+    }
+}
@@ -0,0 +1,47 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Cli.Profiles
+{
+    /// <summary>
+    /// Profiles known to the tool and the one used when none is given
+    /// </summary>
+    public class ProfileConfiguration
+    {
+        /// <summary>
+        /// Name of the profile used when no profile has been selected
+        /// </summary>
+        public const string DefaultProfileName = "default";
+
+        /// <summary>
+        /// Gets or sets the name of the profile used when no --profile is given
+        /// </summary>
+        public string CurrentProfile { get; set; }
+
+        /// <summary>
+        /// Gets or sets the profiles by name
+        /// </summary>
+        public Dictionary<string, CliProfile> Profiles { get; set; } = new Dictionary<string, CliProfile>(StringComparer.OrdinalIgnoreCase);
+
+        /// <summary>
+        /// Resolves the profile to use
+        /// </summary>
+        /// <param name="requested">Profile given with --profile, if any</param>
+        /// <returns>The requested profile, else $NSL_PROFILE, else the current profile, else "default"</returns>
+        public string ResolveName(string requested)
+        {
+            if (!string.IsNullOrEmpty(requested))
+            {
+                return requested;
+            }
+
+            var fromEnvironment = Environment.GetEnvironmentVariable("NSL_PROFILE");
+            if (!string.IsNullOrEmpty(fromEnvironment))
+            {
+                return fromEnvironment;
+            }
+
+            return string.IsNullOrEmpty(CurrentProfile) ? DefaultProfileName : CurrentProfile;
+        }
+    }
+}
@@ -0,0 +1,81 @@
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Text.Json;
+
+namespace NeoServiceLayer.Cli.Profiles
+{
+    /// <summary>
+    /// Reads and writes the profile file in the tool's configuration directory
+    /// </summary>
+    public class ProfileStore
+    {
+        private const string FileName = "profiles.json";
+
+        private static readonly JsonSerializerOptions SerializerOptions = new JsonSerializerOptions { WriteIndented = true };
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ProfileStore"/> class
+        /// </summary>
+        /// <param name="directory">Configuration directory</param>
+        public ProfileStore(string directory)
+        {
+            Directory = directory;
+        }
+
+        /// <summary>
+        /// Gets the configuration directory
+        /// </summary>
+        public string Directory { get; }
+
+        /// <summary>
+        /// Gets the default configuration directory: $NSL_CONFIG_DIR, or "nsl" in the user's application data folder
+        /// </summary>
+        public static string DefaultDirectory
+        {
+            get
+            {
+                var configured = Environment.GetEnvironmentVariable("NSL_CONFIG_DIR");
+                return string.IsNullOrEmpty(configured)
+                    ? Path.Combine(Environment.GetFolderPath(Environment.SpecialFolder.ApplicationData), "nsl")
+                    : configured;
+            }
+        }
+
+        /// <summary>
+        /// Loads the profiles
+        /// </summary>
+        /// <returns>The profiles; empty if none have been saved</returns>
+        public ProfileConfiguration Load()
+        {
+            var path = Path.Combine(Directory, FileName);
+            if (!File.Exists(path))
+            {
+                return new ProfileConfiguration();
+            }
+
+            try
+            {
+                var configuration = JsonSerializer.Deserialize<ProfileConfiguration>(File.ReadAllText(path), SerializerOptions) ?? new ProfileConfiguration();
+
+                // Profile names are case-insensitive; the deserializer creates a case-sensitive dictionary
+                configuration.Profiles = new Dictionary<string, CliProfile>(configuration.Profiles ?? new Dictionary<string, CliProfile>(), StringComparer.OrdinalIgnoreCase);
+                return configuration;
+            }
+            catch (JsonException ex)
+            {
+                throw new CommandException($"Profile file {path} is not valid JSON: {ex.Message}", ex);
+            }
+        }
+
+        /// <summary>
+        /// Saves the profiles
+        /// </summary>
+        /// <param name="configuration">Profiles to save</param>
+        public void Save(ProfileConfiguration configuration)
+        {
+            System.IO.Directory.CreateDirectory(Directory);
+            File.WriteAllText(Path.Combine(Directory, FileName), JsonSerializer.Serialize(configuration, SerializerOptions));
+        }
+    }
+}
@@ -0,0 +1,51 @@
+using System.Threading.Tasks;
+
+namespace NeoServiceLayer.Cli.Profiles
+{
+    /// <summary>
+    /// Stores credentials in the freedesktop Secret Service (GNOME Keyring, KWallet) through secret-tool
+    /// </summary>
+    public class SecretServiceCredentialStore : CommandCredentialStore
+    {
+        private const string Tool = "secret-tool";
+
+        /// <summary>
+        /// Checks if the Secret Service can be used: secret-tool is installed and a session bus is running
+        /// </summary>
+        public static bool IsAvailable =>
+            IsOnPath(Tool) && !string.IsNullOrEmpty(System.Environment.GetEnvironmentVariable("DBUS_SESSION_BUS_ADDRESS"));
+
+        /// <inheritdoc/>
+        public override string Name => "the Secret Service keyring";
+
+        /// <inheritdoc/>
+        public override async Task<StoredCredential> GetAsync(string profile)
+        {
+            var (exitCode, output) = await RunAsync(Tool, null, "lookup", "service", ServiceName, "profile", profile);
+            return exitCode == 0 ? Deserialize(output) : null;
+        }
+
+        /// <inheritdoc/>
+        public override async Task SaveAsync(string profile, StoredCredential credential)
+        {
+            // The secret is passed on standard input so it never shows up in the process list
+            var (exitCode, _) = await RunAsync(Tool, Serialize(credential), "store", $"--label=nsl ({profile})", "service", ServiceName, "profile", profile);
+            if (exitCode != 0)
+            {
+                throw new CommandException($"Could not store the credential in {Name} ({Tool} exited with {exitCode})");
+            }
+        }
+
+        /// <inheritdoc/>
+        public override async Task<bool> DeleteAsync(string profile)
+        {
+            if (await GetAsync(profile) == null)
+            {
+                return false;
+            }
+
+            var (exitCode, _) = await RunAsync(Tool, null, "clear", "service", ServiceName, "profile", profile);
+            return exitCode == 0;
+        }
+    }
+}
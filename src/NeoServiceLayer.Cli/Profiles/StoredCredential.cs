@@ -0,0 +1,30 @@
+using System;
+
+namespace NeoServiceLayer.Cli.Profiles
+{
+    /// <summary>
+    /// Secret material cached for a profile
+    /// </summary>
+    public class StoredCredential
+    {
+        /// <summary>
+        /// Gets or sets the access token of a password login
+        /// </summary>
+        public string AccessToken { get; set; }
+
+        /// <summary>
+        /// Gets or sets the refresh token of a password login
+        /// </summary>
+        public string RefreshToken { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the access token expires
+        /// </summary>
+        public DateTime? ExpiresAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the API key of an API key login
+        /// </summary>
+        public string ApiKey { get; set; }
+    }
+}
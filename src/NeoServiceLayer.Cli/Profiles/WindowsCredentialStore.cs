@@ -0,0 +1,140 @@
+using System;
+using System.ComponentModel;
+using System.Runtime.InteropServices;
+using System.Runtime.Versioning;
+using System.Text;
+using System.Text.Json;
+using System.Threading.Tasks;
+
+namespace NeoServiceLayer.Cli.Profiles
+{
+    /// <summary>
+    /// Stores credentials in the Windows Credential Manager
+    /// </summary>
+    [SupportedOSPlatform("windows")]
+    public class WindowsCredentialStore : ICredentialStore
+    {
+        private const uint GenericCredential = 1;
+        private const uint PersistLocalMachine = 2;
+        private const int ErrorNotFound = 1168;
+
+        // Credential Manager limits a generic credential's blob to 5 * 512 bytes
+        private const int MaxBlobSize = 2560;
+
+        /// <inheritdoc/>
+        public string Name => "the Windows Credential Manager";
+
+        /// <inheritdoc/>
+        public Task<StoredCredential> GetAsync(string profile)
+        {
+            if (!CredRead(TargetName(profile), GenericCredential, 0, out var handle))
+            {
+                var error = Marshal.GetLastWin32Error();
+                return error == ErrorNotFound ? Task.FromResult<StoredCredential>(null) : throw new Win32Exception(error);
+            }
+
+            try
+            {
+                var credential = Marshal.PtrToStructure<NativeCredential>(handle);
+                var blob = new byte[credential.CredentialBlobSize];
+                Marshal.Copy(credential.CredentialBlob, blob, 0, blob.Length);
+
+                try
+                {
+                    return Task.FromResult(JsonSerializer.Deserialize<StoredCredential>(blob));
+                }
+                catch (JsonException)
+                {
+                    return Task.FromResult<StoredCredential>(null);
+                }
+            }
+            finally
+            {
+                CredFree(handle);
+            }
+        }
+
+        /// <inheritdoc/>
+        public Task SaveAsync(string profile, StoredCredential credential)
+        {
+            var blob = Encoding.UTF8.GetBytes(JsonSerializer.Serialize(credential));
+            if (blob.Length > MaxBlobSize)
+            {
+                throw new CommandException($"The credential is too large for {Name} ({blob.Length} bytes)");
+            }
+
+            var blobHandle = Marshal.AllocHGlobal(blob.Length);
+            try
+            {
+                Marshal.Copy(blob, 0, blobHandle, blob.Length);
+
+                var nativeCredential = new NativeCredential
+                {
+                    Type = GenericCredential,
+                    TargetName = TargetName(profile),
+                    UserName = profile,
+                    CredentialBlob = blobHandle,
+                    CredentialBlobSize = (uint)blob.Length,
+                    Persist = PersistLocalMachine
+                };
+
+                if (!CredWrite(ref nativeCredential, 0))
+                {
+                    throw new Win32Exception(Marshal.GetLastWin32Error());
+                }
+            }
+            finally
+            {
+                Marshal.FreeHGlobal(blobHandle);
+            }
+
+            return Task.CompletedTask;
+        }
+
+        /// <inheritdoc/>
+        public Task<bool> DeleteAsync(string profile)
+        {
+            if (CredDelete(TargetName(profile), GenericCredential, 0))
+            {
+                return Task.FromResult(true);
+            }
+
+            var error = Marshal.GetLastWin32Error();
+            return error == ErrorNotFound ? Task.FromResult(false) : throw new Win32Exception(error);
+        }
+
+        private static string TargetName(string profile)
+        {
+            return $"nsl:{profile}";
+        }
+
+        [StructLayout(LayoutKind.Sequential, CharSet = CharSet.Unicode)]
+        private struct NativeCredential
+        {
+            public uint Flags;
+            public uint Type;
+            public string TargetName;
+            public string Comment;
+            public long LastWritten;
+            public uint CredentialBlobSize;
+            public IntPtr CredentialBlob;
+            public uint Persist;
+            public uint AttributeCount;
+            public IntPtr Attributes;
+            public string TargetAlias;
+            public string UserName;
+        }
+
+        [DllImport("advapi32.dll", EntryPoint = "CredReadW", CharSet = CharSet.Unicode, SetLastError = true)]
+        private static extern bool CredRead(string target, uint type, uint flags, out IntPtr credential);
+
+        [DllImport("advapi32.dll", EntryPoint = "CredWriteW", CharSet = CharSet.Unicode, SetLastError = true)]
+        private static extern bool CredWrite(ref NativeCredential credential, uint flags);
+
+        [DllImport("advapi32.dll", EntryPoint = "CredDeleteW", CharSet = CharSet.Unicode, SetLastError = true)]
+        private static extern bool CredDelete(string target, uint type, uint flags);
+
+        [DllImport("advapi32.dll")]
+        private static extern void CredFree(IntPtr buffer);
+    }
+}
@@ -0,0 +1,124 @@
+using System;
+using System.Text;
+using System.Threading.Tasks;
+using NeoServiceLayer.Cli.Commands;
+using NeoServiceLayer.Cli.Profiles;
+using NeoServiceLayer.Client;
+
+namespace NeoServiceLayer.Cli
+{
+    /// <summary>
+    /// Entry point of the nsl command line tool
+    /// </summary>
+    public static class Program
+    {
+        private static readonly string[] Flags = { "help", "history", "force", "yes", "api-key-stdin", "all", "overwrite" };
+
+        /// <summary>
+        /// Runs the command line tool
+        /// </summary>
+        /// <param name="args">Command line arguments</param>
+        /// <returns>The exit code</returns>
+        public static async Task<int> Main(string[] args)
+        {
+            var arguments = CommandLineArguments.Parse(args, Flags);
+            var command = arguments.Positionals.Count > 0 ? arguments.Positionals[0] : null;
+
+            if (command == null || command == "help" || arguments.HasFlag("help"))
+            {
+                PrintUsage();
+                return command == null && !arguments.HasFlag("help") ? ExitCodes.Usage : ExitCodes.Success;
+            }
+
+            try
+            {
+                var profiles = new ProfileStore(ProfileStore.DefaultDirectory);
+                var credentials = CredentialStores.CreateDefault(profiles.Directory);
+                var clientFactory = new ClientFactory(profiles, credentials);
+
+                switch (command)
+                {
+                    case "login":
+                    case "logout":
+                    case "profile":
+                        return await new LoginCommands(profiles, credentials, clientFactory, Console.In, Console.Out, ReadSecret).RunAsync(arguments);
+                    case "ops":
+                        return await new OpsCommands(await clientFactory.CreateAsync(arguments), Console.Out).RunAsync(arguments);
+                    case "price":
+                        return await new PriceCommands(await clientFactory.CreateAsync(arguments), Console.In, Console.Out).RunAsync(arguments);
+                    case "records":
+                        return await new RecordCommands(await clientFactory.CreateAsync(arguments), Console.Out).RunAsync(arguments);
+                    case "scheduler":
+                        return await new SchedulerCommands(await clientFactory.CreateAsync(arguments), Console.Out).RunAsync(arguments);
+                    case "secrets":
+                        return await new SecretCommands(await clientFactory.CreateAsync(arguments), Console.Out).RunAsync(arguments);
+                    default:
+                        throw new CommandException($"Unknown command: {command}");
+                }
+            }
+            catch (CommandException ex)
+            {
+                Console.Error.WriteLine($"Error: {ex.Message}");
+                Console.Error.WriteLine("Run 'nsl help' for usage.");
+                return ExitCodes.Usage;
+            }
+            catch (NeoServiceLayerApiException ex)
+            {
+                Console.Error.WriteLine(ex.StatusCode.HasValue
+                    ? $"Error: {ex.Message} ({(int)ex.StatusCode.Value})"
+                    : $"Error: {ex.Message}");
+                return ExitCodes.Failure;
+            }
+        }
+
+        private static string ReadSecret(string prompt)
+        {
+            Console.Write(prompt);
+            if (Console.IsInputRedirected)
+            {
+                return Console.ReadLine();
+            }
+
+            var secret = new StringBuilder();
+            while (true)
+            {
+                var key = Console.ReadKey(true);
+                if (key.Key == ConsoleKey.Enter)
+                {
+                    Console.WriteLine();
+                    return secret.ToString();
+                }
+
+                if (key.Key == ConsoleKey.Backspace)
+                {
+                    if (secret.Length > 0)
+                    {
+                        secret.Length--;
+                    }
+                }
+                else if (!char.IsControl(key.KeyChar))
+                {
+                    secret.Append(key.KeyChar);
+                }
+            }
+        }
+
+        private static void PrintUsage()
+        {
+            Console.WriteLine("Usage: nsl [--profile NAME] [--url URL] [--token TOKEN] <command> [options]");
+            Console.WriteLine();
+            Console.WriteLine("Global options:");
+            Console.WriteLine("  --profile NAME  Profile to use (default: $NSL_PROFILE or the profile set with 'nsl profile use')");
+            Console.WriteLine("  --url URL       API base URL (default: $NSL_API_URL, the profile's URL or " + ClientFactory.DefaultApiUrl + ")");
+            Console.WriteLine("  --token TOKEN   Access token (default: $NSL_API_TOKEN or the profile's cached credential)");
+            Console.WriteLine();
+            Console.WriteLine("Commands:");
+            Console.Write(LoginCommands.Usage);
+            Console.Write(OpsCommands.Usage);
+            Console.Write(PriceCommands.Usage);
+            Console.Write(RecordCommands.Usage);
+            Console.Write(SchedulerCommands.Usage);
+            Console.Write(SecretCommands.Usage);
+        }
+    }
+}
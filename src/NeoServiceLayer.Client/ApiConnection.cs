@@ -0,0 +1,299 @@
+using System;
+using System.Collections.Generic;
+using System.Net;
+using System.Net.Http;
+using System.Net.Http.Headers;
+using System.Net.Http.Json;
+using System.Runtime.CompilerServices;
+using System.Text.Json;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Client
+{
+    /// <summary>
+    /// Sends requests to the API, handling authentication, retries and error responses
+    /// </summary>
+    internal class ApiConnection
+    {
+        private readonly HttpClient _httpClient;
+        private readonly NeoServiceLayerClientOptions _options;
+        private readonly SemaphoreSlim _tokenLock = new SemaphoreSlim(1, 1);
+        private TokenResponse _token;
+
+        // Tokens are refreshed this long before they expire so in-flight requests don't race the expiry
+        private static readonly TimeSpan TokenRefreshMargin = TimeSpan.FromSeconds(30);
+
+        private const string ApiKeyHeader = "X-API-Key";
+
+        internal static readonly JsonSerializerOptions SerializerOptions = new JsonSerializerOptions(JsonSerializerDefaults.Web);
+
+        public ApiConnection(HttpClient httpClient, NeoServiceLayerClientOptions options)
+        {
+            _httpClient = httpClient;
+            _options = options;
+
+            if (_httpClient.BaseAddress == null)
+            {
+                _httpClient.BaseAddress = new Uri(options.BaseUrl.TrimEnd('/') + "/");
+            }
+        }
+
+        public async Task<T> GetAsync<T>(string path, CancellationToken cancellationToken)
+        {
+            return await SendAsync<T>(HttpMethod.Get, path, null, cancellationToken);
+        }
+
+        public async Task<T> SendAsync<T>(HttpMethod method, string path, object body, CancellationToken cancellationToken)
+        {
+            using var response = await SendWithRetriesAsync(method, path, body, true, cancellationToken);
+            if (response.StatusCode == HttpStatusCode.NoContent || response.Content.Headers.ContentLength == 0)
+            {
+                return default;
+            }
+
+            return await response.Content.ReadFromJsonAsync<T>(SerializerOptions, cancellationToken);
+        }
+
+        public async Task SendAsync(HttpMethod method, string path, object body, CancellationToken cancellationToken)
+        {
+            using var response = await SendWithRetriesAsync(method, path, body, true, cancellationToken);
+        }
+
+        /// <summary>
+        /// Sends a request without credentials, e.g. to log in
+        /// </summary>
+        public async Task<T> SendAnonymousAsync<T>(HttpMethod method, string path, object body, CancellationToken cancellationToken)
+        {
+            using var response = await SendWithRetriesAsync(method, path, body, false, cancellationToken);
+            return await response.Content.ReadFromJsonAsync<T>(SerializerOptions, cancellationToken);
+        }
+
+        /// <summary>
+        /// Iterates over an offset-paginated endpoint until it returns a short page
+        /// </summary>
+        public async IAsyncEnumerable<T> PaginateAsync<T>(
+            Func<int, int, string> pathForPage,
+            int pageSize,
+            [EnumeratorCancellation] CancellationToken cancellationToken)
+        {
+            if (pageSize <= 0)
+            {
+                throw new ArgumentOutOfRangeException(nameof(pageSize), "Page size must be positive");
+            }
+
+            var offset = 0;
+            while (true)
+            {
+                var page = await GetAsync<List<T>>(pathForPage(offset, pageSize), cancellationToken) ?? new List<T>();
+                foreach (var item in page)
+                {
+                    yield return item;
+                }
+
+                if (page.Count < pageSize)
+                {
+                    yield break;
+                }
+
+                offset += page.Count;
+            }
+        }
+
+        private async Task<HttpResponseMessage> SendWithRetriesAsync(HttpMethod method, string path, object body, bool authenticate, CancellationToken cancellationToken)
+        {
+            // Only requests that are safe to repeat are retried; POSTs may have side effects such as executions or transfers
+            var maxAttempts = IsIdempotent(method) ? Math.Max(0, _options.MaxRetries) + 1 : 1;
+            var refreshedAfterUnauthorized = false;
+
+            for (var attempt = 1; ; attempt++)
+            {
+                HttpResponseMessage response;
+                try
+                {
+                    using var request = new HttpRequestMessage(method, path.TrimStart('/'));
+                    if (body != null)
+                    {
+                        request.Content = JsonContent.Create(body, body.GetType(), options: SerializerOptions);
+                    }
+
+                    if (authenticate)
+                    {
+                        var accessToken = await GetAccessTokenAsync(cancellationToken);
+                        if (!string.IsNullOrEmpty(accessToken))
+                        {
+                            request.Headers.Authorization = new AuthenticationHeaderValue("Bearer", accessToken);
+                        }
+                        else if (!string.IsNullOrEmpty(_options.ApiKey))
+                        {
+                            request.Headers.Add(ApiKeyHeader, _options.ApiKey);
+                        }
+                    }
+
+                    response = await _httpClient.SendAsync(request, cancellationToken);
+                }
+                catch (Exception ex) when (IsTransient(ex, cancellationToken) && attempt < maxAttempts)
+                {
+                    await Task.Delay(GetRetryDelay(attempt, null), cancellationToken);
+                    continue;
+                }
+                catch (Exception ex) when (IsTransient(ex, cancellationToken))
+                {
+                    throw new NeoServiceLayerApiException($"Request to {path} failed: {ex.Message}", ex);
+                }
+
+                if (response.IsSuccessStatusCode)
+                {
+                    return response;
+                }
+
+                // An expired session is renewed once, independently of the retry budget
+                if (response.StatusCode == HttpStatusCode.Unauthorized && authenticate && !refreshedAfterUnauthorized && CanLogIn)
+                {
+                    response.Dispose();
+                    refreshedAfterUnauthorized = true;
+                    await RenewTokenAsync(true, cancellationToken);
+                    attempt--;
+                    continue;
+                }
+
+                if (IsRetryable(response.StatusCode) && attempt < maxAttempts)
+                {
+                    var delay = GetRetryDelay(attempt, response.Headers.RetryAfter);
+                    response.Dispose();
+                    await Task.Delay(delay, cancellationToken);
+                    continue;
+                }
+
+                using (response)
+                {
+                    throw new NeoServiceLayerApiException(await ReadErrorMessageAsync(response, cancellationToken), response.StatusCode);
+                }
+            }
+        }
+
+        private bool CanLogIn => string.IsNullOrEmpty(_options.AccessToken) &&
+            !string.IsNullOrEmpty(_options.Username) && !string.IsNullOrEmpty(_options.Password);
+
+        private async Task<string> GetAccessTokenAsync(CancellationToken cancellationToken)
+        {
+            if (!string.IsNullOrEmpty(_options.AccessToken))
+            {
+                return _options.AccessToken;
+            }
+
+            if (!CanLogIn)
+            {
+                return null;
+            }
+
+            var token = _token;
+            if (token != null && token.ExpiresAt - TokenRefreshMargin > DateTime.UtcNow)
+            {
+                return token.AccessToken;
+            }
+
+            return (await RenewTokenAsync(false, cancellationToken)).AccessToken;
+        }
+
+        private async Task<TokenResponse> RenewTokenAsync(bool force, CancellationToken cancellationToken)
+        {
+            await _tokenLock.WaitAsync(cancellationToken);
+            try
+            {
+                // Another caller may have renewed the token while we were waiting
+                if (!force && _token != null && _token.ExpiresAt - TokenRefreshMargin > DateTime.UtcNow)
+                {
+                    return _token;
+                }
+
+                if (_token != null && !string.IsNullOrEmpty(_token.RefreshToken))
+                {
+                    try
+                    {
+                        _token = await RequestTokenAsync("api/auth/refresh", new { RefreshToken = _token.RefreshToken }, cancellationToken);
+                        return _token;
+                    }
+                    catch (NeoServiceLayerApiException ex) when (ex.StatusCode == HttpStatusCode.Unauthorized)
+                    {
+                        // Fall back to logging in again
+                    }
+                }
+
+                _token = await RequestTokenAsync("api/auth/login", new { _options.Username, _options.Password }, cancellationToken);
+                return _token;
+            }
+            finally
+            {
+                _tokenLock.Release();
+            }
+        }
+
+        private async Task<TokenResponse> RequestTokenAsync(string path, object body, CancellationToken cancellationToken)
+        {
+            using var response = await SendWithRetriesAsync(HttpMethod.Post, path, body, false, cancellationToken);
+            return await response.Content.ReadFromJsonAsync<TokenResponse>(SerializerOptions, cancellationToken);
+        }
+
+        private TimeSpan GetRetryDelay(int attempt, RetryConditionHeaderValue retryAfter)
+        {
+            if (retryAfter?.Delta != null)
+            {
+                return retryAfter.Delta.Value;
+            }
+
+            if (retryAfter?.Date != null)
+            {
+                var delay = retryAfter.Date.Value - DateTimeOffset.UtcNow;
+                return delay > TimeSpan.Zero ? delay : TimeSpan.Zero;
+            }
+
+            return TimeSpan.FromMilliseconds(_options.RetryDelay.TotalMilliseconds * Math.Pow(2, attempt - 1));
+        }
+
+        private static async Task<string> ReadErrorMessageAsync(HttpResponseMessage response, CancellationToken cancellationToken)
+        {
+            var content = await response.Content.ReadAsStringAsync(cancellationToken);
+
+            try
+            {
+                using var document = JsonDocument.Parse(content);
+                if (document.RootElement.ValueKind == JsonValueKind.Object &&
+                    document.RootElement.TryGetProperty("message", out var message) &&
+                    message.ValueKind == JsonValueKind.String)
+                {
+                    return message.GetString();
+                }
+            }
+            catch (JsonException)
+            {
+                // Not a JSON error body
+            }
+
+            return string.IsNullOrWhiteSpace(content)
+                ? $"Request failed with status code {(int)response.StatusCode}"
+                : content;
+        }
+
+        private static bool IsIdempotent(HttpMethod method)
+        {
+            return method == HttpMethod.Get || method == HttpMethod.Put || method == HttpMethod.Delete;
+        }
+
+        private static bool IsRetryable(HttpStatusCode statusCode)
+        {
+            return statusCode == HttpStatusCode.TooManyRequests ||
+                statusCode == HttpStatusCode.BadGateway ||
+                statusCode == HttpStatusCode.ServiceUnavailable ||
+                statusCode == HttpStatusCode.GatewayTimeout;
+        }
+
+        private static bool IsTransient(Exception ex, CancellationToken cancellationToken)
+        {
+            // A TaskCanceledException without a cancelled token is an HttpClient timeout
+            return ex is HttpRequestException ||
+                (ex is TaskCanceledException && !cancellationToken.IsCancellationRequested);
+        }
+    }
+}
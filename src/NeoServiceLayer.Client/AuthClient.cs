@@ -0,0 +1,60 @@
+using System;
+using System.Net.Http;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Client
+{
+    /// <summary>
+    /// Client for the authentication API
+    /// </summary>
+    /// <remarks>
+    /// <see cref="NeoServiceLayerClient"/> logs in by itself when given a username and password. Use this client
+    /// to obtain a token that is stored and reused across processes, such as the one cached by the nsl tool.
+    /// </remarks>
+    public class AuthClient
+    {
+        private const string BasePath = "api/auth";
+
+        private readonly ApiConnection _connection;
+
+        internal AuthClient(ApiConnection connection)
+        {
+            _connection = connection;
+        }
+
+        /// <summary>
+        /// Logs in with a username and password
+        /// </summary>
+        /// <param name="username">Username</param>
+        /// <param name="password">Password</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The issued access and refresh tokens</returns>
+        public Task<TokenResponse> LoginAsync(string username, string password, CancellationToken cancellationToken = default)
+        {
+            if (string.IsNullOrEmpty(username))
+            {
+                throw new ArgumentException("Username is required", nameof(username));
+            }
+
+            return _connection.SendAnonymousAsync<TokenResponse>(HttpMethod.Post, $"{BasePath}/login", new { Username = username, Password = password }, cancellationToken);
+        }
+
+        /// <summary>
+        /// Exchanges a refresh token for a new access token
+        /// </summary>
+        /// <param name="refreshToken">Refresh token</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The issued access and refresh tokens</returns>
+        public Task<TokenResponse> RefreshAsync(string refreshToken, CancellationToken cancellationToken = default)
+        {
+            if (string.IsNullOrEmpty(refreshToken))
+            {
+                throw new ArgumentException("Refresh token is required", nameof(refreshToken));
+            }
+
+            return _connection.SendAnonymousAsync<TokenResponse>(HttpMethod.Post, $"{BasePath}/refresh", new { RefreshToken = refreshToken }, cancellationToken);
+        }
+    }
+}
@@ -0,0 +1,185 @@
+using System;
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Text.Json;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Client.Models;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Client
+{
+    /// <summary>
+    /// Client for the functions API
+    /// </summary>
+    public class FunctionsClient
+    {
+        private const string BasePath = "api/function";
+
+        private readonly ApiConnection _connection;
+
+        internal FunctionsClient(ApiConnection connection)
+        {
+            _connection = connection;
+        }
+
+        /// <summary>
+        /// Creates a function
+        /// </summary>
+        /// <param name="request">Function to create</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The created function</returns>
+        public Task<Function> CreateAsync(CreateFunctionRequest request, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<Function>(HttpMethod.Post, BasePath, request, cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets the functions of the authenticated account
+        /// </summary>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>List of functions</returns>
+        public Task<List<Function>> ListAsync(CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<List<Function>>(BasePath, cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets a function by ID
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The function</returns>
+        public Task<Function> GetAsync(Guid id, CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<Function>($"{BasePath}/{id}", cancellationToken);
+        }
+
+        /// <summary>
+        /// Updates a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="request">Updated fields</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The updated function</returns>
+        public Task<Function> UpdateAsync(Guid id, UpdateFunctionRequest request, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<Function>(HttpMethod.Put, $"{BasePath}/{id}", request, cancellationToken);
+        }
+
+        /// <summary>
+        /// Replaces the source code of a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="sourceCode">New source code</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The updated function</returns>
+        public Task<Function> UpdateSourceCodeAsync(Guid id, string sourceCode, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<Function>(HttpMethod.Put, $"{BasePath}/{id}/source", new { SourceCode = sourceCode }, cancellationToken);
+        }
+
+        /// <summary>
+        /// Replaces the environment variables of a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="environmentVariables">New environment variables</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The updated function</returns>
+        public Task<Function> UpdateEnvironmentVariablesAsync(Guid id, Dictionary<string, string> environmentVariables, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<Function>(HttpMethod.Put, $"{BasePath}/{id}/environment", new { EnvironmentVariables = environmentVariables }, cancellationToken);
+        }
+
+        /// <summary>
+        /// Replaces the secrets a function may access
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="secretIds">Secret IDs</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The updated function</returns>
+        public Task<Function> UpdateSecretAccessAsync(Guid id, IEnumerable<Guid> secretIds, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<Function>(HttpMethod.Put, $"{BasePath}/{id}/secrets", new { SecretIds = secretIds }, cancellationToken);
+        }
+
+        /// <summary>
+        /// Executes a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="parameters">Execution parameters</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The execution result</returns>
+        /// <remarks>Executions are never retried automatically because they may have side effects.</remarks>
+        public Task<ExecuteFunctionResult> ExecuteAsync(Guid id, Dictionary<string, object> parameters = null, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<ExecuteFunctionResult>(
+                HttpMethod.Post,
+                $"{BasePath}/{id}/execute",
+                new { Parameters = parameters ?? new Dictionary<string, object>() },
+                cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets the execution history of a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="startTime">Start of the period; the API defaults to seven days ago</param>
+        /// <param name="endTime">End of the period; the API defaults to now</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>List of execution records</returns>
+        public Task<List<JsonElement>> GetExecutionHistoryAsync(Guid id, DateTime? startTime = null, DateTime? endTime = null, CancellationToken cancellationToken = default)
+        {
+            var query = new List<string>();
+            if (startTime.HasValue)
+            {
+                query.Add($"startTime={Uri.EscapeDataString(startTime.Value.ToUniversalTime().ToString("o"))}");
+            }
+
+            if (endTime.HasValue)
+            {
+                query.Add($"endTime={Uri.EscapeDataString(endTime.Value.ToUniversalTime().ToString("o"))}");
+            }
+
+            var path = $"{BasePath}/{id}/history";
+            if (query.Count > 0)
+            {
+                path += "?" + string.Join("&", query);
+            }
+
+            return _connection.GetAsync<List<JsonElement>>(path, cancellationToken);
+        }
+
+        /// <summary>
+        /// Activates a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The updated function</returns>
+        public Task<Function> ActivateAsync(Guid id, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<Function>(HttpMethod.Post, $"{BasePath}/{id}/activate", null, cancellationToken);
+        }
+
+        /// <summary>
+        /// Deactivates a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The updated function</returns>
+        public Task<Function> DeactivateAsync(Guid id, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<Function>(HttpMethod.Post, $"{BasePath}/{id}/deactivate", null, cancellationToken);
+        }
+
+        /// <summary>
+        /// Deletes a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        public Task DeleteAsync(Guid id, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync(HttpMethod.Delete, $"{BasePath}/{id}", null, cancellationToken);
+        }
+    }
+}
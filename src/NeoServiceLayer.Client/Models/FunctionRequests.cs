@@ -0,0 +1,122 @@
+using System;
+using System.Collections.Generic;
+using System.Text.Json;
+using NeoServiceLayer.Core.Enums;
+
+namespace NeoServiceLayer.Client.Models
+{
+    /// <summary>
+    /// Request to create a function
+    /// </summary>
+    public class CreateFunctionRequest
+    {
+        /// <summary>
+        /// Gets or sets the name of the function
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the description of the function
+        /// </summary>
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Gets or sets the runtime of the function
+        /// </summary>
+        public FunctionRuntime Runtime { get; set; }
+
+        /// <summary>
+        /// Gets or sets the source code of the function
+        /// </summary>
+        public string SourceCode { get; set; }
+
+        /// <summary>
+        /// Gets or sets the entry point of the function
+        /// </summary>
+        public string EntryPoint { get; set; }
+
+        /// <summary>
+        /// Gets or sets the maximum execution time in milliseconds
+        /// </summary>
+        public int MaxExecutionTime { get; set; } = 30000;
+
+        /// <summary>
+        /// Gets or sets the maximum memory in megabytes
+        /// </summary>
+        public int MaxMemory { get; set; } = 128;
+
+        /// <summary>
+        /// Gets or sets the IDs of the secrets the function may access
+        /// </summary>
+        public List<Guid> SecretIds { get; set; } = new List<Guid>();
+
+        /// <summary>
+        /// Gets or sets the environment variables of the function
+        /// </summary>
+        public Dictionary<string, string> EnvironmentVariables { get; set; } = new Dictionary<string, string>();
+    }
+
+    /// <summary>
+    /// Request to update a function
+    /// </summary>
+    public class UpdateFunctionRequest
+    {
+        /// <summary>
+        /// Gets or sets the name of the function
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the description of the function
+        /// </summary>
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Gets or sets the entry point of the function
+        /// </summary>
+        public string EntryPoint { get; set; }
+
+        /// <summary>
+        /// Gets or sets the maximum execution time in milliseconds
+        /// </summary>
+        public int MaxExecutionTime { get; set; }
+
+        /// <summary>
+        /// Gets or sets the maximum memory in megabytes
+        /// </summary>
+        public int MaxMemory { get; set; }
+    }
+
+    /// <summary>
+    /// Result of a function execution
+    /// </summary>
+    public class ExecuteFunctionResult
+    {
+        /// <summary>
+        /// Gets or sets the value returned by the function
+        /// </summary>
+        public JsonElement Result { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the queued invocation when the platform was in maintenance, null otherwise
+        /// </summary>
+        public Guid? QueuedInvocationId { get; set; }
+
+        /// <summary>
+        /// Gets a value indicating whether the invocation was queued to run after maintenance instead of executed
+        /// </summary>
+        public bool IsQueued => QueuedInvocationId.HasValue;
+
+        /// <summary>
+        /// Deserializes the returned value
+        /// </summary>
+        /// <typeparam name="T">Type of the value</typeparam>
+        /// <returns>The value</returns>
+        public T GetResult<T>()
+        {
+            return Result.ValueKind == JsonValueKind.Undefined
+                ? default
+                : Result.Deserialize<T>(ApiConnection.SerializerOptions);
+        }
+    }
+}
@@ -0,0 +1,46 @@
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Client.Models
+{
+    /// <summary>
+    /// Request to publish an emergency price round
+    /// </summary>
+    public class PublishPriceRequest
+    {
+        /// <summary>
+        /// Gets or sets the base currency
+        /// </summary>
+        public string BaseCurrency { get; set; } = "USD";
+
+        /// <summary>
+        /// Gets or sets the value to publish; the latest aggregated price is published if not set
+        /// </summary>
+        public decimal? Value { get; set; }
+
+        /// <summary>
+        /// Gets or sets a value indicating whether the publish is confirmed
+        /// </summary>
+        public bool Force { get; set; }
+
+        /// <summary>
+        /// Gets or sets the reason recorded in the audit log
+        /// </summary>
+        public string Reason { get; set; }
+    }
+
+    /// <summary>
+    /// Result of publishing a price round
+    /// </summary>
+    public class PublishPriceResponse
+    {
+        /// <summary>
+        /// Gets or sets the hash of the oracle transaction
+        /// </summary>
+        public string TransactionHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the published price
+        /// </summary>
+        public Price Price { get; set; }
+    }
+}
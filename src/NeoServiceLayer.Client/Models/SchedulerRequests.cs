@@ -0,0 +1,32 @@
+using System;
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Client.Models
+{
+    /// <summary>
+    /// Request to replay the scheduler's decisions
+    /// </summary>
+    public class SchedulerReplayRequest
+    {
+        /// <summary>
+        /// Gets or sets the start of the window, inclusive
+        /// </summary>
+        public DateTime From { get; set; }
+
+        /// <summary>
+        /// Gets or sets the end of the window, exclusive; the server uses the current time if not set
+        /// </summary>
+        public DateTime? To { get; set; }
+
+        /// <summary>
+        /// Gets or sets the IDs of the stored triggers to replay; every trigger is replayed if neither this nor <see cref="Triggers"/> is set
+        /// </summary>
+        public List<Guid> TriggerIds { get; set; }
+
+        /// <summary>
+        /// Gets or sets trigger definitions to replay against instead of the stored triggers
+        /// </summary>
+        public List<ScheduleTrigger> Triggers { get; set; }
+    }
+}
@@ -0,0 +1,69 @@
+using System;
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Client.Models
+{
+    /// <summary>
+    /// Request to create a secret
+    /// </summary>
+    public class CreateSecretRequest
+    {
+        /// <summary>
+        /// Gets or sets the name of the secret
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the value of the secret
+        /// </summary>
+        public string Value { get; set; }
+
+        /// <summary>
+        /// Gets or sets the description of the secret
+        /// </summary>
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Gets or sets the IDs of the functions allowed to read the secret
+        /// </summary>
+        public List<Guid> AllowedFunctionIds { get; set; } = new List<Guid>();
+
+        /// <summary>
+        /// Gets or sets when the secret expires
+        /// </summary>
+        public DateTime? ExpiresAt { get; set; }
+    }
+
+    /// <summary>
+    /// Request to export secrets to another environment
+    /// </summary>
+    public class ExportSecretsRequest
+    {
+        /// <summary>
+        /// Gets or sets the base64 public key from the target environment's transfer key
+        /// </summary>
+        public string RecipientPublicKey { get; set; }
+
+        /// <summary>
+        /// Gets or sets the names of the secrets to export; all secrets are exported if not set
+        /// </summary>
+        public List<string> Names { get; set; }
+    }
+
+    /// <summary>
+    /// Request to import secrets exported from another environment
+    /// </summary>
+    public class ImportSecretsRequest
+    {
+        /// <summary>
+        /// Gets or sets the bundle returned by the source environment's export
+        /// </summary>
+        public SecretTransferBundle Bundle { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether secrets that already exist are updated
+        /// </summary>
+        public bool Overwrite { get; set; }
+    }
+}
@@ -0,0 +1,45 @@
+namespace NeoServiceLayer.Client.Models
+{
+    /// <summary>
+    /// Request to transfer assets from a wallet
+    /// </summary>
+    public class TransferRequest
+    {
+        /// <summary>
+        /// Gets or sets the wallet password
+        /// </summary>
+        public string Password { get; set; }
+
+        /// <summary>
+        /// Gets or sets the recipient address
+        /// </summary>
+        public string ToAddress { get; set; }
+
+        /// <summary>
+        /// Gets or sets the amount to transfer
+        /// </summary>
+        public decimal Amount { get; set; }
+    }
+
+    /// <summary>
+    /// Balance of a wallet
+    /// </summary>
+    public class BalanceResponse
+    {
+        /// <summary>
+        /// Gets or sets the balance
+        /// </summary>
+        public decimal Balance { get; set; }
+    }
+
+    /// <summary>
+    /// Result of a transfer
+    /// </summary>
+    public class TransferResponse
+    {
+        /// <summary>
+        /// Gets or sets the hash of the submitted transaction
+        /// </summary>
+        public string TransactionHash { get; set; }
+    }
+}
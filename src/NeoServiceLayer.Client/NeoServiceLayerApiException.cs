@@ -0,0 +1,35 @@
+using System;
+using System.Net;
+
+namespace NeoServiceLayer.Client
+{
+    /// <summary>
+    /// Exception thrown when the API returns an error response
+    /// </summary>
+    public class NeoServiceLayerApiException : Exception
+    {
+        /// <summary>
+        /// Gets the HTTP status code of the response, or null if no response was received
+        /// </summary>
+        public HttpStatusCode? StatusCode { get; }
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="NeoServiceLayerApiException"/> class
+        /// </summary>
+        /// <param name="message">Error message</param>
+        /// <param name="statusCode">HTTP status code</param>
+        public NeoServiceLayerApiException(string message, HttpStatusCode? statusCode = null) : base(message)
+        {
+            StatusCode = statusCode;
+        }
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="NeoServiceLayerApiException"/> class
+        /// </summary>
+        /// <param name="message">Error message</param>
+        /// <param name="innerException">Inner exception</param>
+        public NeoServiceLayerApiException(string message, Exception innerException) : base(message, innerException)
+        {
+        }
+    }
+}
@@ -0,0 +1,91 @@
+using System;
+using System.Net.Http;
+
+namespace NeoServiceLayer.Client
+{
+    /// <summary>
+    /// Typed client for the Neo Service Layer API
+    /// </summary>
+    /// <remarks>
+    /// Authenticates with a pre-issued access token, an API key, or a username and password, in which case
+    /// the client logs in on first use and refreshes the session when it expires. Idempotent requests
+    /// are retried with exponential backoff on connection failures and 429/502/503/504 responses.
+    /// </remarks>
+    public class NeoServiceLayerClient
+    {
+        /// <summary>
+        /// Initializes a new instance of the <see cref="NeoServiceLayerClient"/> class
+        /// </summary>
+        /// <param name="options">Client options</param>
+        /// <param name="httpClient">HTTP client; a new one is created if not provided</param>
+        public NeoServiceLayerClient(NeoServiceLayerClientOptions options, HttpClient httpClient = null)
+        {
+            if (options == null)
+            {
+                throw new ArgumentNullException(nameof(options));
+            }
+
+            if (string.IsNullOrEmpty(options.BaseUrl) && httpClient?.BaseAddress == null)
+            {
+                throw new ArgumentException("Base URL is required", nameof(options));
+            }
+
+            var connection = new ApiConnection(httpClient ?? new HttpClient { Timeout = options.Timeout }, options);
+
+            Auth = new AuthClient(connection);
+            Functions = new FunctionsClient(connection);
+            Triggers = new TriggersClient(connection);
+            Secrets = new SecretsClient(connection);
+            Prices = new PricesClient(connection);
+            Wallets = new WalletsClient(connection);
+            Records = new RecordsClient(connection);
+            Scheduler = new SchedulerClient(connection);
+            Ops = new OpsClient(connection);
+        }
+
+        /// <summary>
+        /// Gets the authentication client
+        /// </summary>
+        public AuthClient Auth { get; }
+
+        /// <summary>
+        /// Gets the functions client
+        /// </summary>
+        public FunctionsClient Functions { get; }
+
+        /// <summary>
+        /// Gets the triggers client
+        /// </summary>
+        public TriggersClient Triggers { get; }
+
+        /// <summary>
+        /// Gets the secrets client
+        /// </summary>
+        public SecretsClient Secrets { get; }
+
+        /// <summary>
+        /// Gets the prices client
+        /// </summary>
+        public PricesClient Prices { get; }
+
+        /// <summary>
+        /// Gets the wallets client
+        /// </summary>
+        public WalletsClient Wallets { get; }
+
+        /// <summary>
+        /// Gets the stored record administration client
+        /// </summary>
+        public RecordsClient Records { get; }
+
+        /// <summary>
+        /// Gets the scheduler replay log client
+        /// </summary>
+        public SchedulerClient Scheduler { get; }
+
+        /// <summary>
+        /// Gets the operator checks client
+        /// </summary>
+        public OpsClient Ops { get; }
+    }
+}
@@ -0,0 +1,50 @@
+using System;
+
+namespace NeoServiceLayer.Client
+{
+    /// <summary>
+    /// Options for <see cref="NeoServiceLayerClient"/>
+    /// </summary>
+    public class NeoServiceLayerClientOptions
+    {
+        /// <summary>
+        /// Gets or sets the base URL of the API, e.g. https://api.example.com
+        /// </summary>
+        public string BaseUrl { get; set; }
+
+        /// <summary>
+        /// Gets or sets a pre-issued access token; takes precedence over username and password
+        /// </summary>
+        public string AccessToken { get; set; }
+
+        /// <summary>
+        /// Gets or sets an API key sent in the X-API-Key header; used when no access token is set
+        /// </summary>
+        public string ApiKey { get; set; }
+
+        /// <summary>
+        /// Gets or sets the username used to log in
+        /// </summary>
+        public string Username { get; set; }
+
+        /// <summary>
+        /// Gets or sets the password used to log in
+        /// </summary>
+        public string Password { get; set; }
+
+        /// <summary>
+        /// Gets or sets the maximum number of retries for idempotent requests
+        /// </summary>
+        public int MaxRetries { get; set; } = 3;
+
+        /// <summary>
+        /// Gets or sets the delay before the first retry; later retries back off exponentially
+        /// </summary>
+        public TimeSpan RetryDelay { get; set; } = TimeSpan.FromMilliseconds(500);
+
+        /// <summary>
+        /// Gets or sets the request timeout
+        /// </summary>
+        public TimeSpan Timeout { get; set; } = TimeSpan.FromSeconds(30);
+    }
+}
@@ -0,0 +1,43 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Http;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Client
+{
+    /// <summary>
+    /// Client for the operator checks API; requires the Admin role
+    /// </summary>
+    public class OpsClient
+    {
+        private const string BasePath = "api/admin/ops";
+
+        private readonly ApiConnection _connection;
+
+        internal OpsClient(ApiConnection connection)
+        {
+            _connection = connection;
+        }
+
+        /// <summary>
+        /// Runs live end-to-end checks of the components the service depends on
+        /// </summary>
+        /// <param name="checks">Names of the checks to run, from <see cref="SystemVerificationChecks"/>; every check when null or empty</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The verification report</returns>
+        public Task<SystemVerificationReport> VerifyAsync(IEnumerable<string> checks = null, CancellationToken cancellationToken = default)
+        {
+            var path = $"{BasePath}/verify";
+            var names = checks?.Where(c => !string.IsNullOrWhiteSpace(c)).ToList();
+            if (names != null && names.Count > 0)
+            {
+                path += $"?checks={Uri.EscapeDataString(string.Join(",", names))}";
+            }
+
+            return _connection.SendAsync<SystemVerificationReport>(HttpMethod.Post, path, null, cancellationToken);
+        }
+    }
+}
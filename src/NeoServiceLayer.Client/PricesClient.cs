@@ -0,0 +1,128 @@
+using System;
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Client.Models;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Client
+{
+    /// <summary>
+    /// Client for the price feed API
+    /// </summary>
+    public class PricesClient
+    {
+        private const string BasePath = "api/pricefeed";
+
+        private readonly ApiConnection _connection;
+
+        internal PricesClient(ApiConnection connection)
+        {
+            _connection = connection;
+        }
+
+        /// <summary>
+        /// Gets the latest price of a symbol
+        /// </summary>
+        /// <param name="symbol">Symbol, e.g. NEO</param>
+        /// <param name="baseCurrency">Base currency</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The latest price</returns>
+        public Task<Price> GetLatestAsync(string symbol, string baseCurrency = "USD", CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<Price>(
+                $"{BasePath}/latest/{Uri.EscapeDataString(symbol)}?baseCurrency={Uri.EscapeDataString(baseCurrency)}",
+                cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets the latest price of every supported symbol
+        /// </summary>
+        /// <param name="baseCurrency">Base currency</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>Dictionary of symbol to latest price</returns>
+        public Task<Dictionary<string, Price>> GetAllLatestAsync(string baseCurrency = "USD", CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<Dictionary<string, Price>>(
+                $"{BasePath}/latest?baseCurrency={Uri.EscapeDataString(baseCurrency)}",
+                cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets the historical prices of a symbol
+        /// </summary>
+        /// <param name="symbol">Symbol</param>
+        /// <param name="startTime">Start of the period</param>
+        /// <param name="endTime">End of the period</param>
+        /// <param name="baseCurrency">Base currency</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>List of prices</returns>
+        public Task<List<Price>> GetHistoryAsync(string symbol, DateTime startTime, DateTime endTime, string baseCurrency = "USD", CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<List<Price>>(
+                $"{BasePath}/history/{Uri.EscapeDataString(symbol)}?baseCurrency={Uri.EscapeDataString(baseCurrency)}" +
+                $"&startTime={FormatTime(startTime)}&endTime={FormatTime(endTime)}",
+                cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets OHLCV candles of a symbol
+        /// </summary>
+        /// <param name="symbol">Symbol</param>
+        /// <param name="interval">Candle interval, e.g. 1h</param>
+        /// <param name="startTime">Start of the period</param>
+        /// <param name="endTime">End of the period</param>
+        /// <param name="baseCurrency">Base currency</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The price history</returns>
+        public Task<PriceHistory> GetOhlcvAsync(string symbol, string interval, DateTime startTime, DateTime endTime, string baseCurrency = "USD", CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<PriceHistory>(
+                $"{BasePath}/ohlcv/{Uri.EscapeDataString(symbol)}?interval={Uri.EscapeDataString(interval)}" +
+                $"&baseCurrency={Uri.EscapeDataString(baseCurrency)}&startTime={FormatTime(startTime)}&endTime={FormatTime(endTime)}",
+                cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets the supported symbols
+        /// </summary>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>List of symbols</returns>
+        public Task<List<string>> GetSymbolsAsync(CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<List<string>>($"{BasePath}/symbols", cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets the supported base currencies
+        /// </summary>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>List of base currencies</returns>
+        public Task<List<string>> GetBaseCurrenciesAsync(CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<List<string>>($"{BasePath}/currencies", cancellationToken);
+        }
+
+        /// <summary>
+        /// Publishes an emergency price round to the oracle contract; requires the Admin role
+        /// </summary>
+        /// <param name="symbol">Symbol</param>
+        /// <param name="request">Publish request; <see cref="PublishPriceRequest.Force"/> must be set</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The transaction hash and published price</returns>
+        public Task<PublishPriceResponse> PublishAsync(string symbol, PublishPriceRequest request, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<PublishPriceResponse>(
+                HttpMethod.Post,
+                $"{BasePath}/publish/{Uri.EscapeDataString(symbol)}",
+                request,
+                cancellationToken);
+        }
+
+        private static string FormatTime(DateTime time)
+        {
+            return Uri.EscapeDataString(time.ToUniversalTime().ToString("o"));
+        }
+    }
+}
@@ -0,0 +1,32 @@
+using System.Net.Http;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Client
+{
+    /// <summary>
+    /// Client for the stored record administration API; requires the Admin role
+    /// </summary>
+    public class RecordsClient
+    {
+        private const string BasePath = "api/admin/records";
+
+        private readonly ApiConnection _connection;
+
+        internal RecordsClient(ApiConnection connection)
+        {
+            _connection = connection;
+        }
+
+        /// <summary>
+        /// Upgrades and rewrites every stored record below its current schema version
+        /// </summary>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The migration report</returns>
+        public Task<RecordMigrationReport> MigrateAsync(CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<RecordMigrationReport>(HttpMethod.Post, $"{BasePath}/migrate", null, cancellationToken);
+        }
+    }
+}
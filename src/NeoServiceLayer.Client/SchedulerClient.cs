@@ -0,0 +1,60 @@
+using System;
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Client.Models;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Client
+{
+    /// <summary>
+    /// Client for the cron scheduler's replay log; requires the Admin role
+    /// </summary>
+    public class SchedulerClient
+    {
+        private const string BasePath = "api/schedules";
+
+        private readonly ApiConnection _connection;
+
+        internal SchedulerClient(ApiConnection connection)
+        {
+            _connection = connection;
+        }
+
+        /// <summary>
+        /// Gets the scheduler's decisions in a time window
+        /// </summary>
+        /// <param name="from">Start of the window, inclusive</param>
+        /// <param name="to">End of the window, exclusive</param>
+        /// <param name="triggerId">Trigger the decisions must be about, or null for every trigger</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The decisions, in the order they were made</returns>
+        public Task<List<SchedulerDecision>> GetDecisionsAsync(DateTime from, DateTime to, Guid? triggerId = null, CancellationToken cancellationToken = default)
+        {
+            var path = $"{BasePath}/decisions?from={FormatTime(from)}&to={FormatTime(to)}";
+            if (triggerId.HasValue)
+            {
+                path += $"&triggerId={triggerId.Value}";
+            }
+
+            return _connection.GetAsync<List<SchedulerDecision>>(path, cancellationToken);
+        }
+
+        /// <summary>
+        /// Replays the scheduler's decisions in a time window against a set of triggers
+        /// </summary>
+        /// <param name="request">Replay request</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The replay report</returns>
+        public Task<SchedulerReplayReport> ReplayAsync(SchedulerReplayRequest request, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<SchedulerReplayReport>(HttpMethod.Post, $"{BasePath}/replay", request, cancellationToken);
+        }
+
+        private static string FormatTime(DateTime time)
+        {
+            return Uri.EscapeDataString(time.ToUniversalTime().ToString("o"));
+        }
+    }
+}
@@ -0,0 +1,149 @@
+using System;
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Client.Models;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Client
+{
+    /// <summary>
+    /// Client for the secrets API
+    /// </summary>
+    /// <remarks>
+    /// Secret values are write-only; responses carry metadata but never the value itself.
+    /// </remarks>
+    public class SecretsClient
+    {
+        private const string BasePath = "api/secrets";
+
+        private readonly ApiConnection _connection;
+
+        internal SecretsClient(ApiConnection connection)
+        {
+            _connection = connection;
+        }
+
+        /// <summary>
+        /// Creates a secret
+        /// </summary>
+        /// <param name="request">Secret to create</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The created secret</returns>
+        public Task<Secret> CreateAsync(CreateSecretRequest request, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<Secret>(HttpMethod.Post, BasePath, request, cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets the secrets of the authenticated account
+        /// </summary>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>List of secrets</returns>
+        public Task<List<Secret>> ListAsync(CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<List<Secret>>(BasePath, cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets the secrets a function may access
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>List of secrets</returns>
+        public Task<List<Secret>> ListByFunctionAsync(Guid functionId, CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<List<Secret>>($"{BasePath}/function/{functionId}", cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets a secret by ID
+        /// </summary>
+        /// <param name="id">Secret ID</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The secret</returns>
+        public Task<Secret> GetAsync(Guid id, CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<Secret>($"{BasePath}/{id}", cancellationToken);
+        }
+
+        /// <summary>
+        /// Updates the value of a secret
+        /// </summary>
+        /// <param name="id">Secret ID</param>
+        /// <param name="value">New value</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The updated secret</returns>
+        public Task<Secret> UpdateValueAsync(Guid id, string value, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<Secret>(HttpMethod.Put, $"{BasePath}/{id}/value", new { Value = value }, cancellationToken);
+        }
+
+        /// <summary>
+        /// Replaces the functions allowed to read a secret
+        /// </summary>
+        /// <param name="id">Secret ID</param>
+        /// <param name="functionIds">Function IDs</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The updated secret</returns>
+        public Task<Secret> UpdateAllowedFunctionsAsync(Guid id, IEnumerable<Guid> functionIds, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<Secret>(HttpMethod.Put, $"{BasePath}/{id}/functions", new { AllowedFunctionIds = functionIds }, cancellationToken);
+        }
+
+        /// <summary>
+        /// Rotates a secret to a new value
+        /// </summary>
+        /// <param name="id">Secret ID</param>
+        /// <param name="newValue">New value</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The rotated secret</returns>
+        public Task<Secret> RotateAsync(Guid id, string newValue, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<Secret>(HttpMethod.Post, $"{BasePath}/{id}/rotate", new { NewValue = newValue }, cancellationToken);
+        }
+
+        /// <summary>
+        /// Deletes a secret
+        /// </summary>
+        /// <param name="id">Secret ID</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        public Task DeleteAsync(Guid id, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync(HttpMethod.Delete, $"{BasePath}/{id}", null, cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets the public key other environments export secrets to this environment under
+        /// </summary>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The transfer key</returns>
+        public Task<SecretTransferKey> GetTransferKeyAsync(CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<SecretTransferKey>($"{BasePath}/transfer-key", cancellationToken);
+        }
+
+        /// <summary>
+        /// Exports secrets encrypted to another environment's transfer key
+        /// </summary>
+        /// <param name="request">Export request</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The bundle, which only the target environment can decrypt</returns>
+        public Task<SecretTransferBundle> ExportAsync(ExportSecretsRequest request, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<SecretTransferBundle>(HttpMethod.Post, $"{BasePath}/export", request, cancellationToken);
+        }
+
+        /// <summary>
+        /// Imports secrets exported to this environment
+        /// </summary>
+        /// <param name="request">Import request</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>What was done to each secret</returns>
+        public Task<SecretImportResult> ImportAsync(ImportSecretsRequest request, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<SecretImportResult>(HttpMethod.Post, $"{BasePath}/import", request, cancellationToken);
+        }
+    }
+}
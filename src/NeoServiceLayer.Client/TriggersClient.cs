@@ -0,0 +1,129 @@
+using System;
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Client
+{
+    /// <summary>
+    /// Client for blockchain event triggers (event subscriptions)
+    /// </summary>
+    public class TriggersClient
+    {
+        private const string BasePath = "api/eventmonitoring";
+
+        private readonly ApiConnection _connection;
+
+        internal TriggersClient(ApiConnection connection)
+        {
+            _connection = connection;
+        }
+
+        /// <summary>
+        /// Creates a trigger
+        /// </summary>
+        /// <param name="subscription">Trigger to create</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The created trigger</returns>
+        public Task<EventSubscription> CreateAsync(EventSubscription subscription, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<EventSubscription>(HttpMethod.Post, $"{BasePath}/subscriptions", subscription, cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets the triggers of the authenticated account
+        /// </summary>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>List of triggers</returns>
+        public Task<List<EventSubscription>> ListAsync(CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<List<EventSubscription>>($"{BasePath}/subscriptions", cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets a trigger by ID
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The trigger</returns>
+        public Task<EventSubscription> GetAsync(Guid id, CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<EventSubscription>($"{BasePath}/subscriptions/{id}", cancellationToken);
+        }
+
+        /// <summary>
+        /// Updates a trigger
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <param name="subscription">Updated trigger</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The updated trigger</returns>
+        public Task<EventSubscription> UpdateAsync(Guid id, EventSubscription subscription, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<EventSubscription>(HttpMethod.Put, $"{BasePath}/subscriptions/{id}", subscription, cancellationToken);
+        }
+
+        /// <summary>
+        /// Deletes a trigger
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        public Task DeleteAsync(Guid id, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync(HttpMethod.Delete, $"{BasePath}/subscriptions/{id}", null, cancellationToken);
+        }
+
+        /// <summary>
+        /// Activates a trigger
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The activated trigger</returns>
+        public Task<EventSubscription> ActivateAsync(Guid id, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<EventSubscription>(HttpMethod.Post, $"{BasePath}/subscriptions/{id}/activate", null, cancellationToken);
+        }
+
+        /// <summary>
+        /// Pauses a trigger
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The paused trigger</returns>
+        public Task<EventSubscription> PauseAsync(Guid id, CancellationToken cancellationToken = default)
+        {
+            return _connection.SendAsync<EventSubscription>(HttpMethod.Post, $"{BasePath}/subscriptions/{id}/pause", null, cancellationToken);
+        }
+
+        /// <summary>
+        /// Iterates over the firing log of a trigger, fetching pages as needed
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <param name="pageSize">Number of entries fetched per request</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>Log entries</returns>
+        public IAsyncEnumerable<EventLog> GetLogsAsync(Guid id, int pageSize = 100, CancellationToken cancellationToken = default)
+        {
+            return _connection.PaginateAsync<EventLog>(
+                (offset, limit) => $"{BasePath}/subscriptions/{id}/logs?limit={limit}&offset={offset}",
+                pageSize,
+                cancellationToken);
+        }
+
+        /// <summary>
+        /// Iterates over the firing log of every trigger of the authenticated account
+        /// </summary>
+        /// <param name="pageSize">Number of entries fetched per request</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>Log entries</returns>
+        public IAsyncEnumerable<EventLog> GetAllLogsAsync(int pageSize = 100, CancellationToken cancellationToken = default)
+        {
+            return _connection.PaginateAsync<EventLog>(
+                (offset, limit) => $"{BasePath}/logs?limit={limit}&offset={offset}",
+                pageSize,
+                cancellationToken);
+        }
+    }
+}
@@ -0,0 +1,97 @@
+using System;
+using System.Collections.Generic;
+using System.Net.Http;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Client.Models;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Client
+{
+    /// <summary>
+    /// Client for the wallet API, including GAS balances and transfers
+    /// </summary>
+    public class WalletsClient
+    {
+        private const string BasePath = "api/wallet";
+
+        private readonly ApiConnection _connection;
+
+        internal WalletsClient(ApiConnection connection)
+        {
+            _connection = connection;
+        }
+
+        /// <summary>
+        /// Gets the wallets of the authenticated account
+        /// </summary>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>List of wallets</returns>
+        public Task<List<Wallet>> ListAsync(CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<List<Wallet>>(BasePath, cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets a wallet by ID
+        /// </summary>
+        /// <param name="id">Wallet ID</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The wallet</returns>
+        public Task<Wallet> GetAsync(Guid id, CancellationToken cancellationToken = default)
+        {
+            return _connection.GetAsync<Wallet>($"{BasePath}/{id}", cancellationToken);
+        }
+
+        /// <summary>
+        /// Gets the GAS balance of a wallet
+        /// </summary>
+        /// <param name="id">Wallet ID</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>GAS balance</returns>
+        public async Task<decimal> GetGasBalanceAsync(Guid id, CancellationToken cancellationToken = default)
+        {
+            var response = await _connection.GetAsync<BalanceResponse>($"{BasePath}/{id}/balance/gas", cancellationToken);
+            return response.Balance;
+        }
+
+        /// <summary>
+        /// Gets the NEO balance of a wallet
+        /// </summary>
+        /// <param name="id">Wallet ID</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>NEO balance</returns>
+        public async Task<decimal> GetNeoBalanceAsync(Guid id, CancellationToken cancellationToken = default)
+        {
+            var response = await _connection.GetAsync<BalanceResponse>($"{BasePath}/{id}/balance/neo", cancellationToken);
+            return response.Balance;
+        }
+
+        /// <summary>
+        /// Transfers GAS from a wallet
+        /// </summary>
+        /// <param name="id">Wallet ID</param>
+        /// <param name="request">Transfer details</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>Hash of the submitted transaction</returns>
+        /// <remarks>Transfers are never retried automatically to avoid sending twice.</remarks>
+        public async Task<string> TransferGasAsync(Guid id, TransferRequest request, CancellationToken cancellationToken = default)
+        {
+            var response = await _connection.SendAsync<TransferResponse>(HttpMethod.Post, $"{BasePath}/{id}/transfer/gas", request, cancellationToken);
+            return response.TransactionHash;
+        }
+
+        /// <summary>
+        /// Transfers NEO from a wallet
+        /// </summary>
+        /// <param name="id">Wallet ID</param>
+        /// <param name="request">Transfer details</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>Hash of the submitted transaction</returns>
+        public async Task<string> TransferNeoAsync(Guid id, TransferRequest request, CancellationToken cancellationToken = default)
+        {
+            var response = await _connection.SendAsync<TransferResponse>(HttpMethod.Post, $"{BasePath}/{id}/transfer/neo", request, cancellationToken);
+            return response.TransactionHash;
+        }
+    }
+}
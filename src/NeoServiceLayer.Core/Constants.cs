@@ -34,6 +34,43 @@ namespace NeoServiceLayer.Core
             /// Price feed service
             /// </summary>
             public const string PriceFeed = "pricefeed";
+
+            /// <summary>
+            /// Authorization service issuing capability tokens
+            /// </summary>
+            public const string Auth = "auth";
+
+            /// <summary>
+            /// Attestation service
+            /// </summary>
+            public const string Attestation = "attestation";
+        }
+
+        /// <summary>
+        /// Authorization service operations
+        /// </summary>
+        public static class AuthOperations
+        {
+            /// <summary>
+            /// Issue a capability token for TEE operations
+            /// </summary>
+            public const string IssueToken = "issueToken";
+        }
+
+        /// <summary>
+        /// Attestation service operations
+        /// </summary>
+        public static class AttestationOperations
+        {
+            /// <summary>
+            /// Sign the digest of a build provenance statement with the attested enclave key
+            /// </summary>
+            public const string SignProvenance = "signProvenance";
+
+            /// <summary>
+            /// Prefix of the signed provenance content, separating it from signed execution results
+            /// </summary>
+            public const string ProvenanceSignaturePrefix = "neo-service-layer-provenance:";
         }
 
         /// <summary>
@@ -92,11 +129,36 @@ namespace NeoServiceLayer.Core
             /// </summary>
             public const string TransferGas = "transferGas";
 
+            /// <summary>
+            /// Transfer GAS tokens to multiple recipients in one transaction
+            /// </summary>
+            public const string TransferGasMulti = "transferGasMulti";
+
             /// <summary>
             /// Transfer NEP-17 tokens
             /// </summary>
             public const string TransferToken = "transferToken";
 
+            /// <summary>
+            /// Transfer a NEP-11 token
+            /// </summary>
+            public const string TransferNft = "transferNft";
+
+            /// <summary>
+            /// Vote for a consensus candidate with the wallet's NEO
+            /// </summary>
+            public const string Vote = "vote";
+
+            /// <summary>
+            /// Wrap NEO as bNEO
+            /// </summary>
+            public const string WrapBneo = "wrapBneo";
+
+            /// <summary>
+            /// Unwrap bNEO back to NEO
+            /// </summary>
+            public const string UnwrapBneo = "unwrapBneo";
+
             /// <summary>
             /// Get the current block height
             /// </summary>
@@ -122,10 +184,25 @@ namespace NeoServiceLayer.Core
             /// </summary>
             public const string InvokeRead = "invokeRead";
 
+            /// <summary>
+            /// Invoke a read-only contract method against a historical block
+            /// </summary>
+            public const string InvokeReadAt = "invokeReadAt";
+
+            /// <summary>
+            /// Get the balance of an address at a historical block
+            /// </summary>
+            public const string GetBalanceAt = "getBalanceAt";
+
             /// <summary>
             /// Invoke a contract method that modifies state
             /// </summary>
             public const string InvokeWrite = "invokeWrite";
+
+            /// <summary>
+            /// Send a transaction from a multi-signature account once enough signers have signed it
+            /// </summary>
+            public const string SendMultiSig = "sendMultiSig";
         }
 
         /// <summary>
@@ -182,6 +259,31 @@ namespace NeoServiceLayer.Core
             /// Check if a function has access to a secret
             /// </summary>
             public const string HasAccess = "hasAccess";
+
+            /// <summary>
+            /// Generate a per-account data key wrapped by the enclave
+            /// </summary>
+            public const string GenerateDataKey = "generateDataKey";
+
+            /// <summary>
+            /// Unwrap a per-account data key
+            /// </summary>
+            public const string DecryptDataKey = "decryptDataKey";
+
+            /// <summary>
+            /// Get the public key secret transfer bundles are encrypted to
+            /// </summary>
+            public const string GetTransferKey = "getTransferKey";
+
+            /// <summary>
+            /// Encrypt secrets to another environment's transfer key
+            /// </summary>
+            public const string ExportSecrets = "exportSecrets";
+
+            /// <summary>
+            /// Decrypt a secret transfer bundle and seal its secrets
+            /// </summary>
+            public const string ImportSecrets = "importSecrets";
         }
 
         /// <summary>
@@ -278,6 +380,26 @@ namespace NeoServiceLayer.Core
             /// Trigger a custom event
             /// </summary>
             public const string TriggerCustomEvent = "triggerCustomEvent";
+
+            /// <summary>
+            /// Kill a running function execution
+            /// </summary>
+            public const string KillExecution = "killExecution";
+
+            /// <summary>
+            /// Get the memory running function executions have allocated
+            /// </summary>
+            public const string GetExecutionMemory = "getExecutionMemory";
+
+            /// <summary>
+            /// Execute a function over a stream of input chunks and log frames
+            /// </summary>
+            public const string ExecuteFunctionStream = "executeFunctionStream";
+
+            /// <summary>
+            /// Run a built-in function to check that the sandbox works
+            /// </summary>
+            public const string SmokeTest = "smokeTest";
         }
 
         /// <summary>
@@ -319,6 +441,32 @@ namespace NeoServiceLayer.Core
             /// Submit multiple price data to the Neo N3 oracle contract
             /// </summary>
             public const string SubmitBatchToOracle = "submitBatchToOracle";
+
+            /// <summary>
+            /// Deploy or update the Neo N3 PriceFeed contract
+            /// </summary>
+            public const string DeployContract = "deployContract";
+        }
+
+        /// <summary>
+        /// Methods of the Neo N3 PriceFeed contract
+        /// </summary>
+        public static class PriceFeedContractMethods
+        {
+            /// <summary>
+            /// Get the ID of the latest round of a pair
+            /// </summary>
+            public const string GetLatestRoundId = "getLatestRoundId";
+
+            /// <summary>
+            /// Get the price and timestamp of a round of a pair
+            /// </summary>
+            public const string GetRound = "getRound";
+
+            /// <summary>
+            /// Replace the contract's NEF and manifest
+            /// </summary>
+            public const string Update = "update";
         }
 
         /// <summary>
@@ -342,6 +490,27 @@ namespace NeoServiceLayer.Core
             public const int EnclavePort = 5000;
         }
 
+        /// <summary>
+        /// Secrets configuration
+        /// </summary>
+        public static class SecretsConfig
+        {
+            /// <summary>
+            /// Maximum number of secrets in a single bulk request
+            /// </summary>
+            public const int MaxBulkItems = 100;
+
+            /// <summary>
+            /// Maximum size of a secret value in bytes
+            /// </summary>
+            public const int MaxValueSizeBytes = 64 * 1024;
+
+            /// <summary>
+            /// Maximum number of secrets per account
+            /// </summary>
+            public const int MaxSecretsPerAccount = 1000;
+        }
+
         /// <summary>
         /// JWT configuration
         /// </summary>
@@ -388,10 +557,91 @@ namespace NeoServiceLayer.Core
             /// </summary>
             public const string NeoTokenHash = "0xef4073a0f2b305a38ec4050e4d3d28bc40ea63f5";
 
+            /// <summary>
+            /// bNEO token hash; NEO sent to it is wrapped, and bNEO sent to it is unwrapped
+            /// </summary>
+            public const string BneoTokenHash = "0x48c40d4666f93408be1bef038b6722404d9a4c2a";
+
             /// <summary>
             /// Neo N3 oracle contract hash
             /// </summary>
             public const string OracleContractHash = "0xfe924b7cfe89ddd271abaf7210a80a7e11178758";
+
+            /// <summary>
+            /// Neo N3 policy contract hash
+            /// </summary>
+            public const string PolicyContractHash = "0xcc5e4edd9f5f8dba8bb65734541df7a1c081c67b";
+
+            /// <summary>
+            /// Neo N3 contract management contract hash; it raises Deploy, Update and Destroy notifications
+            /// </summary>
+            public const string ContractManagementHash = "0xfffdc93764dbaddd97c48f252a53ea4643faa3fd";
+        }
+
+        /// <summary>
+        /// Names of the managed background services, used to declare what each depends on
+        /// </summary>
+        public static class ManagedServices
+        {
+            /// <summary>
+            /// Functions/Trigger leadership lease
+            /// </summary>
+            public const string Leadership = "Leadership";
+
+            /// <summary>
+            /// Cron schedule trigger firing
+            /// </summary>
+            public const string Scheduler = "Scheduler";
+
+            /// <summary>
+            /// Trigger firing and replay log pruning
+            /// </summary>
+            public const string TriggerFiringRetention = "TriggerFiringRetention";
+
+            /// <summary>
+            /// Contract upkeep checks
+            /// </summary>
+            public const string Automation = "Automation";
+
+            /// <summary>
+            /// GasBank cold storage sweeps and withdrawal confirmations
+            /// </summary>
+            public const string GasBank = "GasBank";
+
+            /// <summary>
+            /// GasBank payment stream settlement
+            /// </summary>
+            public const string GasPaymentStreams = "GasPaymentStreams";
+
+            /// <summary>
+            /// Recurring GasBank withdrawals
+            /// </summary>
+            public const string GasRecurringWithdrawals = "GasRecurringWithdrawals";
+
+            /// <summary>
+            /// Aggregated price publication
+            /// </summary>
+            public const string PricePublication = "PricePublication";
+
+            /// <summary>
+            /// Gas price refreshes
+            /// </summary>
+            public const string GasPriceOracle = "GasPriceOracle";
+
+            /// <summary>
+            /// Function executions, drained on shutdown
+            /// </summary>
+            public const string Functions = "Functions";
+
+            /// <summary>
+            /// Asynchronous function invocations and their callbacks
+            /// </summary>
+            public const string AsyncInvocations = "AsyncInvocations";
+
+            /// <summary>
+            /// Log alert rule evaluation
+            /// </summary>
+            public const string LogAlerts = "LogAlerts";
         }
     }
 }
@@ -18,6 +18,11 @@ namespace NeoServiceLayer.Core.Enums
         /// <summary>
         /// C# runtime
         /// </summary>
-        CSharp
+        CSharp,
+
+        /// <summary>
+        /// TypeScript, transpiled to JavaScript at deploy time
+        /// </summary>
+        TypeScript
     }
 }
@@ -0,0 +1,23 @@
+namespace NeoServiceLayer.Core.Enums
+{
+    /// <summary>
+    /// Represents the outcome of checking whether a principal may invoke a function
+    /// </summary>
+    public enum InvokeAuthorizationResult
+    {
+        /// <summary>
+        /// The principal owns the function or holds an invoke grant with capacity left
+        /// </summary>
+        Allowed,
+
+        /// <summary>
+        /// The principal has no active invoke grant
+        /// </summary>
+        Denied,
+
+        /// <summary>
+        /// The principal's grant allows invocation but its rate limit is used up
+        /// </summary>
+        RateLimited
+    }
+}
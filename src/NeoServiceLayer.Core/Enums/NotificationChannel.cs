@@ -28,6 +28,11 @@ namespace NeoServiceLayer.Core.Enums
         /// <summary>
         /// In-app notification
         /// </summary>
-        InApp
+        InApp,
+
+        /// <summary>
+        /// Telegram message
+        /// </summary>
+        Telegram
     }
 }
@@ -0,0 +1,39 @@
+using System;
+
+namespace NeoServiceLayer.Core.Exceptions
+{
+    /// <summary>
+    /// Exception thrown when an update was based on a revision of a resource that has since changed
+    /// </summary>
+    public class ConcurrencyConflictException : Exception
+    {
+        /// <summary>
+        /// Gets the resource type
+        /// </summary>
+        public string ResourceType { get; }
+
+        /// <summary>
+        /// Gets the resource identifier
+        /// </summary>
+        public string ResourceId { get; }
+
+        /// <summary>
+        /// Gets the revision the resource is currently at
+        /// </summary>
+        public long CurrentRevision { get; }
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ConcurrencyConflictException"/> class
+        /// </summary>
+        /// <param name="resourceType">Resource type</param>
+        /// <param name="resourceId">Resource identifier</param>
+        /// <param name="currentRevision">Revision the resource is currently at</param>
+        public ConcurrencyConflictException(string resourceType, string resourceId, long currentRevision)
+            : base($"{resourceType} with ID {resourceId} was modified concurrently and is now at revision {currentRevision}")
+        {
+            ResourceType = resourceType;
+            ResourceId = resourceId;
+            CurrentRevision = currentRevision;
+        }
+    }
+}
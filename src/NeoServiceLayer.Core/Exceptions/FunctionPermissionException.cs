@@ -0,0 +1,25 @@
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Exceptions
+{
+    /// <summary>
+    /// Exception thrown when function code uses capabilities it has not declared as permissions
+    /// </summary>
+    public class FunctionPermissionException : FunctionException
+    {
+        /// <summary>
+        /// Initializes a new instance of the <see cref="FunctionPermissionException"/> class
+        /// </summary>
+        /// <param name="report">Capability report of the rejected code</param>
+        public FunctionPermissionException(FunctionCapabilityReport report)
+            : base($"Function code uses undeclared permissions: {string.Join(", ", report.UndeclaredCapabilities)}")
+        {
+            Report = report;
+        }
+
+        /// <summary>
+        /// Gets the capability report of the rejected code
+        /// </summary>
+        public FunctionCapabilityReport Report { get; }
+    }
+}
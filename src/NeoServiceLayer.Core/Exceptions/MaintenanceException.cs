@@ -0,0 +1,32 @@
+using System;
+
+namespace NeoServiceLayer.Core.Exceptions
+{
+    /// <summary>
+    /// Exception thrown when an operation is unavailable because the platform is in maintenance
+    /// </summary>
+    public class MaintenanceException : Exception
+    {
+        /// <summary>
+        /// Initializes a new instance of the <see cref="MaintenanceException"/> class
+        /// </summary>
+        public MaintenanceException()
+        {
+        }
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="MaintenanceException"/> class with a specified error message
+        /// </summary>
+        /// <param name="message">The message that describes the error</param>
+        /// <param name="expectedEndTime">When maintenance is expected to end, null if unknown</param>
+        public MaintenanceException(string message, DateTime? expectedEndTime = null) : base(message)
+        {
+            ExpectedEndTime = expectedEndTime;
+        }
+
+        /// <summary>
+        /// Gets when maintenance is expected to end, null if unknown
+        /// </summary>
+        public DateTime? ExpectedEndTime { get; }
+    }
+}
@@ -0,0 +1,35 @@
+using System;
+
+namespace NeoServiceLayer.Core.Exceptions
+{
+    /// <summary>
+    /// Exception thrown by the Neo RPC client
+    /// </summary>
+    public class NeoRpcException : Exception
+    {
+        /// <summary>
+        /// Initializes a new instance of the <see cref="NeoRpcException"/> class
+        /// </summary>
+        public NeoRpcException()
+        {
+        }
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="NeoRpcException"/> class with a specified error message
+        /// </summary>
+        /// <param name="message">The message that describes the error</param>
+        public NeoRpcException(string message) : base(message)
+        {
+        }
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="NeoRpcException"/> class with a specified error message
+        /// and a reference to the inner exception that is the cause of this exception
+        /// </summary>
+        /// <param name="message">The message that describes the error</param>
+        /// <param name="innerException">The exception that is the cause of the current exception</param>
+        public NeoRpcException(string message, Exception innerException) : base(message, innerException)
+        {
+        }
+    }
+}
@@ -0,0 +1,43 @@
+using System;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Exceptions
+{
+    /// <summary>
+    /// Exception thrown when a function fails in the sandbox, carrying one of the <see cref="FunctionErrorCodes"/>
+    /// </summary>
+    public class SandboxException : EnclaveException
+    {
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SandboxException"/> class with an error code and message
+        /// </summary>
+        /// <param name="errorCode">One of the <see cref="FunctionErrorCodes"/></param>
+        /// <param name="message">The message that describes the error</param>
+        public SandboxException(string errorCode, string message) : base(message)
+        {
+            ErrorCode = errorCode;
+        }
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SandboxException"/> class with an error code and message
+        /// and a reference to the inner exception that is the cause of this exception
+        /// </summary>
+        /// <param name="errorCode">One of the <see cref="FunctionErrorCodes"/></param>
+        /// <param name="message">The message that describes the error</param>
+        /// <param name="innerException">The exception that is the cause of the current exception</param>
+        public SandboxException(string errorCode, string message, Exception innerException) : base(message, innerException)
+        {
+            ErrorCode = errorCode;
+        }
+
+        /// <summary>
+        /// Gets the machine-readable error code
+        /// </summary>
+        public string ErrorCode { get; }
+
+        /// <summary>
+        /// Gets or sets the gas units the execution used before it failed
+        /// </summary>
+        public long GasUsed { get; set; }
+    }
+}
@@ -217,6 +217,53 @@ namespace NeoServiceLayer.Core.Extensions
             }
         }
 
+        /// <summary>
+        /// Convert a Neo N3 address to its script hash
+        /// </summary>
+        /// <param name="address">The Neo address</param>
+        /// <returns>The script hash as a 0x-prefixed big-endian hex string</returns>
+        public static string ToScriptHash(this string address)
+        {
+            if (!address.IsValidNeoAddress())
+                throw new FormatException("Invalid Neo address format");
+
+            const string alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz";
+
+            var value = System.Numerics.BigInteger.Zero;
+            foreach (var c in address)
+            {
+                var digit = alphabet.IndexOf(c);
+                if (digit < 0)
+                    throw new FormatException("Invalid Base58 character in Neo address");
+
+                value = value * 58 + digit;
+            }
+
+            // Version byte (0x35) + 20-byte script hash + 4-byte checksum
+            var bytes = value.ToByteArray(isUnsigned: true, isBigEndian: true);
+            if (bytes.Length != 25 || bytes[0] != 0x35)
+                throw new FormatException("Invalid Neo address payload");
+
+            using (var sha256 = SHA256.Create())
+            {
+                var checksum = sha256.ComputeHash(sha256.ComputeHash(bytes, 0, 21));
+                for (var i = 0; i < 4; i++)
+                {
+                    if (checksum[i] != bytes[21 + i])
+                        throw new FormatException("Invalid Neo address checksum");
+                }
+            }
+
+            // The address encodes the script hash in little-endian order
+            var builder = new StringBuilder("0x", 42);
+            for (var i = 20; i >= 1; i--)
+            {
+                builder.Append(bytes[i].ToString("x2"));
+            }
+
+            return builder.ToString();
+        }
+
         /// <summary>
         /// Check if a string is a valid WIF
         /// </summary>
@@ -0,0 +1,75 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Text.RegularExpressions;
+using NeoServiceLayer.Core.Exceptions;
+
+namespace NeoServiceLayer.Core.Extensions
+{
+    /// <summary>
+    /// Extension methods for the free-form tags on functions and triggers
+    /// </summary>
+    public static class TagExtensions
+    {
+        /// <summary>
+        /// Maximum number of tags on a resource
+        /// </summary>
+        public const int MaxTags = 20;
+
+        /// <summary>
+        /// Maximum length of a tag
+        /// </summary>
+        public const int MaxTagLength = 64;
+
+        // Letters, digits and the separators used by "key:value" and "team/project" style tags
+        private static readonly Regex TagPattern = new Regex(@"^[a-z0-9][a-z0-9_.:/=-]*$", RegexOptions.Compiled);
+
+        /// <summary>
+        /// Trims and lower-cases tags and removes empty entries and duplicates
+        /// </summary>
+        /// <param name="tags">The tags to normalize</param>
+        /// <returns>The normalized tags</returns>
+        /// <exception cref="ValidationException">A tag is too long or has invalid characters, or there are too many tags</exception>
+        public static List<string> NormalizeTags(this IEnumerable<string> tags)
+        {
+            var normalized = (tags ?? Enumerable.Empty<string>())
+                .Where(t => !string.IsNullOrWhiteSpace(t))
+                .Select(t => t.Trim().ToLowerInvariant())
+                .Distinct()
+                .ToList();
+
+            if (normalized.Count > MaxTags)
+            {
+                throw new ValidationException($"At most {MaxTags} tags are allowed");
+            }
+
+            foreach (var tag in normalized)
+            {
+                if (tag.Length > MaxTagLength || !TagPattern.IsMatch(tag))
+                {
+                    throw new ValidationException(
+                        $"Invalid tag '{tag}': tags are up to {MaxTagLength} letters, digits or _ . : / = - and start with a letter or digit");
+                }
+            }
+
+            return normalized;
+        }
+
+        /// <summary>
+        /// Checks whether a resource carries every one of the requested tags
+        /// </summary>
+        /// <param name="tags">The resource's tags</param>
+        /// <param name="required">The requested tags; no tags matches every resource</param>
+        /// <returns>True if every requested tag is present, ignoring case</returns>
+        public static bool HasAllTags(this IEnumerable<string> tags, IEnumerable<string> required)
+        {
+            if (required == null)
+            {
+                return true;
+            }
+
+            var present = new HashSet<string>(tags ?? Enumerable.Empty<string>(), StringComparer.OrdinalIgnoreCase);
+            return required.Where(t => !string.IsNullOrWhiteSpace(t)).All(t => present.Contains(t.Trim()));
+        }
+    }
+}
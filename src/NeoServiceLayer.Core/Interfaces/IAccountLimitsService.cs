@@ -0,0 +1,19 @@
+using System;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for reporting the limits that apply to an account
+    /// </summary>
+    public interface IAccountLimitsService
+    {
+        /// <summary>
+        /// Gets the effective limits of an account and their current usage
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>The account limits; <see cref="AccountLimits.RateLimit"/> is left for the API layer to fill in</returns>
+        Task<AccountLimits> GetLimitsAsync(Guid accountId);
+    }
+}
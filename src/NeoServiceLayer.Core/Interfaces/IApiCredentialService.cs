@@ -0,0 +1,56 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the service managing accounts' own API keys
+    /// </summary>
+    public interface IApiCredentialService
+    {
+        /// <summary>
+        /// Creates an API key for an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <param name="name">Key name</param>
+        /// <param name="scopes">Scopes the key may use; see <see cref="ApiScopes"/></param>
+        /// <param name="rateLimit">Maximum requests per period, or null for no key-specific limit</param>
+        /// <param name="rateLimitPeriodSeconds">Rate limit period in seconds</param>
+        /// <param name="expiresAt">When the key expires, or null if it does not</param>
+        /// <returns>The credential and the key, which is only returned here</returns>
+        Task<CreatedApiCredential> CreateAsync(Guid accountId, string name, IEnumerable<string> scopes, int? rateLimit = null, int rateLimitPeriodSeconds = 60, DateTime? expiresAt = null);
+
+        /// <summary>
+        /// Finds the active credential for a key
+        /// </summary>
+        /// <param name="key">API key presented by a caller</param>
+        /// <returns>The credential, or null if the key is unknown, revoked or expired</returns>
+        Task<ApiCredential> AuthenticateAsync(string key);
+
+        /// <summary>
+        /// Counts a request against a credential's rate limit
+        /// </summary>
+        /// <param name="credentialId">Credential ID</param>
+        /// <param name="rateLimit">Maximum requests per period</param>
+        /// <param name="rateLimitPeriodSeconds">Rate limit period in seconds</param>
+        /// <returns>True if the request fits in the current window, false otherwise</returns>
+        bool TryCountRequest(Guid credentialId, int rateLimit, int rateLimitPeriodSeconds);
+
+        /// <summary>
+        /// Gets an account's API keys, newest first
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>The credentials</returns>
+        Task<IEnumerable<ApiCredential>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Revokes one of an account's API keys
+        /// </summary>
+        /// <param name="id">Credential ID</param>
+        /// <param name="accountId">ID of the account owning the key</param>
+        /// <returns>The revoked credential</returns>
+        Task<ApiCredential> RevokeAsync(Guid id, Guid accountId);
+    }
+}
@@ -0,0 +1,62 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the store of asynchronous invocations
+    /// </summary>
+    public interface IAsyncInvocationRepository
+    {
+        /// <summary>
+        /// Stores a new invocation
+        /// </summary>
+        /// <param name="invocation">Invocation</param>
+        /// <returns>The stored invocation</returns>
+        Task<AsyncInvocation> CreateAsync(AsyncInvocation invocation);
+
+        /// <summary>
+        /// Gets an invocation by execution ID
+        /// </summary>
+        /// <param name="id">Execution ID</param>
+        /// <returns>The invocation if found, null otherwise</returns>
+        Task<AsyncInvocation> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets invocations with a status, oldest first
+        /// </summary>
+        /// <param name="status">Status</param>
+        /// <returns>The invocations</returns>
+        Task<IEnumerable<AsyncInvocation>> GetByStatusAsync(AsyncInvocationStatus status);
+
+        /// <summary>
+        /// Gets finished invocations whose callback is due
+        /// </summary>
+        /// <param name="now">Current time</param>
+        /// <returns>The invocations</returns>
+        Task<IEnumerable<AsyncInvocation>> GetDueCallbacksAsync(DateTime now);
+
+        /// <summary>
+        /// Counts the queued invocations of an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>The number of queued invocations</returns>
+        Task<int> CountQueuedAsync(Guid accountId);
+
+        /// <summary>
+        /// Updates an invocation
+        /// </summary>
+        /// <param name="invocation">Invocation</param>
+        /// <returns>The updated invocation</returns>
+        Task<AsyncInvocation> UpdateAsync(AsyncInvocation invocation);
+
+        /// <summary>
+        /// Deletes finished invocations completed before a time whose callback is no longer pending
+        /// </summary>
+        /// <param name="before">Cutoff time</param>
+        /// <returns>The number of invocations deleted</returns>
+        Task<int> DeleteFinishedBeforeAsync(DateTime before);
+    }
+}
@@ -0,0 +1,31 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for running function invocations in the background
+    /// </summary>
+    public interface IAsyncInvocationService
+    {
+        /// <summary>
+        /// Accepts an invocation to run in the background
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="accountId">ID of the invoking account</param>
+        /// <param name="parameters">Parameters</param>
+        /// <param name="sessionKey">Session key, already scoped to the caller</param>
+        /// <param name="callbackUrl">URL the outcome is posted to, if any</param>
+        /// <returns>The queued invocation, whose ID is the execution ID</returns>
+        Task<AsyncInvocation> EnqueueAsync(Guid functionId, Guid accountId, Dictionary<string, object> parameters, string sessionKey = null, string callbackUrl = null);
+
+        /// <summary>
+        /// Gets an invocation by execution ID
+        /// </summary>
+        /// <param name="executionId">Execution ID</param>
+        /// <returns>The invocation if found, null otherwise</returns>
+        Task<AsyncInvocation> GetAsync(Guid executionId);
+    }
+}
@@ -0,0 +1,74 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the automation service, which keeps registered contracts' upkeeps
+    /// </summary>
+    public interface IAutomationService
+    {
+        /// <summary>
+        /// Registers an upkeep for a contract, paid for by one of the account's GasBank accounts
+        /// </summary>
+        /// <param name="upkeep">Upkeep to register</param>
+        /// <returns>The registered upkeep</returns>
+        Task<Upkeep> RegisterAsync(Upkeep upkeep);
+
+        /// <summary>
+        /// Gets an upkeep by ID
+        /// </summary>
+        /// <param name="id">Upkeep ID</param>
+        /// <returns>The upkeep if found, null otherwise</returns>
+        Task<Upkeep> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the upkeeps of an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>List of upkeeps</returns>
+        Task<IEnumerable<Upkeep>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Stops checking an upkeep until it is resumed
+        /// </summary>
+        /// <param name="id">Upkeep ID</param>
+        /// <returns>The paused upkeep</returns>
+        Task<Upkeep> PauseAsync(Guid id);
+
+        /// <summary>
+        /// Resumes checking a paused upkeep
+        /// </summary>
+        /// <param name="id">Upkeep ID</param>
+        /// <returns>The resumed upkeep</returns>
+        Task<Upkeep> ResumeAsync(Guid id);
+
+        /// <summary>
+        /// Permanently stops an upkeep
+        /// </summary>
+        /// <param name="id">Upkeep ID</param>
+        /// <returns>The cancelled upkeep</returns>
+        Task<Upkeep> CancelAsync(Guid id);
+
+        /// <summary>
+        /// Test-invokes an upkeep's checkUpkeep, and performUpkeep if it is needed, without sending a transaction
+        /// </summary>
+        /// <param name="id">Upkeep ID</param>
+        /// <returns>The decoded results of the invocations</returns>
+        Task<UpkeepSimulation> SimulateAsync(Guid id);
+
+        /// <summary>
+        /// Starts checking active upkeeps on their intervals
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StartAsync();
+
+        /// <summary>
+        /// Stops checking upkeeps
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StopAsync();
+    }
+}
@@ -0,0 +1,49 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the service that alerts accounts when contracts they watch are upgraded
+    /// </summary>
+    public interface IContractWatchService
+    {
+        /// <summary>
+        /// Starts watching a contract
+        /// </summary>
+        /// <param name="watch">Watch; an account can watch each contract once</param>
+        /// <returns>The created watch</returns>
+        Task<WatchedContract> WatchAsync(WatchedContract watch);
+
+        /// <summary>
+        /// Gets a watch
+        /// </summary>
+        /// <param name="id">Watch ID</param>
+        /// <returns>The watch if found, null otherwise</returns>
+        Task<WatchedContract> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the contracts an account watches
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>List of watches</returns>
+        Task<IEnumerable<WatchedContract>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Stops watching a contract
+        /// </summary>
+        /// <param name="id">Watch ID</param>
+        /// <param name="accountId">Account stopping the watch; must own it</param>
+        /// <returns>True if the watch was removed</returns>
+        Task<bool> UnwatchAsync(Guid id, Guid accountId);
+
+        /// <summary>
+        /// Records the contract updates and destructions among a block's events and alerts the accounts watching them
+        /// </summary>
+        /// <param name="blockEvents">Events of a block</param>
+        /// <returns>The number of watches alerted</returns>
+        Task<int> ProcessEventsAsync(IEnumerable<BlockEvent> blockEvents);
+    }
+}
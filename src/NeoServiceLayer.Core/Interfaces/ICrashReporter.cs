@@ -0,0 +1,30 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for reporting unhandled exceptions raised by background work
+    /// </summary>
+    public interface ICrashReporter
+    {
+        /// <summary>
+        /// Records an unhandled exception with its stack trace and context
+        /// </summary>
+        /// <param name="component">Component in which the exception occurred (e.g., "EventMonitoring.MonitorBlocks")</param>
+        /// <param name="exception">The exception</param>
+        /// <param name="context">Additional context to attach to the report</param>
+        /// <returns>Task representing the asynchronous operation</returns>
+        Task ReportAsync(string component, Exception exception, IDictionary<string, object> context = null);
+
+        /// <summary>
+        /// Runs background work, reporting any exception it throws instead of letting it escape
+        /// </summary>
+        /// <param name="component">Component running the work</param>
+        /// <param name="work">The work to run</param>
+        /// <param name="context">Additional context to attach to a report</param>
+        /// <returns>Task that completes when the work has finished or failed</returns>
+        Task RunAsync(string component, Func<Task> work, IDictionary<string, object> context = null);
+    }
+}
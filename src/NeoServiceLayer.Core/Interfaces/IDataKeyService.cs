@@ -0,0 +1,33 @@
+using System;
+using System.Threading.Tasks;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for managing per-account data keys
+    /// </summary>
+    public interface IDataKeyService
+    {
+        /// <summary>
+        /// Gets the data key of an account, generating one in the enclave if none exists
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>The plain data key</returns>
+        /// <exception cref="InvalidOperationException">Thrown if the account's key has been revoked</exception>
+        Task<byte[]> GetOrCreateKeyAsync(Guid accountId);
+
+        /// <summary>
+        /// Gets the data key of an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>The plain data key, or null if the account has no key or it has been revoked</returns>
+        Task<byte[]> GetKeyAsync(Guid accountId);
+
+        /// <summary>
+        /// Revokes the data key of an account, making all data encrypted with it unreadable
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>True if a key was revoked, false if the account had no active key</returns>
+        Task<bool> RevokeKeyAsync(Guid accountId);
+    }
+}
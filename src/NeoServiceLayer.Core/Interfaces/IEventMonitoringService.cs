@@ -203,6 +203,16 @@ namespace NeoServiceLayer.Core.Interfaces
         /// </summary>
         public long CurrentBlockHeight { get; set; }
 
+        /// <summary>
+        /// Gets or sets the highest block with enough confirmations to fire triggers
+        /// </summary>
+        public long ConfirmedBlockHeight { get; set; }
+
+        /// <summary>
+        /// Gets or sets how many confirmations a block needs before its events fire triggers
+        /// </summary>
+        public int TriggerEventConfirmations { get; set; }
+
         /// <summary>
         /// Gets or sets the last processed block height
         /// </summary>
@@ -253,6 +263,11 @@ namespace NeoServiceLayer.Core.Interfaces
         /// </summary>
         public bool IsMonitoring { get; set; }
 
+        /// <summary>
+        /// Gets or sets a value indicating whether this instance is a warm standby that does not fire triggers
+        /// </summary>
+        public bool IsStandby { get; set; }
+
         /// <summary>
         /// Gets or sets the monitoring start time
         /// </summary>
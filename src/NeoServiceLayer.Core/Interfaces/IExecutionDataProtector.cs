@@ -0,0 +1,39 @@
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for encrypting execution data at rest with the owning account's data key
+    /// </summary>
+    public interface IExecutionDataProtector
+    {
+        /// <summary>
+        /// Gets a copy of an execution result with its input, output and logs encrypted
+        /// </summary>
+        /// <param name="execution">Execution result; it is not modified</param>
+        /// <returns>The copy to store</returns>
+        Task<FunctionExecutionResult> ProtectAsync(FunctionExecutionResult execution);
+
+        /// <summary>
+        /// Gets a copy of a stored execution result with its input, output and logs decrypted
+        /// </summary>
+        /// <param name="execution">Stored execution result; it is not modified</param>
+        /// <returns>The decrypted copy; input, output and logs are empty if the account's key has been revoked</returns>
+        Task<FunctionExecutionResult> UnprotectAsync(FunctionExecutionResult execution);
+
+        /// <summary>
+        /// Gets a copy of a log entry with its message encrypted with the key of <see cref="FunctionLog.AccountId"/>
+        /// </summary>
+        /// <param name="log">Log entry; it is not modified</param>
+        /// <returns>The copy to store</returns>
+        Task<FunctionLog> ProtectLogAsync(FunctionLog log);
+
+        /// <summary>
+        /// Gets a copy of a stored log entry with its message decrypted
+        /// </summary>
+        /// <param name="log">Stored log entry; it is not modified</param>
+        /// <returns>The decrypted copy; the message is empty if the account's key has been revoked</returns>
+        Task<FunctionLog> UnprotectLogAsync(FunctionLog log);
+    }
+}
@@ -0,0 +1,44 @@
+using System;
+using System.Collections.Generic;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for sharing an instance's execution slots between execution tiers
+    /// </summary>
+    public interface IExecutionScheduler
+    {
+        /// <summary>
+        /// Waits for an execution slot for an account
+        /// </summary>
+        /// <param name="accountId">ID of the account the function belongs to</param>
+        /// <param name="lane">Lane the execution waits in; interactive executions go ahead of triggered ones in the same tier</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The slot, released when disposed</returns>
+        /// <exception cref="Exceptions.FunctionException">Thrown when the queue is full or the wait times out</exception>
+        Task<IDisposable> AcquireAsync(Guid accountId, ExecutionLane lane = ExecutionLane.Interactive, CancellationToken cancellationToken = default);
+
+        /// <summary>
+        /// Gets the configured tiers with the weights in effect
+        /// </summary>
+        /// <returns>The tiers</returns>
+        IReadOnlyList<ExecutionTier> GetTiers();
+
+        /// <summary>
+        /// Gets the scheduler's status, including starvation counters per tier
+        /// </summary>
+        /// <returns>The status</returns>
+        ExecutionSchedulerStatus GetStatus();
+
+        /// <summary>
+        /// Assigns an account to an execution tier
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <param name="tier">Tier name</param>
+        /// <returns>The updated account</returns>
+        Task<Account> SetAccountTierAsync(Guid accountId, string tier);
+    }
+}
@@ -0,0 +1,66 @@
+using System;
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for tracking function executions that are currently running
+    /// </summary>
+    public interface IExecutionTracker
+    {
+        /// <summary>
+        /// Registers a running execution
+        /// </summary>
+        /// <param name="execution">Execution to register</param>
+        void Register(ActiveExecution execution);
+
+        /// <summary>
+        /// Updates the last reported memory usage of an execution
+        /// </summary>
+        /// <param name="executionId">Execution ID</param>
+        /// <param name="memoryUsageMb">Memory usage in megabytes</param>
+        void UpdateMemoryUsage(Guid executionId, double memoryUsageMb);
+
+        /// <summary>
+        /// Marks an execution as killed
+        /// </summary>
+        /// <param name="executionId">Execution ID</param>
+        /// <param name="killedBy">User who requested the kill</param>
+        /// <returns>True if the execution was running and is now marked as killed, false otherwise</returns>
+        bool MarkKilled(Guid executionId, string killedBy);
+
+        /// <summary>
+        /// Returns an execution marked as killed to running, when the kill could not be delivered
+        /// </summary>
+        /// <param name="executionId">Execution ID</param>
+        void ClearKill(Guid executionId);
+
+        /// <summary>
+        /// Checks whether an execution was killed
+        /// </summary>
+        /// <param name="executionId">Execution ID</param>
+        /// <returns>True if a kill was requested for the execution, false otherwise</returns>
+        bool IsKilled(Guid executionId);
+
+        /// <summary>
+        /// Removes an execution once it has finished
+        /// </summary>
+        /// <param name="executionId">Execution ID</param>
+        /// <returns>The removed execution if found, null otherwise</returns>
+        ActiveExecution Complete(Guid executionId);
+
+        /// <summary>
+        /// Gets a running execution by ID
+        /// </summary>
+        /// <param name="executionId">Execution ID</param>
+        /// <returns>The execution if found, null otherwise</returns>
+        ActiveExecution Get(Guid executionId);
+
+        /// <summary>
+        /// Gets all running executions
+        /// </summary>
+        /// <returns>List of running executions ordered by start time</returns>
+        IEnumerable<ActiveExecution> GetAll();
+    }
+}
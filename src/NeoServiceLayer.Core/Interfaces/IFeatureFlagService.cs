@@ -0,0 +1,53 @@
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the feature flag service
+    /// </summary>
+    /// <remarks>
+    /// Flags are read from an in-memory copy so they can be checked on hot paths.
+    /// </remarks>
+    public interface IFeatureFlagService
+    {
+        /// <summary>
+        /// Checks whether a flag is on
+        /// </summary>
+        /// <param name="name">Flag name</param>
+        /// <param name="defaultValue">Result when the flag is not set</param>
+        /// <returns>True if the flag is on</returns>
+        bool IsEnabled(string name, bool defaultValue = false);
+
+        /// <summary>
+        /// Gets the value of a flag that is on
+        /// </summary>
+        /// <param name="name">Flag name</param>
+        /// <returns>The value, or null if the flag is not set, off or has no value</returns>
+        string GetValue(string name);
+
+        /// <summary>
+        /// Gets all flags
+        /// </summary>
+        /// <returns>The flags, by name</returns>
+        Task<IEnumerable<FeatureFlag>> GetAllAsync();
+
+        /// <summary>
+        /// Creates or changes a flag
+        /// </summary>
+        /// <param name="name">Flag name</param>
+        /// <param name="enabled">Whether the flag is on</param>
+        /// <param name="value">Optional value</param>
+        /// <param name="updatedBy">User making the change</param>
+        /// <returns>The flag</returns>
+        Task<FeatureFlag> SetAsync(string name, bool enabled, string value, string updatedBy);
+
+        /// <summary>
+        /// Removes a flag, so code checking it falls back to its default
+        /// </summary>
+        /// <param name="name">Flag name</param>
+        /// <returns>True if the flag existed</returns>
+        Task<bool> DeleteAsync(string name);
+    }
+}
@@ -1,6 +1,7 @@
 using System;
 using System.Collections.Generic;
 using System.Threading.Tasks;
+using NeoServiceLayer.Core.Enums;
 using NeoServiceLayer.Core.Models;
 
 namespace NeoServiceLayer.Core.Interfaces
@@ -78,6 +79,28 @@ namespace NeoServiceLayer.Core.Interfaces
         /// <returns>The updated permission</returns>
         Task<FunctionPermission> UpdatePermissionAsync(FunctionPermission permission);
 
+        /// <summary>
+        /// Grants a principal permission to invoke a function
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="principalId">Principal ID: an account ID for users or a key ID for API keys</param>
+        /// <param name="principalType">Principal type, "user" or "apikey"</param>
+        /// <param name="grantedBy">Account granting the permission</param>
+        /// <param name="rateLimit">Maximum invocations per period; null for no limit</param>
+        /// <param name="rateLimitPeriodSeconds">Rate limit period in seconds</param>
+        /// <param name="expiresAt">Expiration date</param>
+        /// <returns>The grant</returns>
+        Task<FunctionPermission> GrantInvokeAsync(Guid functionId, string principalId, string principalType, Guid grantedBy, int? rateLimit = null, int rateLimitPeriodSeconds = 60, DateTime? expiresAt = null);
+
+        /// <summary>
+        /// Checks if a principal may invoke a function and counts the invocation against its grant's rate limit
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="principalId">Principal ID</param>
+        /// <param name="principalType">Principal type</param>
+        /// <returns>The authorization result</returns>
+        Task<InvokeAuthorizationResult> AuthorizeInvokeAsync(Guid functionId, string principalId, string principalType);
+
         /// <summary>
         /// Creates an access policy for a function
         /// </summary>
@@ -0,0 +1,27 @@
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for building function sources into the JavaScript the sandbox runs
+    /// </summary>
+    public interface IFunctionBuildService
+    {
+        /// <summary>
+        /// Transpiles a TypeScript source to JavaScript for the sandbox
+        /// </summary>
+        /// <param name="sourceCode">TypeScript source</param>
+        /// <param name="fileName">File name reported in errors and the source map</param>
+        /// <returns>The compiled code and source map, or the build errors</returns>
+        Task<FunctionCompilationResult> BuildAsync(string sourceCode, string fileName);
+
+        /// <summary>
+        /// Rewrites the line and column positions in a runtime error to positions in the source
+        /// </summary>
+        /// <param name="message">Error message from the sandbox</param>
+        /// <param name="sourceMap">Source map produced by the build</param>
+        /// <returns>The error message with source positions, or the message unchanged if it has none</returns>
+        string MapError(string message, string sourceMap);
+    }
+}
@@ -0,0 +1,20 @@
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for statically analyzing which sandbox capabilities function code uses
+    /// </summary>
+    public interface IFunctionCapabilityAnalyzer
+    {
+        /// <summary>
+        /// Analyzes function code and compares the capabilities it uses against its declared permissions
+        /// </summary>
+        /// <param name="runtime">Function runtime</param>
+        /// <param name="sourceCode">Function source code</param>
+        /// <param name="declaredPermissions">Declared permissions, or null if the function declares none</param>
+        /// <returns>The capability report</returns>
+        FunctionCapabilityReport Analyze(string runtime, string sourceCode, IEnumerable<string> declaredPermissions);
+    }
+}
@@ -0,0 +1,44 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the function daily usage repository
+    /// </summary>
+    public interface IFunctionDailyUsageRepository
+    {
+        /// <summary>
+        /// Gets the usage of a function on a day
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="date">Day, as midnight UTC</param>
+        /// <returns>The usage, or null if the function did not run that day</returns>
+        Task<FunctionDailyUsage> GetAsync(Guid functionId, DateTime date);
+
+        /// <summary>
+        /// Gets the usage of a function over a range of days, oldest first
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="from">First day, as midnight UTC</param>
+        /// <param name="to">Last day, as midnight UTC</param>
+        /// <returns>The usage records</returns>
+        Task<IEnumerable<FunctionDailyUsage>> GetByFunctionIdAsync(Guid functionId, DateTime from, DateTime to);
+
+        /// <summary>
+        /// Stores the usage of a new day
+        /// </summary>
+        /// <param name="usage">Usage record</param>
+        /// <returns>The stored record</returns>
+        Task<FunctionDailyUsage> CreateAsync(FunctionDailyUsage usage);
+
+        /// <summary>
+        /// Updates the usage of a day
+        /// </summary>
+        /// <param name="usage">Usage record</param>
+        /// <returns>The updated record</returns>
+        Task<FunctionDailyUsage> UpdateAsync(FunctionDailyUsage usage);
+    }
+}
@@ -0,0 +1,36 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the function security event repository
+    /// </summary>
+    public interface IFunctionSecurityEventRepository
+    {
+        /// <summary>
+        /// Stores a security event
+        /// </summary>
+        /// <param name="securityEvent">Event to store</param>
+        /// <returns>The stored event</returns>
+        Task<FunctionSecurityEvent> CreateAsync(FunctionSecurityEvent securityEvent);
+
+        /// <summary>
+        /// Gets the security events of a function, newest first
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="since">Earliest event time</param>
+        /// <returns>The events</returns>
+        Task<IEnumerable<FunctionSecurityEvent>> GetByFunctionIdAsync(Guid functionId, DateTime since);
+
+        /// <summary>
+        /// Gets the security events of an account's functions, newest first
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <param name="since">Earliest event time</param>
+        /// <returns>The events</returns>
+        Task<IEnumerable<FunctionSecurityEvent>> GetByAccountIdAsync(Guid accountId, DateTime since);
+    }
+}
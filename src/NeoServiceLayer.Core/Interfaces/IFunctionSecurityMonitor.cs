@@ -0,0 +1,44 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for recording sandbox security events and disabling functions that exceed anomaly thresholds
+    /// </summary>
+    public interface IFunctionSecurityMonitor
+    {
+        /// <summary>
+        /// Records the security events of an execution and disables the function if it is over a threshold
+        /// </summary>
+        /// <param name="function">Function that ran</param>
+        /// <param name="executionId">Execution ID</param>
+        /// <param name="events">Events reported by the sandbox</param>
+        /// <returns>True if the function was disabled pending review</returns>
+        Task<bool> RecordAsync(Function function, Guid executionId, IEnumerable<FunctionSecurityEvent> events);
+
+        /// <summary>
+        /// Gets the security events of a function within the configured window, newest first
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <returns>The events</returns>
+        Task<IEnumerable<FunctionSecurityEvent>> GetEventsAsync(Guid functionId);
+
+        /// <summary>
+        /// Gets the security events of an account's functions within the configured window, per function
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>One summary per function with events</returns>
+        Task<IEnumerable<FunctionSecuritySummary>> GetSummariesAsync(Guid accountId);
+
+        /// <summary>
+        /// Re-enables a function disabled pending review
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="reviewedBy">Administrator who reviewed the function</param>
+        /// <returns>The re-enabled function</returns>
+        Task<Function> ApproveAsync(Guid functionId, string reviewedBy);
+    }
+}
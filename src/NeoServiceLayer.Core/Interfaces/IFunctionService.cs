@@ -28,6 +28,24 @@ namespace NeoServiceLayer.Core.Interfaces
         /// <returns>The created function</returns>
         Task<Function> CreateFunctionAsync(string name, string description, FunctionRuntime runtime, string sourceCode, string entryPoint, Guid accountId, int maxExecutionTime = 30000, int maxMemory = 128, List<Guid> secretIds = null, Dictionary<string, string> environmentVariables = null);
 
+        /// <summary>
+        /// Creates a new function that declares the capabilities its code may use
+        /// </summary>
+        /// <param name="name">Name for the function</param>
+        /// <param name="description">Description of the function</param>
+        /// <param name="runtime">Runtime for the function</param>
+        /// <param name="sourceCode">Source code of the function</param>
+        /// <param name="entryPoint">Entry point for the function</param>
+        /// <param name="accountId">Account ID that owns the function</param>
+        /// <param name="maxExecutionTime">Maximum execution time in milliseconds</param>
+        /// <param name="maxMemory">Maximum memory usage in megabytes</param>
+        /// <param name="secretIds">List of secret IDs that the function has access to</param>
+        /// <param name="environmentVariables">Environment variables for the function</param>
+        /// <param name="permissions">Declared permissions, or null to leave the code unrestricted</param>
+        /// <returns>The created function</returns>
+        /// <exception cref="Exceptions.FunctionPermissionException">The code uses capabilities that are not declared</exception>
+        Task<Function> CreateFunctionAsync(string name, string description, FunctionRuntime runtime, string sourceCode, string entryPoint, Guid accountId, int maxExecutionTime, int maxMemory, List<Guid> secretIds, Dictionary<string, string> environmentVariables, List<string> permissions);
+
         /// <summary>
         /// Gets a function by ID
         /// </summary>
@@ -74,8 +92,9 @@ namespace NeoServiceLayer.Core.Interfaces
         /// <summary>
         /// Updates a function
         /// </summary>
-        /// <param name="function">Function to update</param>
-        /// <returns>The updated function</returns>
+        /// <param name="function">Function to update, carrying the revision it was read at</param>
+        /// <returns>The updated function, at the next revision</returns>
+        /// <exception cref="Exceptions.ConcurrencyConflictException">The function has been updated since that revision</exception>
         Task<Function> UpdateAsync(Function function);
 
         /// <summary>
@@ -84,8 +103,25 @@ namespace NeoServiceLayer.Core.Interfaces
         /// <param name="id">Function ID</param>
         /// <param name="sourceCode">New source code for the function</param>
         /// <returns>The updated function</returns>
+        /// <exception cref="Exceptions.FunctionPermissionException">The new code uses capabilities the function has not declared</exception>
         Task<Function> UpdateSourceCodeAsync(Guid id, string sourceCode);
 
+        /// <summary>
+        /// Updates the declared permissions of a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="permissions">Declared permissions, or null to leave the code unrestricted</param>
+        /// <returns>The updated function</returns>
+        /// <exception cref="Exceptions.FunctionPermissionException">The current code uses capabilities missing from the new permissions</exception>
+        Task<Function> UpdatePermissionsAsync(Guid id, List<string> permissions);
+
+        /// <summary>
+        /// Gets the capability report of a function's current code
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <returns>The capability report, or null if the function does not exist</returns>
+        Task<FunctionCapabilityReport> GetCapabilityReportAsync(Guid id);
+
         /// <summary>
         /// Updates the environment variables of a function
         /// </summary>
@@ -94,6 +130,14 @@ namespace NeoServiceLayer.Core.Interfaces
         /// <returns>The updated function</returns>
         Task<Function> UpdateEnvironmentVariablesAsync(Guid id, Dictionary<string, string> environmentVariables);
 
+        /// <summary>
+        /// Replaces the tags of a function
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="tags">New tags for the function</param>
+        /// <returns>The updated function</returns>
+        Task<Function> UpdateTagsAsync(Guid id, List<string> tags);
+
         /// <summary>
         /// Updates the secret access of a function
         /// </summary>
@@ -110,6 +154,15 @@ namespace NeoServiceLayer.Core.Interfaces
         /// <returns>Result of the function execution</returns>
         Task<object> ExecuteAsync(Guid id, Dictionary<string, object> parameters = null);
 
+        /// <summary>
+        /// Executes a function in the warm sandbox of a session, which keeps the function's in-memory state between invocations
+        /// </summary>
+        /// <param name="id">Function ID</param>
+        /// <param name="parameters">Parameters for the function execution</param>
+        /// <param name="sessionKey">Session key; invocations with the same key reuse the sandbox until it is evicted, then cold start</param>
+        /// <returns>Result of the function execution</returns>
+        Task<object> ExecuteAsync(Guid id, Dictionary<string, object> parameters, string sessionKey);
+
         /// <summary>
         /// Executes a function in response to an event
         /// </summary>
@@ -127,6 +180,20 @@ namespace NeoServiceLayer.Core.Interfaces
         /// <returns>List of execution records for the function</returns>
         Task<IEnumerable<object>> GetExecutionHistoryAsync(Guid id, DateTime startTime, DateTime endTime);
 
+        /// <summary>
+        /// Gets the function executions that are currently running
+        /// </summary>
+        /// <returns>List of running executions</returns>
+        Task<IEnumerable<ActiveExecution>> GetActiveExecutionsAsync();
+
+        /// <summary>
+        /// Interrupts a running function execution
+        /// </summary>
+        /// <param name="executionId">Execution ID</param>
+        /// <param name="killedBy">User who requested the kill</param>
+        /// <returns>True if the execution was running and has been interrupted, false otherwise</returns>
+        Task<bool> KillExecutionAsync(Guid executionId, string killedBy);
+
         /// <summary>
         /// Activates a function
         /// </summary>
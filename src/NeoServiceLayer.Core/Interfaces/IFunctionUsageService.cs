@@ -0,0 +1,30 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the per-function daily resource usage reports
+    /// </summary>
+    public interface IFunctionUsageService
+    {
+        /// <summary>
+        /// Adds a finished execution to its function's usage for the day it started
+        /// </summary>
+        /// <param name="execution">The finished execution</param>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task RecordAsync(FunctionExecutionResult execution);
+
+        /// <summary>
+        /// Gets the daily statistics of a function, oldest day first
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="from">First day</param>
+        /// <param name="to">Last day</param>
+        /// <returns>One entry per day with executions</returns>
+        /// <exception cref="Exceptions.ValidationException">The range is reversed or too long</exception>
+        Task<IEnumerable<FunctionDailyStats>> GetDailyStatsAsync(Guid functionId, DateTime from, DateTime to);
+    }
+}
@@ -0,0 +1,48 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for function version repository
+    /// </summary>
+    public interface IFunctionVersionRepository
+    {
+        /// <summary>
+        /// Creates a new function version
+        /// </summary>
+        /// <param name="version">Version to create</param>
+        /// <returns>The created version</returns>
+        Task<FunctionVersion> CreateAsync(FunctionVersion version);
+
+        /// <summary>
+        /// Updates a function version
+        /// </summary>
+        /// <param name="version">Version to update</param>
+        /// <returns>The updated version</returns>
+        Task<FunctionVersion> UpdateAsync(FunctionVersion version);
+
+        /// <summary>
+        /// Gets a function version by ID
+        /// </summary>
+        /// <param name="id">Version ID</param>
+        /// <returns>The version if found, null otherwise</returns>
+        Task<FunctionVersion> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the versions of a function
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <returns>The versions, newest first</returns>
+        Task<IEnumerable<FunctionVersion>> GetByFunctionIdAsync(Guid functionId);
+
+        /// <summary>
+        /// Deletes a function version
+        /// </summary>
+        /// <param name="id">Version ID</param>
+        /// <returns>True if the version was deleted, false otherwise</returns>
+        Task<bool> DeleteAsync(Guid id);
+    }
+}
@@ -0,0 +1,60 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the function registry, which keeps every deployed version of a function
+    /// </summary>
+    public interface IFunctionVersionService
+    {
+        /// <summary>
+        /// Deploys a new version of a function
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="version">Code and settings of the version; unset settings are taken from the function</param>
+        /// <param name="promote">Whether to make the version live straight away</param>
+        /// <returns>The deployed version</returns>
+        Task<FunctionVersion> DeployAsync(Guid functionId, FunctionVersion version, bool promote);
+
+        /// <summary>
+        /// Gets the versions of a function
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <returns>The versions, newest first</returns>
+        Task<IEnumerable<FunctionVersion>> GetVersionsAsync(Guid functionId);
+
+        /// <summary>
+        /// Gets a version of a function by number
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="number">Version number</param>
+        /// <returns>The version if found, null otherwise</returns>
+        Task<FunctionVersion> GetVersionAsync(Guid functionId, int number);
+
+        /// <summary>
+        /// Makes a version the one the function runs
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="number">Version number</param>
+        /// <returns>The promoted version</returns>
+        Task<FunctionVersion> PromoteAsync(Guid functionId, int number);
+
+        /// <summary>
+        /// Makes the function run the newest version below the live one that has been live before
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <returns>The version now live</returns>
+        Task<FunctionVersion> RollbackAsync(Guid functionId);
+
+        /// <summary>
+        /// Deletes a version that is not live
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="number">Version number</param>
+        /// <returns>True if the version was deleted, false if it was not found</returns>
+        Task<bool> DeleteVersionAsync(Guid functionId, int number);
+    }
+}
@@ -47,8 +47,103 @@ namespace NeoServiceLayer.Core.Interfaces
         /// <param name="id">The GasBank account ID</param>
         /// <param name="amount">The amount to withdraw</param>
         /// <param name="toAddress">The Neo address to withdraw to</param>
-        /// <returns>The transaction hash</returns>
-        Task<string> WithdrawAsync(Guid id, decimal amount, string toAddress);
+        /// <returns>The withdrawal, which is queued if the hot wallet does not hold enough GAS</returns>
+        Task<GasBankWithdrawal> WithdrawAsync(Guid id, decimal amount, string toAddress);
+
+        /// <summary>
+        /// Gets the withdrawals waiting for the hot wallet to be replenished
+        /// </summary>
+        /// <returns>The queued withdrawals, oldest first</returns>
+        Task<IEnumerable<GasBankWithdrawal>> GetQueuedWithdrawalsAsync();
+
+        /// <summary>
+        /// Gets the withdrawals that were sending when the service stopped
+        /// </summary>
+        /// <returns>The in-doubt withdrawals, oldest first</returns>
+        Task<IEnumerable<GasBankWithdrawal>> GetInDoubtWithdrawalsAsync();
+
+        /// <summary>
+        /// Resolves an in-doubt withdrawal once an operator has checked the chain for its transfer
+        /// </summary>
+        /// <param name="withdrawalId">The withdrawal ID</param>
+        /// <param name="transactionHash">The hash of the transfer if the GAS was sent, or null to return the amount to the balance</param>
+        /// <param name="resolvedBy">The operator resolving the withdrawal</param>
+        /// <returns>The resolved withdrawal</returns>
+        Task<GasBankWithdrawal> ResolveInDoubtWithdrawalAsync(Guid withdrawalId, string transactionHash, string resolvedBy);
+
+        /// <summary>
+        /// Gets the balances and pending withdrawals summed across all GasBank accounts
+        /// </summary>
+        /// <returns>The GasBank totals</returns>
+        Task<GasBankTotals> GetTotalsAsync();
+
+        /// <summary>
+        /// Moves GAS above the working float from each hot wallet to the cold wallet
+        /// </summary>
+        /// <returns>The sweep transactions</returns>
+        Task<IEnumerable<GasBankTransaction>> SweepToColdWalletAsync();
+
+        /// <summary>
+        /// Checks sent withdrawals on chain, confirming transfers that halted and returning the amount of those that faulted
+        /// </summary>
+        /// <returns>The withdrawals whose status changed</returns>
+        Task<IEnumerable<GasBankWithdrawal>> ConfirmWithdrawalsAsync();
+
+        /// <summary>
+        /// Records GAS that an operator moved from the cold wallet to a GasBank account's hot wallet
+        /// and sends the account's queued withdrawals that can now be covered
+        /// </summary>
+        /// <param name="id">The GasBank account ID</param>
+        /// <param name="amount">The amount that arrived in the hot wallet</param>
+        /// <param name="transactionHash">The hash of the replenishment transaction, which must be <see cref="GasBankConfiguration.DepositConfirmations"/> blocks deep when the chain can be checked</param>
+        /// <param name="confirmedBy">The operator confirming the replenishment</param>
+        /// <returns>The updated GasBank account</returns>
+        Task<GasBankAccount> ConfirmReplenishmentAsync(Guid id, decimal amount, string transactionHash, string confirmedBy);
+
+        /// <summary>
+        /// Distributes GAS from a GasBank account among recipients by weight in a single transaction
+        /// </summary>
+        /// <param name="id">The GasBank account ID</param>
+        /// <param name="totalAmount">The total amount to distribute</param>
+        /// <param name="recipients">The recipients and their weights</param>
+        /// <param name="relatedEntityId">The ID of the entity that triggered the payout</param>
+        /// <returns>A receipt per recipient</returns>
+        Task<IEnumerable<GasPayoutReceipt>> DistributeAsync(Guid id, decimal totalAmount, List<GasPayoutRecipient> recipients, Guid? relatedEntityId = null);
+
+        /// <summary>
+        /// Charges the network fee of a transaction the hot wallet sent on the account's behalf
+        /// </summary>
+        /// <param name="id">The GasBank account ID</param>
+        /// <param name="amount">The fee to charge</param>
+        /// <param name="transactionHash">The hash of the transaction the fee paid for</param>
+        /// <param name="description">What the transaction did</param>
+        /// <param name="relatedEntityId">The ID of the entity the transaction was sent for</param>
+        /// <returns>The fee transaction</returns>
+        Task<GasBankTransaction> ChargeNetworkFeeAsync(Guid id, decimal amount, string transactionHash, string description, Guid? relatedEntityId = null);
+
+        /// <summary>
+        /// Gets the GAS available to pay for executions of a function
+        /// </summary>
+        /// <param name="accountId">The ID of the account owning the function</param>
+        /// <param name="functionId">The function ID</param>
+        /// <returns>The function's allocation if it has one, otherwise the largest unallocated balance of the owner's
+        /// GasBank accounts; null if the owner has no GasBank account</returns>
+        Task<decimal?> GetExecutionBudgetAsync(Guid accountId, Guid functionId);
+
+        /// <summary>
+        /// Charges the metered cost of a function execution to the owner's GasBank
+        /// </summary>
+        /// <remarks>
+        /// The cost is paid from the function's allocation if it has one, otherwise from the owner's GasBank account
+        /// with the largest unallocated balance. The execution has already run, so if concurrent executions left too
+        /// little GAS, only what is left is charged.
+        /// </remarks>
+        /// <param name="accountId">The ID of the account owning the function</param>
+        /// <param name="functionId">The function ID</param>
+        /// <param name="executionId">The execution ID</param>
+        /// <param name="amount">The cost to charge</param>
+        /// <returns>The charge transaction, or null if the owner has no GasBank account or no GAS left</returns>
+        Task<GasBankTransaction> ChargeFunctionExecutionAsync(Guid accountId, Guid functionId, Guid executionId, decimal amount);
 
         /// <summary>
         /// Allocates GAS from a GasBank account to a function
@@ -0,0 +1,115 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the GasBank fee policy service
+    /// </summary>
+    public interface IGasFeePolicyService
+    {
+        /// <summary>
+        /// Creates a template with its first version
+        /// </summary>
+        /// <param name="ownerAccountId">Account creating the template</param>
+        /// <param name="name">Template name</param>
+        /// <param name="description">Template description</param>
+        /// <param name="isPublic">Whether other accounts may create policies from the template</param>
+        /// <param name="rules">Rules of version 1</param>
+        /// <returns>The created template</returns>
+        Task<GasFeePolicyTemplate> CreateTemplateAsync(Guid ownerAccountId, string name, string description, bool isPublic, GasFeePolicyRules rules);
+
+        /// <summary>
+        /// Publishes a new version of a template
+        /// </summary>
+        /// <param name="templateId">Template ID</param>
+        /// <param name="ownerAccountId">Account publishing the version; must own the template</param>
+        /// <param name="rules">Rules of the new version</param>
+        /// <param name="notes">Notes describing what changed</param>
+        /// <returns>The template with the new version</returns>
+        Task<GasFeePolicyTemplate> PublishVersionAsync(Guid templateId, Guid ownerAccountId, GasFeePolicyRules rules, string notes);
+
+        /// <summary>
+        /// Gets a template
+        /// </summary>
+        /// <param name="templateId">Template ID</param>
+        /// <returns>The template if found, null otherwise</returns>
+        Task<GasFeePolicyTemplate> GetTemplateAsync(Guid templateId);
+
+        /// <summary>
+        /// Gets the templates an account owns and the public templates
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>List of templates</returns>
+        Task<IEnumerable<GasFeePolicyTemplate>> GetTemplatesAsync(Guid accountId);
+
+        /// <summary>
+        /// Creates a policy from a template, sponsored by one of the sponsor's GasBank accounts
+        /// </summary>
+        /// <param name="templateId">Template ID; must be public or owned by the sponsor</param>
+        /// <param name="sponsorAccountId">Account that owns the paying GasBank account</param>
+        /// <param name="gasBankAccountId">GasBank account that pays the fees</param>
+        /// <param name="accountId">Account whose fees are covered</param>
+        /// <param name="templateVersion">Version to pin, or null to follow the latest version</param>
+        /// <param name="overrides">Rules replacing the template's</param>
+        /// <returns>The created policy</returns>
+        Task<GasFeePolicy> CreatePolicyAsync(Guid templateId, Guid sponsorAccountId, Guid gasBankAccountId, Guid accountId, int? templateVersion, GasFeePolicyRules overrides);
+
+        /// <summary>
+        /// Applies a template to many accounts at once; accounts that already have a policy from the template keep
+        /// their overrides and move to the applied version
+        /// </summary>
+        /// <param name="templateId">Template ID</param>
+        /// <param name="ownerAccountId">Account applying the template; must own it and the GasBank account</param>
+        /// <param name="gasBankAccountId">GasBank account that pays the fees</param>
+        /// <param name="accountIds">Accounts whose fees are covered</param>
+        /// <param name="templateVersion">Version to pin, or null to follow the latest version</param>
+        /// <returns>The created and updated policies</returns>
+        Task<GasFeePolicyBulkResult> ApplyTemplateAsync(Guid templateId, Guid ownerAccountId, Guid gasBankAccountId, IEnumerable<Guid> accountIds, int? templateVersion);
+
+        /// <summary>
+        /// Gets a policy with its effective rules
+        /// </summary>
+        /// <param name="policyId">Policy ID</param>
+        /// <returns>The policy if found, null otherwise</returns>
+        Task<GasFeePolicy> GetPolicyAsync(Guid policyId);
+
+        /// <summary>
+        /// Gets the policies covering or sponsored by an account, with their effective rules
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>List of policies</returns>
+        Task<IEnumerable<GasFeePolicy>> GetPoliciesAsync(Guid accountId);
+
+        /// <summary>
+        /// Changes a policy's overrides and template version
+        /// </summary>
+        /// <param name="policyId">Policy ID</param>
+        /// <param name="sponsorAccountId">Account making the change; must be the policy's sponsor</param>
+        /// <param name="templateVersion">Version to pin, or null to follow the latest version</param>
+        /// <param name="overrides">Rules replacing the template's</param>
+        /// <returns>The updated policy</returns>
+        Task<GasFeePolicy> UpdatePolicyAsync(Guid policyId, Guid sponsorAccountId, int? templateVersion, GasFeePolicyRules overrides);
+
+        /// <summary>
+        /// Deletes a policy
+        /// </summary>
+        /// <param name="policyId">Policy ID</param>
+        /// <param name="accountId">Account deleting the policy; must be its sponsor or the covered account</param>
+        /// <returns>True if the policy was deleted</returns>
+        Task<bool> DeletePolicyAsync(Guid policyId, Guid accountId);
+
+        /// <summary>
+        /// Pays a transaction's fee from the first policy of the account that covers it
+        /// </summary>
+        /// <param name="accountId">Account that sent the transaction</param>
+        /// <param name="contractHash">Contract the transaction invoked</param>
+        /// <param name="method">Method the transaction invoked</param>
+        /// <param name="fee">Network fee of the transaction</param>
+        /// <param name="transactionHash">Transaction hash</param>
+        /// <returns>The policy that paid the fee, or null if no policy covers the transaction</returns>
+        Task<GasFeePolicy> SponsorAsync(Guid accountId, string contractHash, string method, decimal fee, string transactionHash);
+    }
+}
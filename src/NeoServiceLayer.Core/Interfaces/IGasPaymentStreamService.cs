@@ -0,0 +1,69 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the GasBank payment stream service
+    /// </summary>
+    public interface IGasPaymentStreamService
+    {
+        /// <summary>
+        /// Opens a payment stream, reserving its cap in the payer's GasBank account
+        /// </summary>
+        /// <param name="stream">Stream to open; the payer account must own the payer GasBank account</param>
+        /// <returns>The open stream</returns>
+        Task<GasPaymentStream> OpenAsync(GasPaymentStream stream);
+
+        /// <summary>
+        /// Gets a payment stream with the GAS accrued so far
+        /// </summary>
+        /// <param name="id">Stream ID</param>
+        /// <returns>The stream if found, null otherwise</returns>
+        Task<GasPaymentStream> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the payment streams an account pays or receives, with the GAS accrued so far
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>List of streams</returns>
+        Task<IEnumerable<GasPaymentStream>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Stops a payment stream and settles the GAS accrued so far
+        /// </summary>
+        /// <param name="id">Stream ID</param>
+        /// <param name="closedBy">Account closing the stream; must be its payer or payee</param>
+        /// <returns>The stream, settled unless the settlement transfer failed and will be retried</returns>
+        Task<GasPaymentStream> CloseAsync(Guid id, Guid closedBy);
+
+        /// <summary>
+        /// Gets the streams whose settlement was interrupted by a restart
+        /// </summary>
+        /// <returns>List of in-doubt streams</returns>
+        Task<IEnumerable<GasPaymentStream>> GetInDoubtAsync();
+
+        /// <summary>
+        /// Resolves an in-doubt stream after an operator has checked the chain for its settlement transfer
+        /// </summary>
+        /// <param name="id">Stream ID</param>
+        /// <param name="transactionHash">Hash of the settlement transfer if it reached the chain; null if it did not</param>
+        /// <param name="resolvedBy">User resolving the stream</param>
+        /// <returns>The stream, settled if the transfer was sent, otherwise closed and settled again on the next cycle</returns>
+        Task<GasPaymentStream> ResolveInDoubtAsync(Guid id, string transactionHash, string resolvedBy);
+
+        /// <summary>
+        /// Starts settling expired streams and retrying failed settlements on an interval
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StartAsync();
+
+        /// <summary>
+        /// Stops settling streams in the background
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StopAsync();
+    }
+}
@@ -0,0 +1,49 @@
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for tracking fee levels on Neo and external chains, to route operations to the cheapest chain
+    /// </summary>
+    public interface IGasPriceOracleService
+    {
+        /// <summary>
+        /// Reads the fee level of every chain now
+        /// </summary>
+        /// <returns>One fee level per chain; a chain that could not be read keeps its last level and sets its error</returns>
+        Task<IEnumerable<ChainGasPrice>> RefreshAsync();
+
+        /// <summary>
+        /// Gets the fee level of every chain, reading them first if they were never read
+        /// </summary>
+        /// <returns>One fee level per chain, by chain name</returns>
+        Task<IEnumerable<ChainGasPrice>> GetGasPricesAsync();
+
+        /// <summary>
+        /// Gets the fee levels already read, without reading any chain
+        /// </summary>
+        /// <returns>The fee levels that are not stale</returns>
+        IReadOnlyList<ChainGasPrice> GetCachedGasPrices();
+
+        /// <summary>
+        /// Gets the chain where a plain transfer costs the fewest USD
+        /// </summary>
+        /// <param name="chains">Chains to choose from, or null for every chain</param>
+        /// <returns>The cheapest chain's fee level, or null if no fresh fee level with a USD cost is known</returns>
+        Task<ChainGasPrice> GetCheapestChainAsync(IEnumerable<string> chains = null);
+
+        /// <summary>
+        /// Starts refreshing fee levels in the background, if enabled
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StartAsync();
+
+        /// <summary>
+        /// Stops refreshing fee levels
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StopAsync();
+    }
+}
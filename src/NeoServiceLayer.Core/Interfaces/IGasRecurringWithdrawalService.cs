@@ -0,0 +1,78 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the GasBank recurring withdrawal service
+    /// </summary>
+    public interface IGasRecurringWithdrawalService
+    {
+        /// <summary>
+        /// Creates a recurring withdrawal
+        /// </summary>
+        /// <param name="withdrawal">Recurring withdrawal; the account must own the GasBank account</param>
+        /// <returns>The active recurring withdrawal with its first occurrence scheduled</returns>
+        Task<GasRecurringWithdrawal> CreateAsync(GasRecurringWithdrawal withdrawal);
+
+        /// <summary>
+        /// Gets a recurring withdrawal
+        /// </summary>
+        /// <param name="id">Recurring withdrawal ID</param>
+        /// <returns>The recurring withdrawal if found, null otherwise</returns>
+        Task<GasRecurringWithdrawal> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the recurring withdrawals of an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>List of recurring withdrawals</returns>
+        Task<IEnumerable<GasRecurringWithdrawal>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Gets the occurrences of a recurring withdrawal, newest first
+        /// </summary>
+        /// <param name="id">Recurring withdrawal ID</param>
+        /// <param name="limit">Most occurrences to return</param>
+        /// <returns>List of occurrences</returns>
+        Task<IEnumerable<GasRecurringWithdrawalOccurrence>> GetOccurrencesAsync(Guid id, int limit = 50);
+
+        /// <summary>
+        /// Pauses a recurring withdrawal
+        /// </summary>
+        /// <param name="id">Recurring withdrawal ID</param>
+        /// <param name="accountId">Account pausing it; must own it</param>
+        /// <returns>The paused recurring withdrawal</returns>
+        Task<GasRecurringWithdrawal> PauseAsync(Guid id, Guid accountId);
+
+        /// <summary>
+        /// Resumes a paused recurring withdrawal from its next occurrence; occurrences missed while paused are not run
+        /// </summary>
+        /// <param name="id">Recurring withdrawal ID</param>
+        /// <param name="accountId">Account resuming it; must own it</param>
+        /// <returns>The active recurring withdrawal</returns>
+        Task<GasRecurringWithdrawal> ResumeAsync(Guid id, Guid accountId);
+
+        /// <summary>
+        /// Cancels a recurring withdrawal; it cannot be resumed
+        /// </summary>
+        /// <param name="id">Recurring withdrawal ID</param>
+        /// <param name="accountId">Account cancelling it; must own it</param>
+        /// <returns>The cancelled recurring withdrawal</returns>
+        Task<GasRecurringWithdrawal> CancelAsync(Guid id, Guid accountId);
+
+        /// <summary>
+        /// Starts running due occurrences and retrying failed ones on an interval
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StartAsync();
+
+        /// <summary>
+        /// Stops running occurrences in the background
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StopAsync();
+    }
+}
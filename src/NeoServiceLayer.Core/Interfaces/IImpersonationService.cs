@@ -0,0 +1,73 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the support impersonation service
+    /// </summary>
+    public interface IImpersonationService
+    {
+        /// <summary>
+        /// Records an account owner's consent for a support user to view the account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <param name="supportUserId">Support user ID</param>
+        /// <param name="reason">Why access is needed</param>
+        /// <param name="durationMinutes">How long the consent lasts; the configured default if null</param>
+        /// <returns>The grant</returns>
+        Task<ImpersonationGrant> GrantConsentAsync(Guid accountId, string supportUserId, string reason, int? durationMinutes = null);
+
+        /// <summary>
+        /// Approves access to an account without the owner's consent
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <param name="supportUserId">Support user ID</param>
+        /// <param name="reason">Why access is needed; required</param>
+        /// <param name="approvedBy">Administrator approving access, who must not be the support user</param>
+        /// <param name="durationMinutes">How long the approval lasts; the configured default if null</param>
+        /// <returns>The grant</returns>
+        Task<ImpersonationGrant> ApproveBreakGlassAsync(Guid accountId, string supportUserId, string reason, string approvedBy, int? durationMinutes = null);
+
+        /// <summary>
+        /// Revokes a grant
+        /// </summary>
+        /// <param name="grantId">Grant ID</param>
+        /// <param name="revokedBy">User revoking the grant</param>
+        /// <param name="accountId">The owner's account ID when the owner revokes; null for an administrator</param>
+        /// <returns>The revoked grant</returns>
+        Task<ImpersonationGrant> RevokeAsync(Guid grantId, string revokedBy, Guid? accountId = null);
+
+        /// <summary>
+        /// Gets the grant that currently lets a support user impersonate an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <param name="supportUserId">Support user ID</param>
+        /// <returns>The active grant, or null if there is none</returns>
+        Task<ImpersonationGrant> GetActiveGrantAsync(Guid accountId, string supportUserId);
+
+        /// <summary>
+        /// Gets the grants given for an account, newest first
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>The grants</returns>
+        Task<IEnumerable<ImpersonationGrant>> GetGrantsAsync(Guid accountId);
+
+        /// <summary>
+        /// Records a request made while impersonating an account
+        /// </summary>
+        /// <param name="entry">Audit entry</param>
+        /// <returns>The recorded entry</returns>
+        Task<ImpersonationAuditEntry> RecordAsync(ImpersonationAuditEntry entry);
+
+        /// <summary>
+        /// Gets the requests made while impersonating an account, newest first
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <param name="since">Only entries at or after this time, if set</param>
+        /// <returns>The audit entries</returns>
+        Task<IEnumerable<ImpersonationAuditEntry>> GetAuditAsync(Guid accountId, DateTime? since = null);
+    }
+}
@@ -0,0 +1,22 @@
+using System;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for importing Chainlink job specs as functions and triggers
+    /// </summary>
+    public interface IJobSpecImportService
+    {
+        /// <summary>
+        /// Converts a Chainlink cron, webhook or keeper job spec and creates its equivalent in an account
+        /// </summary>
+        /// <param name="accountId">Account the function and triggers are created in</param>
+        /// <param name="spec">Job spec in TOML</param>
+        /// <param name="options">Import options</param>
+        /// <returns>What the spec was converted into</returns>
+        /// <exception cref="Exceptions.ValidationException">The spec is malformed or its job type is not supported</exception>
+        Task<JobSpecImportResult> ImportAsync(Guid accountId, string spec, JobSpecImportOptions options);
+    }
+}
@@ -0,0 +1,66 @@
+using System;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for electing the active instance of the Functions/Trigger service through a lease
+    /// </summary>
+    public interface ILeadershipService
+    {
+        /// <summary>
+        /// Gets this instance's ID
+        /// </summary>
+        string InstanceId { get; }
+
+        /// <summary>
+        /// Gets a value indicating whether this instance holds an unexpired lease; always true when leadership is disabled
+        /// </summary>
+        bool IsLeader { get; }
+
+        /// <summary>
+        /// Raised when this instance acquires the lease, whether at startup or by taking it over from another instance
+        /// </summary>
+        event EventHandler LeadershipAcquired;
+
+        /// <summary>
+        /// Starts competing for the lease
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StartAsync();
+
+        /// <summary>
+        /// Stops renewing the lease and releases it if held, so a standby takes over without waiting for it to expire
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StopAsync();
+
+        /// <summary>
+        /// Gets a handoff value; on a standby this is the leader's value as of the last check
+        /// </summary>
+        /// <param name="key">Key</param>
+        /// <returns>The value, or null if not set</returns>
+        string GetState(string key);
+
+        /// <summary>
+        /// Sets a handoff value, stored with the lease on the next renewal
+        /// </summary>
+        /// <param name="key">Key</param>
+        /// <param name="value">Value</param>
+        void SetState(string key, string value);
+
+        /// <summary>
+        /// Gets the stored lease
+        /// </summary>
+        /// <returns>The lease, or null if no instance has acquired it</returns>
+        Task<ServiceLease> GetLeaseAsync();
+
+        /// <summary>
+        /// Releases the lease from its current holder and bars that holder from taking it back for the cooldown
+        /// </summary>
+        /// <param name="requestedBy">User forcing the failover</param>
+        /// <returns>The released lease</returns>
+        Task<ServiceLease> ForceFailoverAsync(string requestedBy);
+    }
+}
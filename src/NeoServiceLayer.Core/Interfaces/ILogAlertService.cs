@@ -0,0 +1,53 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for alerting on the service's own log stream
+    /// </summary>
+    public interface ILogAlertService
+    {
+        /// <summary>
+        /// Creates an alert rule
+        /// </summary>
+        /// <param name="rule">Rule to create</param>
+        /// <returns>The created rule</returns>
+        Task<LogAlertRule> CreateAlertRuleAsync(LogAlertRule rule);
+
+        /// <summary>
+        /// Gets an alert rule by ID
+        /// </summary>
+        /// <param name="id">Rule ID</param>
+        /// <returns>The rule if found, null otherwise</returns>
+        Task<LogAlertRule> GetAlertRuleAsync(Guid id);
+
+        /// <summary>
+        /// Gets all alert rules
+        /// </summary>
+        /// <returns>List of rules</returns>
+        Task<IEnumerable<LogAlertRule>> GetAlertRulesAsync();
+
+        /// <summary>
+        /// Updates an alert rule; its state and match counters are reset
+        /// </summary>
+        /// <param name="rule">Updated rule</param>
+        /// <returns>The updated rule</returns>
+        Task<LogAlertRule> UpdateAlertRuleAsync(LogAlertRule rule);
+
+        /// <summary>
+        /// Deletes an alert rule
+        /// </summary>
+        /// <param name="id">Rule ID</param>
+        /// <returns>True if the rule was deleted, false otherwise</returns>
+        Task<bool> DeleteAlertRuleAsync(Guid id);
+
+        /// <summary>
+        /// Matches the entries captured since the last evaluation against the enabled rules and dispatches alerts
+        /// </summary>
+        /// <returns>Number of notifications dispatched</returns>
+        Task<int> EvaluateAsync();
+    }
+}
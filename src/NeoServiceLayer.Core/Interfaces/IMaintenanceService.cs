@@ -0,0 +1,73 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the maintenance mode service
+    /// </summary>
+    public interface IMaintenanceService
+    {
+        /// <summary>
+        /// Checks if maintenance mode is active
+        /// </summary>
+        /// <returns>True if maintenance mode is active, false otherwise</returns>
+        Task<bool> IsActiveAsync();
+
+        /// <summary>
+        /// Gets the current maintenance status
+        /// </summary>
+        /// <returns>The maintenance status</returns>
+        Task<MaintenanceStatus> GetStatusAsync();
+
+        /// <summary>
+        /// Switches maintenance mode on immediately
+        /// </summary>
+        /// <param name="reason">Reason shown to users</param>
+        /// <param name="enabledBy">User switching maintenance on</param>
+        /// <param name="expectedEndTime">When maintenance is expected to end; maintenance ends automatically at this time if set</param>
+        /// <returns>The maintenance status</returns>
+        Task<MaintenanceStatus> EnableAsync(string reason, string enabledBy, DateTime? expectedEndTime = null);
+
+        /// <summary>
+        /// Switches maintenance mode off immediately, ending every active window
+        /// </summary>
+        /// <param name="disabledBy">User switching maintenance off</param>
+        /// <returns>The maintenance status</returns>
+        Task<MaintenanceStatus> DisableAsync(string disabledBy);
+
+        /// <summary>
+        /// Schedules a maintenance window
+        /// </summary>
+        /// <param name="startTime">Start time</param>
+        /// <param name="endTime">End time</param>
+        /// <param name="reason">Reason shown to users</param>
+        /// <param name="createdBy">User scheduling the window</param>
+        /// <returns>The scheduled window</returns>
+        Task<MaintenanceWindow> ScheduleAsync(DateTime startTime, DateTime endTime, string reason, string createdBy);
+
+        /// <summary>
+        /// Gets the maintenance windows that are active or scheduled
+        /// </summary>
+        /// <returns>List of windows</returns>
+        Task<IEnumerable<MaintenanceWindow>> GetWindowsAsync();
+
+        /// <summary>
+        /// Cancels a maintenance window
+        /// </summary>
+        /// <param name="id">Window ID</param>
+        /// <returns>True if the window was cancelled, false if it was not found</returns>
+        Task<bool> CancelWindowAsync(Guid id);
+
+        /// <summary>
+        /// Queues a function invocation to run once maintenance ends
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="accountId">Account requesting the invocation</param>
+        /// <param name="parameters">Invocation parameters</param>
+        /// <returns>The queued invocation</returns>
+        Task<QueuedInvocation> EnqueueInvocationAsync(Guid functionId, Guid accountId, Dictionary<string, object> parameters);
+    }
+}
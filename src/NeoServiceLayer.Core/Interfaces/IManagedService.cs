@@ -0,0 +1,40 @@
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for a background service the service runner starts and stops with the process
+    /// </summary>
+    public interface IManagedService
+    {
+        /// <summary>
+        /// Gets the service name, unique among managed services
+        /// </summary>
+        string Name { get; }
+
+        /// <summary>
+        /// Gets the names of the services this one relies on; they start before it and stop after it
+        /// </summary>
+        IReadOnlyCollection<string> DependsOn { get; }
+
+        /// <summary>
+        /// Starts the service's background work; does nothing if it is running or disabled by configuration
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StartAsync();
+
+        /// <summary>
+        /// Stops the service's background work and waits for work in progress to finish
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StopAsync();
+
+        /// <summary>
+        /// Gets whether the service's background work is running
+        /// </summary>
+        /// <returns>The service health</returns>
+        ManagedServiceHealth GetHealth();
+    }
+}
@@ -0,0 +1,37 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for durable metric storage with downsampling
+    /// </summary>
+    public interface IMetricStore
+    {
+        /// <summary>
+        /// Adds a sample to its minute bucket
+        /// </summary>
+        /// <param name="name">Metric name</param>
+        /// <param name="value">Sample value</param>
+        /// <param name="tags">Tags identifying the series</param>
+        /// <param name="timestamp">Sample time (UTC)</param>
+        /// <returns>Task representing the asynchronous operation</returns>
+        Task RecordAsync(string name, double value, IDictionary<string, string> tags, DateTime timestamp);
+
+        /// <summary>
+        /// Reads the buckets of every series matching a query
+        /// </summary>
+        /// <param name="query">Query</param>
+        /// <returns>Buckets ordered by series, then time</returns>
+        Task<IReadOnlyList<MetricBucket>> QueryAsync(MetricQuery query);
+
+        /// <summary>
+        /// Rolls completed minute buckets up into hours and completed hours into days, then deletes buckets past their retention
+        /// </summary>
+        /// <param name="now">Current time (UTC)</param>
+        /// <returns>Number of buckets written by the rollup</returns>
+        Task<int> RollupAsync(DateTime now);
+    }
+}
@@ -1,6 +1,7 @@
 using System;
 using System.Collections.Generic;
 using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
 
 namespace NeoServiceLayer.Core.Interfaces
 {
@@ -95,6 +96,13 @@ namespace NeoServiceLayer.Core.Interfaces
         /// <returns>List of custom metrics</returns>
         Task<IEnumerable<object>> GetCustomMetricsAsync(string name, DateTime startTime, DateTime endTime, Dictionary<string, string> tags = null);
 
+        /// <summary>
+        /// Queries stored metric buckets, as used by the admin dashboard
+        /// </summary>
+        /// <param name="query">Query</param>
+        /// <returns>Buckets ordered by series, then time</returns>
+        Task<IReadOnlyList<MetricBucket>> QueryMetricsAsync(MetricQuery query);
+
         /// <summary>
         /// Gets system metrics
         /// </summary>
@@ -138,5 +146,46 @@ namespace NeoServiceLayer.Core.Interfaces
         /// <param name="name">Alert name</param>
         /// <returns>The alert if found, null otherwise</returns>
         Task<object> GetAlertAsync(string name);
+
+        /// <summary>
+        /// Creates a user-defined alert rule
+        /// </summary>
+        /// <param name="rule">Rule to create</param>
+        /// <returns>The created rule</returns>
+        Task<MetricAlertRule> CreateAlertRuleAsync(MetricAlertRule rule);
+
+        /// <summary>
+        /// Gets a user-defined alert rule
+        /// </summary>
+        /// <param name="id">Rule ID</param>
+        /// <returns>The rule if found, null otherwise</returns>
+        Task<MetricAlertRule> GetAlertRuleAsync(Guid id);
+
+        /// <summary>
+        /// Gets the user-defined alert rules of an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>List of rules</returns>
+        Task<IEnumerable<MetricAlertRule>> GetAlertRulesByAccountAsync(Guid accountId);
+
+        /// <summary>
+        /// Updates a user-defined alert rule
+        /// </summary>
+        /// <param name="rule">Rule to update</param>
+        /// <returns>The updated rule</returns>
+        Task<MetricAlertRule> UpdateAlertRuleAsync(MetricAlertRule rule);
+
+        /// <summary>
+        /// Deletes a user-defined alert rule
+        /// </summary>
+        /// <param name="id">Rule ID</param>
+        /// <returns>True if the rule was deleted, false otherwise</returns>
+        Task<bool> DeleteAlertRuleAsync(Guid id);
+
+        /// <summary>
+        /// Evaluates all enabled alert rules and dispatches notifications for rules that fire or resolve
+        /// </summary>
+        /// <returns>Number of rules evaluated</returns>
+        Task<int> EvaluateAlertRulesAsync();
     }
 }
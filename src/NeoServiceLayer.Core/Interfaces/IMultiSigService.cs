@@ -0,0 +1,95 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for contract invocations sent from m-of-n multi-signature accounts
+    /// </summary>
+    public interface IMultiSigService
+    {
+        /// <summary>
+        /// Proposes a contract invocation from the multi-signature account of the given keys and threshold
+        /// </summary>
+        /// <param name="accountId">ID of the proposing account</param>
+        /// <param name="threshold">Number of signatures required</param>
+        /// <param name="publicKeys">Compressed public keys of the signers, in any order</param>
+        /// <param name="contractHash">Contract script hash</param>
+        /// <param name="operation">Method name</param>
+        /// <param name="args">Method arguments</param>
+        /// <param name="systemFeeLimit">Most GAS the invocation may consume</param>
+        /// <param name="lifetime">How long signatures are collected; a day when omitted</param>
+        /// <returns>The pending transaction</returns>
+        /// <exception cref="Exceptions.ValidationException">The keys, threshold, invocation or lifetime are invalid</exception>
+        Task<MultiSigTransaction> ProposeAsync(
+            Guid accountId,
+            int threshold,
+            IEnumerable<string> publicKeys,
+            string contractHash,
+            string operation,
+            IEnumerable<NeoContractParameter> args,
+            decimal systemFeeLimit,
+            TimeSpan? lifetime = null);
+
+        /// <summary>
+        /// Signs a pending transaction with a signer's wallet, and sends it once the threshold is reached
+        /// </summary>
+        /// <param name="id">Transaction ID</param>
+        /// <param name="walletId">ID of a wallet holding one of the signers' keys</param>
+        /// <param name="password">Wallet password</param>
+        /// <returns>The transaction, sent if this signature reached the threshold</returns>
+        /// <exception cref="Exceptions.ResourceNotFoundException">The transaction or wallet does not exist</exception>
+        /// <exception cref="Exceptions.ValidationException">The transaction is not pending, or the wallet is not a signer or already signed</exception>
+        Task<MultiSigTransaction> SignAsync(Guid id, Guid walletId, string password);
+
+        /// <summary>
+        /// Retries sending a transaction that reached its threshold but failed to send
+        /// </summary>
+        /// <param name="id">Transaction ID</param>
+        /// <returns>The transaction</returns>
+        /// <exception cref="Exceptions.ResourceNotFoundException">The transaction does not exist</exception>
+        /// <exception cref="Exceptions.ValidationException">The transaction has not failed to send, or has expired</exception>
+        Task<MultiSigTransaction> RetrySendAsync(Guid id);
+
+        /// <summary>
+        /// Withdraws a pending transaction
+        /// </summary>
+        /// <param name="id">Transaction ID</param>
+        /// <returns>The cancelled transaction</returns>
+        /// <exception cref="Exceptions.ResourceNotFoundException">The transaction does not exist</exception>
+        /// <exception cref="Exceptions.ValidationException">The transaction was already sent or closed</exception>
+        Task<MultiSigTransaction> CancelAsync(Guid id);
+
+        /// <summary>
+        /// Gets a transaction by ID
+        /// </summary>
+        /// <param name="id">Transaction ID</param>
+        /// <returns>The transaction if found, null otherwise</returns>
+        Task<MultiSigTransaction> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the transactions an account proposed, newest first
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>The transactions</returns>
+        Task<IEnumerable<MultiSigTransaction>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Gets the pending transactions an account's wallets can sign and have not signed yet, oldest first
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>The transactions</returns>
+        Task<IEnumerable<MultiSigTransaction>> GetAwaitingSignatureAsync(Guid accountId);
+
+        /// <summary>
+        /// Checks whether an account proposed a transaction or holds one of its signers' keys
+        /// </summary>
+        /// <param name="transaction">Transaction</param>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>True if the account takes part in the transaction, false otherwise</returns>
+        Task<bool> IsParticipantAsync(MultiSigTransaction transaction, Guid accountId);
+    }
+}
@@ -0,0 +1,48 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the store of multi-signature transactions awaiting signatures
+    /// </summary>
+    public interface IMultiSigTransactionRepository
+    {
+        /// <summary>
+        /// Stores a new transaction
+        /// </summary>
+        /// <param name="transaction">Transaction</param>
+        /// <returns>The stored transaction</returns>
+        Task<MultiSigTransaction> CreateAsync(MultiSigTransaction transaction);
+
+        /// <summary>
+        /// Gets a transaction by ID
+        /// </summary>
+        /// <param name="id">Transaction ID</param>
+        /// <returns>The transaction if found, null otherwise</returns>
+        Task<MultiSigTransaction> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the transactions an account proposed, newest first
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>The transactions</returns>
+        Task<IEnumerable<MultiSigTransaction>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Gets the pending transactions one of the given public keys may sign, oldest first
+        /// </summary>
+        /// <param name="publicKeys">Public keys in lowercase hex</param>
+        /// <returns>The transactions</returns>
+        Task<IEnumerable<MultiSigTransaction>> GetPendingByPublicKeysAsync(IEnumerable<string> publicKeys);
+
+        /// <summary>
+        /// Updates a transaction
+        /// </summary>
+        /// <param name="transaction">Transaction</param>
+        /// <returns>The updated transaction</returns>
+        Task<MultiSigTransaction> UpdateAsync(MultiSigTransaction transaction);
+    }
+}
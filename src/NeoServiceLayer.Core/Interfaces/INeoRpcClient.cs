@@ -0,0 +1,142 @@
+using System.Collections.Generic;
+using System.Numerics;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models.Blockchain;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the Neo N3 JSON-RPC client
+    /// </summary>
+    public interface INeoRpcClient
+    {
+        /// <summary>
+        /// Gets the current block count
+        /// </summary>
+        /// <returns>The number of blocks in the chain</returns>
+        Task<long> GetBlockCountAsync();
+
+        /// <summary>
+        /// Gets the state root of a block using the state service
+        /// </summary>
+        /// <param name="blockIndex">Block index</param>
+        /// <returns>The state root</returns>
+        Task<NeoStateRoot> GetStateRootAsync(long blockIndex);
+
+        /// <summary>
+        /// Gets a persisted block
+        /// </summary>
+        /// <param name="blockIndex">Block index</param>
+        /// <returns>The block, with the hashes of its transactions</returns>
+        Task<NeoBlock> GetBlockAsync(long blockIndex);
+
+        /// <summary>
+        /// Invokes a contract method against the latest state without persisting anything
+        /// </summary>
+        /// <param name="scriptHash">Contract script hash</param>
+        /// <param name="operation">Method name</param>
+        /// <param name="args">Method arguments</param>
+        /// <returns>The invocation result</returns>
+        Task<NeoInvokeResult> InvokeFunctionAsync(string scriptHash, string operation, IEnumerable<NeoContractParameter> args = null);
+
+        /// <summary>
+        /// Invokes a contract method against the state of a historical block without persisting anything
+        /// </summary>
+        /// <param name="blockIndex">Block index whose state the invocation is evaluated against</param>
+        /// <param name="scriptHash">Contract script hash</param>
+        /// <param name="operation">Method name</param>
+        /// <param name="args">Method arguments</param>
+        /// <returns>The invocation result, including the state root it was evaluated against</returns>
+        Task<NeoInvokeResult> InvokeFunctionAtAsync(long blockIndex, string scriptHash, string operation, IEnumerable<NeoContractParameter> args = null);
+
+        /// <summary>
+        /// Invokes several contract methods against the latest state in one HTTP round trip
+        /// </summary>
+        /// <param name="invocations">Calls to make</param>
+        /// <returns>The invocation results, in the order of the calls</returns>
+        Task<IReadOnlyList<NeoInvokeResult>> InvokeFunctionBatchAsync(IEnumerable<NeoInvocation> invocations);
+
+        /// <summary>
+        /// Gets the application log of a persisted transaction
+        /// </summary>
+        /// <param name="txHash">Transaction hash</param>
+        /// <returns>The application log</returns>
+        /// <remarks>Requires the node to run the ApplicationLogs plugin.</remarks>
+        Task<NeoApplicationLog> GetApplicationLogAsync(string txHash);
+
+        /// <summary>
+        /// Gets the application logs of several persisted transactions in one HTTP round trip
+        /// </summary>
+        /// <param name="txHashes">Transaction hashes</param>
+        /// <returns>The application logs, in the order of the hashes; null for a transaction the node does not know</returns>
+        /// <remarks>Requires the node to run the ApplicationLogs plugin.</remarks>
+        Task<IReadOnlyList<NeoApplicationLog>> GetApplicationLogsAsync(IEnumerable<string> txHashes);
+
+        /// <summary>
+        /// Gets the indexes of the blocks that persisted several transactions in one HTTP round trip
+        /// </summary>
+        /// <param name="txHashes">Transaction hashes</param>
+        /// <returns>The block indexes, in the order of the hashes; null for a transaction the node does not know</returns>
+        /// <remarks>A transaction in block <c>h</c> has <c>blockCount - h</c> confirmations.</remarks>
+        Task<IReadOnlyList<long?>> GetTransactionHeightsAsync(IEnumerable<string> txHashes);
+
+        /// <summary>
+        /// Gets the NEP-17 balance of an address at the latest state
+        /// </summary>
+        /// <remarks>
+        /// Balances may be served from a short-lived cache; see <see cref="InvalidateBalance"/>.
+        /// </remarks>
+        /// <param name="address">Neo address</param>
+        /// <param name="assetHash">NEP-17 token script hash</param>
+        /// <returns>The balance in the token's smallest unit</returns>
+        Task<BigInteger> GetBalanceAsync(string address, string assetHash);
+
+        /// <summary>
+        /// Gets the NEP-17 balance of an address at a historical block
+        /// </summary>
+        /// <param name="blockIndex">Block index</param>
+        /// <param name="address">Neo address</param>
+        /// <param name="assetHash">NEP-17 token script hash</param>
+        /// <returns>The balance in the token's smallest unit</returns>
+        Task<BigInteger> GetBalanceAtAsync(long blockIndex, string address, string assetHash);
+
+        /// <summary>
+        /// Gets the owner of a NEP-11 token
+        /// </summary>
+        /// <param name="contractHash">NEP-11 contract script hash</param>
+        /// <param name="tokenId">Token ID in hex</param>
+        /// <returns>The owner's script hash</returns>
+        Task<string> GetNep11OwnerAsync(string contractHash, string tokenId);
+
+        /// <summary>
+        /// Gets the NEP-11 tokens owned by an address
+        /// </summary>
+        /// <param name="contractHash">NEP-11 contract script hash</param>
+        /// <param name="address">Neo address</param>
+        /// <param name="maxItems">Maximum number of token IDs to return</param>
+        /// <returns>The token IDs in hex</returns>
+        /// <remarks>Requires the node to have RPC sessions enabled to traverse the returned iterator.</remarks>
+        Task<IReadOnlyList<string>> GetNep11TokensOfAsync(string contractHash, string address, int maxItems = 100);
+
+        /// <summary>
+        /// Gets the properties of a NEP-11 token
+        /// </summary>
+        /// <param name="contractHash">NEP-11 contract script hash</param>
+        /// <param name="tokenId">Token ID in hex</param>
+        /// <returns>The token properties, e.g. name, description and image</returns>
+        Task<IDictionary<string, string>> GetNep11PropertiesAsync(string contractHash, string tokenId);
+
+        /// <summary>
+        /// Drops cached balances of a script hash, typically after a transfer involving it was observed
+        /// </summary>
+        /// <param name="scriptHash">Script hash of the account</param>
+        /// <param name="assetHash">NEP-17 token script hash, or null to drop the balances of all tokens</param>
+        void InvalidateBalance(string scriptHash, string assetHash = null);
+
+        /// <summary>
+        /// Gets the health and call statistics of the nodes calls are spread across
+        /// </summary>
+        /// <returns>The node statuses</returns>
+        IReadOnlyList<NeoRpcNodeStatus> GetNodeStatuses();
+    }
+}
@@ -116,5 +116,18 @@ namespace NeoServiceLayer.Core.Interfaces
         bool ValidateWebhookUrl(string webhookUrl);
     }
 
+    /// <summary>
+    /// Interface for Telegram notification provider
+    /// </summary>
+    public interface ITelegramNotificationProvider : INotificationProvider
+    {
+        /// <summary>
+        /// Validates a Telegram chat ID
+        /// </summary>
+        /// <param name="chatId">Numeric chat ID or @channel username</param>
+        /// <returns>True if the chat ID is valid, false otherwise</returns>
+        bool ValidateChatId(string chatId);
+    }
+
 
 }
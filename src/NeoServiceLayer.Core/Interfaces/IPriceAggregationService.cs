@@ -0,0 +1,44 @@
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for aggregating prices from the configured price sources
+    /// </summary>
+    public interface IPriceAggregationService
+    {
+        /// <summary>
+        /// Fetches a price from every active source that supports the symbol, rejects outliers and stale prices,
+        /// and takes the median of the rest
+        /// </summary>
+        /// <param name="symbol">Symbol</param>
+        /// <param name="baseCurrency">Base currency</param>
+        /// <returns>The aggregation; its price is null if fewer than the minimum number of sources agreed</returns>
+        Task<PriceAggregation> AggregateAsync(string symbol, string baseCurrency = "USD");
+
+        /// <summary>
+        /// Aggregates a price, stores it and publishes it to the oracle contract
+        /// </summary>
+        /// <param name="symbol">Symbol</param>
+        /// <param name="baseCurrency">Base currency</param>
+        /// <returns>The aggregation with the publishing transaction hash</returns>
+        /// <exception cref="Exceptions.PriceFeedException">Too few sources agreed; nothing is published</exception>
+        Task<PriceAggregation> AggregateAndPublishAsync(string symbol, string baseCurrency = "USD");
+
+        /// <summary>
+        /// Stores an aggregated price, publishes it to the oracle contract and records it as the pair's last publication
+        /// </summary>
+        /// <param name="aggregation">Aggregation with a price</param>
+        /// <param name="reason">Why the price is published</param>
+        /// <returns>The aggregation with the publishing transaction hash</returns>
+        Task<PriceAggregation> PublishAsync(PriceAggregation aggregation, PricePublicationReason reason);
+
+        /// <summary>
+        /// Gets the health of every source that has been fetched
+        /// </summary>
+        /// <returns>Source health, by source name</returns>
+        Task<IEnumerable<PriceSourceHealth>> GetSourceHealthAsync();
+    }
+}
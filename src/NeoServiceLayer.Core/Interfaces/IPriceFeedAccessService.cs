@@ -0,0 +1,56 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for checking which price feed symbols an account's subscription tier can read
+    /// </summary>
+    public interface IPriceFeedAccessService
+    {
+        /// <summary>
+        /// Gets the configured subscription tiers, from lowest to highest
+        /// </summary>
+        /// <returns>Subscription tiers</returns>
+        IReadOnlyList<PriceFeedTier> GetTiers();
+
+        /// <summary>
+        /// Gets the lowest tier that can read a symbol
+        /// </summary>
+        /// <param name="symbol">Symbol</param>
+        /// <returns>Tier name, or null if every account can read the symbol</returns>
+        string GetRequiredTier(string symbol);
+
+        /// <summary>
+        /// Gets the subscription tier of an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>Tier name</returns>
+        Task<string> GetTierAsync(Guid accountId);
+
+        /// <summary>
+        /// Checks if an account can read a symbol
+        /// </summary>
+        /// <param name="accountId">Account ID, or null for anonymous callers</param>
+        /// <param name="symbol">Symbol</param>
+        /// <returns>True if the account's tier includes the symbol, false otherwise</returns>
+        Task<bool> CanAccessAsync(Guid? accountId, string symbol);
+
+        /// <summary>
+        /// Gets the premium symbols an account cannot read
+        /// </summary>
+        /// <param name="accountId">Account ID, or null for anonymous callers</param>
+        /// <returns>Symbols above the account's tier</returns>
+        Task<IReadOnlyCollection<string>> GetDeniedSymbolsAsync(Guid? accountId);
+
+        /// <summary>
+        /// Moves an account to another tier, charging the tier price to the account's credits
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <param name="tier">Tier name</param>
+        /// <returns>The updated account</returns>
+        Task<Account> ChangeTierAsync(Guid accountId, string tier);
+    }
+}
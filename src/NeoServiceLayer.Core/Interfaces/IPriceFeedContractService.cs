@@ -0,0 +1,31 @@
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for deploying and updating the on-chain PriceFeed contract
+    /// </summary>
+    public interface IPriceFeedContractService
+    {
+        /// <summary>
+        /// Loads and validates the configured NEF and manifest
+        /// </summary>
+        /// <returns>The contract artifacts</returns>
+        Task<PriceFeedContractArtifacts> LoadArtifactsAsync();
+
+        /// <summary>
+        /// Deploys the contract through ContractManagement, signed by the given enclave wallet
+        /// </summary>
+        /// <param name="request">Wallet to sign with</param>
+        /// <returns>The submitted deployment</returns>
+        Task<PriceFeedContractDeployment> DeployAsync(PriceFeedContractDeploymentRequest request);
+
+        /// <summary>
+        /// Updates the configured contract in place through its update method, signed by the given enclave wallet
+        /// </summary>
+        /// <param name="request">Wallet to sign with; must be the contract owner</param>
+        /// <returns>The submitted update</returns>
+        Task<PriceFeedContractDeployment> UpdateAsync(PriceFeedContractDeploymentRequest request);
+    }
+}
@@ -0,0 +1,20 @@
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for comparing on-chain PriceFeed rounds against the service's price history
+    /// </summary>
+    public interface IPriceFeedRoundAuditor
+    {
+        /// <summary>
+        /// Audits the most recent rounds of a pair
+        /// </summary>
+        /// <param name="symbol">Asset symbol</param>
+        /// <param name="baseCurrency">Base currency</param>
+        /// <param name="rounds">Number of rounds to audit, or null for the configured default</param>
+        /// <returns>The audit report</returns>
+        Task<PriceFeedRoundAuditReport> AuditAsync(string symbol, string baseCurrency, int? rounds = null);
+    }
+}
@@ -0,0 +1,45 @@
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for publishing aggregated prices on chain when they deviate or a heartbeat elapses
+    /// </summary>
+    public interface IPricePublicationService
+    {
+        /// <summary>
+        /// Aggregates a pair's price and publishes it if it deviates more than the threshold from the last
+        /// published price, the heartbeat has elapsed, or nothing has been published yet
+        /// </summary>
+        /// <param name="symbol">Symbol</param>
+        /// <param name="baseCurrency">Base currency</param>
+        /// <returns>What was decided and why</returns>
+        Task<PricePublicationResult> PublishIfDueAsync(string symbol, string baseCurrency = "USD");
+
+        /// <summary>
+        /// Checks every configured pair
+        /// </summary>
+        /// <returns>One result per pair</returns>
+        Task<IEnumerable<PricePublicationResult>> PublishDueAsync();
+
+        /// <summary>
+        /// Gets the last publication of every pair
+        /// </summary>
+        /// <returns>List of publications</returns>
+        Task<IEnumerable<PricePublication>> GetPublicationsAsync();
+
+        /// <summary>
+        /// Starts checking the configured pairs in the background, if enabled
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StartAsync();
+
+        /// <summary>
+        /// Stops checking the configured pairs
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StopAsync();
+    }
+}
@@ -0,0 +1,23 @@
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for describing the code that is serving requests
+    /// </summary>
+    public interface IProvenanceService
+    {
+        /// <summary>
+        /// Gets the build metadata of the running server
+        /// </summary>
+        /// <returns>The build metadata</returns>
+        BuildProvenance GetBuildProvenance();
+
+        /// <summary>
+        /// Gets a provenance statement, bound to the enclave measurement when running in a TEE
+        /// </summary>
+        /// <returns>The provenance statement</returns>
+        Task<ProvenanceStatement> GetStatementAsync();
+    }
+}
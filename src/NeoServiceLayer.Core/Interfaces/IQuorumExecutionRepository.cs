@@ -0,0 +1,34 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the repository of quorum execution records
+    /// </summary>
+    public interface IQuorumExecutionRepository
+    {
+        /// <summary>
+        /// Stores a quorum execution
+        /// </summary>
+        /// <param name="execution">Quorum execution to store</param>
+        /// <returns>The stored quorum execution</returns>
+        Task<QuorumExecution> CreateAsync(QuorumExecution execution);
+
+        /// <summary>
+        /// Gets the quorum execution of a function execution
+        /// </summary>
+        /// <param name="executionId">Execution ID</param>
+        /// <returns>The quorum execution, or null if the execution did not run under a quorum</returns>
+        Task<QuorumExecution> GetByExecutionIdAsync(Guid executionId);
+
+        /// <summary>
+        /// Gets the quorum executions of a function
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <returns>The function's quorum executions</returns>
+        Task<IEnumerable<QuorumExecution>> GetByFunctionIdAsync(Guid functionId);
+    }
+}
@@ -0,0 +1,53 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for running critical functions on several independent nodes and acting only on agreeing results
+    /// </summary>
+    public interface IQuorumExecutionService
+    {
+        /// <summary>
+        /// Runs an execution on the nodes the function's quorum policy asks for and compares their results
+        /// </summary>
+        /// <param name="function">Function with a quorum policy</param>
+        /// <param name="executionId">Execution ID</param>
+        /// <param name="operation">Enclave operation, e.g. "executeFunction"</param>
+        /// <param name="executeRequest">Request passed to each node's enclave</param>
+        /// <returns>The recorded quorum execution; <see cref="QuorumExecution.Agreed"/> is false when the quorum was not reached</returns>
+        Task<QuorumExecution> ExecuteAsync(Function function, Guid executionId, string operation, object executeRequest);
+
+        /// <summary>
+        /// Runs a peer's quorum execution on the local enclave
+        /// </summary>
+        /// <param name="request">Request from the peer</param>
+        /// <returns>The local enclave's result</returns>
+        Task<QuorumNodeResult> ExecuteOnLocalNodeAsync(QuorumNodeRequest request);
+
+        /// <summary>
+        /// Sets or removes the quorum policy of a function
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="policy">Quorum policy, or null to run the function on the local enclave only</param>
+        /// <returns>The updated function</returns>
+        /// <exception cref="Exceptions.ValidationException">The policy asks for more nodes than are configured or its threshold is not a majority</exception>
+        Task<Function> SetPolicyAsync(Guid functionId, QuorumPolicy policy);
+
+        /// <summary>
+        /// Gets the quorum executions of a function, newest first
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <returns>The function's quorum executions</returns>
+        Task<IEnumerable<QuorumExecution>> GetExecutionsAsync(Guid functionId);
+
+        /// <summary>
+        /// Gets the quorum execution of a function execution
+        /// </summary>
+        /// <param name="executionId">Execution ID</param>
+        /// <returns>The quorum execution, or null if the execution did not run under a quorum</returns>
+        Task<QuorumExecution> GetByExecutionIdAsync(Guid executionId);
+    }
+}
@@ -0,0 +1,17 @@
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for rewriting stored records at their current schema version
+    /// </summary>
+    public interface IRecordMigrationService
+    {
+        /// <summary>
+        /// Runs each registered upgrader over its whole collection; a failing upgrader is reported and does not stop the others
+        /// </summary>
+        /// <returns>The migration report</returns>
+        Task<RecordMigrationReport> MigrateAsync();
+    }
+}
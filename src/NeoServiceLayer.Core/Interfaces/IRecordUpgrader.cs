@@ -0,0 +1,41 @@
+using System;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for upgrading stored records of one <see cref="IVersionedRecord"/> type to its current schema
+    /// </summary>
+    public interface IRecordUpgrader
+    {
+        /// <summary>
+        /// Gets the record type
+        /// </summary>
+        Type RecordType { get; }
+
+        /// <summary>
+        /// Gets the collection the records are stored in
+        /// </summary>
+        string Collection { get; }
+
+        /// <summary>
+        /// Gets the current schema version
+        /// </summary>
+        int CurrentVersion { get; }
+
+        /// <summary>
+        /// Upgrades a record in place; records at or above the current version are left alone
+        /// </summary>
+        /// <param name="record">Record read from storage</param>
+        /// <returns>True if the record was upgraded, false otherwise</returns>
+        bool Upgrade(object record);
+
+        /// <summary>
+        /// Upgrades every stored record below the current version and writes it back
+        /// </summary>
+        /// <param name="storageProvider">Storage provider that returns records as stored, without upgrading them</param>
+        /// <returns>What was scanned and upgraded</returns>
+        Task<RecordMigrationResult> MigrateAsync(IStorageProvider storageProvider);
+    }
+}
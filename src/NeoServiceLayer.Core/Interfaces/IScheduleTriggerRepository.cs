@@ -0,0 +1,61 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for schedule trigger repository
+    /// </summary>
+    public interface IScheduleTriggerRepository
+    {
+        /// <summary>
+        /// Creates a new schedule trigger
+        /// </summary>
+        /// <param name="trigger">Schedule trigger to create</param>
+        /// <returns>The created schedule trigger</returns>
+        Task<ScheduleTrigger> CreateAsync(ScheduleTrigger trigger);
+
+        /// <summary>
+        /// Updates a schedule trigger
+        /// </summary>
+        /// <param name="trigger">Schedule trigger to update</param>
+        /// <returns>The updated schedule trigger</returns>
+        Task<ScheduleTrigger> UpdateAsync(ScheduleTrigger trigger);
+
+        /// <summary>
+        /// Gets a schedule trigger by ID
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>The schedule trigger if found, null otherwise</returns>
+        Task<ScheduleTrigger> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the schedule triggers of an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>The account's schedule triggers</returns>
+        Task<IEnumerable<ScheduleTrigger>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Gets every schedule trigger
+        /// </summary>
+        /// <returns>The triggers of all accounts</returns>
+        Task<IEnumerable<ScheduleTrigger>> GetAllAsync();
+
+        /// <summary>
+        /// Gets the enabled schedule triggers due at a given time
+        /// </summary>
+        /// <param name="now">Current time</param>
+        /// <returns>Triggers whose next fire time is at or before <paramref name="now"/></returns>
+        Task<IEnumerable<ScheduleTrigger>> GetDueAsync(DateTime now);
+
+        /// <summary>
+        /// Deletes a schedule trigger
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>True if the trigger was deleted</returns>
+        Task<bool> DeleteAsync(Guid id);
+    }
+}
@@ -0,0 +1,101 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for triggers that run functions on a cron schedule
+    /// </summary>
+    public interface IScheduleTriggerService
+    {
+        /// <summary>
+        /// Creates a schedule trigger
+        /// </summary>
+        /// <param name="trigger">Trigger definition; AccountId must be set</param>
+        /// <returns>The created trigger with its first fire time</returns>
+        Task<ScheduleTrigger> CreateAsync(ScheduleTrigger trigger);
+
+        /// <summary>
+        /// Updates a schedule trigger; a changed schedule is fired from the next matching time
+        /// </summary>
+        /// <param name="trigger">Trigger definition, carrying the revision it was read at</param>
+        /// <returns>The updated trigger, at the next revision</returns>
+        /// <exception cref="Exceptions.ConcurrencyConflictException">The trigger has been updated since that revision</exception>
+        Task<ScheduleTrigger> UpdateAsync(ScheduleTrigger trigger);
+
+        /// <summary>
+        /// Gets a schedule trigger
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>The trigger if found, null otherwise</returns>
+        Task<ScheduleTrigger> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the schedule triggers of an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>The account's triggers</returns>
+        Task<IEnumerable<ScheduleTrigger>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Deletes a schedule trigger
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>True if the trigger was deleted</returns>
+        Task<bool> DeleteAsync(Guid id);
+
+        /// <summary>
+        /// Gets how late recent runs on this instance were compared with their fire times
+        /// </summary>
+        /// <returns>Lateness statistics</returns>
+        Task<SchedulerLateness> GetLatenessAsync();
+
+        /// <summary>
+        /// Gets a page of a trigger's firing history, newest first
+        /// </summary>
+        /// <param name="query">Page request</param>
+        /// <returns>The page</returns>
+        Task<TriggerFiringPage> GetFiringsAsync(TriggerFiringQuery query);
+
+        /// <summary>
+        /// Gets the outcome counts of all of a trigger's firings, including those pruned from the history
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>The statistics</returns>
+        Task<TriggerFiringStatistics> GetFiringStatisticsAsync(Guid id);
+
+        /// <summary>
+        /// Gets the scheduler's decisions in a time window from the replay log, in the order they were made
+        /// </summary>
+        /// <param name="from">Start of the window, inclusive</param>
+        /// <param name="to">End of the window, exclusive</param>
+        /// <param name="triggerId">Trigger the decisions must be about, or null for every trigger</param>
+        /// <returns>The decisions</returns>
+        /// <exception cref="Exceptions.ValidationException">The window is empty, or the replay log is disabled</exception>
+        Task<IEnumerable<SchedulerDecision>> GetDecisionsAsync(DateTime from, DateTime to, Guid? triggerId = null);
+
+        /// <summary>
+        /// Replays the scheduler's logged decisions in a time window against a set of triggers, to find missed and duplicate firings
+        /// </summary>
+        /// <param name="from">Start of the window, inclusive</param>
+        /// <param name="to">End of the window, exclusive; fire times that may not have been polled yet are left out</param>
+        /// <param name="triggers">Triggers to replay against, or null for every stored trigger</param>
+        /// <returns>The report</returns>
+        /// <exception cref="Exceptions.ValidationException">The window is empty, or the replay log is disabled</exception>
+        Task<SchedulerReplayReport> ReplayAsync(DateTime from, DateTime to, IEnumerable<ScheduleTrigger> triggers = null);
+
+        /// <summary>
+        /// Starts firing due triggers
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StartAsync();
+
+        /// <summary>
+        /// Stops firing triggers
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StopAsync();
+    }
+}
@@ -0,0 +1,44 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the store of the scheduler's replay log
+    /// </summary>
+    public interface ISchedulerDecisionRepository
+    {
+        /// <summary>
+        /// Stores a decision
+        /// </summary>
+        /// <param name="decision">Decision</param>
+        /// <returns>The stored decision</returns>
+        Task<SchedulerDecision> CreateAsync(SchedulerDecision decision);
+
+        /// <summary>
+        /// Gets the decisions made in a time window, in the order they were made
+        /// </summary>
+        /// <param name="from">Start of the window, inclusive</param>
+        /// <param name="to">End of the window, exclusive</param>
+        /// <param name="triggerId">Trigger the decisions must be about, or null for every trigger</param>
+        /// <returns>The decisions</returns>
+        Task<IEnumerable<SchedulerDecision>> GetRangeAsync(DateTime from, DateTime to, Guid? triggerId = null);
+
+        /// <summary>
+        /// Gets the oldest decisions made before a cutoff
+        /// </summary>
+        /// <param name="cutoff">Time before which decisions are returned</param>
+        /// <param name="limit">Maximum number of decisions</param>
+        /// <returns>The decisions, oldest first</returns>
+        Task<IEnumerable<SchedulerDecision>> GetBeforeAsync(DateTime cutoff, int limit);
+
+        /// <summary>
+        /// Deletes a decision
+        /// </summary>
+        /// <param name="id">Decision ID</param>
+        /// <returns>True if the decision was deleted</returns>
+        Task<bool> DeleteAsync(Guid id);
+    }
+}
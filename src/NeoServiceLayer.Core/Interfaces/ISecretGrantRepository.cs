@@ -0,0 +1,48 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the store of secret grants
+    /// </summary>
+    public interface ISecretGrantRepository
+    {
+        /// <summary>
+        /// Stores a new grant
+        /// </summary>
+        /// <param name="grant">Grant</param>
+        /// <returns>The stored grant</returns>
+        Task<SecretGrant> CreateAsync(SecretGrant grant);
+
+        /// <summary>
+        /// Gets a grant by ID
+        /// </summary>
+        /// <param name="id">Grant ID</param>
+        /// <returns>The grant if found, null otherwise</returns>
+        Task<SecretGrant> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the grants of a secret, newest first
+        /// </summary>
+        /// <param name="secretId">Secret ID</param>
+        /// <returns>The grants</returns>
+        Task<IEnumerable<SecretGrant>> GetBySecretIdAsync(Guid secretId);
+
+        /// <summary>
+        /// Gets the grants given to a user or to the user's functions, newest first
+        /// </summary>
+        /// <param name="accountId">Grantee account ID</param>
+        /// <returns>The grants</returns>
+        Task<IEnumerable<SecretGrant>> GetByGranteeAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Deletes a grant
+        /// </summary>
+        /// <param name="id">Grant ID</param>
+        /// <returns>True if the grant was deleted, false otherwise</returns>
+        Task<bool> DeleteAsync(Guid id);
+    }
+}
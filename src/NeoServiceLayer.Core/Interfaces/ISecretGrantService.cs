@@ -0,0 +1,71 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for sharing secrets with functions and other users through grants
+    /// </summary>
+    public interface ISecretGrantService
+    {
+        /// <summary>
+        /// Grants a function read access to a secret
+        /// </summary>
+        /// <param name="secretId">Secret ID</param>
+        /// <param name="functionId">ID of the function, which may belong to another user</param>
+        /// <param name="expiresAt">Time the grant expires, or null if it does not expire</param>
+        /// <returns>The grant</returns>
+        /// <exception cref="Exceptions.ResourceNotFoundException">The secret or function does not exist</exception>
+        /// <exception cref="Exceptions.ValidationException">The expiry is in the past, or the function already has an active grant</exception>
+        Task<SecretGrant> GrantToFunctionAsync(Guid secretId, Guid functionId, DateTime? expiresAt = null);
+
+        /// <summary>
+        /// Grants every function of another user read access to a secret
+        /// </summary>
+        /// <param name="secretId">Secret ID</param>
+        /// <param name="neoAddress">Neo address of the user</param>
+        /// <param name="expiresAt">Time the grant expires, or null if it does not expire</param>
+        /// <returns>The grant</returns>
+        /// <exception cref="Exceptions.ResourceNotFoundException">The secret or user does not exist</exception>
+        /// <exception cref="Exceptions.ValidationException">The user owns the secret, the expiry is in the past, or the user already has an active grant</exception>
+        Task<SecretGrant> GrantToAddressAsync(Guid secretId, string neoAddress, DateTime? expiresAt = null);
+
+        /// <summary>
+        /// Gets a grant by ID
+        /// </summary>
+        /// <param name="id">Grant ID</param>
+        /// <returns>The grant if found, null otherwise</returns>
+        Task<SecretGrant> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the grants of a secret, newest first
+        /// </summary>
+        /// <param name="secretId">Secret ID</param>
+        /// <returns>The grants, expired ones included</returns>
+        Task<IEnumerable<SecretGrant>> GetBySecretIdAsync(Guid secretId);
+
+        /// <summary>
+        /// Gets the active grants other users gave to a user or to the user's functions, newest first
+        /// </summary>
+        /// <param name="accountId">Grantee account ID</param>
+        /// <returns>The grants</returns>
+        Task<IEnumerable<SecretGrant>> GetSharedWithAccountAsync(Guid accountId);
+
+        /// <summary>
+        /// Revokes a grant
+        /// </summary>
+        /// <param name="id">Grant ID</param>
+        /// <returns>True if the grant was revoked, false if it did not exist</returns>
+        Task<bool> RevokeAsync(Guid id);
+
+        /// <summary>
+        /// Checks whether a function may read a secret, through the secret's allowed functions or an active grant
+        /// </summary>
+        /// <param name="secret">Secret</param>
+        /// <param name="function">Function</param>
+        /// <returns>True if the function may read the secret, false otherwise</returns>
+        Task<bool> CanFunctionReadAsync(Secret secret, Models.Function function);
+    }
+}
@@ -0,0 +1,41 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for moving secrets between environments without their values leaving the enclaves
+    /// </summary>
+    public interface ISecretTransferService
+    {
+        /// <summary>
+        /// Gets the public key other environments export secrets to this environment under
+        /// </summary>
+        /// <returns>The transfer key</returns>
+        Task<SecretTransferKey> GetTransferKeyAsync();
+
+        /// <summary>
+        /// Exports secrets, encrypted inside the enclave to another environment's transfer key
+        /// </summary>
+        /// <param name="accountId">Account that owns the secrets</param>
+        /// <param name="recipientPublicKey">Base64 public key from the target environment's transfer key</param>
+        /// <param name="names">Names of the secrets to export, or null for all of the account's secrets</param>
+        /// <returns>The bundle</returns>
+        /// <exception cref="Exceptions.ResourceNotFoundException">A named secret does not exist</exception>
+        /// <exception cref="Exceptions.ValidationException">The recipient key is missing or there is nothing to export</exception>
+        Task<SecretTransferBundle> ExportAsync(Guid accountId, string recipientPublicKey, IEnumerable<string> names = null);
+
+        /// <summary>
+        /// Imports a bundle exported to this environment, creating or updating secrets by name
+        /// </summary>
+        /// <param name="accountId">Account the secrets are imported into</param>
+        /// <param name="bundle">Bundle</param>
+        /// <param name="overwrite">Whether secrets that already exist are updated; otherwise they are a conflict</param>
+        /// <returns>What was done to each secret</returns>
+        /// <exception cref="Exceptions.ValidationException">The bundle is empty or malformed</exception>
+        /// <exception cref="Exceptions.SecretsException">A secret already exists, the quota would be exceeded, or the enclave rejected the bundle</exception>
+        Task<SecretImportResult> ImportAsync(Guid accountId, SecretTransferBundle bundle, bool overwrite = false);
+    }
+}
@@ -22,6 +22,14 @@ namespace NeoServiceLayer.Core.Interfaces
         /// <returns>The created secret</returns>
         Task<Secret> CreateSecretAsync(string name, string value, string description, Guid accountId, List<Guid> allowedFunctionIds, DateTime? expiresAt = null);
 
+        /// <summary>
+        /// Creates or updates multiple secrets atomically
+        /// </summary>
+        /// <param name="items">Secrets to create or update, matched by name within the account</param>
+        /// <param name="accountId">Account ID that owns the secrets</param>
+        /// <returns>Per-item results; either all items are applied or none are</returns>
+        Task<BulkSecretResult> BulkUpsertAsync(List<BulkSecretItem> items, Guid accountId);
+
         /// <summary>
         /// Gets a secret by ID
         /// </summary>
@@ -0,0 +1,62 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Channels;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for subscribing to server events
+    /// </summary>
+    public interface IServerEventHub : IServerEventPublisher
+    {
+        /// <summary>
+        /// Subscribes to server events
+        /// </summary>
+        /// <param name="accountId">Account of the subscriber; it receives public events and its own</param>
+        /// <param name="receiveAllAccounts">Whether the subscriber receives every account's events, for administrators</param>
+        /// <returns>The subscription, with no topics selected</returns>
+        IServerEventSubscription Subscribe(Guid accountId, bool receiveAllAccounts);
+
+        /// <summary>
+        /// Gets the number of open subscriptions of an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>The number of subscriptions</returns>
+        int GetSubscriptionCount(Guid accountId);
+    }
+
+    /// <summary>
+    /// A subscription to server events; dispose it to unsubscribe
+    /// </summary>
+    public interface IServerEventSubscription : IDisposable
+    {
+        /// <summary>
+        /// Gets the buffered events
+        /// </summary>
+        ChannelReader<ServerEvent> Events { get; }
+
+        /// <summary>
+        /// Gets the topics the subscription receives
+        /// </summary>
+        IReadOnlyCollection<string> Topics { get; }
+
+        /// <summary>
+        /// Adds topics
+        /// </summary>
+        /// <param name="topics">Topics to add</param>
+        void Subscribe(IEnumerable<string> topics);
+
+        /// <summary>
+        /// Removes topics
+        /// </summary>
+        /// <param name="topics">Topics to remove</param>
+        void Unsubscribe(IEnumerable<string> topics);
+
+        /// <summary>
+        /// Returns the number of events dropped since the last call because the buffer was full
+        /// </summary>
+        /// <returns>The number of dropped events</returns>
+        long TakeDroppedCount();
+    }
+}
@@ -0,0 +1,20 @@
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for publishing events to clients of the server event stream
+    /// </summary>
+    public interface IServerEventPublisher
+    {
+        /// <summary>
+        /// Publishes an event to its topic's subscribers
+        /// </summary>
+        /// <remarks>
+        /// Never blocks: each subscriber has its own buffer, and a slow subscriber loses its oldest events
+        /// rather than holding up the publisher.
+        /// </remarks>
+        /// <param name="serverEvent">Event to publish</param>
+        void Publish(ServerEvent serverEvent);
+    }
+}
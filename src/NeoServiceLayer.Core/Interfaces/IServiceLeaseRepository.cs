@@ -0,0 +1,32 @@
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the service lease repository
+    /// </summary>
+    public interface IServiceLeaseRepository
+    {
+        /// <summary>
+        /// Gets a lease by name
+        /// </summary>
+        /// <param name="name">Lease name</param>
+        /// <returns>The lease if found, null otherwise</returns>
+        Task<ServiceLease> GetByIdAsync(string name);
+
+        /// <summary>
+        /// Stores a lease unless one with the same name exists
+        /// </summary>
+        /// <param name="lease">Lease to store</param>
+        /// <returns>The stored lease; the existing one if another instance got there first</returns>
+        Task<ServiceLease> CreateIfNotExistsAsync(ServiceLease lease);
+
+        /// <summary>
+        /// Updates a lease
+        /// </summary>
+        /// <param name="lease">Lease to update</param>
+        /// <returns>The updated lease</returns>
+        Task<ServiceLease> UpdateAsync(ServiceLease lease);
+    }
+}
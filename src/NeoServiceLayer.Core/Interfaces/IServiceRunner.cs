@@ -0,0 +1,31 @@
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for starting and stopping the managed background services in dependency order
+    /// </summary>
+    public interface IServiceRunner
+    {
+        /// <summary>
+        /// Starts every managed service, each after the services it depends on; a service that fails to start is
+        /// logged and does not stop the others
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        Task StartAllAsync();
+
+        /// <summary>
+        /// Stops every started service in reverse dependency order, giving each its own timeout
+        /// </summary>
+        /// <returns>The shutdown report</returns>
+        Task<ShutdownReport> StopAllAsync();
+
+        /// <summary>
+        /// Gets the health of every managed service
+        /// </summary>
+        /// <returns>The service health, in start order</returns>
+        IReadOnlyList<ManagedServiceHealth> GetHealth();
+    }
+}
@@ -0,0 +1,44 @@
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the pool of service wallet accounts that sign trigger transactions
+    /// </summary>
+    public interface ISignerPool
+    {
+        /// <summary>
+        /// Gets a value indicating whether trigger transactions are signed by the pool
+        /// </summary>
+        bool IsEnabled { get; }
+
+        /// <summary>
+        /// Reserves a signer that can pay a transaction's fees and has room for another pending transaction
+        /// </summary>
+        /// <param name="feeLimit">The most GAS the transaction's fees can come to</param>
+        /// <returns>The lease, or null if no signer is available</returns>
+        Task<SignerLease> AcquireAsync(decimal feeLimit);
+
+        /// <summary>
+        /// Records that a leased signer sent its transaction; it counts as pending until it is likely in a block
+        /// </summary>
+        /// <param name="lease">The lease</param>
+        /// <param name="transactionHash">The hash of the sent transaction</param>
+        void Complete(SignerLease lease, string transactionHash);
+
+        /// <summary>
+        /// Releases a leased signer whose transaction was not sent and backs it off
+        /// </summary>
+        /// <param name="lease">The lease</param>
+        /// <param name="error">Why the transaction was not sent</param>
+        void Fail(SignerLease lease, string error);
+
+        /// <summary>
+        /// Gets the signers in the pool
+        /// </summary>
+        /// <returns>The signers in pool order</returns>
+        Task<IReadOnlyList<SignerStatus>> GetStatusAsync();
+    }
+}
@@ -0,0 +1,20 @@
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for running every service's startup recovery
+    /// </summary>
+    public interface IStartupRecoveryService
+    {
+        /// <summary>
+        /// Runs each registered recovery step; a failing step is reported and does not stop the others
+        /// </summary>
+        /// <returns>The recovery report</returns>
+        /// <remarks>
+        /// With leadership enabled the steps are skipped unless this instance holds the lease.
+        /// </remarks>
+        Task<StartupRecoveryReport> RecoverAsync();
+    }
+}
@@ -0,0 +1,28 @@
+using System;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for a service's recovery of state left in doubt by a previous process
+    /// </summary>
+    /// <remarks>
+    /// Steps must be idempotent: running one again, or after a crash part-way through, only finishes the work
+    /// still outstanding. Work started by the current process, at or after <c>processStartTime</c>, is left alone.
+    /// </remarks>
+    public interface IStartupRecoveryStep
+    {
+        /// <summary>
+        /// Gets the name the step is reported under
+        /// </summary>
+        string Name { get; }
+
+        /// <summary>
+        /// Resumes or resolves state left in doubt by a previous process
+        /// </summary>
+        /// <param name="processStartTime">Start time of the current process, in UTC</param>
+        /// <returns>What the step resumed and resolved</returns>
+        Task<StartupRecoveryStepResult> RecoverAsync(DateTime processStartTime);
+    }
+}
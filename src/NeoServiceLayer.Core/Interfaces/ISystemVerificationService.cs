@@ -0,0 +1,20 @@
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for running live end-to-end checks of the components the service depends on
+    /// </summary>
+    public interface ISystemVerificationService
+    {
+        /// <summary>
+        /// Runs the checks; a failing check is reported and does not stop the others
+        /// </summary>
+        /// <param name="checks">Names of the checks to run, from <see cref="SystemVerificationChecks"/>; every check when null or empty</param>
+        /// <returns>The verification report</returns>
+        /// <exception cref="Exceptions.ValidationException">A check name is unknown</exception>
+        Task<SystemVerificationReport> VerifyAsync(IEnumerable<string> checks = null);
+    }
+}
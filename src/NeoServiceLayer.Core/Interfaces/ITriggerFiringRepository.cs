@@ -0,0 +1,77 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for the trigger firing repository
+    /// </summary>
+    public interface ITriggerFiringRepository
+    {
+        /// <summary>
+        /// Stores a firing unless one with the same ID exists
+        /// </summary>
+        /// <param name="firing">Firing to store</param>
+        /// <returns>The stored firing; the existing one if another claim got there first</returns>
+        Task<TriggerFiring> CreateIfNotExistsAsync(TriggerFiring firing);
+
+        /// <summary>
+        /// Gets a firing by ID
+        /// </summary>
+        /// <param name="id">Firing ID</param>
+        /// <returns>The firing if found, null otherwise</returns>
+        Task<TriggerFiring> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Updates a firing
+        /// </summary>
+        /// <param name="firing">Firing to update</param>
+        /// <returns>The updated firing</returns>
+        Task<TriggerFiring> UpdateAsync(TriggerFiring firing);
+
+        /// <summary>
+        /// Gets a page of a trigger's firings, newest first
+        /// </summary>
+        /// <param name="query">Page request</param>
+        /// <returns>The page</returns>
+        Task<TriggerFiringPage> GetPageAsync(TriggerFiringQuery query);
+
+        /// <summary>
+        /// Gets all firings of a trigger still in the history
+        /// </summary>
+        /// <param name="triggerId">Trigger ID</param>
+        /// <returns>The firings</returns>
+        Task<IEnumerable<TriggerFiring>> GetByTriggerIdAsync(Guid triggerId);
+
+        /// <summary>
+        /// Gets the oldest firings scheduled before a time, oldest first
+        /// </summary>
+        /// <param name="cutoff">Scheduled fire time to stop before</param>
+        /// <param name="limit">Maximum number of firings</param>
+        /// <returns>The firings</returns>
+        Task<IEnumerable<TriggerFiring>> GetScheduledBeforeAsync(DateTime cutoff, int limit);
+
+        /// <summary>
+        /// Deletes a firing
+        /// </summary>
+        /// <param name="id">Firing ID</param>
+        /// <returns>True if the firing was deleted, false otherwise</returns>
+        Task<bool> DeleteAsync(Guid id);
+
+        /// <summary>
+        /// Gets the compacted statistics of a trigger
+        /// </summary>
+        /// <param name="triggerId">Trigger ID</param>
+        /// <returns>The statistics if any firings were compacted, null otherwise</returns>
+        Task<TriggerFiringStatistics> GetStatisticsAsync(Guid triggerId);
+
+        /// <summary>
+        /// Creates or replaces the compacted statistics of a trigger
+        /// </summary>
+        /// <param name="statistics">Statistics to save</param>
+        /// <returns>The saved statistics</returns>
+        Task<TriggerFiringStatistics> SaveStatisticsAsync(TriggerFiringStatistics statistics);
+    }
+}
@@ -0,0 +1,47 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for upkeep repository
+    /// </summary>
+    public interface IUpkeepRepository
+    {
+        /// <summary>
+        /// Creates a new upkeep
+        /// </summary>
+        /// <param name="upkeep">Upkeep to create</param>
+        /// <returns>The created upkeep</returns>
+        Task<Upkeep> CreateAsync(Upkeep upkeep);
+
+        /// <summary>
+        /// Updates an upkeep
+        /// </summary>
+        /// <param name="upkeep">Upkeep to update</param>
+        /// <returns>The updated upkeep</returns>
+        Task<Upkeep> UpdateAsync(Upkeep upkeep);
+
+        /// <summary>
+        /// Gets an upkeep by ID
+        /// </summary>
+        /// <param name="id">Upkeep ID</param>
+        /// <returns>The upkeep if found, null otherwise</returns>
+        Task<Upkeep> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the upkeeps of an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>List of upkeeps</returns>
+        Task<IEnumerable<Upkeep>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Gets the upkeeps that are checked on their interval
+        /// </summary>
+        /// <returns>List of active upkeeps</returns>
+        Task<IEnumerable<Upkeep>> GetActiveAsync();
+    }
+}
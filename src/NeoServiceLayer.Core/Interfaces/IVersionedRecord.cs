@@ -0,0 +1,17 @@
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for persisted models that carry the schema version they were written with
+    /// </summary>
+    /// <remarks>
+    /// Records written before the model was versioned read back as version 0. A registered
+    /// <see cref="IRecordUpgrader"/> brings older records up to date when they are read.
+    /// </remarks>
+    public interface IVersionedRecord
+    {
+        /// <summary>
+        /// Gets or sets the schema version the record was written with
+        /// </summary>
+        int SchemaVersion { get; set; }
+    }
+}
@@ -2,6 +2,7 @@ using System;
 using System.Collections.Generic;
 using System.Threading.Tasks;
 using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
 
 namespace NeoServiceLayer.Core.Interfaces
 {
@@ -114,6 +115,27 @@ namespace NeoServiceLayer.Core.Interfaces
         /// <returns>Transaction hash</returns>
         Task<string> TransferGasAsync(Guid walletId, string password, string toAddress, decimal amount);
 
+        /// <summary>
+        /// Transfers GAS to multiple recipients in a single transaction
+        /// </summary>
+        /// <param name="walletId">Wallet ID</param>
+        /// <param name="password">Password for decrypting the private key</param>
+        /// <param name="transfers">Amounts to transfer keyed by recipient address</param>
+        /// <returns>Transaction hash</returns>
+        Task<string> TransferGasMultiAsync(Guid walletId, string password, IDictionary<string, decimal> transfers);
+
+        /// <summary>
+        /// Invokes a contract method that modifies state, signed by the wallet
+        /// </summary>
+        /// <param name="walletId">Wallet ID</param>
+        /// <param name="password">Password for decrypting the private key</param>
+        /// <param name="scriptHash">Contract script hash</param>
+        /// <param name="operation">Method name</param>
+        /// <param name="args">Method arguments</param>
+        /// <param name="systemFeeLimit">Most GAS the invocation may consume</param>
+        /// <returns>Transaction hash</returns>
+        Task<string> InvokeContractAsync(Guid walletId, string password, string scriptHash, string operation, IEnumerable<NeoContractParameter> args, decimal systemFeeLimit);
+
         /// <summary>
         /// Transfers NEP-17 tokens
         /// </summary>
@@ -0,0 +1,48 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for webhook trigger repository
+    /// </summary>
+    public interface IWebhookTriggerRepository
+    {
+        /// <summary>
+        /// Creates a new webhook trigger
+        /// </summary>
+        /// <param name="trigger">Webhook trigger to create</param>
+        /// <returns>The created webhook trigger</returns>
+        Task<WebhookTrigger> CreateAsync(WebhookTrigger trigger);
+
+        /// <summary>
+        /// Updates a webhook trigger
+        /// </summary>
+        /// <param name="trigger">Webhook trigger to update</param>
+        /// <returns>The updated webhook trigger</returns>
+        Task<WebhookTrigger> UpdateAsync(WebhookTrigger trigger);
+
+        /// <summary>
+        /// Gets a webhook trigger by ID
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>The webhook trigger if found, null otherwise</returns>
+        Task<WebhookTrigger> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the webhook triggers of an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>List of webhook triggers</returns>
+        Task<IEnumerable<WebhookTrigger>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Deletes a webhook trigger
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>True if the trigger was deleted, false otherwise</returns>
+        Task<bool> DeleteAsync(Guid id);
+    }
+}
@@ -0,0 +1,67 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Interfaces
+{
+    /// <summary>
+    /// Interface for webhook ingestion triggers
+    /// </summary>
+    public interface IWebhookTriggerService
+    {
+        /// <summary>
+        /// Creates a webhook trigger for a function owned by the trigger's account
+        /// </summary>
+        /// <param name="trigger">Webhook trigger to create</param>
+        /// <returns>The created webhook trigger</returns>
+        Task<WebhookTrigger> CreateAsync(WebhookTrigger trigger);
+
+        /// <summary>
+        /// Updates a webhook trigger
+        /// </summary>
+        /// <param name="trigger">Webhook trigger to update, carrying the revision it was read at</param>
+        /// <returns>The updated webhook trigger, at the next revision</returns>
+        /// <exception cref="Exceptions.ConcurrencyConflictException">The trigger has been updated since that revision</exception>
+        Task<WebhookTrigger> UpdateAsync(WebhookTrigger trigger);
+
+        /// <summary>
+        /// Gets a webhook trigger by ID
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>The webhook trigger if found, null otherwise</returns>
+        Task<WebhookTrigger> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the webhook triggers of an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>List of webhook triggers</returns>
+        Task<IEnumerable<WebhookTrigger>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Deletes a webhook trigger
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <returns>True if the trigger was deleted, false otherwise</returns>
+        Task<bool> DeleteAsync(Guid id);
+
+        /// <summary>
+        /// Applies the trigger's transformation to a delivery without executing the function
+        /// </summary>
+        /// <param name="trigger">Webhook trigger</param>
+        /// <param name="body">Raw request body</param>
+        /// <param name="headers">Request headers</param>
+        /// <returns>The function parameters the delivery would produce</returns>
+        Dictionary<string, object> Transform(WebhookTrigger trigger, string body, IDictionary<string, string> headers);
+
+        /// <summary>
+        /// Verifies, transforms and executes a webhook delivery
+        /// </summary>
+        /// <param name="id">Trigger ID</param>
+        /// <param name="body">Raw request body</param>
+        /// <param name="headers">Request headers</param>
+        /// <returns>The function result, or a <see cref="FunctionHttpResponse"/> for triggers in <see cref="WebhookResponseModes.Http"/> mode</returns>
+        Task<object> IngestAsync(Guid id, string body, IDictionary<string, string> headers);
+    }
+}
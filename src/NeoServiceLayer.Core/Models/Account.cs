@@ -66,5 +66,15 @@ namespace NeoServiceLayer.Core.Models
         /// Current balance of credits for the account
         /// </summary>
         public decimal Credits { get; set; }
+
+        /// <summary>
+        /// Price feed subscription tier of the account; null means the default tier
+        /// </summary>
+        public string PriceFeedTier { get; set; }
+
+        /// <summary>
+        /// Execution tier deciding the account's share of execution slots under load; null means the default tier
+        /// </summary>
+        public string ExecutionTier { get; set; }
     }
 }
@@ -0,0 +1,87 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Effective limits of an account and how much of each is used
+    /// </summary>
+    public class AccountLimits
+    {
+        /// <summary>
+        /// Gets or sets the account ID
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the function count quota
+        /// </summary>
+        public AccountQuota Functions { get; set; }
+
+        /// <summary>
+        /// Gets or sets the concurrent execution quota
+        /// </summary>
+        public AccountQuota ConcurrentExecutions { get; set; }
+
+        /// <summary>
+        /// Gets or sets the secret count quota
+        /// </summary>
+        public AccountQuota Secrets { get; set; }
+
+        /// <summary>
+        /// Gets or sets the largest execution time a function can be given, in milliseconds
+        /// </summary>
+        public int MaxExecutionTimeMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the largest amount of sandbox memory a function can be given, in MB
+        /// </summary>
+        public int MaxMemoryMb { get; set; }
+
+        /// <summary>
+        /// Gets or sets the largest secret value in bytes
+        /// </summary>
+        public int MaxSecretValueSizeBytes { get; set; }
+
+        /// <summary>
+        /// Gets or sets the API rate limit; null if rate limiting is disabled
+        /// </summary>
+        public RateLimitInfo RateLimit { get; set; }
+    }
+
+    /// <summary>
+    /// A counted limit and its current usage
+    /// </summary>
+    public class AccountQuota
+    {
+        /// <summary>
+        /// Gets or sets the limit
+        /// </summary>
+        public int Limit { get; set; }
+
+        /// <summary>
+        /// Gets or sets the current usage
+        /// </summary>
+        public int Used { get; set; }
+
+        /// <summary>
+        /// Gets how much of the limit is left
+        /// </summary>
+        public int Remaining => Math.Max(0, Limit - Used);
+    }
+
+    /// <summary>
+    /// An API rate limit
+    /// </summary>
+    public class RateLimitInfo
+    {
+        /// <summary>
+        /// Gets or sets the number of requests allowed per period
+        /// </summary>
+        public int Limit { get; set; }
+
+        /// <summary>
+        /// Gets or sets the period in seconds
+        /// </summary>
+        public int PeriodSeconds { get; set; }
+    }
+}
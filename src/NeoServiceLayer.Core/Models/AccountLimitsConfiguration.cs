@@ -0,0 +1,28 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for per-account limits
+    /// </summary>
+    public class AccountLimitsConfiguration
+    {
+        /// <summary>
+        /// Gets or sets the maximum number of functions per account
+        /// </summary>
+        public int MaxFunctions { get; set; } = 100;
+
+        /// <summary>
+        /// Gets or sets the maximum number of executions an account can run at the same time
+        /// </summary>
+        public int MaxConcurrentExecutions { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets the largest execution time a function can be given, in milliseconds
+        /// </summary>
+        public int MaxExecutionTimeMs { get; set; } = 30000;
+
+        /// <summary>
+        /// Gets or sets the largest amount of sandbox memory a function can be given, in MB
+        /// </summary>
+        public int MaxMemoryMb { get; set; } = 256;
+    }
+}
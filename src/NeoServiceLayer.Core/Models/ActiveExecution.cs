@@ -0,0 +1,80 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a function execution that is currently running
+    /// </summary>
+    public class ActiveExecution
+    {
+        /// <summary>
+        /// Gets or sets the execution ID
+        /// </summary>
+        public Guid ExecutionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the function ID
+        /// </summary>
+        public Guid FunctionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the name of the function
+        /// </summary>
+        public string FunctionName { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account ID that owns the function
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the runtime of the function
+        /// </summary>
+        public string Runtime { get; set; }
+
+        /// <summary>
+        /// Gets or sets what started the execution (e.g., "api", "event")
+        /// </summary>
+        public string Source { get; set; }
+
+        /// <summary>
+        /// Gets or sets the status of the execution (Running, Killing)
+        /// </summary>
+        public string Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets the start time of the execution
+        /// </summary>
+        public DateTime StartTime { get; set; }
+
+        /// <summary>
+        /// Gets the elapsed time of the execution in milliseconds
+        /// </summary>
+        public long ElapsedMs => (long)(DateTime.UtcNow - StartTime).TotalMilliseconds;
+
+        /// <summary>
+        /// Gets or sets the maximum execution time in milliseconds
+        /// </summary>
+        public int MaxExecutionTime { get; set; }
+
+        /// <summary>
+        /// Gets or sets the memory limit in megabytes
+        /// </summary>
+        public int MaxMemoryMb { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last reported memory usage in megabytes
+        /// </summary>
+        public double MemoryUsageMb { get; set; }
+
+        /// <summary>
+        /// Gets or sets the user who requested the execution to be killed
+        /// </summary>
+        public string KilledBy { get; set; }
+
+        /// <summary>
+        /// Gets or sets the date and time when the kill was requested
+        /// </summary>
+        public DateTime? KillRequestedAt { get; set; }
+    }
+}
@@ -0,0 +1,18 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for the admin dashboard embedded in the API service
+    /// </summary>
+    public class AdminUiConfiguration
+    {
+        /// <summary>
+        /// Gets or sets a value indicating whether the dashboard is served under /admin/ui
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets the number of recent executions shown on the dashboard
+        /// </summary>
+        public int RecentExecutionCount { get; set; } = 20;
+    }
+}
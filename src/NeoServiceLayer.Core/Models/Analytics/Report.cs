@@ -53,6 +53,25 @@ namespace NeoServiceLayer.Core.Models.Analytics
         /// </summary>
         public string Query { get; set; }
 
+        /// <summary>
+        /// Gets or sets the function whose output a function report renders
+        /// </summary>
+        public Guid? FunctionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the template a function report's output is rendered through
+        /// </summary>
+        /// <remarks>
+        /// <c>{{output.path}}</c> inserts a value from the function's output, <c>{{#each output.items}}...{{/each}}</c>
+        /// repeats a block for each item of a list, and <c>{{report.name}}</c> and <c>{{generatedAt}}</c> describe the run.
+        /// </remarks>
+        public string Template { get; set; }
+
+        /// <summary>
+        /// Gets or sets the format of the template
+        /// </summary>
+        public ReportTemplateFormat TemplateFormat { get; set; }
+
         /// <summary>
         /// Gets or sets the parameters for the report
         /// </summary>
@@ -149,6 +168,11 @@ namespace NeoServiceLayer.Core.Models.Analytics
         /// </summary>
         public string OutputFileUrl { get; set; }
 
+        /// <summary>
+        /// Gets or sets the rendered output of a function report
+        /// </summary>
+        public string RenderedOutput { get; set; }
+
         /// <summary>
         /// Gets or sets the error message if the execution failed
         /// </summary>
@@ -246,6 +270,11 @@ namespace NeoServiceLayer.Core.Models.Analytics
         /// </summary>
         public List<string> WebhookUrls { get; set; } = new List<string>();
 
+        /// <summary>
+        /// Gets or sets the Telegram chat IDs
+        /// </summary>
+        public List<string> TelegramChatIds { get; set; } = new List<string>();
+
         /// <summary>
         /// Gets or sets the storage path
         /// </summary>
@@ -311,7 +340,28 @@ namespace NeoServiceLayer.Core.Models.Analytics
         /// <summary>
         /// Custom report
         /// </summary>
-        Custom
+        Custom,
+
+        /// <summary>
+        /// Output of a function rendered through a template
+        /// </summary>
+        Function
+    }
+
+    /// <summary>
+    /// Represents the format of a function report template
+    /// </summary>
+    public enum ReportTemplateFormat
+    {
+        /// <summary>
+        /// Markdown template
+        /// </summary>
+        Markdown,
+
+        /// <summary>
+        /// HTML template; inserted values are HTML-encoded
+        /// </summary>
+        Html
     }
 
     /// <summary>
@@ -451,7 +501,12 @@ namespace NeoServiceLayer.Core.Models.Analytics
         /// <summary>
         /// Storage delivery
         /// </summary>
-        Storage
+        Storage,
+
+        /// <summary>
+        /// Telegram delivery
+        /// </summary>
+        Telegram
     }
 
     /// <summary>
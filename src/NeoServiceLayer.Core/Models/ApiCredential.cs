@@ -0,0 +1,100 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents an API key an account created for its own integrations
+    /// </summary>
+    /// <remarks>
+    /// Only a hash of the key is stored; the key itself is shown once, when it is created.
+    /// </remarks>
+    public class ApiCredential
+    {
+        /// <summary>
+        /// Gets or sets the ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the account the key acts for
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the start of the key, shown so its owner can tell keys apart
+        /// </summary>
+        public string KeyPrefix { get; set; }
+
+        /// <summary>
+        /// Gets or sets the SHA-256 hash of the key, in hex
+        /// </summary>
+        public string KeyHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the scopes the key may use; see <see cref="ApiScopes"/>
+        /// </summary>
+        public List<string> Scopes { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets the maximum number of requests per period, null for no key-specific limit
+        /// </summary>
+        public int? RateLimit { get; set; }
+
+        /// <summary>
+        /// Gets or sets the rate limit period in seconds
+        /// </summary>
+        public int RateLimitPeriodSeconds { get; set; } = 60;
+
+        /// <summary>
+        /// Gets or sets the creation time
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the key expires, null if it does not
+        /// </summary>
+        public DateTime? ExpiresAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the key was revoked, null if it was not
+        /// </summary>
+        public DateTime? RevokedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the key was last used, to the minute
+        /// </summary>
+        public DateTime? LastUsedAt { get; set; }
+
+        /// <summary>
+        /// Checks if the key can be used at a given time
+        /// </summary>
+        /// <param name="time">Time to check</param>
+        /// <returns>True if the key is neither revoked nor expired at the time, false otherwise</returns>
+        public bool IsActiveAt(DateTime time)
+        {
+            return RevokedAt == null && (ExpiresAt == null || ExpiresAt > time);
+        }
+    }
+
+    /// <summary>
+    /// A newly created API key together with the key itself
+    /// </summary>
+    public class CreatedApiCredential
+    {
+        /// <summary>
+        /// Gets or sets the stored credential
+        /// </summary>
+        public ApiCredential Credential { get; set; }
+
+        /// <summary>
+        /// Gets or sets the key, which cannot be retrieved again
+        /// </summary>
+        public string Key { get; set; }
+    }
+}
@@ -0,0 +1,18 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for account API keys
+    /// </summary>
+    public class ApiCredentialConfiguration
+    {
+        /// <summary>
+        /// Gets or sets the maximum number of active API keys per account
+        /// </summary>
+        public int MaxKeysPerAccount { get; set; } = 20;
+
+        /// <summary>
+        /// Gets or sets the shortest rate limit period a key may ask for, in seconds
+        /// </summary>
+        public int MinRateLimitPeriodSeconds { get; set; } = 1;
+    }
+}
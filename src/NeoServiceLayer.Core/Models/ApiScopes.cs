@@ -0,0 +1,69 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Names of the scopes an account's API key can be limited to
+    /// </summary>
+    /// <remarks>
+    /// A key can only call what its scopes allow, reads included: secrets and GasBank reads have their own scopes, and
+    /// <see cref="AccountRead"/> allows every other read of the account's resources.
+    /// </remarks>
+    public static class ApiScopes
+    {
+        /// <summary>
+        /// Executing functions
+        /// </summary>
+        public const string FunctionsInvoke = "functions:invoke";
+
+        /// <summary>
+        /// Reading the account's resources that have no read scope of their own
+        /// </summary>
+        public const string AccountRead = "account:read";
+
+        /// <summary>
+        /// Listing secrets and reading their metadata
+        /// </summary>
+        public const string SecretsRead = "secrets:read";
+
+        /// <summary>
+        /// Creating, changing and deleting secrets
+        /// </summary>
+        public const string SecretsWrite = "secrets:write";
+
+        /// <summary>
+        /// Reading GasBank balances, payment streams, recurring withdrawals and fee policies
+        /// </summary>
+        public const string GasBankRead = "gasbank:read";
+
+        /// <summary>
+        /// Moving GAS out of GasBank accounts
+        /// </summary>
+        public const string GasBankWithdraw = "gasbank:withdraw";
+
+        /// <summary>
+        /// Creating, changing and deleting schedule and webhook triggers
+        /// </summary>
+        public const string TriggersManage = "triggers:manage";
+
+        /// <summary>
+        /// All known scopes
+        /// </summary>
+        public static readonly IReadOnlyList<string> All = new[]
+        {
+            AccountRead, FunctionsInvoke, SecretsRead, SecretsWrite, GasBankRead, GasBankWithdraw, TriggersManage
+        };
+
+        /// <summary>
+        /// Checks if a name is a known scope
+        /// </summary>
+        /// <param name="name">Scope name</param>
+        /// <returns>True if the scope is known, false otherwise</returns>
+        public static bool IsKnown(string name)
+        {
+            return All.Contains(name, StringComparer.OrdinalIgnoreCase);
+        }
+    }
+}
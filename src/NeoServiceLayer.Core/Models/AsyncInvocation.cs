@@ -0,0 +1,163 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Function invocation accepted for running in the background, whose result is polled or delivered to a callback URL
+    /// </summary>
+    public class AsyncInvocation
+    {
+        /// <summary>
+        /// Gets or sets the execution ID returned to the caller
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the function invoked
+        /// </summary>
+        public Guid FunctionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the account that invoked the function
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the parameters of the invocation
+        /// </summary>
+        public Dictionary<string, object> Parameters { get; set; }
+
+        /// <summary>
+        /// Gets or sets the session key the invocation runs under, already scoped to the caller
+        /// </summary>
+        public string SessionKey { get; set; }
+
+        /// <summary>
+        /// Gets or sets the URL the outcome is posted to once the invocation completes or fails
+        /// </summary>
+        public string CallbackUrl { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the API request that accepted the invocation
+        /// </summary>
+        public string RequestId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the status of the invocation
+        /// </summary>
+        public AsyncInvocationStatus Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets the result of the function once completed
+        /// </summary>
+        public object Result { get; set; }
+
+        /// <summary>
+        /// Gets or sets the error message once failed
+        /// </summary>
+        public string Error { get; set; }
+
+        /// <summary>
+        /// Gets or sets the sandbox error code of the failure, if any
+        /// </summary>
+        public string ErrorCode { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the invocation was accepted
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the invocation started running
+        /// </summary>
+        public DateTime? StartedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the invocation completed or failed
+        /// </summary>
+        public DateTime? CompletedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the delivery status of the callback
+        /// </summary>
+        public AsyncCallbackStatus CallbackStatus { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of callback deliveries attempted
+        /// </summary>
+        public int CallbackAttempts { get; set; }
+
+        /// <summary>
+        /// Gets or sets the error of the last failed callback delivery
+        /// </summary>
+        public string CallbackError { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the callback is next attempted
+        /// </summary>
+        public DateTime? NextCallbackAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the callback was delivered
+        /// </summary>
+        public DateTime? CallbackDeliveredAt { get; set; }
+
+        /// <summary>
+        /// Gets whether the invocation has completed or failed
+        /// </summary>
+        public bool IsFinished => Status == AsyncInvocationStatus.Completed || Status == AsyncInvocationStatus.Failed;
+    }
+
+    /// <summary>
+    /// Status of an asynchronous invocation
+    /// </summary>
+    public enum AsyncInvocationStatus
+    {
+        /// <summary>
+        /// Accepted and waiting to run
+        /// </summary>
+        Queued,
+
+        /// <summary>
+        /// Running
+        /// </summary>
+        Running,
+
+        /// <summary>
+        /// Completed with a result
+        /// </summary>
+        Completed,
+
+        /// <summary>
+        /// Failed with an error
+        /// </summary>
+        Failed
+    }
+
+    /// <summary>
+    /// Delivery status of the callback of an asynchronous invocation
+    /// </summary>
+    public enum AsyncCallbackStatus
+    {
+        /// <summary>
+        /// No callback URL was given
+        /// </summary>
+        None,
+
+        /// <summary>
+        /// Waiting for the invocation to finish or for the next attempt
+        /// </summary>
+        Pending,
+
+        /// <summary>
+        /// Delivered
+        /// </summary>
+        Delivered,
+
+        /// <summary>
+        /// Given up after the last attempt failed
+        /// </summary>
+        Failed
+    }
+}
@@ -0,0 +1,48 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for asynchronous function invocations
+    /// </summary>
+    public class AsyncInvocationConfiguration
+    {
+        /// <summary>
+        /// Gets or sets how often queued invocations are started and callbacks retried, in seconds
+        /// </summary>
+        public int PollIntervalSeconds { get; set; } = 2;
+
+        /// <summary>
+        /// Gets or sets how many invocations are started per poll
+        /// </summary>
+        public int BatchSize { get; set; } = 20;
+
+        /// <summary>
+        /// Gets or sets the maximum number of queued invocations an account may have at once
+        /// </summary>
+        public int MaxQueuedPerAccount { get; set; } = 100;
+
+        /// <summary>
+        /// Gets or sets how long an invocation may stay running before it is failed, in seconds; covers instances that stopped mid-run
+        /// </summary>
+        public int RunningTimeoutSeconds { get; set; } = 600;
+
+        /// <summary>
+        /// Gets or sets the timeout of one callback delivery, in seconds
+        /// </summary>
+        public int CallbackTimeoutSeconds { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets how many times a callback is attempted before it is given up
+        /// </summary>
+        public int MaxCallbackAttempts { get; set; } = 5;
+
+        /// <summary>
+        /// Gets or sets the delay before the first callback retry, in seconds; it doubles with each attempt
+        /// </summary>
+        public int CallbackRetryDelaySeconds { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets how long finished invocations are kept for polling, in hours
+        /// </summary>
+        public int ResultRetentionHours { get; set; } = 72;
+    }
+}
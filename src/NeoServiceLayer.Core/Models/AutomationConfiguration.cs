@@ -0,0 +1,38 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for the automation service that checks and performs upkeeps
+    /// </summary>
+    public class AutomationConfiguration
+    {
+        /// <summary>
+        /// Gets or sets a value indicating whether upkeeps are checked and performed
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets how often the keeper looks for upkeeps that are due, in seconds
+        /// </summary>
+        public int PollIntervalSeconds { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets the shortest check interval an upkeep may ask for, in seconds
+        /// </summary>
+        public int MinCheckIntervalSeconds { get; set; } = 30;
+
+        /// <summary>
+        /// Gets or sets the gas limit of an upkeep registered without one, in GAS
+        /// </summary>
+        public decimal DefaultGasLimit { get; set; } = 1m;
+
+        /// <summary>
+        /// Gets or sets the highest gas limit an upkeep may ask for, in GAS
+        /// </summary>
+        public decimal MaxGasLimit { get; set; } = 20m;
+
+        /// <summary>
+        /// Gets or sets how many upkeeps are checked at the same time
+        /// </summary>
+        public int MaxConcurrentChecks { get; set; } = 4;
+    }
+}
@@ -0,0 +1,51 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models.Blockchain
+{
+    /// <summary>
+    /// Permissions a contract call grants the called method, as checked by the Neo VM
+    /// </summary>
+    [Flags]
+    public enum CallFlags : byte
+    {
+        /// <summary>
+        /// No permissions
+        /// </summary>
+        None = 0,
+
+        /// <summary>
+        /// The method may read contract storage
+        /// </summary>
+        ReadStates = 0b00000001,
+
+        /// <summary>
+        /// The method may write contract storage
+        /// </summary>
+        WriteStates = 0b00000010,
+
+        /// <summary>
+        /// The method may call other contracts
+        /// </summary>
+        AllowCall = 0b00000100,
+
+        /// <summary>
+        /// The method may raise notifications
+        /// </summary>
+        AllowNotify = 0b00001000,
+
+        /// <summary>
+        /// The method may read and write contract storage
+        /// </summary>
+        States = ReadStates | WriteStates,
+
+        /// <summary>
+        /// The method may read contract storage and call other contracts
+        /// </summary>
+        ReadOnly = ReadStates | AllowCall,
+
+        /// <summary>
+        /// All permissions
+        /// </summary>
+        All = States | AllowCall | AllowNotify
+    }
+}
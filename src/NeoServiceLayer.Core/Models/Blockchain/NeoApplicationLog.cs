@@ -0,0 +1,87 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models.Blockchain
+{
+    /// <summary>
+    /// Represents the application log of a persisted transaction
+    /// </summary>
+    public class NeoApplicationLog
+    {
+        /// <summary>
+        /// Gets or sets the transaction hash
+        /// </summary>
+        public string TxId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the executions of the transaction's script
+        /// </summary>
+        public List<NeoApplicationExecution> Executions { get; set; } = new List<NeoApplicationExecution>();
+
+        /// <summary>
+        /// Gets a value indicating whether every execution halted successfully
+        /// </summary>
+        public bool IsHalt => Executions.Count > 0 && Executions.TrueForAll(e => e.IsHalt);
+    }
+
+    /// <summary>
+    /// Represents one execution recorded in an application log
+    /// </summary>
+    public class NeoApplicationExecution
+    {
+        /// <summary>
+        /// Gets or sets the trigger type, e.g. Application
+        /// </summary>
+        public string Trigger { get; set; }
+
+        /// <summary>
+        /// Gets or sets the VM state after execution (HALT or FAULT)
+        /// </summary>
+        public string VmState { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS consumed by the execution
+        /// </summary>
+        public string GasConsumed { get; set; }
+
+        /// <summary>
+        /// Gets or sets the VM exception message, if any
+        /// </summary>
+        public string Exception { get; set; }
+
+        /// <summary>
+        /// Gets or sets the result stack
+        /// </summary>
+        public List<NeoStackItem> Stack { get; set; } = new List<NeoStackItem>();
+
+        /// <summary>
+        /// Gets or sets the notifications raised during execution
+        /// </summary>
+        public List<NeoContractNotification> Notifications { get; set; } = new List<NeoContractNotification>();
+
+        /// <summary>
+        /// Gets a value indicating whether the execution halted successfully
+        /// </summary>
+        public bool IsHalt => VmState == "HALT";
+    }
+
+    /// <summary>
+    /// Represents a notification raised by a contract
+    /// </summary>
+    public class NeoContractNotification
+    {
+        /// <summary>
+        /// Gets or sets the script hash of the contract that raised the notification
+        /// </summary>
+        public string Contract { get; set; }
+
+        /// <summary>
+        /// Gets or sets the event name
+        /// </summary>
+        public string EventName { get; set; }
+
+        /// <summary>
+        /// Gets or sets the event state, usually an Array of the event's arguments
+        /// </summary>
+        public NeoStackItem State { get; set; }
+    }
+}
@@ -0,0 +1,31 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models.Blockchain
+{
+    /// <summary>
+    /// Represents a persisted Neo block
+    /// </summary>
+    public class NeoBlock
+    {
+        /// <summary>
+        /// Gets or sets the block hash
+        /// </summary>
+        public string Hash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the block index
+        /// </summary>
+        public long Index { get; set; }
+
+        /// <summary>
+        /// Gets or sets the block timestamp (UTC)
+        /// </summary>
+        public DateTime Timestamp { get; set; }
+
+        /// <summary>
+        /// Gets or sets the hashes of the block's transactions, in block order
+        /// </summary>
+        public List<string> TransactionHashes { get; set; } = new List<string>();
+    }
+}
@@ -0,0 +1,225 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Linq;
+using System.Numerics;
+using System.Text.Json;
+using NeoServiceLayer.Core.Extensions;
+
+namespace NeoServiceLayer.Core.Models.Blockchain
+{
+    /// <summary>
+    /// Represents a contract parameter passed to a Neo invocation
+    /// </summary>
+    public class NeoContractParameter
+    {
+        /// <summary>
+        /// Gets or sets the parameter type (e.g., Hash160, Integer, String, Boolean, ByteArray, Array, Map)
+        /// </summary>
+        public string Type { get; set; }
+
+        /// <summary>
+        /// Gets or sets the parameter value; a list of parameters for an Array and a list of
+        /// <see cref="NeoContractParameterMapEntry"/> for a Map
+        /// </summary>
+        public object Value { get; set; }
+
+        /// <summary>
+        /// Creates a Hash160 parameter
+        /// </summary>
+        /// <param name="scriptHash">Script hash</param>
+        /// <returns>The parameter</returns>
+        public static NeoContractParameter Hash160(string scriptHash)
+        {
+            return new NeoContractParameter { Type = "Hash160", Value = scriptHash };
+        }
+
+        /// <summary>
+        /// Creates a String parameter
+        /// </summary>
+        /// <param name="value">String value</param>
+        /// <returns>The parameter</returns>
+        public static NeoContractParameter String(string value)
+        {
+            return new NeoContractParameter { Type = "String", Value = value };
+        }
+
+        /// <summary>
+        /// Creates an Integer parameter
+        /// </summary>
+        /// <param name="value">Integer value</param>
+        /// <returns>The parameter, with the value encoded as a decimal string</returns>
+        public static NeoContractParameter Integer(BigInteger value)
+        {
+            return new NeoContractParameter { Type = "Integer", Value = value.ToString(CultureInfo.InvariantCulture) };
+        }
+
+        /// <summary>
+        /// Creates a ByteArray parameter
+        /// </summary>
+        /// <param name="bytes">Raw bytes</param>
+        /// <returns>The parameter, with the value encoded in Base64</returns>
+        public static NeoContractParameter ByteArray(byte[] bytes)
+        {
+            return new NeoContractParameter { Type = "ByteArray", Value = Convert.ToBase64String(bytes) };
+        }
+
+        /// <summary>
+        /// Creates a Boolean parameter
+        /// </summary>
+        /// <param name="value">Boolean value</param>
+        /// <returns>The parameter</returns>
+        public static NeoContractParameter Boolean(bool value)
+        {
+            return new NeoContractParameter { Type = "Boolean", Value = value };
+        }
+
+        /// <summary>
+        /// Creates an Array parameter
+        /// </summary>
+        /// <param name="items">Items of the array</param>
+        /// <returns>The parameter</returns>
+        public static NeoContractParameter Array(params NeoContractParameter[] items)
+        {
+            return new NeoContractParameter { Type = "Array", Value = items.ToList() };
+        }
+
+        /// <summary>
+        /// Creates a Map parameter
+        /// </summary>
+        /// <param name="entries">Entries of the map, in order</param>
+        /// <returns>The parameter</returns>
+        public static NeoContractParameter Map(IEnumerable<NeoContractParameterMapEntry> entries)
+        {
+            return new NeoContractParameter { Type = "Map", Value = entries.ToList() };
+        }
+
+        /// <summary>
+        /// Reads a parameter from its JSON form, <c>{ "type": ..., "value": ... }</c>, as accepted by <c>invokefunction</c>
+        /// </summary>
+        /// <remarks>
+        /// Plain JSON values, as functions pass them, are typed by their form: script hashes and Neo addresses become
+        /// Hash160, other strings String, whole numbers Integer, booleans Boolean, null Any, lists Array, and other
+        /// objects a Map with String keys.
+        /// </remarks>
+        /// <param name="json">The JSON parameter</param>
+        /// <returns>The parameter, with scalar values as strings or booleans and nested parameters read recursively</returns>
+        /// <exception cref="FormatException">The JSON is not a contract parameter</exception>
+        public static NeoContractParameter FromJson(JsonElement json)
+        {
+            if (json.ValueKind == JsonValueKind.Object && TryGetProperty(json, "type", out var type) && type.ValueKind == JsonValueKind.String)
+            {
+                return TryGetProperty(json, "value", out var value)
+                    ? FromJson(type.GetString(), value)
+                    : new NeoContractParameter { Type = type.GetString() };
+            }
+
+            switch (json.ValueKind)
+            {
+                case JsonValueKind.String:
+                    var text = json.GetString();
+                    return text.IsValidScriptHash() || text.IsValidNeoAddress() ? Hash160(text) : String(text);
+                case JsonValueKind.Number:
+                    return BigInteger.TryParse(json.GetRawText(), NumberStyles.AllowLeadingSign, CultureInfo.InvariantCulture, out var integer)
+                        ? Integer(integer)
+                        : throw new FormatException($"Numbers must be whole to be passed as Integer, not {json.GetRawText()}");
+                case JsonValueKind.True:
+                case JsonValueKind.False:
+                    return Boolean(json.GetBoolean());
+                case JsonValueKind.Array:
+                    return Array(json.EnumerateArray().Select(FromJson).ToArray());
+                case JsonValueKind.Object:
+                    return Map(json.EnumerateObject().Select(property => new NeoContractParameterMapEntry
+                    {
+                        Key = String(property.Name),
+                        Value = FromJson(property.Value)
+                    }));
+                default:
+                    return new NeoContractParameter { Type = "Any" };
+            }
+        }
+
+        /// <summary>
+        /// Reads a parameter from its type and the JSON form of its value
+        /// </summary>
+        /// <param name="type">The parameter type</param>
+        /// <param name="value">The JSON value</param>
+        /// <returns>The parameter, with scalar values as strings or booleans and nested parameters read recursively</returns>
+        /// <exception cref="FormatException">The value does not suit the type</exception>
+        public static NeoContractParameter FromJson(string type, JsonElement value)
+        {
+            var parameter = new NeoContractParameter { Type = type };
+            if (value.ValueKind == JsonValueKind.Null || value.ValueKind == JsonValueKind.Undefined)
+            {
+                return parameter;
+            }
+
+            switch (parameter.Type)
+            {
+                case "Array":
+                    if (value.ValueKind != JsonValueKind.Array)
+                    {
+                        throw new FormatException("An Array parameter's value must be a list of parameters");
+                    }
+
+                    parameter.Value = value.EnumerateArray().Select(FromJson).ToList();
+                    break;
+                case "Map":
+                    if (value.ValueKind != JsonValueKind.Array)
+                    {
+                        throw new FormatException("A Map parameter's value must be a list of key and value entries");
+                    }
+
+                    parameter.Value = value.EnumerateArray().Select(entry =>
+                        entry.ValueKind == JsonValueKind.Object && TryGetProperty(entry, "key", out var key) && TryGetProperty(entry, "value", out var entryValue)
+                            ? new NeoContractParameterMapEntry { Key = FromJson(key), Value = FromJson(entryValue) }
+                            : throw new FormatException("A Map entry must have a key and a value")).ToList();
+                    break;
+                default:
+                    parameter.Value = value.ValueKind switch
+                    {
+                        JsonValueKind.True => true,
+                        JsonValueKind.False => false,
+                        JsonValueKind.String => value.GetString(),
+                        JsonValueKind.Number => value.GetRawText(),
+                        _ => throw new FormatException($"A {parameter.Type} parameter's value must be a string, number or boolean")
+                    };
+                    break;
+            }
+
+            return parameter;
+        }
+
+        private static bool TryGetProperty(JsonElement json, string name, out JsonElement value)
+        {
+            // Parameters arrive both camel-cased and as serialized by .NET
+            foreach (var property in json.EnumerateObject())
+            {
+                if (string.Equals(property.Name, name, StringComparison.OrdinalIgnoreCase))
+                {
+                    value = property.Value;
+                    return true;
+                }
+            }
+
+            value = default;
+            return false;
+        }
+    }
+
+    /// <summary>
+    /// An entry of a Map contract parameter
+    /// </summary>
+    public class NeoContractParameterMapEntry
+    {
+        /// <summary>
+        /// Gets or sets the key; Neo map keys are primitive types such as String, Integer or ByteArray
+        /// </summary>
+        public NeoContractParameter Key { get; set; }
+
+        /// <summary>
+        /// Gets or sets the value
+        /// </summary>
+        public NeoContractParameter Value { get; set; }
+    }
+}
@@ -0,0 +1,25 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models.Blockchain
+{
+    /// <summary>
+    /// Represents one read-only contract call in a batch
+    /// </summary>
+    public class NeoInvocation
+    {
+        /// <summary>
+        /// Gets or sets the contract script hash
+        /// </summary>
+        public string ScriptHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the method name
+        /// </summary>
+        public string Operation { get; set; }
+
+        /// <summary>
+        /// Gets or sets the method arguments
+        /// </summary>
+        public List<NeoContractParameter> Args { get; set; } = new List<NeoContractParameter>();
+    }
+}
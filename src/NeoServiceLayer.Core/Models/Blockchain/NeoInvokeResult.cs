@@ -0,0 +1,55 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models.Blockchain
+{
+    /// <summary>
+    /// Represents the result of a read-only Neo invocation
+    /// </summary>
+    public class NeoInvokeResult
+    {
+        /// <summary>
+        /// Gets or sets the invoked script in Base64
+        /// </summary>
+        public string Script { get; set; }
+
+        /// <summary>
+        /// Gets or sets the VM state after execution (HALT or FAULT)
+        /// </summary>
+        public string State { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS consumed by the invocation
+        /// </summary>
+        public string GasConsumed { get; set; }
+
+        /// <summary>
+        /// Gets or sets the VM exception message, if any
+        /// </summary>
+        public string Exception { get; set; }
+
+        /// <summary>
+        /// Gets or sets the result stack
+        /// </summary>
+        public List<NeoStackItem> Stack { get; set; } = new List<NeoStackItem>();
+
+        /// <summary>
+        /// Gets or sets the RPC session ID, set when the result stack contains iterators
+        /// </summary>
+        public string Session { get; set; }
+
+        /// <summary>
+        /// Gets or sets the block index the invocation was evaluated at, null for the latest state
+        /// </summary>
+        public long? BlockIndex { get; set; }
+
+        /// <summary>
+        /// Gets or sets the state root hash the invocation was evaluated against, null for the latest state
+        /// </summary>
+        public string StateRoot { get; set; }
+
+        /// <summary>
+        /// Gets a value indicating whether the invocation halted successfully
+        /// </summary>
+        public bool IsHalt => State == "HALT";
+    }
+}
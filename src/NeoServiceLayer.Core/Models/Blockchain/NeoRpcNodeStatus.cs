@@ -0,0 +1,75 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models.Blockchain
+{
+    /// <summary>
+    /// Health and call statistics of a Neo node used by the RPC client
+    /// </summary>
+    public class NeoRpcNodeStatus
+    {
+        /// <summary>
+        /// Gets or sets the node's RPC URL
+        /// </summary>
+        public string Url { get; set; }
+
+        /// <summary>
+        /// Gets or sets the node's share of calls relative to the other healthy nodes
+        /// </summary>
+        public int Weight { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether the node is in rotation
+        /// </summary>
+        public bool IsHealthy { get; set; }
+
+        /// <summary>
+        /// Gets or sets the block count the node last reported
+        /// </summary>
+        public long? BlockCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets how many blocks the node is behind the highest node
+        /// </summary>
+        public long BlockLag { get; set; }
+
+        /// <summary>
+        /// Gets or sets the moving average latency of the node's calls and probes, in milliseconds
+        /// </summary>
+        public double AverageLatencyMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of calls and probes sent to the node
+        /// </summary>
+        public long Requests { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of calls and probes that failed
+        /// </summary>
+        public long Errors { get; set; }
+
+        /// <summary>
+        /// Gets the share of calls and probes that failed
+        /// </summary>
+        public double ErrorRate => Requests == 0 ? 0 : (double)Errors / Requests;
+
+        /// <summary>
+        /// Gets or sets the number of failed calls or probes in a row
+        /// </summary>
+        public int ConsecutiveFailures { get; set; }
+
+        /// <summary>
+        /// Gets or sets the error of the last failure
+        /// </summary>
+        public string LastError { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the node was last probed
+        /// </summary>
+        public DateTime? LastCheckedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the node was taken out of rotation, if it is out
+        /// </summary>
+        public DateTime? UnhealthySince { get; set; }
+    }
+}
@@ -0,0 +1,26 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models.Blockchain
+{
+    /// <summary>
+    /// Represents a stack item returned by a Neo invocation
+    /// </summary>
+    public class NeoStackItem
+    {
+        /// <summary>
+        /// Gets or sets the stack item type (e.g., Integer, ByteString, Boolean, Array, Map)
+        /// </summary>
+        public string Type { get; set; }
+
+        /// <summary>
+        /// Gets or sets the raw value of the stack item as returned by the node; for InteropInterface
+        /// items this is the iterator ID to pass to <c>traverseiterator</c>
+        /// </summary>
+        public string Value { get; set; }
+
+        /// <summary>
+        /// Gets or sets the child items for Array, Struct and Map items
+        /// </summary>
+        public List<NeoStackItem> Items { get; set; }
+    }
+}
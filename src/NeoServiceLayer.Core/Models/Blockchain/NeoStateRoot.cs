@@ -0,0 +1,23 @@
+namespace NeoServiceLayer.Core.Models.Blockchain
+{
+    /// <summary>
+    /// Represents the state root of a Neo block
+    /// </summary>
+    public class NeoStateRoot
+    {
+        /// <summary>
+        /// Gets or sets the state root version
+        /// </summary>
+        public int Version { get; set; }
+
+        /// <summary>
+        /// Gets or sets the block index
+        /// </summary>
+        public long Index { get; set; }
+
+        /// <summary>
+        /// Gets or sets the state root hash
+        /// </summary>
+        public string RootHash { get; set; }
+    }
+}
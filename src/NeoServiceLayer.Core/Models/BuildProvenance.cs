@@ -0,0 +1,62 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents the build metadata of the running server
+    /// </summary>
+    public class BuildProvenance
+    {
+        /// <summary>
+        /// Gets or sets the informational version of the API assembly
+        /// </summary>
+        public string Version { get; set; }
+
+        /// <summary>
+        /// Gets or sets the git commit the server was built from
+        /// </summary>
+        public string GitCommit { get; set; }
+
+        /// <summary>
+        /// Gets or sets the system that built the server
+        /// </summary>
+        public string Builder { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the server was built
+        /// </summary>
+        public DateTime? BuildTime { get; set; }
+
+        /// <summary>
+        /// Gets or sets the SHA-256 digest of each assembly shipped with the server
+        /// </summary>
+        public List<DependencyDigest> Dependencies { get; set; } = new List<DependencyDigest>();
+
+        /// <summary>
+        /// Gets or sets the SHA-256 digest over all dependency digests
+        /// </summary>
+        public string DependenciesDigest { get; set; }
+    }
+
+    /// <summary>
+    /// Represents the digest of one shipped assembly
+    /// </summary>
+    public class DependencyDigest
+    {
+        /// <summary>
+        /// Gets or sets the file name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the assembly version
+        /// </summary>
+        public string Version { get; set; }
+
+        /// <summary>
+        /// Gets or sets the hex SHA-256 digest of the file
+        /// </summary>
+        public string Sha256 { get; set; }
+    }
+}
@@ -0,0 +1,36 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a single secret in a bulk provisioning request
+    /// </summary>
+    public class BulkSecretItem
+    {
+        /// <summary>
+        /// Gets or sets the name of the secret
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the value of the secret
+        /// </summary>
+        public string Value { get; set; }
+
+        /// <summary>
+        /// Gets or sets the description of the secret
+        /// </summary>
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Gets or sets the list of function IDs that are allowed to access the secret
+        /// </summary>
+        public List<Guid> AllowedFunctionIds { get; set; }
+
+        /// <summary>
+        /// Gets or sets the expiration date of the secret
+        /// </summary>
+        public DateTime? ExpiresAt { get; set; }
+    }
+}
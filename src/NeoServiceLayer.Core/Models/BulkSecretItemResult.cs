@@ -0,0 +1,40 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents the outcome of a single secret in a bulk provisioning request
+    /// </summary>
+    public class BulkSecretItemResult
+    {
+        /// <summary>
+        /// Gets or sets the name of the secret
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the secret ID
+        /// </summary>
+        public Guid? SecretId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the action taken for the secret (Created, Updated, None)
+        /// </summary>
+        public string Action { get; set; }
+
+        /// <summary>
+        /// Gets or sets the version of the secret after the operation
+        /// </summary>
+        public int? Version { get; set; }
+
+        /// <summary>
+        /// Gets or sets a value indicating whether the item was applied
+        /// </summary>
+        public bool Success { get; set; }
+
+        /// <summary>
+        /// Gets or sets the error message if the item failed
+        /// </summary>
+        public string Error { get; set; }
+    }
+}
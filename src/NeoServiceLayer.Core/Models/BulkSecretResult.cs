@@ -0,0 +1,25 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents the outcome of a bulk secret provisioning request
+    /// </summary>
+    public class BulkSecretResult
+    {
+        /// <summary>
+        /// Gets or sets a value indicating whether all secrets were applied
+        /// </summary>
+        public bool Success { get; set; }
+
+        /// <summary>
+        /// Gets or sets a value indicating whether applied changes were rolled back
+        /// </summary>
+        public bool RolledBack { get; set; }
+
+        /// <summary>
+        /// Gets or sets the per-item results in request order
+        /// </summary>
+        public List<BulkSecretItemResult> Items { get; set; } = new List<BulkSecretItemResult>();
+    }
+}
@@ -0,0 +1,60 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Fee level of a chain, with the estimated cost of a plain transfer for comparing chains
+    /// </summary>
+    public class ChainGasPrice
+    {
+        /// <summary>
+        /// Gets or sets the chain name, such as neo, ethereum or bsc
+        /// </summary>
+        public string Chain { get; set; }
+
+        /// <summary>
+        /// Gets or sets the symbol of the token fees are paid in
+        /// </summary>
+        public string NativeSymbol { get; set; }
+
+        /// <summary>
+        /// Gets or sets the fee per gas unit, or per transaction byte on Neo, in the native token
+        /// </summary>
+        public decimal GasPrice { get; set; }
+
+        /// <summary>
+        /// Gets or sets the factor execution units are multiplied by on Neo, or null on other chains
+        /// </summary>
+        public long? ExecFeeFactor { get; set; }
+
+        /// <summary>
+        /// Gets or sets the estimated fee of a plain transfer, in the native token
+        /// </summary>
+        public decimal TransferFee { get; set; }
+
+        /// <summary>
+        /// Gets or sets the USD price of the native token, or null if it is not known
+        /// </summary>
+        public decimal? NativePriceUsd { get; set; }
+
+        /// <summary>
+        /// Gets or sets the estimated fee of a plain transfer in USD, or null if the native token's price is not known
+        /// </summary>
+        public decimal? TransferFeeUsd { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the fee level was read
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether the fee level is older than the oracle's maximum age
+        /// </summary>
+        public bool IsStale { get; set; }
+
+        /// <summary>
+        /// Gets or sets why the last read of the fee level failed, or null if it succeeded
+        /// </summary>
+        public string Error { get; set; }
+    }
+}
@@ -0,0 +1,51 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents an unhandled exception captured by the crash reporter
+    /// </summary>
+    public class CrashReport
+    {
+        /// <summary>
+        /// Gets or sets the report ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the component in which the exception occurred
+        /// </summary>
+        public string Component { get; set; }
+
+        /// <summary>
+        /// Gets or sets the exception type name
+        /// </summary>
+        public string ExceptionType { get; set; }
+
+        /// <summary>
+        /// Gets or sets the exception message
+        /// </summary>
+        public string Message { get; set; }
+
+        /// <summary>
+        /// Gets or sets the full stack trace, including inner exceptions
+        /// </summary>
+        public string StackTrace { get; set; }
+
+        /// <summary>
+        /// Gets or sets the context attached to the report
+        /// </summary>
+        public Dictionary<string, object> Context { get; set; } = new Dictionary<string, object>();
+
+        /// <summary>
+        /// Gets or sets the machine on which the exception occurred
+        /// </summary>
+        public string MachineName { get; set; }
+
+        /// <summary>
+        /// Gets or sets the timestamp of the report
+        /// </summary>
+        public DateTime Timestamp { get; set; }
+    }
+}
@@ -0,0 +1,28 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for crash reporting
+    /// </summary>
+    public class CrashReportingConfiguration
+    {
+        /// <summary>
+        /// Gets or sets the Sentry-compatible DSN that reports are forwarded to. Forwarding is disabled when empty
+        /// </summary>
+        public string SentryDsn { get; set; }
+
+        /// <summary>
+        /// Gets or sets the environment name attached to forwarded reports
+        /// </summary>
+        public string Environment { get; set; } = "production";
+
+        /// <summary>
+        /// Gets or sets the release attached to forwarded reports
+        /// </summary>
+        public string Release { get; set; }
+
+        /// <summary>
+        /// Gets or sets the timeout in seconds for forwarding a report
+        /// </summary>
+        public int ForwardTimeoutSeconds { get; set; } = 5;
+    }
+}
@@ -0,0 +1,13 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for encrypting execution data at rest
+    /// </summary>
+    public class DataEncryptionConfiguration
+    {
+        /// <summary>
+        /// Gets or sets a value indicating whether execution inputs, outputs and logs are encrypted when stored
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+    }
+}
@@ -0,0 +1,39 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Per-account data key used to encrypt execution data at rest
+    /// </summary>
+    /// <remarks>
+    /// Only the key wrapped by the enclave is stored. Revoking the key discards the wrapped key,
+    /// which makes all data encrypted with it unreadable.
+    /// </remarks>
+    public class DataKey
+    {
+        /// <summary>
+        /// Gets or sets the ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account ID
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the key wrapped by the enclave, or null once revoked
+        /// </summary>
+        public string WrappedKey { get; set; }
+
+        /// <summary>
+        /// Gets or sets the creation time
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the key was revoked
+        /// </summary>
+        public DateTime? RevokedAt { get; set; }
+    }
+}
@@ -26,5 +26,10 @@ namespace NeoServiceLayer.Core.Models
         /// Payload of the request
         /// </summary>
         public byte[] Payload { get; set; }
+
+        /// <summary>
+        /// Capability token authorizing the operation. Required for protected operations
+        /// </summary>
+        public string AuthToken { get; set; }
     }
 }
@@ -20,6 +20,16 @@ namespace NeoServiceLayer.Core.Models
         /// </summary>
         public string ErrorMessage { get; set; }
 
+        /// <summary>
+        /// Machine-readable category of a failed function execution, if the request ran one
+        /// </summary>
+        public string ErrorCode { get; set; }
+
+        /// <summary>
+        /// Gas units a failed function execution used, if the request ran one
+        /// </summary>
+        public long GasUsed { get; set; }
+
         /// <summary>
         /// Payload of the response
         /// </summary>
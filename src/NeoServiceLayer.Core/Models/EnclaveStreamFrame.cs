@@ -0,0 +1,90 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a single frame of a streaming enclave exchange
+    /// </summary>
+    /// <remarks>
+    /// The parent sends <see cref="EnclaveStreamFrameTypes.Input"/> frames followed by one
+    /// <see cref="EnclaveStreamFrameTypes.End"/> frame. The enclave answers with any number of
+    /// <see cref="EnclaveStreamFrameTypes.Log"/> frames and closes the stream with either a
+    /// <see cref="EnclaveStreamFrameTypes.Result"/> or an <see cref="EnclaveStreamFrameTypes.Error"/> frame.
+    /// </remarks>
+    public class EnclaveStreamFrame
+    {
+        /// <summary>
+        /// Identifier of the request the frame belongs to
+        /// </summary>
+        public string RequestId { get; set; }
+
+        /// <summary>
+        /// Frame type, one of <see cref="EnclaveStreamFrameTypes"/>
+        /// </summary>
+        public string Type { get; set; }
+
+        /// <summary>
+        /// Position of the frame within its direction of the stream, starting at zero
+        /// </summary>
+        public long Sequence { get; set; }
+
+        /// <summary>
+        /// Binary content: an input chunk or the serialized result
+        /// </summary>
+        public byte[] Data { get; set; }
+
+        /// <summary>
+        /// Text content: a log line or an error message
+        /// </summary>
+        public string Message { get; set; }
+
+        /// <summary>
+        /// Category of the failure, one of <see cref="FunctionErrorCodes"/>. Set on error frames only
+        /// </summary>
+        public string ErrorCode { get; set; }
+
+        /// <summary>
+        /// Gas units the failed execution used. Set on error frames only
+        /// </summary>
+        public long GasUsed { get; set; }
+
+        /// <summary>
+        /// Base64 ECDSA P-256 signature over the request ID followed by <see cref="Data"/>. Set on result frames only
+        /// </summary>
+        public string Signature { get; set; }
+
+        /// <summary>
+        /// Base64 SubjectPublicKeyInfo of the enclave key that produced <see cref="Signature"/>
+        /// </summary>
+        public string PublicKey { get; set; }
+    }
+
+    /// <summary>
+    /// Frame types used by <see cref="EnclaveStreamFrame"/>
+    /// </summary>
+    public static class EnclaveStreamFrameTypes
+    {
+        /// <summary>
+        /// A chunk of input sent by the parent
+        /// </summary>
+        public const string Input = "input";
+
+        /// <summary>
+        /// Marks the end of the input
+        /// </summary>
+        public const string End = "end";
+
+        /// <summary>
+        /// A log line produced while the function runs
+        /// </summary>
+        public const string Log = "log";
+
+        /// <summary>
+        /// The final, signed result
+        /// </summary>
+        public const string Result = "result";
+
+        /// <summary>
+        /// The execution failed; no result follows
+        /// </summary>
+        public const string Error = "error";
+    }
+}
@@ -23,7 +23,7 @@ namespace NeoServiceLayer.Core.Models
         public int NotificationIntervalSeconds { get; set; } = 10;
 
         /// <summary>
-        /// Gets or sets the start block height
+        /// Gets or sets the start block height; 0 starts at the chain tip when no checkpoint exists
         /// </summary>
         public long StartBlockHeight { get; set; } = 0;
 
@@ -32,6 +32,12 @@ namespace NeoServiceLayer.Core.Models
         /// </summary>
         public int MaxBlockBatchSize { get; set; } = 100;
 
+        /// <summary>
+        /// Gets or sets how many blocks deep, counting its own, a block must be before its events fire triggers;
+        /// 1 processes each block as soon as it is persisted
+        /// </summary>
+        public int TriggerEventConfirmations { get; set; } = 2;
+
         /// <summary>
         /// Gets or sets the maximum number of concurrent notifications
         /// </summary>
@@ -66,5 +72,16 @@ namespace NeoServiceLayer.Core.Models
         /// Gets or sets the maximum payload size in bytes
         /// </summary>
         public int MaxPayloadSizeBytes { get; set; } = 1048576; // 1 MB
+
+        /// <summary>
+        /// Gets or sets how often, in blocks, committee, candidate and policy state is polled for governance
+        /// subscriptions. Set to 0 to disable governance events
+        /// </summary>
+        public int GovernancePollIntervalBlocks { get; set; } = 1;
+
+        /// <summary>
+        /// Gets or sets the maximum number of contracts one account can watch for upgrades
+        /// </summary>
+        public int MaxWatchedContractsPerAccount { get; set; } = 50;
     }
 }
@@ -43,6 +43,12 @@ namespace NeoServiceLayer.Core.Models
         /// </summary>
         public List<EventFilter> Filters { get; set; } = new List<EventFilter>();
 
+        /// <summary>
+        /// Gets or sets a condition over the event values that must also hold, such as
+        /// <c>amount &gt;= 100000000 AND to == "0x..."</c>, or null to match on the filters alone
+        /// </summary>
+        public string Condition { get; set; }
+
         /// <summary>
         /// Gets or sets the callback URL to notify when the event is triggered
         /// </summary>
@@ -53,6 +59,17 @@ namespace NeoServiceLayer.Core.Models
         /// </summary>
         public Guid? FunctionId { get; set; }
 
+        /// <summary>
+        /// Gets or sets the parameters passed to the function instead of the event, or null to pass the event.
+        /// String values can reference the event and filter results with placeholders such as <c>{{event.state[2]}}</c>
+        /// </summary>
+        public Dictionary<string, object> FunctionParameters { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS payout to distribute when the subscription fires
+        /// </summary>
+        public GasPayoutAction PayoutAction { get; set; }
+
         /// <summary>
         /// Gets or sets the status of the subscription
         /// </summary>
@@ -117,6 +134,11 @@ namespace NeoServiceLayer.Core.Models
         /// Gets or sets whether to include the full event data in the callback
         /// </summary>
         public bool IncludeEventData { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets the tags used to organize and filter subscriptions
+        /// </summary>
+        public List<string> Tags { get; set; } = new List<string>();
     }
 
     /// <summary>
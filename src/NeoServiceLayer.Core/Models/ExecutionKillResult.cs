@@ -0,0 +1,25 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Result of asking the enclave to interrupt a running function execution
+    /// </summary>
+    public class ExecutionKillResult
+    {
+        /// <summary>
+        /// Gets or sets the execution ID
+        /// </summary>
+        public Guid ExecutionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether the execution was running in the enclave and has been signalled
+        /// </summary>
+        public bool Killed { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the enclave handled the request
+        /// </summary>
+        public DateTime Timestamp { get; set; }
+    }
+}
@@ -0,0 +1,18 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Priority lane of a queued function execution within its tier
+    /// </summary>
+    public enum ExecutionLane
+    {
+        /// <summary>
+        /// Started by an authenticated API caller who is waiting for the result
+        /// </summary>
+        Interactive,
+
+        /// <summary>
+        /// Started by a schedule, webhook or blockchain event
+        /// </summary>
+        Triggered
+    }
+}
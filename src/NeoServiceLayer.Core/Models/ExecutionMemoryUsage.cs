@@ -0,0 +1,20 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Memory a running function execution has allocated so far, as measured by the enclave
+    /// </summary>
+    public class ExecutionMemoryUsage
+    {
+        /// <summary>
+        /// Gets or sets the execution ID
+        /// </summary>
+        public Guid ExecutionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the memory the execution has allocated in megabytes
+        /// </summary>
+        public double MemoryUsageMb { get; set; }
+    }
+}
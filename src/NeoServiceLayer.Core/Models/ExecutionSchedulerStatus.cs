@@ -0,0 +1,126 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Status of the execution scheduler of an API instance
+    /// </summary>
+    public class ExecutionSchedulerStatus
+    {
+        /// <summary>
+        /// Gets or sets the maximum number of executions running at once; 0 means unlimited
+        /// </summary>
+        public int MaxConcurrentExecutions { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of processors the worker pool was sized for
+        /// </summary>
+        public int ProcessorCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the memory available to the instance when the worker pool was sized, in megabytes
+        /// </summary>
+        public long AvailableMemoryMb { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of executions holding a slot
+        /// </summary>
+        public int Running { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of executions waiting for a slot
+        /// </summary>
+        public int Queued { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of queued executions held back because their account is at its concurrency limit
+        /// </summary>
+        public int Throttled { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether slots are shared by weight; when false they go first come, first served
+        /// </summary>
+        public bool PrioritizationEnabled { get; set; }
+
+        /// <summary>
+        /// Gets or sets the status of each tier
+        /// </summary>
+        public List<ExecutionTierStatus> Tiers { get; set; } = new List<ExecutionTierStatus>();
+    }
+
+    /// <summary>
+    /// Scheduling status of an execution tier since the instance started
+    /// </summary>
+    public class ExecutionTierStatus
+    {
+        /// <summary>
+        /// Gets or sets the tier name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the weight in effect, including any feature flag override
+        /// </summary>
+        public double Weight { get; set; }
+
+        /// <summary>
+        /// Gets or sets the minimum share of slots the tier gets while every tier is queued
+        /// </summary>
+        public double GuaranteedShare { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of executions waiting
+        /// </summary>
+        public int Queued { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of waiting executions started by API callers
+        /// </summary>
+        public int QueuedInteractive { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of waiting executions started by schedules, webhooks and events
+        /// </summary>
+        public int QueuedTriggered { get; set; }
+
+        /// <summary>
+        /// Gets or sets the per-account concurrency limit of the tier; 0 means unlimited
+        /// </summary>
+        public int MaxConcurrentPerAccount { get; set; }
+
+        /// <summary>
+        /// Gets or sets how long the oldest waiting execution has waited, in milliseconds
+        /// </summary>
+        public double OldestWaitMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of executions started
+        /// </summary>
+        public long Started { get; set; }
+
+        /// <summary>
+        /// Gets or sets the average time started executions waited, in milliseconds
+        /// </summary>
+        public double AverageWaitMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the longest time a started execution waited, in milliseconds
+        /// </summary>
+        public double MaxWaitMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of executions started ahead of their turn because they waited past the starvation threshold
+        /// </summary>
+        public long StarvationPromotions { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of executions that gave up waiting
+        /// </summary>
+        public long TimedOut { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of executions refused because the queue was full
+        /// </summary>
+        public long Rejected { get; set; }
+    }
+}
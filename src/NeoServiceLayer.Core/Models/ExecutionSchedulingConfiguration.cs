@@ -0,0 +1,87 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for scheduling function executions when the instance is saturated
+    /// </summary>
+    public class ExecutionSchedulingConfiguration
+    {
+        /// <summary>
+        /// Gets or sets the maximum number of executions running at once on an API instance; 0 means unlimited
+        /// </summary>
+        public int MaxConcurrentExecutions { get; set; } = 64;
+
+        /// <summary>
+        /// Gets or sets how many executions may run at once per processor; 0 leaves the worker pool unsized by processors
+        /// </summary>
+        public double ExecutionsPerCpu { get; set; }
+
+        /// <summary>
+        /// Gets or sets the memory reserved per running execution, in megabytes; 0 leaves the worker pool unsized by memory
+        /// </summary>
+        public int MemoryPerExecutionMb { get; set; }
+
+        /// <summary>
+        /// Gets or sets the maximum number of executions of one account running at once on an API instance; further
+        /// executions of the account wait without holding up other accounts; 0 means unlimited
+        /// </summary>
+        public int MaxConcurrentPerAccount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the maximum number of executions waiting for a slot, across all tiers
+        /// </summary>
+        public int MaxQueueLength { get; set; } = 1000;
+
+        /// <summary>
+        /// Gets or sets how long an execution waits for a slot before it fails, in seconds
+        /// </summary>
+        public int QueueTimeoutSeconds { get; set; } = 30;
+
+        /// <summary>
+        /// Gets or sets how long an execution may wait before it is started ahead of higher-weighted tiers,
+        /// in seconds; 0 turns starvation protection off
+        /// </summary>
+        public int StarvationThresholdSeconds { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets how long an account's tier is cached, in seconds
+        /// </summary>
+        public int AccountTierCacheSeconds { get; set; } = 60;
+
+        /// <summary>
+        /// Gets or sets the tier of accounts that have not been assigned one
+        /// </summary>
+        public string DefaultTier { get; set; } = "free";
+
+        /// <summary>
+        /// Gets or sets the execution tiers
+        /// </summary>
+        /// <remarks>
+        /// When empty, a <c>paid</c> tier with weight 4 and the default tier with weight 1 are used.
+        /// </remarks>
+        public List<ExecutionTier> Tiers { get; set; } = new List<ExecutionTier>();
+    }
+
+    /// <summary>
+    /// Execution tier
+    /// </summary>
+    public class ExecutionTier
+    {
+        /// <summary>
+        /// Gets or sets the tier name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the weight; while tiers are queued, each gets slots in proportion to its weight
+        /// </summary>
+        public double Weight { get; set; } = 1;
+
+        /// <summary>
+        /// Gets or sets the per-account concurrency limit of accounts in the tier, overriding
+        /// <see cref="ExecutionSchedulingConfiguration.MaxConcurrentPerAccount"/>
+        /// </summary>
+        public int? MaxConcurrentPerAccount { get; set; }
+    }
+}
@@ -0,0 +1,40 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a runtime switch operators can change without a redeploy
+    /// </summary>
+    public class FeatureFlag
+    {
+        /// <summary>
+        /// Gets or sets the ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the name, e.g. execution-prioritization
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether the flag is on
+        /// </summary>
+        public bool Enabled { get; set; }
+
+        /// <summary>
+        /// Gets or sets an optional value, e.g. a weight, read by the code checking the flag
+        /// </summary>
+        public string Value { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the flag was last changed
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets who last changed the flag
+        /// </summary>
+        public string UpdatedBy { get; set; }
+    }
+}
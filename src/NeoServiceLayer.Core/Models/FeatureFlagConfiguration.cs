@@ -0,0 +1,16 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for feature flags
+    /// </summary>
+    public class FeatureFlagConfiguration
+    {
+        /// <summary>
+        /// Gets or sets how often flags are reloaded from storage, in seconds
+        /// </summary>
+        /// <remarks>
+        /// A change made on one instance applies there at once and on the others within this interval.
+        /// </remarks>
+        public int RefreshIntervalSeconds { get; set; } = 15;
+    }
+}
@@ -108,13 +108,24 @@ namespace NeoServiceLayer.Core.Models
         /// </summary>
         public DateTime UpdatedAt { get; set; }
 
+        /// <summary>
+        /// Revision of the function's settings, incremented by every update and used as its entity tag
+        /// </summary>
+        public long Revision { get; set; }
+
         /// <summary>
         /// Date and time when the function was last executed
         /// </summary>
         public DateTime? LastExecutedAt { get; set; }
 
         /// <summary>
-        /// Status of the function (Active, Inactive, Error)
+        /// Date and time when an administrator last cleared the function after a security review;
+        /// security events before this time no longer count towards anomaly thresholds
+        /// </summary>
+        public DateTime? SecurityReviewedAt { get; set; }
+
+        /// <summary>
+        /// Status of the function (Active, Inactive, Error, PendingReview)
         /// </summary>
         public string Status { get; set; }
 
@@ -148,6 +159,16 @@ namespace NeoServiceLayer.Core.Models
         /// </summary>
         public bool IsLatestVersion { get; set; } = true;
 
+        /// <summary>
+        /// Gets or sets the ID of the registry version the function runs, or null if none has been promoted
+        /// </summary>
+        public Guid? LiveVersionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of the registry version the function runs, or 0 if none has been promoted
+        /// </summary>
+        public int LiveVersionNumber { get; set; }
+
         /// <summary>
         /// Number of times the function has been executed
         /// </summary>
@@ -207,5 +228,32 @@ namespace NeoServiceLayer.Core.Models
         /// Gets or sets the output schema for the function
         /// </summary>
         public string OutputSchema { get; set; }
+
+        /// <summary>
+        /// Gets or sets the JavaScript the source code was built to; null when the source runs as is
+        /// </summary>
+        public string CompiledCode { get; set; }
+
+        /// <summary>
+        /// Gets or sets the source map relating <see cref="CompiledCode"/> to <see cref="SourceCode"/>
+        /// </summary>
+        public string SourceMap { get; set; }
+
+        /// <summary>
+        /// Gets or sets the capabilities the function is allowed to use (see <see cref="FunctionCapabilities"/>);
+        /// null when the function declares none, in which case its code is not restricted
+        /// </summary>
+        public List<string> Permissions { get; set; }
+
+        /// <summary>
+        /// Gets or sets the capabilities found in the source code when it was last deployed
+        /// </summary>
+        public List<string> DetectedCapabilities { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets the quorum the function's executions must reach across independent nodes;
+        /// null when the function runs on the local enclave only
+        /// </summary>
+        public QuorumPolicy Quorum { get; set; }
     }
 }
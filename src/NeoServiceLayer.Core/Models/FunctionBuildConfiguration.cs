@@ -0,0 +1,23 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for building function sources at deploy time
+    /// </summary>
+    public class FunctionBuildConfiguration
+    {
+        /// <summary>
+        /// Gets or sets the path of the esbuild binary
+        /// </summary>
+        public string EsbuildPath { get; set; } = "esbuild";
+
+        /// <summary>
+        /// Gets or sets the JavaScript version the sandbox runs
+        /// </summary>
+        public string Target { get; set; } = "es2020";
+
+        /// <summary>
+        /// Gets or sets the maximum time a build may take, in seconds
+        /// </summary>
+        public int TimeoutSeconds { get; set; } = 30;
+    }
+}
@@ -0,0 +1,54 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Names of the sandbox capabilities a function can declare as permissions
+    /// </summary>
+    public static class FunctionCapabilities
+    {
+        /// <summary>
+        /// Sending transactions: contract writes, oracle submissions and NFT transfers
+        /// </summary>
+        public const string Transactions = "transactions";
+
+        /// <summary>
+        /// Reading the account's secrets
+        /// </summary>
+        public const string Secrets = "secrets";
+
+        /// <summary>
+        /// Outbound network access, including callback URLs registered for events
+        /// </summary>
+        public const string Network = "network";
+
+        /// <summary>
+        /// Voting for consensus candidates and wrapping or unwrapping bNEO
+        /// </summary>
+        public const string Staking = "staking";
+
+        /// <summary>
+        /// All known capabilities
+        /// </summary>
+        public static readonly IReadOnlyList<string> All = new[] { Transactions, Secrets, Network, Staking };
+
+        /// <summary>
+        /// Checks if a name is a known capability
+        /// </summary>
+        /// <param name="name">Capability name</param>
+        /// <returns>True if the capability is known, false otherwise</returns>
+        public static bool IsKnown(string name)
+        {
+            foreach (var capability in All)
+            {
+                if (string.Equals(capability, name, StringComparison.OrdinalIgnoreCase))
+                {
+                    return true;
+                }
+            }
+
+            return false;
+        }
+    }
+}
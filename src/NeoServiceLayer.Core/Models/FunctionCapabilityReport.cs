@@ -0,0 +1,66 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Result of statically analyzing function code for the capabilities it uses
+    /// </summary>
+    public class FunctionCapabilityReport
+    {
+        /// <summary>
+        /// Gets or sets whether the runtime's code could be analyzed. Only JavaScript and TypeScript are analyzed
+        /// </summary>
+        public bool Analyzed { get; set; }
+
+        /// <summary>
+        /// Gets or sets the permissions declared by the function, or null if it declares none
+        /// </summary>
+        public List<string> DeclaredPermissions { get; set; }
+
+        /// <summary>
+        /// Gets or sets the capabilities the code uses
+        /// </summary>
+        public List<string> DetectedCapabilities { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets the capabilities the code uses without declaring them
+        /// </summary>
+        public List<string> UndeclaredCapabilities { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets the declared permissions the code does not use
+        /// </summary>
+        public List<string> UnusedPermissions { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets where each capability is used
+        /// </summary>
+        public List<FunctionCapabilityUsage> Usages { get; set; } = new List<FunctionCapabilityUsage>();
+
+        /// <summary>
+        /// Gets whether the code stays within its declared permissions. Functions that declare none are not restricted
+        /// </summary>
+        public bool IsWithinPermissions => DeclaredPermissions == null || UndeclaredCapabilities.Count == 0;
+    }
+
+    /// <summary>
+    /// A use of a capability in function code
+    /// </summary>
+    public class FunctionCapabilityUsage
+    {
+        /// <summary>
+        /// Gets or sets the capability used
+        /// </summary>
+        public string Capability { get; set; }
+
+        /// <summary>
+        /// Gets or sets the API that uses the capability (for example "blockchain.invokeWrite")
+        /// </summary>
+        public string Api { get; set; }
+
+        /// <summary>
+        /// Gets or sets the 1-based source line of the use
+        /// </summary>
+        public int Line { get; set; }
+    }
+}
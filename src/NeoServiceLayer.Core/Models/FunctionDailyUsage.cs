@@ -0,0 +1,127 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Resource usage of one function over one UTC day, accumulated as its executions finish
+    /// </summary>
+    public class FunctionDailyUsage
+    {
+        /// <summary>
+        /// Gets or sets the record ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the function ID
+        /// </summary>
+        public Guid FunctionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the account owning the function
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the day, as midnight UTC
+        /// </summary>
+        public DateTime Date { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of finished executions
+        /// </summary>
+        public long Invocations { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of executions that failed or were killed
+        /// </summary>
+        public long Errors { get; set; }
+
+        /// <summary>
+        /// Gets or sets the summed duration of the executions in milliseconds
+        /// </summary>
+        public double TotalDurationMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the longest duration in milliseconds
+        /// </summary>
+        public double MaxDurationMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the execution counts per duration bucket, from which percentiles are read
+        /// </summary>
+        public List<long> DurationHistogram { get; set; } = new List<long>();
+
+        /// <summary>
+        /// Gets or sets the most memory a single execution used, in megabytes
+        /// </summary>
+        public double PeakMemoryMb { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS charged for the executions
+        /// </summary>
+        public decimal GasCharged { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time of the last recorded execution
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+    }
+
+    /// <summary>
+    /// Usage statistics of a function for one day, as reported to its owner
+    /// </summary>
+    public class FunctionDailyStats
+    {
+        /// <summary>
+        /// Gets or sets the day, as midnight UTC
+        /// </summary>
+        public DateTime Date { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of finished executions
+        /// </summary>
+        public long Invocations { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of executions that failed or were killed
+        /// </summary>
+        public long Errors { get; set; }
+
+        /// <summary>
+        /// Gets or sets the share of executions that failed, between 0 and 1
+        /// </summary>
+        public double ErrorRate { get; set; }
+
+        /// <summary>
+        /// Gets or sets the mean duration in milliseconds
+        /// </summary>
+        public double AverageDurationMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the median duration in milliseconds
+        /// </summary>
+        public double P50DurationMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the 95th percentile duration in milliseconds
+        /// </summary>
+        public double P95DurationMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the longest duration in milliseconds
+        /// </summary>
+        public double MaxDurationMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the most memory a single execution used, in megabytes
+        /// </summary>
+        public double PeakMemoryMb { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS charged for the executions
+        /// </summary>
+        public decimal GasCharged { get; set; }
+    }
+}
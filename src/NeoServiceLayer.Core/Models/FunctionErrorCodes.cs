@@ -0,0 +1,74 @@
+using System;
+using NeoServiceLayer.Core.Exceptions;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Machine-readable categories of function execution failures
+    /// </summary>
+    public static class FunctionErrorCodes
+    {
+        /// <summary>
+        /// The function's code does not parse or compile
+        /// </summary>
+        public const string SyntaxError = "SyntaxError";
+
+        /// <summary>
+        /// The function ran past its execution time or statement budget
+        /// </summary>
+        public const string TimeoutError = "TimeoutError";
+
+        /// <summary>
+        /// The function exceeded its memory or call stack limit
+        /// </summary>
+        public const string MemoryLimitError = "MemoryLimitError";
+
+        /// <summary>
+        /// The sandbox refused a host call: an unknown service, a target outside the function's grants, or too many calls
+        /// </summary>
+        public const string HostCallDenied = "HostCallDenied";
+
+        /// <summary>
+        /// The function used up the gas its execution was metered with
+        /// </summary>
+        public const string GasExhausted = "GasExhausted";
+
+        /// <summary>
+        /// The function threw an error of its own
+        /// </summary>
+        public const string UserThrown = "UserThrown";
+
+        /// <summary>
+        /// The platform failed to run the function
+        /// </summary>
+        public const string InternalError = "InternalError";
+
+        /// <summary>
+        /// Checks whether running the function again unchanged may succeed
+        /// </summary>
+        /// <param name="errorCode">Error code</param>
+        /// <returns>True for timeouts and platform failures; false for failures caused by the function's code or grants</returns>
+        public static bool IsTransient(string errorCode)
+        {
+            return errorCode == TimeoutError || errorCode == InternalError;
+        }
+
+        /// <summary>
+        /// Finds the error code of a failed execution
+        /// </summary>
+        /// <param name="exception">The exception the execution failed with, possibly wrapping a <see cref="SandboxException"/></param>
+        /// <returns>The sandbox's error code, or null if the failure did not come from the sandbox</returns>
+        public static string Find(Exception exception)
+        {
+            for (var current = exception; current != null; current = current.InnerException)
+            {
+                if (current is SandboxException sandboxException && !string.IsNullOrEmpty(sandboxException.ErrorCode))
+                {
+                    return sandboxException.ErrorCode;
+                }
+            }
+
+            return null;
+        }
+    }
+}
@@ -0,0 +1,26 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// An HTTP response produced by a function running behind a webhook trigger in <see cref="WebhookResponseModes.Http"/> mode
+    /// </summary>
+    public class FunctionHttpResponse
+    {
+        /// <summary>
+        /// Gets or sets the HTTP status code
+        /// </summary>
+        public int StatusCode { get; set; } = 200;
+
+        /// <summary>
+        /// Gets or sets the response headers, including the content type
+        /// </summary>
+        public Dictionary<string, string> Headers { get; set; } = new Dictionary<string, string>(StringComparer.OrdinalIgnoreCase);
+
+        /// <summary>
+        /// Gets or sets the response body
+        /// </summary>
+        public string Body { get; set; }
+    }
+}
@@ -31,5 +31,15 @@ namespace NeoServiceLayer.Core.Models
         /// Gets or sets the log message
         /// </summary>
         public string Message { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the account whose data key encrypts the message
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets a value indicating whether the message is encrypted
+        /// </summary>
+        public bool IsEncrypted { get; set; }
     }
 }
@@ -0,0 +1,29 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for metering function executions and charging them to the owner's GasBank
+    /// </summary>
+    public class FunctionMeteringConfiguration
+    {
+        /// <summary>
+        /// Gets or sets whether executions are metered and charged
+        /// </summary>
+        public bool Enabled { get; set; }
+
+        /// <summary>
+        /// Gets or sets the most gas units one execution may use, however much GAS the owner has
+        /// </summary>
+        public long MaxGasUnitsPerExecution { get; set; } = 10_000_000;
+
+        /// <summary>
+        /// Gets or sets the GAS charged per gas unit
+        /// </summary>
+        public decimal GasPerUnit { get; set; } = 0.00000001m;
+
+        /// <summary>
+        /// Gets or sets whether functions whose owner has no GasBank account or no GAS left are refused;
+        /// when false they run with <see cref="MaxGasUnitsPerExecution"/> and are not charged
+        /// </summary>
+        public bool RequireFunding { get; set; }
+    }
+}
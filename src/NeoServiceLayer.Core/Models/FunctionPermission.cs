@@ -92,5 +92,15 @@ namespace NeoServiceLayer.Core.Models
         /// Gets or sets the specific operations denied
         /// </summary>
         public List<string> DeniedOperations { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets the maximum number of invocations the principal can make per period; null for no limit
+        /// </summary>
+        public int? InvokeRateLimit { get; set; }
+
+        /// <summary>
+        /// Gets or sets the invocation rate limit period in seconds
+        /// </summary>
+        public int InvokeRateLimitPeriodSeconds { get; set; } = 60;
     }
 }
@@ -114,6 +114,21 @@ namespace NeoServiceLayer.Core.Models
         /// </summary>
         public Guid FunctionId { get; set; }
 
+        /// <summary>
+        /// Gets or sets the ID of the account that owns the function
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the function version that ran, or null if the function had no promoted version
+        /// </summary>
+        public Guid? FunctionVersionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of the function version that ran, or 0 if the function had no promoted version
+        /// </summary>
+        public int FunctionVersionNumber { get; set; }
+
         /// <summary>
         /// Gets or sets the input parameters
         /// </summary>
@@ -134,6 +149,11 @@ namespace NeoServiceLayer.Core.Models
         /// </summary>
         public string Error { get; set; }
 
+        /// <summary>
+        /// Gets or sets the category of the error, one of <see cref="FunctionErrorCodes"/>
+        /// </summary>
+        public string ErrorCode { get; set; }
+
         /// <summary>
         /// Gets or sets the start time
         /// </summary>
@@ -169,6 +189,16 @@ namespace NeoServiceLayer.Core.Models
         /// </summary>
         public double DurationMs { get; set; }
 
+        /// <summary>
+        /// Gets or sets the gas units the sandbox metered for the execution
+        /// </summary>
+        public long GasUsed { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS charged to the owner's GasBank for the execution
+        /// </summary>
+        public decimal GasCharged { get; set; }
+
         /// <summary>
         /// Gets or sets the billing amount
         /// </summary>
@@ -189,10 +219,25 @@ namespace NeoServiceLayer.Core.Models
         /// </summary>
         public string TraceId { get; set; }
 
+        /// <summary>
+        /// Gets or sets the ID of the API request that started the execution
+        /// </summary>
+        public string RequestId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the account API key the execution was requested with, or null
+        /// </summary>
+        public Guid? CredentialId { get; set; }
+
         /// <summary>
         /// Gets or sets the span ID
         /// </summary>
         public string SpanId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the input, output and logs encrypted with the account's data key, or null if stored in plain text
+        /// </summary>
+        public string EncryptedData { get; set; }
     }
 
     /// <summary>
@@ -255,6 +300,11 @@ namespace NeoServiceLayer.Core.Models
         /// Gets or sets the output file path
         /// </summary>
         public string OutputFilePath { get; set; }
+
+        /// <summary>
+        /// Gets or sets the source map relating the compiled code to the source
+        /// </summary>
+        public string SourceMap { get; set; }
     }
 
     /// <summary>
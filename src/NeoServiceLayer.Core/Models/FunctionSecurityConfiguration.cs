@@ -0,0 +1,53 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for recording sandbox security events and disabling anomalous functions
+    /// </summary>
+    public class FunctionSecurityConfiguration
+    {
+        /// <summary>
+        /// Gets or sets whether security events are recorded
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets whether a function over any threshold is disabled pending review
+        /// </summary>
+        public bool AutoDisable { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets the window, in minutes, events are counted over
+        /// </summary>
+        public int WindowMinutes { get; set; } = 60;
+
+        /// <summary>
+        /// Gets or sets how many calls to undefined services a function may make per window
+        /// </summary>
+        public int UndefinedServiceThreshold { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets how many executions per window may exceed the host call limit
+        /// </summary>
+        public int ExcessiveHostCallsThreshold { get; set; } = 5;
+
+        /// <summary>
+        /// Gets or sets how many attempts to reach blocked targets a function may make per window
+        /// </summary>
+        public int BlockedTargetThreshold { get; set; } = 20;
+
+        /// <summary>
+        /// Gets the threshold for an event type
+        /// </summary>
+        /// <param name="type">Event type</param>
+        /// <returns>The number of events per window above which the function is anomalous</returns>
+        public int GetThreshold(FunctionSecurityEventType type)
+        {
+            return type switch
+            {
+                FunctionSecurityEventType.UndefinedService => UndefinedServiceThreshold,
+                FunctionSecurityEventType.ExcessiveHostCalls => ExcessiveHostCallsThreshold,
+                _ => BlockedTargetThreshold
+            };
+        }
+    }
+}
@@ -0,0 +1,103 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a security-relevant action a function attempted inside the sandbox
+    /// </summary>
+    public class FunctionSecurityEvent
+    {
+        /// <summary>
+        /// Gets or sets the event ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the function ID
+        /// </summary>
+        public Guid FunctionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account that owns the function
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the execution during which the event occurred
+        /// </summary>
+        public Guid ExecutionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the event type
+        /// </summary>
+        public FunctionSecurityEventType Type { get; set; }
+
+        /// <summary>
+        /// Gets or sets what the function tried to reach, e.g. the service name or price symbol
+        /// </summary>
+        public string Target { get; set; }
+
+        /// <summary>
+        /// Gets or sets a description of the event
+        /// </summary>
+        public string Detail { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the event occurred
+        /// </summary>
+        public DateTime Timestamp { get; set; }
+    }
+
+    /// <summary>
+    /// Type of sandbox security event
+    /// </summary>
+    public enum FunctionSecurityEventType
+    {
+        /// <summary>
+        /// The function called a host service that does not exist
+        /// </summary>
+        UndefinedService,
+
+        /// <summary>
+        /// The function exceeded the number of host calls allowed per execution
+        /// </summary>
+        ExcessiveHostCalls,
+
+        /// <summary>
+        /// The function tried to reach a target it is not allowed to, e.g. a price symbol above its tier
+        /// </summary>
+        BlockedTarget
+    }
+
+    /// <summary>
+    /// Security events of one function within a time window
+    /// </summary>
+    public class FunctionSecuritySummary
+    {
+        /// <summary>
+        /// Gets or sets the function ID
+        /// </summary>
+        public Guid FunctionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account that owns the function
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of events of each type
+        /// </summary>
+        public Dictionary<FunctionSecurityEventType, int> Counts { get; set; } = new Dictionary<FunctionSecurityEventType, int>();
+
+        /// <summary>
+        /// Gets or sets the total number of events
+        /// </summary>
+        public int Total { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the latest event occurred
+        /// </summary>
+        public DateTime? LastEventAt { get; set; }
+    }
+}
@@ -0,0 +1,95 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents one deployed version of a function's code and settings
+    /// </summary>
+    /// <remarks>
+    /// Versions are immutable once deployed. Promoting a version copies it onto the function, which then runs
+    /// it for every execution until another version is promoted.
+    /// </remarks>
+    public class FunctionVersion
+    {
+        /// <summary>
+        /// Gets or sets the unique identifier for the version
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the function ID
+        /// </summary>
+        public Guid FunctionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account ID that owns the function
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the version number, counting up from 1 for each deployment of the function
+        /// </summary>
+        public int Number { get; set; }
+
+        /// <summary>
+        /// Gets or sets the version label, such as "1.2.0"
+        /// </summary>
+        public string Label { get; set; }
+
+        /// <summary>
+        /// Gets or sets notes describing the change
+        /// </summary>
+        public string Notes { get; set; }
+
+        /// <summary>
+        /// Gets or sets the source code
+        /// </summary>
+        public string SourceCode { get; set; }
+
+        /// <summary>
+        /// Gets or sets the SHA-256 hash of the source code
+        /// </summary>
+        public string SourceCodeHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the entry point
+        /// </summary>
+        public string EntryPoint { get; set; }
+
+        /// <summary>
+        /// Gets or sets the maximum execution time in milliseconds
+        /// </summary>
+        public int MaxExecutionTime { get; set; }
+
+        /// <summary>
+        /// Gets or sets the maximum memory in megabytes
+        /// </summary>
+        public int MaxMemory { get; set; }
+
+        /// <summary>
+        /// Gets or sets the environment variables
+        /// </summary>
+        public Dictionary<string, string> EnvironmentVariables { get; set; } = new Dictionary<string, string>();
+
+        /// <summary>
+        /// Gets or sets who deployed the version
+        /// </summary>
+        public string CreatedBy { get; set; }
+
+        /// <summary>
+        /// Gets or sets the deployment timestamp
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the version was last promoted to live, or null if it never was
+        /// </summary>
+        public DateTime? PromotedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether the version is the one the function runs
+        /// </summary>
+        public bool IsLive { get; set; }
+    }
+}
@@ -1,18 +1,22 @@
 using System;
 using System.Collections.Generic;
+using NeoServiceLayer.Core.Interfaces;
 
 namespace NeoServiceLayer.Core.Models
 {
     /// <summary>
     /// Represents a GasBank account
     /// </summary>
-    public class GasBankAccount
+    public class GasBankAccount : IVersionedRecord
     {
         /// <summary>
         /// Gets or sets the ID
         /// </summary>
         public Guid Id { get; set; }
 
+        /// <inheritdoc/>
+        public int SchemaVersion { get; set; }
+
         /// <summary>
         /// Gets or sets the account ID
         /// </summary>
@@ -33,6 +37,11 @@ namespace NeoServiceLayer.Core.Models
         /// </summary>
         public decimal AllocatedAmount { get; set; }
 
+        /// <summary>
+        /// Gets or sets the part of the balance held in the account's hot wallet; the rest is in cold storage
+        /// </summary>
+        public decimal HotBalance { get; set; }
+
         /// <summary>
         /// Gets or sets the wallet ID
         /// </summary>
@@ -1,17 +1,21 @@
 using System;
+using NeoServiceLayer.Core.Interfaces;
 
 namespace NeoServiceLayer.Core.Models
 {
     /// <summary>
     /// Represents a GasBank allocation
     /// </summary>
-    public class GasBankAllocation
+    public class GasBankAllocation : IVersionedRecord
     {
         /// <summary>
         /// Gets or sets the ID
         /// </summary>
         public Guid Id { get; set; }
 
+        /// <inheritdoc/>
+        public int SchemaVersion { get; set; }
+
         /// <summary>
         /// Gets or sets the GasBank account ID
         /// </summary>
@@ -0,0 +1,101 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for the GasBank hot and cold wallet split
+    /// </summary>
+    public class GasBankConfiguration
+    {
+        /// <summary>
+        /// Gets or sets the Neo address of the cold wallet; the split is disabled when empty
+        /// </summary>
+        public string ColdWalletAddress { get; set; }
+
+        /// <summary>
+        /// Gets or sets the working float, in GAS, each GasBank account keeps in its hot wallet
+        /// </summary>
+        public decimal HotWalletFloat { get; set; } = 100;
+
+        /// <summary>
+        /// Gets or sets the smallest excess over the float, in GAS, that is worth a sweep transaction
+        /// </summary>
+        public decimal MinimumSweepAmount { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets how often hot wallets are swept and queued withdrawals retried, in seconds
+        /// </summary>
+        public int SweepIntervalSeconds { get; set; } = 300;
+
+        /// <summary>
+        /// Gets or sets how often expired payment streams are settled, in seconds
+        /// </summary>
+        public int PaymentStreamSettlementIntervalSeconds { get; set; } = 60;
+
+        /// <summary>
+        /// Gets or sets how often due recurring withdrawals are run and failed ones retried, in seconds
+        /// </summary>
+        public int RecurringWithdrawalIntervalSeconds { get; set; } = 60;
+
+        /// <summary>
+        /// Gets or sets the most recurring withdrawals an account can have that are not cancelled
+        /// </summary>
+        public int MaxRecurringWithdrawalsPerAccount { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets how many times a queued withdrawal is sent before it fails and its amount is returned
+        /// </summary>
+        public int MaxWithdrawalAttempts { get; set; } = 5;
+
+        /// <summary>
+        /// Gets or sets the delay before the first retry of a queued withdrawal, in seconds; it doubles with each failure
+        /// </summary>
+        public int WithdrawalRetryBaseSeconds { get; set; } = 60;
+
+        /// <summary>
+        /// Gets or sets the longest delay between retries of a queued withdrawal, in seconds
+        /// </summary>
+        public int WithdrawalRetryMaxSeconds { get; set; } = 3600;
+
+        /// <summary>
+        /// Gets or sets how often sent withdrawals are checked on chain, in seconds; 0 disables the check
+        /// </summary>
+        public int WithdrawalConfirmationIntervalSeconds { get; set; } = 30;
+
+        /// <summary>
+        /// Gets or sets how long a sent withdrawal's transfer may stay unknown to the chain before it is put in doubt, in minutes
+        /// </summary>
+        public int WithdrawalConfirmationTimeoutMinutes { get; set; } = 60;
+
+        /// <summary>
+        /// Gets or sets how many blocks deep, counting its own, a replenishment transfer must be before it is credited to the hot wallet
+        /// </summary>
+        public int DepositConfirmations { get; set; } = 1;
+
+        /// <summary>
+        /// Gets or sets how many blocks deep, counting its own, a withdrawal transfer must be before the withdrawal is confirmed
+        /// </summary>
+        public int WithdrawalConfirmations { get; set; } = 3;
+
+        /// <summary>
+        /// Gets or sets where GasBank accounts, transactions, withdrawals, allocations, payment streams and recurring withdrawals are stored;
+        /// "Default" uses the configured storage provider and "PostgreSql" a PostgreSQL database shared by all replicas
+        /// </summary>
+        public string StorageBackend { get; set; } = "Default";
+
+        /// <summary>
+        /// Gets or sets the PostgreSQL connection string used when <see cref="StorageBackend"/> is "PostgreSql"
+        /// </summary>
+        public string PostgreSqlConnectionString { get; set; }
+
+        /// <summary>
+        /// Gets a value indicating whether GasBank records are stored in PostgreSQL
+        /// </summary>
+        public bool UsePostgreSql => string.Equals(StorageBackend, "PostgreSql", StringComparison.OrdinalIgnoreCase);
+
+        /// <summary>
+        /// Gets a value indicating whether excess GAS is held in a cold wallet
+        /// </summary>
+        public bool ColdStorageEnabled => !string.IsNullOrEmpty(ColdWalletAddress);
+    }
+}
@@ -0,0 +1,58 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents the GAS held across all GasBank accounts
+    /// </summary>
+    public class GasBankTotals
+    {
+        /// <summary>
+        /// Gets or sets the number of GasBank accounts
+        /// </summary>
+        public int AccountCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the total balance
+        /// </summary>
+        public decimal Balance { get; set; }
+
+        /// <summary>
+        /// Gets or sets the total amount allocated to functions
+        /// </summary>
+        public decimal AllocatedAmount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the total held in hot wallets
+        /// </summary>
+        public decimal HotBalance { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of queued withdrawals
+        /// </summary>
+        public int QueuedWithdrawals { get; set; }
+
+        /// <summary>
+        /// Gets or sets the total amount of queued withdrawals
+        /// </summary>
+        public decimal QueuedWithdrawalAmount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of in-doubt withdrawals
+        /// </summary>
+        public int InDoubtWithdrawals { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of sent withdrawals waiting to be found on chain or to reach the required confirmations
+        /// </summary>
+        public int UnconfirmedWithdrawals { get; set; }
+
+        /// <summary>
+        /// Gets or sets how many confirmations a replenishment transfer needs before it is credited
+        /// </summary>
+        public int DepositConfirmations { get; set; }
+
+        /// <summary>
+        /// Gets or sets how many confirmations a withdrawal transfer needs before the withdrawal is confirmed
+        /// </summary>
+        public int WithdrawalConfirmations { get; set; }
+    }
+}
@@ -86,6 +86,36 @@ namespace NeoServiceLayer.Core.Models
         /// <summary>
         /// Function execution
         /// </summary>
-        FunctionExecution
+        FunctionExecution,
+
+        /// <summary>
+        /// Payout to a recipient of a distribution
+        /// </summary>
+        Payout,
+
+        /// <summary>
+        /// Excess GAS moved from the hot wallet to cold storage
+        /// </summary>
+        Sweep,
+
+        /// <summary>
+        /// GAS moved from cold storage back to the hot wallet
+        /// </summary>
+        Replenishment,
+
+        /// <summary>
+        /// Network fee of a transaction sent on the account's behalf, such as an automation upkeep
+        /// </summary>
+        NetworkFee,
+
+        /// <summary>
+        /// GAS paid to the payee of a payment stream when it settles
+        /// </summary>
+        StreamPayment,
+
+        /// <summary>
+        /// GAS received from the payer of a payment stream when it settles
+        /// </summary>
+        StreamReceipt
     }
 }
@@ -0,0 +1,122 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a withdrawal from a GasBank account
+    /// </summary>
+    public class GasBankWithdrawal
+    {
+        /// <summary>
+        /// Gets or sets the ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GasBank account ID
+        /// </summary>
+        public Guid GasBankAccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the amount
+        /// </summary>
+        public decimal Amount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the Neo address the GAS is sent to
+        /// </summary>
+        public string ToAddress { get; set; }
+
+        /// <summary>
+        /// Gets or sets the status
+        /// </summary>
+        public GasBankWithdrawalStatus Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets the transaction hash, set once the GAS has been sent
+        /// </summary>
+        public string TransactionHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the requested at timestamp
+        /// </summary>
+        public DateTime RequestedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the completed at timestamp
+        /// </summary>
+        public DateTime? CompletedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the transfer was settled on chain, whether it halted or faulted
+        /// </summary>
+        public DateTime? ConfirmedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets how many blocks deep the transfer was, counting its own, when it was last checked on chain
+        /// </summary>
+        public int Confirmations { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of failed attempts to send a queued withdrawal
+        /// </summary>
+        public int Attempts { get; set; }
+
+        /// <summary>
+        /// Gets or sets when a queued withdrawal that failed to send is tried again
+        /// </summary>
+        public DateTime? NextAttemptAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the error of the last failed attempt
+        /// </summary>
+        public string LastError { get; set; }
+
+        /// <summary>
+        /// Gets or sets why the withdrawal is in its current status, for operators
+        /// </summary>
+        public string StatusReason { get; set; }
+    }
+
+    /// <summary>
+    /// Represents the status of a GasBank withdrawal
+    /// </summary>
+    public enum GasBankWithdrawalStatus
+    {
+        /// <summary>
+        /// Waiting for the hot wallet to be replenished from cold storage, or for the next attempt after a failed send
+        /// </summary>
+        Queued,
+
+        /// <summary>
+        /// The GAS has been sent; the transfer is waiting to be found on chain and reach the required confirmations
+        /// </summary>
+        Completed,
+
+        /// <summary>
+        /// The transfer has been started and its amount taken from the balance and hot wallet
+        /// </summary>
+        Sending,
+
+        /// <summary>
+        /// The service stopped while the withdrawal was sending, or its transfer was not found on chain in time,
+        /// so it is not known whether the GAS left; an operator resolves it
+        /// </summary>
+        InDoubt,
+
+        /// <summary>
+        /// The GAS was not sent and the amount was returned to the balance, possibly after every attempt failed
+        /// </summary>
+        Failed,
+
+        /// <summary>
+        /// The transfer halted on chain and reached the required confirmations
+        /// </summary>
+        Confirmed,
+
+        /// <summary>
+        /// The transfer faulted on chain, so the GAS did not leave and the amount was returned to the balance
+        /// </summary>
+        FailedOnChain
+    }
+}
@@ -0,0 +1,222 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Rules deciding which transaction fees a GasBank account sponsors
+    /// </summary>
+    /// <remarks>
+    /// Every rule is optional. In a template an unset rule places no restriction; in a policy's overrides an unset
+    /// rule keeps the template's value.
+    /// </remarks>
+    public class GasFeePolicyRules
+    {
+        /// <summary>
+        /// Gets or sets the contract whose invocations are sponsored
+        /// </summary>
+        public string ContractHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the contract methods that are sponsored, e.g. <c>transfer</c> for NEP-17 transfers
+        /// </summary>
+        public List<string> Methods { get; set; }
+
+        /// <summary>
+        /// Gets or sets the highest fee sponsored for one transaction
+        /// </summary>
+        public decimal? MaxFeePerTransaction { get; set; }
+
+        /// <summary>
+        /// Gets or sets the most GAS sponsored per account per UTC day
+        /// </summary>
+        public decimal? DailyLimit { get; set; }
+
+        /// <summary>
+        /// Merges overrides over base rules
+        /// </summary>
+        /// <param name="rules">Base rules, usually a template version's</param>
+        /// <param name="overrides">Overrides; each rule that is set replaces the base rule</param>
+        /// <returns>The merged rules</returns>
+        public static GasFeePolicyRules Merge(GasFeePolicyRules rules, GasFeePolicyRules overrides)
+        {
+            rules ??= new GasFeePolicyRules();
+            overrides ??= new GasFeePolicyRules();
+
+            return new GasFeePolicyRules
+            {
+                ContractHash = overrides.ContractHash ?? rules.ContractHash,
+                Methods = overrides.Methods ?? rules.Methods,
+                MaxFeePerTransaction = overrides.MaxFeePerTransaction ?? rules.MaxFeePerTransaction,
+                DailyLimit = overrides.DailyLimit ?? rules.DailyLimit
+            };
+        }
+    }
+
+    /// <summary>
+    /// Published version of a fee policy template
+    /// </summary>
+    public class GasFeePolicyTemplateVersion
+    {
+        /// <summary>
+        /// Gets or sets the version number, starting at 1
+        /// </summary>
+        public int Version { get; set; }
+
+        /// <summary>
+        /// Gets or sets the rules of this version
+        /// </summary>
+        public GasFeePolicyRules Rules { get; set; }
+
+        /// <summary>
+        /// Gets or sets notes describing what changed in this version
+        /// </summary>
+        public string Notes { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the version was published
+        /// </summary>
+        public DateTime PublishedAt { get; set; }
+    }
+
+    /// <summary>
+    /// Reusable, versioned set of fee sponsorship rules
+    /// </summary>
+    /// <remarks>
+    /// Versions are immutable once published. Policies created from a template either pin a version or follow the
+    /// latest one, so publishing a new version updates every following policy at once.
+    /// </remarks>
+    public class GasFeePolicyTemplate
+    {
+        /// <summary>
+        /// Gets or sets the unique identifier for the template
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account that owns the template and may publish versions and apply it to other accounts
+        /// </summary>
+        public Guid OwnerAccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the template name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the template description
+        /// </summary>
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether accounts other than the owner may create policies from the template
+        /// </summary>
+        public bool IsPublic { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of the latest version
+        /// </summary>
+        public int LatestVersion { get; set; }
+
+        /// <summary>
+        /// Gets or sets the published versions, oldest first
+        /// </summary>
+        public List<GasFeePolicyTemplateVersion> Versions { get; set; } = new List<GasFeePolicyTemplateVersion>();
+
+        /// <summary>
+        /// Gets or sets the creation timestamp
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last update timestamp
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+    }
+
+    /// <summary>
+    /// Fee sponsorship policy of one account, created from a template
+    /// </summary>
+    /// <remarks>
+    /// The sponsor's GasBank account pays the fees of the covered account's transactions that match the effective
+    /// rules: the template version's rules with the policy's overrides applied.
+    /// </remarks>
+    public class GasFeePolicy
+    {
+        /// <summary>
+        /// Gets or sets the unique identifier for the policy
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account whose transaction fees are covered
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account that owns the paying GasBank account and manages the policy
+        /// </summary>
+        public Guid SponsorAccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GasBank account that pays the fees
+        /// </summary>
+        public Guid GasBankAccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the template the policy was created from
+        /// </summary>
+        public Guid TemplateId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the pinned template version, or null to follow the latest version
+        /// </summary>
+        public int? TemplateVersion { get; set; }
+
+        /// <summary>
+        /// Gets or sets the rules that replace the template's
+        /// </summary>
+        public GasFeePolicyRules Overrides { get; set; } = new GasFeePolicyRules();
+
+        /// <summary>
+        /// Gets or sets the template version and overrides merged; filled in when the policy is read
+        /// </summary>
+        public GasFeePolicyRules EffectiveRules { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS sponsored on <see cref="SpentDate"/>
+        /// </summary>
+        public decimal SpentToday { get; set; }
+
+        /// <summary>
+        /// Gets or sets the UTC day <see cref="SpentToday"/> counts
+        /// </summary>
+        public DateTime? SpentDate { get; set; }
+
+        /// <summary>
+        /// Gets or sets the creation timestamp
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last update timestamp
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+    }
+
+    /// <summary>
+    /// Result of applying a template to many accounts
+    /// </summary>
+    public class GasFeePolicyBulkResult
+    {
+        /// <summary>
+        /// Gets or sets the policies created
+        /// </summary>
+        public List<GasFeePolicy> Created { get; set; } = new List<GasFeePolicy>();
+
+        /// <summary>
+        /// Gets or sets the existing policies moved to the applied version
+        /// </summary>
+        public List<GasFeePolicy> Updated { get; set; } = new List<GasFeePolicy>();
+    }
+}
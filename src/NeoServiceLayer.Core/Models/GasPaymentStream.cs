@@ -0,0 +1,141 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a stream of GAS paid per second from one GasBank account to another
+    /// </summary>
+    /// <remarks>
+    /// Opening a stream reserves its cap in the payer's account. GAS accrues to the payee at the stream's rate
+    /// until the cap is reached or the stream expires or is closed, and the accrued amount is paid in one
+    /// settlement when the stream closes.
+    /// </remarks>
+    public class GasPaymentStream
+    {
+        /// <summary>
+        /// Gets or sets the unique identifier for the stream
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GasBank account paying the stream
+        /// </summary>
+        public Guid PayerGasBankAccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account ID that owns the paying GasBank account
+        /// </summary>
+        public Guid PayerAccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GasBank account receiving the stream
+        /// </summary>
+        public Guid PayeeGasBankAccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account ID that owns the receiving GasBank account
+        /// </summary>
+        public Guid PayeeAccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS accrued per second
+        /// </summary>
+        public decimal RatePerSecond { get; set; }
+
+        /// <summary>
+        /// Gets or sets the most GAS the stream can pay
+        /// </summary>
+        public decimal Cap { get; set; }
+
+        /// <summary>
+        /// Gets or sets the description of the stream
+        /// </summary>
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Gets or sets the status of the stream
+        /// </summary>
+        public GasPaymentStreamStatus Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets when GAS started accruing
+        /// </summary>
+        public DateTime StartedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when GAS stops accruing and the stream is settled
+        /// </summary>
+        public DateTime ExpiresAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the stream stopped accruing, or null while it is open
+        /// </summary>
+        public DateTime? ClosedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account that closed the stream, or null if it expired
+        /// </summary>
+        public Guid? ClosedBy { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS accrued so far; the amount paid once the stream is settled
+        /// </summary>
+        public decimal AccruedAmount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the hash of the settlement transfer
+        /// </summary>
+        public string SettlementTransactionHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the stream was settled
+        /// </summary>
+        public DateTime? SettledAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the error of the last failed settlement; settlement is retried until it succeeds
+        /// </summary>
+        public string LastError { get; set; }
+
+        /// <summary>
+        /// Gets or sets the creation timestamp
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last update timestamp
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+    }
+
+    /// <summary>
+    /// Represents the status of a GAS payment stream
+    /// </summary>
+    public enum GasPaymentStreamStatus
+    {
+        /// <summary>
+        /// GAS is accruing
+        /// </summary>
+        Open,
+
+        /// <summary>
+        /// GAS has stopped accruing and the stream is waiting to be settled
+        /// </summary>
+        Closed,
+
+        /// <summary>
+        /// The settlement transfer is being sent
+        /// </summary>
+        Settling,
+
+        /// <summary>
+        /// The accrued GAS has been paid and the rest of the cap released
+        /// </summary>
+        Settled,
+
+        /// <summary>
+        /// The service stopped while the settlement transfer was being sent; an operator checks the chain and resolves it
+        /// </summary>
+        InDoubt
+    }
+}
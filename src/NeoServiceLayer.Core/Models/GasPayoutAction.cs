@@ -0,0 +1,73 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a built-in action that splits a GAS amount among recipients by weight
+    /// </summary>
+    public class GasPayoutAction
+    {
+        /// <summary>
+        /// Gets or sets the GasBank account that funds the payout
+        /// </summary>
+        public Guid GasBankAccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the total amount of GAS distributed per firing
+        /// </summary>
+        public decimal TotalAmount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the recipients of the payout
+        /// </summary>
+        public List<GasPayoutRecipient> Recipients { get; set; } = new List<GasPayoutRecipient>();
+    }
+
+    /// <summary>
+    /// Represents a recipient of a GAS payout
+    /// </summary>
+    public class GasPayoutRecipient
+    {
+        /// <summary>
+        /// Gets or sets the Neo address of the recipient
+        /// </summary>
+        public string Address { get; set; }
+
+        /// <summary>
+        /// Gets or sets the relative weight of the recipient
+        /// </summary>
+        public decimal Weight { get; set; }
+    }
+
+    /// <summary>
+    /// Represents the receipt for a single recipient of a GAS payout
+    /// </summary>
+    public class GasPayoutReceipt
+    {
+        /// <summary>
+        /// Gets or sets the Neo address of the recipient
+        /// </summary>
+        public string Address { get; set; }
+
+        /// <summary>
+        /// Gets or sets the relative weight of the recipient
+        /// </summary>
+        public decimal Weight { get; set; }
+
+        /// <summary>
+        /// Gets or sets the amount of GAS paid to the recipient
+        /// </summary>
+        public decimal Amount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the hash of the transaction that carried the payout
+        /// </summary>
+        public string TransactionHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GasBank transaction ID recorded for the recipient
+        /// </summary>
+        public Guid GasBankTransactionId { get; set; }
+    }
+}
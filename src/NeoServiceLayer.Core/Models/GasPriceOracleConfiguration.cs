@@ -0,0 +1,71 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for tracking fee levels on Neo and external chains
+    /// </summary>
+    public class GasPriceOracleConfiguration
+    {
+        /// <summary>
+        /// Gets or sets whether fee levels are refreshed in the background
+        /// </summary>
+        public bool Enabled { get; set; }
+
+        /// <summary>
+        /// Gets or sets how often, in seconds, fee levels are refreshed
+        /// </summary>
+        public int RefreshIntervalSeconds { get; set; } = 60;
+
+        /// <summary>
+        /// Gets or sets the age, in seconds, after which a fee level is stale and no longer compared
+        /// </summary>
+        public int MaxAgeSeconds { get; set; } = 300;
+
+        /// <summary>
+        /// Gets or sets how long, in seconds, each chain is given to answer
+        /// </summary>
+        public int TimeoutSeconds { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets the size, in bytes, of the reference Neo transfer
+        /// </summary>
+        public int NeoTransferBytes { get; set; } = 250;
+
+        /// <summary>
+        /// Gets or sets the execution units of the reference Neo transfer, verification included, before the exec fee factor
+        /// </summary>
+        public long NeoTransferExecutionUnits { get; set; } = 66000;
+
+        /// <summary>
+        /// Gets or sets the external EVM chains to track; Neo is always tracked through the configured Neo node
+        /// </summary>
+        public List<GasPriceChain> Chains { get; set; } = new List<GasPriceChain>();
+    }
+
+    /// <summary>
+    /// External EVM chain whose gas price is read with <c>eth_gasPrice</c>
+    /// </summary>
+    public class GasPriceChain
+    {
+        /// <summary>
+        /// Gets or sets the chain name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the URL of a public JSON-RPC endpoint
+        /// </summary>
+        public string RpcUrl { get; set; }
+
+        /// <summary>
+        /// Gets or sets the symbol of the token fees are paid in
+        /// </summary>
+        public string NativeSymbol { get; set; }
+
+        /// <summary>
+        /// Gets or sets the gas units of the reference transfer
+        /// </summary>
+        public long TransferGasUnits { get; set; } = 21000;
+    }
+}
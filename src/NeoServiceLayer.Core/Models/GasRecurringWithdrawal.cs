@@ -0,0 +1,192 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a withdrawal from a GasBank account that repeats on a cron schedule
+    /// </summary>
+    /// <remarks>
+    /// Each occurrence withdraws a fixed amount, or, when no amount is set, sweeps everything above the floor. An
+    /// occurrence that fails is retried on each cycle until the next one is due.
+    /// </remarks>
+    public class GasRecurringWithdrawal
+    {
+        /// <summary>
+        /// Gets or sets the unique identifier for the recurring withdrawal
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account ID that owns the GasBank account
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GasBank account withdrawn from
+        /// </summary>
+        public Guid GasBankAccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the Neo address the GAS is sent to
+        /// </summary>
+        public string ToAddress { get; set; }
+
+        /// <summary>
+        /// Gets or sets the five-field cron expression the withdrawal runs on, evaluated in UTC
+        /// </summary>
+        public string Schedule { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS withdrawn per occurrence, or null to sweep the unallocated balance above the floor
+        /// </summary>
+        public decimal? Amount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the unallocated balance left in the account after each withdrawal
+        /// </summary>
+        public decimal Floor { get; set; }
+
+        /// <summary>
+        /// Gets or sets the smallest sweep worth sending; smaller sweeps are skipped
+        /// </summary>
+        public decimal MinimumAmount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the description of the recurring withdrawal
+        /// </summary>
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Gets or sets the status of the recurring withdrawal
+        /// </summary>
+        public GasRecurringWithdrawalStatus Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the next occurrence is due; a failed occurrence keeps this until it succeeds or the one after it is due
+        /// </summary>
+        public DateTime? NextRunAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when an occurrence last ran
+        /// </summary>
+        public DateTime? LastRunAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the error of the last failed attempt, or null if the last attempt succeeded
+        /// </summary>
+        public string LastError { get; set; }
+
+        /// <summary>
+        /// Gets or sets the creation timestamp
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last update timestamp
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+    }
+
+    /// <summary>
+    /// Represents the status of a recurring GasBank withdrawal
+    /// </summary>
+    public enum GasRecurringWithdrawalStatus
+    {
+        /// <summary>
+        /// Occurrences run on schedule
+        /// </summary>
+        Active,
+
+        /// <summary>
+        /// Occurrences are not run until the withdrawal is resumed
+        /// </summary>
+        Paused,
+
+        /// <summary>
+        /// The withdrawal will not run again
+        /// </summary>
+        Cancelled
+    }
+
+    /// <summary>
+    /// Represents one scheduled run of a recurring GasBank withdrawal
+    /// </summary>
+    public class GasRecurringWithdrawalOccurrence
+    {
+        /// <summary>
+        /// Gets or sets the unique identifier for the occurrence
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the recurring withdrawal the occurrence belongs to
+        /// </summary>
+        public Guid RecurringWithdrawalId { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the occurrence was due
+        /// </summary>
+        public DateTime ScheduledFor { get; set; }
+
+        /// <summary>
+        /// Gets or sets the outcome of the occurrence
+        /// </summary>
+        public GasRecurringWithdrawalOccurrenceStatus Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets how many times the occurrence has been attempted
+        /// </summary>
+        public int Attempts { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS withdrawn
+        /// </summary>
+        public decimal Amount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the withdrawal the occurrence made
+        /// </summary>
+        public Guid? WithdrawalId { get; set; }
+
+        /// <summary>
+        /// Gets or sets why the occurrence failed or was skipped
+        /// </summary>
+        public string Error { get; set; }
+
+        /// <summary>
+        /// Gets or sets the creation timestamp
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last update timestamp
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+    }
+
+    /// <summary>
+    /// Represents the outcome of a recurring GasBank withdrawal occurrence
+    /// </summary>
+    public enum GasRecurringWithdrawalOccurrenceStatus
+    {
+        /// <summary>
+        /// The withdrawal was requested
+        /// </summary>
+        Completed,
+
+        /// <summary>
+        /// Nothing was above the floor, or the sweep was below the minimum amount
+        /// </summary>
+        Skipped,
+
+        /// <summary>
+        /// The last attempt failed; the occurrence is retried until the next one is due
+        /// </summary>
+        Failed,
+
+        /// <summary>
+        /// Every attempt failed and the next occurrence is now due
+        /// </summary>
+        Missed
+    }
+}
@@ -0,0 +1,65 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Records a request a support user made, or tried to make, while impersonating an account
+    /// </summary>
+    public class ImpersonationAuditEntry
+    {
+        /// <summary>
+        /// Gets or sets the ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the grant the request was made under, null if it was refused for lack of one
+        /// </summary>
+        public Guid? GrantId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the impersonated account
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the support user
+        /// </summary>
+        public string SupportUserId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the HTTP method
+        /// </summary>
+        public string Method { get; set; }
+
+        /// <summary>
+        /// Gets or sets the request path and query string
+        /// </summary>
+        public string Path { get; set; }
+
+        /// <summary>
+        /// Gets or sets the response status code
+        /// </summary>
+        public int StatusCode { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether the request was allowed through
+        /// </summary>
+        public bool Allowed { get; set; }
+
+        /// <summary>
+        /// Gets or sets why the request was refused, if it was
+        /// </summary>
+        public string DeniedReason { get; set; }
+
+        /// <summary>
+        /// Gets or sets the request ID, for correlating with request logs
+        /// </summary>
+        public string RequestId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time of the request
+        /// </summary>
+        public DateTime Timestamp { get; set; }
+    }
+}
@@ -0,0 +1,36 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for support impersonation
+    /// </summary>
+    public class ImpersonationConfiguration
+    {
+        /// <summary>
+        /// Gets or sets the roles whose members may impersonate accounts they hold a grant for
+        /// </summary>
+        public List<string> SupportRoles { get; set; } = new List<string> { "Admin", "Support" };
+
+        /// <summary>
+        /// Gets or sets how long a grant lasts when no duration is given, in minutes
+        /// </summary>
+        public int DefaultGrantMinutes { get; set; } = 60;
+
+        /// <summary>
+        /// Gets or sets the longest a grant may last, in minutes
+        /// </summary>
+        public int MaxGrantMinutes { get; set; } = 1440;
+
+        /// <summary>
+        /// Gets or sets the path prefixes that may be read while impersonating
+        /// </summary>
+        public List<string> AllowedPathPrefixes { get; set; } = new List<string>
+        {
+            "/api/function",
+            "/api/schedules",
+            "/api/webhooks",
+            "/api/automation/upkeeps"
+        };
+    }
+}
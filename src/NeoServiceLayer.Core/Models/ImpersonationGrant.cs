@@ -0,0 +1,91 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Permission for a support user to view an account as its owner sees it
+    /// </summary>
+    /// <remarks>
+    /// A grant is either consent given by the account owner or a break-glass approval by an administrator
+    /// other than the support user. Impersonation under a grant is read-only and ends when the grant
+    /// expires or is revoked.
+    /// </remarks>
+    public class ImpersonationGrant
+    {
+        /// <summary>
+        /// Gets or sets the ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the account that may be impersonated
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the support user who may impersonate the account
+        /// </summary>
+        public string SupportUserId { get; set; }
+
+        /// <summary>
+        /// Gets or sets how the grant was given
+        /// </summary>
+        public ImpersonationGrantKind Kind { get; set; }
+
+        /// <summary>
+        /// Gets or sets why the support user needs access
+        /// </summary>
+        public string Reason { get; set; }
+
+        /// <summary>
+        /// Gets or sets the user who gave the grant: the account owner for consent, an administrator for break-glass
+        /// </summary>
+        public string GrantedBy { get; set; }
+
+        /// <summary>
+        /// Gets or sets the creation time
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the grant expires
+        /// </summary>
+        public DateTime ExpiresAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the grant was revoked, null if it was not
+        /// </summary>
+        public DateTime? RevokedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the user who revoked the grant
+        /// </summary>
+        public string RevokedBy { get; set; }
+
+        /// <summary>
+        /// Checks if the grant allows impersonation at a given time
+        /// </summary>
+        /// <param name="time">Time to check</param>
+        /// <returns>True if the grant is neither revoked nor expired at the time, false otherwise</returns>
+        public bool IsActiveAt(DateTime time)
+        {
+            return RevokedAt == null && CreatedAt <= time && ExpiresAt > time;
+        }
+    }
+
+    /// <summary>
+    /// How an impersonation grant was given
+    /// </summary>
+    public enum ImpersonationGrantKind
+    {
+        /// <summary>
+        /// The account owner consented
+        /// </summary>
+        Consent,
+
+        /// <summary>
+        /// An administrator approved access without the owner's consent
+        /// </summary>
+        BreakGlass
+    }
+}
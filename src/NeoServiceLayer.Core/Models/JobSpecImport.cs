@@ -0,0 +1,77 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Options for importing a Chainlink job spec
+    /// </summary>
+    public class JobSpecImportOptions
+    {
+        /// <summary>
+        /// Gets or sets whether to only convert the spec and return what would be created
+        /// </summary>
+        public bool DryRun { get; set; }
+
+        /// <summary>
+        /// Gets or sets the name of the imported function and trigger, or null to use the job's name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the Neo script hash of the contract a keeper job's upkeep calls; EVM contract addresses do not carry over
+        /// </summary>
+        public string ContractHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GasBank account paying a keeper job's upkeep
+        /// </summary>
+        public Guid? GasBankAccountId { get; set; }
+    }
+
+    /// <summary>
+    /// What a Chainlink job spec was converted into
+    /// </summary>
+    public class JobSpecImportResult
+    {
+        /// <summary>
+        /// Gets or sets the job type: cron, webhook or keeper
+        /// </summary>
+        public string JobType { get; set; }
+
+        /// <summary>
+        /// Gets or sets the job's name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether the function and triggers were created, false for a dry run
+        /// </summary>
+        public bool Created { get; set; }
+
+        /// <summary>
+        /// Gets or sets the function scaffolded from the job's pipeline, or null for keeper jobs
+        /// </summary>
+        public Function Function { get; set; }
+
+        /// <summary>
+        /// Gets or sets the schedule trigger a cron job became
+        /// </summary>
+        public ScheduleTrigger ScheduleTrigger { get; set; }
+
+        /// <summary>
+        /// Gets or sets the webhook trigger a webhook job became
+        /// </summary>
+        public WebhookTrigger WebhookTrigger { get; set; }
+
+        /// <summary>
+        /// Gets or sets the upkeep a keeper job became
+        /// </summary>
+        public Upkeep Upkeep { get; set; }
+
+        /// <summary>
+        /// Gets the parts of the job that were not converted and need porting by hand
+        /// </summary>
+        public List<string> Warnings { get; set; } = new List<string>();
+    }
+}
@@ -0,0 +1,43 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for the leadership lease that elects one active Functions/Trigger instance
+    /// </summary>
+    /// <remarks>
+    /// The lease only elects the instance that does background work; every instance still serves API writes. Checked
+    /// updates to records in a storage provider are serialized within one instance only, so deployments with several
+    /// instances send those writes to one of them, as described under Warm Standby in the API documentation.
+    /// </remarks>
+    public class LeadershipConfiguration
+    {
+        /// <summary>
+        /// Gets or sets a value indicating whether instances compete for the lease; when false every instance acts as leader
+        /// </summary>
+        public bool Enabled { get; set; }
+
+        /// <summary>
+        /// Gets or sets the lease name, shared by the primary and its standbys
+        /// </summary>
+        public string LeaseName { get; set; } = "functions";
+
+        /// <summary>
+        /// Gets or sets this instance's ID, or empty to use the machine name and process ID
+        /// </summary>
+        public string InstanceId { get; set; }
+
+        /// <summary>
+        /// Gets or sets how long a lease is valid without renewal, in seconds; a standby takes over at most this long after the leader stops
+        /// </summary>
+        public int LeaseSeconds { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets how often the lease is renewed or, on a standby, checked, in seconds
+        /// </summary>
+        public int RenewIntervalSeconds { get; set; } = 3;
+
+        /// <summary>
+        /// Gets or sets how long a leader removed by a forced failover may not take the lease back, in seconds
+        /// </summary>
+        public int FailoverCooldownSeconds { get; set; } = 60;
+    }
+}
@@ -0,0 +1,40 @@
+using Microsoft.Extensions.Logging;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for alerts over the service's log stream
+    /// </summary>
+    public class LogAlertConfiguration
+    {
+        /// <summary>
+        /// Gets or sets whether log entries are captured and alert rules evaluated
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets the lowest level captured; entries below it cannot match any rule
+        /// </summary>
+        public LogLevel MinimumLevel { get; set; } = LogLevel.Information;
+
+        /// <summary>
+        /// Gets or sets how often, in seconds, captured entries are matched against the rules
+        /// </summary>
+        public int EvaluationIntervalSeconds { get; set; } = 5;
+
+        /// <summary>
+        /// Gets or sets the number of entries held between evaluations; the oldest are dropped beyond it
+        /// </summary>
+        public int QueueCapacity { get; set; } = 10000;
+
+        /// <summary>
+        /// Gets or sets the maximum evaluation window in seconds
+        /// </summary>
+        public int MaxWindowSeconds { get; set; } = 86400;
+
+        /// <summary>
+        /// Gets or sets the maximum number of alert rules
+        /// </summary>
+        public int MaxRules { get; set; } = 100;
+    }
+}
@@ -0,0 +1,113 @@
+using System;
+using System.Collections.Generic;
+using Microsoft.Extensions.Logging;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Alert rule evaluated over the service's own log stream
+    /// </summary>
+    public class LogAlertRule
+    {
+        /// <summary>
+        /// Gets or sets the rule ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account ID that owns the rule and receives its notifications
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the rule name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the rule description
+        /// </summary>
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Gets or sets the regular expression matched against the log message and, if there is one, the exception message.
+        /// Every entry that passes the other filters matches when null
+        /// </summary>
+        public string Pattern { get; set; }
+
+        /// <summary>
+        /// Gets or sets the logger category prefix, e.g. <c>NeoServiceLayer.Services.GasBank</c>. All categories match when null
+        /// </summary>
+        public string Category { get; set; }
+
+        /// <summary>
+        /// Gets or sets the lowest level that matches
+        /// </summary>
+        public LogLevel MinimumLevel { get; set; } = LogLevel.Information;
+
+        /// <summary>
+        /// Gets or sets structured log fields that must all be present with these values, compared case-insensitively
+        /// </summary>
+        public Dictionary<string, string> Fields { get; set; } = new Dictionary<string, string>();
+
+        /// <summary>
+        /// Gets or sets the number of matches within the window at which the alert fires
+        /// </summary>
+        public int Threshold { get; set; } = 1;
+
+        /// <summary>
+        /// Gets or sets the window in seconds over which matches are counted
+        /// </summary>
+        public int WindowSeconds { get; set; } = 300;
+
+        /// <summary>
+        /// Gets or sets the channels the alert is dispatched to
+        /// </summary>
+        public List<NotificationChannel> Channels { get; set; } = new List<NotificationChannel>();
+
+        /// <summary>
+        /// Gets or sets whether a notification is sent when the alert resolves
+        /// </summary>
+        public bool NotifyOnResolve { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether the rule is enabled
+        /// </summary>
+        public bool IsEnabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets the current state of the rule
+        /// </summary>
+        public MetricAlertState State { get; set; } = MetricAlertState.Ok;
+
+        /// <summary>
+        /// Gets or sets the total number of matching entries seen since the rule was created or last changed
+        /// </summary>
+        public long MatchCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the message of the last matching entry
+        /// </summary>
+        public string LastMatchedMessage { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time of the last matching entry
+        /// </summary>
+        public DateTime? LastMatchedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the alert last fired
+        /// </summary>
+        public DateTime? LastFiredAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the creation timestamp
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last update timestamp
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+    }
+}
@@ -0,0 +1,42 @@
+using System;
+using System.Collections.Generic;
+using Microsoft.Extensions.Logging;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Entry written to the service's log stream
+    /// </summary>
+    public class LogRecord
+    {
+        /// <summary>
+        /// Gets or sets when the entry was written
+        /// </summary>
+        public DateTime Timestamp { get; set; }
+
+        /// <summary>
+        /// Gets or sets the log level
+        /// </summary>
+        public LogLevel Level { get; set; }
+
+        /// <summary>
+        /// Gets or sets the logger category
+        /// </summary>
+        public string Category { get; set; }
+
+        /// <summary>
+        /// Gets or sets the formatted message
+        /// </summary>
+        public string Message { get; set; }
+
+        /// <summary>
+        /// Gets or sets the message of the logged exception, if any
+        /// </summary>
+        public string ExceptionMessage { get; set; }
+
+        /// <summary>
+        /// Gets or sets the structured fields of the entry, such as the values of message template placeholders
+        /// </summary>
+        public Dictionary<string, string> Fields { get; set; } = new Dictionary<string, string>();
+    }
+}
@@ -0,0 +1,23 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for maintenance mode
+    /// </summary>
+    public class MaintenanceConfiguration
+    {
+        /// <summary>
+        /// Gets or sets how often scheduled windows are checked and queued invocations are released, in seconds
+        /// </summary>
+        public int CheckIntervalSeconds { get; set; } = 15;
+
+        /// <summary>
+        /// Gets or sets the maximum number of function invocations queued during maintenance
+        /// </summary>
+        public int MaxQueuedInvocations { get; set; } = 1000;
+
+        /// <summary>
+        /// Gets or sets the Retry-After value in seconds returned when the end of maintenance is unknown
+        /// </summary>
+        public int DefaultRetryAfterSeconds { get; set; } = 300;
+    }
+}
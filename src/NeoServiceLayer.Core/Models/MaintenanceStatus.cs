@@ -0,0 +1,46 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents the current maintenance status of the platform
+    /// </summary>
+    public class MaintenanceStatus
+    {
+        /// <summary>
+        /// Gets or sets whether maintenance mode is active
+        /// </summary>
+        public bool IsActive { get; set; }
+
+        /// <summary>
+        /// Gets or sets the reason for the active maintenance
+        /// </summary>
+        public string Reason { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the active maintenance started
+        /// </summary>
+        public DateTime? StartedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the active maintenance is expected to end, null if unknown
+        /// </summary>
+        public DateTime? ExpectedEndTime { get; set; }
+
+        /// <summary>
+        /// Gets or sets how many seconds clients should wait before retrying while maintenance is active
+        /// </summary>
+        public int RetryAfterSeconds { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of function invocations waiting for maintenance to end
+        /// </summary>
+        public int QueuedInvocations { get; set; }
+
+        /// <summary>
+        /// Gets or sets the scheduled maintenance windows that have not started yet
+        /// </summary>
+        public List<MaintenanceWindow> UpcomingWindows { get; set; } = new List<MaintenanceWindow>();
+    }
+}
@@ -0,0 +1,55 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a period during which the platform is in maintenance mode
+    /// </summary>
+    /// <remarks>
+    /// While a window is active trigger firing is paused, function invocations are queued and
+    /// withdrawals are rejected. Windows toggled on instantly start now and have no end until
+    /// maintenance is switched off.
+    /// </remarks>
+    public class MaintenanceWindow
+    {
+        /// <summary>
+        /// Gets or sets the ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the reason shown to users
+        /// </summary>
+        public string Reason { get; set; }
+
+        /// <summary>
+        /// Gets or sets the start time
+        /// </summary>
+        public DateTime StartTime { get; set; }
+
+        /// <summary>
+        /// Gets or sets the end time, null if the window lasts until maintenance is switched off
+        /// </summary>
+        public DateTime? EndTime { get; set; }
+
+        /// <summary>
+        /// Gets or sets the user who created the window
+        /// </summary>
+        public string CreatedBy { get; set; }
+
+        /// <summary>
+        /// Gets or sets the creation time
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Checks if the window is active at a given time
+        /// </summary>
+        /// <param name="time">Time to check</param>
+        /// <returns>True if the window covers the time, false otherwise</returns>
+        public bool IsActiveAt(DateTime time)
+        {
+            return StartTime <= time && (EndTime == null || EndTime > time);
+        }
+    }
+}
@@ -0,0 +1,56 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Health of a managed background service
+    /// </summary>
+    public class ManagedServiceHealth
+    {
+        /// <summary>
+        /// Gets or sets the service name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether the service's background work is running
+        /// </summary>
+        public ManagedServiceStatus Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets more about the status, or null
+        /// </summary>
+        public string Detail { get; set; }
+
+        /// <summary>
+        /// Creates the health of a service
+        /// </summary>
+        /// <param name="name">Service name</param>
+        /// <param name="status">Status</param>
+        /// <param name="detail">More about the status, or null</param>
+        /// <returns>The service health</returns>
+        public static ManagedServiceHealth Of(string name, ManagedServiceStatus status, string detail = null)
+        {
+            return new ManagedServiceHealth { Name = name, Status = status, Detail = detail };
+        }
+    }
+
+    /// <summary>
+    /// Status of a managed background service
+    /// </summary>
+    public enum ManagedServiceStatus
+    {
+        /// <summary>
+        /// The service's background work is running
+        /// </summary>
+        Running,
+
+        /// <summary>
+        /// The service has not been started or has been stopped
+        /// </summary>
+        Stopped,
+
+        /// <summary>
+        /// The service is turned off by configuration and does not start
+        /// </summary>
+        Disabled
+    }
+}
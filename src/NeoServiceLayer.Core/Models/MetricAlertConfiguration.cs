@@ -0,0 +1,38 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for user-defined metric alerts
+    /// </summary>
+    public class MetricAlertConfiguration
+    {
+        /// <summary>
+        /// Gets or sets whether alert rules are evaluated
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets the evaluation interval in seconds
+        /// </summary>
+        public int EvaluationIntervalSeconds { get; set; } = 60;
+
+        /// <summary>
+        /// Gets or sets the maximum number of alert rules per account
+        /// </summary>
+        public int MaxRulesPerAccount { get; set; } = 50;
+
+        /// <summary>
+        /// Gets or sets the minimum evaluation window in seconds
+        /// </summary>
+        public int MinWindowSeconds { get; set; } = 60;
+
+        /// <summary>
+        /// Gets or sets the maximum evaluation window in seconds
+        /// </summary>
+        public int MaxWindowSeconds { get; set; } = 86400;
+
+        /// <summary>
+        /// Gets or sets the maximum number of executions read per function when computing execution metrics
+        /// </summary>
+        public int MaxExecutionsPerFunction { get; set; } = 1000;
+    }
+}
@@ -0,0 +1,204 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// User-defined alert rule evaluated over an account's own metrics
+    /// </summary>
+    public class MetricAlertRule
+    {
+        /// <summary>
+        /// Gets or sets the rule ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account ID that owns the rule
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the rule name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the rule description
+        /// </summary>
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Gets or sets the metric source the rule is evaluated over
+        /// </summary>
+        public MetricAlertSource Source { get; set; }
+
+        /// <summary>
+        /// Gets or sets the metric name reported by the sandbox (only used for <see cref="MetricAlertSource.SandboxMetric"/>)
+        /// </summary>
+        public string MetricName { get; set; }
+
+        /// <summary>
+        /// Gets or sets the function the rule is scoped to. All functions of the account are used when null
+        /// </summary>
+        public Guid? FunctionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets a tag that limits the rule to the account's functions carrying it. Ignored when <see cref="FunctionId"/> is set
+        /// </summary>
+        public string Tag { get; set; }
+
+        /// <summary>
+        /// Gets or sets the aggregation applied to the values in the window
+        /// </summary>
+        public MetricAlertAggregation Aggregation { get; set; } = MetricAlertAggregation.Average;
+
+        /// <summary>
+        /// Gets or sets the comparison operator (&gt;, &gt;=, &lt;, &lt;=, ==, !=)
+        /// </summary>
+        public string Operator { get; set; } = ">";
+
+        /// <summary>
+        /// Gets or sets the threshold value
+        /// </summary>
+        public double Threshold { get; set; }
+
+        /// <summary>
+        /// Gets or sets the evaluation window in seconds
+        /// </summary>
+        public int WindowSeconds { get; set; } = 300;
+
+        /// <summary>
+        /// Gets or sets how long in seconds the condition must hold before the alert fires
+        /// </summary>
+        public int DurationSeconds { get; set; }
+
+        /// <summary>
+        /// Gets or sets the channels the alert is dispatched to
+        /// </summary>
+        public List<NotificationChannel> Channels { get; set; } = new List<NotificationChannel>();
+
+        /// <summary>
+        /// Gets or sets whether a notification is sent when the alert resolves
+        /// </summary>
+        public bool NotifyOnResolve { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets whether the rule is enabled
+        /// </summary>
+        public bool IsEnabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets the current state of the rule
+        /// </summary>
+        public MetricAlertState State { get; set; } = MetricAlertState.Ok;
+
+        /// <summary>
+        /// Gets or sets the time the condition started holding
+        /// </summary>
+        public DateTime? PendingSince { get; set; }
+
+        /// <summary>
+        /// Gets or sets the value computed at the last evaluation
+        /// </summary>
+        public double? LastValue { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time of the last evaluation
+        /// </summary>
+        public DateTime? LastEvaluatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the alert last fired
+        /// </summary>
+        public DateTime? LastFiredAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the creation timestamp
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last update timestamp
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+    }
+
+    /// <summary>
+    /// Metric sources available to alert rules
+    /// </summary>
+    public enum MetricAlertSource
+    {
+        /// <summary>
+        /// Percentage of function executions that failed in the window
+        /// </summary>
+        ExecutionErrorRate,
+
+        /// <summary>
+        /// Custom metric reported by functions from the sandbox
+        /// </summary>
+        SandboxMetric,
+
+        /// <summary>
+        /// GAS spent from the account's GasBank accounts in the window
+        /// </summary>
+        GasSpend,
+
+        /// <summary>
+        /// Metric kept by the metrics store, read from the series tagged with the rule's account
+        /// </summary>
+        StoredMetric
+    }
+
+    /// <summary>
+    /// Aggregations applied to metric values in the evaluation window
+    /// </summary>
+    public enum MetricAlertAggregation
+    {
+        /// <summary>
+        /// Average value
+        /// </summary>
+        Average,
+
+        /// <summary>
+        /// Sum of values
+        /// </summary>
+        Sum,
+
+        /// <summary>
+        /// Minimum value
+        /// </summary>
+        Min,
+
+        /// <summary>
+        /// Maximum value
+        /// </summary>
+        Max,
+
+        /// <summary>
+        /// Number of values
+        /// </summary>
+        Count
+    }
+
+    /// <summary>
+    /// State of an alert rule
+    /// </summary>
+    public enum MetricAlertState
+    {
+        /// <summary>
+        /// Condition does not hold
+        /// </summary>
+        Ok,
+
+        /// <summary>
+        /// Condition holds but has not held for the configured duration yet
+        /// </summary>
+        Pending,
+
+        /// <summary>
+        /// Condition has held for the configured duration and the alert fired
+        /// </summary>
+        Firing
+    }
+}
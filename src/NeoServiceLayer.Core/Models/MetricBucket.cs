@@ -0,0 +1,56 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Aggregate of the samples of one metric series within a time bucket
+    /// </summary>
+    public class MetricBucket
+    {
+        /// <summary>
+        /// Gets or sets the metric name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the tags identifying the series
+        /// </summary>
+        public Dictionary<string, string> Tags { get; set; } = new Dictionary<string, string>();
+
+        /// <summary>
+        /// Gets or sets the bucket width
+        /// </summary>
+        public MetricResolution Resolution { get; set; }
+
+        /// <summary>
+        /// Gets or sets the start of the bucket (UTC)
+        /// </summary>
+        public DateTime Timestamp { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of samples
+        /// </summary>
+        public long Count { get; set; }
+
+        /// <summary>
+        /// Gets or sets the sum of the samples
+        /// </summary>
+        public double Sum { get; set; }
+
+        /// <summary>
+        /// Gets or sets the smallest sample
+        /// </summary>
+        public double Min { get; set; }
+
+        /// <summary>
+        /// Gets or sets the largest sample
+        /// </summary>
+        public double Max { get; set; }
+
+        /// <summary>
+        /// Gets the average of the samples
+        /// </summary>
+        public double Average => Count > 0 ? Sum / Count : 0;
+    }
+}
@@ -0,0 +1,37 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Query over stored metric buckets
+    /// </summary>
+    public class MetricQuery
+    {
+        /// <summary>
+        /// Gets or sets the metric name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the start of the time range (UTC, inclusive)
+        /// </summary>
+        public DateTime StartTime { get; set; }
+
+        /// <summary>
+        /// Gets or sets the end of the time range (UTC, exclusive)
+        /// </summary>
+        public DateTime EndTime { get; set; }
+
+        /// <summary>
+        /// Gets or sets tags a series must have; series with additional tags also match
+        /// </summary>
+        public Dictionary<string, string> Tags { get; set; } = new Dictionary<string, string>();
+
+        /// <summary>
+        /// Gets or sets the resolution to read; null picks the finest one that still covers the range
+        /// within <see cref="MetricsStorageConfiguration.MaxPointsPerSeries"/>
+        /// </summary>
+        public MetricResolution? Resolution { get; set; }
+    }
+}
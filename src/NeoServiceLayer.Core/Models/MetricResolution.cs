@@ -0,0 +1,23 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Bucket widths stored metrics are aggregated into
+    /// </summary>
+    public enum MetricResolution
+    {
+        /// <summary>
+        /// One-minute buckets, written as samples arrive
+        /// </summary>
+        Minute,
+
+        /// <summary>
+        /// One-hour buckets, rolled up from minute buckets
+        /// </summary>
+        Hour,
+
+        /// <summary>
+        /// One-day buckets, rolled up from hour buckets
+        /// </summary>
+        Day
+    }
+}
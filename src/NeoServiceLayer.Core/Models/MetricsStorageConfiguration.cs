@@ -0,0 +1,56 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for stored, downsampled metrics
+    /// </summary>
+    public class MetricsStorageConfiguration
+    {
+        /// <summary>
+        /// Gets or sets where metrics are stored; "None" keeps no history and "PostgreSql" uses a PostgreSQL
+        /// or TimescaleDB database shared by all replicas
+        /// </summary>
+        public string StorageBackend { get; set; } = "None";
+
+        /// <summary>
+        /// Gets or sets the PostgreSQL connection string used when <see cref="StorageBackend"/> is "PostgreSql"
+        /// </summary>
+        public string PostgreSqlConnectionString { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether bucket tables are converted to TimescaleDB hypertables; the extension must be available
+        /// </summary>
+        public bool UseTimescaleDb { get; set; }
+
+        /// <summary>
+        /// Gets or sets how often buckets are rolled up and expired, in seconds
+        /// </summary>
+        public int RollupIntervalSeconds { get; set; } = 300;
+
+        /// <summary>
+        /// Gets or sets how long minute buckets are kept, in days
+        /// </summary>
+        public int MinuteRetentionDays { get; set; } = 7;
+
+        /// <summary>
+        /// Gets or sets how long hour buckets are kept, in days
+        /// </summary>
+        public int HourRetentionDays { get; set; } = 90;
+
+        /// <summary>
+        /// Gets or sets how long day buckets are kept, in days
+        /// </summary>
+        public int DayRetentionDays { get; set; } = 730;
+
+        /// <summary>
+        /// Gets or sets the most buckets per series a query picks a resolution for
+        /// </summary>
+        public int MaxPointsPerSeries { get; set; } = 1440;
+
+        /// <summary>
+        /// Gets a value indicating whether metrics are stored in PostgreSQL
+        /// </summary>
+        public bool UsePostgreSql => string.Equals(StorageBackend, "PostgreSql", StringComparison.OrdinalIgnoreCase);
+    }
+}
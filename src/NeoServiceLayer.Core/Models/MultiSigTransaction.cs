@@ -0,0 +1,184 @@
+using System;
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models.Blockchain;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// A contract invocation sent from an m-of-n multi-signature account, kept while its signatures are collected
+    /// </summary>
+    public class MultiSigTransaction
+    {
+        /// <summary>
+        /// Gets or sets the ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the account that proposed the transaction
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of signatures required
+        /// </summary>
+        public int Threshold { get; set; }
+
+        /// <summary>
+        /// Gets or sets the public keys of the signers, in verification script order
+        /// </summary>
+        public List<string> PublicKeys { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets the verification script of the multi-signature account, Base64-encoded
+        /// </summary>
+        public string VerificationScript { get; set; }
+
+        /// <summary>
+        /// Gets or sets the script hash of the multi-signature account
+        /// </summary>
+        public string SenderScriptHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the address of the multi-signature account
+        /// </summary>
+        public string SenderAddress { get; set; }
+
+        /// <summary>
+        /// Gets or sets the script hash of the invoked contract
+        /// </summary>
+        public string ContractHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the invoked method
+        /// </summary>
+        public string Operation { get; set; }
+
+        /// <summary>
+        /// Gets or sets the method arguments
+        /// </summary>
+        public List<NeoContractParameter> Args { get; set; } = new List<NeoContractParameter>();
+
+        /// <summary>
+        /// Gets or sets the invocation script, Base64-encoded
+        /// </summary>
+        public string Script { get; set; }
+
+        /// <summary>
+        /// Gets or sets the most GAS the invocation may consume
+        /// </summary>
+        public decimal SystemFeeLimit { get; set; }
+
+        /// <summary>
+        /// Gets or sets the random nonce that makes otherwise identical proposals distinct
+        /// </summary>
+        public uint Nonce { get; set; }
+
+        /// <summary>
+        /// Gets or sets the digest every signer signs, as hex
+        /// </summary>
+        public string SignData { get; set; }
+
+        /// <summary>
+        /// Gets or sets the signatures collected so far
+        /// </summary>
+        public List<MultiSigSignature> Signatures { get; set; } = new List<MultiSigSignature>();
+
+        /// <summary>
+        /// Gets or sets the status
+        /// </summary>
+        public MultiSigTransactionStatus Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets the hash of the sent transaction
+        /// </summary>
+        public string TransactionHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets why the last attempt to send the transaction failed
+        /// </summary>
+        public string Error { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time after which no more signatures are accepted
+        /// </summary>
+        public DateTime ExpiresAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the transaction was sent
+        /// </summary>
+        public DateTime? SentAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the creation timestamp
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last update timestamp
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+    }
+
+    /// <summary>
+    /// A signer's signature of a multi-signature transaction
+    /// </summary>
+    public class MultiSigSignature
+    {
+        /// <summary>
+        /// Gets or sets the signer's public key
+        /// </summary>
+        public string PublicKey { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the wallet that signed
+        /// </summary>
+        public Guid WalletId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the account that owns the wallet
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the signature
+        /// </summary>
+        public string Signature { get; set; }
+
+        /// <summary>
+        /// Gets or sets the signing timestamp
+        /// </summary>
+        public DateTime SignedAt { get; set; }
+    }
+
+    /// <summary>
+    /// Represents the status of a multi-signature transaction
+    /// </summary>
+    public enum MultiSigTransactionStatus
+    {
+        /// <summary>
+        /// Signatures are being collected
+        /// </summary>
+        Pending,
+
+        /// <summary>
+        /// The threshold was reached and the transaction was sent
+        /// </summary>
+        Sent,
+
+        /// <summary>
+        /// The threshold was reached but sending failed; sending can be retried until the transaction expires
+        /// </summary>
+        Failed,
+
+        /// <summary>
+        /// The proposer withdrew the transaction
+        /// </summary>
+        Cancelled,
+
+        /// <summary>
+        /// The transaction expired before it was sent
+        /// </summary>
+        Expired
+    }
+}
@@ -0,0 +1,95 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for the Neo RPC client
+    /// </summary>
+    public class NeoRpcConfiguration
+    {
+        /// <summary>
+        /// Gets or sets the Neo node RPC URL, used when <see cref="Nodes"/> is empty
+        /// </summary>
+        public string RpcUrl { get; set; } = Constants.NeoConfig.RpcUrl;
+
+        /// <summary>
+        /// Gets or sets the nodes calls are spread across; a call fails over to the next node when one is unreachable
+        /// </summary>
+        public List<NeoRpcNode> Nodes { get; set; } = new List<NeoRpcNode>();
+
+        /// <summary>
+        /// Gets or sets how often each node is probed with <c>getblockcount</c>, in seconds; only nodes in <see cref="Nodes"/> are probed
+        /// </summary>
+        public int HealthCheckIntervalSeconds { get; set; } = 15;
+
+        /// <summary>
+        /// Gets or sets how many failed calls or probes in a row take a node out of rotation until a probe succeeds
+        /// </summary>
+        public int UnhealthyAfterFailures { get; set; } = 3;
+
+        /// <summary>
+        /// Gets or sets how many blocks a node may be behind the highest node before it is taken out of rotation
+        /// </summary>
+        public int MaxBlockLag { get; set; } = 3;
+
+        /// <summary>
+        /// Gets or sets the RPC URL of a node running the state service, used for historical calls.
+        /// Falls back to <see cref="RpcUrl"/> when not set
+        /// </summary>
+        public string StateServiceUrl { get; set; }
+
+        /// <summary>
+        /// Gets or sets the request timeout in seconds
+        /// </summary>
+        public int TimeoutSeconds { get; set; } = 30;
+
+        /// <summary>
+        /// Gets or sets how long a NEP-17 balance is cached in seconds. Cached balances are also dropped when a
+        /// transfer involving the address is observed. Set to 0 to disable caching
+        /// </summary>
+        public int BalanceCacheSeconds { get; set; } = 15;
+
+        /// <summary>
+        /// Gets or sets the most calls sent in one batched HTTP request; larger batches are split
+        /// </summary>
+        public int MaxBatchSize { get; set; } = 50;
+
+        /// <summary>
+        /// Gets or sets whether results that never change, such as blocks, transactions and application logs,
+        /// are cached on disk and shared by every service using the client
+        /// </summary>
+        public bool ResponseCacheEnabled { get; set; }
+
+        /// <summary>
+        /// Gets or sets the directory of the response cache
+        /// </summary>
+        public string ResponseCacheDirectory { get; set; } = "data/neo-rpc-cache";
+
+        /// <summary>
+        /// Gets or sets the most disk space the response cache uses in megabytes; the least recently used
+        /// results are deleted beyond it
+        /// </summary>
+        public int ResponseCacheMaxMegabytes { get; set; } = 512;
+
+        /// <summary>
+        /// Gets or sets how long cached contract state is kept in seconds, since a contract update changes it
+        /// </summary>
+        public int ContractStateCacheSeconds { get; set; } = 300;
+    }
+
+    /// <summary>
+    /// Neo node the RPC client spreads calls across
+    /// </summary>
+    public class NeoRpcNode
+    {
+        /// <summary>
+        /// Gets or sets the node's RPC URL
+        /// </summary>
+        public string Url { get; set; }
+
+        /// <summary>
+        /// Gets or sets the node's share of calls relative to the other healthy nodes
+        /// </summary>
+        public int Weight { get; set; } = 1;
+    }
+}
@@ -349,7 +349,12 @@ namespace NeoServiceLayer.Core.Models
         /// <summary>
         /// Webhook channel
         /// </summary>
-        Webhook
+        Webhook,
+
+        /// <summary>
+        /// Telegram channel
+        /// </summary>
+        Telegram
     }
 
 
@@ -0,0 +1,28 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for the OpenAPI document and documentation UI served by the API service
+    /// </summary>
+    public class OpenApiConfiguration
+    {
+        /// <summary>
+        /// Gets or sets a value indicating whether the document and UI are served
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets the path the OpenAPI 3 document is served at
+        /// </summary>
+        public string DocumentPath { get; set; } = "/openapi.json";
+
+        /// <summary>
+        /// Gets or sets the path the documentation UI is served under, without a leading slash
+        /// </summary>
+        public string UiPath { get; set; } = "docs";
+
+        /// <summary>
+        /// Gets or sets the public base URL listed as the document's server; the request's own URL when empty
+        /// </summary>
+        public string ServerUrl { get; set; }
+    }
+}
@@ -0,0 +1,194 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Price aggregated from several sources, with what each source reported
+    /// </summary>
+    public class PriceAggregation
+    {
+        /// <summary>
+        /// Gets or sets the symbol
+        /// </summary>
+        public string Symbol { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base currency
+        /// </summary>
+        public string BaseCurrency { get; set; }
+
+        /// <summary>
+        /// Gets or sets the aggregated price; null if too few sources agreed
+        /// </summary>
+        public Price Price { get; set; }
+
+        /// <summary>
+        /// Gets or sets the median of all prices received, before outliers were rejected
+        /// </summary>
+        public decimal? RawMedian { get; set; }
+
+        /// <summary>
+        /// Gets or sets what each source reported
+        /// </summary>
+        public List<PriceSourceObservation> Observations { get; set; } = new List<PriceSourceObservation>();
+
+        /// <summary>
+        /// Gets or sets the hash of the transaction that published the price on chain, if it was published
+        /// </summary>
+        public string TransactionHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the aggregation ran
+        /// </summary>
+        public DateTime Timestamp { get; set; }
+    }
+
+    /// <summary>
+    /// Price one source reported during an aggregation
+    /// </summary>
+    public class PriceSourceObservation
+    {
+        /// <summary>
+        /// Gets or sets the source ID
+        /// </summary>
+        public Guid SourceId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the source name
+        /// </summary>
+        public string SourceName { get; set; }
+
+        /// <summary>
+        /// Gets or sets the outcome for this source
+        /// </summary>
+        public PriceObservationStatus Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets the price the source reported
+        /// </summary>
+        public decimal? Value { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the source reported for its price
+        /// </summary>
+        public DateTime? Timestamp { get; set; }
+
+        /// <summary>
+        /// Gets or sets the deviation of the price from the median of all sources, in percent
+        /// </summary>
+        public decimal? DeviationPercent { get; set; }
+
+        /// <summary>
+        /// Gets or sets how long the fetch took, in milliseconds
+        /// </summary>
+        public long LatencyMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the error, if the fetch failed
+        /// </summary>
+        public string Error { get; set; }
+    }
+
+    /// <summary>
+    /// Outcome of fetching a price from one source
+    /// </summary>
+    public enum PriceObservationStatus
+    {
+        /// <summary>
+        /// The price was used in the aggregate
+        /// </summary>
+        Accepted,
+
+        /// <summary>
+        /// The price deviated too far from the median
+        /// </summary>
+        Outlier,
+
+        /// <summary>
+        /// The price was older than the allowed age
+        /// </summary>
+        Stale,
+
+        /// <summary>
+        /// The fetch failed or timed out
+        /// </summary>
+        Failed
+    }
+
+    /// <summary>
+    /// Health of a price source, built from its recent fetches
+    /// </summary>
+    public class PriceSourceHealth
+    {
+        /// <summary>
+        /// Gets or sets the source ID
+        /// </summary>
+        public Guid SourceId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the source name
+        /// </summary>
+        public string SourceName { get; set; }
+
+        /// <summary>
+        /// Gets or sets the health status: Healthy, Degraded or Unhealthy
+        /// </summary>
+        public string Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of successful fetches
+        /// </summary>
+        public long SuccessCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of failed fetches
+        /// </summary>
+        public long FailureCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of prices rejected as outliers
+        /// </summary>
+        public long OutlierCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of prices rejected as stale
+        /// </summary>
+        public long StaleCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of failures since the last success
+        /// </summary>
+        public int ConsecutiveFailures { get; set; }
+
+        /// <summary>
+        /// Gets or sets the latency of the last fetch, in milliseconds
+        /// </summary>
+        public long LastLatencyMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the average latency of successful fetches, in milliseconds
+        /// </summary>
+        public double AverageLatencyMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the deviation of the last price from the median, in percent
+        /// </summary>
+        public decimal? LastDeviationPercent { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time of the last successful fetch
+        /// </summary>
+        public DateTime? LastSuccessAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time of the last failed fetch
+        /// </summary>
+        public DateTime? LastFailureAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the error of the last failed fetch
+        /// </summary>
+        public string LastError { get; set; }
+    }
+}
@@ -0,0 +1,33 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for aggregating prices from the configured price sources
+    /// </summary>
+    public class PriceAggregationConfiguration
+    {
+        /// <summary>
+        /// Gets or sets the relative deviation from the median, in percent, above which a source's price is rejected as an outlier
+        /// </summary>
+        public decimal MaxDeviationPercent { get; set; } = 2m;
+
+        /// <summary>
+        /// Gets or sets the number of sources that must agree before a price is published
+        /// </summary>
+        public int MinimumSources { get; set; } = 2;
+
+        /// <summary>
+        /// Gets or sets the age, in seconds, above which a source's price is rejected as stale
+        /// </summary>
+        public int MaxPriceAgeSeconds { get; set; } = 300;
+
+        /// <summary>
+        /// Gets or sets the fetch timeout, in seconds, for sources that do not set their own
+        /// </summary>
+        public int DefaultTimeoutSeconds { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets the number of consecutive failures after which a source is reported unhealthy
+        /// </summary>
+        public int UnhealthyAfterFailures { get; set; } = 3;
+    }
+}
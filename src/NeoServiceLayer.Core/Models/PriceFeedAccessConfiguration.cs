@@ -0,0 +1,47 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for price feed subscription tiers
+    /// </summary>
+    public class PriceFeedAccessConfiguration
+    {
+        /// <summary>
+        /// Gets or sets the tier of accounts that have not subscribed to one
+        /// </summary>
+        public string DefaultTier { get; set; } = "free";
+
+        /// <summary>
+        /// Gets or sets the subscription tiers, from lowest to highest
+        /// </summary>
+        /// <remarks>
+        /// A tier includes every symbol of the tiers listed before it. When empty, the default tier is the only tier.
+        /// </remarks>
+        public List<PriceFeedTier> Tiers { get; set; } = new List<PriceFeedTier>();
+
+        /// <summary>
+        /// Gets or sets the lowest tier that can read each premium symbol
+        /// </summary>
+        /// <remarks>
+        /// Symbols that are not listed can be read by every account.
+        /// </remarks>
+        public Dictionary<string, string> SymbolTiers { get; set; } = new Dictionary<string, string>();
+    }
+
+    /// <summary>
+    /// Price feed subscription tier
+    /// </summary>
+    public class PriceFeedTier
+    {
+        /// <summary>
+        /// Gets or sets the tier name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the credits charged when an account subscribes to the tier
+        /// </summary>
+        public decimal Price { get; set; }
+    }
+}
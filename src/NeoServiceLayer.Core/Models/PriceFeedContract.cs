@@ -0,0 +1,103 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Compiled PriceFeed contract artifacts, validated and ready to be deployed
+    /// </summary>
+    public class PriceFeedContractArtifacts
+    {
+        /// <summary>
+        /// Gets or sets the contract name declared in the manifest
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the NEF file in Base64
+        /// </summary>
+        public string Nef { get; set; }
+
+        /// <summary>
+        /// Gets or sets the NEF checksum in hex
+        /// </summary>
+        public string NefChecksum { get; set; }
+
+        /// <summary>
+        /// Gets or sets the manifest JSON
+        /// </summary>
+        public string Manifest { get; set; }
+
+        /// <summary>
+        /// Gets or sets the methods declared in the manifest ABI
+        /// </summary>
+        public List<string> Methods { get; set; } = new List<string>();
+    }
+
+    /// <summary>
+    /// Wallet used to sign a PriceFeed contract deployment
+    /// </summary>
+    public class PriceFeedContractDeploymentRequest
+    {
+        /// <summary>
+        /// Gets or sets the wallet ID
+        /// </summary>
+        public Guid WalletId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account ID owning the wallet
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the wallet password
+        /// </summary>
+        public string Password { get; set; }
+
+        /// <summary>
+        /// Gets or sets the network (MainNet or TestNet)
+        /// </summary>
+        public string Network { get; set; } = "MainNet";
+    }
+
+    /// <summary>
+    /// Result of a PriceFeed contract deployment or update
+    /// </summary>
+    public class PriceFeedContractDeployment
+    {
+        /// <summary>
+        /// Gets or sets the operation (deploy or update)
+        /// </summary>
+        public string Operation { get; set; }
+
+        /// <summary>
+        /// Gets or sets the contract name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the script hash of the contract that was updated, null for a new deployment
+        /// </summary>
+        public string ContractHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the NEF checksum in hex
+        /// </summary>
+        public string NefChecksum { get; set; }
+
+        /// <summary>
+        /// Gets or sets the network
+        /// </summary>
+        public string Network { get; set; }
+
+        /// <summary>
+        /// Gets or sets the transaction hash
+        /// </summary>
+        public string TransactionHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the submission timestamp
+        /// </summary>
+        public DateTime SubmittedAt { get; set; }
+    }
+}
@@ -0,0 +1,43 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for the on-chain PriceFeed contract
+    /// </summary>
+    public class PriceFeedContractConfiguration
+    {
+        /// <summary>
+        /// Gets or sets the script hash of the deployed contract, empty until it has been deployed
+        /// </summary>
+        public string ContractHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the path of the compiled contract (.nef)
+        /// </summary>
+        public string NefPath { get; set; } = "contracts/PriceFeed/PriceFeed.nef";
+
+        /// <summary>
+        /// Gets or sets the path of the contract manifest (.manifest.json)
+        /// </summary>
+        public string ManifestPath { get; set; } = "contracts/PriceFeed/PriceFeed.manifest.json";
+
+        /// <summary>
+        /// Gets or sets the number of decimals prices are stored with on chain
+        /// </summary>
+        public int Decimals { get; set; } = 8;
+
+        /// <summary>
+        /// Gets or sets the relative deviation, in percent, above which an on-chain round is flagged
+        /// </summary>
+        public decimal AuditTolerancePercent { get; set; } = 0.5m;
+
+        /// <summary>
+        /// Gets or sets how far, in seconds, an internal price may be from an on-chain round to be matched with it
+        /// </summary>
+        public int AuditMatchWindowSeconds { get; set; } = 300;
+
+        /// <summary>
+        /// Gets or sets the number of most recent rounds audited when the caller does not specify one
+        /// </summary>
+        public int DefaultAuditRounds { get; set; } = 20;
+    }
+}
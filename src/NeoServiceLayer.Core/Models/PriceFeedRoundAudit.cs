@@ -0,0 +1,92 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Result of comparing on-chain PriceFeed rounds against the service's price history
+    /// </summary>
+    public class PriceFeedRoundAuditReport
+    {
+        /// <summary>
+        /// Gets or sets the asset symbol
+        /// </summary>
+        public string Symbol { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base currency
+        /// </summary>
+        public string BaseCurrency { get; set; }
+
+        /// <summary>
+        /// Gets or sets the contract script hash
+        /// </summary>
+        public string ContractHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the latest round ID on chain
+        /// </summary>
+        public long LatestRoundId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of rounds compared
+        /// </summary>
+        public int RoundsAudited { get; set; }
+
+        /// <summary>
+        /// Gets or sets the rounds that diverge from the service's history
+        /// </summary>
+        public List<PriceFeedRoundDivergence> Divergences { get; set; } = new List<PriceFeedRoundDivergence>();
+
+        /// <summary>
+        /// Gets or sets the audit timestamp
+        /// </summary>
+        public DateTime AuditedAt { get; set; }
+
+        /// <summary>
+        /// Gets a value indicating whether every audited round matched
+        /// </summary>
+        public bool IsConsistent => Divergences.Count == 0;
+    }
+
+    /// <summary>
+    /// An on-chain round that does not match the service's price history
+    /// </summary>
+    public class PriceFeedRoundDivergence
+    {
+        /// <summary>
+        /// Gets or sets the round ID
+        /// </summary>
+        public long RoundId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the reason (Missing, Deviation or Unreadable)
+        /// </summary>
+        public string Reason { get; set; }
+
+        /// <summary>
+        /// Gets or sets the price stored on chain
+        /// </summary>
+        public decimal? OnChainValue { get; set; }
+
+        /// <summary>
+        /// Gets or sets the round timestamp stored on chain
+        /// </summary>
+        public DateTime? OnChainTimestamp { get; set; }
+
+        /// <summary>
+        /// Gets or sets the closest internal price, null when none was recorded near the round
+        /// </summary>
+        public decimal? ServiceValue { get; set; }
+
+        /// <summary>
+        /// Gets or sets the timestamp of the closest internal price
+        /// </summary>
+        public DateTime? ServiceTimestamp { get; set; }
+
+        /// <summary>
+        /// Gets or sets the relative deviation between the two prices, in percent
+        /// </summary>
+        public decimal? DeviationPercent { get; set; }
+    }
+}
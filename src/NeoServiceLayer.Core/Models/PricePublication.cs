@@ -0,0 +1,112 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Last price published on chain for a pair
+    /// </summary>
+    public class PricePublication
+    {
+        /// <summary>
+        /// Gets or sets the symbol
+        /// </summary>
+        public string Symbol { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base currency
+        /// </summary>
+        public string BaseCurrency { get; set; }
+
+        /// <summary>
+        /// Gets or sets the published price
+        /// </summary>
+        public decimal Value { get; set; }
+
+        /// <summary>
+        /// Gets or sets the hash of the publishing transaction
+        /// </summary>
+        public string TransactionHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets why the price was published
+        /// </summary>
+        public PricePublicationReason Reason { get; set; }
+
+        /// <summary>
+        /// Gets or sets the deviation from the previously published price, in percent
+        /// </summary>
+        public decimal? DeviationPercent { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the price was published
+        /// </summary>
+        public DateTime PublishedAt { get; set; }
+    }
+
+    /// <summary>
+    /// Outcome of checking whether a pair's price should be published
+    /// </summary>
+    public class PricePublicationResult
+    {
+        /// <summary>
+        /// Gets or sets the symbol
+        /// </summary>
+        public string Symbol { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base currency
+        /// </summary>
+        public string BaseCurrency { get; set; }
+
+        /// <summary>
+        /// Gets or sets the aggregation the decision was based on
+        /// </summary>
+        public PriceAggregation Aggregation { get; set; }
+
+        /// <summary>
+        /// Gets or sets why the price was published; null if it was not
+        /// </summary>
+        public PricePublicationReason? Reason { get; set; }
+
+        /// <summary>
+        /// Gets or sets the deviation of the aggregated price from the last published price, in percent
+        /// </summary>
+        public decimal? DeviationPercent { get; set; }
+
+        /// <summary>
+        /// Gets or sets the publication in effect before this check
+        /// </summary>
+        public PricePublication PreviousPublication { get; set; }
+
+        /// <summary>
+        /// Gets or sets why the check failed, if it did
+        /// </summary>
+        public string Error { get; set; }
+    }
+
+    /// <summary>
+    /// Reasons a price is published on chain
+    /// </summary>
+    public enum PricePublicationReason
+    {
+        /// <summary>
+        /// Nothing had been published for the pair
+        /// </summary>
+        Initial,
+
+        /// <summary>
+        /// The price moved more than the deviation threshold from the last published price
+        /// </summary>
+        Deviation,
+
+        /// <summary>
+        /// The heartbeat interval elapsed since the last publication
+        /// </summary>
+        Heartbeat,
+
+        /// <summary>
+        /// An administrator published the price
+        /// </summary>
+        Manual
+    }
+}
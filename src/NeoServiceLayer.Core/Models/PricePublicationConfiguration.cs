@@ -0,0 +1,61 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for publishing aggregated prices on chain
+    /// </summary>
+    public class PricePublicationConfiguration
+    {
+        /// <summary>
+        /// Gets or sets whether the configured pairs are checked and published in the background
+        /// </summary>
+        public bool Enabled { get; set; }
+
+        /// <summary>
+        /// Gets or sets how often, in seconds, the pairs are checked
+        /// </summary>
+        public int CheckIntervalSeconds { get; set; } = 30;
+
+        /// <summary>
+        /// Gets or sets the deviation from the last published price, in percent, above which a price is published
+        /// </summary>
+        public decimal DeviationThresholdPercent { get; set; } = 0.5m;
+
+        /// <summary>
+        /// Gets or sets the time, in seconds, after which a price is published even if it has not moved
+        /// </summary>
+        public int HeartbeatSeconds { get; set; } = 3600;
+
+        /// <summary>
+        /// Gets or sets the pairs to publish
+        /// </summary>
+        public List<PricePublicationPair> Pairs { get; set; } = new List<PricePublicationPair>();
+    }
+
+    /// <summary>
+    /// Pair published on chain, with optional thresholds of its own
+    /// </summary>
+    public class PricePublicationPair
+    {
+        /// <summary>
+        /// Gets or sets the symbol
+        /// </summary>
+        public string Symbol { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base currency
+        /// </summary>
+        public string BaseCurrency { get; set; } = "USD";
+
+        /// <summary>
+        /// Gets or sets the deviation threshold for this pair, in percent; the default threshold is used when null
+        /// </summary>
+        public decimal? DeviationThresholdPercent { get; set; }
+
+        /// <summary>
+        /// Gets or sets the heartbeat for this pair, in seconds; the default heartbeat is used when null
+        /// </summary>
+        public int? HeartbeatSeconds { get; set; }
+    }
+}
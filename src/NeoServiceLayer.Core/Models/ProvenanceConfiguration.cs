@@ -0,0 +1,16 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for build provenance statements
+    /// </summary>
+    public class ProvenanceConfiguration
+    {
+        /// <summary>
+        /// Gets or sets a value indicating whether statements are signed by the enclave and carry its attestation document
+        /// </summary>
+        /// <remarks>
+        /// Enable only when the server runs alongside a TEE; elsewhere there is no enclave to attest.
+        /// </remarks>
+        public bool BindToEnclave { get; set; }
+    }
+}
@@ -0,0 +1,60 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a statement of exactly what code is serving requests
+    /// </summary>
+    public class ProvenanceStatement
+    {
+        /// <summary>
+        /// Gets or sets the build metadata
+        /// </summary>
+        public BuildProvenance Build { get; set; }
+
+        /// <summary>
+        /// Gets or sets the hex SHA-256 digest of the serialized build metadata
+        /// </summary>
+        public string StatementDigest { get; set; }
+
+        /// <summary>
+        /// Gets or sets the binding of the statement to the enclave, or null when not running in a TEE
+        /// </summary>
+        public EnclaveProvenanceBinding Enclave { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the statement was produced
+        /// </summary>
+        public DateTime GeneratedAt { get; set; }
+    }
+
+    /// <summary>
+    /// Represents an enclave's signature over a provenance statement
+    /// </summary>
+    /// <remarks>
+    /// Verify the attestation document, check that it reports <see cref="PublicKey"/>, then verify
+    /// <see cref="Signature"/> over the provenance signature prefix followed by the statement digest.
+    /// </remarks>
+    public class EnclaveProvenanceBinding
+    {
+        /// <summary>
+        /// Gets or sets the base64 attestation document carrying the enclave measurement
+        /// </summary>
+        public string AttestationDocument { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base64 SubjectPublicKeyInfo of the enclave signing key
+        /// </summary>
+        public string PublicKey { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base64 ECDSA P-256 signature over the statement digest
+        /// </summary>
+        public string Signature { get; set; }
+
+        /// <summary>
+        /// Gets or sets why the statement could not be bound, when binding was configured but failed
+        /// </summary>
+        public string Error { get; set; }
+    }
+}
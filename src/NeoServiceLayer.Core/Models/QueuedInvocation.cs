@@ -0,0 +1,41 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a function invocation queued during maintenance
+    /// </summary>
+    public class QueuedInvocation
+    {
+        /// <summary>
+        /// Gets or sets the ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the function ID
+        /// </summary>
+        public Guid FunctionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account that requested the invocation
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the invocation parameters
+        /// </summary>
+        public Dictionary<string, object> Parameters { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the invocation was queued
+        /// </summary>
+        public DateTime QueuedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the position in the queue when the invocation was queued
+        /// </summary>
+        public int Position { get; set; }
+    }
+}
@@ -0,0 +1,51 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for running critical functions on several independent nodes
+    /// </summary>
+    public class QuorumConfiguration
+    {
+        /// <summary>
+        /// Gets or sets the ID this instance reports for its own enclave
+        /// </summary>
+        public string NodeId { get; set; } = "local";
+
+        /// <summary>
+        /// Gets or sets the key peers must present to run a function on this instance; peer execution is refused when empty
+        /// </summary>
+        public string NodeKey { get; set; }
+
+        /// <summary>
+        /// Gets or sets how long to wait for a peer's result, in seconds
+        /// </summary>
+        public int NodeTimeoutSeconds { get; set; } = 60;
+
+        /// <summary>
+        /// Gets or sets the peers that run quorum executions alongside the local enclave, in the order they are used
+        /// </summary>
+        public List<QuorumNodeConfiguration> Nodes { get; set; } = new List<QuorumNodeConfiguration>();
+    }
+
+    /// <summary>
+    /// A peer taking part in quorum executions
+    /// </summary>
+    public class QuorumNodeConfiguration
+    {
+        /// <summary>
+        /// Gets or sets the node ID recorded with its results
+        /// </summary>
+        public string Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base URL of the peer's API, e.g. https://node-2.example.com
+        /// </summary>
+        public string Url { get; set; }
+
+        /// <summary>
+        /// Gets or sets the peer's <see cref="QuorumConfiguration.NodeKey"/>
+        /// </summary>
+        public string Key { get; set; }
+    }
+}
@@ -0,0 +1,148 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Record of a function execution run on several nodes and the result each node returned
+    /// </summary>
+    public class QuorumExecution
+    {
+        /// <summary>
+        /// Gets or sets the ID of the record
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the execution the quorum ran for
+        /// </summary>
+        public Guid ExecutionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the function ID
+        /// </summary>
+        public Guid FunctionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account owning the function
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the policy the execution ran under
+        /// </summary>
+        public QuorumPolicy Policy { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether at least <see cref="QuorumPolicy.Threshold"/> nodes agreed
+        /// </summary>
+        public bool Agreed { get; set; }
+
+        /// <summary>
+        /// Gets or sets how many nodes returned the agreed result
+        /// </summary>
+        public int AgreeingNodes { get; set; }
+
+        /// <summary>
+        /// Gets or sets the node whose output was used; null when the quorum was not reached
+        /// </summary>
+        public string SelectedNodeId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the output acted on; null when the quorum was not reached
+        /// </summary>
+        public object AgreedOutput { get; set; }
+
+        /// <summary>
+        /// Gets or sets the result each node returned
+        /// </summary>
+        public List<QuorumNodeResult> Results { get; set; } = new List<QuorumNodeResult>();
+
+        /// <summary>
+        /// Gets or sets when the nodes were asked to run the function
+        /// </summary>
+        public DateTime StartedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the last node answered
+        /// </summary>
+        public DateTime CompletedAt { get; set; }
+    }
+
+    /// <summary>
+    /// Result one node returned for a quorum execution
+    /// </summary>
+    public class QuorumNodeResult
+    {
+        /// <summary>
+        /// Gets or sets the node ID
+        /// </summary>
+        public string NodeId { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether the node ran the function without error
+        /// </summary>
+        public bool Succeeded { get; set; }
+
+        /// <summary>
+        /// Gets or sets the output the node's enclave returned
+        /// </summary>
+        public object Output { get; set; }
+
+        /// <summary>
+        /// Gets or sets the compared value, as canonical JSON
+        /// </summary>
+        public string ComparedValue { get; set; }
+
+        /// <summary>
+        /// Gets or sets the hex SHA-256 digest of <see cref="ComparedValue"/>
+        /// </summary>
+        public string ResultDigest { get; set; }
+
+        /// <summary>
+        /// Gets or sets the hex SHA-256 digest of the attestation document of the node's enclave
+        /// </summary>
+        public string AttestationDigest { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether the node's result was part of the agreeing group
+        /// </summary>
+        public bool Agreed { get; set; }
+
+        /// <summary>
+        /// Gets or sets why the node failed
+        /// </summary>
+        public string Error { get; set; }
+
+        /// <summary>
+        /// Gets or sets how long the node took to answer, in milliseconds
+        /// </summary>
+        public long DurationMs { get; set; }
+    }
+
+    /// <summary>
+    /// Request a node receives to run its part of a quorum execution
+    /// </summary>
+    public class QuorumNodeRequest
+    {
+        /// <summary>
+        /// Gets or sets the execution ID
+        /// </summary>
+        public Guid ExecutionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the function ID
+        /// </summary>
+        public Guid FunctionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the enclave operation, e.g. "executeFunction"
+        /// </summary>
+        public string Operation { get; set; }
+
+        /// <summary>
+        /// Gets or sets the request passed to the node's enclave, as built for the local enclave
+        /// </summary>
+        public object ExecuteRequest { get; set; }
+    }
+}
@@ -0,0 +1,50 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// How many independent nodes run a critical function and how many of their results must agree
+    /// </summary>
+    public class QuorumPolicy
+    {
+        /// <summary>
+        /// Gets or sets how many nodes run each execution, including the local enclave
+        /// </summary>
+        public int Nodes { get; set; } = 3;
+
+        /// <summary>
+        /// Gets or sets how many nodes must return agreeing results; more than half of <see cref="Nodes"/>
+        /// </summary>
+        public int Threshold { get; set; } = 2;
+
+        /// <summary>
+        /// Gets or sets how results are compared, one of <see cref="QuorumComparisons"/>
+        /// </summary>
+        public string Comparison { get; set; } = QuorumComparisons.Exact;
+
+        /// <summary>
+        /// Gets or sets the JSON path of the compared value within the function's result, e.g. "price";
+        /// null to compare the whole result
+        /// </summary>
+        public string ResultPath { get; set; }
+
+        /// <summary>
+        /// Gets or sets how far a numeric result may be from the median and still agree, as a fraction of the median
+        /// </summary>
+        public decimal Tolerance { get; set; }
+    }
+
+    /// <summary>
+    /// Ways of comparing the results of a quorum execution
+    /// </summary>
+    public static class QuorumComparisons
+    {
+        /// <summary>
+        /// Results agree when their JSON is identical, ignoring property order
+        /// </summary>
+        public const string Exact = "exact";
+
+        /// <summary>
+        /// Results are numbers and agree when within the policy's tolerance of their median
+        /// </summary>
+        public const string Numeric = "numeric";
+    }
+}
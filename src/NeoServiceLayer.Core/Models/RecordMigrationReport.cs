@@ -0,0 +1,83 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Result of a bulk record migration
+    /// </summary>
+    public class RecordMigrationReport
+    {
+        /// <summary>
+        /// Gets or sets when the migration started
+        /// </summary>
+        public DateTime StartedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the migration finished
+        /// </summary>
+        public DateTime CompletedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the result for each record type
+        /// </summary>
+        public List<RecordMigrationResult> Results { get; set; } = new List<RecordMigrationResult>();
+
+        /// <summary>
+        /// Gets whether every record was upgraded
+        /// </summary>
+        public bool Succeeded => Results.All(r => r.Error == null && r.Failed == 0);
+    }
+
+    /// <summary>
+    /// Result of migrating the records of one type
+    /// </summary>
+    public class RecordMigrationResult
+    {
+        /// <summary>
+        /// Gets or sets the record type name
+        /// </summary>
+        public string RecordType { get; set; }
+
+        /// <summary>
+        /// Gets or sets the collection the records are stored in
+        /// </summary>
+        public string Collection { get; set; }
+
+        /// <summary>
+        /// Gets or sets the schema version the records were upgraded to
+        /// </summary>
+        public int CurrentVersion { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of records read
+        /// </summary>
+        public int Scanned { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of records upgraded and written back
+        /// </summary>
+        public int Upgraded { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of records that could not be upgraded or written back
+        /// </summary>
+        public int Failed { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of records written by a newer schema version, which are left alone
+        /// </summary>
+        public int Newer { get; set; }
+
+        /// <summary>
+        /// Gets or sets a line for each record that failed
+        /// </summary>
+        public List<string> Details { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets the error that stopped the migration of this type, or null if it completed
+        /// </summary>
+        public string Error { get; set; }
+    }
+}
@@ -0,0 +1,100 @@
+using System;
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a trigger that runs a function on a cron schedule
+    /// </summary>
+    public class ScheduleTrigger : IVersionedRecord
+    {
+        /// <summary>
+        /// Gets or sets the trigger ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <inheritdoc/>
+        public int SchemaVersion { get; set; }
+
+        /// <summary>
+        /// Gets or sets the owning account ID
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the function run by the trigger
+        /// </summary>
+        public Guid FunctionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the trigger name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the trigger description
+        /// </summary>
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Gets or sets the five-field cron expression, evaluated in UTC
+        /// </summary>
+        public string CronExpression { get; set; }
+
+        /// <summary>
+        /// Gets or sets the parameters the function is run with
+        /// </summary>
+        public Dictionary<string, object> Parameters { get; set; } = new Dictionary<string, object>();
+
+        /// <summary>
+        /// Gets or sets whether the trigger fires
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets the next fire time, or null if the schedule never fires again
+        /// </summary>
+        public DateTime? NextRunAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the fire time of the last run
+        /// </summary>
+        public DateTime? LastRunAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the instance that ran the last firing
+        /// </summary>
+        public string LastRunInstanceId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the error of the last run, if it failed
+        /// </summary>
+        public string LastError { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of runs
+        /// </summary>
+        public int RunCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the tags used to organize and filter triggers
+        /// </summary>
+        public List<string> Tags { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets the creation timestamp
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last update timestamp
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the revision, incremented by every update and used as the entity tag
+        /// </summary>
+        public long Revision { get; set; }
+    }
+}
@@ -0,0 +1,63 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for the cron scheduler of schedule triggers
+    /// </summary>
+    public class SchedulerConfiguration
+    {
+        /// <summary>
+        /// Gets or sets a value indicating whether schedule triggers fire on this instance
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets how often due triggers are looked for, in seconds
+        /// </summary>
+        public int PollIntervalSeconds { get; set; } = 5;
+
+        /// <summary>
+        /// Gets or sets how long a claimed firing may run before another instance treats it as abandoned, in seconds
+        /// </summary>
+        public int FiringLeaseSeconds { get; set; } = 300;
+
+        /// <summary>
+        /// Gets or sets the maximum number of schedule triggers per account
+        /// </summary>
+        public int MaxTriggersPerAccount { get; set; } = 100;
+
+        /// <summary>
+        /// Gets or sets the maximum number of due triggers run at once
+        /// </summary>
+        public int MaxConcurrentFirings { get; set; } = 8;
+
+        /// <summary>
+        /// Gets or sets the number of recent runs kept to measure lateness
+        /// </summary>
+        public int LatenessSampleSize { get; set; } = 1000;
+
+        /// <summary>
+        /// Gets or sets how long trigger firings stay in the history, in days; 0 keeps them forever
+        /// </summary>
+        public int FiringRetentionDays { get; set; } = 30;
+
+        /// <summary>
+        /// Gets or sets how often expired firings are pruned, in seconds
+        /// </summary>
+        public int FiringRetentionIntervalSeconds { get; set; } = 3600;
+
+        /// <summary>
+        /// Gets or sets the maximum number of firings pruned per batch
+        /// </summary>
+        public int FiringRetentionBatchSize { get; set; } = 1000;
+
+        /// <summary>
+        /// Gets or sets a value indicating whether the scheduler's decisions are kept in the replay log
+        /// </summary>
+        public bool ReplayLogEnabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets how long decisions stay in the replay log, in days; 0 keeps them forever
+        /// </summary>
+        public int ReplayLogRetentionDays { get; set; } = 7;
+    }
+}
@@ -0,0 +1,86 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents one decision the cron scheduler made about a schedule trigger, kept in the replay log
+    /// </summary>
+    public class SchedulerDecision
+    {
+        /// <summary>
+        /// Gets or sets the decision ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the instance that made the decision
+        /// </summary>
+        public string InstanceId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the position of the decision among those of its instance, ordering decisions made at the same time
+        /// </summary>
+        public long Sequence { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the decision was made
+        /// </summary>
+        public DateTime At { get; set; }
+
+        /// <summary>
+        /// Gets or sets the kind of decision
+        /// </summary>
+        public SchedulerDecisionKind Kind { get; set; }
+
+        /// <summary>
+        /// Gets or sets the trigger ID
+        /// </summary>
+        public Guid TriggerId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the owning account ID of the trigger
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the fire time the decision is about; for a reschedule, the fire time moved on from, if any
+        /// </summary>
+        public DateTime? ScheduledFor { get; set; }
+
+        /// <summary>
+        /// Gets or sets the next fire time set by a reschedule
+        /// </summary>
+        public DateTime? NextRunAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets why a fire time was skipped or the trigger rescheduled
+        /// </summary>
+        public string Reason { get; set; }
+    }
+
+    /// <summary>
+    /// Kind of scheduler decision
+    /// </summary>
+    public enum SchedulerDecisionKind
+    {
+        /// <summary>
+        /// A poll found the trigger's fire time due
+        /// </summary>
+        Due,
+
+        /// <summary>
+        /// The instance claimed the fire time and ran the function
+        /// </summary>
+        Fire,
+
+        /// <summary>
+        /// The instance did not run a due fire time
+        /// </summary>
+        Skip,
+
+        /// <summary>
+        /// The trigger's next fire time was set
+        /// </summary>
+        Reschedule
+    }
+}
@@ -0,0 +1,55 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// How late schedule triggers ran compared with their fire times
+    /// </summary>
+    public class SchedulerLateness
+    {
+        /// <summary>
+        /// Gets or sets the number of runs measured since this instance started
+        /// </summary>
+        public long TotalRuns { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of recent runs the figures below cover
+        /// </summary>
+        public int SampleSize { get; set; }
+
+        /// <summary>
+        /// Gets or sets the average lateness, in milliseconds
+        /// </summary>
+        public double AverageMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the median lateness, in milliseconds
+        /// </summary>
+        public double P50Ms { get; set; }
+
+        /// <summary>
+        /// Gets or sets the 95th percentile lateness, in milliseconds
+        /// </summary>
+        public double P95Ms { get; set; }
+
+        /// <summary>
+        /// Gets or sets the 99th percentile lateness, in milliseconds
+        /// </summary>
+        public double P99Ms { get; set; }
+
+        /// <summary>
+        /// Gets or sets the largest lateness, in milliseconds
+        /// </summary>
+        public double MaxMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of triggers that were due in the last poll
+        /// </summary>
+        public int LastPollDueCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the last poll with due triggers ran
+        /// </summary>
+        public DateTime? LastPollAt { get; set; }
+    }
+}
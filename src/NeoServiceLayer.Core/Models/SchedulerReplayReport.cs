@@ -0,0 +1,144 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Result of replaying the scheduler's decisions in a time window against a set of schedule triggers
+    /// </summary>
+    public class SchedulerReplayReport
+    {
+        /// <summary>
+        /// Gets or sets the start of the window, inclusive
+        /// </summary>
+        public DateTime From { get; set; }
+
+        /// <summary>
+        /// Gets or sets the end of the window, exclusive
+        /// </summary>
+        public DateTime To { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of logged decisions replayed
+        /// </summary>
+        public int DecisionCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of fire times that were missed
+        /// </summary>
+        public int Missed { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of fire times that ran more than once
+        /// </summary>
+        public int Duplicates { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of runs for fire times the schedule does not have
+        /// </summary>
+        public int Unexpected { get; set; }
+
+        /// <summary>
+        /// Gets or sets the result for each trigger
+        /// </summary>
+        public List<SchedulerReplayTrigger> Triggers { get; set; } = new List<SchedulerReplayTrigger>();
+    }
+
+    /// <summary>
+    /// Replay result for one schedule trigger
+    /// </summary>
+    public class SchedulerReplayTrigger
+    {
+        /// <summary>
+        /// Gets or sets the trigger ID
+        /// </summary>
+        public Guid TriggerId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the trigger name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the cron expression replayed
+        /// </summary>
+        public string CronExpression { get; set; }
+
+        /// <summary>
+        /// Gets or sets every fire time in the window, expected or run, in order
+        /// </summary>
+        public List<SchedulerReplayFireTime> FireTimes { get; set; } = new List<SchedulerReplayFireTime>();
+    }
+
+    /// <summary>
+    /// Replay result for one fire time of a schedule trigger
+    /// </summary>
+    public class SchedulerReplayFireTime
+    {
+        /// <summary>
+        /// Gets or sets the fire time
+        /// </summary>
+        public DateTime ScheduledFor { get; set; }
+
+        /// <summary>
+        /// Gets or sets what happened to the fire time
+        /// </summary>
+        public SchedulerReplayOutcome Outcome { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of times the function ran for the fire time
+        /// </summary>
+        public int Runs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the instances that ran it
+        /// </summary>
+        public List<string> InstanceIds { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets when a poll first found the fire time due
+        /// </summary>
+        public DateTime? FirstDueAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets an explanation of the outcome
+        /// </summary>
+        public string Reason { get; set; }
+    }
+
+    /// <summary>
+    /// What happened to a fire time
+    /// </summary>
+    public enum SchedulerReplayOutcome
+    {
+        /// <summary>
+        /// The function ran once
+        /// </summary>
+        Fired,
+
+        /// <summary>
+        /// The function ran more than once
+        /// </summary>
+        Duplicate,
+
+        /// <summary>
+        /// The fire time was missed while no instance was firing and folded into a later run
+        /// </summary>
+        Coalesced,
+
+        /// <summary>
+        /// The scheduler decided not to run the fire time
+        /// </summary>
+        Skipped,
+
+        /// <summary>
+        /// The fire time was expected but nothing ran or explains its absence
+        /// </summary>
+        Missed,
+
+        /// <summary>
+        /// The function ran for a fire time the replayed schedule does not have
+        /// </summary>
+        Unexpected
+    }
+}
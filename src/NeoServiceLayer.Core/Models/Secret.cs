@@ -1,18 +1,22 @@
 using System;
 using System.Collections.Generic;
+using NeoServiceLayer.Core.Interfaces;
 
 namespace NeoServiceLayer.Core.Models
 {
     /// <summary>
     /// Represents a secret in the Neo Service Layer
     /// </summary>
-    public class Secret
+    public class Secret : IVersionedRecord
     {
         /// <summary>
         /// Unique identifier for the secret
         /// </summary>
         public Guid Id { get; set; }
 
+        /// <inheritdoc/>
+        public int SchemaVersion { get; set; }
+
         /// <summary>
         /// Name of the secret
         /// </summary>
@@ -53,6 +57,14 @@ namespace NeoServiceLayer.Core.Models
         /// </summary>
         public DateTime UpdatedAt { get; set; }
 
+        /// <summary>
+        /// Revision of the secret, incremented by every update and used as its entity tag
+        /// </summary>
+        /// <remarks>
+        /// Unlike <see cref="Version"/>, which counts value rotations, this also changes when the allowed functions do.
+        /// </remarks>
+        public long Revision { get; set; }
+
         /// <summary>
         /// Date and time when the secret expires
         /// </summary>
@@ -0,0 +1,86 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Read access to a secret that its owner gave to a function or to another user
+    /// </summary>
+    public class SecretGrant
+    {
+        /// <summary>
+        /// Gets or sets the ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the shared secret
+        /// </summary>
+        public Guid SecretId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the name of the shared secret when it was granted
+        /// </summary>
+        public string SecretName { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the account that owns the secret
+        /// </summary>
+        public Guid OwnerAccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether the grant is for one function or for every function of a user
+        /// </summary>
+        public SecretGranteeType GranteeType { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the granted function, for function grants
+        /// </summary>
+        public Guid? GranteeFunctionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the granted user, or of the granted function's owner
+        /// </summary>
+        public Guid GranteeAccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the Neo address of the granted user, for user grants
+        /// </summary>
+        public string GranteeAddress { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time after which the grant no longer gives access, or null if it does not expire
+        /// </summary>
+        public DateTime? ExpiresAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the creation timestamp
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Checks whether the grant gives access at a point in time
+        /// </summary>
+        /// <param name="now">Point in time</param>
+        /// <returns>True if the grant has not expired, false otherwise</returns>
+        public bool IsActive(DateTime now)
+        {
+            return !ExpiresAt.HasValue || ExpiresAt.Value > now;
+        }
+    }
+
+    /// <summary>
+    /// Represents who a secret grant is for
+    /// </summary>
+    public enum SecretGranteeType
+    {
+        /// <summary>
+        /// One function, whoever owns it
+        /// </summary>
+        Function,
+
+        /// <summary>
+        /// Every function of a user, identified by Neo address
+        /// </summary>
+        Account
+    }
+}
@@ -0,0 +1,182 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Public key an environment's enclave receives secret transfer bundles under
+    /// </summary>
+    public class SecretTransferKey
+    {
+        /// <summary>
+        /// Gets or sets the key ID, the first 16 hex characters of the SHA-256 of the public key
+        /// </summary>
+        public string KeyId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base64 SubjectPublicKeyInfo of the ECDH P-256 key
+        /// </summary>
+        public string PublicKey { get; set; }
+
+        /// <summary>
+        /// Gets or sets the scheme bundles are encrypted with
+        /// </summary>
+        public string Algorithm { get; set; }
+    }
+
+    /// <summary>
+    /// Secrets exported from one environment, encrypted inside its enclave to another environment's transfer key
+    /// </summary>
+    public class SecretTransferBundle
+    {
+        /// <summary>
+        /// Gets or sets the bundle format
+        /// </summary>
+        public string Format { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the transfer key the bundle is encrypted to
+        /// </summary>
+        public string RecipientKeyId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base64 SubjectPublicKeyInfo of the one-time key the bundle key was agreed with
+        /// </summary>
+        public string EphemeralPublicKey { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base64 AES-GCM nonce
+        /// </summary>
+        public string Nonce { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base64 encrypted secrets
+        /// </summary>
+        public string Ciphertext { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base64 AES-GCM tag
+        /// </summary>
+        public string Tag { get; set; }
+
+        /// <summary>
+        /// Gets or sets the names of the secrets in the bundle, readable without decrypting it
+        /// </summary>
+        public List<string> Names { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets when the bundle was exported
+        /// </summary>
+        public DateTime ExportedAt { get; set; }
+    }
+
+    /// <summary>
+    /// Result of importing a secret transfer bundle
+    /// </summary>
+    public class SecretImportResult
+    {
+        /// <summary>
+        /// Gets or sets the result of each secret in the bundle
+        /// </summary>
+        public List<SecretImportItem> Items { get; set; } = new List<SecretImportItem>();
+    }
+
+    /// <summary>
+    /// Result of importing one secret
+    /// </summary>
+    public class SecretImportItem
+    {
+        /// <summary>
+        /// Gets or sets the secret name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the secret in this environment
+        /// </summary>
+        public Guid SecretId { get; set; }
+
+        /// <summary>
+        /// Gets or sets what was done: "Created" or "Updated"
+        /// </summary>
+        public string Action { get; set; }
+
+        /// <summary>
+        /// Gets or sets the version of the secret after the import
+        /// </summary>
+        public int Version { get; set; }
+    }
+
+    /// <summary>
+    /// Request to the enclave to export secrets to another environment's transfer key
+    /// </summary>
+    public class SecretExportRequest
+    {
+        /// <summary>
+        /// Gets or sets the account that owns the secrets
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base64 SubjectPublicKeyInfo of the recipient's transfer key
+        /// </summary>
+        public string RecipientPublicKey { get; set; }
+
+        /// <summary>
+        /// Gets or sets the secrets, with their sealed values
+        /// </summary>
+        public List<Secret> Secrets { get; set; } = new List<Secret>();
+    }
+
+    /// <summary>
+    /// Request to the enclave to import a secret transfer bundle
+    /// </summary>
+    public class SecretImportRequest
+    {
+        /// <summary>
+        /// Gets or sets the account the secrets are imported into
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the bundle
+        /// </summary>
+        public SecretTransferBundle Bundle { get; set; }
+
+        /// <summary>
+        /// Gets or sets the secret ID each name is sealed under; existing secrets keep their IDs
+        /// </summary>
+        public Dictionary<string, Guid> SecretIds { get; set; } = new Dictionary<string, Guid>();
+    }
+
+    /// <summary>
+    /// A secret decrypted from a bundle and sealed again by the importing enclave
+    /// </summary>
+    public class SecretImportEntry
+    {
+        /// <summary>
+        /// Gets or sets the secret ID the value is sealed under
+        /// </summary>
+        public Guid SecretId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the secret name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the description from the source environment
+        /// </summary>
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Gets or sets the expiration date from the source environment
+        /// </summary>
+        public DateTime? ExpiresAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the value sealed by this environment's enclave
+        /// </summary>
+        public string EncryptedValue { get; set; }
+    }
+}
@@ -0,0 +1,71 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// An event pushed to clients subscribed to the server event stream
+    /// </summary>
+    public class ServerEvent
+    {
+        /// <summary>
+        /// Gets or sets the event ID
+        /// </summary>
+        public Guid Id { get; set; } = Guid.NewGuid();
+
+        /// <summary>
+        /// Gets or sets the topic, one of <see cref="ServerEventTopics"/>
+        /// </summary>
+        public string Topic { get; set; }
+
+        /// <summary>
+        /// Gets or sets the event type within the topic, e.g. "completed"
+        /// </summary>
+        public string Type { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account the event belongs to; null for public events such as price updates
+        /// </summary>
+        public Guid? AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the event happened
+        /// </summary>
+        public DateTime Timestamp { get; set; } = DateTime.UtcNow;
+
+        /// <summary>
+        /// Gets or sets the event payload
+        /// </summary>
+        public object Data { get; set; }
+    }
+
+    /// <summary>
+    /// Topics of the server event stream
+    /// </summary>
+    public static class ServerEventTopics
+    {
+        /// <summary>
+        /// Functions run by a trigger: blockchain events and webhooks
+        /// </summary>
+        public const string Triggers = "triggers";
+
+        /// <summary>
+        /// Completed function executions
+        /// </summary>
+        public const string Executions = "executions";
+
+        /// <summary>
+        /// GasBank balance changes
+        /// </summary>
+        public const string GasBank = "gasbank";
+
+        /// <summary>
+        /// Price feed updates
+        /// </summary>
+        public const string Prices = "prices";
+
+        /// <summary>
+        /// All topics
+        /// </summary>
+        public static readonly string[] All = { Triggers, Executions, GasBank, Prices };
+    }
+}
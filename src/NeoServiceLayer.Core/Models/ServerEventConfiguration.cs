@@ -0,0 +1,28 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for the server event stream
+    /// </summary>
+    public class ServerEventConfiguration
+    {
+        /// <summary>
+        /// Gets or sets whether the WebSocket endpoint accepts connections
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets how many events are buffered per subscriber; the oldest are dropped when it is full
+        /// </summary>
+        public int BufferSize { get; set; } = 256;
+
+        /// <summary>
+        /// Gets or sets how long a single send may take before the connection is closed as too slow
+        /// </summary>
+        public int SendTimeoutSeconds { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets the maximum number of connections per account
+        /// </summary>
+        public int MaxConnectionsPerAccount { get; set; } = 5;
+    }
+}
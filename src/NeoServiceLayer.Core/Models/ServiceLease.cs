@@ -0,0 +1,65 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a leadership lease held by one instance of a service
+    /// </summary>
+    /// <remarks>
+    /// The leader stores the state a standby needs to resume its work, such as the last processed block, in
+    /// <see cref="State"/> on every renewal. A standby reads it while waiting and keeps it when it takes over.
+    /// </remarks>
+    public class ServiceLease
+    {
+        /// <summary>
+        /// Gets or sets the lease name
+        /// </summary>
+        public string Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the instance holding the lease
+        /// </summary>
+        public string HolderId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the term, incremented each time the lease changes hands
+        /// </summary>
+        public long Term { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the holder acquired the lease
+        /// </summary>
+        public DateTime AcquiredAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the holder last renewed the lease
+        /// </summary>
+        public DateTime RenewedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the lease expires unless renewed
+        /// </summary>
+        public DateTime ExpiresAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the state handed off to the next holder
+        /// </summary>
+        public Dictionary<string, string> State { get; set; } = new Dictionary<string, string>();
+
+        /// <summary>
+        /// Gets or sets an instance that may not take the lease, set by a forced failover
+        /// </summary>
+        public string ExcludedHolderId { get; set; }
+
+        /// <summary>
+        /// Gets or sets when <see cref="ExcludedHolderId"/> may take the lease again
+        /// </summary>
+        public DateTime? ExcludedUntil { get; set; }
+
+        /// <summary>
+        /// Gets or sets the user who last forced a failover
+        /// </summary>
+        public string FailoverRequestedBy { get; set; }
+    }
+}
@@ -0,0 +1,20 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for starting and stopping the managed background services
+    /// </summary>
+    public class ServiceRunnerConfiguration
+    {
+        /// <summary>
+        /// Gets or sets how long a service may take to stop before shutdown moves on to the next, in seconds
+        /// </summary>
+        public int StopTimeoutSeconds { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets stop timeouts, in seconds, for services that need longer or shorter than <see cref="StopTimeoutSeconds"/>, by service name
+        /// </summary>
+        public Dictionary<string, int> ServiceStopTimeouts { get; set; } = new Dictionary<string, int>();
+    }
+}
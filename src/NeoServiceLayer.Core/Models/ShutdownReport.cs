@@ -0,0 +1,79 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Result of stopping the managed background services
+    /// </summary>
+    public class ShutdownReport
+    {
+        /// <summary>
+        /// Gets or sets when the shutdown started
+        /// </summary>
+        public DateTime StartedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the last service was stopped or given up on
+        /// </summary>
+        public DateTime CompletedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the result for each service, in stop order
+        /// </summary>
+        public List<ServiceStopResult> Services { get; set; } = new List<ServiceStopResult>();
+
+        /// <summary>
+        /// Gets whether every service stopped cleanly
+        /// </summary>
+        public bool Succeeded => Services.All(s => s.Outcome == ServiceStopOutcome.Stopped);
+    }
+
+    /// <summary>
+    /// Result of stopping one managed background service
+    /// </summary>
+    public class ServiceStopResult
+    {
+        /// <summary>
+        /// Gets or sets the service name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets how the stop ended
+        /// </summary>
+        public ServiceStopOutcome Outcome { get; set; }
+
+        /// <summary>
+        /// Gets or sets how long the stop took, in milliseconds
+        /// </summary>
+        public long DurationMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets the error the stop failed with, or null
+        /// </summary>
+        public string Error { get; set; }
+    }
+
+    /// <summary>
+    /// How stopping a managed background service ended
+    /// </summary>
+    public enum ServiceStopOutcome
+    {
+        /// <summary>
+        /// The service stopped and its work in progress finished
+        /// </summary>
+        Stopped,
+
+        /// <summary>
+        /// The service did not stop within its timeout and was left running
+        /// </summary>
+        TimedOut,
+
+        /// <summary>
+        /// The service threw while stopping
+        /// </summary>
+        Failed
+    }
+}
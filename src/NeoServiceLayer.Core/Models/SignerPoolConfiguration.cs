@@ -0,0 +1,68 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for the pool of service wallet accounts that sign trigger transactions
+    /// </summary>
+    public class SignerPoolConfiguration
+    {
+        /// <summary>
+        /// Gets or sets a value indicating whether trigger transactions are signed by pool accounts rather than
+        /// by the hot wallet of the GasBank account paying for them
+        /// </summary>
+        public bool Enabled { get; set; }
+
+        /// <summary>
+        /// Gets or sets the service wallets in the pool; every service wallet is used when empty
+        /// </summary>
+        public List<Guid> WalletIds { get; set; } = new List<Guid>();
+
+        /// <summary>
+        /// Gets or sets how the next signer is picked: "RoundRobin" or "LeastRecentlyUsed"
+        /// </summary>
+        public string Strategy { get; set; } = SignerPoolStrategies.LeastRecentlyUsed;
+
+        /// <summary>
+        /// Gets or sets the most transactions a signer may have waiting for a block
+        /// </summary>
+        public int MaxPendingTransactions { get; set; } = 1;
+
+        /// <summary>
+        /// Gets or sets how long a sent transaction counts as pending, in seconds; a few Neo blocks
+        /// </summary>
+        public int PendingTimeoutSeconds { get; set; } = 45;
+
+        /// <summary>
+        /// Gets or sets the GAS a signer keeps on top of the fees of its pending transactions
+        /// </summary>
+        public decimal MinimumGasBalance { get; set; } = 1m;
+
+        /// <summary>
+        /// Gets or sets how often signer balances are read from the chain, in seconds
+        /// </summary>
+        public int BalanceRefreshSeconds { get; set; } = 30;
+
+        /// <summary>
+        /// Gets or sets how long a signer is left out after a failed send, in seconds; doubled for each further failure
+        /// </summary>
+        public int FailureBackoffSeconds { get; set; } = 30;
+    }
+
+    /// <summary>
+    /// Signer selection strategies
+    /// </summary>
+    public static class SignerPoolStrategies
+    {
+        /// <summary>
+        /// Signers take turns in a fixed order
+        /// </summary>
+        public const string RoundRobin = "RoundRobin";
+
+        /// <summary>
+        /// The signer idle longest is picked
+        /// </summary>
+        public const string LeastRecentlyUsed = "LeastRecentlyUsed";
+    }
+}
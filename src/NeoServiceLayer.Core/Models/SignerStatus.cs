@@ -0,0 +1,106 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a pool account that signs trigger transactions
+    /// </summary>
+    public class SignerStatus
+    {
+        /// <summary>
+        /// Gets or sets the service wallet ID
+        /// </summary>
+        public Guid WalletId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the signer's Neo address
+        /// </summary>
+        public string Address { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS balance last read from the chain, less the fees sent since
+        /// </summary>
+        public decimal GasBalance { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the balance was last read from the chain
+        /// </summary>
+        public DateTime? BalanceCheckedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS reserved for the fee limits of transactions being sent or waiting for a block
+        /// </summary>
+        public decimal ReservedGas { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of transactions being sent or waiting for a block
+        /// </summary>
+        public int PendingTransactions { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of transactions the signer has sent since the service started
+        /// </summary>
+        public long SentTransactions { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the signer last sent a transaction
+        /// </summary>
+        public DateTime? LastUsedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the hash of the last transaction the signer sent
+        /// </summary>
+        public string LastTransactionHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of failed sends since the last successful one
+        /// </summary>
+        public int ConsecutiveFailures { get; set; }
+
+        /// <summary>
+        /// Gets or sets the error of the last failed send or balance read
+        /// </summary>
+        public string LastError { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the signer is tried again after failures
+        /// </summary>
+        public DateTime? BackoffUntil { get; set; }
+
+        /// <summary>
+        /// Gets or sets a value indicating whether the signer can take another transaction
+        /// </summary>
+        public bool IsAvailable { get; set; }
+    }
+
+    /// <summary>
+    /// Represents a signer reserved for one transaction
+    /// </summary>
+    public class SignerLease
+    {
+        /// <summary>
+        /// Gets or sets the unique identifier for the lease
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the service wallet that signs the transaction
+        /// </summary>
+        public Guid WalletId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the signer's Neo address
+        /// </summary>
+        public string Address { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS reserved for the transaction's fees
+        /// </summary>
+        public decimal FeeLimit { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the signer was reserved
+        /// </summary>
+        public DateTime AcquiredAt { get; set; }
+    }
+}
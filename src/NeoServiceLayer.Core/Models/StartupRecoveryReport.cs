@@ -0,0 +1,73 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Result of a startup recovery run
+    /// </summary>
+    public class StartupRecoveryReport
+    {
+        /// <summary>
+        /// Gets or sets the start time of the process the run recovered for
+        /// </summary>
+        public DateTime ProcessStartTime { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the run started
+        /// </summary>
+        public DateTime StartedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the run finished
+        /// </summary>
+        public DateTime CompletedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether the steps were skipped because another instance holds the leadership lease
+        /// </summary>
+        public bool Skipped { get; set; }
+
+        /// <summary>
+        /// Gets or sets the result of each step, in the order they ran
+        /// </summary>
+        public List<StartupRecoveryStepResult> Steps { get; set; } = new List<StartupRecoveryStepResult>();
+
+        /// <summary>
+        /// Gets whether every step completed
+        /// </summary>
+        public bool Succeeded => Steps.All(s => s.Error == null);
+    }
+
+    /// <summary>
+    /// Result of one startup recovery step
+    /// </summary>
+    public class StartupRecoveryStepResult
+    {
+        /// <summary>
+        /// Gets or sets the step name
+        /// </summary>
+        public string Step { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of items handed back to their normal flow to finish
+        /// </summary>
+        public int Resumed { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of items moved to a final or operator-reviewed state
+        /// </summary>
+        public int Resolved { get; set; }
+
+        /// <summary>
+        /// Gets or sets a line for each item the step changed
+        /// </summary>
+        public List<string> Details { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets the error that stopped the step, or null if it completed
+        /// </summary>
+        public string Error { get; set; }
+    }
+}
@@ -0,0 +1,18 @@
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Configuration for the live end-to-end system checks run by <c>POST /api/admin/ops/verify</c>
+    /// </summary>
+    public class SystemVerificationConfiguration
+    {
+        /// <summary>
+        /// Gets or sets how long a single check may take before it fails, in seconds
+        /// </summary>
+        public int CheckTimeoutSeconds { get; set; } = 15;
+
+        /// <summary>
+        /// Gets or sets the collection the storage check writes its probe record to
+        /// </summary>
+        public string StorageCollection { get; set; } = "ops_verification";
+    }
+}
@@ -0,0 +1,120 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Result of a run of live end-to-end system checks
+    /// </summary>
+    public class SystemVerificationReport
+    {
+        /// <summary>
+        /// Gets or sets when the checks started
+        /// </summary>
+        public DateTime StartedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the last check finished
+        /// </summary>
+        public DateTime CompletedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the result of each check, in run order
+        /// </summary>
+        public List<SystemVerificationCheck> Checks { get; set; } = new List<SystemVerificationCheck>();
+
+        /// <summary>
+        /// Gets whether no check failed; skipped checks do not count as failures
+        /// </summary>
+        public bool Succeeded => Checks.All(c => c.Status != SystemVerificationStatus.Failed);
+    }
+
+    /// <summary>
+    /// Result of one live system check
+    /// </summary>
+    public class SystemVerificationCheck
+    {
+        /// <summary>
+        /// Gets or sets the check name, one of <see cref="SystemVerificationChecks"/>
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the outcome
+        /// </summary>
+        public SystemVerificationStatus Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets how long the check took, in milliseconds
+        /// </summary>
+        public long DurationMs { get; set; }
+
+        /// <summary>
+        /// Gets or sets what the check found, or why it failed or was skipped
+        /// </summary>
+        public string Detail { get; set; }
+    }
+
+    /// <summary>
+    /// Outcome of a live system check
+    /// </summary>
+    public enum SystemVerificationStatus
+    {
+        /// <summary>
+        /// The component answered as expected
+        /// </summary>
+        Passed,
+
+        /// <summary>
+        /// The component failed, answered wrongly or did not answer in time
+        /// </summary>
+        Failed,
+
+        /// <summary>
+        /// The component is not configured in this deployment
+        /// </summary>
+        Skipped
+    }
+
+    /// <summary>
+    /// Names of the live system checks
+    /// </summary>
+    public static class SystemVerificationChecks
+    {
+        /// <summary>
+        /// A Neo RPC node returns the block count
+        /// </summary>
+        public const string Rpc = "rpc";
+
+        /// <summary>
+        /// The enclave decrypts a service wallet's key and signs with it
+        /// </summary>
+        public const string Wallet = "wallet";
+
+        /// <summary>
+        /// The enclave produces an attestation document that verifies
+        /// </summary>
+        public const string Attestation = "attestation";
+
+        /// <summary>
+        /// The storage provider writes, reads back and deletes a probe record
+        /// </summary>
+        public const string Storage = "storage";
+
+        /// <summary>
+        /// The active price sources return prices
+        /// </summary>
+        public const string Prices = "prices";
+
+        /// <summary>
+        /// The enclave sandbox runs a built-in JavaScript function
+        /// </summary>
+        public const string Sandbox = "sandbox";
+
+        /// <summary>
+        /// Gets every check, in run order
+        /// </summary>
+        public static IReadOnlyList<string> All { get; } = new[] { Rpc, Wallet, Attestation, Storage, Prices, Sandbox };
+    }
+}
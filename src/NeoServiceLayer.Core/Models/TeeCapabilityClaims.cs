@@ -0,0 +1,36 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Claims carried by a TEE capability token
+    /// </summary>
+    public class TeeCapabilityClaims
+    {
+        /// <summary>
+        /// Gets or sets the token ID
+        /// </summary>
+        public string TokenId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the service identity the token was issued to
+        /// </summary>
+        public string Subject { get; set; }
+
+        /// <summary>
+        /// Gets or sets the operation scopes the token grants, in the form serviceType.operation or serviceType.*
+        /// </summary>
+        public List<string> Scopes { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets the time the token was issued
+        /// </summary>
+        public DateTime IssuedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the token expires
+        /// </summary>
+        public DateTime ExpiresAt { get; set; }
+    }
+}
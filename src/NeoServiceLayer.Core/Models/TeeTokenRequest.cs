@@ -0,0 +1,30 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Request for a TEE capability token
+    /// </summary>
+    public class TeeTokenRequest
+    {
+        /// <summary>
+        /// Gets or sets the identity of the requesting service
+        /// </summary>
+        public string ServiceIdentity { get; set; }
+
+        /// <summary>
+        /// Gets or sets the credential of the requesting service
+        /// </summary>
+        public string Credential { get; set; }
+
+        /// <summary>
+        /// Gets or sets the requested operation scopes
+        /// </summary>
+        public List<string> Scopes { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets the requested lifetime in seconds. The server default is used when zero
+        /// </summary>
+        public int LifetimeSeconds { get; set; }
+    }
+}
@@ -0,0 +1,26 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Response containing an issued TEE capability token
+    /// </summary>
+    public class TeeTokenResponse
+    {
+        /// <summary>
+        /// Gets or sets the token
+        /// </summary>
+        public string Token { get; set; }
+
+        /// <summary>
+        /// Gets or sets the scopes granted by the token
+        /// </summary>
+        public List<string> Scopes { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets the time the token expires
+        /// </summary>
+        public DateTime ExpiresAt { get; set; }
+    }
+}
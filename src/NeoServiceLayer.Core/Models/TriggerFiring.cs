@@ -0,0 +1,110 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents the idempotency token of one scheduled firing of a trigger
+    /// </summary>
+    /// <remarks>
+    /// The ID is derived from the trigger and its scheduled fire time, so every instance computes the same token
+    /// for the same firing and the store can refuse the second claim.
+    /// </remarks>
+    public class TriggerFiring
+    {
+        /// <summary>
+        /// Gets or sets the token, derived from the trigger type, trigger ID and scheduled fire time
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the kind of trigger, e.g. "report"
+        /// </summary>
+        public string TriggerType { get; set; }
+
+        /// <summary>
+        /// Gets or sets the trigger ID
+        /// </summary>
+        public Guid TriggerId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time the firing was scheduled for
+        /// </summary>
+        public DateTime ScheduledFor { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the claim, unique to the instance attempt that wrote it
+        /// </summary>
+        public Guid ClaimId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the instance that claimed the firing
+        /// </summary>
+        public string InstanceId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the firing status
+        /// </summary>
+        public TriggerFiringStatus Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the firing was claimed
+        /// </summary>
+        public DateTime ClaimedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when the action finished
+        /// </summary>
+        public DateTime? CompletedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the error of a failed action
+        /// </summary>
+        public string Error { get; set; }
+    }
+
+    /// <summary>
+    /// Status of a trigger firing
+    /// </summary>
+    public enum TriggerFiringStatus
+    {
+        /// <summary>
+        /// Claimed; the action is running or the claiming instance stopped before finishing it
+        /// </summary>
+        Claimed,
+
+        /// <summary>
+        /// The action completed
+        /// </summary>
+        Completed,
+
+        /// <summary>
+        /// The action failed
+        /// </summary>
+        Failed
+    }
+
+    /// <summary>
+    /// Result of claiming a trigger firing
+    /// </summary>
+    public class TriggerFiringClaim
+    {
+        /// <summary>
+        /// Gets or sets whether this instance claimed the firing and should run the action
+        /// </summary>
+        public bool Acquired { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether another instance claimed the firing and did not finish it within the lease
+        /// </summary>
+        /// <remarks>
+        /// The firing is not run again, since the action may have taken effect before the instance stopped.
+        /// The caller should move the trigger on to its next fire time.
+        /// </remarks>
+        public bool Abandoned { get; set; }
+
+        /// <summary>
+        /// Gets or sets the stored firing; this instance's claim when acquired, otherwise the existing claim
+        /// </summary>
+        public TriggerFiring Firing { get; set; }
+    }
+}
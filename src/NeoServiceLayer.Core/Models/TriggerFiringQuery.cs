@@ -0,0 +1,57 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// A page request over the firing history of a trigger, newest first
+    /// </summary>
+    public class TriggerFiringQuery
+    {
+        /// <summary>
+        /// Gets or sets the trigger ID
+        /// </summary>
+        public Guid TriggerId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the status the firings must have, or null for any
+        /// </summary>
+        public TriggerFiringStatus? Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets the earliest scheduled fire time to include
+        /// </summary>
+        public DateTime? From { get; set; }
+
+        /// <summary>
+        /// Gets or sets the scheduled fire time to stop before
+        /// </summary>
+        public DateTime? To { get; set; }
+
+        /// <summary>
+        /// Gets or sets the cursor returned with the previous page, or null for the first page
+        /// </summary>
+        public string Cursor { get; set; }
+
+        /// <summary>
+        /// Gets or sets the maximum number of firings to return
+        /// </summary>
+        public int Limit { get; set; } = 50;
+    }
+
+    /// <summary>
+    /// A page of a trigger's firing history
+    /// </summary>
+    public class TriggerFiringPage
+    {
+        /// <summary>
+        /// Gets or sets the firings, newest first
+        /// </summary>
+        public List<TriggerFiring> Items { get; set; } = new List<TriggerFiring>();
+
+        /// <summary>
+        /// Gets or sets the cursor of the next page, or null when this is the last page
+        /// </summary>
+        public string NextCursor { get; set; }
+    }
+}
@@ -0,0 +1,74 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Aggregate outcome counts of a trigger's firings, including firings already pruned from the history
+    /// </summary>
+    /// <remarks>
+    /// Stored records hold only the firings the retention job compacted; the counts of firings still in the
+    /// history are added when the statistics are read.
+    /// </remarks>
+    public class TriggerFiringStatistics
+    {
+        /// <summary>
+        /// Gets or sets the ID, which is the trigger ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the kind of trigger, e.g. "schedule"
+        /// </summary>
+        public string TriggerType { get; set; }
+
+        /// <summary>
+        /// Gets or sets the trigger ID
+        /// </summary>
+        public Guid TriggerId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of firings whose action completed
+        /// </summary>
+        public long Completed { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of firings whose action failed
+        /// </summary>
+        public long Failed { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of firings that are still claimed, or were pruned while still claimed
+        /// </summary>
+        public long Claimed { get; set; }
+
+        /// <summary>
+        /// Gets the total number of firings
+        /// </summary>
+        public long Total => Completed + Failed + Claimed;
+
+        /// <summary>
+        /// Gets or sets the scheduled fire time of the first firing
+        /// </summary>
+        public DateTime? FirstScheduledFor { get; set; }
+
+        /// <summary>
+        /// Gets or sets the scheduled fire time of the latest firing
+        /// </summary>
+        public DateTime? LastScheduledFor { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of firings pruned from the history
+        /// </summary>
+        public long Compacted { get; set; }
+
+        /// <summary>
+        /// Gets or sets the scheduled fire time of the latest pruned firing; firings up to it are already counted
+        /// </summary>
+        public DateTime? CompactedThrough { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last update timestamp
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+    }
+}
@@ -0,0 +1,116 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// A contract the keeper calls <c>checkUpkeep</c> on and, when that reports work, <c>performUpkeep</c>
+    /// </summary>
+    public class Upkeep
+    {
+        /// <summary>
+        /// Gets or sets the upkeep ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account that registered the upkeep
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the upkeep name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the script hash of the upkeep contract
+        /// </summary>
+        public string ContractHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the hex encoded data passed to <c>checkUpkeep</c>
+        /// </summary>
+        public string CheckData { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GasBank account that pays for <c>performUpkeep</c> transactions
+        /// </summary>
+        public Guid GasBankAccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the most GAS one <c>performUpkeep</c> transaction may consume
+        /// </summary>
+        public decimal GasLimit { get; set; }
+
+        /// <summary>
+        /// Gets or sets how often <c>checkUpkeep</c> is called, in seconds
+        /// </summary>
+        public int CheckIntervalSeconds { get; set; }
+
+        /// <summary>
+        /// Gets or sets the upkeep status
+        /// </summary>
+        public UpkeepStatus Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets when <c>checkUpkeep</c> was last called
+        /// </summary>
+        public DateTime? LastCheckedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets when <c>performUpkeep</c> was last sent
+        /// </summary>
+        public DateTime? LastPerformedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the hash of the last <c>performUpkeep</c> transaction
+        /// </summary>
+        public string LastTransactionHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets why the last check or perform failed, or null if it succeeded
+        /// </summary>
+        public string LastError { get; set; }
+
+        /// <summary>
+        /// Gets or sets how many times <c>performUpkeep</c> was sent
+        /// </summary>
+        public int PerformCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the GAS charged to the GasBank account for this upkeep
+        /// </summary>
+        public decimal TotalGasSpent { get; set; }
+
+        /// <summary>
+        /// Gets or sets the creation date
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last update date
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+    }
+
+    /// <summary>
+    /// Status of an upkeep
+    /// </summary>
+    public enum UpkeepStatus
+    {
+        /// <summary>
+        /// Checked on its interval
+        /// </summary>
+        Active,
+
+        /// <summary>
+        /// Not checked until resumed
+        /// </summary>
+        Paused,
+
+        /// <summary>
+        /// Permanently stopped
+        /// </summary>
+        Cancelled
+    }
+}
@@ -0,0 +1,66 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents the outcome of test-invoking an upkeep's checkUpkeep and performUpkeep without sending anything
+    /// </summary>
+    public class UpkeepSimulation
+    {
+        /// <summary>
+        /// Gets or sets the upkeep ID
+        /// </summary>
+        public Guid UpkeepId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the VM state of the checkUpkeep invocation (HALT or FAULT)
+        /// </summary>
+        public string CheckState { get; set; }
+
+        /// <summary>
+        /// Gets or sets the VM exception of the checkUpkeep invocation, if any
+        /// </summary>
+        public string CheckException { get; set; }
+
+        /// <summary>
+        /// Gets or sets the decoded checkUpkeep result stack
+        /// </summary>
+        public List<object> CheckResult { get; set; } = new List<object>();
+
+        /// <summary>
+        /// Gets or sets whether the contract asked for performUpkeep
+        /// </summary>
+        public bool UpkeepNeeded { get; set; }
+
+        /// <summary>
+        /// Gets or sets the perform data returned by checkUpkeep, in hex
+        /// </summary>
+        public string PerformData { get; set; }
+
+        /// <summary>
+        /// Gets or sets the VM state of the performUpkeep invocation, null if it was not test-invoked
+        /// </summary>
+        public string PerformState { get; set; }
+
+        /// <summary>
+        /// Gets or sets the VM exception of the performUpkeep invocation, if any
+        /// </summary>
+        public string PerformException { get; set; }
+
+        /// <summary>
+        /// Gets or sets the decoded performUpkeep result stack
+        /// </summary>
+        public List<object> PerformResult { get; set; } = new List<object>();
+
+        /// <summary>
+        /// Gets or sets the GAS performUpkeep consumed, null if it was not test-invoked
+        /// </summary>
+        public decimal? PerformGasConsumed { get; set; }
+
+        /// <summary>
+        /// Gets or sets why the keeper would not perform the upkeep now, null if it would or it is not needed
+        /// </summary>
+        public string Error { get; set; }
+    }
+}
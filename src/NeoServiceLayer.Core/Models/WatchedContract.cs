@@ -0,0 +1,80 @@
+using System;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents a deployed contract an account watches for upgrades
+    /// </summary>
+    /// <remarks>
+    /// The account is alerted when the contract management contract reports the contract updated or destroyed, so
+    /// automations built on the contract's methods and notifications do not break silently.
+    /// </remarks>
+    public class WatchedContract
+    {
+        /// <summary>
+        /// Gets or sets the unique identifier for the watch
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account ID that watches the contract
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the script hash of the contract, <c>0x</c>-prefixed and lowercase
+        /// </summary>
+        public string ContractHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets a label for the contract
+        /// </summary>
+        public string Label { get; set; }
+
+        /// <summary>
+        /// Gets or sets a value indicating whether the account's active event subscriptions on the contract are paused
+        /// when it changes, so they can be checked against the new version before they fire again
+        /// </summary>
+        public bool PauseDependentSubscriptions { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of updates observed since the contract was watched
+        /// </summary>
+        public int UpdateCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets a value indicating whether the contract has been destroyed
+        /// </summary>
+        public bool Destroyed { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last change observed, <c>Update</c> or <c>Destroy</c>
+        /// </summary>
+        public string LastChange { get; set; }
+
+        /// <summary>
+        /// Gets or sets the block the last change was observed in
+        /// </summary>
+        public long? LastChangeBlockHeight { get; set; }
+
+        /// <summary>
+        /// Gets or sets the transaction that made the last change
+        /// </summary>
+        public string LastChangeTransactionHash { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time of the block the last change was observed in
+        /// </summary>
+        public DateTime? LastChangedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the creation timestamp
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last update timestamp
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+    }
+}
@@ -0,0 +1,147 @@
+using System;
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Core.Models
+{
+    /// <summary>
+    /// Represents an HTTP endpoint that runs a function when a third-party webhook is delivered to it
+    /// </summary>
+    public class WebhookTrigger
+    {
+        /// <summary>
+        /// Gets or sets the unique identifier for the trigger
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the account ID that owns the trigger
+        /// </summary>
+        public Guid AccountId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the function ID to execute when a webhook is received
+        /// </summary>
+        public Guid FunctionId { get; set; }
+
+        /// <summary>
+        /// Gets or sets the name of the trigger
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the description of the trigger
+        /// </summary>
+        public string Description { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether deliveries are accepted
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets the shared secret used to verify the HMAC-SHA256 signature of each delivery, or null to accept unsigned deliveries
+        /// </summary>
+        public string SigningSecret { get; set; }
+
+        /// <summary>
+        /// Gets or sets the header carrying the hex signature, optionally prefixed with "sha256="
+        /// </summary>
+        public string SignatureHeader { get; set; } = "X-Hub-Signature-256";
+
+        /// <summary>
+        /// Gets or sets the rules that shape the delivery into function parameters
+        /// </summary>
+        public WebhookTransformation Transformation { get; set; } = new WebhookTransformation();
+
+        /// <summary>
+        /// Gets or sets how the function result is returned to the caller, one of the <see cref="WebhookResponseModes"/>
+        /// </summary>
+        public string ResponseMode { get; set; } = WebhookResponseModes.Envelope;
+
+        /// <summary>
+        /// Gets or sets the creation timestamp
+        /// </summary>
+        public DateTime CreatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last update timestamp
+        /// </summary>
+        public DateTime UpdatedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the revision, incremented by every update and used as the entity tag
+        /// </summary>
+        public long Revision { get; set; }
+
+        /// <summary>
+        /// Gets or sets the last delivery timestamp
+        /// </summary>
+        public DateTime? LastTriggeredAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of accepted deliveries
+        /// </summary>
+        public int TriggerCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the tags used to organize and filter triggers
+        /// </summary>
+        public List<string> Tags { get; set; } = new List<string>();
+    }
+
+    /// <summary>
+    /// How a webhook trigger returns the function result to the caller
+    /// </summary>
+    public static class WebhookResponseModes
+    {
+        /// <summary>
+        /// The function result is wrapped in the generic execution envelope
+        /// </summary>
+        public const string Envelope = "Envelope";
+
+        /// <summary>
+        /// The function returns <c>{status, headers, body}</c>, which becomes the HTTP response of the delivery
+        /// </summary>
+        public const string Http = "Http";
+
+        /// <summary>
+        /// Gets all response modes
+        /// </summary>
+        public static readonly string[] All = { Envelope, Http };
+    }
+
+    /// <summary>
+    /// Rules that turn a webhook delivery into function parameters
+    /// </summary>
+    /// <remarks>
+    /// Rules are applied in order: payload extraction, then header mapping, then static values, so a
+    /// static value cannot be overridden by the sender.
+    /// </remarks>
+    public class WebhookTransformation
+    {
+        /// <summary>
+        /// Gets or sets the parameters to extract from the JSON payload, keyed by parameter name with a JSONPath value (for example "$.pull_request.head.sha")
+        /// </summary>
+        public Dictionary<string, string> Extract { get; set; } = new Dictionary<string, string>();
+
+        /// <summary>
+        /// Gets or sets the request headers to pass on, keyed by header name with the parameter name as value
+        /// </summary>
+        public Dictionary<string, string> Headers { get; set; } = new Dictionary<string, string>();
+
+        /// <summary>
+        /// Gets or sets the constant parameters added to every delivery
+        /// </summary>
+        public Dictionary<string, object> Static { get; set; } = new Dictionary<string, object>();
+
+        /// <summary>
+        /// Gets or sets the parameters that must be present after transformation; deliveries missing any of them are rejected
+        /// </summary>
+        public List<string> Required { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets whether the whole payload is passed as the "payload" parameter. Always done when no extraction rules are set
+        /// </summary>
+        public bool IncludePayload { get; set; }
+    }
+}
@@ -0,0 +1,237 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Security.Cryptography;
+using System.Text;
+using System.Text.Json;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Utilities
+{
+    /// <summary>
+    /// Utility class for creating and validating TEE capability tokens
+    /// </summary>
+    /// <remarks>
+    /// A token has the form v1.&lt;base64url claims&gt;.&lt;base64url HMAC-SHA256&gt;. The signing key never leaves the TEE,
+    /// so only the TEE can issue tokens and only the TEE can verify them.
+    /// </remarks>
+    public static class CapabilityTokenUtility
+    {
+        private const string Version = "v1";
+
+        /// <summary>
+        /// Prefix of the error message the TEE returns when it rejects a request's capability token
+        /// </summary>
+        public const string UnauthorizedErrorPrefix = "Unauthorized: ";
+
+        private static readonly HashSet<string> ProtectedScopes = new HashSet<string>(StringComparer.Ordinal)
+        {
+            // Signing
+            GetScope(Constants.EnclaveServiceTypes.Wallet, "signData"),
+            GetScope(Constants.EnclaveServiceTypes.Wallet, "signTransaction"),
+            GetScope(Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.TransferNeo),
+            GetScope(Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.TransferGas),
+            GetScope(Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.TransferGasMulti),
+            GetScope(Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.TransferToken),
+            GetScope(Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.TransferNft),
+            GetScope(Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.Vote),
+            GetScope(Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.WrapBneo),
+            GetScope(Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.UnwrapBneo),
+            GetScope(Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.InvokeWrite),
+            GetScope(Constants.EnclaveServiceTypes.PriceFeed, Constants.PriceFeedOperations.SubmitToOracle),
+            GetScope(Constants.EnclaveServiceTypes.PriceFeed, Constants.PriceFeedOperations.SubmitBatchToOracle),
+            GetScope(Constants.EnclaveServiceTypes.PriceFeed, Constants.PriceFeedOperations.DeployContract),
+            GetScope(Constants.EnclaveServiceTypes.PriceFeed, "submitPriceToOracle"),
+
+            // Sealing and unsealing
+            GetScope(Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.CreateWallet),
+            GetScope(Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.ImportFromWIF),
+            GetScope(Constants.EnclaveServiceTypes.Wallet, "importWallet"),
+            GetScope(Constants.EnclaveServiceTypes.Secrets, Constants.SecretsOperations.CreateSecret),
+            GetScope(Constants.EnclaveServiceTypes.Secrets, Constants.SecretsOperations.GetSecretValue),
+            GetScope(Constants.EnclaveServiceTypes.Secrets, Constants.SecretsOperations.UpdateSecretValue),
+            GetScope(Constants.EnclaveServiceTypes.Secrets, Constants.SecretsOperations.UpdateValue),
+            GetScope(Constants.EnclaveServiceTypes.Secrets, Constants.SecretsOperations.RotateSecret),
+            GetScope(Constants.EnclaveServiceTypes.Secrets, Constants.SecretsOperations.GenerateDataKey),
+            GetScope(Constants.EnclaveServiceTypes.Secrets, Constants.SecretsOperations.DecryptDataKey),
+            GetScope(Constants.EnclaveServiceTypes.Secrets, Constants.SecretsOperations.ExportSecrets),
+            GetScope(Constants.EnclaveServiceTypes.Secrets, Constants.SecretsOperations.ImportSecrets)
+        };
+
+        private static readonly JsonSerializerOptions SerializerOptions = new JsonSerializerOptions
+        {
+            PropertyNamingPolicy = JsonNamingPolicy.CamelCase
+        };
+
+        /// <summary>
+        /// Gets the scope name of an operation
+        /// </summary>
+        /// <param name="serviceType">Enclave service type</param>
+        /// <param name="operation">Operation</param>
+        /// <returns>The scope name</returns>
+        public static string GetScope(string serviceType, string operation)
+        {
+            return $"{serviceType}.{operation}";
+        }
+
+        /// <summary>
+        /// Determines whether an operation requires a capability token
+        /// </summary>
+        /// <param name="serviceType">Enclave service type</param>
+        /// <param name="operation">Operation</param>
+        /// <returns>True if the operation is protected</returns>
+        public static bool IsProtected(string serviceType, string operation)
+        {
+            // Every attestation operation is protected
+            return serviceType == Constants.EnclaveServiceTypes.Attestation ||
+                ProtectedScopes.Contains(GetScope(serviceType, operation));
+        }
+
+        /// <summary>
+        /// Determines whether the TEE rejected a request because of its capability token
+        /// </summary>
+        /// <param name="errorMessage">Error message returned by the TEE</param>
+        /// <returns>True if the request was unauthorized</returns>
+        public static bool IsUnauthorizedError(string errorMessage)
+        {
+            return errorMessage != null && errorMessage.StartsWith(UnauthorizedErrorPrefix, StringComparison.Ordinal);
+        }
+
+        /// <summary>
+        /// Determines whether a set of scopes covers an operation
+        /// </summary>
+        /// <param name="scopes">Granted scopes; serviceType.* covers every operation of a service</param>
+        /// <param name="serviceType">Enclave service type</param>
+        /// <param name="operation">Operation</param>
+        /// <returns>True if the operation is in scope</returns>
+        public static bool IsInScope(IEnumerable<string> scopes, string serviceType, string operation)
+        {
+            if (scopes == null)
+            {
+                return false;
+            }
+
+            var scope = GetScope(serviceType, operation);
+            var wildcard = GetScope(serviceType, "*");
+            return scopes.Any(s => s == scope || s == wildcard);
+        }
+
+        /// <summary>
+        /// Creates a signed capability token
+        /// </summary>
+        /// <param name="claims">Token claims</param>
+        /// <param name="key">Signing key</param>
+        /// <returns>The token</returns>
+        public static string Create(TeeCapabilityClaims claims, byte[] key)
+        {
+            if (claims == null)
+            {
+                throw new ArgumentNullException(nameof(claims));
+            }
+
+            if (key == null || key.Length == 0)
+            {
+                throw new ArgumentException("Signing key is required", nameof(key));
+            }
+
+            var payload = Base64UrlEncode(JsonSerializer.SerializeToUtf8Bytes(claims, SerializerOptions));
+            var signature = Base64UrlEncode(Sign($"{Version}.{payload}", key));
+            return $"{Version}.{payload}.{signature}";
+        }
+
+        /// <summary>
+        /// Validates a capability token
+        /// </summary>
+        /// <param name="token">Token to validate</param>
+        /// <param name="key">Signing key</param>
+        /// <param name="now">Current time</param>
+        /// <param name="claims">Claims of the token if it is valid</param>
+        /// <param name="error">Reason the token is invalid</param>
+        /// <returns>True if the token is valid</returns>
+        public static bool TryValidate(string token, byte[] key, DateTime now, out TeeCapabilityClaims claims, out string error)
+        {
+            claims = null;
+            error = null;
+
+            if (string.IsNullOrEmpty(token))
+            {
+                error = "Capability token is missing";
+                return false;
+            }
+
+            var parts = token.Split('.');
+            if (parts.Length != 3 || parts[0] != Version)
+            {
+                error = "Capability token is malformed";
+                return false;
+            }
+
+            try
+            {
+                var expected = Sign($"{parts[0]}.{parts[1]}", key);
+                if (!CryptographicOperations.FixedTimeEquals(expected, Base64UrlDecode(parts[2])))
+                {
+                    error = "Capability token signature is invalid";
+                    return false;
+                }
+
+                claims = JsonSerializer.Deserialize<TeeCapabilityClaims>(Base64UrlDecode(parts[1]), SerializerOptions);
+            }
+            catch (FormatException)
+            {
+                error = "Capability token is malformed";
+                return false;
+            }
+            catch (JsonException)
+            {
+                error = "Capability token is malformed";
+                return false;
+            }
+
+            if (claims == null || string.IsNullOrEmpty(claims.Subject))
+            {
+                claims = null;
+                error = "Capability token has no subject";
+                return false;
+            }
+
+            if (claims.ExpiresAt <= now)
+            {
+                claims = null;
+                error = "Capability token has expired";
+                return false;
+            }
+
+            return true;
+        }
+
+        private static byte[] Sign(string input, byte[] key)
+        {
+            using (var hmac = new HMACSHA256(key))
+            {
+                return hmac.ComputeHash(Encoding.UTF8.GetBytes(input));
+            }
+        }
+
+        private static string Base64UrlEncode(byte[] data)
+        {
+            return Convert.ToBase64String(data).TrimEnd('=').Replace('+', '-').Replace('/', '_');
+        }
+
+        private static byte[] Base64UrlDecode(string data)
+        {
+            var base64 = data.Replace('-', '+').Replace('_', '/');
+            switch (base64.Length % 4)
+            {
+                case 2:
+                    base64 += "==";
+                    break;
+                case 3:
+                    base64 += "=";
+                    break;
+            }
+
+            return Convert.FromBase64String(base64);
+        }
+    }
+}
@@ -0,0 +1,220 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+
+namespace NeoServiceLayer.Core.Utilities
+{
+    /// <summary>
+    /// A five-field cron expression: minute, hour, day of month, month and day of week, evaluated in UTC
+    /// </summary>
+    /// <remarks>
+    /// Fields accept <c>*</c>, values, ranges (<c>1-5</c>), steps (<c>*/15</c>, <c>0-30/10</c>) and comma-separated lists of
+    /// these. Day of week runs from 0 (Sunday) to 6, and 7 is also Sunday. As in Vixie cron, when both day of month and
+    /// day of week are restricted (do not start with <c>*</c>), a day matches if either does.
+    /// </remarks>
+    public sealed class CronSchedule
+    {
+        // Occurrences are searched for at most this far ahead; "0 0 29 2 *" needs up to eight years
+        private const int MaxSearchDays = 366 * 9;
+
+        private readonly bool[] _minutes;
+        private readonly bool[] _hours;
+        private readonly bool[] _daysOfMonth;
+        private readonly bool[] _months;
+        private readonly bool[] _daysOfWeek;
+        private readonly bool _dayOfMonthRestricted;
+        private readonly bool _dayOfWeekRestricted;
+
+        private CronSchedule(string expression, bool[] minutes, bool[] hours, bool[] daysOfMonth, bool[] months, bool[] daysOfWeek,
+            bool dayOfMonthRestricted, bool dayOfWeekRestricted)
+        {
+            Expression = expression;
+            _minutes = minutes;
+            _hours = hours;
+            _daysOfMonth = daysOfMonth;
+            _months = months;
+            _daysOfWeek = daysOfWeek;
+            _dayOfMonthRestricted = dayOfMonthRestricted;
+            _dayOfWeekRestricted = dayOfWeekRestricted;
+        }
+
+        /// <summary>
+        /// Gets the expression the schedule was parsed from
+        /// </summary>
+        public string Expression { get; }
+
+        /// <summary>
+        /// Parses a cron expression
+        /// </summary>
+        /// <param name="expression">The expression</param>
+        /// <returns>The schedule</returns>
+        /// <exception cref="FormatException">The expression is not a valid five-field cron expression</exception>
+        public static CronSchedule Parse(string expression)
+        {
+            if (!TryParse(expression, out var schedule, out var error))
+            {
+                throw new FormatException(error);
+            }
+
+            return schedule;
+        }
+
+        /// <summary>
+        /// Tries to parse a cron expression
+        /// </summary>
+        /// <param name="expression">The expression</param>
+        /// <param name="schedule">The schedule, if the expression is valid</param>
+        /// <param name="error">Why the expression is invalid, if it is not</param>
+        /// <returns>True if the expression is valid</returns>
+        public static bool TryParse(string expression, out CronSchedule schedule, out string error)
+        {
+            schedule = null;
+
+            var fields = (expression ?? string.Empty).Split((char[])null, StringSplitOptions.RemoveEmptyEntries);
+            if (fields.Length != 5)
+            {
+                error = "A cron expression has five fields: minute, hour, day of month, month and day of week";
+                return false;
+            }
+
+            if (!TryParseField(fields[0], 0, 59, "minute", out var minutes, out error) ||
+                !TryParseField(fields[1], 0, 23, "hour", out var hours, out error) ||
+                !TryParseField(fields[2], 1, 31, "day of month", out var daysOfMonth, out error) ||
+                !TryParseField(fields[3], 1, 12, "month", out var months, out error) ||
+                !TryParseField(fields[4], 0, 7, "day of week", out var daysOfWeek, out error))
+            {
+                return false;
+            }
+
+            // 7 is another name for Sunday
+            daysOfWeek[0] |= daysOfWeek[7];
+
+            schedule = new CronSchedule(string.Join(" ", fields), minutes, hours, daysOfMonth, months, daysOfWeek,
+                !fields[2].StartsWith("*", StringComparison.Ordinal), !fields[4].StartsWith("*", StringComparison.Ordinal));
+            return true;
+        }
+
+        /// <summary>
+        /// Gets the first time after a given time that the schedule fires
+        /// </summary>
+        /// <param name="after">The time to search from, exclusive; treated as UTC</param>
+        /// <returns>The next fire time in UTC, or null if the schedule never fires, e.g. "0 0 31 2 *"</returns>
+        public DateTime? GetNextOccurrence(DateTime after)
+        {
+            var utc = after.Kind == DateTimeKind.Local ? after.ToUniversalTime() : after;
+            var start = new DateTime(utc.Year, utc.Month, utc.Day, utc.Hour, utc.Minute, 0, DateTimeKind.Utc).AddMinutes(1);
+
+            var day = start.Date;
+            for (var i = 0; i < MaxSearchDays; i++, day = day.AddDays(1))
+            {
+                if (!_months[day.Month] || !MatchesDay(day))
+                {
+                    continue;
+                }
+
+                var firstHour = day == start.Date ? start.Hour : 0;
+                for (var hour = firstHour; hour < 24; hour++)
+                {
+                    if (!_hours[hour])
+                    {
+                        continue;
+                    }
+
+                    var firstMinute = day == start.Date && hour == start.Hour ? start.Minute : 0;
+                    for (var minute = firstMinute; minute < 60; minute++)
+                    {
+                        if (_minutes[minute])
+                        {
+                            return new DateTime(day.Year, day.Month, day.Day, hour, minute, 0, DateTimeKind.Utc);
+                        }
+                    }
+                }
+            }
+
+            return null;
+        }
+
+        /// <inheritdoc/>
+        public override string ToString()
+        {
+            return Expression;
+        }
+
+        private bool MatchesDay(DateTime day)
+        {
+            var dayOfMonth = _daysOfMonth[day.Day];
+            var dayOfWeek = _daysOfWeek[(int)day.DayOfWeek];
+
+            if (_dayOfMonthRestricted && _dayOfWeekRestricted)
+            {
+                return dayOfMonth || dayOfWeek;
+            }
+
+            return dayOfMonth && dayOfWeek;
+        }
+
+        private static bool TryParseField(string field, int min, int max, string name, out bool[] values, out string error)
+        {
+            values = new bool[max + 1];
+            error = null;
+
+            foreach (var part in field.Split(','))
+            {
+                var step = 1;
+                var range = part;
+
+                var slash = part.IndexOf('/');
+                if (slash >= 0)
+                {
+                    if (!int.TryParse(part.Substring(slash + 1), NumberStyles.None, CultureInfo.InvariantCulture, out step) || step < 1)
+                    {
+                        error = $"Invalid step in {name} field: '{part}'";
+                        return false;
+                    }
+
+                    range = part.Substring(0, slash);
+                }
+
+                int first, last;
+                if (range == "*")
+                {
+                    first = min;
+                    last = max;
+                }
+                else
+                {
+                    var dash = range.IndexOf('-');
+                    var bounds = dash >= 0
+                        ? new List<string> { range.Substring(0, dash), range.Substring(dash + 1) }
+                        : new List<string> { range };
+
+                    if (!int.TryParse(bounds[0], NumberStyles.None, CultureInfo.InvariantCulture, out first) ||
+                        !int.TryParse(bounds[bounds.Count - 1], NumberStyles.None, CultureInfo.InvariantCulture, out last))
+                    {
+                        error = $"Invalid value in {name} field: '{part}'";
+                        return false;
+                    }
+
+                    // "5/15" means from 5 to the end of the range in steps of 15
+                    if (dash < 0 && slash >= 0)
+                    {
+                        last = max;
+                    }
+                }
+
+                if (first < min || last > max || first > last)
+                {
+                    error = $"Value out of range in {name} field: '{part}' (allowed {min}-{max})";
+                    return false;
+                }
+
+                for (var value = first; value <= last; value += step)
+                {
+                    values[value] = true;
+                }
+            }
+
+            return true;
+        }
+    }
+}
@@ -0,0 +1,160 @@
+using System;
+using System.IO;
+using System.Security.Cryptography;
+using System.Text;
+using System.Text.Json;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Core.Utilities
+{
+    /// <summary>
+    /// Utility class for the length-prefixed messages exchanged with the enclave over VSOCK
+    /// </summary>
+    /// <remarks>
+    /// Every message is a 4-byte little-endian length followed by that many bytes. Single requests send one
+    /// message each way; streaming requests follow the first message with <see cref="EnclaveStreamFrame"/> messages.
+    /// </remarks>
+    public static class EnclaveStreamUtility
+    {
+        /// <summary>
+        /// Largest message accepted by default, so a corrupt length prefix cannot exhaust enclave memory
+        /// </summary>
+        public const int DefaultMaxMessageSize = 16 * 1024 * 1024;
+
+        private static readonly JsonSerializerOptions SerializerOptions = new JsonSerializerOptions
+        {
+            PropertyNamingPolicy = JsonNamingPolicy.CamelCase,
+            PropertyNameCaseInsensitive = true
+        };
+
+        /// <summary>
+        /// Writes a length-prefixed message
+        /// </summary>
+        /// <param name="stream">The stream to write to</param>
+        /// <param name="message">The message bytes</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        public static async Task WriteMessageAsync(Stream stream, byte[] message, CancellationToken cancellationToken = default)
+        {
+            await stream.WriteAsync(BitConverter.GetBytes(message.Length), cancellationToken);
+            await stream.WriteAsync(message, cancellationToken);
+            await stream.FlushAsync(cancellationToken);
+        }
+
+        /// <summary>
+        /// Reads a length-prefixed message
+        /// </summary>
+        /// <param name="stream">The stream to read from</param>
+        /// <param name="maxMessageSize">Largest message accepted</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The message bytes</returns>
+        public static async Task<byte[]> ReadMessageAsync(Stream stream, int maxMessageSize = DefaultMaxMessageSize, CancellationToken cancellationToken = default)
+        {
+            var lengthBytes = new byte[4];
+            await ReadExactlyAsync(stream, lengthBytes, cancellationToken);
+
+            var length = BitConverter.ToInt32(lengthBytes);
+            if (length < 0 || length > maxMessageSize)
+            {
+                throw new InvalidDataException($"Message length {length} is outside the allowed range of 0 to {maxMessageSize} bytes");
+            }
+
+            var message = new byte[length];
+            await ReadExactlyAsync(stream, message, cancellationToken);
+            return message;
+        }
+
+        /// <summary>
+        /// Writes a stream frame
+        /// </summary>
+        /// <param name="stream">The stream to write to</param>
+        /// <param name="frame">The frame</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        public static Task WriteFrameAsync(Stream stream, EnclaveStreamFrame frame, CancellationToken cancellationToken = default)
+        {
+            return WriteMessageAsync(stream, JsonSerializer.SerializeToUtf8Bytes(frame, SerializerOptions), cancellationToken);
+        }
+
+        /// <summary>
+        /// Reads a stream frame
+        /// </summary>
+        /// <param name="stream">The stream to read from</param>
+        /// <param name="maxMessageSize">Largest frame accepted</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The frame</returns>
+        public static async Task<EnclaveStreamFrame> ReadFrameAsync(Stream stream, int maxMessageSize = DefaultMaxMessageSize, CancellationToken cancellationToken = default)
+        {
+            var message = await ReadMessageAsync(stream, maxMessageSize, cancellationToken);
+            var frame = JsonSerializer.Deserialize<EnclaveStreamFrame>(message, SerializerOptions);
+            if (string.IsNullOrEmpty(frame?.Type))
+            {
+                throw new InvalidDataException("Stream frame has no type");
+            }
+
+            return frame;
+        }
+
+        /// <summary>
+        /// Gets the bytes covered by a result signature
+        /// </summary>
+        /// <param name="requestId">The request ID</param>
+        /// <param name="data">The serialized result</param>
+        /// <returns>The request ID as UTF-8 followed by the result bytes</returns>
+        public static byte[] GetSignedContent(string requestId, byte[] data)
+        {
+            var prefix = Encoding.UTF8.GetBytes(requestId ?? string.Empty);
+            var content = new byte[prefix.Length + (data?.Length ?? 0)];
+            prefix.CopyTo(content, 0);
+            data?.CopyTo(content, prefix.Length);
+            return content;
+        }
+
+        /// <summary>
+        /// Verifies the signature of a result frame
+        /// </summary>
+        /// <param name="frame">The result frame</param>
+        /// <param name="publicKey">Base64 SubjectPublicKeyInfo of the expected enclave key</param>
+        /// <returns>True if the frame is a result signed by the given key</returns>
+        public static bool VerifyResult(EnclaveStreamFrame frame, string publicKey)
+        {
+            if (frame?.Type != EnclaveStreamFrameTypes.Result || string.IsNullOrEmpty(frame.Signature) || string.IsNullOrEmpty(publicKey))
+            {
+                return false;
+            }
+
+            try
+            {
+                using var ecdsa = ECDsa.Create();
+                ecdsa.ImportSubjectPublicKeyInfo(Convert.FromBase64String(publicKey), out _);
+                return ecdsa.VerifyData(
+                    GetSignedContent(frame.RequestId, frame.Data),
+                    Convert.FromBase64String(frame.Signature),
+                    HashAlgorithmName.SHA256);
+            }
+            catch (FormatException)
+            {
+                return false;
+            }
+            catch (CryptographicException)
+            {
+                return false;
+            }
+        }
+
+        private static async Task ReadExactlyAsync(Stream stream, byte[] buffer, CancellationToken cancellationToken)
+        {
+            var offset = 0;
+            while (offset < buffer.Length)
+            {
+                var read = await stream.ReadAsync(buffer.AsMemory(offset), cancellationToken);
+                if (read == 0)
+                {
+                    throw new EndOfStreamException("The connection closed before the message was complete");
+                }
+
+                offset += read;
+            }
+        }
+    }
+}
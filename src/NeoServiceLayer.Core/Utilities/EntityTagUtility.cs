@@ -0,0 +1,54 @@
+using System;
+using System.Globalization;
+
+namespace NeoServiceLayer.Core.Utilities
+{
+    /// <summary>
+    /// Utility class for the entity tags that guard updates with optimistic concurrency
+    /// </summary>
+    /// <remarks>
+    /// An entity tag is the revision of a resource as a quoted string, for example <c>"5"</c>. Clients read it from the
+    /// <c>ETag</c> header and send it back in <c>If-Match</c>; an update whose tag no longer matches is rejected
+    /// rather than overwriting a change the client has not seen.
+    /// </remarks>
+    public static class EntityTagUtility
+    {
+        /// <summary>
+        /// Formats a revision as an entity tag
+        /// </summary>
+        /// <param name="revision">The revision</param>
+        /// <returns>The entity tag</returns>
+        public static string Format(long revision)
+        {
+            return "\"" + revision.ToString(CultureInfo.InvariantCulture) + "\"";
+        }
+
+        /// <summary>
+        /// Checks an <c>If-Match</c> header against the current revision of a resource
+        /// </summary>
+        /// <param name="ifMatch">Value of the header; null or empty when the update is unconditional</param>
+        /// <param name="revision">Current revision of the resource</param>
+        /// <returns>True if the update may go ahead</returns>
+        /// <remarks>
+        /// <c>*</c> matches any revision. Weak tags never match, as <c>If-Match</c> uses strong comparison.
+        /// </remarks>
+        public static bool Matches(string ifMatch, long revision)
+        {
+            if (string.IsNullOrWhiteSpace(ifMatch))
+            {
+                return true;
+            }
+
+            var current = Format(revision);
+            foreach (var tag in ifMatch.Split(',', StringSplitOptions.RemoveEmptyEntries | StringSplitOptions.TrimEntries))
+            {
+                if (tag == "*" || tag == current)
+                {
+                    return true;
+                }
+            }
+
+            return false;
+        }
+    }
+}
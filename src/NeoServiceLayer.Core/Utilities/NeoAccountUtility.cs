@@ -0,0 +1,295 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Numerics;
+using System.Security.Cryptography;
+using System.Text;
+
+namespace NeoServiceLayer.Core.Utilities
+{
+    /// <summary>
+    /// Derives Neo N3 accounts from verification scripts, chiefly m-of-n multi-signature accounts
+    /// </summary>
+    /// <remarks>
+    /// A multi-signature verification script pushes the threshold, each public key sorted the way the Neo reference
+    /// implementation sorts them, and the key count, and ends with a <c>System.Crypto.CheckMultisig</c> syscall. The
+    /// account's script hash is RIPEMD-160 of SHA-256 of that script, and its address is the Base58Check encoding of
+    /// the version byte and the little-endian script hash. The same keys and threshold always give the same account,
+    /// whatever order the keys are given in.
+    /// </remarks>
+    public static class NeoAccountUtility
+    {
+        /// <summary>
+        /// Interop hash of the <c>System.Crypto.CheckMultisig</c> syscall
+        /// </summary>
+        public const uint SystemCryptoCheckMultisig = 0x3adcd09e;
+
+        /// <summary>
+        /// Most public keys a multi-signature account may have
+        /// </summary>
+        public const int MaxMultiSigKeys = 1024;
+
+        private const byte AddressVersion = 0x35;
+        private const string Base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz";
+
+        /// <summary>
+        /// Sorts compressed public keys into the order a multi-signature verification script lists them
+        /// </summary>
+        /// <param name="publicKeys">Compressed public keys as hex</param>
+        /// <returns>The keys in lowercase hex, sorted by X coordinate</returns>
+        /// <exception cref="FormatException">A key is not a 33-byte compressed key</exception>
+        public static List<string> SortPublicKeys(IEnumerable<string> publicKeys)
+        {
+            return publicKeys
+                .Select(key => Convert.ToHexString(ParsePublicKey(key)).ToLowerInvariant())
+                .OrderBy(key => key.Substring(2), StringComparer.Ordinal)
+                .ThenBy(key => key.Substring(0, 2), StringComparer.Ordinal)
+                .ToList();
+        }
+
+        /// <summary>
+        /// Builds the verification script of an m-of-n multi-signature account
+        /// </summary>
+        /// <param name="threshold">Number of signatures required</param>
+        /// <param name="publicKeys">Compressed public keys as hex, in any order</param>
+        /// <returns>The verification script</returns>
+        /// <exception cref="FormatException">A key is invalid or repeated, or the threshold is out of range</exception>
+        public static byte[] CreateMultiSigVerificationScript(int threshold, IEnumerable<string> publicKeys)
+        {
+            var keys = SortPublicKeys(publicKeys ?? Enumerable.Empty<string>());
+            if (keys.Count == 0 || keys.Count > MaxMultiSigKeys)
+            {
+                throw new FormatException($"A multi-signature account has between 1 and {MaxMultiSigKeys} public keys");
+            }
+
+            if (keys.Distinct(StringComparer.Ordinal).Count() != keys.Count)
+            {
+                throw new FormatException("A public key is listed more than once");
+            }
+
+            if (threshold < 1 || threshold > keys.Count)
+            {
+                throw new FormatException($"The threshold must be between 1 and the number of public keys ({keys.Count})");
+            }
+
+            var builder = new NeoScriptBuilder().EmitPush(threshold);
+            foreach (var key in keys)
+            {
+                builder.EmitPush(Convert.FromHexString(key));
+            }
+
+            return builder
+                .EmitPush(keys.Count)
+                .EmitSysCall(SystemCryptoCheckMultisig)
+                .ToArray();
+        }
+
+        /// <summary>
+        /// Gets the script hash of a verification script
+        /// </summary>
+        /// <param name="verificationScript">The verification script</param>
+        /// <returns>The script hash, 0x-prefixed and big-endian</returns>
+        public static string GetScriptHash(byte[] verificationScript)
+        {
+            byte[] sha;
+            using (var sha256 = SHA256.Create())
+            {
+                sha = sha256.ComputeHash(verificationScript);
+            }
+
+            // The hash is little-endian; script hashes are written big-endian
+            var hash = Ripemd160(sha);
+            Array.Reverse(hash);
+            return "0x" + Convert.ToHexString(hash).ToLowerInvariant();
+        }
+
+        /// <summary>
+        /// Gets the address of a script hash
+        /// </summary>
+        /// <param name="scriptHash">Script hash, 0x-prefixed and big-endian</param>
+        /// <returns>The Neo N3 address</returns>
+        /// <exception cref="FormatException">The script hash is malformed</exception>
+        public static string ToAddress(string scriptHash)
+        {
+            if (scriptHash == null || !scriptHash.StartsWith("0x", StringComparison.OrdinalIgnoreCase) || scriptHash.Length != 42)
+            {
+                throw new FormatException("A script hash must be 0x followed by 40 hex digits");
+            }
+
+            var hash = Convert.FromHexString(scriptHash.Substring(2));
+            Array.Reverse(hash);
+
+            var payload = new byte[25];
+            payload[0] = AddressVersion;
+            Buffer.BlockCopy(hash, 0, payload, 1, 20);
+            using (var sha256 = SHA256.Create())
+            {
+                var checksum = sha256.ComputeHash(sha256.ComputeHash(payload, 0, 21));
+                Buffer.BlockCopy(checksum, 0, payload, 21, 4);
+            }
+
+            var value = new BigInteger(payload, isUnsigned: true, isBigEndian: true);
+            var address = new StringBuilder();
+            while (value > 0)
+            {
+                address.Insert(0, Base58Alphabet[(int)(value % 58)]);
+                value /= 58;
+            }
+
+            // Leading zero bytes are written as leading 1s
+            for (var i = 0; i < payload.Length && payload[i] == 0; i++)
+            {
+                address.Insert(0, Base58Alphabet[0]);
+            }
+
+            return address.ToString();
+        }
+
+        private static byte[] ParsePublicKey(string publicKey)
+        {
+            byte[] key = null;
+            try
+            {
+                key = publicKey == null ? null : Convert.FromHexString(publicKey);
+            }
+            catch (FormatException)
+            {
+            }
+
+            if (key == null || key.Length != 33 || (key[0] != 0x02 && key[0] != 0x03))
+            {
+                throw new FormatException($"'{publicKey}' is not a 33-byte compressed public key in hex");
+            }
+
+            return key;
+        }
+
+        private static readonly int[] LeftWords =
+        {
+            0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+            7, 4, 13, 1, 10, 6, 15, 3, 12, 0, 9, 5, 2, 14, 11, 8,
+            3, 10, 14, 4, 9, 15, 8, 1, 2, 7, 0, 6, 13, 11, 5, 12,
+            1, 9, 11, 10, 0, 8, 12, 4, 13, 3, 7, 15, 14, 5, 6, 2,
+            4, 0, 5, 9, 7, 12, 2, 10, 14, 1, 3, 8, 11, 6, 15, 13
+        };
+
+        private static readonly int[] RightWords =
+        {
+            5, 14, 7, 0, 9, 2, 11, 4, 13, 6, 15, 8, 1, 10, 3, 12,
+            6, 11, 3, 7, 0, 13, 5, 10, 14, 15, 8, 12, 4, 9, 1, 2,
+            15, 5, 1, 3, 7, 14, 6, 9, 11, 8, 12, 2, 10, 0, 4, 13,
+            8, 6, 4, 1, 3, 11, 15, 0, 5, 12, 2, 13, 9, 7, 10, 14,
+            12, 15, 10, 4, 1, 5, 8, 7, 6, 2, 13, 14, 0, 3, 9, 11
+        };
+
+        private static readonly int[] LeftShifts =
+        {
+            11, 14, 15, 12, 5, 8, 7, 9, 11, 13, 14, 15, 6, 7, 9, 8,
+            7, 6, 8, 13, 11, 9, 7, 15, 7, 12, 15, 9, 11, 7, 13, 12,
+            11, 13, 6, 7, 14, 9, 13, 15, 14, 8, 13, 6, 5, 12, 7, 5,
+            11, 12, 14, 15, 14, 15, 9, 8, 9, 14, 5, 6, 8, 6, 5, 12,
+            9, 15, 5, 11, 6, 8, 13, 12, 5, 12, 13, 14, 11, 8, 5, 6
+        };
+
+        private static readonly int[] RightShifts =
+        {
+            8, 9, 9, 11, 13, 15, 15, 5, 7, 7, 8, 11, 14, 14, 12, 6,
+            9, 13, 15, 7, 12, 8, 9, 11, 7, 7, 12, 7, 6, 15, 13, 11,
+            9, 7, 15, 11, 8, 6, 6, 14, 12, 13, 5, 14, 13, 13, 7, 5,
+            15, 5, 8, 11, 14, 14, 6, 14, 6, 9, 12, 9, 12, 5, 15, 8,
+            8, 5, 12, 9, 12, 5, 14, 6, 8, 13, 6, 5, 15, 13, 11, 11
+        };
+
+        private static readonly uint[] LeftConstants = { 0x00000000, 0x5a827999, 0x6ed9eba1, 0x8f1bbcdc, 0xa953fd4e };
+        private static readonly uint[] RightConstants = { 0x50a28be6, 0x5c4dd124, 0x6d703ef3, 0x7a6d76e9, 0x00000000 };
+
+        // .NET has no RIPEMD-160 outside Windows, so it is implemented here after the reference description
+        private static byte[] Ripemd160(byte[] data)
+        {
+            var padded = new byte[((data.Length + 8) / 64 + 1) * 64];
+            Buffer.BlockCopy(data, 0, padded, 0, data.Length);
+            padded[data.Length] = 0x80;
+            var bitLength = (ulong)data.Length * 8;
+            for (var i = 0; i < 8; i++)
+            {
+                padded[padded.Length - 8 + i] = (byte)(bitLength >> (8 * i));
+            }
+
+            uint h0 = 0x67452301, h1 = 0xefcdab89, h2 = 0x98badcfe, h3 = 0x10325476, h4 = 0xc3d2e1f0;
+            var words = new uint[16];
+            for (var block = 0; block < padded.Length; block += 64)
+            {
+                for (var i = 0; i < 16; i++)
+                {
+                    words[i] = BitConverter.ToUInt32(padded, block + i * 4);
+                    if (!BitConverter.IsLittleEndian)
+                    {
+                        words[i] = System.Buffers.Binary.BinaryPrimitives.ReverseEndianness(words[i]);
+                    }
+                }
+
+                uint al = h0, bl = h1, cl = h2, dl = h3, el = h4;
+                uint ar = h0, br = h1, cr = h2, dr = h3, er = h4;
+                for (var j = 0; j < 80; j++)
+                {
+                    var round = j / 16;
+
+                    var t = RotateLeft(al + Ripemd160Function(round, bl, cl, dl) + words[LeftWords[j]] + LeftConstants[round], LeftShifts[j]) + el;
+                    al = el;
+                    el = dl;
+                    dl = RotateLeft(cl, 10);
+                    cl = bl;
+                    bl = t;
+
+                    t = RotateLeft(ar + Ripemd160Function(4 - round, br, cr, dr) + words[RightWords[j]] + RightConstants[round], RightShifts[j]) + er;
+                    ar = er;
+                    er = dr;
+                    dr = RotateLeft(cr, 10);
+                    cr = br;
+                    br = t;
+                }
+
+                var next = h1 + cl + dr;
+                h1 = h2 + dl + er;
+                h2 = h3 + el + ar;
+                h3 = h4 + al + br;
+                h4 = h0 + bl + cr;
+                h0 = next;
+            }
+
+            var hash = new byte[20];
+            var state = new[] { h0, h1, h2, h3, h4 };
+            for (var i = 0; i < 5; i++)
+            {
+                for (var b = 0; b < 4; b++)
+                {
+                    hash[i * 4 + b] = (byte)(state[i] >> (8 * b));
+                }
+            }
+
+            return hash;
+        }
+
+        private static uint Ripemd160Function(int round, uint x, uint y, uint z)
+        {
+            switch (round)
+            {
+                case 0:
+                    return x ^ y ^ z;
+                case 1:
+                    return (x & y) | (~x & z);
+                case 2:
+                    return (x | ~y) ^ z;
+                case 3:
+                    return (x & z) | (y & ~z);
+                default:
+                    return x ^ (y | ~z);
+            }
+        }
+
+        private static uint RotateLeft(uint value, int shift)
+        {
+            return (value << shift) | (value >> (32 - shift));
+        }
+    }
+}
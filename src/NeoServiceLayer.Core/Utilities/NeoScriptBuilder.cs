@@ -0,0 +1,348 @@
+using System;
+using System.Buffers.Binary;
+using System.Collections.Generic;
+using System.Globalization;
+using System.IO;
+using System.Linq;
+using System.Numerics;
+using System.Text;
+using System.Text.Json;
+using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Core.Models.Blockchain;
+
+namespace NeoServiceLayer.Core.Utilities
+{
+    /// <summary>
+    /// Emits Neo VM scripts, chiefly the invocation script of a contract call
+    /// </summary>
+    /// <remarks>
+    /// The encoding follows the Neo reference script builder, so a script built here is byte for byte what
+    /// <c>invokefunction</c> would run for the same call. A contract call pushes its arguments packed into an array,
+    /// then the call flags, the method name and the little-endian script hash, and ends with a
+    /// <c>System.Contract.Call</c> syscall. Parameters are encoded by type: Hash160 and Hash256 values are reversed
+    /// into little-endian order, ByteArray and Signature values are Base64, PublicKey values are hex, and Arrays and
+    /// Maps are built from their items recursively.
+    /// </remarks>
+    public sealed class NeoScriptBuilder
+    {
+        /// <summary>
+        /// Interop hash of the <c>System.Contract.Call</c> syscall
+        /// </summary>
+        public const uint SystemContractCall = 0x525b7d62;
+
+        private const int MaxNestingDepth = 16;
+
+        private readonly MemoryStream _script = new MemoryStream();
+
+        /// <summary>
+        /// Builds the script that calls a contract method
+        /// </summary>
+        /// <param name="scriptHash">Script hash of the contract, 0x-prefixed and big-endian</param>
+        /// <param name="operation">Method name</param>
+        /// <param name="args">Method arguments</param>
+        /// <param name="callFlags">Permissions granted to the method</param>
+        /// <returns>The script</returns>
+        /// <exception cref="FormatException">The script hash or an argument cannot be encoded</exception>
+        public static byte[] CreateInvocationScript(string scriptHash, string operation, IEnumerable<NeoContractParameter> args, CallFlags callFlags = CallFlags.All)
+        {
+            return new NeoScriptBuilder()
+                .EmitDynamicCall(scriptHash, operation, callFlags, args)
+                .ToArray();
+        }
+
+        /// <summary>
+        /// Emits a contract call
+        /// </summary>
+        /// <param name="scriptHash">Script hash of the contract, 0x-prefixed and big-endian</param>
+        /// <param name="operation">Method name</param>
+        /// <param name="callFlags">Permissions granted to the method</param>
+        /// <param name="args">Method arguments</param>
+        /// <returns>This builder</returns>
+        public NeoScriptBuilder EmitDynamicCall(string scriptHash, string operation, CallFlags callFlags, IEnumerable<NeoContractParameter> args)
+        {
+            if (string.IsNullOrEmpty(operation) || operation.StartsWith("_", StringComparison.Ordinal))
+            {
+                throw new FormatException("The method name cannot be empty or start with an underscore");
+            }
+
+            EmitArray(args?.ToList() ?? new List<NeoContractParameter>(), 0);
+            EmitPush((BigInteger)(byte)callFlags);
+            EmitPush(Encoding.UTF8.GetBytes(operation));
+            EmitPush(ParseHash(scriptHash, 20, "Hash160"));
+            return EmitSysCall(SystemContractCall);
+        }
+
+        /// <summary>
+        /// Emits a syscall
+        /// </summary>
+        /// <param name="interopHash">Interop hash of the syscall</param>
+        /// <returns>This builder</returns>
+        public NeoScriptBuilder EmitSysCall(uint interopHash)
+        {
+            var bytes = new byte[4];
+            BinaryPrimitives.WriteUInt32LittleEndian(bytes, interopHash);
+            Emit(OpCode.SYSCALL);
+            _script.Write(bytes);
+            return this;
+        }
+
+        /// <summary>
+        /// Emits a contract parameter
+        /// </summary>
+        /// <param name="parameter">The parameter</param>
+        /// <returns>This builder</returns>
+        public NeoScriptBuilder EmitPush(NeoContractParameter parameter)
+        {
+            EmitParameter(parameter, 0);
+            return this;
+        }
+
+        /// <summary>
+        /// Emits an integer with the shortest push that holds it
+        /// </summary>
+        /// <param name="value">The integer</param>
+        /// <returns>This builder</returns>
+        public NeoScriptBuilder EmitPush(BigInteger value)
+        {
+            if (value >= -1 && value <= 16)
+            {
+                _script.WriteByte((byte)((int)OpCode.PUSH0 + (int)value));
+                return this;
+            }
+
+            var bytes = value.ToByteArray();
+            var sizes = new[] { 1, 2, 4, 8, 16, 32 };
+            var index = Array.FindIndex(sizes, size => size >= bytes.Length);
+            if (index < 0)
+            {
+                throw new FormatException("Integers are at most 256 bits");
+            }
+
+            Emit((OpCode)((int)OpCode.PUSHINT8 + index));
+            _script.Write(bytes);
+
+            // Pad with the sign so the value keeps its sign at the wider size
+            var padding = value.Sign < 0 ? (byte)0xff : (byte)0x00;
+            for (var i = bytes.Length; i < sizes[index]; i++)
+            {
+                _script.WriteByte(padding);
+            }
+
+            return this;
+        }
+
+        /// <summary>
+        /// Emits a boolean
+        /// </summary>
+        /// <param name="value">The boolean</param>
+        /// <returns>This builder</returns>
+        public NeoScriptBuilder EmitPush(bool value)
+        {
+            Emit(value ? OpCode.PUSHT : OpCode.PUSHF);
+            return this;
+        }
+
+        /// <summary>
+        /// Emits a byte string
+        /// </summary>
+        /// <param name="data">The bytes</param>
+        /// <returns>This builder</returns>
+        public NeoScriptBuilder EmitPush(byte[] data)
+        {
+            if (data.Length < 0x100)
+            {
+                Emit(OpCode.PUSHDATA1);
+                _script.WriteByte((byte)data.Length);
+            }
+            else if (data.Length < 0x10000)
+            {
+                var length = new byte[2];
+                BinaryPrimitives.WriteUInt16LittleEndian(length, (ushort)data.Length);
+                Emit(OpCode.PUSHDATA2);
+                _script.Write(length);
+            }
+            else
+            {
+                var length = new byte[4];
+                BinaryPrimitives.WriteUInt32LittleEndian(length, (uint)data.Length);
+                Emit(OpCode.PUSHDATA4);
+                _script.Write(length);
+            }
+
+            _script.Write(data);
+            return this;
+        }
+
+        /// <summary>
+        /// Gets the script built so far
+        /// </summary>
+        /// <returns>The script</returns>
+        public byte[] ToArray()
+        {
+            return _script.ToArray();
+        }
+
+        private void EmitParameter(NeoContractParameter parameter, int depth)
+        {
+            if (depth > MaxNestingDepth)
+            {
+                throw new FormatException($"Parameters nest at most {MaxNestingDepth} levels deep");
+            }
+
+            if (parameter == null)
+            {
+                Emit(OpCode.PUSHNULL);
+                return;
+            }
+
+            var value = parameter.Value is JsonElement json ? NeoContractParameter.FromJson(parameter.Type, json).Value : parameter.Value;
+            if (value == null)
+            {
+                Emit(OpCode.PUSHNULL);
+                return;
+            }
+
+            switch (parameter.Type)
+            {
+                case "Any":
+                    Emit(OpCode.PUSHNULL);
+                    break;
+                case "Boolean":
+                    EmitPush(value is bool b ? b : bool.TryParse(value.ToString(), out var parsed) ? parsed
+                        : throw new FormatException($"Invalid Boolean value '{value}'"));
+                    break;
+                case "Integer":
+                    EmitPush(BigInteger.TryParse(Convert.ToString(value, CultureInfo.InvariantCulture), NumberStyles.AllowLeadingSign, CultureInfo.InvariantCulture, out var integer)
+                        ? integer
+                        : throw new FormatException($"Invalid Integer value '{value}'"));
+                    break;
+                case "String":
+                    EmitPush(Encoding.UTF8.GetBytes(value.ToString()));
+                    break;
+                case "Hash160":
+                    var hash = value.ToString();
+                    EmitPush(ParseHash(hash.IsValidNeoAddress() ? hash.ToScriptHash() : hash, 20, "Hash160"));
+                    break;
+                case "Hash256":
+                    EmitPush(ParseHash(value.ToString(), 32, "Hash256"));
+                    break;
+                case "ByteArray":
+                case "Signature":
+                    EmitPush(value as byte[] ?? FromBase64(value.ToString(), parameter.Type));
+                    break;
+                case "PublicKey":
+                    var key = FromHex(value.ToString(), "PublicKey");
+                    if (key.Length != 33 || (key[0] != 0x02 && key[0] != 0x03))
+                    {
+                        throw new FormatException("A PublicKey must be a 33-byte compressed key");
+                    }
+
+                    EmitPush(key);
+                    break;
+                case "Array":
+                    EmitArray(value as IEnumerable<NeoContractParameter> ?? throw new FormatException("An Array value must be a list of parameters"), depth + 1);
+                    break;
+                case "Map":
+                    EmitMap(value as IEnumerable<NeoContractParameterMapEntry> ?? throw new FormatException("A Map value must be a list of entries"), depth + 1);
+                    break;
+                default:
+                    throw new FormatException($"Unsupported parameter type '{parameter.Type}'");
+            }
+        }
+
+        private void EmitArray(IEnumerable<NeoContractParameter> items, int depth)
+        {
+            var list = items.ToList();
+            if (list.Count == 0)
+            {
+                Emit(OpCode.NEWARRAY0);
+                return;
+            }
+
+            // PACK takes the first item from the top of the stack
+            for (var i = list.Count - 1; i >= 0; i--)
+            {
+                EmitParameter(list[i], depth);
+            }
+
+            EmitPush(list.Count);
+            Emit(OpCode.PACK);
+        }
+
+        private void EmitMap(IEnumerable<NeoContractParameterMapEntry> entries, int depth)
+        {
+            var list = entries.ToList();
+            for (var i = list.Count - 1; i >= 0; i--)
+            {
+                if (list[i]?.Key == null || list[i].Key.Type is "Array" or "Map" or "Any")
+                {
+                    throw new FormatException("Map keys must be primitive parameters");
+                }
+
+                EmitParameter(list[i].Value, depth);
+                EmitParameter(list[i].Key, depth);
+            }
+
+            EmitPush(list.Count);
+            Emit(OpCode.PACKMAP);
+        }
+
+        private void Emit(OpCode opCode)
+        {
+            _script.WriteByte((byte)opCode);
+        }
+
+        private static byte[] ParseHash(string hash, int length, string type)
+        {
+            var bytes = hash != null && hash.StartsWith("0x", StringComparison.OrdinalIgnoreCase) ? FromHex(hash.Substring(2), type) : null;
+            if (bytes == null || bytes.Length != length)
+            {
+                throw new FormatException($"A {type} must be 0x followed by {length * 2} hex digits");
+            }
+
+            // Hashes are written big-endian and pushed little-endian
+            Array.Reverse(bytes);
+            return bytes;
+        }
+
+        private static byte[] FromHex(string hex, string type)
+        {
+            try
+            {
+                return Convert.FromHexString(hex);
+            }
+            catch (FormatException)
+            {
+                throw new FormatException($"Invalid {type} value: not hex");
+            }
+        }
+
+        private static byte[] FromBase64(string base64, string type)
+        {
+            try
+            {
+                return Convert.FromBase64String(base64);
+            }
+            catch (FormatException)
+            {
+                throw new FormatException($"Invalid {type} value: not Base64");
+            }
+        }
+
+        private enum OpCode : byte
+        {
+            PUSHINT8 = 0x00,
+            PUSHT = 0x08,
+            PUSHF = 0x09,
+            PUSHNULL = 0x0B,
+            PUSHDATA1 = 0x0C,
+            PUSHDATA2 = 0x0D,
+            PUSHDATA4 = 0x0E,
+            PUSH0 = 0x10,
+            SYSCALL = 0x41,
+            PACKMAP = 0xBE,
+            PACK = 0xC0,
+            NEWARRAY0 = 0xC2
+        }
+    }
+}
@@ -0,0 +1,52 @@
+using System;
+using System.Threading;
+
+namespace NeoServiceLayer.Core.Utilities
+{
+    /// <summary>
+    /// Ambient context of the API request being served
+    /// </summary>
+    /// <remarks>
+    /// The request ID and the authenticated principal flow with the async call chain, so services can
+    /// stamp them on records and outgoing calls without them being passed through every method. They are
+    /// null outside API requests, e.g. in background timers.
+    /// </remarks>
+    public static class RequestContext
+    {
+        /// <summary>
+        /// Header carrying the request ID on API requests, API responses and outgoing calls
+        /// </summary>
+        public const string RequestIdHeader = "X-Request-ID";
+
+        private static readonly AsyncLocal<string> CurrentRequestId = new AsyncLocal<string>();
+        private static readonly AsyncLocal<string> CurrentPrincipalId = new AsyncLocal<string>();
+        private static readonly AsyncLocal<Guid?> CurrentCredentialId = new AsyncLocal<Guid?>();
+
+        /// <summary>
+        /// Gets or sets the ID of the current request
+        /// </summary>
+        public static string RequestId
+        {
+            get => CurrentRequestId.Value;
+            set => CurrentRequestId.Value = value;
+        }
+
+        /// <summary>
+        /// Gets or sets the ID of the user or account the current request is authenticated as
+        /// </summary>
+        public static string PrincipalId
+        {
+            get => CurrentPrincipalId.Value;
+            set => CurrentPrincipalId.Value = value;
+        }
+
+        /// <summary>
+        /// Gets or sets the ID of the account API key the current request is authenticated with, if any
+        /// </summary>
+        public static Guid? CredentialId
+        {
+            get => CurrentCredentialId.Value;
+            set => CurrentCredentialId.Value = value;
+        }
+    }
+}
@@ -0,0 +1,142 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Linq;
+using System.Numerics;
+using System.Text;
+using NeoServiceLayer.Core.Models.Blockchain;
+
+namespace NeoServiceLayer.Core.Utilities
+{
+    /// <summary>
+    /// Converts Neo VM stack items into plain values that serialize to readable JSON
+    /// </summary>
+    /// <remarks>
+    /// Integers become strings so values beyond 64 bits survive, with a decimal point inserted when the caller
+    /// knows the token's decimals. Byte strings become a 0x-prefixed script hash when they are 20 bytes, text
+    /// when they are printable UTF-8, and hex otherwise. Arrays, structs and maps are decoded recursively.
+    /// </remarks>
+    public static class StackItemDecoder
+    {
+        /// <summary>
+        /// Decodes a stack item
+        /// </summary>
+        /// <param name="item">The stack item</param>
+        /// <param name="decimals">Decimals to apply to integers, 0 to leave them whole</param>
+        /// <returns>A string, boolean, list, dictionary or null</returns>
+        public static object Decode(NeoStackItem item, int decimals = 0)
+        {
+            if (item == null)
+            {
+                return null;
+            }
+
+            switch (item.Type)
+            {
+                case "Integer":
+                    return FormatInteger(item.Value, decimals);
+                case "Boolean":
+                    return string.Equals(item.Value, "true", StringComparison.OrdinalIgnoreCase);
+                case "ByteString":
+                case "Buffer":
+                    return DecodeBytes(TryFromBase64(item.Value));
+                case "Array":
+                case "Struct":
+                    return DecodeStack(item.Items, decimals);
+                case "Map":
+                    // Map entries are read as two-item structs of key and value
+                    var map = new Dictionary<string, object>();
+                    foreach (var entry in item.Items ?? new List<NeoStackItem>())
+                    {
+                        if (entry.Items?.Count == 2)
+                        {
+                            map[Decode(entry.Items[0])?.ToString() ?? string.Empty] = Decode(entry.Items[1], decimals);
+                        }
+                    }
+
+                    return map;
+                case "InteropInterface":
+                    return new Dictionary<string, object> { ["iterator"] = item.Value };
+                case "Any":
+                    return null;
+                default:
+                    return item.Value;
+            }
+        }
+
+        /// <summary>
+        /// Decodes a result stack or the items of an array
+        /// </summary>
+        /// <param name="items">The stack items</param>
+        /// <param name="decimals">Decimals to apply to integers, 0 to leave them whole</param>
+        /// <returns>The decoded items, in order</returns>
+        public static List<object> DecodeStack(IEnumerable<NeoStackItem> items, int decimals = 0)
+        {
+            return (items ?? Enumerable.Empty<NeoStackItem>()).Select(i => Decode(i, decimals)).ToList();
+        }
+
+        /// <summary>
+        /// Formats an integer with a decimal point, e.g. 150000000 with 8 decimals as 1.5
+        /// </summary>
+        /// <param name="value">The integer as a string</param>
+        /// <param name="decimals">Number of decimals</param>
+        /// <returns>The formatted value, or the input unchanged if it is not an integer</returns>
+        public static string FormatInteger(string value, int decimals)
+        {
+            if (!BigInteger.TryParse(value, NumberStyles.AllowLeadingSign, CultureInfo.InvariantCulture, out var integer))
+            {
+                return value;
+            }
+
+            if (decimals <= 0)
+            {
+                return integer.ToString(CultureInfo.InvariantCulture);
+            }
+
+            var digits = BigInteger.Abs(integer).ToString(CultureInfo.InvariantCulture).PadLeft(decimals + 1, '0');
+            var whole = digits.Substring(0, digits.Length - decimals);
+            var fraction = digits.Substring(digits.Length - decimals).TrimEnd('0');
+            var sign = integer.Sign < 0 ? "-" : string.Empty;
+
+            return fraction.Length == 0 ? sign + whole : $"{sign}{whole}.{fraction}";
+        }
+
+        private static byte[] TryFromBase64(string value)
+        {
+            try
+            {
+                return Convert.FromBase64String(value ?? string.Empty);
+            }
+            catch (FormatException)
+            {
+                return Array.Empty<byte>();
+            }
+        }
+
+        private static string DecodeBytes(byte[] bytes)
+        {
+            if (bytes.Length == 20)
+            {
+                // Hash160 values are little-endian on the stack
+                var reversed = (byte[])bytes.Clone();
+                Array.Reverse(reversed);
+                return "0x" + Convert.ToHexString(reversed).ToLowerInvariant();
+            }
+
+            try
+            {
+                var text = new UTF8Encoding(false, true).GetString(bytes);
+                if (!text.Any(char.IsControl))
+                {
+                    return text;
+                }
+            }
+            catch (ArgumentException)
+            {
+                // Not UTF-8; fall through to hex
+            }
+
+            return Convert.ToHexString(bytes).ToLowerInvariant();
+        }
+    }
+}
@@ -45,6 +45,43 @@ namespace NeoServiceLayer.Enclave.Enclave
             /// Price feed service
             /// </summary>
             public const string PriceFeed = "pricefeed";
+
+            /// <summary>
+            /// Authorization service
+            /// </summary>
+            public const string Auth = "auth";
+
+            /// <summary>
+            /// Attestation service
+            /// </summary>
+            public const string Attestation = "attestation";
+        }
+
+        /// <summary>
+        /// Attestation operations
+        /// </summary>
+        public static class AttestationOperations
+        {
+            /// <summary>
+            /// Sign the digest of a build provenance statement with the attested enclave key
+            /// </summary>
+            public const string SignProvenance = "signProvenance";
+
+            /// <summary>
+            /// Prefix of the signed provenance content, separating it from signed execution results
+            /// </summary>
+            public const string ProvenanceSignaturePrefix = "neo-service-layer-provenance:";
+        }
+
+        /// <summary>
+        /// Authorization operations
+        /// </summary>
+        public static class AuthOperations
+        {
+            /// <summary>
+            /// Issue a capability token
+            /// </summary>
+            public const string IssueToken = "issueToken";
         }
 
         /// <summary>
@@ -108,10 +145,45 @@ namespace NeoServiceLayer.Enclave.Enclave
             /// </summary>
             public const string TransferGas = "transferGas";
 
+            /// <summary>
+            /// Transfer GAS to multiple recipients in one transaction
+            /// </summary>
+            public const string TransferGasMulti = "transferGasMulti";
+
             /// <summary>
             /// Transfer NEP-17 token
             /// </summary>
             public const string TransferToken = "transferToken";
+
+            /// <summary>
+            /// Transfer NEP-11 token
+            /// </summary>
+            public const string TransferNft = "transferNft";
+
+            /// <summary>
+            /// Vote for a consensus candidate with the wallet's NEO
+            /// </summary>
+            public const string Vote = "vote";
+
+            /// <summary>
+            /// Wrap NEO as bNEO
+            /// </summary>
+            public const string WrapBneo = "wrapBneo";
+
+            /// <summary>
+            /// Unwrap bNEO back to NEO
+            /// </summary>
+            public const string UnwrapBneo = "unwrapBneo";
+
+            /// <summary>
+            /// Invoke a contract method that modifies state
+            /// </summary>
+            public const string InvokeWrite = "invokeWrite";
+
+            /// <summary>
+            /// Send a transaction from a multi-signature account once enough signers have signed it
+            /// </summary>
+            public const string SendMultiSig = "sendMultiSig";
         }
 
         /// <summary>
@@ -148,6 +220,26 @@ namespace NeoServiceLayer.Enclave.Enclave
             /// Execute a function
             /// </summary>
             public const string ExecuteFunction = "executeFunction";
+
+            /// <summary>
+            /// Kill a running function execution
+            /// </summary>
+            public const string KillExecution = "killExecution";
+
+            /// <summary>
+            /// Get the memory running function executions have allocated
+            /// </summary>
+            public const string GetExecutionMemory = "getExecutionMemory";
+
+            /// <summary>
+            /// Execute a function over a stream of input chunks and log frames
+            /// </summary>
+            public const string ExecuteFunctionStream = "executeFunctionStream";
+
+            /// <summary>
+            /// Run a built-in function to check that the sandbox works
+            /// </summary>
+            public const string SmokeTest = "smokeTest";
         }
 
         /// <summary>
@@ -179,6 +271,31 @@ namespace NeoServiceLayer.Enclave.Enclave
             /// Update secret value
             /// </summary>
             public const string UpdateSecretValue = "updateSecretValue";
+
+            /// <summary>
+            /// Generate a wrapped data key
+            /// </summary>
+            public const string GenerateDataKey = "generateDataKey";
+
+            /// <summary>
+            /// Unwrap a data key
+            /// </summary>
+            public const string DecryptDataKey = "decryptDataKey";
+
+            /// <summary>
+            /// Get the public key secret transfer bundles are encrypted to
+            /// </summary>
+            public const string GetTransferKey = "getTransferKey";
+
+            /// <summary>
+            /// Encrypt secrets to another environment's transfer key
+            /// </summary>
+            public const string ExportSecrets = "exportSecrets";
+
+            /// <summary>
+            /// Decrypt a secret transfer bundle and seal its secrets
+            /// </summary>
+            public const string ImportSecrets = "importSecrets";
         }
 
         /// <summary>
@@ -0,0 +1,110 @@
+using System;
+using System.Collections.Concurrent;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Enclave.Enclave.Models;
+
+namespace NeoServiceLayer.Enclave.Enclave.Execution
+{
+    /// <summary>
+    /// Limits how many write invocations each target contract receives per window, across all accounts
+    /// </summary>
+    public class ContractInvocationLimiter
+    {
+        private readonly ILogger<ContractInvocationLimiter> _logger;
+        private readonly ContractInvocationLimitOptions _options;
+        private readonly ConcurrentDictionary<string, InvocationWindow> _windows = new ConcurrentDictionary<string, InvocationWindow>();
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ContractInvocationLimiter"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="options">Limit options</param>
+        public ContractInvocationLimiter(ILogger<ContractInvocationLimiter> logger, IOptions<ContractInvocationLimitOptions> options)
+        {
+            _logger = logger;
+            _options = options?.Value ?? new ContractInvocationLimitOptions();
+        }
+
+        /// <summary>
+        /// Waits for room to invoke a contract, or fails when the invocation is shed
+        /// </summary>
+        /// <param name="scriptHash">Script hash of the target contract</param>
+        /// <param name="accountId">Account making the invocation</param>
+        /// <returns>A task that completes when the invocation may be sent</returns>
+        /// <exception cref="InvalidOperationException">The contract is over its limit and the invocation was dropped</exception>
+        public async Task AcquireAsync(string scriptHash, Guid accountId)
+        {
+            if (!_options.Enabled)
+            {
+                return;
+            }
+
+            var limit = GetLimit(scriptHash);
+            var period = TimeSpan.FromSeconds(_options.WindowSeconds);
+            var deadline = DateTime.UtcNow.AddSeconds(_options.MaxQueueWaitSeconds);
+            var window = _windows.GetOrAdd(NormalizeHash(scriptHash), _ => new InvocationWindow { Start = DateTime.UtcNow });
+
+            while (true)
+            {
+                TimeSpan wait;
+                lock (window)
+                {
+                    var now = DateTime.UtcNow;
+                    if (now - window.Start >= period)
+                    {
+                        window.Start = now;
+                        window.Count = 0;
+                    }
+
+                    if (window.Count < limit)
+                    {
+                        window.Count++;
+                        return;
+                    }
+
+                    wait = window.Start + period - now;
+                }
+
+                if (_options.OverflowAction == ContractInvocationOverflowAction.Shed || DateTime.UtcNow + wait > deadline)
+                {
+                    _logger.LogWarning("Dropping invocation of contract {ScriptHash} by account {AccountId}: limit of {Limit} per {WindowSeconds}s reached",
+                        scriptHash, accountId, limit, _options.WindowSeconds);
+                    throw new InvalidOperationException(
+                        $"Contract {scriptHash} has reached its limit of {limit} invocations per {_options.WindowSeconds} seconds; the invocation was dropped");
+                }
+
+                _logger.LogInformation("Queuing invocation of contract {ScriptHash} by account {AccountId} for {WaitMs}ms",
+                    scriptHash, accountId, (int)wait.TotalMilliseconds);
+                await Task.Delay(wait);
+            }
+        }
+
+        private int GetLimit(string scriptHash)
+        {
+            foreach (var entry in _options.ContractLimits)
+            {
+                if (NormalizeHash(entry.Key) == NormalizeHash(scriptHash))
+                {
+                    return entry.Value;
+                }
+            }
+
+            return _options.MaxInvocationsPerWindow;
+        }
+
+        private static string NormalizeHash(string scriptHash)
+        {
+            var hash = scriptHash?.Trim() ?? string.Empty;
+            return (hash.StartsWith("0x", StringComparison.OrdinalIgnoreCase) ? hash.Substring(2) : hash).ToLowerInvariant();
+        }
+
+        private class InvocationWindow
+        {
+            public DateTime Start { get; set; }
+
+            public int Count { get; set; }
+        }
+    }
+}
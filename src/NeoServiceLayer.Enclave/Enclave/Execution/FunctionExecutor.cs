@@ -1,8 +1,11 @@
 using System;
+using System.Collections.Concurrent;
 using System.Collections.Generic;
 using System.Reflection;
+using System.Threading;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Exceptions;
 using NeoServiceLayer.Core.Models;
 using NeoServiceLayer.Enclave.Enclave.Models;
 using NeoServiceLayer.Enclave.Enclave.Services;
@@ -16,6 +19,8 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
     {
         private readonly ILogger<FunctionExecutor> _logger;
         private readonly Dictionary<string, IFunctionRuntime> _runtimes;
+        private readonly ConcurrentDictionary<Guid, CancellationTokenSource> _runningExecutions = new ConcurrentDictionary<Guid, CancellationTokenSource>();
+        private readonly ConcurrentDictionary<Guid, FunctionExecutionContext> _runningContexts = new ConcurrentDictionary<Guid, FunctionExecutionContext>();
 
         /// <summary>
         /// Initializes a new instance of the <see cref="FunctionExecutor"/> class
@@ -64,7 +69,9 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
             catch (Exception ex)
             {
                 _logger.LogError(ex, "Error compiling function: {Id}", metadata.Id);
-                throw new Exception($"Error compiling function: {ex.Message}");
+                // Whatever stops a function compiling is in its code
+                var errorCode = ex is SandboxException sandboxException ? sandboxException.ErrorCode : FunctionErrorCodes.SyntaxError;
+                throw new SandboxException(errorCode, $"Error compiling function: {ex.Message}", ex);
             }
         }
 
@@ -74,28 +81,189 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
         /// <param name="metadata">Function metadata</param>
         /// <param name="parameters">Function parameters</param>
         /// <returns>Function result</returns>
-        public virtual async Task<object> ExecuteAsync(FunctionMetadata metadata, Dictionary<string, object> parameters)
+        public virtual Task<object> ExecuteAsync(FunctionMetadata metadata, Dictionary<string, object> parameters)
         {
-            _logger.LogInformation("Executing function: {Id}, Runtime: {Runtime}", metadata.Id, metadata.Runtime);
+            return ExecuteAsync(metadata, parameters, Guid.Empty);
+        }
+
+        /// <summary>
+        /// Executes a function that can be interrupted with <see cref="CancelExecution"/>
+        /// </summary>
+        /// <param name="metadata">Function metadata</param>
+        /// <param name="parameters">Function parameters</param>
+        /// <param name="executionId">Execution ID assigned by the host</param>
+        /// <returns>Function result</returns>
+        public virtual Task<object> ExecuteAsync(FunctionMetadata metadata, Dictionary<string, object> parameters, Guid executionId)
+        {
+            return ExecuteAsync(metadata, parameters, executionId, null);
+        }
+
+        /// <summary>
+        /// Executes a function that can be interrupted with <see cref="CancelExecution"/>, reporting log lines as they are written
+        /// </summary>
+        /// <param name="metadata">Function metadata</param>
+        /// <param name="parameters">Function parameters</param>
+        /// <param name="executionId">Execution ID assigned by the host</param>
+        /// <param name="onLog">Callback invoked for each log line, or null</param>
+        /// <returns>Function result</returns>
+        public virtual Task<object> ExecuteAsync(FunctionMetadata metadata, Dictionary<string, object> parameters, Guid executionId, Action<string> onLog)
+        {
+            return ExecuteAsync(metadata, parameters, executionId, onLog, null);
+        }
+
+        /// <summary>
+        /// Executes a function that can be interrupted with <see cref="CancelExecution"/>, reporting log lines as they are written
+        /// </summary>
+        /// <param name="metadata">Function metadata</param>
+        /// <param name="parameters">Function parameters</param>
+        /// <param name="executionId">Execution ID assigned by the host</param>
+        /// <param name="onLog">Callback invoked for each log line, or null</param>
+        /// <param name="deniedPriceSymbols">Price feed symbols above the account's subscription tier, or null</param>
+        /// <returns>Function result</returns>
+        public virtual Task<object> ExecuteAsync(
+            FunctionMetadata metadata,
+            Dictionary<string, object> parameters,
+            Guid executionId,
+            Action<string> onLog,
+            IEnumerable<string>? deniedPriceSymbols)
+        {
+            return ExecuteAsync(metadata, parameters, executionId, onLog, deniedPriceSymbols, null);
+        }
+
+        /// <summary>
+        /// Executes a function that can be interrupted with <see cref="CancelExecution"/>, reporting log lines as they are written
+        /// </summary>
+        /// <param name="metadata">Function metadata</param>
+        /// <param name="parameters">Function parameters</param>
+        /// <param name="executionId">Execution ID assigned by the host</param>
+        /// <param name="onLog">Callback invoked for each log line, or null</param>
+        /// <param name="deniedPriceSymbols">Price feed symbols above the account's subscription tier, or null</param>
+        /// <param name="grantedSecrets">Sealed secrets the host granted to the function, or null</param>
+        /// <returns>Function result</returns>
+        public virtual Task<object> ExecuteAsync(
+            FunctionMetadata metadata,
+            Dictionary<string, object> parameters,
+            Guid executionId,
+            Action<string> onLog,
+            IEnumerable<string>? deniedPriceSymbols,
+            IEnumerable<GrantedSecret>? grantedSecrets)
+        {
+            return ExecuteAsync(metadata, parameters, executionId, onLog, deniedPriceSymbols, grantedSecrets, 0);
+        }
+
+        /// <summary>
+        /// Executes a function that can be interrupted with <see cref="CancelExecution"/>, reporting log lines as they are written
+        /// </summary>
+        /// <param name="metadata">Function metadata</param>
+        /// <param name="parameters">Function parameters</param>
+        /// <param name="executionId">Execution ID assigned by the host</param>
+        /// <param name="onLog">Callback invoked for each log line, or null</param>
+        /// <param name="deniedPriceSymbols">Price feed symbols above the account's subscription tier, or null</param>
+        /// <param name="grantedSecrets">Sealed secrets the host granted to the function, or null</param>
+        /// <param name="gasLimit">Gas units the execution may use, or 0 to run unmetered</param>
+        /// <returns>Function result</returns>
+        public virtual Task<object> ExecuteAsync(
+            FunctionMetadata metadata,
+            Dictionary<string, object> parameters,
+            Guid executionId,
+            Action<string> onLog,
+            IEnumerable<string>? deniedPriceSymbols,
+            IEnumerable<GrantedSecret>? grantedSecrets,
+            long gasLimit)
+        {
+            return ExecuteAsync(metadata, parameters, executionId, onLog, deniedPriceSymbols, grantedSecrets, gasLimit, null);
+        }
+
+        /// <summary>
+        /// Executes a function that can be interrupted with <see cref="CancelExecution"/>, reporting log lines as they are written
+        /// </summary>
+        /// <param name="metadata">Function metadata</param>
+        /// <param name="parameters">Function parameters</param>
+        /// <param name="executionId">Execution ID assigned by the host</param>
+        /// <param name="onLog">Callback invoked for each log line, or null</param>
+        /// <param name="deniedPriceSymbols">Price feed symbols above the account's subscription tier, or null</param>
+        /// <param name="grantedSecrets">Sealed secrets the host granted to the function, or null</param>
+        /// <param name="gasLimit">Gas units the execution may use, or 0 to run unmetered</param>
+        /// <param name="sessionKey">Key of the session whose warm sandbox the execution runs in, or null to cold start</param>
+        /// <returns>Function result</returns>
+        public virtual Task<object> ExecuteAsync(
+            FunctionMetadata metadata,
+            Dictionary<string, object> parameters,
+            Guid executionId,
+            Action<string> onLog,
+            IEnumerable<string>? deniedPriceSymbols,
+            IEnumerable<GrantedSecret>? grantedSecrets,
+            long gasLimit,
+            string? sessionKey)
+        {
+            return ExecuteAsync(metadata, parameters, executionId, onLog, deniedPriceSymbols, grantedSecrets, gasLimit, sessionKey, null);
+        }
+
+        /// <summary>
+        /// Executes a function that can be interrupted with <see cref="CancelExecution"/>, reporting log lines as they are written
+        /// </summary>
+        /// <param name="metadata">Function metadata</param>
+        /// <param name="parameters">Function parameters</param>
+        /// <param name="executionId">Execution ID assigned by the host</param>
+        /// <param name="onLog">Callback invoked for each log line, or null</param>
+        /// <param name="deniedPriceSymbols">Price feed symbols above the account's subscription tier, or null</param>
+        /// <param name="grantedSecrets">Sealed secrets the host granted to the function, or null</param>
+        /// <param name="gasLimit">Gas units the execution may use, or 0 to run unmetered</param>
+        /// <param name="sessionKey">Key of the session whose warm sandbox the execution runs in, or null to cold start</param>
+        /// <param name="gasPrices">Host's fresh gas prices of Neo and the external chains, or null</param>
+        /// <returns>Function result</returns>
+        public virtual async Task<object> ExecuteAsync(
+            FunctionMetadata metadata,
+            Dictionary<string, object> parameters,
+            Guid executionId,
+            Action<string> onLog,
+            IEnumerable<string>? deniedPriceSymbols,
+            IEnumerable<GrantedSecret>? grantedSecrets,
+            long gasLimit,
+            string? sessionKey,
+            IEnumerable<ChainGasPrice>? gasPrices)
+        {
+            _logger.LogInformation("Executing function: {Id}, Runtime: {Runtime}, ExecutionId: {ExecutionId}", metadata.Id, metadata.Runtime, executionId);
 
             if (!_runtimes.TryGetValue(metadata.Runtime, out var runtime))
             {
                 throw new Exception($"Unsupported runtime: {metadata.Runtime}");
             }
 
+            var cancellationSource = new CancellationTokenSource();
+            if (executionId != Guid.Empty)
+            {
+                _runningExecutions[executionId] = cancellationSource;
+            }
+
+            var gasMeter = CreateGasMeter(gasLimit);
+
             try
             {
                 // Create execution context
                 var context = new FunctionExecutionContext
                 {
+                    ExecutionId = executionId,
                     FunctionId = metadata.Id,
                     AccountId = metadata.AccountId,
                     EnvironmentVariables = metadata.EnvironmentVariables,
                     SecretIds = metadata.SecretIds,
                     MaxExecutionTime = metadata.MaxExecutionTime,
-                    MaxMemory = metadata.MaxMemory
+                    MaxMemory = metadata.MaxMemory,
+                    CancellationToken = cancellationSource.Token,
+                    OnLog = onLog,
+                    DeniedPriceSymbols = CreateDeniedPriceSymbols(deniedPriceSymbols),
+                    GrantedSecrets = CreateGrantedSecrets(grantedSecrets),
+                    GasMeter = gasMeter,
+                    SessionKey = sessionKey,
+                    GasPrices = CreateGasPrices(gasPrices)
                 };
 
+                if (executionId != Guid.Empty)
+                {
+                    _runningContexts[executionId] = context;
+                }
+
                 // Execute the function
                 object compiledAssembly;
 
@@ -139,11 +307,61 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
                 _logger.LogInformation("Function executed successfully: {Id}", metadata.Id);
                 return result;
             }
+            catch (Exception) when (cancellationSource.IsCancellationRequested)
+            {
+                _logger.LogWarning("Function execution was killed: {Id}, ExecutionId: {ExecutionId}", metadata.Id, executionId);
+                throw new OperationCanceledException($"Function execution {executionId} was killed");
+            }
             catch (Exception ex)
             {
                 _logger.LogError(ex, "Error executing function: {Id}", metadata.Id);
-                throw new Exception($"Error executing function: {ex.Message}");
+                throw new SandboxException(SandboxErrorClassifier.Classify(ex, null), $"Error executing function: {ex.Message}", ex)
+                {
+                    GasUsed = gasMeter?.Used ?? 0
+                };
+            }
+            finally
+            {
+                if (executionId != Guid.Empty)
+                {
+                    _runningExecutions.TryRemove(executionId, out _);
+                    _runningContexts.TryRemove(executionId, out _);
+                }
+
+                cancellationSource.Dispose();
+            }
+        }
+
+        /// <summary>
+        /// Interrupts a running function execution
+        /// </summary>
+        /// <param name="executionId">Execution ID assigned by the host</param>
+        /// <returns>True if the execution was running and has been signalled, false otherwise</returns>
+        public virtual bool CancelExecution(Guid executionId)
+        {
+            if (!_runningExecutions.TryGetValue(executionId, out var cancellationSource))
+            {
+                return false;
             }
+
+            _logger.LogWarning("Cancelling function execution: {ExecutionId}", executionId);
+            cancellationSource.Cancel();
+            return true;
+        }
+
+        /// <summary>
+        /// Gets the memory a running function execution has allocated so far
+        /// </summary>
+        /// <param name="executionId">Execution ID assigned by the host</param>
+        /// <returns>Bytes allocated, 0 if the script has not started yet, or null if the execution is not running</returns>
+        public virtual long? GetMemoryUsed(Guid executionId)
+        {
+            if (!_runningContexts.TryGetValue(executionId, out var context))
+            {
+                return null;
+            }
+
+            return context.MemoryMeter?.Used ?? 0;
         }
 
         /// <summary>
@@ -152,7 +370,79 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
         /// <param name="metadata">Function metadata</param>
         /// <param name="eventData">Event data</param>
         /// <returns>Function result</returns>
-        public virtual async Task<object> ExecuteForEventAsync(FunctionMetadata metadata, NeoServiceLayer.Enclave.Enclave.Models.Event eventData)
+        public virtual Task<object> ExecuteForEventAsync(FunctionMetadata metadata, NeoServiceLayer.Enclave.Enclave.Models.Event eventData)
+        {
+            return ExecuteForEventAsync(metadata, eventData, null);
+        }
+
+        /// <summary>
+        /// Executes a function for an event
+        /// </summary>
+        /// <param name="metadata">Function metadata</param>
+        /// <param name="eventData">Event data</param>
+        /// <param name="deniedPriceSymbols">Price feed symbols above the account's subscription tier, or null</param>
+        /// <returns>Function result</returns>
+        public virtual Task<object> ExecuteForEventAsync(
+            FunctionMetadata metadata,
+            NeoServiceLayer.Enclave.Enclave.Models.Event eventData,
+            IEnumerable<string>? deniedPriceSymbols)
+        {
+            return ExecuteForEventAsync(metadata, eventData, deniedPriceSymbols, null);
+        }
+
+        /// <summary>
+        /// Executes a function for an event
+        /// </summary>
+        /// <param name="metadata">Function metadata</param>
+        /// <param name="eventData">Event data</param>
+        /// <param name="deniedPriceSymbols">Price feed symbols above the account's subscription tier, or null</param>
+        /// <param name="grantedSecrets">Sealed secrets the host granted to the function, or null</param>
+        /// <returns>Function result</returns>
+        public virtual Task<object> ExecuteForEventAsync(
+            FunctionMetadata metadata,
+            NeoServiceLayer.Enclave.Enclave.Models.Event eventData,
+            IEnumerable<string>? deniedPriceSymbols,
+            IEnumerable<GrantedSecret>? grantedSecrets)
+        {
+            return ExecuteForEventAsync(metadata, eventData, deniedPriceSymbols, grantedSecrets, 0);
+        }
+
+        /// <summary>
+        /// Executes a function for an event
+        /// </summary>
+        /// <param name="metadata">Function metadata</param>
+        /// <param name="eventData">Event data</param>
+        /// <param name="deniedPriceSymbols">Price feed symbols above the account's subscription tier, or null</param>
+        /// <param name="grantedSecrets">Sealed secrets the host granted to the function, or null</param>
+        /// <param name="gasLimit">Gas units the execution may use, or 0 to run unmetered</param>
+        /// <returns>Function result</returns>
+        public virtual Task<object> ExecuteForEventAsync(
+            FunctionMetadata metadata,
+            NeoServiceLayer.Enclave.Enclave.Models.Event eventData,
+            IEnumerable<string>? deniedPriceSymbols,
+            IEnumerable<GrantedSecret>? grantedSecrets,
+            long gasLimit)
+        {
+            return ExecuteForEventAsync(metadata, eventData, deniedPriceSymbols, grantedSecrets, gasLimit, null);
+        }
+
+        /// <summary>
+        /// Executes a function for an event
+        /// </summary>
+        /// <param name="metadata">Function metadata</param>
+        /// <param name="eventData">Event data</param>
+        /// <param name="deniedPriceSymbols">Price feed symbols above the account's subscription tier, or null</param>
+        /// <param name="grantedSecrets">Sealed secrets the host granted to the function, or null</param>
+        /// <param name="gasLimit">Gas units the execution may use, or 0 to run unmetered</param>
+        /// <param name="gasPrices">Host's fresh gas prices of Neo and the external chains, or null</param>
+        /// <returns>Function result</returns>
+        public virtual async Task<object> ExecuteForEventAsync(
+            FunctionMetadata metadata,
+            NeoServiceLayer.Enclave.Enclave.Models.Event eventData,
+            IEnumerable<string>? deniedPriceSymbols,
+            IEnumerable<GrantedSecret>? grantedSecrets,
+            long gasLimit,
+            IEnumerable<ChainGasPrice>? gasPrices)
         {
             _logger.LogInformation("Executing function for event: {Id}, Runtime: {Runtime}, EventType: {EventType}", metadata.Id, metadata.Runtime, eventData.Type);
 
@@ -161,6 +451,8 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
                 throw new Exception($"Unsupported runtime: {metadata.Runtime}");
             }
 
+            var gasMeter = CreateGasMeter(gasLimit);
+
             try
             {
                 // Create execution context
@@ -172,7 +464,11 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
                     SecretIds = metadata.SecretIds,
                     MaxExecutionTime = metadata.MaxExecutionTime,
                     MaxMemory = metadata.MaxMemory,
-                    Event = eventData
+                    Event = eventData,
+                    DeniedPriceSymbols = CreateDeniedPriceSymbols(deniedPriceSymbols),
+                    GrantedSecrets = CreateGrantedSecrets(grantedSecrets),
+                    GasMeter = gasMeter,
+                    GasPrices = CreateGasPrices(gasPrices)
                 };
 
                 // Execute the function
@@ -232,8 +528,39 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
             catch (Exception ex)
             {
                 _logger.LogError(ex, "Error executing function for event: {Id}, EventType: {EventType}", metadata.Id, eventData.Type);
-                throw new Exception($"Error executing function for event: {ex.Message}");
+                throw new SandboxException(SandboxErrorClassifier.Classify(ex, null), $"Error executing function for event: {ex.Message}", ex)
+                {
+                    GasUsed = gasMeter?.Used ?? 0
+                };
+            }
+        }
+
+        private static GasMeter? CreateGasMeter(long gasLimit)
+        {
+            return gasLimit > 0 ? new GasMeter(gasLimit) : null;
+        }
+
+        private static HashSet<string> CreateDeniedPriceSymbols(IEnumerable<string>? deniedPriceSymbols)
+        {
+            return deniedPriceSymbols == null
+                ? new HashSet<string>(StringComparer.OrdinalIgnoreCase)
+                : new HashSet<string>(deniedPriceSymbols, StringComparer.OrdinalIgnoreCase);
+        }
+
+        private static Dictionary<Guid, GrantedSecret> CreateGrantedSecrets(IEnumerable<GrantedSecret>? grantedSecrets)
+        {
+            var secrets = new Dictionary<Guid, GrantedSecret>();
+            foreach (var secret in grantedSecrets ?? Array.Empty<GrantedSecret>())
+            {
+                secrets[secret.Id] = secret;
             }
+
+            return secrets;
+        }
+
+        private static List<ChainGasPrice> CreateGasPrices(IEnumerable<ChainGasPrice>? gasPrices)
+        {
+            return gasPrices == null ? new List<ChainGasPrice>() : new List<ChainGasPrice>(gasPrices);
         }
     }
 
@@ -242,6 +569,11 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
     /// </summary>
     public class FunctionExecutionContext
     {
+        /// <summary>
+        /// Gets or sets the execution ID assigned by the host
+        /// </summary>
+        public Guid ExecutionId { get; set; }
+
         /// <summary>
         /// Gets or sets the function ID
         /// </summary>
@@ -268,13 +600,79 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
         public int MaxExecutionTime { get; set; }
 
         /// <summary>
-        /// Gets or sets the maximum memory
+        /// Gets or sets the maximum memory the execution may allocate, in MB
         /// </summary>
         public int MaxMemory { get; set; }
 
+        /// <summary>
+        /// Gets or sets the key of the session whose warm sandbox the execution runs in, or null to cold start
+        /// </summary>
+        public string? SessionKey { get; set; }
+
         /// <summary>
         /// Gets or sets the event data
         /// </summary>
         public NeoServiceLayer.Enclave.Enclave.Models.Event? Event { get; set; }
+
+        /// <summary>
+        /// Gets or sets the token that is signalled when the execution is killed
+        /// </summary>
+        public CancellationToken CancellationToken { get; set; }
+
+        /// <summary>
+        /// Gets or sets how long the function's onTimeout handler may run after the execution times out, in milliseconds
+        /// </summary>
+        public int TimeoutGracePeriod { get; set; } = 250;
+
+        /// <summary>
+        /// Gets or sets the callback invoked for each log line as it is written, or null
+        /// </summary>
+        public Action<string>? OnLog { get; set; }
+
+        /// <summary>
+        /// Gets the custom metrics recorded by the function during execution
+        /// </summary>
+        public Dictionary<string, double> Metrics { get; } = new Dictionary<string, double>();
+
+        /// <summary>
+        /// Gets or sets the price feed symbols the account's subscription tier cannot read
+        /// </summary>
+        public HashSet<string> DeniedPriceSymbols { get; set; } = new HashSet<string>(StringComparer.OrdinalIgnoreCase);
+
+        /// <summary>
+        /// Gets or sets the sealed secrets the host granted to the function, by secret ID
+        /// </summary>
+        public Dictionary<Guid, GrantedSecret> GrantedSecrets { get; set; } = new Dictionary<Guid, GrantedSecret>();
+
+        /// <summary>
+        /// Gets or sets the host's fresh gas prices of Neo and the external chains
+        /// </summary>
+        public List<ChainGasPrice> GasPrices { get; set; } = new List<ChainGasPrice>();
+
+        /// <summary>
+        /// Gets or sets the number of host calls the function has made
+        /// </summary>
+        public int HostCallCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the meter charged for the statements and host calls the function runs, or null if unmetered
+        /// </summary>
+        public GasMeter? GasMeter { get; set; }
+
+        /// <summary>
+        /// Gets or sets the meter counting the memory the function allocates against <see cref="MaxMemory"/>, or null
+        /// to have the runtime create one
+        /// </summary>
+        public MemoryMeter? MemoryMeter { get; set; }
+
+        /// <summary>
+        /// Gets what the function has used of its fetch budgets
+        /// </summary>
+        public SandboxFetchUsage FetchUsage { get; } = new SandboxFetchUsage();
+
+        /// <summary>
+        /// Gets the security events the function caused during execution
+        /// </summary>
+        public List<FunctionSecurityEvent> SecurityEvents { get; } = new List<FunctionSecurityEvent>();
     }
 }
@@ -0,0 +1,99 @@
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Enclave.Enclave.Execution
+{
+    /// <summary>
+    /// Counts the gas units a function execution uses and stops it when its limit is reached
+    /// </summary>
+    /// <remarks>
+    /// Costs are fixed per operation rather than measured, so the same code with the same inputs always uses
+    /// the same gas, whatever the load on the enclave.
+    /// </remarks>
+    public class GasMeter
+    {
+        /// <summary>
+        /// Gas units charged for each script statement
+        /// </summary>
+        public const long StatementCost = 1;
+
+        /// <summary>
+        /// Gas units charged for each call into a host service
+        /// </summary>
+        public const long HostCallCost = 1000;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasMeter"/> class
+        /// </summary>
+        /// <param name="limit">Gas units the execution may use</param>
+        public GasMeter(long limit)
+        {
+            Limit = limit;
+        }
+
+        /// <summary>
+        /// Gets the gas units the execution may use
+        /// </summary>
+        public long Limit { get; }
+
+        /// <summary>
+        /// Gets the gas units used so far, never more than <see cref="Limit"/>
+        /// </summary>
+        public long Used { get; private set; }
+
+        /// <summary>
+        /// Gets whether a charge has been refused
+        /// </summary>
+        public bool Exhausted { get; private set; }
+
+        /// <summary>
+        /// Charges gas units to the execution
+        /// </summary>
+        /// <param name="units">Gas units</param>
+        /// <exception cref="SandboxException">The charge would exceed the limit</exception>
+        public void Charge(long units)
+        {
+            if (Used + units > Limit)
+            {
+                // Once exhausted, every further statement throws, so a script cannot catch its way past the limit
+                Used = Limit;
+                Exhausted = true;
+                throw new SandboxException(FunctionErrorCodes.GasExhausted, $"Gas limit of {Limit} units exhausted")
+                {
+                    GasUsed = Used
+                };
+            }
+
+            Used += units;
+        }
+    }
+
+    /// <summary>
+    /// Jint constraint charging a <see cref="GasMeter"/> for each statement the engine runs
+    /// </summary>
+    public class GasMeterConstraint : Jint.Constraint
+    {
+        private readonly GasMeter _meter;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasMeterConstraint"/> class
+        /// </summary>
+        /// <param name="meter">Meter to charge</param>
+        public GasMeterConstraint(GasMeter meter)
+        {
+            _meter = meter;
+        }
+
+        /// <inheritdoc/>
+        public override void Check()
+        {
+            _meter.Charge(GasMeter.StatementCost);
+        }
+
+        /// <inheritdoc/>
+        public override void Reset()
+        {
+            // The engine resets constraints on every Execute and Invoke; gas is counted across all of them
+        }
+    }
+}
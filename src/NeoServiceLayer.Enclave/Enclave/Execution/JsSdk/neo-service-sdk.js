@@ -3,6 +3,471 @@
  * This SDK provides JavaScript functions with access to Neo Service Layer capabilities
  */
 
+// Token amount helpers; kept in a closure so their names can't collide with function code
+const _amount = (() => {
+    /**
+     * Exact fixed-point token amount. The value is held as a BigInt in the token's smallest unit, so
+     * arithmetic never goes through floating point.
+     */
+    class Amount {
+        /**
+         * @param {bigint} raw - Amount in the token's smallest unit
+         * @param {number} decimals - Number of decimals of the token
+         */
+        constructor(raw, decimals) {
+            this.raw = raw;
+            this.decimals = decimals;
+        }
+
+        /**
+         * Adds an amount
+         * @param {Amount|string|number|bigint} other - Amount with at most this amount's decimals
+         * @returns {Amount} - The sum
+         */
+        add(other) {
+            return new Amount(this.raw + _toRaw(other, this.decimals), this.decimals);
+        }
+
+        /**
+         * Subtracts an amount
+         * @param {Amount|string|number|bigint} other - Amount with at most this amount's decimals
+         * @returns {Amount} - The difference
+         */
+        sub(other) {
+            return new Amount(this.raw - _toRaw(other, this.decimals), this.decimals);
+        }
+
+        /**
+         * Multiplies by a factor, rounding toward zero to this amount's decimals
+         * @param {Amount|string|number|bigint} factor - Factor, e.g. "0.003" for a 0.3% fee
+         * @returns {Amount} - The product
+         */
+        mul(factor) {
+            const f = _toFactor(factor);
+            return new Amount(this.raw * f.raw / _pow10(f.decimals), this.decimals);
+        }
+
+        /**
+         * Divides by a divisor, rounding toward zero to this amount's decimals
+         * @param {Amount|string|number|bigint} divisor - Divisor
+         * @returns {Amount} - The quotient
+         */
+        div(divisor) {
+            const d = _toFactor(divisor);
+            if (d.raw === 0n) {
+                throw new RangeError("Division by zero");
+            }
+            return new Amount(this.raw * _pow10(d.decimals) / d.raw, this.decimals);
+        }
+
+        /**
+         * Compares with an amount
+         * @param {Amount|string|number|bigint} other - Amount to compare with
+         * @returns {number} - -1, 0 or 1
+         */
+        cmp(other) {
+            const decimals = other instanceof Amount ? Math.max(this.decimals, other.decimals) : this.decimals;
+            const a = _rescale(this.raw, this.decimals, decimals, true);
+            const b = _toRaw(other, decimals);
+            return a < b ? -1 : a > b ? 1 : 0;
+        }
+
+        // Comparisons take the same arguments as cmp
+        eq(other) { return this.cmp(other) === 0; }
+        lt(other) { return this.cmp(other) < 0; }
+        lte(other) { return this.cmp(other) <= 0; }
+        gt(other) { return this.cmp(other) > 0; }
+        gte(other) { return this.cmp(other) >= 0; }
+        isZero() { return this.raw === 0n; }
+        isNegative() { return this.raw < 0n; }
+
+        /**
+         * Converts to another number of decimals, rounding toward zero when decimals are dropped
+         * @param {number} decimals - Number of decimals
+         * @returns {Amount} - The converted amount
+         */
+        toDecimals(decimals) {
+            _checkDecimals(decimals);
+            return new Amount(_rescale(this.raw, this.decimals, decimals, false), decimals);
+        }
+
+        /**
+         * Gets the on-chain integer representation, for contract calls
+         * @returns {string} - Amount in the token's smallest unit
+         */
+        toInteger() {
+            return this.raw.toString();
+        }
+
+        /**
+         * Gets the amount in the token's smallest unit
+         * @returns {bigint} - Amount in the token's smallest unit
+         */
+        toBigInt() {
+            return this.raw;
+        }
+
+        /**
+         * Formats the amount as a decimal string without trailing zeros
+         * @returns {string} - Decimal string, e.g. "1.5"
+         */
+        toString() {
+            const negative = this.raw < 0n;
+            const digits = (negative ? -this.raw : this.raw).toString().padStart(this.decimals + 1, "0");
+            const whole = digits.slice(0, digits.length - this.decimals);
+            const fraction = digits.slice(digits.length - this.decimals).replace(/0+$/, "");
+            return (negative ? "-" : "") + whole + (fraction ? "." + fraction : "");
+        }
+
+        toJSON() {
+            return this.toString();
+        }
+    }
+
+    function _pow10(exponent) {
+        return 10n ** BigInt(exponent);
+    }
+
+    function _checkDecimals(decimals) {
+        if (!Number.isInteger(decimals) || decimals < 0) {
+            throw new RangeError(`Decimals must be a non-negative integer: ${decimals}`);
+        }
+    }
+
+    function _rescale(raw, from, to, exact) {
+        if (to >= from) {
+            return raw * _pow10(to - from);
+        }
+        const divisor = _pow10(from - to);
+        if (exact && raw % divisor !== 0n) {
+            throw new RangeError(`Amount has more than ${to} decimals`);
+        }
+        // BigInt division rounds toward zero
+        return raw / divisor;
+    }
+
+    function _parseAmount(value, decimals) {
+        _checkDecimals(decimals);
+
+        if (value instanceof Amount) {
+            return new Amount(_rescale(value.raw, value.decimals, decimals, true), decimals);
+        }
+        if (typeof value === "bigint") {
+            return new Amount(value * _pow10(decimals), decimals);
+        }
+        if (typeof value === "number") {
+            // Numbers are read through their shortest decimal form, so 0.1 + 0.2 is rejected rather than rounded
+            if (!Number.isFinite(value)) {
+                throw new RangeError(`Invalid amount: ${value}`);
+            }
+            value = String(value);
+        }
+        if (typeof value !== "string") {
+            throw new TypeError(`Invalid amount: ${value}`);
+        }
+
+        const match = /^([+-]?)(\d*)(?:\.(\d*))?$/.exec(value.trim());
+        if (!match || (match[2] === "" && !match[3])) {
+            throw new SyntaxError(`Invalid amount: ${value}`);
+        }
+
+        const fraction = match[3] || "";
+        if (/[^0]/.test(fraction.slice(decimals))) {
+            throw new RangeError(`Amount ${value} has more than ${decimals} decimals`);
+        }
+
+        const raw = BigInt((match[2] || "0") + fraction.slice(0, decimals).padEnd(decimals, "0"));
+        return new Amount(match[1] === "-" ? -raw : raw, decimals);
+    }
+
+    function _toRaw(value, decimals) {
+        return _parseAmount(value, decimals).raw;
+    }
+
+    function _toFactor(value) {
+        if (value instanceof Amount) {
+            return value;
+        }
+        if (typeof value === "bigint") {
+            return new Amount(value, 0);
+        }
+        const text = String(value).trim();
+        const dot = text.indexOf(".");
+        return _parseAmount(text, dot < 0 ? 0 : text.length - dot - 1);
+    }
+
+    function _toBigInt(value) {
+        if (typeof value === "bigint") {
+            return value;
+        }
+        if (typeof value === "number" && Number.isSafeInteger(value)) {
+            return BigInt(value);
+        }
+        if (typeof value === "string" && /^[+-]?\d+$/.test(value.trim())) {
+            return BigInt(value.trim());
+        }
+        throw new TypeError(`Invalid integer amount: ${value}`);
+    }
+
+    return {
+        /**
+         * Parses a decimal amount
+         * @param {string|number|bigint|Amount} value - Decimal amount, e.g. "1.5"; a bigint is a whole number of tokens
+         * @param {number} decimals - Number of decimals of the token, e.g. 8 for GAS
+         * @returns {Amount} - The amount; throws if the value has more than the given decimals
+         */
+        parse(value, decimals) {
+            return _parseAmount(value, decimals);
+        },
+
+        /**
+         * Creates an amount from its on-chain integer representation
+         * @param {string|number|bigint} value - Amount in the token's smallest unit, e.g. a balanceOf result
+         * @param {number} decimals - Number of decimals of the token
+         * @returns {Amount} - The amount
+         */
+        fromInteger(value, decimals) {
+            _checkDecimals(decimals);
+            return new Amount(_toBigInt(value), decimals);
+        },
+
+        /**
+         * Checks if a value is an amount
+         * @param {*} value - Value to check
+         * @returns {boolean} - True if the value is an amount
+         */
+        isAmount(value) {
+            return value instanceof Amount;
+        }
+    };
+})();
+
+// JSON diff and patch helpers (RFC 6902); kept in a closure so their names can't collide with function code
+const _json = (() => {
+    function _isObject(value) {
+        return value !== null && typeof value === "object" && !Array.isArray(value);
+    }
+
+    function _has(target, key) {
+        return Object.prototype.hasOwnProperty.call(target, key);
+    }
+
+    function _clone(value) {
+        return value === undefined ? undefined : JSON.parse(JSON.stringify(value));
+    }
+
+    function _equal(a, b) {
+        if (a === b) {
+            return true;
+        }
+        if (Array.isArray(a) && Array.isArray(b)) {
+            return a.length === b.length && a.every((item, i) => _equal(item, b[i]));
+        }
+        if (_isObject(a) && _isObject(b)) {
+            const keys = Object.keys(a);
+            return keys.length === Object.keys(b).length && keys.every(key => _has(b, key) && _equal(a[key], b[key]));
+        }
+        return false;
+    }
+
+    function _escape(token) {
+        return String(token).replace(/~/g, "~0").replace(/\//g, "~1");
+    }
+
+    function _parsePointer(path) {
+        if (typeof path !== "string" || (path !== "" && path[0] !== "/")) {
+            throw new SyntaxError(`Invalid JSON pointer: ${path}`);
+        }
+        return path === "" ? [] : path.substring(1).split("/").map(t => t.replace(/~1/g, "/").replace(/~0/g, "~"));
+    }
+
+    function _index(array, token, allowEnd) {
+        if (!/^(0|[1-9]\d*)$/.test(token)) {
+            throw new Error(`Invalid array index: ${token}`);
+        }
+        const index = Number(token);
+        if (index > array.length || (!allowEnd && index === array.length)) {
+            throw new Error(`Array index out of range: ${token}`);
+        }
+        return index;
+    }
+
+    function _resolve(doc, tokens, path) {
+        let current = doc;
+        for (const token of tokens) {
+            if (Array.isArray(current)) {
+                current = current[_index(current, token, false)];
+            } else if (_isObject(current) && _has(current, token)) {
+                current = current[token];
+            } else {
+                throw new Error(`Path not found: ${path}`);
+            }
+        }
+        return current;
+    }
+
+    function _add(doc, path, value) {
+        const tokens = _parsePointer(path);
+        if (tokens.length === 0) {
+            return value;
+        }
+        const last = tokens.pop();
+        const parent = _resolve(doc, tokens, path);
+        if (Array.isArray(parent)) {
+            parent.splice(last === "-" ? parent.length : _index(parent, last, true), 0, value);
+        } else if (_isObject(parent)) {
+            // Defined rather than assigned, so a "__proto__" key stays a plain property
+            Object.defineProperty(parent, last, { value, writable: true, enumerable: true, configurable: true });
+        } else {
+            throw new Error(`Path not found: ${path}`);
+        }
+        return doc;
+    }
+
+    function _replace(doc, path, value) {
+        const tokens = _parsePointer(path);
+        if (tokens.length === 0) {
+            return value;
+        }
+        const last = tokens.pop();
+        const parent = _resolve(doc, tokens, path);
+        if (Array.isArray(parent)) {
+            parent[_index(parent, last, false)] = value;
+        } else if (_isObject(parent) && _has(parent, last)) {
+            // Replaced in place, so the key keeps its position
+            Object.defineProperty(parent, last, { value, writable: true, enumerable: true, configurable: true });
+        } else {
+            throw new Error(`Path not found: ${path}`);
+        }
+        return doc;
+    }
+
+    function _remove(doc, path) {
+        const tokens = _parsePointer(path);
+        if (tokens.length === 0) {
+            throw new Error("The document root cannot be removed");
+        }
+        const last = tokens.pop();
+        const parent = _resolve(doc, tokens, path);
+        if (Array.isArray(parent)) {
+            parent.splice(_index(parent, last, false), 1);
+        } else if (_isObject(parent) && _has(parent, last)) {
+            delete parent[last];
+        } else {
+            throw new Error(`Path not found: ${path}`);
+        }
+        return doc;
+    }
+
+    function _diff(a, b, path, operations) {
+        if (_equal(a, b)) {
+            return;
+        }
+        if (Array.isArray(a) && Array.isArray(b)) {
+            const common = Math.min(a.length, b.length);
+            for (let i = 0; i < common; i++) {
+                _diff(a[i], b[i], `${path}/${i}`, operations);
+            }
+            for (let i = common; i < b.length; i++) {
+                operations.push({ op: "add", path: `${path}/${i}`, value: _clone(b[i]) });
+            }
+            // Trailing items are removed from the end, so each index is still valid when applied
+            for (let i = a.length - 1; i >= b.length; i--) {
+                operations.push({ op: "remove", path: `${path}/${i}` });
+            }
+        } else if (_isObject(a) && _isObject(b)) {
+            for (const key of Object.keys(a)) {
+                if (_has(b, key)) {
+                    _diff(a[key], b[key], `${path}/${_escape(key)}`, operations);
+                } else {
+                    operations.push({ op: "remove", path: `${path}/${_escape(key)}` });
+                }
+            }
+            for (const key of Object.keys(b)) {
+                if (!_has(a, key)) {
+                    operations.push({ op: "add", path: `${path}/${_escape(key)}`, value: _clone(b[key]) });
+                }
+            }
+        } else {
+            operations.push({ op: "replace", path, value: _clone(b) });
+        }
+    }
+
+    function _apply(doc, operation) {
+        if (!_isObject(operation) || typeof operation.op !== "string") {
+            throw new TypeError("A patch operation must be an object with an op");
+        }
+        if ((operation.op === "add" || operation.op === "replace" || operation.op === "test") && !_has(operation, "value")) {
+            throw new TypeError(`A ${operation.op} operation needs a value`);
+        }
+
+        switch (operation.op) {
+            case "add":
+                return _add(doc, operation.path, _clone(operation.value));
+            case "remove":
+                return _remove(doc, operation.path);
+            case "replace":
+                return _replace(doc, operation.path, _clone(operation.value));
+            case "move": {
+                if (operation.path === operation.from) {
+                    return doc;
+                }
+                if (operation.path.startsWith(`${operation.from}/`)) {
+                    throw new Error(`Cannot move ${operation.from} into itself`);
+                }
+                const value = _resolve(doc, _parsePointer(operation.from), operation.from);
+                return _add(_remove(doc, operation.from), operation.path, value);
+            }
+            case "copy":
+                return _add(doc, operation.path, _clone(_resolve(doc, _parsePointer(operation.from), operation.from)));
+            case "test":
+                if (!_equal(_resolve(doc, _parsePointer(operation.path), operation.path), operation.value)) {
+                    throw new Error(`Test failed at ${operation.path}`);
+                }
+                return doc;
+            default:
+                throw new TypeError(`Unknown patch operation: ${operation.op}`);
+        }
+    }
+
+    return {
+        /**
+         * Computes the JSON Patch (RFC 6902) that turns one JSON value into another
+         * @param {*} a - Original value
+         * @param {*} b - Target value
+         * @returns {Array<object>} - add, remove and replace operations; empty if the values are equal
+         */
+        diff(a, b) {
+            const operations = [];
+            _diff(_clone(a), _clone(b), "", operations);
+            return operations;
+        },
+
+        /**
+         * Applies a JSON Patch (RFC 6902) to a JSON value
+         * @param {*} doc - Value to patch; it is not modified
+         * @param {Array<object>} patch - Operations: add, remove, replace, move, copy and test
+         * @returns {*} - The patched copy; throws without a partial result if any operation fails
+         */
+        patch(doc, patch) {
+            if (!Array.isArray(patch)) {
+                throw new TypeError("A patch must be an array of operations");
+            }
+            return patch.reduce((result, operation, i) => {
+                try {
+                    return _apply(result, operation);
+                } catch (e) {
+                    e.message = `Patch operation ${i} failed: ${e.message}`;
+                    throw e;
+                }
+            }, _clone(doc));
+        }
+    };
+})();
+
+// Cleanup handler registered with neoService.onTimeout; read by the runtime when the execution times out
+const _timeout = { handler: null };
+
 // Global SDK object
 const neoService = {
     /**
@@ -35,6 +500,23 @@ const neoService = {
          */
         async submitToOracle(price) {
             return await _callNativeFunction("priceFeed.submitToOracle", { price });
+        },
+
+        /**
+         * Gets the fee levels of Neo and the configured external chains
+         * @returns {Promise<Array>} - Fee levels, with the USD cost of a plain transfer on each chain
+         */
+        async getGasPrices() {
+            return await _callNativeFunction("priceFeed.getGasPrices", {});
+        },
+
+        /**
+         * Gets the chain where a plain transfer currently costs the fewest USD
+         * @param {Array<string>} chains - Chains to choose from (default: every chain)
+         * @returns {Promise<object|null>} - The cheapest chain's fee level, or null if none is known
+         */
+        async getCheapestChain(chains = null) {
+            return await _callNativeFunction("priceFeed.getCheapestChain", { chains });
         }
     },
 
@@ -101,6 +583,17 @@ const neoService = {
             return await _callNativeFunction("blockchain.getBalance", { address, assetHash });
         },
 
+        /**
+         * Gets the balance of an address as of a historical block
+         * @param {number} blockIndex - Block index whose state is read
+         * @param {string} address - Neo address
+         * @param {string} assetHash - Asset hash (NEO, GAS, or other NEP-17 token)
+         * @returns {Promise<number>} - Balance at the given block
+         */
+        async getBalanceAt(blockIndex, address, assetHash) {
+            return await _callNativeFunction("blockchain.getBalanceAt", { blockIndex, address, assetHash });
+        },
+
         /**
          * Invokes a contract read-only method
          * @param {string} scriptHash - Contract script hash
@@ -112,6 +605,18 @@ const neoService = {
             return await _callNativeFunction("blockchain.invokeRead", { scriptHash, operation, args });
         },
 
+        /**
+         * Invokes a contract read-only method against the state of a historical block
+         * @param {number} blockIndex - Block index whose state is read
+         * @param {string} scriptHash - Contract script hash
+         * @param {string} operation - Contract operation
+         * @param {Array} args - Contract arguments
+         * @returns {Promise<object>} - Invocation result, including the state root used
+         */
+        async invokeReadAt(blockIndex, scriptHash, operation, args = []) {
+            return await _callNativeFunction("blockchain.invokeReadAt", { blockIndex, scriptHash, operation, args });
+        },
+
         /**
          * Invokes a contract method that modifies state
          * @param {string} scriptHash - Contract script hash
@@ -124,6 +629,103 @@ const neoService = {
         }
     },
 
+    /**
+     * NEP-11 (NFT) functions
+     */
+    nft: {
+        /**
+         * Gets the owner of a token
+         * @param {string} contractHash - NEP-11 contract script hash
+         * @param {string} tokenId - Token ID in hex
+         * @returns {Promise<string>} - Owner script hash
+         */
+        async ownerOf(contractHash, tokenId) {
+            return await _callNativeFunction("nft.ownerOf", { contractHash, tokenId });
+        },
+
+        /**
+         * Gets the tokens owned by an address
+         * @param {string} contractHash - NEP-11 contract script hash
+         * @param {string} address - Neo address
+         * @param {number} maxItems - Maximum number of token IDs to return (default: 100)
+         * @returns {Promise<Array<string>>} - Token IDs in hex
+         */
+        async tokensOf(contractHash, address, maxItems = 100) {
+            return await _callNativeFunction("nft.tokensOf", { contractHash, address, maxItems });
+        },
+
+        /**
+         * Gets the properties of a token
+         * @param {string} contractHash - NEP-11 contract script hash
+         * @param {string} tokenId - Token ID in hex
+         * @returns {Promise<object>} - Token properties, e.g. name, description and image
+         */
+        async properties(contractHash, tokenId) {
+            return await _callNativeFunction("nft.properties", { contractHash, tokenId });
+        },
+
+        /**
+         * Transfers a token from one of the account's wallets
+         * @param {string} walletId - ID of the wallet holding the token
+         * @param {string} password - Wallet password, e.g. read with secrets.getSecret
+         * @param {string} contractHash - NEP-11 contract script hash
+         * @param {string} toAddress - Recipient address
+         * @param {string} tokenId - Token ID in hex
+         * @param {string} data - Optional data passed to the recipient's onNEP11Payment
+         * @returns {Promise<object>} - Transfer result, including the transaction hash
+         */
+        async transfer(walletId, password, contractHash, toAddress, tokenId, data = null) {
+            return await _callNativeFunction("nft.transfer", { walletId, password, contractHash, toAddress, tokenId, data });
+        }
+    },
+
+    /**
+     * NEO governance and bNEO staking functions
+     */
+    staking: {
+        /**
+         * Votes for a consensus candidate with all the NEO held by one of the account's wallets
+         * @param {string} walletId - ID of the voting wallet
+         * @param {string} password - Wallet password, e.g. read with secrets.getSecret
+         * @param {string|null} candidatePublicKey - Candidate's compressed public key in hex, or null to clear the vote
+         * @returns {Promise<object>} - Vote result, including the transaction hash
+         */
+        async vote(walletId, password, candidatePublicKey) {
+            return await _callNativeFunction("staking.vote", { walletId, password, candidatePublicKey });
+        },
+
+        /**
+         * Gets the NEO account state of an address, including the candidate it votes for
+         * @param {string} address - Neo address
+         * @returns {Promise<object>} - Account state: balance, balance height and vote
+         */
+        async getVote(address) {
+            return await _callNativeFunction("staking.getVote", { address });
+        },
+
+        /**
+         * Wraps NEO held by one of the account's wallets as bNEO
+         * @param {string} walletId - ID of the wallet holding the NEO
+         * @param {string} password - Wallet password, e.g. read with secrets.getSecret
+         * @param {number} amount - Whole number of NEO to wrap
+         * @returns {Promise<object>} - Wrap result, including the transaction hash
+         */
+        async wrapBneo(walletId, password, amount) {
+            return await _callNativeFunction("staking.wrapBneo", { walletId, password, amount });
+        },
+
+        /**
+         * Unwraps bNEO held by one of the account's wallets back to NEO
+         * @param {string} walletId - ID of the wallet holding the bNEO
+         * @param {string} password - Wallet password, e.g. read with secrets.getSecret
+         * @param {number} amount - Whole number of bNEO to unwrap
+         * @returns {Promise<object>} - Unwrap result, including the transaction hash
+         */
+        async unwrapBneo(walletId, password, amount) {
+            return await _callNativeFunction("staking.unwrapBneo", { walletId, password, amount });
+        }
+    },
+
     /**
      * Event service functions
      */
@@ -199,6 +801,22 @@ const neoService = {
         }
     },
 
+    /**
+     * Metric functions
+     */
+    metrics: {
+        /**
+         * Records a custom metric for this execution. Recorded metrics can be used in alert rules
+         * @param {string} name - Metric name
+         * @param {number} value - Metric value; the last value recorded for a name wins
+         * @returns {boolean} - Success indicator
+         */
+        record(name, value) {
+            _callNativeFunction("metrics.record", { name, value });
+            return true;
+        }
+    },
+
     /**
      * Storage functions
      */
@@ -230,9 +848,72 @@ const neoService = {
         async delete(key) {
             return await _callNativeFunction("storage.delete", { key });
         }
+    },
+
+    /**
+     * HTTP functions
+     */
+    http: {
+        /**
+         * Sends an HTTP request to a host on the enclave's fetch allowlist. Redirects are not followed.
+         * @param {string} url - Absolute https URL
+         * @param {object} init - Options: method (default "GET"), headers (object of name to value), body (string)
+         * @returns {Promise<object>} - Response with ok, status, statusText, url, headers, text() and json()
+         */
+        async fetch(url, init = {}) {
+            const body = init.body === undefined || init.body === null ? null : String(init.body);
+            const raw = JSON.parse(await _callNativeFunction("http.fetch", {
+                url: String(url),
+                method: init.method || "GET",
+                headers: init.headers || {},
+                body
+            }));
+            return {
+                ok: raw.status >= 200 && raw.status < 300,
+                status: raw.status,
+                statusText: raw.statusText,
+                url: raw.url,
+                headers: {
+                    get(name) {
+                        const value = raw.headers[String(name).toLowerCase()];
+                        return value === undefined ? null : value;
+                    }
+                },
+                async text() { return raw.body; },
+                async json() { return JSON.parse(raw.body); }
+            };
+        }
+    },
+
+    /**
+     * Exact token amount functions: amount.parse("1.5", 8), amount.fromInteger("150000000", 8)
+     */
+    amount: _amount,
+
+    /**
+     * JSON Patch (RFC 6902) functions: json.diff(before, after), json.patch(doc, operations)
+     */
+    json: _json,
+
+    /**
+     * Registers a cleanup handler run once if the execution hits its timeout. The handler gets a short
+     * grace budget (250ms by default) to release resources or store a partial-progress marker; it is
+     * stopped when the budget runs out. Registering again replaces the previous handler.
+     * @param {function({graceMs: number}): void} handler - Cleanup handler
+     */
+    onTimeout(handler) {
+        if (typeof handler !== 'function') {
+            throw new TypeError("onTimeout expects a function");
+        }
+        _timeout.handler = handler;
     }
 };
 
+// fetch() as a global, for code written against the standard API
+async function fetch(url, init) {
+    return await neoService.http.fetch(url, init);
+}
+
 // Internal function to call native functions
 async function _callNativeFunction(functionName, args) {
     // This function will be replaced by the actual implementation in NodeJsRuntime
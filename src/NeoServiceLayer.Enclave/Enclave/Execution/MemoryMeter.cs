@@ -0,0 +1,94 @@
+using System;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Enclave.Enclave.Execution
+{
+    /// <summary>
+    /// Counts the memory one function execution allocates and stops it when its limit is reached
+    /// </summary>
+    /// <remarks>
+    /// Allocations are read from the thread the script runs on, so executions running side by side are measured
+    /// separately; reading the process heap instead would let one function's garbage fail another's execution.
+    /// The count is of bytes allocated, not bytes still live, which makes it independent of when the GC runs.
+    /// </remarks>
+    public class MemoryMeter
+    {
+        private int _threadId;
+        private long _lastAllocated;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="MemoryMeter"/> class
+        /// </summary>
+        /// <param name="limit">Bytes the execution may allocate, or 0 for no limit</param>
+        public MemoryMeter(long limit)
+        {
+            Limit = limit;
+        }
+
+        /// <summary>
+        /// Gets the bytes the execution may allocate, or 0 for no limit
+        /// </summary>
+        public long Limit { get; }
+
+        /// <summary>
+        /// Gets the bytes the execution has allocated so far
+        /// </summary>
+        public long Used { get; private set; }
+
+        /// <summary>
+        /// Adds what the current thread allocated since the last sample
+        /// </summary>
+        /// <exception cref="SandboxException">The execution has allocated more than the limit</exception>
+        public void Sample()
+        {
+            var threadId = Environment.CurrentManagedThreadId;
+            var allocated = GC.GetAllocatedBytesForCurrentThread();
+
+            // Counting starts at the first statement, so building the engine is not charged to the function.
+            // After a continuation moves the script to another thread, counting starts again from that thread's total
+            if (threadId == _threadId)
+            {
+                Used += allocated - _lastAllocated;
+            }
+
+            _threadId = threadId;
+            _lastAllocated = allocated;
+
+            if (Limit > 0 && Used > Limit)
+            {
+                throw new SandboxException(FunctionErrorCodes.MemoryLimitError,
+                    $"Memory limit of {Limit / (1024 * 1024)} MB exceeded");
+            }
+        }
+    }
+
+    /// <summary>
+    /// Jint constraint sampling a <see cref="MemoryMeter"/> for each statement the engine runs
+    /// </summary>
+    public class MemoryMeterConstraint : Jint.Constraint
+    {
+        private readonly MemoryMeter _meter;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="MemoryMeterConstraint"/> class
+        /// </summary>
+        /// <param name="meter">Meter to sample</param>
+        public MemoryMeterConstraint(MemoryMeter meter)
+        {
+            _meter = meter;
+        }
+
+        /// <inheritdoc/>
+        public override void Check()
+        {
+            _meter.Sample();
+        }
+
+        /// <inheritdoc/>
+        public override void Reset()
+        {
+            // The engine resets constraints on every Execute and Invoke; memory is counted across all of them
+        }
+    }
+}
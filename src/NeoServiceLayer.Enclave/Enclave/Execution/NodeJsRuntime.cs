@@ -1,13 +1,18 @@
 using System;
 using System.Collections.Generic;
 using System.IO;
+using System.Linq;
+using System.Security.Cryptography;
 using System.Text;
+using System.Threading;
 using System.Threading.Tasks;
 using Jint;
 using Jint.Runtime;
 using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
 using Newtonsoft.Json;
 using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
 using NeoServiceLayer.Core.Models;
 using NeoServiceLayer.Enclave.Enclave.Services;
 using NeoServiceLayer.Enclave.Enclave.Utilities;
@@ -24,6 +29,11 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
         private readonly EnclaveSecretsService _secretsService;
         private readonly EnclaveWalletService _walletService;
         private readonly EnclaveFunctionService _functionService;
+        private readonly ContractInvocationLimiter? _invocationLimiter;
+        private readonly Models.SandboxSecurityOptions _securityOptions;
+        private readonly SandboxFetchClient? _fetchClient;
+        private readonly SandboxSessionRegistry? _sessionRegistry;
+        private readonly SandboxEnginePool? _enginePool;
         private readonly string _sdkScript;
 
         /// <summary>
@@ -34,18 +44,33 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
         /// <param name="secretsService">Secrets service</param>
         /// <param name="walletService">Wallet service</param>
         /// <param name="functionService">Function service</param>
+        /// <param name="invocationLimiter">Per-contract limiter for write invocations</param>
+        /// <param name="securityOptions">Per-execution security limits</param>
+        /// <param name="fetchClient">Client for the HTTP requests functions make; fetch is refused when omitted</param>
+        /// <param name="sessionRegistry">Warm sandboxes of session invocations; every invocation cold starts when omitted</param>
+        /// <param name="enginePool">Pre-warmed sandboxes and parsed scripts for other invocations; each builds its own when omitted</param>
         public NodeJsRuntime(
             ILogger<NodeJsRuntime> logger,
             EnclavePriceFeedService priceFeedService,
             EnclaveSecretsService secretsService,
             EnclaveWalletService walletService,
-            EnclaveFunctionService functionService)
+            EnclaveFunctionService functionService,
+            ContractInvocationLimiter? invocationLimiter = null,
+            IOptions<Models.SandboxSecurityOptions>? securityOptions = null,
+            SandboxFetchClient? fetchClient = null,
+            SandboxSessionRegistry? sessionRegistry = null,
+            SandboxEnginePool? enginePool = null)
         {
             _logger = logger;
             _priceFeedService = priceFeedService;
             _secretsService = secretsService;
             _walletService = walletService;
             _functionService = functionService;
+            _invocationLimiter = invocationLimiter;
+            _securityOptions = securityOptions?.Value ?? new Models.SandboxSecurityOptions();
+            _fetchClient = fetchClient;
+            _sessionRegistry = sessionRegistry;
+            _enginePool = enginePool;
 
             // Load the SDK script
             var sdkPath = Path.Combine(AppDomain.CurrentDomain.BaseDirectory, "Enclave", "Execution", "JsSdk", "neo-service-sdk.js");
@@ -83,13 +108,13 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
                 catch (JavaScriptException jsEx)
                 {
                     _logger.LogError(jsEx, "JavaScript syntax error: {Message}", jsEx.Message);
-                    throw new Exception($"JavaScript syntax error: {jsEx.Message}");
+                    throw new SandboxException(FunctionErrorCodes.SyntaxError, $"JavaScript syntax error: {jsEx.Message}", jsEx);
                 }
 
                 // Verify that the entry point exists
                 if (!sourceCode.Contains(entryPoint))
                 {
-                    throw new Exception($"Entry point '{entryPoint}' not found in the source code");
+                    throw new SandboxException(FunctionErrorCodes.SyntaxError, $"Entry point '{entryPoint}' not found in the source code");
                 }
 
                 var compiledFunction = new CompiledJsFunction
@@ -113,17 +138,58 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
         {
             _logger.LogInformation("Executing JavaScript function, EntryPoint: {EntryPoint}", entryPoint);
 
+            SandboxSession? session = null;
+            PooledSandbox? pooled = null;
+            var succeeded = false;
             try
             {
                 var compiledFunction = (CompiledJsFunction)compiledAssembly;
                 var sourceCode = compiledFunction.SourceCode;
 
-                // Create a new Jint engine with appropriate constraints
-                var engine = new Engine(options => {
+                // The engine's own token lets the onTimeout handler be stopped without killing the execution
+                using var engineCancellation = CancellationTokenSource.CreateLinkedTokenSource(context.CancellationToken);
+                var memoryMeter = context.MemoryMeter ??= new MemoryMeter(context.MaxMemory * 1024L * 1024);
+
+                // An invocation carrying a session key resumes the engine of the session's earlier invocations
+                session = await AcquireSessionAsync(sourceCode, context);
+                var engine = session?.Engine;
+                var resumed = engine != null;
+                if (session != null)
+                {
+                    session.Context = context;
+                    session.CancellationToken = engineCancellation.Token;
+                }
+
+                // Otherwise take a sandbox warmed with the SDK, which serves this invocation only
+                pooled = session == null && _enginePool?.Enabled == true
+                    ? _enginePool.Take(context.MaxExecutionTime, BuildPooledEngine)
+                    : null;
+                if (pooled != null)
+                {
+                    pooled.Context = context;
+                    pooled.CancellationToken = engineCancellation.Token;
+                    engine = pooled.Engine;
+                }
+
+                // Otherwise create a new Jint engine with appropriate constraints
+                engine ??= new Engine(options => {
                     options.LimitRecursion(100);
                     options.TimeoutInterval(TimeSpan.FromSeconds(context.MaxExecutionTime / 1000));
                     options.MaxStatements(10000);
                     options.DebugMode();
+                    if (session != null)
+                    {
+                        options.Constraint(new SandboxSessionConstraint(session));
+                    }
+                    else
+                    {
+                        options.CancellationToken(engineCancellation.Token);
+                        options.Constraint(new MemoryMeterConstraint(memoryMeter));
+                        if (context.GasMeter != null)
+                        {
+                            options.Constraint(new GasMeterConstraint(context.GasMeter));
+                        }
+                    }
                 });
 
                 // Add environment variables to the JavaScript context
@@ -134,25 +200,31 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
 
                 // Add console.log functionality
                 var logs = new List<string>();
+                void AddLog(string line)
+                {
+                    logs.Add(line);
+                    context.OnLog?.Invoke(line);
+                }
+
                 engine.SetValue("console", new {
                     log = new Action<object>(message => {
                         var logMessage = message?.ToString() ?? "null";
-                        logs.Add(logMessage);
+                        AddLog(logMessage);
                         _logger.LogInformation("[JS Console] {Message}", logMessage);
                     }),
                     error = new Action<object>(message => {
                         var logMessage = message?.ToString() ?? "null";
-                        logs.Add("ERROR: " + logMessage);
+                        AddLog("ERROR: " + logMessage);
                         _logger.LogError("[JS Console Error] {Message}", logMessage);
                     }),
                     warn = new Action<object>(message => {
                         var logMessage = message?.ToString() ?? "null";
-                        logs.Add("WARN: " + logMessage);
+                        AddLog("WARN: " + logMessage);
                         _logger.LogWarning("[JS Console Warn] {Message}", logMessage);
                     }),
                     debug = new Action<object>(message => {
                         var logMessage = message?.ToString() ?? "null";
-                        logs.Add("DEBUG: " + logMessage);
+                        AddLog("DEBUG: " + logMessage);
                         _logger.LogDebug("[JS Console Debug] {Message}", logMessage);
                     })
                 });
@@ -162,11 +234,31 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
                     return await HandleNativeFunctionCallAsync(functionName, args, context);
                 }));
 
-                // Load the Neo Service SDK
-                engine.Execute(_sdkScript);
+                // Bindings are set again on a resumed engine, so they report to this invocation
+                if (!resumed)
+                {
+                    // Load the Neo Service SDK, which a pooled sandbox already has
+                    if (pooled == null)
+                    {
+                        engine.Execute(_sdkScript);
+                    }
 
-                // Execute the JavaScript code
-                engine.Execute(sourceCode);
+                    // Execute the JavaScript code, parsed once per function version when the pool caches it
+                    var script = _enginePool?.Enabled == true ? _enginePool.GetScript(context.FunctionId, sourceCode) : null;
+                    if (script != null)
+                    {
+                        engine.Execute(script.Value);
+                    }
+                    else
+                    {
+                        engine.Execute(sourceCode);
+                    }
+                }
+
+                if (session != null)
+                {
+                    session.Engine = engine;
+                }
 
                 // Convert parameters to a JavaScript object
                 var parametersJson = JsonConvert.SerializeObject(parameters);
@@ -174,31 +266,104 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
                 engine.Execute($"var __params = JSON.parse('{jsonString}')");
 
                 // Call the entry point function with parameters
-                var result = engine.Invoke(entryPoint, engine.GetValue("__params"));
+                var result = InvokeWithTimeoutHandler(engine, engineCancellation, context, entryPoint, engine.GetValue("__params"));
 
                 // Convert the result to a .NET object
                 var resultObj = ConvertJsValueToObject(result);
 
                 _logger.LogInformation("JavaScript function executed successfully");
+                succeeded = true;
                 return new {
                     Result = resultObj,
                     Logs = logs,
+                    Metrics = context.Metrics,
+                    SecurityEvents = context.SecurityEvents,
+                    GasUsed = context.GasMeter?.Used ?? 0,
                     ExecutionTime = DateTime.UtcNow,
-                    MemoryUsage = GC.GetTotalMemory(false) / (1024 * 1024) // Approximate memory usage in MB
+                    MemoryUsage = memoryMeter.Used / (1024 * 1024), // Memory the execution allocated, in MB
+                    Session = session == null ? null : new {
+                        session.Key,
+                        Resumed = resumed,
+                        session.CreatedAt,
+                        Invocation = session.InvocationCount + 1
+                    }
                 };
             }
             catch (JavaScriptException jsEx)
             {
                 _logger.LogError(jsEx, "JavaScript execution error: {Message}", jsEx.Message);
-                throw new Exception($"JavaScript execution error: {jsEx.Message}");
+                throw new SandboxException(SandboxErrorClassifier.Classify(jsEx, context), $"JavaScript execution error: {FormatError(jsEx)}", jsEx);
             }
-            catch (Exception ex)
+            catch (Exception ex) when (ex is not SandboxException)
             {
                 _logger.LogError(ex, "Error executing JavaScript function");
-                throw;
+                throw new SandboxException(SandboxErrorClassifier.Classify(ex, context), ex.Message, ex);
+            }
+            finally
+            {
+                if (session != null)
+                {
+                    session.Context = null;
+                    session.CancellationToken = CancellationToken.None;
+                    _sessionRegistry!.Release(session, succeeded);
+                }
+
+                if (pooled != null)
+                {
+                    // The sandbox is discarded; drop the references to this invocation along with it
+                    pooled.Context = null;
+                    pooled.CancellationToken = CancellationToken.None;
+                }
             }
         }
 
+        /// <summary>
+        /// Builds the engine of a pooled sandbox with the SDK loaded
+        /// </summary>
+        /// <param name="sandbox">Sandbox the engine's limits are bound to</param>
+        /// <returns>The engine</returns>
+        private Engine BuildPooledEngine(PooledSandbox sandbox)
+        {
+            var engine = new Engine(options => {
+                options.LimitRecursion(100);
+                options.TimeoutInterval(TimeSpan.FromSeconds(sandbox.MaxExecutionTime / 1000));
+                options.MaxStatements(10000);
+                options.DebugMode();
+                options.Constraint(new PooledSandboxConstraint(sandbox));
+            });
+
+            // The SDK is cached like a function, under an empty ID
+            var sdk = _enginePool!.GetScript(Guid.Empty, _sdkScript);
+            if (sdk != null)
+            {
+                engine.Execute(sdk.Value);
+            }
+            else
+            {
+                engine.Execute(_sdkScript);
+            }
+
+            return engine;
+        }
+
+        /// <summary>
+        /// Acquires the session an invocation carrying a session key runs in
+        /// </summary>
+        /// <param name="sourceCode">Function source code</param>
+        /// <param name="context">Execution context</param>
+        /// <returns>The session, or null if the invocation runs without one</returns>
+        private async Task<SandboxSession?> AcquireSessionAsync(string sourceCode, FunctionExecutionContext context)
+        {
+            if (_sessionRegistry == null || !_sessionRegistry.Enabled || string.IsNullOrEmpty(context.SessionKey))
+            {
+                return null;
+            }
+
+            // The time limit is fixed when the engine is built, so changing it starts a new session like changing the code does
+            var fingerprint = Convert.ToHexString(SHA256.HashData(Encoding.UTF8.GetBytes($"{context.MaxExecutionTime}\n{sourceCode}")));
+            return await _sessionRegistry.AcquireAsync(context.FunctionId, context.SessionKey, fingerprint, context.CancellationToken);
+        }
+
         /// <summary>
         /// Handles native function calls from JavaScript
         /// </summary>
@@ -210,6 +375,21 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
         {
             _logger.LogInformation("Handling native function call: {FunctionName}", functionName);
 
+            context.GasMeter?.Charge(GasMeter.HostCallCost);
+
+            var maxHostCalls = _securityOptions.MaxHostCallsPerExecution;
+            if (maxHostCalls > 0 && ++context.HostCallCount > maxHostCalls)
+            {
+                // Report the execution once, not every call it makes over the limit
+                if (context.HostCallCount == maxHostCalls + 1)
+                {
+                    RecordSecurityEvent(context, FunctionSecurityEventType.ExcessiveHostCalls, functionName,
+                        $"More than {maxHostCalls} host calls in one execution");
+                }
+
+                throw new SandboxException(FunctionErrorCodes.HostCallDenied, $"Host call limit of {maxHostCalls} per execution reached");
+            }
+
             try
             {
                 // Convert args to a dictionary
@@ -229,6 +409,10 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
                         return await HandlePriceFeedGetAllPricesAsync(argsDict, context);
                     case "priceFeed.submitToOracle":
                         return await HandlePriceFeedSubmitToOracleAsync(argsDict, context);
+                    case "priceFeed.getGasPrices":
+                        return HandlePriceFeedGetGasPrices(argsDict, context);
+                    case "priceFeed.getCheapestChain":
+                        return HandlePriceFeedGetCheapestChain(argsDict, context);
 
                     // Secrets functions
                     case "secrets.getSecret":
@@ -245,11 +429,35 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
                         return await HandleBlockchainGetTransactionAsync(argsDict, context);
                     case "blockchain.getBalance":
                         return await HandleBlockchainGetBalanceAsync(argsDict, context);
+                    case "blockchain.getBalanceAt":
+                        return await HandleBlockchainGetBalanceAtAsync(argsDict, context);
                     case "blockchain.invokeRead":
                         return await HandleBlockchainInvokeReadAsync(argsDict, context);
+                    case "blockchain.invokeReadAt":
+                        return await HandleBlockchainInvokeReadAtAsync(argsDict, context);
                     case "blockchain.invokeWrite":
                         return await HandleBlockchainInvokeWriteAsync(argsDict, context);
 
+                    // NFT functions
+                    case "nft.ownerOf":
+                        return await HandleNftOwnerOfAsync(argsDict, context);
+                    case "nft.tokensOf":
+                        return await HandleNftTokensOfAsync(argsDict, context);
+                    case "nft.properties":
+                        return await HandleNftPropertiesAsync(argsDict, context);
+                    case "nft.transfer":
+                        return await HandleNftTransferAsync(argsDict, context);
+
+                    // Staking functions
+                    case "staking.vote":
+                        return await HandleStakingVoteAsync(argsDict, context);
+                    case "staking.getVote":
+                        return await HandleStakingGetVoteAsync(argsDict, context);
+                    case "staking.wrapBneo":
+                        return await HandleStakingConvertBneoAsync(argsDict, context, Constants.WalletOperations.WrapBneo);
+                    case "staking.unwrapBneo":
+                        return await HandleStakingConvertBneoAsync(argsDict, context, Constants.WalletOperations.UnwrapBneo);
+
                     // Event functions
                     case "events.registerBlockchainEvent":
                         return await HandleEventsRegisterBlockchainEventAsync(argsDict, context);
@@ -268,6 +476,14 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
                     case "log.debug":
                         return HandleLogDebugAsync(argsDict, context);
 
+                    // Metric functions
+                    case "metrics.record":
+                        return HandleMetricsRecord(argsDict, context);
+
+                    // HTTP functions
+                    case "http.fetch":
+                        return await HandleHttpFetchAsync(argsDict, context);
+
                     // Storage functions
                     case "storage.get":
                         return await HandleStorageGetAsync(argsDict, context);
@@ -277,7 +493,9 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
                         return await HandleStorageDeleteAsync(argsDict, context);
 
                     default:
-                        throw new Exception($"Unknown native function: {functionName}");
+                        RecordSecurityEvent(context, FunctionSecurityEventType.UndefinedService, functionName,
+                            "Call to a host service that does not exist");
+                        throw new SandboxException(FunctionErrorCodes.HostCallDenied, $"Unknown native function: {functionName}");
                 }
             }
             catch (Exception ex)
@@ -287,6 +505,33 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
             }
         }
 
+        /// <summary>
+        /// Records a security event for the host to aggregate
+        /// </summary>
+        /// <param name="context">Execution context</param>
+        /// <param name="type">Event type</param>
+        /// <param name="target">What the function tried to reach</param>
+        /// <param name="detail">Description of the event</param>
+        private void RecordSecurityEvent(FunctionExecutionContext context, FunctionSecurityEventType type, string target, string detail)
+        {
+            _logger.LogWarning("Security event {Type} in function {FunctionId}, execution {ExecutionId}: {Target}",
+                type, context.FunctionId, context.ExecutionId, target);
+
+            lock (context.SecurityEvents)
+            {
+                context.SecurityEvents.Add(new FunctionSecurityEvent
+                {
+                    FunctionId = context.FunctionId,
+                    AccountId = context.AccountId,
+                    ExecutionId = context.ExecutionId,
+                    Type = type,
+                    Target = target,
+                    Detail = detail,
+                    Timestamp = DateTime.UtcNow
+                });
+            }
+        }
+
         #region Price Feed Handlers
 
         private async Task<object> HandlePriceFeedGetPriceAsync(Dictionary<string, object> args, FunctionExecutionContext context)
@@ -296,6 +541,13 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
 
             _logger.LogInformation("Getting price for symbol: {Symbol}, BaseCurrency: {BaseCurrency}", symbol, baseCurrency);
 
+            if (context.DeniedPriceSymbols.Contains(symbol))
+            {
+                RecordSecurityEvent(context, FunctionSecurityEventType.BlockedTarget, symbol,
+                    "Price feed symbol above the account's subscription tier");
+                throw new SandboxException(FunctionErrorCodes.HostCallDenied, $"{symbol} is not included in the account's price feed tier");
+            }
+
             var request = new
             {
                 Symbol = symbol,
@@ -326,9 +578,38 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
                 "fetchPrices",
                 requestBytes);
 
+            // Premium symbols above the account's tier are left out rather than failing the whole call
+            if (result is List<Price> prices && context.DeniedPriceSymbols.Count > 0)
+            {
+                return prices.Where(p => p.Symbol == null || !context.DeniedPriceSymbols.Contains(p.Symbol)).ToList();
+            }
+
             return result;
         }
 
+        private object HandlePriceFeedGetGasPrices(Dictionary<string, object> args, FunctionExecutionContext context)
+        {
+            // The host passes its fresh gas prices with the execution, so no chain is called from the sandbox
+            return context.GasPrices;
+        }
+
+        private object HandlePriceFeedGetCheapestChain(Dictionary<string, object> args, FunctionExecutionContext context)
+        {
+            var chains = args != null && args.TryGetValue("chains", out var value) && value != null
+                ? JsonConvert.DeserializeObject<List<string>>(JsonConvert.SerializeObject(value))
+                : null;
+            var allowed = chains?.Where(c => !string.IsNullOrWhiteSpace(c)).ToHashSet(StringComparer.OrdinalIgnoreCase);
+
+            _logger.LogInformation("Getting the cheapest chain of: {Chains}", allowed == null ? "all" : string.Join(", ", allowed));
+
+            return context.GasPrices
+                .Where(p => p.TransferFeeUsd.HasValue)
+                .Where(p => allowed == null || allowed.Count == 0 || allowed.Contains(p.Chain))
+                .OrderBy(p => p.TransferFeeUsd.Value)
+                .ThenBy(p => p.Chain, StringComparer.OrdinalIgnoreCase)
+                .FirstOrDefault();
+        }
+
         private async Task<object> HandlePriceFeedSubmitToOracleAsync(Dictionary<string, object> args, FunctionExecutionContext context)
         {
             var price = args["price"];
@@ -347,44 +628,50 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
 
         #region Secrets Handlers
 
-        private async Task<object> HandleSecretsGetSecretAsync(Dictionary<string, object> args, FunctionExecutionContext context)
+        private Task<object> HandleSecretsGetSecretAsync(Dictionary<string, object> args, FunctionExecutionContext context)
         {
             var name = args["name"].ToString();
 
             _logger.LogInformation("Getting secret by name: {Name}", name);
 
-            var request = new
-            {
-                Name = name,
-                FunctionId = context.FunctionId
-            };
+            // A shared secret with the same name as one of the account's own never shadows it
+            var secret = context.GrantedSecrets.Values
+                .Where(s => s.Name == name)
+                .OrderBy(s => s.AccountId != Guid.Empty && s.AccountId != context.AccountId)
+                .FirstOrDefault();
 
-            var requestBytes = JsonUtility.SerializeToUtf8Bytes(request);
-            var result = await _secretsService.HandleRequestAsync(
-                Constants.SecretsOperations.GetSecret,
-                requestBytes);
-
-            return result;
+            return Task.FromResult<object>(UnsealGrantedSecret(secret, name, context));
         }
 
-        private async Task<object> HandleSecretsGetSecretByIdAsync(Dictionary<string, object> args, FunctionExecutionContext context)
+        private Task<object> HandleSecretsGetSecretByIdAsync(Dictionary<string, object> args, FunctionExecutionContext context)
         {
             var id = args["id"].ToString();
 
             _logger.LogInformation("Getting secret by ID: {Id}", id);
 
-            var request = new
+            Models.GrantedSecret? secret = null;
+            if (Guid.TryParse(id, out var secretId))
             {
-                Id = Guid.Parse(id),
-                FunctionId = context.FunctionId
-            };
+                context.GrantedSecrets.TryGetValue(secretId, out secret);
+            }
 
-            var requestBytes = JsonUtility.SerializeToUtf8Bytes(request);
-            var result = await _secretsService.HandleRequestAsync(
-                Constants.SecretsOperations.GetSecret,
-                requestBytes);
+            return Task.FromResult<object>(UnsealGrantedSecret(secret, id, context));
+        }
 
-            return result;
+        // Only secrets the host granted to this function are reachable; anything else is refused
+        // the same way whether it does not exist or belongs to another function
+        private string UnsealGrantedSecret(Models.GrantedSecret? secret, string requested, FunctionExecutionContext context)
+        {
+            if (secret == null)
+            {
+                RecordSecurityEvent(context, FunctionSecurityEventType.BlockedTarget, requested,
+                    "Secret not granted to the function");
+                throw new SandboxException(FunctionErrorCodes.HostCallDenied, $"Secret {requested} is not granted to this function");
+            }
+
+            // Shared secrets are sealed under their owner's account
+            var ownerAccountId = secret.AccountId != Guid.Empty ? secret.AccountId : context.AccountId;
+            return _secretsService.UnsealSecretValue(secret.EncryptedValue, secret.Id, ownerAccountId);
         }
 
         #endregion
@@ -421,6 +708,74 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
 
         #endregion
 
+        #region HTTP Handlers
+
+        private async Task<object> HandleHttpFetchAsync(Dictionary<string, object> args, FunctionExecutionContext context)
+        {
+            if (_fetchClient == null)
+            {
+                throw new SandboxException(FunctionErrorCodes.HostCallDenied, "HTTP fetch is not enabled in this sandbox");
+            }
+
+            var url = args["url"]?.ToString() ?? string.Empty;
+            var uri = _fetchClient.ParseUrl(url);
+            if (!_fetchClient.IsHostAllowed(uri))
+            {
+                RecordSecurityEvent(context, FunctionSecurityEventType.BlockedTarget, uri.Host,
+                    "Host not on the fetch allowlist");
+                throw new SandboxException(FunctionErrorCodes.HostCallDenied, $"Host {uri.Host} is not on the fetch allowlist");
+            }
+
+            var request = new SandboxFetchRequest
+            {
+                Url = url,
+                Method = args.TryGetValue("method", out var method) ? method?.ToString() : null,
+                Headers = args.TryGetValue("headers", out var headers) && headers != null
+                    ? Newtonsoft.Json.Linq.JObject.FromObject(headers).Properties().ToDictionary(p => p.Name, p => p.Value.ToString())
+                    : null,
+                Body = args.TryGetValue("body", out var body) ? body?.ToString() : null
+            };
+
+            var response = await _fetchClient.FetchAsync(request, context.FetchUsage, context.CancellationToken);
+
+            // Returned as JSON so the SDK sees the usual camel-case property names
+            return JsonConvert.SerializeObject(response, new JsonSerializerSettings
+            {
+                ContractResolver = new Newtonsoft.Json.Serialization.DefaultContractResolver
+                {
+                    NamingStrategy = new Newtonsoft.Json.Serialization.CamelCaseNamingStrategy()
+                }
+            });
+        }
+
+        #endregion
+
+        #region Metrics Handlers
+
+        private object HandleMetricsRecord(Dictionary<string, object> args, FunctionExecutionContext context)
+        {
+            var name = args["name"]?.ToString();
+            if (string.IsNullOrWhiteSpace(name))
+            {
+                throw new ArgumentException("Metric name is required");
+            }
+
+            var value = Convert.ToDouble(args["value"], System.Globalization.CultureInfo.InvariantCulture);
+            if (double.IsNaN(value) || double.IsInfinity(value))
+            {
+                throw new ArgumentException($"Metric value must be a finite number: {name}");
+            }
+
+            lock (context.Metrics)
+            {
+                context.Metrics[name] = value;
+            }
+
+            return true;
+        }
+
+        #endregion
+
         #region Storage Handlers
 
         private async Task<object> HandleStorageGetAsync(Dictionary<string, object> args, FunctionExecutionContext context)
@@ -633,6 +988,29 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
             return result;
         }
 
+        private async Task<object> HandleBlockchainGetBalanceAtAsync(Dictionary<string, object> args, FunctionExecutionContext context)
+        {
+            var blockIndex = Convert.ToInt64(args["blockIndex"]);
+            var address = args["address"].ToString();
+            var assetHash = args["assetHash"].ToString();
+
+            _logger.LogInformation("Getting balance at block {BlockIndex} for address: {Address}, AssetHash: {AssetHash}", blockIndex, address, assetHash);
+
+            var request = new
+            {
+                BlockIndex = blockIndex,
+                Address = address,
+                AssetHash = assetHash
+            };
+
+            var requestBytes = JsonUtility.SerializeToUtf8Bytes(request);
+            var result = await _walletService.HandleRequestAsync(
+                "getBalanceAt",
+                requestBytes);
+
+            return result;
+        }
+
         private async Task<object> HandleBlockchainInvokeReadAsync(Dictionary<string, object> args, FunctionExecutionContext context)
         {
             var scriptHash = args["scriptHash"].ToString();
@@ -656,6 +1034,31 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
             return result;
         }
 
+        private async Task<object> HandleBlockchainInvokeReadAtAsync(Dictionary<string, object> args, FunctionExecutionContext context)
+        {
+            var blockIndex = Convert.ToInt64(args["blockIndex"]);
+            var scriptHash = args["scriptHash"].ToString();
+            var operation = args["operation"].ToString();
+            var contractArgs = args["args"];
+
+            _logger.LogInformation("Invoking read-only contract at block {BlockIndex}: {ScriptHash}, Operation: {Operation}", blockIndex, scriptHash, operation);
+
+            var request = new
+            {
+                BlockIndex = blockIndex,
+                ScriptHash = scriptHash,
+                Operation = operation,
+                Args = contractArgs
+            };
+
+            var requestBytes = JsonUtility.SerializeToUtf8Bytes(request);
+            var result = await _walletService.HandleRequestAsync(
+                "invokeReadAt",
+                requestBytes);
+
+            return result;
+        }
+
         private async Task<object> HandleBlockchainInvokeWriteAsync(Dictionary<string, object> args, FunctionExecutionContext context)
         {
             var scriptHash = args["scriptHash"].ToString();
@@ -664,6 +1067,11 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
 
             _logger.LogInformation("Invoking contract: {ScriptHash}, Operation: {Operation}", scriptHash, operation);
 
+            if (_invocationLimiter != null)
+            {
+                await _invocationLimiter.AcquireAsync(scriptHash, context.AccountId);
+            }
+
             var request = new
             {
                 ScriptHash = scriptHash,
@@ -682,6 +1090,235 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
 
         #endregion
 
+        #region NFT Handlers
+
+        private async Task<object> HandleNftOwnerOfAsync(Dictionary<string, object> args, FunctionExecutionContext context)
+        {
+            var contractHash = args["contractHash"].ToString();
+            var tokenId = args["tokenId"].ToString();
+
+            _logger.LogInformation("Getting owner of NFT {TokenId} on contract: {ContractHash}", tokenId, contractHash);
+
+            var request = new
+            {
+                ContractHash = contractHash,
+                TokenId = tokenId
+            };
+
+            var requestBytes = JsonUtility.SerializeToUtf8Bytes(request);
+            var result = await _walletService.HandleRequestAsync(
+                "nep11OwnerOf",
+                requestBytes);
+
+            return result;
+        }
+
+        private async Task<object> HandleNftTokensOfAsync(Dictionary<string, object> args, FunctionExecutionContext context)
+        {
+            var contractHash = args["contractHash"].ToString();
+            var address = args["address"].ToString();
+            var maxItems = args.TryGetValue("maxItems", out var max) && max != null ? Convert.ToInt32(max) : 100;
+
+            _logger.LogInformation("Getting NFTs of address: {Address} on contract: {ContractHash}", address, contractHash);
+
+            var request = new
+            {
+                ContractHash = contractHash,
+                Address = address,
+                MaxItems = maxItems
+            };
+
+            var requestBytes = JsonUtility.SerializeToUtf8Bytes(request);
+            var result = await _walletService.HandleRequestAsync(
+                "nep11TokensOf",
+                requestBytes);
+
+            return result;
+        }
+
+        private async Task<object> HandleNftPropertiesAsync(Dictionary<string, object> args, FunctionExecutionContext context)
+        {
+            var contractHash = args["contractHash"].ToString();
+            var tokenId = args["tokenId"].ToString();
+
+            _logger.LogInformation("Getting properties of NFT {TokenId} on contract: {ContractHash}", tokenId, contractHash);
+
+            var request = new
+            {
+                ContractHash = contractHash,
+                TokenId = tokenId
+            };
+
+            var requestBytes = JsonUtility.SerializeToUtf8Bytes(request);
+            var result = await _walletService.HandleRequestAsync(
+                "nep11Properties",
+                requestBytes);
+
+            return result;
+        }
+
+        private async Task<object> HandleNftTransferAsync(Dictionary<string, object> args, FunctionExecutionContext context)
+        {
+            var walletId = Guid.Parse(args["walletId"].ToString());
+            var contractHash = args["contractHash"].ToString();
+            var toAddress = args["toAddress"].ToString();
+            var tokenId = args["tokenId"].ToString();
+
+            _logger.LogInformation("Transferring NFT {TokenId} on contract: {ContractHash} to {ToAddress}", tokenId, contractHash, toAddress);
+
+            var request = new
+            {
+                WalletId = walletId,
+                AccountId = context.AccountId,
+                Password = args["password"]?.ToString(),
+                ContractHash = contractHash,
+                ToAddress = toAddress,
+                TokenId = tokenId,
+                Data = args.TryGetValue("data", out var data) ? data?.ToString() : null
+            };
+
+            var requestBytes = JsonUtility.SerializeToUtf8Bytes(request);
+            var result = await _walletService.HandleRequestAsync(
+                Constants.WalletOperations.TransferNft,
+                requestBytes);
+
+            return result;
+        }
+
+        #endregion
+
+        #region Staking Handlers
+
+        private async Task<object> HandleStakingVoteAsync(Dictionary<string, object> args, FunctionExecutionContext context)
+        {
+            var walletId = Guid.Parse(args["walletId"].ToString());
+            var candidatePublicKey = args.TryGetValue("candidatePublicKey", out var candidate) ? candidate?.ToString() : null;
+
+            _logger.LogInformation("Voting for candidate {CandidatePublicKey} from wallet {WalletId}", candidatePublicKey ?? "(none)", walletId);
+
+            var request = new
+            {
+                WalletId = walletId,
+                AccountId = context.AccountId,
+                Password = args["password"]?.ToString(),
+                CandidatePublicKey = candidatePublicKey
+            };
+
+            var requestBytes = JsonUtility.SerializeToUtf8Bytes(request);
+            var result = await _walletService.HandleRequestAsync(
+                Constants.WalletOperations.Vote,
+                requestBytes);
+
+            return result;
+        }
+
+        private async Task<object> HandleStakingGetVoteAsync(Dictionary<string, object> args, FunctionExecutionContext context)
+        {
+            var address = args["address"].ToString();
+
+            _logger.LogInformation("Getting vote of address: {Address}", address);
+
+            // The NEO contract's account state holds the balance, the balance height and the candidate voted for
+            var request = new
+            {
+                ScriptHash = NeoServiceLayer.Core.Constants.NeoConfig.NeoTokenHash,
+                Operation = "getAccountState",
+                Args = new object[] { address }
+            };
+
+            var requestBytes = JsonUtility.SerializeToUtf8Bytes(request);
+            var result = await _walletService.HandleRequestAsync(
+                "invokeRead",
+                requestBytes);
+
+            return result;
+        }
+
+        private async Task<object> HandleStakingConvertBneoAsync(Dictionary<string, object> args, FunctionExecutionContext context, string operation)
+        {
+            var walletId = Guid.Parse(args["walletId"].ToString());
+            var amount = Convert.ToDecimal(args["amount"]);
+
+            _logger.LogInformation("Running {Operation} for {Amount} from wallet {WalletId}", operation, amount, walletId);
+
+            var request = new
+            {
+                WalletId = walletId,
+                AccountId = context.AccountId,
+                Password = args["password"]?.ToString(),
+                Amount = amount
+            };
+
+            var requestBytes = JsonUtility.SerializeToUtf8Bytes(request);
+            var result = await _walletService.HandleRequestAsync(
+                operation,
+                requestBytes);
+
+            return result;
+        }
+
+        #endregion
+
+        /// <summary>
+        /// Invokes the entry point, running the function's onTimeout handler if the invocation times out
+        /// </summary>
+        /// <param name="engine">Engine the function was loaded into</param>
+        /// <param name="engineCancellation">Source of the engine's cancellation token</param>
+        /// <param name="context">Execution context</param>
+        /// <param name="entryPoint">Entry point name</param>
+        /// <param name="argument">Argument passed to the entry point</param>
+        /// <returns>Entry point result</returns>
+        private Jint.Native.JsValue InvokeWithTimeoutHandler(Engine engine, CancellationTokenSource engineCancellation, FunctionExecutionContext context, string entryPoint, Jint.Native.JsValue argument)
+        {
+            try
+            {
+                return engine.Invoke(entryPoint, argument);
+            }
+            catch (Exception ex) when ((ex is TimeoutException || ex is StatementsCountOverflowException) && !context.CancellationToken.IsCancellationRequested)
+            {
+                // Running out of statements is reported as a timeout too, so it runs the handler as well
+                var handler = engine.Evaluate("typeof _timeout === 'undefined' ? null : _timeout.handler");
+                if (handler.IsNull() || handler.IsUndefined())
+                {
+                    throw;
+                }
+
+                _logger.LogWarning("Function {FunctionId} timed out; running its onTimeout handler for up to {GracePeriod}ms",
+                    context.FunctionId, context.TimeoutGracePeriod);
+
+                // Invoking again resets the engine's time and statement constraints, so the grace budget is enforced through cancellation
+                engineCancellation.CancelAfter(context.TimeoutGracePeriod);
+                try
+                {
+                    engine.Invoke(handler, Jint.Native.JsValue.FromObject(engine, new { graceMs = context.TimeoutGracePeriod })).UnwrapIfPromise();
+                }
+                catch (ExecutionCanceledException)
+                {
+                    _logger.LogWarning("onTimeout handler of function {FunctionId} exceeded its {GracePeriod}ms grace period",
+                        context.FunctionId, context.TimeoutGracePeriod);
+                }
+                catch (Exception handlerException)
+                {
+                    _logger.LogWarning(handlerException, "onTimeout handler of function {FunctionId} failed", context.FunctionId);
+                }
+
+                throw;
+            }
+        }
+
+        /// <summary>
+        /// Formats a script error with its position so the host can map it back to the function's source
+        /// </summary>
+        /// <param name="exception">Script error</param>
+        /// <returns>Error message</returns>
+        private static string FormatError(JavaScriptException exception)
+        {
+            var start = exception.Location.Start;
+            return start.Line > 0
+                ? $"{exception.Message} (line {start.Line}, column {start.Column + 1})"
+                : exception.Message;
+        }
+
         /// <summary>
         /// Converts a Jint JavaScript value to a .NET object
         /// </summary>
@@ -740,11 +1377,20 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
                 var sourceCode = compiledFunction.SourceCode;
 
                 // Create a new Jint engine with appropriate constraints
+                // The engine's own token lets the onTimeout handler be stopped without killing the execution
+                using var engineCancellation = CancellationTokenSource.CreateLinkedTokenSource(context.CancellationToken);
+                var memoryMeter = context.MemoryMeter ??= new MemoryMeter(context.MaxMemory * 1024L * 1024);
                 var engine = new Engine(options => {
                     options.LimitRecursion(100);
                     options.TimeoutInterval(TimeSpan.FromSeconds(context.MaxExecutionTime / 1000));
                     options.MaxStatements(10000);
+                    options.CancellationToken(engineCancellation.Token);
                     options.DebugMode();
+                    options.Constraint(new MemoryMeterConstraint(memoryMeter));
+                    if (context.GasMeter != null)
+                    {
+                        options.Constraint(new GasMeterConstraint(context.GasMeter));
+                    }
                 });
 
                 // Add environment variables to the JavaScript context
@@ -792,7 +1438,7 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
                 engine.Execute($"var __event = JSON.parse('{jsonString}')");
 
                 // Call the entry point function with event data
-                var result = engine.Invoke(entryPoint, engine.GetValue("__event"));
+                var result = InvokeWithTimeoutHandler(engine, engineCancellation, context, entryPoint, engine.GetValue("__event"));
 
                 // Convert the result to a .NET object
                 var resultObj = ConvertJsValueToObject(result);
@@ -801,20 +1447,23 @@ namespace NeoServiceLayer.Enclave.Enclave.Execution
                 return new {
                     Result = resultObj,
                     Logs = logs,
+                    Metrics = context.Metrics,
+                    SecurityEvents = context.SecurityEvents,
+                    GasUsed = context.GasMeter?.Used ?? 0,
                     EventType = eventData.Type,
                     ExecutionTime = DateTime.UtcNow,
-                    MemoryUsage = GC.GetTotalMemory(false) / (1024 * 1024) // Approximate memory usage in MB
+                    MemoryUsage = memoryMeter.Used / (1024 * 1024) // Memory the execution allocated, in MB
                 };
             }
             catch (JavaScriptException jsEx)
             {
                 _logger.LogError(jsEx, "JavaScript execution error for event: {Message}", jsEx.Message);
-                throw new Exception($"JavaScript execution error for event: {jsEx.Message}");
+                throw new SandboxException(SandboxErrorClassifier.Classify(jsEx, context), $"JavaScript execution error for event: {FormatError(jsEx)}", jsEx);
             }
-            catch (Exception ex)
+            catch (Exception ex) when (ex is not SandboxException)
             {
                 _logger.LogError(ex, "Error executing JavaScript function for event");
-                throw;
+                throw new SandboxException(SandboxErrorClassifier.Classify(ex, context), ex.Message, ex);
             }
         }
     }
@@ -0,0 +1,265 @@
+using System;
+using System.Collections.Concurrent;
+using System.Linq;
+using System.Security.Cryptography;
+using System.Text;
+using System.Threading;
+using System.Threading.Tasks;
+using Acornima.Ast;
+using Jint;
+using Jint.Runtime;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Enclave.Enclave.Models;
+
+namespace NeoServiceLayer.Enclave.Enclave.Execution
+{
+    /// <summary>
+    /// Keeps pre-warmed sandboxes and the parsed scripts of recently run function versions, so an invocation without
+    /// a session key neither builds an engine, loads the SDK nor parses the function's code on its own time
+    /// </summary>
+    /// <remarks>
+    /// A warm sandbox is handed to one invocation and discarded afterwards. Scrubbing a used engine's globals could not
+    /// undo changes a function makes to built-ins or SDK objects, so discarding it is the only reset that keeps one
+    /// caller's state from the next; invocations that want state kept use sessions instead. Taking a sandbox starts
+    /// warming its replacement in the background. The time limit is fixed when an engine is built, so sandboxes are
+    /// warmed per limit, up to <see cref="SandboxPoolOptions.WarmSandboxes"/> for each limit that has been used.
+    ///
+    /// Parsed scripts are immutable and shared by every engine running them. They are keyed by function ID and a
+    /// hash of the code, so a new version of a function is parsed once and the old one ages out.
+    /// </remarks>
+    public class SandboxEnginePool
+    {
+        private readonly ILogger<SandboxEnginePool> _logger;
+        private readonly SandboxPoolOptions _options;
+        private readonly ConcurrentDictionary<int, WarmSandboxes> _warm = new ConcurrentDictionary<int, WarmSandboxes>();
+        private readonly ConcurrentDictionary<(Guid FunctionId, string CodeHash), CachedScript> _scripts = new ConcurrentDictionary<(Guid FunctionId, string CodeHash), CachedScript>();
+        private int _warmCount;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SandboxEnginePool"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="options">Pool limits</param>
+        public SandboxEnginePool(ILogger<SandboxEnginePool> logger, IOptions<SandboxPoolOptions>? options = null)
+        {
+            _logger = logger;
+            _options = options?.Value ?? new SandboxPoolOptions();
+        }
+
+        /// <summary>
+        /// Gets whether sandboxes are pre-warmed and scripts cached
+        /// </summary>
+        public bool Enabled => _options.Enabled;
+
+        /// <summary>
+        /// Gets the number of sandboxes that are warm or being warmed
+        /// </summary>
+        public int WarmCount => Volatile.Read(ref _warmCount);
+
+        /// <summary>
+        /// Gets the number of parsed scripts kept
+        /// </summary>
+        public int CachedScriptCount => _scripts.Count;
+
+        /// <summary>
+        /// Takes a warm sandbox for one invocation, building one now if none is ready, and starts warming its replacement
+        /// </summary>
+        /// <param name="maxExecutionTime">Time limit the sandbox's engine is built with, in milliseconds</param>
+        /// <param name="build">Builds the engine of a sandbox, with the SDK loaded and its limits bound to the sandbox</param>
+        /// <returns>The sandbox; it must not be used by another invocation</returns>
+        public PooledSandbox Take(int maxExecutionTime, Func<PooledSandbox, Engine> build)
+        {
+            var warm = _warm.GetOrAdd(maxExecutionTime, _ => new WarmSandboxes());
+
+            if (!warm.Ready.TryDequeue(out var sandbox))
+            {
+                _logger.LogDebug("No warm sandbox for a {MaxExecutionTime}ms time limit; building one", maxExecutionTime);
+                sandbox = Build(maxExecutionTime, build);
+            }
+            else
+            {
+                Interlocked.Decrement(ref _warmCount);
+            }
+
+            Refill(warm, maxExecutionTime, build);
+            return sandbox;
+        }
+
+        /// <summary>
+        /// Gets the parsed script of a function version, parsing it on first use
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="sourceCode">Function source code</param>
+        /// <returns>The parsed script, or null if the code does not parse and must be executed as text to report the error</returns>
+        public Prepared<Script>? GetScript(Guid functionId, string sourceCode)
+        {
+            var codeHash = Convert.ToHexString(SHA256.HashData(Encoding.UTF8.GetBytes(sourceCode)));
+            if (_scripts.TryGetValue((functionId, codeHash), out var cached))
+            {
+                cached.LastUsedAt = DateTime.UtcNow;
+                return cached.Script;
+            }
+
+            Prepared<Script> script;
+            try
+            {
+                script = Engine.PrepareScript(sourceCode);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogDebug(ex, "Code of function {FunctionId} does not parse; it is not cached", functionId);
+                return null;
+            }
+
+            _scripts[(functionId, codeHash)] = new CachedScript(script);
+            EnforceScriptCapacity();
+            return script;
+        }
+
+        private static PooledSandbox Build(int maxExecutionTime, Func<PooledSandbox, Engine> build)
+        {
+            var sandbox = new PooledSandbox(maxExecutionTime);
+            sandbox.Engine = build(sandbox);
+            return sandbox;
+        }
+
+        private void Refill(WarmSandboxes warm, int maxExecutionTime, Func<PooledSandbox, Engine> build)
+        {
+            while (warm.Ready.Count + Volatile.Read(ref warm.Pending) < _options.WarmSandboxes)
+            {
+                // Reserve the slot before warming, so concurrent takes cannot exceed the limit
+                if (Interlocked.Increment(ref _warmCount) > _options.MaxWarmSandboxes)
+                {
+                    Interlocked.Decrement(ref _warmCount);
+                    return;
+                }
+
+                Interlocked.Increment(ref warm.Pending);
+                _ = Task.Run(() =>
+                {
+                    try
+                    {
+                        warm.Ready.Enqueue(Build(maxExecutionTime, build));
+                    }
+                    catch (Exception ex)
+                    {
+                        Interlocked.Decrement(ref _warmCount);
+                        _logger.LogWarning(ex, "Error warming a sandbox for a {MaxExecutionTime}ms time limit", maxExecutionTime);
+                    }
+                    finally
+                    {
+                        Interlocked.Decrement(ref warm.Pending);
+                    }
+                });
+            }
+        }
+
+        private void EnforceScriptCapacity()
+        {
+            var excess = _scripts.Count - Math.Max(1, _options.MaxCachedScripts);
+            if (excess <= 0)
+            {
+                return;
+            }
+
+            foreach (var entry in _scripts.OrderBy(s => s.Value.LastUsedAt).Take(excess).ToList())
+            {
+                _scripts.TryRemove(entry.Key, out _);
+            }
+        }
+
+        private sealed class WarmSandboxes
+        {
+            public readonly ConcurrentQueue<PooledSandbox> Ready = new ConcurrentQueue<PooledSandbox>();
+            public int Pending;
+        }
+
+        private sealed class CachedScript
+        {
+            public CachedScript(Prepared<Script> script)
+            {
+                Script = script;
+                LastUsedAt = DateTime.UtcNow;
+            }
+
+            public Prepared<Script> Script { get; }
+
+            public DateTime LastUsedAt { get; set; }
+        }
+    }
+
+    /// <summary>
+    /// Pre-warmed sandbox serving a single invocation
+    /// </summary>
+    public class PooledSandbox
+    {
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PooledSandbox"/> class
+        /// </summary>
+        /// <param name="maxExecutionTime">Time limit the engine is built with, in milliseconds</param>
+        public PooledSandbox(int maxExecutionTime)
+        {
+            MaxExecutionTime = maxExecutionTime;
+        }
+
+        /// <summary>
+        /// Gets the time limit the engine is built with, in milliseconds
+        /// </summary>
+        public int MaxExecutionTime { get; }
+
+        /// <summary>
+        /// Gets the engine, with the SDK loaded
+        /// </summary>
+        public Engine Engine { get; internal set; } = null!;
+
+        /// <summary>
+        /// Gets or sets the context of the invocation running in the sandbox
+        /// </summary>
+        public FunctionExecutionContext? Context { get; set; }
+
+        /// <summary>
+        /// Gets or sets the token that stops the invocation running in the sandbox
+        /// </summary>
+        public CancellationToken CancellationToken { get; set; }
+    }
+
+    /// <summary>
+    /// Jint constraint applying the limits of the invocation a pooled sandbox was taken for
+    /// </summary>
+    /// <remarks>
+    /// The engine is built before the invocation exists, so nothing is metered while the SDK loads; each invocation
+    /// binds its context to the sandbox when it takes it.
+    /// </remarks>
+    public class PooledSandboxConstraint : Jint.Constraint
+    {
+        private readonly PooledSandbox _sandbox;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PooledSandboxConstraint"/> class
+        /// </summary>
+        /// <param name="sandbox">Sandbox whose invocation is checked</param>
+        public PooledSandboxConstraint(PooledSandbox sandbox)
+        {
+            _sandbox = sandbox;
+        }
+
+        /// <inheritdoc/>
+        public override void Check()
+        {
+            if (_sandbox.CancellationToken.IsCancellationRequested)
+            {
+                throw new ExecutionCanceledException();
+            }
+
+            _sandbox.Context?.MemoryMeter?.Sample();
+            _sandbox.Context?.GasMeter?.Charge(GasMeter.StatementCost);
+        }
+
+        /// <inheritdoc/>
+        public override void Reset()
+        {
+            // Limits belong to the invocation, not to the Execute or Invoke the engine is resetting for
+        }
+    }
+}
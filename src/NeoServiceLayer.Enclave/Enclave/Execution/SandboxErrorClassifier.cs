@@ -0,0 +1,75 @@
+using System;
+using System.Linq;
+using Jint.Native.Object;
+using Jint.Runtime;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Enclave.Enclave.Execution
+{
+    /// <summary>
+    /// Maps sandbox failures to <see cref="FunctionErrorCodes"/>
+    /// </summary>
+    public static class SandboxErrorClassifier
+    {
+        /// <summary>
+        /// Classifies the exception a function execution failed with
+        /// </summary>
+        /// <param name="exception">The exception</param>
+        /// <param name="context">The execution context, or null if the function did not start</param>
+        /// <returns>One of <see cref="FunctionErrorCodes"/></returns>
+        public static string Classify(Exception exception, FunctionExecutionContext? context)
+        {
+            for (var current = exception; current != null; current = current.InnerException)
+            {
+                switch (current)
+                {
+                    case SandboxException sandboxException when !string.IsNullOrEmpty(sandboxException.ErrorCode):
+                        return sandboxException.ErrorCode;
+                    case TimeoutException:
+                    case StatementsCountOverflowException:
+                        return FunctionErrorCodes.TimeoutError;
+                    case MemoryLimitExceededException:
+                    case RecursionDepthOverflowException:
+                        return FunctionErrorCodes.MemoryLimitError;
+                    case JavaScriptException javaScriptException when IsSyntaxError(javaScriptException):
+                        return FunctionErrorCodes.SyntaxError;
+                }
+            }
+
+            // Gas can run out inside a host call, which the script sees as a rejected promise
+            if (context?.GasMeter?.Exhausted == true)
+            {
+                return FunctionErrorCodes.GasExhausted;
+            }
+
+            // A refused host call surfaces in the script as whatever error the function let escape
+            if (context?.SecurityEvents.Any() == true || HasInner<UnauthorizedAccessException>(exception))
+            {
+                return FunctionErrorCodes.HostCallDenied;
+            }
+
+            return HasInner<JavaScriptException>(exception)
+                ? FunctionErrorCodes.UserThrown
+                : FunctionErrorCodes.InternalError;
+        }
+
+        private static bool IsSyntaxError(JavaScriptException exception)
+        {
+            return exception.Error is ObjectInstance error && error.Get("name").ToString() == "SyntaxError";
+        }
+
+        private static bool HasInner<T>(Exception exception) where T : Exception
+        {
+            for (var current = exception; current != null; current = current.InnerException)
+            {
+                if (current is T)
+                {
+                    return true;
+                }
+            }
+
+            return false;
+        }
+    }
+}
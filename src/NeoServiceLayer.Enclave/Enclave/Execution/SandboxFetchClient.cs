@@ -0,0 +1,302 @@
+using System;
+using System.Collections.Generic;
+using System.Diagnostics;
+using System.IO;
+using System.Linq;
+using System.Net.Http;
+using System.Text;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Enclave.Enclave.Models;
+
+namespace NeoServiceLayer.Enclave.Enclave.Execution
+{
+    /// <summary>
+    /// Makes the HTTP requests functions send with <c>fetch</c>, within the allowlist and budgets of <see cref="SandboxFetchOptions"/>
+    /// </summary>
+    public class SandboxFetchClient
+    {
+        private static readonly HashSet<string> AllowedMethods = new HashSet<string>(StringComparer.OrdinalIgnoreCase)
+        {
+            "GET", "HEAD", "POST", "PUT", "PATCH", "DELETE"
+        };
+
+        private readonly ILogger<SandboxFetchClient> _logger;
+        private readonly SandboxFetchOptions _options;
+        private readonly HttpClient _httpClient;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SandboxFetchClient"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="options">Fetch options; requests are refused when omitted</param>
+        /// <param name="handler">Handler sending the requests, or null for one that follows no redirects and keeps no cookies</param>
+        public SandboxFetchClient(ILogger<SandboxFetchClient> logger, IOptions<SandboxFetchOptions>? options = null, HttpMessageHandler? handler = null)
+        {
+            _logger = logger;
+            _options = options?.Value ?? new SandboxFetchOptions();
+
+            // Timeouts come from the per-request and per-execution budgets
+            _httpClient = new HttpClient(handler ?? new SocketsHttpHandler { AllowAutoRedirect = false, UseCookies = false })
+            {
+                Timeout = Timeout.InfiniteTimeSpan
+            };
+        }
+
+        /// <summary>
+        /// Parses the URL a function asked for
+        /// </summary>
+        /// <param name="url">URL</param>
+        /// <returns>The URL</returns>
+        /// <exception cref="ArgumentException">The URL is not absolute or uses a scheme the sandbox does not allow</exception>
+        public Uri ParseUrl(string url)
+        {
+            if (!Uri.TryCreate(url, UriKind.Absolute, out var uri))
+            {
+                throw new ArgumentException($"Invalid URL: {url}");
+            }
+
+            if (uri.Scheme != Uri.UriSchemeHttps && !(_options.AllowHttp && uri.Scheme == Uri.UriSchemeHttp))
+            {
+                throw new ArgumentException($"URL scheme {uri.Scheme} is not allowed; use https");
+            }
+
+            return uri;
+        }
+
+        /// <summary>
+        /// Checks whether a URL's host is on the allowlist
+        /// </summary>
+        /// <param name="uri">URL</param>
+        /// <returns>True if functions may reach the host</returns>
+        public bool IsHostAllowed(Uri uri)
+        {
+            var host = uri.IdnHost.TrimEnd('.');
+            return _options.AllowedHosts.Any(allowed =>
+                allowed.StartsWith("*.", StringComparison.Ordinal)
+                    ? host.EndsWith(allowed.Substring(1), StringComparison.OrdinalIgnoreCase)
+                    : string.Equals(host, allowed, StringComparison.OrdinalIgnoreCase));
+        }
+
+        /// <summary>
+        /// Sends a request for a function
+        /// </summary>
+        /// <param name="request">Request</param>
+        /// <param name="usage">What the execution has used of its budgets so far; updated with this request</param>
+        /// <param name="cancellationToken">Token signalled when the execution is killed</param>
+        /// <returns>The response</returns>
+        /// <exception cref="SandboxException">Fetch is disabled, the host is not allowed, or the request would exceed a budget</exception>
+        public async Task<SandboxFetchResponse> FetchAsync(SandboxFetchRequest request, SandboxFetchUsage usage, CancellationToken cancellationToken)
+        {
+            if (!_options.Enabled)
+            {
+                throw new SandboxException(FunctionErrorCodes.HostCallDenied, "HTTP fetch is not enabled in this sandbox");
+            }
+
+            var uri = ParseUrl(request.Url);
+            if (!IsHostAllowed(uri))
+            {
+                throw new SandboxException(FunctionErrorCodes.HostCallDenied, $"Host {uri.Host} is not on the fetch allowlist");
+            }
+
+            var method = string.IsNullOrEmpty(request.Method) ? "GET" : request.Method.ToUpperInvariant();
+            if (!AllowedMethods.Contains(method))
+            {
+                throw new ArgumentException($"HTTP method {method} is not allowed");
+            }
+
+            var body = request.Body == null ? null : Encoding.UTF8.GetBytes(request.Body);
+            var sent = body?.Length ?? 0;
+            if (sent > _options.MaxRequestBodyBytes)
+            {
+                throw new SandboxException(FunctionErrorCodes.HostCallDenied, $"Request body exceeds {_options.MaxRequestBodyBytes} bytes");
+            }
+
+            TimeSpan timeout;
+            long responseLimit;
+            lock (usage)
+            {
+                if (usage.Requests >= _options.MaxRequestsPerExecution)
+                {
+                    throw new SandboxException(FunctionErrorCodes.HostCallDenied,
+                        $"Fetch limit of {_options.MaxRequestsPerExecution} requests per execution reached");
+                }
+
+                responseLimit = Math.Min(_options.MaxResponseBytes, _options.MaxBytesPerExecution - usage.Bytes - sent);
+                var remainingTime = TimeSpan.FromMilliseconds(_options.MaxTimePerExecutionMs) - usage.Elapsed;
+                if (responseLimit < 0 || remainingTime <= TimeSpan.Zero)
+                {
+                    throw new SandboxException(FunctionErrorCodes.HostCallDenied, "Fetch budget of this execution is used up");
+                }
+
+                timeout = remainingTime < TimeSpan.FromMilliseconds(_options.RequestTimeoutMs)
+                    ? remainingTime
+                    : TimeSpan.FromMilliseconds(_options.RequestTimeoutMs);
+                usage.Requests++;
+            }
+
+            using var message = new HttpRequestMessage(new HttpMethod(method), uri);
+            if (body != null)
+            {
+                message.Content = new ByteArrayContent(body);
+            }
+
+            foreach (var header in request.Headers ?? new Dictionary<string, string>())
+            {
+                if (!message.Headers.TryAddWithoutValidation(header.Key, header.Value))
+                {
+                    message.Content?.Headers.TryAddWithoutValidation(header.Key, header.Value);
+                }
+            }
+
+            using var timeoutSource = CancellationTokenSource.CreateLinkedTokenSource(cancellationToken);
+            timeoutSource.CancelAfter(timeout);
+            var stopwatch = Stopwatch.StartNew();
+            long received = 0;
+
+            try
+            {
+                using var response = await _httpClient.SendAsync(message, HttpCompletionOption.ResponseHeadersRead, timeoutSource.Token);
+                var content = await ReadLimitedAsync(response.Content, responseLimit, uri, timeoutSource.Token);
+                received = content.Length;
+
+                _logger.LogInformation("Function fetch {Method} {Host} returned {Status} with {Bytes} bytes",
+                    method, uri.Host, (int)response.StatusCode, received);
+
+                var headers = new Dictionary<string, string>();
+                foreach (var header in response.Headers.Concat(response.Content.Headers))
+                {
+                    headers[header.Key.ToLowerInvariant()] = string.Join(", ", header.Value);
+                }
+
+                return new SandboxFetchResponse
+                {
+                    Url = uri.ToString(),
+                    Status = (int)response.StatusCode,
+                    StatusText = response.ReasonPhrase ?? string.Empty,
+                    Headers = headers,
+                    Body = Encoding.UTF8.GetString(content)
+                };
+            }
+            catch (OperationCanceledException) when (!cancellationToken.IsCancellationRequested)
+            {
+                throw new TimeoutException($"Request to {uri.Host} timed out after {(int)timeout.TotalMilliseconds}ms");
+            }
+            finally
+            {
+                lock (usage)
+                {
+                    usage.Bytes += sent + received;
+                    usage.Elapsed += stopwatch.Elapsed;
+                }
+            }
+        }
+
+        private static async Task<byte[]> ReadLimitedAsync(HttpContent content, long limit, Uri uri, CancellationToken cancellationToken)
+        {
+            if (content.Headers.ContentLength > limit)
+            {
+                throw new SandboxException(FunctionErrorCodes.HostCallDenied, $"Response from {uri.Host} exceeds {limit} bytes");
+            }
+
+            await using var stream = await content.ReadAsStreamAsync(cancellationToken);
+            using var buffer = new MemoryStream();
+            var chunk = new byte[8192];
+            int read;
+            while ((read = await stream.ReadAsync(chunk, cancellationToken)) > 0)
+            {
+                // The declared length can be missing or wrong, so the cap is enforced on what actually arrives
+                if (buffer.Length + read > limit)
+                {
+                    throw new SandboxException(FunctionErrorCodes.HostCallDenied, $"Response from {uri.Host} exceeds {limit} bytes");
+                }
+
+                buffer.Write(chunk, 0, read);
+            }
+
+            return buffer.ToArray();
+        }
+    }
+
+    /// <summary>
+    /// HTTP request a function makes with <c>fetch</c>
+    /// </summary>
+    public class SandboxFetchRequest
+    {
+        /// <summary>
+        /// Gets or sets the absolute URL
+        /// </summary>
+        public string Url { get; set; } = string.Empty;
+
+        /// <summary>
+        /// Gets or sets the HTTP method; GET when empty
+        /// </summary>
+        public string? Method { get; set; }
+
+        /// <summary>
+        /// Gets or sets the request headers
+        /// </summary>
+        public Dictionary<string, string>? Headers { get; set; }
+
+        /// <summary>
+        /// Gets or sets the request body, or null for none
+        /// </summary>
+        public string? Body { get; set; }
+    }
+
+    /// <summary>
+    /// HTTP response returned to a function
+    /// </summary>
+    public class SandboxFetchResponse
+    {
+        /// <summary>
+        /// Gets or sets the URL that was requested
+        /// </summary>
+        public string Url { get; set; } = string.Empty;
+
+        /// <summary>
+        /// Gets or sets the status code
+        /// </summary>
+        public int Status { get; set; }
+
+        /// <summary>
+        /// Gets or sets the reason phrase
+        /// </summary>
+        public string StatusText { get; set; } = string.Empty;
+
+        /// <summary>
+        /// Gets or sets the response headers, keyed by lower-case name
+        /// </summary>
+        public Dictionary<string, string> Headers { get; set; } = new Dictionary<string, string>();
+
+        /// <summary>
+        /// Gets or sets the body, decoded as UTF-8
+        /// </summary>
+        public string Body { get; set; } = string.Empty;
+    }
+
+    /// <summary>
+    /// What one execution has used of its fetch budgets
+    /// </summary>
+    public class SandboxFetchUsage
+    {
+        /// <summary>
+        /// Gets or sets the number of requests made
+        /// </summary>
+        public int Requests { get; set; }
+
+        /// <summary>
+        /// Gets or sets the bytes sent and received
+        /// </summary>
+        public long Bytes { get; set; }
+
+        /// <summary>
+        /// Gets or sets the time spent waiting on requests
+        /// </summary>
+        public TimeSpan Elapsed { get; set; }
+    }
+}
@@ -0,0 +1,283 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Jint;
+using Jint.Runtime;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Enclave.Enclave.Models;
+
+namespace NeoServiceLayer.Enclave.Enclave.Execution
+{
+    /// <summary>
+    /// Keeps the warm sandboxes of invocations that carry a session key, so a function can hold state in memory between them
+    /// </summary>
+    /// <remarks>
+    /// A session is pinned to one function and one key. Invocations of the same session run one at a time, since a
+    /// sandbox is not safe to use from two threads. Idle and lifetime limits are checked whenever a session is
+    /// acquired, and the least recently used idle session is discarded when <see cref="SandboxSessionOptions.MaxSessions"/>
+    /// is reached. A session that is gone is simply created again, which is a cold start.
+    /// </remarks>
+    public class SandboxSessionRegistry
+    {
+        private readonly ILogger<SandboxSessionRegistry> _logger;
+        private readonly SandboxSessionOptions _options;
+        private readonly ConcurrentDictionary<(Guid FunctionId, string Key), SandboxSession> _sessions = new ConcurrentDictionary<(Guid FunctionId, string Key), SandboxSession>();
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SandboxSessionRegistry"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="options">Session limits</param>
+        public SandboxSessionRegistry(ILogger<SandboxSessionRegistry> logger, IOptions<SandboxSessionOptions>? options = null)
+        {
+            _logger = logger;
+            _options = options?.Value ?? new SandboxSessionOptions();
+        }
+
+        /// <summary>
+        /// Gets whether session keys are honoured
+        /// </summary>
+        public bool Enabled => _options.Enabled;
+
+        /// <summary>
+        /// Gets the number of sessions kept
+        /// </summary>
+        public int Count => _sessions.Count;
+
+        /// <summary>
+        /// Acquires a function's session, waiting for an invocation already running in it to finish
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="key">Session key</param>
+        /// <param name="fingerprint">Hash of the code and limits the sandbox must have been built with</param>
+        /// <param name="cancellationToken">Token that stops the wait</param>
+        /// <returns>The session; its <see cref="SandboxSession.Engine"/> is null if the invocation cold starts</returns>
+        public async Task<SandboxSession> AcquireAsync(Guid functionId, string key, string fingerprint, CancellationToken cancellationToken)
+        {
+            EvictExpired();
+
+            while (true)
+            {
+                var session = _sessions.GetOrAdd((functionId, key), _ => new SandboxSession(functionId, key, fingerprint));
+                await session.Lock.WaitAsync(cancellationToken);
+
+                if (session.IsEvicted)
+                {
+                    // Discarded while we waited for it; start over with a new one
+                    session.Lock.Release();
+                    continue;
+                }
+
+                if (session.Fingerprint != fingerprint || IsExpired(session, DateTime.UtcNow))
+                {
+                    Evict(session, session.Fingerprint != fingerprint ? "the function changed" : "it expired");
+                    session.Lock.Release();
+                    continue;
+                }
+
+                EnforceCapacity(session);
+                return session;
+            }
+        }
+
+        /// <summary>
+        /// Releases a session acquired with <see cref="AcquireAsync"/>
+        /// </summary>
+        /// <param name="session">Session</param>
+        /// <param name="keep">False to discard the session, e.g. because the invocation failed and may have left its state half updated</param>
+        public void Release(SandboxSession session, bool keep)
+        {
+            session.LastUsedAt = DateTime.UtcNow;
+            session.InvocationCount++;
+
+            if (!keep || session.Engine == null)
+            {
+                Evict(session, "its invocation failed");
+            }
+
+            session.Lock.Release();
+        }
+
+        /// <summary>
+        /// Discards the idle sessions that reached their idle timeout or lifetime
+        /// </summary>
+        public void EvictExpired()
+        {
+            var now = DateTime.UtcNow;
+            foreach (var session in _sessions.Values.Where(s => IsExpired(s, now)).ToList())
+            {
+                // A session in use is checked again when it is next acquired
+                if (session.Lock.Wait(0))
+                {
+                    Evict(session, "it expired");
+                    session.Lock.Release();
+                }
+            }
+        }
+
+        private void EnforceCapacity(SandboxSession current)
+        {
+            var excess = _sessions.Count - Math.Max(1, _options.MaxSessions);
+            if (excess <= 0)
+            {
+                return;
+            }
+
+            foreach (var session in _sessions.Values.Where(s => s != current).OrderBy(s => s.LastUsedAt).ToList())
+            {
+                if (excess <= 0)
+                {
+                    break;
+                }
+
+                if (session.Lock.Wait(0))
+                {
+                    Evict(session, "the session limit was reached");
+                    session.Lock.Release();
+                    excess--;
+                }
+            }
+        }
+
+        private bool IsExpired(SandboxSession session, DateTime now)
+        {
+            return now - session.LastUsedAt > TimeSpan.FromSeconds(_options.IdleTimeoutSeconds)
+                || now - session.CreatedAt > TimeSpan.FromSeconds(_options.MaxLifetimeSeconds);
+        }
+
+        private void Evict(SandboxSession session, string reason)
+        {
+            // Only the caller holding the session's lock evicts it, so it is never discarded mid-invocation
+            ((ICollection<KeyValuePair<(Guid, string), SandboxSession>>)_sessions)
+                .Remove(new KeyValuePair<(Guid, string), SandboxSession>((session.FunctionId, session.Key), session));
+
+            if (!session.IsEvicted && session.Engine != null)
+            {
+                _logger.LogInformation("Discarding sandbox session {Key} of function {FunctionId} after {Count} invocations because {Reason}",
+                    session.Key, session.FunctionId, session.InvocationCount, reason);
+            }
+
+            session.IsEvicted = true;
+            session.Engine = null;
+        }
+    }
+
+    /// <summary>
+    /// Warm sandbox pinned to a function's session key
+    /// </summary>
+    public class SandboxSession
+    {
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SandboxSession"/> class
+        /// </summary>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="key">Session key</param>
+        /// <param name="fingerprint">Hash of the code and limits the sandbox is built with</param>
+        public SandboxSession(Guid functionId, string key, string fingerprint)
+        {
+            FunctionId = functionId;
+            Key = key;
+            Fingerprint = fingerprint;
+            CreatedAt = DateTime.UtcNow;
+            LastUsedAt = CreatedAt;
+        }
+
+        /// <summary>
+        /// Gets the function ID
+        /// </summary>
+        public Guid FunctionId { get; }
+
+        /// <summary>
+        /// Gets the session key
+        /// </summary>
+        public string Key { get; }
+
+        /// <summary>
+        /// Gets the hash of the code and limits the sandbox is built with
+        /// </summary>
+        public string Fingerprint { get; }
+
+        /// <summary>
+        /// Gets when the session was created
+        /// </summary>
+        public DateTime CreatedAt { get; }
+
+        /// <summary>
+        /// Gets or sets when the last invocation in the session finished
+        /// </summary>
+        public DateTime LastUsedAt { get; set; }
+
+        /// <summary>
+        /// Gets or sets the number of invocations that ran in the session
+        /// </summary>
+        public int InvocationCount { get; set; }
+
+        /// <summary>
+        /// Gets or sets the engine holding the session's state, or null until the first invocation has built it
+        /// </summary>
+        public Engine? Engine { get; set; }
+
+        /// <summary>
+        /// Gets or sets the context of the invocation running in the session
+        /// </summary>
+        public FunctionExecutionContext? Context { get; set; }
+
+        /// <summary>
+        /// Gets or sets the token that stops the invocation running in the session
+        /// </summary>
+        public CancellationToken CancellationToken { get; set; }
+
+        /// <summary>
+        /// Gets or sets whether the session has been discarded
+        /// </summary>
+        public bool IsEvicted { get; set; }
+
+        /// <summary>
+        /// Gets the lock held by the invocation running in the session
+        /// </summary>
+        internal SemaphoreSlim Lock { get; } = new SemaphoreSlim(1, 1);
+    }
+
+    /// <summary>
+    /// Jint constraint applying the limits of whichever invocation is running in a session's engine
+    /// </summary>
+    /// <remarks>
+    /// An engine's constraints are fixed when it is built, so a session's engine gets this one instead of a meter
+    /// and cancellation token of its own, and each invocation binds its context to the session.
+    /// </remarks>
+    public class SandboxSessionConstraint : Jint.Constraint
+    {
+        private readonly SandboxSession _session;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SandboxSessionConstraint"/> class
+        /// </summary>
+        /// <param name="session">Session whose invocation is checked</param>
+        public SandboxSessionConstraint(SandboxSession session)
+        {
+            _session = session;
+        }
+
+        /// <inheritdoc/>
+        public override void Check()
+        {
+            if (_session.CancellationToken.IsCancellationRequested)
+            {
+                throw new ExecutionCanceledException();
+            }
+
+            _session.Context?.MemoryMeter?.Sample();
+            _session.Context?.GasMeter?.Charge(GasMeter.StatementCost);
+        }
+
+        /// <inheritdoc/>
+        public override void Reset()
+        {
+            // Limits belong to the invocation, not to the Execute or Invoke the engine is resetting for
+        }
+    }
+}
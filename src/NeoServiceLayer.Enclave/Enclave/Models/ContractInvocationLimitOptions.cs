@@ -0,0 +1,60 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Enclave.Enclave.Models
+{
+    /// <summary>
+    /// Options for limiting contract write invocations per target contract
+    /// </summary>
+    /// <remarks>
+    /// The limit applies across all accounts and functions so a misbehaving trigger cannot flood a contract with
+    /// transactions. The default window matches the Neo N3 block time, making the limit roughly per block.
+    /// </remarks>
+    public class ContractInvocationLimitOptions
+    {
+        /// <summary>
+        /// Gets or sets whether contract invocations are limited
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets the maximum number of invocations of one contract per window
+        /// </summary>
+        public int MaxInvocationsPerWindow { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets the window length in seconds
+        /// </summary>
+        public int WindowSeconds { get; set; } = 15;
+
+        /// <summary>
+        /// Gets or sets what happens to invocations over the limit
+        /// </summary>
+        public ContractInvocationOverflowAction OverflowAction { get; set; } = ContractInvocationOverflowAction.Queue;
+
+        /// <summary>
+        /// Gets or sets how long a queued invocation waits for room before it is dropped
+        /// </summary>
+        public int MaxQueueWaitSeconds { get; set; } = 30;
+
+        /// <summary>
+        /// Gets or sets per-contract limits that replace <see cref="MaxInvocationsPerWindow"/>, keyed by script hash
+        /// </summary>
+        public Dictionary<string, int> ContractLimits { get; set; } = new Dictionary<string, int>();
+    }
+
+    /// <summary>
+    /// What happens to contract invocations over the limit
+    /// </summary>
+    public enum ContractInvocationOverflowAction
+    {
+        /// <summary>
+        /// Wait for the next window, up to the maximum queue wait
+        /// </summary>
+        Queue,
+
+        /// <summary>
+        /// Fail the invocation at once
+        /// </summary>
+        Shed
+    }
+}
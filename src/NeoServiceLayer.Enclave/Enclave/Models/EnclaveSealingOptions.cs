@@ -0,0 +1,19 @@
+namespace NeoServiceLayer.Enclave.Enclave.Models
+{
+    /// <summary>
+    /// Options for the key the enclave seals data keys and secret values under
+    /// </summary>
+    /// <remarks>
+    /// The sealing key is derived from the master key, so every instance of an environment given the same master key
+    /// can unwrap what another instance wrapped, also after a restart. In production the key manager releases the
+    /// master key to the enclave only after verifying its attestation.
+    /// </remarks>
+    public class EnclaveSealingOptions
+    {
+        /// <summary>
+        /// Gets or sets the base64 encoded 32-byte master key; when empty a random key is generated on startup and
+        /// nothing sealed survives a restart
+        /// </summary>
+        public string MasterKey { get; set; }
+    }
+}
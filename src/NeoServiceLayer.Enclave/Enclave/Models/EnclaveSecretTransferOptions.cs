@@ -0,0 +1,35 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Enclave.Enclave.Models
+{
+    /// <summary>
+    /// Options for moving secrets between environments
+    /// </summary>
+    /// <remarks>
+    /// Secrets are only exported to the transfer key of this environment or of an environment listed here, so a
+    /// compromised host cannot have the enclave encrypt secrets to a key it holds.
+    /// </remarks>
+    public class EnclaveSecretTransferOptions
+    {
+        /// <summary>
+        /// Gets or sets the transfer keys of the environments secrets may be exported to
+        /// </summary>
+        public List<TrustedTransferKey> TrustedKeys { get; set; } = new List<TrustedTransferKey>();
+    }
+
+    /// <summary>
+    /// Transfer key of an environment secrets may be exported to
+    /// </summary>
+    public class TrustedTransferKey
+    {
+        /// <summary>
+        /// Gets or sets the environment name, such as "production"
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the base64 SubjectPublicKeyInfo the environment's enclave returns from GetTransferKey
+        /// </summary>
+        public string PublicKey { get; set; }
+    }
+}
@@ -0,0 +1,59 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Enclave.Enclave.Models
+{
+    /// <summary>
+    /// Options for the HTTP requests functions make with <c>fetch</c>
+    /// </summary>
+    /// <remarks>
+    /// Only hosts on the allowlist can be reached. Redirects are returned to the function rather than followed,
+    /// so an allowed host cannot redirect a request to one that is not.
+    /// </remarks>
+    public class SandboxFetchOptions
+    {
+        /// <summary>
+        /// Gets or sets whether functions can make HTTP requests
+        /// </summary>
+        public bool Enabled { get; set; }
+
+        /// <summary>
+        /// Gets or sets the hosts functions can reach; <c>*.example.com</c> allows every subdomain of example.com
+        /// </summary>
+        public List<string> AllowedHosts { get; set; } = new List<string>();
+
+        /// <summary>
+        /// Gets or sets whether plain HTTP is allowed as well as HTTPS
+        /// </summary>
+        public bool AllowHttp { get; set; }
+
+        /// <summary>
+        /// Gets or sets the longest one request may take, in milliseconds
+        /// </summary>
+        public int RequestTimeoutMs { get; set; } = 5000;
+
+        /// <summary>
+        /// Gets or sets the largest request body, in bytes
+        /// </summary>
+        public int MaxRequestBodyBytes { get; set; } = 64 * 1024;
+
+        /// <summary>
+        /// Gets or sets the largest response body, in bytes
+        /// </summary>
+        public int MaxResponseBytes { get; set; } = 1024 * 1024;
+
+        /// <summary>
+        /// Gets or sets the most requests one execution may make
+        /// </summary>
+        public int MaxRequestsPerExecution { get; set; } = 10;
+
+        /// <summary>
+        /// Gets or sets the most bytes one execution may send and receive across all its requests
+        /// </summary>
+        public long MaxBytesPerExecution { get; set; } = 4 * 1024 * 1024;
+
+        /// <summary>
+        /// Gets or sets the longest one execution may spend waiting on requests, in milliseconds
+        /// </summary>
+        public int MaxTimePerExecutionMs { get; set; } = 15000;
+    }
+}
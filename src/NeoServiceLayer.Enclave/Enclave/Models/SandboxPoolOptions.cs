@@ -0,0 +1,32 @@
+namespace NeoServiceLayer.Enclave.Enclave.Models
+{
+    /// <summary>
+    /// Options for the pre-warmed sandboxes and parsed scripts kept for invocations without a session key
+    /// </summary>
+    /// <remarks>
+    /// A warm sandbox has the SDK loaded and serves a single invocation, so no state carries from one invocation to
+    /// the next. Parsed scripts are kept per function version and shared by every sandbox that runs it.
+    /// </remarks>
+    public class SandboxPoolOptions
+    {
+        /// <summary>
+        /// Gets or sets whether sandboxes are pre-warmed and scripts cached; when false every invocation builds its sandbox
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets how many sandboxes are kept warm for each execution time limit that has been used
+        /// </summary>
+        public int WarmSandboxes { get; set; } = 4;
+
+        /// <summary>
+        /// Gets or sets the most sandboxes kept warm at once across all time limits
+        /// </summary>
+        public int MaxWarmSandboxes { get; set; } = 32;
+
+        /// <summary>
+        /// Gets or sets the most parsed function scripts kept at once; the least recently used makes room for a new one
+        /// </summary>
+        public int MaxCachedScripts { get; set; } = 256;
+    }
+}
@@ -0,0 +1,16 @@
+namespace NeoServiceLayer.Enclave.Enclave.Models
+{
+    /// <summary>
+    /// Options for the security limits the sandbox enforces on each execution
+    /// </summary>
+    public class SandboxSecurityOptions
+    {
+        /// <summary>
+        /// Gets or sets the maximum number of host calls one execution may make; 0 means unlimited
+        /// </summary>
+        /// <remarks>
+        /// Calls over the limit fail, and the first one is reported to the host as an excessive host calls event.
+        /// </remarks>
+        public int MaxHostCallsPerExecution { get; set; } = 500;
+    }
+}
@@ -0,0 +1,33 @@
+namespace NeoServiceLayer.Enclave.Enclave.Models
+{
+    /// <summary>
+    /// Options for the warm sandboxes kept for invocations that carry a session key
+    /// </summary>
+    /// <remarks>
+    /// A session's sandbox keeps the globals the function set in earlier invocations. It is discarded when any of
+    /// the limits below is reached, when the function's code changes or when an invocation fails; the next
+    /// invocation with the key then cold starts, so functions must treat session state as a cache they can rebuild.
+    /// </remarks>
+    public class SandboxSessionOptions
+    {
+        /// <summary>
+        /// Gets or sets whether session keys are honoured; when false every invocation cold starts
+        /// </summary>
+        public bool Enabled { get; set; } = true;
+
+        /// <summary>
+        /// Gets or sets how long a session may go without an invocation before it is discarded, in seconds
+        /// </summary>
+        public int IdleTimeoutSeconds { get; set; } = 300;
+
+        /// <summary>
+        /// Gets or sets how long a session may live however busy it is, in seconds
+        /// </summary>
+        public int MaxLifetimeSeconds { get; set; } = 3600;
+
+        /// <summary>
+        /// Gets or sets the most sessions kept at once; the least recently used idle session makes room for a new one
+        /// </summary>
+        public int MaxSessions { get; set; } = 100;
+    }
+}
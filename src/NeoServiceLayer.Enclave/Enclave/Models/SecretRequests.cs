@@ -142,4 +142,30 @@ namespace NeoServiceLayer.Enclave.Enclave.Models
         /// </summary>
         public Guid AccountId { get; set; }
     }
+
+    /// <summary>
+    /// A secret the host has granted to one function execution, still sealed by the enclave
+    /// </summary>
+    public class GrantedSecret
+    {
+        /// <summary>
+        /// Gets or sets the secret ID
+        /// </summary>
+        public Guid Id { get; set; }
+
+        /// <summary>
+        /// Gets or sets the secret name
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the sealed value
+        /// </summary>
+        public string EncryptedValue { get; set; }
+
+        /// <summary>
+        /// Gets or sets the ID of the account that owns the secret, which differs from the function's for shared secrets
+        /// </summary>
+        public Guid AccountId { get; set; }
+    }
 }
@@ -0,0 +1,51 @@
+using System.Collections.Generic;
+
+namespace NeoServiceLayer.Enclave.Enclave.Models
+{
+    /// <summary>
+    /// Options for authorizing protected TEE operations
+    /// </summary>
+    public class TeeAuthorizationOptions
+    {
+        /// <summary>
+        /// Gets or sets whether protected operations require a capability token
+        /// </summary>
+        public bool Enabled { get; set; }
+
+        /// <summary>
+        /// Gets or sets the default token lifetime in seconds
+        /// </summary>
+        public int TokenLifetimeSeconds { get; set; } = 300;
+
+        /// <summary>
+        /// Gets or sets the maximum token lifetime in seconds
+        /// </summary>
+        public int MaxTokenLifetimeSeconds { get; set; } = 900;
+
+        /// <summary>
+        /// Gets or sets the service identities allowed to request tokens
+        /// </summary>
+        public List<TeeServiceIdentity> Identities { get; set; } = new List<TeeServiceIdentity>();
+    }
+
+    /// <summary>
+    /// Service identity allowed to request capability tokens
+    /// </summary>
+    public class TeeServiceIdentity
+    {
+        /// <summary>
+        /// Gets or sets the name of the service
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets or sets the hex encoded SHA-256 hash of the service credential
+        /// </summary>
+        public string CredentialSha256 { get; set; }
+
+        /// <summary>
+        /// Gets or sets the scopes the service may request, e.g. wallet.transferGas or secrets.*
+        /// </summary>
+        public List<string> Scopes { get; set; } = new List<string>();
+    }
+}
@@ -5,6 +5,7 @@ using Microsoft.Extensions.DependencyInjection;
 using Microsoft.Extensions.Hosting;
 using Microsoft.Extensions.Logging;
 using NeoServiceLayer.Enclave.Enclave.Execution;
+using NeoServiceLayer.Enclave.Enclave.Models;
 using NeoServiceLayer.Enclave.Enclave.Services;
 
 namespace NeoServiceLayer.Enclave.Enclave
@@ -64,10 +65,28 @@ namespace NeoServiceLayer.Enclave.Enclave
                     // Add enclave services
                     services.AddSingleton<EnclaveAccountService>();
                     services.AddSingleton<EnclaveWalletService>();
+                    services.Configure<EnclaveSealingOptions>(hostContext.Configuration.GetSection("Sealing"));
+                    services.Configure<EnclaveSecretTransferOptions>(hostContext.Configuration.GetSection("SecretTransfer"));
                     services.AddSingleton<EnclaveSecretsService>();
                     services.AddSingleton<EnclavePriceFeedService>();
 
+                    // Add authorization for protected operations
+                    services.Configure<TeeAuthorizationOptions>(hostContext.Configuration.GetSection("TeeAuthorization"));
+                    services.AddSingleton<EnclaveAuthorizationService>();
+
+                    // Add signing of streamed execution results
+                    services.AddSingleton<EnclaveResultSigningService>();
+
                     // Add function execution services
+                    services.Configure<ContractInvocationLimitOptions>(hostContext.Configuration.GetSection("ContractInvocationLimits"));
+                    services.AddSingleton<ContractInvocationLimiter>();
+                    services.Configure<SandboxSecurityOptions>(hostContext.Configuration.GetSection("SandboxSecurity"));
+                    services.Configure<SandboxFetchOptions>(hostContext.Configuration.GetSection("SandboxFetch"));
+                    services.AddSingleton<SandboxFetchClient>();
+                    services.Configure<SandboxSessionOptions>(hostContext.Configuration.GetSection("SandboxSessions"));
+                    services.AddSingleton<SandboxSessionRegistry>();
+                    services.Configure<SandboxPoolOptions>(hostContext.Configuration.GetSection("SandboxPool"));
+                    services.AddSingleton<SandboxEnginePool>();
                     services.AddSingleton<NodeJsRuntime>();
                     services.AddSingleton<DotNetRuntime>();
                     services.AddSingleton<PythonRuntime>();
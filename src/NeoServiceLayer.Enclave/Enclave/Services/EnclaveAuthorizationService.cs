@@ -0,0 +1,243 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Security.Cryptography;
+using System.Text;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using NeoServiceLayer.Enclave.Enclave.Models;
+using CoreEnclaveRequest = NeoServiceLayer.Core.Models.EnclaveRequest;
+using EnclaveRequest = NeoServiceLayer.Enclave.Enclave.Models.EnclaveRequest;
+using EnclaveResponse = NeoServiceLayer.Enclave.Enclave.Models.EnclaveResponse;
+
+namespace NeoServiceLayer.Enclave.Enclave.Services
+{
+    /// <summary>
+    /// Enclave service that issues and verifies capability tokens for protected operations
+    /// </summary>
+    /// <remarks>
+    /// The signing key is generated inside the enclave on startup and never leaves it, so tokens
+    /// become invalid when the enclave restarts and callers simply request new ones.
+    /// </remarks>
+    public class EnclaveAuthorizationService
+    {
+        private readonly ILogger<EnclaveAuthorizationService> _logger;
+        private readonly TeeAuthorizationOptions _options;
+        private readonly byte[] _signingKey;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="EnclaveAuthorizationService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="options">Authorization options</param>
+        public EnclaveAuthorizationService(ILogger<EnclaveAuthorizationService> logger, IOptions<TeeAuthorizationOptions> options)
+        {
+            _logger = logger;
+            _options = options?.Value ?? new TeeAuthorizationOptions();
+            _signingKey = RandomNumberGenerator.GetBytes(32);
+
+            if (!_options.Enabled)
+            {
+                _logger.LogWarning("TEE operation authorization is disabled; protected operations are accepted without a capability token");
+            }
+        }
+
+        /// <summary>
+        /// Processes an authorization request
+        /// </summary>
+        /// <param name="request">Enclave request</param>
+        /// <returns>Enclave response</returns>
+        public async Task<EnclaveResponse> ProcessRequestAsync(EnclaveRequest request)
+        {
+            var requestId = request.RequestId ?? Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["Operation"] = request.Operation,
+                ["PayloadSize"] = request.Payload?.Length ?? 0
+            };
+
+            LoggingUtility.LogOperationStart(_logger, "ProcessAuthorizationRequest", requestId, additionalData);
+
+            try
+            {
+                var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<EnclaveAuthorizationService, byte[]>(
+                    _logger,
+                    () =>
+                    {
+                        switch (request.Operation)
+                        {
+                            case Constants.AuthOperations.IssueToken:
+                                return Task.FromResult(IssueToken(request.Payload));
+                            default:
+                                throw new InvalidOperationException($"Unknown operation: {request.Operation}");
+                        }
+                    },
+                    request.Operation,
+                    requestId,
+                    additionalData);
+
+                if (!result.success)
+                {
+                    return new EnclaveResponse
+                    {
+                        RequestId = requestId,
+                        Success = false,
+                        ErrorMessage = $"Failed to process authorization request: {request.Operation}"
+                    };
+                }
+
+                return new EnclaveResponse
+                {
+                    RequestId = requestId,
+                    Success = true,
+                    Payload = result.result
+                };
+            }
+            catch (Exception ex)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "ProcessAuthorizationRequest", requestId, ex, 0, additionalData);
+
+                return new EnclaveResponse
+                {
+                    RequestId = requestId,
+                    Success = false,
+                    ErrorMessage = ex.Message
+                };
+            }
+        }
+
+        /// <summary>
+        /// Checks whether a request may be executed
+        /// </summary>
+        /// <param name="request">Request received from the parent instance</param>
+        /// <param name="error">Reason the request is rejected</param>
+        /// <returns>True if the request is authorized</returns>
+        public bool Authorize(CoreEnclaveRequest request, out string error)
+        {
+            error = null;
+
+            if (!_options.Enabled || !CapabilityTokenUtility.IsProtected(request.ServiceType, request.Operation))
+            {
+                return true;
+            }
+
+            if (!CapabilityTokenUtility.TryValidate(request.AuthToken, _signingKey, DateTime.UtcNow, out var claims, out error))
+            {
+                _logger.LogWarning("Rejected {ServiceType}.{Operation} request {RequestId}: {Error}",
+                    request.ServiceType, request.Operation, request.RequestId, error);
+                return false;
+            }
+
+            if (!CapabilityTokenUtility.IsInScope(claims.Scopes, request.ServiceType, request.Operation))
+            {
+                error = $"Capability token does not grant {CapabilityTokenUtility.GetScope(request.ServiceType, request.Operation)}";
+                _logger.LogWarning("Rejected {ServiceType}.{Operation} request {RequestId} from {Subject}: operation not in scope",
+                    request.ServiceType, request.Operation, request.RequestId, claims.Subject);
+                return false;
+            }
+
+            return true;
+        }
+
+        private byte[] IssueToken(byte[] payload)
+        {
+            var request = JsonUtility.Deserialize<TeeTokenRequest>(payload);
+
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+            ValidationUtility.ValidateNotNullOrEmpty(request.ServiceIdentity, "ServiceIdentity");
+            ValidationUtility.ValidateNotNullOrEmpty(request.Credential, "Credential");
+            ValidationUtility.ValidateNotNullOrEmpty(request.Scopes, "Scopes");
+
+            var identity = _options.Identities.FirstOrDefault(i => i.Name == request.ServiceIdentity);
+            if (identity == null || !VerifyCredential(identity, request.Credential))
+            {
+                LoggingUtility.LogSecurityEvent(_logger, "CapabilityTokenDenied", Guid.NewGuid().ToString(),
+                    request.ServiceIdentity, "CapabilityToken", null, "Issue", "Failure",
+                    new Dictionary<string, object> { ["Reason"] = "Invalid service credential" });
+                throw new UnauthorizedAccessException("Invalid service identity or credential");
+            }
+
+            // Grant only the requested scopes the identity is allowed to hold
+            var granted = request.Scopes
+                .Where(scope => IsAllowed(identity.Scopes, scope))
+                .Distinct()
+                .ToList();
+
+            if (granted.Count == 0)
+            {
+                LoggingUtility.LogSecurityEvent(_logger, "CapabilityTokenDenied", Guid.NewGuid().ToString(),
+                    identity.Name, "CapabilityToken", null, "Issue", "Failure",
+                    new Dictionary<string, object> { ["RequestedScopes"] = string.Join(",", request.Scopes) });
+                throw new UnauthorizedAccessException($"Service {identity.Name} is not allowed any of the requested scopes");
+            }
+
+            var lifetime = request.LifetimeSeconds > 0 ? request.LifetimeSeconds : _options.TokenLifetimeSeconds;
+            lifetime = Math.Min(lifetime, _options.MaxTokenLifetimeSeconds);
+
+            var now = DateTime.UtcNow;
+            var claims = new TeeCapabilityClaims
+            {
+                TokenId = Guid.NewGuid().ToString(),
+                Subject = identity.Name,
+                Scopes = granted,
+                IssuedAt = now,
+                ExpiresAt = now.AddSeconds(lifetime)
+            };
+
+            LoggingUtility.LogSecurityEvent(_logger, "CapabilityTokenIssued", Guid.NewGuid().ToString(),
+                identity.Name, "CapabilityToken", claims.TokenId, "Issue", "Success",
+                new Dictionary<string, object>
+                {
+                    ["Scopes"] = string.Join(",", granted),
+                    ["ExpiresAt"] = claims.ExpiresAt
+                });
+
+            return JsonUtility.SerializeToUtf8Bytes(new TeeTokenResponse
+            {
+                Token = CapabilityTokenUtility.Create(claims, _signingKey),
+                Scopes = granted,
+                ExpiresAt = claims.ExpiresAt
+            });
+        }
+
+        private static bool IsAllowed(IEnumerable<string> allowedScopes, string scope)
+        {
+            var separator = scope.IndexOf('.');
+            if (separator <= 0)
+            {
+                return false;
+            }
+
+            // A wildcard scope can only be granted if the identity holds the same wildcard
+            var serviceType = scope.Substring(0, separator);
+            var operation = scope.Substring(separator + 1);
+            return operation == "*"
+                ? allowedScopes.Contains(scope)
+                : CapabilityTokenUtility.IsInScope(allowedScopes, serviceType, operation);
+        }
+
+        private static bool VerifyCredential(TeeServiceIdentity identity, string credential)
+        {
+            if (string.IsNullOrEmpty(identity.CredentialSha256))
+            {
+                return false;
+            }
+
+            byte[] expected;
+            try
+            {
+                expected = Convert.FromHexString(identity.CredentialSha256);
+            }
+            catch (FormatException)
+            {
+                return false;
+            }
+
+            var actual = SHA256.HashData(Encoding.UTF8.GetBytes(credential));
+            return CryptographicOperations.FixedTimeEquals(expected, actual);
+        }
+    }
+}
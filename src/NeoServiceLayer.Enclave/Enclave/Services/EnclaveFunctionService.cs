@@ -4,6 +4,7 @@ using System.Text.Json;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
 using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
 using NeoServiceLayer.Core.Utilities;
 using NeoServiceLayer.Core.Extensions;
 using NeoServiceLayer.Enclave.Enclave.Execution;
@@ -16,6 +17,9 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
     /// </summary>
     public class EnclaveFunctionService
     {
+        // Echoes the nonce reversed, so the smoke test's answer can only come from running it
+        private const string SmokeTestSource = "function main(params) { return 'pong:' + params.nonce.split('').reverse().join(''); }";
+
         private readonly ILogger<EnclaveFunctionService> _logger;
         private readonly FunctionExecutor _functionExecutor;
         private readonly Dictionary<Guid, FunctionMetadata> _functionCache = new Dictionary<Guid, FunctionMetadata>();
@@ -65,7 +69,9 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
                 {
                     RequestId = requestId,
                     Success = false,
-                    ErrorMessage = ex.Message
+                    ErrorMessage = ex.Message,
+                    ErrorCode = (ex as SandboxException)?.ErrorCode,
+                    GasUsed = (ex as SandboxException)?.GasUsed ?? 0
                 };
             }
         }
@@ -129,6 +135,12 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
                                 return await RegisterTimeEventAsync(payload);
                             case "triggerCustomEvent":
                                 return await TriggerCustomEventAsync(payload);
+                            case Constants.FunctionOperations.KillExecution:
+                                return KillExecution(payload);
+                            case Constants.FunctionOperations.GetExecutionMemory:
+                                return GetExecutionMemory(payload);
+                            case Constants.FunctionOperations.SmokeTest:
+                                return await SmokeTestAsync(payload);
                             default:
                                 throw new InvalidOperationException($"Unknown operation: {operation}");
                         }
@@ -178,7 +190,7 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
                 }
 
                 // Execute the function
-                var result = await _functionExecutor.ExecuteAsync(functionMetadata, request.Parameters);
+                var result = await _functionExecutor.ExecuteAsync(functionMetadata, request.Parameters, request.ExecutionId, null, request.DeniedPriceSymbols, request.Secrets, request.GasLimit, request.SessionKey, request.GasPrices);
 
                 // Create response
                 var response = new
@@ -200,11 +212,79 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             }
         }
 
+        /// <summary>
+        /// Executes a function whose parameters arrived as a stream of input chunks
+        /// </summary>
+        /// <param name="payload">Serialized execute request; its parameters, if any, are overridden by the input</param>
+        /// <param name="input">Concatenated input chunks holding a JSON object of parameters, or empty</param>
+        /// <param name="onLog">Callback invoked for each log line as the function writes it</param>
+        /// <returns>The serialized execution result</returns>
+        public async Task<byte[]> ExecuteStreamAsync(byte[] payload, byte[] input, Action<string> onLog)
+        {
+            // Parse the request payload
+            var request = JsonUtility.Deserialize<ExecuteRequest>(payload);
+
+            // Validate request
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+            ValidationUtility.ValidateGuid(request.FunctionId, "Function ID");
+
+            var parameters = request.Parameters ?? new Dictionary<string, object>();
+            if (input != null && input.Length > 0)
+            {
+                var streamedParameters = JsonUtility.Deserialize<Dictionary<string, object>>(input);
+                foreach (var parameter in streamedParameters)
+                {
+                    parameters[parameter.Key] = parameter.Value;
+                }
+            }
+
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["FunctionId"] = request.FunctionId,
+                ["ParameterCount"] = parameters.Count,
+                ["InputSize"] = input?.Length ?? 0
+            };
+
+            LoggingUtility.LogOperationStart(_logger, "ExecuteFunctionStream", requestId, additionalData);
+
+            try
+            {
+                if (!_functionCache.TryGetValue(request.FunctionId, out var functionMetadata))
+                {
+                    throw new Exception($"Function with ID {request.FunctionId} not found in cache");
+                }
+
+                var result = await _functionExecutor.ExecuteAsync(functionMetadata, parameters, request.ExecutionId, onLog, request.DeniedPriceSymbols, request.Secrets, request.GasLimit, request.SessionKey, request.GasPrices);
+
+                var response = new
+                {
+                    FunctionId = request.FunctionId,
+                    Result = result,
+                    Timestamp = DateTime.UtcNow
+                };
+
+                LoggingUtility.LogOperationSuccess(_logger, "ExecuteFunctionStream", requestId, 0, additionalData);
+
+                return JsonUtility.SerializeToUtf8Bytes(response);
+            }
+            catch (Exception ex)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "ExecuteFunctionStream", requestId, ex, 0, additionalData);
+                throw;
+            }
+        }
+
         /// <summary>
         /// Request model for executing a function
         /// </summary>
         private class ExecuteRequest
         {
+            /// <summary>
+            /// Gets or sets the execution ID assigned by the host
+            /// </summary>
+            public Guid ExecutionId { get; set; }
+
             /// <summary>
             /// Gets or sets the function ID
             /// </summary>
@@ -219,6 +299,143 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             /// Gets or sets the function parameters
             /// </summary>
             public Dictionary<string, object> Parameters { get; set; }
+
+            /// <summary>
+            /// Gets or sets the price feed symbols above the account's subscription tier
+            /// </summary>
+            public List<string> DeniedPriceSymbols { get; set; }
+
+            /// <summary>
+            /// Gets or sets the sealed secrets granted to the function
+            /// </summary>
+            public List<GrantedSecret> Secrets { get; set; }
+
+            /// <summary>
+            /// Gets or sets the gas units the execution may use, or 0 to run unmetered
+            /// </summary>
+            public long GasLimit { get; set; }
+
+            /// <summary>
+            /// Gets or sets the key of the session whose warm sandbox the execution runs in, or null to cold start
+            /// </summary>
+            public string SessionKey { get; set; }
+
+            /// <summary>
+            /// Gets or sets the host's fresh gas prices of Neo and the external chains
+            /// </summary>
+            public List<NeoServiceLayer.Core.Models.ChainGasPrice> GasPrices { get; set; }
+        }
+
+        private byte[] KillExecution(byte[] payload)
+        {
+            // Parse the request payload
+            var request = JsonUtility.Deserialize<KillExecutionRequest>(payload);
+
+            // Validate request
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+            ValidationUtility.ValidateGuid(request.ExecutionId, "Execution ID");
+
+            var killed = _functionExecutor.CancelExecution(request.ExecutionId);
+            _logger.LogInformation("Kill requested for execution: {ExecutionId}, Found: {Found}", request.ExecutionId, killed);
+
+            return JsonUtility.SerializeToUtf8Bytes(new NeoServiceLayer.Core.Models.ExecutionKillResult
+            {
+                ExecutionId = request.ExecutionId,
+                Killed = killed,
+                Timestamp = DateTime.UtcNow
+            });
+        }
+
+        private byte[] GetExecutionMemory(byte[] payload)
+        {
+            // Parse the request payload
+            var request = JsonUtility.Deserialize<GetExecutionMemoryRequest>(payload);
+
+            // Validate request
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+
+            // Executions that are no longer running are left out, so the host keeps their last reported usage
+            var usage = new List<NeoServiceLayer.Core.Models.ExecutionMemoryUsage>();
+            foreach (var executionId in request.ExecutionIds ?? new List<Guid>())
+            {
+                var used = _functionExecutor.GetMemoryUsed(executionId);
+                if (used.HasValue)
+                {
+                    usage.Add(new NeoServiceLayer.Core.Models.ExecutionMemoryUsage
+                    {
+                        ExecutionId = executionId,
+                        MemoryUsageMb = used.Value / (1024.0 * 1024)
+                    });
+                }
+            }
+
+            return JsonUtility.SerializeToUtf8Bytes(usage);
+        }
+
+        /// <summary>
+        /// Request model for killing a running execution
+        /// </summary>
+        private class KillExecutionRequest
+        {
+            /// <summary>
+            /// Gets or sets the execution ID
+            /// </summary>
+            public Guid ExecutionId { get; set; }
+        }
+
+        /// <summary>
+        /// Request model for the memory of running executions
+        /// </summary>
+        private class GetExecutionMemoryRequest
+        {
+            /// <summary>
+            /// Gets or sets the IDs of the executions to report on
+            /// </summary>
+            public List<Guid> ExecutionIds { get; set; }
+        }
+
+        private async Task<byte[]> SmokeTestAsync(byte[] payload)
+        {
+            // Parse the request payload
+            var request = JsonUtility.Deserialize<SmokeTestRequest>(payload);
+
+            // Validate request
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+            ValidationUtility.ValidateNotNullOrEmpty(request.Nonce, "Nonce");
+
+            // The function is compiled and run like a deployed one but never cached, so it cannot be invoked by ID
+            var metadata = new FunctionMetadata
+            {
+                Id = Guid.NewGuid(),
+                Name = "smoke-test",
+                Runtime = "javascript",
+                SourceCode = SmokeTestSource,
+                EntryPoint = "main",
+                MaxExecutionTime = 5000,
+                MaxMemory = 32,
+                CreatedAt = DateTime.UtcNow,
+                UpdatedAt = DateTime.UtcNow
+            };
+
+            await _functionExecutor.ValidateAndCompileAsync(metadata);
+            var result = await _functionExecutor.ExecuteAsync(metadata, new Dictionary<string, object> { ["nonce"] = request.Nonce }, Guid.NewGuid());
+
+            return JsonUtility.SerializeToUtf8Bytes(new
+            {
+                Result = result,
+                Timestamp = DateTime.UtcNow
+            });
+        }
+
+        /// <summary>
+        /// Request model for the sandbox smoke test
+        /// </summary>
+        private class SmokeTestRequest
+        {
+            /// <summary>
+            /// Gets or sets the value the function echoes
+            /// </summary>
+            public string Nonce { get; set; } = string.Empty;
         }
 
         private async Task<byte[]> CreateFunctionAsync(byte[] payload)
@@ -905,7 +1122,7 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
                 };
 
                 // Execute the function
-                var result = await _functionExecutor.ExecuteForEventAsync(functionMetadata, eventObj);
+                var result = await _functionExecutor.ExecuteForEventAsync(functionMetadata, eventObj, request.DeniedPriceSymbols, request.Secrets, request.GasLimit, request.GasPrices);
 
                 // Create response
                 var response = new
@@ -952,6 +1169,26 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             /// Gets or sets the event
             /// </summary>
             public EventModel Event { get; set; }
+
+            /// <summary>
+            /// Gets or sets the price feed symbols above the account's subscription tier
+            /// </summary>
+            public List<string> DeniedPriceSymbols { get; set; }
+
+            /// <summary>
+            /// Gets or sets the sealed secrets granted to the function
+            /// </summary>
+            public List<GrantedSecret> Secrets { get; set; }
+
+            /// <summary>
+            /// Gets or sets the gas units the execution may use, or 0 to run unmetered
+            /// </summary>
+            public long GasLimit { get; set; }
+
+            /// <summary>
+            /// Gets or sets the host's fresh gas prices of Neo and the external chains
+            /// </summary>
+            public List<NeoServiceLayer.Core.Models.ChainGasPrice> GasPrices { get; set; }
         }
 
         /// <summary>
@@ -115,6 +115,7 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
                             "validateSource" => await ValidateSourceAsync(request),
                             "submitToOracle" => await SubmitToOracleAsync(request),
                             "submitBatchToOracle" => await SubmitBatchToOracleAsync(request),
+                            "deployContract" => await DeployContractAsync(request),
                             _ => throw new NotSupportedException($"Operation not supported: {operation}")
                         };
                     },
@@ -213,6 +214,72 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             }
         }
 
+        private async Task<object> DeployContractAsync(object request)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>();
+
+            LoggingUtility.LogOperationStart(_logger, "DeployPriceFeedContract", requestId, additionalData);
+
+            try
+            {
+                var requestData = JsonUtility.Deserialize<JsonElement>(JsonUtility.Serialize(request));
+                ValidationUtility.ValidateNotNull(requestData, nameof(requestData));
+
+                var operation = requestData.TryGetProperty("Operation", out var operationElement) ? operationElement.GetString() : null;
+                var contractHash = requestData.TryGetProperty("ContractHash", out var contractHashElement) && contractHashElement.ValueKind == JsonValueKind.String ?
+                    contractHashElement.GetString() : null;
+                var nef = requestData.TryGetProperty("Nef", out var nefElement) ? nefElement.GetString() : null;
+                var manifest = requestData.TryGetProperty("Manifest", out var manifestElement) ? manifestElement.GetString() : null;
+
+                if (operation != "deploy" && operation != "update")
+                {
+                    throw new ArgumentException("Operation must be deploy or update");
+                }
+
+                if (operation == "update")
+                {
+                    ValidationUtility.ValidateNotNullOrEmpty(contractHash, "Contract hash");
+                }
+
+                ValidationUtility.ValidateNotNullOrEmpty(nef, "NEF");
+                ValidationUtility.ValidateNotNullOrEmpty(manifest, "Manifest");
+
+                var walletId = requestData.TryGetProperty("WalletId", out var walletIdElement) ?
+                    Guid.Parse(walletIdElement.GetString()) : Guid.Empty;
+                var accountId = requestData.TryGetProperty("AccountId", out var accountIdElement) ?
+                    Guid.Parse(accountIdElement.GetString()) : Guid.Empty;
+                var password = requestData.TryGetProperty("Password", out var passwordElement) ?
+                    passwordElement.GetString() : null;
+                var network = requestData.TryGetProperty("Network", out var networkElement) ?
+                    networkElement.GetString() : "MainNet";
+
+                ValidationUtility.ValidateGuid(walletId, "Wallet ID");
+                ValidationUtility.ValidateGuid(accountId, "Account ID");
+                ValidationUtility.ValidateNotNullOrEmpty(password, "Password");
+
+                additionalData["Operation"] = operation;
+                additionalData["ContractHash"] = contractHash;
+                additionalData["WalletId"] = walletId;
+                additionalData["Network"] = network;
+
+                var wallet = await RetrieveWalletAsync(walletId, accountId, password);
+
+                var transactionHash = await SubmitContractDeploymentAsync(wallet, operation, contractHash, Convert.FromBase64String(nef), manifest, network);
+
+                additionalData["TransactionHash"] = transactionHash;
+
+                LoggingUtility.LogOperationSuccess(_logger, "DeployPriceFeedContract", requestId, 0, additionalData);
+
+                return new { TransactionHash = transactionHash };
+            }
+            catch (Exception ex)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "DeployPriceFeedContract", requestId, ex, 0, additionalData);
+                throw;
+            }
+        }
+
         private async Task<List<string>> SubmitBatchToOracleAsync(object request)
         {
             _logger.LogInformation("Submitting batch of prices to oracle");
@@ -885,6 +952,35 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             return transactionHash;
         }
 
+        private async Task<string> SubmitContractDeploymentAsync(Wallet wallet, string operation, string contractHash, byte[] nef, string manifest, string network)
+        {
+            // In a production environment, this would use the Neo SDK to build and send the deployment
+            // For now, we'll simulate submission with a placeholder transaction hash, as for price submissions
+
+            _logger.LogInformation("Submitting PriceFeed contract {Operation}: ContractHash: {ContractHash}, NefSize: {NefSize}, Network: {Network}",
+                operation, contractHash, nef.Length, network);
+
+            if (wallet == null || string.IsNullOrEmpty(wallet.Address) || string.IsNullOrEmpty(wallet.PrivateKey))
+            {
+                throw new ArgumentException("Invalid wallet data");
+            }
+
+            if (string.IsNullOrEmpty(network) || (network != "MainNet" && network != "TestNet"))
+            {
+                throw new ArgumentException("Invalid network");
+            }
+
+            // In a real implementation, this would:
+            // 1. For a deploy, call ContractManagement.deploy(nef, manifest) with the wallet as sender
+            // 2. For an update, call update(nef, manifest, null) on the contract, witnessed by the owner
+            // 3. Sign the transaction with the wallet's private key and send it
+            // 4. Return the transaction hash
+
+            await Task.Delay(100);
+
+            return "0x" + Guid.NewGuid().ToString("N");
+        }
+
         /// <summary>
         /// Wallet model for price feed operations
         /// </summary>
@@ -0,0 +1,82 @@
+using System;
+using System.Security.Cryptography;
+using System.Text;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Enclave.Enclave.Services
+{
+    /// <summary>
+    /// Enclave service that signs execution results so callers can tell they were produced inside the enclave
+    /// </summary>
+    /// <remarks>
+    /// The ECDSA P-256 key is generated inside the enclave on startup and never leaves it. Callers obtain the
+    /// public key from a result frame and should pin it against the key reported in the attestation document.
+    /// </remarks>
+    public class EnclaveResultSigningService : IDisposable
+    {
+        private readonly ILogger<EnclaveResultSigningService> _logger;
+        private readonly ECDsa _signingKey;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="EnclaveResultSigningService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        public EnclaveResultSigningService(ILogger<EnclaveResultSigningService> logger)
+        {
+            _logger = logger;
+            _signingKey = ECDsa.Create(ECCurve.NamedCurves.nistP256);
+            PublicKey = Convert.ToBase64String(_signingKey.ExportSubjectPublicKeyInfo());
+
+            _logger.LogInformation("Generated enclave result signing key");
+        }
+
+        /// <summary>
+        /// Gets the base64 SubjectPublicKeyInfo of the signing key
+        /// </summary>
+        public string PublicKey { get; }
+
+        /// <summary>
+        /// Creates a signed result frame
+        /// </summary>
+        /// <param name="requestId">The request ID the result answers</param>
+        /// <param name="data">The serialized result</param>
+        /// <param name="sequence">Sequence number of the frame</param>
+        /// <returns>The result frame</returns>
+        public EnclaveStreamFrame CreateResultFrame(string requestId, byte[] data, long sequence)
+        {
+            var signature = _signingKey.SignData(
+                EnclaveStreamUtility.GetSignedContent(requestId, data),
+                HashAlgorithmName.SHA256);
+
+            return new EnclaveStreamFrame
+            {
+                RequestId = requestId,
+                Type = EnclaveStreamFrameTypes.Result,
+                Sequence = sequence,
+                Data = data,
+                Signature = Convert.ToBase64String(signature),
+                PublicKey = PublicKey
+            };
+        }
+
+        /// <summary>
+        /// Signs the digest of a build provenance statement
+        /// </summary>
+        /// <param name="statementDigest">Hex SHA-256 digest of the statement</param>
+        /// <returns>The base64 signature over the provenance prefix followed by the digest</returns>
+        public string SignProvenance(string statementDigest)
+        {
+            var content = Encoding.UTF8.GetBytes(Constants.AttestationOperations.ProvenanceSignaturePrefix + statementDigest);
+            return Convert.ToBase64String(_signingKey.SignData(content, HashAlgorithmName.SHA256));
+        }
+
+        /// <inheritdoc/>
+        public void Dispose()
+        {
+            _signingKey.Dispose();
+        }
+    }
+}
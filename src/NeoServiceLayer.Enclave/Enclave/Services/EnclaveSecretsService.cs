@@ -1,12 +1,17 @@
 using System;
 using System.Collections.Generic;
+using System.Linq;
+using System.Security.Cryptography;
+using System.Text;
 using System.Text.Json;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
 using NeoServiceLayer.Core;
 using NeoServiceLayer.Core.Utilities;
 using NeoServiceLayer.Core.Extensions;
 using NeoServiceLayer.Enclave.Enclave.Models;
+using CoreModels = NeoServiceLayer.Core.Models;
 
 namespace NeoServiceLayer.Enclave.Enclave.Services
 {
@@ -15,15 +20,51 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
     /// </summary>
     public class EnclaveSecretsService
     {
+        private const string TransferFormat = "nsl-secrets-transfer/1";
+        private const string TransferAlgorithm = "ECDH-P256+SHA256+AES-256-GCM";
+
+        private const string SealingKeyInfo = "nsl-sealing/1";
+
         private readonly ILogger<EnclaveSecretsService> _logger;
+        private readonly byte[] _sealingKey;
+        private readonly ECDiffieHellman _transferKey;
+        private readonly string _transferKeyId;
+        private readonly Dictionary<string, string> _trustedTransferKeys = new Dictionary<string, string>(StringComparer.Ordinal);
 
         /// <summary>
         /// Initializes a new instance of the <see cref="EnclaveSecretsService"/> class
         /// </summary>
         /// <param name="logger">Logger</param>
-        public EnclaveSecretsService(ILogger<EnclaveSecretsService> logger)
+        /// <param name="sealingOptions">Sealing options; without a master key nothing sealed survives a restart</param>
+        /// <param name="transferOptions">Secret transfer options; without trusted keys secrets can only be exported to this environment</param>
+        /// <exception cref="ArgumentException">The master key or a trusted transfer key is invalid</exception>
+        public EnclaveSecretsService(
+            ILogger<EnclaveSecretsService> logger,
+            IOptions<EnclaveSealingOptions> sealingOptions = null,
+            IOptions<EnclaveSecretTransferOptions> transferOptions = null)
         {
             _logger = logger;
+
+            var masterKey = ReadMasterKey(sealingOptions?.Value?.MasterKey);
+            if (masterKey == null)
+            {
+                _logger.LogWarning("No sealing master key is configured; data keys and secret values sealed by this enclave cannot be unsealed after it restarts");
+                masterKey = EncryptionUtility.GenerateRandomKey();
+            }
+
+            _sealingKey = HKDF.DeriveKey(HashAlgorithmName.SHA256, masterKey, 32, info: Encoding.UTF8.GetBytes(SealingKeyInfo));
+
+            // Derived like the sealing key, so every instance of the environment has the same transfer key and bundles
+            // exported to it stay importable after a restart
+            _transferKey = DeriveTransferPrivateKey(masterKey);
+            _transferKeyId = GetTransferKeyId(_transferKey.ExportSubjectPublicKeyInfo());
+            _trustedTransferKeys[_transferKeyId] = "this environment";
+
+            foreach (var trusted in transferOptions?.Value?.TrustedKeys ?? new List<TrustedTransferKey>())
+            {
+                using var key = ImportTransferPublicKey(trusted?.PublicKey, $"Trusted transfer key {trusted?.Name}");
+                _trustedTransferKeys[GetTransferKeyId(key.ExportSubjectPublicKeyInfo())] = trusted.Name;
+            }
         }
 
         /// <summary>
@@ -100,6 +141,16 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
                                 return await DeleteSecretAsync(payload);
                             case Constants.SecretsOperations.UpdateSecretValue:
                                 return await UpdateValueAsync(payload);
+                            case Constants.SecretsOperations.GenerateDataKey:
+                                return GenerateDataKey(payload);
+                            case Constants.SecretsOperations.DecryptDataKey:
+                                return DecryptDataKey(payload);
+                            case Constants.SecretsOperations.GetTransferKey:
+                                return GetTransferKey();
+                            case Constants.SecretsOperations.ExportSecrets:
+                                return ExportSecrets(payload);
+                            case Constants.SecretsOperations.ImportSecrets:
+                                return ImportSecrets(payload);
                             default:
                                 throw new InvalidOperationException($"Unknown operation: {operation}");
                         }
@@ -136,11 +187,8 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             // Generate a unique ID for the secret
             var secretId = Guid.NewGuid();
 
-            // Generate a unique encryption key for this secret
-            var encryptionKey = EncryptionUtility.GenerateRandomKey();
-
-            // Encrypt the secret value
-            var encryptedValue = EncryptionUtility.EncryptWithKey(request.Value, encryptionKey);
+            // Seal the secret value to this secret and account
+            var encryptedValue = SealSecretValue(secretId, request.AccountId, request.Value);
 
             // Create the secret object
             var secret = new Secret
@@ -161,13 +209,13 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             };
 
             // Store the secret in the secure storage
-            await StoreSecretAsync(secret, encryptionKey);
+            await StoreSecretAsync(secret);
 
             // Log the creation (without sensitive data)
             LoggingUtility.LogSecurityEvent(_logger, "SecretCreated", Guid.NewGuid().ToString(),
                 request.AccountId.ToString(), "Secret", secretId.ToString(), "Create", "Success");
 
-            // Create response (without sensitive data)
+            // Create response (only the sealed value leaves the enclave)
             var response = new
             {
                 Id = secret.Id,
@@ -176,6 +224,7 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
                 AccountId = secret.AccountId,
                 AllowedFunctionIds = secret.AllowedFunctionIds,
                 Tags = secret.Tags,
+                EncryptedValue = secret.EncryptedValue,
                 Version = secret.Version,
                 CreatedAt = secret.CreatedAt,
                 UpdatedAt = secret.UpdatedAt,
@@ -189,17 +238,331 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
 
         // These methods are now replaced by EncryptionUtility
 
-        private async Task StoreSecretAsync(Secret secret, byte[] encryptionKey)
+        private async Task StoreSecretAsync(Secret secret)
         {
             // Store the secret in the secure storage
             // In a production environment, this would use a secure storage mechanism
             // such as a hardware security module (HSM) or a secure database
 
-            // For now, we'll simulate storage
+            // For now, we'll simulate storage; the host persists the sealed value it is returned
             await Task.Delay(10); // Placeholder for actual storage operation
+        }
+
+        /// <summary>
+        /// Unseals the value of a secret the host granted to a function execution
+        /// </summary>
+        /// <param name="encryptedValue">Sealed value, as returned when the secret was created or updated</param>
+        /// <param name="secretId">Secret ID the value was granted under</param>
+        /// <param name="accountId">Account that owns the executing function</param>
+        /// <returns>The plaintext value</returns>
+        /// <exception cref="UnauthorizedAccessException">The value was sealed for another secret or account</exception>
+        public virtual string UnsealSecretValue(string encryptedValue, Guid secretId, Guid accountId)
+        {
+            ValidationUtility.ValidateNotNullOrEmpty(encryptedValue, "Encrypted value");
+
+            var sealedValue = JsonSerializer.Deserialize<SealedSecretValue>(EncryptionUtility.DecryptWithKey(encryptedValue, _sealingKey));
+            if (sealedValue == null || sealedValue.SecretId != secretId || sealedValue.AccountId != accountId)
+            {
+                LoggingUtility.LogSecurityEvent(_logger, "SecretAccessDenied", Guid.NewGuid().ToString(),
+                    accountId.ToString(), "Secret", secretId.ToString(), "Unseal", "Denied");
+
+                throw new UnauthorizedAccessException("Secret value does not belong to this secret and account");
+            }
+
+            return sealedValue.Value;
+        }
+
+        // The secret and account IDs are sealed with the value so a sealed value cannot be replayed under another secret
+        private string SealSecretValue(Guid secretId, Guid accountId, string value)
+        {
+            var sealedValue = JsonSerializer.Serialize(new SealedSecretValue
+            {
+                SecretId = secretId,
+                AccountId = accountId,
+                Value = value
+            });
+
+            return EncryptionUtility.EncryptWithKey(sealedValue, _sealingKey);
+        }
+
+        private byte[] GenerateDataKey(byte[] payload)
+        {
+            var request = JsonUtility.Deserialize<DataKeyRequest>(payload);
+
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+            ValidationUtility.ValidateGuid(request.AccountId, "Account ID");
+
+            var dataKey = EncryptionUtility.GenerateRandomKey();
+
+            // The account ID is sealed with the key so a wrapped key cannot be replayed for another account
+            var sealedKey = JsonSerializer.Serialize(new SealedDataKey
+            {
+                AccountId = request.AccountId,
+                Key = Convert.ToBase64String(dataKey)
+            });
+
+            LoggingUtility.LogSecurityEvent(_logger, "DataKeyGenerated", Guid.NewGuid().ToString(),
+                request.AccountId.ToString(), "DataKey", request.AccountId.ToString(), "Generate", "Success");
+
+            return JsonUtility.SerializeToUtf8Bytes(new
+            {
+                Plaintext = Convert.ToBase64String(dataKey),
+                Ciphertext = EncryptionUtility.EncryptWithKey(sealedKey, _sealingKey)
+            });
+        }
+
+        private byte[] DecryptDataKey(byte[] payload)
+        {
+            var request = JsonUtility.Deserialize<DataKeyRequest>(payload);
+
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+            ValidationUtility.ValidateGuid(request.AccountId, "Account ID");
+            ValidationUtility.ValidateNotNullOrEmpty(request.Ciphertext, "Ciphertext");
+
+            var sealedKey = JsonSerializer.Deserialize<SealedDataKey>(EncryptionUtility.DecryptWithKey(request.Ciphertext, _sealingKey));
+            if (sealedKey == null || sealedKey.AccountId != request.AccountId)
+            {
+                LoggingUtility.LogSecurityEvent(_logger, "DataKeyAccessDenied", Guid.NewGuid().ToString(),
+                    request.AccountId.ToString(), "DataKey", request.AccountId.ToString(), "Decrypt", "Denied");
+
+                throw new UnauthorizedAccessException("Data key does not belong to this account");
+            }
+
+            return JsonUtility.SerializeToUtf8Bytes(new
+            {
+                Plaintext = sealedKey.Key,
+                Ciphertext = request.Ciphertext
+            });
+        }
 
-            // In a real implementation, we would also securely store the encryption key
-            // associated with this secret, possibly using a key management service
+        private byte[] GetTransferKey()
+        {
+            var publicKey = _transferKey.ExportSubjectPublicKeyInfo();
+
+            return JsonUtility.SerializeToUtf8Bytes(new CoreModels.SecretTransferKey
+            {
+                KeyId = _transferKeyId,
+                PublicKey = Convert.ToBase64String(publicKey),
+                Algorithm = TransferAlgorithm
+            });
+        }
+
+        private byte[] ExportSecrets(byte[] payload)
+        {
+            var request = JsonUtility.Deserialize<CoreModels.SecretExportRequest>(payload);
+
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+            ValidationUtility.ValidateGuid(request.AccountId, "Account ID");
+            ValidationUtility.ValidateNotNullOrEmpty(request.RecipientPublicKey, "Recipient public key");
+
+            if (request.Secrets == null || request.Secrets.Count == 0)
+            {
+                throw new ArgumentException("At least one secret is required");
+            }
+
+            using var recipientKey = ImportTransferPublicKey(request.RecipientPublicKey, "Recipient public key");
+            var recipientKeyId = GetTransferKeyId(recipientKey.ExportSubjectPublicKeyInfo());
+            if (!_trustedTransferKeys.TryGetValue(recipientKeyId, out var recipientName))
+            {
+                LoggingUtility.LogSecurityEvent(_logger, "SecretsExportDenied", Guid.NewGuid().ToString(),
+                    request.AccountId.ToString(), "Secret", recipientKeyId, "Export", "Denied");
+
+                throw new UnauthorizedAccessException("The recipient public key is not the transfer key of a trusted environment");
+            }
+
+            // Values are unsealed only here and leave the enclave encrypted to the recipient
+            var entries = request.Secrets.Select(s => new TransferredSecret
+            {
+                Name = s.Name,
+                Description = s.Description,
+                ExpiresAt = s.ExpiresAt,
+                Value = UnsealSecretValue(s.EncryptedValue, s.Id, request.AccountId)
+            }).ToList();
+
+            using var ephemeralKey = ECDiffieHellman.Create(ECCurve.NamedCurves.nistP256);
+            var bundle = new CoreModels.SecretTransferBundle
+            {
+                Format = TransferFormat,
+                RecipientKeyId = recipientKeyId,
+                EphemeralPublicKey = Convert.ToBase64String(ephemeralKey.ExportSubjectPublicKeyInfo()),
+                Names = entries.Select(e => e.Name).ToList(),
+                ExportedAt = DateTime.UtcNow
+            };
+
+            var plaintext = JsonSerializer.SerializeToUtf8Bytes(entries);
+            var nonce = RandomNumberGenerator.GetBytes(12);
+            var ciphertext = new byte[plaintext.Length];
+            var tag = new byte[16];
+
+            using (var aes = new AesGcm(DeriveTransferKey(ephemeralKey, recipientKey.PublicKey)))
+            {
+                aes.Encrypt(nonce, plaintext, ciphertext, tag, GetTransferAssociatedData(bundle));
+            }
+
+            CryptographicOperations.ZeroMemory(plaintext);
+
+            bundle.Nonce = Convert.ToBase64String(nonce);
+            bundle.Ciphertext = Convert.ToBase64String(ciphertext);
+            bundle.Tag = Convert.ToBase64String(tag);
+
+            LoggingUtility.LogSecurityEvent(_logger, "SecretsExported", Guid.NewGuid().ToString(),
+                request.AccountId.ToString(), "Secret", bundle.RecipientKeyId, "Export", "Success");
+            _logger.LogInformation("Exported {Count} secrets to {Environment}, AccountId: {AccountId}", entries.Count, recipientName, request.AccountId);
+
+            return JsonUtility.SerializeToUtf8Bytes(bundle);
+        }
+
+        private byte[] ImportSecrets(byte[] payload)
+        {
+            var request = JsonUtility.Deserialize<CoreModels.SecretImportRequest>(payload);
+
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+            ValidationUtility.ValidateGuid(request.AccountId, "Account ID");
+            ValidationUtility.ValidateNotNull(request.Bundle, "Bundle");
+
+            var bundle = request.Bundle;
+            if (bundle.Format != TransferFormat)
+            {
+                throw new ArgumentException($"Unsupported bundle format: {bundle.Format}");
+            }
+
+            if (bundle.RecipientKeyId != _transferKeyId)
+            {
+                LoggingUtility.LogSecurityEvent(_logger, "SecretsImportDenied", Guid.NewGuid().ToString(),
+                    request.AccountId.ToString(), "Secret", bundle.RecipientKeyId, "Import", "Denied");
+
+                throw new UnauthorizedAccessException("The bundle was exported to another environment's transfer key");
+            }
+
+            List<TransferredSecret> entries;
+            using (var ephemeralKey = ImportTransferPublicKey(bundle.EphemeralPublicKey, "Ephemeral public key"))
+            {
+                byte[] plaintext;
+                try
+                {
+                    var ciphertext = Convert.FromBase64String(bundle.Ciphertext ?? string.Empty);
+                    plaintext = new byte[ciphertext.Length];
+
+                    using var aes = new AesGcm(DeriveTransferKey(_transferKey, ephemeralKey.PublicKey));
+                    aes.Decrypt(
+                        Convert.FromBase64String(bundle.Nonce ?? string.Empty),
+                        ciphertext,
+                        Convert.FromBase64String(bundle.Tag ?? string.Empty),
+                        plaintext,
+                        GetTransferAssociatedData(bundle));
+                }
+                catch (Exception ex) when (ex is CryptographicException || ex is FormatException || ex is ArgumentException)
+                {
+                    LoggingUtility.LogSecurityEvent(_logger, "SecretsImportDenied", Guid.NewGuid().ToString(),
+                        request.AccountId.ToString(), "Secret", bundle.RecipientKeyId, "Import", "Denied");
+
+                    throw new UnauthorizedAccessException("The bundle was modified or was not encrypted to this environment");
+                }
+
+                entries = JsonSerializer.Deserialize<List<TransferredSecret>>(plaintext);
+                CryptographicOperations.ZeroMemory(plaintext);
+            }
+
+            var imported = new List<CoreModels.SecretImportEntry>();
+            foreach (var entry in entries)
+            {
+                if (request.SecretIds == null || !request.SecretIds.TryGetValue(entry.Name, out var secretId))
+                {
+                    throw new ArgumentException($"No secret ID was given for {entry.Name}");
+                }
+
+                imported.Add(new CoreModels.SecretImportEntry
+                {
+                    SecretId = secretId,
+                    Name = entry.Name,
+                    Description = entry.Description,
+                    ExpiresAt = entry.ExpiresAt,
+                    EncryptedValue = SealSecretValue(secretId, request.AccountId, entry.Value)
+                });
+            }
+
+            LoggingUtility.LogSecurityEvent(_logger, "SecretsImported", Guid.NewGuid().ToString(),
+                request.AccountId.ToString(), "Secret", bundle.RecipientKeyId, "Import", "Success");
+
+            return JsonUtility.SerializeToUtf8Bytes(imported);
+        }
+
+        private static byte[] ReadMasterKey(string masterKey)
+        {
+            if (string.IsNullOrWhiteSpace(masterKey))
+            {
+                return null;
+            }
+
+            var key = new byte[masterKey.Length];
+            if (Convert.TryFromBase64String(masterKey.Trim(), key, out var length) && length == 32)
+            {
+                return key[..32];
+            }
+
+            throw new ArgumentException("The sealing master key must be a base64 encoded 32-byte key", nameof(masterKey));
+        }
+
+        private static ECDiffieHellman DeriveTransferPrivateKey(byte[] masterKey)
+        {
+            // A derived scalar that is not a valid P-256 private key is vanishingly rare; derive the next one if so
+            for (var counter = 0; ; counter++)
+            {
+                var d = HKDF.DeriveKey(HashAlgorithmName.SHA256, masterKey, 32, info: Encoding.UTF8.GetBytes($"{TransferFormat}/{counter}"));
+                try
+                {
+                    return ECDiffieHellman.Create(new ECParameters { Curve = ECCurve.NamedCurves.nistP256, D = d });
+                }
+                catch (CryptographicException) when (counter < 16)
+                {
+                    continue;
+                }
+                finally
+                {
+                    CryptographicOperations.ZeroMemory(d);
+                }
+            }
+        }
+
+        private static ECDiffieHellman ImportTransferPublicKey(string publicKey, string parameterName)
+        {
+            var key = ECDiffieHellman.Create();
+            try
+            {
+                key.ImportSubjectPublicKeyInfo(Convert.FromBase64String(publicKey ?? string.Empty), out _);
+                if (key.ExportParameters(false).Curve.Oid?.Value != ECCurve.NamedCurves.nistP256.Oid.Value)
+                {
+                    throw new CryptographicException();
+                }
+
+                return key;
+            }
+            catch (Exception ex) when (ex is CryptographicException || ex is FormatException)
+            {
+                key.Dispose();
+                throw new ArgumentException($"{parameterName} must be a base64 ECDH P-256 public key");
+            }
+        }
+
+        private static string GetTransferKeyId(byte[] publicKey)
+        {
+            return Convert.ToHexString(SHA256.HashData(publicKey), 0, 8).ToLowerInvariant();
+        }
+
+        private static byte[] DeriveTransferKey(ECDiffieHellman privateKey, ECDiffieHellmanPublicKey publicKey)
+        {
+            return privateKey.DeriveKeyFromHash(publicKey, HashAlgorithmName.SHA256, Encoding.UTF8.GetBytes(TransferFormat), null);
+        }
+
+        // Binds the readable parts of the bundle to the ciphertext so the host cannot relabel or retarget it
+        private static byte[] GetTransferAssociatedData(CoreModels.SecretTransferBundle bundle)
+        {
+            return Encoding.UTF8.GetBytes(string.Join("\n", new[]
+            {
+                bundle.Format,
+                bundle.RecipientKeyId,
+                bundle.EphemeralPublicKey
+            }.Concat(bundle.Names ?? new List<string>())));
         }
 
         private async Task<byte[]> GetSecretValueAsync(byte[] payload)
@@ -332,11 +695,8 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
                 throw new KeyNotFoundException("Secret not found");
             }
 
-            // Generate a new encryption key for the updated value
-            var newEncryptionKey = EncryptionUtility.GenerateRandomKey();
-
-            // Encrypt the new value
-            var encryptedValue = EncryptionUtility.EncryptWithKey(request.Value, newEncryptionKey);
+            // Seal the new value
+            var encryptedValue = SealSecretValue(secret.Id, secret.AccountId, request.Value);
 
             // Update the secret
             secret.EncryptedValue = encryptedValue;
@@ -365,7 +725,7 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             }
 
             // Store the updated secret
-            await StoreSecretAsync(secret, newEncryptionKey);
+            await StoreSecretAsync(secret);
 
             // Create response (without sensitive data)
             var response = new
@@ -376,6 +736,7 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
                 AccountId = secret.AccountId,
                 AllowedFunctionIds = secret.AllowedFunctionIds,
                 Tags = secret.Tags,
+                EncryptedValue = secret.EncryptedValue,
                 Version = secret.Version,
                 UpdatedAt = secret.UpdatedAt,
                 LastRotatedAt = secret.LastRotatedAt,
@@ -429,11 +790,8 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
                 newValue = await GenerateNewSecretValueAsync(secret, currentValue);
             }
 
-            // Generate a new encryption key
-            var newEncryptionKey = EncryptionUtility.GenerateRandomKey();
-
-            // Encrypt the new value
-            var encryptedValue = EncryptionUtility.EncryptWithKey(newValue, newEncryptionKey);
+            // Seal the new value
+            var encryptedValue = SealSecretValue(secret.Id, secret.AccountId, newValue);
 
             // Update the secret
             secret.EncryptedValue = encryptedValue;
@@ -447,7 +805,7 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             }
 
             // Store the updated secret
-            await StoreSecretAsync(secret, newEncryptionKey);
+            await StoreSecretAsync(secret);
 
             // Create response (without sensitive data)
             var response = new
@@ -458,6 +816,7 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
                 AccountId = secret.AccountId,
                 AllowedFunctionIds = secret.AllowedFunctionIds,
                 Tags = secret.Tags,
+                EncryptedValue = secret.EncryptedValue,
                 Version = secret.Version,
                 UpdatedAt = secret.UpdatedAt,
                 LastRotatedAt = secret.LastRotatedAt,
@@ -756,6 +1115,85 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             public string NewValue { get; set; }
         }
 
+        /// <summary>
+        /// Request model for generating or unwrapping a data key
+        /// </summary>
+        private class DataKeyRequest
+        {
+            /// <summary>
+            /// Gets or sets the account ID
+            /// </summary>
+            public Guid AccountId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the wrapped data key
+            /// </summary>
+            public string Ciphertext { get; set; }
+        }
+
+        /// <summary>
+        /// Data key sealed by the enclave
+        /// </summary>
+        private class SealedDataKey
+        {
+            /// <summary>
+            /// Gets or sets the account the key belongs to
+            /// </summary>
+            public Guid AccountId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the Base64 encoded key
+            /// </summary>
+            public string Key { get; set; }
+        }
+
+        /// <summary>
+        /// Secret value sealed by the enclave
+        /// </summary>
+        private class SealedSecretValue
+        {
+            /// <summary>
+            /// Gets or sets the secret the value belongs to
+            /// </summary>
+            public Guid SecretId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the account the secret belongs to
+            /// </summary>
+            public Guid AccountId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the plaintext value
+            /// </summary>
+            public string Value { get; set; }
+        }
+
+        /// <summary>
+        /// Secret as encrypted into a transfer bundle
+        /// </summary>
+        private class TransferredSecret
+        {
+            /// <summary>
+            /// Gets or sets the name
+            /// </summary>
+            public string Name { get; set; }
+
+            /// <summary>
+            /// Gets or sets the description
+            /// </summary>
+            public string Description { get; set; }
+
+            /// <summary>
+            /// Gets or sets the expiration date
+            /// </summary>
+            public DateTime? ExpiresAt { get; set; }
+
+            /// <summary>
+            /// Gets or sets the plaintext value
+            /// </summary>
+            public string Value { get; set; }
+        }
+
         /// <summary>
         /// Request model for checking secret access
         /// </summary>
@@ -1,11 +1,13 @@
 using System;
 using System.Collections.Generic;
+using System.Linq;
 using System.Text.Json;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
 using NeoServiceLayer.Core;
 using NeoServiceLayer.Core.Utilities;
 using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Core.Models.Blockchain;
 using NeoServiceLayer.Enclave.Enclave.Models;
 
 namespace NeoServiceLayer.Enclave.Enclave.Services
@@ -100,8 +102,22 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
                                 return await TransferNeoAsync(payload);
                             case Constants.WalletOperations.TransferGas:
                                 return await TransferGasAsync(payload);
+                            case Constants.WalletOperations.TransferGasMulti:
+                                return await TransferGasMultiAsync(payload);
                             case Constants.WalletOperations.TransferToken:
                                 return await TransferTokenAsync(payload);
+                            case Constants.WalletOperations.TransferNft:
+                                return await TransferNftAsync(payload);
+                            case Constants.WalletOperations.Vote:
+                                return await VoteAsync(payload);
+                            case Constants.WalletOperations.WrapBneo:
+                                return await ConvertBneoAsync(payload, wrap: true);
+                            case Constants.WalletOperations.UnwrapBneo:
+                                return await ConvertBneoAsync(payload, wrap: false);
+                            case Constants.WalletOperations.InvokeWrite:
+                                return await InvokeWriteAsync(payload);
+                            case Constants.WalletOperations.SendMultiSig:
+                                return await SendMultiSigAsync(payload);
                             default:
                                 throw new InvalidOperationException($"Unknown operation: {operation}");
                         }
@@ -576,6 +592,293 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             return JsonUtility.SerializeToUtf8Bytes(response);
         }
 
+        private async Task<byte[]> TransferGasMultiAsync(byte[] payload)
+        {
+            // Parse the request payload
+            var request = JsonUtility.Deserialize<TransferGasMultiRequest>(payload);
+
+            // Validate request
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+            ValidationUtility.ValidateGuid(request.WalletId, "Wallet ID");
+            ValidationUtility.ValidateNotNullOrEmpty(request.Password, "Password");
+            ValidationUtility.ValidateNotNull(request.Transfers, "Transfers");
+
+            if (request.Transfers.Count == 0)
+            {
+                throw new ArgumentException("At least one transfer is required");
+            }
+
+            foreach (var transfer in request.Transfers)
+            {
+                ValidationUtility.ValidateNotNullOrEmpty(transfer.ToAddress, "Recipient address");
+                ValidationUtility.ValidateGreaterThanZero(transfer.Amount, "Amount");
+
+                if (!transfer.ToAddress.IsValidNeoAddress())
+                {
+                    throw new ArgumentException("Invalid Neo address format");
+                }
+            }
+
+            // Retrieve the wallet
+            var wallet = await RetrieveWalletAsync(request.WalletId, request.AccountId);
+
+            if (wallet == null)
+            {
+                throw new KeyNotFoundException("Wallet not found");
+            }
+
+            // Decrypt the private key
+            var privateKey = EncryptionUtility.DecryptWithPassword(wallet.EncryptedPrivateKey, request.Password);
+
+            // Create and sign a single transaction carrying every transfer
+            var transactionHash = await CreateAndSignGasMultiTransactionAsync(wallet, privateKey, request.Transfers, request.Network);
+
+            var totalAmount = 0m;
+            foreach (var transfer in request.Transfers)
+            {
+                totalAmount += transfer.Amount;
+            }
+
+            // Create response
+            var response = new
+            {
+                WalletId = wallet.Id,
+                FromAddress = wallet.Address,
+                Transfers = request.Transfers,
+                TotalAmount = totalAmount,
+                Asset = "GAS",
+                TransactionHash = transactionHash,
+                Network = request.Network
+            };
+
+            // Log the transaction (without sensitive data)
+            LoggingUtility.LogSecurityEvent(_logger, "GasMultiTransfer", Guid.NewGuid().ToString(),
+                request.AccountId.ToString(), "Wallet", wallet.Id.ToString(), "Transfer", "Success",
+                new Dictionary<string, object>
+                {
+                    ["FromAddress"] = wallet.Address,
+                    ["RecipientCount"] = request.Transfers.Count,
+                    ["TotalAmount"] = totalAmount,
+                    ["Asset"] = "GAS",
+                    ["TransactionHash"] = transactionHash,
+                    ["Network"] = request.Network
+                });
+
+            return JsonUtility.SerializeToUtf8Bytes(response);
+        }
+
+        private async Task<string> CreateAndSignGasMultiTransactionAsync(Wallet wallet, string privateKey, List<GasTransferItem> transfers, string network)
+        {
+            // In a production environment, this would use the Neo SDK to build one script
+            // with a GAS transfer call per recipient and sign it as a single transaction
+            // For now, we'll simulate transaction creation and signing
+
+            foreach (var transfer in transfers)
+            {
+                if (!IsValidNeoAddress(transfer.ToAddress))
+                {
+                    throw new ArgumentException("Invalid recipient address");
+                }
+            }
+
+            // Simulate network delay for transaction creation and signing
+            await Task.Delay(100);
+
+            // Generate a transaction hash
+            var transactionHash = "0x" + Guid.NewGuid().ToString("N");
+
+            return transactionHash;
+        }
+
+        private async Task<byte[]> InvokeWriteAsync(byte[] payload)
+        {
+            // Parse the request payload
+            var request = JsonUtility.Deserialize<InvokeWriteRequest>(payload);
+
+            // Validate request
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+            ValidationUtility.ValidateGuid(request.WalletId, "Wallet ID");
+            ValidationUtility.ValidateNotNullOrEmpty(request.Password, "Password");
+            ValidationUtility.ValidateNotNullOrEmpty(request.Operation, "Operation");
+            ValidationUtility.ValidateGreaterThanZero(request.SystemFeeLimit, "System fee limit");
+
+            if (!request.ScriptHash.IsValidScriptHash())
+            {
+                throw new ArgumentException("Invalid script hash format");
+            }
+
+            // Build the invocation script first, so malformed arguments are rejected before the key is decrypted
+            var callFlags = request.CallFlags ?? CallFlags.All;
+            byte[] script;
+            try
+            {
+                var args = request.Args?.Select(NeoContractParameter.FromJson).ToList();
+                script = NeoScriptBuilder.CreateInvocationScript(request.ScriptHash, request.Operation, args, callFlags);
+            }
+            catch (FormatException ex)
+            {
+                throw new ArgumentException($"Invalid invocation arguments: {ex.Message}", ex);
+            }
+
+            // Retrieve the wallet
+            var wallet = await RetrieveWalletAsync(request.WalletId, request.AccountId);
+
+            if (wallet == null)
+            {
+                throw new KeyNotFoundException("Wallet not found");
+            }
+
+            // Decrypt the private key
+            var privateKey = EncryptionUtility.DecryptWithPassword(wallet.EncryptedPrivateKey, request.Password);
+
+            // Create and sign the invocation transaction
+            var transactionHash = await CreateAndSignInvocationTransactionAsync(wallet, privateKey, script, request.SystemFeeLimit, request.Network);
+
+            // Create response
+            var response = new
+            {
+                WalletId = wallet.Id,
+                FromAddress = wallet.Address,
+                ScriptHash = request.ScriptHash,
+                Operation = request.Operation,
+                CallFlags = callFlags,
+                Script = Convert.ToBase64String(script),
+                SystemFeeLimit = request.SystemFeeLimit,
+                TransactionHash = transactionHash,
+                Network = request.Network
+            };
+
+            // Log the transaction (without sensitive data)
+            LoggingUtility.LogSecurityEvent(_logger, "ContractInvocation", Guid.NewGuid().ToString(),
+                request.AccountId.ToString(), "Wallet", wallet.Id.ToString(), "Invoke", "Success",
+                new Dictionary<string, object>
+                {
+                    ["FromAddress"] = wallet.Address,
+                    ["ScriptHash"] = request.ScriptHash,
+                    ["Operation"] = request.Operation,
+                    ["SystemFeeLimit"] = request.SystemFeeLimit,
+                    ["TransactionHash"] = transactionHash,
+                    ["Network"] = request.Network
+                });
+
+            return JsonUtility.SerializeToUtf8Bytes(response);
+        }
+
+        private async Task<string> CreateAndSignInvocationTransactionAsync(Wallet wallet, string privateKey, byte[] script, decimal systemFeeLimit, string network)
+        {
+            // In a production environment, this would use the Neo SDK to wrap the script in a transaction,
+            // cap its system fee at the limit and sign it
+            // For now, we'll simulate transaction creation and signing
+
+            // Simulate network delay for transaction creation and signing
+            await Task.Delay(100);
+
+            // Generate a transaction hash
+            var transactionHash = "0x" + Guid.NewGuid().ToString("N");
+
+            return transactionHash;
+        }
+
+        private async Task<byte[]> SendMultiSigAsync(byte[] payload)
+        {
+            // Parse the request payload
+            var request = JsonUtility.Deserialize<SendMultiSigRequest>(payload);
+
+            // Validate request
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+            ValidationUtility.ValidateNotNullOrEmpty(request.Script, "Script");
+            ValidationUtility.ValidateNotNullOrEmpty(request.SignData, "Sign data");
+            ValidationUtility.ValidateGreaterThanZero(request.SystemFeeLimit, "System fee limit");
+            ValidationUtility.ValidateNotNull(request.Signatures, "Signatures");
+
+            // The sender must be the account of the keys that signed, not whatever account the host names
+            byte[] verificationScript;
+            try
+            {
+                verificationScript = NeoAccountUtility.CreateMultiSigVerificationScript(request.Threshold, request.PublicKeys);
+            }
+            catch (FormatException ex)
+            {
+                throw new ArgumentException($"Invalid multi-signature account: {ex.Message}", ex);
+            }
+
+            if (Convert.ToBase64String(verificationScript) != request.VerificationScript)
+            {
+                throw new ArgumentException("The verification script does not match the signers' keys and threshold");
+            }
+
+            var keys = NeoAccountUtility.SortPublicKeys(request.PublicKeys);
+            var signatures = request.Signatures
+                .Where(s => s.PublicKey != null && !string.IsNullOrEmpty(s.Signature))
+                .GroupBy(s => s.PublicKey.ToLowerInvariant())
+                .Select(g => g.First())
+                .ToList();
+
+            if (signatures.Any(s => !keys.Contains(s.PublicKey.ToLowerInvariant())))
+            {
+                throw new ArgumentException("A signature belongs to a key that is not a signer");
+            }
+
+            if (signatures.Count < request.Threshold)
+            {
+                throw new ArgumentException($"{request.Threshold} signatures are required, {signatures.Count} were given");
+            }
+
+            // The witness pushes the signatures in the order the verification script lists their keys
+            var invocation = new NeoScriptBuilder();
+            foreach (var signature in signatures.OrderBy(s => keys.IndexOf(s.PublicKey.ToLowerInvariant())).Take(request.Threshold))
+            {
+                invocation.EmitPush(Convert.FromHexString(signature.Signature.StartsWith("0x", StringComparison.OrdinalIgnoreCase)
+                    ? signature.Signature.Substring(2)
+                    : signature.Signature));
+            }
+
+            var senderScriptHash = NeoAccountUtility.GetScriptHash(verificationScript);
+            var senderAddress = NeoAccountUtility.ToAddress(senderScriptHash);
+            var transactionHash = await CreateAndSendMultiSigTransactionAsync(
+                Convert.FromBase64String(request.Script), senderScriptHash, invocation.ToArray(), verificationScript, request.SystemFeeLimit, request.Nonce, request.Network);
+
+            // Create response
+            var response = new
+            {
+                SenderScriptHash = senderScriptHash,
+                SenderAddress = senderAddress,
+                Invocation = Convert.ToBase64String(invocation.ToArray()),
+                TransactionHash = transactionHash,
+                Network = request.Network
+            };
+
+            // Log the transaction (without sensitive data)
+            LoggingUtility.LogSecurityEvent(_logger, "MultiSigTransaction", Guid.NewGuid().ToString(),
+                request.AccountId.ToString(), "MultiSigAccount", senderScriptHash, "Send", "Success",
+                new Dictionary<string, object>
+                {
+                    ["SenderAddress"] = senderAddress,
+                    ["Threshold"] = request.Threshold,
+                    ["KeyCount"] = keys.Count,
+                    ["SystemFeeLimit"] = request.SystemFeeLimit,
+                    ["TransactionHash"] = transactionHash,
+                    ["Network"] = request.Network
+                });
+
+            return JsonUtility.SerializeToUtf8Bytes(response);
+        }
+
+        private async Task<string> CreateAndSendMultiSigTransactionAsync(byte[] script, string senderScriptHash, byte[] invocation, byte[] verification, decimal systemFeeLimit, uint nonce, string network)
+        {
+            // In a production environment, this would use the Neo SDK to build the transaction with the
+            // multi-signature account as sender, attach the invocation and verification scripts as its witness and relay it
+            // For now, we'll simulate transaction creation and sending
+
+            // Simulate network delay for transaction creation and sending
+            await Task.Delay(100);
+
+            // Generate a transaction hash
+            var transactionHash = "0x" + Guid.NewGuid().ToString("N");
+
+            return transactionHash;
+        }
+
         private async Task<string> CreateAndSignGasTransactionAsync(Wallet wallet, string privateKey, string toAddress, decimal amount, string network)
         {
             // In a production environment, this would use the Neo SDK to create and sign a transaction
@@ -616,10 +919,261 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
                 throw new ArgumentException("Invalid Neo address format");
             }
 
-            // Validate script hash format
-            if (!request.TokenScriptHash.IsValidScriptHash())
+            // Validate script hash format
+            if (!request.TokenScriptHash.IsValidScriptHash())
+            {
+                throw new ArgumentException("Invalid token script hash format");
+            }
+
+            // Retrieve the wallet
+            var wallet = await RetrieveWalletAsync(request.WalletId, request.AccountId);
+
+            if (wallet == null)
+            {
+                throw new KeyNotFoundException("Wallet not found");
+            }
+
+            // Decrypt the private key
+            var privateKey = EncryptionUtility.DecryptWithPassword(wallet.EncryptedPrivateKey, request.Password);
+
+            // Create and sign the transaction
+            var transactionHash = await CreateAndSignTokenTransactionAsync(wallet, privateKey, request.ToAddress, request.TokenScriptHash, request.Amount, request.Network);
+
+            // Create response
+            var response = new
+            {
+                WalletId = wallet.Id,
+                FromAddress = wallet.Address,
+                ToAddress = request.ToAddress,
+                TokenScriptHash = request.TokenScriptHash,
+                Amount = request.Amount,
+                TransactionHash = transactionHash,
+                Network = request.Network
+            };
+
+            // Log the transaction (without sensitive data)
+            LoggingUtility.LogSecurityEvent(_logger, "TokenTransfer", Guid.NewGuid().ToString(),
+                request.AccountId.ToString(), "Wallet", wallet.Id.ToString(), "Transfer", "Success",
+                new Dictionary<string, object>
+                {
+                    ["FromAddress"] = wallet.Address,
+                    ["ToAddress"] = request.ToAddress,
+                    ["TokenScriptHash"] = request.TokenScriptHash,
+                    ["Amount"] = request.Amount,
+                    ["TransactionHash"] = transactionHash,
+                    ["Network"] = request.Network
+                });
+
+            return JsonUtility.SerializeToUtf8Bytes(response);
+        }
+
+        private async Task<string> CreateAndSignTokenTransactionAsync(Wallet wallet, string privateKey, string toAddress, string tokenScriptHash, decimal amount, string network)
+        {
+            // In a production environment, this would use the Neo SDK to create and sign a transaction
+            // For now, we'll simulate transaction creation and signing
+
+            // Validate the recipient address
+            if (!IsValidNeoAddress(toAddress))
+            {
+                throw new ArgumentException("Invalid recipient address");
+            }
+
+            // Validate the token script hash
+            if (!IsValidScriptHash(tokenScriptHash))
+            {
+                throw new ArgumentException("Invalid token script hash");
+            }
+
+            // Simulate network delay for transaction creation and signing
+            await Task.Delay(100);
+
+            // Generate a transaction hash
+            var transactionHash = "0x" + Guid.NewGuid().ToString("N");
+
+            return transactionHash;
+        }
+
+        private async Task<byte[]> TransferNftAsync(byte[] payload)
+        {
+            // Parse the request payload
+            var request = JsonUtility.Deserialize<TransferNftRequest>(payload);
+
+            // Validate request
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+            ValidationUtility.ValidateGuid(request.WalletId, "Wallet ID");
+            ValidationUtility.ValidateGuid(request.AccountId, "Account ID");
+            ValidationUtility.ValidateNotNullOrEmpty(request.ToAddress, "Recipient address");
+            ValidationUtility.ValidateNotNullOrEmpty(request.ContractHash, "Contract hash");
+            ValidationUtility.ValidateNotNullOrEmpty(request.TokenId, "Token ID");
+            ValidationUtility.ValidateNotNullOrEmpty(request.Password, "Password");
+
+            // Validate Neo address format
+            if (!request.ToAddress.IsValidNeoAddress())
+            {
+                throw new ArgumentException("Invalid Neo address format");
+            }
+
+            // Validate script hash format
+            if (!request.ContractHash.IsValidScriptHash())
+            {
+                throw new ArgumentException("Invalid contract hash format");
+            }
+
+            // Retrieve the wallet
+            var wallet = await RetrieveWalletAsync(request.WalletId, request.AccountId);
+
+            if (wallet == null)
+            {
+                throw new KeyNotFoundException("Wallet not found");
+            }
+
+            // Decrypt the private key
+            var privateKey = EncryptionUtility.DecryptWithPassword(wallet.EncryptedPrivateKey, request.Password);
+
+            // Create and sign the transaction
+            var transactionHash = await CreateAndSignNftTransactionAsync(wallet, privateKey, request.ToAddress, request.ContractHash, request.TokenId, request.Data, request.Network);
+
+            // Create response
+            var response = new
+            {
+                WalletId = wallet.Id,
+                FromAddress = wallet.Address,
+                ToAddress = request.ToAddress,
+                ContractHash = request.ContractHash,
+                TokenId = request.TokenId,
+                TransactionHash = transactionHash,
+                Network = request.Network
+            };
+
+            // Log the transaction (without sensitive data)
+            LoggingUtility.LogSecurityEvent(_logger, "NftTransfer", Guid.NewGuid().ToString(),
+                request.AccountId.ToString(), "Wallet", wallet.Id.ToString(), "Transfer", "Success",
+                new Dictionary<string, object>
+                {
+                    ["FromAddress"] = wallet.Address,
+                    ["ToAddress"] = request.ToAddress,
+                    ["ContractHash"] = request.ContractHash,
+                    ["TokenId"] = request.TokenId,
+                    ["TransactionHash"] = transactionHash,
+                    ["Network"] = request.Network
+                });
+
+            return JsonUtility.SerializeToUtf8Bytes(response);
+        }
+
+        private async Task<string> CreateAndSignNftTransactionAsync(Wallet wallet, string privateKey, string toAddress, string contractHash, string tokenId, string data, string network)
+        {
+            // In a production environment, this would use the Neo SDK to build a NEP-11
+            // transfer(to, tokenId, data) invocation and sign it with the wallet key
+            // For now, we'll simulate transaction creation and signing
+
+            // Validate the recipient address
+            if (!IsValidNeoAddress(toAddress))
+            {
+                throw new ArgumentException("Invalid recipient address");
+            }
+
+            // Validate the contract hash
+            if (!IsValidScriptHash(contractHash))
+            {
+                throw new ArgumentException("Invalid contract hash");
+            }
+
+            // Simulate network delay for transaction creation and signing
+            await Task.Delay(100);
+
+            // Generate a transaction hash
+            var transactionHash = "0x" + Guid.NewGuid().ToString("N");
+
+            return transactionHash;
+        }
+
+        private async Task<byte[]> VoteAsync(byte[] payload)
+        {
+            // Parse the request payload
+            var request = JsonUtility.Deserialize<VoteRequest>(payload);
+
+            // Validate request
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+            ValidationUtility.ValidateGuid(request.WalletId, "Wallet ID");
+            ValidationUtility.ValidateGuid(request.AccountId, "Account ID");
+            ValidationUtility.ValidateNotNullOrEmpty(request.Password, "Password");
+
+            // A null candidate clears the vote
+            if (request.CandidatePublicKey != null && !IsValidPublicKey(request.CandidatePublicKey))
+            {
+                throw new ArgumentException("Invalid candidate public key format");
+            }
+
+            // Retrieve the wallet
+            var wallet = await RetrieveWalletAsync(request.WalletId, request.AccountId);
+
+            if (wallet == null)
+            {
+                throw new KeyNotFoundException("Wallet not found");
+            }
+
+            // Decrypt the private key
+            var privateKey = EncryptionUtility.DecryptWithPassword(wallet.EncryptedPrivateKey, request.Password);
+
+            // Create and sign the transaction
+            var transactionHash = await CreateAndSignVoteTransactionAsync(wallet, privateKey, request.CandidatePublicKey, request.Network);
+
+            // Create response
+            var response = new
+            {
+                WalletId = wallet.Id,
+                Address = wallet.Address,
+                CandidatePublicKey = request.CandidatePublicKey,
+                TransactionHash = transactionHash,
+                Network = request.Network
+            };
+
+            // Log the transaction (without sensitive data)
+            LoggingUtility.LogSecurityEvent(_logger, "NeoVote", Guid.NewGuid().ToString(),
+                request.AccountId.ToString(), "Wallet", wallet.Id.ToString(), "Vote", "Success",
+                new Dictionary<string, object>
+                {
+                    ["Address"] = wallet.Address,
+                    ["CandidatePublicKey"] = request.CandidatePublicKey,
+                    ["TransactionHash"] = transactionHash,
+                    ["Network"] = request.Network
+                });
+
+            return JsonUtility.SerializeToUtf8Bytes(response);
+        }
+
+        private async Task<string> CreateAndSignVoteTransactionAsync(Wallet wallet, string privateKey, string candidatePublicKey, string network)
+        {
+            // In a production environment, this would use the Neo SDK to build a NEO
+            // vote(account, voteTo) invocation and sign it with the wallet key
+            // For now, we'll simulate transaction creation and signing
+
+            // Simulate network delay for transaction creation and signing
+            await Task.Delay(100);
+
+            // Generate a transaction hash
+            var transactionHash = "0x" + Guid.NewGuid().ToString("N");
+
+            return transactionHash;
+        }
+
+        private async Task<byte[]> ConvertBneoAsync(byte[] payload, bool wrap)
+        {
+            // Parse the request payload
+            var request = JsonUtility.Deserialize<ConvertBneoRequest>(payload);
+
+            // Validate request
+            ValidationUtility.ValidateNotNull(request, nameof(request));
+            ValidationUtility.ValidateGuid(request.WalletId, "Wallet ID");
+            ValidationUtility.ValidateGuid(request.AccountId, "Account ID");
+            ValidationUtility.ValidateGreaterThanZero(request.Amount, "Amount");
+            ValidationUtility.ValidateNotNullOrEmpty(request.Password, "Password");
+
+            // NEO is indivisible, so only whole amounts can be wrapped or unwrapped
+            if (request.Amount != decimal.Truncate(request.Amount))
             {
-                throw new ArgumentException("Invalid token script hash format");
+                throw new ArgumentException("Amount must be a whole number of NEO");
             }
 
             // Retrieve the wallet
@@ -634,28 +1188,27 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             var privateKey = EncryptionUtility.DecryptWithPassword(wallet.EncryptedPrivateKey, request.Password);
 
             // Create and sign the transaction
-            var transactionHash = await CreateAndSignTokenTransactionAsync(wallet, privateKey, request.ToAddress, request.TokenScriptHash, request.Amount, request.Network);
+            var transactionHash = await CreateAndSignBneoTransactionAsync(wallet, privateKey, request.Amount, wrap, request.Network);
 
             // Create response
             var response = new
             {
                 WalletId = wallet.Id,
-                FromAddress = wallet.Address,
-                ToAddress = request.ToAddress,
-                TokenScriptHash = request.TokenScriptHash,
+                Address = wallet.Address,
                 Amount = request.Amount,
+                FromAsset = wrap ? "NEO" : "bNEO",
+                ToAsset = wrap ? "bNEO" : "NEO",
+                ContractHash = NeoServiceLayer.Core.Constants.NeoConfig.BneoTokenHash,
                 TransactionHash = transactionHash,
                 Network = request.Network
             };
 
             // Log the transaction (without sensitive data)
-            LoggingUtility.LogSecurityEvent(_logger, "TokenTransfer", Guid.NewGuid().ToString(),
-                request.AccountId.ToString(), "Wallet", wallet.Id.ToString(), "Transfer", "Success",
+            LoggingUtility.LogSecurityEvent(_logger, wrap ? "BneoWrap" : "BneoUnwrap", Guid.NewGuid().ToString(),
+                request.AccountId.ToString(), "Wallet", wallet.Id.ToString(), wrap ? "Wrap" : "Unwrap", "Success",
                 new Dictionary<string, object>
                 {
-                    ["FromAddress"] = wallet.Address,
-                    ["ToAddress"] = request.ToAddress,
-                    ["TokenScriptHash"] = request.TokenScriptHash,
+                    ["Address"] = wallet.Address,
                     ["Amount"] = request.Amount,
                     ["TransactionHash"] = transactionHash,
                     ["Network"] = request.Network
@@ -664,23 +1217,12 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             return JsonUtility.SerializeToUtf8Bytes(response);
         }
 
-        private async Task<string> CreateAndSignTokenTransactionAsync(Wallet wallet, string privateKey, string toAddress, string tokenScriptHash, decimal amount, string network)
+        private async Task<string> CreateAndSignBneoTransactionAsync(Wallet wallet, string privateKey, decimal amount, bool wrap, string network)
         {
-            // In a production environment, this would use the Neo SDK to create and sign a transaction
+            // In a production environment, this would use the Neo SDK to build the transfer to the
+            // bNEO contract (NEO to wrap, bNEO to unwrap) and sign it with the wallet key
             // For now, we'll simulate transaction creation and signing
 
-            // Validate the recipient address
-            if (!IsValidNeoAddress(toAddress))
-            {
-                throw new ArgumentException("Invalid recipient address");
-            }
-
-            // Validate the token script hash
-            if (!IsValidScriptHash(tokenScriptHash))
-            {
-                throw new ArgumentException("Invalid token script hash");
-            }
-
             // Simulate network delay for transaction creation and signing
             await Task.Delay(100);
 
@@ -690,6 +1232,14 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             return transactionHash;
         }
 
+        private static bool IsValidPublicKey(string publicKey)
+        {
+            // Compressed secp256r1 public key: 33 bytes starting with 02 or 03
+            return publicKey.Length == 66
+                && (publicKey.StartsWith("02", StringComparison.Ordinal) || publicKey.StartsWith("03", StringComparison.Ordinal))
+                && publicKey.All(Uri.IsHexDigit);
+        }
+
         private bool IsValidScriptHash(string scriptHash)
         {
             // This method is now replaced by StringExtensions.IsValidScriptHash
@@ -816,6 +1366,176 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             public string Network { get; set; }
         }
 
+        /// <summary>
+        /// Request model for transferring GAS to multiple recipients
+        /// </summary>
+        private class TransferGasMultiRequest
+        {
+            /// <summary>
+            /// Gets or sets the wallet ID
+            /// </summary>
+            public Guid WalletId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the account ID
+            /// </summary>
+            public Guid AccountId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the transfers to include in the transaction
+            /// </summary>
+            public List<GasTransferItem> Transfers { get; set; }
+
+            /// <summary>
+            /// Gets or sets the password for decrypting the private key
+            /// </summary>
+            public string Password { get; set; }
+
+            /// <summary>
+            /// Gets or sets the network (MainNet or TestNet)
+            /// </summary>
+            public string Network { get; set; }
+        }
+
+        /// <summary>
+        /// Request model for invoking a contract method that modifies state
+        /// </summary>
+        private class InvokeWriteRequest
+        {
+            /// <summary>
+            /// Gets or sets the wallet ID
+            /// </summary>
+            public Guid WalletId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the account ID
+            /// </summary>
+            public Guid AccountId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the contract script hash
+            /// </summary>
+            public string ScriptHash { get; set; }
+
+            /// <summary>
+            /// Gets or sets the method name
+            /// </summary>
+            public string Operation { get; set; }
+
+            /// <summary>
+            /// Gets or sets the method arguments as contract parameters
+            /// </summary>
+            public List<JsonElement> Args { get; set; }
+
+            /// <summary>
+            /// Gets or sets the permissions granted to the called method, or null for all
+            /// </summary>
+            public CallFlags? CallFlags { get; set; }
+
+            /// <summary>
+            /// Gets or sets the most GAS the invocation may consume
+            /// </summary>
+            public decimal SystemFeeLimit { get; set; }
+
+            /// <summary>
+            /// Gets or sets the password for decrypting the private key
+            /// </summary>
+            public string Password { get; set; }
+
+            /// <summary>
+            /// Gets or sets the network (MainNet or TestNet)
+            /// </summary>
+            public string Network { get; set; }
+        }
+
+        /// <summary>
+        /// Request model for sending a multi-signature transaction
+        /// </summary>
+        private class SendMultiSigRequest
+        {
+            /// <summary>
+            /// Gets or sets the ID of the account that proposed the transaction
+            /// </summary>
+            public Guid AccountId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the number of signatures required
+            /// </summary>
+            public int Threshold { get; set; }
+
+            /// <summary>
+            /// Gets or sets the signers' public keys
+            /// </summary>
+            public List<string> PublicKeys { get; set; }
+
+            /// <summary>
+            /// Gets or sets the verification script of the sender, Base64-encoded
+            /// </summary>
+            public string VerificationScript { get; set; }
+
+            /// <summary>
+            /// Gets or sets the invocation script, Base64-encoded
+            /// </summary>
+            public string Script { get; set; }
+
+            /// <summary>
+            /// Gets or sets the most GAS the invocation may consume
+            /// </summary>
+            public decimal SystemFeeLimit { get; set; }
+
+            /// <summary>
+            /// Gets or sets the transaction nonce
+            /// </summary>
+            public uint Nonce { get; set; }
+
+            /// <summary>
+            /// Gets or sets the digest the signers signed, as hex
+            /// </summary>
+            public string SignData { get; set; }
+
+            /// <summary>
+            /// Gets or sets the signatures
+            /// </summary>
+            public List<MultiSigSignatureItem> Signatures { get; set; }
+
+            /// <summary>
+            /// Gets or sets the network (MainNet or TestNet)
+            /// </summary>
+            public string Network { get; set; }
+        }
+
+        /// <summary>
+        /// A signer's signature of a multi-signature transaction
+        /// </summary>
+        private class MultiSigSignatureItem
+        {
+            /// <summary>
+            /// Gets or sets the signer's public key
+            /// </summary>
+            public string PublicKey { get; set; }
+
+            /// <summary>
+            /// Gets or sets the signature, as hex
+            /// </summary>
+            public string Signature { get; set; }
+        }
+
+        /// <summary>
+        /// A single recipient of a multi-recipient GAS transfer
+        /// </summary>
+        private class GasTransferItem
+        {
+            /// <summary>
+            /// Gets or sets the recipient address
+            /// </summary>
+            public string ToAddress { get; set; }
+
+            /// <summary>
+            /// Gets or sets the amount to transfer
+            /// </summary>
+            public decimal Amount { get; set; }
+        }
+
         /// <summary>
         /// Request model for transferring GAS
         /// </summary>
@@ -893,6 +1613,114 @@ namespace NeoServiceLayer.Enclave.Enclave.Services
             public string Network { get; set; }
         }
 
+        /// <summary>
+        /// Request model for voting for a consensus candidate
+        /// </summary>
+        private class VoteRequest
+        {
+            /// <summary>
+            /// Gets or sets the wallet ID
+            /// </summary>
+            public Guid WalletId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the account ID
+            /// </summary>
+            public Guid AccountId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the candidate's compressed public key in hex, or null to clear the vote
+            /// </summary>
+            public string CandidatePublicKey { get; set; }
+
+            /// <summary>
+            /// Gets or sets the password for decrypting the private key
+            /// </summary>
+            public string Password { get; set; }
+
+            /// <summary>
+            /// Gets or sets the network (MainNet or TestNet)
+            /// </summary>
+            public string Network { get; set; }
+        }
+
+        /// <summary>
+        /// Request model for wrapping NEO as bNEO or unwrapping it
+        /// </summary>
+        private class ConvertBneoRequest
+        {
+            /// <summary>
+            /// Gets or sets the wallet ID
+            /// </summary>
+            public Guid WalletId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the account ID
+            /// </summary>
+            public Guid AccountId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the amount of NEO to wrap or bNEO to unwrap
+            /// </summary>
+            public decimal Amount { get; set; }
+
+            /// <summary>
+            /// Gets or sets the password for decrypting the private key
+            /// </summary>
+            public string Password { get; set; }
+
+            /// <summary>
+            /// Gets or sets the network (MainNet or TestNet)
+            /// </summary>
+            public string Network { get; set; }
+        }
+
+        /// <summary>
+        /// Request model for transferring a NEP-11 token
+        /// </summary>
+        private class TransferNftRequest
+        {
+            /// <summary>
+            /// Gets or sets the wallet ID
+            /// </summary>
+            public Guid WalletId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the account ID
+            /// </summary>
+            public Guid AccountId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the recipient address
+            /// </summary>
+            public string ToAddress { get; set; }
+
+            /// <summary>
+            /// Gets or sets the NEP-11 contract script hash
+            /// </summary>
+            public string ContractHash { get; set; }
+
+            /// <summary>
+            /// Gets or sets the token ID in hex
+            /// </summary>
+            public string TokenId { get; set; }
+
+            /// <summary>
+            /// Gets or sets the optional data passed to the recipient's onNEP11Payment
+            /// </summary>
+            public string Data { get; set; }
+
+            /// <summary>
+            /// Gets or sets the password for decrypting the private key
+            /// </summary>
+            public string Password { get; set; }
+
+            /// <summary>
+            /// Gets or sets the network (MainNet or TestNet)
+            /// </summary>
+            public string Network { get; set; }
+        }
+
         /// <summary>
         /// Wallet model
         /// </summary>
@@ -1,12 +1,16 @@
 using System;
+using System.IO;
 using System.Net.Sockets;
 using System.Text.Json;
 using System.Threading;
+using System.Threading.Channels;
 using System.Threading.Tasks;
 using Microsoft.Extensions.DependencyInjection;
 using Microsoft.Extensions.Logging;
 using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
 using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
 using NeoServiceLayer.Enclave.Host;
 using NeoServiceLayer.Enclave.Enclave.Services;
 using System.Linq;
@@ -28,6 +32,9 @@ namespace NeoServiceLayer.Enclave.Enclave
         private readonly CancellationTokenSource _cancellationTokenSource;
         private readonly Socket _socket;
 
+        // Upper bound on the combined size of the input chunks of one streaming execution
+        private const int MaxStreamInputSize = 64 * 1024 * 1024;
+
         /// <summary>
         /// Initializes a new instance of the <see cref="VsockServer"/> class
         /// </summary>
@@ -92,36 +99,26 @@ namespace NeoServiceLayer.Enclave.Enclave
         private async Task HandleClientAsync(Socket clientSocket)
         {
             using (clientSocket)
+            using (var stream = new NetworkStream(clientSocket, ownsSocket: false))
             {
                 try
                 {
-                    // Receive message length
-                    var lengthBytes = new byte[4];
-                    await clientSocket.ReceiveAsync(lengthBytes, SocketFlags.None);
-                    var messageLength = BitConverter.ToInt32(lengthBytes);
-
                     // Receive message
-                    var message = new byte[messageLength];
-                    var totalBytesReceived = 0;
+                    var message = await EnclaveStreamUtility.ReadMessageAsync(stream);
 
-                    while (totalBytesReceived < messageLength)
+                    // Streaming executions keep the connection open for input and log frames
+                    var streamRequest = TryGetStreamRequest(message);
+                    if (streamRequest != null)
                     {
-                        var bytesReceived = await clientSocket.ReceiveAsync(
-                            message.AsMemory(totalBytesReceived, messageLength - totalBytesReceived),
-                            SocketFlags.None);
-
-                        totalBytesReceived += bytesReceived;
+                        await HandleStreamAsync(stream, streamRequest);
+                        return;
                     }
 
                     // Process message
                     var response = await ProcessMessageAsync(message);
 
-                    // Send response length
-                    var responseLengthBytes = BitConverter.GetBytes(response.Length);
-                    await clientSocket.SendAsync(responseLengthBytes, SocketFlags.None);
-
                     // Send response
-                    await clientSocket.SendAsync(response, SocketFlags.None);
+                    await EnclaveStreamUtility.WriteMessageAsync(stream, response);
                 }
                 catch (Exception ex)
                 {
@@ -130,6 +127,137 @@ namespace NeoServiceLayer.Enclave.Enclave
             }
         }
 
+        private CoreEnclaveRequest TryGetStreamRequest(byte[] message)
+        {
+            try
+            {
+                var request = JsonSerializer.Deserialize<CoreEnclaveRequest>(message, new JsonSerializerOptions { PropertyNameCaseInsensitive = true });
+                return request != null &&
+                    request.ServiceType == Constants.EnclaveServiceTypes.Function &&
+                    request.Operation == Constants.FunctionOperations.ExecuteFunctionStream
+                    ? request
+                    : null;
+            }
+            catch (JsonException)
+            {
+                // Let ProcessMessageAsync report the malformed message
+                return null;
+            }
+        }
+
+        private async Task HandleStreamAsync(NetworkStream stream, CoreEnclaveRequest request)
+        {
+            _logger.LogInformation("Received streaming request: {RequestId} {ServiceType}.{Operation}",
+                request.RequestId, request.ServiceType, request.Operation);
+
+            IncrementRequestCount();
+
+            var authorizationService = _serviceProvider.GetRequiredService<EnclaveAuthorizationService>();
+            if (!authorizationService.Authorize(request, out var authorizationError))
+            {
+                await EnclaveStreamUtility.WriteFrameAsync(stream, CreateErrorFrame(request.RequestId, 0, $"{CapabilityTokenUtility.UnauthorizedErrorPrefix}{authorizationError}"));
+                return;
+            }
+
+            // Collect the input chunks
+            byte[] input;
+            try
+            {
+                input = await ReceiveStreamInputAsync(stream, request.RequestId);
+            }
+            catch (InvalidDataException ex)
+            {
+                await EnclaveStreamUtility.WriteFrameAsync(stream, CreateErrorFrame(request.RequestId, 0, ex.Message));
+                return;
+            }
+
+            // Log lines are produced on the execution thread, so they are queued and written by a single pump
+            var outgoing = Channel.CreateUnbounded<EnclaveStreamFrame>(new UnboundedChannelOptions { SingleReader = true });
+            var pump = Task.Run(async () =>
+            {
+                await foreach (var frame in outgoing.Reader.ReadAllAsync())
+                {
+                    await EnclaveStreamUtility.WriteFrameAsync(stream, frame);
+                }
+            });
+
+            long sequence = 0;
+            EnclaveStreamFrame finalFrame;
+            try
+            {
+                var functionService = _serviceProvider.GetRequiredService<EnclaveFunctionService>();
+                var result = await functionService.ExecuteStreamAsync(request.Payload, input, line =>
+                {
+                    outgoing.Writer.TryWrite(new EnclaveStreamFrame
+                    {
+                        RequestId = request.RequestId,
+                        Type = EnclaveStreamFrameTypes.Log,
+                        Sequence = Interlocked.Increment(ref sequence) - 1,
+                        Message = line
+                    });
+                });
+
+                var signingService = _serviceProvider.GetRequiredService<EnclaveResultSigningService>();
+                finalFrame = signingService.CreateResultFrame(request.RequestId, result, Interlocked.Read(ref sequence));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error processing streaming request {RequestId}", request.RequestId);
+                finalFrame = CreateErrorFrame(request.RequestId, Interlocked.Read(ref sequence), ex.Message, ex as SandboxException);
+            }
+
+            outgoing.Writer.Complete();
+            await pump;
+
+            await EnclaveStreamUtility.WriteFrameAsync(stream, finalFrame);
+        }
+
+        private static async Task<byte[]> ReceiveStreamInputAsync(NetworkStream stream, string requestId)
+        {
+            using var input = new MemoryStream();
+            long expectedSequence = 0;
+
+            while (true)
+            {
+                var frame = await EnclaveStreamUtility.ReadFrameAsync(stream);
+                if (frame.RequestId != requestId || frame.Sequence != expectedSequence)
+                {
+                    throw new InvalidDataException($"Unexpected frame {frame.Sequence} for request {frame.RequestId}");
+                }
+
+                expectedSequence++;
+
+                switch (frame.Type)
+                {
+                    case EnclaveStreamFrameTypes.Input:
+                        if (input.Length + (frame.Data?.Length ?? 0) > MaxStreamInputSize)
+                        {
+                            throw new InvalidDataException($"Streamed input exceeds {MaxStreamInputSize} bytes");
+                        }
+
+                        input.Write(frame.Data ?? Array.Empty<byte>());
+                        break;
+                    case EnclaveStreamFrameTypes.End:
+                        return input.ToArray();
+                    default:
+                        throw new InvalidDataException($"Unexpected {frame.Type} frame while receiving input");
+                }
+            }
+        }
+
+        private static EnclaveStreamFrame CreateErrorFrame(string requestId, long sequence, string errorMessage, SandboxException? sandboxException = null)
+        {
+            return new EnclaveStreamFrame
+            {
+                RequestId = requestId,
+                Type = EnclaveStreamFrameTypes.Error,
+                Sequence = sequence,
+                Message = errorMessage,
+                ErrorCode = sandboxException?.ErrorCode,
+                GasUsed = sandboxException?.GasUsed ?? 0
+            };
+        }
+
         private async Task<byte[]> ProcessMessageAsync(byte[] message)
         {
             try
@@ -168,8 +296,16 @@ namespace NeoServiceLayer.Enclave.Enclave
         {
             try
             {
+                var authorizationService = _serviceProvider.GetRequiredService<EnclaveAuthorizationService>();
+                if (!authorizationService.Authorize(request, out var authorizationError))
+                {
+                    return CreateErrorResponse(request.RequestId, $"{CapabilityTokenUtility.UnauthorizedErrorPrefix}{authorizationError}");
+                }
+
                 switch (request.ServiceType)
                 {
+                    case Constants.EnclaveServiceTypes.Auth:
+                        return await ProcessAuthRequestAsync(request);
                     case Constants.EnclaveServiceTypes.Account:
                         return await ProcessAccountRequestAsync(request);
                     case Constants.EnclaveServiceTypes.Wallet:
@@ -180,6 +316,8 @@ namespace NeoServiceLayer.Enclave.Enclave
                         return await ProcessFunctionRequestAsync(request);
                     case Constants.EnclaveServiceTypes.PriceFeed:
                         return await ProcessPriceFeedRequestAsync(request);
+                    case Constants.EnclaveServiceTypes.Attestation:
+                        return ProcessAttestationRequest(request);
                     case "ping":
                         return CreateSuccessResponse(request.RequestId, new { Status = "OK" });
                     case "metrics":
@@ -195,6 +333,33 @@ namespace NeoServiceLayer.Enclave.Enclave
             }
         }
 
+        private async Task<CoreEnclaveResponse> ProcessAuthRequestAsync(CoreEnclaveRequest request)
+        {
+            try
+            {
+                var authorizationService = _serviceProvider.GetRequiredService<EnclaveAuthorizationService>();
+                var enclaveRequest = new EnclaveEnclaveRequest
+                {
+                    RequestId = request.RequestId,
+                    Operation = request.Operation,
+                    Payload = request.Payload
+                };
+                var response = await authorizationService.ProcessRequestAsync(enclaveRequest);
+                return new CoreEnclaveResponse
+                {
+                    RequestId = response.RequestId,
+                    Success = response.Success,
+                    ErrorMessage = response.ErrorMessage,
+                    Payload = response.Payload
+                };
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error processing auth request");
+                return CreateErrorResponse(request.RequestId, ex.Message);
+            }
+        }
+
         private async Task<CoreEnclaveResponse> ProcessAccountRequestAsync(CoreEnclaveRequest request)
         {
             try
@@ -293,6 +458,8 @@ namespace NeoServiceLayer.Enclave.Enclave
                     RequestId = response.RequestId,
                     Success = response.Success,
                     ErrorMessage = response.ErrorMessage,
+                    ErrorCode = response.ErrorCode,
+                    GasUsed = response.GasUsed,
                     Payload = response.Payload
                 };
             }
@@ -330,6 +497,35 @@ namespace NeoServiceLayer.Enclave.Enclave
             }
         }
 
+        private CoreEnclaveResponse ProcessAttestationRequest(CoreEnclaveRequest request)
+        {
+            try
+            {
+                if (request.Operation != Constants.AttestationOperations.SignProvenance)
+                {
+                    return CreateErrorResponse(request.RequestId, $"Unknown attestation operation: {request.Operation}");
+                }
+
+                var payload = JsonSerializer.Deserialize<ProvenanceSignatureRequest>(request.Payload, new JsonSerializerOptions { PropertyNameCaseInsensitive = true });
+                if (string.IsNullOrEmpty(payload?.StatementDigest))
+                {
+                    return CreateErrorResponse(request.RequestId, "Statement digest is required");
+                }
+
+                var signingService = _serviceProvider.GetRequiredService<EnclaveResultSigningService>();
+                return CreateSuccessResponse(request.RequestId, new
+                {
+                    PublicKey = signingService.PublicKey,
+                    Signature = signingService.SignProvenance(payload.StatementDigest)
+                });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error processing attestation request");
+                return CreateErrorResponse(request.RequestId, ex.Message);
+            }
+        }
+
         private async Task<CoreEnclaveResponse> ProcessMetricsRequestAsync(CoreEnclaveRequest request)
         {
             try
@@ -493,5 +689,10 @@ namespace NeoServiceLayer.Enclave.Enclave
                 ErrorMessage = errorMessage
             };
         }
+
+        private class ProvenanceSignatureRequest
+        {
+            public string? StatementDigest { get; set; }
+        }
     }
 }
@@ -1,12 +1,16 @@
 using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
 using System.Diagnostics;
 using System.Text.Json;
+using System.Threading;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Configuration;
 using Microsoft.Extensions.Logging;
 using NeoServiceLayer.Core;
 using NeoServiceLayer.Core.Exceptions;
 using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
 
 namespace NeoServiceLayer.Enclave.Host
 {
@@ -18,8 +22,12 @@ namespace NeoServiceLayer.Enclave.Host
         private readonly ILogger<EnclaveManager> _logger;
         private readonly IConfiguration _configuration;
         private readonly VsockClient _vsockClient;
+        private readonly ConcurrentDictionary<string, TeeTokenResponse> _capabilityTokens = new ConcurrentDictionary<string, TeeTokenResponse>();
         private Process _enclaveProcess;
 
+        // Tokens are refreshed this long before they expire so in-flight requests don't race the expiry
+        private static readonly TimeSpan TokenRefreshMargin = TimeSpan.FromSeconds(30);
+
         /// <summary>
         /// Initializes a new instance of the <see cref="EnclaveManager"/> class
         /// </summary>
@@ -55,6 +63,9 @@ namespace NeoServiceLayer.Enclave.Host
                     CreateNoWindow = true
                 };
 
+                // A new enclave has a new token signing key, so tokens issued by the previous one are worthless
+                _capabilityTokens.Clear();
+
                 _enclaveProcess = new Process { StartInfo = startInfo };
                 _enclaveProcess.Start();
 
@@ -94,20 +105,24 @@ namespace NeoServiceLayer.Enclave.Host
         {
             try
             {
-                var enclaveRequest = new EnclaveRequest
-                {
-                    ServiceType = serviceType,
-                    Operation = operation,
-                    Payload = JsonSerializer.SerializeToUtf8Bytes(request)
-                };
+                var payload = JsonSerializer.SerializeToUtf8Bytes(request);
+                var isProtected = CapabilityTokenUtility.IsProtected(serviceType, operation);
+                var enclaveResponse = await SendEnclaveRequestAsync(serviceType, operation, payload, isProtected);
 
-                var requestBytes = JsonSerializer.SerializeToUtf8Bytes(enclaveRequest);
-                var responseBytes = await _vsockClient.SendMessageAsync(requestBytes);
-                var enclaveResponse = JsonSerializer.Deserialize<EnclaveResponse>(responseBytes);
+                if (isProtected && !enclaveResponse.Success && CapabilityTokenUtility.IsUnauthorizedError(enclaveResponse.ErrorMessage))
+                {
+                    // The enclave may have restarted behind our back and lost the key our cached token was signed with,
+                    // so get a new token and try once more
+                    _logger.LogWarning("Enclave rejected the capability token for {ServiceType}.{Operation}, requesting a new one", serviceType, operation);
+                    InvalidateCapabilityToken(serviceType, operation);
+                    enclaveResponse = await SendEnclaveRequestAsync(serviceType, operation, payload, isProtected);
+                }
 
                 if (!enclaveResponse.Success)
                 {
-                    throw new EnclaveException(enclaveResponse.ErrorMessage);
+                    throw string.IsNullOrEmpty(enclaveResponse.ErrorCode)
+                        ? new EnclaveException(enclaveResponse.ErrorMessage)
+                        : new SandboxException(enclaveResponse.ErrorCode, enclaveResponse.ErrorMessage) { GasUsed = enclaveResponse.GasUsed };
                 }
 
                 return JsonSerializer.Deserialize<TResponse>(enclaveResponse.Payload);
@@ -123,6 +138,138 @@ namespace NeoServiceLayer.Enclave.Host
             }
         }
 
+        private async Task<EnclaveResponse> SendEnclaveRequestAsync(string serviceType, string operation, byte[] payload, bool isProtected)
+        {
+            var enclaveRequest = new EnclaveRequest
+            {
+                ServiceType = serviceType,
+                Operation = operation,
+                Payload = payload
+            };
+
+            if (isProtected)
+            {
+                enclaveRequest.AuthToken = await GetCapabilityTokenAsync(serviceType, operation);
+            }
+
+            var requestBytes = JsonSerializer.SerializeToUtf8Bytes(enclaveRequest);
+            var responseBytes = await _vsockClient.SendMessageAsync(requestBytes);
+            return JsonSerializer.Deserialize<EnclaveResponse>(responseBytes);
+        }
+
+        /// <summary>
+        /// Executes a function in the enclave, streaming its input and log output
+        /// </summary>
+        /// <remarks>
+        /// Use this for long executions and large inputs: the connection stays busy with frames instead of
+        /// waiting for a single response, and the result is signed by a key that never leaves the enclave.
+        /// </remarks>
+        /// <param name="functionId">Function ID</param>
+        /// <param name="executionId">Execution ID, used to kill the execution</param>
+        /// <param name="inputChunks">Chunks that together form a JSON object of function parameters</param>
+        /// <param name="onLog">Callback invoked for each log line as the function writes it</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The signed result frame</returns>
+        public async Task<EnclaveStreamFrame> ExecuteStreamAsync(
+            Guid functionId,
+            Guid executionId,
+            IAsyncEnumerable<byte[]> inputChunks,
+            Func<string, Task> onLog,
+            CancellationToken cancellationToken = default)
+        {
+            var serviceType = Constants.EnclaveServiceTypes.Function;
+            var operation = Constants.FunctionOperations.ExecuteFunctionStream;
+
+            var enclaveRequest = new EnclaveRequest
+            {
+                ServiceType = serviceType,
+                Operation = operation,
+                Payload = JsonSerializer.SerializeToUtf8Bytes(new { FunctionId = functionId, ExecutionId = executionId })
+            };
+
+            if (CapabilityTokenUtility.IsProtected(serviceType, operation))
+            {
+                enclaveRequest.AuthToken = await GetCapabilityTokenAsync(serviceType, operation);
+            }
+
+            var frame = await _vsockClient.StreamMessageAsync(
+                JsonSerializer.SerializeToUtf8Bytes(enclaveRequest),
+                enclaveRequest.RequestId,
+                inputChunks,
+                log => onLog != null ? onLog(log.Message) : Task.CompletedTask,
+                cancellationToken);
+
+            if (frame.Type == EnclaveStreamFrameTypes.Error)
+            {
+                // The input chunks can't be sent twice, so the stream is not retried; the next call requests a new token
+                if (CapabilityTokenUtility.IsUnauthorizedError(frame.Message))
+                {
+                    InvalidateCapabilityToken(serviceType, operation);
+                }
+
+                throw string.IsNullOrEmpty(frame.ErrorCode)
+                    ? new EnclaveException(frame.Message)
+                    : new SandboxException(frame.ErrorCode, frame.Message) { GasUsed = frame.GasUsed };
+            }
+
+            // Prefer the key pinned from attestation; otherwise at least check the frame is self-consistent
+            var publicKey = _configuration["Enclave:ResultPublicKey"];
+            if (string.IsNullOrEmpty(publicKey))
+            {
+                publicKey = frame.PublicKey;
+            }
+
+            if (frame.Type != EnclaveStreamFrameTypes.Result ||
+                frame.RequestId != enclaveRequest.RequestId ||
+                !EnclaveStreamUtility.VerifyResult(frame, publicKey))
+            {
+                throw new EnclaveException($"Enclave returned an unverifiable result for request {enclaveRequest.RequestId}");
+            }
+
+            return frame;
+        }
+
+        /// <summary>
+        /// Gets a capability token for a protected operation, requesting a new one from the enclave when needed
+        /// </summary>
+        /// <param name="serviceType">Type of service handling the operation</param>
+        /// <param name="operation">Operation to perform</param>
+        /// <returns>The capability token, or null if no service identity is configured</returns>
+        private async Task<string> GetCapabilityTokenAsync(string serviceType, string operation)
+        {
+            var serviceIdentity = _configuration["TeeAuthorization:ServiceIdentity"];
+            var credential = _configuration["TeeAuthorization:Credential"];
+            if (string.IsNullOrEmpty(serviceIdentity) || string.IsNullOrEmpty(credential))
+            {
+                // The enclave rejects the request if it enforces authorization
+                return null;
+            }
+
+            var scope = CapabilityTokenUtility.GetScope(serviceType, operation);
+            if (_capabilityTokens.TryGetValue(scope, out var cached) && cached.ExpiresAt - TokenRefreshMargin > DateTime.UtcNow)
+            {
+                return cached.Token;
+            }
+
+            var tokenResponse = await SendRequestAsync<TeeTokenRequest, TeeTokenResponse>(
+                Constants.EnclaveServiceTypes.Auth,
+                Constants.AuthOperations.IssueToken,
+                new TeeTokenRequest
+                {
+                    ServiceIdentity = serviceIdentity,
+                    Credential = credential,
+                    Scopes = new List<string> { scope }
+                });
+
+            _capabilityTokens[scope] = tokenResponse;
+            return tokenResponse.Token;
+        }
+
+        private void InvalidateCapabilityToken(string serviceType, string operation)
+        {
+            _capabilityTokens.TryRemove(CapabilityTokenUtility.GetScope(serviceType, operation), out _);
+        }
+
         /// <summary>
         /// Gets the attestation document from the enclave
         /// </summary>
@@ -202,6 +349,8 @@ namespace NeoServiceLayer.Enclave.Host
                     return false;
                 }
 
+                _capabilityTokens.Clear();
+
                 _logger.LogInformation("Enclave stopped: {Output}", output);
                 return true;
             }
@@ -1,10 +1,14 @@
 using System;
+using System.Collections.Generic;
 using System.Net;
 using System.Net.Sockets;
+using System.Threading;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
 using NeoServiceLayer.Core;
 using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
 
 namespace NeoServiceLayer.Enclave.Host
 {
@@ -71,6 +75,81 @@ namespace NeoServiceLayer.Enclave.Host
                 throw new EnclaveException("Error sending message to enclave", ex);
             }
         }
+
+        /// <summary>
+        /// Sends a streaming request to the enclave
+        /// </summary>
+        /// <param name="message">Initial request message</param>
+        /// <param name="requestId">ID of the request, stamped on every input frame</param>
+        /// <param name="inputChunks">Input chunks to stream after the request</param>
+        /// <param name="onLog">Callback invoked for each log frame received before the result</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The final result or error frame</returns>
+        public async Task<EnclaveStreamFrame> StreamMessageAsync(
+            byte[] message,
+            string requestId,
+            IAsyncEnumerable<byte[]> inputChunks,
+            Func<EnclaveStreamFrame, Task> onLog,
+            CancellationToken cancellationToken = default)
+        {
+            try
+            {
+                using var socket = new Socket(AddressFamily.Unix, SocketType.Stream, ProtocolType.Unspecified);
+                var endpoint = new VsockEndPoint(Constants.VsockConfig.EnclaveCid, Constants.VsockConfig.EnclavePort);
+
+                await socket.ConnectAsync(endpoint, cancellationToken);
+                using var stream = new NetworkStream(socket, ownsSocket: false);
+
+                await EnclaveStreamUtility.WriteMessageAsync(stream, message, cancellationToken);
+
+                // Stream the input, then mark its end
+                long sequence = 0;
+                if (inputChunks != null)
+                {
+                    await foreach (var chunk in inputChunks.WithCancellation(cancellationToken))
+                    {
+                        await EnclaveStreamUtility.WriteFrameAsync(stream, new EnclaveStreamFrame
+                        {
+                            RequestId = requestId,
+                            Type = EnclaveStreamFrameTypes.Input,
+                            Sequence = sequence++,
+                            Data = chunk
+                        }, cancellationToken);
+                    }
+                }
+
+                await EnclaveStreamUtility.WriteFrameAsync(stream, new EnclaveStreamFrame
+                {
+                    RequestId = requestId,
+                    Type = EnclaveStreamFrameTypes.End,
+                    Sequence = sequence
+                }, cancellationToken);
+
+                // Relay log frames until the enclave sends the outcome
+                while (true)
+                {
+                    var frame = await EnclaveStreamUtility.ReadFrameAsync(stream, cancellationToken: cancellationToken);
+                    if (frame.Type != EnclaveStreamFrameTypes.Log)
+                    {
+                        return frame;
+                    }
+
+                    if (onLog != null)
+                    {
+                        await onLog(frame);
+                    }
+                }
+            }
+            catch (OperationCanceledException)
+            {
+                throw;
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error streaming message to enclave");
+                throw new EnclaveException("Error streaming message to enclave", ex);
+            }
+        }
     }
 
     /// <summary>
@@ -33,7 +33,14 @@ namespace NeoServiceLayer.Enclave
                 services.AddSingleton<EnclaveSecretsService>();
                 services.AddSingleton<EnclavePriceFeedService>();
 
+                // Add authorization for protected operations (default options; configured by the hosted entry point)
+                services.AddSingleton<EnclaveAuthorizationService>();
+
+                // Add signing of streamed execution results
+                services.AddSingleton<EnclaveResultSigningService>();
+
                 // Add function execution services
+                services.AddSingleton<ContractInvocationLimiter>();
                 services.AddSingleton<NodeJsRuntime>();
                 services.AddSingleton<DotNetRuntime>();
                 services.AddSingleton<PythonRuntime>();
@@ -0,0 +1,70 @@
+using System;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Account
+{
+    /// <summary>
+    /// Implementation of the account limits service
+    /// </summary>
+    /// <remarks>
+    /// Reports the same values the function and secrets services enforce, so clients can check a
+    /// deployment or execution against them before making it.
+    /// </remarks>
+    public class AccountLimitsService : IAccountLimitsService
+    {
+        private readonly ILogger<AccountLimitsService> _logger;
+        private readonly IFunctionService _functionService;
+        private readonly ISecretsService _secretsService;
+        private readonly IExecutionTracker _executionTracker;
+        private readonly AccountLimitsConfiguration _configuration;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AccountLimitsService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="functionService">Function service</param>
+        /// <param name="secretsService">Secrets service</param>
+        /// <param name="configuration">Account limits configuration</param>
+        /// <param name="executionTracker">Tracker for running executions</param>
+        public AccountLimitsService(
+            ILogger<AccountLimitsService> logger,
+            IFunctionService functionService,
+            ISecretsService secretsService,
+            IOptions<AccountLimitsConfiguration> configuration,
+            IExecutionTracker executionTracker = null)
+        {
+            _logger = logger;
+            _functionService = functionService;
+            _secretsService = secretsService;
+            _configuration = configuration.Value;
+            _executionTracker = executionTracker;
+        }
+
+        /// <inheritdoc/>
+        public async Task<AccountLimits> GetLimitsAsync(Guid accountId)
+        {
+            _logger.LogInformation("Getting limits for account: {AccountId}", accountId);
+
+            var functions = await _functionService.GetByAccountIdAsync(accountId);
+            var secrets = await _secretsService.GetByAccountIdAsync(accountId);
+            var running = _executionTracker?.GetAll().Count(e => e.AccountId == accountId) ?? 0;
+
+            return new AccountLimits
+            {
+                AccountId = accountId,
+                Functions = new AccountQuota { Limit = _configuration.MaxFunctions, Used = functions?.Count() ?? 0 },
+                ConcurrentExecutions = new AccountQuota { Limit = _configuration.MaxConcurrentExecutions, Used = running },
+                Secrets = new AccountQuota { Limit = Constants.SecretsConfig.MaxSecretsPerAccount, Used = secrets?.Count() ?? 0 },
+                MaxExecutionTimeMs = _configuration.MaxExecutionTimeMs,
+                MaxMemoryMb = _configuration.MaxMemoryMb,
+                MaxSecretValueSizeBytes = Constants.SecretsConfig.MaxValueSizeBytes
+            };
+        }
+    }
+}
@@ -28,6 +28,7 @@ namespace NeoServiceLayer.Services.Account
         private readonly IConfiguration _configuration;
         private readonly IAccountRepository _accountRepository;
         private readonly IEnclaveService _enclaveService;
+        private readonly IDataKeyService _dataKeyService;
 
         /// <summary>
         /// Initializes a new instance of the <see cref="AccountService"/> class
@@ -36,13 +37,15 @@ namespace NeoServiceLayer.Services.Account
         /// <param name="configuration">Configuration</param>
         /// <param name="accountRepository">Account repository</param>
         /// <param name="enclaveService">Enclave service</param>
+        /// <param name="dataKeyService">Data key service; the account's key is revoked on deletion</param>
         public AccountService(ILogger<AccountService> logger, IConfiguration configuration,
-            IAccountRepository accountRepository, IEnclaveService enclaveService)
+            IAccountRepository accountRepository, IEnclaveService enclaveService, IDataKeyService dataKeyService = null)
         {
             _logger = logger;
             _configuration = configuration;
             _accountRepository = accountRepository;
             _enclaveService = enclaveService;
+            _dataKeyService = dataKeyService;
         }
 
         /// <inheritdoc/>
@@ -922,7 +925,15 @@ namespace NeoServiceLayer.Services.Account
                         additionalData["Username"] = account.Username;
                         additionalData["Email"] = account.Email;
 
-                        return await _accountRepository.DeleteAsync(accountId);
+                        var deleted = await _accountRepository.DeleteAsync(accountId);
+
+                        // Revoking the data key makes the account's stored execution data unreadable
+                        if (deleted && _dataKeyService != null)
+                        {
+                            additionalData["DataKeyRevoked"] = await _dataKeyService.RevokeKeyAsync(accountId);
+                        }
+
+                        return deleted;
                     },
                     "DeleteAccount",
                     requestId,
@@ -21,6 +21,7 @@ namespace NeoServiceLayer.Services.Account
 
             // Register services
             services.AddSingleton<IAccountService, AccountService>();
+            services.AddSingleton<IAccountLimitsService, AccountLimitsService>();
 
             return services;
         }
@@ -1,6 +1,7 @@
 using System;
 using System.Collections.Generic;
 using System.Linq;
+using System.Net;
 using System.Threading;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
@@ -8,6 +9,8 @@ using Microsoft.Extensions.Options;
 using NeoServiceLayer.Core.Interfaces;
 using NeoServiceLayer.Core.Models.Analytics;
 using NeoServiceLayer.Services.Analytics.Repositories;
+using NeoServiceLayer.Services.Diagnostics;
+using NeoServiceLayer.Services.Scheduling;
 
 namespace NeoServiceLayer.Services.Analytics
 {
@@ -24,6 +27,10 @@ namespace NeoServiceLayer.Services.Analytics
         private readonly IAlertRepository _alertRepository;
         private readonly INotificationService _notificationService;
         private readonly AnalyticsConfiguration _configuration;
+        private readonly ICrashReporter _crashReporter;
+        private readonly IFunctionService _functionService;
+        private readonly IEnumerable<INotificationProvider> _notificationProviders;
+        private readonly TriggerFiringCoordinator _firingCoordinator;
 
         private Timer _metricCollectionTimer;
         private Timer _alertEvaluationTimer;
@@ -50,6 +57,10 @@ namespace NeoServiceLayer.Services.Analytics
         /// <param name="alertRepository">Alert repository</param>
         /// <param name="notificationService">Notification service</param>
         /// <param name="configuration">Configuration</param>
+        /// <param name="crashReporter">Crash reporter for background work</param>
+        /// <param name="functionService">Function service used by function reports</param>
+        /// <param name="notificationProviders">Notification providers used to deliver function reports</param>
+        /// <param name="firingCoordinator">Coordinator that keeps a scheduled report run to one instance</param>
         public AnalyticsService(
             ILogger<AnalyticsService> logger,
             IMetricRepository metricRepository,
@@ -58,7 +69,11 @@ namespace NeoServiceLayer.Services.Analytics
             IReportRepository reportRepository,
             IAlertRepository alertRepository,
             INotificationService notificationService,
-            IOptions<AnalyticsConfiguration> configuration)
+            IOptions<AnalyticsConfiguration> configuration,
+            ICrashReporter crashReporter = null,
+            IFunctionService functionService = null,
+            IEnumerable<INotificationProvider> notificationProviders = null,
+            TriggerFiringCoordinator firingCoordinator = null)
         {
             _logger = logger;
             _metricRepository = metricRepository;
@@ -68,6 +83,10 @@ namespace NeoServiceLayer.Services.Analytics
             _alertRepository = alertRepository;
             _notificationService = notificationService;
             _configuration = configuration.Value;
+            _crashReporter = crashReporter;
+            _functionService = functionService;
+            _notificationProviders = notificationProviders ?? Enumerable.Empty<INotificationProvider>();
+            _firingCoordinator = firingCoordinator;
 
             // Start timers if enabled
             if (_configuration.Enabled)
@@ -541,6 +560,16 @@ namespace NeoServiceLayer.Services.Analytics
                     report.Format = ReportFormat.PDF;
                 }
 
+                if (report.Type == ReportType.Function)
+                {
+                    ValidateFunctionReport(report);
+                }
+
+                if (report.Schedule != null && report.Schedule.IsScheduled)
+                {
+                    report.NextRunAt = CalculateNextRunTime(report.Schedule);
+                }
+
                 // Create report
                 return await _reportRepository.CreateAsync(report);
             }
@@ -596,6 +625,11 @@ namespace NeoServiceLayer.Services.Analytics
                     throw new ArgumentException("Report name is required");
                 }
 
+                if (report.Type == ReportType.Function)
+                {
+                    ValidateFunctionReport(report);
+                }
+
                 // Update timestamp
                 report.UpdatedAt = DateTime.UtcNow;
 
@@ -1026,6 +1060,9 @@ namespace NeoServiceLayer.Services.Analytics
                     case ReportType.Custom:
                         await ExecuteCustomReportAsync(report, execution);
                         break;
+                    case ReportType.Function:
+                        await ExecuteFunctionReportAsync(report, execution);
+                        break;
                     default:
                         throw new NotSupportedException($"Report type not supported: {report.Type}");
                 }
@@ -1120,6 +1157,69 @@ namespace NeoServiceLayer.Services.Analytics
             await Task.Delay(1000); // Simulate report generation
         }
 
+        /// <summary>
+        /// Executes a function report
+        /// </summary>
+        /// <param name="report">Report to execute</param>
+        /// <param name="execution">Report execution</param>
+        private async Task ExecuteFunctionReportAsync(Report report, ReportExecution execution)
+        {
+            _logger.LogInformation("Executing function report: {ReportId}", report.Id);
+
+            if (_functionService == null)
+            {
+                throw new InvalidOperationException("Function reports are not available: no function service is registered");
+            }
+
+            ValidateFunctionReport(report);
+
+            var function = await _functionService.GetByIdAsync(report.FunctionId.Value);
+            if (function == null || function.AccountId != report.AccountId)
+            {
+                throw new InvalidOperationException($"Function not found: {report.FunctionId}");
+            }
+
+            // Execution parameters override the report's stored parameters
+            var parameters = new Dictionary<string, object>(report.Parameters ?? new Dictionary<string, object>());
+            foreach (var parameter in execution.Parameters ?? new Dictionary<string, object>())
+            {
+                parameters[parameter.Key] = parameter.Value;
+            }
+
+            var output = await _functionService.ExecuteAsync(function.Id, parameters);
+            var generatedAt = DateTime.UtcNow;
+
+            execution.RenderedOutput = ReportTemplateRenderer.Render(report.Template, report.TemplateFormat, new Dictionary<string, object>
+            {
+                ["report"] = new Dictionary<string, object>
+                {
+                    ["id"] = report.Id,
+                    ["name"] = report.Name,
+                    ["description"] = report.Description
+                },
+                ["parameters"] = parameters,
+                ["output"] = output,
+                ["generatedAt"] = generatedAt
+            });
+        }
+
+        /// <summary>
+        /// Validates the fields a function report requires
+        /// </summary>
+        /// <param name="report">Report</param>
+        private static void ValidateFunctionReport(Report report)
+        {
+            if (!report.FunctionId.HasValue || report.FunctionId.Value == Guid.Empty)
+            {
+                throw new ArgumentException("Function ID is required for function reports");
+            }
+
+            if (string.IsNullOrWhiteSpace(report.Template))
+            {
+                throw new ArgumentException("Template is required for function reports");
+            }
+        }
+
         /// <summary>
         /// Delivers a report
         /// </summary>
@@ -1137,6 +1237,12 @@ namespace NeoServiceLayer.Services.Analytics
                 // Deliver via each method
                 foreach (var method in report.Delivery.Methods)
                 {
+                    if (report.Type == ReportType.Function && method != DeliveryMethod.Storage)
+                    {
+                        await DeliverRenderedReportAsync(report, execution, method);
+                        continue;
+                    }
+
                     switch (method)
                     {
                         case DeliveryMethod.Email:
@@ -1163,6 +1269,104 @@ namespace NeoServiceLayer.Services.Analytics
             }
         }
 
+        /// <summary>
+        /// Delivers the rendered output of a function report through the notification providers
+        /// </summary>
+        /// <param name="report">Report</param>
+        /// <param name="execution">Report execution</param>
+        /// <param name="method">Delivery method</param>
+        private async Task DeliverRenderedReportAsync(Report report, ReportExecution execution, DeliveryMethod method)
+        {
+            var subject = report.Delivery.EmailSubject ?? report.Name;
+            var html = report.TemplateFormat == ReportTemplateFormat.Html;
+            IEnumerable<(string Recipient, Core.Models.Notification Notification)> notifications;
+            Core.Models.NotificationChannel channel;
+
+            switch (method)
+            {
+                case DeliveryMethod.Email:
+                    channel = Core.Models.NotificationChannel.Email;
+                    var body = html
+                        ? execution.RenderedOutput
+                        : $"<pre style=\"white-space: pre-wrap\">{WebUtility.HtmlEncode(execution.RenderedOutput)}</pre>";
+                    notifications = (report.Delivery.EmailRecipients ?? new List<string>()).Select(recipient =>
+                        (recipient, CreateReportNotification(report, subject, body, "Email", recipient)));
+                    break;
+                case DeliveryMethod.Webhook:
+                    channel = Core.Models.NotificationChannel.Webhook;
+                    notifications = (report.Delivery.WebhookUrls ?? new List<string>()).Select(url =>
+                    {
+                        var notification = CreateReportNotification(report, subject, execution.RenderedOutput, "WebhookUrl", url);
+                        notification.Data["ReportId"] = report.Id;
+                        notification.Data["ExecutionId"] = execution.Id;
+                        notification.Data["Format"] = report.TemplateFormat.ToString();
+                        return (url, notification);
+                    });
+                    break;
+                case DeliveryMethod.Telegram:
+                    channel = Core.Models.NotificationChannel.Telegram;
+                    notifications = (report.Delivery.TelegramChatIds ?? new List<string>()).Select(chatId =>
+                    {
+                        var notification = CreateReportNotification(report, subject, execution.RenderedOutput, "TelegramChatId", chatId);
+                        if (html)
+                        {
+                            notification.Data["TelegramParseMode"] = "HTML";
+                        }
+
+                        return (chatId, notification);
+                    });
+                    break;
+                default:
+                    _logger.LogWarning("Unsupported delivery method for function reports: {Method}", method);
+                    return;
+            }
+
+            var provider = _notificationProviders.FirstOrDefault(p => p.Channel == channel && p.IsEnabled);
+            foreach (var (recipient, notification) in notifications)
+            {
+                var status = new DeliveryStatus
+                {
+                    Method = method,
+                    Status = DeliveryStatusType.Failed,
+                    Timestamp = DateTime.UtcNow,
+                    Recipient = recipient
+                };
+
+                if (provider == null)
+                {
+                    status.ErrorMessage = $"No enabled {channel} notification provider";
+                }
+                else
+                {
+                    var (result, errorMessage) = await provider.SendAsync(notification);
+                    if (result == Core.Models.NotificationDeliveryStatus.Failed)
+                    {
+                        _logger.LogWarning("Error delivering report {ReportId} by {Method} to {Recipient}: {Error}", report.Id, method, recipient, errorMessage);
+                        status.ErrorMessage = errorMessage;
+                    }
+                    else
+                    {
+                        status.Status = DeliveryStatusType.Sent;
+                    }
+                }
+
+                execution.DeliveryStatus[$"{method}:{recipient}"] = status;
+            }
+        }
+
+        private static Core.Models.Notification CreateReportNotification(Report report, string subject, string content, string recipientKey, string recipient)
+        {
+            return new Core.Models.Notification
+            {
+                Id = Guid.NewGuid(),
+                AccountId = report.AccountId,
+                Subject = subject,
+                Content = content,
+                CreatedAt = DateTime.UtcNow,
+                Data = new Dictionary<string, object> { [recipientKey] = recipient }
+            };
+        }
+
         /// <summary>
         /// Delivers a report by email
         /// </summary>
@@ -1956,35 +2160,35 @@ namespace NeoServiceLayer.Services.Analytics
 
             // Start metric collection timer
             _metricCollectionTimer = new Timer(
-                async _ => await CollectMetricsAsync(),
+                _crashReporter.Guard(_logger, "Analytics.CollectMetrics", CollectMetricsAsync),
                 null,
                 TimeSpan.FromSeconds(5),
                 TimeSpan.FromSeconds(_configuration.MetricCollectionIntervalSeconds));
 
             // Start alert evaluation timer
             _alertEvaluationTimer = new Timer(
-                async _ => await EvaluateAlertsAsync(),
+                _crashReporter.Guard(_logger, "Analytics.EvaluateAlerts", EvaluateAlertsAsync),
                 null,
                 TimeSpan.FromSeconds(10),
                 TimeSpan.FromSeconds(_configuration.AlertEvaluationIntervalSeconds));
 
             // Start report execution timer
             _reportExecutionTimer = new Timer(
-                async _ => await ExecuteReportsAsync(),
+                _crashReporter.Guard(_logger, "Analytics.ExecuteReports", ExecuteReportsAsync),
                 null,
                 TimeSpan.FromSeconds(15),
                 TimeSpan.FromSeconds(_configuration.ReportExecutionIntervalSeconds));
 
             // Start data retention timer
             _dataRetentionTimer = new Timer(
-                async _ => await EnforceDataRetentionAsync(),
+                _crashReporter.Guard(_logger, "Analytics.EnforceDataRetention", EnforceDataRetentionAsync),
                 null,
                 TimeSpan.FromHours(1),
                 TimeSpan.FromHours(24));
 
             // Start event flush timer
             _eventFlushTimer = new Timer(
-                async _ => await FlushEventBatchAsync(),
+                _crashReporter.Guard(_logger, "Analytics.FlushEventBatch", FlushEventBatchAsync),
                 null,
                 TimeSpan.FromSeconds(_configuration.EventFlushIntervalSeconds),
                 TimeSpan.FromSeconds(_configuration.EventFlushIntervalSeconds));
@@ -2038,6 +2242,26 @@ namespace NeoServiceLayer.Services.Analytics
                 {
                     try
                     {
+                        // Claim this firing before running it so another instance does not run it too
+                        Core.Models.TriggerFiring firing = null;
+                        if (_firingCoordinator != null)
+                        {
+                            var claim = await _firingCoordinator.ClaimAsync(
+                                "report", report.Id, report.NextRunAt.Value, TimeSpan.FromSeconds(_configuration.MaxReportExecutionTimeSeconds));
+                            if (!claim.Acquired)
+                            {
+                                if (claim.Abandoned && report.NextRunAt == claim.Firing.ScheduledFor)
+                                {
+                                    report.NextRunAt = CalculateNextRunTime(report.Schedule);
+                                    await _reportRepository.UpdateAsync(report);
+                                }
+
+                                continue;
+                            }
+
+                            firing = claim.Firing;
+                        }
+
                         // Create execution
                         var execution = new ReportExecution
                         {
@@ -2052,6 +2276,11 @@ namespace NeoServiceLayer.Services.Analytics
 
                         // Execute report
                         await ExecuteReportInternalAsync(report, execution);
+
+                        if (firing != null)
+                        {
+                            await _firingCoordinator.CompleteAsync(firing, execution.Status == ReportExecutionStatus.Completed, execution.ErrorMessage);
+                        }
                     }
                     catch (Exception ex)
                     {
@@ -0,0 +1,180 @@
+using System;
+using System.Globalization;
+using System.Net;
+using System.Text;
+using System.Text.Json;
+using System.Text.RegularExpressions;
+using NeoServiceLayer.Core.Models.Analytics;
+
+namespace NeoServiceLayer.Services.Analytics
+{
+    /// <summary>
+    /// Renders function report templates
+    /// </summary>
+    /// <remarks>
+    /// Templates use a small mustache-like syntax. <c>{{path}}</c> inserts the value at a dotted path of the model,
+    /// and <c>{{#each path}}...{{/each}}</c> repeats its body for each element of an array, where <c>{{this}}</c>
+    /// and <c>{{this.name}}</c> refer to the current element and <c>{{@index}}</c> to its position. Missing values
+    /// render as empty, objects and arrays render as JSON, and HTML templates have every value HTML-encoded.
+    /// </remarks>
+    public static class ReportTemplateRenderer
+    {
+        private const string EachOpen = "{{#each ";
+        private const string EachClose = "{{/each}}";
+
+        private static readonly Regex PlaceholderPattern = new Regex(@"\{\{\s*([^#/{}\s][^{}]*?)\s*\}\}", RegexOptions.Compiled);
+
+        /// <summary>
+        /// Renders a template against a model
+        /// </summary>
+        /// <param name="template">Template</param>
+        /// <param name="format">Template format</param>
+        /// <param name="model">Model; serialized to JSON before rendering</param>
+        /// <returns>Rendered output</returns>
+        public static string Render(string template, ReportTemplateFormat format, object model)
+        {
+            if (string.IsNullOrEmpty(template))
+            {
+                return string.Empty;
+            }
+
+            var root = model is JsonElement element ? element : JsonSerializer.SerializeToElement(model);
+            return RenderBlock(template, format, root, root, -1);
+        }
+
+        private static string RenderBlock(string template, ReportTemplateFormat format, JsonElement root, JsonElement scope, int index)
+        {
+            var result = new StringBuilder();
+            var position = 0;
+
+            while (position < template.Length)
+            {
+                var open = template.IndexOf(EachOpen, position, StringComparison.Ordinal);
+                if (open < 0)
+                {
+                    result.Append(RenderPlaceholders(template.Substring(position), format, root, scope, index));
+                    break;
+                }
+
+                result.Append(RenderPlaceholders(template.Substring(position, open - position), format, root, scope, index));
+
+                var pathEnd = template.IndexOf("}}", open, StringComparison.Ordinal);
+                if (pathEnd < 0)
+                {
+                    throw new FormatException("Unterminated {{#each}} tag in report template");
+                }
+
+                var path = template.Substring(open + EachOpen.Length, pathEnd - open - EachOpen.Length).Trim();
+                var bodyStart = pathEnd + 2;
+                var close = FindMatchingClose(template, bodyStart);
+                var body = template.Substring(bodyStart, close - bodyStart);
+
+                if (TryResolve(path, root, scope, out var items) && items.ValueKind == JsonValueKind.Array)
+                {
+                    var itemIndex = 0;
+                    foreach (var item in items.EnumerateArray())
+                    {
+                        result.Append(RenderBlock(body, format, root, item, itemIndex++));
+                    }
+                }
+
+                position = close + EachClose.Length;
+            }
+
+            return result.ToString();
+        }
+
+        private static int FindMatchingClose(string template, int start)
+        {
+            var depth = 1;
+            var position = start;
+
+            while (true)
+            {
+                var nextOpen = template.IndexOf(EachOpen, position, StringComparison.Ordinal);
+                var nextClose = template.IndexOf(EachClose, position, StringComparison.Ordinal);
+                if (nextClose < 0)
+                {
+                    throw new FormatException("Missing {{/each}} in report template");
+                }
+
+                if (nextOpen >= 0 && nextOpen < nextClose)
+                {
+                    depth++;
+                    position = nextOpen + EachOpen.Length;
+                    continue;
+                }
+
+                if (--depth == 0)
+                {
+                    return nextClose;
+                }
+
+                position = nextClose + EachClose.Length;
+            }
+        }
+
+        private static string RenderPlaceholders(string text, ReportTemplateFormat format, JsonElement root, JsonElement scope, int index)
+        {
+            return PlaceholderPattern.Replace(text, match =>
+            {
+                var path = match.Groups[1].Value;
+                string value;
+                if (path == "@index")
+                {
+                    value = index >= 0 ? index.ToString(CultureInfo.InvariantCulture) : string.Empty;
+                }
+                else
+                {
+                    value = TryResolve(path, root, scope, out var element) ? FormatValue(element) : string.Empty;
+                }
+
+                return format == ReportTemplateFormat.Html ? WebUtility.HtmlEncode(value) : value;
+            });
+        }
+
+        private static bool TryResolve(string path, JsonElement root, JsonElement scope, out JsonElement value)
+        {
+            var segments = path.Split('.');
+            var current = root;
+            var start = 0;
+            if (segments[0] == "this")
+            {
+                current = scope;
+                start = 1;
+            }
+
+            for (var i = start; i < segments.Length; i++)
+            {
+                if (current.ValueKind == JsonValueKind.Object && current.TryGetProperty(segments[i], out var property))
+                {
+                    current = property;
+                }
+                else if (current.ValueKind == JsonValueKind.Array
+                    && int.TryParse(segments[i], NumberStyles.None, CultureInfo.InvariantCulture, out var position)
+                    && position < current.GetArrayLength())
+                {
+                    current = current[position];
+                }
+                else
+                {
+                    value = default;
+                    return false;
+                }
+            }
+
+            value = current;
+            return true;
+        }
+
+        private static string FormatValue(JsonElement value)
+        {
+            return value.ValueKind switch
+            {
+                JsonValueKind.String => value.GetString(),
+                JsonValueKind.Null or JsonValueKind.Undefined => string.Empty,
+                _ => value.GetRawText()
+            };
+        }
+    }
+}
@@ -0,0 +1,230 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.Linq;
+using System.Security.Cryptography;
+using System.Text;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.ApiCredentials.Repositories;
+
+namespace NeoServiceLayer.Services.ApiCredentials
+{
+    /// <summary>
+    /// Implementation of the service managing accounts' own API keys
+    /// </summary>
+    /// <remarks>
+    /// Keys have the form <c>nsl_{credential id}_{secret}</c>, so a presented key is looked up by ID and checked
+    /// against the stored SHA-256 hash. Rate limits are counted per API instance.
+    /// </remarks>
+    public class ApiCredentialService : IApiCredentialService
+    {
+        private const string KeyMarker = "nsl_";
+
+        private readonly ILogger<ApiCredentialService> _logger;
+        private readonly IApiCredentialRepository _credentialRepository;
+        private readonly ApiCredentialConfiguration _configuration;
+        private readonly ConcurrentDictionary<Guid, RequestWindow> _requestWindows = new ConcurrentDictionary<Guid, RequestWindow>();
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ApiCredentialService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="credentialRepository">API credential repository</param>
+        /// <param name="configuration">Configuration</param>
+        public ApiCredentialService(
+            ILogger<ApiCredentialService> logger,
+            IApiCredentialRepository credentialRepository,
+            IOptions<ApiCredentialConfiguration> configuration = null)
+        {
+            _logger = logger;
+            _credentialRepository = credentialRepository;
+            _configuration = configuration?.Value ?? new ApiCredentialConfiguration();
+        }
+
+        /// <inheritdoc/>
+        public async Task<CreatedApiCredential> CreateAsync(
+            Guid accountId,
+            string name,
+            IEnumerable<string> scopes,
+            int? rateLimit = null,
+            int rateLimitPeriodSeconds = 60,
+            DateTime? expiresAt = null)
+        {
+            if (accountId == Guid.Empty)
+            {
+                throw new ArgumentException("Account ID is required", nameof(accountId));
+            }
+
+            if (string.IsNullOrWhiteSpace(name))
+            {
+                throw new ArgumentException("Name is required", nameof(name));
+            }
+
+            var scopeList = (scopes ?? Enumerable.Empty<string>()).Select(s => s?.Trim().ToLowerInvariant()).Distinct().ToList();
+            var unknown = scopeList.Where(s => !ApiScopes.IsKnown(s)).ToList();
+            if (unknown.Any())
+            {
+                throw new ArgumentException($"Unknown scopes: {string.Join(", ", unknown)}", nameof(scopes));
+            }
+
+            if (rateLimit.HasValue && (rateLimit.Value <= 0 || rateLimitPeriodSeconds < _configuration.MinRateLimitPeriodSeconds))
+            {
+                throw new ArgumentException(
+                    $"Rate limit must be positive and its period at least {_configuration.MinRateLimitPeriodSeconds} seconds", nameof(rateLimit));
+            }
+
+            var now = DateTime.UtcNow;
+            if (expiresAt.HasValue && expiresAt.Value.ToUniversalTime() <= now)
+            {
+                throw new ArgumentException("Expiry must be in the future", nameof(expiresAt));
+            }
+
+            var active = (await _credentialRepository.GetByAccountIdAsync(accountId)).Count(c => c.IsActiveAt(now));
+            if (active >= _configuration.MaxKeysPerAccount)
+            {
+                throw new ValidationException($"An account can have at most {_configuration.MaxKeysPerAccount} active API keys");
+            }
+
+            var id = Guid.NewGuid();
+            var key = $"{KeyMarker}{id:N}_{Base64UrlEncode(RandomNumberGenerator.GetBytes(32))}";
+            var credential = new ApiCredential
+            {
+                Id = id,
+                AccountId = accountId,
+                Name = name.Trim(),
+                KeyPrefix = key.Substring(0, KeyMarker.Length + 8),
+                KeyHash = Hash(key),
+                Scopes = scopeList,
+                RateLimit = rateLimit,
+                RateLimitPeriodSeconds = rateLimitPeriodSeconds,
+                CreatedAt = now,
+                ExpiresAt = expiresAt?.ToUniversalTime()
+            };
+
+            await _credentialRepository.CreateAsync(credential);
+
+            _logger.LogInformation("Created API key {CredentialId} for account {AccountId} with scopes {Scopes}",
+                credential.Id, accountId, string.Join(",", scopeList));
+
+            return new CreatedApiCredential { Credential = credential, Key = key };
+        }
+
+        /// <inheritdoc/>
+        public async Task<ApiCredential> AuthenticateAsync(string key)
+        {
+            if (!TryParseId(key, out var id))
+            {
+                return null;
+            }
+
+            var credential = await _credentialRepository.GetByIdAsync(id);
+            var now = DateTime.UtcNow;
+            if (credential == null || !credential.IsActiveAt(now) ||
+                !CryptographicOperations.FixedTimeEquals(Encoding.ASCII.GetBytes(Hash(key)), Encoding.ASCII.GetBytes(credential.KeyHash ?? string.Empty)))
+            {
+                return null;
+            }
+
+            // Last use is kept to the minute so busy keys don't write on every request
+            if (credential.LastUsedAt == null || now - credential.LastUsedAt.Value >= TimeSpan.FromMinutes(1))
+            {
+                credential.LastUsedAt = now;
+                try
+                {
+                    await _credentialRepository.UpdateAsync(credential);
+                }
+                catch (Exception ex)
+                {
+                    _logger.LogWarning(ex, "Error recording use of API key {CredentialId}", credential.Id);
+                }
+            }
+
+            return credential;
+        }
+
+        /// <inheritdoc/>
+        public bool TryCountRequest(Guid credentialId, int rateLimit, int rateLimitPeriodSeconds)
+        {
+            var window = _requestWindows.GetOrAdd(credentialId, _ => new RequestWindow { Start = DateTime.UtcNow });
+
+            lock (window)
+            {
+                var now = DateTime.UtcNow;
+                if (now - window.Start >= TimeSpan.FromSeconds(rateLimitPeriodSeconds))
+                {
+                    window.Start = now;
+                    window.Count = 0;
+                }
+
+                if (window.Count >= rateLimit)
+                {
+                    return false;
+                }
+
+                window.Count++;
+                return true;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<ApiCredential>> GetByAccountIdAsync(Guid accountId)
+        {
+            var credentials = await _credentialRepository.GetByAccountIdAsync(accountId);
+            return credentials.OrderByDescending(c => c.CreatedAt).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<ApiCredential> RevokeAsync(Guid id, Guid accountId)
+        {
+            var credential = await _credentialRepository.GetByIdAsync(id);
+            if (credential == null || credential.AccountId != accountId)
+            {
+                throw new ResourceNotFoundException("ApiCredential", id.ToString());
+            }
+
+            if (credential.RevokedAt.HasValue)
+            {
+                return credential;
+            }
+
+            credential.RevokedAt = DateTime.UtcNow;
+            _requestWindows.TryRemove(id, out _);
+
+            _logger.LogInformation("Revoked API key {CredentialId} of account {AccountId}", id, accountId);
+
+            return await _credentialRepository.UpdateAsync(credential);
+        }
+
+        private static bool TryParseId(string key, out Guid id)
+        {
+            id = Guid.Empty;
+            return !string.IsNullOrEmpty(key) &&
+                key.StartsWith(KeyMarker, StringComparison.Ordinal) &&
+                key.Length > KeyMarker.Length + 33 &&
+                key[KeyMarker.Length + 32] == '_' &&
+                Guid.TryParseExact(key.Substring(KeyMarker.Length, 32), "N", out id);
+        }
+
+        private static string Hash(string key)
+        {
+            return Convert.ToHexString(SHA256.HashData(Encoding.UTF8.GetBytes(key))).ToLowerInvariant();
+        }
+
+        private static string Base64UrlEncode(byte[] bytes)
+        {
+            return Convert.ToBase64String(bytes).TrimEnd('=').Replace('+', '-').Replace('/', '_');
+        }
+
+        private class RequestWindow
+        {
+            public DateTime Start { get; set; }
+
+            public int Count { get; set; }
+        }
+    }
+}
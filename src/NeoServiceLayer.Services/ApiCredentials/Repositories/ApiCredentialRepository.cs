@@ -0,0 +1,115 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.ApiCredentials.Repositories
+{
+    /// <summary>
+    /// Implementation of the API credential repository
+    /// </summary>
+    public class ApiCredentialRepository : IApiCredentialRepository
+    {
+        private readonly ILogger<ApiCredentialRepository> _logger;
+        private readonly IDatabaseService _databaseService;
+        private const string CollectionName = "api_credentials";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ApiCredentialRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="databaseService">Database service</param>
+        public ApiCredentialRepository(ILogger<ApiCredentialRepository> logger, IDatabaseService databaseService)
+        {
+            _logger = logger;
+            _databaseService = databaseService;
+        }
+
+        /// <inheritdoc/>
+        public async Task<ApiCredential> CreateAsync(ApiCredential credential)
+        {
+            _logger.LogInformation("Creating API credential for account {AccountId}", credential.AccountId);
+
+            try
+            {
+                if (credential.Id == Guid.Empty)
+                {
+                    credential.Id = Guid.NewGuid();
+                }
+
+                // Create collection if it doesn't exist
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    await _databaseService.CreateCollectionAsync(CollectionName);
+                }
+
+                return await _databaseService.CreateAsync(CollectionName, credential);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error creating API credential for account {AccountId}", credential.AccountId);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<ApiCredential> GetByIdAsync(Guid id)
+        {
+            _logger.LogDebug("Getting API credential: {Id}", id);
+
+            try
+            {
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    return null;
+                }
+
+                return await _databaseService.GetByIdAsync<ApiCredential, Guid>(CollectionName, id);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting API credential: {Id}", id);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<ApiCredential>> GetByAccountIdAsync(Guid accountId)
+        {
+            _logger.LogDebug("Getting API credentials for account {AccountId}", accountId);
+
+            try
+            {
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    return new List<ApiCredential>();
+                }
+
+                return await _databaseService.GetByFilterAsync<ApiCredential>(CollectionName, c => c.AccountId == accountId);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting API credentials for account {AccountId}", accountId);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<ApiCredential> UpdateAsync(ApiCredential credential)
+        {
+            _logger.LogInformation("Updating API credential: {Id}", credential.Id);
+
+            try
+            {
+                return await _databaseService.UpdateAsync<ApiCredential, Guid>(CollectionName, credential.Id, credential);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error updating API credential: {Id}", credential.Id);
+                throw;
+            }
+        }
+    }
+}
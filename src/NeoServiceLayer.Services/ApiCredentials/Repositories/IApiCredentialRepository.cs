@@ -0,0 +1,41 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.ApiCredentials.Repositories
+{
+    /// <summary>
+    /// Interface for API credential repository
+    /// </summary>
+    public interface IApiCredentialRepository
+    {
+        /// <summary>
+        /// Creates a credential
+        /// </summary>
+        /// <param name="credential">Credential to create</param>
+        /// <returns>The created credential</returns>
+        Task<ApiCredential> CreateAsync(ApiCredential credential);
+
+        /// <summary>
+        /// Gets a credential by ID
+        /// </summary>
+        /// <param name="id">Credential ID</param>
+        /// <returns>The credential, or null if not found</returns>
+        Task<ApiCredential> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the credentials of an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>List of credentials</returns>
+        Task<IEnumerable<ApiCredential>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Updates a credential
+        /// </summary>
+        /// <param name="credential">Credential to update</param>
+        /// <returns>The updated credential</returns>
+        Task<ApiCredential> UpdateAsync(ApiCredential credential);
+    }
+}
@@ -0,0 +1,557 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Core.Utilities;
+using NeoServiceLayer.Services.Diagnostics;
+
+namespace NeoServiceLayer.Services.Automation
+{
+    /// <summary>
+    /// Keeps registered contracts' upkeeps, in the style of Chainlink Automation
+    /// </summary>
+    /// <remarks>
+    /// On each upkeep's interval the keeper test-invokes <c>checkUpkeep(checkData)</c>. When the contract returns
+    /// <c>[true, performData]</c>, <c>performUpkeep(performData)</c> is test-invoked to measure its GAS, then sent from the
+    /// GasBank account's hot wallet with the upkeep's gas limit as the system fee cap. The GAS the test invocation
+    /// consumed is charged to the GasBank account. Only the leader instance performs upkeeps. When the signer pool is
+    /// enabled, performUpkeep is sent from a pool account instead, so upkeeps firing together do not queue behind one
+    /// sender in the mempool.
+    /// </remarks>
+    public class AutomationService : IAutomationService, IManagedService, IDisposable
+    {
+        private const string CheckUpkeepMethod = "checkUpkeep";
+        private const string PerformUpkeepMethod = "performUpkeep";
+
+        // GAS has 8 decimals; invocation results report consumption in its smallest unit
+        private const decimal GasFactor = 100_000_000m;
+
+        private readonly ILogger<AutomationService> _logger;
+        private readonly IUpkeepRepository _upkeepRepository;
+        private readonly INeoRpcClient _rpcClient;
+        private readonly IGasBankService _gasBankService;
+        private readonly IWalletService _walletService;
+        private readonly ILeadershipService _leadership;
+        private readonly IMaintenanceService _maintenanceService;
+        private readonly ICrashReporter _crashReporter;
+        private readonly ISignerPool _signerPool;
+        private readonly AutomationConfiguration _configuration;
+        private readonly SemaphoreSlim _cycleSemaphore = new SemaphoreSlim(1, 1);
+
+        private Timer _pollTimer;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AutomationService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="upkeepRepository">Upkeep repository</param>
+        /// <param name="rpcClient">Neo RPC client for test invocations</param>
+        /// <param name="gasBankService">GasBank service that pays for performed upkeeps</param>
+        /// <param name="walletService">Wallet service that sends performUpkeep transactions</param>
+        /// <param name="configuration">Automation configuration</param>
+        /// <param name="leadership">Leadership service; only the leader performs upkeeps</param>
+        /// <param name="maintenanceService">Maintenance service; upkeeps are not checked while maintenance is active</param>
+        /// <param name="crashReporter">Crash reporter for the poll timer</param>
+        /// <param name="signerPool">Pool of accounts that sign performUpkeep transactions when enabled</param>
+        public AutomationService(
+            ILogger<AutomationService> logger,
+            IUpkeepRepository upkeepRepository,
+            INeoRpcClient rpcClient,
+            IGasBankService gasBankService,
+            IWalletService walletService,
+            IOptions<AutomationConfiguration> configuration,
+            ILeadershipService leadership = null,
+            IMaintenanceService maintenanceService = null,
+            ICrashReporter crashReporter = null,
+            ISignerPool signerPool = null)
+        {
+            _logger = logger;
+            _upkeepRepository = upkeepRepository;
+            _rpcClient = rpcClient;
+            _gasBankService = gasBankService;
+            _walletService = walletService;
+            _leadership = leadership;
+            _maintenanceService = maintenanceService;
+            _crashReporter = crashReporter;
+            _signerPool = signerPool;
+            _configuration = configuration.Value;
+        }
+
+        /// <inheritdoc/>
+        public async Task<Upkeep> RegisterAsync(Upkeep upkeep)
+        {
+            if (upkeep == null)
+            {
+                throw new ArgumentNullException(nameof(upkeep));
+            }
+
+            if (string.IsNullOrWhiteSpace(upkeep.Name))
+            {
+                throw new ValidationException("Upkeep name is required");
+            }
+
+            if (!upkeep.ContractHash.IsValidScriptHash())
+            {
+                throw new ValidationException("Contract hash is not a valid script hash");
+            }
+
+            if (!TryDecodeHex(upkeep.CheckData, out _))
+            {
+                throw new ValidationException("Check data must be hex encoded");
+            }
+
+            if (upkeep.GasLimit == 0)
+            {
+                upkeep.GasLimit = _configuration.DefaultGasLimit;
+            }
+
+            if (upkeep.GasLimit < 0 || upkeep.GasLimit > _configuration.MaxGasLimit)
+            {
+                throw new ValidationException($"Gas limit must be greater than 0 and at most {_configuration.MaxGasLimit} GAS");
+            }
+
+            if (upkeep.CheckIntervalSeconds == 0)
+            {
+                upkeep.CheckIntervalSeconds = _configuration.MinCheckIntervalSeconds;
+            }
+
+            if (upkeep.CheckIntervalSeconds < _configuration.MinCheckIntervalSeconds)
+            {
+                throw new ValidationException($"Check interval must be at least {_configuration.MinCheckIntervalSeconds} seconds");
+            }
+
+            var gasBankAccount = await _gasBankService.GetByIdAsync(upkeep.GasBankAccountId);
+            if (gasBankAccount == null)
+            {
+                throw new ResourceNotFoundException("GasBankAccount", upkeep.GasBankAccountId.ToString());
+            }
+
+            if (gasBankAccount.AccountId != upkeep.AccountId)
+            {
+                throw new ForbiddenAccessException("GasBankAccount", upkeep.GasBankAccountId.ToString(), upkeep.AccountId.ToString());
+            }
+
+            upkeep.Id = Guid.NewGuid();
+            upkeep.Status = UpkeepStatus.Active;
+            upkeep.CreatedAt = DateTime.UtcNow;
+            upkeep.UpdatedAt = upkeep.CreatedAt;
+            upkeep.LastCheckedAt = null;
+            upkeep.LastPerformedAt = null;
+            upkeep.LastTransactionHash = null;
+            upkeep.LastError = null;
+            upkeep.PerformCount = 0;
+            upkeep.TotalGasSpent = 0;
+
+            _logger.LogInformation("Registering upkeep {Name} for contract {ContractHash}", upkeep.Name, upkeep.ContractHash);
+            return await _upkeepRepository.CreateAsync(upkeep);
+        }
+
+        /// <inheritdoc/>
+        public Task<Upkeep> GetByIdAsync(Guid id)
+        {
+            return _upkeepRepository.GetByIdAsync(id);
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<Upkeep>> GetByAccountIdAsync(Guid accountId)
+        {
+            return _upkeepRepository.GetByAccountIdAsync(accountId);
+        }
+
+        /// <inheritdoc/>
+        public Task<Upkeep> PauseAsync(Guid id)
+        {
+            return SetStatusAsync(id, UpkeepStatus.Paused);
+        }
+
+        /// <inheritdoc/>
+        public Task<Upkeep> ResumeAsync(Guid id)
+        {
+            return SetStatusAsync(id, UpkeepStatus.Active);
+        }
+
+        /// <inheritdoc/>
+        public Task<Upkeep> CancelAsync(Guid id)
+        {
+            return SetStatusAsync(id, UpkeepStatus.Cancelled);
+        }
+
+        /// <inheritdoc/>
+        public string Name => Constants.ManagedServices.Automation;
+
+        /// <inheritdoc/>
+        public IReadOnlyCollection<string> DependsOn { get; } = new[] { Constants.ManagedServices.Leadership, Constants.ManagedServices.GasBank };
+
+        /// <inheritdoc/>
+        public Task StartAsync()
+        {
+            if (!_configuration.Enabled || _pollTimer != null)
+            {
+                return Task.CompletedTask;
+            }
+
+            _logger.LogInformation("Checking upkeeps every {Interval} seconds", _configuration.PollIntervalSeconds);
+
+            _pollTimer = new Timer(
+                _crashReporter.Guard(_logger, "Automation.Poll", RunCycleAsync),
+                null,
+                TimeSpan.FromSeconds(_configuration.PollIntervalSeconds),
+                TimeSpan.FromSeconds(_configuration.PollIntervalSeconds));
+
+            return Task.CompletedTask;
+        }
+
+        /// <inheritdoc/>
+        public async Task StopAsync()
+        {
+            _pollTimer?.Change(Timeout.Infinite, Timeout.Infinite);
+            _pollTimer?.Dispose();
+            _pollTimer = null;
+
+            // Let a cycle already running finish
+            await _cycleSemaphore.WaitAsync();
+            _cycleSemaphore.Release();
+        }
+
+        /// <inheritdoc/>
+        public ManagedServiceHealth GetHealth()
+        {
+            if (!_configuration.Enabled)
+            {
+                return ManagedServiceHealth.Of(Name, ManagedServiceStatus.Disabled);
+            }
+
+            return ManagedServiceHealth.Of(Name, _pollTimer != null ? ManagedServiceStatus.Running : ManagedServiceStatus.Stopped);
+        }
+
+        /// <summary>
+        /// Checks every active upkeep whose interval has elapsed
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task RunCycleAsync()
+        {
+            if (!await _cycleSemaphore.WaitAsync(0))
+            {
+                return;
+            }
+
+            try
+            {
+                if (_leadership?.IsLeader == false)
+                {
+                    return;
+                }
+
+                if (_maintenanceService != null && await _maintenanceService.IsActiveAsync())
+                {
+                    return;
+                }
+
+                var now = DateTime.UtcNow;
+                var due = (await _upkeepRepository.GetActiveAsync())
+                    .Where(u => u.LastCheckedAt == null || u.LastCheckedAt.Value.AddSeconds(u.CheckIntervalSeconds) <= now)
+                    .OrderBy(u => u.LastCheckedAt ?? DateTime.MinValue)
+                    .ToList();
+
+                using var slots = new SemaphoreSlim(Math.Max(1, _configuration.MaxConcurrentChecks));
+                await Task.WhenAll(due.Select(async upkeep =>
+                {
+                    await slots.WaitAsync();
+                    try
+                    {
+                        await CheckAndPerformAsync(upkeep);
+                    }
+                    finally
+                    {
+                        slots.Release();
+                    }
+                }));
+            }
+            finally
+            {
+                _cycleSemaphore.Release();
+            }
+        }
+
+        /// <summary>
+        /// Calls checkUpkeep on an upkeep's contract and sends performUpkeep if the contract asks for it
+        /// </summary>
+        /// <param name="upkeep">Upkeep to check</param>
+        /// <returns>The upkeep with the outcome recorded</returns>
+        public async Task<Upkeep> CheckAndPerformAsync(Upkeep upkeep)
+        {
+            upkeep.LastCheckedAt = DateTime.UtcNow;
+
+            try
+            {
+                upkeep.LastError = await TryPerformAsync(upkeep);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogWarning(ex, "Error keeping upkeep {UpkeepId}", upkeep.Id);
+                upkeep.LastError = ex.Message;
+            }
+
+            upkeep.UpdatedAt = DateTime.UtcNow;
+            return await _upkeepRepository.UpdateAsync(upkeep);
+        }
+
+        // Returns why the upkeep was not performed, or null if it did not need to be or was performed
+        private async Task<string> TryPerformAsync(Upkeep upkeep)
+        {
+            TryDecodeHex(upkeep.CheckData, out var checkData);
+
+            var check = await _rpcClient.InvokeFunctionAsync(upkeep.ContractHash, CheckUpkeepMethod,
+                new[] { NeoContractParameter.ByteArray(checkData) });
+            if (!check.IsHalt)
+            {
+                return $"checkUpkeep faulted: {check.Exception}";
+            }
+
+            if (!TryParseCheckResult(check, out var upkeepNeeded, out var performData))
+            {
+                return "checkUpkeep did not return [upkeepNeeded, performData]";
+            }
+
+            if (!upkeepNeeded)
+            {
+                return null;
+            }
+
+            var performArgs = new[] { NeoContractParameter.ByteArray(performData) };
+
+            var dryRun = await _rpcClient.InvokeFunctionAsync(upkeep.ContractHash, PerformUpkeepMethod, performArgs);
+            if (!dryRun.IsHalt)
+            {
+                return $"performUpkeep faulted in test invocation: {dryRun.Exception}";
+            }
+
+            var gasConsumed = long.TryParse(dryRun.GasConsumed, NumberStyles.Integer, CultureInfo.InvariantCulture, out var units)
+                ? units / GasFactor
+                : upkeep.GasLimit;
+            if (gasConsumed > upkeep.GasLimit)
+            {
+                return $"performUpkeep needs {gasConsumed} GAS, above the gas limit of {upkeep.GasLimit} GAS";
+            }
+
+            var gasBankAccount = await _gasBankService.GetByIdAsync(upkeep.GasBankAccountId);
+            if (gasBankAccount == null)
+            {
+                return "GasBank account not found";
+            }
+
+            // The sent transaction may consume up to the limit, so the account must cover all of it
+            if (gasBankAccount.Balance - gasBankAccount.AllocatedAmount < upkeep.GasLimit)
+            {
+                return $"GasBank account balance does not cover the gas limit of {upkeep.GasLimit} GAS";
+            }
+
+            var walletId = gasBankAccount.WalletId;
+            SignerLease lease = null;
+            if (_signerPool?.IsEnabled == true)
+            {
+                lease = await _signerPool.AcquireAsync(upkeep.GasLimit);
+                if (lease == null)
+                {
+                    return "No signer account can send performUpkeep now";
+                }
+
+                walletId = lease.WalletId;
+            }
+
+            string transactionHash;
+            try
+            {
+                transactionHash = await _walletService.InvokeContractAsync(
+                    walletId,
+                    Guid.NewGuid().ToString(), // Password is not used for service wallets
+                    upkeep.ContractHash,
+                    PerformUpkeepMethod,
+                    performArgs,
+                    upkeep.GasLimit);
+            }
+            catch (Exception ex) when (lease != null)
+            {
+                _signerPool.Fail(lease, ex.Message);
+                throw;
+            }
+
+            if (lease != null)
+            {
+                _signerPool.Complete(lease, transactionHash);
+            }
+
+            upkeep.LastPerformedAt = DateTime.UtcNow;
+            upkeep.LastTransactionHash = transactionHash;
+            upkeep.PerformCount++;
+
+            _logger.LogInformation("Performed upkeep {UpkeepId} on contract {ContractHash}: {TransactionHash}",
+                upkeep.Id, upkeep.ContractHash, transactionHash);
+
+            if (gasConsumed > 0)
+            {
+                await _gasBankService.ChargeNetworkFeeAsync(gasBankAccount.Id, gasConsumed, transactionHash,
+                    $"performUpkeep for upkeep {upkeep.Name}", upkeep.Id);
+                upkeep.TotalGasSpent += gasConsumed;
+            }
+
+            return null;
+        }
+
+        /// <inheritdoc/>
+        public async Task<UpkeepSimulation> SimulateAsync(Guid id)
+        {
+            var upkeep = await _upkeepRepository.GetByIdAsync(id);
+            if (upkeep == null)
+            {
+                throw new ResourceNotFoundException("Upkeep", id.ToString());
+            }
+
+            TryDecodeHex(upkeep.CheckData, out var checkData);
+
+            var check = await _rpcClient.InvokeFunctionAsync(upkeep.ContractHash, CheckUpkeepMethod,
+                new[] { NeoContractParameter.ByteArray(checkData) });
+            var simulation = new UpkeepSimulation
+            {
+                UpkeepId = upkeep.Id,
+                CheckState = check.State,
+                CheckException = check.Exception,
+                CheckResult = StackItemDecoder.DecodeStack(check.Stack)
+            };
+
+            if (!check.IsHalt)
+            {
+                simulation.Error = $"checkUpkeep faulted: {check.Exception}";
+                return simulation;
+            }
+
+            if (!TryParseCheckResult(check, out var upkeepNeeded, out var performData))
+            {
+                simulation.Error = "checkUpkeep did not return [upkeepNeeded, performData]";
+                return simulation;
+            }
+
+            simulation.UpkeepNeeded = upkeepNeeded;
+            simulation.PerformData = Convert.ToHexString(performData).ToLowerInvariant();
+            if (!upkeepNeeded)
+            {
+                return simulation;
+            }
+
+            var dryRun = await _rpcClient.InvokeFunctionAsync(upkeep.ContractHash, PerformUpkeepMethod,
+                new[] { NeoContractParameter.ByteArray(performData) });
+            simulation.PerformState = dryRun.State;
+            simulation.PerformException = dryRun.Exception;
+            simulation.PerformResult = StackItemDecoder.DecodeStack(dryRun.Stack);
+
+            if (long.TryParse(dryRun.GasConsumed, NumberStyles.Integer, CultureInfo.InvariantCulture, out var units))
+            {
+                simulation.PerformGasConsumed = units / GasFactor;
+            }
+
+            if (!dryRun.IsHalt)
+            {
+                simulation.Error = $"performUpkeep faulted in test invocation: {dryRun.Exception}";
+            }
+            else if (simulation.PerformGasConsumed > upkeep.GasLimit)
+            {
+                simulation.Error = $"performUpkeep needs {simulation.PerformGasConsumed} GAS, above the gas limit of {upkeep.GasLimit} GAS";
+            }
+
+            return simulation;
+        }
+
+        private async Task<Upkeep> SetStatusAsync(Guid id, UpkeepStatus status)
+        {
+            var upkeep = await _upkeepRepository.GetByIdAsync(id);
+            if (upkeep == null)
+            {
+                throw new ResourceNotFoundException("Upkeep", id.ToString());
+            }
+
+            if (upkeep.Status == UpkeepStatus.Cancelled && status != UpkeepStatus.Cancelled)
+            {
+                throw new ValidationException("A cancelled upkeep cannot be paused or resumed");
+            }
+
+            _logger.LogInformation("Setting upkeep {UpkeepId} to {Status}", id, status);
+
+            upkeep.Status = status;
+            upkeep.UpdatedAt = DateTime.UtcNow;
+            if (status == UpkeepStatus.Active)
+            {
+                upkeep.LastError = null;
+            }
+
+            return await _upkeepRepository.UpdateAsync(upkeep);
+        }
+
+        // checkUpkeep returns a two-item array of a boolean and a byte string, as in Chainlink Automation
+        private static bool TryParseCheckResult(NeoInvokeResult result, out bool upkeepNeeded, out byte[] performData)
+        {
+            upkeepNeeded = false;
+            performData = Array.Empty<byte>();
+
+            var items = result.Stack?.Count == 1 ? result.Stack[0].Items : result.Stack;
+            if (items == null || items.Count != 2)
+            {
+                return false;
+            }
+
+            var needed = items[0];
+            upkeepNeeded = needed.Type == "Boolean"
+                ? needed.Value == "true"
+                : needed.Type == "Integer" && needed.Value != "0";
+
+            if (!string.IsNullOrEmpty(items[1].Value))
+            {
+                try
+                {
+                    performData = Convert.FromBase64String(items[1].Value);
+                }
+                catch (FormatException)
+                {
+                    return false;
+                }
+            }
+
+            return true;
+        }
+
+        private static bool TryDecodeHex(string hex, out byte[] bytes)
+        {
+            bytes = Array.Empty<byte>();
+            if (string.IsNullOrEmpty(hex))
+            {
+                return true;
+            }
+
+            try
+            {
+                bytes = Convert.FromHexString(hex.StartsWith("0x", StringComparison.OrdinalIgnoreCase) ? hex[2..] : hex);
+                return true;
+            }
+            catch (FormatException)
+            {
+                return false;
+            }
+        }
+
+        /// <inheritdoc/>
+        public void Dispose()
+        {
+            _pollTimer?.Dispose();
+            _cycleSemaphore.Dispose();
+        }
+    }
+}
@@ -0,0 +1,28 @@
+using Microsoft.Extensions.DependencyInjection;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Services.Automation.Repositories;
+
+namespace NeoServiceLayer.Services.Automation
+{
+    /// <summary>
+    /// Extension methods for registering automation services
+    /// </summary>
+    public static class AutomationServiceExtensions
+    {
+        /// <summary>
+        /// Adds automation services to the service collection
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddAutomationServices(this IServiceCollection services)
+        {
+            // Register repositories
+            services.AddSingleton<IUpkeepRepository, UpkeepRepository>();
+
+            // Register services
+            services.AddSingleton<IAutomationService, AutomationService>();
+
+            return services;
+        }
+    }
+}
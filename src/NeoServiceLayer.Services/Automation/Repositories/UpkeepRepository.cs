@@ -0,0 +1,73 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Automation.Repositories
+{
+    /// <summary>
+    /// Repository for upkeeps
+    /// </summary>
+    public class UpkeepRepository : IUpkeepRepository
+    {
+        private readonly ILogger<UpkeepRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private readonly string _collectionName = "upkeeps";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="UpkeepRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public UpkeepRepository(ILogger<UpkeepRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<Upkeep> CreateAsync(Upkeep upkeep)
+        {
+            _logger.LogInformation("Creating upkeep {Name} for contract {ContractHash}", upkeep.Name, upkeep.ContractHash);
+
+            await _storageProvider.CreateAsync(_collectionName, upkeep);
+
+            return upkeep;
+        }
+
+        /// <inheritdoc/>
+        public async Task<Upkeep> UpdateAsync(Upkeep upkeep)
+        {
+            await _storageProvider.UpdateAsync<Upkeep, Guid>(_collectionName, upkeep.Id, upkeep);
+
+            return upkeep;
+        }
+
+        /// <inheritdoc/>
+        public async Task<Upkeep> GetByIdAsync(Guid id)
+        {
+            return await _storageProvider.GetByIdAsync<Upkeep, Guid>(_collectionName, id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<Upkeep>> GetByAccountIdAsync(Guid accountId)
+        {
+            _logger.LogInformation("Getting upkeeps by account ID: {AccountId}", accountId);
+
+            var upkeeps = await _storageProvider.GetAllAsync<Upkeep>(_collectionName);
+
+            return upkeeps.Where(u => u.AccountId == accountId);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<Upkeep>> GetActiveAsync()
+        {
+            var upkeeps = await _storageProvider.GetAllAsync<Upkeep>(_collectionName);
+
+            return upkeeps.Where(u => u.Status == UpkeepStatus.Active);
+        }
+    }
+}
@@ -0,0 +1,25 @@
+using Microsoft.Extensions.DependencyInjection;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Services.Blockchain
+{
+    /// <summary>
+    /// Extension methods for registering blockchain services
+    /// </summary>
+    public static class BlockchainServiceExtensions
+    {
+        /// <summary>
+        /// Adds blockchain services to the service collection
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddBlockchainServices(this IServiceCollection services)
+        {
+            // Register services
+            services.AddSingleton<NeoRpcResponseCache>();
+            services.AddSingleton<INeoRpcClient, NeoRpcClient>();
+
+            return services;
+        }
+    }
+}
@@ -0,0 +1,904 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.Diagnostics;
+using System.Globalization;
+using System.Linq;
+using System.Net.Http;
+using System.Numerics;
+using System.Text;
+using System.Text.Json;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Core.Utilities;
+using NeoServiceLayer.Services.Diagnostics;
+
+namespace NeoServiceLayer.Services.Blockchain
+{
+    /// <summary>
+    /// JSON-RPC client for Neo N3 nodes
+    /// </summary>
+    /// <remarks>
+    /// Historical calls pin the block's state root through the state service (<c>getstateroot</c>)
+    /// and evaluate against it with <c>invokefunctionhistoric</c>, so the same call always returns
+    /// the same result regardless of when it is made.
+    ///
+    /// Latest-state NEP-17 balances are cached for <see cref="NeoRpcConfiguration.BalanceCacheSeconds"/>
+    /// so that repeated checks of the same account do not each cost a node round trip. The event monitor
+    /// drops cached entries as soon as it observes a transfer involving the account.
+    ///
+    /// Batched calls send a JSON-RPC array in one HTTP request, split into chunks of at most
+    /// <see cref="NeoRpcConfiguration.MaxBatchSize"/> calls, and match responses back to calls by ID.
+    ///
+    /// With <see cref="NeoRpcConfiguration.ResponseCacheEnabled"/>, blocks, application logs and state roots
+    /// are served from a <see cref="NeoRpcResponseCache"/> on disk once the node has returned them.
+    ///
+    /// Calls to <see cref="NeoRpcConfiguration.RpcUrl"/> are spread across <see cref="NeoRpcConfiguration.Nodes"/>
+    /// by a <see cref="NeoRpcNodePool"/>. A call that cannot reach a node, or gets a non-success HTTP status, is
+    /// retried on the next one; an error returned by the node itself is not. Iterator sessions stay on the node that
+    /// opened them. Nodes are probed with <c>getblockcount</c> every
+    /// <see cref="NeoRpcConfiguration.HealthCheckIntervalSeconds"/>, which is how an unhealthy node returns.
+    /// </remarks>
+    public class NeoRpcClient : INeoRpcClient, IDisposable
+    {
+        private readonly ILogger<NeoRpcClient> _logger;
+        private readonly NeoRpcConfiguration _configuration;
+        private readonly HttpClient _httpClient;
+        private readonly NeoRpcResponseCache _responseCache;
+        private readonly ICrashReporter _crashReporter;
+        private readonly NeoRpcNodePool _nodePool;
+        private readonly ConcurrentDictionary<string, string> _sessionNodes = new ConcurrentDictionary<string, string>();
+        private readonly SemaphoreSlim _probeSemaphore = new SemaphoreSlim(1, 1);
+        private readonly Timer _healthCheckTimer;
+        private readonly ConcurrentDictionary<(string ScriptHash, string AssetHash), CachedBalance> _balanceCache =
+            new ConcurrentDictionary<(string ScriptHash, string AssetHash), CachedBalance>();
+        private int _requestId;
+        private long _balanceGeneration;
+
+        private static readonly JsonSerializerOptions SerializerOptions = new JsonSerializerOptions
+        {
+            PropertyNamingPolicy = JsonNamingPolicy.CamelCase
+        };
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="NeoRpcClient"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="configuration">Configuration</param>
+        /// <param name="httpClient">HTTP client used to reach the node</param>
+        /// <param name="responseCache">Cache of results that never change; every call goes to the node when omitted</param>
+        /// <param name="crashReporter">Crash reporter for the node health checks</param>
+        public NeoRpcClient(
+            ILogger<NeoRpcClient> logger,
+            IOptions<NeoRpcConfiguration> configuration,
+            HttpClient httpClient = null,
+            NeoRpcResponseCache responseCache = null,
+            ICrashReporter crashReporter = null)
+        {
+            _logger = logger;
+            _configuration = configuration.Value;
+            _httpClient = httpClient ?? new HttpClient { Timeout = TimeSpan.FromSeconds(_configuration.TimeoutSeconds) };
+            _responseCache = responseCache;
+            _crashReporter = crashReporter;
+            _nodePool = new NeoRpcNodePool(logger, _configuration);
+
+            // A single node has nothing to fail over to, so it is only tracked through its calls
+            if (_nodePool.Urls.Count > 1 && _configuration.HealthCheckIntervalSeconds > 0)
+            {
+                _healthCheckTimer = new Timer(
+                    _crashReporter.Guard(_logger, "NeoRpc.HealthCheck", ProbeNodesAsync),
+                    null,
+                    TimeSpan.Zero,
+                    TimeSpan.FromSeconds(_configuration.HealthCheckIntervalSeconds));
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<long> GetBlockCountAsync()
+        {
+            var result = await SendAsync(_configuration.RpcUrl, "getblockcount");
+            return result.GetInt64();
+        }
+
+        /// <inheritdoc/>
+        public async Task<NeoStateRoot> GetStateRootAsync(long blockIndex)
+        {
+            if (blockIndex < 0)
+            {
+                throw new ArgumentOutOfRangeException(nameof(blockIndex), "Block index cannot be negative");
+            }
+
+            var result = await SendAsync(StateServiceUrl, "getstateroot", blockIndex);
+
+            return new NeoStateRoot
+            {
+                Version = result.TryGetProperty("version", out var version) ? version.GetInt32() : 0,
+                Index = result.GetProperty("index").GetInt64(),
+                RootHash = result.GetProperty("roothash").GetString()
+            };
+        }
+
+        /// <inheritdoc/>
+        public async Task<NeoBlock> GetBlockAsync(long blockIndex)
+        {
+            if (blockIndex < 0)
+            {
+                throw new ArgumentOutOfRangeException(nameof(blockIndex), "Block index cannot be negative");
+            }
+
+            // Verbose mode returns the block as JSON instead of a serialized byte string
+            var result = await SendAsync(_configuration.RpcUrl, "getblock", blockIndex, true);
+
+            var block = new NeoBlock
+            {
+                Hash = result.GetProperty("hash").GetString(),
+                Index = result.GetProperty("index").GetInt64(),
+                Timestamp = DateTimeOffset.FromUnixTimeMilliseconds(result.GetProperty("time").GetInt64()).UtcDateTime
+            };
+
+            if (result.TryGetProperty("tx", out var transactions) && transactions.ValueKind == JsonValueKind.Array)
+            {
+                block.TransactionHashes = transactions.EnumerateArray()
+                    .Select(tx => tx.GetProperty("hash").GetString())
+                    .ToList();
+            }
+
+            return block;
+        }
+
+        /// <inheritdoc/>
+        public async Task<NeoInvokeResult> InvokeFunctionAsync(string scriptHash, string operation, IEnumerable<NeoContractParameter> args = null)
+        {
+            ValidateInvocation(scriptHash, operation);
+
+            _logger.LogDebug("Invoking {ScriptHash}.{Operation} at latest state", scriptHash, operation);
+
+            var result = await SendAsync(_configuration.RpcUrl, "invokefunction", scriptHash, operation, args?.ToList() ?? new List<NeoContractParameter>());
+            return ParseInvokeResult(result);
+        }
+
+        /// <inheritdoc/>
+        public async Task<NeoInvokeResult> InvokeFunctionAtAsync(long blockIndex, string scriptHash, string operation, IEnumerable<NeoContractParameter> args = null)
+        {
+            ValidateInvocation(scriptHash, operation);
+
+            var stateRoot = await GetStateRootAsync(blockIndex);
+
+            _logger.LogDebug("Invoking {ScriptHash}.{Operation} at block {BlockIndex}, state root {StateRoot}",
+                scriptHash, operation, blockIndex, stateRoot.RootHash);
+
+            var result = await SendAsync(StateServiceUrl, "invokefunctionhistoric", stateRoot.RootHash, scriptHash, operation, args?.ToList() ?? new List<NeoContractParameter>());
+
+            var invokeResult = ParseInvokeResult(result);
+            invokeResult.BlockIndex = blockIndex;
+            invokeResult.StateRoot = stateRoot.RootHash;
+            return invokeResult;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IReadOnlyList<NeoInvokeResult>> InvokeFunctionBatchAsync(IEnumerable<NeoInvocation> invocations)
+        {
+            var calls = invocations?.ToList() ?? throw new ArgumentNullException(nameof(invocations));
+            foreach (var invocation in calls)
+            {
+                ValidateInvocation(invocation.ScriptHash, invocation.Operation);
+            }
+
+            _logger.LogDebug("Invoking {InvocationCount} methods at latest state in one batch", calls.Count);
+
+            var responses = await SendBatchAsync(
+                _configuration.RpcUrl,
+                "invokefunction",
+                calls.Select(c => new object[] { c.ScriptHash, c.Operation, c.Args ?? new List<NeoContractParameter>() }).ToList());
+
+            return responses.Select(r => ParseInvokeResult(GetResult(r, "invokefunction"))).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<NeoApplicationLog> GetApplicationLogAsync(string txHash)
+        {
+            ValidateTxHash(txHash);
+
+            var result = await SendAsync(_configuration.RpcUrl, "getapplicationlog", txHash);
+            return ParseApplicationLog(result);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IReadOnlyList<NeoApplicationLog>> GetApplicationLogsAsync(IEnumerable<string> txHashes)
+        {
+            var hashes = txHashes?.ToList() ?? throw new ArgumentNullException(nameof(txHashes));
+            foreach (var txHash in hashes)
+            {
+                ValidateTxHash(txHash);
+            }
+
+            var responses = await SendBatchAsync(_configuration.RpcUrl, "getapplicationlog", hashes.Select(h => new object[] { h }).ToList());
+
+            return responses
+                .Select(r => IsUnknownTransaction(r) ? null : ParseApplicationLog(GetResult(r, "getapplicationlog")))
+                .ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<IReadOnlyList<long?>> GetTransactionHeightsAsync(IEnumerable<string> txHashes)
+        {
+            var hashes = txHashes?.ToList() ?? throw new ArgumentNullException(nameof(txHashes));
+            foreach (var txHash in hashes)
+            {
+                ValidateTxHash(txHash);
+            }
+
+            var responses = await SendBatchAsync(_configuration.RpcUrl, "gettransactionheight", hashes.Select(h => new object[] { h }).ToList());
+
+            return responses
+                .Select(r => IsUnknownTransaction(r) ? (long?)null : GetResult(r, "gettransactionheight").GetInt64())
+                .ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<BigInteger> GetBalanceAsync(string address, string assetHash)
+        {
+            var scriptHash = ToScriptHash(address);
+            if (_configuration.BalanceCacheSeconds <= 0)
+            {
+                var uncached = await InvokeFunctionAsync(assetHash, "balanceOf", new[] { NeoContractParameter.Hash160(scriptHash) });
+                return ParseBalance(uncached, assetHash);
+            }
+
+            var key = BalanceCacheKey(scriptHash, assetHash);
+            if (_balanceCache.TryGetValue(key, out var cached) && cached.ExpiresAt > DateTime.UtcNow)
+            {
+                return cached.Balance;
+            }
+
+            // A transfer observed while the call is in flight may not be reflected in its result
+            var generation = Interlocked.Read(ref _balanceGeneration);
+            var result = await InvokeFunctionAsync(assetHash, "balanceOf", new[] { NeoContractParameter.Hash160(scriptHash) });
+            var balance = ParseBalance(result, assetHash);
+
+            if (Interlocked.Read(ref _balanceGeneration) == generation)
+            {
+                _balanceCache[key] = new CachedBalance(balance, DateTime.UtcNow.AddSeconds(_configuration.BalanceCacheSeconds));
+            }
+
+            return balance;
+        }
+
+        /// <inheritdoc/>
+        public async Task<BigInteger> GetBalanceAtAsync(long blockIndex, string address, string assetHash)
+        {
+            var result = await InvokeFunctionAtAsync(blockIndex, assetHash, "balanceOf", new[] { NeoContractParameter.Hash160(ToScriptHash(address)) });
+            return ParseBalance(result, assetHash);
+        }
+
+        /// <inheritdoc/>
+        public async Task<string> GetNep11OwnerAsync(string contractHash, string tokenId)
+        {
+            var result = await InvokeFunctionAsync(contractHash, "ownerOf", new[] { NeoContractParameter.ByteArray(ParseTokenId(tokenId)) });
+            EnsureHalt(result, contractHash, "ownerOf");
+
+            var item = result.Stack.FirstOrDefault();
+            var owner = item?.Type == "ByteString" && item.Value != null ? Convert.FromBase64String(item.Value) : null;
+            if (owner == null || owner.Length != 20)
+            {
+                throw new NeoRpcException($"ownerOf on {contractHash} returned an unexpected result");
+            }
+
+            // Hash160 values are little-endian on the stack
+            Array.Reverse(owner);
+            return "0x" + Convert.ToHexString(owner).ToLowerInvariant();
+        }
+
+        /// <inheritdoc/>
+        public async Task<IReadOnlyList<string>> GetNep11TokensOfAsync(string contractHash, string address, int maxItems = 100)
+        {
+            if (maxItems <= 0)
+            {
+                throw new ArgumentOutOfRangeException(nameof(maxItems), "Max items must be greater than zero");
+            }
+
+            var result = await InvokeFunctionAsync(contractHash, "tokensOf", new[] { NeoContractParameter.Hash160(ToScriptHash(address)) });
+            EnsureHalt(result, contractHash, "tokensOf");
+
+            var iterator = result.Stack.FirstOrDefault();
+            if (iterator?.Type != "InteropInterface" || string.IsNullOrEmpty(iterator.Value) || string.IsNullOrEmpty(result.Session))
+            {
+                throw new NeoRpcException($"tokensOf on {contractHash} did not return an iterator; the node may have RPC sessions disabled");
+            }
+
+            try
+            {
+                var items = await SendAsync(_configuration.RpcUrl, "traverseiterator", result.Session, iterator.Value, maxItems);
+                return items.EnumerateArray()
+                    .Select(ParseStackItem)
+                    .Select(item => Convert.ToHexString(Convert.FromBase64String(item.Value ?? string.Empty)).ToLowerInvariant())
+                    .ToList();
+            }
+            finally
+            {
+                try
+                {
+                    await SendAsync(_configuration.RpcUrl, "terminatesession", result.Session);
+                }
+                catch (NeoRpcException ex)
+                {
+                    // The node expires idle sessions on its own, so a failed cleanup is not fatal
+                    _logger.LogWarning(ex, "Error terminating RPC session {Session}", result.Session);
+                }
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IDictionary<string, string>> GetNep11PropertiesAsync(string contractHash, string tokenId)
+        {
+            var result = await InvokeFunctionAsync(contractHash, "properties", new[] { NeoContractParameter.ByteArray(ParseTokenId(tokenId)) });
+            EnsureHalt(result, contractHash, "properties");
+
+            var map = result.Stack.FirstOrDefault();
+            if (map?.Type != "Map" || map.Items == null)
+            {
+                throw new NeoRpcException($"properties on {contractHash} returned an unexpected result");
+            }
+
+            var properties = new Dictionary<string, string>();
+            foreach (var entry in map.Items)
+            {
+                properties[DecodeString(entry.Items[0])] = DecodeString(entry.Items[1]);
+            }
+
+            return properties;
+        }
+
+        /// <inheritdoc/>
+        public void InvalidateBalance(string scriptHash, string assetHash = null)
+        {
+            if (string.IsNullOrEmpty(scriptHash))
+            {
+                return;
+            }
+
+            Interlocked.Increment(ref _balanceGeneration);
+
+            if (assetHash != null)
+            {
+                _balanceCache.TryRemove(BalanceCacheKey(scriptHash, assetHash), out _);
+                return;
+            }
+
+            var normalized = scriptHash.ToLowerInvariant();
+            foreach (var key in _balanceCache.Keys.Where(k => k.ScriptHash == normalized))
+            {
+                _balanceCache.TryRemove(key, out _);
+            }
+        }
+
+        /// <inheritdoc/>
+        public IReadOnlyList<NeoRpcNodeStatus> GetNodeStatuses()
+        {
+            return _nodePool.GetStatuses();
+        }
+
+        /// <summary>
+        /// Disposes the client
+        /// </summary>
+        public void Dispose()
+        {
+            _healthCheckTimer?.Dispose();
+            _probeSemaphore?.Dispose();
+        }
+
+        private string StateServiceUrl => string.IsNullOrEmpty(_configuration.StateServiceUrl) ? _configuration.RpcUrl : _configuration.StateServiceUrl;
+
+        private async Task<JsonElement> SendAsync(string url, string method, params object[] parameters)
+        {
+            if (_responseCache?.IsCacheable(method, parameters) != true)
+            {
+                return await SendUncachedAsync(url, method, parameters);
+            }
+
+            var serializedParameters = JsonSerializer.Serialize(parameters, SerializerOptions);
+            if (_responseCache.TryGet(url, method, serializedParameters, out var cached))
+            {
+                return cached;
+            }
+
+            var result = await SendUncachedAsync(url, method, parameters);
+            _responseCache.Set(url, method, serializedParameters, result);
+            return result;
+        }
+
+        private async Task<JsonElement> SendUncachedAsync(string url, string method, object[] parameters)
+        {
+            var request = new
+            {
+                jsonrpc = "2.0",
+                id = Interlocked.Increment(ref _requestId),
+                method,
+                @params = parameters
+            };
+
+            // Session calls must reach the node holding the session
+            string pinnedNode = null;
+            if ((method == "traverseiterator" || method == "terminatesession") && parameters.Length > 0 && parameters[0] is string session)
+            {
+                _sessionNodes.TryGetValue(session, out pinnedNode);
+                if (method == "terminatesession")
+                {
+                    _sessionNodes.TryRemove(session, out _);
+                }
+            }
+
+            var (document, nodeUrl) = await PostAsync(url, JsonSerializer.Serialize(request, SerializerOptions), method, pinnedNode);
+            using (document)
+            {
+                var result = GetResult(document.RootElement, method).Clone();
+                if (result.ValueKind == JsonValueKind.Object && result.TryGetProperty("session", out var opened) && opened.ValueKind == JsonValueKind.String)
+                {
+                    _sessionNodes[opened.GetString()] = nodeUrl;
+                }
+
+                return result;
+            }
+        }
+
+        private async Task<List<JsonElement>> SendBatchAsync(string url, string method, IReadOnlyList<object[]> parameterSets)
+        {
+            if (_responseCache?.IsCacheable(method, parameterSets.FirstOrDefault()) != true)
+            {
+                return await SendUncachedBatchAsync(url, method, parameterSets);
+            }
+
+            // Only the calls the cache cannot answer go to the node
+            var responses = new JsonElement?[parameterSets.Count];
+            var serializedParameters = parameterSets.Select(p => JsonSerializer.Serialize(p, SerializerOptions)).ToList();
+            var misses = new List<int>();
+            for (var i = 0; i < parameterSets.Count; i++)
+            {
+                if (_responseCache.TryGet(url, method, serializedParameters[i], out var cached))
+                {
+                    responses[i] = WrapResult(cached);
+                }
+                else
+                {
+                    misses.Add(i);
+                }
+            }
+
+            if (misses.Count > 0)
+            {
+                var fetched = await SendUncachedBatchAsync(url, method, misses.Select(i => parameterSets[i]).ToList());
+                for (var j = 0; j < misses.Count; j++)
+                {
+                    var response = fetched[j];
+                    responses[misses[j]] = response;
+
+                    // Errors, such as a transaction the node does not know yet, are not cached
+                    if ((!response.TryGetProperty("error", out var error) || error.ValueKind == JsonValueKind.Null)
+                        && response.TryGetProperty("result", out var result))
+                    {
+                        _responseCache.Set(url, method, serializedParameters[misses[j]], result);
+                    }
+                }
+            }
+
+            return responses.Select(r => r.Value).ToList();
+        }
+
+        private async Task<List<JsonElement>> SendUncachedBatchAsync(string url, string method, IReadOnlyList<object[]> parameterSets)
+        {
+            var responses = new List<JsonElement>(parameterSets.Count);
+            var batchSize = Math.Max(1, _configuration.MaxBatchSize);
+
+            for (var offset = 0; offset < parameterSets.Count; offset += batchSize)
+            {
+                var requests = parameterSets
+                    .Skip(offset)
+                    .Take(batchSize)
+                    .Select(parameters => new
+                    {
+                        jsonrpc = "2.0",
+                        id = Interlocked.Increment(ref _requestId),
+                        method,
+                        @params = parameters
+                    })
+                    .ToList();
+
+                using var document = (await PostAsync(url, JsonSerializer.Serialize(requests, SerializerOptions), method)).Document;
+                if (document.RootElement.ValueKind != JsonValueKind.Array)
+                {
+                    // Nodes answer a batch they cannot parse with a single error object
+                    GetResult(document.RootElement, method);
+                    throw new NeoRpcException($"Neo RPC batch of {method} returned no results");
+                }
+
+                // Responses to a batch may come back in any order
+                var byId = new Dictionary<int, JsonElement>();
+                foreach (var response in document.RootElement.EnumerateArray())
+                {
+                    if (response.TryGetProperty("id", out var id) && id.ValueKind == JsonValueKind.Number)
+                    {
+                        byId[id.GetInt32()] = response.Clone();
+                    }
+                }
+
+                foreach (var request in requests)
+                {
+                    if (!byId.TryGetValue(request.id, out var response))
+                    {
+                        throw new NeoRpcException($"Neo RPC batch of {method} is missing the response to request {request.id}");
+                    }
+
+                    responses.Add(response);
+                }
+            }
+
+            return responses;
+        }
+
+        private async Task<(JsonDocument Document, string NodeUrl)> PostAsync(string url, string payload, string method, string pinnedNode = null)
+        {
+            // Calls to the configured RPC URL go to the node pool and fail over between its nodes
+            var nodes = pinnedNode != null ? new[] { pinnedNode } : url == _configuration.RpcUrl ? _nodePool.Select() : new[] { url };
+
+            for (var i = 0; ; i++)
+            {
+                try
+                {
+                    return (await PostToNodeAsync(nodes[i], payload, method), nodes[i]);
+                }
+                catch (NeoRpcException ex) when (i + 1 < nodes.Count)
+                {
+                    _logger.LogWarning("Neo RPC method {Method} failed on {Url}, trying {NextUrl}: {Message}", method, nodes[i], nodes[i + 1], ex.Message);
+                }
+            }
+        }
+
+        private async Task<JsonDocument> PostToNodeAsync(string url, string payload, string method)
+        {
+            using var message = new HttpRequestMessage(HttpMethod.Post, url)
+            {
+                Content = new StringContent(payload, Encoding.UTF8, "application/json")
+            };
+
+            // Forward the API request ID so node logs can be correlated with ours
+            if (RequestContext.RequestId != null)
+            {
+                message.Headers.TryAddWithoutValidation(RequestContext.RequestIdHeader, RequestContext.RequestId);
+            }
+
+            var stopwatch = Stopwatch.StartNew();
+            HttpResponseMessage response;
+            string body;
+            try
+            {
+                response = await _httpClient.SendAsync(message);
+                body = await response.Content.ReadAsStringAsync();
+            }
+            catch (Exception ex)
+            {
+                _nodePool.RecordFailure(url, ex.Message);
+                _logger.LogError(ex, "Error calling Neo RPC method {Method} on {Url}", method, url);
+                throw new NeoRpcException($"Error calling Neo RPC method {method}", ex);
+            }
+
+            if (!response.IsSuccessStatusCode)
+            {
+                _nodePool.RecordFailure(url, $"HTTP {(int)response.StatusCode}");
+                throw new NeoRpcException($"Neo RPC method {method} failed with HTTP {(int)response.StatusCode}");
+            }
+
+            JsonDocument document;
+            try
+            {
+                document = JsonDocument.Parse(body);
+            }
+            catch (JsonException ex)
+            {
+                _nodePool.RecordFailure(url, "Invalid JSON response");
+                throw new NeoRpcException($"Neo RPC method {method} returned an invalid response", ex);
+            }
+
+            _nodePool.RecordSuccess(url, stopwatch.Elapsed);
+            return document;
+        }
+
+        /// <summary>
+        /// Probes every node with getblockcount, recording its latency and height
+        /// </summary>
+        private async Task ProbeNodesAsync()
+        {
+            // Prevent concurrent execution
+            if (!await _probeSemaphore.WaitAsync(0))
+            {
+                return;
+            }
+
+            try
+            {
+                await Task.WhenAll(_nodePool.Urls.Select(ProbeNodeAsync));
+            }
+            finally
+            {
+                _probeSemaphore.Release();
+            }
+        }
+
+        private async Task ProbeNodeAsync(string url)
+        {
+            var payload = JsonSerializer.Serialize(new
+            {
+                jsonrpc = "2.0",
+                id = Interlocked.Increment(ref _requestId),
+                method = "getblockcount",
+                @params = Array.Empty<object>()
+            }, SerializerOptions);
+
+            var stopwatch = Stopwatch.StartNew();
+            try
+            {
+                using var response = await _httpClient.PostAsync(url, new StringContent(payload, Encoding.UTF8, "application/json"));
+                if (!response.IsSuccessStatusCode)
+                {
+                    _nodePool.RecordFailure(url, $"HTTP {(int)response.StatusCode}", probe: true);
+                    return;
+                }
+
+                using var document = JsonDocument.Parse(await response.Content.ReadAsStringAsync());
+                var blockCount = GetResult(document.RootElement, "getblockcount").GetInt64();
+                _nodePool.RecordSuccess(url, stopwatch.Elapsed, blockCount);
+            }
+            catch (Exception ex)
+            {
+                _nodePool.RecordFailure(url, ex.Message, probe: true);
+            }
+        }
+
+        private static JsonElement GetResult(JsonElement response, string method)
+        {
+            if (response.TryGetProperty("error", out var error) && error.ValueKind != JsonValueKind.Null)
+            {
+                var message = error.TryGetProperty("message", out var errorMessage) ? errorMessage.GetString() : error.ToString();
+                throw new NeoRpcException($"Neo RPC method {method} returned an error: {message}");
+            }
+
+            if (!response.TryGetProperty("result", out var result))
+            {
+                throw new NeoRpcException($"Neo RPC method {method} returned no result");
+            }
+
+            return result;
+        }
+
+        private static JsonElement WrapResult(JsonElement result)
+        {
+            using var document = JsonDocument.Parse($"{{\"result\":{result.GetRawText()}}}");
+            return document.RootElement.Clone();
+        }
+
+        private static bool IsUnknownTransaction(JsonElement response)
+        {
+            // Neo nodes report an unknown transaction or block with error code -100
+            return response.TryGetProperty("error", out var error)
+                && error.ValueKind == JsonValueKind.Object
+                && error.TryGetProperty("code", out var code)
+                && code.ValueKind == JsonValueKind.Number
+                && code.GetInt32() == -100;
+        }
+
+        private static NeoApplicationLog ParseApplicationLog(JsonElement result)
+        {
+            var log = new NeoApplicationLog
+            {
+                TxId = result.TryGetProperty("txid", out var txId) ? txId.GetString() : null
+            };
+
+            if (!result.TryGetProperty("executions", out var executions) || executions.ValueKind != JsonValueKind.Array)
+            {
+                return log;
+            }
+
+            foreach (var execution in executions.EnumerateArray())
+            {
+                var parsed = new NeoApplicationExecution
+                {
+                    Trigger = execution.TryGetProperty("trigger", out var trigger) ? trigger.GetString() : null,
+                    VmState = execution.TryGetProperty("vmstate", out var vmState) ? vmState.GetString() : null,
+                    GasConsumed = execution.TryGetProperty("gasconsumed", out var gas) ? gas.GetString() : null,
+                    Exception = execution.TryGetProperty("exception", out var exception) && exception.ValueKind == JsonValueKind.String ? exception.GetString() : null
+                };
+
+                if (execution.TryGetProperty("stack", out var stack) && stack.ValueKind == JsonValueKind.Array)
+                {
+                    parsed.Stack = stack.EnumerateArray().Select(ParseStackItem).ToList();
+                }
+
+                if (execution.TryGetProperty("notifications", out var notifications) && notifications.ValueKind == JsonValueKind.Array)
+                {
+                    parsed.Notifications = notifications.EnumerateArray()
+                        .Select(n => new NeoContractNotification
+                        {
+                            Contract = n.TryGetProperty("contract", out var contract) ? contract.GetString() : null,
+                            EventName = n.TryGetProperty("eventname", out var eventName) ? eventName.GetString() : null,
+                            State = n.TryGetProperty("state", out var state) && state.ValueKind == JsonValueKind.Object ? ParseStackItem(state) : null
+                        })
+                        .ToList();
+                }
+
+                log.Executions.Add(parsed);
+            }
+
+            return log;
+        }
+
+        private static NeoInvokeResult ParseInvokeResult(JsonElement result)
+        {
+            var invokeResult = new NeoInvokeResult
+            {
+                Script = result.TryGetProperty("script", out var script) ? script.GetString() : null,
+                State = result.TryGetProperty("state", out var state) ? state.GetString() : null,
+                GasConsumed = result.TryGetProperty("gasconsumed", out var gas) ? gas.GetString() : null,
+                Exception = result.TryGetProperty("exception", out var exception) && exception.ValueKind == JsonValueKind.String ? exception.GetString() : null,
+                Session = result.TryGetProperty("session", out var session) && session.ValueKind == JsonValueKind.String ? session.GetString() : null
+            };
+
+            if (result.TryGetProperty("stack", out var stack) && stack.ValueKind == JsonValueKind.Array)
+            {
+                invokeResult.Stack = stack.EnumerateArray().Select(ParseStackItem).ToList();
+            }
+
+            return invokeResult;
+        }
+
+        private static NeoStackItem ParseStackItem(JsonElement element)
+        {
+            var item = new NeoStackItem
+            {
+                Type = element.TryGetProperty("type", out var type) ? type.GetString() : null
+            };
+
+            if (item.Type == "InteropInterface" && element.TryGetProperty("id", out var iteratorId))
+            {
+                item.Value = iteratorId.GetString();
+                return item;
+            }
+
+            if (!element.TryGetProperty("value", out var value))
+            {
+                return item;
+            }
+
+            if (item.Type == "Map" && value.ValueKind == JsonValueKind.Array)
+            {
+                // Each map entry becomes a two-item Struct of key and value
+                item.Items = value.EnumerateArray()
+                    .Select(entry => new NeoStackItem
+                    {
+                        Type = "Struct",
+                        Items = new List<NeoStackItem> { ParseStackItem(entry.GetProperty("key")), ParseStackItem(entry.GetProperty("value")) }
+                    })
+                    .ToList();
+            }
+            else if (value.ValueKind == JsonValueKind.Array)
+            {
+                item.Items = value.EnumerateArray().Select(ParseStackItem).ToList();
+            }
+            else if (value.ValueKind == JsonValueKind.String)
+            {
+                item.Value = value.GetString();
+            }
+            else if (value.ValueKind != JsonValueKind.Null)
+            {
+                item.Value = value.ToString().ToLowerInvariant();
+            }
+
+            return item;
+        }
+
+        private static BigInteger ParseBalance(NeoInvokeResult result, string assetHash)
+        {
+            if (!result.IsHalt)
+            {
+                throw new NeoRpcException($"balanceOf on {assetHash} faulted: {result.Exception}");
+            }
+
+            var item = result.Stack.FirstOrDefault();
+            if (item == null || item.Type != "Integer" || !BigInteger.TryParse(item.Value, NumberStyles.AllowLeadingSign, CultureInfo.InvariantCulture, out var balance))
+            {
+                throw new NeoRpcException($"balanceOf on {assetHash} returned an unexpected result");
+            }
+
+            return balance;
+        }
+
+        private static void EnsureHalt(NeoInvokeResult result, string contractHash, string operation)
+        {
+            if (!result.IsHalt)
+            {
+                throw new NeoRpcException($"{operation} on {contractHash} faulted: {result.Exception}");
+            }
+        }
+
+        private static string DecodeString(NeoStackItem item)
+        {
+            // Property keys and most values are UTF-8 ByteStrings; integers and booleans are returned as-is
+            return item.Type == "ByteString" && item.Value != null
+                ? Encoding.UTF8.GetString(Convert.FromBase64String(item.Value))
+                : item.Value;
+        }
+
+        private static byte[] ParseTokenId(string tokenId)
+        {
+            if (string.IsNullOrEmpty(tokenId))
+            {
+                throw new ArgumentException("Token ID is required", nameof(tokenId));
+            }
+
+            try
+            {
+                return Convert.FromHexString(tokenId.StartsWith("0x", StringComparison.OrdinalIgnoreCase) ? tokenId.Substring(2) : tokenId);
+            }
+            catch (FormatException ex)
+            {
+                throw new ArgumentException("Token ID must be a hex string", nameof(tokenId), ex);
+            }
+        }
+
+        private static void ValidateInvocation(string scriptHash, string operation)
+        {
+            if (!scriptHash.IsValidScriptHash())
+            {
+                throw new ArgumentException("Invalid script hash format", nameof(scriptHash));
+            }
+
+            if (string.IsNullOrEmpty(operation))
+            {
+                throw new ArgumentException("Operation is required", nameof(operation));
+            }
+        }
+
+        private static void ValidateTxHash(string txHash)
+        {
+            if (string.IsNullOrEmpty(txHash))
+            {
+                throw new ArgumentException("Transaction hash is required", nameof(txHash));
+            }
+        }
+
+        private static (string ScriptHash, string AssetHash) BalanceCacheKey(string scriptHash, string assetHash)
+        {
+            return (scriptHash.ToLowerInvariant(), assetHash?.ToLowerInvariant());
+        }
+
+        private static string ToScriptHash(string address)
+        {
+            try
+            {
+                return address.ToScriptHash();
+            }
+            catch (FormatException ex)
+            {
+                throw new ArgumentException(ex.Message, nameof(address), ex);
+            }
+        }
+
+        private readonly struct CachedBalance
+        {
+            public CachedBalance(BigInteger balance, DateTime expiresAt)
+            {
+                Balance = balance;
+                ExpiresAt = expiresAt;
+            }
+
+            public BigInteger Balance { get; }
+
+            public DateTime ExpiresAt { get; }
+        }
+    }
+}
@@ -0,0 +1,252 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+
+namespace NeoServiceLayer.Services.Blockchain
+{
+    /// <summary>
+    /// Tracks the health of the Neo nodes the RPC client uses and picks the node for each call
+    /// </summary>
+    /// <remarks>
+    /// Healthy nodes take turns by smooth weighted round-robin, so a node of weight 2 gets two calls for every one of
+    /// a node of weight 1, interleaved rather than in bursts. A node leaves rotation after
+    /// <see cref="NeoRpcConfiguration.UnhealthyAfterFailures"/> failures in a row or once it falls more than
+    /// <see cref="NeoRpcConfiguration.MaxBlockLag"/> blocks behind the highest node, and returns when it answers and
+    /// has caught up. When no node is healthy every node is still tried, least failing first, rather than failing
+    /// the call outright.
+    /// </remarks>
+    public class NeoRpcNodePool
+    {
+        private const double LatencySmoothing = 0.2;
+
+        private readonly ILogger _logger;
+        private readonly NeoRpcConfiguration _configuration;
+        private readonly List<NodeState> _nodes;
+        private readonly object _lock = new object();
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="NeoRpcNodePool"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="configuration">Configuration; <see cref="NeoRpcConfiguration.RpcUrl"/> is the only node when no nodes are listed</param>
+        public NeoRpcNodePool(ILogger logger, NeoRpcConfiguration configuration)
+        {
+            _logger = logger;
+            _configuration = configuration;
+
+            var nodes = configuration.Nodes?.Where(n => !string.IsNullOrWhiteSpace(n.Url)).ToList() ?? new List<NeoRpcNode>();
+            _nodes = nodes.Count > 0
+                ? nodes.GroupBy(n => n.Url.Trim()).Select(g => new NodeState(g.Key, Math.Max(1, g.First().Weight))).ToList()
+                : new List<NodeState> { new NodeState(configuration.RpcUrl, 1) };
+        }
+
+        /// <summary>
+        /// Gets the URLs of the nodes
+        /// </summary>
+        public IReadOnlyList<string> Urls => _nodes.Select(n => n.Url).ToList();
+
+        /// <summary>
+        /// Gets the nodes to try for a call, in order
+        /// </summary>
+        /// <returns>The node picked by weighted round-robin, then the other healthy nodes, then the unhealthy ones</returns>
+        public IReadOnlyList<string> Select()
+        {
+            if (_nodes.Count == 1)
+            {
+                return new[] { _nodes[0].Url };
+            }
+
+            lock (_lock)
+            {
+                var highest = HighestBlockCount();
+                var healthy = _nodes.Where(n => IsHealthy(n, highest)).ToList();
+                var order = new List<string>(_nodes.Count);
+
+                if (healthy.Count > 0)
+                {
+                    var total = healthy.Sum(n => n.Weight);
+                    foreach (var node in healthy)
+                    {
+                        node.CurrentWeight += node.Weight;
+                    }
+
+                    var picked = healthy.OrderByDescending(n => n.CurrentWeight).First();
+                    picked.CurrentWeight -= total;
+
+                    order.Add(picked.Url);
+                    order.AddRange(healthy.Where(n => n != picked).OrderByDescending(n => n.Weight).Select(n => n.Url));
+                }
+
+                order.AddRange(_nodes.Where(n => !healthy.Contains(n)).OrderBy(n => n.ConsecutiveFailures).ThenBy(n => Lag(n, highest)).Select(n => n.Url));
+                return order;
+            }
+        }
+
+        /// <summary>
+        /// Records a call or probe a node answered
+        /// </summary>
+        /// <param name="url">Node URL</param>
+        /// <param name="latency">Time the node took to answer</param>
+        /// <param name="blockCount">Block count the node reported, if the call was a probe</param>
+        public void RecordSuccess(string url, TimeSpan latency, long? blockCount = null)
+        {
+            lock (_lock)
+            {
+                var node = Find(url);
+                if (node == null)
+                {
+                    return;
+                }
+
+                node.Requests++;
+                node.AverageLatencyMs = node.Requests == 1
+                    ? latency.TotalMilliseconds
+                    : (LatencySmoothing * latency.TotalMilliseconds) + ((1 - LatencySmoothing) * node.AverageLatencyMs);
+                node.ConsecutiveFailures = 0;
+
+                if (blockCount.HasValue)
+                {
+                    node.BlockCount = blockCount;
+                    node.LastCheckedAt = DateTime.UtcNow;
+                }
+
+                UpdateHealth();
+            }
+        }
+
+        /// <summary>
+        /// Records a call or probe a node did not answer
+        /// </summary>
+        /// <param name="url">Node URL</param>
+        /// <param name="error">Error</param>
+        /// <param name="probe">Whether the failure was a probe</param>
+        public void RecordFailure(string url, string error, bool probe = false)
+        {
+            lock (_lock)
+            {
+                var node = Find(url);
+                if (node == null)
+                {
+                    return;
+                }
+
+                node.Requests++;
+                node.Errors++;
+                node.ConsecutiveFailures++;
+                node.LastError = error;
+
+                if (probe)
+                {
+                    node.LastCheckedAt = DateTime.UtcNow;
+                }
+
+                UpdateHealth();
+            }
+        }
+
+        /// <summary>
+        /// Gets the health and call statistics of every node
+        /// </summary>
+        /// <returns>The node statuses, in configuration order</returns>
+        public IReadOnlyList<NeoRpcNodeStatus> GetStatuses()
+        {
+            lock (_lock)
+            {
+                var highest = HighestBlockCount();
+                return _nodes.Select(n => new NeoRpcNodeStatus
+                {
+                    Url = n.Url,
+                    Weight = n.Weight,
+                    IsHealthy = IsHealthy(n, highest),
+                    BlockCount = n.BlockCount,
+                    BlockLag = Lag(n, highest),
+                    AverageLatencyMs = Math.Round(n.AverageLatencyMs, 1),
+                    Requests = n.Requests,
+                    Errors = n.Errors,
+                    ConsecutiveFailures = n.ConsecutiveFailures,
+                    LastError = n.LastError,
+                    LastCheckedAt = n.LastCheckedAt,
+                    UnhealthySince = n.UnhealthySince
+                }).ToList();
+            }
+        }
+
+        private void UpdateHealth()
+        {
+            var highest = HighestBlockCount();
+
+            // A node reporting a new highest block can push the others behind, so every node is rechecked
+            foreach (var node in _nodes)
+            {
+                var healthy = IsHealthy(node, highest);
+                if (!healthy && node.UnhealthySince == null)
+                {
+                    node.UnhealthySince = DateTime.UtcNow;
+                    _logger.LogWarning(
+                        "Neo node {Url} taken out of rotation after {Failures} failures, {Lag} blocks behind: {Error}",
+                        node.Url, node.ConsecutiveFailures, Lag(node, highest), node.LastError);
+                }
+                else if (healthy && node.UnhealthySince != null)
+                {
+                    node.UnhealthySince = null;
+                    _logger.LogInformation("Neo node {Url} back in rotation", node.Url);
+                }
+            }
+        }
+
+        private bool IsHealthy(NodeState node, long highest)
+        {
+            return node.ConsecutiveFailures < Math.Max(1, _configuration.UnhealthyAfterFailures)
+                && Lag(node, highest) <= _configuration.MaxBlockLag;
+        }
+
+        private static long Lag(NodeState node, long highest)
+        {
+            return node.BlockCount.HasValue ? Math.Max(0, highest - node.BlockCount.Value) : 0;
+        }
+
+        private long HighestBlockCount()
+        {
+            return _nodes.Max(n => n.BlockCount ?? 0);
+        }
+
+        private NodeState Find(string url)
+        {
+            return _nodes.FirstOrDefault(n => n.Url == url);
+        }
+
+        private sealed class NodeState
+        {
+            public NodeState(string url, int weight)
+            {
+                Url = url;
+                Weight = weight;
+            }
+
+            public string Url { get; }
+
+            public int Weight { get; }
+
+            public int CurrentWeight { get; set; }
+
+            public long? BlockCount { get; set; }
+
+            public double AverageLatencyMs { get; set; }
+
+            public long Requests { get; set; }
+
+            public long Errors { get; set; }
+
+            public int ConsecutiveFailures { get; set; }
+
+            public string LastError { get; set; }
+
+            public DateTime? LastCheckedAt { get; set; }
+
+            public DateTime? UnhealthySince { get; set; }
+        }
+    }
+}
@@ -0,0 +1,297 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.IO;
+using System.Linq;
+using System.Text;
+using System.Text.Json;
+using System.Threading;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.Blockchain
+{
+    /// <summary>
+    /// On-disk cache of Neo RPC results that never change once the node has returned them
+    /// </summary>
+    /// <remarks>
+    /// Neo N3 blocks are final as soon as they are persisted, so a block, a transaction, its height, its application log
+    /// and the state root of a block can be kept forever. Contract state can change when a contract is updated
+    /// and is kept for <see cref="NeoRpcConfiguration.ContractStateCacheSeconds"/> only. Errors are never cached,
+    /// so a transaction the node does not know yet is looked up again next time.
+    ///
+    /// Each result is a file named after the hash of the node URL, method and parameters. When the files
+    /// exceed <see cref="NeoRpcConfiguration.ResponseCacheMaxMegabytes"/>, the least recently used are deleted.
+    /// The cache survives restarts; the index is rebuilt from the files' timestamps.
+    /// </remarks>
+    public class NeoRpcResponseCache
+    {
+        private static readonly IReadOnlyDictionary<string, bool> CacheableMethods = new Dictionary<string, bool>(StringComparer.OrdinalIgnoreCase)
+        {
+            // Method => whether the result expires
+            ["getblock"] = false,
+            ["getblockheader"] = false,
+            ["getblockhash"] = false,
+            ["getrawtransaction"] = false,
+            ["getapplicationlog"] = false,
+            ["gettransactionheight"] = false,
+            ["getstateroot"] = false,
+            ["getcontractstate"] = true
+        };
+
+        private readonly ILogger<NeoRpcResponseCache> _logger;
+        private readonly NeoRpcConfiguration _configuration;
+        private readonly ConcurrentDictionary<string, Entry> _entries = new ConcurrentDictionary<string, Entry>();
+        private readonly object _evictionLock = new object();
+        private long _totalBytes;
+        private long _hits;
+        private long _misses;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="NeoRpcResponseCache"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="configuration">Neo RPC configuration</param>
+        public NeoRpcResponseCache(ILogger<NeoRpcResponseCache> logger, IOptions<NeoRpcConfiguration> configuration)
+        {
+            _logger = logger;
+            _configuration = configuration.Value;
+
+            if (Enabled)
+            {
+                LoadIndex();
+            }
+        }
+
+        /// <summary>
+        /// Gets whether responses are cached
+        /// </summary>
+        public bool Enabled => _configuration.ResponseCacheEnabled;
+
+        /// <summary>
+        /// Gets the number of results served from the cache
+        /// </summary>
+        public long Hits => Interlocked.Read(ref _hits);
+
+        /// <summary>
+        /// Gets the number of cacheable calls that went to the node
+        /// </summary>
+        public long Misses => Interlocked.Read(ref _misses);
+
+        /// <summary>
+        /// Gets the size of the cached results in bytes
+        /// </summary>
+        public long SizeBytes => Interlocked.Read(ref _totalBytes);
+
+        /// <summary>
+        /// Checks whether the result of a call may be cached
+        /// </summary>
+        /// <param name="method">RPC method</param>
+        /// <param name="parameters">RPC parameters</param>
+        /// <returns>True if the result never changes or changes rarely enough to cache</returns>
+        public bool IsCacheable(string method, IReadOnlyList<object> parameters)
+        {
+            if (!Enabled || !CacheableMethods.ContainsKey(method))
+            {
+                return false;
+            }
+
+            // Every cacheable method names what it looks up; a call without parameters is not one we know
+            return parameters != null && parameters.Count > 0;
+        }
+
+        /// <summary>
+        /// Gets a cached result
+        /// </summary>
+        /// <param name="url">Node URL</param>
+        /// <param name="method">RPC method</param>
+        /// <param name="parameters">Serialized RPC parameters</param>
+        /// <param name="result">The cached result</param>
+        /// <returns>True if the result was cached</returns>
+        public bool TryGet(string url, string method, string parameters, out JsonElement result)
+        {
+            result = default;
+            var key = Key(url, method, parameters);
+
+            if (_entries.TryGetValue(key, out var entry))
+            {
+                if (entry.ExpiresAt != null && entry.ExpiresAt <= DateTime.UtcNow)
+                {
+                    Remove(key);
+                }
+                else
+                {
+                    try
+                    {
+                        using var document = JsonDocument.Parse(File.ReadAllText(PathOf(key)));
+                        result = document.RootElement.Clone();
+
+                        entry.LastAccessed = DateTime.UtcNow;
+                        Interlocked.Increment(ref _hits);
+                        return true;
+                    }
+                    catch (Exception ex) when (ex is IOException || ex is JsonException)
+                    {
+                        // A file deleted or truncated behind our back is treated as a miss
+                        _logger.LogWarning(ex, "Dropping unreadable cached Neo RPC result {Key}", key);
+                        Remove(key);
+                    }
+                }
+            }
+
+            Interlocked.Increment(ref _misses);
+            return false;
+        }
+
+        /// <summary>
+        /// Caches a result
+        /// </summary>
+        /// <param name="url">Node URL</param>
+        /// <param name="method">RPC method</param>
+        /// <param name="parameters">Serialized RPC parameters</param>
+        /// <param name="result">Result returned by the node</param>
+        public void Set(string url, string method, string parameters, JsonElement result)
+        {
+            if (result.ValueKind == JsonValueKind.Null || result.ValueKind == JsonValueKind.Undefined)
+            {
+                return;
+            }
+
+            var key = Key(url, method, parameters);
+            var bytes = Encoding.UTF8.GetBytes(result.GetRawText());
+            var expiresAt = CacheableMethods.TryGetValue(method, out var expires) && expires
+                ? DateTime.UtcNow.AddSeconds(_configuration.ContractStateCacheSeconds)
+                : (DateTime?)null;
+
+            try
+            {
+                Directory.CreateDirectory(_configuration.ResponseCacheDirectory);
+
+                // Write then rename, so a concurrent reader never sees half a file
+                var path = PathOf(key);
+                var temporaryPath = path + "." + Guid.NewGuid().ToString("N") + ".tmp";
+                File.WriteAllBytes(temporaryPath, bytes);
+                File.Move(temporaryPath, path, true);
+                if (expiresAt != null)
+                {
+                    File.WriteAllText(path + ".expires", expiresAt.Value.ToString("O"));
+                }
+            }
+            catch (IOException ex)
+            {
+                _logger.LogWarning(ex, "Could not cache Neo RPC result of {Method}", method);
+                return;
+            }
+
+            var entry = new Entry { Size = bytes.Length, LastAccessed = DateTime.UtcNow, ExpiresAt = expiresAt };
+            _entries.AddOrUpdate(
+                key,
+                _ => { Interlocked.Add(ref _totalBytes, entry.Size); return entry; },
+                (_, previous) => { Interlocked.Add(ref _totalBytes, entry.Size - previous.Size); return entry; });
+
+            Evict();
+        }
+
+        private void Evict()
+        {
+            var limit = Math.Max(1, _configuration.ResponseCacheMaxMegabytes) * 1024L * 1024L;
+            if (SizeBytes <= limit)
+            {
+                return;
+            }
+
+            lock (_evictionLock)
+            {
+                // Drop down to 90% of the limit, so a full cache does not evict on every write
+                var target = limit * 9 / 10;
+                foreach (var key in _entries.OrderBy(e => e.Value.LastAccessed).Select(e => e.Key).ToList())
+                {
+                    if (SizeBytes <= target)
+                    {
+                        break;
+                    }
+
+                    Remove(key);
+                }
+            }
+        }
+
+        private void Remove(string key)
+        {
+            if (!_entries.TryRemove(key, out var entry))
+            {
+                return;
+            }
+
+            Interlocked.Add(ref _totalBytes, -entry.Size);
+
+            try
+            {
+                File.Delete(PathOf(key));
+                File.Delete(PathOf(key) + ".expires");
+            }
+            catch (IOException ex)
+            {
+                _logger.LogWarning(ex, "Could not delete cached Neo RPC result {Key}", key);
+            }
+        }
+
+        private void LoadIndex()
+        {
+            var directory = new DirectoryInfo(_configuration.ResponseCacheDirectory);
+            if (!directory.Exists)
+            {
+                return;
+            }
+
+            foreach (var file in directory.EnumerateFiles("*.json"))
+            {
+                DateTime? expiresAt = null;
+                var expiresFile = file.FullName + ".expires";
+                if (File.Exists(expiresFile) && DateTime.TryParse(File.ReadAllText(expiresFile), null,
+                    System.Globalization.DateTimeStyles.RoundtripKind, out var parsed))
+                {
+                    expiresAt = parsed;
+                }
+
+                var key = Path.GetFileNameWithoutExtension(file.Name);
+                if (_entries.TryAdd(key, new Entry { Size = file.Length, LastAccessed = file.LastWriteTimeUtc, ExpiresAt = expiresAt }))
+                {
+                    Interlocked.Add(ref _totalBytes, file.Length);
+                }
+            }
+
+            // Leftovers of writes interrupted by a crash
+            foreach (var file in directory.EnumerateFiles("*.tmp"))
+            {
+                file.Delete();
+            }
+
+            _logger.LogInformation("Loaded {Count} cached Neo RPC results ({Bytes} bytes) from {Directory}",
+                _entries.Count, SizeBytes, directory.FullName);
+            Evict();
+        }
+
+        private string PathOf(string key)
+        {
+            return Path.Combine(_configuration.ResponseCacheDirectory, key + ".json");
+        }
+
+        private static string Key(string url, string method, string parameters)
+        {
+            // The node URL is part of the key, so pointing the client at another network never serves stale results
+            return HashUtility.ComputeSha256Hash($"{url}\n{method.ToLowerInvariant()}\n{parameters}");
+        }
+
+        private sealed class Entry
+        {
+            public long Size { get; set; }
+
+            public DateTime LastAccessed { get; set; }
+
+            public DateTime? ExpiresAt { get; set; }
+        }
+    }
+}
@@ -0,0 +1,43 @@
+using System;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Services.Diagnostics
+{
+    /// <summary>
+    /// Extension methods for running background work under the crash reporter
+    /// </summary>
+    public static class CrashReporterExtensions
+    {
+        /// <summary>
+        /// Creates a timer callback that runs the work and reports any exception it throws
+        /// </summary>
+        /// <param name="crashReporter">Crash reporter, may be null in which case exceptions are only logged</param>
+        /// <param name="logger">Logger used when no crash reporter is available</param>
+        /// <param name="component">Component running the work</param>
+        /// <param name="work">The work to run</param>
+        /// <returns>Timer callback</returns>
+        public static TimerCallback Guard(this ICrashReporter crashReporter, ILogger logger, string component, Func<Task> work)
+        {
+            return async _ =>
+            {
+                if (crashReporter != null)
+                {
+                    await crashReporter.RunAsync(component, work);
+                    return;
+                }
+
+                try
+                {
+                    await work();
+                }
+                catch (Exception ex)
+                {
+                    logger.LogCritical(ex, "Unhandled exception in {Component}", component);
+                }
+            };
+        }
+    }
+}
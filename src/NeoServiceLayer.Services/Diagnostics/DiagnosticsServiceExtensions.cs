@@ -0,0 +1,25 @@
+using Microsoft.Extensions.DependencyInjection;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Services.Diagnostics
+{
+    /// <summary>
+    /// Extension methods for registering diagnostics services
+    /// </summary>
+    public static class DiagnosticsServiceExtensions
+    {
+        /// <summary>
+        /// Adds diagnostics services to the service collection
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddDiagnosticsServices(this IServiceCollection services)
+        {
+            // Register services
+            services.AddSingleton<CrashReporter>();
+            services.AddSingleton<ICrashReporter>(provider => provider.GetRequiredService<CrashReporter>());
+
+            return services;
+        }
+    }
+}
@@ -0,0 +1,343 @@
+using System;
+using System.Collections.Generic;
+using System.Diagnostics;
+using System.Linq;
+using System.Text;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Diagnostics
+{
+    /// <summary>
+    /// Runs live end-to-end checks of the components the service depends on
+    /// </summary>
+    /// <remarks>
+    /// Unlike the health check, which reports what the service last saw, every check here does real work against the
+    /// live component: it reads the chain, has the enclave sign, attest and run code, and writes to storage. The checks
+    /// run in parallel and each is bounded by <see cref="SystemVerificationConfiguration.CheckTimeoutSeconds"/>, so a
+    /// hung component fails its own check without holding up the report. A check whose component is not registered in
+    /// this deployment is skipped.
+    /// </remarks>
+    public class SystemVerificationService : ISystemVerificationService
+    {
+        private readonly ILogger<SystemVerificationService> _logger;
+        private readonly SystemVerificationConfiguration _configuration;
+        private readonly INeoRpcClient _neoRpcClient;
+        private readonly IWalletService _walletService;
+        private readonly IEnclaveService _enclaveService;
+        private readonly IStorageProvider _storageProvider;
+        private readonly IPriceFeedService _priceFeedService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SystemVerificationService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="configuration">Verification configuration</param>
+        /// <param name="neoRpcClient">Neo RPC client, or null to skip the RPC check</param>
+        /// <param name="walletService">Wallet service, or null to skip the wallet check</param>
+        /// <param name="enclaveService">Enclave service, or null to skip the wallet, attestation and sandbox checks</param>
+        /// <param name="storageProvider">Storage provider, or null to skip the storage check</param>
+        /// <param name="priceFeedService">Price feed service, or null to skip the price check</param>
+        public SystemVerificationService(
+            ILogger<SystemVerificationService> logger,
+            IOptions<SystemVerificationConfiguration> configuration,
+            INeoRpcClient neoRpcClient = null,
+            IWalletService walletService = null,
+            IEnclaveService enclaveService = null,
+            IStorageProvider storageProvider = null,
+            IPriceFeedService priceFeedService = null)
+        {
+            _logger = logger;
+            _configuration = configuration.Value;
+            _neoRpcClient = neoRpcClient;
+            _walletService = walletService;
+            _enclaveService = enclaveService;
+            _storageProvider = storageProvider;
+            _priceFeedService = priceFeedService;
+        }
+
+        /// <inheritdoc/>
+        public async Task<SystemVerificationReport> VerifyAsync(IEnumerable<string> checks = null)
+        {
+            var names = checks?.Select(c => c?.Trim().ToLowerInvariant()).Where(c => !string.IsNullOrEmpty(c)).Distinct().ToList();
+            if (names == null || names.Count == 0)
+            {
+                names = SystemVerificationChecks.All.ToList();
+            }
+
+            var unknown = names.Where(n => !SystemVerificationChecks.All.Contains(n)).ToList();
+            if (unknown.Count > 0)
+            {
+                throw new ValidationException(
+                    $"Unknown checks: {string.Join(", ", unknown)}. Known checks: {string.Join(", ", SystemVerificationChecks.All)}");
+            }
+
+            var report = new SystemVerificationReport { StartedAt = DateTime.UtcNow };
+
+            // Run in the canonical order whatever order they were asked for in
+            var ordered = SystemVerificationChecks.All.Where(names.Contains).ToList();
+            report.Checks = (await Task.WhenAll(ordered.Select(RunCheckAsync))).ToList();
+            report.CompletedAt = DateTime.UtcNow;
+
+            var failed = report.Checks.Where(c => c.Status == SystemVerificationStatus.Failed).Select(c => c.Name).ToList();
+            if (failed.Count > 0)
+            {
+                _logger.LogWarning("System verification failed: {Checks}", string.Join(", ", failed));
+            }
+            else
+            {
+                _logger.LogInformation("System verification passed {Count} checks", report.Checks.Count);
+            }
+
+            return report;
+        }
+
+        private async Task<SystemVerificationCheck> RunCheckAsync(string name)
+        {
+            var check = new SystemVerificationCheck { Name = name };
+            var stopwatch = Stopwatch.StartNew();
+
+            try
+            {
+                var run = RunAsync(name);
+                var timeout = TimeSpan.FromSeconds(Math.Max(1, _configuration.CheckTimeoutSeconds));
+                if (await Task.WhenAny(run, Task.Delay(timeout)) != run)
+                {
+                    check.Status = SystemVerificationStatus.Failed;
+                    check.Detail = $"No answer within {timeout.TotalSeconds:0} seconds";
+                }
+                else
+                {
+                    (check.Status, check.Detail) = await run;
+                }
+            }
+            catch (Exception ex)
+            {
+                _logger.LogWarning(ex, "System verification check {Check} failed", name);
+                check.Status = SystemVerificationStatus.Failed;
+                check.Detail = ex.Message;
+            }
+
+            check.DurationMs = stopwatch.ElapsedMilliseconds;
+            return check;
+        }
+
+        private Task<(SystemVerificationStatus, string)> RunAsync(string name)
+        {
+            switch (name)
+            {
+                case SystemVerificationChecks.Rpc:
+                    return CheckRpcAsync();
+                case SystemVerificationChecks.Wallet:
+                    return CheckWalletAsync();
+                case SystemVerificationChecks.Attestation:
+                    return CheckAttestationAsync();
+                case SystemVerificationChecks.Storage:
+                    return CheckStorageAsync();
+                case SystemVerificationChecks.Prices:
+                    return CheckPricesAsync();
+                case SystemVerificationChecks.Sandbox:
+                    return CheckSandboxAsync();
+                default:
+                    throw new ArgumentOutOfRangeException(nameof(name), name, "Unknown check");
+            }
+        }
+
+        private async Task<(SystemVerificationStatus, string)> CheckRpcAsync()
+        {
+            if (_neoRpcClient == null)
+            {
+                return Skipped("Neo RPC client is not configured");
+            }
+
+            var blockCount = await _neoRpcClient.GetBlockCountAsync();
+            if (blockCount <= 0)
+            {
+                return (SystemVerificationStatus.Failed, $"Node reported block count {blockCount}");
+            }
+
+            var nodes = _neoRpcClient.GetNodeStatuses();
+            var detail = $"Block count {blockCount}";
+            if (nodes.Count > 1)
+            {
+                detail += $"; {nodes.Count(n => n.IsHealthy)} of {nodes.Count} nodes in rotation";
+            }
+
+            return (SystemVerificationStatus.Passed, detail);
+        }
+
+        private async Task<(SystemVerificationStatus, string)> CheckWalletAsync()
+        {
+            if (_walletService == null || _enclaveService == null)
+            {
+                return Skipped("Wallet service is not configured");
+            }
+
+            var wallet = (await _walletService.GetServiceWalletsAsync())?.FirstOrDefault();
+            if (wallet == null)
+            {
+                return Skipped("No service wallet to decrypt");
+            }
+
+            // Service wallet keys are held by the enclave, so the password is not used
+            var signature = await _walletService.SignDataAsync(wallet.Id, Guid.NewGuid().ToString(), Encoding.UTF8.GetBytes($"ops-verify:{Guid.NewGuid()}"));
+            if (string.IsNullOrEmpty(signature))
+            {
+                return (SystemVerificationStatus.Failed, $"Service wallet {wallet.Address} returned no signature");
+            }
+
+            return (SystemVerificationStatus.Passed, $"Service wallet {wallet.Address} decrypted and signed");
+        }
+
+        private async Task<(SystemVerificationStatus, string)> CheckAttestationAsync()
+        {
+            if (_enclaveService == null)
+            {
+                return Skipped("Enclave is not configured");
+            }
+
+            var document = await _enclaveService.GetAttestationDocumentAsync();
+            if (document == null || document.Length == 0)
+            {
+                return (SystemVerificationStatus.Failed, "Enclave returned an empty attestation document");
+            }
+
+            if (!await _enclaveService.VerifyAttestationDocumentAsync(document))
+            {
+                return (SystemVerificationStatus.Failed, $"Attestation document of {document.Length} bytes did not verify");
+            }
+
+            return (SystemVerificationStatus.Passed, $"Attestation document of {document.Length} bytes verified");
+        }
+
+        private async Task<(SystemVerificationStatus, string)> CheckStorageAsync()
+        {
+            if (_storageProvider == null)
+            {
+                return Skipped("Storage provider is not configured");
+            }
+
+            var collection = _configuration.StorageCollection;
+            var probe = new VerificationProbe { Id = Guid.NewGuid(), Value = Guid.NewGuid().ToString(), CreatedAt = DateTime.UtcNow };
+
+            await _storageProvider.CreateAsync(collection, probe);
+            try
+            {
+                var read = await _storageProvider.GetByIdAsync<VerificationProbe, Guid>(collection, probe.Id);
+                if (read == null)
+                {
+                    return (SystemVerificationStatus.Failed, $"Probe record written to {collection} could not be read back");
+                }
+
+                if (read.Value != probe.Value)
+                {
+                    return (SystemVerificationStatus.Failed, $"Probe record read back from {collection} does not match what was written");
+                }
+            }
+            finally
+            {
+                await _storageProvider.DeleteAsync<VerificationProbe, Guid>(collection, probe.Id);
+            }
+
+            return (SystemVerificationStatus.Passed, $"Probe record written, read back and deleted in {collection}");
+        }
+
+        private async Task<(SystemVerificationStatus, string)> CheckPricesAsync()
+        {
+            if (_priceFeedService == null)
+            {
+                return Skipped("Price feed is not configured");
+            }
+
+            var sources = (await _priceFeedService.GetActiveSourcesAsync())?.ToList() ?? new List<PriceSource>();
+            if (sources.Count == 0)
+            {
+                return Skipped("No active price sources");
+            }
+
+            var failures = new List<string>();
+            foreach (var source in sources)
+            {
+                try
+                {
+                    var prices = await _priceFeedService.FetchPriceFromSourceAsync(source.Id);
+                    if (prices == null || !prices.Any())
+                    {
+                        failures.Add($"{source.Name} returned no prices");
+                    }
+                }
+                catch (Exception ex)
+                {
+                    failures.Add($"{source.Name}: {ex.Message}");
+                }
+            }
+
+            var answered = sources.Count - failures.Count;
+            var detail = $"{answered} of {sources.Count} sources returned prices";
+            if (failures.Count > 0)
+            {
+                detail += $"; {string.Join("; ", failures)}";
+            }
+
+            // One source being down is what aggregation tolerates; none answering means no price can be published
+            return (answered > 0 ? SystemVerificationStatus.Passed : SystemVerificationStatus.Failed, detail);
+        }
+
+        private async Task<(SystemVerificationStatus, string)> CheckSandboxAsync()
+        {
+            if (_enclaveService == null)
+            {
+                return Skipped("Enclave is not configured");
+            }
+
+            var nonce = Guid.NewGuid().ToString("N");
+            var response = await _enclaveService.SendRequestAsync<object, SmokeTestResponse>(
+                Constants.EnclaveServiceTypes.Function,
+                Constants.FunctionOperations.SmokeTest,
+                new { Nonce = nonce });
+
+            if (response?.Result == null)
+            {
+                return (SystemVerificationStatus.Failed, "Sandbox returned no result");
+            }
+
+            var expected = "pong:" + new string(nonce.Reverse().ToArray());
+            if (response.Result != expected)
+            {
+                return (SystemVerificationStatus.Failed, $"Sandbox returned '{response.Result}' instead of '{expected}'");
+            }
+
+            return (SystemVerificationStatus.Passed, "Built-in JavaScript function ran and returned the expected result");
+        }
+
+        private static (SystemVerificationStatus, string) Skipped(string reason)
+        {
+            return (SystemVerificationStatus.Skipped, reason);
+        }
+
+        /// <summary>
+        /// Record the storage check writes and deletes
+        /// </summary>
+        private class VerificationProbe
+        {
+            public Guid Id { get; set; }
+
+            public string Value { get; set; }
+
+            public DateTime CreatedAt { get; set; }
+        }
+
+        /// <summary>
+        /// Response of the enclave's sandbox smoke test
+        /// </summary>
+        private class SmokeTestResponse
+        {
+            public string Result { get; set; }
+        }
+    }
+}
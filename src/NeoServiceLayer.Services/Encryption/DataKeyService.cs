@@ -0,0 +1,154 @@
+using System;
+using System.Collections.Concurrent;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Encryption.Repositories;
+
+namespace NeoServiceLayer.Services.Encryption
+{
+    /// <summary>
+    /// Implementation of the data key service
+    /// </summary>
+    /// <remarks>
+    /// Data keys are generated and unwrapped by the enclave; only wrapped keys are persisted.
+    /// Both operations are protected, so the enclave manager attaches a capability token to them.
+    /// Unwrapped keys are cached in memory so that reads do not need an enclave round trip.
+    /// </remarks>
+    public class DataKeyService : IDataKeyService
+    {
+        private readonly ILogger<DataKeyService> _logger;
+        private readonly IDataKeyRepository _dataKeyRepository;
+        private readonly IEnclaveService _enclaveService;
+        private readonly ConcurrentDictionary<Guid, byte[]> _keyCache = new ConcurrentDictionary<Guid, byte[]>();
+        private readonly SemaphoreSlim _createSemaphore = new SemaphoreSlim(1, 1);
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="DataKeyService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="dataKeyRepository">Data key repository</param>
+        /// <param name="enclaveService">Enclave service</param>
+        public DataKeyService(ILogger<DataKeyService> logger, IDataKeyRepository dataKeyRepository, IEnclaveService enclaveService)
+        {
+            _logger = logger;
+            _dataKeyRepository = dataKeyRepository;
+            _enclaveService = enclaveService;
+        }
+
+        /// <inheritdoc/>
+        public async Task<byte[]> GetOrCreateKeyAsync(Guid accountId)
+        {
+            var key = await GetKeyAsync(accountId);
+            if (key != null)
+            {
+                return key;
+            }
+
+            await _createSemaphore.WaitAsync();
+            try
+            {
+                var dataKey = await _dataKeyRepository.GetByAccountIdAsync(accountId);
+                if (dataKey?.RevokedAt != null)
+                {
+                    throw new InvalidOperationException($"The data key of account {accountId} has been revoked");
+                }
+
+                if (dataKey != null)
+                {
+                    return await UnwrapAsync(dataKey);
+                }
+
+                _logger.LogInformation("Generating data key for account: {AccountId}", accountId);
+
+                var response = await _enclaveService.SendRequestAsync<object, DataKeyResponse>(
+                    Constants.EnclaveServiceTypes.Secrets,
+                    Constants.SecretsOperations.GenerateDataKey,
+                    new { AccountId = accountId });
+
+                await _dataKeyRepository.CreateAsync(new DataKey
+                {
+                    AccountId = accountId,
+                    WrappedKey = response.Ciphertext
+                });
+
+                key = Convert.FromBase64String(response.Plaintext);
+                _keyCache[accountId] = key;
+                return key;
+            }
+            finally
+            {
+                _createSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<byte[]> GetKeyAsync(Guid accountId)
+        {
+            if (_keyCache.TryGetValue(accountId, out var key))
+            {
+                return key;
+            }
+
+            var dataKey = await _dataKeyRepository.GetByAccountIdAsync(accountId);
+            if (dataKey == null || dataKey.RevokedAt != null)
+            {
+                return null;
+            }
+
+            return await UnwrapAsync(dataKey);
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> RevokeKeyAsync(Guid accountId)
+        {
+            _keyCache.TryRemove(accountId, out _);
+
+            var dataKey = await _dataKeyRepository.GetByAccountIdAsync(accountId);
+            if (dataKey == null || dataKey.RevokedAt != null)
+            {
+                return false;
+            }
+
+            _logger.LogWarning("Revoking data key of account: {AccountId}", accountId);
+
+            // Discarding the wrapped key is what makes the encrypted data unrecoverable
+            dataKey.WrappedKey = null;
+            dataKey.RevokedAt = DateTime.UtcNow;
+            await _dataKeyRepository.UpdateAsync(dataKey);
+
+            return true;
+        }
+
+        private async Task<byte[]> UnwrapAsync(DataKey dataKey)
+        {
+            var response = await _enclaveService.SendRequestAsync<object, DataKeyResponse>(
+                Constants.EnclaveServiceTypes.Secrets,
+                Constants.SecretsOperations.DecryptDataKey,
+                new { dataKey.AccountId, Ciphertext = dataKey.WrappedKey });
+
+            var key = Convert.FromBase64String(response.Plaintext);
+            _keyCache[dataKey.AccountId] = key;
+            return key;
+        }
+
+        /// <summary>
+        /// Data key returned by the enclave
+        /// </summary>
+        private class DataKeyResponse
+        {
+            /// <summary>
+            /// Gets or sets the Base64 encoded plain key
+            /// </summary>
+            public string Plaintext { get; set; }
+
+            /// <summary>
+            /// Gets or sets the wrapped key
+            /// </summary>
+            public string Ciphertext { get; set; }
+        }
+    }
+}
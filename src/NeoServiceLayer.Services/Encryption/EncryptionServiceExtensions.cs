@@ -0,0 +1,29 @@
+using Microsoft.Extensions.DependencyInjection;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Services.Encryption.Repositories;
+
+namespace NeoServiceLayer.Services.Encryption
+{
+    /// <summary>
+    /// Extension methods for registering encryption services
+    /// </summary>
+    public static class EncryptionServiceExtensions
+    {
+        /// <summary>
+        /// Adds encryption services to the service collection
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddEncryptionServices(this IServiceCollection services)
+        {
+            // Register repositories
+            services.AddSingleton<IDataKeyRepository, DataKeyRepository>();
+
+            // Register services
+            services.AddSingleton<IDataKeyService, DataKeyService>();
+            services.AddSingleton<IExecutionDataProtector, ExecutionDataProtector>();
+
+            return services;
+        }
+    }
+}
@@ -0,0 +1,209 @@
+using System;
+using System.Collections.Generic;
+using System.Text.Json;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.Encryption
+{
+    /// <summary>
+    /// Encrypts execution inputs, outputs and logs with the owning account's data key
+    /// </summary>
+    /// <remarks>
+    /// Records of accounts whose key has been revoked are stored and returned without their input,
+    /// output and logs; everything else about the execution stays readable for billing and metrics.
+    /// </remarks>
+    public class ExecutionDataProtector : IExecutionDataProtector
+    {
+        private readonly ILogger<ExecutionDataProtector> _logger;
+        private readonly IDataKeyService _dataKeyService;
+        private readonly DataEncryptionConfiguration _configuration;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ExecutionDataProtector"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="dataKeyService">Data key service</param>
+        /// <param name="configuration">Configuration</param>
+        public ExecutionDataProtector(
+            ILogger<ExecutionDataProtector> logger,
+            IDataKeyService dataKeyService,
+            IOptions<DataEncryptionConfiguration> configuration)
+        {
+            _logger = logger;
+            _dataKeyService = dataKeyService;
+            _configuration = configuration.Value;
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionExecutionResult> ProtectAsync(FunctionExecutionResult execution)
+        {
+            if (!_configuration.Enabled || execution.AccountId == Guid.Empty || execution.EncryptedData != null)
+            {
+                return execution;
+            }
+
+            var protectedExecution = Copy(execution);
+            protectedExecution.Input = null;
+            protectedExecution.Output = null;
+            protectedExecution.Logs = new List<string>();
+
+            var key = await GetKeyForWriteAsync(execution.AccountId);
+            if (key == null)
+            {
+                return protectedExecution;
+            }
+
+            var data = JsonSerializer.Serialize(new ExecutionData
+            {
+                Input = execution.Input,
+                Output = execution.Output,
+                Logs = execution.Logs
+            });
+
+            protectedExecution.EncryptedData = EncryptionUtility.EncryptWithKey(data, key);
+            return protectedExecution;
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionExecutionResult> UnprotectAsync(FunctionExecutionResult execution)
+        {
+            if (execution?.EncryptedData == null)
+            {
+                return execution;
+            }
+
+            var unprotectedExecution = Copy(execution);
+            unprotectedExecution.EncryptedData = null;
+
+            var key = await _dataKeyService.GetKeyAsync(execution.AccountId);
+            if (key == null)
+            {
+                return unprotectedExecution;
+            }
+
+            var data = JsonSerializer.Deserialize<ExecutionData>(EncryptionUtility.DecryptWithKey(execution.EncryptedData, key));
+            unprotectedExecution.Input = data.Input;
+            unprotectedExecution.Output = data.Output;
+            unprotectedExecution.Logs = data.Logs ?? new List<string>();
+
+            return unprotectedExecution;
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionLog> ProtectLogAsync(FunctionLog log)
+        {
+            if (!_configuration.Enabled || log.AccountId == Guid.Empty || log.IsEncrypted || string.IsNullOrEmpty(log.Message))
+            {
+                return log;
+            }
+
+            var key = await GetKeyForWriteAsync(log.AccountId);
+
+            return new FunctionLog
+            {
+                Id = log.Id,
+                ExecutionId = log.ExecutionId,
+                Timestamp = log.Timestamp,
+                Level = log.Level,
+                AccountId = log.AccountId,
+                Message = key == null ? null : EncryptionUtility.EncryptWithKey(log.Message, key),
+                IsEncrypted = key != null
+            };
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionLog> UnprotectLogAsync(FunctionLog log)
+        {
+            if (log == null || !log.IsEncrypted)
+            {
+                return log;
+            }
+
+            var key = await _dataKeyService.GetKeyAsync(log.AccountId);
+
+            return new FunctionLog
+            {
+                Id = log.Id,
+                ExecutionId = log.ExecutionId,
+                Timestamp = log.Timestamp,
+                Level = log.Level,
+                AccountId = log.AccountId,
+                Message = key == null ? null : EncryptionUtility.DecryptWithKey(log.Message, key),
+                IsEncrypted = false
+            };
+        }
+
+        private async Task<byte[]> GetKeyForWriteAsync(Guid accountId)
+        {
+            try
+            {
+                return await _dataKeyService.GetOrCreateKeyAsync(accountId);
+            }
+            catch (InvalidOperationException ex)
+            {
+                // The account has been deleted; its data must not be stored in plain text
+                _logger.LogWarning(ex, "Discarding execution data of account {AccountId} with a revoked data key", accountId);
+                return null;
+            }
+        }
+
+        private static FunctionExecutionResult Copy(FunctionExecutionResult execution)
+        {
+            return new FunctionExecutionResult
+            {
+                Id = execution.Id,
+                ExecutionId = execution.ExecutionId,
+                FunctionId = execution.FunctionId,
+                AccountId = execution.AccountId,
+                FunctionVersionId = execution.FunctionVersionId,
+                FunctionVersionNumber = execution.FunctionVersionNumber,
+                Input = execution.Input,
+                Status = execution.Status,
+                Output = execution.Output,
+                Error = execution.Error,
+                StartTime = execution.StartTime,
+                EndTime = execution.EndTime,
+                ExecutionTimeMs = execution.ExecutionTimeMs,
+                MemoryUsageMb = execution.MemoryUsageMb,
+                CpuUsagePercentage = execution.CpuUsagePercentage,
+                CpuUsagePercent = execution.CpuUsagePercent,
+                DurationMs = execution.DurationMs,
+                GasUsed = execution.GasUsed,
+                GasCharged = execution.GasCharged,
+                BillingAmount = execution.BillingAmount,
+                Logs = execution.Logs,
+                Metrics = execution.Metrics,
+                TraceId = execution.TraceId,
+                RequestId = execution.RequestId,
+                SpanId = execution.SpanId,
+                EncryptedData = execution.EncryptedData
+            };
+        }
+
+        /// <summary>
+        /// Execution data that is encrypted at rest
+        /// </summary>
+        private class ExecutionData
+        {
+            /// <summary>
+            /// Gets or sets the input parameters
+            /// </summary>
+            public object Input { get; set; }
+
+            /// <summary>
+            /// Gets or sets the output
+            /// </summary>
+            public object Output { get; set; }
+
+            /// <summary>
+            /// Gets or sets the logs
+            /// </summary>
+            public List<string> Logs { get; set; }
+        }
+    }
+}
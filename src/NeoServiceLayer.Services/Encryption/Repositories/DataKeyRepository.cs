@@ -0,0 +1,97 @@
+using System;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Encryption.Repositories
+{
+    /// <summary>
+    /// Implementation of the data key repository
+    /// </summary>
+    public class DataKeyRepository : IDataKeyRepository
+    {
+        private readonly ILogger<DataKeyRepository> _logger;
+        private readonly IDatabaseService _databaseService;
+        private const string CollectionName = "data_keys";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="DataKeyRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="databaseService">Database service</param>
+        public DataKeyRepository(ILogger<DataKeyRepository> logger, IDatabaseService databaseService)
+        {
+            _logger = logger;
+            _databaseService = databaseService;
+        }
+
+        /// <inheritdoc/>
+        public async Task<DataKey> CreateAsync(DataKey dataKey)
+        {
+            _logger.LogInformation("Creating data key for account: {AccountId}", dataKey.AccountId);
+
+            try
+            {
+                if (dataKey.Id == Guid.Empty)
+                {
+                    dataKey.Id = Guid.NewGuid();
+                }
+
+                dataKey.CreatedAt = DateTime.UtcNow;
+
+                // Create collection if it doesn't exist
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    await _databaseService.CreateCollectionAsync(CollectionName);
+                }
+
+                return await _databaseService.CreateAsync(CollectionName, dataKey);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error creating data key for account: {AccountId}", dataKey.AccountId);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<DataKey> GetByAccountIdAsync(Guid accountId)
+        {
+            _logger.LogDebug("Getting data key for account: {AccountId}", accountId);
+
+            try
+            {
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    return null;
+                }
+
+                var dataKeys = await _databaseService.GetByFilterAsync<DataKey>(CollectionName, k => k.AccountId == accountId);
+                return dataKeys.OrderByDescending(k => k.CreatedAt).FirstOrDefault();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting data key for account: {AccountId}", accountId);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<DataKey> UpdateAsync(DataKey dataKey)
+        {
+            _logger.LogInformation("Updating data key: {Id}", dataKey.Id);
+
+            try
+            {
+                return await _databaseService.UpdateAsync<DataKey, Guid>(CollectionName, dataKey.Id, dataKey);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error updating data key: {Id}", dataKey.Id);
+                throw;
+            }
+        }
+    }
+}
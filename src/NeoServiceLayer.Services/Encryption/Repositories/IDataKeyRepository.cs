@@ -0,0 +1,33 @@
+using System;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Encryption.Repositories
+{
+    /// <summary>
+    /// Interface for data key repository
+    /// </summary>
+    public interface IDataKeyRepository
+    {
+        /// <summary>
+        /// Creates a data key
+        /// </summary>
+        /// <param name="dataKey">Data key to create</param>
+        /// <returns>The created data key</returns>
+        Task<DataKey> CreateAsync(DataKey dataKey);
+
+        /// <summary>
+        /// Gets the data key of an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>The data key if found, null otherwise</returns>
+        Task<DataKey> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Updates a data key
+        /// </summary>
+        /// <param name="dataKey">Data key to update</param>
+        /// <returns>The updated data key</returns>
+        Task<DataKey> UpdateAsync(DataKey dataKey);
+    }
+}
@@ -0,0 +1,642 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Linq;
+using System.Numerics;
+using System.Text;
+using Newtonsoft.Json.Linq;
+
+namespace NeoServiceLayer.Services.EventMonitoring
+{
+    /// <summary>
+    /// A boolean condition over decoded contract notification values, such as
+    /// <c>amount &gt;= 100000000 AND (to == "0x..." OR memo contains "refund")</c>
+    /// </summary>
+    /// <remarks>
+    /// Operands are literals (integers, decimals, quoted strings, <c>true</c>, <c>false</c>, <c>null</c>) or paths into the
+    /// notification values, made of names, <c>.name</c> members and <c>[n]</c> indexes. Paths are looked up, never evaluated.
+    /// Comparisons are typed: two values that both read as numbers compare as numbers, with integers compared exactly at
+    /// any size, since NEP-17 amounts arrive as decimal strings; booleans compare with booleans; everything else compares
+    /// as text, and <c>0x</c> hashes compare without regard to case. A path with no value is null. A comparison whose
+    /// operands cannot be ordered is false rather than an error, so a malformed notification does not match.
+    /// </remarks>
+    public sealed class ConditionExpression
+    {
+        /// <summary>
+        /// The longest expression accepted
+        /// </summary>
+        public const int MaxLength = 1000;
+
+        private const int MaxDepth = 32;
+
+        private readonly Node _root;
+
+        private ConditionExpression(string expression, Node root)
+        {
+            Expression = expression;
+            _root = root;
+        }
+
+        /// <summary>
+        /// Gets the expression the condition was parsed from
+        /// </summary>
+        public string Expression { get; }
+
+        /// <summary>
+        /// Parses a condition
+        /// </summary>
+        /// <param name="expression">The expression</param>
+        /// <returns>The condition</returns>
+        /// <exception cref="FormatException">The expression is not a valid condition</exception>
+        public static ConditionExpression Parse(string expression)
+        {
+            if (!TryParse(expression, out var condition, out var error))
+            {
+                throw new FormatException(error);
+            }
+
+            return condition;
+        }
+
+        /// <summary>
+        /// Tries to parse a condition
+        /// </summary>
+        /// <param name="expression">The expression</param>
+        /// <param name="condition">The condition, if the expression is valid</param>
+        /// <param name="error">Why the expression is invalid, if it is not</param>
+        /// <returns>True if the expression is valid</returns>
+        public static bool TryParse(string expression, out ConditionExpression condition, out string error)
+        {
+            condition = null;
+            error = null;
+
+            if (string.IsNullOrWhiteSpace(expression))
+            {
+                error = "A condition cannot be empty";
+                return false;
+            }
+
+            if (expression.Length > MaxLength)
+            {
+                error = $"A condition is at most {MaxLength} characters";
+                return false;
+            }
+
+            try
+            {
+                var parser = new Parser(Tokenize(expression));
+                var root = parser.ParseExpression(0);
+                parser.ExpectEnd();
+                condition = new ConditionExpression(expression.Trim(), root);
+                return true;
+            }
+            catch (FormatException ex)
+            {
+                error = ex.Message;
+                return false;
+            }
+        }
+
+        /// <summary>
+        /// Evaluates the condition against notification values
+        /// </summary>
+        /// <param name="values">Decoded notification values by name</param>
+        /// <returns>True if the condition holds</returns>
+        public bool Evaluate(IDictionary<string, object> values)
+        {
+            var context = values == null ? new JObject() : JObject.FromObject(values);
+            return _root.Evaluate(context) is true;
+        }
+
+        /// <inheritdoc/>
+        public override string ToString()
+        {
+            return Expression;
+        }
+
+        private static List<Token> Tokenize(string text)
+        {
+            var tokens = new List<Token>();
+            var i = 0;
+            while (i < text.Length)
+            {
+                var c = text[i];
+                if (char.IsWhiteSpace(c))
+                {
+                    i++;
+                    continue;
+                }
+
+                var start = i;
+                if (c == '"' || c == '\'')
+                {
+                    var value = new StringBuilder();
+                    i++;
+                    while (i < text.Length && text[i] != c)
+                    {
+                        // A backslash escapes the quote or another backslash
+                        if (text[i] == '\\' && i + 1 < text.Length)
+                        {
+                            i++;
+                        }
+
+                        value.Append(text[i++]);
+                    }
+
+                    if (i >= text.Length)
+                    {
+                        throw new FormatException($"Unterminated string starting at position {start + 1}");
+                    }
+
+                    i++;
+                    tokens.Add(new Token(TokenKind.String, value.ToString(), start));
+                }
+                else if (char.IsDigit(c) || (c == '-' && i + 1 < text.Length && char.IsDigit(text[i + 1]) && !EndsOperand(tokens)))
+                {
+                    i++;
+                    while (i < text.Length && (char.IsDigit(text[i]) || text[i] == '.'))
+                    {
+                        i++;
+                    }
+
+                    tokens.Add(new Token(TokenKind.Number, text.Substring(start, i - start), start));
+                }
+                else if (char.IsLetter(c) || c == '_')
+                {
+                    while (i < text.Length && (char.IsLetterOrDigit(text[i]) || text[i] == '_' || text[i] == '.' || text[i] == '[' || text[i] == ']'))
+                    {
+                        i++;
+                    }
+
+                    tokens.Add(new Token(TokenKind.Word, text.Substring(start, i - start), start));
+                }
+                else
+                {
+                    var two = i + 1 < text.Length ? text.Substring(i, 2) : null;
+                    if (two is "==" or "!=" or ">=" or "<=" or "&&" or "||")
+                    {
+                        tokens.Add(new Token(TokenKind.Symbol, two, start));
+                        i += 2;
+                    }
+                    else if (c is '>' or '<' or '!' or '(' or ')')
+                    {
+                        tokens.Add(new Token(TokenKind.Symbol, c.ToString(), start));
+                        i++;
+                    }
+                    else
+                    {
+                        throw new FormatException($"Unexpected character '{c}' at position {start + 1}");
+                    }
+                }
+            }
+
+            return tokens;
+        }
+
+        // A minus sign after an operand would be subtraction, which conditions do not have
+        private static bool EndsOperand(List<Token> tokens)
+        {
+            var last = tokens.LastOrDefault();
+            return last != null && (last.Kind != TokenKind.Symbol || last.Text == ")");
+        }
+
+        private static object ToValue(JToken token)
+        {
+            switch (token)
+            {
+                case null:
+                    return null;
+                case JValue value when value.Type == JTokenType.Null || value.Type == JTokenType.Undefined:
+                    return null;
+                case JValue value when value.Type == JTokenType.Boolean:
+                    return (bool)value;
+                case JValue value when value.Type == JTokenType.Integer:
+                    return value.Value is BigInteger big ? big : new BigInteger(Convert.ToInt64(value.Value, CultureInfo.InvariantCulture));
+                case JValue value when value.Type == JTokenType.Float:
+                    return Convert.ToDecimal(value.Value, CultureInfo.InvariantCulture);
+                case JValue value:
+                    return Convert.ToString(value.Value, CultureInfo.InvariantCulture);
+                default:
+                    // Lists and maps only compare by their text
+                    return token.ToString(Newtonsoft.Json.Formatting.None);
+            }
+        }
+
+        private static bool TryGetNumber(object value, out BigInteger integer, out decimal number, out bool isInteger)
+        {
+            integer = default;
+            number = default;
+            isInteger = false;
+
+            switch (value)
+            {
+                case BigInteger big:
+                    integer = big;
+                    isInteger = true;
+                    return true;
+                case decimal d:
+                    number = d;
+                    return true;
+                case string text when BigInteger.TryParse(text, NumberStyles.AllowLeadingSign, CultureInfo.InvariantCulture, out integer):
+                    isInteger = true;
+                    return true;
+                case string text:
+                    return decimal.TryParse(text, NumberStyles.AllowLeadingSign | NumberStyles.AllowDecimalPoint, CultureInfo.InvariantCulture, out number);
+                default:
+                    return false;
+            }
+        }
+
+        private static bool TryGetBoolean(object value, out bool result)
+        {
+            switch (value)
+            {
+                case bool b:
+                    result = b;
+                    return true;
+                case string text when bool.TryParse(text, out result):
+                    return true;
+                default:
+                    result = false;
+                    return false;
+            }
+        }
+
+        // Returns null when the operands cannot be ordered
+        private static int? Compare(object left, object right)
+        {
+            if (left == null || right == null)
+            {
+                return left == null && right == null ? 0 : null;
+            }
+
+            if (TryGetNumber(left, out var leftInteger, out var leftNumber, out var leftIsInteger) &&
+                TryGetNumber(right, out var rightInteger, out var rightNumber, out var rightIsInteger))
+            {
+                if (leftIsInteger && rightIsInteger)
+                {
+                    return leftInteger.CompareTo(rightInteger);
+                }
+
+                try
+                {
+                    var l = leftIsInteger ? (decimal)leftInteger : leftNumber;
+                    var r = rightIsInteger ? (decimal)rightInteger : rightNumber;
+                    return l.CompareTo(r);
+                }
+                catch (OverflowException)
+                {
+                    // An integer beyond the decimal range is larger in magnitude than any decimal operand
+                    return leftIsInteger ? leftInteger.Sign : -rightInteger.Sign;
+                }
+            }
+
+            if (left is bool || right is bool)
+            {
+                return TryGetBoolean(left, out var leftBool) && TryGetBoolean(right, out var rightBool)
+                    ? leftBool.CompareTo(rightBool)
+                    : null;
+            }
+
+            var leftText = Convert.ToString(left, CultureInfo.InvariantCulture);
+            var rightText = Convert.ToString(right, CultureInfo.InvariantCulture);
+            return IsHash(leftText) && IsHash(rightText)
+                ? string.Compare(leftText, rightText, StringComparison.OrdinalIgnoreCase)
+                : string.CompareOrdinal(leftText, rightText);
+        }
+
+        private static bool IsHash(string text)
+        {
+            return text.StartsWith("0x", StringComparison.OrdinalIgnoreCase);
+        }
+
+        private enum TokenKind
+        {
+            Number,
+            String,
+            Word,
+            Symbol
+        }
+
+        private sealed class Token
+        {
+            public Token(TokenKind kind, string text, int position)
+            {
+                Kind = kind;
+                Text = text;
+                Position = position;
+            }
+
+            public TokenKind Kind { get; }
+
+            public string Text { get; }
+
+            public int Position { get; }
+
+            public bool IsWord(string word)
+            {
+                return Kind == TokenKind.Word && string.Equals(Text, word, StringComparison.OrdinalIgnoreCase);
+            }
+
+            public bool IsSymbol(string symbol)
+            {
+                return Kind == TokenKind.Symbol && Text == symbol;
+            }
+        }
+
+        private sealed class Parser
+        {
+            private static readonly string[] ComparisonSymbols = { "==", "!=", ">", ">=", "<", "<=" };
+            private static readonly string[] ComparisonWords = { "contains", "startsWith", "endsWith" };
+
+            private readonly List<Token> _tokens;
+            private int _position;
+
+            public Parser(List<Token> tokens)
+            {
+                _tokens = tokens;
+            }
+
+            private Token Current => _position < _tokens.Count ? _tokens[_position] : null;
+
+            public Node ParseExpression(int depth)
+            {
+                if (depth > MaxDepth)
+                {
+                    throw new FormatException($"A condition nests at most {MaxDepth} levels deep");
+                }
+
+                var left = ParseAnd(depth);
+                while (Current != null && (Current.IsWord("OR") || Current.IsSymbol("||")))
+                {
+                    _position++;
+                    left = new LogicalNode(false, left, ParseAnd(depth));
+                }
+
+                return left;
+            }
+
+            public void ExpectEnd()
+            {
+                if (Current != null)
+                {
+                    throw new FormatException($"Unexpected '{Current.Text}' at position {Current.Position + 1}");
+                }
+            }
+
+            private Node ParseAnd(int depth)
+            {
+                var left = ParseNot(depth);
+                while (Current != null && (Current.IsWord("AND") || Current.IsSymbol("&&")))
+                {
+                    _position++;
+                    left = new LogicalNode(true, left, ParseNot(depth));
+                }
+
+                return left;
+            }
+
+            private Node ParseNot(int depth)
+            {
+                if (Current != null && (Current.IsWord("NOT") || Current.IsSymbol("!")))
+                {
+                    _position++;
+                    return new NotNode(ParseNot(depth + 1));
+                }
+
+                return ParseComparison(depth);
+            }
+
+            private Node ParseComparison(int depth)
+            {
+                var left = ParseOperand(depth);
+
+                var current = Current;
+                var op = current == null ? null
+                    : current.Kind == TokenKind.Symbol && ComparisonSymbols.Contains(current.Text) ? current.Text
+                    : ComparisonWords.FirstOrDefault(current.IsWord);
+                if (op == null)
+                {
+                    return left;
+                }
+
+                _position++;
+                return new ComparisonNode(op, left, ParseOperand(depth));
+            }
+
+            private Node ParseOperand(int depth)
+            {
+                var token = Current ?? throw new FormatException("The condition ends where a value was expected");
+                _position++;
+
+                switch (token.Kind)
+                {
+                    case TokenKind.Number:
+                        if (BigInteger.TryParse(token.Text, NumberStyles.AllowLeadingSign, CultureInfo.InvariantCulture, out var integer))
+                        {
+                            return new LiteralNode(integer);
+                        }
+
+                        if (decimal.TryParse(token.Text, NumberStyles.AllowLeadingSign | NumberStyles.AllowDecimalPoint, CultureInfo.InvariantCulture, out var number))
+                        {
+                            return new LiteralNode(number);
+                        }
+
+                        throw new FormatException($"Invalid number '{token.Text}' at position {token.Position + 1}");
+
+                    case TokenKind.String:
+                        return new LiteralNode(token.Text);
+
+                    case TokenKind.Word when token.IsWord("true"):
+                        return new LiteralNode(true);
+
+                    case TokenKind.Word when token.IsWord("false"):
+                        return new LiteralNode(false);
+
+                    case TokenKind.Word when token.IsWord("null"):
+                        return new LiteralNode(null);
+
+                    case TokenKind.Word when token.IsWord("AND") || token.IsWord("OR") || token.IsWord("NOT") || ComparisonWords.Any(token.IsWord):
+                        throw new FormatException($"Expected a value at position {token.Position + 1} but found '{token.Text}'");
+
+                    case TokenKind.Word:
+                        return new PathNode(ValidatePath(token));
+
+                    case TokenKind.Symbol when token.Text == "(":
+                        var inner = ParseExpression(depth + 1);
+                        if (Current == null || !Current.IsSymbol(")"))
+                        {
+                            throw new FormatException($"Missing ')' for the '(' at position {token.Position + 1}");
+                        }
+
+                        _position++;
+                        return inner;
+
+                    default:
+                        throw new FormatException($"Expected a value at position {token.Position + 1} but found '{token.Text}'");
+                }
+            }
+
+            private static string ValidatePath(Token token)
+            {
+                // Names separated by dots, each optionally followed by [n] indexes
+                var valid = token.Text.Split('.').All(segment =>
+                {
+                    var bracket = segment.IndexOf('[');
+                    var name = bracket < 0 ? segment : segment.Substring(0, bracket);
+                    if (name.Length == 0 || !(char.IsLetter(name[0]) || name[0] == '_') || !name.All(ch => char.IsLetterOrDigit(ch) || ch == '_'))
+                    {
+                        return false;
+                    }
+
+                    var rest = bracket < 0 ? string.Empty : segment.Substring(bracket);
+                    while (rest.Length > 0)
+                    {
+                        var close = rest.IndexOf(']');
+                        if (!rest.StartsWith("[", StringComparison.Ordinal) || close < 2 || !rest.Substring(1, close - 1).All(char.IsDigit))
+                        {
+                            return false;
+                        }
+
+                        rest = rest.Substring(close + 1);
+                    }
+
+                    return true;
+                });
+
+                if (!valid)
+                {
+                    throw new FormatException($"Invalid path '{token.Text}' at position {token.Position + 1}");
+                }
+
+                return token.Text;
+            }
+        }
+
+        private abstract class Node
+        {
+            public abstract object Evaluate(JObject context);
+        }
+
+        private sealed class LiteralNode : Node
+        {
+            private readonly object _value;
+
+            public LiteralNode(object value)
+            {
+                _value = value;
+            }
+
+            public override object Evaluate(JObject context)
+            {
+                return _value;
+            }
+        }
+
+        private sealed class PathNode : Node
+        {
+            private readonly string _path;
+
+            public PathNode(string path)
+            {
+                _path = path;
+            }
+
+            public override object Evaluate(JObject context)
+            {
+                return ToValue(context.SelectToken(_path));
+            }
+        }
+
+        private sealed class NotNode : Node
+        {
+            private readonly Node _operand;
+
+            public NotNode(Node operand)
+            {
+                _operand = operand;
+            }
+
+            public override object Evaluate(JObject context)
+            {
+                return !(TryGetBoolean(_operand.Evaluate(context), out var value) && value);
+            }
+        }
+
+        private sealed class LogicalNode : Node
+        {
+            private readonly bool _and;
+            private readonly Node _left;
+            private readonly Node _right;
+
+            public LogicalNode(bool and, Node left, Node right)
+            {
+                _and = and;
+                _left = left;
+                _right = right;
+            }
+
+            public override object Evaluate(JObject context)
+            {
+                var left = TryGetBoolean(_left.Evaluate(context), out var l) && l;
+                if (_and ? !left : left)
+                {
+                    return left;
+                }
+
+                return TryGetBoolean(_right.Evaluate(context), out var r) && r;
+            }
+        }
+
+        private sealed class ComparisonNode : Node
+        {
+            private readonly string _operator;
+            private readonly Node _left;
+            private readonly Node _right;
+
+            public ComparisonNode(string op, Node left, Node right)
+            {
+                _operator = op;
+                _left = left;
+                _right = right;
+            }
+
+            public override object Evaluate(JObject context)
+            {
+                var left = _left.Evaluate(context);
+                var right = _right.Evaluate(context);
+
+                switch (_operator)
+                {
+                    case "==":
+                        return Compare(left, right) == 0;
+                    case "!=":
+                        return Compare(left, right) != 0;
+                    case ">":
+                        return Compare(left, right) > 0;
+                    case ">=":
+                        return Compare(left, right) >= 0;
+                    case "<":
+                        return Compare(left, right) < 0;
+                    case "<=":
+                        return Compare(left, right) <= 0;
+                }
+
+                if (left == null || right == null)
+                {
+                    return false;
+                }
+
+                var text = Convert.ToString(left, CultureInfo.InvariantCulture);
+                var part = Convert.ToString(right, CultureInfo.InvariantCulture);
+                var comparison = IsHash(text) ? StringComparison.OrdinalIgnoreCase : StringComparison.Ordinal;
+                return _operator switch
+                {
+                    "contains" => text.Contains(part, comparison),
+                    "startsWith" => text.StartsWith(part, comparison),
+                    _ => text.EndsWith(part, comparison)
+                };
+            }
+        }
+    }
+}
@@ -0,0 +1,262 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Enums;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.EventMonitoring.Repositories;
+
+namespace NeoServiceLayer.Services.EventMonitoring
+{
+    /// <summary>
+    /// Implementation of the contract watch service
+    /// </summary>
+    /// <remarks>
+    /// Upgrades are read from the <c>Update</c> and <c>Destroy</c> notifications of the contract management contract,
+    /// whose only argument is the script hash of the contract that changed. Each watching account gets an in-app
+    /// alert, and watches that ask for it have the account's active event subscriptions on the contract paused.
+    /// Paused subscriptions are resumed by hand once they have been checked against the new version.
+    /// </remarks>
+    public class ContractWatchService : IContractWatchService
+    {
+        /// <summary>
+        /// Notification the contract management contract raises when a contract is updated
+        /// </summary>
+        public const string UpdateEventName = "Update";
+
+        /// <summary>
+        /// Notification the contract management contract raises when a contract is destroyed
+        /// </summary>
+        public const string DestroyEventName = "Destroy";
+
+        private readonly ILogger<ContractWatchService> _logger;
+        private readonly IWatchedContractRepository _repository;
+        private readonly IEventSubscriptionRepository _subscriptionRepository;
+        private readonly INotificationService _notificationService;
+        private readonly EventMonitoringConfiguration _configuration;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ContractWatchService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="repository">Watched contract repository</param>
+        /// <param name="subscriptionRepository">Event subscription repository, used to pause dependent subscriptions</param>
+        /// <param name="notificationService">Notification service that delivers the alerts</param>
+        /// <param name="configuration">Event monitoring configuration</param>
+        public ContractWatchService(
+            ILogger<ContractWatchService> logger,
+            IWatchedContractRepository repository,
+            IEventSubscriptionRepository subscriptionRepository,
+            INotificationService notificationService = null,
+            IOptions<EventMonitoringConfiguration> configuration = null)
+        {
+            _logger = logger;
+            _repository = repository;
+            _subscriptionRepository = subscriptionRepository;
+            _notificationService = notificationService;
+            _configuration = configuration?.Value ?? new EventMonitoringConfiguration();
+        }
+
+        /// <inheritdoc/>
+        public async Task<WatchedContract> WatchAsync(WatchedContract watch)
+        {
+            if (watch == null)
+            {
+                throw new ArgumentNullException(nameof(watch));
+            }
+
+            if (!watch.ContractHash.IsValidScriptHash())
+            {
+                throw new ValidationException("Invalid contract script hash format");
+            }
+
+            if (watch.Label?.Length > 100)
+            {
+                throw new ValidationException("Label must be at most 100 characters");
+            }
+
+            var contractHash = watch.ContractHash.ToLowerInvariant();
+            var existing = (await _repository.GetByAccountIdAsync(watch.AccountId)).ToList();
+            if (existing.Any(w => w.ContractHash == contractHash))
+            {
+                throw new ValidationException("The contract is already watched");
+            }
+
+            if (existing.Count >= _configuration.MaxWatchedContractsPerAccount)
+            {
+                throw new ValidationException($"An account can watch at most {_configuration.MaxWatchedContractsPerAccount} contracts");
+            }
+
+            var created = await _repository.CreateAsync(new WatchedContract
+            {
+                Id = Guid.NewGuid(),
+                AccountId = watch.AccountId,
+                ContractHash = contractHash,
+                Label = watch.Label,
+                PauseDependentSubscriptions = watch.PauseDependentSubscriptions
+            });
+
+            _logger.LogInformation("Account {AccountId} is watching contract {ContractHash}", created.AccountId, created.ContractHash);
+
+            return created;
+        }
+
+        /// <inheritdoc/>
+        public Task<WatchedContract> GetByIdAsync(Guid id)
+        {
+            return _repository.GetByIdAsync(id);
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<WatchedContract>> GetByAccountIdAsync(Guid accountId)
+        {
+            return _repository.GetByAccountIdAsync(accountId);
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> UnwatchAsync(Guid id, Guid accountId)
+        {
+            var watch = await _repository.GetByIdAsync(id)
+                ?? throw new ResourceNotFoundException("WatchedContract", id.ToString());
+
+            if (watch.AccountId != accountId)
+            {
+                throw new ForbiddenAccessException("WatchedContract", id.ToString(), accountId.ToString());
+            }
+
+            return await _repository.DeleteAsync(id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<int> ProcessEventsAsync(IEnumerable<BlockEvent> blockEvents)
+        {
+            var alerted = 0;
+
+            foreach (var blockEvent in blockEvents)
+            {
+                if (!string.Equals(blockEvent.ContractHash, Constants.NeoConfig.ContractManagementHash, StringComparison.OrdinalIgnoreCase) ||
+                    !(blockEvent.EventName == UpdateEventName || blockEvent.EventName == DestroyEventName) ||
+                    blockEvent.EventData == null ||
+                    !blockEvent.EventData.TryGetValue("arg0", out var value) ||
+                    !(value is string contractHash) || !contractHash.IsValidScriptHash())
+                {
+                    continue;
+                }
+
+                var watches = await _repository.GetByContractHashAsync(contractHash.ToLowerInvariant());
+                foreach (var watch in watches)
+                {
+                    // Blocks can be read again after a leadership hand-off
+                    if (watch.LastChangeTransactionHash != null && watch.LastChangeTransactionHash == blockEvent.TransactionHash)
+                    {
+                        continue;
+                    }
+
+                    await RecordChangeAsync(watch, blockEvent);
+                    alerted++;
+                }
+            }
+
+            return alerted;
+        }
+
+        private async Task RecordChangeAsync(WatchedContract watch, BlockEvent blockEvent)
+        {
+            var destroyed = blockEvent.EventName == DestroyEventName;
+
+            watch.LastChange = blockEvent.EventName;
+            watch.LastChangeBlockHeight = blockEvent.BlockHeight;
+            watch.LastChangeTransactionHash = blockEvent.TransactionHash;
+            watch.LastChangedAt = blockEvent.BlockTimestamp;
+            watch.Destroyed = destroyed;
+            if (!destroyed)
+            {
+                watch.UpdateCount++;
+            }
+
+            await _repository.UpdateAsync(watch);
+
+            _logger.LogWarning("Observed {Change} of watched contract {ContractHash} at block {BlockHeight}, alerting account {AccountId}",
+                blockEvent.EventName, watch.ContractHash, blockEvent.BlockHeight, watch.AccountId);
+
+            var paused = watch.PauseDependentSubscriptions ? await PauseSubscriptionsAsync(watch) : new List<Guid>();
+            await SendAlertAsync(watch, destroyed, paused);
+        }
+
+        private async Task<List<Guid>> PauseSubscriptionsAsync(WatchedContract watch)
+        {
+            var paused = new List<Guid>();
+
+            try
+            {
+                var subscriptions = await _subscriptionRepository.GetByAccountAsync(watch.AccountId);
+                foreach (var subscription in subscriptions.Where(s =>
+                    s.Status == EventSubscriptionStatus.Active &&
+                    string.Equals(s.ContractHash, watch.ContractHash, StringComparison.OrdinalIgnoreCase)))
+                {
+                    subscription.Status = EventSubscriptionStatus.Paused;
+                    subscription.UpdatedAt = DateTime.UtcNow;
+                    await _subscriptionRepository.UpdateAsync(subscription);
+                    paused.Add(subscription.Id);
+                }
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error pausing subscriptions on watched contract {ContractHash} for account {AccountId}",
+                    watch.ContractHash, watch.AccountId);
+            }
+
+            return paused;
+        }
+
+        private async Task SendAlertAsync(WatchedContract watch, bool destroyed, List<Guid> pausedSubscriptions)
+        {
+            if (_notificationService == null)
+            {
+                return;
+            }
+
+            var name = string.IsNullOrEmpty(watch.Label) ? watch.ContractHash : $"{watch.Label} ({watch.ContractHash})";
+            var content = destroyed
+                ? $"Contract {name} was destroyed at block {watch.LastChangeBlockHeight}."
+                : $"Contract {name} was updated at block {watch.LastChangeBlockHeight}. Its methods, events or permissions may have changed.";
+            if (pausedSubscriptions.Count > 0)
+            {
+                content += $" {pausedSubscriptions.Count} event subscription(s) on it were paused; resume them once they have been checked.";
+            }
+
+            try
+            {
+                await _notificationService.SendNotificationAsync(new Core.Models.Notification
+                {
+                    AccountId = watch.AccountId,
+                    Type = Core.Models.NotificationType.Security,
+                    Priority = Core.Models.NotificationPriority.High,
+                    Subject = destroyed ? "Watched contract destroyed" : "Watched contract updated",
+                    Content = content,
+                    Channels = new List<Core.Models.NotificationChannel> { Core.Models.NotificationChannel.InApp },
+                    Data = new Dictionary<string, object>
+                    {
+                        { "WatchId", watch.Id },
+                        { "ContractHash", watch.ContractHash },
+                        { "Change", watch.LastChange },
+                        { "BlockHeight", watch.LastChangeBlockHeight },
+                        { "TransactionHash", watch.LastChangeTransactionHash },
+                        { "PausedSubscriptions", pausedSubscriptions }
+                    }
+                });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error sending upgrade alert for watched contract {ContractHash} to account {AccountId}",
+                    watch.ContractHash, watch.AccountId);
+            }
+        }
+    }
+}
@@ -1,5 +1,7 @@
 using System;
+using System.Collections.Concurrent;
 using System.Collections.Generic;
+using System.Globalization;
 using System.Linq;
 using System.Net.Http;
 using System.Text;
@@ -13,7 +15,10 @@ using NeoServiceLayer.Core.Interfaces;
 using NeoServiceLayer.Core.Models;
 using NeoServiceLayer.Core.Utilities;
 using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Services.Diagnostics;
 using NeoServiceLayer.Services.EventMonitoring.Repositories;
+using NeoServiceLayer.Services.Function;
+using NeoServiceLayer.Services.GasBank;
 
 namespace NeoServiceLayer.Services.EventMonitoring
 {
@@ -22,11 +27,24 @@ namespace NeoServiceLayer.Services.EventMonitoring
     /// </summary>
     public class EventMonitoringService : IEventMonitoringService, IDisposable
     {
+        private const string CheckpointStateKey = "eventMonitoring.lastProcessedBlock";
+        private const string EventTemplateRoot = "event";
+        private const string ConditionsTemplateRoot = "conditions";
+        private const string SubscriptionTemplateRoot = "subscription";
+
         private readonly ILogger<EventMonitoringService> _logger;
         private readonly IEventSubscriptionRepository _subscriptionRepository;
         private readonly IEventLogRepository _eventLogRepository;
         private readonly IFunctionService _functionService;
         private readonly IEnclaveService _enclaveService;
+        private readonly IGasBankService _gasBankService;
+        private readonly ICrashReporter _crashReporter;
+        private readonly IMaintenanceService _maintenanceService;
+        private readonly INeoRpcClient _neoRpcClient;
+        private readonly GovernanceEventSource _governanceEventSource;
+        private readonly NotificationEventSource _notificationEventSource;
+        private readonly IContractWatchService _contractWatchService;
+        private readonly ILeadershipService _leadership;
         private readonly EventMonitoringConfiguration _configuration;
         private readonly HttpClient _httpClient;
 
@@ -37,6 +55,7 @@ namespace NeoServiceLayer.Services.EventMonitoring
         private DateTime? _monitoringStartTime;
         private readonly SemaphoreSlim _monitoringSemaphore = new SemaphoreSlim(1, 1);
         private readonly SemaphoreSlim _notificationSemaphore = new SemaphoreSlim(1, 1);
+        private readonly ConcurrentDictionary<string, ConditionExpression> _conditions = new ConcurrentDictionary<string, ConditionExpression>();
 
         /// <summary>
         /// Initializes a new instance of the <see cref="EventMonitoringService"/> class
@@ -47,19 +66,43 @@ namespace NeoServiceLayer.Services.EventMonitoring
         /// <param name="functionService">Function service</param>
         /// <param name="enclaveService">Enclave service</param>
         /// <param name="configuration">Configuration</param>
+        /// <param name="gasBankService">GasBank service used to fund payout actions</param>
+        /// <param name="crashReporter">Crash reporter for background work</param>
+        /// <param name="maintenanceService">Maintenance service; trigger firing is paused while maintenance is active</param>
+        /// <param name="neoRpcClient">Neo RPC client whose cached balances are dropped when transfers are observed</param>
+        /// <param name="governanceEventSource">Source of committee, candidate and policy change events</param>
+        /// <param name="leadership">Leadership lease; only the leader fires triggers, standbys follow its checkpoint</param>
+        /// <param name="notificationEventSource">Source of contract notifications read from application logs</param>
+        /// <param name="contractWatchService">Contract watch service alerted of contract updates and destructions</param>
         public EventMonitoringService(
             ILogger<EventMonitoringService> logger,
             IEventSubscriptionRepository subscriptionRepository,
             IEventLogRepository eventLogRepository,
             IFunctionService functionService,
             IEnclaveService enclaveService,
-            IOptions<EventMonitoringConfiguration> configuration)
+            IOptions<EventMonitoringConfiguration> configuration,
+            IGasBankService gasBankService = null,
+            ICrashReporter crashReporter = null,
+            IMaintenanceService maintenanceService = null,
+            INeoRpcClient neoRpcClient = null,
+            GovernanceEventSource governanceEventSource = null,
+            ILeadershipService leadership = null,
+            NotificationEventSource notificationEventSource = null,
+            IContractWatchService contractWatchService = null)
         {
             _logger = logger;
             _subscriptionRepository = subscriptionRepository;
             _eventLogRepository = eventLogRepository;
             _functionService = functionService;
             _enclaveService = enclaveService;
+            _gasBankService = gasBankService;
+            _crashReporter = crashReporter;
+            _maintenanceService = maintenanceService;
+            _neoRpcClient = neoRpcClient;
+            _governanceEventSource = governanceEventSource;
+            _leadership = leadership;
+            _notificationEventSource = notificationEventSource;
+            _contractWatchService = contractWatchService;
             _configuration = configuration.Value;
             _httpClient = new HttpClient();
         }
@@ -87,14 +130,14 @@ namespace NeoServiceLayer.Services.EventMonitoring
 
                     // Start monitoring timer
                     _monitoringTimer = new Timer(
-                        async _ => await MonitorBlocksAsync(),
+                        _crashReporter.Guard(_logger, "EventMonitoring.MonitorBlocks", MonitorBlocksAsync),
                         null,
                         TimeSpan.Zero,
                         TimeSpan.FromSeconds(_configuration.MonitoringIntervalSeconds));
 
                     // Start notification timer
                     _notificationTimer = new Timer(
-                        async _ => await ProcessNotificationsAsync(),
+                        _crashReporter.Guard(_logger, "EventMonitoring.ProcessNotifications", ProcessNotificationsAsync),
                         null,
                         TimeSpan.FromSeconds(5),
                         TimeSpan.FromSeconds(_configuration.NotificationIntervalSeconds));
@@ -163,9 +206,13 @@ namespace NeoServiceLayer.Services.EventMonitoring
         {
             try
             {
-                // In a real implementation, this would query the Neo node
-                // For now, we'll simulate it
-                return await Task.FromResult(_lastProcessedBlockHeight + 1);
+                if (_neoRpcClient == null)
+                {
+                    return _lastProcessedBlockHeight;
+                }
+
+                // The block count includes the genesis block at height 0
+                return await _neoRpcClient.GetBlockCountAsync() - 1;
             }
             catch (Exception ex)
             {
@@ -195,9 +242,14 @@ namespace NeoServiceLayer.Services.EventMonitoring
                 ValidationUtility.ValidateNotNullOrEmpty(subscription.ContractHash, "Contract hash");
                 ValidationUtility.ValidateNotNullOrEmpty(subscription.EventName, "Event name");
 
-                if (string.IsNullOrEmpty(subscription.CallbackUrl) && !subscription.FunctionId.HasValue)
+                if (string.IsNullOrEmpty(subscription.CallbackUrl) && !subscription.FunctionId.HasValue && subscription.PayoutAction == null)
                 {
-                    throw new ArgumentException("Either callback URL or function ID is required");
+                    throw new ArgumentException("Either callback URL, function ID or payout action is required");
+                }
+
+                if (subscription.PayoutAction != null)
+                {
+                    await ValidatePayoutActionAsync(subscription);
                 }
 
                 if (!string.IsNullOrEmpty(subscription.CallbackUrl) && !subscription.CallbackUrl.IsValidUrl())
@@ -210,6 +262,11 @@ namespace NeoServiceLayer.Services.EventMonitoring
                     ValidationUtility.ValidateGuid(subscription.FunctionId.Value, "Function ID");
                 }
 
+                ValidateFunctionParameters(subscription);
+                ValidateCondition(subscription);
+
+                NormalizeTags(subscription);
+
                 // Set default values
                 if (subscription.StartBlockHeight <= 0)
                 {
@@ -343,9 +400,14 @@ namespace NeoServiceLayer.Services.EventMonitoring
                 ValidationUtility.ValidateNotNullOrEmpty(subscription.ContractHash, "Contract hash");
                 ValidationUtility.ValidateNotNullOrEmpty(subscription.EventName, "Event name");
 
-                if (string.IsNullOrEmpty(subscription.CallbackUrl) && !subscription.FunctionId.HasValue)
+                if (string.IsNullOrEmpty(subscription.CallbackUrl) && !subscription.FunctionId.HasValue && subscription.PayoutAction == null)
+                {
+                    throw new ArgumentException("Either callback URL, function ID or payout action is required");
+                }
+
+                if (subscription.PayoutAction != null)
                 {
-                    throw new ArgumentException("Either callback URL or function ID is required");
+                    await ValidatePayoutActionAsync(subscription);
                 }
 
                 if (!string.IsNullOrEmpty(subscription.CallbackUrl) && !subscription.CallbackUrl.IsValidUrl())
@@ -358,6 +420,11 @@ namespace NeoServiceLayer.Services.EventMonitoring
                     ValidationUtility.ValidateGuid(subscription.FunctionId.Value, "Function ID");
                 }
 
+                ValidateFunctionParameters(subscription);
+                ValidateCondition(subscription);
+
+                NormalizeTags(subscription);
+
                 // Update subscription
                 var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<EventMonitoringService, EventSubscription>(
                     _logger,
@@ -677,9 +744,13 @@ namespace NeoServiceLayer.Services.EventMonitoring
                 var eventsLast24Hours = await _eventLogRepository.GetCountLast24HoursAsync();
                 var notificationsLast24Hours = await _eventLogRepository.GetSuccessfulNotificationsLast24HoursAsync();
 
+                var currentBlockHeight = await GetCurrentBlockHeightAsync();
+
                 return new EventMonitoringStatistics
                 {
-                    CurrentBlockHeight = await GetCurrentBlockHeightAsync(),
+                    CurrentBlockHeight = currentBlockHeight,
+                    ConfirmedBlockHeight = GetConfirmedBlockHeight(currentBlockHeight),
+                    TriggerEventConfirmations = _configuration.TriggerEventConfirmations,
                     LastProcessedBlockHeight = _lastProcessedBlockHeight,
                     ActiveSubscriptions = activeSubscriptions,
                     TotalEventsDetected = successfulNotifications + pendingNotifications + failedNotifications + retryingNotifications,
@@ -690,6 +761,7 @@ namespace NeoServiceLayer.Services.EventMonitoring
                     EventsDetectedLast24Hours = eventsLast24Hours,
                     NotificationsSentLast24Hours = notificationsLast24Hours,
                     IsMonitoring = _isMonitoring,
+                    IsStandby = _leadership != null && !_leadership.IsLeader,
                     MonitoringStartTime = _monitoringStartTime,
                     LastUpdateTime = DateTime.UtcNow
                 };
@@ -711,6 +783,19 @@ namespace NeoServiceLayer.Services.EventMonitoring
                 return;
             }
 
+            // Blocks are left unprocessed during maintenance and picked up once it ends
+            if (_maintenanceService != null && await _maintenanceService.IsActiveAsync())
+            {
+                return;
+            }
+
+            // A standby follows the leader's checkpoint so it resumes from there when it takes over
+            FollowCheckpoint();
+            if (_leadership != null && !_leadership.IsLeader)
+            {
+                return;
+            }
+
             // Prevent concurrent execution
             if (!await _monitoringSemaphore.WaitAsync(0))
             {
@@ -719,21 +804,35 @@ namespace NeoServiceLayer.Services.EventMonitoring
 
             try
             {
-                // Get current block height
-                var currentBlockHeight = await GetCurrentBlockHeightAsync();
-                if (currentBlockHeight <= _lastProcessedBlockHeight)
+                // Only blocks with enough confirmations fire triggers; the tip itself has one
+                var confirmedBlockHeight = GetConfirmedBlockHeight(await GetCurrentBlockHeightAsync());
+
+                // Without a configured start block or checkpoint, follow the chain from its tip rather than replaying it
+                if (_lastProcessedBlockHeight <= 0 && confirmedBlockHeight > 0)
+                {
+                    _lastProcessedBlockHeight = confirmedBlockHeight - 1;
+                }
+
+                if (confirmedBlockHeight <= _lastProcessedBlockHeight)
                 {
                     return;
                 }
 
-                _logger.LogInformation("Processing blocks from {LastProcessedBlockHeight} to {CurrentBlockHeight}",
-                    _lastProcessedBlockHeight + 1, currentBlockHeight);
+                _logger.LogInformation("Processing blocks from {LastProcessedBlockHeight} to {ConfirmedBlockHeight}",
+                    _lastProcessedBlockHeight + 1, confirmedBlockHeight);
 
                 // Process blocks
-                for (var blockHeight = _lastProcessedBlockHeight + 1; blockHeight <= currentBlockHeight; blockHeight++)
+                for (var blockHeight = _lastProcessedBlockHeight + 1; blockHeight <= confirmedBlockHeight; blockHeight++)
                 {
+                    // Stop firing as soon as the lease is lost; the new leader resumes from the checkpoint
+                    if (_leadership != null && !_leadership.IsLeader)
+                    {
+                        break;
+                    }
+
                     await ProcessBlockAsync(blockHeight);
                     _lastProcessedBlockHeight = blockHeight;
+                    _leadership?.SetState(CheckpointStateKey, blockHeight.ToString(CultureInfo.InvariantCulture));
                 }
             }
             catch (Exception ex)
@@ -746,6 +845,29 @@ namespace NeoServiceLayer.Services.EventMonitoring
             }
         }
 
+        /// <summary>
+        /// Gets the highest block that has <see cref="EventMonitoringConfiguration.TriggerEventConfirmations"/> confirmations
+        /// </summary>
+        /// <param name="currentBlockHeight">Height of the chain tip</param>
+        /// <returns>The block height</returns>
+        private long GetConfirmedBlockHeight(long currentBlockHeight)
+        {
+            return currentBlockHeight - Math.Max(0, _configuration.TriggerEventConfirmations - 1);
+        }
+
+        /// <summary>
+        /// Moves the last processed block forward to the checkpoint handed off through the leadership lease
+        /// </summary>
+        private void FollowCheckpoint()
+        {
+            var checkpoint = _leadership?.GetState(CheckpointStateKey);
+            if (long.TryParse(checkpoint, NumberStyles.Integer, CultureInfo.InvariantCulture, out var blockHeight)
+                && blockHeight > _lastProcessedBlockHeight)
+            {
+                _lastProcessedBlockHeight = blockHeight;
+            }
+        }
+
         /// <summary>
         /// Processes a block for events
         /// </summary>
@@ -756,21 +878,27 @@ namespace NeoServiceLayer.Services.EventMonitoring
             {
                 // Get active subscriptions for this block
                 var subscriptions = await _subscriptionRepository.GetActiveForBlockRangeAsync(blockHeight, blockHeight);
-                if (!subscriptions.Any())
+
+                // Blocks are still read without subscriptions so that observed transfers can invalidate cached balances
+                if (!subscriptions.Any() && _neoRpcClient == null)
                 {
                     return;
                 }
 
-                _logger.LogInformation("Processing block {BlockHeight} with {SubscriptionCount} active subscriptions",
-                    blockHeight, subscriptions.Count());
-
                 // Get block events from Neo node
                 var blockEvents = await GetBlockEventsAsync(blockHeight);
-                if (!blockEvents.Any())
+                InvalidateTransferredBalances(blockEvents);
+                await ProcessContractChangesAsync(blockEvents, blockHeight);
+                blockEvents.AddRange(await GetGovernanceEventsAsync(subscriptions, blockHeight));
+
+                if (!subscriptions.Any() || !blockEvents.Any())
                 {
                     return;
                 }
 
+                _logger.LogInformation("Processing block {BlockHeight} with {SubscriptionCount} active subscriptions",
+                    blockHeight, subscriptions.Count());
+
                 // Process events
                 foreach (var subscription in subscriptions)
                 {
@@ -784,51 +912,99 @@ namespace NeoServiceLayer.Services.EventMonitoring
         }
 
         /// <summary>
-        /// Gets events for a block
+        /// Drops the cached balances of every account that sent or received a token in the given events
         /// </summary>
-        /// <param name="blockHeight">Block height</param>
-        /// <returns>List of events in the block</returns>
-        private async Task<List<BlockEvent>> GetBlockEventsAsync(long blockHeight)
+        /// <param name="blockEvents">Events of a block</param>
+        private void InvalidateTransferredBalances(IEnumerable<BlockEvent> blockEvents)
         {
-            try
+            if (_neoRpcClient == null)
             {
-                // In a real implementation, this would query the Neo node
-                // For now, we'll simulate it with random events
-                await Task.Delay(100); // Simulate network delay
+                return;
+            }
 
-                var events = new List<BlockEvent>();
-                var random = new Random();
+            foreach (var blockEvent in blockEvents)
+            {
+                if (!string.Equals(blockEvent.EventName, TransferEventDecoder.TransferEventName, StringComparison.OrdinalIgnoreCase) ||
+                    blockEvent.EventData == null)
+                {
+                    continue;
+                }
 
-                // Simulate 0-3 events per block
-                var eventCount = random.Next(4);
-                for (var i = 0; i < eventCount; i++)
+                foreach (var party in new[] { "from", "to" })
                 {
-                    var contractIndex = random.Next(3);
-                    var eventIndex = random.Next(3);
+                    // Mints and burns have no sender or recipient
+                    if (blockEvent.EventData.TryGetValue(party, out var scriptHash) && scriptHash is string hash && hash.IsValidScriptHash())
+                    {
+                        _neoRpcClient.InvalidateBalance(hash, blockEvent.ContractHash);
+                    }
+                }
+            }
+        }
 
-                    var contractHash = $"0x{new string('0', 40 - (contractIndex + 1).ToString().Length)}{contractIndex + 1}";
-                    var eventName = $"Event{eventIndex + 1}";
+        /// <summary>
+        /// Alerts the accounts watching contracts updated or destroyed in the given events
+        /// </summary>
+        /// <param name="blockEvents">Events of a block</param>
+        /// <param name="blockHeight">Block height</param>
+        private async Task ProcessContractChangesAsync(IEnumerable<BlockEvent> blockEvents, long blockHeight)
+        {
+            if (_contractWatchService == null)
+            {
+                return;
+            }
 
-                    var eventData = new Dictionary<string, object>
-                    {
-                        { "param1", $"value{random.Next(100)}" },
-                        { "param2", random.Next(1000) },
-                        { "param3", random.NextDouble() * 100 }
-                    };
+            try
+            {
+                await _contractWatchService.ProcessEventsAsync(blockEvents);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error processing contract changes at block {BlockHeight}", blockHeight);
+            }
+        }
 
-                    events.Add(new BlockEvent
-                    {
-                        TransactionHash = $"0x{Guid.NewGuid().ToString("N")}",
-                        BlockHash = $"0x{Guid.NewGuid().ToString("N")}",
-                        BlockHeight = blockHeight,
-                        BlockTimestamp = DateTime.UtcNow,
-                        ContractHash = contractHash,
-                        EventName = eventName,
-                        EventData = eventData
-                    });
-                }
+        /// <summary>
+        /// Gets governance events when a subscription listens for them and the block is due for a poll
+        /// </summary>
+        /// <param name="subscriptions">Active subscriptions</param>
+        /// <param name="blockHeight">Block height</param>
+        /// <returns>Governance events, or an empty list</returns>
+        private async Task<List<BlockEvent>> GetGovernanceEventsAsync(IEnumerable<EventSubscription> subscriptions, long blockHeight)
+        {
+            if (_governanceEventSource == null || _configuration.GovernancePollIntervalBlocks <= 0 ||
+                blockHeight % _configuration.GovernancePollIntervalBlocks != 0 ||
+                !subscriptions.Any(GovernanceEventSource.IsGovernanceSubscription))
+            {
+                return new List<BlockEvent>();
+            }
 
-                return events;
+            try
+            {
+                return await _governanceEventSource.PollAsync(blockHeight);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error polling governance state at block {BlockHeight}", blockHeight);
+                return new List<BlockEvent>();
+            }
+        }
+
+        /// <summary>
+        /// Gets events for a block
+        /// </summary>
+        /// <param name="blockHeight">Block height</param>
+        /// <returns>List of events in the block</returns>
+        private async Task<List<BlockEvent>> GetBlockEventsAsync(long blockHeight)
+        {
+            if (_notificationEventSource == null)
+            {
+                return new List<BlockEvent>();
+            }
+
+            // A failed read is not retried; the block is skipped like any block processing error
+            try
+            {
+                return await _notificationEventSource.GetEventsAsync(blockHeight);
             }
             catch (Exception ex)
             {
@@ -850,8 +1026,9 @@ namespace NeoServiceLayer.Services.EventMonitoring
                 // Filter events for this subscription
                 var matchingEvents = blockEvents.Where(e =>
                     e.ContractHash.Equals(subscription.ContractHash, StringComparison.OrdinalIgnoreCase) &&
-                    e.EventName.Equals(subscription.EventName, StringComparison.OrdinalIgnoreCase) &&
-                    MatchesFilters(e.EventData, subscription.Filters));
+                    TransferEventDecoder.MatchesEventName(subscription.EventName, e) &&
+                    MatchesFilters(e.EventData, subscription.Filters) &&
+                    MatchesCondition(e.EventData, subscription.Condition));
 
                 foreach (var blockEvent in matchingEvents)
                 {
@@ -934,6 +1111,34 @@ namespace NeoServiceLayer.Services.EventMonitoring
             return true;
         }
 
+        /// <summary>
+        /// Checks if event data satisfies a subscription condition
+        /// </summary>
+        /// <param name="eventData">Event data</param>
+        /// <param name="condition">Condition expression, or null for none</param>
+        /// <returns>True if there is no condition or the event data satisfies it, false otherwise</returns>
+        private bool MatchesCondition(Dictionary<string, object> eventData, string condition)
+        {
+            if (string.IsNullOrWhiteSpace(condition))
+            {
+                return true;
+            }
+
+            // Conditions are validated when saved, so a parse failure here means the stored subscription predates validation
+            if (!_conditions.TryGetValue(condition, out var expression))
+            {
+                if (!ConditionExpression.TryParse(condition, out expression, out var error))
+                {
+                    _logger.LogWarning("Ignoring events for invalid condition {Condition}: {Error}", condition, error);
+                    return false;
+                }
+
+                _conditions.TryAdd(condition, expression);
+            }
+
+            return expression.Evaluate(eventData);
+        }
+
         /// <summary>
         /// Tries to compare numeric values
         /// </summary>
@@ -1034,6 +1239,17 @@ namespace NeoServiceLayer.Services.EventMonitoring
                 return;
             }
 
+            // Pending notifications stay queued until maintenance ends
+            if (_maintenanceService != null && await _maintenanceService.IsActiveAsync())
+            {
+                return;
+            }
+
+            if (_leadership != null && !_leadership.IsLeader)
+            {
+                return;
+            }
+
             // Prevent concurrent execution
             if (!await _notificationSemaphore.WaitAsync(0))
             {
@@ -1117,15 +1333,20 @@ namespace NeoServiceLayer.Services.EventMonitoring
                 else if (subscription.FunctionId.HasValue)
                 {
                     // Execute function
-                    (success, response) = await ExecuteFunctionAsync(subscription, payload);
+                    (success, response) = await ExecuteFunctionAsync(subscription, eventLog);
+                }
+                else if (subscription.PayoutAction != null)
+                {
+                    // Distribute GAS
+                    (success, response) = await ExecutePayoutAsync(subscription, eventLog);
                 }
                 else
                 {
-                    _logger.LogWarning("No callback URL or function ID specified for subscription {SubscriptionId}",
+                    _logger.LogWarning("No callback URL, function ID or payout action specified for subscription {SubscriptionId}",
                         subscription.Id);
 
                     eventLog.NotificationStatus = Core.Enums.NotificationStatus.Failed;
-                    eventLog.ErrorMessage = "No callback URL or function ID specified";
+                    eventLog.ErrorMessage = "No callback URL, function ID or payout action specified";
                     await _eventLogRepository.UpdateAsync(eventLog);
                     return false;
                 }
@@ -1256,44 +1477,206 @@ namespace NeoServiceLayer.Services.EventMonitoring
         }
 
         /// <summary>
-        /// Executes a function
+        /// Normalizes the tags of a subscription
         /// </summary>
         /// <param name="subscription">Subscription</param>
-        /// <param name="payload">Notification payload</param>
+        private static void NormalizeTags(EventSubscription subscription)
+        {
+            try
+            {
+                subscription.Tags = subscription.Tags.NormalizeTags();
+            }
+            catch (Core.Exceptions.ValidationException ex)
+            {
+                throw new ArgumentException(ex.Message, ex);
+            }
+        }
+
+        /// <summary>
+        /// Validates the condition expression of a subscription
+        /// </summary>
+        /// <param name="subscription">Subscription</param>
+        private static void ValidateCondition(EventSubscription subscription)
+        {
+            if (subscription.Condition == null)
+            {
+                return;
+            }
+
+            if (!ConditionExpression.TryParse(subscription.Condition, out _, out var error))
+            {
+                throw new ArgumentException($"Invalid condition: {error}");
+            }
+        }
+
+        /// <summary>
+        /// Validates the function parameter template of a subscription
+        /// </summary>
+        /// <param name="subscription">Subscription</param>
+        private static void ValidateFunctionParameters(EventSubscription subscription)
+        {
+            try
+            {
+                ParameterTemplateRenderer.Validate(subscription.FunctionParameters, EventTemplateRoot, ConditionsTemplateRoot, SubscriptionTemplateRoot);
+            }
+            catch (Core.Exceptions.ValidationException ex)
+            {
+                throw new ArgumentException(ex.Message, ex);
+            }
+        }
+
+        /// <summary>
+        /// Builds the values a subscription's function parameter template can reference
+        /// </summary>
+        /// <param name="subscription">Subscription</param>
+        /// <param name="eventLog">Event log of the matched notification</param>
+        /// <returns>The template context</returns>
+        private static Dictionary<string, object> CreateTemplateContext(EventSubscription subscription, EventLog eventLog)
+        {
+            var eventData = eventLog.EventData ?? new Dictionary<string, object>();
+
+            return new Dictionary<string, object>
+            {
+                [EventTemplateRoot] = new Dictionary<string, object>
+                {
+                    ["id"] = eventLog.Id,
+                    ["name"] = eventLog.EventName,
+                    ["contract"] = eventLog.ContractHash,
+                    ["txHash"] = eventLog.TransactionHash,
+                    ["blockHeight"] = eventLog.BlockHeight,
+                    ["blockTime"] = eventLog.BlockTimestamp,
+                    ["data"] = eventData,
+                    // The decoded values in notification order, so templates can address positional state
+                    ["state"] = eventData.Values.ToList()
+                },
+                // Every filter matched, so each carries the event value it was checked against
+                [ConditionsTemplateRoot] = (subscription.Filters ?? new List<EventFilter>())
+                    .Select(filter => new Dictionary<string, object>
+                    {
+                        ["parameter"] = filter.ParameterName,
+                        ["operator"] = filter.Operator.ToString(),
+                        ["value"] = filter.Value,
+                        ["actual"] = eventData.TryGetValue(filter.ParameterName ?? string.Empty, out var actual) ? actual : null
+                    })
+                    .ToList(),
+                [SubscriptionTemplateRoot] = new Dictionary<string, object>
+                {
+                    ["id"] = subscription.Id,
+                    ["name"] = subscription.Name,
+                    ["triggerCount"] = subscription.TriggerCount
+                }
+            };
+        }
+
+        /// <summary>
+        /// Validates the payout action of a subscription
+        /// </summary>
+        /// <param name="subscription">Subscription</param>
+        private async Task ValidatePayoutActionAsync(EventSubscription subscription)
+        {
+            ValidationUtility.ValidateGuid(subscription.PayoutAction.GasBankAccountId, "GasBank account ID");
+
+            try
+            {
+                GasPayoutSplitter.Validate(subscription.PayoutAction.TotalAmount, subscription.PayoutAction.Recipients);
+            }
+            catch (Core.Exceptions.GasBankException ex)
+            {
+                throw new ArgumentException(ex.Message, ex);
+            }
+
+            if (_gasBankService == null)
+            {
+                throw new ArgumentException("Payout actions are not supported");
+            }
+
+            var gasBankAccount = await _gasBankService.GetByIdAsync(subscription.PayoutAction.GasBankAccountId);
+            if (gasBankAccount == null || gasBankAccount.AccountId != subscription.AccountId)
+            {
+                throw new ArgumentException("GasBank account not found");
+            }
+        }
+
+        /// <summary>
+        /// Executes a GAS payout
+        /// </summary>
+        /// <param name="subscription">Subscription</param>
+        /// <param name="eventLog">Event log that triggered the payout</param>
+        /// <returns>Success status and the serialized per-recipient receipts</returns>
+        private async Task<(bool success, string response)> ExecutePayoutAsync(EventSubscription subscription, EventLog eventLog)
+        {
+            if (_gasBankService == null)
+            {
+                return (false, "GasBank service is not available");
+            }
+
+            try
+            {
+                _logger.LogInformation("Distributing {Amount} GAS to {RecipientCount} recipients for subscription {SubscriptionId}",
+                    subscription.PayoutAction.TotalAmount, subscription.PayoutAction.Recipients.Count, subscription.Id);
+
+                var receipts = await _gasBankService.DistributeAsync(
+                    subscription.PayoutAction.GasBankAccountId,
+                    subscription.PayoutAction.TotalAmount,
+                    subscription.PayoutAction.Recipients,
+                    eventLog.Id);
+
+                return (true, JsonSerializer.Serialize(receipts));
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error distributing GAS for subscription {SubscriptionId}",
+                    subscription.Id);
+                return (false, ex.InnerException?.Message ?? ex.Message);
+            }
+        }
+
+        /// <summary>
+        /// Executes a function with the event as its trigger
+        /// </summary>
+        /// <param name="subscription">Subscription</param>
+        /// <param name="eventLog">Event log of the matched notification</param>
         /// <returns>Success status and response</returns>
-        private async Task<(bool success, string response)> ExecuteFunctionAsync(EventSubscription subscription, string payload)
+        private async Task<(bool success, string response)> ExecuteFunctionAsync(EventSubscription subscription, EventLog eventLog)
         {
             try
             {
                 _logger.LogInformation("Executing function {FunctionId} for subscription {SubscriptionId}",
                     subscription.FunctionId, subscription.Id);
 
-                // In a real implementation, this would execute the function
-                // For now, we'll simulate it
-                await Task.Delay(100); // Simulate function execution
-
-                // Simulate success or failure
-                var random = new Random();
-                var success = random.Next(10) < 9; // 90% success rate
-
-                if (success)
+                object result;
+                if (subscription.FunctionParameters != null && subscription.FunctionParameters.Count > 0)
                 {
-                    _logger.LogInformation("Function execution successful for subscription {SubscriptionId}",
-                        subscription.Id);
-                    return (true, "Function executed successfully");
+                    // A template lets one generic function serve many subscriptions with parameters of its own
+                    var parameters = ParameterTemplateRenderer.Render(subscription.FunctionParameters, CreateTemplateContext(subscription, eventLog));
+                    result = await _functionService.ExecuteAsync(subscription.FunctionId.Value, parameters);
                 }
                 else
                 {
-                    _logger.LogWarning("Function execution failed for subscription {SubscriptionId}",
-                        subscription.Id);
-                    return (false, "Function execution failed");
+                    // The decoded notification is passed whatever IncludeEventData says; that flag shapes callback payloads
+                    result = await _functionService.ExecuteForEventAsync(subscription.FunctionId.Value, new Event
+                    {
+                        Id = eventLog.Id,
+                        Name = eventLog.EventName,
+                        Type = "blockchain",
+                        Source = subscription.Id.ToString(),
+                        Data = eventLog.EventData,
+                        Timestamp = eventLog.BlockTimestamp,
+                        TransactionHash = eventLog.TransactionHash,
+                        BlockIndex = eventLog.BlockHeight,
+                        ContractHash = eventLog.ContractHash
+                    });
                 }
+
+                _logger.LogInformation("Function execution successful for subscription {SubscriptionId}",
+                    subscription.Id);
+                return (true, JsonSerializer.Serialize(result));
             }
             catch (Exception ex)
             {
                 _logger.LogError(ex, "Error executing function for subscription {SubscriptionId}",
                     subscription.Id);
-                return (false, ex.Message);
+                return (false, ex.InnerException?.Message ?? ex.Message);
             }
         }
 
@@ -19,8 +19,12 @@ namespace NeoServiceLayer.Services.EventMonitoring
             // Register repositories
             services.AddSingleton<IEventSubscriptionRepository, EventSubscriptionRepository>();
             services.AddSingleton<IEventLogRepository, EventLogRepository>();
+            services.AddSingleton<IWatchedContractRepository, WatchedContractRepository>();
 
             // Register services
+            services.AddSingleton<GovernanceEventSource>();
+            services.AddSingleton<NotificationEventSource>();
+            services.AddSingleton<IContractWatchService, ContractWatchService>();
             services.AddSingleton<IEventMonitoringService, EventMonitoringService>();
 
             return services;
@@ -0,0 +1,238 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Linq;
+using System.Numerics;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+
+namespace NeoServiceLayer.Services.EventMonitoring
+{
+    /// <summary>
+    /// Produces governance events by comparing committee, candidate and policy state between polls
+    /// </summary>
+    /// <remarks>
+    /// Events are attributed to the NEO token contract (<see cref="CommitteeChangedEventName"/>,
+    /// <see cref="CandidateVotesChangedEventName"/>) and the policy contract (<see cref="PolicyChangedEventName"/>)
+    /// so subscriptions and filters work as they do for contract notifications. A vote threshold crossing is
+    /// expressed with two filters, for example <c>votes &gt;= 1000000</c> and <c>previousVotes &lt; 1000000</c>.
+    /// State is read at the latest block when polled, in one batched RPC round trip, and the first poll only
+    /// records a baseline.
+    /// </remarks>
+    public class GovernanceEventSource
+    {
+        /// <summary>
+        /// Event raised when members join or leave the committee
+        /// </summary>
+        public const string CommitteeChangedEventName = "CommitteeChanged";
+
+        /// <summary>
+        /// Event raised for each candidate whose vote total changed, or who registered or unregistered
+        /// </summary>
+        public const string CandidateVotesChangedEventName = "CandidateVotesChanged";
+
+        /// <summary>
+        /// Event raised for each policy contract parameter that changed
+        /// </summary>
+        public const string PolicyChangedEventName = "PolicyChanged";
+
+        private static readonly string[] PolicyParameters = { "getFeePerByte", "getExecFeeFactor", "getStoragePrice" };
+
+        private readonly ILogger<GovernanceEventSource> _logger;
+        private readonly INeoRpcClient _neoRpcClient;
+        private GovernanceSnapshot _lastSnapshot;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GovernanceEventSource"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="neoRpcClient">Neo RPC client</param>
+        public GovernanceEventSource(ILogger<GovernanceEventSource> logger, INeoRpcClient neoRpcClient)
+        {
+            _logger = logger;
+            _neoRpcClient = neoRpcClient;
+        }
+
+        /// <summary>
+        /// Checks if a subscription listens for governance events
+        /// </summary>
+        /// <param name="subscription">Subscription</param>
+        /// <returns>True if the subscription targets a governance event, false otherwise</returns>
+        public static bool IsGovernanceSubscription(EventSubscription subscription)
+        {
+            if (string.Equals(subscription.ContractHash, Constants.NeoConfig.NeoTokenHash, StringComparison.OrdinalIgnoreCase))
+            {
+                return string.Equals(subscription.EventName, CommitteeChangedEventName, StringComparison.OrdinalIgnoreCase) ||
+                    string.Equals(subscription.EventName, CandidateVotesChangedEventName, StringComparison.OrdinalIgnoreCase);
+            }
+
+            return string.Equals(subscription.ContractHash, Constants.NeoConfig.PolicyContractHash, StringComparison.OrdinalIgnoreCase) &&
+                string.Equals(subscription.EventName, PolicyChangedEventName, StringComparison.OrdinalIgnoreCase);
+        }
+
+        /// <summary>
+        /// Reads the current governance state and returns the changes since the previous poll
+        /// </summary>
+        /// <param name="blockHeight">Block height the events are attributed to</param>
+        /// <returns>Governance events, empty on the first poll</returns>
+        public async Task<List<BlockEvent>> PollAsync(long blockHeight)
+        {
+            var snapshot = await ReadSnapshotAsync();
+            var previous = _lastSnapshot;
+            _lastSnapshot = snapshot;
+
+            if (previous == null)
+            {
+                _logger.LogInformation("Recorded governance baseline at block {BlockHeight}: {CommitteeSize} committee members, {CandidateCount} candidates",
+                    blockHeight, snapshot.Committee.Count, snapshot.CandidateVotes.Count);
+                return new List<BlockEvent>();
+            }
+
+            var events = new List<BlockEvent>();
+
+            var added = snapshot.Committee.Except(previous.Committee).ToList();
+            var removed = previous.Committee.Except(snapshot.Committee).ToList();
+            if (added.Count > 0 || removed.Count > 0)
+            {
+                // Lists are joined so Contains filters can match a single member
+                events.Add(CreateEvent(blockHeight, Constants.NeoConfig.NeoTokenHash, CommitteeChangedEventName, new Dictionary<string, object>
+                {
+                    ["added"] = string.Join(",", added),
+                    ["removed"] = string.Join(",", removed),
+                    ["committee"] = string.Join(",", snapshot.Committee),
+                    ["addedCount"] = added.Count,
+                    ["removedCount"] = removed.Count
+                }));
+            }
+
+            foreach (var candidate in snapshot.CandidateVotes.Keys.Union(previous.CandidateVotes.Keys))
+            {
+                var registered = snapshot.CandidateVotes.TryGetValue(candidate, out var votes);
+                var wasRegistered = previous.CandidateVotes.TryGetValue(candidate, out var previousVotes);
+                if (registered == wasRegistered && votes == previousVotes)
+                {
+                    continue;
+                }
+
+                events.Add(CreateEvent(blockHeight, Constants.NeoConfig.NeoTokenHash, CandidateVotesChangedEventName, new Dictionary<string, object>
+                {
+                    ["candidate"] = candidate,
+                    ["votes"] = votes.ToString(CultureInfo.InvariantCulture),
+                    ["previousVotes"] = previousVotes.ToString(CultureInfo.InvariantCulture),
+                    ["registered"] = registered,
+                    ["wasRegistered"] = wasRegistered
+                }));
+            }
+
+            foreach (var parameter in snapshot.Policy)
+            {
+                if (previous.Policy.TryGetValue(parameter.Key, out var previousValue) && previousValue != parameter.Value)
+                {
+                    events.Add(CreateEvent(blockHeight, Constants.NeoConfig.PolicyContractHash, PolicyChangedEventName, new Dictionary<string, object>
+                    {
+                        ["parameter"] = parameter.Key,
+                        ["value"] = parameter.Value,
+                        ["previousValue"] = previousValue
+                    }));
+                }
+            }
+
+            if (events.Count > 0)
+            {
+                _logger.LogInformation("Observed {EventCount} governance changes at block {BlockHeight}", events.Count, blockHeight);
+            }
+
+            return events;
+        }
+
+        private async Task<GovernanceSnapshot> ReadSnapshotAsync()
+        {
+            var snapshot = new GovernanceSnapshot();
+
+            var invocations = new List<NeoInvocation>
+            {
+                new NeoInvocation { ScriptHash = Constants.NeoConfig.NeoTokenHash, Operation = "getCommittee" },
+                new NeoInvocation { ScriptHash = Constants.NeoConfig.NeoTokenHash, Operation = "getCandidates" }
+            };
+            invocations.AddRange(PolicyParameters.Select(p => new NeoInvocation { ScriptHash = Constants.NeoConfig.PolicyContractHash, Operation = p }));
+
+            var results = await _neoRpcClient.InvokeFunctionBatchAsync(invocations);
+
+            foreach (var member in GetResultItems(results[0], "getCommittee"))
+            {
+                snapshot.Committee.Add(ToPublicKey(member));
+            }
+
+            foreach (var candidate in GetResultItems(results[1], "getCandidates"))
+            {
+                // Each candidate is a Struct of public key and vote total
+                if (candidate.Items?.Count == 2 &&
+                    BigInteger.TryParse(candidate.Items[1].Value, NumberStyles.AllowLeadingSign, CultureInfo.InvariantCulture, out var votes))
+                {
+                    snapshot.CandidateVotes[ToPublicKey(candidate.Items[0])] = votes;
+                }
+            }
+
+            for (var i = 0; i < PolicyParameters.Length; i++)
+            {
+                var parameter = PolicyParameters[i];
+                var result = results[i + 2];
+                if (result.IsHalt && result.Stack.Count > 0)
+                {
+                    snapshot.Policy[ToParameterName(parameter)] = result.Stack[0].Value;
+                }
+            }
+
+            return snapshot;
+        }
+
+        private static List<NeoStackItem> GetResultItems(NeoInvokeResult result, string operation)
+        {
+            if (!result.IsHalt || result.Stack.Count == 0)
+            {
+                throw new InvalidOperationException($"{operation} on the NEO contract faulted: {result.Exception}");
+            }
+
+            return result.Stack[0].Items ?? new List<NeoStackItem>();
+        }
+
+        private static string ToPublicKey(NeoStackItem item)
+        {
+            return item.Type == "ByteString" && item.Value != null
+                ? Convert.ToHexString(Convert.FromBase64String(item.Value)).ToLowerInvariant()
+                : item.Value;
+        }
+
+        // getFeePerByte becomes feePerByte
+        private static string ToParameterName(string operation)
+        {
+            var name = operation.Substring("get".Length);
+            return char.ToLowerInvariant(name[0]) + name.Substring(1);
+        }
+
+        private static BlockEvent CreateEvent(long blockHeight, string contractHash, string eventName, Dictionary<string, object> eventData)
+        {
+            return new BlockEvent
+            {
+                BlockHeight = blockHeight,
+                BlockTimestamp = DateTime.UtcNow,
+                ContractHash = contractHash,
+                EventName = eventName,
+                EventData = eventData
+            };
+        }
+
+        private class GovernanceSnapshot
+        {
+            public List<string> Committee { get; } = new List<string>();
+
+            public Dictionary<string, BigInteger> CandidateVotes { get; } = new Dictionary<string, BigInteger>();
+
+            public Dictionary<string, string> Policy { get; } = new Dictionary<string, string>();
+        }
+    }
+}
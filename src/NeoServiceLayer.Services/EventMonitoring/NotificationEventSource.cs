@@ -0,0 +1,114 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.EventMonitoring
+{
+    /// <summary>
+    /// Produces block events from the contract notifications recorded in a block's application logs
+    /// </summary>
+    /// <remarks>
+    /// The block's transactions are read with <c>getblock</c> and their application logs fetched in one batched
+    /// round trip, so the node must run the ApplicationLogs plugin. Only notifications of executions that halted
+    /// are returned; a faulted transaction's notifications were rolled back. <c>Transfer</c> notifications are
+    /// decoded by <see cref="TransferEventDecoder"/>; other notifications expose their arguments as
+    /// <c>arg0</c>, <c>arg1</c>, and so on, so subscription filters can target them by position.
+    /// </remarks>
+    public class NotificationEventSource
+    {
+        private readonly ILogger<NotificationEventSource> _logger;
+        private readonly INeoRpcClient _neoRpcClient;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="NotificationEventSource"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="neoRpcClient">Neo RPC client</param>
+        public NotificationEventSource(ILogger<NotificationEventSource> logger, INeoRpcClient neoRpcClient)
+        {
+            _logger = logger;
+            _neoRpcClient = neoRpcClient;
+        }
+
+        /// <summary>
+        /// Reads the notifications raised in a block
+        /// </summary>
+        /// <param name="blockHeight">Block height</param>
+        /// <returns>One event per notification, in block order</returns>
+        public async Task<List<BlockEvent>> GetEventsAsync(long blockHeight)
+        {
+            var block = await _neoRpcClient.GetBlockAsync(blockHeight);
+            var events = new List<BlockEvent>();
+            if (block.TransactionHashes.Count == 0)
+            {
+                return events;
+            }
+
+            var logs = await _neoRpcClient.GetApplicationLogsAsync(block.TransactionHashes);
+            for (var i = 0; i < logs.Count; i++)
+            {
+                if (logs[i] == null)
+                {
+                    _logger.LogWarning("No application log for transaction {TransactionHash} in block {BlockHeight}",
+                        block.TransactionHashes[i], blockHeight);
+                    continue;
+                }
+
+                foreach (var notification in logs[i].Executions.Where(e => e.IsHalt).SelectMany(e => e.Notifications))
+                {
+                    events.Add(new BlockEvent
+                    {
+                        TransactionHash = logs[i].TxId ?? block.TransactionHashes[i],
+                        BlockHash = block.Hash,
+                        BlockHeight = block.Index,
+                        BlockTimestamp = block.Timestamp,
+                        ContractHash = notification.Contract,
+                        EventName = notification.EventName,
+                        EventData = Decode(notification)
+                    });
+                }
+            }
+
+            return events;
+        }
+
+        /// <summary>
+        /// Decodes the state of a notification into named event data
+        /// </summary>
+        /// <param name="notification">Notification</param>
+        /// <returns>The event data</returns>
+        public static Dictionary<string, object> Decode(NeoContractNotification notification)
+        {
+            var items = notification.State?.Items ?? new List<NeoStackItem>();
+
+            if (string.Equals(notification.EventName, TransferEventDecoder.TransferEventName, StringComparison.OrdinalIgnoreCase))
+            {
+                var transfer = TransferEventDecoder.Decode(items.Select(ToTransferValue).ToList());
+                if (transfer != null)
+                {
+                    return transfer;
+                }
+            }
+
+            var eventData = new Dictionary<string, object>();
+            for (var i = 0; i < items.Count; i++)
+            {
+                eventData[$"arg{i}"] = StackItemDecoder.Decode(items[i]);
+            }
+
+            return eventData;
+        }
+
+        // The transfer decoder takes raw bytes for hashes and token IDs
+        private static object ToTransferValue(NeoStackItem item)
+        {
+            return item.Type == "ByteString" && item.Value != null ? Convert.FromBase64String(item.Value) : item.Value;
+        }
+    }
+}
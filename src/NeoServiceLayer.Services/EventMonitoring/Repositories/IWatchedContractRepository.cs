@@ -0,0 +1,55 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.EventMonitoring.Repositories
+{
+    /// <summary>
+    /// Interface for the watched contract repository
+    /// </summary>
+    public interface IWatchedContractRepository
+    {
+        /// <summary>
+        /// Creates a new watch
+        /// </summary>
+        /// <param name="watch">The watch to create</param>
+        /// <returns>The created watch</returns>
+        Task<WatchedContract> CreateAsync(WatchedContract watch);
+
+        /// <summary>
+        /// Gets a watch by ID
+        /// </summary>
+        /// <param name="id">The watch ID</param>
+        /// <returns>The watch</returns>
+        Task<WatchedContract> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets all watches of an account
+        /// </summary>
+        /// <param name="accountId">The account ID</param>
+        /// <returns>The watches</returns>
+        Task<IEnumerable<WatchedContract>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Gets all watches of a contract
+        /// </summary>
+        /// <param name="contractHash">The lowercase contract script hash</param>
+        /// <returns>The watches</returns>
+        Task<IEnumerable<WatchedContract>> GetByContractHashAsync(string contractHash);
+
+        /// <summary>
+        /// Updates a watch
+        /// </summary>
+        /// <param name="watch">The watch to update</param>
+        /// <returns>The updated watch</returns>
+        Task<WatchedContract> UpdateAsync(WatchedContract watch);
+
+        /// <summary>
+        /// Deletes a watch
+        /// </summary>
+        /// <param name="id">The watch ID</param>
+        /// <returns>True if the watch was deleted</returns>
+        Task<bool> DeleteAsync(Guid id);
+    }
+}
@@ -0,0 +1,95 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.EventMonitoring.Repositories
+{
+    /// <summary>
+    /// Implementation of the watched contract repository
+    /// </summary>
+    public class WatchedContractRepository : IWatchedContractRepository
+    {
+        private readonly ILogger<WatchedContractRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private const string CollectionName = "watched_contracts";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="WatchedContractRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public WatchedContractRepository(ILogger<WatchedContractRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<WatchedContract> CreateAsync(WatchedContract watch)
+        {
+            ValidationUtility.ValidateNotNull(watch, nameof(watch));
+            ValidationUtility.ValidateGuid(watch.Id, "Watch ID");
+
+            watch.CreatedAt = DateTime.UtcNow;
+            watch.UpdatedAt = DateTime.UtcNow;
+
+            _logger.LogDebug("Creating watch {Id} on contract {ContractHash}", watch.Id, watch.ContractHash);
+            await _storageProvider.CreateAsync(CollectionName, watch);
+
+            return watch;
+        }
+
+        /// <inheritdoc/>
+        public Task<WatchedContract> GetByIdAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "Watch ID");
+
+            return _storageProvider.GetByIdAsync<WatchedContract, Guid>(CollectionName, id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<WatchedContract>> GetByAccountIdAsync(Guid accountId)
+        {
+            ValidationUtility.ValidateGuid(accountId, "Account ID");
+
+            var watches = await _storageProvider.GetByFilterAsync<WatchedContract>(
+                CollectionName,
+                watch => watch.AccountId == accountId);
+
+            return watches.OrderByDescending(w => w.CreatedAt).ToList();
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<WatchedContract>> GetByContractHashAsync(string contractHash)
+        {
+            return _storageProvider.GetByFilterAsync<WatchedContract>(
+                CollectionName,
+                watch => watch.ContractHash == contractHash);
+        }
+
+        /// <inheritdoc/>
+        public async Task<WatchedContract> UpdateAsync(WatchedContract watch)
+        {
+            ValidationUtility.ValidateNotNull(watch, nameof(watch));
+            ValidationUtility.ValidateGuid(watch.Id, "Watch ID");
+
+            watch.UpdatedAt = DateTime.UtcNow;
+            await _storageProvider.UpdateAsync<WatchedContract, Guid>(CollectionName, watch.Id, watch);
+
+            return watch;
+        }
+
+        /// <inheritdoc/>
+        public Task<bool> DeleteAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "Watch ID");
+
+            return _storageProvider.DeleteAsync<WatchedContract, Guid>(CollectionName, id);
+        }
+    }
+}
@@ -0,0 +1,89 @@
+using System;
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.EventMonitoring
+{
+    /// <summary>
+    /// Decodes NEP-17 and NEP-11 <c>Transfer</c> notifications into named event data
+    /// </summary>
+    /// <remarks>
+    /// Both standards emit an event named <c>Transfer</c>; NEP-17 carries (from, to, amount) and NEP-11
+    /// adds a fourth tokenId item. Decoded events expose <c>from</c>, <c>to</c>, <c>amount</c> and, for
+    /// NEP-11, <c>tokenId</c>, so subscription filters can target them by name. Subscriptions using
+    /// <see cref="Nep11TransferEventName"/> match only NEP-11 transfers.
+    /// </remarks>
+    public static class TransferEventDecoder
+    {
+        /// <summary>
+        /// On-chain name of the transfer event
+        /// </summary>
+        public const string TransferEventName = "Transfer";
+
+        /// <summary>
+        /// Subscription event name that matches only NEP-11 transfers
+        /// </summary>
+        public const string Nep11TransferEventName = "Nep11Transfer";
+
+        /// <summary>
+        /// Decodes the state of a transfer notification
+        /// </summary>
+        /// <param name="state">Notification state items; byte arrays are decoded as script hashes and token IDs</param>
+        /// <returns>The named event data, or null if the state does not have a transfer shape</returns>
+        public static Dictionary<string, object> Decode(IReadOnlyList<object> state)
+        {
+            if (state == null || (state.Count != 3 && state.Count != 4))
+            {
+                return null;
+            }
+
+            var eventData = new Dictionary<string, object>
+            {
+                // A null sender is a mint and a null recipient is a burn
+                ["from"] = ToScriptHash(state[0]),
+                ["to"] = ToScriptHash(state[1]),
+                ["amount"] = state[2]?.ToString(),
+                ["standard"] = state.Count == 4 ? "NEP-11" : "NEP-17"
+            };
+
+            if (state.Count == 4)
+            {
+                eventData["tokenId"] = state[3] is byte[] tokenId
+                    ? Convert.ToHexString(tokenId).ToLowerInvariant()
+                    : state[3]?.ToString();
+            }
+
+            return eventData;
+        }
+
+        /// <summary>
+        /// Checks if a block event matches the event name of a subscription
+        /// </summary>
+        /// <param name="subscriptionEventName">Subscription event name</param>
+        /// <param name="blockEvent">Block event</param>
+        /// <returns>True if the event matches, false otherwise</returns>
+        public static bool MatchesEventName(string subscriptionEventName, BlockEvent blockEvent)
+        {
+            if (string.Equals(subscriptionEventName, Nep11TransferEventName, StringComparison.OrdinalIgnoreCase))
+            {
+                return string.Equals(blockEvent.EventName, TransferEventName, StringComparison.OrdinalIgnoreCase) &&
+                    blockEvent.EventData != null && blockEvent.EventData.ContainsKey("tokenId");
+            }
+
+            return string.Equals(blockEvent.EventName, subscriptionEventName, StringComparison.OrdinalIgnoreCase);
+        }
+
+        private static string ToScriptHash(object value)
+        {
+            if (value is byte[] bytes && bytes.Length == 20)
+            {
+                // Hash160 values are little-endian in notifications
+                var reversed = (byte[])bytes.Clone();
+                Array.Reverse(reversed);
+                return "0x" + Convert.ToHexString(reversed).ToLowerInvariant();
+            }
+
+            return value?.ToString();
+        }
+    }
+}
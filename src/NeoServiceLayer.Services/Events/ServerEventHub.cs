@@ -0,0 +1,161 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Channels;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Events
+{
+    /// <summary>
+    /// In-process fan-out of server events to subscribers
+    /// </summary>
+    /// <remarks>
+    /// Each subscription has a bounded buffer. Publishing writes to every matching buffer without waiting;
+    /// when a subscriber falls behind, its oldest events are dropped and counted so the consumer can tell.
+    /// </remarks>
+    public class ServerEventHub : IServerEventHub
+    {
+        private readonly ILogger<ServerEventHub> _logger;
+        private readonly ServerEventConfiguration _configuration;
+        private readonly ConcurrentDictionary<Guid, Subscription> _subscriptions = new ConcurrentDictionary<Guid, Subscription>();
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ServerEventHub"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="configuration">Server event configuration</param>
+        public ServerEventHub(ILogger<ServerEventHub> logger, IOptions<ServerEventConfiguration> configuration = null)
+        {
+            _logger = logger;
+            _configuration = configuration?.Value ?? new ServerEventConfiguration();
+        }
+
+        /// <inheritdoc/>
+        public void Publish(ServerEvent serverEvent)
+        {
+            if (serverEvent == null || string.IsNullOrEmpty(serverEvent.Topic))
+            {
+                return;
+            }
+
+            foreach (var subscription in _subscriptions.Values)
+            {
+                if (subscription.Accepts(serverEvent))
+                {
+                    subscription.Write(serverEvent);
+                }
+            }
+        }
+
+        /// <inheritdoc/>
+        public IServerEventSubscription Subscribe(Guid accountId, bool receiveAllAccounts)
+        {
+            var subscription = new Subscription(this, accountId, receiveAllAccounts, Math.Max(1, _configuration.BufferSize));
+            _subscriptions[subscription.Id] = subscription;
+
+            _logger.LogInformation("Server event subscription {SubscriptionId} opened for account {AccountId}", subscription.Id, accountId);
+
+            return subscription;
+        }
+
+        /// <inheritdoc/>
+        public int GetSubscriptionCount(Guid accountId)
+        {
+            return _subscriptions.Values.Count(s => s.AccountId == accountId);
+        }
+
+        private void Remove(Subscription subscription)
+        {
+            if (_subscriptions.TryRemove(subscription.Id, out _))
+            {
+                _logger.LogInformation("Server event subscription {SubscriptionId} closed for account {AccountId}", subscription.Id, subscription.AccountId);
+            }
+        }
+
+        private class Subscription : IServerEventSubscription
+        {
+            private readonly ServerEventHub _hub;
+            private readonly bool _receiveAllAccounts;
+            private readonly Channel<ServerEvent> _channel;
+            private HashSet<string> _topics = new HashSet<string>(StringComparer.OrdinalIgnoreCase);
+            private long _dropped;
+
+            public Subscription(ServerEventHub hub, Guid accountId, bool receiveAllAccounts, int bufferSize)
+            {
+                _hub = hub;
+                AccountId = accountId;
+                _receiveAllAccounts = receiveAllAccounts;
+                _channel = Channel.CreateBounded<ServerEvent>(
+                    new BoundedChannelOptions(bufferSize)
+                    {
+                        FullMode = BoundedChannelFullMode.DropOldest,
+                        SingleReader = true,
+                        SingleWriter = false
+                    },
+                    _ => Interlocked.Increment(ref _dropped));
+            }
+
+            public Guid Id { get; } = Guid.NewGuid();
+
+            public Guid AccountId { get; }
+
+            public ChannelReader<ServerEvent> Events => _channel.Reader;
+
+            public IReadOnlyCollection<string> Topics => _topics;
+
+            public bool Accepts(ServerEvent serverEvent)
+            {
+                if (!_topics.Contains(serverEvent.Topic))
+                {
+                    return false;
+                }
+
+                return serverEvent.AccountId == null || serverEvent.AccountId == AccountId || _receiveAllAccounts;
+            }
+
+            public void Write(ServerEvent serverEvent)
+            {
+                // Never waits: a full buffer drops its oldest event instead
+                _channel.Writer.TryWrite(serverEvent);
+            }
+
+            public void Subscribe(IEnumerable<string> topics)
+            {
+                ChangeTopics(set => set.UnionWith(topics));
+            }
+
+            public void Unsubscribe(IEnumerable<string> topics)
+            {
+                ChangeTopics(set => set.ExceptWith(topics));
+            }
+
+            public long TakeDroppedCount()
+            {
+                return Interlocked.Exchange(ref _dropped, 0);
+            }
+
+            public void Dispose()
+            {
+                _hub.Remove(this);
+                _channel.Writer.TryComplete();
+            }
+
+            private void ChangeTopics(Action<HashSet<string>> change)
+            {
+                // Publishers read the set without locking, so it is replaced rather than modified
+                lock (_channel)
+                {
+                    var topics = new HashSet<string>(_topics, StringComparer.OrdinalIgnoreCase);
+                    change(topics);
+                    topics.IntersectWith(ServerEventTopics.All);
+                    _topics = topics;
+                }
+            }
+        }
+    }
+}
@@ -0,0 +1,26 @@
+using Microsoft.Extensions.DependencyInjection;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Services.Events
+{
+    /// <summary>
+    /// Extension methods for registering server event services
+    /// </summary>
+    public static class ServerEventServiceExtensions
+    {
+        /// <summary>
+        /// Adds the server event hub to the service collection
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddServerEventServices(this IServiceCollection services)
+        {
+            // Publishers and subscribers must share one hub
+            services.AddSingleton<ServerEventHub>();
+            services.AddSingleton<IServerEventHub>(provider => provider.GetRequiredService<ServerEventHub>());
+            services.AddSingleton<IServerEventPublisher>(provider => provider.GetRequiredService<ServerEventHub>());
+
+            return services;
+        }
+    }
+}
@@ -0,0 +1,174 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Diagnostics;
+using NeoServiceLayer.Services.FeatureFlags.Repositories;
+
+namespace NeoServiceLayer.Services.FeatureFlags
+{
+    /// <summary>
+    /// Implementation of the feature flag service
+    /// </summary>
+    /// <remarks>
+    /// Flags are persisted and reloaded periodically so that all instances pick up a change. Until the first
+    /// load completes every flag reads as not set.
+    /// </remarks>
+    public class FeatureFlagService : IFeatureFlagService, IDisposable
+    {
+        private readonly ILogger<FeatureFlagService> _logger;
+        private readonly IFeatureFlagRepository _flagRepository;
+        private readonly ICrashReporter _crashReporter;
+        private readonly FeatureFlagConfiguration _configuration;
+        private readonly SemaphoreSlim _refreshSemaphore = new SemaphoreSlim(1, 1);
+        private readonly Timer _refreshTimer;
+
+        private Dictionary<string, FeatureFlag> _flags = new Dictionary<string, FeatureFlag>(StringComparer.OrdinalIgnoreCase);
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="FeatureFlagService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="flagRepository">Feature flag repository</param>
+        /// <param name="configuration">Configuration</param>
+        /// <param name="crashReporter">Crash reporter for background work</param>
+        public FeatureFlagService(
+            ILogger<FeatureFlagService> logger,
+            IFeatureFlagRepository flagRepository,
+            IOptions<FeatureFlagConfiguration> configuration = null,
+            ICrashReporter crashReporter = null)
+        {
+            _logger = logger;
+            _flagRepository = flagRepository;
+            _configuration = configuration?.Value ?? new FeatureFlagConfiguration();
+            _crashReporter = crashReporter;
+
+            _refreshTimer = new Timer(
+                _crashReporter.Guard(_logger, "FeatureFlags.Refresh", RefreshAsync),
+                null,
+                TimeSpan.Zero,
+                TimeSpan.FromSeconds(Math.Max(1, _configuration.RefreshIntervalSeconds)));
+        }
+
+        /// <inheritdoc/>
+        public bool IsEnabled(string name, bool defaultValue = false)
+        {
+            return name != null && _flags.TryGetValue(name, out var flag) ? flag.Enabled : defaultValue;
+        }
+
+        /// <inheritdoc/>
+        public string GetValue(string name)
+        {
+            return name != null && _flags.TryGetValue(name, out var flag) && flag.Enabled ? flag.Value : null;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<FeatureFlag>> GetAllAsync()
+        {
+            var flags = await ReloadAsync();
+            return flags.Values.OrderBy(f => f.Name, StringComparer.OrdinalIgnoreCase).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<FeatureFlag> SetAsync(string name, bool enabled, string value, string updatedBy)
+        {
+            if (string.IsNullOrWhiteSpace(name))
+            {
+                throw new ArgumentException("Flag name is required", nameof(name));
+            }
+
+            name = name.Trim();
+            var flags = await ReloadAsync();
+
+            FeatureFlag flag;
+            if (flags.TryGetValue(name, out var existing))
+            {
+                existing.Enabled = enabled;
+                existing.Value = value;
+                existing.UpdatedAt = DateTime.UtcNow;
+                existing.UpdatedBy = updatedBy;
+                flag = await _flagRepository.UpdateAsync(existing);
+            }
+            else
+            {
+                flag = await _flagRepository.CreateAsync(new FeatureFlag
+                {
+                    Name = name,
+                    Enabled = enabled,
+                    Value = value,
+                    UpdatedAt = DateTime.UtcNow,
+                    UpdatedBy = updatedBy
+                });
+            }
+
+            _logger.LogWarning("Feature flag {Name} set to {Enabled} with value {Value} by {User}", name, enabled, value, updatedBy);
+
+            await ReloadAsync();
+            return flag;
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAsync(string name)
+        {
+            var flags = await ReloadAsync();
+            if (name == null || !flags.TryGetValue(name, out var flag))
+            {
+                return false;
+            }
+
+            var deleted = await _flagRepository.DeleteAsync(flag.Id);
+
+            _logger.LogWarning("Feature flag {Name} removed", flag.Name);
+
+            await ReloadAsync();
+            return deleted;
+        }
+
+        /// <summary>
+        /// Reloads the flags so changes made on other instances apply here
+        /// </summary>
+        private async Task RefreshAsync()
+        {
+            // Prevent concurrent execution
+            if (!await _refreshSemaphore.WaitAsync(0))
+            {
+                return;
+            }
+
+            try
+            {
+                await ReloadAsync();
+            }
+            finally
+            {
+                _refreshSemaphore.Release();
+            }
+        }
+
+        private async Task<Dictionary<string, FeatureFlag>> ReloadAsync()
+        {
+            var flags = new Dictionary<string, FeatureFlag>(StringComparer.OrdinalIgnoreCase);
+            foreach (var flag in await _flagRepository.GetAllAsync())
+            {
+                flags[flag.Name] = flag;
+            }
+
+            _flags = flags;
+            return flags;
+        }
+
+        /// <summary>
+        /// Disposes the service
+        /// </summary>
+        public void Dispose()
+        {
+            _refreshTimer?.Dispose();
+            _refreshSemaphore?.Dispose();
+        }
+    }
+}
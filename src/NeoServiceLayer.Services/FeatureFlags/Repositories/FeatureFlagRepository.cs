@@ -0,0 +1,110 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.FeatureFlags.Repositories
+{
+    /// <summary>
+    /// Implementation of the feature flag repository
+    /// </summary>
+    public class FeatureFlagRepository : IFeatureFlagRepository
+    {
+        private readonly ILogger<FeatureFlagRepository> _logger;
+        private readonly IDatabaseService _databaseService;
+        private const string CollectionName = "feature_flags";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="FeatureFlagRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="databaseService">Database service</param>
+        public FeatureFlagRepository(ILogger<FeatureFlagRepository> logger, IDatabaseService databaseService)
+        {
+            _logger = logger;
+            _databaseService = databaseService;
+        }
+
+        /// <inheritdoc/>
+        public async Task<FeatureFlag> CreateAsync(FeatureFlag flag)
+        {
+            _logger.LogInformation("Creating feature flag: {Name}", flag.Name);
+
+            try
+            {
+                if (flag.Id == Guid.Empty)
+                {
+                    flag.Id = Guid.NewGuid();
+                }
+
+                // Create collection if it doesn't exist
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    await _databaseService.CreateCollectionAsync(CollectionName);
+                }
+
+                return await _databaseService.CreateAsync(CollectionName, flag);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error creating feature flag: {Name}", flag.Name);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<FeatureFlag>> GetAllAsync()
+        {
+            _logger.LogDebug("Getting feature flags");
+
+            try
+            {
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    return new List<FeatureFlag>();
+                }
+
+                return await _databaseService.GetAllAsync<FeatureFlag>(CollectionName);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting feature flags");
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<FeatureFlag> UpdateAsync(FeatureFlag flag)
+        {
+            _logger.LogInformation("Updating feature flag: {Id}", flag.Id);
+
+            try
+            {
+                return await _databaseService.UpdateAsync<FeatureFlag, Guid>(CollectionName, flag.Id, flag);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error updating feature flag: {Id}", flag.Id);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAsync(Guid id)
+        {
+            _logger.LogInformation("Deleting feature flag: {Id}", id);
+
+            try
+            {
+                return await _databaseService.DeleteAsync<FeatureFlag, Guid>(CollectionName, id);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error deleting feature flag: {Id}", id);
+                throw;
+            }
+        }
+    }
+}
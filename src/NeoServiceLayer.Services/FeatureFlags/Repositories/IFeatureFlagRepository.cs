@@ -0,0 +1,40 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.FeatureFlags.Repositories
+{
+    /// <summary>
+    /// Interface for feature flag repository
+    /// </summary>
+    public interface IFeatureFlagRepository
+    {
+        /// <summary>
+        /// Creates a flag
+        /// </summary>
+        /// <param name="flag">Flag to create</param>
+        /// <returns>The created flag</returns>
+        Task<FeatureFlag> CreateAsync(FeatureFlag flag);
+
+        /// <summary>
+        /// Gets all flags
+        /// </summary>
+        /// <returns>List of flags</returns>
+        Task<IEnumerable<FeatureFlag>> GetAllAsync();
+
+        /// <summary>
+        /// Updates a flag
+        /// </summary>
+        /// <param name="flag">Flag to update</param>
+        /// <returns>The updated flag</returns>
+        Task<FeatureFlag> UpdateAsync(FeatureFlag flag);
+
+        /// <summary>
+        /// Deletes a flag
+        /// </summary>
+        /// <param name="id">Flag ID</param>
+        /// <returns>True if the flag was deleted, false otherwise</returns>
+        Task<bool> DeleteAsync(Guid id);
+    }
+}
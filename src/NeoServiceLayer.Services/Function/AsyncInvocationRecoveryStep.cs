@@ -0,0 +1,66 @@
+using System;
+using System.Linq;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Function
+{
+    /// <summary>
+    /// Fails asynchronous invocations that were running when the previous process stopped and resumes queued ones
+    /// </summary>
+    /// <remarks>
+    /// Interrupted invocations are failed rather than rerun, like their executions; their callback still reports the
+    /// failure. Resolving the async invocation service starts its poll, so queued invocations run without waiting for
+    /// the first API request.
+    /// </remarks>
+    public class AsyncInvocationRecoveryStep : IStartupRecoveryStep
+    {
+        private readonly IAsyncInvocationRepository _repository;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AsyncInvocationRecoveryStep"/> class
+        /// </summary>
+        /// <param name="repository">Async invocation repository</param>
+        /// <param name="asyncInvocationService">Async invocation service, resolved so that it starts polling</param>
+        public AsyncInvocationRecoveryStep(IAsyncInvocationRepository repository, IAsyncInvocationService asyncInvocationService)
+        {
+            _repository = repository;
+        }
+
+        /// <inheritdoc/>
+        public string Name => "AsyncInvocations";
+
+        /// <inheritdoc/>
+        public async Task<StartupRecoveryStepResult> RecoverAsync(DateTime processStartTime)
+        {
+            var result = new StartupRecoveryStepResult();
+
+            foreach (var invocation in await _repository.GetByStatusAsync(AsyncInvocationStatus.Running))
+            {
+                if (invocation.StartedAt >= processStartTime)
+                {
+                    continue;
+                }
+
+                invocation.Status = AsyncInvocationStatus.Failed;
+                invocation.Error = "Execution was interrupted by a service restart";
+                invocation.ErrorCode = FunctionErrorCodes.InternalError;
+                invocation.CompletedAt = DateTime.UtcNow;
+                await _repository.UpdateAsync(invocation);
+
+                result.Resolved++;
+                result.Details.Add($"Async invocation {invocation.Id} of function {invocation.FunctionId} failed as interrupted");
+            }
+
+            var queued = (await _repository.GetByStatusAsync(AsyncInvocationStatus.Queued)).Count();
+            if (queued > 0)
+            {
+                result.Resumed += queued;
+                result.Details.Add($"{queued} queued async invocations resumed");
+            }
+
+            return result;
+        }
+    }
+}
@@ -0,0 +1,383 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.Linq;
+using System.Net.Http;
+using System.Text;
+using System.Text.Json;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using NeoServiceLayer.Services.Diagnostics;
+
+namespace NeoServiceLayer.Services.Function
+{
+    /// <summary>
+    /// Runs function invocations in the background and keeps their outcome for polling and callbacks
+    /// </summary>
+    /// <remarks>
+    /// Invocations are persisted when accepted, so the caller gets an execution ID at once and an invocation survives
+    /// a restart before it runs. Only the leader starts invocations and delivers callbacks, and nothing is started
+    /// while maintenance is active. An invocation left running by an instance that stopped is failed once it exceeds
+    /// the running timeout rather than run twice, since functions may have side effects. Callbacks are retried with a
+    /// doubling delay; the outcome stays available for polling either way until the retention period ends.
+    /// </remarks>
+    public class AsyncInvocationService : IAsyncInvocationService, IManagedService, IDisposable
+    {
+        private readonly ILogger<AsyncInvocationService> _logger;
+        private readonly IAsyncInvocationRepository _repository;
+        private readonly IFunctionService _functionService;
+        private readonly ICrashReporter _crashReporter;
+        private readonly IMaintenanceService _maintenanceService;
+        private readonly ILeadershipService _leadership;
+        private readonly AsyncInvocationConfiguration _configuration;
+        private readonly HttpClient _httpClient;
+        private readonly ConcurrentDictionary<Guid, Task> _running = new ConcurrentDictionary<Guid, Task>();
+        private readonly SemaphoreSlim _pollSemaphore = new SemaphoreSlim(1, 1);
+        private readonly Timer _pollTimer;
+
+        private volatile bool _stopped;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AsyncInvocationService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="repository">Async invocation repository</param>
+        /// <param name="functionService">Function service used to run invocations</param>
+        /// <param name="configuration">Configuration</param>
+        /// <param name="crashReporter">Crash reporter for background work</param>
+        /// <param name="maintenanceService">Maintenance service; nothing is started while maintenance is active</param>
+        /// <param name="leadership">Leadership lease; only the leader starts invocations and delivers callbacks</param>
+        public AsyncInvocationService(
+            ILogger<AsyncInvocationService> logger,
+            IAsyncInvocationRepository repository,
+            IFunctionService functionService,
+            IOptions<AsyncInvocationConfiguration> configuration,
+            ICrashReporter crashReporter = null,
+            IMaintenanceService maintenanceService = null,
+            ILeadershipService leadership = null)
+        {
+            _logger = logger;
+            _repository = repository;
+            _functionService = functionService;
+            _configuration = configuration.Value;
+            _crashReporter = crashReporter;
+            _maintenanceService = maintenanceService;
+            _leadership = leadership;
+            _httpClient = new HttpClient { Timeout = TimeSpan.FromSeconds(_configuration.CallbackTimeoutSeconds) };
+
+            _pollTimer = new Timer(
+                _crashReporter.Guard(_logger, "AsyncInvocation.Poll", PollAsync),
+                null,
+                TimeSpan.FromSeconds(_configuration.PollIntervalSeconds),
+                TimeSpan.FromSeconds(_configuration.PollIntervalSeconds));
+        }
+
+        /// <inheritdoc/>
+        public async Task<AsyncInvocation> EnqueueAsync(Guid functionId, Guid accountId, Dictionary<string, object> parameters, string sessionKey = null, string callbackUrl = null)
+        {
+            if (!string.IsNullOrEmpty(callbackUrl) && !callbackUrl.IsValidUrl())
+            {
+                throw new ValidationException("Callback URL must be an absolute HTTP or HTTPS URL");
+            }
+
+            if (await _repository.CountQueuedAsync(accountId) >= _configuration.MaxQueuedPerAccount)
+            {
+                throw new ValidationException($"No more than {_configuration.MaxQueuedPerAccount} invocations may be queued at once");
+            }
+
+            var invocation = await _repository.CreateAsync(new AsyncInvocation
+            {
+                Id = Guid.NewGuid(),
+                FunctionId = functionId,
+                AccountId = accountId,
+                Parameters = parameters,
+                SessionKey = sessionKey,
+                CallbackUrl = string.IsNullOrEmpty(callbackUrl) ? null : callbackUrl,
+                RequestId = RequestContext.RequestId,
+                Status = AsyncInvocationStatus.Queued,
+                CallbackStatus = string.IsNullOrEmpty(callbackUrl) ? AsyncCallbackStatus.None : AsyncCallbackStatus.Pending,
+                CreatedAt = DateTime.UtcNow
+            });
+
+            _logger.LogInformation("Queued async invocation {ExecutionId} of function {FunctionId} for account {AccountId}", invocation.Id, functionId, accountId);
+
+            // Start it without waiting for the next poll; once stopped it waits for the next start or another instance
+            if (!_stopped)
+            {
+                _pollTimer.Change(TimeSpan.Zero, TimeSpan.FromSeconds(_configuration.PollIntervalSeconds));
+            }
+
+            return invocation;
+        }
+
+        /// <inheritdoc/>
+        public Task<AsyncInvocation> GetAsync(Guid executionId)
+        {
+            return _repository.GetByIdAsync(executionId);
+        }
+
+        /// <inheritdoc/>
+        public string Name => Constants.ManagedServices.AsyncInvocations;
+
+        /// <inheritdoc/>
+        public IReadOnlyCollection<string> DependsOn { get; } = new[] { Constants.ManagedServices.Leadership, Constants.ManagedServices.Functions };
+
+        /// <summary>
+        /// Resumes polling after <see cref="StopAsync"/>; the service polls from construction, so this is only needed to restart it
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public Task StartAsync()
+        {
+            if (_stopped)
+            {
+                _stopped = false;
+                _pollTimer.Change(TimeSpan.FromSeconds(_configuration.PollIntervalSeconds), TimeSpan.FromSeconds(_configuration.PollIntervalSeconds));
+            }
+
+            return Task.CompletedTask;
+        }
+
+        /// <summary>
+        /// Stops polling and waits for running invocations to finish and deliver their callbacks
+        /// </summary>
+        /// <remarks>
+        /// Queued invocations stay queued for the next leader. An invocation still running when the process exits is
+        /// failed as interrupted by the recovery step of the next start.
+        /// </remarks>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task StopAsync()
+        {
+            _stopped = true;
+            _pollTimer.Change(Timeout.Infinite, Timeout.Infinite);
+
+            await _pollSemaphore.WaitAsync();
+            _pollSemaphore.Release();
+
+            await Task.WhenAll(_running.Values);
+        }
+
+        /// <inheritdoc/>
+        public ManagedServiceHealth GetHealth()
+        {
+            return ManagedServiceHealth.Of(
+                Name,
+                _stopped ? ManagedServiceStatus.Stopped : ManagedServiceStatus.Running,
+                $"{_running.Count} invocations running");
+        }
+
+        /// <summary>
+        /// Starts queued invocations, fails stale running ones, delivers due callbacks and drops expired outcomes
+        /// </summary>
+        private async Task PollAsync()
+        {
+            // Prevent concurrent execution
+            if (!await _pollSemaphore.WaitAsync(0))
+            {
+                return;
+            }
+
+            try
+            {
+                // Skip a poll the timer queued just before the service stopped
+                if (_stopped || (_leadership != null && !_leadership.IsLeader))
+                {
+                    return;
+                }
+
+                await FailStaleInvocationsAsync();
+
+                if (_maintenanceService == null || !await _maintenanceService.IsActiveAsync())
+                {
+                    await StartQueuedInvocationsAsync();
+                }
+
+                await DeliverDueCallbacksAsync();
+                await _repository.DeleteFinishedBeforeAsync(DateTime.UtcNow.AddHours(-_configuration.ResultRetentionHours));
+            }
+            finally
+            {
+                _pollSemaphore.Release();
+            }
+        }
+
+        private async Task StartQueuedInvocationsAsync()
+        {
+            var slots = _configuration.BatchSize - _running.Count;
+            if (slots <= 0)
+            {
+                return;
+            }
+
+            foreach (var invocation in (await _repository.GetByStatusAsync(AsyncInvocationStatus.Queued)).Take(slots))
+            {
+                invocation.Status = AsyncInvocationStatus.Running;
+                invocation.StartedAt = DateTime.UtcNow;
+                await _repository.UpdateAsync(invocation);
+
+                _running[invocation.Id] = Task.Run(() => RunAsync(invocation));
+            }
+        }
+
+        private async Task RunAsync(AsyncInvocation invocation)
+        {
+            // Tie the execution's records to the request that accepted it
+            RequestContext.RequestId = invocation.RequestId;
+
+            try
+            {
+                _logger.LogInformation("Running async invocation {ExecutionId} of function {FunctionId}", invocation.Id, invocation.FunctionId);
+
+                invocation.Result = await _functionService.ExecuteAsync(invocation.FunctionId, invocation.Parameters, invocation.SessionKey);
+                invocation.Status = AsyncInvocationStatus.Completed;
+            }
+            catch (Exception ex)
+            {
+                // The failure is also recorded in the function's execution history
+                _logger.LogWarning(ex, "Async invocation {ExecutionId} of function {FunctionId} failed", invocation.Id, invocation.FunctionId);
+
+                invocation.Status = AsyncInvocationStatus.Failed;
+                invocation.Error = ex is FunctionException || ex is ResourceNotFoundException ? ex.Message : "An unexpected error occurred";
+                invocation.ErrorCode = FunctionErrorCodes.Find(ex);
+            }
+
+            try
+            {
+                invocation.CompletedAt = DateTime.UtcNow;
+                await _repository.UpdateAsync(invocation);
+
+                if (invocation.CallbackStatus == AsyncCallbackStatus.Pending)
+                {
+                    await DeliverCallbackAsync(invocation);
+                }
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error recording the outcome of async invocation {ExecutionId}", invocation.Id);
+            }
+            finally
+            {
+                _running.TryRemove(invocation.Id, out _);
+            }
+        }
+
+        private async Task FailStaleInvocationsAsync()
+        {
+            var cutoff = DateTime.UtcNow.AddSeconds(-_configuration.RunningTimeoutSeconds);
+            var stale = (await _repository.GetByStatusAsync(AsyncInvocationStatus.Running))
+                .Where(i => !_running.ContainsKey(i.Id) && i.StartedAt < cutoff);
+
+            foreach (var invocation in stale)
+            {
+                _logger.LogWarning("Async invocation {ExecutionId} of function {FunctionId} did not finish; marking it failed", invocation.Id, invocation.FunctionId);
+
+                invocation.Status = AsyncInvocationStatus.Failed;
+                invocation.Error = "The invocation did not finish in time";
+                invocation.CompletedAt = DateTime.UtcNow;
+                await _repository.UpdateAsync(invocation);
+            }
+        }
+
+        private async Task DeliverDueCallbacksAsync()
+        {
+            foreach (var invocation in await _repository.GetDueCallbacksAsync(DateTime.UtcNow))
+            {
+                // Invocations still finishing on this instance deliver their own first callback
+                if (!_running.ContainsKey(invocation.Id))
+                {
+                    await DeliverCallbackAsync(invocation);
+                }
+            }
+        }
+
+        private async Task DeliverCallbackAsync(AsyncInvocation invocation)
+        {
+            invocation.CallbackAttempts++;
+
+            var (success, error) = await SendCallbackAsync(invocation);
+            if (success)
+            {
+                invocation.CallbackStatus = AsyncCallbackStatus.Delivered;
+                invocation.CallbackDeliveredAt = DateTime.UtcNow;
+                invocation.CallbackError = null;
+                invocation.NextCallbackAt = null;
+            }
+            else if (invocation.CallbackAttempts >= _configuration.MaxCallbackAttempts)
+            {
+                _logger.LogWarning("Giving up the callback of async invocation {ExecutionId} after {Attempts} attempts", invocation.Id, invocation.CallbackAttempts);
+
+                invocation.CallbackStatus = AsyncCallbackStatus.Failed;
+                invocation.CallbackError = error;
+                invocation.NextCallbackAt = null;
+            }
+            else
+            {
+                invocation.CallbackError = error;
+                invocation.NextCallbackAt = DateTime.UtcNow.AddSeconds(
+                    _configuration.CallbackRetryDelaySeconds * Math.Pow(2, invocation.CallbackAttempts - 1));
+            }
+
+            await _repository.UpdateAsync(invocation);
+        }
+
+        private async Task<(bool success, string error)> SendCallbackAsync(AsyncInvocation invocation)
+        {
+            try
+            {
+                var payload = JsonSerializer.Serialize(new
+                {
+                    execution_id = invocation.Id,
+                    function_id = invocation.FunctionId,
+                    status = invocation.Status.ToString(),
+                    result = invocation.Result,
+                    error = invocation.Error,
+                    error_code = invocation.ErrorCode,
+                    created_at = invocation.CreatedAt,
+                    completed_at = invocation.CompletedAt
+                });
+
+                var request = new HttpRequestMessage(HttpMethod.Post, invocation.CallbackUrl)
+                {
+                    Content = new StringContent(payload, Encoding.UTF8, "application/json")
+                };
+
+                if (invocation.RequestId != null)
+                {
+                    request.Headers.TryAddWithoutValidation(RequestContext.RequestIdHeader, invocation.RequestId);
+                }
+
+                var response = await _httpClient.SendAsync(request);
+                if (response.IsSuccessStatusCode)
+                {
+                    _logger.LogInformation("Delivered the callback of async invocation {ExecutionId}", invocation.Id);
+                    return (true, null);
+                }
+
+                _logger.LogWarning("Callback of async invocation {ExecutionId} failed: {StatusCode}", invocation.Id, response.StatusCode);
+                return (false, $"HTTP {(int)response.StatusCode}");
+            }
+            catch (Exception ex)
+            {
+                _logger.LogWarning(ex, "Error delivering the callback of async invocation {ExecutionId}", invocation.Id);
+                return (false, ex.Message);
+            }
+        }
+
+        /// <summary>
+        /// Disposes the service
+        /// </summary>
+        public void Dispose()
+        {
+            _pollTimer?.Dispose();
+            _pollSemaphore?.Dispose();
+            _httpClient?.Dispose();
+        }
+    }
+}
@@ -0,0 +1,162 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Text.Json;
+
+namespace NeoServiceLayer.Services.Function.Build
+{
+    /// <summary>
+    /// Reads version 3 source maps to find the source position of a position in compiled code
+    /// </summary>
+    public class SourceMapReader
+    {
+        private const string Base64Chars = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/";
+
+        private readonly List<string> _sources;
+        private readonly List<List<Segment>> _lines;
+
+        private SourceMapReader(List<string> sources, List<List<Segment>> lines)
+        {
+            _sources = sources;
+            _lines = lines;
+        }
+
+        /// <summary>
+        /// Parses a source map
+        /// </summary>
+        /// <param name="json">Source map JSON</param>
+        /// <returns>The reader</returns>
+        /// <exception cref="FormatException">Thrown if the source map is malformed</exception>
+        public static SourceMapReader Parse(string json)
+        {
+            using var document = JsonDocument.Parse(json);
+            var root = document.RootElement;
+
+            var sources = root.TryGetProperty("sources", out var sourcesElement)
+                ? sourcesElement.EnumerateArray().Select(s => s.GetString()).ToList()
+                : new List<string>();
+            var mappings = root.TryGetProperty("mappings", out var mappingsElement) ? mappingsElement.GetString() : string.Empty;
+
+            return new SourceMapReader(sources, DecodeMappings(mappings ?? string.Empty));
+        }
+
+        /// <summary>
+        /// Finds the source position of a position in the compiled code
+        /// </summary>
+        /// <param name="line">Line in the compiled code, starting at 1</param>
+        /// <param name="column">Column in the compiled code, starting at 1</param>
+        /// <param name="source">Source file</param>
+        /// <param name="sourceLine">Line in the source, starting at 1</param>
+        /// <param name="sourceColumn">Column in the source, starting at 1</param>
+        /// <returns>True if the position is mapped, false otherwise</returns>
+        public bool TryGetSourcePosition(int line, int column, out string source, out int sourceLine, out int sourceColumn)
+        {
+            source = null;
+            sourceLine = 0;
+            sourceColumn = 0;
+
+            if (line < 1 || line > _lines.Count)
+            {
+                return false;
+            }
+
+            var segments = _lines[line - 1];
+            if (segments.Count == 0)
+            {
+                return false;
+            }
+
+            // Use the last segment starting at or before the column; errors reported at the start of a
+            // line fall back to the line's first segment
+            var segment = segments.LastOrDefault(s => s.GeneratedColumn <= column - 1) ?? segments[0];
+
+            source = segment.SourceIndex < _sources.Count ? _sources[segment.SourceIndex] : null;
+            sourceLine = segment.SourceLine + 1;
+            sourceColumn = segment.SourceColumn + 1;
+            return true;
+        }
+
+        private static List<List<Segment>> DecodeMappings(string mappings)
+        {
+            var lines = new List<List<Segment>>();
+            int sourceIndex = 0, sourceLine = 0, sourceColumn = 0;
+
+            foreach (var lineMappings in mappings.Split(';'))
+            {
+                var segments = new List<Segment>();
+                var generatedColumn = 0;
+
+                foreach (var encoded in lineMappings.Split(',', StringSplitOptions.RemoveEmptyEntries))
+                {
+                    var fields = DecodeVlq(encoded);
+                    generatedColumn += fields[0];
+
+                    // Segments with a single field have no source position
+                    if (fields.Count < 4)
+                    {
+                        continue;
+                    }
+
+                    sourceIndex += fields[1];
+                    sourceLine += fields[2];
+                    sourceColumn += fields[3];
+
+                    segments.Add(new Segment(generatedColumn, sourceIndex, sourceLine, sourceColumn));
+                }
+
+                lines.Add(segments);
+            }
+
+            return lines;
+        }
+
+        private static List<int> DecodeVlq(string encoded)
+        {
+            var values = new List<int>();
+            int value = 0, shift = 0;
+
+            foreach (var c in encoded)
+            {
+                var digit = Base64Chars.IndexOf(c);
+                if (digit < 0)
+                {
+                    throw new FormatException($"Invalid character '{c}' in source map mappings");
+                }
+
+                value += (digit & 31) << shift;
+
+                if ((digit & 32) != 0)
+                {
+                    shift += 5;
+                    continue;
+                }
+
+                // The lowest bit is the sign
+                values.Add((value & 1) == 1 ? -(value >> 1) : value >> 1);
+                value = 0;
+                shift = 0;
+            }
+
+            return values;
+        }
+
+        private sealed class Segment
+        {
+            public Segment(int generatedColumn, int sourceIndex, int sourceLine, int sourceColumn)
+            {
+                GeneratedColumn = generatedColumn;
+                SourceIndex = sourceIndex;
+                SourceLine = sourceLine;
+                SourceColumn = sourceColumn;
+            }
+
+            public int GeneratedColumn { get; }
+
+            public int SourceIndex { get; }
+
+            public int SourceLine { get; }
+
+            public int SourceColumn { get; }
+        }
+    }
+}
@@ -0,0 +1,708 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.DependencyInjection;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Function
+{
+    /// <summary>
+    /// Implementation of the execution scheduler using weighted fair queuing between tiers
+    /// </summary>
+    /// <remarks>
+    /// Executions start at once while the instance has free slots. Once it is saturated they wait in a queue
+    /// per tier, and each freed slot goes to the tier that has received the least service relative to its
+    /// weight (stride scheduling), so every queued tier gets at least its weight's share of slots. Within a tier,
+    /// interactive executions go ahead of triggered ones. An execution that has waited past the starvation
+    /// threshold is started next whatever its tier or lane. An account at its concurrency limit waits without
+    /// holding up other accounts. Weights can be changed at runtime with <c>execution-weight:{tier}</c> feature
+    /// flags, and turning the <c>execution-prioritization</c> flag off makes the queue first come, first served.
+    /// </remarks>
+    public class ExecutionScheduler : IExecutionScheduler
+    {
+        /// <summary>
+        /// Feature flag that, when off, makes the queue first come, first served
+        /// </summary>
+        public const string PrioritizationFlag = "execution-prioritization";
+
+        /// <summary>
+        /// Prefix of the feature flags overriding a tier's weight; the flag's value is the weight
+        /// </summary>
+        public const string WeightFlagPrefix = "execution-weight:";
+
+        private const string PaidTier = "paid";
+
+        private readonly ILogger<ExecutionScheduler> _logger;
+        private readonly ExecutionSchedulingConfiguration _configuration;
+        private readonly IServiceScopeFactory _scopeFactory;
+        private readonly IFeatureFlagService _featureFlags;
+        private readonly IMetricsService _metricsService;
+        private readonly object _lock = new object();
+        private readonly Dictionary<string, TierQueue> _queues = new Dictionary<string, TierQueue>(StringComparer.OrdinalIgnoreCase);
+        private readonly ConcurrentDictionary<Guid, CachedTier> _accountTiers = new ConcurrentDictionary<Guid, CachedTier>();
+        private readonly Dictionary<Guid, int> _accountRunning = new Dictionary<Guid, int>();
+        private readonly int _maxConcurrentExecutions;
+        private readonly int _processorCount;
+        private readonly long _availableMemoryBytes;
+
+        private int _running;
+        private int _queued;
+        private double _virtualTime;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ExecutionScheduler"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="configuration">Configuration</param>
+        /// <param name="scopeFactory">Scope factory used to resolve the scoped account service; every account is in the default tier when omitted</param>
+        /// <param name="featureFlags">Feature flags adjusting weights at runtime</param>
+        /// <param name="metricsService">Metrics service queue waits and starvation promotions are recorded to</param>
+        public ExecutionScheduler(
+            ILogger<ExecutionScheduler> logger,
+            IOptions<ExecutionSchedulingConfiguration> configuration = null,
+            IServiceScopeFactory scopeFactory = null,
+            IFeatureFlagService featureFlags = null,
+            IMetricsService metricsService = null)
+        {
+            _logger = logger;
+            _configuration = configuration?.Value ?? new ExecutionSchedulingConfiguration();
+            _scopeFactory = scopeFactory;
+            _featureFlags = featureFlags;
+            _metricsService = metricsService;
+
+            _processorCount = Environment.ProcessorCount;
+            _availableMemoryBytes = GC.GetGCMemoryInfo().TotalAvailableMemoryBytes;
+            _maxConcurrentExecutions = GetWorkerPoolSize(_configuration, _processorCount, _availableMemoryBytes);
+
+            if (_maxConcurrentExecutions != _configuration.MaxConcurrentExecutions)
+            {
+                _logger.LogInformation(
+                    "Sized the execution worker pool to {Size} for {Processors} processors and {MemoryMb} MB of memory",
+                    _maxConcurrentExecutions,
+                    _processorCount,
+                    _availableMemoryBytes / (1024 * 1024));
+            }
+        }
+
+        /// <summary>
+        /// Gets the number of executions an instance runs at once
+        /// </summary>
+        /// <param name="configuration">Configuration</param>
+        /// <param name="processorCount">Processors available to the instance</param>
+        /// <param name="availableMemoryBytes">Memory available to the instance, in bytes</param>
+        /// <returns>The smallest of the configured maximum and the processor and memory based sizes, or 0 if none is set</returns>
+        public static int GetWorkerPoolSize(ExecutionSchedulingConfiguration configuration, int processorCount, long availableMemoryBytes)
+        {
+            var sizes = new List<int>();
+
+            if (configuration.MaxConcurrentExecutions > 0)
+            {
+                sizes.Add(configuration.MaxConcurrentExecutions);
+            }
+
+            if (configuration.ExecutionsPerCpu > 0)
+            {
+                sizes.Add(Math.Max(1, (int)Math.Floor(processorCount * configuration.ExecutionsPerCpu)));
+            }
+
+            if (configuration.MemoryPerExecutionMb > 0 && availableMemoryBytes > 0)
+            {
+                sizes.Add((int)Math.Clamp(availableMemoryBytes / (configuration.MemoryPerExecutionMb * 1024L * 1024L), 1, int.MaxValue));
+            }
+
+            return sizes.Count > 0 ? sizes.Min() : 0;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IDisposable> AcquireAsync(Guid accountId, ExecutionLane lane = ExecutionLane.Interactive, CancellationToken cancellationToken = default)
+        {
+            var tier = await GetAccountTierAsync(accountId);
+            if (_maxConcurrentExecutions <= 0 && GetAccountLimit(tier) <= 0)
+            {
+                return new Slot(null, accountId);
+            }
+
+            Waiter waiter;
+            List<Dispatch> dispatched;
+            int depth;
+
+            lock (_lock)
+            {
+                // Whenever a slot is free, everything still queued is held back by its account's limit,
+                // so an execution whose account has room jumps nobody by starting at once
+                var queue = GetQueue(tier);
+                if (HasFreeSlotLocked() && HasAccountRoomLocked(accountId, tier))
+                {
+                    StartLocked(accountId);
+                    queue.Started++;
+                    return new Slot(this, accountId);
+                }
+
+                if (_queued >= _configuration.MaxQueueLength)
+                {
+                    queue.Rejected++;
+                    throw new FunctionException("The execution queue is full, try again later");
+                }
+
+                // A tier that was idle rejoins at the current virtual time, so it can't spend credit banked while idle
+                if (queue.Count == 0)
+                {
+                    queue.Pass = Math.Max(queue.Pass, _virtualTime);
+                }
+
+                waiter = new Waiter { AccountId = accountId, Tier = queue.Name, Lane = lane, QueuedAt = DateTime.UtcNow };
+                waiter.Node = queue.GetLane(lane).AddLast(waiter);
+                _queued++;
+                depth = queue.GetLane(lane).Count;
+
+                dispatched = DispatchLocked();
+            }
+
+            _ = RecordQueueDepthAsync(tier, lane, depth);
+            Record(dispatched);
+
+            using var timeout = CancellationTokenSource.CreateLinkedTokenSource(cancellationToken);
+            timeout.CancelAfter(TimeSpan.FromSeconds(Math.Max(1, _configuration.QueueTimeoutSeconds)));
+
+            using (timeout.Token.Register(() => Abandon(waiter, cancellationToken.IsCancellationRequested)))
+            {
+                return await waiter.Completion.Task;
+            }
+        }
+
+        /// <inheritdoc/>
+        public IReadOnlyList<ExecutionTier> GetTiers()
+        {
+            var tiers = _configuration.Tiers?.Count > 0
+                ? _configuration.Tiers
+                : new List<ExecutionTier>
+                {
+                    new ExecutionTier { Name = PaidTier, Weight = 4 },
+                    new ExecutionTier { Name = _configuration.DefaultTier, Weight = 1 }
+                };
+
+            return tiers.Select(t => new ExecutionTier
+            {
+                Name = t.Name,
+                Weight = GetWeight(t),
+                MaxConcurrentPerAccount = t.MaxConcurrentPerAccount ?? _configuration.MaxConcurrentPerAccount
+            }).ToList();
+        }
+
+        /// <inheritdoc/>
+        public ExecutionSchedulerStatus GetStatus()
+        {
+            var tiers = GetTiers();
+            var totalWeight = tiers.Sum(t => t.Weight);
+            var now = DateTime.UtcNow;
+
+            lock (_lock)
+            {
+                return new ExecutionSchedulerStatus
+                {
+                    MaxConcurrentExecutions = _maxConcurrentExecutions,
+                    ProcessorCount = _processorCount,
+                    AvailableMemoryMb = _availableMemoryBytes / (1024 * 1024),
+                    Running = _running,
+                    Queued = _queued,
+                    Throttled = _queues.Values
+                        .SelectMany(q => q.Interactive.Concat(q.Triggered))
+                        .Count(w => !HasAccountRoomLocked(w.AccountId, w.Tier)),
+                    PrioritizationEnabled = IsPrioritizationEnabled(),
+                    Tiers = tiers.Select(t =>
+                    {
+                        _queues.TryGetValue(t.Name, out var queue);
+                        var oldest = queue?.GetOldest();
+                        return new ExecutionTierStatus
+                        {
+                            Name = t.Name,
+                            Weight = t.Weight,
+                            GuaranteedShare = totalWeight > 0 ? t.Weight / totalWeight : 0,
+                            Queued = queue?.Count ?? 0,
+                            QueuedInteractive = queue?.Interactive.Count ?? 0,
+                            QueuedTriggered = queue?.Triggered.Count ?? 0,
+                            MaxConcurrentPerAccount = t.MaxConcurrentPerAccount ?? 0,
+                            OldestWaitMs = oldest != null ? (now - oldest.QueuedAt).TotalMilliseconds : 0,
+                            Started = queue?.Started ?? 0,
+                            AverageWaitMs = queue?.Started > 0 ? queue.TotalWaitMs / queue.Started : 0,
+                            MaxWaitMs = queue?.MaxWaitMs ?? 0,
+                            StarvationPromotions = queue?.StarvationPromotions ?? 0,
+                            TimedOut = queue?.TimedOut ?? 0,
+                            Rejected = queue?.Rejected ?? 0
+                        };
+                    }).ToList()
+                };
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<Core.Models.Account> SetAccountTierAsync(Guid accountId, string tier)
+        {
+            var target = GetTiers().FirstOrDefault(t => string.Equals(t.Name, tier, StringComparison.OrdinalIgnoreCase));
+            if (target == null)
+            {
+                throw new ValidationException($"Unknown execution tier: {tier}");
+            }
+
+            if (_scopeFactory == null)
+            {
+                throw new InvalidOperationException("Account service is not available");
+            }
+
+            using var scope = _scopeFactory.CreateScope();
+            var accountService = scope.ServiceProvider.GetRequiredService<IAccountService>();
+
+            var account = await accountService.GetByIdAsync(accountId);
+            if (account == null)
+            {
+                throw new ResourceNotFoundException("Account", accountId.ToString());
+            }
+
+            account.ExecutionTier = target.Name;
+            account.UpdatedAt = DateTime.UtcNow;
+            var updatedAccount = await accountService.UpdateAsync(account);
+
+            _accountTiers[accountId] = new CachedTier { Tier = target.Name, ExpiresAt = DateTime.UtcNow.AddSeconds(_configuration.AccountTierCacheSeconds) };
+
+            _logger.LogInformation("Moved account {AccountId} to execution tier {Tier}", accountId, target.Name);
+
+            return updatedAccount;
+        }
+
+        private void Release(Guid accountId)
+        {
+            List<Dispatch> dispatched;
+            lock (_lock)
+            {
+                _running--;
+                if (_accountRunning.TryGetValue(accountId, out var running) && running > 1)
+                {
+                    _accountRunning[accountId] = running - 1;
+                }
+                else
+                {
+                    _accountRunning.Remove(accountId);
+                }
+
+                dispatched = DispatchLocked();
+            }
+
+            Record(dispatched);
+        }
+
+        private void Abandon(Waiter waiter, bool cancelled)
+        {
+            lock (_lock)
+            {
+                // Already started: the caller owns a slot
+                if (waiter.Node.List == null)
+                {
+                    return;
+                }
+
+                waiter.Node.List.Remove(waiter.Node);
+                _queued--;
+
+                if (!cancelled)
+                {
+                    GetQueue(waiter.Tier).TimedOut++;
+                }
+            }
+
+            if (cancelled)
+            {
+                waiter.Completion.TrySetCanceled();
+                return;
+            }
+
+            _logger.LogWarning(
+                "{Lane} execution in tier {Tier} timed out after waiting {Seconds} seconds for a slot",
+                waiter.Lane,
+                waiter.Tier,
+                _configuration.QueueTimeoutSeconds);
+            waiter.Completion.TrySetException(new FunctionException("Timed out waiting for an execution slot, try again later"));
+        }
+
+        private List<Dispatch> DispatchLocked()
+        {
+            var dispatched = new List<Dispatch>();
+            var now = DateTime.UtcNow;
+            var prioritize = IsPrioritizationEnabled();
+
+            while (HasFreeSlotLocked() && _queued > 0)
+            {
+                // Each tier offers the next execution it could start; waiters whose account is at its limit are skipped
+                var candidates = new List<Candidate>();
+                foreach (var queue in _queues.Values)
+                {
+                    var candidate = NextStartableLocked(queue, now, prioritize);
+                    if (candidate != null)
+                    {
+                        candidates.Add(candidate);
+                    }
+                }
+
+                if (candidates.Count == 0)
+                {
+                    break;
+                }
+
+                var next = prioritize
+                    ? candidates.OrderBy(c => c.Queue.Pass).ThenBy(c => c.Waiter.QueuedAt).First()
+                    : candidates.OrderBy(c => c.Waiter.QueuedAt).First();
+
+                // Weights decide the order, but nothing waits past the threshold while a lower-weighted tier is queued
+                if (prioritize)
+                {
+                    var oldest = candidates.OrderBy(c => c.Waiter.QueuedAt).First();
+                    if (oldest.Queue != next.Queue && IsStarving(oldest.Waiter, now))
+                    {
+                        next = oldest;
+                        next.Promoted = true;
+                    }
+                }
+
+                var waiter = next.Waiter;
+                var lane = waiter.Node.List;
+                lane.Remove(waiter.Node);
+                _queued--;
+                StartLocked(waiter.AccountId);
+
+                _virtualTime = Math.Max(_virtualTime, next.Queue.Pass);
+                next.Queue.Pass += 1.0 / GetWeight(next.Queue.Name);
+
+                var waitMs = (now - waiter.QueuedAt).TotalMilliseconds;
+                next.Queue.Started++;
+                next.Queue.TotalWaitMs += waitMs;
+                next.Queue.MaxWaitMs = Math.Max(next.Queue.MaxWaitMs, waitMs);
+                if (next.Promoted)
+                {
+                    next.Queue.StarvationPromotions++;
+                }
+
+                // The waiter has left the queue under the lock, so it can no longer time out
+                waiter.Completion.TrySetResult(new Slot(this, waiter.AccountId));
+                dispatched.Add(new Dispatch
+                {
+                    Tier = next.Queue.Name,
+                    Lane = waiter.Lane,
+                    WaitMs = waitMs,
+                    Promoted = next.Promoted,
+                    QueueDepth = lane.Count
+                });
+            }
+
+            return dispatched;
+        }
+
+        private Candidate NextStartableLocked(TierQueue queue, DateTime now, bool prioritize)
+        {
+            var interactive = FirstStartableLocked(queue.Interactive);
+            var triggered = FirstStartableLocked(queue.Triggered);
+            if (interactive == null || triggered == null)
+            {
+                var only = interactive ?? triggered;
+                return only != null ? new Candidate { Queue = queue, Waiter = only } : null;
+            }
+
+            if (!prioritize)
+            {
+                return new Candidate { Queue = queue, Waiter = triggered.QueuedAt < interactive.QueuedAt ? triggered : interactive };
+            }
+
+            // Interactive executions go first, but a triggered one is not held back past the starvation threshold
+            if (triggered.QueuedAt < interactive.QueuedAt && IsStarving(triggered, now))
+            {
+                return new Candidate { Queue = queue, Waiter = triggered, Promoted = true };
+            }
+
+            return new Candidate { Queue = queue, Waiter = interactive };
+        }
+
+        private Waiter FirstStartableLocked(LinkedList<Waiter> lane)
+        {
+            for (var node = lane.First; node != null; node = node.Next)
+            {
+                if (HasAccountRoomLocked(node.Value.AccountId, node.Value.Tier))
+                {
+                    return node.Value;
+                }
+            }
+
+            return null;
+        }
+
+        private bool IsStarving(Waiter waiter, DateTime now)
+        {
+            return _configuration.StarvationThresholdSeconds > 0 &&
+                now - waiter.QueuedAt >= TimeSpan.FromSeconds(_configuration.StarvationThresholdSeconds);
+        }
+
+        private bool HasFreeSlotLocked()
+        {
+            return _maxConcurrentExecutions <= 0 || _running < _maxConcurrentExecutions;
+        }
+
+        private bool HasAccountRoomLocked(Guid accountId, string tier)
+        {
+            var limit = GetAccountLimit(tier);
+            return limit <= 0 || !_accountRunning.TryGetValue(accountId, out var running) || running < limit;
+        }
+
+        private void StartLocked(Guid accountId)
+        {
+            _running++;
+            _accountRunning.TryGetValue(accountId, out var running);
+            _accountRunning[accountId] = running + 1;
+        }
+
+        private void Record(List<Dispatch> dispatched)
+        {
+            foreach (var dispatch in dispatched)
+            {
+                if (dispatch.Promoted)
+                {
+                    _logger.LogWarning(
+                        "Started a {Lane} execution in tier {Tier} after {WaitMs} ms to keep it from starving",
+                        dispatch.Lane,
+                        dispatch.Tier,
+                        dispatch.WaitMs);
+                }
+
+                _ = RecordMetricsAsync(dispatch);
+            }
+        }
+
+        private async Task RecordMetricsAsync(Dispatch dispatch)
+        {
+            if (_metricsService == null)
+            {
+                return;
+            }
+
+            try
+            {
+                var tags = new Dictionary<string, string> { ["tier"] = dispatch.Tier, ["lane"] = FormatLane(dispatch.Lane) };
+                await _metricsService.RecordCustomMetricAsync("execution_queue_wait_ms", dispatch.WaitMs, tags);
+                await _metricsService.RecordCustomMetricAsync("execution_queue_depth", dispatch.QueueDepth, tags);
+
+                if (dispatch.Promoted)
+                {
+                    await _metricsService.RecordCustomMetricAsync("execution_starvation_promotions", 1, tags);
+                }
+            }
+            catch (Exception ex)
+            {
+                _logger.LogWarning(ex, "Error recording execution queue metrics for tier {Tier}", dispatch.Tier);
+            }
+        }
+
+        private async Task RecordQueueDepthAsync(string tier, ExecutionLane lane, int depth)
+        {
+            if (_metricsService == null)
+            {
+                return;
+            }
+
+            try
+            {
+                var tags = new Dictionary<string, string> { ["tier"] = tier, ["lane"] = FormatLane(lane) };
+                await _metricsService.RecordCustomMetricAsync("execution_queue_depth", depth, tags);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogWarning(ex, "Error recording execution queue depth for tier {Tier}", tier);
+            }
+        }
+
+        private static string FormatLane(ExecutionLane lane)
+        {
+            return lane == ExecutionLane.Triggered ? "triggered" : "interactive";
+        }
+
+        private async Task<string> GetAccountTierAsync(Guid accountId)
+        {
+            if (_accountTiers.TryGetValue(accountId, out var cached) && cached.ExpiresAt > DateTime.UtcNow)
+            {
+                return cached.Tier;
+            }
+
+            var tier = _configuration.DefaultTier;
+            if (_scopeFactory != null)
+            {
+                try
+                {
+                    using var scope = _scopeFactory.CreateScope();
+                    var accountService = scope.ServiceProvider.GetService<IAccountService>();
+                    var account = accountService != null ? await accountService.GetByIdAsync(accountId) : null;
+                    tier = ResolveTier(account?.ExecutionTier);
+                }
+                catch (Exception ex)
+                {
+                    // Scheduling must not fail an execution; the account waits in the default tier instead
+                    _logger.LogWarning(ex, "Error getting execution tier of account {AccountId}", accountId);
+                }
+            }
+
+            _accountTiers[accountId] = new CachedTier { Tier = tier, ExpiresAt = DateTime.UtcNow.AddSeconds(_configuration.AccountTierCacheSeconds) };
+            return tier;
+        }
+
+        private string ResolveTier(string tier)
+        {
+            var known = GetTiers().FirstOrDefault(t => string.Equals(t.Name, tier, StringComparison.OrdinalIgnoreCase));
+            return known?.Name ?? _configuration.DefaultTier;
+        }
+
+        private double GetWeight(ExecutionTier tier)
+        {
+            var value = _featureFlags?.GetValue(WeightFlagPrefix + tier.Name);
+            if (value != null && double.TryParse(value, NumberStyles.Float, CultureInfo.InvariantCulture, out var weight) && weight > 0)
+            {
+                return weight;
+            }
+
+            return tier.Weight > 0 ? tier.Weight : 1;
+        }
+
+        private double GetWeight(string tierName)
+        {
+            var tier = GetTiers().FirstOrDefault(t => string.Equals(t.Name, tierName, StringComparison.OrdinalIgnoreCase));
+            return tier?.Weight ?? 1;
+        }
+
+        private int GetAccountLimit(string tierName)
+        {
+            var tier = _configuration.Tiers?.FirstOrDefault(t => string.Equals(t.Name, tierName, StringComparison.OrdinalIgnoreCase));
+            return tier?.MaxConcurrentPerAccount ?? _configuration.MaxConcurrentPerAccount;
+        }
+
+        private bool IsPrioritizationEnabled()
+        {
+            return _featureFlags?.IsEnabled(PrioritizationFlag, true) ?? true;
+        }
+
+        private TierQueue GetQueue(string tier)
+        {
+            if (!_queues.TryGetValue(tier, out var queue))
+            {
+                queue = new TierQueue { Name = tier, Pass = _virtualTime };
+                _queues[tier] = queue;
+            }
+
+            return queue;
+        }
+
+        private class TierQueue
+        {
+            public string Name { get; set; }
+
+            public LinkedList<Waiter> Interactive { get; } = new LinkedList<Waiter>();
+
+            public LinkedList<Waiter> Triggered { get; } = new LinkedList<Waiter>();
+
+            public int Count => Interactive.Count + Triggered.Count;
+
+            public double Pass { get; set; }
+
+            public long Started { get; set; }
+
+            public double TotalWaitMs { get; set; }
+
+            public double MaxWaitMs { get; set; }
+
+            public long StarvationPromotions { get; set; }
+
+            public long TimedOut { get; set; }
+
+            public long Rejected { get; set; }
+
+            public LinkedList<Waiter> GetLane(ExecutionLane lane)
+            {
+                return lane == ExecutionLane.Triggered ? Triggered : Interactive;
+            }
+
+            public Waiter GetOldest()
+            {
+                var interactive = Interactive.First?.Value;
+                var triggered = Triggered.First?.Value;
+                if (interactive == null || triggered == null)
+                {
+                    return interactive ?? triggered;
+                }
+
+                return triggered.QueuedAt < interactive.QueuedAt ? triggered : interactive;
+            }
+        }
+
+        private class Waiter
+        {
+            public Guid AccountId { get; set; }
+
+            public string Tier { get; set; }
+
+            public ExecutionLane Lane { get; set; }
+
+            public DateTime QueuedAt { get; set; }
+
+            public LinkedListNode<Waiter> Node { get; set; }
+
+            public TaskCompletionSource<IDisposable> Completion { get; } =
+                new TaskCompletionSource<IDisposable>(TaskCreationOptions.RunContinuationsAsynchronously);
+        }
+
+        private class CachedTier
+        {
+            public string Tier { get; set; }
+
+            public DateTime ExpiresAt { get; set; }
+        }
+
+        private class Candidate
+        {
+            public TierQueue Queue { get; set; }
+
+            public Waiter Waiter { get; set; }
+
+            public bool Promoted { get; set; }
+        }
+
+        private class Dispatch
+        {
+            public string Tier { get; set; }
+
+            public ExecutionLane Lane { get; set; }
+
+            public double WaitMs { get; set; }
+
+            public bool Promoted { get; set; }
+
+            public int QueueDepth { get; set; }
+        }
+
+        private class Slot : IDisposable
+        {
+            private readonly Guid _accountId;
+            private ExecutionScheduler _scheduler;
+
+            public Slot(ExecutionScheduler scheduler, Guid accountId)
+            {
+                _scheduler = scheduler;
+                _accountId = accountId;
+            }
+
+            public void Dispose()
+            {
+                Interlocked.Exchange(ref _scheduler, null)?.Release(_accountId);
+            }
+        }
+    }
+}
@@ -0,0 +1,124 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.Linq;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Function
+{
+    /// <summary>
+    /// In-process tracker for function executions that are currently running
+    /// </summary>
+    public class ExecutionTracker : IExecutionTracker
+    {
+        private readonly ILogger<ExecutionTracker> _logger;
+        private readonly ConcurrentDictionary<Guid, ActiveExecution> _executions = new ConcurrentDictionary<Guid, ActiveExecution>();
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ExecutionTracker"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        public ExecutionTracker(ILogger<ExecutionTracker> logger)
+        {
+            _logger = logger;
+        }
+
+        /// <inheritdoc/>
+        public void Register(ActiveExecution execution)
+        {
+            if (execution == null)
+            {
+                throw new ArgumentNullException(nameof(execution));
+            }
+
+            execution.Status = "Running";
+            _executions[execution.ExecutionId] = execution;
+
+            _logger.LogDebug("Registered active execution: {ExecutionId}, FunctionId: {FunctionId}", execution.ExecutionId, execution.FunctionId);
+        }
+
+        /// <inheritdoc/>
+        public void UpdateMemoryUsage(Guid executionId, double memoryUsageMb)
+        {
+            if (_executions.TryGetValue(executionId, out var execution))
+            {
+                execution.MemoryUsageMb = memoryUsageMb;
+            }
+        }
+
+        /// <inheritdoc/>
+        public bool MarkKilled(Guid executionId, string killedBy)
+        {
+            if (!_executions.TryGetValue(executionId, out var execution))
+            {
+                return false;
+            }
+
+            lock (execution)
+            {
+                if (execution.Status == "Killing")
+                {
+                    return false;
+                }
+
+                execution.Status = "Killing";
+                execution.KilledBy = killedBy;
+                execution.KillRequestedAt = DateTime.UtcNow;
+            }
+
+            _logger.LogWarning("Kill requested for execution: {ExecutionId}, FunctionId: {FunctionId}, KilledBy: {KilledBy}", executionId, execution.FunctionId, killedBy);
+            return true;
+        }
+
+        /// <inheritdoc/>
+        public void ClearKill(Guid executionId)
+        {
+            if (!_executions.TryGetValue(executionId, out var execution))
+            {
+                return;
+            }
+
+            lock (execution)
+            {
+                if (execution.Status != "Killing")
+                {
+                    return;
+                }
+
+                execution.Status = "Running";
+                execution.KilledBy = null;
+                execution.KillRequestedAt = null;
+            }
+
+            _logger.LogWarning("Kill not delivered, execution still running: {ExecutionId}, FunctionId: {FunctionId}", executionId, execution.FunctionId);
+        }
+
+        /// <inheritdoc/>
+        public bool IsKilled(Guid executionId)
+        {
+            return _executions.TryGetValue(executionId, out var execution) && execution.Status == "Killing";
+        }
+
+        /// <inheritdoc/>
+        public ActiveExecution Complete(Guid executionId)
+        {
+            _executions.TryRemove(executionId, out var execution);
+            return execution;
+        }
+
+        /// <inheritdoc/>
+        public ActiveExecution Get(Guid executionId)
+        {
+            _executions.TryGetValue(executionId, out var execution);
+            return execution;
+        }
+
+        /// <inheritdoc/>
+        public IEnumerable<ActiveExecution> GetAll()
+        {
+            return _executions.Values.OrderBy(e => e.StartTime).ToList();
+        }
+    }
+}
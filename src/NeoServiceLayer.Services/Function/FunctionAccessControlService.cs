@@ -1,8 +1,10 @@
 using System;
+using System.Collections.Concurrent;
 using System.Collections.Generic;
 using System.Linq;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Enums;
 using NeoServiceLayer.Core.Interfaces;
 using NeoServiceLayer.Core.Models;
 
@@ -11,6 +13,10 @@ namespace NeoServiceLayer.Services.Function
     /// <summary>
     /// Service for managing function access control
     /// </summary>
+    /// <remarks>
+    /// Invoke grant rate limits are counted in memory with a fixed window per grant, so each API
+    /// instance allows up to the limit.
+    /// </remarks>
     public class FunctionAccessControlService : IFunctionAccessControlService
     {
         private readonly ILogger<FunctionAccessControlService> _logger;
@@ -18,6 +24,7 @@ namespace NeoServiceLayer.Services.Function
         private readonly IFunctionAccessPolicyRepository _policyRepository;
         private readonly IFunctionAccessRequestRepository _requestRepository;
         private readonly IFunctionRepository _functionRepository;
+        private readonly ConcurrentDictionary<Guid, InvokeWindow> _invokeWindows = new ConcurrentDictionary<Guid, InvokeWindow>();
 
         /// <summary>
         /// Initializes a new instance of the <see cref="FunctionAccessControlService"/> class
@@ -210,6 +217,7 @@ namespace NeoServiceLayer.Services.Function
 
             try
             {
+                _invokeWindows.TryRemove(permissionId, out _);
                 return await _permissionRepository.DeleteAsync(permissionId);
             }
             catch (Exception ex)
@@ -270,6 +278,111 @@ namespace NeoServiceLayer.Services.Function
             }
         }
 
+        /// <inheritdoc/>
+        public async Task<FunctionPermission> GrantInvokeAsync(Guid functionId, string principalId, string principalType, Guid grantedBy, int? rateLimit = null, int rateLimitPeriodSeconds = 60, DateTime? expiresAt = null)
+        {
+            _logger.LogInformation("Granting invoke permission to principal {PrincipalId} of type {PrincipalType} for function {FunctionId}", principalId, principalType, functionId);
+
+            if (string.IsNullOrEmpty(principalId))
+            {
+                throw new ArgumentException("Principal ID is required", nameof(principalId));
+            }
+
+            if (principalType != "user" && principalType != "apikey")
+            {
+                throw new ArgumentException("Principal type must be \"user\" or \"apikey\"", nameof(principalType));
+            }
+
+            if (rateLimit.HasValue && rateLimit.Value <= 0)
+            {
+                throw new ArgumentException("Rate limit must be greater than zero", nameof(rateLimit));
+            }
+
+            if (rateLimitPeriodSeconds <= 0)
+            {
+                throw new ArgumentException("Rate limit period must be greater than zero", nameof(rateLimitPeriodSeconds));
+            }
+
+            var function = await _functionRepository.GetByIdAsync(functionId);
+            if (function == null)
+            {
+                throw new ArgumentException($"Function not found: {functionId}", nameof(functionId));
+            }
+
+            var existingPermissions = await _permissionRepository.GetByFunctionIdAndPrincipalAsync(functionId, principalId, principalType);
+            var permission = existingPermissions.FirstOrDefault() ?? new FunctionPermission
+            {
+                Id = Guid.NewGuid(),
+                FunctionId = functionId,
+                PrincipalId = principalId,
+                PrincipalType = principalType,
+                PermissionLevel = "execute",
+                CreatedAt = DateTime.UtcNow,
+                CreatedBy = grantedBy
+            };
+            var isNew = !existingPermissions.Any();
+
+            // Keep a higher permission level the principal already holds
+            if (!IsOperationAllowedByPermissionLevel(permission.PermissionLevel ?? string.Empty, "execute") && !permission.AllowedOperations.Contains("execute"))
+            {
+                permission.AllowedOperations.Add("execute");
+            }
+
+            permission.DeniedOperations.Remove("execute");
+            permission.InvokeRateLimit = rateLimit;
+            permission.InvokeRateLimitPeriodSeconds = rateLimitPeriodSeconds;
+            permission.ExpiresAt = expiresAt;
+            permission.IsActive = true;
+            permission.UpdatedAt = DateTime.UtcNow;
+            permission.UpdatedBy = grantedBy;
+
+            // A changed limit starts a new window
+            _invokeWindows.TryRemove(permission.Id, out _);
+
+            return isNew
+                ? await _permissionRepository.CreateAsync(permission)
+                : await _permissionRepository.UpdateAsync(permission);
+        }
+
+        /// <inheritdoc/>
+        public async Task<InvokeAuthorizationResult> AuthorizeInvokeAsync(Guid functionId, string principalId, string principalType)
+        {
+            var function = await _functionRepository.GetByIdAsync(functionId);
+            if (function == null)
+            {
+                return InvokeAuthorizationResult.Denied;
+            }
+
+            if (principalType == "user" && function.AccountId.ToString() == principalId)
+            {
+                return InvokeAuthorizationResult.Allowed;
+            }
+
+            if (!await HasPermissionAsync(functionId, principalId, principalType, "execute"))
+            {
+                return InvokeAuthorizationResult.Denied;
+            }
+
+            var grants = (await _permissionRepository.GetByFunctionIdAndPrincipalAsync(functionId, principalId, principalType))
+                .Where(p => p.IsActive && (!p.ExpiresAt.HasValue || p.ExpiresAt.Value >= DateTime.UtcNow))
+                .ToList();
+
+            // An unlimited grant takes precedence over limited ones
+            var limitedGrant = grants.FirstOrDefault(p => p.InvokeRateLimit.HasValue);
+            if (limitedGrant == null || grants.Any(p => !p.InvokeRateLimit.HasValue))
+            {
+                return InvokeAuthorizationResult.Allowed;
+            }
+
+            if (!TryCountInvocation(limitedGrant))
+            {
+                _logger.LogWarning("Invoke rate limit reached for principal {PrincipalId} of type {PrincipalType} on function {FunctionId}", principalId, principalType, functionId);
+                return InvokeAuthorizationResult.RateLimited;
+            }
+
+            return InvokeAuthorizationResult.Allowed;
+        }
+
         /// <inheritdoc/>
         public async Task<FunctionAccessPolicy> CreateAccessPolicyAsync(FunctionAccessPolicy policy)
         {
@@ -613,6 +726,34 @@ namespace NeoServiceLayer.Services.Function
             }
         }
 
+        /// <summary>
+        /// Counts an invocation against a grant's rate limit
+        /// </summary>
+        /// <param name="grant">Grant with a rate limit</param>
+        /// <returns>True if the invocation fits in the current window, false otherwise</returns>
+        private bool TryCountInvocation(FunctionPermission grant)
+        {
+            var window = _invokeWindows.GetOrAdd(grant.Id, _ => new InvokeWindow { Start = DateTime.UtcNow });
+
+            lock (window)
+            {
+                var now = DateTime.UtcNow;
+                if (now - window.Start >= TimeSpan.FromSeconds(grant.InvokeRateLimitPeriodSeconds))
+                {
+                    window.Start = now;
+                    window.Count = 0;
+                }
+
+                if (window.Count >= grant.InvokeRateLimit.Value)
+                {
+                    return false;
+                }
+
+                window.Count++;
+                return true;
+            }
+        }
+
         /// <summary>
         /// Checks if an operation is allowed by a permission level
         /// </summary>
@@ -759,5 +900,12 @@ namespace NeoServiceLayer.Services.Function
             // Return the result based on the action
             return conditionMet ? rule.Action.ToLower() == "allow" : null;
         }
+
+        private class InvokeWindow
+        {
+            public DateTime Start { get; set; }
+
+            public int Count { get; set; }
+        }
     }
 }
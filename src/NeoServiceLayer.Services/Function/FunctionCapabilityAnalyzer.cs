@@ -0,0 +1,216 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Text;
+using System.Text.RegularExpressions;
+using NeoServiceLayer.Core.Enums;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Function
+{
+    /// <summary>
+    /// Finds the sandbox capabilities JavaScript and TypeScript function code uses
+    /// </summary>
+    /// <remarks>
+    /// The analysis matches SDK member names rather than resolving references, so it errs on the side of
+    /// reporting a capability: destructured and aliased calls are found by name, and code that reaches the
+    /// SDK dynamically (computed member access, <c>eval</c>, the native bridge) is reported as using every
+    /// capability. Comments are ignored; string literals are not, since they can name members.
+    /// </remarks>
+    public class FunctionCapabilityAnalyzer : IFunctionCapabilityAnalyzer
+    {
+        private const string DynamicAccessApi = "dynamic access";
+
+        private static readonly (string Capability, string Api, Regex Pattern)[] Rules =
+        {
+            (FunctionCapabilities.Transactions, "blockchain.invokeWrite", Compile(@"\binvokeWrite\b")),
+            (FunctionCapabilities.Transactions, "priceFeed.submitToOracle", Compile(@"\bsubmitToOracle\b")),
+            (FunctionCapabilities.Transactions, "nft.transfer", Compile(@"\bnft\s*(?:\.\s*|\[\s*['""`])transfer\b")),
+            // Aliasing the namespace (const { transfer } = neoService.nft) hides the call from the rule above
+            (FunctionCapabilities.Transactions, "nft", Compile(@"\bneoService\s*\.\s*nft\b(?!\s*\.)")),
+            (FunctionCapabilities.Staking, "staking.vote", Compile(@"\bstaking\s*(?:\.\s*|\[\s*['""`])vote\b")),
+            (FunctionCapabilities.Staking, "staking.wrapBneo", Compile(@"\bwrapBneo\b")),
+            (FunctionCapabilities.Staking, "staking.unwrapBneo", Compile(@"\bunwrapBneo\b")),
+            (FunctionCapabilities.Staking, "staking", Compile(@"\bneoService\s*\.\s*staking\b(?!\s*\.)")),
+            (FunctionCapabilities.Secrets, "secrets.getSecret", Compile(@"\bgetSecret\b")),
+            (FunctionCapabilities.Secrets, "secrets.getSecretById", Compile(@"\bgetSecretById\b")),
+            (FunctionCapabilities.Network, "fetch", Compile(@"\bfetch\s*\(")),
+            (FunctionCapabilities.Network, "XMLHttpRequest", Compile(@"\bXMLHttpRequest\b")),
+            (FunctionCapabilities.Network, "WebSocket", Compile(@"\bWebSocket\b")),
+            (FunctionCapabilities.Network, "network module", Compile(@"(?:\brequire\s*\(|\bimport\s*\(|\bfrom)\s*['""`](?:node:)?(?:http|https|http2|net|tls|dgram|dns|axios|node-fetch|undici|ws)['""`]")),
+            (FunctionCapabilities.Network, "events.registerBlockchainEvent", Compile(@"\bregisterBlockchainEvent\b")),
+            (FunctionCapabilities.Network, "events.registerTimeEvent", Compile(@"\bregisterTimeEvent\b"))
+        };
+
+        private static readonly Regex[] DynamicAccessPatterns =
+        {
+            Compile(@"\bneoService\s*\["),
+            Compile(@"\b_{1,2}callNativeFunction\b"),
+            Compile(@"\beval\s*\("),
+            Compile(@"\bFunction\s*\(")
+        };
+
+        /// <inheritdoc/>
+        public FunctionCapabilityReport Analyze(string runtime, string sourceCode, IEnumerable<string> declaredPermissions)
+        {
+            var report = new FunctionCapabilityReport
+            {
+                DeclaredPermissions = declaredPermissions?
+                    .Select(p => p.ToLowerInvariant())
+                    .Distinct()
+                    .OrderBy(p => p, StringComparer.Ordinal)
+                    .ToList()
+            };
+
+            if (!IsAnalyzable(runtime))
+            {
+                return report;
+            }
+
+            report.Analyzed = true;
+
+            var code = StripComments(sourceCode ?? string.Empty);
+            var lineStarts = GetLineStarts(code);
+
+            foreach (var (capability, api, pattern) in Rules)
+            {
+                foreach (Match match in pattern.Matches(code))
+                {
+                    AddUsage(report, capability, api, GetLine(lineStarts, match.Index));
+                }
+            }
+
+            foreach (var pattern in DynamicAccessPatterns)
+            {
+                foreach (Match match in pattern.Matches(code))
+                {
+                    var line = GetLine(lineStarts, match.Index);
+                    foreach (var capability in FunctionCapabilities.All)
+                    {
+                        AddUsage(report, capability, DynamicAccessApi, line);
+                    }
+                }
+            }
+
+            report.Usages = report.Usages.OrderBy(u => u.Line).ThenBy(u => u.Capability, StringComparer.Ordinal).ToList();
+            report.DetectedCapabilities = report.Usages
+                .Select(u => u.Capability)
+                .Distinct()
+                .OrderBy(c => c, StringComparer.Ordinal)
+                .ToList();
+
+            if (report.DeclaredPermissions != null)
+            {
+                report.UndeclaredCapabilities = report.DetectedCapabilities.Except(report.DeclaredPermissions).ToList();
+                report.UnusedPermissions = report.DeclaredPermissions.Except(report.DetectedCapabilities).ToList();
+            }
+
+            return report;
+        }
+
+        private static bool IsAnalyzable(string runtime)
+        {
+            return string.Equals(runtime, nameof(FunctionRuntime.JavaScript), StringComparison.OrdinalIgnoreCase) ||
+                string.Equals(runtime, nameof(FunctionRuntime.TypeScript), StringComparison.OrdinalIgnoreCase);
+        }
+
+        private static void AddUsage(FunctionCapabilityReport report, string capability, string api, int line)
+        {
+            if (report.Usages.Any(u => u.Capability == capability && u.Api == api && u.Line == line))
+            {
+                return;
+            }
+
+            report.Usages.Add(new FunctionCapabilityUsage { Capability = capability, Api = api, Line = line });
+        }
+
+        /// <summary>
+        /// Blanks out comments, keeping line breaks so match positions still map to source lines
+        /// </summary>
+        private static string StripComments(string code)
+        {
+            var builder = new StringBuilder(code.Length);
+            char? quote = null;
+            var i = 0;
+
+            while (i < code.Length)
+            {
+                var c = code[i];
+                var next = i + 1 < code.Length ? code[i + 1] : '\0';
+
+                if (quote != null)
+                {
+                    builder.Append(c);
+                    if (c == '\\' && i + 1 < code.Length)
+                    {
+                        builder.Append(next);
+                        i += 2;
+                        continue;
+                    }
+
+                    if (c == quote || (c == '\n' && quote != '`'))
+                    {
+                        quote = null;
+                    }
+
+                    i++;
+                }
+                else if (c == '/' && next == '/')
+                {
+                    while (i < code.Length && code[i] != '\n')
+                    {
+                        builder.Append(' ');
+                        i++;
+                    }
+                }
+                else if (c == '/' && next == '*')
+                {
+                    var end = code.IndexOf("*/", i + 2, StringComparison.Ordinal);
+                    end = end < 0 ? code.Length : end + 2;
+                    for (; i < end; i++)
+                    {
+                        builder.Append(code[i] == '\n' ? '\n' : ' ');
+                    }
+                }
+                else
+                {
+                    if (c == '"' || c == '\'' || c == '`')
+                    {
+                        quote = c;
+                    }
+
+                    builder.Append(c);
+                    i++;
+                }
+            }
+
+            return builder.ToString();
+        }
+
+        private static List<int> GetLineStarts(string code)
+        {
+            var starts = new List<int> { 0 };
+            for (var i = 0; i < code.Length; i++)
+            {
+                if (code[i] == '\n')
+                {
+                    starts.Add(i + 1);
+                }
+            }
+
+            return starts;
+        }
+
+        private static int GetLine(List<int> lineStarts, int index)
+        {
+            var position = lineStarts.BinarySearch(index);
+            return (position >= 0 ? position : ~position - 1) + 1;
+        }
+
+        private static Regex Compile(string pattern)
+        {
+            return new Regex(pattern, RegexOptions.Compiled | RegexOptions.CultureInvariant);
+        }
+    }
+}
@@ -4,6 +4,7 @@ using System.Linq;
 using System.Text.Json;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Enums;
 using NeoServiceLayer.Core.Interfaces;
 using NeoServiceLayer.Core.Models;
 
@@ -20,6 +21,7 @@ namespace NeoServiceLayer.Services.Function
         private readonly IFunctionRepository _functionRepository;
         private readonly IFunctionService _functionService;
         private readonly IFunctionExecutor _functionExecutor;
+        private readonly IFunctionAccessControlService _accessControlService;
 
         /// <summary>
         /// Initializes a new instance of the <see cref="FunctionCompositionService"/> class
@@ -30,13 +32,15 @@ namespace NeoServiceLayer.Services.Function
         /// <param name="functionRepository">Function repository</param>
         /// <param name="functionService">Function service</param>
         /// <param name="functionExecutor">Function executor</param>
+        /// <param name="accessControlService">Access control service checking steps that invoke other accounts' functions</param>
         public FunctionCompositionService(
             ILogger<FunctionCompositionService> logger,
             IFunctionCompositionRepository compositionRepository,
             IFunctionCompositionExecutionRepository executionRepository,
             IFunctionRepository functionRepository,
             IFunctionService functionService,
-            IFunctionExecutor functionExecutor)
+            IFunctionExecutor functionExecutor,
+            IFunctionAccessControlService accessControlService = null)
         {
             _logger = logger;
             _compositionRepository = compositionRepository;
@@ -44,6 +48,7 @@ namespace NeoServiceLayer.Services.Function
             _functionRepository = functionRepository;
             _functionService = functionService;
             _functionExecutor = functionExecutor;
+            _accessControlService = accessControlService;
         }
 
         /// <inheritdoc/>
@@ -1017,6 +1022,8 @@ namespace NeoServiceLayer.Services.Function
                         throw new Exception($"Function not found: {step.FunctionId}");
                     }
 
+                    await EnsureCanInvokeAsync(composition, function);
+
                     // Create execution context
                     var executionContext = new FunctionExecutionContext
                     {
@@ -1195,6 +1202,8 @@ namespace NeoServiceLayer.Services.Function
                     throw new Exception($"Function not found: {step.FunctionId}");
                 }
 
+                await EnsureCanInvokeAsync(composition, function);
+
                 // Create execution context
                 var executionContext = new FunctionExecutionContext
                 {
@@ -1265,6 +1274,34 @@ namespace NeoServiceLayer.Services.Function
             }
         }
 
+        /// <summary>
+        /// Checks that a composition may invoke a step's function
+        /// </summary>
+        /// <param name="composition">Composition running the step</param>
+        /// <param name="function">Function of the step</param>
+        /// <remarks>Functions of other accounts need an invoke grant to the composition's account</remarks>
+        private async Task EnsureCanInvokeAsync(FunctionComposition composition, Core.Models.Function function)
+        {
+            if (function.AccountId == composition.AccountId)
+            {
+                return;
+            }
+
+            var authorization = _accessControlService == null
+                ? InvokeAuthorizationResult.Denied
+                : await _accessControlService.AuthorizeInvokeAsync(function.Id, composition.AccountId.ToString(), "user");
+
+            if (authorization == InvokeAuthorizationResult.RateLimited)
+            {
+                throw new Exception($"Invoke rate limit reached for function: {function.Id}");
+            }
+
+            if (authorization != InvokeAuthorizationResult.Allowed)
+            {
+                throw new Exception($"Not allowed to invoke function: {function.Id}");
+            }
+        }
+
         /// <summary>
         /// Waits for dependencies to complete
         /// </summary>
@@ -0,0 +1,78 @@
+using System;
+using System.Linq;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Function
+{
+    /// <summary>
+    /// Fails executions that were still running when the previous process stopped
+    /// </summary>
+    /// <remarks>
+    /// The sandbox that ran them is gone and their side effects may be partial, so they are failed rather than
+    /// rerun; callers retry them like any other failed execution.
+    /// </remarks>
+    public class FunctionExecutionRecoveryStep : IStartupRecoveryStep
+    {
+        private const int BatchSize = 100;
+
+        private readonly IFunctionExecutionRepository _executionRepository;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="FunctionExecutionRecoveryStep"/> class
+        /// </summary>
+        /// <param name="executionRepository">Function execution repository</param>
+        public FunctionExecutionRecoveryStep(IFunctionExecutionRepository executionRepository)
+        {
+            _executionRepository = executionRepository;
+        }
+
+        /// <inheritdoc/>
+        public string Name => "FunctionExecutions";
+
+        /// <inheritdoc/>
+        public async Task<StartupRecoveryStepResult> RecoverAsync(DateTime processStartTime)
+        {
+            var result = new StartupRecoveryStepResult();
+
+            // Failed executions drop out of the Running query, so only the ones left in place move the offset
+            var offset = 0;
+            while (true)
+            {
+                var running = (await _executionRepository.GetByStatusAsync("Running", BatchSize, offset)).ToList();
+                if (running.Count == 0)
+                {
+                    break;
+                }
+
+                foreach (var execution in running)
+                {
+                    if (execution.StartTime >= processStartTime)
+                    {
+                        offset++;
+                        continue;
+                    }
+
+                    execution.Status = "Failed";
+                    execution.Error = "Execution was interrupted by a service restart";
+                    execution.ErrorCode = FunctionErrorCodes.InternalError;
+                    execution.EndTime = DateTime.UtcNow;
+                    execution.DurationMs = (execution.EndTime.Value - execution.StartTime).TotalMilliseconds;
+
+                    await _executionRepository.UpdateAsync(execution.Id, execution);
+
+                    result.Resolved++;
+                    result.Details.Add($"Execution {execution.Id} of function {execution.FunctionId} failed as interrupted");
+                }
+
+                if (running.Count < BatchSize)
+                {
+                    break;
+                }
+            }
+
+            return result;
+        }
+    }
+}
@@ -19,6 +19,7 @@ namespace NeoServiceLayer.Services.Function
         private readonly IFunctionMetricsRepository _metricsRepository;
         private readonly IFunctionMonitoringSettingsRepository _monitoringSettingsRepository;
         private readonly IFunctionRepository _functionRepository;
+        private readonly IExecutionDataProtector _dataProtector;
 
         /// <summary>
         /// Initializes a new instance of the <see cref="FunctionMonitoringService"/> class
@@ -29,13 +30,15 @@ namespace NeoServiceLayer.Services.Function
         /// <param name="metricsRepository">Metrics repository</param>
         /// <param name="monitoringSettingsRepository">Monitoring settings repository</param>
         /// <param name="functionRepository">Function repository</param>
+        /// <param name="dataProtector">Protector encrypting log messages at rest</param>
         public FunctionMonitoringService(
             ILogger<FunctionMonitoringService> logger,
             IFunctionExecutionRepository executionRepository,
             IFunctionLogRepository logRepository,
             IFunctionMetricsRepository metricsRepository,
             IFunctionMonitoringSettingsRepository monitoringSettingsRepository,
-            IFunctionRepository functionRepository)
+            IFunctionRepository functionRepository,
+            IExecutionDataProtector dataProtector = null)
         {
             _logger = logger;
             _executionRepository = executionRepository;
@@ -43,6 +46,7 @@ namespace NeoServiceLayer.Services.Function
             _metricsRepository = metricsRepository;
             _monitoringSettingsRepository = monitoringSettingsRepository;
             _functionRepository = functionRepository;
+            _dataProtector = dataProtector;
         }
 
         /// <inheritdoc/>
@@ -58,6 +62,7 @@ namespace NeoServiceLayer.Services.Function
                     Id = execution.Id,
                     ExecutionId = execution.Id,
                     FunctionId = execution.FunctionId,
+                    AccountId = execution.AccountId,
                     Status = execution.Status,
                     Output = execution.Output,
                     Error = execution.ErrorMessage,
@@ -116,7 +121,16 @@ namespace NeoServiceLayer.Services.Function
                     Timestamp = timestamp
                 };
 
-                return await _logRepository.CreateAsync(log);
+                if (_dataProtector == null)
+                {
+                    return await _logRepository.CreateAsync(log);
+                }
+
+                var function = await _functionRepository.GetByIdAsync(functionId);
+                log.AccountId = function?.AccountId ?? Guid.Empty;
+
+                await _logRepository.CreateAsync(await _dataProtector.ProtectLogAsync(log));
+                return log;
             }
             catch (Exception ex)
             {
@@ -235,7 +249,19 @@ namespace NeoServiceLayer.Services.Function
 
             try
             {
-                return await _logRepository.GetByExecutionIdAsync(executionId, limit, offset);
+                var logs = await _logRepository.GetByExecutionIdAsync(executionId, limit, offset);
+                if (_dataProtector == null)
+                {
+                    return logs;
+                }
+
+                var results = new List<FunctionLog>();
+                foreach (var log in logs)
+                {
+                    results.Add(await _dataProtector.UnprotectLogAsync(log));
+                }
+
+                return results;
             }
             catch (Exception ex)
             {
@@ -0,0 +1,160 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Function
+{
+    /// <summary>
+    /// Records sandbox security events and disables functions that exceed anomaly thresholds
+    /// </summary>
+    /// <remarks>
+    /// A disabled function gets the status "PendingReview". It cannot run, and its owner cannot activate it again;
+    /// only an administrator can clear it, after which earlier events no longer count.
+    /// </remarks>
+    public class FunctionSecurityMonitor : IFunctionSecurityMonitor
+    {
+        /// <summary>
+        /// Status of a function disabled pending a security review
+        /// </summary>
+        public const string PendingReviewStatus = "PendingReview";
+
+        private readonly ILogger<FunctionSecurityMonitor> _logger;
+        private readonly IFunctionSecurityEventRepository _eventRepository;
+        private readonly IFunctionRepository _functionRepository;
+        private readonly FunctionSecurityConfiguration _configuration;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="FunctionSecurityMonitor"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="eventRepository">Security event repository</param>
+        /// <param name="functionRepository">Function repository</param>
+        /// <param name="configuration">Security configuration</param>
+        public FunctionSecurityMonitor(
+            ILogger<FunctionSecurityMonitor> logger,
+            IFunctionSecurityEventRepository eventRepository,
+            IFunctionRepository functionRepository,
+            IOptions<FunctionSecurityConfiguration> configuration = null)
+        {
+            _logger = logger;
+            _eventRepository = eventRepository;
+            _functionRepository = functionRepository;
+            _configuration = configuration?.Value ?? new FunctionSecurityConfiguration();
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> RecordAsync(Core.Models.Function function, Guid executionId, IEnumerable<FunctionSecurityEvent> events)
+        {
+            var recorded = events?.ToList() ?? new List<FunctionSecurityEvent>();
+            if (!_configuration.Enabled || recorded.Count == 0)
+            {
+                return false;
+            }
+
+            foreach (var securityEvent in recorded)
+            {
+                // The sandbox reports what happened; who it happened to comes from the host's own records
+                securityEvent.Id = Guid.NewGuid();
+                securityEvent.FunctionId = function.Id;
+                securityEvent.AccountId = function.AccountId;
+                securityEvent.ExecutionId = executionId;
+                if (securityEvent.Timestamp == default)
+                {
+                    securityEvent.Timestamp = DateTime.UtcNow;
+                }
+
+                _logger.LogWarning("Security event {Type} in execution {ExecutionId} of function {FunctionId} owned by {AccountId}: {Target} {Detail}",
+                    securityEvent.Type, executionId, function.Id, function.AccountId, securityEvent.Target, securityEvent.Detail);
+
+                await _eventRepository.CreateAsync(securityEvent);
+            }
+
+            if (!_configuration.AutoDisable || function.Status != "Active")
+            {
+                return false;
+            }
+
+            var since = GetWindowStart(function);
+            var counts = (await _eventRepository.GetByFunctionIdAsync(function.Id, since))
+                .GroupBy(e => e.Type)
+                .ToDictionary(g => g.Key, g => g.Count());
+
+            var exceeded = counts.Where(c => c.Value > _configuration.GetThreshold(c.Key)).Select(c => c.Key).ToList();
+            if (exceeded.Count == 0)
+            {
+                return false;
+            }
+
+            _logger.LogWarning("Disabling function {FunctionId} owned by {AccountId} pending review: {Types} over threshold in the last {WindowMinutes} minutes",
+                function.Id, function.AccountId, string.Join(", ", exceeded), _configuration.WindowMinutes);
+
+            function.Status = PendingReviewStatus;
+            function.UpdatedAt = DateTime.UtcNow;
+            await _functionRepository.UpdateAsync(function.Id, function);
+
+            return true;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<FunctionSecurityEvent>> GetEventsAsync(Guid functionId)
+        {
+            return await _eventRepository.GetByFunctionIdAsync(functionId, DateTime.UtcNow.AddMinutes(-_configuration.WindowMinutes));
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<FunctionSecuritySummary>> GetSummariesAsync(Guid accountId)
+        {
+            var events = await _eventRepository.GetByAccountIdAsync(accountId, DateTime.UtcNow.AddMinutes(-_configuration.WindowMinutes));
+
+            return events
+                .GroupBy(e => e.FunctionId)
+                .Select(g => new FunctionSecuritySummary
+                {
+                    FunctionId = g.Key,
+                    AccountId = accountId,
+                    Counts = g.GroupBy(e => e.Type).ToDictionary(t => t.Key, t => t.Count()),
+                    Total = g.Count(),
+                    LastEventAt = g.Max(e => e.Timestamp)
+                })
+                .OrderByDescending(s => s.Total)
+                .ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<Core.Models.Function> ApproveAsync(Guid functionId, string reviewedBy)
+        {
+            var function = await _functionRepository.GetByIdAsync(functionId);
+            if (function == null)
+            {
+                throw new FunctionException("Function not found");
+            }
+
+            if (function.Status != PendingReviewStatus)
+            {
+                throw new FunctionException("Function is not pending a security review");
+            }
+
+            _logger.LogWarning("Function {FunctionId} cleared after security review by {ReviewedBy}", functionId, reviewedBy);
+
+            function.Status = "Active";
+            function.SecurityReviewedAt = DateTime.UtcNow;
+            function.UpdatedAt = DateTime.UtcNow;
+
+            return await _functionRepository.UpdateAsync(function.Id, function);
+        }
+
+        private DateTime GetWindowStart(Core.Models.Function function)
+        {
+            var windowStart = DateTime.UtcNow.AddMinutes(-_configuration.WindowMinutes);
+            return function.SecurityReviewedAt.HasValue && function.SecurityReviewedAt.Value > windowStart
+                ? function.SecurityReviewedAt.Value
+                : windowStart;
+        }
+    }
+}
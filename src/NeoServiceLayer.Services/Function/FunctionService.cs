@@ -3,8 +3,11 @@ using System.Collections.Generic;
 using System.Linq;
 using System.Security.Cryptography;
 using System.Text;
+using System.Threading;
 using System.Threading.Tasks;
+using Microsoft.Extensions.DependencyInjection;
 using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
 using NeoServiceLayer.Core;
 using NeoServiceLayer.Core.Enums;
 using NeoServiceLayer.Core.Exceptions;
@@ -19,13 +22,36 @@ namespace NeoServiceLayer.Services.Function
     /// <summary>
     /// Implementation of the function service
     /// </summary>
-    public class FunctionService : IFunctionService
+    public class FunctionService : IFunctionService, IManagedService
     {
         private readonly ILogger<FunctionService> _logger;
         private readonly IFunctionRepository _functionRepository;
         private readonly IFunctionExecutionRepository _executionRepository;
         private readonly IEnclaveService _enclaveService;
         private readonly ISecretsService _secretsService;
+        private readonly IExecutionTracker _executionTracker;
+        private readonly IFunctionBuildService _buildService;
+        private readonly IFunctionCapabilityAnalyzer _capabilityAnalyzer;
+        private readonly IServiceScopeFactory _scopeFactory;
+        private readonly IFunctionSecurityMonitor _securityMonitor;
+        private readonly IServerEventPublisher _eventPublisher;
+        private readonly AccountLimitsConfiguration _limits;
+        private readonly IGasBankService _gasBankService;
+        private readonly FunctionMeteringConfiguration _metering;
+        private readonly IQuorumExecutionService _quorumExecutionService;
+        private readonly IExecutionScheduler _executionScheduler;
+        private readonly IFunctionUsageService _usageService;
+        private readonly IGasPriceOracleService _gasPriceOracle;
+        private readonly SemaphoreSlim _updateSemaphore = new SemaphoreSlim(1, 1);
+        private volatile bool _stopped;
+
+        // How often a stop checks whether the running executions have finished
+        private static readonly TimeSpan StopPollInterval = TimeSpan.FromMilliseconds(100);
+
+        /// <summary>
+        /// File name TypeScript sources are built and reported under
+        /// </summary>
+        private const string TypeScriptFileName = "index.ts";
 
         /// <summary>
         /// Initializes a new instance of the <see cref="FunctionService"/> class
@@ -35,22 +61,61 @@ namespace NeoServiceLayer.Services.Function
         /// <param name="executionRepository">Function execution repository</param>
         /// <param name="enclaveService">Enclave service</param>
         /// <param name="secretsService">Secrets service</param>
+        /// <param name="executionTracker">Tracker for running executions</param>
+        /// <param name="buildService">Build service for TypeScript sources</param>
+        /// <param name="limits">Per-account limits</param>
+        /// <param name="capabilityAnalyzer">Analyzer checking function code against its declared permissions</param>
+        /// <param name="scopeFactory">Scope factory used to resolve the scoped price feed access service</param>
+        /// <param name="securityMonitor">Monitor recording sandbox security events</param>
+        /// <param name="eventPublisher">Publisher of execution events to event stream subscribers</param>
+        /// <param name="gasBankService">GasBank service metered executions are charged to</param>
+        /// <param name="metering">Execution metering configuration; executions are unmetered when omitted</param>
+        /// <param name="quorumExecutionService">Quorum execution service; functions with a quorum policy run on the local enclave only when omitted</param>
+        /// <param name="executionScheduler">Scheduler sharing execution slots between tiers; executions start at once when omitted</param>
+        /// <param name="usageService">Service accumulating daily usage reports; executions are not reported when omitted</param>
+        /// <param name="gasPriceOracle">Cross-chain gas price oracle; the sandbox sees no gas prices when omitted</param>
         public FunctionService(
             ILogger<FunctionService> logger,
             IFunctionRepository functionRepository,
             IFunctionExecutionRepository executionRepository,
             IEnclaveService enclaveService,
-            ISecretsService secretsService)
+            ISecretsService secretsService,
+            IExecutionTracker executionTracker = null,
+            IFunctionBuildService buildService = null,
+            IOptions<AccountLimitsConfiguration> limits = null,
+            IFunctionCapabilityAnalyzer capabilityAnalyzer = null,
+            IServiceScopeFactory scopeFactory = null,
+            IFunctionSecurityMonitor securityMonitor = null,
+            IServerEventPublisher eventPublisher = null,
+            IGasBankService gasBankService = null,
+            IOptions<FunctionMeteringConfiguration> metering = null,
+            IQuorumExecutionService quorumExecutionService = null,
+            IExecutionScheduler executionScheduler = null,
+            IFunctionUsageService usageService = null,
+            IGasPriceOracleService gasPriceOracle = null)
         {
             _logger = logger;
             _functionRepository = functionRepository;
             _executionRepository = executionRepository;
             _enclaveService = enclaveService;
             _secretsService = secretsService;
+            _executionTracker = executionTracker;
+            _buildService = buildService;
+            _limits = limits?.Value ?? new AccountLimitsConfiguration();
+            _capabilityAnalyzer = capabilityAnalyzer ?? new FunctionCapabilityAnalyzer();
+            _scopeFactory = scopeFactory;
+            _securityMonitor = securityMonitor;
+            _eventPublisher = eventPublisher;
+            _gasBankService = gasBankService;
+            _metering = metering?.Value ?? new FunctionMeteringConfiguration();
+            _quorumExecutionService = quorumExecutionService;
+            _executionScheduler = executionScheduler;
+            _usageService = usageService;
+            _gasPriceOracle = gasPriceOracle;
         }
 
         /// <inheritdoc/>
-        public async Task<Core.Models.Function> CreateFunctionAsync(
+        public Task<Core.Models.Function> CreateFunctionAsync(
             string name,
             string description,
             FunctionRuntime runtime,
@@ -61,6 +126,23 @@ namespace NeoServiceLayer.Services.Function
             int maxMemory = 128,
             List<Guid> secretIds = null,
             Dictionary<string, string> environmentVariables = null)
+        {
+            return CreateFunctionAsync(name, description, runtime, sourceCode, entryPoint, accountId, maxExecutionTime, maxMemory, secretIds, environmentVariables, null);
+        }
+
+        /// <inheritdoc/>
+        public async Task<Core.Models.Function> CreateFunctionAsync(
+            string name,
+            string description,
+            FunctionRuntime runtime,
+            string sourceCode,
+            string entryPoint,
+            Guid accountId,
+            int maxExecutionTime,
+            int maxMemory,
+            List<Guid> secretIds,
+            Dictionary<string, string> environmentVariables,
+            List<string> permissions)
         {
             var requestId = Guid.NewGuid().ToString();
             var additionalData = new Dictionary<string, object>
@@ -84,6 +166,19 @@ namespace NeoServiceLayer.Services.Function
                 Common.Utilities.ValidationUtility.ValidateGuid(accountId, "Account ID");
                 Common.Utilities.ValidationUtility.ValidateGreaterThanZero(maxExecutionTime, "Max execution time");
                 Common.Utilities.ValidationUtility.ValidateGreaterThanZero(maxMemory, "Max memory");
+                ValidateSandboxLimits(maxExecutionTime, maxMemory);
+
+                var functionCount = await _functionRepository.CountByAccountIdAsync(accountId);
+                if (functionCount >= _limits.MaxFunctions)
+                {
+                    throw new FunctionException($"Function limit of {_limits.MaxFunctions} reached for account");
+                }
+
+                permissions = NormalizePermissions(permissions);
+                var capabilities = EnsureWithinPermissions(runtime.ToString(), sourceCode, permissions);
+
+                // Build before touching the enclave so build errors reach the caller
+                var build = await BuildIfRequiredAsync(runtime.ToString(), sourceCode);
 
                 var result = await Common.Utilities.ExceptionUtility.ExecuteWithExceptionHandlingAsync<FunctionService, Core.Models.Function>(
                     _logger,
@@ -129,12 +224,16 @@ namespace NeoServiceLayer.Services.Function
                             Description = description,
                             Runtime = runtime.ToString(),
                             SourceCode = sourceCode,
+                            CompiledCode = build?.CompiledCode,
+                            SourceMap = build?.SourceMap,
                             EntryPoint = entryPoint,
                             AccountId = accountId,
                             MaxExecutionTime = maxExecutionTime,
                             MaxMemory = maxMemory,
                             SecretIds = secretIds ?? new List<Guid>(),
                             EnvironmentVariables = environmentVariables ?? new Dictionary<string, string>(),
+                            Permissions = permissions,
+                            DetectedCapabilities = capabilities.DetectedCapabilities,
                             CreatedAt = DateTime.UtcNow,
                             UpdatedAt = DateTime.UtcNow,
                             Status = "Active"
@@ -142,14 +241,14 @@ namespace NeoServiceLayer.Services.Function
 
                         additionalData["FunctionId"] = function.Id;
 
-                        // Send function creation request to enclave
+                        // Send function creation request to enclave; built functions run as JavaScript
                         var functionRequest = new
                         {
                             Id = function.Id,
                             Name = function.Name,
                             Description = function.Description,
-                            Runtime = function.Runtime,
-                            SourceCode = function.SourceCode,
+                            Runtime = build != null ? nameof(FunctionRuntime.JavaScript) : function.Runtime,
+                            SourceCode = function.CompiledCode ?? function.SourceCode,
                             EntryPoint = function.EntryPoint,
                             AccountId = function.AccountId,
                             MaxExecutionTime = function.MaxExecutionTime,
@@ -181,6 +280,11 @@ namespace NeoServiceLayer.Services.Function
 
                 return result.result;
             }
+            catch (FunctionPermissionException ex)
+            {
+                Common.Utilities.LoggingUtility.LogOperationFailure(_logger, "CreateFunction", requestId, ex, 0, additionalData);
+                throw;
+            }
             catch (Exception ex)
             {
                 Common.Utilities.LoggingUtility.LogOperationFailure(_logger, "CreateFunction", requestId, ex, 0, additionalData);
@@ -395,50 +499,76 @@ namespace NeoServiceLayer.Services.Function
                 Common.Utilities.ValidationUtility.ValidateNotNullOrEmpty(function.EntryPoint, "Entry point");
                 Common.Utilities.ValidationUtility.ValidateGreaterThanZero(function.MaxExecutionTime, "Max execution time");
                 Common.Utilities.ValidationUtility.ValidateGreaterThanZero(function.MaxMemory, "Max memory");
+                ValidateSandboxLimits(function.MaxExecutionTime, function.MaxMemory);
 
-                var result = await Common.Utilities.ExceptionUtility.ExecuteWithExceptionHandlingAsync<FunctionService, Core.Models.Function>(
-                    _logger,
-                    async () =>
+                // Compare the revision and write under one lock
+                await _updateSemaphore.WaitAsync();
+                try
+                {
+                    var current = await _functionRepository.GetByIdAsync(function.Id);
+                    if (current != null && current.Revision != function.Revision)
                     {
-                        var existingFunction = await _functionRepository.GetByIdAsync(function.Id);
-                        if (existingFunction == null)
-                        {
-                            throw new FunctionException("Function not found");
-                        }
+                        throw new ConcurrencyConflictException("Function", function.Id.ToString(), current.Revision);
+                    }
 
-                        // Update function in enclave
-                        var updateRequest = new
+                    var result = await Common.Utilities.ExceptionUtility.ExecuteWithExceptionHandlingAsync<FunctionService, Core.Models.Function>(
+                        _logger,
+                        async () =>
                         {
-                            Id = function.Id,
-                            Name = function.Name,
-                            Description = function.Description,
-                            EntryPoint = function.EntryPoint,
-                            MaxExecutionTime = function.MaxExecutionTime,
-                            MaxMemory = function.MaxMemory,
-                            EnvironmentVariables = function.EnvironmentVariables,
-                            Status = function.Status
-                        };
+                            var existingFunction = await _functionRepository.GetByIdAsync(function.Id);
+                            if (existingFunction == null)
+                            {
+                                throw new FunctionException("Function not found");
+                            }
 
-                        await _enclaveService.SendRequestAsync<object, object>(
-                            Constants.EnclaveServiceTypes.Function,
-                            Constants.FunctionOperations.UpdateFunction,
-                            updateRequest);
+                            // Update function in enclave
+                            var updateRequest = new
+                            {
+                                Id = function.Id,
+                                Name = function.Name,
+                                Description = function.Description,
+                                EntryPoint = function.EntryPoint,
+                                MaxExecutionTime = function.MaxExecutionTime,
+                                MaxMemory = function.MaxMemory,
+                                EnvironmentVariables = function.EnvironmentVariables,
+                                Status = function.Status
+                            };
+
+                            await _enclaveService.SendRequestAsync<object, object>(
+                                Constants.EnclaveServiceTypes.Function,
+                                Constants.FunctionOperations.UpdateFunction,
+                                updateRequest);
+
+                            // Permissions are only changed through UpdatePermissionsAsync, which checks them against the code,
+                            // and the quorum policy through the quorum execution service
+                            function.Permissions = existingFunction.Permissions;
+                            function.Quorum = existingFunction.Quorum;
+                            function.DetectedCapabilities = existingFunction.DetectedCapabilities;
+                            function.UpdatedAt = DateTime.UtcNow;
+                            function.Revision = existingFunction.Revision + 1;
+                            return await _functionRepository.UpdateAsync(function.Id, function);
+                        },
+                        "UpdateFunction",
+                        requestId,
+                        additionalData);
+
+                    if (!result.success)
+                    {
+                        throw new FunctionException("Failed to update function");
+                    }
 
-                        function.UpdatedAt = DateTime.UtcNow;
-                        return await _functionRepository.UpdateAsync(function.Id, function);
-                    },
-                    "UpdateFunction",
-                    requestId,
-                    additionalData);
+                    Common.Utilities.LoggingUtility.LogOperationSuccess(_logger, "UpdateFunction", requestId, 0, additionalData);
 
-                if (!result.success)
+                    return result.result;
+                }
+                finally
                 {
-                    throw new FunctionException("Failed to update function");
+                    _updateSemaphore.Release();
                 }
-
-                Common.Utilities.LoggingUtility.LogOperationSuccess(_logger, "UpdateFunction", requestId, 0, additionalData);
-
-                return result.result;
+            }
+            catch (ConcurrencyConflictException)
+            {
+                throw;
             }
             catch (Exception ex)
             {
@@ -465,6 +595,13 @@ namespace NeoServiceLayer.Services.Function
                 Common.Utilities.ValidationUtility.ValidateGuid(id, "Function ID");
                 Common.Utilities.ValidationUtility.ValidateNotNullOrEmpty(sourceCode, "Source code");
 
+                // Build before touching the enclave so build errors reach the caller
+                var existingFunction = await _functionRepository.GetByIdAsync(id);
+                var capabilities = existingFunction != null
+                    ? EnsureWithinPermissions(existingFunction.Runtime, sourceCode, existingFunction.Permissions)
+                    : null;
+                var build = existingFunction != null ? await BuildIfRequiredAsync(existingFunction.Runtime, sourceCode) : null;
+
                 var result = await Common.Utilities.ExceptionUtility.ExecuteWithExceptionHandlingAsync<FunctionService, Core.Models.Function>(
                     _logger,
                     async () =>
@@ -486,7 +623,7 @@ namespace NeoServiceLayer.Services.Function
                         var updateRequest = new
                         {
                             Id = id,
-                            SourceCode = sourceCode
+                            SourceCode = build?.CompiledCode ?? sourceCode
                         };
 
                         await _enclaveService.SendRequestAsync<object, object>(
@@ -495,7 +632,11 @@ namespace NeoServiceLayer.Services.Function
                             updateRequest);
 
                         function.SourceCode = sourceCode;
+                        function.CompiledCode = build?.CompiledCode;
+                        function.SourceMap = build?.SourceMap;
+                        function.DetectedCapabilities = capabilities?.DetectedCapabilities ?? function.DetectedCapabilities;
                         function.UpdatedAt = DateTime.UtcNow;
+                        function.Revision++;
                         return await _functionRepository.UpdateAsync(function.Id, function);
                     },
                     "UpdateFunctionSourceCode",
@@ -511,6 +652,11 @@ namespace NeoServiceLayer.Services.Function
 
                 return result.result;
             }
+            catch (FunctionPermissionException ex)
+            {
+                Common.Utilities.LoggingUtility.LogOperationFailure(_logger, "UpdateFunctionSourceCode", requestId, ex, 0, additionalData);
+                throw;
+            }
             catch (Exception ex)
             {
                 Common.Utilities.LoggingUtility.LogOperationFailure(_logger, "UpdateFunctionSourceCode", requestId, ex, 0, additionalData);
@@ -518,6 +664,39 @@ namespace NeoServiceLayer.Services.Function
             }
         }
 
+        /// <inheritdoc/>
+        public async Task<Core.Models.Function> UpdatePermissionsAsync(Guid id, List<string> permissions)
+        {
+            Common.Utilities.ValidationUtility.ValidateGuid(id, "Function ID");
+
+            var function = await _functionRepository.GetByIdAsync(id);
+            if (function == null)
+            {
+                throw new FunctionException("Function not found");
+            }
+
+            permissions = NormalizePermissions(permissions);
+            var capabilities = EnsureWithinPermissions(function.Runtime, function.SourceCode, permissions);
+
+            _logger.LogInformation("Updating permissions of function {FunctionId} to {Permissions}",
+                id, permissions == null ? "unrestricted" : string.Join(", ", permissions));
+
+            function.Permissions = permissions;
+            function.DetectedCapabilities = capabilities.DetectedCapabilities;
+            function.UpdatedAt = DateTime.UtcNow;
+            function.Revision++;
+            return await _functionRepository.UpdateAsync(function.Id, function);
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionCapabilityReport> GetCapabilityReportAsync(Guid id)
+        {
+            var function = await _functionRepository.GetByIdAsync(id);
+            return function == null
+                ? null
+                : _capabilityAnalyzer.Analyze(function.Runtime, function.SourceCode, function.Permissions);
+        }
+
         /// <inheritdoc/>
         public async Task<Core.Models.Function> UpdateEnvironmentVariablesAsync(Guid id, Dictionary<string, string> environmentVariables)
         {
@@ -563,6 +742,7 @@ namespace NeoServiceLayer.Services.Function
 
                         function.EnvironmentVariables = environmentVariables;
                         function.UpdatedAt = DateTime.UtcNow;
+                        function.Revision++;
                         return await _functionRepository.UpdateAsync(function.Id, function);
                     },
                     "UpdateFunctionEnvironmentVariables",
@@ -585,6 +765,66 @@ namespace NeoServiceLayer.Services.Function
             }
         }
 
+        /// <inheritdoc/>
+        public async Task<Core.Models.Function> UpdateTagsAsync(Guid id, List<string> tags)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["Id"] = id,
+                ["TagCount"] = tags?.Count ?? 0
+            };
+
+            Common.Utilities.LoggingUtility.LogOperationStart(_logger, "UpdateFunctionTags", requestId, additionalData);
+
+            try
+            {
+                // Validate input
+                Common.Utilities.ValidationUtility.ValidateGuid(id, "Function ID");
+                var normalizedTags = tags.NormalizeTags();
+
+                var result = await Common.Utilities.ExceptionUtility.ExecuteWithExceptionHandlingAsync<FunctionService, Core.Models.Function>(
+                    _logger,
+                    async () =>
+                    {
+                        var function = await _functionRepository.GetByIdAsync(id);
+                        if (function == null)
+                        {
+                            throw new FunctionException("Function not found");
+                        }
+
+                        additionalData["Name"] = function.Name;
+
+                        // Tags are host metadata, so the enclave is not involved
+                        function.Tags = normalizedTags;
+                        function.UpdatedAt = DateTime.UtcNow;
+                        function.Revision++;
+                        return await _functionRepository.UpdateAsync(function.Id, function);
+                    },
+                    "UpdateFunctionTags",
+                    requestId,
+                    additionalData);
+
+                if (!result.success)
+                {
+                    throw new FunctionException("Failed to update function tags");
+                }
+
+                Common.Utilities.LoggingUtility.LogOperationSuccess(_logger, "UpdateFunctionTags", requestId, 0, additionalData);
+
+                return result.result;
+            }
+            catch (ValidationException)
+            {
+                throw;
+            }
+            catch (Exception ex)
+            {
+                Common.Utilities.LoggingUtility.LogOperationFailure(_logger, "UpdateFunctionTags", requestId, ex, 0, additionalData);
+                throw new FunctionException($"Error updating tags for function {id}", ex);
+            }
+        }
+
         /// <inheritdoc/>
         public async Task<Core.Models.Function> UpdateSecretAccessAsync(Guid id, List<Guid> secretIds)
         {
@@ -655,6 +895,7 @@ namespace NeoServiceLayer.Services.Function
 
                         function.SecretIds = secretIds;
                         function.UpdatedAt = DateTime.UtcNow;
+                        function.Revision++;
                         return await _functionRepository.UpdateAsync(function.Id, function);
                     },
                     "UpdateFunctionSecretAccess",
@@ -678,13 +919,20 @@ namespace NeoServiceLayer.Services.Function
         }
 
         /// <inheritdoc/>
-        public async Task<object> ExecuteAsync(Guid id, Dictionary<string, object> parameters = null)
+        public Task<object> ExecuteAsync(Guid id, Dictionary<string, object> parameters = null)
+        {
+            return ExecuteAsync(id, parameters, null);
+        }
+
+        /// <inheritdoc/>
+        public async Task<object> ExecuteAsync(Guid id, Dictionary<string, object> parameters, string sessionKey)
         {
             var requestId = Guid.NewGuid().ToString();
             var additionalData = new Dictionary<string, object>
             {
                 ["Id"] = id,
-                ["ParameterCount"] = parameters?.Count ?? 0
+                ["ParameterCount"] = parameters?.Count ?? 0,
+                ["SessionKey"] = sessionKey
             };
 
             Common.Utilities.LoggingUtility.LogOperationStart(_logger, "ExecuteFunction", requestId, additionalData);
@@ -694,6 +942,9 @@ namespace NeoServiceLayer.Services.Function
                 // Validate input
                 Common.Utilities.ValidationUtility.ValidateGuid(id, "Function ID");
 
+                // The wrapper below swallows the exception, so the sandbox failure is kept for the caller
+                SandboxException executionFailure = null;
+
                 var result = await Common.Utilities.ExceptionUtility.ExecuteWithExceptionHandlingAsync<FunctionService, object>(
                     _logger,
                     async () =>
@@ -713,41 +964,94 @@ namespace NeoServiceLayer.Services.Function
                             throw new FunctionException("Function is not active");
                         }
 
+                        EnsureExecutionSlotAvailable(function.AccountId);
+
+                        // Waits while the instance or account is saturated; tiers share the freed slots by weight, and
+                        // callers waiting on the API go ahead of schedules and webhooks, which have no authenticated caller
+                        var lane = RequestContext.PrincipalId != null ? ExecutionLane.Interactive : ExecutionLane.Triggered;
+                        using var slot = _executionScheduler != null ? await _executionScheduler.AcquireAsync(function.AccountId, lane) : null;
+
                         // Create execution record
                         var execution = new FunctionExecutionResult
                         {
                             Id = Guid.NewGuid(),
                             ExecutionId = Guid.NewGuid(),
                             FunctionId = function.Id,
+                            AccountId = function.AccountId,
+                            FunctionVersionId = function.LiveVersionId,
+                            FunctionVersionNumber = function.LiveVersionNumber,
                             Input = parameters,
                             Status = "Running",
+                            RequestId = RequestContext.RequestId,
+                            CredentialId = RequestContext.CredentialId,
                             StartTime = DateTime.UtcNow
                         };
 
                         additionalData["ExecutionId"] = execution.Id;
 
                         await _executionRepository.CreateAsync(execution);
+                        TrackExecution(execution, function, "api");
 
-                        // Execute function in enclave
-                        var executeRequest = new
+                        object functionResult;
+                        try
                         {
-                            ExecutionId = execution.Id,
-                            FunctionId = function.Id,
-                            Parameters = parameters
-                        };
-
-                        var functionResult = await _enclaveService.SendRequestAsync<object, object>(
-                            Constants.EnclaveServiceTypes.Function,
-                            Constants.FunctionOperations.ExecuteFunction,
-                            executeRequest);
+                            // Execute function in enclave
+                            var executeRequest = new
+                            {
+                                ExecutionId = execution.Id,
+                                FunctionId = function.Id,
+                                Parameters = parameters,
+                                DeniedPriceSymbols = await GetDeniedPriceSymbolsAsync(function.AccountId),
+                                Secrets = await GetGrantedSecretsAsync(function),
+                                GasLimit = await GetGasLimitAsync(function),
+                                SessionKey = sessionKey,
+                                GasPrices = _gasPriceOracle?.GetCachedGasPrices()
+                            };
+
+                            functionResult = await SendExecuteRequestAsync(
+                                function,
+                                execution.Id,
+                                Constants.FunctionOperations.ExecuteFunction,
+                                executeRequest);
+                        }
+                        catch (Exception) when (_executionTracker?.IsKilled(execution.Id) == true)
+                        {
+                            await RecordKilledExecutionAsync(execution);
+                            await RecordUsageAsync(execution);
+                            PublishExecution(execution, "api", null);
+                            throw new FunctionException("Function execution was killed by an administrator");
+                        }
+                        catch (Exception ex)
+                        {
+                            execution.GasUsed = FindGasUsed(ex);
+                            await ChargeExecutionAsync(function, execution);
+                            await RecordFailedExecutionAsync(execution, ex, function.SourceMap);
+                            executionFailure = new SandboxException(execution.ErrorCode, execution.Error, ex);
+                            await RecordUsageAsync(execution);
+                            PublishExecution(execution, "api", null);
+                            throw;
+                        }
+                        finally
+                        {
+                            _executionTracker?.Complete(execution.Id);
+                        }
 
                         // Update execution record
                         execution.Status = "Completed";
                         execution.Output = functionResult;
+                        execution.Metrics = ExtractSandboxMetrics(functionResult);
+                        execution.GasUsed = FindSandboxValue(functionResult, "GasUsed")?.ToObject<long>() ?? 0;
+                        execution.MemoryUsageMb = FindSandboxValue(functionResult, "MemoryUsage")?.ToObject<double>() ?? 0;
+                        execution.EndTime = DateTime.UtcNow;
+                        execution.DurationMs = (execution.EndTime.Value - execution.StartTime).TotalMilliseconds;
+                        await ChargeExecutionAsync(function, execution);
 
-                        additionalData["DurationMs"] = (long)(DateTime.UtcNow - execution.StartTime).TotalMilliseconds;
+                        additionalData["DurationMs"] = (long)execution.DurationMs;
 
                         await _executionRepository.UpdateAsync(execution.Id, execution);
+                        await RecordSecurityEventsAsync(function, execution.Id, functionResult);
+                        await RecordUsageAsync(execution);
+                        PublishExecution(execution, "api", null);
 
                         // Update function's last executed timestamp
                         function.LastExecutedAt = DateTime.UtcNow;
@@ -761,7 +1065,7 @@ namespace NeoServiceLayer.Services.Function
 
                 if (!result.success)
                 {
-                    throw new FunctionException("Failed to execute function");
+                    throw new FunctionException("Failed to execute function", executionFailure);
                 }
 
                 Common.Utilities.LoggingUtility.LogOperationSuccess(_logger, "ExecuteFunction", requestId, 0, additionalData);
@@ -793,6 +1097,9 @@ namespace NeoServiceLayer.Services.Function
                 Common.Utilities.ValidationUtility.ValidateGuid(id, "Function ID");
                 Common.Utilities.ValidationUtility.ValidateNotNull(eventData, "Event data");
 
+                // The wrapper below swallows the exception, so the sandbox failure is kept for the caller
+                SandboxException executionFailure = null;
+
                 var result = await Common.Utilities.ExceptionUtility.ExecuteWithExceptionHandlingAsync<FunctionService, object>(
                     _logger,
                     async () =>
@@ -812,40 +1119,89 @@ namespace NeoServiceLayer.Services.Function
                             throw new FunctionException("Function is not active");
                         }
 
+                        EnsureExecutionSlotAvailable(function.AccountId);
+
+                        // Waits while the instance or account is saturated; tiers share the freed slots by weight
+                        using var slot = _executionScheduler != null ? await _executionScheduler.AcquireAsync(function.AccountId, ExecutionLane.Triggered) : null;
+
                         // Create execution record
                         var execution = new FunctionExecutionResult
                         {
                             Id = Guid.NewGuid(),
                             ExecutionId = Guid.NewGuid(),
                             FunctionId = function.Id,
+                            AccountId = function.AccountId,
+                            FunctionVersionId = function.LiveVersionId,
+                            FunctionVersionNumber = function.LiveVersionNumber,
                             Status = "Running",
+                            RequestId = RequestContext.RequestId,
                             StartTime = DateTime.UtcNow
                         };
 
                         additionalData["ExecutionId"] = execution.Id;
 
                         await _executionRepository.CreateAsync(execution);
+                        TrackExecution(execution, function, "event");
 
-                        // Execute function in enclave
-                        var executeRequest = new
+                        object functionResult;
+                        try
                         {
-                            ExecutionId = execution.Id,
-                            FunctionId = function.Id,
-                            Event = eventData
-                        };
-
-                        var functionResult = await _enclaveService.SendRequestAsync<object, object>(
-                            Constants.EnclaveServiceTypes.Function,
-                            Constants.FunctionOperations.ExecuteFunctionForEvent,
-                            executeRequest);
+                            // Execute function in enclave
+                            var executeRequest = new
+                            {
+                                ExecutionId = execution.Id,
+                                FunctionId = function.Id,
+                                Event = eventData,
+                                DeniedPriceSymbols = await GetDeniedPriceSymbolsAsync(function.AccountId),
+                                Secrets = await GetGrantedSecretsAsync(function),
+                                GasLimit = await GetGasLimitAsync(function),
+                                GasPrices = _gasPriceOracle?.GetCachedGasPrices()
+                            };
+
+                            functionResult = await SendExecuteRequestAsync(
+                                function,
+                                execution.Id,
+                                Constants.FunctionOperations.ExecuteFunctionForEvent,
+                                executeRequest);
+                        }
+                        catch (Exception) when (_executionTracker?.IsKilled(execution.Id) == true)
+                        {
+                            await RecordKilledExecutionAsync(execution);
+                            await RecordUsageAsync(execution);
+                            PublishExecution(execution, "event", eventData);
+                            throw new FunctionException("Function execution was killed by an administrator");
+                        }
+                        catch (Exception ex)
+                        {
+                            execution.GasUsed = FindGasUsed(ex);
+                            await ChargeExecutionAsync(function, execution);
+                            await RecordFailedExecutionAsync(execution, ex, function.SourceMap);
+                            executionFailure = new SandboxException(execution.ErrorCode, execution.Error, ex);
+                            await RecordUsageAsync(execution);
+                            PublishExecution(execution, "event", eventData);
+                            throw;
+                        }
+                        finally
+                        {
+                            _executionTracker?.Complete(execution.Id);
+                        }
 
                         // Update execution record
                         execution.Status = "Completed";
                         execution.Output = functionResult;
+                        execution.Metrics = ExtractSandboxMetrics(functionResult);
+                        execution.GasUsed = FindSandboxValue(functionResult, "GasUsed")?.ToObject<long>() ?? 0;
+                        execution.MemoryUsageMb = FindSandboxValue(functionResult, "MemoryUsage")?.ToObject<double>() ?? 0;
+                        execution.EndTime = DateTime.UtcNow;
+                        execution.DurationMs = (execution.EndTime.Value - execution.StartTime).TotalMilliseconds;
+                        await ChargeExecutionAsync(function, execution);
 
-                        additionalData["DurationMs"] = (long)(DateTime.UtcNow - execution.StartTime).TotalMilliseconds;
+                        additionalData["DurationMs"] = (long)execution.DurationMs;
 
                         await _executionRepository.UpdateAsync(execution.Id, execution);
+                        await RecordSecurityEventsAsync(function, execution.Id, functionResult);
+                        await RecordUsageAsync(execution);
+                        PublishExecution(execution, "event", eventData);
 
                         // Update function's last executed timestamp
                         function.LastExecutedAt = DateTime.UtcNow;
@@ -859,7 +1215,7 @@ namespace NeoServiceLayer.Services.Function
 
                 if (!result.success)
                 {
-                    throw new FunctionException("Failed to execute function for event");
+                    throw new FunctionException("Failed to execute function for event", executionFailure);
                 }
 
                 Common.Utilities.LoggingUtility.LogOperationSuccess(_logger, "ExecuteFunctionForEvent", requestId, 0, additionalData);
@@ -981,6 +1337,11 @@ namespace NeoServiceLayer.Services.Function
                             return function;
                         }
 
+                        if (function.Status == FunctionSecurityMonitor.PendingReviewStatus)
+                        {
+                            throw new FunctionException("Function was disabled after anomalous sandbox activity and is pending an administrator's review");
+                        }
+
                         // Activate function in enclave
                         var activateRequest = new
                         {
@@ -994,6 +1355,7 @@ namespace NeoServiceLayer.Services.Function
 
                         function.Status = "Active";
                         function.UpdatedAt = DateTime.UtcNow;
+                        function.Revision++;
                         return await _functionRepository.UpdateAsync(function.Id, function);
                     },
                     "ActivateFunction",
@@ -1064,6 +1426,7 @@ namespace NeoServiceLayer.Services.Function
 
                         function.Status = "Inactive";
                         function.UpdatedAt = DateTime.UtcNow;
+                        function.Revision++;
                         return await _functionRepository.UpdateAsync(function.Id, function);
                     },
                     "DeactivateFunction",
@@ -1153,6 +1516,553 @@ namespace NeoServiceLayer.Services.Function
             return NeoServiceLayer.Core.Utilities.HashUtility.ComputeSha256Hash(input);
         }
 
+        /// <inheritdoc/>
+        public async Task<IEnumerable<ActiveExecution>> GetActiveExecutionsAsync()
+        {
+            if (_executionTracker == null)
+            {
+                return new List<ActiveExecution>();
+            }
+
+            var executionIds = _executionTracker.GetAll().Select(e => e.ExecutionId).ToList();
+            if (executionIds.Count > 0)
+            {
+                await RefreshMemoryUsageAsync(executionIds);
+            }
+
+            return _executionTracker.GetAll();
+        }
+
+        private async Task RefreshMemoryUsageAsync(List<Guid> executionIds)
+        {
+            try
+            {
+                var usage = await _enclaveService.SendRequestAsync<object, List<ExecutionMemoryUsage>>(
+                    Constants.EnclaveServiceTypes.Function,
+                    Constants.FunctionOperations.GetExecutionMemory,
+                    new { ExecutionIds = executionIds });
+
+                foreach (var item in usage ?? new List<ExecutionMemoryUsage>())
+                {
+                    _executionTracker.UpdateMemoryUsage(item.ExecutionId, item.MemoryUsageMb);
+                }
+            }
+            catch (Exception ex)
+            {
+                // The executions are still listed, with the memory usage last reported
+                _logger.LogWarning(ex, "Error getting memory usage of {Count} running executions from the enclave", executionIds.Count);
+            }
+        }
+
+        /// <inheritdoc/>
+        public string Name => Constants.ManagedServices.Functions;
+
+        /// <inheritdoc/>
+        public IReadOnlyCollection<string> DependsOn { get; } = new[] { Constants.ManagedServices.GasBank };
+
+        /// <summary>
+        /// Accepts executions again after <see cref="StopAsync"/>; they are accepted from construction, so this is only needed to restart it
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public Task StartAsync()
+        {
+            _stopped = false;
+            return Task.CompletedTask;
+        }
+
+        /// <summary>
+        /// Stops accepting executions and waits for the running ones to finish and be charged
+        /// </summary>
+        /// <remarks>
+        /// An execution refused while stopping fails, and the caller can retry it on another instance. An execution
+        /// still running when the process exits is failed as interrupted by the recovery step of the next start.
+        /// </remarks>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task StopAsync()
+        {
+            _stopped = true;
+
+            while (_executionTracker?.GetAll().Any() == true)
+            {
+                await Task.Delay(StopPollInterval);
+            }
+        }
+
+        /// <inheritdoc/>
+        public ManagedServiceHealth GetHealth()
+        {
+            return ManagedServiceHealth.Of(
+                Name,
+                _stopped ? ManagedServiceStatus.Stopped : ManagedServiceStatus.Running,
+                $"{_executionTracker?.GetAll().Count() ?? 0} executions running");
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> KillExecutionAsync(Guid executionId, string killedBy)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["ExecutionId"] = executionId,
+                ["KilledBy"] = killedBy
+            };
+
+            Common.Utilities.LoggingUtility.LogOperationStart(_logger, "KillExecution", requestId, additionalData);
+
+            try
+            {
+                Common.Utilities.ValidationUtility.ValidateGuid(executionId, "Execution ID");
+
+                if (_executionTracker == null || !_executionTracker.MarkKilled(executionId, killedBy))
+                {
+                    return false;
+                }
+
+                // Ask the enclave to interrupt the sandbox VM; the runtime checks the cancellation
+                // between statements so the engine unwinds without leaving host calls half-done
+                var killRequest = new
+                {
+                    ExecutionId = executionId
+                };
+
+                ExecutionKillResult killResult;
+                try
+                {
+                    killResult = await _enclaveService.SendRequestAsync<object, ExecutionKillResult>(
+                        Constants.EnclaveServiceTypes.Function,
+                        Constants.FunctionOperations.KillExecution,
+                        killRequest);
+                }
+                catch
+                {
+                    // The kill never reached the sandbox, so the execution is still running and can be killed again
+                    _executionTracker.ClearKill(executionId);
+                    throw;
+                }
+
+                if (killResult?.Killed != true)
+                {
+                    // The enclave is not running the execution, e.g. because it finished while the kill was on its way
+                    _executionTracker.ClearKill(executionId);
+                    _logger.LogWarning("Execution not running in the enclave, nothing to kill: {ExecutionId}", executionId);
+                    return false;
+                }
+
+                Common.Utilities.LoggingUtility.LogOperationSuccess(_logger, "KillExecution", requestId, 0, additionalData);
+
+                return true;
+            }
+            catch (Exception ex)
+            {
+                Common.Utilities.LoggingUtility.LogOperationFailure(_logger, "KillExecution", requestId, ex, 0, additionalData);
+                throw new FunctionException($"Error killing execution {executionId}", ex);
+            }
+        }
+
+        private void ValidateSandboxLimits(int maxExecutionTime, int maxMemory)
+        {
+            if (maxExecutionTime > _limits.MaxExecutionTimeMs)
+            {
+                throw new FunctionException($"Max execution time cannot exceed {_limits.MaxExecutionTimeMs} ms");
+            }
+
+            if (maxMemory > _limits.MaxMemoryMb)
+            {
+                throw new FunctionException($"Max memory cannot exceed {_limits.MaxMemoryMb} MB");
+            }
+        }
+
+        private static List<string> NormalizePermissions(List<string> permissions)
+        {
+            if (permissions == null)
+            {
+                return null;
+            }
+
+            var unknown = permissions.Where(p => !FunctionCapabilities.IsKnown(p)).ToList();
+            if (unknown.Count > 0)
+            {
+                throw new FunctionException(
+                    $"Unknown permissions: {string.Join(", ", unknown)}. Valid permissions are: {string.Join(", ", FunctionCapabilities.All)}");
+            }
+
+            return permissions.Select(p => p.ToLowerInvariant()).Distinct().ToList();
+        }
+
+        // Deploys of code that uses more than the function declares are rejected with the capability report
+        private FunctionCapabilityReport EnsureWithinPermissions(string runtime, string sourceCode, List<string> permissions)
+        {
+            var report = _capabilityAnalyzer.Analyze(runtime, sourceCode, permissions);
+            if (!report.IsWithinPermissions)
+            {
+                _logger.LogWarning("Rejected function code using undeclared permissions: {Capabilities}",
+                    string.Join(", ", report.UndeclaredCapabilities));
+                throw new FunctionPermissionException(report);
+            }
+
+            return report;
+        }
+
+        // The sandbox's priceFeed binding refuses these symbols, matching what the API serves the account
+        private async Task<List<string>> GetDeniedPriceSymbolsAsync(Guid accountId)
+        {
+            if (_scopeFactory == null)
+            {
+                return null;
+            }
+
+            using var scope = _scopeFactory.CreateScope();
+            var accessService = scope.ServiceProvider.GetService<IPriceFeedAccessService>();
+            if (accessService == null)
+            {
+                return null;
+            }
+
+            var deniedSymbols = await accessService.GetDeniedSymbolsAsync(accountId);
+            return deniedSymbols.ToList();
+        }
+
+        // The sandbox's secrets binding only sees secrets the function lists and that allow the function in return,
+        // through the secret's allowed functions or an active grant. Values stay sealed; the enclave unseals one when
+        // the function asks for it, under the owner's account since shared secrets belong to another user
+        private async Task<List<object>> GetGrantedSecretsAsync(Core.Models.Function function)
+        {
+            var grantedSecrets = new List<object>();
+            if (function.SecretIds == null)
+            {
+                return grantedSecrets;
+            }
+
+            using var scope = _scopeFactory?.CreateScope();
+            var grantService = scope?.ServiceProvider.GetService<ISecretGrantService>();
+
+            foreach (var secretId in function.SecretIds.Distinct())
+            {
+                var secret = await _secretsService.GetByIdAsync(secretId);
+                var granted = grantService != null
+                    ? await grantService.CanFunctionReadAsync(secret, function)
+                    : secret != null &&
+                        secret.AccountId == function.AccountId &&
+                        secret.AllowedFunctionIds?.Contains(function.Id) == true &&
+                        !(secret.ExpiresAt.HasValue && secret.ExpiresAt.Value < DateTime.UtcNow);
+                if (!granted)
+                {
+                    _logger.LogWarning("Secret {SecretId} is not granted to function {FunctionId}", secretId, function.Id);
+                    continue;
+                }
+
+                grantedSecrets.Add(new
+                {
+                    secret.Id,
+                    secret.Name,
+                    secret.EncryptedValue,
+                    secret.AccountId
+                });
+            }
+
+            return grantedSecrets;
+        }
+
+        // Funded executions are limited to the gas the owner's GAS buys; 0 runs the execution unmetered
+        private async Task<long> GetGasLimitAsync(Core.Models.Function function)
+        {
+            if (!_metering.Enabled)
+            {
+                return 0;
+            }
+
+            var budget = _gasBankService != null
+                ? await _gasBankService.GetExecutionBudgetAsync(function.AccountId, function.Id)
+                : null;
+            var affordable = budget.HasValue && _metering.GasPerUnit > 0
+                ? (long)Math.Min(decimal.Floor(budget.Value / _metering.GasPerUnit), _metering.MaxGasUnitsPerExecution)
+                : 0;
+
+            if (affordable > 0)
+            {
+                return affordable;
+            }
+
+            if (_metering.RequireFunding)
+            {
+                throw new SandboxException(FunctionErrorCodes.GasExhausted, "The function's owner has no GAS to pay for the execution");
+            }
+
+            return _metering.MaxGasUnitsPerExecution;
+        }
+
+        private async Task ChargeExecutionAsync(Core.Models.Function function, FunctionExecutionResult execution)
+        {
+            if (!_metering.Enabled || _gasBankService == null || execution.GasUsed <= 0 || _metering.GasPerUnit <= 0)
+            {
+                return;
+            }
+
+            try
+            {
+                var transaction = await _gasBankService.ChargeFunctionExecutionAsync(
+                    function.AccountId, function.Id, execution.Id, execution.GasUsed * _metering.GasPerUnit);
+                execution.GasCharged = transaction?.Amount ?? 0;
+            }
+            catch (Exception ex)
+            {
+                // A failure to charge must not change the outcome of an execution that already ran
+                _logger.LogError(ex, "Error charging execution {ExecutionId} of function {FunctionId}", execution.Id, function.Id);
+            }
+        }
+
+        private static long FindGasUsed(Exception exception)
+        {
+            for (var current = exception; current != null; current = current.InnerException)
+            {
+                if (current is SandboxException sandboxException && sandboxException.GasUsed > 0)
+                {
+                    return sandboxException.GasUsed;
+                }
+            }
+
+            return 0;
+        }
+
+        private void EnsureExecutionSlotAvailable(Guid accountId)
+        {
+            if (_stopped)
+            {
+                throw new FunctionException("The function service is shutting down; retry the execution");
+            }
+
+            var running = _executionTracker?.GetAll().Count(e => e.AccountId == accountId) ?? 0;
+            if (running >= _limits.MaxConcurrentExecutions)
+            {
+                throw new FunctionException($"Concurrent execution limit of {_limits.MaxConcurrentExecutions} reached for account");
+            }
+        }
+
+        private void TrackExecution(FunctionExecutionResult execution, Core.Models.Function function, string source)
+        {
+            _executionTracker?.Register(new ActiveExecution
+            {
+                ExecutionId = execution.Id,
+                FunctionId = function.Id,
+                FunctionName = function.Name,
+                AccountId = function.AccountId,
+                Runtime = function.Runtime,
+                Source = source,
+                StartTime = execution.StartTime,
+                MaxExecutionTime = function.MaxExecutionTime,
+                MaxMemoryMb = function.MaxMemory
+            });
+        }
+
+        /// <summary>
+        /// Builds a source if its runtime can't run it as is
+        /// </summary>
+        /// <param name="runtime">Function runtime</param>
+        /// <param name="sourceCode">Source code</param>
+        /// <returns>The build result, or null if the source runs as is</returns>
+        private async Task<FunctionCompilationResult> BuildIfRequiredAsync(string runtime, string sourceCode)
+        {
+            if (!string.Equals(runtime, nameof(FunctionRuntime.TypeScript), StringComparison.OrdinalIgnoreCase))
+            {
+                return null;
+            }
+
+            if (_buildService == null)
+            {
+                throw new FunctionException("TypeScript functions are not supported because no build service is configured");
+            }
+
+            var build = await _buildService.BuildAsync(sourceCode, TypeScriptFileName);
+            if (!build.IsSuccess)
+            {
+                throw new FunctionException($"TypeScript build failed: {string.Join("; ", build.Errors)}");
+            }
+
+            return build;
+        }
+
+        private async Task RecordUsageAsync(FunctionExecutionResult execution)
+        {
+            if (_usageService == null)
+            {
+                return;
+            }
+
+            try
+            {
+                await _usageService.RecordAsync(execution);
+            }
+            catch (Exception ex)
+            {
+                // Usage reports are best effort and must not change the outcome of an execution that already ran
+                _logger.LogError(ex, "Error recording usage of execution {ExecutionId}", execution.Id);
+            }
+        }
+
+        private void PublishExecution(FunctionExecutionResult execution, string source, Event eventData)
+        {
+            if (_eventPublisher == null)
+            {
+                return;
+            }
+
+            var data = new
+            {
+                ExecutionId = execution.Id,
+                execution.FunctionId,
+                execution.Status,
+                Source = source,
+                DurationMs = (long)((execution.EndTime ?? DateTime.UtcNow) - execution.StartTime).TotalMilliseconds,
+                execution.Error,
+                execution.ErrorCode
+            };
+
+            _eventPublisher.Publish(new ServerEvent
+            {
+                Topic = ServerEventTopics.Executions,
+                Type = execution.Status.ToLowerInvariant(),
+                AccountId = execution.AccountId,
+                Data = data
+            });
+
+            if (eventData != null)
+            {
+                _eventPublisher.Publish(new ServerEvent
+                {
+                    Topic = ServerEventTopics.Triggers,
+                    Type = "event",
+                    AccountId = execution.AccountId,
+                    Data = new { data.ExecutionId, data.FunctionId, data.Status, EventType = eventData.Type }
+                });
+            }
+        }
+
+        private async Task RecordKilledExecutionAsync(FunctionExecutionResult execution)
+        {
+            execution.Status = "Killed";
+            execution.Error = "Execution was killed by an administrator";
+            execution.EndTime = DateTime.UtcNow;
+            execution.DurationMs = (execution.EndTime.Value - execution.StartTime).TotalMilliseconds;
+
+            await _executionRepository.UpdateAsync(execution.Id, execution);
+        }
+
+        private async Task RecordFailedExecutionAsync(FunctionExecutionResult execution, Exception exception, string sourceMap = null)
+        {
+            execution.Status = "Failed";
+            execution.Error = sourceMap != null && _buildService != null
+                ? _buildService.MapError(exception.Message, sourceMap)
+                : exception.Message;
+            execution.ErrorCode = FunctionErrorCodes.Find(exception) ?? FunctionErrorCodes.InternalError;
+            execution.EndTime = DateTime.UtcNow;
+            execution.DurationMs = (execution.EndTime.Value - execution.StartTime).TotalMilliseconds;
+
+            try
+            {
+                await _executionRepository.UpdateAsync(execution.Id, execution);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error recording failed execution {ExecutionId}", execution.Id);
+            }
+        }
+
+        /// <summary>
+        /// Sends an execution to the enclave, or to the function's quorum nodes when it has a quorum policy
+        /// </summary>
+        /// <param name="function">Function being executed</param>
+        /// <param name="executionId">Execution ID</param>
+        /// <param name="operation">Enclave operation</param>
+        /// <param name="executeRequest">Request passed to the enclave</param>
+        /// <returns>The enclave's result, or the result the quorum agreed on</returns>
+        /// <exception cref="FunctionException">The quorum was not reached</exception>
+        private async Task<object> SendExecuteRequestAsync(Core.Models.Function function, Guid executionId, string operation, object executeRequest)
+        {
+            if (function.Quorum == null || _quorumExecutionService == null)
+            {
+                return await _enclaveService.SendRequestAsync<object, object>(
+                    Constants.EnclaveServiceTypes.Function,
+                    operation,
+                    executeRequest);
+            }
+
+            var quorum = await _quorumExecutionService.ExecuteAsync(function, executionId, operation, executeRequest);
+            if (!quorum.Agreed)
+            {
+                throw new FunctionException(
+                    $"Quorum not reached: {quorum.AgreeingNodes} of {quorum.Results.Count} nodes agreed and {function.Quorum.Threshold} are required");
+            }
+
+            return quorum.AgreedOutput;
+        }
+
+        /// <summary>
+        /// Extracts the custom metrics a function recorded in the sandbox from its result
+        /// </summary>
+        /// <param name="functionResult">Result returned by the enclave</param>
+        /// <returns>Recorded metrics, empty if none</returns>
+        private static Dictionary<string, double> ExtractSandboxMetrics(object functionResult)
+        {
+            if (functionResult == null)
+            {
+                return new Dictionary<string, double>();
+            }
+
+            try
+            {
+                var metrics = FindSandboxValue(functionResult, "Metrics");
+                return metrics?.ToObject<Dictionary<string, double>>() ?? new Dictionary<string, double>();
+            }
+            catch (Exception)
+            {
+                return new Dictionary<string, double>();
+            }
+        }
+
+        /// <summary>
+        /// Finds a value the sandbox reported alongside the function's result
+        /// </summary>
+        /// <param name="functionResult">Result returned by the enclave</param>
+        /// <param name="name">Value name, e.g. "Metrics"</param>
+        /// <returns>The value, or null when absent</returns>
+        private static Newtonsoft.Json.Linq.JToken FindSandboxValue(object functionResult, string name)
+        {
+            if (functionResult == null)
+            {
+                return null;
+            }
+
+            var response = Newtonsoft.Json.Linq.JToken.FromObject(functionResult) as Newtonsoft.Json.Linq.JObject;
+            return response?[name] ?? (response?["Result"] as Newtonsoft.Json.Linq.JObject)?[name];
+        }
+
+        private async Task RecordSecurityEventsAsync(Core.Models.Function function, Guid executionId, object functionResult)
+        {
+            if (_securityMonitor == null)
+            {
+                return;
+            }
+
+            try
+            {
+                var events = FindSandboxValue(functionResult, "SecurityEvents")?.ToObject<List<FunctionSecurityEvent>>();
+                if (events == null || events.Count == 0)
+                {
+                    return;
+                }
+
+                if (await _securityMonitor.RecordAsync(function, executionId, events))
+                {
+                    _logger.LogWarning("Function {FunctionId} was disabled pending review after execution {ExecutionId}", function.Id, executionId);
+                }
+            }
+            catch (Exception ex)
+            {
+                // A failure to record security events must not fail an execution that already ran
+                _logger.LogError(ex, "Error recording security events of execution {ExecutionId}", executionId);
+            }
+        }
+
 
 
         /// <inheritdoc/>
@@ -1,6 +1,8 @@
 using Microsoft.Extensions.Configuration;
 using Microsoft.Extensions.DependencyInjection;
 using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Function.Build;
 using NeoServiceLayer.Services.Function.Repositories;
 using NeoServiceLayer.Services.Function.Runtimes;
 using CoreInterfaces = NeoServiceLayer.Core.Interfaces;
@@ -37,14 +39,30 @@ namespace NeoServiceLayer.Services.Function
             services.AddSingleton<ServiceRepositories.IFunctionMarketplacePurchaseRepository, ServiceRepositories.FunctionMarketplacePurchaseRepository>();
             services.AddSingleton<ServiceRepositories.IFunctionCompositionRepository, ServiceRepositories.FunctionCompositionRepository>();
             services.AddSingleton<ServiceRepositories.IFunctionCompositionExecutionRepository, ServiceRepositories.FunctionCompositionExecutionRepository>();
+            services.AddSingleton<CoreInterfaces.IWebhookTriggerRepository, ServiceRepositories.WebhookTriggerRepository>();
+            services.AddSingleton<CoreInterfaces.IFunctionVersionRepository, ServiceRepositories.FunctionVersionRepository>();
+            services.AddSingleton<CoreInterfaces.IFunctionSecurityEventRepository, ServiceRepositories.FunctionSecurityEventRepository>();
+            services.AddSingleton<CoreInterfaces.IFunctionDailyUsageRepository, ServiceRepositories.FunctionDailyUsageRepository>();
+            services.AddSingleton<CoreInterfaces.IAsyncInvocationRepository, ServiceRepositories.AsyncInvocationRepository>();
 
             // Register services
+            services.AddSingleton<CoreInterfaces.IExecutionTracker, ExecutionTracker>();
+            services.AddSingleton<CoreInterfaces.IExecutionScheduler, ExecutionScheduler>();
+            services.AddSingleton<CoreInterfaces.IFunctionBuildService, EsbuildFunctionBuildService>();
+            services.AddSingleton<CoreInterfaces.IFunctionCapabilityAnalyzer, FunctionCapabilityAnalyzer>();
+            services.AddSingleton<CoreInterfaces.IFunctionSecurityMonitor, FunctionSecurityMonitor>();
+            services.AddSingleton<CoreInterfaces.IFunctionUsageService, FunctionUsageService>();
             services.AddSingleton<CoreInterfaces.IFunctionService, FunctionService>();
             services.AddSingleton<FunctionTemplateInitializer>();
             services.AddSingleton<CoreInterfaces.IFunctionTestService, FunctionTestService>();
             services.AddSingleton<CoreInterfaces.IFunctionAccessControlService, FunctionAccessControlService>();
             services.AddSingleton<CoreInterfaces.IFunctionMarketplaceService, FunctionMarketplaceService>();
             services.AddSingleton<CoreInterfaces.IFunctionCompositionService, FunctionCompositionService>();
+            services.AddSingleton<CoreInterfaces.IWebhookTriggerService, WebhookTriggerService>();
+            services.AddSingleton<CoreInterfaces.IFunctionVersionService, FunctionVersionService>();
+            services.AddSingleton<CoreInterfaces.IStartupRecoveryStep, FunctionExecutionRecoveryStep>();
+            services.AddSingleton<CoreInterfaces.IAsyncInvocationService, AsyncInvocationService>();
+            services.AddSingleton<CoreInterfaces.IStartupRecoveryStep, AsyncInvocationRecoveryStep>();
 
             // Register function runtimes
             services.AddSingleton<JavaScriptRuntime>();
@@ -58,6 +76,11 @@ namespace NeoServiceLayer.Services.Function
                 services.Configure<JavaScriptRuntimeOptions>(options => configuration.GetSection("Function:Runtimes:JavaScript").Bind(options));
                 services.Configure<PythonRuntimeOptions>(options => configuration.GetSection("Function:Runtimes:Python").Bind(options));
                 services.Configure<CSharpRuntimeOptions>(options => configuration.GetSection("Function:Runtimes:CSharp").Bind(options));
+                services.Configure<FunctionBuildConfiguration>(options => configuration.GetSection("Function:Build").Bind(options));
+                services.Configure<FunctionSecurityConfiguration>(options => configuration.GetSection("Function:Security").Bind(options));
+                services.Configure<FunctionMeteringConfiguration>(options => configuration.GetSection("Function:Metering").Bind(options));
+                services.Configure<ExecutionSchedulingConfiguration>(options => configuration.GetSection("Function:Scheduling").Bind(options));
+                services.Configure<AsyncInvocationConfiguration>(options => configuration.GetSection("Function:AsyncInvocation").Bind(options));
             }
 
             // Initialize templates
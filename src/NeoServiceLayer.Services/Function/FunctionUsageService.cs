@@ -0,0 +1,176 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Function
+{
+    /// <summary>
+    /// Accumulates per-function daily resource usage and reports it to function owners
+    /// </summary>
+    /// <remarks>
+    /// Each finished execution is added to the record of its function for the UTC day it started, so the report does
+    /// not depend on execution history being kept. Durations are counted in buckets that grow by a quarter, so the
+    /// reported p50 and p95 are the upper bound of the bucket the percentile falls in: at most 25% above the true
+    /// value, and never above the day's longest execution.
+    /// </remarks>
+    public class FunctionUsageService : IFunctionUsageService
+    {
+        /// <summary>
+        /// Longest range of days a report covers
+        /// </summary>
+        public const int MaxRangeDays = 366;
+
+        private const double BucketGrowth = 1.25;
+        private const int BucketCount = 64;
+
+        private readonly ILogger<FunctionUsageService> _logger;
+        private readonly IFunctionDailyUsageRepository _repository;
+        private readonly SemaphoreSlim _recordSemaphore = new SemaphoreSlim(1, 1);
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="FunctionUsageService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="repository">Daily usage repository</param>
+        public FunctionUsageService(ILogger<FunctionUsageService> logger, IFunctionDailyUsageRepository repository)
+        {
+            _logger = logger;
+            _repository = repository;
+        }
+
+        /// <inheritdoc/>
+        public async Task RecordAsync(FunctionExecutionResult execution)
+        {
+            if (execution == null)
+            {
+                throw new ArgumentNullException(nameof(execution));
+            }
+
+            var date = execution.StartTime.Date;
+            var durationMs = Math.Max(0, execution.DurationMs);
+
+            await _recordSemaphore.WaitAsync();
+            try
+            {
+                var usage = await _repository.GetAsync(execution.FunctionId, date);
+                var created = usage == null;
+                usage ??= new FunctionDailyUsage
+                {
+                    Id = Guid.NewGuid(),
+                    FunctionId = execution.FunctionId,
+                    AccountId = execution.AccountId,
+                    Date = date
+                };
+
+                while (usage.DurationHistogram.Count < BucketCount)
+                {
+                    usage.DurationHistogram.Add(0);
+                }
+
+                usage.Invocations++;
+                if (!string.Equals(execution.Status, "Completed", StringComparison.OrdinalIgnoreCase))
+                {
+                    usage.Errors++;
+                }
+
+                usage.TotalDurationMs += durationMs;
+                usage.MaxDurationMs = Math.Max(usage.MaxDurationMs, durationMs);
+                usage.DurationHistogram[GetBucket(durationMs)]++;
+                usage.PeakMemoryMb = Math.Max(usage.PeakMemoryMb, execution.MemoryUsageMb);
+                usage.GasCharged += execution.GasCharged;
+                usage.UpdatedAt = DateTime.UtcNow;
+
+                if (created)
+                {
+                    await _repository.CreateAsync(usage);
+                }
+                else
+                {
+                    await _repository.UpdateAsync(usage);
+                }
+            }
+            finally
+            {
+                _recordSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<FunctionDailyStats>> GetDailyStatsAsync(Guid functionId, DateTime from, DateTime to)
+        {
+            from = from.Date;
+            to = to.Date;
+
+            if (to < from)
+            {
+                throw new ValidationException("The end of the range must not be before its start");
+            }
+
+            if ((to - from).TotalDays >= MaxRangeDays)
+            {
+                throw new ValidationException($"A report covers at most {MaxRangeDays} days");
+            }
+
+            _logger.LogDebug("Reporting usage of function {FunctionId} from {From:yyyy-MM-dd} to {To:yyyy-MM-dd}", functionId, from, to);
+
+            var usage = await _repository.GetByFunctionIdAsync(functionId, from, to);
+            return usage.Select(ToStats).ToList();
+        }
+
+        private static FunctionDailyStats ToStats(FunctionDailyUsage usage)
+        {
+            return new FunctionDailyStats
+            {
+                Date = usage.Date,
+                Invocations = usage.Invocations,
+                Errors = usage.Errors,
+                ErrorRate = usage.Invocations == 0 ? 0 : (double)usage.Errors / usage.Invocations,
+                AverageDurationMs = usage.Invocations == 0 ? 0 : usage.TotalDurationMs / usage.Invocations,
+                P50DurationMs = GetPercentile(usage, 0.50),
+                P95DurationMs = GetPercentile(usage, 0.95),
+                MaxDurationMs = usage.MaxDurationMs,
+                PeakMemoryMb = usage.PeakMemoryMb,
+                GasCharged = usage.GasCharged
+            };
+        }
+
+        private static int GetBucket(double durationMs)
+        {
+            if (durationMs <= 1)
+            {
+                return 0;
+            }
+
+            var bucket = (int)Math.Ceiling(Math.Log(durationMs) / Math.Log(BucketGrowth));
+            return Math.Min(bucket, BucketCount - 1);
+        }
+
+        private static double GetPercentile(FunctionDailyUsage usage, double percentile)
+        {
+            var total = usage.DurationHistogram.Sum();
+            if (total == 0)
+            {
+                return 0;
+            }
+
+            var rank = (long)Math.Ceiling(percentile * total);
+            long seen = 0;
+            for (var bucket = 0; bucket < usage.DurationHistogram.Count; bucket++)
+            {
+                seen += usage.DurationHistogram[bucket];
+                if (seen >= rank)
+                {
+                    return Math.Round(Math.Min(Math.Pow(BucketGrowth, bucket), usage.MaxDurationMs), 1);
+                }
+            }
+
+            return usage.MaxDurationMs;
+        }
+    }
+}
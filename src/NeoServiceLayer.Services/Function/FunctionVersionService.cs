@@ -0,0 +1,211 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.Function
+{
+    /// <summary>
+    /// Service for the function registry
+    /// </summary>
+    /// <remarks>
+    /// Each deployment stores an immutable, numbered copy of a function's code and settings. Promoting a version
+    /// writes it onto the function through <see cref="IFunctionService"/>, so the usual permission checks, builds
+    /// and enclave updates apply, and records it as live. Executions then record the version that ran.
+    /// </remarks>
+    public class FunctionVersionService : IFunctionVersionService
+    {
+        private readonly ILogger<FunctionVersionService> _logger;
+        private readonly IFunctionVersionRepository _versionRepository;
+        private readonly IFunctionService _functionService;
+
+        // Numbering and promotion read then write, so they are serialized
+        private readonly SemaphoreSlim _registryLock = new SemaphoreSlim(1, 1);
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="FunctionVersionService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="versionRepository">Function version repository</param>
+        /// <param name="functionService">Function service</param>
+        public FunctionVersionService(
+            ILogger<FunctionVersionService> logger,
+            IFunctionVersionRepository versionRepository,
+            IFunctionService functionService)
+        {
+            _logger = logger;
+            _versionRepository = versionRepository;
+            _functionService = functionService;
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionVersion> DeployAsync(Guid functionId, FunctionVersion version, bool promote)
+        {
+            if (version == null)
+            {
+                throw new ArgumentNullException(nameof(version));
+            }
+
+            if (string.IsNullOrWhiteSpace(version.SourceCode))
+            {
+                throw new ValidationException("Source code is required");
+            }
+
+            var function = await GetFunctionAsync(functionId);
+
+            version.EntryPoint = string.IsNullOrWhiteSpace(version.EntryPoint) ? function.EntryPoint : version.EntryPoint;
+            version.MaxExecutionTime = version.MaxExecutionTime > 0 ? version.MaxExecutionTime : function.MaxExecutionTime;
+            version.MaxMemory = version.MaxMemory > 0 ? version.MaxMemory : function.MaxMemory;
+            version.EnvironmentVariables ??= function.EnvironmentVariables ?? new Dictionary<string, string>();
+
+            await _registryLock.WaitAsync();
+            try
+            {
+                var versions = await _versionRepository.GetByFunctionIdAsync(functionId);
+
+                version.Id = Guid.NewGuid();
+                version.FunctionId = functionId;
+                version.AccountId = function.AccountId;
+                version.Number = versions.Select(v => v.Number).DefaultIfEmpty(0).Max() + 1;
+                version.Label = string.IsNullOrWhiteSpace(version.Label) ? version.Number.ToString() : version.Label.Trim();
+                version.SourceCodeHash = HashUtility.ComputeSha256Hash(version.SourceCode);
+                version.CreatedAt = DateTime.UtcNow;
+                version.PromotedAt = null;
+                version.IsLive = false;
+
+                _logger.LogInformation("Deploying version {Number} of function {FunctionId}", version.Number, functionId);
+                await _versionRepository.CreateAsync(version);
+
+                return promote ? await PromoteLockedAsync(function, version, versions) : version;
+            }
+            finally
+            {
+                _registryLock.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<FunctionVersion>> GetVersionsAsync(Guid functionId)
+        {
+            return _versionRepository.GetByFunctionIdAsync(functionId);
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionVersion> GetVersionAsync(Guid functionId, int number)
+        {
+            var versions = await _versionRepository.GetByFunctionIdAsync(functionId);
+            return versions.FirstOrDefault(v => v.Number == number);
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionVersion> PromoteAsync(Guid functionId, int number)
+        {
+            var function = await GetFunctionAsync(functionId);
+
+            await _registryLock.WaitAsync();
+            try
+            {
+                var versions = await _versionRepository.GetByFunctionIdAsync(functionId);
+                var version = versions.FirstOrDefault(v => v.Number == number)
+                    ?? throw new ResourceNotFoundException("FunctionVersion", $"{functionId}/{number}");
+
+                return await PromoteLockedAsync(function, version, versions);
+            }
+            finally
+            {
+                _registryLock.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionVersion> RollbackAsync(Guid functionId)
+        {
+            await _registryLock.WaitAsync();
+            try
+            {
+                var function = await GetFunctionAsync(functionId);
+                var versions = (await _versionRepository.GetByFunctionIdAsync(functionId)).ToList();
+                if (function.LiveVersionNumber == 0)
+                {
+                    throw new ValidationException("The function has no live version to roll back from");
+                }
+
+                // Repeated rollbacks keep walking down the versions that have been live before
+                var target = versions
+                    .Where(v => v.Number < function.LiveVersionNumber && v.PromotedAt != null)
+                    .OrderByDescending(v => v.Number)
+                    .FirstOrDefault()
+                    ?? throw new ValidationException($"No version below {function.LiveVersionNumber} has been live");
+
+                _logger.LogInformation("Rolling function {FunctionId} back from version {From} to {To}",
+                    functionId, function.LiveVersionNumber, target.Number);
+
+                return await PromoteLockedAsync(function, target, versions);
+            }
+            finally
+            {
+                _registryLock.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteVersionAsync(Guid functionId, int number)
+        {
+            var version = await GetVersionAsync(functionId, number);
+            if (version == null)
+            {
+                return false;
+            }
+
+            if (version.IsLive)
+            {
+                throw new ValidationException("The live version cannot be deleted; promote another version first");
+            }
+
+            return await _versionRepository.DeleteAsync(version.Id);
+        }
+
+        private async Task<FunctionVersion> PromoteLockedAsync(Core.Models.Function function, FunctionVersion version, IEnumerable<FunctionVersion> versions)
+        {
+            _logger.LogInformation("Promoting version {Number} of function {FunctionId}", version.Number, function.Id);
+
+            // The code goes first: it is checked against the function's permissions and built before anything changes
+            if (version.SourceCode != function.SourceCode)
+            {
+                function = await _functionService.UpdateSourceCodeAsync(function.Id, version.SourceCode);
+            }
+
+            function.SourceCodeHash = version.SourceCodeHash;
+            function.EntryPoint = version.EntryPoint;
+            function.MaxExecutionTime = version.MaxExecutionTime;
+            function.MaxMemory = version.MaxMemory;
+            function.EnvironmentVariables = version.EnvironmentVariables;
+            function.Version = version.Label;
+            function.LiveVersionId = version.Id;
+            function.LiveVersionNumber = version.Number;
+            await _functionService.UpdateAsync(function);
+
+            foreach (var previous in versions.Where(v => v.IsLive && v.Id != version.Id))
+            {
+                previous.IsLive = false;
+                await _versionRepository.UpdateAsync(previous);
+            }
+
+            version.IsLive = true;
+            version.PromotedAt = DateTime.UtcNow;
+            return await _versionRepository.UpdateAsync(version);
+        }
+
+        private async Task<Core.Models.Function> GetFunctionAsync(Guid functionId)
+        {
+            return await _functionService.GetByIdAsync(functionId)
+                ?? throw new ResourceNotFoundException("Function", functionId.ToString());
+        }
+    }
+}
@@ -0,0 +1,191 @@
+using System;
+using System.Collections;
+using System.Collections.Generic;
+using System.Linq;
+using System.Text.RegularExpressions;
+using NeoServiceLayer.Core.Exceptions;
+using Newtonsoft.Json;
+using Newtonsoft.Json.Linq;
+
+namespace NeoServiceLayer.Services.Function
+{
+    /// <summary>
+    /// Renders trigger parameter templates into function parameters
+    /// </summary>
+    /// <remarks>
+    /// String values may reference the trigger context with <c>{{path}}</c> placeholders, where a path is a
+    /// chain of property names and array indexes such as <c>event.state[2]</c>. Paths are looked up, never
+    /// evaluated, so a template cannot run code or reach anything outside the context it is given.
+    /// A string that is exactly one placeholder takes the referenced value with its type; placeholders
+    /// inside a longer string are replaced with the value's text.
+    /// </remarks>
+    public static class ParameterTemplateRenderer
+    {
+        private static readonly Regex PlaceholderPattern = new Regex(@"\{\{\s*(.*?)\s*\}\}", RegexOptions.Compiled);
+        private static readonly Regex PathPattern = new Regex(@"^[A-Za-z_][A-Za-z0-9_]*(\.[A-Za-z_][A-Za-z0-9_]*|\[\d+\])*$", RegexOptions.Compiled);
+
+        /// <summary>
+        /// Checks that every placeholder in a template is a valid path under one of the given roots
+        /// </summary>
+        /// <param name="template">Template</param>
+        /// <param name="roots">Names the paths may start with</param>
+        /// <exception cref="ValidationException">A placeholder is malformed or starts with an unknown root</exception>
+        public static void Validate(IDictionary<string, object> template, params string[] roots)
+        {
+            if (template == null)
+            {
+                return;
+            }
+
+            foreach (var entry in template)
+            {
+                foreach (var text in Strings(ToToken(entry.Value)))
+                {
+                    foreach (Match match in PlaceholderPattern.Matches(text))
+                    {
+                        var path = match.Groups[1].Value;
+                        if (!PathPattern.IsMatch(path))
+                        {
+                            throw new ValidationException($"Invalid placeholder '{match.Value}' in parameter '{entry.Key}'");
+                        }
+
+                        var root = path.Split('.', '[')[0];
+                        if (!roots.Contains(root))
+                        {
+                            throw new ValidationException(
+                                $"Placeholder '{match.Value}' in parameter '{entry.Key}' must start with {string.Join(" or ", roots)}");
+                        }
+                    }
+                }
+            }
+        }
+
+        /// <summary>
+        /// Renders a template against a context
+        /// </summary>
+        /// <param name="template">Template</param>
+        /// <param name="context">Values the placeholders can reference, keyed by root name</param>
+        /// <returns>The function parameters</returns>
+        /// <exception cref="ValidationException">A placeholder is malformed or references a value the context does not have</exception>
+        public static Dictionary<string, object> Render(IDictionary<string, object> template, IDictionary<string, object> context)
+        {
+            var contextToken = (JObject)ToToken(context ?? new Dictionary<string, object>());
+            var parameters = new Dictionary<string, object>();
+
+            foreach (var entry in template ?? new Dictionary<string, object>())
+            {
+                parameters[entry.Key] = ToPlainValue(RenderToken(ToToken(entry.Value), contextToken, entry.Key));
+            }
+
+            return parameters;
+        }
+
+        private static JToken RenderToken(JToken token, JObject context, string parameter)
+        {
+            switch (token)
+            {
+                case JObject obj:
+                    return new JObject(obj.Properties().Select(p => new JProperty(p.Name, RenderToken(p.Value, context, parameter))));
+                case JArray array:
+                    return new JArray(array.Select(item => RenderToken(item, context, parameter)));
+                case JValue value when value.Type == JTokenType.String:
+                    return RenderString((string)value, context, parameter);
+                default:
+                    return token;
+            }
+        }
+
+        private static JToken RenderString(string text, JObject context, string parameter)
+        {
+            var whole = PlaceholderPattern.Match(text);
+            if (whole.Success && whole.Index == 0 && whole.Length == text.Length)
+            {
+                return Resolve(whole, context, parameter).DeepClone();
+            }
+
+            return PlaceholderPattern.Replace(text, match =>
+            {
+                var value = Resolve(match, context, parameter);
+                return value.Type switch
+                {
+                    JTokenType.Null => string.Empty,
+                    JTokenType.Object or JTokenType.Array => value.ToString(Formatting.None),
+                    JTokenType.Date => ((DateTime)value).ToString("O"),
+                    _ => Convert.ToString(((JValue)value).Value, System.Globalization.CultureInfo.InvariantCulture)
+                };
+            });
+        }
+
+        private static JToken Resolve(Match match, JObject context, string parameter)
+        {
+            var path = match.Groups[1].Value;
+            if (!PathPattern.IsMatch(path))
+            {
+                throw new ValidationException($"Invalid placeholder '{match.Value}' in parameter '{parameter}'");
+            }
+
+            var value = context.SelectToken(path);
+            if (value == null)
+            {
+                throw new ValidationException($"Placeholder '{match.Value}' in parameter '{parameter}' has no value");
+            }
+
+            return value;
+        }
+
+        private static IEnumerable<string> Strings(JToken token)
+        {
+            if (token.Type == JTokenType.String)
+            {
+                return new[] { (string)token };
+            }
+
+            return token.Type == JTokenType.Object || token.Type == JTokenType.Array
+                ? ((JContainer)token).Descendants().Where(t => t.Type == JTokenType.String).Select(t => (string)t)
+                : Enumerable.Empty<string>();
+        }
+
+        // Templates arrive from the API as System.Text.Json elements and from storage as plain values
+        private static JToken ToToken(object value)
+        {
+            switch (value)
+            {
+                case null:
+                    return JValue.CreateNull();
+                case JToken token:
+                    return token;
+                case System.Text.Json.JsonElement element:
+                    return JToken.Parse(element.GetRawText());
+                case string text:
+                    return new JValue(text);
+                case IDictionary dictionary:
+                    var obj = new JObject();
+                    foreach (DictionaryEntry entry in dictionary)
+                    {
+                        obj[entry.Key.ToString()] = ToToken(entry.Value);
+                    }
+
+                    return obj;
+                case IEnumerable items and not byte[]:
+                    return new JArray(items.Cast<object>().Select(ToToken));
+                default:
+                    return JToken.FromObject(value);
+            }
+        }
+
+        private static object ToPlainValue(JToken token)
+        {
+            switch (token)
+            {
+                case JObject obj:
+                    return obj.Properties().ToDictionary(p => p.Name, p => ToPlainValue(p.Value));
+                case JArray array:
+                    return array.Select(ToPlainValue).ToList();
+                case JValue value:
+                    return value.Value;
+                default:
+                    return null;
+            }
+        }
+    }
+}
@@ -0,0 +1,114 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.Function.Repositories
+{
+    /// <summary>
+    /// Repository for asynchronous function invocations
+    /// </summary>
+    public class AsyncInvocationRepository : IAsyncInvocationRepository
+    {
+        private readonly ILogger<AsyncInvocationRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private const string CollectionName = "async_invocations";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="AsyncInvocationRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public AsyncInvocationRepository(ILogger<AsyncInvocationRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<AsyncInvocation> CreateAsync(AsyncInvocation invocation)
+        {
+            ValidationUtility.ValidateNotNull(invocation, nameof(invocation));
+            ValidationUtility.ValidateGuid(invocation.Id, "Execution ID");
+
+            _logger.LogDebug("Storing async invocation {Id} of function {FunctionId}", invocation.Id, invocation.FunctionId);
+            await _storageProvider.CreateAsync(CollectionName, invocation);
+
+            return invocation;
+        }
+
+        /// <inheritdoc/>
+        public Task<AsyncInvocation> GetByIdAsync(Guid id)
+        {
+            return _storageProvider.GetByIdAsync<AsyncInvocation, Guid>(CollectionName, id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<AsyncInvocation>> GetByStatusAsync(AsyncInvocationStatus status)
+        {
+            var invocations = await _storageProvider.GetByFilterAsync<AsyncInvocation>(CollectionName, i => i.Status == status);
+            return invocations.OrderBy(i => i.CreatedAt).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<AsyncInvocation>> GetDueCallbacksAsync(DateTime now)
+        {
+            var invocations = await _storageProvider.GetByFilterAsync<AsyncInvocation>(
+                CollectionName,
+                i => i.CallbackStatus == AsyncCallbackStatus.Pending &&
+                     (i.Status == AsyncInvocationStatus.Completed || i.Status == AsyncInvocationStatus.Failed) &&
+                     (i.NextCallbackAt == null || i.NextCallbackAt <= now));
+
+            return invocations.OrderBy(i => i.CompletedAt).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<int> CountQueuedAsync(Guid accountId)
+        {
+            var invocations = await _storageProvider.GetByFilterAsync<AsyncInvocation>(
+                CollectionName,
+                i => i.AccountId == accountId && i.Status == AsyncInvocationStatus.Queued);
+
+            return invocations.Count();
+        }
+
+        /// <inheritdoc/>
+        public async Task<AsyncInvocation> UpdateAsync(AsyncInvocation invocation)
+        {
+            ValidationUtility.ValidateNotNull(invocation, nameof(invocation));
+
+            await _storageProvider.UpdateAsync<AsyncInvocation, Guid>(CollectionName, invocation.Id, invocation);
+            return invocation;
+        }
+
+        /// <inheritdoc/>
+        public async Task<int> DeleteFinishedBeforeAsync(DateTime before)
+        {
+            var invocations = await _storageProvider.GetByFilterAsync<AsyncInvocation>(
+                CollectionName,
+                i => (i.Status == AsyncInvocationStatus.Completed || i.Status == AsyncInvocationStatus.Failed) &&
+                     i.CompletedAt < before &&
+                     i.CallbackStatus != AsyncCallbackStatus.Pending);
+
+            var deleted = 0;
+            foreach (var invocation in invocations.ToList())
+            {
+                if (await _storageProvider.DeleteAsync<AsyncInvocation, Guid>(CollectionName, invocation.Id))
+                {
+                    deleted++;
+                }
+            }
+
+            if (deleted > 0)
+            {
+                _logger.LogInformation("Deleted {Count} async invocations finished before {Before}", deleted, before);
+            }
+
+            return deleted;
+        }
+    }
+}
@@ -0,0 +1,75 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.Function.Repositories
+{
+    /// <summary>
+    /// Repository for function daily usage records
+    /// </summary>
+    public class FunctionDailyUsageRepository : IFunctionDailyUsageRepository
+    {
+        private readonly ILogger<FunctionDailyUsageRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private const string CollectionName = "function_daily_usage";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="FunctionDailyUsageRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public FunctionDailyUsageRepository(ILogger<FunctionDailyUsageRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionDailyUsage> GetAsync(Guid functionId, DateTime date)
+        {
+            var usage = await _storageProvider.GetByFilterAsync<FunctionDailyUsage>(
+                CollectionName,
+                u => u.FunctionId == functionId && u.Date == date);
+
+            return usage.FirstOrDefault();
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<FunctionDailyUsage>> GetByFunctionIdAsync(Guid functionId, DateTime from, DateTime to)
+        {
+            var usage = await _storageProvider.GetByFilterAsync<FunctionDailyUsage>(
+                CollectionName,
+                u => u.FunctionId == functionId && u.Date >= from && u.Date <= to);
+
+            return usage.OrderBy(u => u.Date).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionDailyUsage> CreateAsync(FunctionDailyUsage usage)
+        {
+            ValidationUtility.ValidateNotNull(usage, nameof(usage));
+            ValidationUtility.ValidateGuid(usage.Id, "Usage ID");
+
+            _logger.LogDebug("Starting usage record of function {FunctionId} for {Date:yyyy-MM-dd}", usage.FunctionId, usage.Date);
+            await _storageProvider.CreateAsync(CollectionName, usage);
+
+            return usage;
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionDailyUsage> UpdateAsync(FunctionDailyUsage usage)
+        {
+            ValidationUtility.ValidateNotNull(usage, nameof(usage));
+            ValidationUtility.ValidateGuid(usage.Id, "Usage ID");
+
+            await _storageProvider.UpdateAsync<FunctionDailyUsage, Guid>(CollectionName, usage.Id, usage);
+
+            return usage;
+        }
+    }
+}
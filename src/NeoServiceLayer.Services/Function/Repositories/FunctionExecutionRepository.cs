@@ -11,20 +11,27 @@ namespace NeoServiceLayer.Services.Function.Repositories
     /// <summary>
     /// Implementation of the function execution repository
     /// </summary>
+    /// <remarks>
+    /// When a data protector is available, execution inputs, outputs and logs are stored encrypted with
+    /// the owning account's data key and decrypted on read.
+    /// </remarks>
     public class FunctionExecutionRepository : Core.Interfaces.IFunctionExecutionRepository
     {
         private readonly ILogger<FunctionExecutionRepository> _logger;
         private readonly Dictionary<Guid, FunctionExecution> _executions;
         private readonly Dictionary<Guid, List<FunctionLog>> _logs;
         private readonly Dictionary<Guid, FunctionExecutionResult> _executionResults;
+        private readonly IExecutionDataProtector _dataProtector;
 
         /// <summary>
         /// Initializes a new instance of the <see cref="FunctionExecutionRepository"/> class
         /// </summary>
         /// <param name="logger">Logger</param>
-        public FunctionExecutionRepository(ILogger<FunctionExecutionRepository> logger)
+        /// <param name="dataProtector">Protector encrypting execution data at rest</param>
+        public FunctionExecutionRepository(ILogger<FunctionExecutionRepository> logger, IExecutionDataProtector dataProtector = null)
         {
             _logger = logger;
+            _dataProtector = dataProtector;
             _executions = new Dictionary<Guid, FunctionExecution>();
             _logs = new Dictionary<Guid, List<FunctionLog>>();
             _executionResults = new Dictionary<Guid, FunctionExecutionResult>();
@@ -85,16 +92,16 @@ namespace NeoServiceLayer.Services.Function.Repositories
         #region Core.Interfaces.IFunctionExecutionRepository Implementation
 
         /// <inheritdoc/>
-        public Task<FunctionExecutionResult?> GetByIdAsync(Guid id)
+        public async Task<FunctionExecutionResult?> GetByIdAsync(Guid id)
         {
             _logger.LogInformation("Getting function execution result by ID: {Id}", id);
 
             _executionResults.TryGetValue(id, out var executionResult);
-            return Task.FromResult<FunctionExecutionResult?>(executionResult);
+            return await UnprotectAsync(executionResult);
         }
 
         /// <inheritdoc/>
-        public Task<IEnumerable<FunctionExecutionResult>> GetByFunctionIdAsync(Guid functionId, int limit = 100, int offset = 0)
+        public async Task<IEnumerable<FunctionExecutionResult>> GetByFunctionIdAsync(Guid functionId, int limit = 100, int offset = 0)
         {
             _logger.LogInformation("Getting function execution results by function ID: {FunctionId}, Limit: {Limit}, Offset: {Offset}", functionId, limit, offset);
 
@@ -105,27 +112,26 @@ namespace NeoServiceLayer.Services.Function.Repositories
                 .Take(limit)
                 .ToList();
 
-            return Task.FromResult<IEnumerable<FunctionExecutionResult>>(executionResults);
+            return await UnprotectAsync(executionResults);
         }
 
         /// <inheritdoc/>
-        public Task<IEnumerable<FunctionExecutionResult>> GetByAccountIdAsync(Guid accountId, int limit = 100, int offset = 0)
+        public async Task<IEnumerable<FunctionExecutionResult>> GetByAccountIdAsync(Guid accountId, int limit = 100, int offset = 0)
         {
             _logger.LogInformation("Getting function execution results by account ID: {AccountId}, Limit: {Limit}, Offset: {Offset}", accountId, limit, offset);
 
-            // Note: FunctionExecutionResult doesn't have AccountId property, so we can't filter by it
-            // This is a placeholder implementation
             var executionResults = _executionResults.Values
+                .Where(e => e.AccountId == accountId)
                 .OrderByDescending(e => e.StartTime)
                 .Skip(offset)
                 .Take(limit)
                 .ToList();
 
-            return Task.FromResult<IEnumerable<FunctionExecutionResult>>(executionResults);
+            return await UnprotectAsync(executionResults);
         }
 
         /// <inheritdoc/>
-        public Task<IEnumerable<FunctionExecutionResult>> GetByStatusAsync(string status, int limit = 100, int offset = 0)
+        public async Task<IEnumerable<FunctionExecutionResult>> GetByStatusAsync(string status, int limit = 100, int offset = 0)
         {
             _logger.LogInformation("Getting function execution results by status: {Status}, Limit: {Limit}, Offset: {Offset}", status, limit, offset);
 
@@ -136,11 +142,11 @@ namespace NeoServiceLayer.Services.Function.Repositories
                 .Take(limit)
                 .ToList();
 
-            return Task.FromResult<IEnumerable<FunctionExecutionResult>>(executionResults);
+            return await UnprotectAsync(executionResults);
         }
 
         /// <inheritdoc/>
-        public Task<IEnumerable<FunctionExecutionResult>> GetByTimeRangeAsync(DateTime startTime, DateTime endTime, int limit = 100, int offset = 0)
+        public async Task<IEnumerable<FunctionExecutionResult>> GetByTimeRangeAsync(DateTime startTime, DateTime endTime, int limit = 100, int offset = 0)
         {
             _logger.LogInformation("Getting function execution results by time range: {StartTime} to {EndTime}, Limit: {Limit}, Offset: {Offset}", startTime, endTime, limit, offset);
 
@@ -151,11 +157,11 @@ namespace NeoServiceLayer.Services.Function.Repositories
                 .Take(limit)
                 .ToList();
 
-            return Task.FromResult<IEnumerable<FunctionExecutionResult>>(executionResults);
+            return await UnprotectAsync(executionResults);
         }
 
         /// <inheritdoc/>
-        public Task<FunctionExecutionResult> CreateAsync(FunctionExecutionResult execution)
+        public async Task<FunctionExecutionResult> CreateAsync(FunctionExecutionResult execution)
         {
             _logger.LogInformation("Creating function execution result: {Id}, FunctionId: {FunctionId}", execution.Id, execution.FunctionId);
 
@@ -164,25 +170,25 @@ namespace NeoServiceLayer.Services.Function.Repositories
                 execution.Id = Guid.NewGuid();
             }
 
-            _executionResults[execution.Id] = execution;
+            _executionResults[execution.Id] = await ProtectAsync(execution);
 
-            return Task.FromResult(execution);
+            return execution;
         }
 
         /// <inheritdoc/>
-        public Task<FunctionExecutionResult> UpdateAsync(Guid id, FunctionExecutionResult execution)
+        public async Task<FunctionExecutionResult> UpdateAsync(Guid id, FunctionExecutionResult execution)
         {
             _logger.LogInformation("Updating function execution result: {Id}", id);
 
             if (!_executionResults.ContainsKey(id))
             {
-                return Task.FromResult<FunctionExecutionResult?>(null);
+                return null;
             }
 
             execution.Id = id;
-            _executionResults[id] = execution;
+            _executionResults[id] = await ProtectAsync(execution);
 
-            return Task.FromResult(execution);
+            return execution;
         }
 
         /// <inheritdoc/>
@@ -202,7 +208,7 @@ namespace NeoServiceLayer.Services.Function.Repositories
         }
 
         /// <inheritdoc/>
-        public Task<IEnumerable<FunctionExecutionResult>> GetAllAsync(int limit = 100, int offset = 0)
+        public async Task<IEnumerable<FunctionExecutionResult>> GetAllAsync(int limit = 100, int offset = 0)
         {
             _logger.LogInformation("Getting all function execution results, Limit: {Limit}, Offset: {Offset}", limit, offset);
 
@@ -212,7 +218,7 @@ namespace NeoServiceLayer.Services.Function.Repositories
                 .Take(limit)
                 .ToList();
 
-            return Task.FromResult<IEnumerable<FunctionExecutionResult>>(executionResults);
+            return await UnprotectAsync(executionResults);
         }
 
         /// <inheritdoc/>
@@ -237,9 +243,8 @@ namespace NeoServiceLayer.Services.Function.Repositories
         {
             _logger.LogInformation("Counting function execution results by account ID: {AccountId}", accountId);
 
-            // Note: FunctionExecutionResult doesn't have AccountId property, so we can't filter by it
-            // This is a placeholder implementation
-            return Task.FromResult(_executionResults.Count);
+            var count = _executionResults.Values.Count(e => e.AccountId == accountId);
+            return Task.FromResult(count);
         }
 
         /// <inheritdoc/>
@@ -261,5 +266,31 @@ namespace NeoServiceLayer.Services.Function.Repositories
         }
 
         #endregion
+
+        private async Task<FunctionExecutionResult> ProtectAsync(FunctionExecutionResult execution)
+        {
+            return _dataProtector == null ? execution : await _dataProtector.ProtectAsync(execution);
+        }
+
+        private async Task<FunctionExecutionResult> UnprotectAsync(FunctionExecutionResult execution)
+        {
+            return _dataProtector == null || execution == null ? execution : await _dataProtector.UnprotectAsync(execution);
+        }
+
+        private async Task<IEnumerable<FunctionExecutionResult>> UnprotectAsync(List<FunctionExecutionResult> executions)
+        {
+            if (_dataProtector == null)
+            {
+                return executions;
+            }
+
+            var results = new List<FunctionExecutionResult>(executions.Count);
+            foreach (var execution in executions)
+            {
+                results.Add(await _dataProtector.UnprotectAsync(execution));
+            }
+
+            return results;
+        }
     }
 }
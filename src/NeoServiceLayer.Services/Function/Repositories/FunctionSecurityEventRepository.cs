@@ -0,0 +1,61 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Function.Repositories
+{
+    /// <summary>
+    /// Repository for function security events
+    /// </summary>
+    public class FunctionSecurityEventRepository : IFunctionSecurityEventRepository
+    {
+        private readonly ILogger<FunctionSecurityEventRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private readonly string _collectionName = "function_security_events";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="FunctionSecurityEventRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public FunctionSecurityEventRepository(ILogger<FunctionSecurityEventRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionSecurityEvent> CreateAsync(FunctionSecurityEvent securityEvent)
+        {
+            _logger.LogInformation("Recording {Type} security event for function {FunctionId}", securityEvent.Type, securityEvent.FunctionId);
+
+            await _storageProvider.CreateAsync(_collectionName, securityEvent);
+
+            return securityEvent;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<FunctionSecurityEvent>> GetByFunctionIdAsync(Guid functionId, DateTime since)
+        {
+            var events = await _storageProvider.GetByFilterAsync<FunctionSecurityEvent>(
+                _collectionName,
+                e => e.FunctionId == functionId && e.Timestamp >= since);
+
+            return events.OrderByDescending(e => e.Timestamp);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<FunctionSecurityEvent>> GetByAccountIdAsync(Guid accountId, DateTime since)
+        {
+            var events = await _storageProvider.GetByFilterAsync<FunctionSecurityEvent>(
+                _collectionName,
+                e => e.AccountId == accountId && e.Timestamp >= since);
+
+            return events.OrderByDescending(e => e.Timestamp);
+        }
+    }
+}
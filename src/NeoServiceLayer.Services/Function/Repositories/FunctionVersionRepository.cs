@@ -0,0 +1,83 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Function.Repositories
+{
+    /// <summary>
+    /// Repository for function versions
+    /// </summary>
+    public class FunctionVersionRepository : IFunctionVersionRepository
+    {
+        private readonly ILogger<FunctionVersionRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private readonly string _collectionName = "function_versions";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="FunctionVersionRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public FunctionVersionRepository(ILogger<FunctionVersionRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionVersion> CreateAsync(FunctionVersion version)
+        {
+            _logger.LogInformation("Creating version {Number} of function {FunctionId}", version.Number, version.FunctionId);
+
+            // Save to store
+            await _storageProvider.CreateAsync(_collectionName, version);
+
+            return version;
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionVersion> UpdateAsync(FunctionVersion version)
+        {
+            _logger.LogInformation("Updating function version: {Id}", version.Id);
+
+            // Update in store
+            await _storageProvider.UpdateAsync<FunctionVersion, Guid>(_collectionName, version.Id, version);
+
+            return version;
+        }
+
+        /// <inheritdoc/>
+        public async Task<FunctionVersion> GetByIdAsync(Guid id)
+        {
+            _logger.LogInformation("Getting function version by ID: {Id}", id);
+
+            // Get from store
+            return await _storageProvider.GetByIdAsync<FunctionVersion, Guid>(_collectionName, id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<FunctionVersion>> GetByFunctionIdAsync(Guid functionId)
+        {
+            _logger.LogInformation("Getting versions of function: {FunctionId}", functionId);
+
+            // Get all versions
+            var versions = await _storageProvider.GetAllAsync<FunctionVersion>(_collectionName);
+
+            // Filter by function ID
+            return versions.Where(v => v.FunctionId == functionId).OrderByDescending(v => v.Number).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAsync(Guid id)
+        {
+            _logger.LogInformation("Deleting function version: {Id}", id);
+
+            // Delete from store
+            return await _storageProvider.DeleteAsync<FunctionVersion, Guid>(_collectionName, id);
+        }
+    }
+}
@@ -0,0 +1,83 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Function.Repositories
+{
+    /// <summary>
+    /// Repository for webhook triggers
+    /// </summary>
+    public class WebhookTriggerRepository : IWebhookTriggerRepository
+    {
+        private readonly ILogger<WebhookTriggerRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private readonly string _collectionName = "webhook_triggers";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="WebhookTriggerRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public WebhookTriggerRepository(ILogger<WebhookTriggerRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<WebhookTrigger> CreateAsync(WebhookTrigger trigger)
+        {
+            _logger.LogInformation("Creating webhook trigger {Name} for function {FunctionId}", trigger.Name, trigger.FunctionId);
+
+            // Save to store
+            await _storageProvider.CreateAsync(_collectionName, trigger);
+
+            return trigger;
+        }
+
+        /// <inheritdoc/>
+        public async Task<WebhookTrigger> UpdateAsync(WebhookTrigger trigger)
+        {
+            _logger.LogInformation("Updating webhook trigger: {Id}", trigger.Id);
+
+            // Update in store
+            await _storageProvider.UpdateAsync<WebhookTrigger, Guid>(_collectionName, trigger.Id, trigger);
+
+            return trigger;
+        }
+
+        /// <inheritdoc/>
+        public async Task<WebhookTrigger> GetByIdAsync(Guid id)
+        {
+            _logger.LogInformation("Getting webhook trigger by ID: {Id}", id);
+
+            // Get from store
+            return await _storageProvider.GetByIdAsync<WebhookTrigger, Guid>(_collectionName, id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<WebhookTrigger>> GetByAccountIdAsync(Guid accountId)
+        {
+            _logger.LogInformation("Getting webhook triggers by account ID: {AccountId}", accountId);
+
+            // Get all triggers
+            var triggers = await _storageProvider.GetAllAsync<WebhookTrigger>(_collectionName);
+
+            // Filter by account ID
+            return triggers.Where(t => t.AccountId == accountId);
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAsync(Guid id)
+        {
+            _logger.LogInformation("Deleting webhook trigger: {Id}", id);
+
+            // Delete from store
+            return await _storageProvider.DeleteAsync<WebhookTrigger, Guid>(_collectionName, id);
+        }
+    }
+}
@@ -52,6 +52,9 @@ namespace NeoServiceLayer.Services.Function.Runtimes
             {
                 case "javascript":
                 case "js":
+                // TypeScript functions run the JavaScript they were built to
+                case "typescript":
+                case "ts":
                     runtime = _serviceProvider.GetRequiredService<JavaScriptRuntime>();
                     break;
 
@@ -181,8 +181,8 @@ namespace NeoServiceLayer.Services.Function.Runtimes
                 // Register parameters
                 engine.SetValue("params", parameters);
 
-                // Execute the function
-                var functionCode = function.Code;
+                // Execute the function, preferring the build output of compiled sources
+                var functionCode = function.CompiledCode ?? function.Code;
                 var output = engine.Evaluate(functionCode);
 
                 // Convert output to .NET object
@@ -0,0 +1,437 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Security.Cryptography;
+using System.Text;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using Newtonsoft.Json;
+using Newtonsoft.Json.Linq;
+
+namespace NeoServiceLayer.Services.Function
+{
+    /// <summary>
+    /// Service for webhook ingestion triggers
+    /// </summary>
+    /// <remarks>
+    /// A delivery is verified against the trigger's signing secret, shaped into parameters by the trigger's
+    /// <see cref="WebhookTransformation"/> and then passed to the function like a regular execution.
+    /// </remarks>
+    public class WebhookTriggerService : IWebhookTriggerService
+    {
+        private const string PayloadParameter = "payload";
+
+        // Headers that describe the connection or belong to the platform's domain, which a function may not set
+        private static readonly HashSet<string> RestrictedResponseHeaders = new HashSet<string>(StringComparer.OrdinalIgnoreCase)
+        {
+            "Connection", "Content-Length", "Keep-Alive", "Transfer-Encoding", "Upgrade", "Set-Cookie", "Strict-Transport-Security"
+        };
+
+        private readonly ILogger<WebhookTriggerService> _logger;
+        private readonly IWebhookTriggerRepository _triggerRepository;
+        private readonly IFunctionService _functionService;
+        private readonly IServerEventPublisher _eventPublisher;
+        private readonly SemaphoreSlim _updateSemaphore = new SemaphoreSlim(1, 1);
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="WebhookTriggerService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="triggerRepository">Webhook trigger repository</param>
+        /// <param name="functionService">Function service</param>
+        /// <param name="eventPublisher">Publisher of trigger events to event stream subscribers</param>
+        public WebhookTriggerService(
+            ILogger<WebhookTriggerService> logger,
+            IWebhookTriggerRepository triggerRepository,
+            IFunctionService functionService,
+            IServerEventPublisher eventPublisher = null)
+        {
+            _logger = logger;
+            _triggerRepository = triggerRepository;
+            _functionService = functionService;
+            _eventPublisher = eventPublisher;
+        }
+
+        /// <inheritdoc/>
+        public async Task<WebhookTrigger> CreateAsync(WebhookTrigger trigger)
+        {
+            await ValidateAsync(trigger);
+
+            trigger.Id = Guid.NewGuid();
+            trigger.CreatedAt = DateTime.UtcNow;
+            trigger.UpdatedAt = trigger.CreatedAt;
+            trigger.LastTriggeredAt = null;
+            trigger.TriggerCount = 0;
+
+            _logger.LogInformation("Creating webhook trigger {Name} for function {FunctionId}", trigger.Name, trigger.FunctionId);
+            return await _triggerRepository.CreateAsync(trigger);
+        }
+
+        /// <inheritdoc/>
+        public async Task<WebhookTrigger> UpdateAsync(WebhookTrigger trigger)
+        {
+            await _updateSemaphore.WaitAsync();
+            try
+            {
+                var existing = await _triggerRepository.GetByIdAsync(trigger.Id);
+                if (existing == null)
+                {
+                    throw new ResourceNotFoundException("WebhookTrigger", trigger.Id.ToString());
+                }
+
+                if (trigger.Revision != existing.Revision)
+                {
+                    throw new ConcurrencyConflictException("WebhookTrigger", trigger.Id.ToString(), existing.Revision);
+                }
+
+                // The secret is write-only: omit it to keep the current one, send an empty string to remove it
+                trigger.SigningSecret = trigger.SigningSecret == null
+                    ? existing.SigningSecret
+                    : (trigger.SigningSecret.Length == 0 ? null : trigger.SigningSecret);
+
+                await ValidateAsync(trigger);
+
+                trigger.AccountId = existing.AccountId;
+                trigger.CreatedAt = existing.CreatedAt;
+                trigger.LastTriggeredAt = existing.LastTriggeredAt;
+                trigger.TriggerCount = existing.TriggerCount;
+                trigger.UpdatedAt = DateTime.UtcNow;
+                trigger.Revision = existing.Revision + 1;
+
+                return await _triggerRepository.UpdateAsync(trigger);
+            }
+            finally
+            {
+                _updateSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public Task<WebhookTrigger> GetByIdAsync(Guid id)
+        {
+            return _triggerRepository.GetByIdAsync(id);
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<WebhookTrigger>> GetByAccountIdAsync(Guid accountId)
+        {
+            return _triggerRepository.GetByAccountIdAsync(accountId);
+        }
+
+        /// <inheritdoc/>
+        public Task<bool> DeleteAsync(Guid id)
+        {
+            return _triggerRepository.DeleteAsync(id);
+        }
+
+        /// <inheritdoc/>
+        public Dictionary<string, object> Transform(WebhookTrigger trigger, string body, IDictionary<string, string> headers)
+        {
+            var transformation = trigger.Transformation ?? new WebhookTransformation();
+            var parameters = new Dictionary<string, object>();
+
+            JToken payload;
+            try
+            {
+                payload = string.IsNullOrWhiteSpace(body) ? new JObject() : JToken.Parse(body);
+            }
+            catch (JsonReaderException ex)
+            {
+                throw new ValidationException($"Webhook payload is not valid JSON: {ex.Message}");
+            }
+
+            // Payload extraction
+            foreach (var rule in transformation.Extract ?? new Dictionary<string, string>())
+            {
+                var matches = payload.SelectTokens(rule.Value).ToList();
+                if (matches.Count == 1)
+                {
+                    parameters[rule.Key] = ToPlainValue(matches[0]);
+                }
+                else if (matches.Count > 1)
+                {
+                    parameters[rule.Key] = matches.Select(ToPlainValue).ToList();
+                }
+            }
+
+            if (transformation.IncludePayload || transformation.Extract == null || transformation.Extract.Count == 0)
+            {
+                parameters[PayloadParameter] = ToPlainValue(payload);
+            }
+
+            // Header mapping
+            if (headers != null && transformation.Headers != null)
+            {
+                var caseInsensitiveHeaders = new Dictionary<string, string>(headers, StringComparer.OrdinalIgnoreCase);
+                foreach (var mapping in transformation.Headers)
+                {
+                    if (caseInsensitiveHeaders.TryGetValue(mapping.Key, out var value))
+                    {
+                        parameters[mapping.Value] = value;
+                    }
+                }
+            }
+
+            // Static enrichment is applied last so senders cannot override it
+            foreach (var value in transformation.Static ?? new Dictionary<string, object>())
+            {
+                parameters[value.Key] = value.Value;
+            }
+
+            var missing = (transformation.Required ?? new List<string>())
+                .Where(name => !parameters.TryGetValue(name, out var value) || value == null)
+                .ToList();
+            if (missing.Count > 0)
+            {
+                throw new ValidationException($"Webhook payload is missing required parameters: {string.Join(", ", missing)}");
+            }
+
+            return parameters;
+        }
+
+        /// <inheritdoc/>
+        public async Task<object> IngestAsync(Guid id, string body, IDictionary<string, string> headers)
+        {
+            var trigger = await _triggerRepository.GetByIdAsync(id);
+            if (trigger == null || !trigger.Enabled)
+            {
+                // Disabled triggers look the same as missing ones to the sender
+                throw new ResourceNotFoundException("WebhookTrigger", id.ToString());
+            }
+
+            if (!VerifySignature(trigger, body, headers))
+            {
+                _logger.LogWarning("Rejected webhook delivery for trigger {Id}: invalid signature", id);
+                throw new ForbiddenAccessException("Invalid webhook signature");
+            }
+
+            var parameters = Transform(trigger, body, headers);
+
+            _logger.LogInformation("Executing function {FunctionId} for webhook trigger {Id} with {ParameterCount} parameters",
+                trigger.FunctionId, id, parameters.Count);
+
+            trigger.LastTriggeredAt = DateTime.UtcNow;
+            trigger.TriggerCount++;
+            await _triggerRepository.UpdateAsync(trigger);
+
+            try
+            {
+                var result = await _functionService.ExecuteAsync(trigger.FunctionId, parameters);
+                PublishTriggered(trigger, true);
+                return trigger.ResponseMode == WebhookResponseModes.Http ? ToHttpResponse(trigger, result) : result;
+            }
+            catch (Exception)
+            {
+                PublishTriggered(trigger, false);
+                throw;
+            }
+        }
+
+        /// <summary>
+        /// Maps the <c>{status, headers, body}</c> object a function returned to an HTTP response
+        /// </summary>
+        /// <param name="trigger">Webhook trigger</param>
+        /// <param name="result">Function result</param>
+        /// <returns>The HTTP response, or a 502 response when the function did not return a valid one</returns>
+        private FunctionHttpResponse ToHttpResponse(WebhookTrigger trigger, object result)
+        {
+            var token = result == null ? null : JToken.FromObject(result);
+
+            // The enclave response wraps the sandbox envelope, which wraps the value the function returned
+            while (token is JObject envelope && envelope["Result"] != null && (envelope.ContainsKey("Logs") || envelope.ContainsKey("FunctionId")))
+            {
+                token = envelope["Result"];
+            }
+
+            if (token is not JObject value)
+            {
+                return InvalidHttpResponse(trigger, "the function did not return an object");
+            }
+
+            var response = new FunctionHttpResponse();
+
+            var status = value["status"];
+            if (status != null && status.Type != JTokenType.Null)
+            {
+                if (status.Type != JTokenType.Integer && status.Type != JTokenType.Float)
+                {
+                    return InvalidHttpResponse(trigger, "status must be a number");
+                }
+
+                var code = status.Value<double>();
+                if (code % 1 != 0 || code < 100 || code > 599)
+                {
+                    return InvalidHttpResponse(trigger, $"status {code} is not a valid HTTP status code");
+                }
+
+                response.StatusCode = (int)code;
+            }
+
+            var headers = value["headers"];
+            if (headers != null && headers.Type != JTokenType.Null)
+            {
+                if (headers is not JObject headerObject)
+                {
+                    return InvalidHttpResponse(trigger, "headers must be an object");
+                }
+
+                foreach (var header in headerObject.Properties())
+                {
+                    if (header.Value is not JValue headerValue || headerValue.Value == null)
+                    {
+                        continue;
+                    }
+
+                    if (RestrictedResponseHeaders.Contains(header.Name))
+                    {
+                        _logger.LogDebug("Dropped restricted header {Header} from the response of webhook trigger {Id}", header.Name, trigger.Id);
+                        continue;
+                    }
+
+                    response.Headers[header.Name] = headerValue.ToString(System.Globalization.CultureInfo.InvariantCulture);
+                }
+            }
+
+            var body = value["body"];
+            if (body != null && body.Type != JTokenType.Null)
+            {
+                // Strings are sent as they are, anything else as JSON
+                var isText = body.Type == JTokenType.String;
+                response.Body = isText ? body.Value<string>() : body.ToString(Formatting.None);
+                if (!response.Headers.ContainsKey("Content-Type"))
+                {
+                    response.Headers["Content-Type"] = isText ? "text/plain; charset=utf-8" : "application/json; charset=utf-8";
+                }
+            }
+
+            return response;
+        }
+
+        private FunctionHttpResponse InvalidHttpResponse(WebhookTrigger trigger, string reason)
+        {
+            _logger.LogWarning("Function {FunctionId} of webhook trigger {Id} returned an invalid HTTP response: {Reason}", trigger.FunctionId, trigger.Id, reason);
+            return new FunctionHttpResponse
+            {
+                StatusCode = 502,
+                Headers = new Dictionary<string, string>(StringComparer.OrdinalIgnoreCase) { ["Content-Type"] = "application/json; charset=utf-8" },
+                Body = JsonConvert.SerializeObject(new { Message = $"Function returned an invalid HTTP response: {reason}" })
+            };
+        }
+
+        private void PublishTriggered(WebhookTrigger trigger, bool succeeded)
+        {
+            _eventPublisher?.Publish(new ServerEvent
+            {
+                Topic = ServerEventTopics.Triggers,
+                Type = "webhook",
+                AccountId = trigger.AccountId,
+                Data = new { TriggerId = trigger.Id, trigger.FunctionId, Succeeded = succeeded, trigger.TriggerCount }
+            });
+        }
+
+        private async Task ValidateAsync(WebhookTrigger trigger)
+        {
+            if (trigger == null)
+            {
+                throw new ArgumentNullException(nameof(trigger));
+            }
+
+            if (string.IsNullOrWhiteSpace(trigger.Name))
+            {
+                throw new ValidationException("Webhook trigger name is required");
+            }
+
+            trigger.ResponseMode = string.IsNullOrEmpty(trigger.ResponseMode) ? WebhookResponseModes.Envelope : trigger.ResponseMode;
+            if (!WebhookResponseModes.All.Contains(trigger.ResponseMode))
+            {
+                throw new ValidationException($"Response mode must be one of: {string.Join(", ", WebhookResponseModes.All)}");
+            }
+
+            var function = await _functionService.GetByIdAsync(trigger.FunctionId);
+            if (function == null)
+            {
+                throw new ResourceNotFoundException("Function", trigger.FunctionId.ToString());
+            }
+
+            if (function.AccountId != trigger.AccountId)
+            {
+                throw new ForbiddenAccessException("Function", trigger.FunctionId.ToString(), trigger.AccountId.ToString());
+            }
+
+            if (!string.IsNullOrEmpty(trigger.SigningSecret) && string.IsNullOrWhiteSpace(trigger.SignatureHeader))
+            {
+                throw new ValidationException("A signature header is required when a signing secret is set");
+            }
+
+            trigger.Tags = trigger.Tags.NormalizeTags();
+
+            // Reject malformed JSONPath expressions now rather than on the first delivery
+            foreach (var rule in trigger.Transformation?.Extract ?? new Dictionary<string, string>())
+            {
+                try
+                {
+                    new JObject().SelectTokens(rule.Value).ToList();
+                }
+                catch (JsonException ex)
+                {
+                    throw new ValidationException($"Invalid JSONPath for parameter '{rule.Key}': {ex.Message}");
+                }
+            }
+        }
+
+        private static bool VerifySignature(WebhookTrigger trigger, string body, IDictionary<string, string> headers)
+        {
+            if (string.IsNullOrEmpty(trigger.SigningSecret))
+            {
+                return true;
+            }
+
+            var signature = headers?
+                .FirstOrDefault(h => string.Equals(h.Key, trigger.SignatureHeader, StringComparison.OrdinalIgnoreCase))
+                .Value;
+            if (string.IsNullOrEmpty(signature))
+            {
+                return false;
+            }
+
+            if (signature.StartsWith("sha256=", StringComparison.OrdinalIgnoreCase))
+            {
+                signature = signature.Substring("sha256=".Length);
+            }
+
+            byte[] provided;
+            try
+            {
+                provided = Convert.FromHexString(signature);
+            }
+            catch (FormatException)
+            {
+                return false;
+            }
+
+            using var hmac = new HMACSHA256(Encoding.UTF8.GetBytes(trigger.SigningSecret));
+            var expected = hmac.ComputeHash(Encoding.UTF8.GetBytes(body ?? string.Empty));
+            return CryptographicOperations.FixedTimeEquals(expected, provided);
+        }
+
+        private static object ToPlainValue(JToken token)
+        {
+            switch (token)
+            {
+                case JObject obj:
+                    return obj.Properties().ToDictionary(p => p.Name, p => ToPlainValue(p.Value));
+                case JArray array:
+                    return array.Select(ToPlainValue).ToList();
+                case JValue value:
+                    return value.Value;
+                default:
+                    return null;
+            }
+        }
+    }
+}
@@ -1,14 +1,18 @@
 using System;
 using System.Collections.Generic;
 using System.Linq;
+using System.Threading;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
 using NeoServiceLayer.Core;
 using NeoServiceLayer.Core.Exceptions;
 using NeoServiceLayer.Core.Interfaces;
 using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
 using NeoServiceLayer.Core.Utilities;
 using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Services.Diagnostics;
 using NeoServiceLayer.Services.GasBank.Repositories;
 
 namespace NeoServiceLayer.Services.GasBank
@@ -16,7 +20,13 @@ namespace NeoServiceLayer.Services.GasBank
     /// <summary>
     /// Implementation of the GasBank service
     /// </summary>
-    public class GasBankService : IGasBankService
+    /// <remarks>
+    /// When a cold wallet is configured, each account's own wallet is its hot wallet and keeps only a
+    /// working float: excess GAS is swept to the cold wallet on a timer, and withdrawals the hot wallet
+    /// cannot cover are queued until an operator confirms a replenishment from cold storage. With or without
+    /// cold storage, a withdrawal whose transfer fails is queued and retried with a backoff.
+    /// </remarks>
+    public class GasBankService : IGasBankService, IManagedService, IDisposable
     {
         private readonly ILogger<GasBankService> _logger;
         private readonly IGasBankAccountRepository _accountRepository;
@@ -24,6 +34,18 @@ namespace NeoServiceLayer.Services.GasBank
         private readonly IGasBankTransactionRepository _transactionRepository;
         private readonly IWalletService _walletService;
         private readonly IEnclaveService _enclaveService;
+        private readonly IMaintenanceService _maintenanceService;
+        private readonly IGasBankWithdrawalRepository _withdrawalRepository;
+        private readonly ICrashReporter _crashReporter;
+        private readonly IServerEventPublisher _eventPublisher;
+        private readonly INeoRpcClient _neoRpcClient;
+        private readonly ILeadershipService _leadership;
+        private readonly GasBankConfiguration _configuration;
+        private readonly SemaphoreSlim _hotWalletSemaphore = new SemaphoreSlim(1, 1);
+        private readonly Timer _sweepTimer;
+        private readonly Timer _confirmationTimer;
+
+        private volatile bool _stopped;
 
         /// <summary>
         /// Initializes a new instance of the <see cref="GasBankService"/> class
@@ -34,13 +56,27 @@ namespace NeoServiceLayer.Services.GasBank
         /// <param name="transactionRepository">GasBank transaction repository</param>
         /// <param name="walletService">Wallet service</param>
         /// <param name="enclaveService">Enclave service</param>
+        /// <param name="withdrawalRepository">GasBank withdrawal repository</param>
+        /// <param name="maintenanceService">Maintenance service; withdrawals are blocked while maintenance is active</param>
+        /// <param name="configuration">Hot and cold wallet configuration; all GAS stays in the hot wallets when omitted</param>
+        /// <param name="crashReporter">Crash reporter for background work</param>
+        /// <param name="eventPublisher">Publisher of balance changes to event stream subscribers</param>
+        /// <param name="neoRpcClient">Neo RPC client; sent withdrawals are checked on chain when provided</param>
+        /// <param name="leadership">Leadership service; only the leader checks sent withdrawals and sends queued ones</param>
         public GasBankService(
             ILogger<GasBankService> logger,
             IGasBankAccountRepository accountRepository,
             IGasBankAllocationRepository allocationRepository,
             IGasBankTransactionRepository transactionRepository,
             IWalletService walletService,
-            IEnclaveService enclaveService)
+            IEnclaveService enclaveService,
+            IGasBankWithdrawalRepository withdrawalRepository,
+            IMaintenanceService maintenanceService = null,
+            IOptions<GasBankConfiguration> configuration = null,
+            ICrashReporter crashReporter = null,
+            IServerEventPublisher eventPublisher = null,
+            INeoRpcClient neoRpcClient = null,
+            ILeadershipService leadership = null)
         {
             _logger = logger;
             _accountRepository = accountRepository;
@@ -48,6 +84,34 @@ namespace NeoServiceLayer.Services.GasBank
             _transactionRepository = transactionRepository;
             _walletService = walletService;
             _enclaveService = enclaveService;
+            _maintenanceService = maintenanceService;
+            _withdrawalRepository = withdrawalRepository;
+            _configuration = configuration?.Value ?? new GasBankConfiguration();
+            _crashReporter = crashReporter;
+            _eventPublisher = eventPublisher;
+            _neoRpcClient = neoRpcClient;
+            _leadership = leadership;
+
+            if (_neoRpcClient != null && _configuration.WithdrawalConfirmationIntervalSeconds > 0)
+            {
+                _confirmationTimer = new Timer(
+                    _crashReporter.Guard(_logger, "GasBank.Confirm", ConfirmationCycleAsync),
+                    null,
+                    TimeSpan.FromSeconds(_configuration.WithdrawalConfirmationIntervalSeconds),
+                    TimeSpan.FromSeconds(_configuration.WithdrawalConfirmationIntervalSeconds));
+            }
+
+            if (_configuration.ColdStorageEnabled && !_configuration.ColdWalletAddress.IsValidNeoAddress())
+            {
+                throw new ArgumentException("GasBank cold wallet address is not a valid Neo address", nameof(configuration));
+            }
+
+            // The cycle also retries failed withdrawals, so it runs whether or not cold storage is enabled
+            _sweepTimer = new Timer(
+                _crashReporter.Guard(_logger, "GasBank.Sweep", SweepCycleAsync),
+                null,
+                TimeSpan.FromSeconds(_configuration.SweepIntervalSeconds),
+                TimeSpan.FromSeconds(_configuration.SweepIntervalSeconds));
         }
 
         /// <inheritdoc/>
@@ -93,6 +157,7 @@ namespace NeoServiceLayer.Services.GasBank
                             Name = name,
                             Balance = initialDeposit,
                             AllocatedAmount = 0,
+                            HotBalance = initialDeposit,
                             WalletId = wallet.Id,
                             NeoAddress = wallet.Address,
                             Tags = new Dictionary<string, string>(),
@@ -123,6 +188,7 @@ namespace NeoServiceLayer.Services.GasBank
                             };
 
                             await _transactionRepository.CreateAsync(transaction);
+                            PublishBalanceChanged(gasBankAccount, transaction);
                         }
 
                         return gasBankAccount;
@@ -249,42 +315,43 @@ namespace NeoServiceLayer.Services.GasBank
                     _logger,
                     async () =>
                     {
-                        // Get GasBank account
-                        var gasBankAccount = await _accountRepository.GetByIdAsync(id);
-                        if (gasBankAccount == null)
+                        GasBankTransaction transaction = null;
+
+                        // Update the balance and record the transaction together; deposits arrive in the hot wallet
+                        var gasBankAccount = await _accountRepository.UpdateBalanceAsync(id, account =>
                         {
-                            throw new GasBankException("GasBank account not found");
-                        }
+                            additionalData["AccountId"] = account.AccountId;
+                            additionalData["Name"] = account.Name;
+                            additionalData["PreviousBalance"] = account.Balance;
 
-                        additionalData["AccountId"] = gasBankAccount.AccountId;
-                        additionalData["Name"] = gasBankAccount.Name;
-                        additionalData["PreviousBalance"] = gasBankAccount.Balance;
+                            account.Balance += amount;
+                            account.HotBalance += amount;
+
+                            transaction = new GasBankTransaction
+                            {
+                                Id = Guid.NewGuid(),
+                                GasBankAccountId = account.Id,
+                                Type = GasBankTransactionType.Deposit,
+                                Amount = amount,
+                                BalanceAfter = account.Balance,
+                                TransactionHash = null, // No blockchain transaction for manual deposit
+                                RelatedEntityId = null,
+                                NeoAddress = null,
+                                Timestamp = DateTime.UtcNow,
+                                Description = "Manual deposit"
+                            };
 
-                        // Update balance
-                        gasBankAccount.Balance += amount;
-                        gasBankAccount.UpdatedAt = DateTime.UtcNow;
+                            return transaction;
+                        });
 
-                        // Create transaction record
-                        var transaction = new GasBankTransaction
+                        if (gasBankAccount == null)
                         {
-                            Id = Guid.NewGuid(),
-                            GasBankAccountId = gasBankAccount.Id,
-                            Type = GasBankTransactionType.Deposit,
-                            Amount = amount,
-                            BalanceAfter = gasBankAccount.Balance,
-                            TransactionHash = null, // No blockchain transaction for manual deposit
-                            RelatedEntityId = null,
-                            NeoAddress = null,
-                            Timestamp = DateTime.UtcNow,
-                            Description = "Manual deposit"
-                        };
-
-                        // Update account and create transaction
-                        await _accountRepository.UpdateAsync(gasBankAccount);
-                        await _transactionRepository.CreateAsync(transaction);
+                            throw new GasBankException("GasBank account not found");
+                        }
 
                         additionalData["NewBalance"] = gasBankAccount.Balance;
                         additionalData["TransactionId"] = transaction.Id;
+                        PublishBalanceChanged(gasBankAccount, transaction);
 
                         return gasBankAccount;
                     },
@@ -309,7 +376,7 @@ namespace NeoServiceLayer.Services.GasBank
         }
 
         /// <inheritdoc/>
-        public async Task<string> WithdrawAsync(Guid id, decimal amount, string toAddress)
+        public async Task<GasBankWithdrawal> WithdrawAsync(Guid id, decimal amount, string toAddress)
         {
             var requestId = Guid.NewGuid().ToString();
             var additionalData = new Dictionary<string, object>
@@ -321,6 +388,17 @@ namespace NeoServiceLayer.Services.GasBank
 
             LoggingUtility.LogOperationStart(_logger, "WithdrawFromGasBankAccount", requestId, additionalData);
 
+            if (_maintenanceService != null)
+            {
+                var maintenance = await _maintenanceService.GetStatusAsync();
+                if (maintenance.IsActive)
+                {
+                    var exception = new MaintenanceException("Withdrawals are paused during maintenance", maintenance.ExpectedEndTime);
+                    LoggingUtility.LogOperationFailure(_logger, "WithdrawFromGasBankAccount", requestId, exception, 0, additionalData);
+                    throw exception;
+                }
+            }
+
             try
             {
                 // Validate input
@@ -333,69 +411,163 @@ namespace NeoServiceLayer.Services.GasBank
                     throw new ArgumentException("Invalid Neo address format");
                 }
 
-                var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<GasBankService, string>(
+                var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<GasBankService, GasBankWithdrawal>(
                     _logger,
                     async () =>
                     {
-                        // Get GasBank account
-                        var gasBankAccount = await _accountRepository.GetByIdAsync(id);
-                        if (gasBankAccount == null)
-                        {
-                            throw new GasBankException("GasBank account not found");
-                        }
-
-                        additionalData["AccountId"] = gasBankAccount.AccountId;
-                        additionalData["Name"] = gasBankAccount.Name;
-                        additionalData["PreviousBalance"] = gasBankAccount.Balance;
-
-                        // Check if there's enough balance
-                        if (gasBankAccount.Balance < amount)
+                        await _hotWalletSemaphore.WaitAsync();
+                        try
                         {
-                            throw new GasBankException("Insufficient balance");
-                        }
+                            var withdrawal = new GasBankWithdrawal
+                            {
+                                Id = Guid.NewGuid(),
+                                GasBankAccountId = id,
+                                Amount = amount,
+                                ToAddress = toAddress,
+                                RequestedAt = DateTime.UtcNow
+                            };
 
-                        // Check if there's enough unallocated balance
-                        decimal availableBalance = gasBankAccount.Balance - gasBankAccount.AllocatedAmount;
-                        if (availableBalance < amount)
-                        {
-                            throw new GasBankException("Insufficient unallocated balance");
-                        }
+                            // Later withdrawals wait behind queued ones; the semaphore keeps the queue from changing meanwhile
+                            var hasQueued = await HasQueuedWithdrawalsAsync(id);
+                            decimal hotAmount = 0;
+                            GasBankTransaction transaction = null;
 
-                        // Transfer GAS from wallet to the specified address
-                        string transactionHash = await _walletService.TransferGasAsync(
-                            gasBankAccount.WalletId,
-                            Guid.NewGuid().ToString(), // Password is not used for service wallets
-                            toAddress,
-                            amount);
+                            var gasBankAccount = await _accountRepository.UpdateBalanceAsync(id, account =>
+                            {
+                                additionalData["AccountId"] = account.AccountId;
+                                additionalData["Name"] = account.Name;
+                                additionalData["PreviousBalance"] = account.Balance;
+
+                                // Check if there's enough balance
+                                if (account.Balance < amount)
+                                {
+                                    throw new GasBankException("Insufficient balance");
+                                }
+
+                                // Check if there's enough unallocated balance
+                                if (account.Balance - account.AllocatedAmount < amount)
+                                {
+                                    throw new GasBankException("Insufficient unallocated balance");
+                                }
+
+                                // A withdrawal reserves its amount until it is sent
+                                account.Balance -= amount;
+
+                                // Queue the withdrawal if the hot wallet can't cover it or earlier withdrawals are still waiting
+                                if ((_configuration.ColdStorageEnabled && account.HotBalance < amount) || hasQueued)
+                                {
+                                    withdrawal.Status = GasBankWithdrawalStatus.Queued;
+                                    withdrawal.StatusReason = account.HotBalance < amount
+                                        ? "Waiting for the hot wallet to be replenished"
+                                        : "Waiting for the account's earlier queued withdrawals";
+
+                                    // Queued withdrawals are recorded in the history when they are queued
+                                    transaction = CreateWithdrawalTransaction(account, withdrawal,
+                                        "Withdrawal to external address, queued until it can be sent");
+                                    return transaction;
+                                }
+
+                                // Recorded as sending before the transfer, so a restart mid-transfer leaves it in doubt rather than unrecorded
+                                withdrawal.Status = GasBankWithdrawalStatus.Sending;
+                                hotAmount = Math.Min(account.HotBalance, amount);
+                                account.HotBalance -= hotAmount;
+                                return null;
+                            });
+
+                            if (gasBankAccount == null)
+                            {
+                                throw new GasBankException("GasBank account not found");
+                            }
 
-                        // Update balance
-                        gasBankAccount.Balance -= amount;
-                        gasBankAccount.UpdatedAt = DateTime.UtcNow;
+                            await _withdrawalRepository.CreateAsync(withdrawal);
 
-                        // Create transaction record
-                        var transaction = new GasBankTransaction
-                        {
-                            Id = Guid.NewGuid(),
-                            GasBankAccountId = gasBankAccount.Id,
-                            Type = GasBankTransactionType.Withdrawal,
-                            Amount = amount,
-                            BalanceAfter = gasBankAccount.Balance,
-                            TransactionHash = transactionHash,
-                            RelatedEntityId = null,
-                            NeoAddress = toAddress,
-                            Timestamp = DateTime.UtcNow,
-                            Description = "Withdrawal to external address"
-                        };
+                            if (withdrawal.Status == GasBankWithdrawalStatus.Queued)
+                            {
+                                _logger.LogWarning(
+                                    "Queued withdrawal {WithdrawalId} of {Amount} GAS from GasBank account {GasBankAccountId}; hot wallet holds {HotBalance} GAS",
+                                    withdrawal.Id, amount, gasBankAccount.Id, gasBankAccount.HotBalance);
+                            }
+                            else
+                            {
+                                try
+                                {
+                                    // Transfer GAS from wallet to the specified address
+                                    withdrawal.TransactionHash = await _walletService.TransferGasAsync(
+                                        gasBankAccount.WalletId,
+                                        Guid.NewGuid().ToString(), // Password is not used for service wallets
+                                        toAddress,
+                                        amount);
+                                }
+                                catch (Exception ex)
+                                {
+                                    _logger.LogError(ex, "Error sending withdrawal {WithdrawalId} from GasBank account {GasBankAccountId}, attempt 1",
+                                        withdrawal.Id, gasBankAccount.Id);
+
+                                    // A failed transfer is retried like a queued withdrawal unless no attempts are left
+                                    withdrawal.Attempts = 1;
+                                    withdrawal.LastError = ex.Message;
+                                    var retry = withdrawal.Attempts < Math.Max(1, _configuration.MaxWithdrawalAttempts);
+                                    if (retry)
+                                    {
+                                        withdrawal.Status = GasBankWithdrawalStatus.Queued;
+                                        withdrawal.NextAttemptAt = DateTime.UtcNow.Add(GetWithdrawalRetryDelay(withdrawal.Attempts));
+                                        withdrawal.StatusReason = $"Attempt 1 of {_configuration.MaxWithdrawalAttempts} failed; retrying at {withdrawal.NextAttemptAt:u}";
+                                    }
+                                    else
+                                    {
+                                        withdrawal.Status = GasBankWithdrawalStatus.Failed;
+                                        withdrawal.StatusReason = "The transfer failed and the amount was returned to the balance";
+                                    }
+
+                                    await _withdrawalRepository.UpdateAsync(withdrawal);
+                                    gasBankAccount = await _accountRepository.UpdateBalanceAsync(id, account =>
+                                    {
+                                        account.HotBalance += hotAmount;
+                                        if (!retry)
+                                        {
+                                            account.Balance += amount;
+                                            return null;
+                                        }
+
+                                        // The amount stays reserved, and is recorded as withdrawn as for any queued withdrawal
+                                        transaction = CreateWithdrawalTransaction(account, withdrawal,
+                                            "Withdrawal to external address, queued for a retry after the transfer failed");
+                                        return transaction;
+                                    }) ?? gasBankAccount;
+
+                                    if (!retry)
+                                    {
+                                        throw;
+                                    }
+                                }
+
+                                if (withdrawal.Status == GasBankWithdrawalStatus.Sending)
+                                {
+                                    withdrawal.Status = GasBankWithdrawalStatus.Completed;
+                                    withdrawal.StatusReason = null;
+                                    withdrawal.CompletedAt = DateTime.UtcNow;
+                                    await _withdrawalRepository.UpdateAsync(withdrawal);
+
+                                    // Direct withdrawals are recorded in the history once sent; the balance was already reduced
+                                    transaction = CreateWithdrawalTransaction(gasBankAccount, withdrawal, "Withdrawal to external address");
+                                    await _transactionRepository.CreateAsync(transaction);
+                                }
+                            }
 
-                        // Update account and create transaction
-                        await _accountRepository.UpdateAsync(gasBankAccount);
-                        await _transactionRepository.CreateAsync(transaction);
+                            PublishBalanceChanged(gasBankAccount, transaction);
 
-                        additionalData["NewBalance"] = gasBankAccount.Balance;
-                        additionalData["TransactionId"] = transaction.Id;
-                        additionalData["TransactionHash"] = transactionHash;
+                            additionalData["NewBalance"] = gasBankAccount.Balance;
+                            additionalData["TransactionId"] = transaction.Id;
+                            additionalData["WithdrawalId"] = withdrawal.Id;
+                            additionalData["Status"] = withdrawal.Status.ToString();
+                            additionalData["TransactionHash"] = withdrawal.TransactionHash;
 
-                        return transactionHash;
+                            return withdrawal;
+                        }
+                        finally
+                        {
+                            _hotWalletSemaphore.Release();
+                        }
                     },
                     "WithdrawFromGasBankAccount",
                     requestId,
@@ -418,26 +590,26 @@ namespace NeoServiceLayer.Services.GasBank
         }
 
         /// <inheritdoc/>
-        public async Task<GasBankAllocation> AllocateToFunctionAsync(Guid id, Guid functionId, decimal amount)
+        public async Task<IEnumerable<GasPayoutReceipt>> DistributeAsync(Guid id, decimal totalAmount, List<GasPayoutRecipient> recipients, Guid? relatedEntityId = null)
         {
             var requestId = Guid.NewGuid().ToString();
             var additionalData = new Dictionary<string, object>
             {
                 ["Id"] = id,
-                ["FunctionId"] = functionId,
-                ["Amount"] = amount
+                ["TotalAmount"] = totalAmount,
+                ["RecipientCount"] = recipients?.Count ?? 0,
+                ["RelatedEntityId"] = relatedEntityId
             };
 
-            LoggingUtility.LogOperationStart(_logger, "AllocateToFunction", requestId, additionalData);
+            LoggingUtility.LogOperationStart(_logger, "DistributeFromGasBankAccount", requestId, additionalData);
 
             try
             {
                 // Validate input
                 ValidationUtility.ValidateGuid(id, "GasBank account ID");
-                ValidationUtility.ValidateGuid(functionId, "Function ID");
-                ValidationUtility.ValidateGreaterThanZero(amount, "Amount");
+                var shares = GasPayoutSplitter.Split(totalAmount, recipients);
 
-                var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<GasBankService, GasBankAllocation>(
+                var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<GasBankService, IEnumerable<GasPayoutReceipt>>(
                     _logger,
                     async () =>
                     {
@@ -449,285 +621,454 @@ namespace NeoServiceLayer.Services.GasBank
                         }
 
                         additionalData["AccountId"] = gasBankAccount.AccountId;
-                        additionalData["Name"] = gasBankAccount.Name;
-                        additionalData["CurrentBalance"] = gasBankAccount.Balance;
-                        additionalData["CurrentAllocatedAmount"] = gasBankAccount.AllocatedAmount;
+                        additionalData["PreviousBalance"] = gasBankAccount.Balance;
 
                         // Check if there's enough unallocated balance
                         decimal availableBalance = gasBankAccount.Balance - gasBankAccount.AllocatedAmount;
-                        if (availableBalance < amount)
+                        if (availableBalance < totalAmount)
                         {
                             throw new GasBankException("Insufficient unallocated balance");
                         }
 
-                        // Check if there's already an allocation for this function
-                        var existingAllocations = await _allocationRepository.GetByFunctionIdAsync(functionId);
-                        var existingAllocation = existingAllocations.FirstOrDefault(a => a.GasBankAccountId == id);
-
-                        if (existingAllocation != null)
+                        // Payouts are not queued, so the hot wallet must cover them now
+                        if (_configuration.ColdStorageEnabled && gasBankAccount.HotBalance < totalAmount)
                         {
-                            throw new GasBankException("Allocation already exists for this function");
+                            throw new GasBankException("Insufficient hot wallet balance, a replenishment from cold storage is required");
                         }
 
-                        // Create allocation
-                        var allocation = new GasBankAllocation
+                        // Transfer all shares in one transaction
+                        string transactionHash = await _walletService.TransferGasMultiAsync(
+                            gasBankAccount.WalletId,
+                            Guid.NewGuid().ToString(), // Password is not used for service wallets
+                            shares.ToDictionary(s => s.Key.Address, s => s.Value));
+
+                        // Record a transaction per recipient so each payout has its own receipt; the GAS has left
+                        // the hot wallet, so the payouts are recorded even if the balance changed meanwhile
+                        var transactions = new List<GasBankTransaction>();
+                        gasBankAccount = await _accountRepository.UpdateBalanceWithEntriesAsync(id, account =>
                         {
-                            Id = Guid.NewGuid(),
-                            GasBankAccountId = id,
-                            FunctionId = functionId,
-                            Amount = amount,
-                            CreatedAt = DateTime.UtcNow,
-                            UpdatedAt = DateTime.UtcNow
-                        };
+                            transactions.Clear();
+                            foreach (var share in shares)
+                            {
+                                account.Balance -= share.Value;
+
+                                transactions.Add(new GasBankTransaction
+                                {
+                                    Id = Guid.NewGuid(),
+                                    GasBankAccountId = account.Id,
+                                    Type = GasBankTransactionType.Payout,
+                                    Amount = share.Value,
+                                    BalanceAfter = account.Balance,
+                                    TransactionHash = transactionHash,
+                                    RelatedEntityId = relatedEntityId,
+                                    NeoAddress = share.Key.Address,
+                                    Timestamp = DateTime.UtcNow,
+                                    Description = $"Payout share (weight {share.Key.Weight})"
+                                });
+                            }
 
-                        // Update GasBank account allocated amount
-                        gasBankAccount.AllocatedAmount += amount;
-                        gasBankAccount.UpdatedAt = DateTime.UtcNow;
+                            account.HotBalance = Math.Max(0, account.HotBalance - totalAmount);
+                            return transactions;
+                        });
 
-                        // Create transaction record
-                        var transaction = new GasBankTransaction
+                        if (gasBankAccount == null)
                         {
-                            Id = Guid.NewGuid(),
-                            GasBankAccountId = gasBankAccount.Id,
-                            Type = GasBankTransactionType.Allocation,
-                            Amount = amount,
-                            BalanceAfter = gasBankAccount.Balance,
-                            TransactionHash = null,
-                            RelatedEntityId = functionId,
-                            NeoAddress = null,
-                            Timestamp = DateTime.UtcNow,
-                            Description = $"Allocation to function {functionId}"
-                        };
+                            throw new GasBankException("GasBank account not found");
+                        }
 
-                        // Save changes
-                        await _accountRepository.UpdateAsync(gasBankAccount);
-                        await _allocationRepository.CreateAsync(allocation);
-                        await _transactionRepository.CreateAsync(transaction);
+                        var receipts = new List<GasPayoutReceipt>();
+                        foreach (var (share, transaction) in shares.Zip(transactions))
+                        {
+                            PublishBalanceChanged(gasBankAccount, transaction);
 
-                        additionalData["AllocationId"] = allocation.Id;
-                        additionalData["NewAllocatedAmount"] = gasBankAccount.AllocatedAmount;
-                        additionalData["TransactionId"] = transaction.Id;
+                            receipts.Add(new GasPayoutReceipt
+                            {
+                                Address = share.Key.Address,
+                                Weight = share.Key.Weight,
+                                Amount = share.Value,
+                                TransactionHash = transactionHash,
+                                GasBankTransactionId = transaction.Id
+                            });
+                        }
 
-                        return allocation;
+                        additionalData["NewBalance"] = gasBankAccount.Balance;
+                        additionalData["TransactionHash"] = transactionHash;
+
+                        return receipts;
                     },
-                    "AllocateToFunction",
+                    "DistributeFromGasBankAccount",
                     requestId,
                     additionalData);
 
                 if (!result.success)
                 {
-                    throw new Exception("Failed to allocate to function");
+                    throw new Exception("Failed to distribute from GasBank account");
                 }
 
-                LoggingUtility.LogOperationSuccess(_logger, "AllocateToFunction", requestId, 0, additionalData);
+                LoggingUtility.LogOperationSuccess(_logger, "DistributeFromGasBankAccount", requestId, 0, additionalData);
 
                 return result.result;
             }
             catch (Exception ex)
             {
-                LoggingUtility.LogOperationFailure(_logger, "AllocateToFunction", requestId, ex, 0, additionalData);
-                throw new GasBankException("Error allocating to function", ex);
+                LoggingUtility.LogOperationFailure(_logger, "DistributeFromGasBankAccount", requestId, ex, 0, additionalData);
+                throw new GasBankException("Error distributing from GasBank account", ex);
             }
         }
 
         /// <inheritdoc/>
-        public async Task<IEnumerable<GasBankAllocation>> GetAllocationsAsync(Guid id)
+        public async Task<GasBankTransaction> ChargeNetworkFeeAsync(Guid id, decimal amount, string transactionHash, string description, Guid? relatedEntityId = null)
         {
             var requestId = Guid.NewGuid().ToString();
             var additionalData = new Dictionary<string, object>
             {
-                ["Id"] = id
+                ["Id"] = id,
+                ["Amount"] = amount,
+                ["TransactionHash"] = transactionHash,
+                ["RelatedEntityId"] = relatedEntityId
             };
 
-            LoggingUtility.LogOperationStart(_logger, "GetGasBankAllocations", requestId, additionalData);
+            LoggingUtility.LogOperationStart(_logger, "ChargeGasBankNetworkFee", requestId, additionalData);
 
             try
             {
                 // Validate input
                 ValidationUtility.ValidateGuid(id, "GasBank account ID");
+                ValidationUtility.ValidateGreaterThanZero(amount, "Amount");
 
-                var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<GasBankService, IEnumerable<GasBankAllocation>>(
+                var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<GasBankService, GasBankTransaction>(
                     _logger,
                     async () =>
                     {
-                        // Verify GasBank account exists
-                        var gasBankAccount = await _accountRepository.GetByIdAsync(id);
+                        GasBankTransaction transaction = null;
+
+                        // The hot wallet has already paid the fee on chain
+                        var gasBankAccount = await _accountRepository.UpdateBalanceAsync(id, account =>
+                        {
+                            additionalData["PreviousBalance"] = account.Balance;
+
+                            if (account.Balance - account.AllocatedAmount < amount)
+                            {
+                                throw new GasBankException("Insufficient unallocated balance");
+                            }
+
+                            account.Balance -= amount;
+                            account.HotBalance = Math.Max(0, account.HotBalance - amount);
+
+                            transaction = new GasBankTransaction
+                            {
+                                Id = Guid.NewGuid(),
+                                GasBankAccountId = account.Id,
+                                Type = GasBankTransactionType.NetworkFee,
+                                Amount = amount,
+                                BalanceAfter = account.Balance,
+                                TransactionHash = transactionHash,
+                                RelatedEntityId = relatedEntityId,
+                                NeoAddress = account.NeoAddress,
+                                Timestamp = DateTime.UtcNow,
+                                Description = description
+                            };
+
+                            return transaction;
+                        });
+
                         if (gasBankAccount == null)
                         {
                             throw new GasBankException("GasBank account not found");
                         }
 
-                        additionalData["AccountId"] = gasBankAccount.AccountId;
-                        additionalData["Name"] = gasBankAccount.Name;
-
-                        // Get allocations
-                        var allocations = await _allocationRepository.GetByGasBankAccountIdAsync(id);
-
-                        additionalData["Count"] = allocations.Count();
+                        additionalData["NewBalance"] = gasBankAccount.Balance;
+                        PublishBalanceChanged(gasBankAccount, transaction);
 
-                        return allocations;
+                        return transaction;
                     },
-                    "GetGasBankAllocations",
+                    "ChargeGasBankNetworkFee",
                     requestId,
                     additionalData);
 
-                LoggingUtility.LogOperationSuccess(_logger, "GetGasBankAllocations", requestId, 0, additionalData);
+                if (!result.success)
+                {
+                    throw new Exception("Failed to charge GasBank network fee");
+                }
+
+                LoggingUtility.LogOperationSuccess(_logger, "ChargeGasBankNetworkFee", requestId, 0, additionalData);
 
                 return result.result;
             }
             catch (Exception ex)
             {
-                LoggingUtility.LogOperationFailure(_logger, "GetGasBankAllocations", requestId, ex, 0, additionalData);
-                throw new GasBankException($"Error getting allocations for GasBank account {id}", ex);
+                LoggingUtility.LogOperationFailure(_logger, "ChargeGasBankNetworkFee", requestId, ex, 0, additionalData);
+                throw new GasBankException("Error charging GasBank network fee", ex);
             }
         }
 
         /// <inheritdoc/>
-        public async Task<GasBankAllocation> UpdateAllocationAsync(Guid id, decimal amount)
+        public async Task<decimal?> GetExecutionBudgetAsync(Guid accountId, Guid functionId)
+        {
+            ValidationUtility.ValidateGuid(accountId, "Account ID");
+            ValidationUtility.ValidateGuid(functionId, "Function ID");
+
+            var (gasBankAccount, allocation) = await FindExecutionFundingAsync(accountId, functionId);
+            if (gasBankAccount == null)
+            {
+                return null;
+            }
+
+            return allocation != null
+                ? allocation.Amount
+                : Math.Max(0, gasBankAccount.Balance - gasBankAccount.AllocatedAmount);
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankTransaction> ChargeFunctionExecutionAsync(Guid accountId, Guid functionId, Guid executionId, decimal amount)
         {
             var requestId = Guid.NewGuid().ToString();
             var additionalData = new Dictionary<string, object>
             {
-                ["Id"] = id,
+                ["AccountId"] = accountId,
+                ["FunctionId"] = functionId,
+                ["ExecutionId"] = executionId,
                 ["Amount"] = amount
             };
 
-            LoggingUtility.LogOperationStart(_logger, "UpdateGasBankAllocation", requestId, additionalData);
+            LoggingUtility.LogOperationStart(_logger, "ChargeFunctionExecution", requestId, additionalData);
 
             try
             {
                 // Validate input
-                ValidationUtility.ValidateGuid(id, "Allocation ID");
+                ValidationUtility.ValidateGuid(accountId, "Account ID");
+                ValidationUtility.ValidateGuid(functionId, "Function ID");
                 ValidationUtility.ValidateGreaterThanZero(amount, "Amount");
 
-                var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<GasBankService, GasBankAllocation>(
+                var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<GasBankService, GasBankTransaction>(
                     _logger,
                     async () =>
                     {
-                        // Get allocation
-                        var allocation = await _allocationRepository.GetByIdAsync(id);
-                        if (allocation == null)
-                        {
-                            throw new GasBankException("Allocation not found");
-                        }
-
-                        additionalData["GasBankAccountId"] = allocation.GasBankAccountId;
-                        additionalData["FunctionId"] = allocation.FunctionId;
-                        additionalData["PreviousAmount"] = allocation.Amount;
-
-                        // Get GasBank account
-                        var gasBankAccount = await _accountRepository.GetByIdAsync(allocation.GasBankAccountId);
-                        if (gasBankAccount == null)
+                        var (funding, allocation) = await FindExecutionFundingAsync(accountId, functionId);
+                        if (funding == null)
                         {
-                            throw new GasBankException("GasBank account not found");
+                            return null;
                         }
 
-                        additionalData["AccountId"] = gasBankAccount.AccountId;
-                        additionalData["Name"] = gasBankAccount.Name;
-                        additionalData["CurrentBalance"] = gasBankAccount.Balance;
-                        additionalData["CurrentAllocatedAmount"] = gasBankAccount.AllocatedAmount;
+                        additionalData["GasBankAccountId"] = funding.Id;
+                        additionalData["AllocationId"] = allocation?.Id;
 
-                        // Calculate new allocated amount
-                        decimal difference = amount - allocation.Amount;
-                        decimal newAllocatedAmount = gasBankAccount.AllocatedAmount + difference;
+                        GasBankTransaction transaction = null;
+                        decimal charged = 0;
 
-                        // Check if there's enough unallocated balance if increasing allocation
-                        if (difference > 0)
+                        GasBankTransaction Charge(GasBankAccount account, GasBankAllocation current)
                         {
-                            decimal availableBalance = gasBankAccount.Balance - gasBankAccount.AllocatedAmount;
-                            if (availableBalance < difference)
+                            var available = current != null
+                                ? Math.Min(current.Amount, account.AllocatedAmount)
+                                : account.Balance - account.AllocatedAmount;
+                            charged = Math.Min(amount, Math.Max(0, available));
+                            if (charged == 0)
                             {
-                                throw new GasBankException("Insufficient unallocated balance");
+                                return null;
                             }
-                        }
 
-                        // Update allocation
-                        allocation.Amount = amount;
-                        allocation.UpdatedAt = DateTime.UtcNow;
+                            account.Balance -= charged;
+                            if (current != null)
+                            {
+                                account.AllocatedAmount -= charged;
+                                current.Amount -= charged;
+                            }
+
+                            transaction = new GasBankTransaction
+                            {
+                                Id = Guid.NewGuid(),
+                                GasBankAccountId = account.Id,
+                                Type = GasBankTransactionType.FunctionExecution,
+                                Amount = charged,
+                                BalanceAfter = account.Balance,
+                                RelatedEntityId = executionId,
+                                Timestamp = DateTime.UtcNow,
+                                Description = $"Execution {executionId} of function {functionId}"
+                            };
+
+                            return transaction;
+                        }
 
-                        // Update GasBank account allocated amount
-                        gasBankAccount.AllocatedAmount = newAllocatedAmount;
-                        gasBankAccount.UpdatedAt = DateTime.UtcNow;
+                        // The GAS stays in the hot wallet; the execution is settled off chain. An allocation is
+                        // charged under the account's lock so that concurrent executions see each other's charges.
+                        var gasBankAccount = allocation != null
+                            ? await _accountRepository.UpdateBalanceAndAllocationAsync(funding.Id, allocation.Id, Charge)
+                            : await _accountRepository.UpdateBalanceAsync(funding.Id, account => Charge(account, null));
 
-                        // Create transaction record
-                        var transaction = new GasBankTransaction
+                        if (gasBankAccount == null || transaction == null)
                         {
-                            Id = Guid.NewGuid(),
-                            GasBankAccountId = gasBankAccount.Id,
-                            Type = difference > 0 ? GasBankTransactionType.Allocation : GasBankTransactionType.Deallocation,
-                            Amount = Math.Abs(difference),
-                            BalanceAfter = gasBankAccount.Balance,
-                            TransactionHash = null,
-                            RelatedEntityId = allocation.FunctionId,
-                            NeoAddress = null,
-                            Timestamp = DateTime.UtcNow,
-                            Description = $"Updated allocation for function {allocation.FunctionId}"
-                        };
+                            _logger.LogWarning("Execution {ExecutionId} of function {FunctionId} cost {Amount} GAS but none was left to charge",
+                                executionId, functionId, amount);
+                            return null;
+                        }
 
-                        // Save changes
-                        await _accountRepository.UpdateAsync(gasBankAccount);
-                        await _allocationRepository.UpdateAsync(allocation);
-                        await _transactionRepository.CreateAsync(transaction);
+                        if (charged < amount)
+                        {
+                            _logger.LogWarning("Execution {ExecutionId} of function {FunctionId} cost {Amount} GAS but only {Charged} was left to charge",
+                                executionId, functionId, amount, charged);
+                        }
 
-                        additionalData["NewAmount"] = allocation.Amount;
-                        additionalData["NewAllocatedAmount"] = gasBankAccount.AllocatedAmount;
-                        additionalData["TransactionId"] = transaction.Id;
+                        additionalData["Charged"] = charged;
+                        additionalData["NewBalance"] = gasBankAccount.Balance;
+                        PublishBalanceChanged(gasBankAccount, transaction);
 
-                        return allocation;
+                        return transaction;
                     },
-                    "UpdateGasBankAllocation",
+                    "ChargeFunctionExecution",
                     requestId,
                     additionalData);
 
                 if (!result.success)
                 {
-                    throw new Exception("Failed to update allocation");
+                    throw new Exception("Failed to charge function execution");
                 }
 
-                LoggingUtility.LogOperationSuccess(_logger, "UpdateGasBankAllocation", requestId, 0, additionalData);
+                LoggingUtility.LogOperationSuccess(_logger, "ChargeFunctionExecution", requestId, 0, additionalData);
 
                 return result.result;
             }
             catch (Exception ex)
             {
-                LoggingUtility.LogOperationFailure(_logger, "UpdateGasBankAllocation", requestId, ex, 0, additionalData);
-                throw new GasBankException("Error updating allocation", ex);
+                LoggingUtility.LogOperationFailure(_logger, "ChargeFunctionExecution", requestId, ex, 0, additionalData);
+                throw new GasBankException("Error charging function execution", ex);
             }
         }
 
         /// <inheritdoc/>
-        public async Task<bool> RemoveAllocationAsync(Guid id)
+        public async Task<GasBankAllocation> AllocateToFunctionAsync(Guid id, Guid functionId, decimal amount)
         {
             var requestId = Guid.NewGuid().ToString();
             var additionalData = new Dictionary<string, object>
             {
-                ["Id"] = id
+                ["Id"] = id,
+                ["FunctionId"] = functionId,
+                ["Amount"] = amount
             };
 
-            LoggingUtility.LogOperationStart(_logger, "RemoveGasBankAllocation", requestId, additionalData);
+            LoggingUtility.LogOperationStart(_logger, "AllocateToFunction", requestId, additionalData);
 
             try
             {
                 // Validate input
-                ValidationUtility.ValidateGuid(id, "Allocation ID");
+                ValidationUtility.ValidateGuid(id, "GasBank account ID");
+                ValidationUtility.ValidateGuid(functionId, "Function ID");
+                ValidationUtility.ValidateGreaterThanZero(amount, "Amount");
 
-                var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<GasBankService, bool>(
+                var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<GasBankService, GasBankAllocation>(
                     _logger,
                     async () =>
                     {
-                        // Get allocation
-                        var allocation = await _allocationRepository.GetByIdAsync(id);
-                        if (allocation == null)
-                        {
-                            throw new GasBankException("Allocation not found");
-                        }
-
-                        additionalData["GasBankAccountId"] = allocation.GasBankAccountId;
-                        additionalData["FunctionId"] = allocation.FunctionId;
-                        additionalData["Amount"] = allocation.Amount;
+                        // Check if there's already an allocation for this function
+                        var existingAllocations = await _allocationRepository.GetByFunctionIdAsync(functionId);
+                        var existingAllocation = existingAllocations.FirstOrDefault(a => a.GasBankAccountId == id);
 
-                        // Get GasBank account
-                        var gasBankAccount = await _accountRepository.GetByIdAsync(allocation.GasBankAccountId);
+                        if (existingAllocation != null)
+                        {
+                            throw new GasBankException("Allocation already exists for this function");
+                        }
+
+                        // Create allocation
+                        var allocation = new GasBankAllocation
+                        {
+                            Id = Guid.NewGuid(),
+                            GasBankAccountId = id,
+                            FunctionId = functionId,
+                            Amount = amount,
+                            CreatedAt = DateTime.UtcNow,
+                            UpdatedAt = DateTime.UtcNow
+                        };
+
+                        GasBankTransaction transaction = null;
+
+                        // Update GasBank account allocated amount and record the allocation together
+                        var gasBankAccount = await _accountRepository.UpdateBalanceAsync(id, account =>
+                        {
+                            additionalData["AccountId"] = account.AccountId;
+                            additionalData["Name"] = account.Name;
+                            additionalData["CurrentBalance"] = account.Balance;
+                            additionalData["CurrentAllocatedAmount"] = account.AllocatedAmount;
+
+                            // Check if there's enough unallocated balance
+                            if (account.Balance - account.AllocatedAmount < amount)
+                            {
+                                throw new GasBankException("Insufficient unallocated balance");
+                            }
+
+                            account.AllocatedAmount += amount;
+
+                            transaction = new GasBankTransaction
+                            {
+                                Id = Guid.NewGuid(),
+                                GasBankAccountId = account.Id,
+                                Type = GasBankTransactionType.Allocation,
+                                Amount = amount,
+                                BalanceAfter = account.Balance,
+                                TransactionHash = null,
+                                RelatedEntityId = functionId,
+                                NeoAddress = null,
+                                Timestamp = DateTime.UtcNow,
+                                Description = $"Allocation to function {functionId}"
+                            };
+
+                            return transaction;
+                        });
+
+                        if (gasBankAccount == null)
+                        {
+                            throw new GasBankException("GasBank account not found");
+                        }
+
+                        await _allocationRepository.CreateAsync(allocation);
+                        PublishBalanceChanged(gasBankAccount, transaction);
+
+                        additionalData["AllocationId"] = allocation.Id;
+                        additionalData["NewAllocatedAmount"] = gasBankAccount.AllocatedAmount;
+                        additionalData["TransactionId"] = transaction.Id;
+
+                        return allocation;
+                    },
+                    "AllocateToFunction",
+                    requestId,
+                    additionalData);
+
+                if (!result.success)
+                {
+                    throw new Exception("Failed to allocate to function");
+                }
+
+                LoggingUtility.LogOperationSuccess(_logger, "AllocateToFunction", requestId, 0, additionalData);
+
+                return result.result;
+            }
+            catch (Exception ex)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "AllocateToFunction", requestId, ex, 0, additionalData);
+                throw new GasBankException("Error allocating to function", ex);
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankAllocation>> GetAllocationsAsync(Guid id)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["Id"] = id
+            };
+
+            LoggingUtility.LogOperationStart(_logger, "GetGasBankAllocations", requestId, additionalData);
+
+            try
+            {
+                // Validate input
+                ValidationUtility.ValidateGuid(id, "GasBank account ID");
+
+                var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<GasBankService, IEnumerable<GasBankAllocation>>(
+                    _logger,
+                    async () =>
+                    {
+                        // Verify GasBank account exists
+                        var gasBankAccount = await _accountRepository.GetByIdAsync(id);
                         if (gasBankAccount == null)
                         {
                             throw new GasBankException("GasBank account not found");
@@ -735,31 +1076,204 @@ namespace NeoServiceLayer.Services.GasBank
 
                         additionalData["AccountId"] = gasBankAccount.AccountId;
                         additionalData["Name"] = gasBankAccount.Name;
-                        additionalData["CurrentAllocatedAmount"] = gasBankAccount.AllocatedAmount;
 
-                        // Update GasBank account allocated amount
-                        gasBankAccount.AllocatedAmount -= allocation.Amount;
-                        gasBankAccount.UpdatedAt = DateTime.UtcNow;
+                        // Get allocations
+                        var allocations = await _allocationRepository.GetByGasBankAccountIdAsync(id);
+
+                        additionalData["Count"] = allocations.Count();
+
+                        return allocations;
+                    },
+                    "GetGasBankAllocations",
+                    requestId,
+                    additionalData);
+
+                LoggingUtility.LogOperationSuccess(_logger, "GetGasBankAllocations", requestId, 0, additionalData);
+
+                return result.result;
+            }
+            catch (Exception ex)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "GetGasBankAllocations", requestId, ex, 0, additionalData);
+                throw new GasBankException($"Error getting allocations for GasBank account {id}", ex);
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankAllocation> UpdateAllocationAsync(Guid id, decimal amount)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["Id"] = id,
+                ["Amount"] = amount
+            };
+
+            LoggingUtility.LogOperationStart(_logger, "UpdateGasBankAllocation", requestId, additionalData);
+
+            try
+            {
+                // Validate input
+                ValidationUtility.ValidateGuid(id, "Allocation ID");
+                ValidationUtility.ValidateGreaterThanZero(amount, "Amount");
+
+                var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<GasBankService, GasBankAllocation>(
+                    _logger,
+                    async () =>
+                    {
+                        // Get allocation
+                        var allocation = await _allocationRepository.GetByIdAsync(id);
+                        if (allocation == null)
+                        {
+                            throw new GasBankException("Allocation not found");
+                        }
+
+                        additionalData["GasBankAccountId"] = allocation.GasBankAccountId;
+                        additionalData["FunctionId"] = allocation.FunctionId;
+                        additionalData["PreviousAmount"] = allocation.Amount;
+
+                        GasBankTransaction transaction = null;
 
-                        // Create transaction record
-                        var transaction = new GasBankTransaction
+                        // The allocation is re-read under the account's lock, as executions may be charging it
+                        var gasBankAccount = await _accountRepository.UpdateBalanceAndAllocationAsync(allocation.GasBankAccountId, id, (account, current) =>
                         {
-                            Id = Guid.NewGuid(),
-                            GasBankAccountId = gasBankAccount.Id,
-                            Type = GasBankTransactionType.Deallocation,
-                            Amount = allocation.Amount,
-                            BalanceAfter = gasBankAccount.Balance,
-                            TransactionHash = null,
-                            RelatedEntityId = allocation.FunctionId,
-                            NeoAddress = null,
-                            Timestamp = DateTime.UtcNow,
-                            Description = $"Removed allocation for function {allocation.FunctionId}"
-                        };
+                            // Calculate the change in allocated amount
+                            var difference = amount - current.Amount;
+
+                            additionalData["AccountId"] = account.AccountId;
+                            additionalData["Name"] = account.Name;
+                            additionalData["CurrentBalance"] = account.Balance;
+                            additionalData["CurrentAllocatedAmount"] = account.AllocatedAmount;
+
+                            // Check if there's enough unallocated balance if increasing allocation
+                            if (difference > 0 && account.Balance - account.AllocatedAmount < difference)
+                            {
+                                throw new GasBankException("Insufficient unallocated balance");
+                            }
+
+                            account.AllocatedAmount += difference;
+                            current.Amount = amount;
+                            allocation = current;
+
+                            transaction = new GasBankTransaction
+                            {
+                                Id = Guid.NewGuid(),
+                                GasBankAccountId = account.Id,
+                                Type = difference > 0 ? GasBankTransactionType.Allocation : GasBankTransactionType.Deallocation,
+                                Amount = Math.Abs(difference),
+                                BalanceAfter = account.Balance,
+                                TransactionHash = null,
+                                RelatedEntityId = allocation.FunctionId,
+                                NeoAddress = null,
+                                Timestamp = DateTime.UtcNow,
+                                Description = $"Updated allocation for function {allocation.FunctionId}"
+                            };
+
+                            return transaction;
+                        });
+
+                        if (gasBankAccount == null)
+                        {
+                            throw new GasBankException("GasBank account not found");
+                        }
+
+                        PublishBalanceChanged(gasBankAccount, transaction);
+
+                        additionalData["NewAmount"] = allocation.Amount;
+                        additionalData["NewAllocatedAmount"] = gasBankAccount.AllocatedAmount;
+                        additionalData["TransactionId"] = transaction.Id;
+
+                        return allocation;
+                    },
+                    "UpdateGasBankAllocation",
+                    requestId,
+                    additionalData);
+
+                if (!result.success)
+                {
+                    throw new Exception("Failed to update allocation");
+                }
+
+                LoggingUtility.LogOperationSuccess(_logger, "UpdateGasBankAllocation", requestId, 0, additionalData);
+
+                return result.result;
+            }
+            catch (Exception ex)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "UpdateGasBankAllocation", requestId, ex, 0, additionalData);
+                throw new GasBankException("Error updating allocation", ex);
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> RemoveAllocationAsync(Guid id)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["Id"] = id
+            };
+
+            LoggingUtility.LogOperationStart(_logger, "RemoveGasBankAllocation", requestId, additionalData);
+
+            try
+            {
+                // Validate input
+                ValidationUtility.ValidateGuid(id, "Allocation ID");
+
+                var result = await ExceptionUtility.ExecuteWithExceptionHandlingAsync<GasBankService, bool>(
+                    _logger,
+                    async () =>
+                    {
+                        // Get allocation
+                        var allocation = await _allocationRepository.GetByIdAsync(id);
+                        if (allocation == null)
+                        {
+                            throw new GasBankException("Allocation not found");
+                        }
+
+                        additionalData["GasBankAccountId"] = allocation.GasBankAccountId;
+                        additionalData["FunctionId"] = allocation.FunctionId;
+                        additionalData["Amount"] = allocation.Amount;
+
+                        GasBankTransaction transaction = null;
+
+                        // Update GasBank account allocated amount and record the deallocation together. The allocation
+                        // is emptied under the account's lock, so an execution charged before it is deleted finds nothing left.
+                        var gasBankAccount = await _accountRepository.UpdateBalanceAndAllocationAsync(allocation.GasBankAccountId, id, (account, current) =>
+                        {
+                            additionalData["AccountId"] = account.AccountId;
+                            additionalData["Name"] = account.Name;
+                            additionalData["CurrentAllocatedAmount"] = account.AllocatedAmount;
+
+                            var released = current.Amount;
+                            account.AllocatedAmount -= released;
+                            current.Amount = 0;
+
+                            transaction = new GasBankTransaction
+                            {
+                                Id = Guid.NewGuid(),
+                                GasBankAccountId = account.Id,
+                                Type = GasBankTransactionType.Deallocation,
+                                Amount = released,
+                                BalanceAfter = account.Balance,
+                                TransactionHash = null,
+                                RelatedEntityId = allocation.FunctionId,
+                                NeoAddress = null,
+                                Timestamp = DateTime.UtcNow,
+                                Description = $"Removed allocation for function {allocation.FunctionId}"
+                            };
+
+                            return transaction;
+                        });
+
+                        if (gasBankAccount == null)
+                        {
+                            throw new GasBankException("GasBank account not found");
+                        }
 
-                        // Save changes
-                        await _accountRepository.UpdateAsync(gasBankAccount);
                         await _allocationRepository.DeleteAsync(id);
-                        await _transactionRepository.CreateAsync(transaction);
+                        PublishBalanceChanged(gasBankAccount, transaction);
 
                         additionalData["NewAllocatedAmount"] = gasBankAccount.AllocatedAmount;
                         additionalData["TransactionId"] = transaction.Id;
@@ -844,5 +1358,960 @@ namespace NeoServiceLayer.Services.GasBank
                 throw new GasBankException($"Error getting transaction history for GasBank account {id}", ex);
             }
         }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankWithdrawal>> GetQueuedWithdrawalsAsync()
+        {
+            try
+            {
+                return await _withdrawalRepository.GetByStatusAsync(GasBankWithdrawalStatus.Queued);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting queued GasBank withdrawals");
+                throw new GasBankException("Error getting queued GasBank withdrawals", ex);
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankWithdrawal>> GetInDoubtWithdrawalsAsync()
+        {
+            try
+            {
+                return await _withdrawalRepository.GetByStatusAsync(GasBankWithdrawalStatus.InDoubt);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting in-doubt GasBank withdrawals");
+                throw new GasBankException("Error getting in-doubt GasBank withdrawals", ex);
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankTotals> GetTotalsAsync()
+        {
+            try
+            {
+                var accounts = (await _accountRepository.GetAllAsync()).ToList();
+                var queued = (await _withdrawalRepository.GetByStatusAsync(GasBankWithdrawalStatus.Queued)).ToList();
+                var inDoubt = await _withdrawalRepository.GetByStatusAsync(GasBankWithdrawalStatus.InDoubt);
+                var unconfirmed = await _withdrawalRepository.GetByStatusAsync(GasBankWithdrawalStatus.Completed);
+
+                return new GasBankTotals
+                {
+                    AccountCount = accounts.Count,
+                    Balance = accounts.Sum(a => a.Balance),
+                    AllocatedAmount = accounts.Sum(a => a.AllocatedAmount),
+                    HotBalance = accounts.Sum(a => a.HotBalance),
+                    QueuedWithdrawals = queued.Count,
+                    QueuedWithdrawalAmount = queued.Sum(w => w.Amount),
+                    InDoubtWithdrawals = inDoubt.Count(),
+                    UnconfirmedWithdrawals = unconfirmed.Count(),
+                    DepositConfirmations = _configuration.DepositConfirmations,
+                    WithdrawalConfirmations = _configuration.WithdrawalConfirmations
+                };
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting GasBank totals");
+                throw new GasBankException("Error getting GasBank totals", ex);
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankWithdrawal> ResolveInDoubtWithdrawalAsync(Guid withdrawalId, string transactionHash, string resolvedBy)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["WithdrawalId"] = withdrawalId,
+                ["TransactionHash"] = transactionHash,
+                ["ResolvedBy"] = resolvedBy
+            };
+
+            LoggingUtility.LogOperationStart(_logger, "ResolveInDoubtGasBankWithdrawal", requestId, additionalData);
+
+            try
+            {
+                ValidationUtility.ValidateGuid(withdrawalId, "Withdrawal ID");
+
+                await _hotWalletSemaphore.WaitAsync();
+                try
+                {
+                    var withdrawal = await _withdrawalRepository.GetByIdAsync(withdrawalId);
+                    if (withdrawal == null)
+                    {
+                        throw new GasBankException("GasBank withdrawal not found");
+                    }
+
+                    if (withdrawal.Status != GasBankWithdrawalStatus.InDoubt)
+                    {
+                        throw new GasBankException($"GasBank withdrawal is {withdrawal.Status}, not in doubt");
+                    }
+
+                    var gasBankAccount = await _accountRepository.GetByIdAsync(withdrawal.GasBankAccountId);
+                    if (gasBankAccount == null)
+                    {
+                        throw new GasBankException("GasBank account not found");
+                    }
+
+                    // Queued withdrawals were recorded in the history when they were queued, direct ones only once sent
+                    var recorded = (await _transactionRepository.GetByGasBankAccountIdAsync(gasBankAccount.Id))
+                        .Any(t => t.Type == GasBankTransactionType.Withdrawal && t.RelatedEntityId == withdrawal.Id);
+
+                    GasBankTransaction transaction = null;
+                    if (!string.IsNullOrEmpty(transactionHash))
+                    {
+                        // The amount was taken from the balance and hot wallet when sending started
+                        withdrawal.Status = GasBankWithdrawalStatus.Completed;
+                        withdrawal.StatusReason = $"Confirmed as sent by {resolvedBy}";
+                        withdrawal.TransactionHash = transactionHash;
+                        withdrawal.CompletedAt = DateTime.UtcNow;
+
+                        if (!recorded)
+                        {
+                            transaction = new GasBankTransaction
+                            {
+                                Id = Guid.NewGuid(),
+                                GasBankAccountId = gasBankAccount.Id,
+                                Type = GasBankTransactionType.Withdrawal,
+                                Amount = withdrawal.Amount,
+                                BalanceAfter = gasBankAccount.Balance,
+                                TransactionHash = transactionHash,
+                                RelatedEntityId = withdrawal.Id,
+                                NeoAddress = withdrawal.ToAddress,
+                                Timestamp = DateTime.UtcNow,
+                                Description = $"Withdrawal to external address, confirmed as sent by {resolvedBy}"
+                            };
+                        }
+                    }
+                    else
+                    {
+                        withdrawal.Status = GasBankWithdrawalStatus.Failed;
+                        withdrawal.StatusReason = $"Confirmed as not sent by {resolvedBy}; the amount was returned to the balance";
+                    }
+
+                    await _withdrawalRepository.UpdateAsync(withdrawal);
+
+                    if (withdrawal.Status == GasBankWithdrawalStatus.Failed)
+                    {
+                        gasBankAccount = await _accountRepository.UpdateBalanceAsync(gasBankAccount.Id, account =>
+                        {
+                            account.Balance += withdrawal.Amount;
+                            account.HotBalance += withdrawal.Amount;
+
+                            // Only a return of an amount that is in the history is recorded
+                            transaction = !recorded ? null : new GasBankTransaction
+                            {
+                                Id = Guid.NewGuid(),
+                                GasBankAccountId = account.Id,
+                                Type = GasBankTransactionType.Deposit,
+                                Amount = withdrawal.Amount,
+                                BalanceAfter = account.Balance,
+                                TransactionHash = null,
+                                RelatedEntityId = withdrawal.Id,
+                                NeoAddress = withdrawal.ToAddress,
+                                Timestamp = DateTime.UtcNow,
+                                Description = $"Return of a withdrawal confirmed as not sent by {resolvedBy}"
+                            };
+
+                            return transaction;
+                        });
+
+                        if (gasBankAccount == null)
+                        {
+                            throw new GasBankException("GasBank account not found");
+                        }
+                    }
+                    else if (transaction != null)
+                    {
+                        // The balance was already reduced when sending started
+                        await _transactionRepository.CreateAsync(transaction);
+                    }
+
+                    if (transaction != null)
+                    {
+                        PublishBalanceChanged(gasBankAccount, transaction);
+                    }
+
+                    additionalData["Status"] = withdrawal.Status.ToString();
+                    LoggingUtility.LogOperationSuccess(_logger, "ResolveInDoubtGasBankWithdrawal", requestId, 0, additionalData);
+
+                    return withdrawal;
+                }
+                finally
+                {
+                    _hotWalletSemaphore.Release();
+                }
+            }
+            catch (Exception ex) when (ex is GasBankException || ex is ArgumentException)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "ResolveInDoubtGasBankWithdrawal", requestId, ex, 0, additionalData);
+                throw;
+            }
+            catch (Exception ex)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "ResolveInDoubtGasBankWithdrawal", requestId, ex, 0, additionalData);
+                throw new GasBankException("Error resolving in-doubt GasBank withdrawal", ex);
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankTransaction>> SweepToColdWalletAsync()
+        {
+            if (!_configuration.ColdStorageEnabled)
+            {
+                throw new GasBankException("No GasBank cold wallet is configured");
+            }
+
+            await _hotWalletSemaphore.WaitAsync();
+            try
+            {
+                return await SweepAsync();
+            }
+            finally
+            {
+                _hotWalletSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankWithdrawal>> ConfirmWithdrawalsAsync()
+        {
+            if (_neoRpcClient == null)
+            {
+                throw new GasBankException("No Neo RPC client is configured to check withdrawals on chain");
+            }
+
+            await _hotWalletSemaphore.WaitAsync();
+            try
+            {
+                return await ConfirmSentWithdrawalsAsync();
+            }
+            catch (Exception ex) when (!(ex is GasBankException))
+            {
+                _logger.LogError(ex, "Error checking sent GasBank withdrawals on chain");
+                throw new GasBankException("Error checking sent GasBank withdrawals on chain", ex);
+            }
+            finally
+            {
+                _hotWalletSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankAccount> ConfirmReplenishmentAsync(Guid id, decimal amount, string transactionHash, string confirmedBy)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["Id"] = id,
+                ["Amount"] = amount,
+                ["TransactionHash"] = transactionHash,
+                ["ConfirmedBy"] = confirmedBy
+            };
+
+            LoggingUtility.LogOperationStart(_logger, "ConfirmGasBankReplenishment", requestId, additionalData);
+
+            try
+            {
+                // Validate input
+                ValidationUtility.ValidateGuid(id, "GasBank account ID");
+                ValidationUtility.ValidateGreaterThanZero(amount, "Amount");
+                ValidationUtility.ValidateNotNullOrEmpty(transactionHash, "Transaction hash");
+
+                if (!_configuration.ColdStorageEnabled)
+                {
+                    throw new GasBankException("No GasBank cold wallet is configured");
+                }
+
+                // Without an RPC client the operator's confirmation is taken as is
+                if (_neoRpcClient != null && _configuration.DepositConfirmations > 0)
+                {
+                    var confirmations = await GetConfirmationsAsync(new[] { transactionHash });
+                    confirmations.TryGetValue(transactionHash, out var depth);
+                    if (depth < _configuration.DepositConfirmations)
+                    {
+                        throw new GasBankException(
+                            $"Replenishment transaction {transactionHash} has {depth} of the {_configuration.DepositConfirmations} required confirmations; confirm it again later");
+                    }
+
+                    additionalData["Confirmations"] = depth;
+                }
+
+                await _hotWalletSemaphore.WaitAsync();
+                try
+                {
+                    var queued = (await _withdrawalRepository.GetByStatusAsync(GasBankWithdrawalStatus.Queued))
+                        .Where(w => w.GasBankAccountId == id)
+                        .ToList();
+
+                    GasBankTransaction transaction = null;
+                    var gasBankAccount = await _accountRepository.UpdateBalanceAsync(id, account =>
+                    {
+                        // Queued withdrawals are already deducted from the balance but still paid from the hot wallet
+                        var coldBalance = account.Balance + queued.Sum(w => w.Amount) - account.HotBalance;
+                        if (amount > coldBalance)
+                        {
+                            throw new GasBankException($"Replenishment of {amount} GAS exceeds the {coldBalance} GAS held in cold storage for the account");
+                        }
+
+                        account.HotBalance += amount;
+
+                        transaction = new GasBankTransaction
+                        {
+                            Id = Guid.NewGuid(),
+                            GasBankAccountId = account.Id,
+                            Type = GasBankTransactionType.Replenishment,
+                            Amount = amount,
+                            BalanceAfter = account.Balance,
+                            TransactionHash = transactionHash,
+                            RelatedEntityId = null,
+                            NeoAddress = account.NeoAddress,
+                            Timestamp = DateTime.UtcNow,
+                            Description = $"Replenishment from cold storage confirmed by {confirmedBy}"
+                        };
+
+                        return transaction;
+                    });
+
+                    if (gasBankAccount == null)
+                    {
+                        throw new GasBankException("GasBank account not found");
+                    }
+
+                    PublishBalanceChanged(gasBankAccount, transaction);
+
+                    additionalData["HotBalance"] = gasBankAccount.HotBalance;
+                    additionalData["QueuedWithdrawals"] = queued.Count;
+
+                    gasBankAccount = await ReleaseQueuedWithdrawalsAsync(gasBankAccount, queued);
+
+                    LoggingUtility.LogOperationSuccess(_logger, "ConfirmGasBankReplenishment", requestId, 0, additionalData);
+
+                    return gasBankAccount;
+                }
+                finally
+                {
+                    _hotWalletSemaphore.Release();
+                }
+            }
+            catch (Exception ex) when (ex is GasBankException || ex is ArgumentException)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "ConfirmGasBankReplenishment", requestId, ex, 0, additionalData);
+                throw;
+            }
+            catch (Exception ex)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "ConfirmGasBankReplenishment", requestId, ex, 0, additionalData);
+                throw new GasBankException("Error confirming GasBank replenishment", ex);
+            }
+        }
+
+        /// <summary>
+        /// Finds what pays for a function's executions
+        /// </summary>
+        /// <param name="accountId">The ID of the account owning the function</param>
+        /// <param name="functionId">The function ID</param>
+        /// <returns>The GasBank account and the function's allocation in it, if any; a null account if the owner has no GasBank account</returns>
+        private async Task<(GasBankAccount Account, GasBankAllocation Allocation)> FindExecutionFundingAsync(Guid accountId, Guid functionId)
+        {
+            var gasBankAccounts = (await _accountRepository.GetByAccountIdAsync(accountId)).ToList();
+            if (gasBankAccounts.Count == 0)
+            {
+                return (null, null);
+            }
+
+            var allocation = (await _allocationRepository.GetByFunctionIdAsync(functionId))
+                .FirstOrDefault(a => gasBankAccounts.Any(g => g.Id == a.GasBankAccountId));
+            if (allocation != null)
+            {
+                return (gasBankAccounts.First(g => g.Id == allocation.GasBankAccountId), allocation);
+            }
+
+            return (gasBankAccounts.OrderByDescending(g => g.Balance - g.AllocatedAmount).First(), null);
+        }
+
+        private static GasBankTransaction CreateWithdrawalTransaction(GasBankAccount gasBankAccount, GasBankWithdrawal withdrawal, string description)
+        {
+            return new GasBankTransaction
+            {
+                Id = Guid.NewGuid(),
+                GasBankAccountId = gasBankAccount.Id,
+                Type = GasBankTransactionType.Withdrawal,
+                Amount = withdrawal.Amount,
+                BalanceAfter = gasBankAccount.Balance,
+                TransactionHash = withdrawal.TransactionHash,
+                RelatedEntityId = withdrawal.Id,
+                NeoAddress = withdrawal.ToAddress,
+                Timestamp = DateTime.UtcNow,
+                Description = description
+            };
+        }
+
+        private void PublishBalanceChanged(GasBankAccount gasBankAccount, GasBankTransaction transaction)
+        {
+            _eventPublisher?.Publish(new ServerEvent
+            {
+                Topic = ServerEventTopics.GasBank,
+                Type = "balance",
+                AccountId = gasBankAccount.AccountId,
+                Data = new
+                {
+                    GasBankAccountId = gasBankAccount.Id,
+                    gasBankAccount.Balance,
+                    gasBankAccount.AllocatedAmount,
+                    TransactionId = transaction.Id,
+                    TransactionType = transaction.Type.ToString(),
+                    transaction.Amount
+                }
+            });
+        }
+
+        /// <summary>
+        /// Sends queued withdrawals the hot wallets can now cover and, with cold storage enabled, sweeps excess GAS to it
+        /// </summary>
+        private async Task SweepCycleAsync()
+        {
+            // Skip the cycle while a withdrawal or replenishment is in progress, or once the service has stopped
+            if (_stopped || !await _hotWalletSemaphore.WaitAsync(0))
+            {
+                return;
+            }
+
+            try
+            {
+                if (_leadership?.IsLeader == false)
+                {
+                    return;
+                }
+
+                // Deposits may have refilled the hot wallet of an account with queued withdrawals, and failed ones may be due for a retry
+                var queued = await _withdrawalRepository.GetByStatusAsync(GasBankWithdrawalStatus.Queued);
+                foreach (var group in queued.GroupBy(w => w.GasBankAccountId))
+                {
+                    var gasBankAccount = await _accountRepository.GetByIdAsync(group.Key);
+                    if (gasBankAccount != null)
+                    {
+                        await ReleaseQueuedWithdrawalsAsync(gasBankAccount, group.ToList());
+                    }
+                }
+
+                if (_configuration.ColdStorageEnabled)
+                {
+                    await SweepAsync();
+                }
+            }
+            finally
+            {
+                _hotWalletSemaphore.Release();
+            }
+        }
+
+        /// <summary>
+        /// Checks sent withdrawals on chain
+        /// </summary>
+        private async Task ConfirmationCycleAsync()
+        {
+            // Skip the cycle while a withdrawal or replenishment is in progress, or once the service has stopped
+            if (_stopped || !await _hotWalletSemaphore.WaitAsync(0))
+            {
+                return;
+            }
+
+            try
+            {
+                await ConfirmSentWithdrawalsAsync();
+            }
+            finally
+            {
+                _hotWalletSemaphore.Release();
+            }
+        }
+
+        /// <summary>
+        /// Looks up the application logs of sent withdrawals and settles the ones the chain has executed;
+        /// the caller holds the hot wallet semaphore
+        /// </summary>
+        /// <remarks>
+        /// A transfer is settled once it is <see cref="GasBankConfiguration.WithdrawalConfirmations"/> blocks deep;
+        /// until then its depth is recorded and it is checked again next cycle. A transfer that halted is confirmed.
+        /// One that faulted moved no GAS, so its amount is returned to the balance and hot wallet. One the node still
+        /// doesn't know after <see cref="GasBankConfiguration.WithdrawalConfirmationTimeoutMinutes"/> is put in doubt
+        /// for an operator.
+        /// </remarks>
+        /// <returns>The withdrawals whose status changed</returns>
+        private async Task<IEnumerable<GasBankWithdrawal>> ConfirmSentWithdrawalsAsync()
+        {
+            // Only the leader settles withdrawals, so two instances can't both return a faulted one
+            if (_leadership?.IsLeader == false)
+            {
+                return new List<GasBankWithdrawal>();
+            }
+
+            var sent = (await _withdrawalRepository.GetByStatusAsync(GasBankWithdrawalStatus.Completed))
+                .Where(w => !string.IsNullOrEmpty(w.TransactionHash))
+                .ToList();
+
+            var changed = new List<GasBankWithdrawal>();
+            if (sent.Count == 0)
+            {
+                return changed;
+            }
+
+            var logs = await _neoRpcClient.GetApplicationLogsAsync(sent.Select(w => w.TransactionHash));
+            var timeout = TimeSpan.FromMinutes(_configuration.WithdrawalConfirmationTimeoutMinutes);
+            var confirmations = await GetConfirmationsAsync(sent
+                .Where((w, i) => i < logs.Count && logs[i] != null)
+                .Select(w => w.TransactionHash)
+                .ToList());
+
+            for (int i = 0; i < sent.Count; i++)
+            {
+                var withdrawal = sent[i];
+                var log = i < logs.Count ? logs[i] : null;
+
+                try
+                {
+                    if (log == null)
+                    {
+                        if (DateTime.UtcNow - (withdrawal.CompletedAt ?? withdrawal.RequestedAt) < timeout)
+                        {
+                            continue;
+                        }
+
+                        // The transfer may still be in a node's memory pool, or may never have been relayed
+                        withdrawal.Status = GasBankWithdrawalStatus.InDoubt;
+                        withdrawal.StatusReason = $"Transaction {withdrawal.TransactionHash} was not found on chain within " +
+                            $"{_configuration.WithdrawalConfirmationTimeoutMinutes} minutes; check the chain and resolve the withdrawal";
+
+                        if (!await _withdrawalRepository.UpdateAsync(withdrawal, GasBankWithdrawalStatus.Completed))
+                        {
+                            continue;
+                        }
+
+                        _logger.LogWarning("Withdrawal {WithdrawalId} from GasBank account {GasBankAccountId} is in doubt: transaction {TransactionHash} was not found on chain",
+                            withdrawal.Id, withdrawal.GasBankAccountId, withdrawal.TransactionHash);
+                    }
+                    else if (!confirmations.TryGetValue(withdrawal.TransactionHash, out var depth) || depth < _configuration.WithdrawalConfirmations)
+                    {
+                        // The transfer is on chain, so it is no longer timed out; it is settled once deep enough
+                        if (withdrawal.Confirmations != depth)
+                        {
+                            withdrawal.Confirmations = depth;
+                            await _withdrawalRepository.UpdateAsync(withdrawal);
+                        }
+
+                        continue;
+                    }
+                    else if (log.IsHalt)
+                    {
+                        withdrawal.Confirmations = depth;
+                        withdrawal.Status = GasBankWithdrawalStatus.Confirmed;
+                        withdrawal.ConfirmedAt = DateTime.UtcNow;
+                        withdrawal.StatusReason = null;
+
+                        if (!await _withdrawalRepository.UpdateAsync(withdrawal, GasBankWithdrawalStatus.Completed))
+                        {
+                            continue;
+                        }
+
+                        _logger.LogInformation("Withdrawal {WithdrawalId} from GasBank account {GasBankAccountId} confirmed on chain: {TransactionHash}",
+                            withdrawal.Id, withdrawal.GasBankAccountId, withdrawal.TransactionHash);
+                    }
+                    else
+                    {
+                        withdrawal.Confirmations = depth;
+                        if (!await ReturnFaultedWithdrawalAsync(withdrawal, log))
+                        {
+                            continue;
+                        }
+                    }
+
+                    changed.Add(withdrawal);
+                }
+                catch (Exception ex)
+                {
+                    // Leave the withdrawal as sent and check it again next cycle
+                    _logger.LogError(ex, "Error settling withdrawal {WithdrawalId} from GasBank account {GasBankAccountId}",
+                        withdrawal.Id, withdrawal.GasBankAccountId);
+                }
+            }
+
+            return changed;
+        }
+
+        /// <summary>
+        /// Gets how many blocks deep persisted transactions are, counting their own blocks
+        /// </summary>
+        /// <param name="txHashes">Transaction hashes</param>
+        /// <returns>The depth of each transaction the node knows, by hash</returns>
+        private async Task<Dictionary<string, int>> GetConfirmationsAsync(IReadOnlyList<string> txHashes)
+        {
+            var confirmations = new Dictionary<string, int>(StringComparer.OrdinalIgnoreCase);
+            if (txHashes.Count == 0)
+            {
+                return confirmations;
+            }
+
+            var heights = await _neoRpcClient.GetTransactionHeightsAsync(txHashes);
+            var blockCount = await _neoRpcClient.GetBlockCountAsync();
+
+            for (int i = 0; i < txHashes.Count && i < heights.Count; i++)
+            {
+                if (heights[i].HasValue)
+                {
+                    confirmations[txHashes[i]] = (int)Math.Max(0, blockCount - heights[i].Value);
+                }
+            }
+
+            return confirmations;
+        }
+
+        /// <summary>
+        /// Marks a withdrawal whose transfer faulted on chain and returns its amount to the balance and hot wallet
+        /// </summary>
+        /// <returns>True if the amount was returned, false if the withdrawal was no longer completed</returns>
+        private async Task<bool> ReturnFaultedWithdrawalAsync(GasBankWithdrawal withdrawal, NeoApplicationLog log)
+        {
+            var now = DateTime.UtcNow;
+            var error = log.Executions.Select(e => e.Exception).FirstOrDefault(e => !string.IsNullOrEmpty(e));
+
+            withdrawal.Status = GasBankWithdrawalStatus.FailedOnChain;
+            withdrawal.ConfirmedAt = now;
+            withdrawal.LastError = error;
+            withdrawal.StatusReason = "The transfer faulted on chain; the amount was returned to the balance";
+
+            // The status only moves from completed once, so the amount is never returned twice
+            if (!await _withdrawalRepository.UpdateAsync(withdrawal, GasBankWithdrawalStatus.Completed))
+            {
+                _logger.LogWarning("Withdrawal {WithdrawalId} was settled elsewhere; not returning its amount again", withdrawal.Id);
+                return false;
+            }
+
+            GasBankTransaction transaction = null;
+            var gasBankAccount = await _accountRepository.UpdateBalanceAsync(withdrawal.GasBankAccountId, account =>
+            {
+                account.Balance += withdrawal.Amount;
+                account.HotBalance += withdrawal.Amount;
+
+                transaction = new GasBankTransaction
+                {
+                    Id = Guid.NewGuid(),
+                    GasBankAccountId = account.Id,
+                    Type = GasBankTransactionType.Deposit,
+                    Amount = withdrawal.Amount,
+                    BalanceAfter = account.Balance,
+                    TransactionHash = withdrawal.TransactionHash,
+                    RelatedEntityId = withdrawal.Id,
+                    NeoAddress = withdrawal.ToAddress,
+                    Timestamp = now,
+                    Description = "Return of a withdrawal that faulted on chain"
+                };
+
+                return transaction;
+            });
+
+            if (gasBankAccount == null)
+            {
+                throw new GasBankException("GasBank account not found");
+            }
+
+            PublishBalanceChanged(gasBankAccount, transaction);
+
+            _logger.LogWarning("Withdrawal {WithdrawalId} from GasBank account {GasBankAccountId} faulted on chain: {TransactionHash} {Error}",
+                withdrawal.Id, gasBankAccount.Id, withdrawal.TransactionHash, error);
+
+            return true;
+        }
+
+        /// <summary>
+        /// Moves GAS above the float from each hot wallet to the cold wallet; the caller holds the hot wallet semaphore
+        /// </summary>
+        private async Task<IEnumerable<GasBankTransaction>> SweepAsync()
+        {
+            // GAS needed for queued withdrawals stays in the hot wallet
+            var queuedAccountIds = (await _withdrawalRepository.GetByStatusAsync(GasBankWithdrawalStatus.Queued))
+                .Select(w => w.GasBankAccountId)
+                .ToHashSet();
+
+            var sweeps = new List<GasBankTransaction>();
+            foreach (var gasBankAccount in await _accountRepository.GetAllAsync())
+            {
+                var excess = gasBankAccount.HotBalance - _configuration.HotWalletFloat;
+                if (queuedAccountIds.Contains(gasBankAccount.Id) || excess <= 0 || excess < _configuration.MinimumSweepAmount)
+                {
+                    continue;
+                }
+
+                try
+                {
+                    string transactionHash = await _walletService.TransferGasAsync(
+                        gasBankAccount.WalletId,
+                        Guid.NewGuid().ToString(), // Password is not used for service wallets
+                        _configuration.ColdWalletAddress,
+                        excess);
+
+                    GasBankTransaction transaction = null;
+                    var sweptAccount = await _accountRepository.UpdateBalanceAsync(gasBankAccount.Id, account =>
+                    {
+                        account.HotBalance = Math.Max(0, account.HotBalance - excess);
+
+                        transaction = new GasBankTransaction
+                        {
+                            Id = Guid.NewGuid(),
+                            GasBankAccountId = account.Id,
+                            Type = GasBankTransactionType.Sweep,
+                            Amount = excess,
+                            BalanceAfter = account.Balance,
+                            TransactionHash = transactionHash,
+                            RelatedEntityId = null,
+                            NeoAddress = _configuration.ColdWalletAddress,
+                            Timestamp = DateTime.UtcNow,
+                            Description = "Sweep of excess GAS to cold storage"
+                        };
+
+                        return transaction;
+                    });
+
+                    if (sweptAccount == null)
+                    {
+                        throw new GasBankException("GasBank account not found");
+                    }
+
+                    PublishBalanceChanged(sweptAccount, transaction);
+                    sweeps.Add(transaction);
+
+                    _logger.LogInformation("Swept {Amount} GAS from GasBank account {GasBankAccountId} to cold storage: {TransactionHash}",
+                        excess, gasBankAccount.Id, transactionHash);
+                }
+                catch (Exception ex)
+                {
+                    // Leave the excess in the hot wallet and try again next cycle
+                    _logger.LogError(ex, "Error sweeping GasBank account {GasBankAccountId} to cold storage", gasBankAccount.Id);
+                }
+            }
+
+            return sweeps;
+        }
+
+        /// <summary>
+        /// Sends an account's queued withdrawals, oldest first, while its hot wallet can cover them
+        /// </summary>
+        /// <remarks>
+        /// A failed send is retried with exponential backoff, and later withdrawals of the account wait behind it.
+        /// After <see cref="GasBankConfiguration.MaxWithdrawalAttempts"/> failures it is marked failed and its amount
+        /// returned to the balance.
+        /// </remarks>
+        /// <returns>The account after the sends</returns>
+        private async Task<GasBankAccount> ReleaseQueuedWithdrawalsAsync(GasBankAccount gasBankAccount, List<GasBankWithdrawal> queued)
+        {
+            foreach (var withdrawal in queued.OrderBy(w => w.RequestedAt))
+            {
+                // Later withdrawals wait behind an earlier one that can't be covered or retried yet
+                if (gasBankAccount.HotBalance < withdrawal.Amount || withdrawal.NextAttemptAt > DateTime.UtcNow)
+                {
+                    break;
+                }
+
+                var covered = false;
+                var account = await _accountRepository.UpdateBalanceAsync(gasBankAccount.Id, a =>
+                {
+                    covered = a.HotBalance >= withdrawal.Amount;
+                    if (covered)
+                    {
+                        a.HotBalance -= withdrawal.Amount;
+                    }
+
+                    return null;
+                });
+
+                if (account == null)
+                {
+                    break;
+                }
+
+                gasBankAccount = account;
+                if (!covered)
+                {
+                    break;
+                }
+
+                // Recorded as sending before the transfer, so a restart mid-transfer leaves it in doubt instead of sending it twice
+                withdrawal.Status = GasBankWithdrawalStatus.Sending;
+                withdrawal.StatusReason = null;
+                await _withdrawalRepository.UpdateAsync(withdrawal);
+
+                try
+                {
+                    withdrawal.TransactionHash = await _walletService.TransferGasAsync(
+                        gasBankAccount.WalletId,
+                        Guid.NewGuid().ToString(), // Password is not used for service wallets
+                        withdrawal.ToAddress,
+                        withdrawal.Amount);
+                }
+                catch (Exception ex)
+                {
+                    _logger.LogError(ex, "Error sending queued withdrawal {WithdrawalId} from GasBank account {GasBankAccountId}, attempt {Attempt}",
+                        withdrawal.Id, gasBankAccount.Id, withdrawal.Attempts + 1);
+
+                    gasBankAccount = await RetryOrFailQueuedWithdrawalAsync(withdrawal, ex.Message) ?? gasBankAccount;
+                    break;
+                }
+
+                withdrawal.Status = GasBankWithdrawalStatus.Completed;
+                withdrawal.NextAttemptAt = null;
+                withdrawal.CompletedAt = DateTime.UtcNow;
+
+                await _withdrawalRepository.UpdateAsync(withdrawal);
+
+                _logger.LogInformation("Sent queued withdrawal {WithdrawalId} of {Amount} GAS from GasBank account {GasBankAccountId}: {TransactionHash}",
+                    withdrawal.Id, withdrawal.Amount, gasBankAccount.Id, withdrawal.TransactionHash);
+            }
+
+            return gasBankAccount;
+        }
+
+        /// <summary>
+        /// Puts a queued withdrawal whose send failed back in the queue with a backoff, or fails it once out of attempts
+        /// </summary>
+        /// <returns>The account after the hot wallet amount, and on failure the balance, were returned</returns>
+        private async Task<GasBankAccount> RetryOrFailQueuedWithdrawalAsync(GasBankWithdrawal withdrawal, string error)
+        {
+            var now = DateTime.UtcNow;
+            withdrawal.Attempts++;
+            withdrawal.LastError = error;
+
+            var retry = withdrawal.Attempts < Math.Max(1, _configuration.MaxWithdrawalAttempts);
+            if (retry)
+            {
+                withdrawal.Status = GasBankWithdrawalStatus.Queued;
+                withdrawal.NextAttemptAt = now.Add(GetWithdrawalRetryDelay(withdrawal.Attempts));
+                withdrawal.StatusReason = $"Attempt {withdrawal.Attempts} of {_configuration.MaxWithdrawalAttempts} failed; retrying at {withdrawal.NextAttemptAt:u}";
+            }
+            else
+            {
+                withdrawal.Status = GasBankWithdrawalStatus.Failed;
+                withdrawal.NextAttemptAt = null;
+                withdrawal.StatusReason = $"All {withdrawal.Attempts} attempts failed; the amount was returned to the balance";
+            }
+
+            await _withdrawalRepository.UpdateAsync(withdrawal);
+
+            GasBankTransaction transaction = null;
+            var gasBankAccount = await _accountRepository.UpdateBalanceAsync(withdrawal.GasBankAccountId, account =>
+            {
+                account.HotBalance += withdrawal.Amount;
+                if (retry)
+                {
+                    return null;
+                }
+
+                // The amount was recorded as withdrawn when the withdrawal was queued, so its return is recorded too
+                account.Balance += withdrawal.Amount;
+
+                transaction = new GasBankTransaction
+                {
+                    Id = Guid.NewGuid(),
+                    GasBankAccountId = account.Id,
+                    Type = GasBankTransactionType.Deposit,
+                    Amount = withdrawal.Amount,
+                    BalanceAfter = account.Balance,
+                    TransactionHash = null,
+                    RelatedEntityId = withdrawal.Id,
+                    NeoAddress = withdrawal.ToAddress,
+                    Timestamp = now,
+                    Description = $"Return of a queued withdrawal that failed {withdrawal.Attempts} times"
+                };
+
+                return transaction;
+            });
+
+            if (gasBankAccount != null && transaction != null)
+            {
+                PublishBalanceChanged(gasBankAccount, transaction);
+            }
+
+            if (!retry)
+            {
+                _logger.LogWarning("Queued withdrawal {WithdrawalId} from GasBank account {GasBankAccountId} failed after {Attempts} attempts: {Error}",
+                    withdrawal.Id, withdrawal.GasBankAccountId, withdrawal.Attempts, error);
+            }
+
+            return gasBankAccount;
+        }
+
+        /// <summary>
+        /// Gets the delay before the next attempt, doubling with each failure up to the configured maximum
+        /// </summary>
+        /// <param name="attempts">Failed attempts so far</param>
+        /// <returns>The delay</returns>
+        private TimeSpan GetWithdrawalRetryDelay(int attempts)
+        {
+            var seconds = _configuration.WithdrawalRetryBaseSeconds * Math.Pow(2, Math.Min(attempts - 1, 20));
+            return TimeSpan.FromSeconds(Math.Min(seconds, _configuration.WithdrawalRetryMaxSeconds));
+        }
+
+        private async Task<bool> HasQueuedWithdrawalsAsync(Guid gasBankAccountId)
+        {
+            var queued = await _withdrawalRepository.GetByStatusAsync(GasBankWithdrawalStatus.Queued);
+            return queued.Any(w => w.GasBankAccountId == gasBankAccountId);
+        }
+
+        /// <inheritdoc/>
+        public string Name => Constants.ManagedServices.GasBank;
+
+        /// <inheritdoc/>
+        public IReadOnlyCollection<string> DependsOn { get; } = new[] { Constants.ManagedServices.Leadership };
+
+        /// <summary>
+        /// Resumes the sweep and confirmation cycles after <see cref="StopAsync"/>; they run from construction, so this
+        /// is only needed to restart them
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public Task StartAsync()
+        {
+            if (_stopped)
+            {
+                _stopped = false;
+                _confirmationTimer?.Change(
+                    TimeSpan.FromSeconds(_configuration.WithdrawalConfirmationIntervalSeconds),
+                    TimeSpan.FromSeconds(_configuration.WithdrawalConfirmationIntervalSeconds));
+                _sweepTimer?.Change(TimeSpan.FromSeconds(_configuration.SweepIntervalSeconds), TimeSpan.FromSeconds(_configuration.SweepIntervalSeconds));
+            }
+
+            return Task.CompletedTask;
+        }
+
+        /// <summary>
+        /// Stops the sweep and confirmation cycles and waits for a withdrawal, replenishment or cycle in progress to finish
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task StopAsync()
+        {
+            _stopped = true;
+            _confirmationTimer?.Change(Timeout.Infinite, Timeout.Infinite);
+            _sweepTimer?.Change(Timeout.Infinite, Timeout.Infinite);
+
+            // A withdrawal half sent when the process exits has to be reconciled by hand
+            await _hotWalletSemaphore.WaitAsync();
+            _hotWalletSemaphore.Release();
+        }
+
+        /// <inheritdoc/>
+        public ManagedServiceHealth GetHealth()
+        {
+            return ManagedServiceHealth.Of(Name, _stopped ? ManagedServiceStatus.Stopped : ManagedServiceStatus.Running);
+        }
+
+        /// <summary>
+        /// Disposes the service
+        /// </summary>
+        public void Dispose()
+        {
+            _sweepTimer?.Dispose();
+            _confirmationTimer?.Dispose();
+            _hotWalletSemaphore?.Dispose();
+        }
     }
 }
\ No newline at end of file
@@ -0,0 +1,91 @@
+using Microsoft.Extensions.Configuration;
+using Microsoft.Extensions.DependencyInjection;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.GasBank.Repositories;
+using NeoServiceLayer.Services.GasBank.Repositories.PostgreSql;
+
+namespace NeoServiceLayer.Services.GasBank
+{
+    /// <summary>
+    /// Extension methods for registering GasBank services
+    /// </summary>
+    public static class GasBankServiceExtensions
+    {
+        /// <summary>
+        /// Adds GasBank services to the service collection
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <param name="configuration">Configuration</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddGasBankServices(this IServiceCollection services, IConfiguration configuration = null)
+        {
+            // Register options
+            if (configuration != null)
+            {
+                services.Configure<GasBankConfiguration>(options => configuration.GetSection("GasBank").Bind(options));
+            }
+
+            // Register repositories
+            services.AddGasBankRepositories(configuration);
+
+            // Register services
+            services.AddSingleton<IGasBankService, GasBankService>();
+            services.AddSingleton<IGasPaymentStreamService, GasPaymentStreamService>();
+            services.AddSingleton<IGasRecurringWithdrawalService, GasRecurringWithdrawalService>();
+            services.AddSingleton<IGasFeePolicyService, GasFeePolicyService>();
+            services.AddGasBankRecovery();
+
+            return services;
+        }
+
+        /// <summary>
+        /// Adds the startup recovery steps that hold interrupted withdrawals and stream settlements for an operator
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddGasBankRecovery(this IServiceCollection services)
+        {
+            services.AddSingleton<IStartupRecoveryStep, GasBankWithdrawalRecoveryStep>();
+            services.AddSingleton<IStartupRecoveryStep, GasPaymentStreamRecoveryStep>();
+
+            return services;
+        }
+
+        /// <summary>
+        /// Adds the GasBank repositories for the configured storage backend
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <param name="configuration">Configuration; the storage provider backend is used when null</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddGasBankRepositories(this IServiceCollection services, IConfiguration configuration)
+        {
+            var gasBankConfig = configuration?.GetSection("GasBank").Get<GasBankConfiguration>();
+            if (gasBankConfig?.UsePostgreSql == true)
+            {
+                services.AddSingleton<PostgreSqlGasBankStore>();
+                services.AddSingleton<IGasBankAccountRepository, PostgreSqlGasBankAccountRepository>();
+                services.AddSingleton<IGasBankAllocationRepository, PostgreSqlGasBankAllocationRepository>();
+                services.AddSingleton<IGasBankTransactionRepository, PostgreSqlGasBankTransactionRepository>();
+                services.AddSingleton<IGasBankWithdrawalRepository, PostgreSqlGasBankWithdrawalRepository>();
+                services.AddSingleton<IGasPaymentStreamRepository, PostgreSqlGasPaymentStreamRepository>();
+                services.AddSingleton<IGasRecurringWithdrawalRepository, PostgreSqlGasRecurringWithdrawalRepository>();
+                services.AddSingleton<IGasFeePolicyTemplateRepository, PostgreSqlGasFeePolicyTemplateRepository>();
+                services.AddSingleton<IGasFeePolicyRepository, PostgreSqlGasFeePolicyRepository>();
+            }
+            else
+            {
+                services.AddSingleton<IGasBankAccountRepository, GasBankAccountRepository>();
+                services.AddSingleton<IGasBankAllocationRepository, GasBankAllocationRepository>();
+                services.AddSingleton<IGasBankTransactionRepository, GasBankTransactionRepository>();
+                services.AddSingleton<IGasBankWithdrawalRepository, GasBankWithdrawalRepository>();
+                services.AddSingleton<IGasPaymentStreamRepository, GasPaymentStreamRepository>();
+                services.AddSingleton<IGasRecurringWithdrawalRepository, GasRecurringWithdrawalRepository>();
+                services.AddSingleton<IGasFeePolicyTemplateRepository, GasFeePolicyTemplateRepository>();
+                services.AddSingleton<IGasFeePolicyRepository, GasFeePolicyRepository>();
+            }
+
+            return services;
+        }
+    }
+}
@@ -0,0 +1,54 @@
+using System;
+using System.Linq;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.GasBank.Repositories;
+
+namespace NeoServiceLayer.Services.GasBank
+{
+    /// <summary>
+    /// Moves withdrawals that were sending when the previous process stopped to <see cref="GasBankWithdrawalStatus.InDoubt"/>
+    /// </summary>
+    /// <remarks>
+    /// The transfer may or may not have reached the chain, so the withdrawal is neither sent again nor refunded
+    /// until an operator resolves it. Queued withdrawals need no recovery; the sweep cycle resumes them.
+    /// </remarks>
+    public class GasBankWithdrawalRecoveryStep : IStartupRecoveryStep
+    {
+        private readonly IGasBankWithdrawalRepository _withdrawalRepository;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasBankWithdrawalRecoveryStep"/> class
+        /// </summary>
+        /// <param name="withdrawalRepository">GasBank withdrawal repository</param>
+        public GasBankWithdrawalRecoveryStep(IGasBankWithdrawalRepository withdrawalRepository)
+        {
+            _withdrawalRepository = withdrawalRepository;
+        }
+
+        /// <inheritdoc/>
+        public string Name => "GasBankWithdrawals";
+
+        /// <inheritdoc/>
+        public async Task<StartupRecoveryStepResult> RecoverAsync(DateTime processStartTime)
+        {
+            var result = new StartupRecoveryStepResult();
+
+            var sending = await _withdrawalRepository.GetByStatusAsync(GasBankWithdrawalStatus.Sending);
+            foreach (var withdrawal in sending.Where(w => w.RequestedAt < processStartTime))
+            {
+                withdrawal.Status = GasBankWithdrawalStatus.InDoubt;
+                withdrawal.StatusReason = "The service stopped while the transfer was being sent; check the address on chain and resolve it";
+                await _withdrawalRepository.UpdateAsync(withdrawal);
+
+                result.Resolved++;
+                result.Details.Add($"Withdrawal {withdrawal.Id} of {withdrawal.Amount} GAS to {withdrawal.ToAddress} is in doubt");
+            }
+
+            result.Resumed = (await _withdrawalRepository.GetByStatusAsync(GasBankWithdrawalStatus.Queued)).Count();
+
+            return result;
+        }
+    }
+}
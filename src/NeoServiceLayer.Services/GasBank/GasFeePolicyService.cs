@@ -0,0 +1,445 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Text.RegularExpressions;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.GasBank.Repositories;
+
+namespace NeoServiceLayer.Services.GasBank
+{
+    /// <summary>
+    /// Implementation of the GasBank fee policy service
+    /// </summary>
+    /// <remarks>
+    /// A template is a named list of immutable rule versions. A policy points at a template, either pinned to one
+    /// version or following the latest, and may override individual rules; its effective rules are resolved when it
+    /// is read, so a newly published version takes effect for every following policy without rewriting them. The
+    /// template owner, typically a dApp admin, can apply a template to many accounts at once, paying from one of
+    /// their own GasBank accounts. Sponsored fees are charged to the sponsor's GasBank account and counted against
+    /// the policy's daily limit.
+    /// </remarks>
+    public class GasFeePolicyService : IGasFeePolicyService
+    {
+        private static readonly Regex ScriptHash = new Regex(@"^0x[a-fA-F0-9]{40}$", RegexOptions.Compiled);
+
+        private readonly ILogger<GasFeePolicyService> _logger;
+        private readonly IGasFeePolicyTemplateRepository _templateRepository;
+        private readonly IGasFeePolicyRepository _policyRepository;
+        private readonly IGasBankAccountRepository _accountRepository;
+        private readonly IGasBankService _gasBankService;
+        private readonly SemaphoreSlim _spendLock = new SemaphoreSlim(1, 1);
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasFeePolicyService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="templateRepository">Fee policy template repository</param>
+        /// <param name="policyRepository">Fee policy repository</param>
+        /// <param name="accountRepository">GasBank account repository</param>
+        /// <param name="gasBankService">GasBank service that charges sponsored fees</param>
+        public GasFeePolicyService(
+            ILogger<GasFeePolicyService> logger,
+            IGasFeePolicyTemplateRepository templateRepository,
+            IGasFeePolicyRepository policyRepository,
+            IGasBankAccountRepository accountRepository,
+            IGasBankService gasBankService)
+        {
+            _logger = logger;
+            _templateRepository = templateRepository;
+            _policyRepository = policyRepository;
+            _accountRepository = accountRepository;
+            _gasBankService = gasBankService;
+        }
+
+        /// <summary>
+        /// Checks whether rules cover a transaction
+        /// </summary>
+        /// <param name="rules">Effective rules</param>
+        /// <param name="contractHash">Contract the transaction invoked</param>
+        /// <param name="method">Method the transaction invoked</param>
+        /// <param name="fee">Network fee of the transaction</param>
+        /// <returns>True if the contract, method and fee are within the rules; the daily limit is not checked</returns>
+        public static bool Covers(GasFeePolicyRules rules, string contractHash, string method, decimal fee)
+        {
+            if (rules.ContractHash != null && !string.Equals(rules.ContractHash, contractHash, StringComparison.OrdinalIgnoreCase))
+            {
+                return false;
+            }
+
+            if (rules.Methods != null && rules.Methods.Count > 0 && !rules.Methods.Contains(method, StringComparer.Ordinal))
+            {
+                return false;
+            }
+
+            return rules.MaxFeePerTransaction == null || fee <= rules.MaxFeePerTransaction.Value;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicyTemplate> CreateTemplateAsync(Guid ownerAccountId, string name, string description, bool isPublic, GasFeePolicyRules rules)
+        {
+            if (string.IsNullOrWhiteSpace(name))
+            {
+                throw new ValidationException("Template name is required");
+            }
+
+            ValidateRules(rules ?? throw new ValidationException("Template rules are required"));
+
+            var template = new GasFeePolicyTemplate
+            {
+                Id = Guid.NewGuid(),
+                OwnerAccountId = ownerAccountId,
+                Name = name,
+                Description = description,
+                IsPublic = isPublic,
+                LatestVersion = 1,
+                Versions = new List<GasFeePolicyTemplateVersion>
+                {
+                    new GasFeePolicyTemplateVersion { Version = 1, Rules = rules, PublishedAt = DateTime.UtcNow }
+                }
+            };
+
+            _logger.LogInformation("Creating fee policy template {Name} for account {AccountId}", name, ownerAccountId);
+            return await _templateRepository.CreateAsync(template);
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicyTemplate> PublishVersionAsync(Guid templateId, Guid ownerAccountId, GasFeePolicyRules rules, string notes)
+        {
+            ValidateRules(rules ?? throw new ValidationException("Template rules are required"));
+
+            var template = await GetOwnedTemplateAsync(templateId, ownerAccountId);
+            var expectedLatestVersion = template.LatestVersion;
+
+            template.LatestVersion = expectedLatestVersion + 1;
+            template.Versions.Add(new GasFeePolicyTemplateVersion
+            {
+                Version = template.LatestVersion,
+                Rules = rules,
+                Notes = notes,
+                PublishedAt = DateTime.UtcNow
+            });
+
+            if (!await _templateRepository.UpdateAsync(template, expectedLatestVersion))
+            {
+                throw new ValidationException($"Another version of template {templateId} was published first; reload it and try again");
+            }
+
+            _logger.LogInformation("Published version {Version} of fee policy template {TemplateId}", template.LatestVersion, templateId);
+            return template;
+        }
+
+        /// <inheritdoc/>
+        public Task<GasFeePolicyTemplate> GetTemplateAsync(Guid templateId)
+        {
+            return _templateRepository.GetByIdAsync(templateId);
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<GasFeePolicyTemplate>> GetTemplatesAsync(Guid accountId)
+        {
+            return _templateRepository.GetVisibleToAsync(accountId);
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicy> CreatePolicyAsync(Guid templateId, Guid sponsorAccountId, Guid gasBankAccountId, Guid accountId, int? templateVersion, GasFeePolicyRules overrides)
+        {
+            var template = await _templateRepository.GetByIdAsync(templateId)
+                ?? throw new ResourceNotFoundException("GasFeePolicyTemplate", templateId.ToString());
+
+            if (!template.IsPublic && template.OwnerAccountId != sponsorAccountId)
+            {
+                throw new ForbiddenAccessException("GasFeePolicyTemplate", templateId.ToString(), sponsorAccountId.ToString());
+            }
+
+            // Only the template owner may sponsor other accounts with it
+            if (accountId != sponsorAccountId && template.OwnerAccountId != sponsorAccountId)
+            {
+                throw new ForbiddenAccessException("GasFeePolicyTemplate", templateId.ToString(), sponsorAccountId.ToString());
+            }
+
+            ValidateVersion(template, templateVersion);
+            ValidateRules(overrides ??= new GasFeePolicyRules());
+            await GetSponsorAccountAsync(gasBankAccountId, sponsorAccountId);
+
+            var policy = new GasFeePolicy
+            {
+                Id = Guid.NewGuid(),
+                AccountId = accountId,
+                SponsorAccountId = sponsorAccountId,
+                GasBankAccountId = gasBankAccountId,
+                TemplateId = templateId,
+                TemplateVersion = templateVersion,
+                Overrides = overrides
+            };
+
+            _logger.LogInformation("Creating fee policy from template {TemplateId} for account {AccountId}", templateId, accountId);
+            await _policyRepository.CreateAsync(policy);
+
+            policy.EffectiveRules = Resolve(template, policy);
+            return policy;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicyBulkResult> ApplyTemplateAsync(Guid templateId, Guid ownerAccountId, Guid gasBankAccountId, IEnumerable<Guid> accountIds, int? templateVersion)
+        {
+            var targets = accountIds?.Where(id => id != Guid.Empty).Distinct().ToList() ?? new List<Guid>();
+            if (targets.Count == 0)
+            {
+                throw new ValidationException("At least one account is required");
+            }
+
+            var template = await GetOwnedTemplateAsync(templateId, ownerAccountId);
+            ValidateVersion(template, templateVersion);
+            await GetSponsorAccountAsync(gasBankAccountId, ownerAccountId);
+
+            // Policies the owner already sponsors from this template are moved rather than duplicated
+            var existing = (await _policyRepository.GetByTemplateIdAsync(templateId))
+                .Where(p => p.SponsorAccountId == ownerAccountId)
+                .GroupBy(p => p.AccountId)
+                .ToDictionary(g => g.Key, g => g.First());
+
+            var result = new GasFeePolicyBulkResult();
+            foreach (var accountId in targets)
+            {
+                if (existing.TryGetValue(accountId, out var policy))
+                {
+                    policy.GasBankAccountId = gasBankAccountId;
+                    policy.TemplateVersion = templateVersion;
+                    await _policyRepository.UpdateAsync(policy);
+                    result.Updated.Add(policy);
+                }
+                else
+                {
+                    policy = await _policyRepository.CreateAsync(new GasFeePolicy
+                    {
+                        Id = Guid.NewGuid(),
+                        AccountId = accountId,
+                        SponsorAccountId = ownerAccountId,
+                        GasBankAccountId = gasBankAccountId,
+                        TemplateId = templateId,
+                        TemplateVersion = templateVersion
+                    });
+                    result.Created.Add(policy);
+                }
+
+                policy.EffectiveRules = Resolve(template, policy);
+            }
+
+            _logger.LogInformation("Applied fee policy template {TemplateId} to {Count} accounts: {Created} created, {Updated} updated",
+                templateId, targets.Count, result.Created.Count, result.Updated.Count);
+            return result;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicy> GetPolicyAsync(Guid policyId)
+        {
+            var policy = await _policyRepository.GetByIdAsync(policyId);
+            if (policy != null)
+            {
+                policy.EffectiveRules = Resolve(await _templateRepository.GetByIdAsync(policy.TemplateId), policy);
+            }
+
+            return policy;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasFeePolicy>> GetPoliciesAsync(Guid accountId)
+        {
+            var policies = (await _policyRepository.GetByAccountIdAsync(accountId)).ToList();
+            await ResolveAsync(policies);
+
+            return policies;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicy> UpdatePolicyAsync(Guid policyId, Guid sponsorAccountId, int? templateVersion, GasFeePolicyRules overrides)
+        {
+            var policy = await _policyRepository.GetByIdAsync(policyId)
+                ?? throw new ResourceNotFoundException("GasFeePolicy", policyId.ToString());
+
+            if (policy.SponsorAccountId != sponsorAccountId)
+            {
+                throw new ForbiddenAccessException("GasFeePolicy", policyId.ToString(), sponsorAccountId.ToString());
+            }
+
+            var template = await _templateRepository.GetByIdAsync(policy.TemplateId)
+                ?? throw new ResourceNotFoundException("GasFeePolicyTemplate", policy.TemplateId.ToString());
+
+            ValidateVersion(template, templateVersion);
+            ValidateRules(overrides ??= new GasFeePolicyRules());
+
+            policy.TemplateVersion = templateVersion;
+            policy.Overrides = overrides;
+            await _policyRepository.UpdateAsync(policy);
+
+            policy.EffectiveRules = Resolve(template, policy);
+            return policy;
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeletePolicyAsync(Guid policyId, Guid accountId)
+        {
+            var policy = await _policyRepository.GetByIdAsync(policyId);
+            if (policy == null)
+            {
+                return false;
+            }
+
+            if (policy.SponsorAccountId != accountId && policy.AccountId != accountId)
+            {
+                throw new ForbiddenAccessException("GasFeePolicy", policyId.ToString(), accountId.ToString());
+            }
+
+            return await _policyRepository.DeleteAsync(policyId);
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicy> SponsorAsync(Guid accountId, string contractHash, string method, decimal fee, string transactionHash)
+        {
+            if (fee <= 0)
+            {
+                throw new ValidationException("Fee must be greater than zero");
+            }
+
+            var policies = (await _policyRepository.GetByAccountIdAsync(accountId)).Where(p => p.AccountId == accountId).ToList();
+            await ResolveAsync(policies);
+
+            // Daily spending is read and written under one lock so concurrent sponsorships cannot overrun the limit
+            await _spendLock.WaitAsync();
+            try
+            {
+                var today = DateTime.UtcNow.Date;
+                foreach (var policy in policies.Where(p => p.EffectiveRules != null && Covers(p.EffectiveRules, contractHash, method, fee)))
+                {
+                    var current = await _policyRepository.GetByIdAsync(policy.Id);
+                    if (current == null)
+                    {
+                        continue;
+                    }
+
+                    var spent = current.SpentDate == today ? current.SpentToday : 0;
+                    if (policy.EffectiveRules.DailyLimit != null && spent + fee > policy.EffectiveRules.DailyLimit.Value)
+                    {
+                        continue;
+                    }
+
+                    try
+                    {
+                        await _gasBankService.ChargeNetworkFeeAsync(
+                            current.GasBankAccountId,
+                            fee,
+                            transactionHash,
+                            $"Sponsored fee for {method} on {contractHash}",
+                            current.Id);
+                    }
+                    catch (GasBankException ex)
+                    {
+                        // An empty sponsor account falls through to the account's next policy
+                        _logger.LogWarning(ex, "Fee policy {PolicyId} could not pay the fee of transaction {TransactionHash}", current.Id, transactionHash);
+                        continue;
+                    }
+
+                    current.SpentToday = spent + fee;
+                    current.SpentDate = today;
+                    await _policyRepository.UpdateAsync(current);
+
+                    current.EffectiveRules = policy.EffectiveRules;
+                    _logger.LogInformation("Fee policy {PolicyId} sponsored {Fee} GAS for transaction {TransactionHash}", current.Id, fee, transactionHash);
+                    return current;
+                }
+
+                return null;
+            }
+            finally
+            {
+                _spendLock.Release();
+            }
+        }
+
+        private static GasFeePolicyRules Resolve(GasFeePolicyTemplate template, GasFeePolicy policy)
+        {
+            var version = policy.TemplateVersion ?? template?.LatestVersion;
+            var rules = template?.Versions.FirstOrDefault(v => v.Version == version)?.Rules;
+
+            // A policy whose template is gone covers nothing
+            return rules != null ? GasFeePolicyRules.Merge(rules, policy.Overrides) : null;
+        }
+
+        private static void ValidateRules(GasFeePolicyRules rules)
+        {
+            if (rules.ContractHash != null && !ScriptHash.IsMatch(rules.ContractHash))
+            {
+                throw new ValidationException("Contract hash must be a 0x-prefixed script hash");
+            }
+
+            if (rules.Methods != null && rules.Methods.Any(string.IsNullOrWhiteSpace))
+            {
+                throw new ValidationException("Method names cannot be empty");
+            }
+
+            if (rules.MaxFeePerTransaction <= 0)
+            {
+                throw new ValidationException("Maximum fee per transaction must be greater than zero");
+            }
+
+            if (rules.DailyLimit <= 0)
+            {
+                throw new ValidationException("Daily limit must be greater than zero");
+            }
+        }
+
+        private static void ValidateVersion(GasFeePolicyTemplate template, int? templateVersion)
+        {
+            if (templateVersion != null && (templateVersion < 1 || templateVersion > template.LatestVersion))
+            {
+                throw new ValidationException($"Template {template.Id} has no version {templateVersion}");
+            }
+        }
+
+        private async Task ResolveAsync(List<GasFeePolicy> policies)
+        {
+            var templates = new Dictionary<Guid, GasFeePolicyTemplate>();
+            foreach (var policy in policies)
+            {
+                if (!templates.TryGetValue(policy.TemplateId, out var template))
+                {
+                    template = await _templateRepository.GetByIdAsync(policy.TemplateId);
+                    templates[policy.TemplateId] = template;
+                }
+
+                policy.EffectiveRules = Resolve(template, policy);
+            }
+        }
+
+        private async Task<GasFeePolicyTemplate> GetOwnedTemplateAsync(Guid templateId, Guid ownerAccountId)
+        {
+            var template = await _templateRepository.GetByIdAsync(templateId)
+                ?? throw new ResourceNotFoundException("GasFeePolicyTemplate", templateId.ToString());
+
+            if (template.OwnerAccountId != ownerAccountId)
+            {
+                throw new ForbiddenAccessException("GasFeePolicyTemplate", templateId.ToString(), ownerAccountId.ToString());
+            }
+
+            return template;
+        }
+
+        private async Task<GasBankAccount> GetSponsorAccountAsync(Guid gasBankAccountId, Guid sponsorAccountId)
+        {
+            var account = await _accountRepository.GetByIdAsync(gasBankAccountId)
+                ?? throw new ResourceNotFoundException("GasBankAccount", gasBankAccountId.ToString());
+
+            if (account.AccountId != sponsorAccountId)
+            {
+                throw new ForbiddenAccessException("GasBankAccount", gasBankAccountId.ToString(), sponsorAccountId.ToString());
+            }
+
+            return account;
+        }
+    }
+}
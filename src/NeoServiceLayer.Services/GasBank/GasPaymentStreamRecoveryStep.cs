@@ -0,0 +1,60 @@
+using System;
+using System.Linq;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.GasBank.Repositories;
+
+namespace NeoServiceLayer.Services.GasBank
+{
+    /// <summary>
+    /// Moves payment streams that were settling when the previous process stopped to <see cref="GasPaymentStreamStatus.InDoubt"/>
+    /// </summary>
+    /// <remarks>
+    /// The settlement transfer may or may not have reached the chain, so the stream is neither settled again nor
+    /// released until an operator resolves it. Closed streams need no recovery; the settlement cycle resumes them.
+    /// </remarks>
+    public class GasPaymentStreamRecoveryStep : IStartupRecoveryStep
+    {
+        private readonly IGasPaymentStreamRepository _streamRepository;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasPaymentStreamRecoveryStep"/> class
+        /// </summary>
+        /// <param name="streamRepository">Payment stream repository</param>
+        public GasPaymentStreamRecoveryStep(IGasPaymentStreamRepository streamRepository)
+        {
+            _streamRepository = streamRepository;
+        }
+
+        /// <inheritdoc/>
+        public string Name => "GasPaymentStreams";
+
+        /// <inheritdoc/>
+        public async Task<StartupRecoveryStepResult> RecoverAsync(DateTime processStartTime)
+        {
+            var result = new StartupRecoveryStepResult();
+
+            var settling = await _streamRepository.GetByStatusAsync(GasPaymentStreamStatus.Settling);
+            foreach (var stream in settling.Where(s => s.UpdatedAt < processStartTime))
+            {
+                stream.Status = GasPaymentStreamStatus.InDoubt;
+                stream.LastError = string.IsNullOrEmpty(stream.SettlementTransactionHash)
+                    ? "The service stopped while the settlement transfer was being sent; check the payee address on chain and resolve it"
+                    : $"The service stopped before settlement {stream.SettlementTransactionHash} was recorded; check it on chain and resolve it";
+
+                if (!await _streamRepository.UpdateAsync(stream, GasPaymentStreamStatus.Settling))
+                {
+                    continue;
+                }
+
+                result.Resolved++;
+                result.Details.Add($"Payment stream {stream.Id} settlement of {stream.AccruedAmount} GAS is in doubt");
+            }
+
+            result.Resumed = (await _streamRepository.GetByStatusAsync(GasPaymentStreamStatus.Closed)).Count();
+
+            return result;
+        }
+    }
+}
@@ -0,0 +1,584 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Diagnostics;
+using NeoServiceLayer.Services.GasBank.Repositories;
+
+namespace NeoServiceLayer.Services.GasBank
+{
+    /// <summary>
+    /// Implementation of the GasBank payment stream service
+    /// </summary>
+    /// <remarks>
+    /// Opening a stream reserves its cap in the payer's account, so the accrued GAS is always covered. Nothing
+    /// moves while the stream runs: the accrued amount is computed from the rate and the time elapsed. When the
+    /// payer or payee closes the stream, or it expires, the accrued GAS is sent to the payee in one transfer,
+    /// recorded in both ledgers, and the rest of the reservation is released. Status changes are conditional
+    /// on the previous status, so a stream is settled once even when a close races the expiry timer.
+    /// </remarks>
+    public class GasPaymentStreamService : IGasPaymentStreamService, IManagedService, IDisposable
+    {
+        // GAS has eight decimals
+        private const decimal GasFactor = 100_000_000m;
+
+        private readonly ILogger<GasPaymentStreamService> _logger;
+        private readonly IGasPaymentStreamRepository _streamRepository;
+        private readonly IGasBankAccountRepository _accountRepository;
+        private readonly IWalletService _walletService;
+        private readonly GasBankConfiguration _configuration;
+        private readonly ILeadershipService _leadership;
+        private readonly IMaintenanceService _maintenanceService;
+        private readonly ICrashReporter _crashReporter;
+        private readonly IServerEventPublisher _eventPublisher;
+        private readonly SemaphoreSlim _cycleSemaphore = new SemaphoreSlim(1, 1);
+        private Timer _settlementTimer;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasPaymentStreamService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="streamRepository">Payment stream repository</param>
+        /// <param name="accountRepository">GasBank account repository</param>
+        /// <param name="walletService">Wallet service</param>
+        /// <param name="configuration">GasBank configuration</param>
+        /// <param name="leadership">Leadership service; only the leader settles expired streams</param>
+        /// <param name="maintenanceService">Maintenance service; settlements wait while maintenance is active</param>
+        /// <param name="crashReporter">Crash reporter for background work</param>
+        /// <param name="eventPublisher">Publisher of balance changes to event stream subscribers</param>
+        public GasPaymentStreamService(
+            ILogger<GasPaymentStreamService> logger,
+            IGasPaymentStreamRepository streamRepository,
+            IGasBankAccountRepository accountRepository,
+            IWalletService walletService,
+            IOptions<GasBankConfiguration> configuration = null,
+            ILeadershipService leadership = null,
+            IMaintenanceService maintenanceService = null,
+            ICrashReporter crashReporter = null,
+            IServerEventPublisher eventPublisher = null)
+        {
+            _logger = logger;
+            _streamRepository = streamRepository;
+            _accountRepository = accountRepository;
+            _walletService = walletService;
+            _configuration = configuration?.Value ?? new GasBankConfiguration();
+            _leadership = leadership;
+            _maintenanceService = maintenanceService;
+            _crashReporter = crashReporter;
+            _eventPublisher = eventPublisher;
+        }
+
+        /// <summary>
+        /// Calculates the GAS a stream has accrued at a point in time
+        /// </summary>
+        /// <param name="stream">Stream</param>
+        /// <param name="at">Point in time; accrual stops at the stream's expiry</param>
+        /// <returns>The accrued GAS, rounded down to whole GAS fractions and never above the cap</returns>
+        public static decimal CalculateAccrued(GasPaymentStream stream, DateTime at)
+        {
+            var end = at < stream.ExpiresAt ? at : stream.ExpiresAt;
+            if (end <= stream.StartedAt)
+            {
+                return 0;
+            }
+
+            var accrued = stream.RatePerSecond * (decimal)(end - stream.StartedAt).TotalSeconds;
+            return Math.Min(stream.Cap, Math.Floor(accrued * GasFactor) / GasFactor);
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasPaymentStream> OpenAsync(GasPaymentStream stream)
+        {
+            if (stream == null)
+            {
+                throw new ArgumentNullException(nameof(stream));
+            }
+
+            if (stream.RatePerSecond <= 0)
+            {
+                throw new ValidationException("Rate per second must be greater than zero");
+            }
+
+            if (stream.Cap <= 0)
+            {
+                throw new ValidationException("Cap must be greater than zero");
+            }
+
+            if (stream.ExpiresAt <= DateTime.UtcNow)
+            {
+                throw new ValidationException("Expiry must be in the future");
+            }
+
+            if (stream.PayerGasBankAccountId == stream.PayeeGasBankAccountId)
+            {
+                throw new ValidationException("A payment stream needs two different GasBank accounts");
+            }
+
+            var payer = await _accountRepository.GetByIdAsync(stream.PayerGasBankAccountId)
+                ?? throw new ResourceNotFoundException("GasBankAccount", stream.PayerGasBankAccountId.ToString());
+            var payee = await _accountRepository.GetByIdAsync(stream.PayeeGasBankAccountId)
+                ?? throw new ResourceNotFoundException("GasBankAccount", stream.PayeeGasBankAccountId.ToString());
+
+            if (payer.AccountId != stream.PayerAccountId)
+            {
+                throw new ForbiddenAccessException("GasBankAccount", payer.Id.ToString(), stream.PayerAccountId.ToString());
+            }
+
+            stream.Id = Guid.NewGuid();
+            stream.PayeeAccountId = payee.AccountId;
+            stream.Status = GasPaymentStreamStatus.Open;
+            stream.StartedAt = DateTime.UtcNow;
+            stream.ClosedAt = null;
+            stream.ClosedBy = null;
+            stream.AccruedAmount = 0;
+            stream.SettlementTransactionHash = null;
+            stream.SettledAt = null;
+            stream.LastError = null;
+
+            // Reserve the cap first, so an open stream is always covered
+            GasBankTransaction reservation = null;
+            payer = await _accountRepository.UpdateBalanceAsync(payer.Id, account =>
+            {
+                if (account.Balance - account.AllocatedAmount < stream.Cap)
+                {
+                    throw new GasBankException("Insufficient unallocated balance");
+                }
+
+                account.AllocatedAmount += stream.Cap;
+
+                reservation = new GasBankTransaction
+                {
+                    Id = Guid.NewGuid(),
+                    GasBankAccountId = account.Id,
+                    Type = GasBankTransactionType.Allocation,
+                    Amount = stream.Cap,
+                    BalanceAfter = account.Balance,
+                    RelatedEntityId = stream.Id,
+                    Timestamp = DateTime.UtcNow,
+                    Description = $"Reserved for payment stream {stream.Id}"
+                };
+
+                return reservation;
+            });
+
+            try
+            {
+                await _streamRepository.CreateAsync(stream);
+            }
+            catch
+            {
+                await ReleaseReservationAsync(stream, stream.Cap);
+                throw;
+            }
+
+            _logger.LogInformation("Opened payment stream {StreamId} from {Payer} to {Payee} at {Rate} GAS/s, capped at {Cap}",
+                stream.Id, stream.PayerGasBankAccountId, stream.PayeeGasBankAccountId, stream.RatePerSecond, stream.Cap);
+            PublishBalanceChanged(payer, reservation);
+
+            return stream;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasPaymentStream> GetByIdAsync(Guid id)
+        {
+            var stream = await _streamRepository.GetByIdAsync(id);
+            return stream == null ? null : WithCurrentAccrual(stream);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasPaymentStream>> GetByAccountIdAsync(Guid accountId)
+        {
+            var streams = await _streamRepository.GetByAccountIdAsync(accountId);
+            return streams.Select(WithCurrentAccrual).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasPaymentStream> CloseAsync(Guid id, Guid closedBy)
+        {
+            var stream = await _streamRepository.GetByIdAsync(id)
+                ?? throw new ResourceNotFoundException("GasPaymentStream", id.ToString());
+
+            if (closedBy != stream.PayerAccountId && closedBy != stream.PayeeAccountId)
+            {
+                throw new ForbiddenAccessException("GasPaymentStream", id.ToString(), closedBy.ToString());
+            }
+
+            if (stream.Status == GasPaymentStreamStatus.Open)
+            {
+                stream = await StopAccruingAsync(stream, closedBy);
+            }
+
+            // Expired or already closed streams are settled now rather than on the next cycle
+            if (stream.Status == GasPaymentStreamStatus.Closed
+                && (_maintenanceService == null || !await _maintenanceService.IsActiveAsync()))
+            {
+                stream = await SettleAsync(stream);
+            }
+
+            return stream;
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<GasPaymentStream>> GetInDoubtAsync()
+        {
+            return _streamRepository.GetByStatusAsync(GasPaymentStreamStatus.InDoubt);
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasPaymentStream> ResolveInDoubtAsync(Guid id, string transactionHash, string resolvedBy)
+        {
+            var stream = await _streamRepository.GetByIdAsync(id)
+                ?? throw new ResourceNotFoundException("GasPaymentStream", id.ToString());
+
+            if (stream.Status != GasPaymentStreamStatus.InDoubt)
+            {
+                throw new GasBankException($"Payment stream is {stream.Status}, not in doubt");
+            }
+
+            if (string.IsNullOrWhiteSpace(transactionHash))
+            {
+                // Nothing was paid, so the next cycle settles it as usual
+                stream.Status = GasPaymentStreamStatus.Closed;
+                stream.SettlementTransactionHash = null;
+                stream.LastError = $"Resolved by {resolvedBy} as not sent";
+                if (!await _streamRepository.UpdateAsync(stream, GasPaymentStreamStatus.InDoubt))
+                {
+                    throw new GasBankException("Payment stream was resolved by someone else");
+                }
+
+                _logger.LogWarning("In-doubt payment stream {StreamId} resolved by {ResolvedBy} as not sent; it will be settled again", stream.Id, resolvedBy);
+                return stream;
+            }
+
+            stream.Status = GasPaymentStreamStatus.Settling;
+            stream.SettlementTransactionHash = transactionHash.Trim();
+            if (!await _streamRepository.UpdateAsync(stream, GasPaymentStreamStatus.InDoubt))
+            {
+                throw new GasBankException("Payment stream was resolved by someone else");
+            }
+
+            _logger.LogWarning("In-doubt payment stream {StreamId} resolved by {ResolvedBy} as sent in {TransactionHash}",
+                stream.Id, resolvedBy, stream.SettlementTransactionHash);
+
+            return await CompleteSettlementAsync(stream);
+        }
+
+        /// <inheritdoc/>
+        public string Name => Constants.ManagedServices.GasPaymentStreams;
+
+        /// <inheritdoc/>
+        public IReadOnlyCollection<string> DependsOn { get; } = new[] { Constants.ManagedServices.Leadership };
+
+        /// <inheritdoc/>
+        public Task StartAsync()
+        {
+            if (_settlementTimer != null)
+            {
+                return Task.CompletedTask;
+            }
+
+            _logger.LogInformation("Settling payment streams every {Interval} seconds", _configuration.PaymentStreamSettlementIntervalSeconds);
+
+            _settlementTimer = new Timer(
+                _crashReporter.Guard(_logger, "GasBank.PaymentStreams", RunCycleAsync),
+                null,
+                TimeSpan.FromSeconds(_configuration.PaymentStreamSettlementIntervalSeconds),
+                TimeSpan.FromSeconds(_configuration.PaymentStreamSettlementIntervalSeconds));
+
+            return Task.CompletedTask;
+        }
+
+        /// <inheritdoc/>
+        public async Task StopAsync()
+        {
+            _settlementTimer?.Change(Timeout.Infinite, Timeout.Infinite);
+            _settlementTimer?.Dispose();
+            _settlementTimer = null;
+
+            // Let a cycle already running finish
+            await _cycleSemaphore.WaitAsync();
+            _cycleSemaphore.Release();
+        }
+
+        /// <inheritdoc/>
+        public ManagedServiceHealth GetHealth()
+        {
+            return ManagedServiceHealth.Of(Name, _settlementTimer != null ? ManagedServiceStatus.Running : ManagedServiceStatus.Stopped);
+        }
+
+        /// <summary>
+        /// Closes expired streams and settles closed ones, including those whose settlement failed before
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task RunCycleAsync()
+        {
+            if (!await _cycleSemaphore.WaitAsync(0))
+            {
+                return;
+            }
+
+            try
+            {
+                if (_leadership?.IsLeader == false)
+                {
+                    return;
+                }
+
+                if (_maintenanceService != null && await _maintenanceService.IsActiveAsync())
+                {
+                    return;
+                }
+
+                var now = DateTime.UtcNow;
+                foreach (var stream in (await _streamRepository.GetByStatusAsync(GasPaymentStreamStatus.Open)).Where(s => s.ExpiresAt <= now))
+                {
+                    await StopAccruingAsync(stream, null);
+                }
+
+                foreach (var stream in await _streamRepository.GetByStatusAsync(GasPaymentStreamStatus.Closed))
+                {
+                    await SettleAsync(stream);
+                }
+            }
+            finally
+            {
+                _cycleSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public void Dispose()
+        {
+            _settlementTimer?.Dispose();
+            _cycleSemaphore.Dispose();
+        }
+
+        /// <summary>
+        /// Stops an open stream accruing
+        /// </summary>
+        /// <returns>The stream as stored after the attempt</returns>
+        private async Task<GasPaymentStream> StopAccruingAsync(GasPaymentStream stream, Guid? closedBy)
+        {
+            var now = DateTime.UtcNow;
+            stream.Status = GasPaymentStreamStatus.Closed;
+            stream.ClosedAt = now < stream.ExpiresAt ? now : stream.ExpiresAt;
+            stream.ClosedBy = closedBy;
+            stream.AccruedAmount = CalculateAccrued(stream, stream.ClosedAt.Value);
+
+            if (!await _streamRepository.UpdateAsync(stream, GasPaymentStreamStatus.Open))
+            {
+                // The other party or the expiry timer got there first
+                return await _streamRepository.GetByIdAsync(stream.Id);
+            }
+
+            _logger.LogInformation("Closed payment stream {StreamId} with {Amount} GAS accrued", stream.Id, stream.AccruedAmount);
+            return stream;
+        }
+
+        /// <summary>
+        /// Pays a closed stream's accrued GAS to the payee and releases the payer's reservation
+        /// </summary>
+        /// <returns>The stream as stored after the attempt</returns>
+        private async Task<GasPaymentStream> SettleAsync(GasPaymentStream stream)
+        {
+            stream.Status = GasPaymentStreamStatus.Settling;
+            if (!await _streamRepository.UpdateAsync(stream, GasPaymentStreamStatus.Closed))
+            {
+                return await _streamRepository.GetByIdAsync(stream.Id);
+            }
+
+            var amount = stream.AccruedAmount;
+            if (amount > 0)
+            {
+                try
+                {
+                    var payer = await _accountRepository.GetByIdAsync(stream.PayerGasBankAccountId)
+                        ?? throw new GasBankException("Payer GasBank account not found");
+                    var payee = await _accountRepository.GetByIdAsync(stream.PayeeGasBankAccountId)
+                        ?? throw new GasBankException("Payee GasBank account not found");
+
+                    // Settlements are not queued, so the hot wallet must cover them now
+                    if (_configuration.ColdStorageEnabled && payer.HotBalance < amount)
+                    {
+                        throw new GasBankException("Insufficient hot wallet balance, a replenishment from cold storage is required");
+                    }
+
+                    stream.SettlementTransactionHash = await _walletService.TransferGasAsync(
+                        payer.WalletId,
+                        Guid.NewGuid().ToString(), // Password is not used for service wallets
+                        payee.NeoAddress,
+                        amount);
+                }
+                catch (Exception ex)
+                {
+                    _logger.LogWarning(ex, "Settlement of payment stream {StreamId} failed and will be retried", stream.Id);
+
+                    stream.Status = GasPaymentStreamStatus.Closed;
+                    stream.LastError = ex.Message;
+                    await _streamRepository.UpdateAsync(stream, GasPaymentStreamStatus.Settling);
+
+                    return stream;
+                }
+
+                // Kept with the stream, so an operator can find the transfer if the service stops before it is recorded
+                await _streamRepository.UpdateAsync(stream, GasPaymentStreamStatus.Settling);
+            }
+
+            return await CompleteSettlementAsync(stream);
+        }
+
+        /// <summary>
+        /// Records a sent settlement in both ledgers, releases the rest of the reservation and marks the stream settled
+        /// </summary>
+        /// <returns>The settled stream</returns>
+        private async Task<GasPaymentStream> CompleteSettlementAsync(GasPaymentStream stream)
+        {
+            var amount = stream.AccruedAmount;
+            if (amount > 0)
+            {
+                await RecordPaymentAsync(stream, amount);
+            }
+
+            await ReleaseReservationAsync(stream, stream.Cap - amount);
+
+            stream.Status = GasPaymentStreamStatus.Settled;
+            stream.SettledAt = DateTime.UtcNow;
+            stream.LastError = null;
+            await _streamRepository.UpdateAsync(stream, GasPaymentStreamStatus.Settling);
+
+            _logger.LogInformation("Settled payment stream {StreamId}: {Amount} GAS paid in {TransactionHash}",
+                stream.Id, amount, stream.SettlementTransactionHash);
+
+            return stream;
+        }
+
+        /// <summary>
+        /// Records a settlement transfer in both ledgers
+        /// </summary>
+        private async Task RecordPaymentAsync(GasPaymentStream stream, decimal amount)
+        {
+            // The paid GAS comes out of the reservation, so the payer's unallocated balance does not change
+            GasBankTransaction payment = null;
+            var payer = await _accountRepository.UpdateBalanceAsync(stream.PayerGasBankAccountId, account =>
+            {
+                account.Balance -= amount;
+                account.AllocatedAmount = Math.Max(0, account.AllocatedAmount - amount);
+                account.HotBalance = Math.Max(0, account.HotBalance - amount);
+
+                payment = new GasBankTransaction
+                {
+                    Id = Guid.NewGuid(),
+                    GasBankAccountId = account.Id,
+                    Type = GasBankTransactionType.StreamPayment,
+                    Amount = amount,
+                    BalanceAfter = account.Balance,
+                    TransactionHash = stream.SettlementTransactionHash,
+                    RelatedEntityId = stream.Id,
+                    Timestamp = DateTime.UtcNow,
+                    Description = $"Payment stream {stream.Id} settlement"
+                };
+
+                return payment;
+            });
+
+            GasBankTransaction receipt = null;
+            var payee = await _accountRepository.UpdateBalanceAsync(stream.PayeeGasBankAccountId, account =>
+            {
+                account.Balance += amount;
+                account.HotBalance += amount;
+
+                receipt = new GasBankTransaction
+                {
+                    Id = Guid.NewGuid(),
+                    GasBankAccountId = account.Id,
+                    Type = GasBankTransactionType.StreamReceipt,
+                    Amount = amount,
+                    BalanceAfter = account.Balance,
+                    TransactionHash = stream.SettlementTransactionHash,
+                    RelatedEntityId = stream.Id,
+                    Timestamp = DateTime.UtcNow,
+                    Description = $"Payment stream {stream.Id} settlement"
+                };
+
+                return receipt;
+            });
+
+            PublishBalanceChanged(payer, payment);
+            PublishBalanceChanged(payee, receipt);
+        }
+
+        /// <summary>
+        /// Releases the unused part of a stream's reservation
+        /// </summary>
+        private async Task ReleaseReservationAsync(GasPaymentStream stream, decimal amount)
+        {
+            if (amount <= 0)
+            {
+                return;
+            }
+
+            GasBankTransaction release = null;
+            var payer = await _accountRepository.UpdateBalanceAsync(stream.PayerGasBankAccountId, account =>
+            {
+                account.AllocatedAmount = Math.Max(0, account.AllocatedAmount - amount);
+
+                release = new GasBankTransaction
+                {
+                    Id = Guid.NewGuid(),
+                    GasBankAccountId = account.Id,
+                    Type = GasBankTransactionType.Deallocation,
+                    Amount = amount,
+                    BalanceAfter = account.Balance,
+                    RelatedEntityId = stream.Id,
+                    Timestamp = DateTime.UtcNow,
+                    Description = $"Released from payment stream {stream.Id}"
+                };
+
+                return release;
+            });
+
+            PublishBalanceChanged(payer, release);
+        }
+
+        private static GasPaymentStream WithCurrentAccrual(GasPaymentStream stream)
+        {
+            if (stream.Status == GasPaymentStreamStatus.Open)
+            {
+                stream.AccruedAmount = CalculateAccrued(stream, DateTime.UtcNow);
+            }
+
+            return stream;
+        }
+
+        private void PublishBalanceChanged(GasBankAccount gasBankAccount, GasBankTransaction transaction)
+        {
+            if (gasBankAccount == null || transaction == null)
+            {
+                return;
+            }
+
+            _eventPublisher?.Publish(new ServerEvent
+            {
+                Topic = ServerEventTopics.GasBank,
+                Type = "balance",
+                AccountId = gasBankAccount.AccountId,
+                Data = new
+                {
+                    GasBankAccountId = gasBankAccount.Id,
+                    gasBankAccount.Balance,
+                    gasBankAccount.AllocatedAmount,
+                    TransactionId = transaction.Id,
+                    TransactionType = transaction.Type.ToString(),
+                    transaction.Amount
+                }
+            });
+        }
+    }
+}
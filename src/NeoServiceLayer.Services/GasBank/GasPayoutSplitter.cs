@@ -0,0 +1,111 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.GasBank
+{
+    /// <summary>
+    /// Splits a GAS amount among recipients by weight
+    /// </summary>
+    public static class GasPayoutSplitter
+    {
+        /// <summary>
+        /// Number of decimal places supported by GAS
+        /// </summary>
+        public const int GasDecimals = 8;
+
+        /// <summary>
+        /// Maximum number of recipients in a single payout
+        /// </summary>
+        public const int MaxRecipients = 64;
+
+        /// <summary>
+        /// Splits the total amount among the recipients in proportion to their weights
+        /// </summary>
+        /// <param name="totalAmount">Total amount of GAS to distribute</param>
+        /// <param name="recipients">Recipients and their weights</param>
+        /// <returns>Amounts keyed by recipient address, in recipient order</returns>
+        /// <remarks>
+        /// Shares are rounded down to GAS precision and the remaining dust is given to the
+        /// recipient with the largest weight, so the shares always add up to the total.
+        /// </remarks>
+        public static List<KeyValuePair<GasPayoutRecipient, decimal>> Split(decimal totalAmount, IList<GasPayoutRecipient> recipients)
+        {
+            Validate(totalAmount, recipients);
+
+            var totalWeight = recipients.Sum(r => r.Weight);
+            var shares = recipients
+                .Select(r => new KeyValuePair<GasPayoutRecipient, decimal>(r, RoundDown(totalAmount * r.Weight / totalWeight)))
+                .ToList();
+
+            var remainder = totalAmount - shares.Sum(s => s.Value);
+            if (remainder > 0)
+            {
+                var largest = shares.IndexOf(shares.OrderByDescending(s => s.Key.Weight).First());
+                shares[largest] = new KeyValuePair<GasPayoutRecipient, decimal>(shares[largest].Key, shares[largest].Value + remainder);
+            }
+
+            if (shares.Any(s => s.Value <= 0))
+            {
+                throw new GasBankException("Total amount is too small to pay every recipient");
+            }
+
+            return shares;
+        }
+
+        /// <summary>
+        /// Validates a payout configuration
+        /// </summary>
+        /// <param name="totalAmount">Total amount of GAS to distribute</param>
+        /// <param name="recipients">Recipients and their weights</param>
+        public static void Validate(decimal totalAmount, IList<GasPayoutRecipient> recipients)
+        {
+            if (totalAmount <= 0)
+            {
+                throw new GasBankException("Total amount must be greater than zero");
+            }
+
+            if (RoundDown(totalAmount) != totalAmount)
+            {
+                throw new GasBankException($"Total amount cannot have more than {GasDecimals} decimal places");
+            }
+
+            if (recipients == null || recipients.Count == 0)
+            {
+                throw new GasBankException("At least one recipient is required");
+            }
+
+            if (recipients.Count > MaxRecipients)
+            {
+                throw new GasBankException($"A payout may have at most {MaxRecipients} recipients");
+            }
+
+            var addresses = new HashSet<string>(StringComparer.Ordinal);
+            foreach (var recipient in recipients)
+            {
+                if (recipient == null || string.IsNullOrEmpty(recipient.Address) || !recipient.Address.IsValidNeoAddress())
+                {
+                    throw new GasBankException($"Invalid recipient address: {recipient?.Address}");
+                }
+
+                if (recipient.Weight <= 0)
+                {
+                    throw new GasBankException($"Weight for recipient {recipient.Address} must be greater than zero");
+                }
+
+                if (!addresses.Add(recipient.Address))
+                {
+                    throw new GasBankException($"Recipient {recipient.Address} appears more than once");
+                }
+            }
+        }
+
+        private static decimal RoundDown(decimal amount)
+        {
+            return Math.Round(amount, GasDecimals, MidpointRounding.ToZero);
+        }
+    }
+}
@@ -0,0 +1,397 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using NeoServiceLayer.Services.Diagnostics;
+using NeoServiceLayer.Services.GasBank.Repositories;
+
+namespace NeoServiceLayer.Services.GasBank
+{
+    /// <summary>
+    /// Implementation of the GasBank recurring withdrawal service
+    /// </summary>
+    /// <remarks>
+    /// Each cycle runs the occurrences that are due through the ordinary withdrawal path, so the hot wallet queue,
+    /// retries and on-chain confirmation apply to them as to any other withdrawal. Every occurrence gets one record,
+    /// updated on each attempt. An occurrence that fails stays due and is retried on the next cycle until the
+    /// occurrence after it is due, when it is recorded as missed and that one runs instead. Occurrences missed while
+    /// the service was down or the withdrawal paused are not run.
+    /// </remarks>
+    public class GasRecurringWithdrawalService : IGasRecurringWithdrawalService, IManagedService, IDisposable
+    {
+        // GAS has eight decimals
+        private const decimal GasFactor = 100_000_000m;
+        private const int MaxStatusUpdateAttempts = 3;
+
+        private readonly ILogger<GasRecurringWithdrawalService> _logger;
+        private readonly IGasRecurringWithdrawalRepository _repository;
+        private readonly IGasBankAccountRepository _accountRepository;
+        private readonly IGasBankService _gasBankService;
+        private readonly GasBankConfiguration _configuration;
+        private readonly ILeadershipService _leadership;
+        private readonly IMaintenanceService _maintenanceService;
+        private readonly ICrashReporter _crashReporter;
+        private readonly SemaphoreSlim _cycleSemaphore = new SemaphoreSlim(1, 1);
+        private Timer _cycleTimer;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasRecurringWithdrawalService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="repository">Recurring withdrawal repository</param>
+        /// <param name="accountRepository">GasBank account repository</param>
+        /// <param name="gasBankService">GasBank service that makes the withdrawals</param>
+        /// <param name="configuration">GasBank configuration</param>
+        /// <param name="leadership">Leadership service; only the leader runs occurrences</param>
+        /// <param name="maintenanceService">Maintenance service; occurrences wait while maintenance is active</param>
+        /// <param name="crashReporter">Crash reporter for background work</param>
+        public GasRecurringWithdrawalService(
+            ILogger<GasRecurringWithdrawalService> logger,
+            IGasRecurringWithdrawalRepository repository,
+            IGasBankAccountRepository accountRepository,
+            IGasBankService gasBankService,
+            IOptions<GasBankConfiguration> configuration = null,
+            ILeadershipService leadership = null,
+            IMaintenanceService maintenanceService = null,
+            ICrashReporter crashReporter = null)
+        {
+            _logger = logger;
+            _repository = repository;
+            _accountRepository = accountRepository;
+            _gasBankService = gasBankService;
+            _configuration = configuration?.Value ?? new GasBankConfiguration();
+            _leadership = leadership;
+            _maintenanceService = maintenanceService;
+            _crashReporter = crashReporter;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasRecurringWithdrawal> CreateAsync(GasRecurringWithdrawal withdrawal)
+        {
+            if (withdrawal == null)
+            {
+                throw new ArgumentNullException(nameof(withdrawal));
+            }
+
+            if (!CronSchedule.TryParse(withdrawal.Schedule, out var schedule, out var error))
+            {
+                throw new ValidationException(error);
+            }
+
+            if (string.IsNullOrEmpty(withdrawal.ToAddress) || !withdrawal.ToAddress.IsValidNeoAddress())
+            {
+                throw new ValidationException("Invalid Neo address format");
+            }
+
+            if (withdrawal.Amount.HasValue && withdrawal.Amount <= 0)
+            {
+                throw new ValidationException("Amount must be greater than zero");
+            }
+
+            if (withdrawal.Floor < 0 || withdrawal.MinimumAmount < 0)
+            {
+                throw new ValidationException("Floor and minimum amount cannot be negative");
+            }
+
+            var nextRunAt = schedule.GetNextOccurrence(DateTime.UtcNow)
+                ?? throw new ValidationException("The schedule never fires");
+
+            var gasBankAccount = await _accountRepository.GetByIdAsync(withdrawal.GasBankAccountId)
+                ?? throw new ResourceNotFoundException("GasBankAccount", withdrawal.GasBankAccountId.ToString());
+            if (gasBankAccount.AccountId != withdrawal.AccountId)
+            {
+                throw new ForbiddenAccessException("GasBankAccount", gasBankAccount.Id.ToString(), withdrawal.AccountId.ToString());
+            }
+
+            var existing = await _repository.GetByAccountIdAsync(withdrawal.AccountId);
+            if (existing.Count(w => w.Status != GasRecurringWithdrawalStatus.Cancelled) >= _configuration.MaxRecurringWithdrawalsPerAccount)
+            {
+                throw new ValidationException($"An account can have at most {_configuration.MaxRecurringWithdrawalsPerAccount} recurring withdrawals");
+            }
+
+            withdrawal.Id = Guid.NewGuid();
+            withdrawal.Schedule = schedule.Expression;
+            withdrawal.Status = GasRecurringWithdrawalStatus.Active;
+            withdrawal.NextRunAt = nextRunAt;
+            withdrawal.LastRunAt = null;
+            withdrawal.LastError = null;
+
+            await _repository.CreateAsync(withdrawal);
+
+            _logger.LogInformation("Created recurring withdrawal {Id} from GasBank account {GasBankAccountId} on \"{Schedule}\", first run at {NextRunAt}",
+                withdrawal.Id, withdrawal.GasBankAccountId, withdrawal.Schedule, withdrawal.NextRunAt);
+
+            return withdrawal;
+        }
+
+        /// <inheritdoc/>
+        public Task<GasRecurringWithdrawal> GetByIdAsync(Guid id)
+        {
+            return _repository.GetByIdAsync(id);
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<GasRecurringWithdrawal>> GetByAccountIdAsync(Guid accountId)
+        {
+            return _repository.GetByAccountIdAsync(accountId);
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<GasRecurringWithdrawalOccurrence>> GetOccurrencesAsync(Guid id, int limit = 50)
+        {
+            return _repository.GetOccurrencesAsync(id, Math.Clamp(limit, 1, 500));
+        }
+
+        /// <inheritdoc/>
+        public Task<GasRecurringWithdrawal> PauseAsync(Guid id, Guid accountId)
+        {
+            return ChangeStatusAsync(id, accountId, GasRecurringWithdrawalStatus.Paused, _ => { });
+        }
+
+        /// <inheritdoc/>
+        public Task<GasRecurringWithdrawal> ResumeAsync(Guid id, Guid accountId)
+        {
+            return ChangeStatusAsync(id, accountId, GasRecurringWithdrawalStatus.Active, withdrawal =>
+            {
+                withdrawal.NextRunAt = CronSchedule.Parse(withdrawal.Schedule).GetNextOccurrence(DateTime.UtcNow);
+            });
+        }
+
+        /// <inheritdoc/>
+        public Task<GasRecurringWithdrawal> CancelAsync(Guid id, Guid accountId)
+        {
+            return ChangeStatusAsync(id, accountId, GasRecurringWithdrawalStatus.Cancelled, withdrawal =>
+            {
+                withdrawal.NextRunAt = null;
+            });
+        }
+
+        /// <inheritdoc/>
+        public string Name => Constants.ManagedServices.GasRecurringWithdrawals;
+
+        /// <inheritdoc/>
+        public IReadOnlyCollection<string> DependsOn { get; } = new[] { Constants.ManagedServices.Leadership, Constants.ManagedServices.GasBank };
+
+        /// <inheritdoc/>
+        public Task StartAsync()
+        {
+            if (_cycleTimer != null)
+            {
+                return Task.CompletedTask;
+            }
+
+            _logger.LogInformation("Running recurring withdrawals every {Interval} seconds", _configuration.RecurringWithdrawalIntervalSeconds);
+
+            _cycleTimer = new Timer(
+                _crashReporter.Guard(_logger, "GasBank.RecurringWithdrawals", RunCycleAsync),
+                null,
+                TimeSpan.FromSeconds(_configuration.RecurringWithdrawalIntervalSeconds),
+                TimeSpan.FromSeconds(_configuration.RecurringWithdrawalIntervalSeconds));
+
+            return Task.CompletedTask;
+        }
+
+        /// <inheritdoc/>
+        public async Task StopAsync()
+        {
+            _cycleTimer?.Change(Timeout.Infinite, Timeout.Infinite);
+            _cycleTimer?.Dispose();
+            _cycleTimer = null;
+
+            // Let a cycle already running finish
+            await _cycleSemaphore.WaitAsync();
+            _cycleSemaphore.Release();
+        }
+
+        /// <inheritdoc/>
+        public ManagedServiceHealth GetHealth()
+        {
+            return ManagedServiceHealth.Of(Name, _cycleTimer != null ? ManagedServiceStatus.Running : ManagedServiceStatus.Stopped);
+        }
+
+        /// <summary>
+        /// Runs the occurrences that are due, including failed ones being retried
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task RunCycleAsync()
+        {
+            if (!await _cycleSemaphore.WaitAsync(0))
+            {
+                return;
+            }
+
+            try
+            {
+                if (_leadership?.IsLeader == false)
+                {
+                    return;
+                }
+
+                if (_maintenanceService != null && await _maintenanceService.IsActiveAsync())
+                {
+                    return;
+                }
+
+                foreach (var withdrawal in await _repository.GetDueAsync(DateTime.UtcNow))
+                {
+                    try
+                    {
+                        await RunOccurrenceAsync(withdrawal);
+                    }
+                    catch (Exception ex)
+                    {
+                        _logger.LogError(ex, "Error running recurring withdrawal {Id}", withdrawal.Id);
+                    }
+                }
+            }
+            finally
+            {
+                _cycleSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public void Dispose()
+        {
+            _cycleTimer?.Dispose();
+            _cycleSemaphore.Dispose();
+        }
+
+        /// <summary>
+        /// Runs the due occurrence of a recurring withdrawal and schedules the next one
+        /// </summary>
+        private async Task RunOccurrenceAsync(GasRecurringWithdrawal withdrawal)
+        {
+            var now = DateTime.UtcNow;
+            var schedule = CronSchedule.Parse(withdrawal.Schedule);
+            var scheduledFor = withdrawal.NextRunAt.Value;
+
+            var occurrence = await _repository.GetOccurrenceAsync(withdrawal.Id, scheduledFor)
+                ?? new GasRecurringWithdrawalOccurrence
+                {
+                    Id = Guid.NewGuid(),
+                    RecurringWithdrawalId = withdrawal.Id,
+                    ScheduledFor = scheduledFor
+                };
+            occurrence.Attempts++;
+
+            try
+            {
+                var gasBankAccount = await _accountRepository.GetByIdAsync(withdrawal.GasBankAccountId)
+                    ?? throw new GasBankException("GasBank account not found");
+                if (gasBankAccount.AccountId != withdrawal.AccountId)
+                {
+                    throw new GasBankException("GasBank account no longer belongs to the account");
+                }
+
+                var aboveFloor = gasBankAccount.Balance - gasBankAccount.AllocatedAmount - withdrawal.Floor;
+                var amount = withdrawal.Amount ?? Math.Floor(aboveFloor * GasFactor) / GasFactor;
+
+                if (withdrawal.Amount.HasValue && aboveFloor < amount)
+                {
+                    throw new GasBankException("Withdrawing the amount would leave less than the floor");
+                }
+
+                if (amount <= 0 || amount < withdrawal.MinimumAmount)
+                {
+                    occurrence.Status = GasRecurringWithdrawalOccurrenceStatus.Skipped;
+                    occurrence.Amount = 0;
+                    occurrence.Error = $"{Math.Max(0, amount)} GAS above the floor is below the minimum amount";
+                }
+                else
+                {
+                    var made = await _gasBankService.WithdrawAsync(gasBankAccount.Id, amount, withdrawal.ToAddress);
+
+                    occurrence.Status = GasRecurringWithdrawalOccurrenceStatus.Completed;
+                    occurrence.Amount = amount;
+                    occurrence.WithdrawalId = made.Id;
+                    occurrence.Error = null;
+
+                    _logger.LogInformation("Recurring withdrawal {Id} withdrew {Amount} GAS in withdrawal {WithdrawalId}",
+                        withdrawal.Id, amount, made.Id);
+                }
+
+                withdrawal.LastError = null;
+                withdrawal.NextRunAt = schedule.GetNextOccurrence(now);
+            }
+            catch (Exception ex)
+            {
+                occurrence.Error = ex.Message;
+                withdrawal.LastError = ex.Message;
+
+                var following = schedule.GetNextOccurrence(scheduledFor);
+                if (following != null && following > now)
+                {
+                    _logger.LogWarning(ex, "Recurring withdrawal {Id} failed and will be retried on the next cycle", withdrawal.Id);
+                    occurrence.Status = GasRecurringWithdrawalOccurrenceStatus.Failed;
+                }
+                else
+                {
+                    _logger.LogWarning(ex, "Recurring withdrawal {Id} missed the occurrence due at {ScheduledFor}", withdrawal.Id, scheduledFor);
+                    occurrence.Status = GasRecurringWithdrawalOccurrenceStatus.Missed;
+                    withdrawal.NextRunAt = following;
+                }
+            }
+
+            withdrawal.LastRunAt = now;
+            await _repository.SaveOccurrenceAsync(occurrence);
+            await _repository.UpdateRunAsync(withdrawal);
+        }
+
+        /// <summary>
+        /// Moves a recurring withdrawal to a status, retrying if another request changes it first
+        /// </summary>
+        /// <remarks>Moving to the current status does nothing, and a cancelled withdrawal cannot be moved.</remarks>
+        private async Task<GasRecurringWithdrawal> ChangeStatusAsync(
+            Guid id,
+            Guid accountId,
+            GasRecurringWithdrawalStatus status,
+            Action<GasRecurringWithdrawal> apply)
+        {
+            for (var attempt = 1; ; attempt++)
+            {
+                var withdrawal = await _repository.GetByIdAsync(id)
+                    ?? throw new ResourceNotFoundException("GasRecurringWithdrawal", id.ToString());
+
+                if (withdrawal.AccountId != accountId)
+                {
+                    throw new ForbiddenAccessException("GasRecurringWithdrawal", id.ToString(), accountId.ToString());
+                }
+
+                if (withdrawal.Status == status)
+                {
+                    return withdrawal;
+                }
+
+                if (withdrawal.Status == GasRecurringWithdrawalStatus.Cancelled)
+                {
+                    throw new ValidationException("The recurring withdrawal is cancelled");
+                }
+
+                var expectedStatus = withdrawal.Status;
+                apply(withdrawal);
+                withdrawal.Status = status;
+
+                if (await _repository.UpdateAsync(withdrawal, expectedStatus))
+                {
+                    _logger.LogInformation("Recurring withdrawal {Id} is now {Status}", id, status);
+                    return withdrawal;
+                }
+
+                if (attempt == MaxStatusUpdateAttempts)
+                {
+                    throw new GasBankException("The recurring withdrawal kept changing, try again");
+                }
+            }
+        }
+    }
+}
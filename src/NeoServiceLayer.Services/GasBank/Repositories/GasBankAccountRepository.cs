@@ -2,6 +2,7 @@ using System;
 using System.Collections.Generic;
 using System.Linq;
 using System.Linq.Expressions;
+using System.Threading;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
 using NeoServiceLayer.Core.Interfaces;
@@ -18,6 +19,9 @@ namespace NeoServiceLayer.Services.GasBank.Repositories
     {
         private readonly ILogger<GasBankAccountRepository> _logger;
         private readonly IGenericRepository<GasBankAccount, Guid> _repository;
+        private readonly IGasBankTransactionRepository _transactionRepository;
+        private readonly IGasBankAllocationRepository _allocationRepository;
+        private readonly SemaphoreSlim _balanceLock = new SemaphoreSlim(1, 1);
         private const string CollectionName = "gasbank_accounts";
 
         /// <summary>
@@ -25,10 +29,18 @@ namespace NeoServiceLayer.Services.GasBank.Repositories
         /// </summary>
         /// <param name="logger">Logger</param>
         /// <param name="storageProvider">Storage provider</param>
-        public GasBankAccountRepository(ILogger<GasBankAccountRepository> logger, IStorageProvider storageProvider)
+        /// <param name="transactionRepository">GasBank transaction repository</param>
+        /// <param name="allocationRepository">GasBank allocation repository</param>
+        public GasBankAccountRepository(
+            ILogger<GasBankAccountRepository> logger,
+            IStorageProvider storageProvider,
+            IGasBankTransactionRepository transactionRepository,
+            IGasBankAllocationRepository allocationRepository)
         {
             _logger = logger;
             _repository = new GenericRepository<GasBankAccount, Guid>(logger, storageProvider, CollectionName);
+            _transactionRepository = transactionRepository;
+            _allocationRepository = allocationRepository;
         }
 
         /// <inheritdoc/>
@@ -138,6 +150,31 @@ namespace NeoServiceLayer.Services.GasBank.Repositories
             }
         }
 
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankAccount>> GetAllAsync()
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>();
+
+            LoggingUtility.LogOperationStart(_logger, "GetAllGasBankAccounts", requestId, additionalData);
+
+            try
+            {
+                var accounts = await _repository.GetAllAsync();
+
+                additionalData["Count"] = accounts.Count();
+
+                LoggingUtility.LogOperationSuccess(_logger, "GetAllGasBankAccounts", requestId, 0, additionalData);
+
+                return accounts;
+            }
+            catch (Exception ex)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "GetAllGasBankAccounts", requestId, ex, 0, additionalData);
+                throw;
+            }
+        }
+
         /// <inheritdoc/>
         public async Task<GasBankAccount> UpdateAsync(GasBankAccount account)
         {
@@ -176,6 +213,83 @@ namespace NeoServiceLayer.Services.GasBank.Repositories
             }
         }
 
+        /// <inheritdoc/>
+        public Task<GasBankAccount> UpdateBalanceAsync(Guid id, Func<GasBankAccount, GasBankTransaction> apply)
+        {
+            ValidationUtility.ValidateNotNull(apply, nameof(apply));
+
+            return UpdateBalanceWithEntriesAsync(id, account =>
+            {
+                var transaction = apply(account);
+                return transaction != null ? new[] { transaction } : Array.Empty<GasBankTransaction>();
+            });
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankAccount> UpdateBalanceWithEntriesAsync(Guid id, Func<GasBankAccount, IEnumerable<GasBankTransaction>> apply)
+        {
+            ValidationUtility.ValidateGuid(id, "GasBank account ID");
+            ValidationUtility.ValidateNotNull(apply, nameof(apply));
+
+            await _balanceLock.WaitAsync();
+            try
+            {
+                var account = await GetByIdAsync(id);
+                if (account == null)
+                {
+                    return null;
+                }
+
+                var transactions = apply(account)?.ToList() ?? new List<GasBankTransaction>();
+
+                await UpdateAsync(account);
+                foreach (var transaction in transactions)
+                {
+                    await _transactionRepository.CreateAsync(transaction);
+                }
+
+                return account;
+            }
+            finally
+            {
+                _balanceLock.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankAccount> UpdateBalanceAndAllocationAsync(Guid id, Guid allocationId, Func<GasBankAccount, GasBankAllocation, GasBankTransaction> apply)
+        {
+            ValidationUtility.ValidateGuid(id, "GasBank account ID");
+            ValidationUtility.ValidateGuid(allocationId, "GasBank allocation ID");
+            ValidationUtility.ValidateNotNull(apply, nameof(apply));
+
+            await _balanceLock.WaitAsync();
+            try
+            {
+                var account = await GetByIdAsync(id);
+                var allocation = await _allocationRepository.GetByIdAsync(allocationId);
+                if (account == null || allocation == null || allocation.GasBankAccountId != id)
+                {
+                    return null;
+                }
+
+                var transaction = apply(account, allocation);
+
+                await _allocationRepository.UpdateAsync(allocation);
+                await UpdateAsync(account);
+                if (transaction != null)
+                {
+                    await _transactionRepository.CreateAsync(transaction);
+                }
+
+                return account;
+            }
+            finally
+            {
+                _balanceLock.Release();
+            }
+        }
+
         /// <inheritdoc/>
         public async Task<bool> DeleteAsync(Guid id)
         {
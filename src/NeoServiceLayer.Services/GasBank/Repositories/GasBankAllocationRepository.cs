@@ -196,7 +196,7 @@ namespace NeoServiceLayer.Services.GasBank.Repositories
                 ValidationUtility.ValidateGuid(allocation.Id, "GasBank allocation ID");
                 ValidationUtility.ValidateGuid(allocation.GasBankAccountId, "GasBank account ID");
                 ValidationUtility.ValidateGuid(allocation.FunctionId, "Function ID");
-                ValidationUtility.ValidateGreaterThanZero(allocation.Amount, "Amount");
+                ValidationUtility.ValidateGreaterThanOrEqualToZero(allocation.Amount, "Amount");
 
                 // Update timestamp
                 allocation.UpdatedAt = DateTime.UtcNow;
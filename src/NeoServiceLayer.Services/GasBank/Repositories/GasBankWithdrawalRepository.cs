@@ -0,0 +1,188 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories
+{
+    /// <summary>
+    /// Implementation of the GasBank withdrawal repository
+    /// </summary>
+    public class GasBankWithdrawalRepository : IGasBankWithdrawalRepository
+    {
+        private readonly ILogger<GasBankWithdrawalRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private readonly SemaphoreSlim _statusLock = new SemaphoreSlim(1, 1);
+        private const string CollectionName = "gasbank_withdrawals";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasBankWithdrawalRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public GasBankWithdrawalRepository(ILogger<GasBankWithdrawalRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankWithdrawal> CreateAsync(GasBankWithdrawal withdrawal)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["Id"] = withdrawal.Id,
+                ["GasBankAccountId"] = withdrawal.GasBankAccountId,
+                ["Status"] = withdrawal.Status.ToString(),
+                ["Amount"] = withdrawal.Amount
+            };
+
+            LoggingUtility.LogOperationStart(_logger, "CreateGasBankWithdrawal", requestId, additionalData);
+
+            try
+            {
+                // Validate input
+                ValidationUtility.ValidateNotNull(withdrawal, nameof(withdrawal));
+                ValidationUtility.ValidateGuid(withdrawal.Id, "GasBank withdrawal ID");
+                ValidationUtility.ValidateGuid(withdrawal.GasBankAccountId, "GasBank account ID");
+
+                if (withdrawal.RequestedAt == default)
+                {
+                    withdrawal.RequestedAt = DateTime.UtcNow;
+                }
+
+                await _storageProvider.CreateAsync(CollectionName, withdrawal);
+
+                LoggingUtility.LogOperationSuccess(_logger, "CreateGasBankWithdrawal", requestId, 0, additionalData);
+
+                return withdrawal;
+            }
+            catch (Exception ex)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "CreateGasBankWithdrawal", requestId, ex, 0, additionalData);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankWithdrawal> GetByIdAsync(Guid id)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["Id"] = id
+            };
+
+            LoggingUtility.LogOperationStart(_logger, "GetGasBankWithdrawalById", requestId, additionalData);
+
+            try
+            {
+                // Validate input
+                ValidationUtility.ValidateGuid(id, "GasBank withdrawal ID");
+
+                var withdrawal = await _storageProvider.GetByIdAsync<GasBankWithdrawal, Guid>(CollectionName, id);
+
+                LoggingUtility.LogOperationSuccess(_logger, "GetGasBankWithdrawalById", requestId, 0, additionalData);
+
+                return withdrawal;
+            }
+            catch (Exception ex)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "GetGasBankWithdrawalById", requestId, ex, 0, additionalData);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankWithdrawal>> GetByStatusAsync(GasBankWithdrawalStatus status)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["Status"] = status.ToString()
+            };
+
+            LoggingUtility.LogOperationStart(_logger, "GetGasBankWithdrawalsByStatus", requestId, additionalData);
+
+            try
+            {
+                var withdrawals = await _storageProvider.GetByFilterAsync<GasBankWithdrawal>(
+                    CollectionName,
+                    withdrawal => withdrawal.Status == status);
+
+                additionalData["Count"] = withdrawals.Count();
+
+                LoggingUtility.LogOperationSuccess(_logger, "GetGasBankWithdrawalsByStatus", requestId, 0, additionalData);
+
+                return withdrawals.OrderBy(w => w.RequestedAt);
+            }
+            catch (Exception ex)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "GetGasBankWithdrawalsByStatus", requestId, ex, 0, additionalData);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankWithdrawal> UpdateAsync(GasBankWithdrawal withdrawal)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["Id"] = withdrawal.Id,
+                ["Status"] = withdrawal.Status.ToString()
+            };
+
+            LoggingUtility.LogOperationStart(_logger, "UpdateGasBankWithdrawal", requestId, additionalData);
+
+            try
+            {
+                // Validate input
+                ValidationUtility.ValidateNotNull(withdrawal, nameof(withdrawal));
+                ValidationUtility.ValidateGuid(withdrawal.Id, "GasBank withdrawal ID");
+
+                await _storageProvider.UpdateAsync<GasBankWithdrawal, Guid>(CollectionName, withdrawal.Id, withdrawal);
+
+                LoggingUtility.LogOperationSuccess(_logger, "UpdateGasBankWithdrawal", requestId, 0, additionalData);
+
+                return withdrawal;
+            }
+            catch (Exception ex)
+            {
+                LoggingUtility.LogOperationFailure(_logger, "UpdateGasBankWithdrawal", requestId, ex, 0, additionalData);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> UpdateAsync(GasBankWithdrawal withdrawal, GasBankWithdrawalStatus expectedStatus)
+        {
+            ValidationUtility.ValidateNotNull(withdrawal, nameof(withdrawal));
+            ValidationUtility.ValidateGuid(withdrawal.Id, "GasBank withdrawal ID");
+
+            await _statusLock.WaitAsync();
+            try
+            {
+                var stored = await GetByIdAsync(withdrawal.Id);
+                if (stored == null || stored.Status != expectedStatus)
+                {
+                    return false;
+                }
+
+                await _storageProvider.UpdateAsync<GasBankWithdrawal, Guid>(CollectionName, withdrawal.Id, withdrawal);
+
+                return true;
+            }
+            finally
+            {
+                _statusLock.Release();
+            }
+        }
+    }
+}
@@ -0,0 +1,98 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories
+{
+    /// <summary>
+    /// Implementation of the GasBank fee policy repository
+    /// </summary>
+    public class GasFeePolicyRepository : IGasFeePolicyRepository
+    {
+        private readonly ILogger<GasFeePolicyRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private const string CollectionName = "gasbank_fee_policies";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasFeePolicyRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public GasFeePolicyRepository(ILogger<GasFeePolicyRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicy> CreateAsync(GasFeePolicy policy)
+        {
+            ValidationUtility.ValidateNotNull(policy, nameof(policy));
+            ValidationUtility.ValidateGuid(policy.Id, "Fee policy ID");
+            ValidationUtility.ValidateGuid(policy.AccountId, "Account ID");
+            ValidationUtility.ValidateGuid(policy.GasBankAccountId, "GasBank account ID");
+            ValidationUtility.ValidateGuid(policy.TemplateId, "Fee policy template ID");
+
+            policy.CreatedAt = DateTime.UtcNow;
+            policy.UpdatedAt = DateTime.UtcNow;
+
+            _logger.LogDebug("Creating fee policy {Id} for account {AccountId}", policy.Id, policy.AccountId);
+            await _storageProvider.CreateAsync(CollectionName, policy);
+
+            return policy;
+        }
+
+        /// <inheritdoc/>
+        public Task<GasFeePolicy> GetByIdAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "Fee policy ID");
+
+            return _storageProvider.GetByIdAsync<GasFeePolicy, Guid>(CollectionName, id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasFeePolicy>> GetByAccountIdAsync(Guid accountId)
+        {
+            ValidationUtility.ValidateGuid(accountId, "Account ID");
+
+            var policies = await _storageProvider.GetByFilterAsync<GasFeePolicy>(
+                CollectionName,
+                policy => policy.AccountId == accountId || policy.SponsorAccountId == accountId);
+
+            return policies.OrderBy(p => p.CreatedAt).ToList();
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<GasFeePolicy>> GetByTemplateIdAsync(Guid templateId)
+        {
+            ValidationUtility.ValidateGuid(templateId, "Fee policy template ID");
+
+            return _storageProvider.GetByFilterAsync<GasFeePolicy>(CollectionName, policy => policy.TemplateId == templateId);
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicy> UpdateAsync(GasFeePolicy policy)
+        {
+            ValidationUtility.ValidateNotNull(policy, nameof(policy));
+            ValidationUtility.ValidateGuid(policy.Id, "Fee policy ID");
+
+            policy.UpdatedAt = DateTime.UtcNow;
+            await _storageProvider.UpdateAsync<GasFeePolicy, Guid>(CollectionName, policy.Id, policy);
+
+            return policy;
+        }
+
+        /// <inheritdoc/>
+        public Task<bool> DeleteAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "Fee policy ID");
+
+            return _storageProvider.DeleteAsync<GasFeePolicy, Guid>(CollectionName, id);
+        }
+    }
+}
@@ -0,0 +1,96 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories
+{
+    /// <summary>
+    /// Implementation of the GasBank fee policy template repository
+    /// </summary>
+    public class GasFeePolicyTemplateRepository : IGasFeePolicyTemplateRepository
+    {
+        private readonly ILogger<GasFeePolicyTemplateRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private readonly SemaphoreSlim _versionLock = new SemaphoreSlim(1, 1);
+        private const string CollectionName = "gasbank_fee_policy_templates";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasFeePolicyTemplateRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public GasFeePolicyTemplateRepository(ILogger<GasFeePolicyTemplateRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicyTemplate> CreateAsync(GasFeePolicyTemplate template)
+        {
+            ValidationUtility.ValidateNotNull(template, nameof(template));
+            ValidationUtility.ValidateGuid(template.Id, "Fee policy template ID");
+            ValidationUtility.ValidateGuid(template.OwnerAccountId, "Owner account ID");
+
+            template.CreatedAt = DateTime.UtcNow;
+            template.UpdatedAt = DateTime.UtcNow;
+
+            _logger.LogDebug("Creating fee policy template {Id}", template.Id);
+            await _storageProvider.CreateAsync(CollectionName, template);
+
+            return template;
+        }
+
+        /// <inheritdoc/>
+        public Task<GasFeePolicyTemplate> GetByIdAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "Fee policy template ID");
+
+            return _storageProvider.GetByIdAsync<GasFeePolicyTemplate, Guid>(CollectionName, id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasFeePolicyTemplate>> GetVisibleToAsync(Guid accountId)
+        {
+            ValidationUtility.ValidateGuid(accountId, "Account ID");
+
+            var templates = await _storageProvider.GetByFilterAsync<GasFeePolicyTemplate>(
+                CollectionName,
+                template => template.OwnerAccountId == accountId || template.IsPublic);
+
+            return templates.OrderBy(t => t.Name).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> UpdateAsync(GasFeePolicyTemplate template, int expectedLatestVersion)
+        {
+            ValidationUtility.ValidateNotNull(template, nameof(template));
+            ValidationUtility.ValidateGuid(template.Id, "Fee policy template ID");
+
+            await _versionLock.WaitAsync();
+            try
+            {
+                var stored = await GetByIdAsync(template.Id);
+                if (stored == null || stored.LatestVersion != expectedLatestVersion)
+                {
+                    return false;
+                }
+
+                template.UpdatedAt = DateTime.UtcNow;
+                await _storageProvider.UpdateAsync<GasFeePolicyTemplate, Guid>(CollectionName, template.Id, template);
+
+                return true;
+            }
+            finally
+            {
+                _versionLock.Release();
+            }
+        }
+    }
+}
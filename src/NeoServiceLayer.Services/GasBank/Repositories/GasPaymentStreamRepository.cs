@@ -0,0 +1,103 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories
+{
+    /// <summary>
+    /// Implementation of the GasBank payment stream repository
+    /// </summary>
+    public class GasPaymentStreamRepository : IGasPaymentStreamRepository
+    {
+        private readonly ILogger<GasPaymentStreamRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private readonly SemaphoreSlim _statusLock = new SemaphoreSlim(1, 1);
+        private const string CollectionName = "gasbank_payment_streams";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasPaymentStreamRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public GasPaymentStreamRepository(ILogger<GasPaymentStreamRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasPaymentStream> CreateAsync(GasPaymentStream stream)
+        {
+            ValidationUtility.ValidateNotNull(stream, nameof(stream));
+            ValidationUtility.ValidateGuid(stream.Id, "Payment stream ID");
+            ValidationUtility.ValidateGuid(stream.PayerGasBankAccountId, "Payer GasBank account ID");
+            ValidationUtility.ValidateGuid(stream.PayeeGasBankAccountId, "Payee GasBank account ID");
+
+            stream.CreatedAt = DateTime.UtcNow;
+            stream.UpdatedAt = DateTime.UtcNow;
+
+            _logger.LogDebug("Creating payment stream {Id}", stream.Id);
+            await _storageProvider.CreateAsync(CollectionName, stream);
+
+            return stream;
+        }
+
+        /// <inheritdoc/>
+        public Task<GasPaymentStream> GetByIdAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "Payment stream ID");
+
+            return _storageProvider.GetByIdAsync<GasPaymentStream, Guid>(CollectionName, id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasPaymentStream>> GetByAccountIdAsync(Guid accountId)
+        {
+            ValidationUtility.ValidateGuid(accountId, "Account ID");
+
+            var streams = await _storageProvider.GetByFilterAsync<GasPaymentStream>(
+                CollectionName,
+                stream => stream.PayerAccountId == accountId || stream.PayeeAccountId == accountId);
+
+            return streams.OrderByDescending(s => s.CreatedAt).ToList();
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<GasPaymentStream>> GetByStatusAsync(GasPaymentStreamStatus status)
+        {
+            return _storageProvider.GetByFilterAsync<GasPaymentStream>(CollectionName, stream => stream.Status == status);
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> UpdateAsync(GasPaymentStream stream, GasPaymentStreamStatus expectedStatus)
+        {
+            ValidationUtility.ValidateNotNull(stream, nameof(stream));
+            ValidationUtility.ValidateGuid(stream.Id, "Payment stream ID");
+
+            await _statusLock.WaitAsync();
+            try
+            {
+                var stored = await GetByIdAsync(stream.Id);
+                if (stored == null || stored.Status != expectedStatus)
+                {
+                    return false;
+                }
+
+                stream.UpdatedAt = DateTime.UtcNow;
+                await _storageProvider.UpdateAsync<GasPaymentStream, Guid>(CollectionName, stream.Id, stream);
+
+                return true;
+            }
+            finally
+            {
+                _statusLock.Release();
+            }
+        }
+    }
+}
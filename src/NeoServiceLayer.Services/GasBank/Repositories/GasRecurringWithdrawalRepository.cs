@@ -0,0 +1,182 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories
+{
+    /// <summary>
+    /// Implementation of the GasBank recurring withdrawal repository
+    /// </summary>
+    public class GasRecurringWithdrawalRepository : IGasRecurringWithdrawalRepository
+    {
+        private readonly ILogger<GasRecurringWithdrawalRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private readonly SemaphoreSlim _updateLock = new SemaphoreSlim(1, 1);
+        private const string CollectionName = "gasbank_recurring_withdrawals";
+        private const string OccurrenceCollectionName = "gasbank_recurring_withdrawal_occurrences";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasRecurringWithdrawalRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public GasRecurringWithdrawalRepository(ILogger<GasRecurringWithdrawalRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasRecurringWithdrawal> CreateAsync(GasRecurringWithdrawal withdrawal)
+        {
+            ValidationUtility.ValidateNotNull(withdrawal, nameof(withdrawal));
+            ValidationUtility.ValidateGuid(withdrawal.Id, "Recurring withdrawal ID");
+            ValidationUtility.ValidateGuid(withdrawal.GasBankAccountId, "GasBank account ID");
+
+            withdrawal.CreatedAt = DateTime.UtcNow;
+            withdrawal.UpdatedAt = DateTime.UtcNow;
+
+            _logger.LogDebug("Creating recurring withdrawal {Id}", withdrawal.Id);
+            await _storageProvider.CreateAsync(CollectionName, withdrawal);
+
+            return withdrawal;
+        }
+
+        /// <inheritdoc/>
+        public Task<GasRecurringWithdrawal> GetByIdAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "Recurring withdrawal ID");
+
+            return _storageProvider.GetByIdAsync<GasRecurringWithdrawal, Guid>(CollectionName, id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasRecurringWithdrawal>> GetByAccountIdAsync(Guid accountId)
+        {
+            ValidationUtility.ValidateGuid(accountId, "Account ID");
+
+            var withdrawals = await _storageProvider.GetByFilterAsync<GasRecurringWithdrawal>(
+                CollectionName,
+                withdrawal => withdrawal.AccountId == accountId);
+
+            return withdrawals.OrderByDescending(w => w.CreatedAt).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasRecurringWithdrawal>> GetDueAsync(DateTime now)
+        {
+            var withdrawals = await _storageProvider.GetByFilterAsync<GasRecurringWithdrawal>(
+                CollectionName,
+                withdrawal => withdrawal.Status == GasRecurringWithdrawalStatus.Active && withdrawal.NextRunAt <= now);
+
+            return withdrawals.OrderBy(w => w.NextRunAt).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> UpdateAsync(GasRecurringWithdrawal withdrawal, GasRecurringWithdrawalStatus expectedStatus)
+        {
+            ValidationUtility.ValidateNotNull(withdrawal, nameof(withdrawal));
+            ValidationUtility.ValidateGuid(withdrawal.Id, "Recurring withdrawal ID");
+
+            await _updateLock.WaitAsync();
+            try
+            {
+                var stored = await GetByIdAsync(withdrawal.Id);
+                if (stored == null || stored.Status != expectedStatus)
+                {
+                    return false;
+                }
+
+                withdrawal.UpdatedAt = DateTime.UtcNow;
+                await _storageProvider.UpdateAsync<GasRecurringWithdrawal, Guid>(CollectionName, withdrawal.Id, withdrawal);
+
+                return true;
+            }
+            finally
+            {
+                _updateLock.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task UpdateRunAsync(GasRecurringWithdrawal withdrawal)
+        {
+            ValidationUtility.ValidateNotNull(withdrawal, nameof(withdrawal));
+            ValidationUtility.ValidateGuid(withdrawal.Id, "Recurring withdrawal ID");
+
+            await _updateLock.WaitAsync();
+            try
+            {
+                var stored = await GetByIdAsync(withdrawal.Id);
+                if (stored == null)
+                {
+                    return;
+                }
+
+                // A withdrawal paused during the run is rescheduled when it is resumed
+                if (stored.Status == GasRecurringWithdrawalStatus.Active)
+                {
+                    stored.NextRunAt = withdrawal.NextRunAt;
+                }
+
+                stored.LastRunAt = withdrawal.LastRunAt;
+                stored.LastError = withdrawal.LastError;
+                stored.UpdatedAt = DateTime.UtcNow;
+                await _storageProvider.UpdateAsync<GasRecurringWithdrawal, Guid>(CollectionName, stored.Id, stored);
+            }
+            finally
+            {
+                _updateLock.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasRecurringWithdrawalOccurrence> SaveOccurrenceAsync(GasRecurringWithdrawalOccurrence occurrence)
+        {
+            ValidationUtility.ValidateNotNull(occurrence, nameof(occurrence));
+            ValidationUtility.ValidateGuid(occurrence.Id, "Occurrence ID");
+            ValidationUtility.ValidateGuid(occurrence.RecurringWithdrawalId, "Recurring withdrawal ID");
+
+            occurrence.UpdatedAt = DateTime.UtcNow;
+
+            var existing = await _storageProvider.GetByIdAsync<GasRecurringWithdrawalOccurrence, Guid>(OccurrenceCollectionName, occurrence.Id);
+            if (existing == null)
+            {
+                occurrence.CreatedAt = occurrence.UpdatedAt;
+                return await _storageProvider.CreateAsync(OccurrenceCollectionName, occurrence);
+            }
+
+            return await _storageProvider.UpdateAsync<GasRecurringWithdrawalOccurrence, Guid>(OccurrenceCollectionName, occurrence.Id, occurrence);
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasRecurringWithdrawalOccurrence> GetOccurrenceAsync(Guid recurringWithdrawalId, DateTime scheduledFor)
+        {
+            ValidationUtility.ValidateGuid(recurringWithdrawalId, "Recurring withdrawal ID");
+
+            var occurrences = await _storageProvider.GetByFilterAsync<GasRecurringWithdrawalOccurrence>(
+                OccurrenceCollectionName,
+                occurrence => occurrence.RecurringWithdrawalId == recurringWithdrawalId && occurrence.ScheduledFor == scheduledFor);
+
+            return occurrences.FirstOrDefault();
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasRecurringWithdrawalOccurrence>> GetOccurrencesAsync(Guid recurringWithdrawalId, int limit)
+        {
+            ValidationUtility.ValidateGuid(recurringWithdrawalId, "Recurring withdrawal ID");
+
+            var occurrences = await _storageProvider.GetByFilterAsync<GasRecurringWithdrawalOccurrence>(
+                OccurrenceCollectionName,
+                occurrence => occurrence.RecurringWithdrawalId == recurringWithdrawalId);
+
+            return occurrences.OrderByDescending(o => o.ScheduledFor).Take(limit).ToList();
+        }
+    }
+}
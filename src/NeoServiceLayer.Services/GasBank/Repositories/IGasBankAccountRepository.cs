@@ -31,6 +31,12 @@ namespace NeoServiceLayer.Services.GasBank.Repositories
         /// <returns>The GasBank accounts</returns>
         Task<IEnumerable<GasBankAccount>> GetByAccountIdAsync(Guid accountId);
 
+        /// <summary>
+        /// Gets all GasBank accounts
+        /// </summary>
+        /// <returns>The GasBank accounts</returns>
+        Task<IEnumerable<GasBankAccount>> GetAllAsync();
+
         /// <summary>
         /// Updates a GasBank account
         /// </summary>
@@ -38,6 +44,45 @@ namespace NeoServiceLayer.Services.GasBank.Repositories
         /// <returns>The updated GasBank account</returns>
         Task<GasBankAccount> UpdateAsync(GasBankAccount account);
 
+        /// <summary>
+        /// Changes a GasBank account's balances and records the ledger entry as one unit
+        /// </summary>
+        /// <remarks>
+        /// The account passed to <paramref name="apply"/> is read after any concurrent balance update has finished,
+        /// so checks made in the callback, such as for sufficient balance, hold when the change is written.
+        /// An exception thrown by the callback aborts the update before anything is written.
+        /// </remarks>
+        /// <param name="id">The GasBank account ID</param>
+        /// <param name="apply">Changes the account and returns the transaction to record, or null to record none</param>
+        /// <returns>The updated GasBank account, or null if it was not found</returns>
+        Task<GasBankAccount> UpdateBalanceAsync(Guid id, Func<GasBankAccount, GasBankTransaction> apply);
+
+        /// <summary>
+        /// Changes a GasBank account's balances and records several ledger entries as one unit
+        /// </summary>
+        /// <remarks>
+        /// Works like <see cref="UpdateBalanceAsync(Guid, Func{GasBankAccount, GasBankTransaction})"/>, for changes
+        /// that are recorded as one entry per part, such as a payout to several recipients.
+        /// </remarks>
+        /// <param name="id">The GasBank account ID</param>
+        /// <param name="apply">Changes the account and returns the transactions to record</param>
+        /// <returns>The updated GasBank account, or null if it was not found</returns>
+        Task<GasBankAccount> UpdateBalanceWithEntriesAsync(Guid id, Func<GasBankAccount, IEnumerable<GasBankTransaction>> apply);
+
+        /// <summary>
+        /// Changes a GasBank account's balances and one of its allocations, and records the ledger entry, as one unit
+        /// </summary>
+        /// <remarks>
+        /// Works like <see cref="UpdateBalanceAsync(Guid, Func{GasBankAccount, GasBankTransaction})"/>. The allocation is
+        /// read under the same lock as the account, so concurrent changes to it, such as two executions charged to one
+        /// function, each see the amount the previous one left.
+        /// </remarks>
+        /// <param name="id">The GasBank account ID</param>
+        /// <param name="allocationId">The ID of an allocation in the account</param>
+        /// <param name="apply">Changes the account and the allocation and returns the transaction to record, or null to record none</param>
+        /// <returns>The updated GasBank account, or null if the account or the allocation was not found</returns>
+        Task<GasBankAccount> UpdateBalanceAndAllocationAsync(Guid id, Guid allocationId, Func<GasBankAccount, GasBankAllocation, GasBankTransaction> apply);
+
         /// <summary>
         /// Deletes a GasBank account
         /// </summary>
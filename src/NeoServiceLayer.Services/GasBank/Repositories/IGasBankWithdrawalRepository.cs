@@ -0,0 +1,49 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories
+{
+    /// <summary>
+    /// Interface for the GasBank withdrawal repository
+    /// </summary>
+    public interface IGasBankWithdrawalRepository
+    {
+        /// <summary>
+        /// Creates a new GasBank withdrawal
+        /// </summary>
+        /// <param name="withdrawal">The GasBank withdrawal to create</param>
+        /// <returns>The created GasBank withdrawal</returns>
+        Task<GasBankWithdrawal> CreateAsync(GasBankWithdrawal withdrawal);
+
+        /// <summary>
+        /// Gets a GasBank withdrawal by ID
+        /// </summary>
+        /// <param name="id">The GasBank withdrawal ID</param>
+        /// <returns>The GasBank withdrawal</returns>
+        Task<GasBankWithdrawal> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets all GasBank withdrawals with a status
+        /// </summary>
+        /// <param name="status">The status</param>
+        /// <returns>The GasBank withdrawals, oldest first</returns>
+        Task<IEnumerable<GasBankWithdrawal>> GetByStatusAsync(GasBankWithdrawalStatus status);
+
+        /// <summary>
+        /// Updates a GasBank withdrawal
+        /// </summary>
+        /// <param name="withdrawal">The GasBank withdrawal to update</param>
+        /// <returns>The updated GasBank withdrawal</returns>
+        Task<GasBankWithdrawal> UpdateAsync(GasBankWithdrawal withdrawal);
+
+        /// <summary>
+        /// Updates a GasBank withdrawal if its stored status is still the expected one
+        /// </summary>
+        /// <param name="withdrawal">The GasBank withdrawal to update</param>
+        /// <param name="expectedStatus">The status the stored withdrawal must have</param>
+        /// <returns>True if the withdrawal was updated, false if its status had changed</returns>
+        Task<bool> UpdateAsync(GasBankWithdrawal withdrawal, GasBankWithdrawalStatus expectedStatus);
+    }
+}
@@ -0,0 +1,55 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories
+{
+    /// <summary>
+    /// Interface for the GasBank fee policy repository
+    /// </summary>
+    public interface IGasFeePolicyRepository
+    {
+        /// <summary>
+        /// Creates a new policy
+        /// </summary>
+        /// <param name="policy">The policy to create</param>
+        /// <returns>The created policy</returns>
+        Task<GasFeePolicy> CreateAsync(GasFeePolicy policy);
+
+        /// <summary>
+        /// Gets a policy by ID
+        /// </summary>
+        /// <param name="id">The policy ID</param>
+        /// <returns>The policy</returns>
+        Task<GasFeePolicy> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets all policies covering or sponsored by an account
+        /// </summary>
+        /// <param name="accountId">The account ID</param>
+        /// <returns>The policies</returns>
+        Task<IEnumerable<GasFeePolicy>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Gets all policies created from a template
+        /// </summary>
+        /// <param name="templateId">The template ID</param>
+        /// <returns>The policies</returns>
+        Task<IEnumerable<GasFeePolicy>> GetByTemplateIdAsync(Guid templateId);
+
+        /// <summary>
+        /// Updates a policy
+        /// </summary>
+        /// <param name="policy">The policy to update</param>
+        /// <returns>The updated policy</returns>
+        Task<GasFeePolicy> UpdateAsync(GasFeePolicy policy);
+
+        /// <summary>
+        /// Deletes a policy
+        /// </summary>
+        /// <param name="id">The policy ID</param>
+        /// <returns>True if the policy was deleted</returns>
+        Task<bool> DeleteAsync(Guid id);
+    }
+}
@@ -0,0 +1,42 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories
+{
+    /// <summary>
+    /// Interface for the GasBank fee policy template repository
+    /// </summary>
+    public interface IGasFeePolicyTemplateRepository
+    {
+        /// <summary>
+        /// Creates a new template
+        /// </summary>
+        /// <param name="template">The template to create</param>
+        /// <returns>The created template</returns>
+        Task<GasFeePolicyTemplate> CreateAsync(GasFeePolicyTemplate template);
+
+        /// <summary>
+        /// Gets a template by ID
+        /// </summary>
+        /// <param name="id">The template ID</param>
+        /// <returns>The template</returns>
+        Task<GasFeePolicyTemplate> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the templates an account owns and the public templates
+        /// </summary>
+        /// <param name="accountId">The account ID</param>
+        /// <returns>The templates</returns>
+        Task<IEnumerable<GasFeePolicyTemplate>> GetVisibleToAsync(Guid accountId);
+
+        /// <summary>
+        /// Updates a template if its stored latest version is still the expected one
+        /// </summary>
+        /// <param name="template">The template to update</param>
+        /// <param name="expectedLatestVersion">The latest version the stored template must have</param>
+        /// <returns>True if the template was updated, false if another version was published first</returns>
+        Task<bool> UpdateAsync(GasFeePolicyTemplate template, int expectedLatestVersion);
+    }
+}
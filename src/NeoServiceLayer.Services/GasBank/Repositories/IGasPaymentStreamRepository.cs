@@ -0,0 +1,49 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories
+{
+    /// <summary>
+    /// Interface for the GasBank payment stream repository
+    /// </summary>
+    public interface IGasPaymentStreamRepository
+    {
+        /// <summary>
+        /// Creates a new payment stream
+        /// </summary>
+        /// <param name="stream">The payment stream to create</param>
+        /// <returns>The created payment stream</returns>
+        Task<GasPaymentStream> CreateAsync(GasPaymentStream stream);
+
+        /// <summary>
+        /// Gets a payment stream by ID
+        /// </summary>
+        /// <param name="id">The payment stream ID</param>
+        /// <returns>The payment stream</returns>
+        Task<GasPaymentStream> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets all payment streams an account pays or receives
+        /// </summary>
+        /// <param name="accountId">The account ID</param>
+        /// <returns>The payment streams</returns>
+        Task<IEnumerable<GasPaymentStream>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Gets all payment streams with a status
+        /// </summary>
+        /// <param name="status">The status</param>
+        /// <returns>The payment streams</returns>
+        Task<IEnumerable<GasPaymentStream>> GetByStatusAsync(GasPaymentStreamStatus status);
+
+        /// <summary>
+        /// Updates a payment stream if its stored status is still the expected one
+        /// </summary>
+        /// <param name="stream">The payment stream to update</param>
+        /// <param name="expectedStatus">The status the stored stream must have</param>
+        /// <returns>True if the stream was updated, false if its status had changed</returns>
+        Task<bool> UpdateAsync(GasPaymentStream stream, GasPaymentStreamStatus expectedStatus);
+    }
+}
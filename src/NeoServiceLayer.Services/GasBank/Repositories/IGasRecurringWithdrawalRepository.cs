@@ -0,0 +1,79 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories
+{
+    /// <summary>
+    /// Interface for the GasBank recurring withdrawal repository
+    /// </summary>
+    public interface IGasRecurringWithdrawalRepository
+    {
+        /// <summary>
+        /// Creates a new recurring withdrawal
+        /// </summary>
+        /// <param name="withdrawal">The recurring withdrawal to create</param>
+        /// <returns>The created recurring withdrawal</returns>
+        Task<GasRecurringWithdrawal> CreateAsync(GasRecurringWithdrawal withdrawal);
+
+        /// <summary>
+        /// Gets a recurring withdrawal by ID
+        /// </summary>
+        /// <param name="id">The recurring withdrawal ID</param>
+        /// <returns>The recurring withdrawal</returns>
+        Task<GasRecurringWithdrawal> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets all recurring withdrawals of an account
+        /// </summary>
+        /// <param name="accountId">The account ID</param>
+        /// <returns>The recurring withdrawals</returns>
+        Task<IEnumerable<GasRecurringWithdrawal>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Gets active recurring withdrawals whose next occurrence is due
+        /// </summary>
+        /// <param name="now">The current time</param>
+        /// <returns>The due recurring withdrawals</returns>
+        Task<IEnumerable<GasRecurringWithdrawal>> GetDueAsync(DateTime now);
+
+        /// <summary>
+        /// Updates a recurring withdrawal if its stored status is still the expected one
+        /// </summary>
+        /// <param name="withdrawal">The recurring withdrawal to update</param>
+        /// <param name="expectedStatus">The status the stored recurring withdrawal must have</param>
+        /// <returns>True if the recurring withdrawal was updated, false if its status had changed</returns>
+        Task<bool> UpdateAsync(GasRecurringWithdrawal withdrawal, GasRecurringWithdrawalStatus expectedStatus);
+
+        /// <summary>
+        /// Records the outcome of a run, leaving the status alone so a pause or cancel made during the run is kept
+        /// </summary>
+        /// <param name="withdrawal">The recurring withdrawal whose next run, last run and last error are stored</param>
+        /// <returns>Task representing the asynchronous operation</returns>
+        Task UpdateRunAsync(GasRecurringWithdrawal withdrawal);
+
+        /// <summary>
+        /// Creates or replaces an occurrence
+        /// </summary>
+        /// <param name="occurrence">The occurrence</param>
+        /// <returns>The stored occurrence</returns>
+        Task<GasRecurringWithdrawalOccurrence> SaveOccurrenceAsync(GasRecurringWithdrawalOccurrence occurrence);
+
+        /// <summary>
+        /// Gets the occurrence of a recurring withdrawal that was due at a time
+        /// </summary>
+        /// <param name="recurringWithdrawalId">The recurring withdrawal ID</param>
+        /// <param name="scheduledFor">When the occurrence was due</param>
+        /// <returns>The occurrence, or null if it has not run</returns>
+        Task<GasRecurringWithdrawalOccurrence> GetOccurrenceAsync(Guid recurringWithdrawalId, DateTime scheduledFor);
+
+        /// <summary>
+        /// Gets the latest occurrences of a recurring withdrawal, newest first
+        /// </summary>
+        /// <param name="recurringWithdrawalId">The recurring withdrawal ID</param>
+        /// <param name="limit">The most occurrences to return</param>
+        /// <returns>The occurrences</returns>
+        Task<IEnumerable<GasRecurringWithdrawalOccurrence>> GetOccurrencesAsync(Guid recurringWithdrawalId, int limit);
+    }
+}
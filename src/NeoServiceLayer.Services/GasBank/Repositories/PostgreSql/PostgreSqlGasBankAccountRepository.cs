@@ -0,0 +1,277 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Text.Json;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using Npgsql;
+using NpgsqlTypes;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories.PostgreSql
+{
+    /// <summary>
+    /// PostgreSQL implementation of the GasBank account repository
+    /// </summary>
+    public class PostgreSqlGasBankAccountRepository : IGasBankAccountRepository
+    {
+        private const string SelectColumns = "SELECT id, account_id, name, balance, allocated_amount, hot_balance, wallet_id, neo_address, tags, created_at, updated_at FROM gasbank_accounts";
+
+        private readonly ILogger<PostgreSqlGasBankAccountRepository> _logger;
+        private readonly PostgreSqlGasBankStore _store;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PostgreSqlGasBankAccountRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="store">GasBank PostgreSQL store</param>
+        public PostgreSqlGasBankAccountRepository(ILogger<PostgreSqlGasBankAccountRepository> logger, PostgreSqlGasBankStore store)
+        {
+            _logger = logger;
+            _store = store;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankAccount> CreateAsync(GasBankAccount account)
+        {
+            ValidationUtility.ValidateNotNull(account, nameof(account));
+            ValidationUtility.ValidateGuid(account.Id, "GasBank account ID");
+            ValidationUtility.ValidateGuid(account.AccountId, "Account ID");
+            ValidationUtility.ValidateNotNullOrEmpty(account.Name, "Name");
+
+            account.CreatedAt = DateTime.UtcNow;
+            account.UpdatedAt = DateTime.UtcNow;
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+INSERT INTO gasbank_accounts (id, account_id, name, balance, allocated_amount, hot_balance, wallet_id, neo_address, tags, created_at, updated_at)
+VALUES (@id, @accountId, @name, @balance, @allocatedAmount, @hotBalance, @walletId, @neoAddress, @tags, @createdAt, @updatedAt)",
+                connection);
+            AddParameters(command, account);
+            PostgreSqlGasBankStore.AddParameter(command, "accountId", account.AccountId);
+            PostgreSqlGasBankStore.AddParameter(command, "createdAt", account.CreatedAt);
+            await command.ExecuteNonQueryAsync();
+
+            _logger.LogInformation("Created GasBank account {Id} for account {AccountId}", account.Id, account.AccountId);
+
+            return account;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankAccount> GetByIdAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "GasBank account ID");
+
+            await using var connection = await _store.OpenConnectionAsync();
+            return await ReadAsync(connection, null, id, false);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankAccount>> GetByAccountIdAsync(Guid accountId)
+        {
+            ValidationUtility.ValidateGuid(accountId, "Account ID");
+
+            return await QueryAsync($"{SelectColumns} WHERE account_id = @accountId", command => command.Parameters.AddWithValue("accountId", accountId));
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankAccount>> GetAllAsync()
+        {
+            return await QueryAsync(SelectColumns, _ => { });
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankAccount> UpdateAsync(GasBankAccount account)
+        {
+            ValidationUtility.ValidateNotNull(account, nameof(account));
+            ValidationUtility.ValidateGuid(account.Id, "GasBank account ID");
+
+            account.UpdatedAt = DateTime.UtcNow;
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await WriteAsync(connection, null, account);
+
+            return account;
+        }
+
+        /// <inheritdoc/>
+        public Task<GasBankAccount> UpdateBalanceAsync(Guid id, Func<GasBankAccount, GasBankTransaction> apply)
+        {
+            ValidationUtility.ValidateNotNull(apply, nameof(apply));
+
+            return UpdateBalanceWithEntriesAsync(id, account =>
+            {
+                var transaction = apply(account);
+                return transaction != null ? new[] { transaction } : Array.Empty<GasBankTransaction>();
+            });
+        }
+
+        /// <inheritdoc/>
+        /// <remarks>
+        /// The account row is locked with <c>SELECT ... FOR UPDATE</c> for the length of the database transaction,
+        /// so concurrent updates from any replica are applied one after another, and the balance change and its
+        /// ledger entries are committed together.
+        /// </remarks>
+        public async Task<GasBankAccount> UpdateBalanceWithEntriesAsync(Guid id, Func<GasBankAccount, IEnumerable<GasBankTransaction>> apply)
+        {
+            ValidationUtility.ValidateGuid(id, "GasBank account ID");
+            ValidationUtility.ValidateNotNull(apply, nameof(apply));
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var dbTransaction = await connection.BeginTransactionAsync();
+
+            var account = await ReadAsync(connection, dbTransaction, id, true);
+            if (account == null)
+            {
+                return null;
+            }
+
+            var transactions = apply(account)?.ToList() ?? new List<GasBankTransaction>();
+
+            account.UpdatedAt = DateTime.UtcNow;
+            await WriteAsync(connection, dbTransaction, account);
+            foreach (var transaction in transactions)
+            {
+                await PostgreSqlGasBankTransactionRepository.InsertAsync(connection, dbTransaction, transaction);
+            }
+
+            await dbTransaction.CommitAsync();
+
+            return account;
+        }
+
+        /// <inheritdoc/>
+        /// <remarks>
+        /// The account and allocation rows are both locked with <c>SELECT ... FOR UPDATE</c>, always in that order,
+        /// and the two rows and the ledger entry are committed together.
+        /// </remarks>
+        public async Task<GasBankAccount> UpdateBalanceAndAllocationAsync(Guid id, Guid allocationId, Func<GasBankAccount, GasBankAllocation, GasBankTransaction> apply)
+        {
+            ValidationUtility.ValidateGuid(id, "GasBank account ID");
+            ValidationUtility.ValidateGuid(allocationId, "GasBank allocation ID");
+            ValidationUtility.ValidateNotNull(apply, nameof(apply));
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var dbTransaction = await connection.BeginTransactionAsync();
+
+            var account = await ReadAsync(connection, dbTransaction, id, true);
+            if (account == null)
+            {
+                return null;
+            }
+
+            var allocation = await PostgreSqlGasBankAllocationRepository.ReadAsync(connection, dbTransaction, allocationId, true);
+            if (allocation == null || allocation.GasBankAccountId != id)
+            {
+                return null;
+            }
+
+            var transaction = apply(account, allocation);
+
+            account.UpdatedAt = DateTime.UtcNow;
+            allocation.UpdatedAt = DateTime.UtcNow;
+            await WriteAsync(connection, dbTransaction, account);
+            await PostgreSqlGasBankAllocationRepository.WriteAsync(connection, dbTransaction, allocation);
+            if (transaction != null)
+            {
+                await PostgreSqlGasBankTransactionRepository.InsertAsync(connection, dbTransaction, transaction);
+            }
+
+            await dbTransaction.CommitAsync();
+
+            return account;
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "GasBank account ID");
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand("DELETE FROM gasbank_accounts WHERE id = @id", connection);
+            command.Parameters.AddWithValue("id", id);
+
+            return await command.ExecuteNonQueryAsync() > 0;
+        }
+
+        private static async Task WriteAsync(NpgsqlConnection connection, NpgsqlTransaction dbTransaction, GasBankAccount account)
+        {
+            await using var command = new NpgsqlCommand(@"
+UPDATE gasbank_accounts
+SET name = @name, balance = @balance, allocated_amount = @allocatedAmount, hot_balance = @hotBalance,
+    wallet_id = @walletId, neo_address = @neoAddress, tags = @tags, updated_at = @updatedAt
+WHERE id = @id",
+                connection,
+                dbTransaction);
+            AddParameters(command, account);
+
+            if (await command.ExecuteNonQueryAsync() == 0)
+            {
+                throw new InvalidOperationException($"GasBank account {account.Id} not found");
+            }
+        }
+
+        private static void AddParameters(NpgsqlCommand command, GasBankAccount account)
+        {
+            PostgreSqlGasBankStore.AddParameter(command, "id", account.Id);
+            PostgreSqlGasBankStore.AddParameter(command, "name", account.Name);
+            PostgreSqlGasBankStore.AddParameter(command, "balance", account.Balance);
+            PostgreSqlGasBankStore.AddParameter(command, "allocatedAmount", account.AllocatedAmount);
+            PostgreSqlGasBankStore.AddParameter(command, "hotBalance", account.HotBalance);
+            PostgreSqlGasBankStore.AddParameter(command, "walletId", account.WalletId);
+            PostgreSqlGasBankStore.AddParameter(command, "neoAddress", account.NeoAddress);
+            PostgreSqlGasBankStore.AddParameter(command, "updatedAt", account.UpdatedAt);
+            command.Parameters.Add(new NpgsqlParameter("tags", NpgsqlDbType.Jsonb)
+            {
+                Value = account.Tags != null ? JsonSerializer.Serialize(account.Tags) : (object)DBNull.Value
+            });
+        }
+
+        private static async Task<GasBankAccount> ReadAsync(NpgsqlConnection connection, NpgsqlTransaction dbTransaction, Guid id, bool forUpdate)
+        {
+            var sql = $"{SelectColumns} WHERE id = @id" + (forUpdate ? " FOR UPDATE" : string.Empty);
+            await using var command = new NpgsqlCommand(sql, connection, dbTransaction);
+            command.Parameters.AddWithValue("id", id);
+
+            await using var reader = await command.ExecuteReaderAsync();
+            return await reader.ReadAsync() ? Map(reader) : null;
+        }
+
+        private async Task<List<GasBankAccount>> QueryAsync(string sql, Action<NpgsqlCommand> addParameters)
+        {
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(sql, connection);
+            addParameters(command);
+
+            var accounts = new List<GasBankAccount>();
+            await using var reader = await command.ExecuteReaderAsync();
+            while (await reader.ReadAsync())
+            {
+                accounts.Add(Map(reader));
+            }
+
+            return accounts;
+        }
+
+        private static GasBankAccount Map(NpgsqlDataReader reader)
+        {
+            var tags = PostgreSqlGasBankStore.GetNullable<string>(reader, "tags");
+
+            return new GasBankAccount
+            {
+                Id = reader.GetGuid(reader.GetOrdinal("id")),
+                AccountId = reader.GetGuid(reader.GetOrdinal("account_id")),
+                Name = reader.GetString(reader.GetOrdinal("name")),
+                Balance = reader.GetDecimal(reader.GetOrdinal("balance")),
+                AllocatedAmount = reader.GetDecimal(reader.GetOrdinal("allocated_amount")),
+                HotBalance = reader.GetDecimal(reader.GetOrdinal("hot_balance")),
+                WalletId = reader.GetGuid(reader.GetOrdinal("wallet_id")),
+                NeoAddress = PostgreSqlGasBankStore.GetNullable<string>(reader, "neo_address"),
+                Tags = tags != null ? JsonSerializer.Deserialize<Dictionary<string, string>>(tags) : null,
+                CreatedAt = reader.GetDateTime(reader.GetOrdinal("created_at")),
+                UpdatedAt = reader.GetDateTime(reader.GetOrdinal("updated_at"))
+            };
+        }
+    }
+}
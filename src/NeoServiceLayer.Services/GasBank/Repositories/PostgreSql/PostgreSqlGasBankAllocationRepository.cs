@@ -0,0 +1,189 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using Npgsql;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories.PostgreSql
+{
+    /// <summary>
+    /// PostgreSQL implementation of the GasBank allocation repository
+    /// </summary>
+    public class PostgreSqlGasBankAllocationRepository : IGasBankAllocationRepository
+    {
+        private const string SelectColumns = "SELECT id, gasbank_account_id, function_id, amount, created_at, updated_at FROM gasbank_allocations";
+
+        private readonly PostgreSqlGasBankStore _store;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PostgreSqlGasBankAllocationRepository"/> class
+        /// </summary>
+        /// <param name="store">GasBank PostgreSQL store</param>
+        public PostgreSqlGasBankAllocationRepository(PostgreSqlGasBankStore store)
+        {
+            _store = store;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankAllocation> CreateAsync(GasBankAllocation allocation)
+        {
+            ValidationUtility.ValidateNotNull(allocation, nameof(allocation));
+            ValidationUtility.ValidateGuid(allocation.Id, "GasBank allocation ID");
+            ValidationUtility.ValidateGuid(allocation.GasBankAccountId, "GasBank account ID");
+            ValidationUtility.ValidateGuid(allocation.FunctionId, "Function ID");
+            ValidationUtility.ValidateGreaterThanZero(allocation.Amount, "Amount");
+
+            allocation.CreatedAt = DateTime.UtcNow;
+            allocation.UpdatedAt = DateTime.UtcNow;
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+INSERT INTO gasbank_allocations (id, gasbank_account_id, function_id, amount, created_at, updated_at)
+VALUES (@id, @gasBankAccountId, @functionId, @amount, @createdAt, @updatedAt)",
+                connection);
+            AddParameters(command, allocation);
+            await command.ExecuteNonQueryAsync();
+
+            return allocation;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankAllocation> GetByIdAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "GasBank allocation ID");
+
+            var allocations = await QueryAsync($"{SelectColumns} WHERE id = @id", command => command.Parameters.AddWithValue("id", id));
+            return allocations.Count > 0 ? allocations[0] : null;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankAllocation>> GetByGasBankAccountIdAsync(Guid gasBankAccountId)
+        {
+            ValidationUtility.ValidateGuid(gasBankAccountId, "GasBank account ID");
+
+            return await QueryAsync(
+                $"{SelectColumns} WHERE gasbank_account_id = @gasBankAccountId",
+                command => command.Parameters.AddWithValue("gasBankAccountId", gasBankAccountId));
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankAllocation>> GetByFunctionIdAsync(Guid functionId)
+        {
+            ValidationUtility.ValidateGuid(functionId, "Function ID");
+
+            return await QueryAsync(
+                $"{SelectColumns} WHERE function_id = @functionId",
+                command => command.Parameters.AddWithValue("functionId", functionId));
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankAllocation> UpdateAsync(GasBankAllocation allocation)
+        {
+            ValidationUtility.ValidateNotNull(allocation, nameof(allocation));
+            ValidationUtility.ValidateGuid(allocation.Id, "GasBank allocation ID");
+            ValidationUtility.ValidateGuid(allocation.GasBankAccountId, "GasBank account ID");
+            ValidationUtility.ValidateGuid(allocation.FunctionId, "Function ID");
+            ValidationUtility.ValidateGreaterThanOrEqualToZero(allocation.Amount, "Amount");
+
+            allocation.UpdatedAt = DateTime.UtcNow;
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await WriteAsync(connection, null, allocation);
+
+            return allocation;
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "GasBank allocation ID");
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand("DELETE FROM gasbank_allocations WHERE id = @id", connection);
+            command.Parameters.AddWithValue("id", id);
+
+            return await command.ExecuteNonQueryAsync() > 0;
+        }
+
+        /// <summary>
+        /// Reads an allocation as part of a database transaction
+        /// </summary>
+        /// <param name="connection">Open connection</param>
+        /// <param name="dbTransaction">Database transaction</param>
+        /// <param name="id">Allocation ID</param>
+        /// <param name="forUpdate">Whether to lock the row until the transaction ends</param>
+        /// <returns>The allocation, or null if it was not found</returns>
+        internal static async Task<GasBankAllocation> ReadAsync(NpgsqlConnection connection, NpgsqlTransaction dbTransaction, Guid id, bool forUpdate)
+        {
+            var sql = $"{SelectColumns} WHERE id = @id" + (forUpdate ? " FOR UPDATE" : string.Empty);
+            await using var command = new NpgsqlCommand(sql, connection, dbTransaction);
+            command.Parameters.AddWithValue("id", id);
+
+            await using var reader = await command.ExecuteReaderAsync();
+            return await reader.ReadAsync() ? Map(reader) : null;
+        }
+
+        /// <summary>
+        /// Writes an allocation, optionally as part of a database transaction
+        /// </summary>
+        /// <param name="connection">Open connection</param>
+        /// <param name="dbTransaction">Database transaction, or null</param>
+        /// <param name="allocation">Allocation to write</param>
+        /// <returns>Task representing the asynchronous operation</returns>
+        internal static async Task WriteAsync(NpgsqlConnection connection, NpgsqlTransaction dbTransaction, GasBankAllocation allocation)
+        {
+            await using var command = new NpgsqlCommand(@"
+UPDATE gasbank_allocations
+SET gasbank_account_id = @gasBankAccountId, function_id = @functionId, amount = @amount, updated_at = @updatedAt
+WHERE id = @id",
+                connection,
+                dbTransaction);
+            AddParameters(command, allocation);
+
+            if (await command.ExecuteNonQueryAsync() == 0)
+            {
+                throw new InvalidOperationException($"GasBank allocation {allocation.Id} not found");
+            }
+        }
+
+        private static void AddParameters(NpgsqlCommand command, GasBankAllocation allocation)
+        {
+            PostgreSqlGasBankStore.AddParameter(command, "id", allocation.Id);
+            PostgreSqlGasBankStore.AddParameter(command, "gasBankAccountId", allocation.GasBankAccountId);
+            PostgreSqlGasBankStore.AddParameter(command, "functionId", allocation.FunctionId);
+            PostgreSqlGasBankStore.AddParameter(command, "amount", allocation.Amount);
+            PostgreSqlGasBankStore.AddParameter(command, "createdAt", allocation.CreatedAt);
+            PostgreSqlGasBankStore.AddParameter(command, "updatedAt", allocation.UpdatedAt);
+        }
+
+        private async Task<List<GasBankAllocation>> QueryAsync(string sql, Action<NpgsqlCommand> addParameters)
+        {
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(sql, connection);
+            addParameters(command);
+
+            var allocations = new List<GasBankAllocation>();
+            await using var reader = await command.ExecuteReaderAsync();
+            while (await reader.ReadAsync())
+            {
+                allocations.Add(Map(reader));
+            }
+
+            return allocations;
+        }
+
+        private static GasBankAllocation Map(NpgsqlDataReader reader)
+        {
+            return new GasBankAllocation
+            {
+                Id = reader.GetGuid(reader.GetOrdinal("id")),
+                GasBankAccountId = reader.GetGuid(reader.GetOrdinal("gasbank_account_id")),
+                FunctionId = reader.GetGuid(reader.GetOrdinal("function_id")),
+                Amount = reader.GetDecimal(reader.GetOrdinal("amount")),
+                CreatedAt = reader.GetDateTime(reader.GetOrdinal("created_at")),
+                UpdatedAt = reader.GetDateTime(reader.GetOrdinal("updated_at"))
+            };
+        }
+    }
+}
@@ -0,0 +1,332 @@
+using System;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Models;
+using Npgsql;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories.PostgreSql
+{
+    /// <summary>
+    /// PostgreSQL database holding GasBank accounts, transactions, withdrawals and allocations
+    /// </summary>
+    /// <remarks>
+    /// The schema is migrated the first time a connection is opened. Migrations run under a transaction-scoped
+    /// advisory lock, so replicas starting together apply each migration once, and the applied versions are kept
+    /// in <c>gasbank_schema_migrations</c>.
+    /// </remarks>
+    public class PostgreSqlGasBankStore : IDisposable
+    {
+        private const long MigrationLockKey = 0x47617342616E6B; // "GasBank"
+
+        private static readonly (int Version, string Description, string Sql)[] Migrations =
+        {
+            (1, "Create GasBank tables", @"
+CREATE TABLE gasbank_accounts (
+    id uuid PRIMARY KEY,
+    account_id uuid NOT NULL,
+    name text NOT NULL,
+    balance numeric NOT NULL DEFAULT 0,
+    allocated_amount numeric NOT NULL DEFAULT 0,
+    hot_balance numeric NOT NULL DEFAULT 0,
+    wallet_id uuid NOT NULL,
+    neo_address text,
+    tags jsonb,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+CREATE INDEX ix_gasbank_accounts_account_id ON gasbank_accounts (account_id);
+
+CREATE TABLE gasbank_transactions (
+    id uuid PRIMARY KEY,
+    gasbank_account_id uuid NOT NULL,
+    type text NOT NULL,
+    amount numeric NOT NULL,
+    balance_after numeric NOT NULL,
+    transaction_hash text,
+    related_entity_id uuid,
+    neo_address text,
+    timestamp timestamptz NOT NULL,
+    description text
+);
+CREATE INDEX ix_gasbank_transactions_account_timestamp ON gasbank_transactions (gasbank_account_id, timestamp);
+CREATE INDEX ix_gasbank_transactions_related_entity_id ON gasbank_transactions (related_entity_id);
+
+CREATE TABLE gasbank_withdrawals (
+    id uuid PRIMARY KEY,
+    gasbank_account_id uuid NOT NULL,
+    amount numeric NOT NULL,
+    to_address text NOT NULL,
+    status text NOT NULL,
+    transaction_hash text,
+    requested_at timestamptz NOT NULL,
+    completed_at timestamptz
+);
+CREATE INDEX ix_gasbank_withdrawals_status_requested_at ON gasbank_withdrawals (status, requested_at);
+
+CREATE TABLE gasbank_allocations (
+    id uuid PRIMARY KEY,
+    gasbank_account_id uuid NOT NULL,
+    function_id uuid NOT NULL,
+    amount numeric NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+CREATE INDEX ix_gasbank_allocations_account_id ON gasbank_allocations (gasbank_account_id);
+CREATE INDEX ix_gasbank_allocations_function_id ON gasbank_allocations (function_id);
+"),
+            (2, "Create GasBank payment streams table", @"
+CREATE TABLE gasbank_payment_streams (
+    id uuid PRIMARY KEY,
+    payer_gasbank_account_id uuid NOT NULL,
+    payer_account_id uuid NOT NULL,
+    payee_gasbank_account_id uuid NOT NULL,
+    payee_account_id uuid NOT NULL,
+    rate_per_second numeric NOT NULL,
+    cap numeric NOT NULL,
+    description text,
+    status text NOT NULL,
+    started_at timestamptz NOT NULL,
+    expires_at timestamptz NOT NULL,
+    closed_at timestamptz,
+    closed_by uuid,
+    accrued_amount numeric NOT NULL DEFAULT 0,
+    settlement_transaction_hash text,
+    settled_at timestamptz,
+    last_error text,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+CREATE INDEX ix_gasbank_payment_streams_payer_account_id ON gasbank_payment_streams (payer_account_id);
+CREATE INDEX ix_gasbank_payment_streams_payee_account_id ON gasbank_payment_streams (payee_account_id);
+CREATE INDEX ix_gasbank_payment_streams_status_expires_at ON gasbank_payment_streams (status, expires_at);
+"),
+            (3, "Create GasBank fee policy tables", @"
+CREATE TABLE gasbank_fee_policy_templates (
+    id uuid PRIMARY KEY,
+    owner_account_id uuid NOT NULL,
+    name text NOT NULL,
+    description text,
+    is_public boolean NOT NULL DEFAULT false,
+    latest_version integer NOT NULL,
+    versions jsonb NOT NULL,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+CREATE INDEX ix_gasbank_fee_policy_templates_owner_account_id ON gasbank_fee_policy_templates (owner_account_id);
+
+CREATE TABLE gasbank_fee_policies (
+    id uuid PRIMARY KEY,
+    account_id uuid NOT NULL,
+    sponsor_account_id uuid NOT NULL,
+    gasbank_account_id uuid NOT NULL,
+    template_id uuid NOT NULL,
+    template_version integer,
+    overrides jsonb,
+    spent_today numeric NOT NULL DEFAULT 0,
+    spent_date timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+CREATE INDEX ix_gasbank_fee_policies_account_id ON gasbank_fee_policies (account_id);
+CREATE INDEX ix_gasbank_fee_policies_sponsor_account_id ON gasbank_fee_policies (sponsor_account_id);
+CREATE INDEX ix_gasbank_fee_policies_template_id ON gasbank_fee_policies (template_id);
+"),
+            (4, "Add GasBank withdrawal retry columns", @"
+ALTER TABLE gasbank_withdrawals
+    ADD COLUMN attempts integer NOT NULL DEFAULT 0,
+    ADD COLUMN next_attempt_at timestamptz,
+    ADD COLUMN last_error text,
+    ADD COLUMN status_reason text;
+"),
+            (5, "Add GasBank withdrawal confirmation column", @"
+ALTER TABLE gasbank_withdrawals ADD COLUMN confirmed_at timestamptz;
+"),
+            (6, "Create GasBank recurring withdrawal tables", @"
+CREATE TABLE gasbank_recurring_withdrawals (
+    id uuid PRIMARY KEY,
+    account_id uuid NOT NULL,
+    gasbank_account_id uuid NOT NULL,
+    to_address text NOT NULL,
+    schedule text NOT NULL,
+    amount numeric,
+    floor numeric NOT NULL DEFAULT 0,
+    minimum_amount numeric NOT NULL DEFAULT 0,
+    description text,
+    status text NOT NULL,
+    next_run_at timestamptz,
+    last_run_at timestamptz,
+    last_error text,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+CREATE INDEX ix_gasbank_recurring_withdrawals_account_id ON gasbank_recurring_withdrawals (account_id);
+CREATE INDEX ix_gasbank_recurring_withdrawals_status_next_run_at ON gasbank_recurring_withdrawals (status, next_run_at);
+
+CREATE TABLE gasbank_recurring_withdrawal_occurrences (
+    id uuid PRIMARY KEY,
+    recurring_withdrawal_id uuid NOT NULL,
+    scheduled_for timestamptz NOT NULL,
+    status text NOT NULL,
+    attempts integer NOT NULL DEFAULT 0,
+    amount numeric NOT NULL DEFAULT 0,
+    withdrawal_id uuid,
+    error text,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL,
+    UNIQUE (recurring_withdrawal_id, scheduled_for)
+);
+")
+        };
+
+        private readonly ILogger<PostgreSqlGasBankStore> _logger;
+        private readonly NpgsqlDataSource _dataSource;
+        private readonly SemaphoreSlim _migrationLock = new SemaphoreSlim(1, 1);
+        private volatile bool _migrated;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PostgreSqlGasBankStore"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="configuration">GasBank configuration</param>
+        public PostgreSqlGasBankStore(ILogger<PostgreSqlGasBankStore> logger, IOptions<GasBankConfiguration> configuration)
+        {
+            var connectionString = configuration.Value.PostgreSqlConnectionString;
+            if (string.IsNullOrEmpty(connectionString))
+            {
+                throw new InvalidOperationException("GasBank:PostgreSqlConnectionString is required when GasBank:StorageBackend is PostgreSql");
+            }
+
+            _logger = logger;
+            _dataSource = NpgsqlDataSource.Create(connectionString);
+        }
+
+        /// <summary>
+        /// Gets the latest schema version
+        /// </summary>
+        public static int SchemaVersion => Migrations[^1].Version;
+
+        /// <summary>
+        /// Opens a connection, migrating the schema first if this instance has not yet done so
+        /// </summary>
+        /// <returns>The open connection</returns>
+        public async Task<NpgsqlConnection> OpenConnectionAsync()
+        {
+            if (!_migrated)
+            {
+                await MigrateAsync();
+            }
+
+            return await _dataSource.OpenConnectionAsync();
+        }
+
+        /// <summary>
+        /// Applies pending schema migrations
+        /// </summary>
+        /// <returns>Task representing the asynchronous operation</returns>
+        public async Task MigrateAsync()
+        {
+            await _migrationLock.WaitAsync();
+            try
+            {
+                if (_migrated)
+                {
+                    return;
+                }
+
+                await using var connection = await _dataSource.OpenConnectionAsync();
+                await using var transaction = await connection.BeginTransactionAsync();
+
+                await ExecuteAsync(connection, transaction, $"SELECT pg_advisory_xact_lock({MigrationLockKey})");
+                await ExecuteAsync(connection, transaction, @"
+CREATE TABLE IF NOT EXISTS gasbank_schema_migrations (
+    version integer PRIMARY KEY,
+    description text NOT NULL,
+    applied_at timestamptz NOT NULL
+)");
+
+                int currentVersion;
+                await using (var command = new NpgsqlCommand("SELECT COALESCE(MAX(version), 0) FROM gasbank_schema_migrations", connection, transaction))
+                {
+                    currentVersion = Convert.ToInt32(await command.ExecuteScalarAsync());
+                }
+
+                foreach (var migration in Migrations)
+                {
+                    if (migration.Version <= currentVersion)
+                    {
+                        continue;
+                    }
+
+                    _logger.LogInformation("Applying GasBank schema migration {Version}: {Description}", migration.Version, migration.Description);
+
+                    await ExecuteAsync(connection, transaction, migration.Sql);
+                    await using var record = new NpgsqlCommand(
+                        "INSERT INTO gasbank_schema_migrations (version, description, applied_at) VALUES (@version, @description, @appliedAt)",
+                        connection,
+                        transaction);
+                    record.Parameters.AddWithValue("version", migration.Version);
+                    record.Parameters.AddWithValue("description", migration.Description);
+                    record.Parameters.AddWithValue("appliedAt", DateTime.UtcNow);
+                    await record.ExecuteNonQueryAsync();
+                }
+
+                await transaction.CommitAsync();
+                _migrated = true;
+            }
+            finally
+            {
+                _migrationLock.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public void Dispose()
+        {
+            _dataSource.Dispose();
+            _migrationLock.Dispose();
+        }
+
+        /// <summary>
+        /// Adds a parameter to a command, passing null as a database NULL
+        /// </summary>
+        /// <param name="command">Command</param>
+        /// <param name="name">Parameter name</param>
+        /// <param name="value">Parameter value</param>
+        internal static void AddParameter(NpgsqlCommand command, string name, object value)
+        {
+            if (value is DateTime dateTime)
+            {
+                // timestamptz only accepts UTC values
+                value = dateTime.Kind switch
+                {
+                    DateTimeKind.Local => dateTime.ToUniversalTime(),
+                    DateTimeKind.Unspecified => DateTime.SpecifyKind(dateTime, DateTimeKind.Utc),
+                    _ => dateTime
+                };
+            }
+
+            command.Parameters.AddWithValue(name, value ?? DBNull.Value);
+        }
+
+        /// <summary>
+        /// Reads a nullable column
+        /// </summary>
+        /// <typeparam name="T">Column type</typeparam>
+        /// <param name="reader">Reader</param>
+        /// <param name="name">Column name</param>
+        /// <returns>The column value, or the default of <typeparamref name="T"/> for NULL</returns>
+        internal static T GetNullable<T>(NpgsqlDataReader reader, string name)
+        {
+            var ordinal = reader.GetOrdinal(name);
+            return reader.IsDBNull(ordinal) ? default : reader.GetFieldValue<T>(ordinal);
+        }
+
+        private static async Task ExecuteAsync(NpgsqlConnection connection, NpgsqlTransaction transaction, string sql)
+        {
+            await using var command = new NpgsqlCommand(sql, connection, transaction);
+            await command.ExecuteNonQueryAsync();
+        }
+    }
+}
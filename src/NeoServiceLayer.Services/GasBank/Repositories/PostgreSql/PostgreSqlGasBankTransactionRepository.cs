@@ -0,0 +1,144 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using Npgsql;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories.PostgreSql
+{
+    /// <summary>
+    /// PostgreSQL implementation of the GasBank transaction repository
+    /// </summary>
+    public class PostgreSqlGasBankTransactionRepository : IGasBankTransactionRepository
+    {
+        private const string SelectColumns = "SELECT id, gasbank_account_id, type, amount, balance_after, transaction_hash, related_entity_id, neo_address, timestamp, description FROM gasbank_transactions";
+
+        private readonly PostgreSqlGasBankStore _store;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PostgreSqlGasBankTransactionRepository"/> class
+        /// </summary>
+        /// <param name="store">GasBank PostgreSQL store</param>
+        public PostgreSqlGasBankTransactionRepository(PostgreSqlGasBankStore store)
+        {
+            _store = store;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankTransaction> CreateAsync(GasBankTransaction transaction)
+        {
+            ValidationUtility.ValidateNotNull(transaction, nameof(transaction));
+            ValidationUtility.ValidateGuid(transaction.Id, "GasBank transaction ID");
+            ValidationUtility.ValidateGuid(transaction.GasBankAccountId, "GasBank account ID");
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await InsertAsync(connection, null, transaction);
+
+            return transaction;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankTransaction> GetByIdAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "GasBank transaction ID");
+
+            var transactions = await QueryAsync($"{SelectColumns} WHERE id = @id", command => command.Parameters.AddWithValue("id", id));
+            return transactions.Count > 0 ? transactions[0] : null;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankTransaction>> GetByGasBankAccountIdAsync(Guid gasBankAccountId)
+        {
+            ValidationUtility.ValidateGuid(gasBankAccountId, "GasBank account ID");
+
+            return await QueryAsync(
+                $"{SelectColumns} WHERE gasbank_account_id = @gasBankAccountId ORDER BY timestamp DESC",
+                command => command.Parameters.AddWithValue("gasBankAccountId", gasBankAccountId));
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankTransaction>> GetByGasBankAccountIdAndTimeRangeAsync(Guid gasBankAccountId, DateTime startTime, DateTime endTime)
+        {
+            ValidationUtility.ValidateGuid(gasBankAccountId, "GasBank account ID");
+            if (endTime < startTime)
+            {
+                throw new ArgumentException("End time must be greater than or equal to start time");
+            }
+
+            return await QueryAsync(
+                $"{SelectColumns} WHERE gasbank_account_id = @gasBankAccountId AND timestamp >= @startTime AND timestamp <= @endTime ORDER BY timestamp DESC",
+                command =>
+                {
+                    command.Parameters.AddWithValue("gasBankAccountId", gasBankAccountId);
+                    PostgreSqlGasBankStore.AddParameter(command, "startTime", startTime);
+                    PostgreSqlGasBankStore.AddParameter(command, "endTime", endTime);
+                });
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankTransaction>> GetByFunctionIdAsync(Guid functionId)
+        {
+            ValidationUtility.ValidateGuid(functionId, "Function ID");
+
+            return await QueryAsync(
+                $"{SelectColumns} WHERE related_entity_id = @functionId ORDER BY timestamp DESC",
+                command => command.Parameters.AddWithValue("functionId", functionId));
+        }
+
+        /// <summary>
+        /// Inserts a transaction, optionally as part of a database transaction
+        /// </summary>
+        /// <param name="connection">Open connection</param>
+        /// <param name="dbTransaction">Database transaction, or null</param>
+        /// <param name="transaction">GasBank transaction to insert</param>
+        /// <returns>Task representing the asynchronous operation</returns>
+        internal static async Task InsertAsync(NpgsqlConnection connection, NpgsqlTransaction dbTransaction, GasBankTransaction transaction)
+        {
+            await using var command = new NpgsqlCommand(@"
+INSERT INTO gasbank_transactions (id, gasbank_account_id, type, amount, balance_after, transaction_hash, related_entity_id, neo_address, timestamp, description)
+VALUES (@id, @gasBankAccountId, @type, @amount, @balanceAfter, @transactionHash, @relatedEntityId, @neoAddress, @timestamp, @description)",
+                connection,
+                dbTransaction);
+            PostgreSqlGasBankStore.AddParameter(command, "id", transaction.Id);
+            PostgreSqlGasBankStore.AddParameter(command, "gasBankAccountId", transaction.GasBankAccountId);
+            PostgreSqlGasBankStore.AddParameter(command, "type", transaction.Type.ToString());
+            PostgreSqlGasBankStore.AddParameter(command, "amount", transaction.Amount);
+            PostgreSqlGasBankStore.AddParameter(command, "balanceAfter", transaction.BalanceAfter);
+            PostgreSqlGasBankStore.AddParameter(command, "transactionHash", transaction.TransactionHash);
+            PostgreSqlGasBankStore.AddParameter(command, "relatedEntityId", transaction.RelatedEntityId);
+            PostgreSqlGasBankStore.AddParameter(command, "neoAddress", transaction.NeoAddress);
+            PostgreSqlGasBankStore.AddParameter(command, "timestamp", transaction.Timestamp);
+            PostgreSqlGasBankStore.AddParameter(command, "description", transaction.Description);
+            await command.ExecuteNonQueryAsync();
+        }
+
+        private async Task<List<GasBankTransaction>> QueryAsync(string sql, Action<NpgsqlCommand> addParameters)
+        {
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(sql, connection);
+            addParameters(command);
+
+            var transactions = new List<GasBankTransaction>();
+            await using var reader = await command.ExecuteReaderAsync();
+            while (await reader.ReadAsync())
+            {
+                transactions.Add(new GasBankTransaction
+                {
+                    Id = reader.GetGuid(reader.GetOrdinal("id")),
+                    GasBankAccountId = reader.GetGuid(reader.GetOrdinal("gasbank_account_id")),
+                    Type = Enum.Parse<GasBankTransactionType>(reader.GetString(reader.GetOrdinal("type"))),
+                    Amount = reader.GetDecimal(reader.GetOrdinal("amount")),
+                    BalanceAfter = reader.GetDecimal(reader.GetOrdinal("balance_after")),
+                    TransactionHash = PostgreSqlGasBankStore.GetNullable<string>(reader, "transaction_hash"),
+                    RelatedEntityId = PostgreSqlGasBankStore.GetNullable<Guid?>(reader, "related_entity_id"),
+                    NeoAddress = PostgreSqlGasBankStore.GetNullable<string>(reader, "neo_address"),
+                    Timestamp = reader.GetDateTime(reader.GetOrdinal("timestamp")),
+                    Description = PostgreSqlGasBankStore.GetNullable<string>(reader, "description")
+                });
+            }
+
+            return transactions;
+        }
+    }
+}
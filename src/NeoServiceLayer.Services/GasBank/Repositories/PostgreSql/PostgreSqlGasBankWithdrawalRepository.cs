@@ -0,0 +1,162 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using Npgsql;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories.PostgreSql
+{
+    /// <summary>
+    /// PostgreSQL implementation of the GasBank withdrawal repository
+    /// </summary>
+    public class PostgreSqlGasBankWithdrawalRepository : IGasBankWithdrawalRepository
+    {
+        private const string SelectColumns = "SELECT id, gasbank_account_id, amount, to_address, status, transaction_hash, requested_at, completed_at, confirmed_at, attempts, next_attempt_at, last_error, status_reason FROM gasbank_withdrawals";
+
+        private readonly PostgreSqlGasBankStore _store;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PostgreSqlGasBankWithdrawalRepository"/> class
+        /// </summary>
+        /// <param name="store">GasBank PostgreSQL store</param>
+        public PostgreSqlGasBankWithdrawalRepository(PostgreSqlGasBankStore store)
+        {
+            _store = store;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankWithdrawal> CreateAsync(GasBankWithdrawal withdrawal)
+        {
+            ValidationUtility.ValidateNotNull(withdrawal, nameof(withdrawal));
+            ValidationUtility.ValidateGuid(withdrawal.Id, "GasBank withdrawal ID");
+            ValidationUtility.ValidateGuid(withdrawal.GasBankAccountId, "GasBank account ID");
+
+            if (withdrawal.RequestedAt == default)
+            {
+                withdrawal.RequestedAt = DateTime.UtcNow;
+            }
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+INSERT INTO gasbank_withdrawals (id, gasbank_account_id, amount, to_address, status, transaction_hash, requested_at, completed_at,
+    confirmed_at, attempts, next_attempt_at, last_error, status_reason)
+VALUES (@id, @gasBankAccountId, @amount, @toAddress, @status, @transactionHash, @requestedAt, @completedAt,
+    @confirmedAt, @attempts, @nextAttemptAt, @lastError, @statusReason)",
+                connection);
+            AddParameters(command, withdrawal);
+            await command.ExecuteNonQueryAsync();
+
+            return withdrawal;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankWithdrawal> GetByIdAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "GasBank withdrawal ID");
+
+            var withdrawals = await QueryAsync($"{SelectColumns} WHERE id = @id", command => command.Parameters.AddWithValue("id", id));
+            return withdrawals.Count > 0 ? withdrawals[0] : null;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasBankWithdrawal>> GetByStatusAsync(GasBankWithdrawalStatus status)
+        {
+            return await QueryAsync(
+                $"{SelectColumns} WHERE status = @status ORDER BY requested_at",
+                command => command.Parameters.AddWithValue("status", status.ToString()));
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasBankWithdrawal> UpdateAsync(GasBankWithdrawal withdrawal)
+        {
+            ValidationUtility.ValidateNotNull(withdrawal, nameof(withdrawal));
+            ValidationUtility.ValidateGuid(withdrawal.Id, "GasBank withdrawal ID");
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+UPDATE gasbank_withdrawals
+SET gasbank_account_id = @gasBankAccountId, amount = @amount, to_address = @toAddress, status = @status,
+    transaction_hash = @transactionHash, requested_at = @requestedAt, completed_at = @completedAt, confirmed_at = @confirmedAt,
+    attempts = @attempts, next_attempt_at = @nextAttemptAt, last_error = @lastError, status_reason = @statusReason
+WHERE id = @id",
+                connection);
+            AddParameters(command, withdrawal);
+
+            if (await command.ExecuteNonQueryAsync() == 0)
+            {
+                throw new InvalidOperationException($"GasBank withdrawal {withdrawal.Id} not found");
+            }
+
+            return withdrawal;
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> UpdateAsync(GasBankWithdrawal withdrawal, GasBankWithdrawalStatus expectedStatus)
+        {
+            ValidationUtility.ValidateNotNull(withdrawal, nameof(withdrawal));
+            ValidationUtility.ValidateGuid(withdrawal.Id, "GasBank withdrawal ID");
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+UPDATE gasbank_withdrawals
+SET gasbank_account_id = @gasBankAccountId, amount = @amount, to_address = @toAddress, status = @status,
+    transaction_hash = @transactionHash, requested_at = @requestedAt, completed_at = @completedAt, confirmed_at = @confirmedAt,
+    attempts = @attempts, next_attempt_at = @nextAttemptAt, last_error = @lastError, status_reason = @statusReason
+WHERE id = @id AND status = @expectedStatus",
+                connection);
+            AddParameters(command, withdrawal);
+            command.Parameters.AddWithValue("expectedStatus", expectedStatus.ToString());
+
+            return await command.ExecuteNonQueryAsync() > 0;
+        }
+
+        private static void AddParameters(NpgsqlCommand command, GasBankWithdrawal withdrawal)
+        {
+            PostgreSqlGasBankStore.AddParameter(command, "id", withdrawal.Id);
+            PostgreSqlGasBankStore.AddParameter(command, "gasBankAccountId", withdrawal.GasBankAccountId);
+            PostgreSqlGasBankStore.AddParameter(command, "amount", withdrawal.Amount);
+            PostgreSqlGasBankStore.AddParameter(command, "toAddress", withdrawal.ToAddress);
+            PostgreSqlGasBankStore.AddParameter(command, "status", withdrawal.Status.ToString());
+            PostgreSqlGasBankStore.AddParameter(command, "transactionHash", withdrawal.TransactionHash);
+            PostgreSqlGasBankStore.AddParameter(command, "requestedAt", withdrawal.RequestedAt);
+            PostgreSqlGasBankStore.AddParameter(command, "completedAt", withdrawal.CompletedAt);
+            PostgreSqlGasBankStore.AddParameter(command, "confirmedAt", withdrawal.ConfirmedAt);
+            PostgreSqlGasBankStore.AddParameter(command, "attempts", withdrawal.Attempts);
+            PostgreSqlGasBankStore.AddParameter(command, "nextAttemptAt", withdrawal.NextAttemptAt);
+            PostgreSqlGasBankStore.AddParameter(command, "lastError", withdrawal.LastError);
+            PostgreSqlGasBankStore.AddParameter(command, "statusReason", withdrawal.StatusReason);
+        }
+
+        private async Task<List<GasBankWithdrawal>> QueryAsync(string sql, Action<NpgsqlCommand> addParameters)
+        {
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(sql, connection);
+            addParameters(command);
+
+            var withdrawals = new List<GasBankWithdrawal>();
+            await using var reader = await command.ExecuteReaderAsync();
+            while (await reader.ReadAsync())
+            {
+                withdrawals.Add(new GasBankWithdrawal
+                {
+                    Id = reader.GetGuid(reader.GetOrdinal("id")),
+                    GasBankAccountId = reader.GetGuid(reader.GetOrdinal("gasbank_account_id")),
+                    Amount = reader.GetDecimal(reader.GetOrdinal("amount")),
+                    ToAddress = reader.GetString(reader.GetOrdinal("to_address")),
+                    Status = Enum.Parse<GasBankWithdrawalStatus>(reader.GetString(reader.GetOrdinal("status"))),
+                    TransactionHash = PostgreSqlGasBankStore.GetNullable<string>(reader, "transaction_hash"),
+                    RequestedAt = reader.GetDateTime(reader.GetOrdinal("requested_at")),
+                    CompletedAt = PostgreSqlGasBankStore.GetNullable<DateTime?>(reader, "completed_at"),
+                    ConfirmedAt = PostgreSqlGasBankStore.GetNullable<DateTime?>(reader, "confirmed_at"),
+                    Attempts = reader.GetInt32(reader.GetOrdinal("attempts")),
+                    NextAttemptAt = PostgreSqlGasBankStore.GetNullable<DateTime?>(reader, "next_attempt_at"),
+                    LastError = PostgreSqlGasBankStore.GetNullable<string>(reader, "last_error"),
+                    StatusReason = PostgreSqlGasBankStore.GetNullable<string>(reader, "status_reason")
+                });
+            }
+
+            return withdrawals;
+        }
+    }
+}
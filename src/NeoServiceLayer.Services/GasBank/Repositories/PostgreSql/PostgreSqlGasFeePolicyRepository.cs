@@ -0,0 +1,166 @@
+using System;
+using System.Collections.Generic;
+using System.Text.Json;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using Npgsql;
+using NpgsqlTypes;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories.PostgreSql
+{
+    /// <summary>
+    /// PostgreSQL implementation of the GasBank fee policy repository
+    /// </summary>
+    public class PostgreSqlGasFeePolicyRepository : IGasFeePolicyRepository
+    {
+        private const string SelectColumns = @"SELECT id, account_id, sponsor_account_id, gasbank_account_id, template_id, template_version,
+overrides, spent_today, spent_date, created_at, updated_at FROM gasbank_fee_policies";
+
+        private readonly PostgreSqlGasBankStore _store;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PostgreSqlGasFeePolicyRepository"/> class
+        /// </summary>
+        /// <param name="store">GasBank PostgreSQL store</param>
+        public PostgreSqlGasFeePolicyRepository(PostgreSqlGasBankStore store)
+        {
+            _store = store;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicy> CreateAsync(GasFeePolicy policy)
+        {
+            ValidationUtility.ValidateNotNull(policy, nameof(policy));
+            ValidationUtility.ValidateGuid(policy.Id, "Fee policy ID");
+            ValidationUtility.ValidateGuid(policy.AccountId, "Account ID");
+            ValidationUtility.ValidateGuid(policy.GasBankAccountId, "GasBank account ID");
+            ValidationUtility.ValidateGuid(policy.TemplateId, "Fee policy template ID");
+
+            policy.CreatedAt = DateTime.UtcNow;
+            policy.UpdatedAt = DateTime.UtcNow;
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+INSERT INTO gasbank_fee_policies (id, account_id, sponsor_account_id, gasbank_account_id, template_id, template_version,
+    overrides, spent_today, spent_date, created_at, updated_at)
+VALUES (@id, @accountId, @sponsorAccountId, @gasBankAccountId, @templateId, @templateVersion,
+    @overrides, @spentToday, @spentDate, @createdAt, @updatedAt)",
+                connection);
+            AddParameters(command, policy);
+            await command.ExecuteNonQueryAsync();
+
+            return policy;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicy> GetByIdAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "Fee policy ID");
+
+            var policies = await QueryAsync($"{SelectColumns} WHERE id = @id", command => command.Parameters.AddWithValue("id", id));
+            return policies.Count > 0 ? policies[0] : null;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasFeePolicy>> GetByAccountIdAsync(Guid accountId)
+        {
+            ValidationUtility.ValidateGuid(accountId, "Account ID");
+
+            return await QueryAsync(
+                $"{SelectColumns} WHERE account_id = @accountId OR sponsor_account_id = @accountId ORDER BY created_at",
+                command => command.Parameters.AddWithValue("accountId", accountId));
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasFeePolicy>> GetByTemplateIdAsync(Guid templateId)
+        {
+            ValidationUtility.ValidateGuid(templateId, "Fee policy template ID");
+
+            return await QueryAsync(
+                $"{SelectColumns} WHERE template_id = @templateId",
+                command => command.Parameters.AddWithValue("templateId", templateId));
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicy> UpdateAsync(GasFeePolicy policy)
+        {
+            ValidationUtility.ValidateNotNull(policy, nameof(policy));
+            ValidationUtility.ValidateGuid(policy.Id, "Fee policy ID");
+
+            policy.UpdatedAt = DateTime.UtcNow;
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+UPDATE gasbank_fee_policies
+SET gasbank_account_id = @gasBankAccountId, template_version = @templateVersion, overrides = @overrides,
+    spent_today = @spentToday, spent_date = @spentDate, updated_at = @updatedAt
+WHERE id = @id",
+                connection);
+            AddParameters(command, policy);
+            await command.ExecuteNonQueryAsync();
+
+            return policy;
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "Fee policy ID");
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand("DELETE FROM gasbank_fee_policies WHERE id = @id", connection);
+            command.Parameters.AddWithValue("id", id);
+
+            return await command.ExecuteNonQueryAsync() > 0;
+        }
+
+        private static void AddParameters(NpgsqlCommand command, GasFeePolicy policy)
+        {
+            PostgreSqlGasBankStore.AddParameter(command, "id", policy.Id);
+            PostgreSqlGasBankStore.AddParameter(command, "accountId", policy.AccountId);
+            PostgreSqlGasBankStore.AddParameter(command, "sponsorAccountId", policy.SponsorAccountId);
+            PostgreSqlGasBankStore.AddParameter(command, "gasBankAccountId", policy.GasBankAccountId);
+            PostgreSqlGasBankStore.AddParameter(command, "templateId", policy.TemplateId);
+            PostgreSqlGasBankStore.AddParameter(command, "templateVersion", policy.TemplateVersion);
+            PostgreSqlGasBankStore.AddParameter(command, "spentToday", policy.SpentToday);
+            PostgreSqlGasBankStore.AddParameter(command, "spentDate", policy.SpentDate);
+            PostgreSqlGasBankStore.AddParameter(command, "createdAt", policy.CreatedAt);
+            PostgreSqlGasBankStore.AddParameter(command, "updatedAt", policy.UpdatedAt);
+            command.Parameters.Add(new NpgsqlParameter("overrides", NpgsqlDbType.Jsonb)
+            {
+                Value = policy.Overrides != null ? JsonSerializer.Serialize(policy.Overrides) : (object)DBNull.Value
+            });
+        }
+
+        private async Task<List<GasFeePolicy>> QueryAsync(string sql, Action<NpgsqlCommand> addParameters)
+        {
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(sql, connection);
+            addParameters(command);
+
+            var policies = new List<GasFeePolicy>();
+            await using var reader = await command.ExecuteReaderAsync();
+            while (await reader.ReadAsync())
+            {
+                var overrides = PostgreSqlGasBankStore.GetNullable<string>(reader, "overrides");
+                policies.Add(new GasFeePolicy
+                {
+                    Id = reader.GetGuid(reader.GetOrdinal("id")),
+                    AccountId = reader.GetGuid(reader.GetOrdinal("account_id")),
+                    SponsorAccountId = reader.GetGuid(reader.GetOrdinal("sponsor_account_id")),
+                    GasBankAccountId = reader.GetGuid(reader.GetOrdinal("gasbank_account_id")),
+                    TemplateId = reader.GetGuid(reader.GetOrdinal("template_id")),
+                    TemplateVersion = PostgreSqlGasBankStore.GetNullable<int?>(reader, "template_version"),
+                    Overrides = overrides != null ? JsonSerializer.Deserialize<GasFeePolicyRules>(overrides) : new GasFeePolicyRules(),
+                    SpentToday = reader.GetDecimal(reader.GetOrdinal("spent_today")),
+                    SpentDate = PostgreSqlGasBankStore.GetNullable<DateTime?>(reader, "spent_date"),
+                    CreatedAt = reader.GetDateTime(reader.GetOrdinal("created_at")),
+                    UpdatedAt = reader.GetDateTime(reader.GetOrdinal("updated_at"))
+                });
+            }
+
+            return policies;
+        }
+    }
+}
@@ -0,0 +1,135 @@
+using System;
+using System.Collections.Generic;
+using System.Text.Json;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using Npgsql;
+using NpgsqlTypes;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories.PostgreSql
+{
+    /// <summary>
+    /// PostgreSQL implementation of the GasBank fee policy template repository
+    /// </summary>
+    public class PostgreSqlGasFeePolicyTemplateRepository : IGasFeePolicyTemplateRepository
+    {
+        private const string SelectColumns = @"SELECT id, owner_account_id, name, description, is_public, latest_version, versions,
+created_at, updated_at FROM gasbank_fee_policy_templates";
+
+        private readonly PostgreSqlGasBankStore _store;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PostgreSqlGasFeePolicyTemplateRepository"/> class
+        /// </summary>
+        /// <param name="store">GasBank PostgreSQL store</param>
+        public PostgreSqlGasFeePolicyTemplateRepository(PostgreSqlGasBankStore store)
+        {
+            _store = store;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicyTemplate> CreateAsync(GasFeePolicyTemplate template)
+        {
+            ValidationUtility.ValidateNotNull(template, nameof(template));
+            ValidationUtility.ValidateGuid(template.Id, "Fee policy template ID");
+            ValidationUtility.ValidateGuid(template.OwnerAccountId, "Owner account ID");
+
+            template.CreatedAt = DateTime.UtcNow;
+            template.UpdatedAt = DateTime.UtcNow;
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+INSERT INTO gasbank_fee_policy_templates (id, owner_account_id, name, description, is_public, latest_version, versions, created_at, updated_at)
+VALUES (@id, @ownerAccountId, @name, @description, @isPublic, @latestVersion, @versions, @createdAt, @updatedAt)",
+                connection);
+            AddParameters(command, template);
+            await command.ExecuteNonQueryAsync();
+
+            return template;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasFeePolicyTemplate> GetByIdAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "Fee policy template ID");
+
+            var templates = await QueryAsync($"{SelectColumns} WHERE id = @id", command => command.Parameters.AddWithValue("id", id));
+            return templates.Count > 0 ? templates[0] : null;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasFeePolicyTemplate>> GetVisibleToAsync(Guid accountId)
+        {
+            ValidationUtility.ValidateGuid(accountId, "Account ID");
+
+            return await QueryAsync(
+                $"{SelectColumns} WHERE owner_account_id = @accountId OR is_public ORDER BY name",
+                command => command.Parameters.AddWithValue("accountId", accountId));
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> UpdateAsync(GasFeePolicyTemplate template, int expectedLatestVersion)
+        {
+            ValidationUtility.ValidateNotNull(template, nameof(template));
+            ValidationUtility.ValidateGuid(template.Id, "Fee policy template ID");
+
+            template.UpdatedAt = DateTime.UtcNow;
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+UPDATE gasbank_fee_policy_templates
+SET name = @name, description = @description, is_public = @isPublic, latest_version = @latestVersion, versions = @versions,
+    updated_at = @updatedAt
+WHERE id = @id AND latest_version = @expectedLatestVersion",
+                connection);
+            AddParameters(command, template);
+            command.Parameters.AddWithValue("expectedLatestVersion", expectedLatestVersion);
+
+            return await command.ExecuteNonQueryAsync() > 0;
+        }
+
+        private static void AddParameters(NpgsqlCommand command, GasFeePolicyTemplate template)
+        {
+            PostgreSqlGasBankStore.AddParameter(command, "id", template.Id);
+            PostgreSqlGasBankStore.AddParameter(command, "ownerAccountId", template.OwnerAccountId);
+            PostgreSqlGasBankStore.AddParameter(command, "name", template.Name);
+            PostgreSqlGasBankStore.AddParameter(command, "description", template.Description);
+            PostgreSqlGasBankStore.AddParameter(command, "isPublic", template.IsPublic);
+            PostgreSqlGasBankStore.AddParameter(command, "latestVersion", template.LatestVersion);
+            PostgreSqlGasBankStore.AddParameter(command, "createdAt", template.CreatedAt);
+            PostgreSqlGasBankStore.AddParameter(command, "updatedAt", template.UpdatedAt);
+            command.Parameters.Add(new NpgsqlParameter("versions", NpgsqlDbType.Jsonb)
+            {
+                Value = JsonSerializer.Serialize(template.Versions ?? new List<GasFeePolicyTemplateVersion>())
+            });
+        }
+
+        private async Task<List<GasFeePolicyTemplate>> QueryAsync(string sql, Action<NpgsqlCommand> addParameters)
+        {
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(sql, connection);
+            addParameters(command);
+
+            var templates = new List<GasFeePolicyTemplate>();
+            await using var reader = await command.ExecuteReaderAsync();
+            while (await reader.ReadAsync())
+            {
+                templates.Add(new GasFeePolicyTemplate
+                {
+                    Id = reader.GetGuid(reader.GetOrdinal("id")),
+                    OwnerAccountId = reader.GetGuid(reader.GetOrdinal("owner_account_id")),
+                    Name = reader.GetString(reader.GetOrdinal("name")),
+                    Description = PostgreSqlGasBankStore.GetNullable<string>(reader, "description"),
+                    IsPublic = reader.GetBoolean(reader.GetOrdinal("is_public")),
+                    LatestVersion = reader.GetInt32(reader.GetOrdinal("latest_version")),
+                    Versions = JsonSerializer.Deserialize<List<GasFeePolicyTemplateVersion>>(reader.GetString(reader.GetOrdinal("versions"))),
+                    CreatedAt = reader.GetDateTime(reader.GetOrdinal("created_at")),
+                    UpdatedAt = reader.GetDateTime(reader.GetOrdinal("updated_at"))
+                });
+            }
+
+            return templates;
+        }
+    }
+}
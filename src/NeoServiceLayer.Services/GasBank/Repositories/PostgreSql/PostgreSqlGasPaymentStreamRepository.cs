@@ -0,0 +1,165 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using Npgsql;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories.PostgreSql
+{
+    /// <summary>
+    /// PostgreSQL implementation of the GasBank payment stream repository
+    /// </summary>
+    public class PostgreSqlGasPaymentStreamRepository : IGasPaymentStreamRepository
+    {
+        private const string SelectColumns = @"SELECT id, payer_gasbank_account_id, payer_account_id, payee_gasbank_account_id, payee_account_id,
+rate_per_second, cap, description, status, started_at, expires_at, closed_at, closed_by, accrued_amount,
+settlement_transaction_hash, settled_at, last_error, created_at, updated_at FROM gasbank_payment_streams";
+
+        private readonly PostgreSqlGasBankStore _store;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PostgreSqlGasPaymentStreamRepository"/> class
+        /// </summary>
+        /// <param name="store">GasBank PostgreSQL store</param>
+        public PostgreSqlGasPaymentStreamRepository(PostgreSqlGasBankStore store)
+        {
+            _store = store;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasPaymentStream> CreateAsync(GasPaymentStream stream)
+        {
+            ValidationUtility.ValidateNotNull(stream, nameof(stream));
+            ValidationUtility.ValidateGuid(stream.Id, "Payment stream ID");
+            ValidationUtility.ValidateGuid(stream.PayerGasBankAccountId, "Payer GasBank account ID");
+            ValidationUtility.ValidateGuid(stream.PayeeGasBankAccountId, "Payee GasBank account ID");
+
+            stream.CreatedAt = DateTime.UtcNow;
+            stream.UpdatedAt = DateTime.UtcNow;
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+INSERT INTO gasbank_payment_streams (id, payer_gasbank_account_id, payer_account_id, payee_gasbank_account_id, payee_account_id,
+    rate_per_second, cap, description, status, started_at, expires_at, closed_at, closed_by, accrued_amount,
+    settlement_transaction_hash, settled_at, last_error, created_at, updated_at)
+VALUES (@id, @payerGasBankAccountId, @payerAccountId, @payeeGasBankAccountId, @payeeAccountId,
+    @ratePerSecond, @cap, @description, @status, @startedAt, @expiresAt, @closedAt, @closedBy, @accruedAmount,
+    @settlementTransactionHash, @settledAt, @lastError, @createdAt, @updatedAt)",
+                connection);
+            AddParameters(command, stream);
+            await command.ExecuteNonQueryAsync();
+
+            return stream;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasPaymentStream> GetByIdAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "Payment stream ID");
+
+            var streams = await QueryAsync($"{SelectColumns} WHERE id = @id", command => command.Parameters.AddWithValue("id", id));
+            return streams.Count > 0 ? streams[0] : null;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasPaymentStream>> GetByAccountIdAsync(Guid accountId)
+        {
+            ValidationUtility.ValidateGuid(accountId, "Account ID");
+
+            return await QueryAsync(
+                $"{SelectColumns} WHERE payer_account_id = @accountId OR payee_account_id = @accountId ORDER BY created_at DESC",
+                command => command.Parameters.AddWithValue("accountId", accountId));
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasPaymentStream>> GetByStatusAsync(GasPaymentStreamStatus status)
+        {
+            return await QueryAsync(
+                $"{SelectColumns} WHERE status = @status ORDER BY expires_at",
+                command => command.Parameters.AddWithValue("status", status.ToString()));
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> UpdateAsync(GasPaymentStream stream, GasPaymentStreamStatus expectedStatus)
+        {
+            ValidationUtility.ValidateNotNull(stream, nameof(stream));
+            ValidationUtility.ValidateGuid(stream.Id, "Payment stream ID");
+
+            stream.UpdatedAt = DateTime.UtcNow;
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+UPDATE gasbank_payment_streams
+SET status = @status, closed_at = @closedAt, closed_by = @closedBy, accrued_amount = @accruedAmount,
+    settlement_transaction_hash = @settlementTransactionHash, settled_at = @settledAt, last_error = @lastError,
+    updated_at = @updatedAt
+WHERE id = @id AND status = @expectedStatus",
+                connection);
+            AddParameters(command, stream);
+            command.Parameters.AddWithValue("expectedStatus", expectedStatus.ToString());
+
+            return await command.ExecuteNonQueryAsync() > 0;
+        }
+
+        private static void AddParameters(NpgsqlCommand command, GasPaymentStream stream)
+        {
+            PostgreSqlGasBankStore.AddParameter(command, "id", stream.Id);
+            PostgreSqlGasBankStore.AddParameter(command, "payerGasBankAccountId", stream.PayerGasBankAccountId);
+            PostgreSqlGasBankStore.AddParameter(command, "payerAccountId", stream.PayerAccountId);
+            PostgreSqlGasBankStore.AddParameter(command, "payeeGasBankAccountId", stream.PayeeGasBankAccountId);
+            PostgreSqlGasBankStore.AddParameter(command, "payeeAccountId", stream.PayeeAccountId);
+            PostgreSqlGasBankStore.AddParameter(command, "ratePerSecond", stream.RatePerSecond);
+            PostgreSqlGasBankStore.AddParameter(command, "cap", stream.Cap);
+            PostgreSqlGasBankStore.AddParameter(command, "description", stream.Description);
+            PostgreSqlGasBankStore.AddParameter(command, "status", stream.Status.ToString());
+            PostgreSqlGasBankStore.AddParameter(command, "startedAt", stream.StartedAt);
+            PostgreSqlGasBankStore.AddParameter(command, "expiresAt", stream.ExpiresAt);
+            PostgreSqlGasBankStore.AddParameter(command, "closedAt", stream.ClosedAt);
+            PostgreSqlGasBankStore.AddParameter(command, "closedBy", stream.ClosedBy);
+            PostgreSqlGasBankStore.AddParameter(command, "accruedAmount", stream.AccruedAmount);
+            PostgreSqlGasBankStore.AddParameter(command, "settlementTransactionHash", stream.SettlementTransactionHash);
+            PostgreSqlGasBankStore.AddParameter(command, "settledAt", stream.SettledAt);
+            PostgreSqlGasBankStore.AddParameter(command, "lastError", stream.LastError);
+            PostgreSqlGasBankStore.AddParameter(command, "createdAt", stream.CreatedAt);
+            PostgreSqlGasBankStore.AddParameter(command, "updatedAt", stream.UpdatedAt);
+        }
+
+        private async Task<List<GasPaymentStream>> QueryAsync(string sql, Action<NpgsqlCommand> addParameters)
+        {
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(sql, connection);
+            addParameters(command);
+
+            var streams = new List<GasPaymentStream>();
+            await using var reader = await command.ExecuteReaderAsync();
+            while (await reader.ReadAsync())
+            {
+                streams.Add(new GasPaymentStream
+                {
+                    Id = reader.GetGuid(reader.GetOrdinal("id")),
+                    PayerGasBankAccountId = reader.GetGuid(reader.GetOrdinal("payer_gasbank_account_id")),
+                    PayerAccountId = reader.GetGuid(reader.GetOrdinal("payer_account_id")),
+                    PayeeGasBankAccountId = reader.GetGuid(reader.GetOrdinal("payee_gasbank_account_id")),
+                    PayeeAccountId = reader.GetGuid(reader.GetOrdinal("payee_account_id")),
+                    RatePerSecond = reader.GetDecimal(reader.GetOrdinal("rate_per_second")),
+                    Cap = reader.GetDecimal(reader.GetOrdinal("cap")),
+                    Description = PostgreSqlGasBankStore.GetNullable<string>(reader, "description"),
+                    Status = Enum.Parse<GasPaymentStreamStatus>(reader.GetString(reader.GetOrdinal("status"))),
+                    StartedAt = reader.GetDateTime(reader.GetOrdinal("started_at")),
+                    ExpiresAt = reader.GetDateTime(reader.GetOrdinal("expires_at")),
+                    ClosedAt = PostgreSqlGasBankStore.GetNullable<DateTime?>(reader, "closed_at"),
+                    ClosedBy = PostgreSqlGasBankStore.GetNullable<Guid?>(reader, "closed_by"),
+                    AccruedAmount = reader.GetDecimal(reader.GetOrdinal("accrued_amount")),
+                    SettlementTransactionHash = PostgreSqlGasBankStore.GetNullable<string>(reader, "settlement_transaction_hash"),
+                    SettledAt = PostgreSqlGasBankStore.GetNullable<DateTime?>(reader, "settled_at"),
+                    LastError = PostgreSqlGasBankStore.GetNullable<string>(reader, "last_error"),
+                    CreatedAt = reader.GetDateTime(reader.GetOrdinal("created_at")),
+                    UpdatedAt = reader.GetDateTime(reader.GetOrdinal("updated_at"))
+                });
+            }
+
+            return streams;
+        }
+    }
+}
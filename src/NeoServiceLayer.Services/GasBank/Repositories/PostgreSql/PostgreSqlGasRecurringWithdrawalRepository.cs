@@ -0,0 +1,273 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using Npgsql;
+
+namespace NeoServiceLayer.Services.GasBank.Repositories.PostgreSql
+{
+    /// <summary>
+    /// PostgreSQL implementation of the GasBank recurring withdrawal repository
+    /// </summary>
+    public class PostgreSqlGasRecurringWithdrawalRepository : IGasRecurringWithdrawalRepository
+    {
+        private const string SelectColumns = @"SELECT id, account_id, gasbank_account_id, to_address, schedule, amount, floor, minimum_amount,
+description, status, next_run_at, last_run_at, last_error, created_at, updated_at FROM gasbank_recurring_withdrawals";
+
+        private const string SelectOccurrenceColumns = @"SELECT id, recurring_withdrawal_id, scheduled_for, status, attempts, amount,
+withdrawal_id, error, created_at, updated_at FROM gasbank_recurring_withdrawal_occurrences";
+
+        private readonly PostgreSqlGasBankStore _store;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PostgreSqlGasRecurringWithdrawalRepository"/> class
+        /// </summary>
+        /// <param name="store">GasBank PostgreSQL store</param>
+        public PostgreSqlGasRecurringWithdrawalRepository(PostgreSqlGasBankStore store)
+        {
+            _store = store;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasRecurringWithdrawal> CreateAsync(GasRecurringWithdrawal withdrawal)
+        {
+            ValidationUtility.ValidateNotNull(withdrawal, nameof(withdrawal));
+            ValidationUtility.ValidateGuid(withdrawal.Id, "Recurring withdrawal ID");
+            ValidationUtility.ValidateGuid(withdrawal.GasBankAccountId, "GasBank account ID");
+
+            withdrawal.CreatedAt = DateTime.UtcNow;
+            withdrawal.UpdatedAt = DateTime.UtcNow;
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+INSERT INTO gasbank_recurring_withdrawals (id, account_id, gasbank_account_id, to_address, schedule, amount, floor, minimum_amount,
+    description, status, next_run_at, last_run_at, last_error, created_at, updated_at)
+VALUES (@id, @accountId, @gasBankAccountId, @toAddress, @schedule, @amount, @floor, @minimumAmount,
+    @description, @status, @nextRunAt, @lastRunAt, @lastError, @createdAt, @updatedAt)",
+                connection);
+            AddParameters(command, withdrawal);
+            await command.ExecuteNonQueryAsync();
+
+            return withdrawal;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasRecurringWithdrawal> GetByIdAsync(Guid id)
+        {
+            ValidationUtility.ValidateGuid(id, "Recurring withdrawal ID");
+
+            var withdrawals = await QueryAsync($"{SelectColumns} WHERE id = @id", command => command.Parameters.AddWithValue("id", id));
+            return withdrawals.Count > 0 ? withdrawals[0] : null;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasRecurringWithdrawal>> GetByAccountIdAsync(Guid accountId)
+        {
+            ValidationUtility.ValidateGuid(accountId, "Account ID");
+
+            return await QueryAsync(
+                $"{SelectColumns} WHERE account_id = @accountId ORDER BY created_at DESC",
+                command => command.Parameters.AddWithValue("accountId", accountId));
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasRecurringWithdrawal>> GetDueAsync(DateTime now)
+        {
+            return await QueryAsync(
+                $"{SelectColumns} WHERE status = @status AND next_run_at <= @now ORDER BY next_run_at",
+                command =>
+                {
+                    command.Parameters.AddWithValue("status", GasRecurringWithdrawalStatus.Active.ToString());
+                    PostgreSqlGasBankStore.AddParameter(command, "now", now);
+                });
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> UpdateAsync(GasRecurringWithdrawal withdrawal, GasRecurringWithdrawalStatus expectedStatus)
+        {
+            ValidationUtility.ValidateNotNull(withdrawal, nameof(withdrawal));
+            ValidationUtility.ValidateGuid(withdrawal.Id, "Recurring withdrawal ID");
+
+            withdrawal.UpdatedAt = DateTime.UtcNow;
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+UPDATE gasbank_recurring_withdrawals
+SET status = @status, next_run_at = @nextRunAt, last_run_at = @lastRunAt, last_error = @lastError, updated_at = @updatedAt
+WHERE id = @id AND status = @expectedStatus",
+                connection);
+            AddParameters(command, withdrawal);
+            command.Parameters.AddWithValue("expectedStatus", expectedStatus.ToString());
+
+            return await command.ExecuteNonQueryAsync() > 0;
+        }
+
+        /// <inheritdoc/>
+        public async Task UpdateRunAsync(GasRecurringWithdrawal withdrawal)
+        {
+            ValidationUtility.ValidateNotNull(withdrawal, nameof(withdrawal));
+            ValidationUtility.ValidateGuid(withdrawal.Id, "Recurring withdrawal ID");
+
+            withdrawal.UpdatedAt = DateTime.UtcNow;
+
+            // A withdrawal paused during the run is rescheduled when it is resumed
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+UPDATE gasbank_recurring_withdrawals
+SET next_run_at = CASE WHEN status = @activeStatus THEN @nextRunAt ELSE next_run_at END,
+    last_run_at = @lastRunAt, last_error = @lastError, updated_at = @updatedAt
+WHERE id = @id",
+                connection);
+            AddParameters(command, withdrawal);
+            command.Parameters.AddWithValue("activeStatus", GasRecurringWithdrawalStatus.Active.ToString());
+            await command.ExecuteNonQueryAsync();
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasRecurringWithdrawalOccurrence> SaveOccurrenceAsync(GasRecurringWithdrawalOccurrence occurrence)
+        {
+            ValidationUtility.ValidateNotNull(occurrence, nameof(occurrence));
+            ValidationUtility.ValidateGuid(occurrence.Id, "Occurrence ID");
+            ValidationUtility.ValidateGuid(occurrence.RecurringWithdrawalId, "Recurring withdrawal ID");
+
+            occurrence.UpdatedAt = DateTime.UtcNow;
+            if (occurrence.CreatedAt == default)
+            {
+                occurrence.CreatedAt = occurrence.UpdatedAt;
+            }
+
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+INSERT INTO gasbank_recurring_withdrawal_occurrences (id, recurring_withdrawal_id, scheduled_for, status, attempts, amount,
+    withdrawal_id, error, created_at, updated_at)
+VALUES (@id, @recurringWithdrawalId, @scheduledFor, @status, @attempts, @amount, @withdrawalId, @error, @createdAt, @updatedAt)
+ON CONFLICT (id) DO UPDATE
+SET status = EXCLUDED.status, attempts = EXCLUDED.attempts, amount = EXCLUDED.amount, withdrawal_id = EXCLUDED.withdrawal_id,
+    error = EXCLUDED.error, updated_at = EXCLUDED.updated_at",
+                connection);
+            PostgreSqlGasBankStore.AddParameter(command, "id", occurrence.Id);
+            PostgreSqlGasBankStore.AddParameter(command, "recurringWithdrawalId", occurrence.RecurringWithdrawalId);
+            PostgreSqlGasBankStore.AddParameter(command, "scheduledFor", occurrence.ScheduledFor);
+            PostgreSqlGasBankStore.AddParameter(command, "status", occurrence.Status.ToString());
+            PostgreSqlGasBankStore.AddParameter(command, "attempts", occurrence.Attempts);
+            PostgreSqlGasBankStore.AddParameter(command, "amount", occurrence.Amount);
+            PostgreSqlGasBankStore.AddParameter(command, "withdrawalId", occurrence.WithdrawalId);
+            PostgreSqlGasBankStore.AddParameter(command, "error", occurrence.Error);
+            PostgreSqlGasBankStore.AddParameter(command, "createdAt", occurrence.CreatedAt);
+            PostgreSqlGasBankStore.AddParameter(command, "updatedAt", occurrence.UpdatedAt);
+            await command.ExecuteNonQueryAsync();
+
+            return occurrence;
+        }
+
+        /// <inheritdoc/>
+        public async Task<GasRecurringWithdrawalOccurrence> GetOccurrenceAsync(Guid recurringWithdrawalId, DateTime scheduledFor)
+        {
+            ValidationUtility.ValidateGuid(recurringWithdrawalId, "Recurring withdrawal ID");
+
+            var occurrences = await QueryOccurrencesAsync(
+                $"{SelectOccurrenceColumns} WHERE recurring_withdrawal_id = @recurringWithdrawalId AND scheduled_for = @scheduledFor",
+                command =>
+                {
+                    command.Parameters.AddWithValue("recurringWithdrawalId", recurringWithdrawalId);
+                    PostgreSqlGasBankStore.AddParameter(command, "scheduledFor", scheduledFor);
+                });
+            return occurrences.Count > 0 ? occurrences[0] : null;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<GasRecurringWithdrawalOccurrence>> GetOccurrencesAsync(Guid recurringWithdrawalId, int limit)
+        {
+            ValidationUtility.ValidateGuid(recurringWithdrawalId, "Recurring withdrawal ID");
+
+            return await QueryOccurrencesAsync(
+                $"{SelectOccurrenceColumns} WHERE recurring_withdrawal_id = @recurringWithdrawalId ORDER BY scheduled_for DESC LIMIT @limit",
+                command =>
+                {
+                    command.Parameters.AddWithValue("recurringWithdrawalId", recurringWithdrawalId);
+                    command.Parameters.AddWithValue("limit", limit);
+                });
+        }
+
+        private static void AddParameters(NpgsqlCommand command, GasRecurringWithdrawal withdrawal)
+        {
+            PostgreSqlGasBankStore.AddParameter(command, "id", withdrawal.Id);
+            PostgreSqlGasBankStore.AddParameter(command, "accountId", withdrawal.AccountId);
+            PostgreSqlGasBankStore.AddParameter(command, "gasBankAccountId", withdrawal.GasBankAccountId);
+            PostgreSqlGasBankStore.AddParameter(command, "toAddress", withdrawal.ToAddress);
+            PostgreSqlGasBankStore.AddParameter(command, "schedule", withdrawal.Schedule);
+            PostgreSqlGasBankStore.AddParameter(command, "amount", withdrawal.Amount);
+            PostgreSqlGasBankStore.AddParameter(command, "floor", withdrawal.Floor);
+            PostgreSqlGasBankStore.AddParameter(command, "minimumAmount", withdrawal.MinimumAmount);
+            PostgreSqlGasBankStore.AddParameter(command, "description", withdrawal.Description);
+            PostgreSqlGasBankStore.AddParameter(command, "status", withdrawal.Status.ToString());
+            PostgreSqlGasBankStore.AddParameter(command, "nextRunAt", withdrawal.NextRunAt);
+            PostgreSqlGasBankStore.AddParameter(command, "lastRunAt", withdrawal.LastRunAt);
+            PostgreSqlGasBankStore.AddParameter(command, "lastError", withdrawal.LastError);
+            PostgreSqlGasBankStore.AddParameter(command, "createdAt", withdrawal.CreatedAt);
+            PostgreSqlGasBankStore.AddParameter(command, "updatedAt", withdrawal.UpdatedAt);
+        }
+
+        private async Task<List<GasRecurringWithdrawal>> QueryAsync(string sql, Action<NpgsqlCommand> addParameters)
+        {
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(sql, connection);
+            addParameters(command);
+
+            var withdrawals = new List<GasRecurringWithdrawal>();
+            await using var reader = await command.ExecuteReaderAsync();
+            while (await reader.ReadAsync())
+            {
+                withdrawals.Add(new GasRecurringWithdrawal
+                {
+                    Id = reader.GetGuid(reader.GetOrdinal("id")),
+                    AccountId = reader.GetGuid(reader.GetOrdinal("account_id")),
+                    GasBankAccountId = reader.GetGuid(reader.GetOrdinal("gasbank_account_id")),
+                    ToAddress = reader.GetString(reader.GetOrdinal("to_address")),
+                    Schedule = reader.GetString(reader.GetOrdinal("schedule")),
+                    Amount = PostgreSqlGasBankStore.GetNullable<decimal?>(reader, "amount"),
+                    Floor = reader.GetDecimal(reader.GetOrdinal("floor")),
+                    MinimumAmount = reader.GetDecimal(reader.GetOrdinal("minimum_amount")),
+                    Description = PostgreSqlGasBankStore.GetNullable<string>(reader, "description"),
+                    Status = Enum.Parse<GasRecurringWithdrawalStatus>(reader.GetString(reader.GetOrdinal("status"))),
+                    NextRunAt = PostgreSqlGasBankStore.GetNullable<DateTime?>(reader, "next_run_at"),
+                    LastRunAt = PostgreSqlGasBankStore.GetNullable<DateTime?>(reader, "last_run_at"),
+                    LastError = PostgreSqlGasBankStore.GetNullable<string>(reader, "last_error"),
+                    CreatedAt = reader.GetDateTime(reader.GetOrdinal("created_at")),
+                    UpdatedAt = reader.GetDateTime(reader.GetOrdinal("updated_at"))
+                });
+            }
+
+            return withdrawals;
+        }
+
+        private async Task<List<GasRecurringWithdrawalOccurrence>> QueryOccurrencesAsync(string sql, Action<NpgsqlCommand> addParameters)
+        {
+            await using var connection = await _store.OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(sql, connection);
+            addParameters(command);
+
+            var occurrences = new List<GasRecurringWithdrawalOccurrence>();
+            await using var reader = await command.ExecuteReaderAsync();
+            while (await reader.ReadAsync())
+            {
+                occurrences.Add(new GasRecurringWithdrawalOccurrence
+                {
+                    Id = reader.GetGuid(reader.GetOrdinal("id")),
+                    RecurringWithdrawalId = reader.GetGuid(reader.GetOrdinal("recurring_withdrawal_id")),
+                    ScheduledFor = reader.GetDateTime(reader.GetOrdinal("scheduled_for")),
+                    Status = Enum.Parse<GasRecurringWithdrawalOccurrenceStatus>(reader.GetString(reader.GetOrdinal("status"))),
+                    Attempts = reader.GetInt32(reader.GetOrdinal("attempts")),
+                    Amount = reader.GetDecimal(reader.GetOrdinal("amount")),
+                    WithdrawalId = PostgreSqlGasBankStore.GetNullable<Guid?>(reader, "withdrawal_id"),
+                    Error = PostgreSqlGasBankStore.GetNullable<string>(reader, "error"),
+                    CreatedAt = reader.GetDateTime(reader.GetOrdinal("created_at")),
+                    UpdatedAt = reader.GetDateTime(reader.GetOrdinal("updated_at"))
+                });
+            }
+
+            return occurrences;
+        }
+    }
+}
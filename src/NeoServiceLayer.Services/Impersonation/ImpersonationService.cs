@@ -0,0 +1,188 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Impersonation.Repositories;
+
+namespace NeoServiceLayer.Services.Impersonation
+{
+    /// <summary>
+    /// Implementation of the support impersonation service
+    /// </summary>
+    /// <remarks>
+    /// Grants and audit entries are persisted and read on every check so that a revocation takes effect
+    /// on all instances at once.
+    /// </remarks>
+    public class ImpersonationService : IImpersonationService
+    {
+        private readonly ILogger<ImpersonationService> _logger;
+        private readonly IImpersonationGrantRepository _grantRepository;
+        private readonly IImpersonationAuditRepository _auditRepository;
+        private readonly ImpersonationConfiguration _configuration;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ImpersonationService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="grantRepository">Impersonation grant repository</param>
+        /// <param name="auditRepository">Impersonation audit repository</param>
+        /// <param name="configuration">Configuration</param>
+        public ImpersonationService(
+            ILogger<ImpersonationService> logger,
+            IImpersonationGrantRepository grantRepository,
+            IImpersonationAuditRepository auditRepository,
+            IOptions<ImpersonationConfiguration> configuration = null)
+        {
+            _logger = logger;
+            _grantRepository = grantRepository;
+            _auditRepository = auditRepository;
+            _configuration = configuration?.Value ?? new ImpersonationConfiguration();
+        }
+
+        /// <inheritdoc/>
+        public async Task<ImpersonationGrant> GrantConsentAsync(Guid accountId, string supportUserId, string reason, int? durationMinutes = null)
+        {
+            if (string.Equals(supportUserId, accountId.ToString(), StringComparison.OrdinalIgnoreCase))
+            {
+                throw new ArgumentException("An account cannot grant access to itself", nameof(supportUserId));
+            }
+
+            var grant = CreateGrant(accountId, supportUserId, reason, durationMinutes, ImpersonationGrantKind.Consent, accountId.ToString());
+
+            _logger.LogWarning("Account {AccountId} consented to impersonation by {SupportUserId} until {ExpiresAt}: {Reason}",
+                accountId, supportUserId, grant.ExpiresAt, grant.Reason);
+
+            return await _grantRepository.CreateAsync(grant);
+        }
+
+        /// <inheritdoc/>
+        public async Task<ImpersonationGrant> ApproveBreakGlassAsync(Guid accountId, string supportUserId, string reason, string approvedBy, int? durationMinutes = null)
+        {
+            if (string.IsNullOrWhiteSpace(reason))
+            {
+                throw new ArgumentException("A reason is required for break-glass access", nameof(reason));
+            }
+
+            if (string.IsNullOrEmpty(approvedBy) || string.Equals(approvedBy, supportUserId, StringComparison.OrdinalIgnoreCase))
+            {
+                throw new ArgumentException("Break-glass access must be approved by someone other than the support user", nameof(approvedBy));
+            }
+
+            var grant = CreateGrant(accountId, supportUserId, reason, durationMinutes, ImpersonationGrantKind.BreakGlass, approvedBy);
+
+            _logger.LogWarning("Break-glass impersonation of account {AccountId} by {SupportUserId} approved by {ApprovedBy} until {ExpiresAt}: {Reason}",
+                accountId, supportUserId, approvedBy, grant.ExpiresAt, grant.Reason);
+
+            return await _grantRepository.CreateAsync(grant);
+        }
+
+        /// <inheritdoc/>
+        public async Task<ImpersonationGrant> RevokeAsync(Guid grantId, string revokedBy, Guid? accountId = null)
+        {
+            var grant = await _grantRepository.GetByIdAsync(grantId);
+            if (grant == null || (accountId.HasValue && grant.AccountId != accountId.Value))
+            {
+                throw new ResourceNotFoundException("ImpersonationGrant", grantId.ToString());
+            }
+
+            if (grant.RevokedAt.HasValue)
+            {
+                return grant;
+            }
+
+            grant.RevokedAt = DateTime.UtcNow;
+            grant.RevokedBy = revokedBy;
+
+            _logger.LogWarning("Impersonation grant {GrantId} for account {AccountId} revoked by {RevokedBy}", grant.Id, grant.AccountId, revokedBy);
+
+            return await _grantRepository.UpdateAsync(grant);
+        }
+
+        /// <inheritdoc/>
+        public async Task<ImpersonationGrant> GetActiveGrantAsync(Guid accountId, string supportUserId)
+        {
+            if (string.IsNullOrEmpty(supportUserId))
+            {
+                return null;
+            }
+
+            var now = DateTime.UtcNow;
+            var grants = await _grantRepository.GetByAccountIdAsync(accountId);
+            return grants
+                .Where(g => string.Equals(g.SupportUserId, supportUserId, StringComparison.OrdinalIgnoreCase) && g.IsActiveAt(now))
+                .OrderByDescending(g => g.ExpiresAt)
+                .FirstOrDefault();
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<ImpersonationGrant>> GetGrantsAsync(Guid accountId)
+        {
+            var grants = await _grantRepository.GetByAccountIdAsync(accountId);
+            return grants.OrderByDescending(g => g.CreatedAt).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<ImpersonationAuditEntry> RecordAsync(ImpersonationAuditEntry entry)
+        {
+            if (entry.Timestamp == default)
+            {
+                entry.Timestamp = DateTime.UtcNow;
+            }
+
+            return await _auditRepository.CreateAsync(entry);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<ImpersonationAuditEntry>> GetAuditAsync(Guid accountId, DateTime? since = null)
+        {
+            var entries = await _auditRepository.GetByAccountIdAsync(accountId);
+            return entries
+                .Where(e => since == null || e.Timestamp >= since.Value.ToUniversalTime())
+                .OrderByDescending(e => e.Timestamp)
+                .ToList();
+        }
+
+        private ImpersonationGrant CreateGrant(
+            Guid accountId,
+            string supportUserId,
+            string reason,
+            int? durationMinutes,
+            ImpersonationGrantKind kind,
+            string grantedBy)
+        {
+            if (accountId == Guid.Empty)
+            {
+                throw new ArgumentException("Account ID is required", nameof(accountId));
+            }
+
+            if (string.IsNullOrWhiteSpace(supportUserId))
+            {
+                throw new ArgumentException("Support user ID is required", nameof(supportUserId));
+            }
+
+            var minutes = durationMinutes ?? _configuration.DefaultGrantMinutes;
+            if (minutes <= 0 || minutes > _configuration.MaxGrantMinutes)
+            {
+                throw new ArgumentException($"Duration must be between 1 and {_configuration.MaxGrantMinutes} minutes", nameof(durationMinutes));
+            }
+
+            var now = DateTime.UtcNow;
+            return new ImpersonationGrant
+            {
+                Id = Guid.NewGuid(),
+                AccountId = accountId,
+                SupportUserId = supportUserId,
+                Kind = kind,
+                Reason = reason,
+                GrantedBy = grantedBy,
+                CreatedAt = now,
+                ExpiresAt = now.AddMinutes(minutes)
+            };
+        }
+    }
+}
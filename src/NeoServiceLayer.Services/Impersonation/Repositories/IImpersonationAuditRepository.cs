@@ -0,0 +1,27 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Impersonation.Repositories
+{
+    /// <summary>
+    /// Interface for impersonation audit repository
+    /// </summary>
+    public interface IImpersonationAuditRepository
+    {
+        /// <summary>
+        /// Creates an audit entry
+        /// </summary>
+        /// <param name="entry">Entry to create</param>
+        /// <returns>The created entry</returns>
+        Task<ImpersonationAuditEntry> CreateAsync(ImpersonationAuditEntry entry);
+
+        /// <summary>
+        /// Gets the audit entries for an impersonated account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>List of entries</returns>
+        Task<IEnumerable<ImpersonationAuditEntry>> GetByAccountIdAsync(Guid accountId);
+    }
+}
@@ -0,0 +1,41 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Impersonation.Repositories
+{
+    /// <summary>
+    /// Interface for impersonation grant repository
+    /// </summary>
+    public interface IImpersonationGrantRepository
+    {
+        /// <summary>
+        /// Creates a grant
+        /// </summary>
+        /// <param name="grant">Grant to create</param>
+        /// <returns>The created grant</returns>
+        Task<ImpersonationGrant> CreateAsync(ImpersonationGrant grant);
+
+        /// <summary>
+        /// Gets a grant by ID
+        /// </summary>
+        /// <param name="id">Grant ID</param>
+        /// <returns>The grant, or null if not found</returns>
+        Task<ImpersonationGrant> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets the grants for an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>List of grants</returns>
+        Task<IEnumerable<ImpersonationGrant>> GetByAccountIdAsync(Guid accountId);
+
+        /// <summary>
+        /// Updates a grant
+        /// </summary>
+        /// <param name="grant">Grant to update</param>
+        /// <returns>The updated grant</returns>
+        Task<ImpersonationGrant> UpdateAsync(ImpersonationGrant grant);
+    }
+}
@@ -0,0 +1,76 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Impersonation.Repositories
+{
+    /// <summary>
+    /// Implementation of the impersonation audit repository
+    /// </summary>
+    public class ImpersonationAuditRepository : IImpersonationAuditRepository
+    {
+        private readonly ILogger<ImpersonationAuditRepository> _logger;
+        private readonly IDatabaseService _databaseService;
+        private const string CollectionName = "impersonation_audit";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ImpersonationAuditRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="databaseService">Database service</param>
+        public ImpersonationAuditRepository(ILogger<ImpersonationAuditRepository> logger, IDatabaseService databaseService)
+        {
+            _logger = logger;
+            _databaseService = databaseService;
+        }
+
+        /// <inheritdoc/>
+        public async Task<ImpersonationAuditEntry> CreateAsync(ImpersonationAuditEntry entry)
+        {
+            try
+            {
+                if (entry.Id == Guid.Empty)
+                {
+                    entry.Id = Guid.NewGuid();
+                }
+
+                // Create collection if it doesn't exist
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    await _databaseService.CreateCollectionAsync(CollectionName);
+                }
+
+                return await _databaseService.CreateAsync(CollectionName, entry);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error creating impersonation audit entry for account {AccountId}", entry.AccountId);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<ImpersonationAuditEntry>> GetByAccountIdAsync(Guid accountId)
+        {
+            _logger.LogDebug("Getting impersonation audit entries for account {AccountId}", accountId);
+
+            try
+            {
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    return new List<ImpersonationAuditEntry>();
+                }
+
+                return await _databaseService.GetByFilterAsync<ImpersonationAuditEntry>(CollectionName, e => e.AccountId == accountId);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting impersonation audit entries for account {AccountId}", accountId);
+                throw;
+            }
+        }
+    }
+}
@@ -0,0 +1,115 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Impersonation.Repositories
+{
+    /// <summary>
+    /// Implementation of the impersonation grant repository
+    /// </summary>
+    public class ImpersonationGrantRepository : IImpersonationGrantRepository
+    {
+        private readonly ILogger<ImpersonationGrantRepository> _logger;
+        private readonly IDatabaseService _databaseService;
+        private const string CollectionName = "impersonation_grants";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ImpersonationGrantRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="databaseService">Database service</param>
+        public ImpersonationGrantRepository(ILogger<ImpersonationGrantRepository> logger, IDatabaseService databaseService)
+        {
+            _logger = logger;
+            _databaseService = databaseService;
+        }
+
+        /// <inheritdoc/>
+        public async Task<ImpersonationGrant> CreateAsync(ImpersonationGrant grant)
+        {
+            _logger.LogInformation("Creating impersonation grant for account {AccountId}", grant.AccountId);
+
+            try
+            {
+                if (grant.Id == Guid.Empty)
+                {
+                    grant.Id = Guid.NewGuid();
+                }
+
+                // Create collection if it doesn't exist
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    await _databaseService.CreateCollectionAsync(CollectionName);
+                }
+
+                return await _databaseService.CreateAsync(CollectionName, grant);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error creating impersonation grant for account {AccountId}", grant.AccountId);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<ImpersonationGrant> GetByIdAsync(Guid id)
+        {
+            _logger.LogDebug("Getting impersonation grant: {Id}", id);
+
+            try
+            {
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    return null;
+                }
+
+                return await _databaseService.GetByIdAsync<ImpersonationGrant, Guid>(CollectionName, id);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting impersonation grant: {Id}", id);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<ImpersonationGrant>> GetByAccountIdAsync(Guid accountId)
+        {
+            _logger.LogDebug("Getting impersonation grants for account {AccountId}", accountId);
+
+            try
+            {
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    return new List<ImpersonationGrant>();
+                }
+
+                return await _databaseService.GetByFilterAsync<ImpersonationGrant>(CollectionName, g => g.AccountId == accountId);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting impersonation grants for account {AccountId}", accountId);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<ImpersonationGrant> UpdateAsync(ImpersonationGrant grant)
+        {
+            _logger.LogInformation("Updating impersonation grant: {Id}", grant.Id);
+
+            try
+            {
+                return await _databaseService.UpdateAsync<ImpersonationGrant, Guid>(CollectionName, grant.Id, grant);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error updating impersonation grant: {Id}", grant.Id);
+                throw;
+            }
+        }
+    }
+}
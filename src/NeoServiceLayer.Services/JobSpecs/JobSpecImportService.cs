@@ -0,0 +1,364 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Linq;
+using System.Text.RegularExpressions;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Enums;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.JobSpecs
+{
+    /// <summary>
+    /// Imports Chainlink job specs as functions and triggers
+    /// </summary>
+    /// <remarks>
+    /// A cron job becomes a schedule trigger and a webhook job a webhook trigger, each running a function scaffolded
+    /// from the job's pipeline. A keeper job becomes an upkeep, which calls the contract's checkUpkeep and performUpkeep
+    /// itself and needs no function. EVM addresses do not carry over, so a keeper job needs the Neo script hash of the
+    /// ported contract.
+    /// </remarks>
+    public class JobSpecImportService : IJobSpecImportService
+    {
+        private const string EntryPoint = "main";
+
+        private static readonly Regex DurationPart = new Regex(@"(\d+(?:\.\d+)?)(ms|h|m|s)", RegexOptions.Compiled);
+
+        private static readonly Dictionary<string, string> Macros = new Dictionary<string, string>(StringComparer.OrdinalIgnoreCase)
+        {
+            ["@yearly"] = "0 0 1 1 *",
+            ["@annually"] = "0 0 1 1 *",
+            ["@monthly"] = "0 0 1 * *",
+            ["@weekly"] = "0 0 * * 0",
+            ["@daily"] = "0 0 * * *",
+            ["@midnight"] = "0 0 * * *",
+            ["@hourly"] = "0 * * * *"
+        };
+
+        private readonly ILogger<JobSpecImportService> _logger;
+        private readonly IFunctionService _functionService;
+        private readonly IScheduleTriggerService _scheduleTriggerService;
+        private readonly IWebhookTriggerService _webhookTriggerService;
+        private readonly IAutomationService _automationService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="JobSpecImportService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="functionService">Function service</param>
+        /// <param name="scheduleTriggerService">Schedule trigger service</param>
+        /// <param name="webhookTriggerService">Webhook trigger service</param>
+        /// <param name="automationService">Automation service</param>
+        public JobSpecImportService(
+            ILogger<JobSpecImportService> logger,
+            IFunctionService functionService,
+            IScheduleTriggerService scheduleTriggerService,
+            IWebhookTriggerService webhookTriggerService,
+            IAutomationService automationService)
+        {
+            _logger = logger;
+            _functionService = functionService;
+            _scheduleTriggerService = scheduleTriggerService;
+            _webhookTriggerService = webhookTriggerService;
+            _automationService = automationService;
+        }
+
+        /// <inheritdoc/>
+        public async Task<JobSpecImportResult> ImportAsync(Guid accountId, string spec, JobSpecImportOptions options)
+        {
+            options ??= new JobSpecImportOptions();
+
+            Dictionary<string, object> document;
+            try
+            {
+                document = JobSpecToml.Parse(spec);
+            }
+            catch (FormatException ex)
+            {
+                throw new ValidationException($"The job spec is not valid TOML: {ex.Message}");
+            }
+
+            var jobType = GetString(document, "type")?.ToLowerInvariant();
+            var result = new JobSpecImportResult
+            {
+                JobType = jobType,
+                Name = !string.IsNullOrWhiteSpace(options.Name) ? options.Name : GetString(document, "name") ?? $"{jobType}-job"
+            };
+
+            _logger.LogInformation("Importing Chainlink {JobType} job {Name} for account {AccountId}, dry run: {DryRun}",
+                jobType, result.Name, accountId, options.DryRun);
+
+            switch (jobType)
+            {
+                case "cron":
+                    var cronExpression = ConvertSchedule(GetString(document, "schedule"), result.Warnings);
+                    result.Function = Scaffold(accountId, jobType, document, result);
+                    result.ScheduleTrigger = new ScheduleTrigger
+                    {
+                        AccountId = accountId,
+                        Name = result.Name,
+                        Description = result.Function.Description,
+                        CronExpression = cronExpression,
+                        Tags = new List<string> { "chainlink-import" }
+                    };
+                    break;
+
+                case "webhook":
+                    result.Function = Scaffold(accountId, jobType, document, result);
+                    result.WebhookTrigger = new WebhookTrigger
+                    {
+                        AccountId = accountId,
+                        Name = result.Name,
+                        Description = result.Function.Description,
+                        Transformation = new WebhookTransformation { IncludePayload = true },
+                        Tags = new List<string> { "chainlink-import" }
+                    };
+
+                    if (document.TryGetValue("externalInitiators", out var initiators) && initiators is List<object> list && list.Count > 0)
+                    {
+                        result.Warnings.Add("External initiators are not imported; point them at the webhook trigger's URL instead");
+                    }
+
+                    break;
+
+                case "keeper":
+                    result.Upkeep = ConvertKeeper(accountId, document, options, result);
+                    break;
+
+                default:
+                    throw new ValidationException(
+                        $"Job type '{jobType ?? "(none)"}' cannot be imported; cron, webhook and keeper jobs are supported");
+            }
+
+            if (options.DryRun)
+            {
+                return result;
+            }
+
+            await CreateAsync(result);
+            result.Created = true;
+
+            _logger.LogInformation("Imported Chainlink {JobType} job {Name} for account {AccountId} with {WarningCount} warnings",
+                jobType, result.Name, accountId, result.Warnings.Count);
+            return result;
+        }
+
+        /// <summary>
+        /// Converts a Chainlink cron schedule to a five-field UTC cron expression
+        /// </summary>
+        /// <param name="schedule">Schedule such as <c>CRON_TZ=UTC 0 */5 * * * *</c> or <c>@every 10m</c></param>
+        /// <param name="warnings">Receives how the schedule changed</param>
+        /// <returns>The cron expression</returns>
+        /// <exception cref="ValidationException">The schedule cannot be expressed as a schedule trigger</exception>
+        public static string ConvertSchedule(string schedule, List<string> warnings)
+        {
+            if (string.IsNullOrWhiteSpace(schedule))
+            {
+                throw new ValidationException("A cron job needs a schedule");
+            }
+
+            var expression = schedule.Trim();
+            if (expression.StartsWith("CRON_TZ=", StringComparison.Ordinal) || expression.StartsWith("TZ=", StringComparison.Ordinal))
+            {
+                var separator = expression.IndexOf(' ');
+                var timeZone = separator < 0 ? expression : expression.Substring(0, separator);
+                timeZone = timeZone.Substring(timeZone.IndexOf('=') + 1);
+                expression = separator < 0 ? string.Empty : expression.Substring(separator + 1).Trim();
+
+                if (!timeZone.Equals("UTC", StringComparison.OrdinalIgnoreCase) && !timeZone.Equals("Etc/UTC", StringComparison.OrdinalIgnoreCase))
+                {
+                    warnings.Add($"The schedule was in {timeZone}; schedule triggers run in UTC, so adjust its hours");
+                }
+            }
+
+            if (Macros.TryGetValue(expression, out var macro))
+            {
+                expression = macro;
+            }
+            else if (expression.StartsWith("@every ", StringComparison.OrdinalIgnoreCase))
+            {
+                expression = ConvertInterval(expression.Substring("@every ".Length).Trim(), warnings);
+            }
+
+            var fields = expression.Split((char[])null, StringSplitOptions.RemoveEmptyEntries);
+            if (fields.Length == 6)
+            {
+                // Chainlink schedules may lead with a seconds field; schedule triggers fire on the minute
+                if (fields[0] != "0")
+                {
+                    warnings.Add($"The seconds field '{fields[0]}' was dropped; schedule triggers fire at most once a minute");
+                }
+
+                expression = string.Join(" ", fields.Skip(1));
+            }
+
+            if (!CronSchedule.TryParse(expression, out var cron, out var error))
+            {
+                throw new ValidationException($"The schedule '{schedule}' cannot be converted: {error}");
+            }
+
+            return cron.Expression;
+        }
+
+        private static string ConvertInterval(string duration, List<string> warnings)
+        {
+            var matches = DurationPart.Matches(duration);
+            if (matches.Count == 0 || string.Concat(matches.Select(m => m.Value)) != duration)
+            {
+                throw new ValidationException($"Invalid interval '{duration}'");
+            }
+
+            var interval = TimeSpan.Zero;
+            foreach (Match match in matches)
+            {
+                var amount = double.Parse(match.Groups[1].Value, CultureInfo.InvariantCulture);
+                interval += match.Groups[2].Value switch
+                {
+                    "h" => TimeSpan.FromHours(amount),
+                    "m" => TimeSpan.FromMinutes(amount),
+                    "s" => TimeSpan.FromSeconds(amount),
+                    _ => TimeSpan.FromMilliseconds(amount)
+                };
+            }
+
+            if (interval < TimeSpan.FromMinutes(1))
+            {
+                warnings.Add($"The interval {duration} was raised to one minute, the shortest a schedule trigger supports");
+                return "* * * * *";
+            }
+
+            var minutes = interval.TotalMinutes;
+            if (minutes == Math.Floor(minutes) && minutes < 60 && 60 % minutes == 0)
+            {
+                return minutes == 1 ? "* * * * *" : $"*/{minutes} * * * *";
+            }
+
+            var hours = interval.TotalHours;
+            if (hours == Math.Floor(hours) && hours <= 24 && 24 % hours == 0)
+            {
+                return hours == 1 ? "0 * * * *" : hours == 24 ? "0 0 * * *" : $"0 */{hours} * * *";
+            }
+
+            throw new ValidationException(
+                $"The interval {duration} does not divide an hour or a day evenly and cannot be written as a cron expression");
+        }
+
+        private static Core.Models.Function Scaffold(Guid accountId, string jobType, Dictionary<string, object> document, JobSpecImportResult result)
+        {
+            JobSpecPipeline pipeline;
+            try
+            {
+                pipeline = JobSpecPipeline.Parse(GetString(document, "observationSource"));
+            }
+            catch (FormatException ex)
+            {
+                throw new ValidationException($"The job's observationSource is not valid: {ex.Message}");
+            }
+
+            var sourceCode = JobSpecScaffolder.Generate(jobType, result.Name, pipeline, result.Warnings, out var usesNetwork);
+            var externalJobId = GetString(document, "externalJobID");
+
+            return new Core.Models.Function
+            {
+                AccountId = accountId,
+                Name = result.Name,
+                Description = externalJobId != null
+                    ? $"Imported from Chainlink {jobType} job {externalJobId}"
+                    : $"Imported from Chainlink {jobType} job",
+                Runtime = "JavaScript",
+                SourceCode = sourceCode,
+                EntryPoint = EntryPoint,
+                Permissions = usesNetwork ? new List<string> { FunctionCapabilities.Network } : new List<string>()
+            };
+        }
+
+        private static Upkeep ConvertKeeper(Guid accountId, Dictionary<string, object> document, JobSpecImportOptions options, JobSpecImportResult result)
+        {
+            if (string.IsNullOrWhiteSpace(options.ContractHash))
+            {
+                throw new ValidationException(
+                    "A keeper job needs contractHash, the Neo script hash of the ported upkeep contract; EVM contract addresses do not carry over");
+            }
+
+            if (options.GasBankAccountId == null || options.GasBankAccountId == Guid.Empty)
+            {
+                throw new ValidationException("A keeper job needs gasBankAccountId, the GasBank account paying for performUpkeep");
+            }
+
+            var contractAddress = GetString(document, "contractAddress");
+            if (contractAddress != null)
+            {
+                result.Warnings.Add($"The EVM contract {contractAddress} was replaced by {options.ContractHash}; it must implement checkUpkeep and performUpkeep");
+            }
+
+            if (GetString(document, "fromAddress") != null)
+            {
+                result.Warnings.Add("fromAddress is not imported; performUpkeep transactions are sent from the GasBank account's wallet");
+            }
+
+            return new Upkeep
+            {
+                AccountId = accountId,
+                Name = result.Name,
+                ContractHash = options.ContractHash,
+                CheckData = string.Empty,
+                GasBankAccountId = options.GasBankAccountId.Value
+            };
+        }
+
+        private async Task CreateAsync(JobSpecImportResult result)
+        {
+            if (result.Upkeep != null)
+            {
+                result.Upkeep = await _automationService.RegisterAsync(result.Upkeep);
+                return;
+            }
+
+            var scaffold = result.Function;
+            result.Function = await _functionService.CreateFunctionAsync(
+                scaffold.Name,
+                scaffold.Description,
+                FunctionRuntime.JavaScript,
+                scaffold.SourceCode,
+                scaffold.EntryPoint,
+                scaffold.AccountId,
+                30000,
+                128,
+                null,
+                null,
+                scaffold.Permissions);
+
+            try
+            {
+                if (result.ScheduleTrigger != null)
+                {
+                    result.ScheduleTrigger.FunctionId = result.Function.Id;
+                    result.ScheduleTrigger = await _scheduleTriggerService.CreateAsync(result.ScheduleTrigger);
+                }
+
+                if (result.WebhookTrigger != null)
+                {
+                    result.WebhookTrigger.FunctionId = result.Function.Id;
+                    result.WebhookTrigger = await _webhookTriggerService.CreateAsync(result.WebhookTrigger);
+                }
+            }
+            catch (Exception)
+            {
+                // A function without its trigger would never run; remove it so the import can be retried as a whole
+                await _functionService.DeleteAsync(result.Function.Id);
+                throw;
+            }
+        }
+
+        private static string GetString(Dictionary<string, object> document, string key)
+        {
+            return document.TryGetValue(key, out var value) && value != null
+                ? Convert.ToString(value, CultureInfo.InvariantCulture)
+                : null;
+        }
+    }
+}
@@ -0,0 +1,290 @@
+using System;
+using System.Collections.Generic;
+using System.Text;
+
+namespace NeoServiceLayer.Services.JobSpecs
+{
+    /// <summary>
+    /// Task graph of a Chainlink job's <c>observationSource</c>, written in DOT
+    /// </summary>
+    public sealed class JobSpecPipeline
+    {
+        private JobSpecPipeline(List<PipelineTask> tasks)
+        {
+            Tasks = tasks;
+        }
+
+        /// <summary>
+        /// Gets the tasks in an order where each runs after the tasks it takes input from
+        /// </summary>
+        public IReadOnlyList<PipelineTask> Tasks { get; }
+
+        /// <summary>
+        /// Parses an observation source
+        /// </summary>
+        /// <param name="source">DOT statements, e.g. <c>fetch [type="http" url="..."]</c> and <c>fetch -> parse</c></param>
+        /// <returns>The pipeline</returns>
+        /// <exception cref="FormatException">The source is not valid DOT</exception>
+        public static JobSpecPipeline Parse(string source)
+        {
+            var tokens = Tokenize(source ?? string.Empty);
+            var tasks = new Dictionary<string, PipelineTask>(StringComparer.Ordinal);
+            var declared = new List<PipelineTask>();
+
+            PipelineTask GetTask(string name)
+            {
+                if (!tasks.TryGetValue(name, out var task))
+                {
+                    task = new PipelineTask { Name = name };
+                    tasks[name] = task;
+                    declared.Add(task);
+                }
+
+                return task;
+            }
+
+            var position = 0;
+            while (position < tokens.Count)
+            {
+                if (tokens[position] == ";")
+                {
+                    position++;
+                    continue;
+                }
+
+                // A statement is a chain of one or more nodes joined by edges
+                PipelineTask previous = null;
+                while (true)
+                {
+                    var name = tokens[position++];
+                    if (IsPunctuation(name))
+                    {
+                        throw new FormatException($"Expected a task name but found '{name}'");
+                    }
+
+                    var task = GetTask(name);
+                    if (position < tokens.Count && tokens[position] == "[")
+                    {
+                        position = ParseAttributes(tokens, position + 1, task);
+                    }
+
+                    if (previous != null && !task.Inputs.Contains(previous.Name))
+                    {
+                        task.Inputs.Add(previous.Name);
+                    }
+
+                    if (position < tokens.Count && tokens[position] == "->")
+                    {
+                        previous = task;
+                        position++;
+                        if (position >= tokens.Count)
+                        {
+                            throw new FormatException($"Edge from '{name}' has no target");
+                        }
+
+                        continue;
+                    }
+
+                    break;
+                }
+            }
+
+            return new JobSpecPipeline(Sort(declared, tasks));
+        }
+
+        private static int ParseAttributes(List<string> tokens, int position, PipelineTask task)
+        {
+            while (position < tokens.Count && tokens[position] != "]")
+            {
+                if (tokens[position] == "," || tokens[position] == ";")
+                {
+                    position++;
+                    continue;
+                }
+
+                var key = tokens[position++];
+                if (position + 1 >= tokens.Count || tokens[position] != "=")
+                {
+                    throw new FormatException($"Attribute '{key}' of task '{task.Name}' has no value");
+                }
+
+                task.Attributes[key] = tokens[position + 1];
+                position += 2;
+            }
+
+            if (position >= tokens.Count)
+            {
+                throw new FormatException($"Attributes of task '{task.Name}' are not closed with ']'");
+            }
+
+            return position + 1;
+        }
+
+        private static List<PipelineTask> Sort(List<PipelineTask> declared, Dictionary<string, PipelineTask> tasks)
+        {
+            // Depth-first, in declaration order, so the result stays close to how the spec was written
+            var sorted = new List<PipelineTask>();
+            var state = new Dictionary<string, int>();
+
+            void Visit(PipelineTask task)
+            {
+                if (state.TryGetValue(task.Name, out var visited))
+                {
+                    if (visited == 1)
+                    {
+                        throw new FormatException($"The pipeline has a cycle through task '{task.Name}'");
+                    }
+
+                    return;
+                }
+
+                state[task.Name] = 1;
+                foreach (var input in task.Inputs)
+                {
+                    Visit(tasks[input]);
+                }
+
+                state[task.Name] = 2;
+                sorted.Add(task);
+            }
+
+            foreach (var task in declared)
+            {
+                Visit(task);
+            }
+
+            return sorted;
+        }
+
+        private static List<string> Tokenize(string source)
+        {
+            var tokens = new List<string>();
+            var i = 0;
+            while (i < source.Length)
+            {
+                var c = source[i];
+                if (char.IsWhiteSpace(c))
+                {
+                    i++;
+                }
+                else if (c == '/' && i + 1 < source.Length && source[i + 1] == '/')
+                {
+                    while (i < source.Length && source[i] != '\n')
+                    {
+                        i++;
+                    }
+                }
+                else if (c == '-' && i + 1 < source.Length && source[i + 1] == '>')
+                {
+                    tokens.Add("->");
+                    i += 2;
+                }
+                else if (c == '[' || c == ']' || c == '=' || c == ',' || c == ';')
+                {
+                    tokens.Add(c.ToString());
+                    i++;
+                }
+                else if (c == '"')
+                {
+                    var builder = new StringBuilder();
+                    i++;
+                    while (i < source.Length && source[i] != '"')
+                    {
+                        if (source[i] == '\\' && i + 1 < source.Length)
+                        {
+                            i++;
+                        }
+
+                        builder.Append(source[i++]);
+                    }
+
+                    if (i >= source.Length)
+                    {
+                        throw new FormatException("Unterminated string in observation source");
+                    }
+
+                    tokens.Add(builder.ToString());
+                    i++;
+                }
+                else if (c == '<')
+                {
+                    // HTML-like strings, e.g. data=<{"a": $(fetch)}>, may nest angle brackets
+                    var depth = 0;
+                    var start = i + 1;
+                    do
+                    {
+                        if (source[i] == '<')
+                        {
+                            depth++;
+                        }
+                        else if (source[i] == '>')
+                        {
+                            depth--;
+                        }
+
+                        i++;
+                    }
+                    while (depth > 0 && i < source.Length);
+
+                    if (depth > 0)
+                    {
+                        throw new FormatException("Unterminated <...> value in observation source");
+                    }
+
+                    tokens.Add(source.Substring(start, i - start - 1));
+                }
+                else
+                {
+                    var start = i;
+                    while (i < source.Length && !char.IsWhiteSpace(source[i]) && "[]=,;\"<".IndexOf(source[i]) < 0 &&
+                        !(source[i] == '-' && i + 1 < source.Length && source[i + 1] == '>'))
+                    {
+                        i++;
+                    }
+
+                    tokens.Add(source.Substring(start, i - start));
+                }
+            }
+
+            return tokens;
+        }
+
+        private static bool IsPunctuation(string token)
+        {
+            return token == "[" || token == "]" || token == "=" || token == "," || token == ";" || token == "->";
+        }
+    }
+
+    /// <summary>
+    /// Task of a Chainlink job pipeline
+    /// </summary>
+    public sealed class PipelineTask
+    {
+        /// <summary>
+        /// Gets or sets the task name, which later tasks refer to as <c>$(name)</c>
+        /// </summary>
+        public string Name { get; set; }
+
+        /// <summary>
+        /// Gets the task's attributes, including <c>type</c>
+        /// </summary>
+        public Dictionary<string, string> Attributes { get; } = new Dictionary<string, string>(StringComparer.Ordinal);
+
+        /// <summary>
+        /// Gets the tasks with an edge into this one
+        /// </summary>
+        public List<string> Inputs { get; } = new List<string>();
+
+        /// <summary>
+        /// Gets the task type, e.g. <c>http</c> or <c>jsonparse</c>
+        /// </summary>
+        public string Type => Attributes.TryGetValue("type", out var type) ? type.ToLowerInvariant() : string.Empty;
+
+        /// <summary>
+        /// Gets an attribute, or null if the task does not have it
+        /// </summary>
+        /// <param name="name">Attribute name</param>
+        /// <returns>The attribute's value</returns>
+        public string Get(string name) => Attributes.TryGetValue(name, out var value) ? value : null;
+    }
+}
@@ -0,0 +1,295 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Linq;
+using System.Text;
+using System.Text.RegularExpressions;
+using Newtonsoft.Json;
+
+namespace NeoServiceLayer.Services.JobSpecs
+{
+    /// <summary>
+    /// Writes a JavaScript function that performs the steps of a Chainlink job pipeline
+    /// </summary>
+    /// <remarks>
+    /// HTTP, JSON parsing, arithmetic and aggregation tasks are translated. Tasks with no equivalent here, such as
+    /// bridges to external adapters and EVM encoding or transactions, become TODO steps and are listed as warnings.
+    /// </remarks>
+    public static class JobSpecScaffolder
+    {
+        private static readonly Regex Reference = new Regex(@"\$\(\s*([A-Za-z0-9_.]+)\s*\)", RegexOptions.Compiled);
+
+        private static readonly HashSet<string> EvmTasks = new HashSet<string>(StringComparer.Ordinal)
+        {
+            "ethabiencode", "ethabiencode2", "ethabidecode", "ethabidecodelog", "ethcall", "ethtx", "estimategaslimit", "cborparse"
+        };
+
+        private static readonly Dictionary<string, string> Aggregates = new Dictionary<string, string>(StringComparer.Ordinal)
+        {
+            ["median"] = "median",
+            ["mean"] = "mean",
+            ["sum"] = "sum",
+            ["mode"] = "mode",
+            ["min"] = "min",
+            ["max"] = "max"
+        };
+
+        /// <summary>
+        /// Writes the function for a job
+        /// </summary>
+        /// <param name="jobType">Job type, cron or webhook</param>
+        /// <param name="jobName">Job name</param>
+        /// <param name="pipeline">The job's pipeline</param>
+        /// <param name="warnings">Receives the tasks that need porting by hand</param>
+        /// <param name="usesNetwork">Whether the function makes HTTP requests and needs the network permission</param>
+        /// <returns>Source code of a <c>main</c> entry point</returns>
+        public static string Generate(string jobType, string jobName, JobSpecPipeline pipeline, List<string> warnings, out bool usesNetwork)
+        {
+            usesNetwork = false;
+            var helpers = new SortedSet<string>(StringComparer.Ordinal);
+            var body = new StringBuilder();
+
+            foreach (var task in pipeline.Tasks)
+            {
+                var variable = VariableName(task.Name);
+                var input = task.Inputs.Count > 0
+                    ? VariableName(task.Inputs[task.Inputs.Count - 1])
+                    : (jobType == "webhook" ? "params.payload" : "undefined");
+
+                body.AppendLine($"    // {task.Name} [type=\"{task.Type}\"]");
+                switch (task.Type)
+                {
+                    case "http":
+                        usesNetwork = true;
+                        var init = new List<string> { $"method: {JsonConvert.ToString((task.Get("method") ?? "GET").ToUpperInvariant())}" };
+                        if (task.Get("requestData") != null)
+                        {
+                            init.Add("headers: { \"content-type\": \"application/json\" }");
+                            init.Add($"body: {Expression(task.Get("requestData"))}");
+                        }
+
+                        body.AppendLine($"    const {variable} = await (await fetch({Expression(task.Get("url"))}, {{ {string.Join(", ", init)} }})).text();");
+                        break;
+
+                    case "jsonparse":
+                        helpers.Add("jsonPath");
+                        var separator = task.Get("separator") ?? ",";
+                        var path = (task.Get("path") ?? string.Empty).Split(new[] { separator }, StringSplitOptions.RemoveEmptyEntries)
+                            .Select(p => JsonConvert.ToString(p.Trim()));
+                        var data = task.Get("data") != null ? Expression(task.Get("data")) : input;
+                        body.AppendLine($"    const {variable} = jsonPath(typeof {data} === \"string\" ? JSON.parse({data}) : {data}, [{string.Join(", ", path)}]);");
+                        break;
+
+                    case "multiply":
+                        body.AppendLine($"    const {variable} = Number({Value(task, "input", input)}) * {Number(task.Get("times"), "1")};");
+                        break;
+
+                    case "divide":
+                        var divided = $"Number({Value(task, "input", input)}) / {Number(task.Get("divisor"), "1")}";
+                        body.AppendLine(task.Get("precision") != null
+                            ? $"    const {variable} = Number(({divided}).toFixed({Number(task.Get("precision"), "0")}));"
+                            : $"    const {variable} = {divided};");
+                        break;
+
+                    case "lowercase":
+                    case "uppercase":
+                        var method = task.Type == "lowercase" ? "toLowerCase" : "toUpperCase";
+                        body.AppendLine($"    const {variable} = String({Value(task, "input", input)}).{method}();");
+                        break;
+
+                    case "length":
+                        body.AppendLine($"    const {variable} = String({Value(task, "input", input)}).length;");
+                        break;
+
+                    case "memo":
+                        body.AppendLine($"    const {variable} = {Value(task, "value", input)};");
+                        break;
+
+                    case "any":
+                        body.AppendLine($"    const {variable} = [{string.Join(", ", task.Inputs.Select(VariableName))}].find(v => v !== undefined && v !== null);");
+                        break;
+
+                    default:
+                        if (Aggregates.TryGetValue(task.Type, out var aggregate))
+                        {
+                            helpers.Add(aggregate);
+                            if (aggregate == "mean")
+                            {
+                                helpers.Add("sum");
+                            }
+
+                            var values = task.Get("values") != null
+                                ? ArrayExpression(task.Get("values"))
+                                : $"[{string.Join(", ", task.Inputs.Select(VariableName))}]";
+                            body.AppendLine($"    const {variable} = {aggregate}({values});");
+                            break;
+                        }
+
+                        var todo = task.Type == "bridge"
+                            ? $"bridge to external adapter \"{task.Get("name")}\"; call the adapter with fetch and declare the network permission"
+                            : EvmTasks.Contains(task.Type)
+                                ? $"EVM task \"{task.Type}\"; use neoService.blockchain.invokeRead or invokeWrite against the ported Neo contract"
+                                : $"\"{task.Type}\" task has no equivalent on this platform";
+                        warnings.Add($"Task '{task.Name}': {todo}");
+                        body.AppendLine($"    // TODO: {todo}");
+                        body.AppendLine($"    const {variable} = {input};");
+                        break;
+                }
+
+                body.AppendLine();
+            }
+
+            var result = pipeline.Tasks.Count > 0 ? VariableName(pipeline.Tasks[pipeline.Tasks.Count - 1].Name) : "params";
+            if (pipeline.Tasks.Count == 0)
+            {
+                warnings.Add("The job has no observationSource; the function returns its parameters");
+            }
+
+            var source = new StringBuilder();
+            source.AppendLine($"// Scaffolded from the Chainlink {jobType} job {JsonConvert.ToString(jobName ?? string.Empty)}.");
+            source.AppendLine("// Each pipeline task is a step below; review the TODO steps before enabling the trigger.");
+            source.AppendLine("async function main(params) {");
+            source.Append(body);
+            source.AppendLine($"    return {result};");
+            source.AppendLine("}");
+
+            foreach (var helper in helpers)
+            {
+                source.AppendLine();
+                source.Append(Helper(helper));
+            }
+
+            return source.ToString();
+        }
+
+        private static string Value(PipelineTask task, string attribute, string fallback)
+        {
+            return task.Get(attribute) != null ? Expression(task.Get(attribute)) : fallback;
+        }
+
+        private static string Number(string value, string fallback)
+        {
+            if (string.IsNullOrWhiteSpace(value))
+            {
+                return fallback;
+            }
+
+            return double.TryParse(value, NumberStyles.Float, CultureInfo.InvariantCulture, out _)
+                ? value.Trim()
+                : $"Number({Expression(value)})";
+        }
+
+        /// <summary>
+        /// Converts an attribute value to a JavaScript expression, turning <c>$(task)</c> references into variables
+        /// </summary>
+        private static string Expression(string value)
+        {
+            if (value == null)
+            {
+                return "undefined";
+            }
+
+            var whole = Reference.Match(value.Trim());
+            if (whole.Success && whole.Length == value.Trim().Length)
+            {
+                return ReferenceExpression(whole.Groups[1].Value);
+            }
+
+            if (!Reference.IsMatch(value))
+            {
+                return JsonConvert.ToString(value);
+            }
+
+            // Values mixing text and references become template literals
+            var escaped = value.Replace("\\", "\\\\").Replace("`", "\\`").Replace("${", "\\${");
+            return "`" + Reference.Replace(escaped, m => "${" + ReferenceExpression(m.Groups[1].Value) + "}") + "`";
+        }
+
+        private static string ArrayExpression(string value)
+        {
+            // values=<[ $(a), $(b) ]>
+            var trimmed = value.Trim();
+            if (trimmed.StartsWith("[") && trimmed.EndsWith("]"))
+            {
+                var items = trimmed.Substring(1, trimmed.Length - 2)
+                    .Split(',', StringSplitOptions.RemoveEmptyEntries)
+                    .Select(item => Expression(item.Trim()));
+                return $"[{string.Join(", ", items)}]";
+            }
+
+            return Expression(value);
+        }
+
+        private static string ReferenceExpression(string reference)
+        {
+            var parts = reference.Split('.');
+            if (parts[0] == "jobRun")
+            {
+                // A webhook job's request body arrives as the trigger's payload parameter
+                return parts.Length > 1 && parts[1] == "requestBody"
+                    ? "JSON.stringify(params.payload)"
+                    : "params" + string.Concat(parts.Skip(1).Select(p => "." + p));
+            }
+
+            if (parts[0] == "jobSpec")
+            {
+                return "params" + string.Concat(parts.Skip(1).Select(p => "." + p));
+            }
+
+            return VariableName(parts[0]) + string.Concat(parts.Skip(1).Select(p => "." + p));
+        }
+
+        private static string VariableName(string taskName)
+        {
+            // Task names such as "fetch" would shadow globals, so every step gets a prefix
+            var builder = new StringBuilder("task_");
+            foreach (var c in taskName)
+            {
+                builder.Append(char.IsLetterOrDigit(c) || c == '_' ? c : '_');
+            }
+
+            return builder.ToString();
+        }
+
+        private static string Helper(string name)
+        {
+            switch (name)
+            {
+                case "jsonPath":
+                    return "function jsonPath(value, path) {\n" +
+                           "    return path.reduce((current, key) => current == null ? undefined : current[key], value);\n" +
+                           "}\n";
+                case "median":
+                    return "function median(values) {\n" +
+                           "    const sorted = values.map(Number).sort((a, b) => a - b);\n" +
+                           "    const middle = Math.floor(sorted.length / 2);\n" +
+                           "    return sorted.length % 2 ? sorted[middle] : (sorted[middle - 1] + sorted[middle]) / 2;\n" +
+                           "}\n";
+                case "mean":
+                    return "function mean(values) {\n" +
+                           "    return sum(values) / values.length;\n" +
+                           "}\n";
+                case "sum":
+                    return "function sum(values) {\n" +
+                           "    return values.map(Number).reduce((total, value) => total + value, 0);\n" +
+                           "}\n";
+                case "mode":
+                    return "function mode(values) {\n" +
+                           "    const counts = new Map();\n" +
+                           "    values.forEach(value => counts.set(value, (counts.get(value) || 0) + 1));\n" +
+                           "    return [...counts.entries()].sort((a, b) => b[1] - a[1])[0][0];\n" +
+                           "}\n";
+                case "min":
+                    return "function min(values) {\n" +
+                           "    return Math.min(...values.map(Number));\n" +
+                           "}\n";
+                case "max":
+                    return "function max(values) {\n" +
+                           "    return Math.max(...values.map(Number));\n" +
+                           "}\n";
+                default:
+                    throw new ArgumentException($"Unknown helper {name}", nameof(name));
+            }
+        }
+    }
+}
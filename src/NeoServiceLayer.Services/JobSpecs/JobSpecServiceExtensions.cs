@@ -0,0 +1,23 @@
+using Microsoft.Extensions.DependencyInjection;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Services.JobSpecs
+{
+    /// <summary>
+    /// Extension methods for registering job spec import services
+    /// </summary>
+    public static class JobSpecServiceExtensions
+    {
+        /// <summary>
+        /// Adds job spec import services to the service collection
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddJobSpecServices(this IServiceCollection services)
+        {
+            services.AddSingleton<IJobSpecImportService, JobSpecImportService>();
+
+            return services;
+        }
+    }
+}
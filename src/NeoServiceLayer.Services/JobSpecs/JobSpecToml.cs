@@ -0,0 +1,480 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Text;
+
+namespace NeoServiceLayer.Services.JobSpecs
+{
+    /// <summary>
+    /// Reads the subset of TOML that Chainlink job specs are written in
+    /// </summary>
+    /// <remarks>
+    /// Supports comments, bare and quoted keys, the four string forms, integers, floats, booleans, arrays, inline
+    /// tables, <c>[table]</c> headers and <c>[[array]]</c> headers. Dates are read as strings. Values are returned as
+    /// <see cref="string"/>, <see cref="long"/>, <see cref="double"/>, <see cref="bool"/>, <see cref="List{T}"/> of
+    /// values and <see cref="Dictionary{TKey,TValue}"/> keyed by name.
+    /// </remarks>
+    public sealed class JobSpecToml
+    {
+        private readonly string _text;
+        private int _position;
+
+        private JobSpecToml(string text)
+        {
+            _text = text.Replace("\r\n", "\n");
+        }
+
+        /// <summary>
+        /// Parses a TOML document
+        /// </summary>
+        /// <param name="text">TOML text</param>
+        /// <returns>The document's root table</returns>
+        /// <exception cref="FormatException">The text is not valid TOML, with the line of the error</exception>
+        public static Dictionary<string, object> Parse(string text)
+        {
+            return new JobSpecToml(text ?? string.Empty).ParseDocument();
+        }
+
+        private Dictionary<string, object> ParseDocument()
+        {
+            var root = new Dictionary<string, object>(StringComparer.Ordinal);
+            var current = root;
+
+            while (true)
+            {
+                SkipWhitespaceAndComments(true);
+                if (AtEnd)
+                {
+                    return root;
+                }
+
+                if (Peek() == '[')
+                {
+                    current = ParseTableHeader(root);
+                }
+                else
+                {
+                    var keys = ParseKey();
+                    SkipWhitespace();
+                    Expect('=');
+                    SkipWhitespace();
+                    var value = ParseValue();
+                    Assign(current, keys, value);
+                }
+
+                EndOfLine();
+            }
+        }
+
+        private Dictionary<string, object> ParseTableHeader(Dictionary<string, object> root)
+        {
+            var isArray = _position + 1 < _text.Length && _text[_position + 1] == '[';
+            _position += isArray ? 2 : 1;
+            SkipWhitespace();
+            var keys = ParseKey();
+            SkipWhitespace();
+            Expect(']');
+            if (isArray)
+            {
+                Expect(']');
+            }
+
+            var parent = root;
+            for (var i = 0; i < keys.Count - 1; i++)
+            {
+                parent = ChildTable(parent, keys[i]);
+            }
+
+            var last = keys[keys.Count - 1];
+            if (!isArray)
+            {
+                return ChildTable(parent, last);
+            }
+
+            if (!parent.TryGetValue(last, out var existing))
+            {
+                existing = new List<object>();
+                parent[last] = existing;
+            }
+
+            if (existing is not List<object> list)
+            {
+                throw Error($"'{last}' is already defined as a value");
+            }
+
+            var table = new Dictionary<string, object>(StringComparer.Ordinal);
+            list.Add(table);
+            return table;
+        }
+
+        private Dictionary<string, object> ChildTable(Dictionary<string, object> parent, string key)
+        {
+            if (!parent.TryGetValue(key, out var existing))
+            {
+                var table = new Dictionary<string, object>(StringComparer.Ordinal);
+                parent[key] = table;
+                return table;
+            }
+
+            return existing switch
+            {
+                Dictionary<string, object> table => table,
+                List<object> list when list.Count > 0 && list[list.Count - 1] is Dictionary<string, object> last => last,
+                _ => throw Error($"'{key}' is already defined as a value")
+            };
+        }
+
+        private void Assign(Dictionary<string, object> table, List<string> keys, object value)
+        {
+            for (var i = 0; i < keys.Count - 1; i++)
+            {
+                table = ChildTable(table, keys[i]);
+            }
+
+            var last = keys[keys.Count - 1];
+            if (table.ContainsKey(last))
+            {
+                throw Error($"'{last}' is defined twice");
+            }
+
+            table[last] = value;
+        }
+
+        private List<string> ParseKey()
+        {
+            var keys = new List<string>();
+            while (true)
+            {
+                SkipWhitespace();
+                if (AtEnd)
+                {
+                    throw Error("Expected a key");
+                }
+
+                var c = Peek();
+                if (c == '"' || c == '\'')
+                {
+                    keys.Add(ParseString());
+                }
+                else
+                {
+                    var start = _position;
+                    while (!AtEnd && (char.IsLetterOrDigit(Peek()) || Peek() == '_' || Peek() == '-'))
+                    {
+                        _position++;
+                    }
+
+                    if (start == _position)
+                    {
+                        throw Error($"Unexpected '{c}' where a key was expected");
+                    }
+
+                    keys.Add(_text.Substring(start, _position - start));
+                }
+
+                SkipWhitespace();
+                if (AtEnd || Peek() != '.')
+                {
+                    return keys;
+                }
+
+                _position++;
+            }
+        }
+
+        private object ParseValue()
+        {
+            if (AtEnd)
+            {
+                throw Error("Expected a value");
+            }
+
+            switch (Peek())
+            {
+                case '"':
+                case '\'':
+                    return ParseString();
+                case '[':
+                    return ParseArray();
+                case '{':
+                    return ParseInlineTable();
+            }
+
+            var start = _position;
+            while (!AtEnd && Peek() != ',' && Peek() != ']' && Peek() != '}' && Peek() != '#' && Peek() != '\n')
+            {
+                _position++;
+            }
+
+            var token = _text.Substring(start, _position - start).Trim();
+            if (token == "true" || token == "false")
+            {
+                return token == "true";
+            }
+
+            var number = token.Replace("_", string.Empty);
+            if (long.TryParse(number, NumberStyles.AllowLeadingSign, CultureInfo.InvariantCulture, out var integer))
+            {
+                return integer;
+            }
+
+            if (number.StartsWith("0x", StringComparison.OrdinalIgnoreCase) &&
+                long.TryParse(number.Substring(2), NumberStyles.AllowHexSpecifier, CultureInfo.InvariantCulture, out var hex))
+            {
+                return hex;
+            }
+
+            if (double.TryParse(number, NumberStyles.Float, CultureInfo.InvariantCulture, out var real))
+            {
+                return real;
+            }
+
+            // Offset date-times and local dates are kept as written
+            if (token.Length > 0 && char.IsDigit(token[0]) && token.Contains('-'))
+            {
+                return token;
+            }
+
+            throw Error($"Invalid value '{token}'");
+        }
+
+        private List<object> ParseArray()
+        {
+            Expect('[');
+            var items = new List<object>();
+            while (true)
+            {
+                SkipWhitespaceAndComments(true);
+                if (AtEnd)
+                {
+                    throw Error("Unterminated array");
+                }
+
+                if (Peek() == ']')
+                {
+                    _position++;
+                    return items;
+                }
+
+                items.Add(ParseValue());
+                SkipWhitespaceAndComments(true);
+                if (!AtEnd && Peek() == ',')
+                {
+                    _position++;
+                }
+                else if (AtEnd || Peek() != ']')
+                {
+                    throw Error("Expected ',' or ']' in array");
+                }
+            }
+        }
+
+        private Dictionary<string, object> ParseInlineTable()
+        {
+            Expect('{');
+            var table = new Dictionary<string, object>(StringComparer.Ordinal);
+            while (true)
+            {
+                SkipWhitespace();
+                if (AtEnd)
+                {
+                    throw Error("Unterminated inline table");
+                }
+
+                if (Peek() == '}')
+                {
+                    _position++;
+                    return table;
+                }
+
+                var keys = ParseKey();
+                SkipWhitespace();
+                Expect('=');
+                SkipWhitespace();
+                Assign(table, keys, ParseValue());
+                SkipWhitespace();
+                if (!AtEnd && Peek() == ',')
+                {
+                    _position++;
+                }
+                else if (AtEnd || Peek() != '}')
+                {
+                    throw Error("Expected ',' or '}' in inline table");
+                }
+            }
+        }
+
+        private string ParseString()
+        {
+            var quote = Peek();
+            var multiline = _position + 2 < _text.Length && _text[_position + 1] == quote && _text[_position + 2] == quote;
+            _position += multiline ? 3 : 1;
+
+            // A newline straight after the opening delimiter is not part of the string
+            if (multiline && !AtEnd && Peek() == '\n')
+            {
+                _position++;
+            }
+
+            var builder = new StringBuilder();
+            while (true)
+            {
+                if (AtEnd)
+                {
+                    throw Error("Unterminated string");
+                }
+
+                var c = _text[_position];
+                if (c == quote)
+                {
+                    if (!multiline)
+                    {
+                        _position++;
+                        return builder.ToString();
+                    }
+
+                    if (_position + 2 < _text.Length && _text[_position + 1] == quote && _text[_position + 2] == quote)
+                    {
+                        // Up to two quotes may sit right before the closing delimiter
+                        var extra = 0;
+                        while (extra < 2 && _position + 3 + extra < _text.Length && _text[_position + 3 + extra] == quote)
+                        {
+                            extra++;
+                        }
+
+                        builder.Append(quote, extra);
+                        _position += 3 + extra;
+                        return builder.ToString();
+                    }
+                }
+
+                if (c == '\n' && !multiline)
+                {
+                    throw Error("Newline in single-line string");
+                }
+
+                if (c == '\\' && quote == '"')
+                {
+                    _position++;
+                    builder.Append(ParseEscape(multiline));
+                    continue;
+                }
+
+                builder.Append(c);
+                _position++;
+            }
+        }
+
+        private string ParseEscape(bool multiline)
+        {
+            if (AtEnd)
+            {
+                throw Error("Unterminated escape sequence");
+            }
+
+            var c = _text[_position++];
+            switch (c)
+            {
+                case 'b': return "\b";
+                case 't': return "\t";
+                case 'n': return "\n";
+                case 'f': return "\f";
+                case 'r': return "\r";
+                case '"': return "\"";
+                case '\\': return "\\";
+                case 'u':
+                case 'U':
+                    var length = c == 'u' ? 4 : 8;
+                    if (_position + length > _text.Length ||
+                        !int.TryParse(_text.Substring(_position, length), NumberStyles.AllowHexSpecifier, CultureInfo.InvariantCulture, out var codePoint))
+                    {
+                        throw Error("Invalid unicode escape");
+                    }
+
+                    _position += length;
+                    return char.ConvertFromUtf32(codePoint);
+            }
+
+            if (multiline && (c == '\n' || c == ' ' || c == '\t'))
+            {
+                // A backslash at the end of a line trims the line break and the whitespace after it
+                while (!AtEnd && char.IsWhiteSpace(Peek()))
+                {
+                    _position++;
+                }
+
+                return string.Empty;
+            }
+
+            throw Error($"Invalid escape sequence '\\{c}'");
+        }
+
+        private void EndOfLine()
+        {
+            SkipWhitespaceAndComments(false);
+            if (!AtEnd && Peek() != '\n')
+            {
+                throw Error($"Unexpected '{Peek()}' after value");
+            }
+        }
+
+        private void SkipWhitespace()
+        {
+            while (!AtEnd && (Peek() == ' ' || Peek() == '\t'))
+            {
+                _position++;
+            }
+        }
+
+        private void SkipWhitespaceAndComments(bool newlines)
+        {
+            while (!AtEnd)
+            {
+                var c = Peek();
+                if (c == ' ' || c == '\t' || (newlines && c == '\n'))
+                {
+                    _position++;
+                }
+                else if (c == '#')
+                {
+                    while (!AtEnd && Peek() != '\n')
+                    {
+                        _position++;
+                    }
+                }
+                else
+                {
+                    return;
+                }
+            }
+        }
+
+        private void Expect(char c)
+        {
+            if (AtEnd || Peek() != c)
+            {
+                throw Error($"Expected '{c}'");
+            }
+
+            _position++;
+        }
+
+        private bool AtEnd => _position >= _text.Length;
+
+        private char Peek() => _text[_position];
+
+        private FormatException Error(string message)
+        {
+            var line = 1;
+            for (var i = 0; i < Math.Min(_position, _text.Length); i++)
+            {
+                if (_text[i] == '\n')
+                {
+                    line++;
+                }
+            }
+
+            return new FormatException($"{message} on line {line}");
+        }
+    }
+}
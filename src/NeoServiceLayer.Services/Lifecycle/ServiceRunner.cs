@@ -0,0 +1,207 @@
+using System;
+using System.Collections.Generic;
+using System.Diagnostics;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Lifecycle
+{
+    /// <summary>
+    /// Implementation of the service runner
+    /// </summary>
+    /// <remarks>
+    /// Services start in dependency order, keeping registration order where they do not depend on each other, and stop
+    /// in the reverse of that order, so a service never runs while something it relies on is stopped. In particular,
+    /// leader-only services stop before the leadership lease is released, and a standby does not take over while this
+    /// instance is still doing leader work. A dependency that is not registered in this host is ignored. Stops run one
+    /// at a time, each bounded by its own timeout; a service that does not stop in time is left running and shutdown
+    /// moves on, so one stuck service cannot hold up the rest.
+    /// </remarks>
+    public class ServiceRunner : IServiceRunner
+    {
+        private readonly ILogger<ServiceRunner> _logger;
+        private readonly IReadOnlyList<IManagedService> _services;
+        private readonly ServiceRunnerConfiguration _configuration;
+        private readonly List<IManagedService> _started = new List<IManagedService>();
+        private readonly object _lock = new object();
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ServiceRunner"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="services">Managed services</param>
+        /// <param name="configuration">Runner configuration</param>
+        /// <exception cref="InvalidOperationException">Two services share a name or the dependencies form a cycle</exception>
+        public ServiceRunner(ILogger<ServiceRunner> logger, IEnumerable<IManagedService> services, IOptions<ServiceRunnerConfiguration> configuration)
+        {
+            _logger = logger;
+            _configuration = configuration.Value;
+            _services = Order(services.ToList());
+        }
+
+        /// <inheritdoc/>
+        public async Task StartAllAsync()
+        {
+            foreach (var service in _services)
+            {
+                lock (_lock)
+                {
+                    if (_started.Contains(service))
+                    {
+                        continue;
+                    }
+                }
+
+                try
+                {
+                    await service.StartAsync();
+                }
+                catch (Exception ex)
+                {
+                    // Its dependents still start; they already tolerate it being unavailable at runtime
+                    _logger.LogError(ex, "Service {Service} failed to start", service.Name);
+                    continue;
+                }
+
+                lock (_lock)
+                {
+                    _started.Add(service);
+                }
+            }
+
+            _logger.LogInformation("Started services in order: {Services}", string.Join(", ", _started.Select(s => s.Name)));
+        }
+
+        /// <inheritdoc/>
+        public async Task<ShutdownReport> StopAllAsync()
+        {
+            var report = new ShutdownReport { StartedAt = DateTime.UtcNow };
+
+            List<IManagedService> stopping;
+            lock (_lock)
+            {
+                stopping = Enumerable.Reverse(_started).ToList();
+                _started.Clear();
+            }
+
+            foreach (var service in stopping)
+            {
+                report.Services.Add(await StopAsync(service));
+            }
+
+            report.CompletedAt = DateTime.UtcNow;
+
+            var unclean = report.Services.Where(s => s.Outcome != ServiceStopOutcome.Stopped).ToList();
+            if (unclean.Count > 0)
+            {
+                _logger.LogWarning("Shutdown finished in {Duration} ms; {Count} services did not stop cleanly: {Services}",
+                    (long)(report.CompletedAt - report.StartedAt).TotalMilliseconds,
+                    unclean.Count,
+                    string.Join("; ", unclean.Select(s => $"{s.Name} {s.Outcome}: {s.Error}")));
+            }
+            else
+            {
+                _logger.LogInformation("Shutdown finished in {Duration} ms; stopped {Services}",
+                    (long)(report.CompletedAt - report.StartedAt).TotalMilliseconds,
+                    string.Join(", ", report.Services.Select(s => s.Name)));
+            }
+
+            return report;
+        }
+
+        /// <inheritdoc/>
+        public IReadOnlyList<ManagedServiceHealth> GetHealth()
+        {
+            return _services.Select(service =>
+            {
+                try
+                {
+                    return service.GetHealth();
+                }
+                catch (Exception ex)
+                {
+                    return ManagedServiceHealth.Of(service.Name, ManagedServiceStatus.Stopped, $"Health unavailable: {ex.Message}");
+                }
+            }).ToList();
+        }
+
+        private async Task<ServiceStopResult> StopAsync(IManagedService service)
+        {
+            var result = new ServiceStopResult { Name = service.Name };
+            var timeout = TimeSpan.FromSeconds(Math.Max(1, _configuration.ServiceStopTimeouts.TryGetValue(service.Name, out var seconds)
+                ? seconds
+                : _configuration.StopTimeoutSeconds));
+            var stopwatch = Stopwatch.StartNew();
+
+            try
+            {
+                var stop = service.StopAsync();
+                if (await Task.WhenAny(stop, Task.Delay(timeout)) == stop)
+                {
+                    await stop;
+                    result.Outcome = ServiceStopOutcome.Stopped;
+                }
+                else
+                {
+                    result.Outcome = ServiceStopOutcome.TimedOut;
+                    result.Error = $"Did not stop within {timeout.TotalSeconds:0} seconds";
+                }
+            }
+            catch (Exception ex)
+            {
+                result.Outcome = ServiceStopOutcome.Failed;
+                result.Error = ex.Message;
+            }
+
+            result.DurationMs = stopwatch.ElapsedMilliseconds;
+
+            if (result.Outcome == ServiceStopOutcome.Stopped)
+            {
+                _logger.LogInformation("Stopped service {Service} in {Duration} ms", service.Name, result.DurationMs);
+            }
+            else
+            {
+                _logger.LogWarning("Service {Service} did not stop cleanly: {Outcome} after {Duration} ms: {Error}",
+                    service.Name, result.Outcome, result.DurationMs, result.Error);
+            }
+
+            return result;
+        }
+
+        private static IReadOnlyList<IManagedService> Order(List<IManagedService> services)
+        {
+            var duplicate = services.GroupBy(s => s.Name).FirstOrDefault(g => g.Count() > 1);
+            if (duplicate != null)
+            {
+                throw new InvalidOperationException($"More than one managed service is named {duplicate.Key}");
+            }
+
+            var names = services.Select(s => s.Name).ToHashSet();
+            var ordered = new List<IManagedService>(services.Count);
+            var remaining = new List<IManagedService>(services);
+
+            // Take the first registered service whose registered dependencies have all been taken
+            while (remaining.Count > 0)
+            {
+                var next = remaining.FirstOrDefault(s => (s.DependsOn ?? Array.Empty<string>())
+                    .Where(names.Contains)
+                    .All(d => ordered.Any(o => o.Name == d)));
+
+                if (next == null)
+                {
+                    throw new InvalidOperationException(
+                        $"Managed service dependencies form a cycle among {string.Join(", ", remaining.Select(s => s.Name))}");
+                }
+
+                ordered.Add(next);
+                remaining.Remove(next);
+            }
+
+            return ordered;
+        }
+    }
+}
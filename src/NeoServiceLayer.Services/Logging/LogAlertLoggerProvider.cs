@@ -0,0 +1,96 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Logging
+{
+    /// <summary>
+    /// Logger provider that copies log entries to the <see cref="LogAlertQueue"/> for log alert rules
+    /// </summary>
+    /// <remarks>
+    /// The provider only depends on the queue, since anything resolving a logger itself would be a dependency cycle.
+    /// Entries of the log alert components are not captured, so evaluating rules cannot trigger them.
+    /// </remarks>
+    public sealed class LogAlertLoggerProvider : ILoggerProvider
+    {
+        private static readonly string OwnCategoryPrefix = typeof(LogAlertLoggerProvider).Namespace;
+
+        private readonly LogAlertQueue _queue;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="LogAlertLoggerProvider"/> class
+        /// </summary>
+        /// <param name="queue">Queue the entries are copied to</param>
+        public LogAlertLoggerProvider(LogAlertQueue queue)
+        {
+            _queue = queue;
+        }
+
+        /// <inheritdoc/>
+        public ILogger CreateLogger(string categoryName)
+        {
+            return new LogAlertLogger(categoryName, _queue, categoryName.StartsWith(OwnCategoryPrefix, StringComparison.Ordinal));
+        }
+
+        /// <inheritdoc/>
+        public void Dispose()
+        {
+        }
+
+        private sealed class LogAlertLogger : ILogger
+        {
+            private readonly string _category;
+            private readonly LogAlertQueue _queue;
+            private readonly bool _ignored;
+
+            public LogAlertLogger(string category, LogAlertQueue queue, bool ignored)
+            {
+                _category = category;
+                _queue = queue;
+                _ignored = ignored;
+            }
+
+            public IDisposable BeginScope<TState>(TState state)
+            {
+                return null;
+            }
+
+            public bool IsEnabled(LogLevel logLevel)
+            {
+                return !_ignored && _queue.IsEnabled(logLevel);
+            }
+
+            public void Log<TState>(LogLevel logLevel, EventId eventId, TState state, Exception exception, Func<TState, Exception, string> formatter)
+            {
+                if (!IsEnabled(logLevel))
+                {
+                    return;
+                }
+
+                var record = new LogRecord
+                {
+                    Timestamp = DateTime.UtcNow,
+                    Level = logLevel,
+                    Category = _category,
+                    Message = formatter != null ? formatter(state, exception) : state?.ToString(),
+                    ExceptionMessage = exception?.Message
+                };
+
+                if (state is IEnumerable<KeyValuePair<string, object>> fields)
+                {
+                    foreach (var field in fields)
+                    {
+                        if (field.Key != "{OriginalFormat}")
+                        {
+                            record.Fields[field.Key] = Convert.ToString(field.Value, CultureInfo.InvariantCulture);
+                        }
+                    }
+                }
+
+                _queue.Add(record);
+            }
+        }
+    }
+}
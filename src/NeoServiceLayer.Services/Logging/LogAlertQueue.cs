@@ -0,0 +1,68 @@
+using System.Collections.Generic;
+using System.Threading.Channels;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Logging
+{
+    /// <summary>
+    /// Holds log entries captured by <see cref="LogAlertLoggerProvider"/> until the alert rules are evaluated
+    /// </summary>
+    /// <remarks>
+    /// The queue is bounded and drops the oldest entries when full, so logging never blocks on alert evaluation.
+    /// </remarks>
+    public class LogAlertQueue
+    {
+        private readonly Channel<LogRecord> _channel;
+        private readonly LogAlertConfiguration _configuration;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="LogAlertQueue"/> class
+        /// </summary>
+        /// <param name="configuration">Log alert configuration</param>
+        public LogAlertQueue(IOptions<LogAlertConfiguration> configuration = null)
+        {
+            _configuration = configuration?.Value ?? new LogAlertConfiguration();
+            _channel = Channel.CreateBounded<LogRecord>(new BoundedChannelOptions(_configuration.QueueCapacity)
+            {
+                FullMode = BoundedChannelFullMode.DropOldest,
+                SingleReader = true
+            });
+        }
+
+        /// <summary>
+        /// Gets whether entries of a level are captured
+        /// </summary>
+        /// <param name="level">Log level</param>
+        /// <returns>True if entries of the level are captured</returns>
+        public bool IsEnabled(LogLevel level)
+        {
+            return _configuration.Enabled && level != LogLevel.None && level >= _configuration.MinimumLevel;
+        }
+
+        /// <summary>
+        /// Adds an entry
+        /// </summary>
+        /// <param name="record">Log entry</param>
+        public void Add(LogRecord record)
+        {
+            _channel.Writer.TryWrite(record);
+        }
+
+        /// <summary>
+        /// Removes and returns every entry added so far
+        /// </summary>
+        /// <returns>Entries, oldest first</returns>
+        public List<LogRecord> Drain()
+        {
+            var records = new List<LogRecord>();
+            while (_channel.Reader.TryRead(out var record))
+            {
+                records.Add(record);
+            }
+
+            return records;
+        }
+    }
+}
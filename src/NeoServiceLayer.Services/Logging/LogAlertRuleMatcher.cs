@@ -0,0 +1,68 @@
+using System;
+using System.Linq;
+using System.Text.RegularExpressions;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Logging
+{
+    /// <summary>
+    /// Pure matching logic for log alert rules
+    /// </summary>
+    public static class LogAlertRuleMatcher
+    {
+        private static readonly TimeSpan MatchTimeout = TimeSpan.FromMilliseconds(100);
+
+        /// <summary>
+        /// Compiles a rule's pattern
+        /// </summary>
+        /// <param name="pattern">Regular expression</param>
+        /// <returns>The compiled pattern, or null if the rule has none</returns>
+        /// <exception cref="ArgumentException">The pattern is not a valid regular expression</exception>
+        public static Regex CreatePattern(string pattern)
+        {
+            return string.IsNullOrEmpty(pattern) ? null : new Regex(pattern, RegexOptions.CultureInvariant, MatchTimeout);
+        }
+
+        /// <summary>
+        /// Checks whether a log entry matches a rule
+        /// </summary>
+        /// <param name="rule">Rule</param>
+        /// <param name="pattern">The rule's compiled pattern, or null if it has none</param>
+        /// <param name="record">Log entry</param>
+        /// <returns>True if the entry matches every filter of the rule</returns>
+        public static bool Matches(LogAlertRule rule, Regex pattern, LogRecord record)
+        {
+            if (record.Level < rule.MinimumLevel)
+            {
+                return false;
+            }
+
+            if (!string.IsNullOrEmpty(rule.Category) &&
+                (record.Category == null || !record.Category.StartsWith(rule.Category, StringComparison.Ordinal)))
+            {
+                return false;
+            }
+
+            if (rule.Fields != null && rule.Fields.Any(f =>
+                    !record.Fields.TryGetValue(f.Key, out var value) || !string.Equals(value, f.Value, StringComparison.OrdinalIgnoreCase)))
+            {
+                return false;
+            }
+
+            if (pattern == null)
+            {
+                return true;
+            }
+
+            try
+            {
+                return (record.Message != null && pattern.IsMatch(record.Message)) ||
+                       (record.ExceptionMessage != null && pattern.IsMatch(record.ExceptionMessage));
+            }
+            catch (RegexMatchTimeoutException)
+            {
+                return false;
+            }
+        }
+    }
+}
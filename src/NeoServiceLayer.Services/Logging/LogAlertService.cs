@@ -0,0 +1,417 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.Linq;
+using System.Text.RegularExpressions;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Diagnostics;
+using NeoServiceLayer.Services.Logging.Repositories;
+
+namespace NeoServiceLayer.Services.Logging
+{
+    /// <summary>
+    /// Implementation of the log alert service
+    /// </summary>
+    /// <remarks>
+    /// Captured entries are matched against the enabled rules every evaluation interval. Match times are kept in
+    /// memory per rule, so a restart starts every window empty. A rule fires once its matches within the window reach
+    /// its threshold, and does not notify again until the count has dropped below the threshold and the rule resolved.
+    /// </remarks>
+    public class LogAlertService : ILogAlertService, IManagedService, IDisposable
+    {
+        private readonly ILogger<LogAlertService> _logger;
+        private readonly ILogAlertRuleRepository _ruleRepository;
+        private readonly LogAlertQueue _queue;
+        private readonly INotificationService _notificationService;
+        private readonly LogAlertConfiguration _configuration;
+        private readonly ICrashReporter _crashReporter;
+        private readonly ConcurrentDictionary<Guid, Queue<DateTime>> _windows = new ConcurrentDictionary<Guid, Queue<DateTime>>();
+        private readonly ConcurrentDictionary<Guid, (string Source, Regex Pattern)> _patterns = new ConcurrentDictionary<Guid, (string Source, Regex Pattern)>();
+        private readonly SemaphoreSlim _evaluationSemaphore = new SemaphoreSlim(1, 1);
+        private Timer _evaluationTimer;
+        private volatile bool _stopped;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="LogAlertService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="ruleRepository">Log alert rule repository</param>
+        /// <param name="queue">Queue of captured log entries</param>
+        /// <param name="notificationService">Notification service used to dispatch alerts</param>
+        /// <param name="configuration">Log alert configuration</param>
+        /// <param name="crashReporter">Crash reporter for background work</param>
+        public LogAlertService(
+            ILogger<LogAlertService> logger,
+            ILogAlertRuleRepository ruleRepository,
+            LogAlertQueue queue,
+            INotificationService notificationService = null,
+            IOptions<LogAlertConfiguration> configuration = null,
+            ICrashReporter crashReporter = null)
+        {
+            _logger = logger;
+            _ruleRepository = ruleRepository;
+            _queue = queue;
+            _notificationService = notificationService;
+            _configuration = configuration?.Value ?? new LogAlertConfiguration();
+            _crashReporter = crashReporter;
+
+            if (_configuration.Enabled && _configuration.EvaluationIntervalSeconds > 0)
+            {
+                _evaluationTimer = new Timer(
+                    _crashReporter.Guard(_logger, "LogAlerts.Evaluate", EvaluateAsync),
+                    null,
+                    TimeSpan.FromSeconds(_configuration.EvaluationIntervalSeconds),
+                    TimeSpan.FromSeconds(_configuration.EvaluationIntervalSeconds));
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<LogAlertRule> CreateAlertRuleAsync(LogAlertRule rule)
+        {
+            ValidateAlertRule(rule);
+
+            var existing = await _ruleRepository.GetAllAsync();
+            if (existing.Count() >= _configuration.MaxRules)
+            {
+                throw new ArgumentException($"No more than {_configuration.MaxRules} log alert rules can be defined");
+            }
+
+            ResetAlertState(rule);
+            rule.Id = Guid.Empty;
+            return await _ruleRepository.CreateAsync(rule);
+        }
+
+        /// <inheritdoc/>
+        public Task<LogAlertRule> GetAlertRuleAsync(Guid id)
+        {
+            return _ruleRepository.GetByIdAsync(id);
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<LogAlertRule>> GetAlertRulesAsync()
+        {
+            return _ruleRepository.GetAllAsync();
+        }
+
+        /// <inheritdoc/>
+        public async Task<LogAlertRule> UpdateAlertRuleAsync(LogAlertRule rule)
+        {
+            ValidateAlertRule(rule);
+
+            await _evaluationSemaphore.WaitAsync();
+            try
+            {
+                var existing = await _ruleRepository.GetByIdAsync(rule.Id);
+                if (existing == null)
+                {
+                    return null;
+                }
+
+                rule.AccountId = existing.AccountId;
+                rule.CreatedAt = existing.CreatedAt;
+                ResetAlertState(rule);
+                _windows.TryRemove(rule.Id, out _);
+                _patterns.TryRemove(rule.Id, out _);
+
+                return await _ruleRepository.UpdateAsync(rule);
+            }
+            finally
+            {
+                _evaluationSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAlertRuleAsync(Guid id)
+        {
+            await _evaluationSemaphore.WaitAsync();
+            try
+            {
+                _windows.TryRemove(id, out _);
+                _patterns.TryRemove(id, out _);
+                return await _ruleRepository.DeleteAsync(id);
+            }
+            finally
+            {
+                _evaluationSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<int> EvaluateAsync()
+        {
+            if (!await _evaluationSemaphore.WaitAsync(0))
+            {
+                return 0;
+            }
+
+            try
+            {
+                var records = _queue.Drain();
+                var now = DateTime.UtcNow;
+                var dispatched = 0;
+
+                foreach (var rule in await _ruleRepository.GetEnabledAsync())
+                {
+                    dispatched += await EvaluateAlertRuleAsync(rule, records, now);
+                }
+
+                return dispatched;
+            }
+            finally
+            {
+                _evaluationSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public string Name => Constants.ManagedServices.LogAlerts;
+
+        /// <inheritdoc/>
+        public IReadOnlyCollection<string> DependsOn { get; } = Array.Empty<string>();
+
+        /// <summary>
+        /// Resumes evaluation after <see cref="StopAsync"/>; it runs from construction, so this is only needed to restart it
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public Task StartAsync()
+        {
+            if (_stopped)
+            {
+                _stopped = false;
+                _evaluationTimer?.Change(
+                    TimeSpan.FromSeconds(_configuration.EvaluationIntervalSeconds),
+                    TimeSpan.FromSeconds(_configuration.EvaluationIntervalSeconds));
+            }
+
+            return Task.CompletedTask;
+        }
+
+        /// <summary>
+        /// Stops evaluation and waits for an evaluation in progress to dispatch its alerts
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task StopAsync()
+        {
+            _stopped = true;
+            _evaluationTimer?.Change(Timeout.Infinite, Timeout.Infinite);
+
+            await _evaluationSemaphore.WaitAsync();
+            _evaluationSemaphore.Release();
+        }
+
+        /// <inheritdoc/>
+        public ManagedServiceHealth GetHealth()
+        {
+            if (_evaluationTimer == null)
+            {
+                return ManagedServiceHealth.Of(Name, ManagedServiceStatus.Disabled);
+            }
+
+            return ManagedServiceHealth.Of(Name, _stopped ? ManagedServiceStatus.Stopped : ManagedServiceStatus.Running);
+        }
+
+        /// <summary>
+        /// Disposes the service
+        /// </summary>
+        public void Dispose()
+        {
+            _evaluationTimer?.Dispose();
+            _evaluationSemaphore.Dispose();
+        }
+
+        /// <summary>
+        /// Counts a rule's matches in its window and fires or resolves it
+        /// </summary>
+        /// <param name="rule">Rule</param>
+        /// <param name="records">Entries captured since the last evaluation</param>
+        /// <param name="now">Evaluation time</param>
+        /// <returns>Number of notifications dispatched</returns>
+        private async Task<int> EvaluateAlertRuleAsync(LogAlertRule rule, List<LogRecord> records, DateTime now)
+        {
+            var pattern = GetPattern(rule);
+            var matches = records.Where(r => LogAlertRuleMatcher.Matches(rule, pattern, r)).ToList();
+
+            var window = _windows.GetOrAdd(rule.Id, _ => new Queue<DateTime>());
+            foreach (var match in matches)
+            {
+                window.Enqueue(match.Timestamp);
+            }
+
+            var windowStart = now.AddSeconds(-rule.WindowSeconds);
+            while (window.Count > 0 && window.Peek() < windowStart)
+            {
+                window.Dequeue();
+            }
+
+            var changed = matches.Count > 0;
+            if (changed)
+            {
+                rule.MatchCount += matches.Count;
+                rule.LastMatchedAt = matches[^1].Timestamp;
+                rule.LastMatchedMessage = matches[^1].Message;
+            }
+
+            var dispatched = 0;
+            if (window.Count >= rule.Threshold && rule.State != MetricAlertState.Firing)
+            {
+                rule.State = MetricAlertState.Firing;
+                rule.LastFiredAt = now;
+                changed = true;
+                await DispatchAlertAsync(rule, true, window.Count);
+                dispatched++;
+            }
+            else if (window.Count < rule.Threshold && rule.State == MetricAlertState.Firing)
+            {
+                rule.State = MetricAlertState.Ok;
+                changed = true;
+                if (rule.NotifyOnResolve)
+                {
+                    await DispatchAlertAsync(rule, false, window.Count);
+                    dispatched++;
+                }
+            }
+
+            if (changed)
+            {
+                await _ruleRepository.UpdateAsync(rule);
+            }
+
+            return dispatched;
+        }
+
+        /// <summary>
+        /// Gets a rule's compiled pattern, compiling it again only when the pattern changed
+        /// </summary>
+        /// <param name="rule">Rule</param>
+        /// <returns>The compiled pattern, or null if the rule has none</returns>
+        private Regex GetPattern(LogAlertRule rule)
+        {
+            if (_patterns.TryGetValue(rule.Id, out var cached) && cached.Source == rule.Pattern)
+            {
+                return cached.Pattern;
+            }
+
+            var pattern = LogAlertRuleMatcher.CreatePattern(rule.Pattern);
+            _patterns[rule.Id] = (rule.Pattern, pattern);
+            return pattern;
+        }
+
+        /// <summary>
+        /// Dispatches an alert notification through the notification service
+        /// </summary>
+        /// <param name="rule">Rule</param>
+        /// <param name="fired">True if the alert fired, false if it resolved</param>
+        /// <param name="count">Matches within the window</param>
+        private async Task DispatchAlertAsync(LogAlertRule rule, bool fired, int count)
+        {
+            if (_notificationService == null)
+            {
+                _logger.LogWarning("Notification service is not available, log alert {RuleId} was not dispatched", rule.Id);
+                return;
+            }
+
+            var content = fired
+                ? $"{count} matching log entries in the last {rule.WindowSeconds}s (threshold {rule.Threshold}). Last: {rule.LastMatchedMessage}"
+                : $"Matching log entries are back below {rule.Threshold} in the last {rule.WindowSeconds}s";
+
+            var notification = new Core.Models.Notification
+            {
+                AccountId = rule.AccountId,
+                Type = NotificationType.Event,
+                Priority = fired ? NotificationPriority.High : NotificationPriority.Normal,
+                Subject = $"Log alert {(fired ? "firing" : "resolved")}: {rule.Name}",
+                Content = content,
+                Channels = rule.Channels.Any() ? rule.Channels.ToList() : new List<NotificationChannel> { NotificationChannel.InApp },
+                Data = new Dictionary<string, object>
+                {
+                    { "RuleId", rule.Id },
+                    { "RuleName", rule.Name },
+                    { "State", rule.State.ToString() },
+                    { "Count", count },
+                    { "Threshold", rule.Threshold },
+                    { "WindowSeconds", rule.WindowSeconds },
+                    { "LastMatchedAt", rule.LastMatchedAt },
+                    { "LastMatchedMessage", rule.LastMatchedMessage }
+                }
+            };
+
+            try
+            {
+                await _notificationService.SendNotificationAsync(notification);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error dispatching log alert {RuleId}", rule.Id);
+            }
+        }
+
+        /// <summary>
+        /// Validates an alert rule
+        /// </summary>
+        /// <param name="rule">Rule to validate</param>
+        private void ValidateAlertRule(LogAlertRule rule)
+        {
+            if (rule == null)
+            {
+                throw new ArgumentException("Alert rule is required");
+            }
+
+            if (string.IsNullOrWhiteSpace(rule.Name))
+            {
+                throw new ArgumentException("Alert rule name is required");
+            }
+
+            rule.Fields ??= new Dictionary<string, string>();
+            if (string.IsNullOrEmpty(rule.Pattern) && string.IsNullOrEmpty(rule.Category) && rule.Fields.Count == 0)
+            {
+                throw new ArgumentException("A pattern, category or field is required");
+            }
+
+            try
+            {
+                LogAlertRuleMatcher.CreatePattern(rule.Pattern);
+            }
+            catch (ArgumentException ex)
+            {
+                throw new ArgumentException($"Invalid pattern: {ex.Message}");
+            }
+
+            if (!Enum.IsDefined(typeof(LogLevel), rule.MinimumLevel) || rule.MinimumLevel == LogLevel.None)
+            {
+                throw new ArgumentException($"Unsupported minimum level: {rule.MinimumLevel}");
+            }
+
+            if (rule.Threshold < 1)
+            {
+                throw new ArgumentException("Threshold must be at least 1");
+            }
+
+            if (rule.WindowSeconds < 1 || rule.WindowSeconds > _configuration.MaxWindowSeconds)
+            {
+                throw new ArgumentException($"Window must be between 1 and {_configuration.MaxWindowSeconds} seconds");
+            }
+
+            rule.Channels ??= new List<NotificationChannel>();
+        }
+
+        /// <summary>
+        /// Resets the evaluation state of an alert rule
+        /// </summary>
+        /// <param name="rule">Rule</param>
+        private static void ResetAlertState(LogAlertRule rule)
+        {
+            rule.State = MetricAlertState.Ok;
+            rule.MatchCount = 0;
+            rule.LastMatchedAt = null;
+            rule.LastMatchedMessage = null;
+            rule.LastFiredAt = null;
+        }
+    }
+}
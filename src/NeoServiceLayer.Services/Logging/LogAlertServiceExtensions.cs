@@ -0,0 +1,42 @@
+using Microsoft.Extensions.Configuration;
+using Microsoft.Extensions.DependencyInjection;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Logging.Repositories;
+
+namespace NeoServiceLayer.Services.Logging
+{
+    /// <summary>
+    /// Extension methods for registering log alert services
+    /// </summary>
+    public static class LogAlertServiceExtensions
+    {
+        /// <summary>
+        /// Adds log capture and log alert services to the service collection
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <param name="configuration">Configuration</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddLogAlertServices(this IServiceCollection services, IConfiguration configuration = null)
+        {
+            // Register options
+            if (configuration != null)
+            {
+                services.Configure<LogAlertConfiguration>(options => configuration.GetSection("LogAlerts").Bind(options));
+            }
+
+            // Register log capture
+            services.AddSingleton<LogAlertQueue>();
+            services.AddSingleton<ILoggerProvider, LogAlertLoggerProvider>();
+
+            // Register repositories
+            services.AddSingleton<ILogAlertRuleRepository, LogAlertRuleRepository>();
+
+            // Register services
+            services.AddSingleton<ILogAlertService, LogAlertService>();
+
+            return services;
+        }
+    }
+}
@@ -0,0 +1,53 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Logging.Repositories
+{
+    /// <summary>
+    /// Interface for log alert rule repository
+    /// </summary>
+    public interface ILogAlertRuleRepository
+    {
+        /// <summary>
+        /// Creates an alert rule
+        /// </summary>
+        /// <param name="rule">Rule to create</param>
+        /// <returns>The created rule</returns>
+        Task<LogAlertRule> CreateAsync(LogAlertRule rule);
+
+        /// <summary>
+        /// Gets an alert rule by ID
+        /// </summary>
+        /// <param name="id">Rule ID</param>
+        /// <returns>The rule if found, null otherwise</returns>
+        Task<LogAlertRule> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets all alert rules
+        /// </summary>
+        /// <returns>List of rules</returns>
+        Task<IEnumerable<LogAlertRule>> GetAllAsync();
+
+        /// <summary>
+        /// Gets all enabled alert rules
+        /// </summary>
+        /// <returns>List of enabled rules</returns>
+        Task<IEnumerable<LogAlertRule>> GetEnabledAsync();
+
+        /// <summary>
+        /// Updates an alert rule
+        /// </summary>
+        /// <param name="rule">Rule to update</param>
+        /// <returns>The updated rule</returns>
+        Task<LogAlertRule> UpdateAsync(LogAlertRule rule);
+
+        /// <summary>
+        /// Deletes an alert rule
+        /// </summary>
+        /// <param name="id">Rule ID</param>
+        /// <returns>True if the rule was deleted, false otherwise</returns>
+        Task<bool> DeleteAsync(Guid id);
+    }
+}
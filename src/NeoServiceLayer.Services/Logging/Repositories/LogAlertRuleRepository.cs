@@ -0,0 +1,153 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Logging.Repositories
+{
+    /// <summary>
+    /// Implementation of the log alert rule repository
+    /// </summary>
+    public class LogAlertRuleRepository : ILogAlertRuleRepository
+    {
+        private readonly ILogger<LogAlertRuleRepository> _logger;
+        private readonly IDatabaseService _databaseService;
+        private const string CollectionName = "log_alert_rules";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="LogAlertRuleRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="databaseService">Database service</param>
+        public LogAlertRuleRepository(ILogger<LogAlertRuleRepository> logger, IDatabaseService databaseService)
+        {
+            _logger = logger;
+            _databaseService = databaseService;
+        }
+
+        /// <inheritdoc/>
+        public async Task<LogAlertRule> CreateAsync(LogAlertRule rule)
+        {
+            _logger.LogInformation("Creating log alert rule: {Name}", rule.Name);
+
+            try
+            {
+                if (rule.Id == Guid.Empty)
+                {
+                    rule.Id = Guid.NewGuid();
+                }
+
+                rule.CreatedAt = DateTime.UtcNow;
+                rule.UpdatedAt = DateTime.UtcNow;
+
+                // Create collection if it doesn't exist
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    await _databaseService.CreateCollectionAsync(CollectionName);
+                }
+
+                return await _databaseService.CreateAsync(CollectionName, rule);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error creating log alert rule: {Name}", rule.Name);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<LogAlertRule> GetByIdAsync(Guid id)
+        {
+            _logger.LogInformation("Getting log alert rule by ID: {Id}", id);
+
+            try
+            {
+                return await _databaseService.GetByIdAsync<LogAlertRule, Guid>(CollectionName, id);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting log alert rule by ID: {Id}", id);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<LogAlertRule>> GetAllAsync()
+        {
+            _logger.LogInformation("Getting log alert rules");
+
+            try
+            {
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    return new List<LogAlertRule>();
+                }
+
+                return await _databaseService.GetAllAsync<LogAlertRule>(CollectionName);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting log alert rules");
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<LogAlertRule>> GetEnabledAsync()
+        {
+            _logger.LogDebug("Getting enabled log alert rules");
+
+            try
+            {
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    return new List<LogAlertRule>();
+                }
+
+                return await _databaseService.GetByFilterAsync<LogAlertRule>(
+                    CollectionName,
+                    r => r.IsEnabled);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting enabled log alert rules");
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<LogAlertRule> UpdateAsync(LogAlertRule rule)
+        {
+            _logger.LogInformation("Updating log alert rule: {Id}", rule.Id);
+
+            try
+            {
+                rule.UpdatedAt = DateTime.UtcNow;
+                return await _databaseService.UpdateAsync<LogAlertRule, Guid>(CollectionName, rule.Id, rule);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error updating log alert rule: {Id}", rule.Id);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAsync(Guid id)
+        {
+            _logger.LogInformation("Deleting log alert rule: {Id}", id);
+
+            try
+            {
+                return await _databaseService.DeleteAsync<LogAlertRule, Guid>(CollectionName, id);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error deleting log alert rule: {Id}", id);
+                throw;
+            }
+        }
+    }
+}
@@ -0,0 +1,282 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Diagnostics;
+using NeoServiceLayer.Services.Maintenance.Repositories;
+
+namespace NeoServiceLayer.Services.Maintenance
+{
+    /// <summary>
+    /// Implementation of the maintenance mode service
+    /// </summary>
+    /// <remarks>
+    /// Maintenance windows are persisted and reloaded on every check so that all instances agree on
+    /// the current mode. Function invocations queued during maintenance are held in memory and run in
+    /// order once no window is active.
+    /// </remarks>
+    public class MaintenanceService : IMaintenanceService, IDisposable
+    {
+        private readonly ILogger<MaintenanceService> _logger;
+        private readonly IMaintenanceWindowRepository _windowRepository;
+        private readonly IFunctionService _functionService;
+        private readonly ICrashReporter _crashReporter;
+        private readonly MaintenanceConfiguration _configuration;
+        private readonly ConcurrentQueue<QueuedInvocation> _queue = new ConcurrentQueue<QueuedInvocation>();
+        private readonly SemaphoreSlim _checkSemaphore = new SemaphoreSlim(1, 1);
+        private readonly Timer _checkTimer;
+
+        private List<MaintenanceWindow> _windows;
+        private bool _wasActive;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="MaintenanceService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="windowRepository">Maintenance window repository</param>
+        /// <param name="functionService">Function service used to run queued invocations</param>
+        /// <param name="configuration">Configuration</param>
+        /// <param name="crashReporter">Crash reporter for background work</param>
+        public MaintenanceService(
+            ILogger<MaintenanceService> logger,
+            IMaintenanceWindowRepository windowRepository,
+            IFunctionService functionService,
+            IOptions<MaintenanceConfiguration> configuration,
+            ICrashReporter crashReporter = null)
+        {
+            _logger = logger;
+            _windowRepository = windowRepository;
+            _functionService = functionService;
+            _configuration = configuration.Value;
+            _crashReporter = crashReporter;
+
+            _checkTimer = new Timer(
+                _crashReporter.Guard(_logger, "Maintenance.Check", CheckAsync),
+                null,
+                TimeSpan.FromSeconds(_configuration.CheckIntervalSeconds),
+                TimeSpan.FromSeconds(_configuration.CheckIntervalSeconds));
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> IsActiveAsync()
+        {
+            var now = DateTime.UtcNow;
+            var windows = await GetCachedWindowsAsync();
+            return windows.Any(w => w.IsActiveAt(now));
+        }
+
+        /// <inheritdoc/>
+        public async Task<MaintenanceStatus> GetStatusAsync()
+        {
+            var now = DateTime.UtcNow;
+            var windows = await GetCachedWindowsAsync();
+            var active = windows.Where(w => w.IsActiveAt(now)).OrderBy(w => w.StartTime).ToList();
+            var expectedEndTime = active.Any() && active.All(w => w.EndTime.HasValue) ? active.Max(w => w.EndTime) : null;
+
+            return new MaintenanceStatus
+            {
+                IsActive = active.Any(),
+                Reason = active.FirstOrDefault()?.Reason,
+                StartedAt = active.FirstOrDefault()?.StartTime,
+                ExpectedEndTime = expectedEndTime,
+                RetryAfterSeconds = !active.Any() ? 0 : expectedEndTime.HasValue
+                    ? Math.Max(1, (int)Math.Ceiling((expectedEndTime.Value - now).TotalSeconds))
+                    : _configuration.DefaultRetryAfterSeconds,
+                QueuedInvocations = _queue.Count,
+                UpcomingWindows = windows.Where(w => w.StartTime > now).OrderBy(w => w.StartTime).ToList()
+            };
+        }
+
+        /// <inheritdoc/>
+        public async Task<MaintenanceStatus> EnableAsync(string reason, string enabledBy, DateTime? expectedEndTime = null)
+        {
+            var now = DateTime.UtcNow;
+            if (expectedEndTime.HasValue && expectedEndTime.Value.ToUniversalTime() <= now)
+            {
+                throw new ArgumentException("Expected end time must be in the future", nameof(expectedEndTime));
+            }
+
+            _logger.LogWarning("Maintenance mode enabled by {User}: {Reason}", enabledBy, reason);
+
+            await _windowRepository.CreateAsync(new MaintenanceWindow
+            {
+                Reason = string.IsNullOrEmpty(reason) ? "Maintenance in progress" : reason,
+                StartTime = now,
+                EndTime = expectedEndTime?.ToUniversalTime(),
+                CreatedBy = enabledBy
+            });
+
+            await ReloadWindowsAsync();
+            return await GetStatusAsync();
+        }
+
+        /// <inheritdoc/>
+        public async Task<MaintenanceStatus> DisableAsync(string disabledBy)
+        {
+            var now = DateTime.UtcNow;
+            var windows = await _windowRepository.GetAllAsync();
+
+            foreach (var window in windows.Where(w => w.IsActiveAt(now)))
+            {
+                window.EndTime = now;
+                await _windowRepository.UpdateAsync(window);
+            }
+
+            _logger.LogWarning("Maintenance mode disabled by {User}", disabledBy);
+
+            await ReloadWindowsAsync();
+
+            // Release queued invocations without waiting for the next check
+            _checkTimer.Change(TimeSpan.Zero, TimeSpan.FromSeconds(_configuration.CheckIntervalSeconds));
+
+            return await GetStatusAsync();
+        }
+
+        /// <inheritdoc/>
+        public async Task<MaintenanceWindow> ScheduleAsync(DateTime startTime, DateTime endTime, string reason, string createdBy)
+        {
+            startTime = startTime.ToUniversalTime();
+            endTime = endTime.ToUniversalTime();
+
+            if (endTime <= startTime)
+            {
+                throw new ArgumentException("End time must be after start time", nameof(endTime));
+            }
+
+            if (endTime <= DateTime.UtcNow)
+            {
+                throw new ArgumentException("End time must be in the future", nameof(endTime));
+            }
+
+            _logger.LogInformation("Scheduling maintenance window from {StartTime} to {EndTime} by {User}", startTime, endTime, createdBy);
+
+            var window = await _windowRepository.CreateAsync(new MaintenanceWindow
+            {
+                Reason = string.IsNullOrEmpty(reason) ? "Scheduled maintenance" : reason,
+                StartTime = startTime,
+                EndTime = endTime,
+                CreatedBy = createdBy
+            });
+
+            await ReloadWindowsAsync();
+            return window;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<MaintenanceWindow>> GetWindowsAsync()
+        {
+            var now = DateTime.UtcNow;
+            var windows = await ReloadWindowsAsync();
+            return windows.Where(w => w.EndTime == null || w.EndTime > now).OrderBy(w => w.StartTime).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> CancelWindowAsync(Guid id)
+        {
+            _logger.LogInformation("Cancelling maintenance window: {Id}", id);
+
+            var deleted = await _windowRepository.DeleteAsync(id);
+            await ReloadWindowsAsync();
+            return deleted;
+        }
+
+        /// <inheritdoc/>
+        public async Task<QueuedInvocation> EnqueueInvocationAsync(Guid functionId, Guid accountId, Dictionary<string, object> parameters)
+        {
+            if (_queue.Count >= _configuration.MaxQueuedInvocations)
+            {
+                var status = await GetStatusAsync();
+                throw new MaintenanceException("The invocation queue is full, try again after maintenance", status.ExpectedEndTime);
+            }
+
+            var invocation = new QueuedInvocation
+            {
+                Id = Guid.NewGuid(),
+                FunctionId = functionId,
+                AccountId = accountId,
+                Parameters = parameters,
+                QueuedAt = DateTime.UtcNow,
+                Position = _queue.Count + 1
+            };
+
+            _queue.Enqueue(invocation);
+
+            _logger.LogInformation("Queued invocation {InvocationId} of function {FunctionId} during maintenance", invocation.Id, functionId);
+
+            return invocation;
+        }
+
+        /// <summary>
+        /// Reloads the windows and runs queued invocations once maintenance has ended
+        /// </summary>
+        private async Task CheckAsync()
+        {
+            // Prevent concurrent execution
+            if (!await _checkSemaphore.WaitAsync(0))
+            {
+                return;
+            }
+
+            try
+            {
+                await ReloadWindowsAsync();
+
+                var active = await IsActiveAsync();
+                if (active != _wasActive)
+                {
+                    _logger.LogWarning(active ? "Maintenance window started" : "Maintenance window ended");
+                    _wasActive = active;
+                }
+
+                while (!active && _queue.TryDequeue(out var invocation))
+                {
+                    try
+                    {
+                        _logger.LogInformation("Running queued invocation {InvocationId} of function {FunctionId}", invocation.Id, invocation.FunctionId);
+                        await _functionService.ExecuteAsync(invocation.FunctionId, invocation.Parameters);
+                    }
+                    catch (Exception ex)
+                    {
+                        // The failure is recorded in the function's execution history
+                        _logger.LogError(ex, "Error running queued invocation {InvocationId} of function {FunctionId}", invocation.Id, invocation.FunctionId);
+                    }
+
+                    // A window may have started while draining
+                    active = await IsActiveAsync();
+                }
+            }
+            finally
+            {
+                _checkSemaphore.Release();
+            }
+        }
+
+        private async Task<List<MaintenanceWindow>> GetCachedWindowsAsync()
+        {
+            return _windows ?? await ReloadWindowsAsync();
+        }
+
+        private async Task<List<MaintenanceWindow>> ReloadWindowsAsync()
+        {
+            var windows = (await _windowRepository.GetAllAsync()).ToList();
+            _windows = windows;
+            return windows;
+        }
+
+        /// <summary>
+        /// Disposes the service
+        /// </summary>
+        public void Dispose()
+        {
+            _checkTimer?.Dispose();
+            _checkSemaphore?.Dispose();
+        }
+    }
+}
@@ -0,0 +1,28 @@
+using Microsoft.Extensions.DependencyInjection;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Services.Maintenance.Repositories;
+
+namespace NeoServiceLayer.Services.Maintenance
+{
+    /// <summary>
+    /// Extension methods for registering maintenance services
+    /// </summary>
+    public static class MaintenanceServiceExtensions
+    {
+        /// <summary>
+        /// Adds maintenance services to the service collection
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddMaintenanceServices(this IServiceCollection services)
+        {
+            // Register repositories
+            services.AddSingleton<IMaintenanceWindowRepository, MaintenanceWindowRepository>();
+
+            // Register services
+            services.AddSingleton<IMaintenanceService, MaintenanceService>();
+
+            return services;
+        }
+    }
+}
@@ -0,0 +1,40 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Maintenance.Repositories
+{
+    /// <summary>
+    /// Interface for maintenance window repository
+    /// </summary>
+    public interface IMaintenanceWindowRepository
+    {
+        /// <summary>
+        /// Creates a maintenance window
+        /// </summary>
+        /// <param name="window">Window to create</param>
+        /// <returns>The created window</returns>
+        Task<MaintenanceWindow> CreateAsync(MaintenanceWindow window);
+
+        /// <summary>
+        /// Gets all maintenance windows
+        /// </summary>
+        /// <returns>List of windows</returns>
+        Task<IEnumerable<MaintenanceWindow>> GetAllAsync();
+
+        /// <summary>
+        /// Updates a maintenance window
+        /// </summary>
+        /// <param name="window">Window to update</param>
+        /// <returns>The updated window</returns>
+        Task<MaintenanceWindow> UpdateAsync(MaintenanceWindow window);
+
+        /// <summary>
+        /// Deletes a maintenance window
+        /// </summary>
+        /// <param name="id">Window ID</param>
+        /// <returns>True if the window was deleted, false otherwise</returns>
+        Task<bool> DeleteAsync(Guid id);
+    }
+}
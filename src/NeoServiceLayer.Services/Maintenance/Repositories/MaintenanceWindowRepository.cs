@@ -0,0 +1,112 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Maintenance.Repositories
+{
+    /// <summary>
+    /// Implementation of the maintenance window repository
+    /// </summary>
+    public class MaintenanceWindowRepository : IMaintenanceWindowRepository
+    {
+        private readonly ILogger<MaintenanceWindowRepository> _logger;
+        private readonly IDatabaseService _databaseService;
+        private const string CollectionName = "maintenance_windows";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="MaintenanceWindowRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="databaseService">Database service</param>
+        public MaintenanceWindowRepository(ILogger<MaintenanceWindowRepository> logger, IDatabaseService databaseService)
+        {
+            _logger = logger;
+            _databaseService = databaseService;
+        }
+
+        /// <inheritdoc/>
+        public async Task<MaintenanceWindow> CreateAsync(MaintenanceWindow window)
+        {
+            _logger.LogInformation("Creating maintenance window starting at {StartTime}", window.StartTime);
+
+            try
+            {
+                if (window.Id == Guid.Empty)
+                {
+                    window.Id = Guid.NewGuid();
+                }
+
+                window.CreatedAt = DateTime.UtcNow;
+
+                // Create collection if it doesn't exist
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    await _databaseService.CreateCollectionAsync(CollectionName);
+                }
+
+                return await _databaseService.CreateAsync(CollectionName, window);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error creating maintenance window starting at {StartTime}", window.StartTime);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<MaintenanceWindow>> GetAllAsync()
+        {
+            _logger.LogDebug("Getting maintenance windows");
+
+            try
+            {
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    return new List<MaintenanceWindow>();
+                }
+
+                return await _databaseService.GetAllAsync<MaintenanceWindow>(CollectionName);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting maintenance windows");
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<MaintenanceWindow> UpdateAsync(MaintenanceWindow window)
+        {
+            _logger.LogInformation("Updating maintenance window: {Id}", window.Id);
+
+            try
+            {
+                return await _databaseService.UpdateAsync<MaintenanceWindow, Guid>(CollectionName, window.Id, window);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error updating maintenance window: {Id}", window.Id);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAsync(Guid id)
+        {
+            _logger.LogInformation("Deleting maintenance window: {Id}", id);
+
+            try
+            {
+                return await _databaseService.DeleteAsync<MaintenanceWindow, Guid>(CollectionName, id);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error deleting maintenance window: {Id}", id);
+                throw;
+            }
+        }
+    }
+}
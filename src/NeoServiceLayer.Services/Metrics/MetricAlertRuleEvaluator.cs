@@ -0,0 +1,141 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Metrics
+{
+    /// <summary>
+    /// Transition of an alert rule produced by an evaluation
+    /// </summary>
+    public enum MetricAlertTransition
+    {
+        /// <summary>
+        /// State did not change in a way that requires a notification
+        /// </summary>
+        None,
+
+        /// <summary>
+        /// Condition started holding but the duration has not elapsed yet
+        /// </summary>
+        Pending,
+
+        /// <summary>
+        /// Alert fired
+        /// </summary>
+        Fired,
+
+        /// <summary>
+        /// Firing alert resolved
+        /// </summary>
+        Resolved
+    }
+
+    /// <summary>
+    /// Pure evaluation logic for metric alert rules
+    /// </summary>
+    public static class MetricAlertRuleEvaluator
+    {
+        /// <summary>
+        /// Supported comparison operators
+        /// </summary>
+        public static readonly IReadOnlyCollection<string> Operators = new[] { ">", ">=", "<", "<=", "==", "!=" };
+
+        /// <summary>
+        /// Compares a value against a threshold
+        /// </summary>
+        /// <param name="value">Value</param>
+        /// <param name="operator">Comparison operator</param>
+        /// <param name="threshold">Threshold</param>
+        /// <returns>True if the condition holds</returns>
+        public static bool Compare(double value, string @operator, double threshold)
+        {
+            switch (@operator)
+            {
+                case ">":
+                    return value > threshold;
+                case ">=":
+                    return value >= threshold;
+                case "<":
+                    return value < threshold;
+                case "<=":
+                    return value <= threshold;
+                case "==":
+                    return value == threshold;
+                case "!=":
+                    return value != threshold;
+                default:
+                    throw new ArgumentException($"Unsupported operator: {@operator}");
+            }
+        }
+
+        /// <summary>
+        /// Aggregates values in the evaluation window
+        /// </summary>
+        /// <param name="values">Values</param>
+        /// <param name="aggregation">Aggregation</param>
+        /// <returns>The aggregated value, or null if there are no values and the aggregation needs at least one</returns>
+        public static double? Aggregate(IEnumerable<double> values, MetricAlertAggregation aggregation)
+        {
+            var list = values.ToList();
+            if (aggregation == MetricAlertAggregation.Count)
+            {
+                return list.Count;
+            }
+
+            if (list.Count == 0)
+            {
+                return null;
+            }
+
+            switch (aggregation)
+            {
+                case MetricAlertAggregation.Sum:
+                    return list.Sum();
+                case MetricAlertAggregation.Min:
+                    return list.Min();
+                case MetricAlertAggregation.Max:
+                    return list.Max();
+                default:
+                    return list.Average();
+            }
+        }
+
+        /// <summary>
+        /// Advances the state of a rule given whether its condition holds at the evaluation time
+        /// </summary>
+        /// <param name="rule">Rule to advance; its state fields are updated in place</param>
+        /// <param name="conditionHolds">Whether the condition holds</param>
+        /// <param name="now">Evaluation time</param>
+        /// <returns>The transition that happened</returns>
+        public static MetricAlertTransition Advance(MetricAlertRule rule, bool conditionHolds, DateTime now)
+        {
+            if (!conditionHolds)
+            {
+                var wasFiring = rule.State == MetricAlertState.Firing;
+                rule.State = MetricAlertState.Ok;
+                rule.PendingSince = null;
+                return wasFiring ? MetricAlertTransition.Resolved : MetricAlertTransition.None;
+            }
+
+            if (rule.State == MetricAlertState.Firing)
+            {
+                return MetricAlertTransition.None;
+            }
+
+            var pendingSince = rule.PendingSince ?? now;
+            rule.PendingSince = pendingSince;
+
+            if ((now - pendingSince).TotalSeconds >= rule.DurationSeconds)
+            {
+                rule.State = MetricAlertState.Firing;
+                rule.LastFiredAt = now;
+                return MetricAlertTransition.Fired;
+            }
+
+            var wasPending = rule.State == MetricAlertState.Pending;
+            rule.State = MetricAlertState.Pending;
+            return wasPending ? MetricAlertTransition.None : MetricAlertTransition.Pending;
+        }
+    }
+}
@@ -1,97 +1,189 @@
 using System;
 using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Extensions;
 using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.GasBank.Repositories;
+using NeoServiceLayer.Services.Metrics.Repositories;
+using NeoServiceLayer.Services.Metrics.Storage;
+using FunctionRepositories = NeoServiceLayer.Services.Function.Repositories;
 
 namespace NeoServiceLayer.Services.Metrics
 {
     /// <summary>
     /// Implementation of the metrics service
     /// </summary>
-    public class MetricsService : IMetricsService
+    public class MetricsService : IMetricsService, IDisposable
     {
+        private static readonly string[] FailedExecutionStatuses = { "Failed", "Error", "Killed", "Timeout" };
+        private static readonly string[] UnfinishedExecutionStatuses = { "Running", "Killing" };
+
+        private const string FunctionExecutionTimeMetric = "function_execution_time_ms";
+        private const string FunctionMemoryUsageMetric = "function_memory_usage_bytes";
+        private const string StorageOperationMetric = "storage_operation_bytes";
+        private const string BlockchainOperationMetric = "blockchain_operations";
+
         private readonly ILogger<MetricsService> _logger;
+        private readonly IMetricAlertRuleRepository _alertRuleRepository;
+        private readonly INotificationService _notificationService;
+        private readonly FunctionRepositories.IFunctionRepository _functionRepository;
+        private readonly IFunctionExecutionRepository _executionRepository;
+        private readonly IGasBankAccountRepository _gasBankAccountRepository;
+        private readonly IGasBankTransactionRepository _gasBankTransactionRepository;
+        private readonly MetricAlertConfiguration _alertConfiguration;
+        private readonly IMetricStore _metricStore;
+        private readonly MetricsStorageConfiguration _storageConfiguration;
+        private readonly SemaphoreSlim _alertEvaluationSemaphore = new SemaphoreSlim(1, 1);
+        private Timer _alertEvaluationTimer;
+        private Timer _rollupTimer;
 
         /// <summary>
         /// Initializes a new instance of the <see cref="MetricsService"/> class
         /// </summary>
         /// <param name="logger">Logger</param>
-        public MetricsService(ILogger<MetricsService> logger)
+        /// <param name="alertRuleRepository">Alert rule repository</param>
+        /// <param name="notificationService">Notification service used to dispatch alerts</param>
+        /// <param name="functionRepository">Function repository</param>
+        /// <param name="executionRepository">Function execution repository</param>
+        /// <param name="gasBankAccountRepository">GasBank account repository</param>
+        /// <param name="gasBankTransactionRepository">GasBank transaction repository</param>
+        /// <param name="alertConfiguration">Alert configuration</param>
+        /// <param name="metricStore">Store that keeps recorded metrics; without one nothing is kept</param>
+        /// <param name="storageConfiguration">Metrics storage configuration</param>
+        public MetricsService(
+            ILogger<MetricsService> logger,
+            IMetricAlertRuleRepository alertRuleRepository = null,
+            INotificationService notificationService = null,
+            FunctionRepositories.IFunctionRepository functionRepository = null,
+            IFunctionExecutionRepository executionRepository = null,
+            IGasBankAccountRepository gasBankAccountRepository = null,
+            IGasBankTransactionRepository gasBankTransactionRepository = null,
+            IOptions<MetricAlertConfiguration> alertConfiguration = null,
+            IMetricStore metricStore = null,
+            IOptions<MetricsStorageConfiguration> storageConfiguration = null)
         {
             _logger = logger;
+            _alertRuleRepository = alertRuleRepository;
+            _notificationService = notificationService;
+            _functionRepository = functionRepository;
+            _executionRepository = executionRepository;
+            _gasBankAccountRepository = gasBankAccountRepository;
+            _gasBankTransactionRepository = gasBankTransactionRepository;
+            _alertConfiguration = alertConfiguration?.Value ?? new MetricAlertConfiguration();
+            _metricStore = metricStore;
+            _storageConfiguration = storageConfiguration?.Value ?? new MetricsStorageConfiguration();
+
+            if (_alertRuleRepository != null && _alertConfiguration.Enabled)
+            {
+                _alertEvaluationTimer = new Timer(
+                    EvaluateAlertRulesCallback,
+                    null,
+                    TimeSpan.FromSeconds(_alertConfiguration.EvaluationIntervalSeconds),
+                    TimeSpan.FromSeconds(_alertConfiguration.EvaluationIntervalSeconds));
+            }
+
+            if (_metricStore != null && _storageConfiguration.RollupIntervalSeconds > 0)
+            {
+                _rollupTimer = new Timer(
+                    RollupMetricsCallback,
+                    null,
+                    TimeSpan.FromSeconds(_storageConfiguration.RollupIntervalSeconds),
+                    TimeSpan.FromSeconds(_storageConfiguration.RollupIntervalSeconds));
+            }
         }
 
         /// <inheritdoc/>
         public async Task<bool> RecordFunctionExecutionAsync(Guid functionId, long executionTime, long memoryUsage, bool success, string errorMessage = null)
         {
-            _logger.LogInformation("Recording function execution metric for function {FunctionId}", functionId);
-            // TODO: Implement metric recording
-            return await Task.FromResult(true);
+            var tags = new Dictionary<string, string>
+            {
+                ["functionId"] = functionId.ToString(),
+                ["success"] = success ? "true" : "false"
+            };
+
+            // Tagging the owner lets the account's alert rules read the series
+            var function = _functionRepository != null && _metricStore != null ? await _functionRepository.GetByIdAsync(functionId) : null;
+            if (function != null)
+            {
+                tags["accountId"] = function.AccountId.ToString();
+            }
+
+            return await RecordAsync(FunctionExecutionTimeMetric, executionTime, tags) &&
+                await RecordAsync(FunctionMemoryUsageMetric, memoryUsage, tags);
         }
 
         /// <inheritdoc/>
         public async Task<bool> RecordStorageOperationAsync(Guid accountId, Guid? functionId, string operationType, long size)
         {
-            _logger.LogInformation("Recording storage operation metric for account {AccountId}", accountId);
-            // TODO: Implement metric recording
-            return await Task.FromResult(true);
+            return await RecordAsync(StorageOperationMetric, size, OperationTags(accountId, functionId, operationType));
         }
 
         /// <inheritdoc/>
         public async Task<bool> RecordBlockchainOperationAsync(Guid accountId, Guid? functionId, string operationType, string transactionHash = null)
         {
-            _logger.LogInformation("Recording blockchain operation metric for account {AccountId}", accountId);
-            // TODO: Implement metric recording
-            return await Task.FromResult(true);
+            return await RecordAsync(BlockchainOperationMetric, 1, OperationTags(accountId, functionId, operationType));
         }
 
         /// <inheritdoc/>
         public async Task<bool> RecordCustomMetricAsync(string name, double value, Dictionary<string, string> tags = null)
         {
-            _logger.LogInformation("Recording custom metric {Name}", name);
-            // TODO: Implement metric recording
-            return await Task.FromResult(true);
+            return await RecordAsync(name, value, tags ?? new Dictionary<string, string>());
         }
 
         /// <inheritdoc/>
         public async Task<IEnumerable<object>> GetFunctionExecutionMetricsAsync(Guid functionId, DateTime startTime, DateTime endTime)
         {
-            _logger.LogInformation("Getting function execution metrics for function {FunctionId}", functionId);
-            // TODO: Implement metric retrieval
-            return await Task.FromResult(new List<object>());
+            return await QueryAsync(FunctionExecutionTimeMetric, startTime, endTime, new Dictionary<string, string> { ["functionId"] = functionId.ToString() });
         }
 
         /// <inheritdoc/>
         public async Task<IEnumerable<object>> GetFunctionExecutionMetricsForAccountAsync(Guid accountId, DateTime startTime, DateTime endTime)
         {
-            _logger.LogInformation("Getting function execution metrics for account {AccountId}", accountId);
-            // TODO: Implement metric retrieval
-            return await Task.FromResult(new List<object>());
+            return await QueryAsync(FunctionExecutionTimeMetric, startTime, endTime, new Dictionary<string, string> { ["accountId"] = accountId.ToString() });
         }
 
         /// <inheritdoc/>
         public async Task<IEnumerable<object>> GetStorageOperationMetricsAsync(Guid accountId, DateTime startTime, DateTime endTime)
         {
-            _logger.LogInformation("Getting storage operation metrics for account {AccountId}", accountId);
-            // TODO: Implement metric retrieval
-            return await Task.FromResult(new List<object>());
+            return await QueryAsync(StorageOperationMetric, startTime, endTime, new Dictionary<string, string> { ["accountId"] = accountId.ToString() });
         }
 
         /// <inheritdoc/>
         public async Task<IEnumerable<object>> GetBlockchainOperationMetricsAsync(Guid accountId, DateTime startTime, DateTime endTime)
         {
-            _logger.LogInformation("Getting blockchain operation metrics for account {AccountId}", accountId);
-            // TODO: Implement metric retrieval
-            return await Task.FromResult(new List<object>());
+            return await QueryAsync(BlockchainOperationMetric, startTime, endTime, new Dictionary<string, string> { ["accountId"] = accountId.ToString() });
         }
 
         /// <inheritdoc/>
         public async Task<IEnumerable<object>> GetCustomMetricsAsync(string name, DateTime startTime, DateTime endTime, Dictionary<string, string> tags = null)
         {
-            _logger.LogInformation("Getting custom metrics for {Name}", name);
-            // TODO: Implement metric retrieval
-            return await Task.FromResult(new List<object>());
+            return await QueryAsync(name, startTime, endTime, tags ?? new Dictionary<string, string>());
+        }
+
+        /// <inheritdoc/>
+        public async Task<IReadOnlyList<MetricBucket>> QueryMetricsAsync(MetricQuery query)
+        {
+            if (_metricStore == null)
+            {
+                throw new InvalidOperationException("Metrics storage is not configured");
+            }
+
+            if (string.IsNullOrWhiteSpace(query?.Name))
+            {
+                throw new ArgumentException("Metric name is required");
+            }
+
+            if (query.EndTime <= query.StartTime)
+            {
+                throw new ArgumentException("End time must be after start time");
+            }
+
+            return await _metricStore.QueryAsync(query);
         }
 
         /// <inheritdoc/>
@@ -133,5 +225,486 @@ namespace NeoServiceLayer.Services.Metrics
             // TODO: Implement alert retrieval
             return await Task.FromResult(new { Name = name, Description = "Alert", MetricName = "metric", Threshold = 0.0, Operator = ">", Duration = 60, NotificationChannels = new List<string>() });
         }
+
+        /// <inheritdoc/>
+        public async Task<MetricAlertRule> CreateAlertRuleAsync(MetricAlertRule rule)
+        {
+            _logger.LogInformation("Creating metric alert rule {Name} for account {AccountId}", rule?.Name, rule?.AccountId);
+
+            EnsureAlertRulesAvailable();
+            ValidateAlertRule(rule);
+
+            var count = await _alertRuleRepository.GetCountByAccountAsync(rule.AccountId);
+            if (count >= _alertConfiguration.MaxRulesPerAccount)
+            {
+                throw new ArgumentException($"Alert rule limit of {_alertConfiguration.MaxRulesPerAccount} reached for account");
+            }
+
+            rule.Id = Guid.NewGuid();
+            ResetAlertState(rule);
+
+            return await _alertRuleRepository.CreateAsync(rule);
+        }
+
+        /// <inheritdoc/>
+        public async Task<MetricAlertRule> GetAlertRuleAsync(Guid id)
+        {
+            EnsureAlertRulesAvailable();
+            return await _alertRuleRepository.GetByIdAsync(id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<MetricAlertRule>> GetAlertRulesByAccountAsync(Guid accountId)
+        {
+            EnsureAlertRulesAvailable();
+            return await _alertRuleRepository.GetByAccountAsync(accountId);
+        }
+
+        /// <inheritdoc/>
+        public async Task<MetricAlertRule> UpdateAlertRuleAsync(MetricAlertRule rule)
+        {
+            _logger.LogInformation("Updating metric alert rule {Id}", rule?.Id);
+
+            EnsureAlertRulesAvailable();
+            ValidateAlertRule(rule);
+
+            var existing = await _alertRuleRepository.GetByIdAsync(rule.Id);
+            if (existing == null)
+            {
+                throw new ArgumentException($"Alert rule not found: {rule.Id}");
+            }
+
+            rule.AccountId = existing.AccountId;
+            rule.CreatedAt = existing.CreatedAt;
+
+            // A changed condition starts over rather than inheriting a pending or firing state
+            ResetAlertState(rule);
+
+            return await _alertRuleRepository.UpdateAsync(rule);
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAlertRuleAsync(Guid id)
+        {
+            _logger.LogInformation("Deleting metric alert rule {Id}", id);
+
+            EnsureAlertRulesAvailable();
+            return await _alertRuleRepository.DeleteAsync(id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<int> EvaluateAlertRulesAsync()
+        {
+            if (_alertRuleRepository == null)
+            {
+                return 0;
+            }
+
+            if (!await _alertEvaluationSemaphore.WaitAsync(0))
+            {
+                return 0;
+            }
+
+            try
+            {
+                var rules = (await _alertRuleRepository.GetEnabledAsync()).ToList();
+                _logger.LogDebug("Evaluating {Count} metric alert rules", rules.Count);
+
+                foreach (var rule in rules)
+                {
+                    try
+                    {
+                        await EvaluateAlertRuleAsync(rule, DateTime.UtcNow);
+                    }
+                    catch (Exception ex)
+                    {
+                        _logger.LogError(ex, "Error evaluating metric alert rule {RuleId}", rule.Id);
+                    }
+                }
+
+                return rules.Count;
+            }
+            finally
+            {
+                _alertEvaluationSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public void Dispose()
+        {
+            _alertEvaluationTimer?.Dispose();
+            _rollupTimer?.Dispose();
+            _alertEvaluationSemaphore.Dispose();
+        }
+
+        /// <summary>
+        /// Timer callback for alert rule evaluation
+        /// </summary>
+        /// <param name="state">Timer state</param>
+        private async void EvaluateAlertRulesCallback(object state)
+        {
+            try
+            {
+                await EvaluateAlertRulesAsync();
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error evaluating metric alert rules");
+            }
+        }
+
+        /// <summary>
+        /// Timer callback for metric rollups
+        /// </summary>
+        /// <param name="state">Timer state</param>
+        private async void RollupMetricsCallback(object state)
+        {
+            try
+            {
+                await _metricStore.RollupAsync(DateTime.UtcNow);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error rolling up metrics");
+            }
+        }
+
+        /// <summary>
+        /// Adds a sample to the metric store
+        /// </summary>
+        /// <param name="name">Metric name</param>
+        /// <param name="value">Sample value</param>
+        /// <param name="tags">Tags</param>
+        /// <returns>True if the sample was stored, false otherwise</returns>
+        private async Task<bool> RecordAsync(string name, double value, Dictionary<string, string> tags)
+        {
+            if (_metricStore == null)
+            {
+                return false;
+            }
+
+            try
+            {
+                await _metricStore.RecordAsync(name, value, tags, DateTime.UtcNow);
+                return true;
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error recording metric {Name}", name);
+                return false;
+            }
+        }
+
+        /// <summary>
+        /// Reads the buckets of a metric, picking the resolution from the range
+        /// </summary>
+        /// <param name="name">Metric name</param>
+        /// <param name="startTime">Start time</param>
+        /// <param name="endTime">End time</param>
+        /// <param name="tags">Tags a series must have</param>
+        /// <returns>The buckets, or an empty list if metrics are not stored</returns>
+        private async Task<IEnumerable<object>> QueryAsync(string name, DateTime startTime, DateTime endTime, Dictionary<string, string> tags)
+        {
+            if (_metricStore == null)
+            {
+                return new List<object>();
+            }
+
+            var buckets = await _metricStore.QueryAsync(new MetricQuery
+            {
+                Name = name,
+                StartTime = startTime,
+                EndTime = endTime,
+                Tags = tags
+            });
+
+            return buckets.Cast<object>().ToList();
+        }
+
+        private static Dictionary<string, string> OperationTags(Guid accountId, Guid? functionId, string operationType)
+        {
+            var tags = new Dictionary<string, string>
+            {
+                ["accountId"] = accountId.ToString(),
+                ["operation"] = operationType ?? string.Empty
+            };
+
+            if (functionId.HasValue)
+            {
+                tags["functionId"] = functionId.Value.ToString();
+            }
+
+            return tags;
+        }
+
+        /// <summary>
+        /// Evaluates a single alert rule and dispatches a notification if it fires or resolves
+        /// </summary>
+        /// <param name="rule">Rule to evaluate</param>
+        /// <param name="now">Evaluation time</param>
+        private async Task EvaluateAlertRuleAsync(MetricAlertRule rule, DateTime now)
+        {
+            var value = await ComputeRuleValueAsync(rule, now);
+            var conditionHolds = value.HasValue && MetricAlertRuleEvaluator.Compare(value.Value, rule.Operator, rule.Threshold);
+
+            var transition = MetricAlertRuleEvaluator.Advance(rule, conditionHolds, now);
+            rule.LastValue = value;
+            rule.LastEvaluatedAt = now;
+
+            await _alertRuleRepository.UpdateAsync(rule);
+
+            if (transition == MetricAlertTransition.Fired ||
+                (transition == MetricAlertTransition.Resolved && rule.NotifyOnResolve))
+            {
+                await DispatchAlertAsync(rule, transition, value);
+            }
+        }
+
+        /// <summary>
+        /// Computes the current value of the metric a rule is evaluated over
+        /// </summary>
+        /// <param name="rule">Rule</param>
+        /// <param name="now">Evaluation time</param>
+        /// <returns>The value, or null if there is no data in the window</returns>
+        private async Task<double?> ComputeRuleValueAsync(MetricAlertRule rule, DateTime now)
+        {
+            var windowStart = now.AddSeconds(-rule.WindowSeconds);
+
+            switch (rule.Source)
+            {
+                case MetricAlertSource.ExecutionErrorRate:
+                {
+                    var executions = (await GetExecutionsInWindowAsync(rule, windowStart, now))
+                        .Where(e => !UnfinishedExecutionStatuses.Contains(e.Status, StringComparer.OrdinalIgnoreCase))
+                        .ToList();
+                    if (executions.Count == 0)
+                    {
+                        return null;
+                    }
+
+                    var failed = executions.Count(e => !string.IsNullOrEmpty(e.Error) ||
+                        FailedExecutionStatuses.Contains(e.Status, StringComparer.OrdinalIgnoreCase));
+                    return failed * 100.0 / executions.Count;
+                }
+
+                case MetricAlertSource.SandboxMetric:
+                {
+                    var executions = await GetExecutionsInWindowAsync(rule, windowStart, now);
+                    var values = executions
+                        .Where(e => e.Metrics != null && e.Metrics.ContainsKey(rule.MetricName))
+                        .Select(e => e.Metrics[rule.MetricName]);
+                    return MetricAlertRuleEvaluator.Aggregate(values, rule.Aggregation);
+                }
+
+                case MetricAlertSource.GasSpend:
+                {
+                    if (_gasBankAccountRepository == null || _gasBankTransactionRepository == null)
+                    {
+                        _logger.LogWarning("GasBank repositories are not available, skipping metric alert rule {RuleId}", rule.Id);
+                        return null;
+                    }
+
+                    var amounts = new List<double>();
+                    foreach (var account in await _gasBankAccountRepository.GetByAccountIdAsync(rule.AccountId))
+                    {
+                        var transactions = await _gasBankTransactionRepository.GetByGasBankAccountIdAndTimeRangeAsync(account.Id, windowStart, now);
+                        amounts.AddRange(transactions
+                            .Where(t => t.Type == GasBankTransactionType.Withdrawal ||
+                                        t.Type == GasBankTransactionType.FunctionExecution ||
+                                        t.Type == GasBankTransactionType.Payout)
+                            .Select(t => (double)Math.Abs(t.Amount)));
+                    }
+
+                    return MetricAlertRuleEvaluator.Aggregate(amounts, rule.Aggregation);
+                }
+
+                case MetricAlertSource.StoredMetric:
+                {
+                    if (_metricStore == null)
+                    {
+                        _logger.LogWarning("Metrics storage is not configured, skipping metric alert rule {RuleId}", rule.Id);
+                        return null;
+                    }
+
+                    // Rules only see series tagged with their own account
+                    var tags = new Dictionary<string, string> { ["accountId"] = rule.AccountId.ToString() };
+                    if (rule.FunctionId.HasValue)
+                    {
+                        tags["functionId"] = rule.FunctionId.Value.ToString();
+                    }
+
+                    var buckets = await _metricStore.QueryAsync(new MetricQuery
+                    {
+                        Name = rule.MetricName,
+                        StartTime = windowStart,
+                        EndTime = now,
+                        Tags = tags
+                    });
+                    return MetricRollup.Aggregate(buckets, rule.Aggregation);
+                }
+
+                default:
+                    throw new NotSupportedException($"Metric alert source not supported: {rule.Source}");
+            }
+        }
+
+        /// <summary>
+        /// Gets the executions of the functions a rule is scoped to, by ID or tag, that started in the window
+        /// </summary>
+        /// <param name="rule">Rule</param>
+        /// <param name="windowStart">Window start</param>
+        /// <param name="windowEnd">Window end</param>
+        /// <returns>List of executions</returns>
+        private async Task<List<FunctionExecutionResult>> GetExecutionsInWindowAsync(MetricAlertRule rule, DateTime windowStart, DateTime windowEnd)
+        {
+            if (_functionRepository == null || _executionRepository == null)
+            {
+                _logger.LogWarning("Function repositories are not available, skipping metric alert rule {RuleId}", rule.Id);
+                return new List<FunctionExecutionResult>();
+            }
+
+            var functionIds = new List<Guid>();
+            if (rule.FunctionId.HasValue)
+            {
+                functionIds.Add(rule.FunctionId.Value);
+            }
+            else
+            {
+                functionIds.AddRange((await _functionRepository.GetByAccountIdAsync(rule.AccountId))
+                    .Where(f => string.IsNullOrEmpty(rule.Tag) || f.Tags.HasAllTags(new[] { rule.Tag }))
+                    .Select(f => f.Id));
+            }
+
+            var result = new List<FunctionExecutionResult>();
+            foreach (var functionId in functionIds)
+            {
+                var executions = await _executionRepository.GetByFunctionIdAsync(functionId, _alertConfiguration.MaxExecutionsPerFunction);
+                result.AddRange(executions.Where(e => e.StartTime >= windowStart && e.StartTime <= windowEnd));
+            }
+
+            return result;
+        }
+
+        /// <summary>
+        /// Dispatches an alert notification through the notification service
+        /// </summary>
+        /// <param name="rule">Rule</param>
+        /// <param name="transition">Transition that triggered the notification</param>
+        /// <param name="value">Value computed at evaluation</param>
+        private async Task DispatchAlertAsync(MetricAlertRule rule, MetricAlertTransition transition, double? value)
+        {
+            if (_notificationService == null)
+            {
+                _logger.LogWarning("Notification service is not available, metric alert {RuleId} was not dispatched", rule.Id);
+                return;
+            }
+
+            var fired = transition == MetricAlertTransition.Fired;
+            var metric = rule.Source == MetricAlertSource.SandboxMetric || rule.Source == MetricAlertSource.StoredMetric ? rule.MetricName : rule.Source.ToString();
+            var content = fired
+                ? $"{metric} is {value:G6} ({rule.Operator} {rule.Threshold:G6}) over the last {rule.WindowSeconds}s"
+                : $"{metric} is back within its threshold ({rule.Operator} {rule.Threshold:G6} no longer holds)";
+
+            var notification = new Core.Models.Notification
+            {
+                AccountId = rule.AccountId,
+                Type = NotificationType.Event,
+                Priority = fired ? NotificationPriority.High : NotificationPriority.Normal,
+                Subject = $"Alert {(fired ? "firing" : "resolved")}: {rule.Name}",
+                Content = content,
+                Channels = rule.Channels.Any() ? rule.Channels.ToList() : new List<NotificationChannel> { NotificationChannel.InApp },
+                Data = new Dictionary<string, object>
+                {
+                    { "RuleId", rule.Id },
+                    { "RuleName", rule.Name },
+                    { "Source", rule.Source.ToString() },
+                    { "MetricName", rule.MetricName },
+                    { "FunctionId", rule.FunctionId },
+                    { "State", rule.State.ToString() },
+                    { "Value", value },
+                    { "Operator", rule.Operator },
+                    { "Threshold", rule.Threshold }
+                }
+            };
+
+            try
+            {
+                await _notificationService.SendNotificationAsync(notification);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error dispatching metric alert {RuleId}", rule.Id);
+            }
+        }
+
+        /// <summary>
+        /// Validates an alert rule
+        /// </summary>
+        /// <param name="rule">Rule to validate</param>
+        private void ValidateAlertRule(MetricAlertRule rule)
+        {
+            if (rule == null)
+            {
+                throw new ArgumentException("Alert rule is required");
+            }
+
+            if (string.IsNullOrWhiteSpace(rule.Name))
+            {
+                throw new ArgumentException("Alert rule name is required");
+            }
+
+            if (!Enum.IsDefined(typeof(MetricAlertSource), rule.Source))
+            {
+                throw new ArgumentException($"Unsupported metric source: {rule.Source}");
+            }
+
+            if ((rule.Source == MetricAlertSource.SandboxMetric || rule.Source == MetricAlertSource.StoredMetric) &&
+                string.IsNullOrWhiteSpace(rule.MetricName))
+            {
+                throw new ArgumentException("Metric name is required for sandbox and stored metric rules");
+            }
+
+            if (!MetricAlertRuleEvaluator.Operators.Contains(rule.Operator))
+            {
+                throw new ArgumentException($"Unsupported operator: {rule.Operator}. Supported operators: {string.Join(", ", MetricAlertRuleEvaluator.Operators)}");
+            }
+
+            if (rule.WindowSeconds < _alertConfiguration.MinWindowSeconds || rule.WindowSeconds > _alertConfiguration.MaxWindowSeconds)
+            {
+                throw new ArgumentException($"Window must be between {_alertConfiguration.MinWindowSeconds} and {_alertConfiguration.MaxWindowSeconds} seconds");
+            }
+
+            if (rule.DurationSeconds < 0)
+            {
+                throw new ArgumentException("Duration cannot be negative");
+            }
+
+            rule.Channels ??= new List<NotificationChannel>();
+        }
+
+        /// <summary>
+        /// Resets the evaluation state of an alert rule
+        /// </summary>
+        /// <param name="rule">Rule</param>
+        private static void ResetAlertState(MetricAlertRule rule)
+        {
+            rule.State = MetricAlertState.Ok;
+            rule.PendingSince = null;
+            rule.LastValue = null;
+            rule.LastEvaluatedAt = null;
+            rule.LastFiredAt = null;
+        }
+
+        /// <summary>
+        /// Ensures alert rule storage is configured
+        /// </summary>
+        private void EnsureAlertRulesAvailable()
+        {
+            if (_alertRuleRepository == null)
+            {
+                throw new InvalidOperationException("Metric alert rules are not configured");
+            }
+        }
     }
 }
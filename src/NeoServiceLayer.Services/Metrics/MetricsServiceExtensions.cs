@@ -1,6 +1,9 @@
+using Microsoft.Extensions.Configuration;
 using Microsoft.Extensions.DependencyInjection;
 using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
 using NeoServiceLayer.Services.Metrics.Repositories;
+using NeoServiceLayer.Services.Metrics.Storage;
 
 namespace NeoServiceLayer.Services.Metrics
 {
@@ -13,16 +16,42 @@ namespace NeoServiceLayer.Services.Metrics
         /// Adds metrics services to the service collection
         /// </summary>
         /// <param name="services">Service collection</param>
+        /// <param name="configuration">Configuration</param>
         /// <returns>Service collection</returns>
-        public static IServiceCollection AddMetricsServices(this IServiceCollection services)
+        public static IServiceCollection AddMetricsServices(this IServiceCollection services, IConfiguration configuration = null)
         {
+            // Register options
+            if (configuration != null)
+            {
+                services.Configure<MetricsStorageConfiguration>(options => configuration.GetSection("MetricsStorage").Bind(options));
+            }
+
             // Register repositories
             // Note: MetricsRepository is implemented in the Analytics module
+            services.AddSingleton<IMetricAlertRuleRepository, MetricAlertRuleRepository>();
+            services.AddMetricStore(configuration);
 
             // Register services
             services.AddSingleton<IMetricsService, MetricsService>();
 
             return services;
         }
+
+        /// <summary>
+        /// Adds the metric store for the configured storage backend
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <param name="configuration">Configuration; no store is added when null</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddMetricStore(this IServiceCollection services, IConfiguration configuration)
+        {
+            var storageConfig = configuration?.GetSection("MetricsStorage").Get<MetricsStorageConfiguration>();
+            if (storageConfig?.UsePostgreSql == true)
+            {
+                services.AddSingleton<IMetricStore, PostgreSqlMetricStore>();
+            }
+
+            return services;
+        }
     }
 }
@@ -0,0 +1,61 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Metrics.Repositories
+{
+    /// <summary>
+    /// Interface for metric alert rule repository
+    /// </summary>
+    public interface IMetricAlertRuleRepository
+    {
+        /// <summary>
+        /// Creates an alert rule
+        /// </summary>
+        /// <param name="rule">Rule to create</param>
+        /// <returns>The created rule</returns>
+        Task<MetricAlertRule> CreateAsync(MetricAlertRule rule);
+
+        /// <summary>
+        /// Gets an alert rule by ID
+        /// </summary>
+        /// <param name="id">Rule ID</param>
+        /// <returns>The rule if found, null otherwise</returns>
+        Task<MetricAlertRule> GetByIdAsync(Guid id);
+
+        /// <summary>
+        /// Gets alert rules for an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>List of rules</returns>
+        Task<IEnumerable<MetricAlertRule>> GetByAccountAsync(Guid accountId);
+
+        /// <summary>
+        /// Gets all enabled alert rules
+        /// </summary>
+        /// <returns>List of enabled rules</returns>
+        Task<IEnumerable<MetricAlertRule>> GetEnabledAsync();
+
+        /// <summary>
+        /// Updates an alert rule
+        /// </summary>
+        /// <param name="rule">Rule to update</param>
+        /// <returns>The updated rule</returns>
+        Task<MetricAlertRule> UpdateAsync(MetricAlertRule rule);
+
+        /// <summary>
+        /// Deletes an alert rule
+        /// </summary>
+        /// <param name="id">Rule ID</param>
+        /// <returns>True if the rule was deleted, false otherwise</returns>
+        Task<bool> DeleteAsync(Guid id);
+
+        /// <summary>
+        /// Gets the number of alert rules for an account
+        /// </summary>
+        /// <param name="accountId">Account ID</param>
+        /// <returns>Number of rules</returns>
+        Task<int> GetCountByAccountAsync(Guid accountId);
+    }
+}
@@ -0,0 +1,163 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Metrics.Repositories
+{
+    /// <summary>
+    /// Implementation of the metric alert rule repository
+    /// </summary>
+    public class MetricAlertRuleRepository : IMetricAlertRuleRepository
+    {
+        private readonly ILogger<MetricAlertRuleRepository> _logger;
+        private readonly IDatabaseService _databaseService;
+        private const string CollectionName = "metric_alert_rules";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="MetricAlertRuleRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="databaseService">Database service</param>
+        public MetricAlertRuleRepository(ILogger<MetricAlertRuleRepository> logger, IDatabaseService databaseService)
+        {
+            _logger = logger;
+            _databaseService = databaseService;
+        }
+
+        /// <inheritdoc/>
+        public async Task<MetricAlertRule> CreateAsync(MetricAlertRule rule)
+        {
+            _logger.LogInformation("Creating metric alert rule: {Name} for account: {AccountId}", rule.Name, rule.AccountId);
+
+            try
+            {
+                if (rule.Id == Guid.Empty)
+                {
+                    rule.Id = Guid.NewGuid();
+                }
+
+                rule.CreatedAt = DateTime.UtcNow;
+                rule.UpdatedAt = DateTime.UtcNow;
+
+                // Create collection if it doesn't exist
+                if (!await _databaseService.CollectionExistsAsync(CollectionName))
+                {
+                    await _databaseService.CreateCollectionAsync(CollectionName);
+                }
+
+                return await _databaseService.CreateAsync(CollectionName, rule);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error creating metric alert rule: {Name} for account: {AccountId}", rule.Name, rule.AccountId);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<MetricAlertRule> GetByIdAsync(Guid id)
+        {
+            _logger.LogInformation("Getting metric alert rule by ID: {Id}", id);
+
+            try
+            {
+                return await _databaseService.GetByIdAsync<MetricAlertRule, Guid>(CollectionName, id);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting metric alert rule by ID: {Id}", id);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<MetricAlertRule>> GetByAccountAsync(Guid accountId)
+        {
+            _logger.LogInformation("Getting metric alert rules for account: {AccountId}", accountId);
+
+            try
+            {
+                return await _databaseService.GetByFilterAsync<MetricAlertRule>(
+                    CollectionName,
+                    r => r.AccountId == accountId);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting metric alert rules for account: {AccountId}", accountId);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<MetricAlertRule>> GetEnabledAsync()
+        {
+            _logger.LogDebug("Getting enabled metric alert rules");
+
+            try
+            {
+                return await _databaseService.GetByFilterAsync<MetricAlertRule>(
+                    CollectionName,
+                    r => r.IsEnabled);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting enabled metric alert rules");
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<MetricAlertRule> UpdateAsync(MetricAlertRule rule)
+        {
+            _logger.LogInformation("Updating metric alert rule: {Id}", rule.Id);
+
+            try
+            {
+                rule.UpdatedAt = DateTime.UtcNow;
+                return await _databaseService.UpdateAsync<MetricAlertRule, Guid>(CollectionName, rule.Id, rule);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error updating metric alert rule: {Id}", rule.Id);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAsync(Guid id)
+        {
+            _logger.LogInformation("Deleting metric alert rule: {Id}", id);
+
+            try
+            {
+                return await _databaseService.DeleteAsync<MetricAlertRule, Guid>(CollectionName, id);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error deleting metric alert rule: {Id}", id);
+                throw;
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<int> GetCountByAccountAsync(Guid accountId)
+        {
+            _logger.LogInformation("Getting count of metric alert rules for account: {AccountId}", accountId);
+
+            try
+            {
+                return await _databaseService.CountAsync<MetricAlertRule>(
+                    CollectionName,
+                    r => r.AccountId == accountId);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error getting count of metric alert rules for account: {AccountId}", accountId);
+                throw;
+            }
+        }
+    }
+}
@@ -0,0 +1,136 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Text.Json;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Metrics.Storage
+{
+    /// <summary>
+    /// Bucket arithmetic shared by metric stores
+    /// </summary>
+    public static class MetricRollup
+    {
+        /// <summary>
+        /// Resolutions from finest to coarsest; each is rolled up into the next
+        /// </summary>
+        public static readonly IReadOnlyList<MetricResolution> Resolutions = new[] { MetricResolution.Minute, MetricResolution.Hour, MetricResolution.Day };
+
+        /// <summary>
+        /// Gets the width of a bucket
+        /// </summary>
+        /// <param name="resolution">Resolution</param>
+        /// <returns>The bucket width</returns>
+        public static TimeSpan GetBucketWidth(MetricResolution resolution)
+        {
+            return resolution switch
+            {
+                MetricResolution.Minute => TimeSpan.FromMinutes(1),
+                MetricResolution.Hour => TimeSpan.FromHours(1),
+                MetricResolution.Day => TimeSpan.FromDays(1),
+                _ => throw new ArgumentOutOfRangeException(nameof(resolution), resolution, "Unknown metric resolution")
+            };
+        }
+
+        /// <summary>
+        /// Gets the start of the bucket a time falls in
+        /// </summary>
+        /// <param name="time">Time (UTC)</param>
+        /// <param name="resolution">Resolution</param>
+        /// <returns>The bucket start (UTC)</returns>
+        public static DateTime GetBucketStart(DateTime time, MetricResolution resolution)
+        {
+            var ticks = GetBucketWidth(resolution).Ticks;
+            return new DateTime(time.Ticks - time.Ticks % ticks, DateTimeKind.Utc);
+        }
+
+        /// <summary>
+        /// Gets how long buckets of a resolution are kept
+        /// </summary>
+        /// <param name="resolution">Resolution</param>
+        /// <param name="configuration">Configuration</param>
+        /// <returns>The retention period</returns>
+        public static TimeSpan GetRetention(MetricResolution resolution, MetricsStorageConfiguration configuration)
+        {
+            return resolution switch
+            {
+                MetricResolution.Minute => TimeSpan.FromDays(configuration.MinuteRetentionDays),
+                MetricResolution.Hour => TimeSpan.FromDays(configuration.HourRetentionDays),
+                _ => TimeSpan.FromDays(configuration.DayRetentionDays)
+            };
+        }
+
+        /// <summary>
+        /// Picks the resolution a query reads
+        /// </summary>
+        /// <param name="query">Query</param>
+        /// <param name="now">Current time (UTC)</param>
+        /// <param name="configuration">Configuration</param>
+        /// <returns>The requested resolution, or the finest one that is still retained at the start of the range
+        /// and returns at most <see cref="MetricsStorageConfiguration.MaxPointsPerSeries"/> buckets per series</returns>
+        public static MetricResolution SelectResolution(MetricQuery query, DateTime now, MetricsStorageConfiguration configuration)
+        {
+            if (query.Resolution.HasValue)
+            {
+                return query.Resolution.Value;
+            }
+
+            var range = query.EndTime - query.StartTime;
+            foreach (var resolution in Resolutions)
+            {
+                if (query.StartTime >= now - GetRetention(resolution, configuration) &&
+                    range.Ticks / GetBucketWidth(resolution).Ticks <= configuration.MaxPointsPerSeries)
+                {
+                    return resolution;
+                }
+            }
+
+            return MetricResolution.Day;
+        }
+
+        /// <summary>
+        /// Gets the key identifying a series' tags, independent of their order
+        /// </summary>
+        /// <param name="tags">Tags</param>
+        /// <returns>The tags as a JSON object with ordinally sorted keys</returns>
+        public static string GetTagsKey(IDictionary<string, string> tags)
+        {
+            return JsonSerializer.Serialize(new SortedDictionary<string, string>(
+                tags ?? new Dictionary<string, string>(),
+                StringComparer.Ordinal));
+        }
+
+        /// <summary>
+        /// Aggregates buckets into a single value
+        /// </summary>
+        /// <param name="buckets">Buckets</param>
+        /// <param name="aggregation">Aggregation</param>
+        /// <returns>The aggregated value, or null if there are no samples and the aggregation needs at least one</returns>
+        public static double? Aggregate(IEnumerable<MetricBucket> buckets, MetricAlertAggregation aggregation)
+        {
+            var list = buckets.Where(b => b.Count > 0).ToList();
+            if (aggregation == MetricAlertAggregation.Count)
+            {
+                return list.Sum(b => b.Count);
+            }
+
+            if (list.Count == 0)
+            {
+                return null;
+            }
+
+            switch (aggregation)
+            {
+                case MetricAlertAggregation.Sum:
+                    return list.Sum(b => b.Sum);
+                case MetricAlertAggregation.Min:
+                    return list.Min(b => b.Min);
+                case MetricAlertAggregation.Max:
+                    return list.Max(b => b.Max);
+                default:
+                    // Weighted by sample count, so a busy minute counts for more than a quiet one
+                    return list.Sum(b => b.Sum) / list.Sum(b => b.Count);
+            }
+        }
+    }
+}
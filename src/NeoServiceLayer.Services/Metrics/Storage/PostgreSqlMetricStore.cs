@@ -0,0 +1,346 @@
+using System;
+using System.Collections.Generic;
+using System.Text.Json;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using Npgsql;
+using NpgsqlTypes;
+
+namespace NeoServiceLayer.Services.Metrics.Storage
+{
+    /// <summary>
+    /// Metric store backed by PostgreSQL or TimescaleDB
+    /// </summary>
+    /// <remarks>
+    /// Samples are folded into one-minute buckets (count, sum, min, max) as they are written, so raw samples are
+    /// never stored. Each rollup recomputes the hour buckets from the last rolled-up hour onwards, then the day
+    /// buckets from the hours, and overwrites them; the current hour and day are therefore at most one rollup
+    /// interval behind the minute buckets, and rerunning a rollup is harmless. Rollups take an advisory lock so
+    /// only one replica does the work at a time. Buckets older than their resolution's retention are deleted.
+    ///
+    /// The schema is migrated the first time a connection is opened, like the GasBank store. With
+    /// <see cref="MetricsStorageConfiguration.UseTimescaleDb"/> the bucket tables are also made hypertables.
+    /// </remarks>
+    public class PostgreSqlMetricStore : IMetricStore, IDisposable
+    {
+        private const long MigrationLockKey = 0x4D657472696373; // "Metrics"
+        private const long RollupLockKey = 0x526F6C6C7570; // "Rollup"
+
+        private static readonly Dictionary<MetricResolution, string> Tables = new Dictionary<MetricResolution, string>
+        {
+            [MetricResolution.Minute] = "metric_buckets_1m",
+            [MetricResolution.Hour] = "metric_buckets_1h",
+            [MetricResolution.Day] = "metric_buckets_1d"
+        };
+
+        private static readonly (int Version, string Description, string Sql)[] Migrations =
+        {
+            (1, "Create metric bucket tables", @"
+CREATE TABLE metric_buckets_1m (
+    name text NOT NULL,
+    tags_key text NOT NULL,
+    tags jsonb NOT NULL,
+    bucket timestamptz NOT NULL,
+    count bigint NOT NULL,
+    sum double precision NOT NULL,
+    min double precision NOT NULL,
+    max double precision NOT NULL,
+    PRIMARY KEY (name, tags_key, bucket)
+);
+CREATE INDEX ix_metric_buckets_1m_bucket ON metric_buckets_1m (bucket);
+
+CREATE TABLE metric_buckets_1h (LIKE metric_buckets_1m INCLUDING ALL);
+CREATE TABLE metric_buckets_1d (LIKE metric_buckets_1m INCLUDING ALL);
+
+CREATE TABLE metric_rollup_state (
+    resolution text PRIMARY KEY,
+    rolled_up_to timestamptz NOT NULL
+);
+")
+        };
+
+        private readonly ILogger<PostgreSqlMetricStore> _logger;
+        private readonly MetricsStorageConfiguration _configuration;
+        private readonly NpgsqlDataSource _dataSource;
+        private readonly SemaphoreSlim _migrationLock = new SemaphoreSlim(1, 1);
+        private volatile bool _migrated;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PostgreSqlMetricStore"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="configuration">Metrics storage configuration</param>
+        public PostgreSqlMetricStore(ILogger<PostgreSqlMetricStore> logger, IOptions<MetricsStorageConfiguration> configuration)
+        {
+            _configuration = configuration.Value;
+            if (string.IsNullOrEmpty(_configuration.PostgreSqlConnectionString))
+            {
+                throw new InvalidOperationException("MetricsStorage:PostgreSqlConnectionString is required when MetricsStorage:StorageBackend is PostgreSql");
+            }
+
+            _logger = logger;
+            _dataSource = NpgsqlDataSource.Create(_configuration.PostgreSqlConnectionString);
+        }
+
+        /// <inheritdoc/>
+        public async Task RecordAsync(string name, double value, IDictionary<string, string> tags, DateTime timestamp)
+        {
+            if (string.IsNullOrWhiteSpace(name))
+            {
+                throw new ArgumentException("Metric name is required", nameof(name));
+            }
+
+            await using var connection = await OpenConnectionAsync();
+            await using var command = new NpgsqlCommand(@"
+INSERT INTO metric_buckets_1m (name, tags_key, tags, bucket, count, sum, min, max)
+VALUES (@name, @tagsKey, @tags, @bucket, 1, @value, @value, @value)
+ON CONFLICT (name, tags_key, bucket) DO UPDATE SET
+    count = metric_buckets_1m.count + 1,
+    sum = metric_buckets_1m.sum + EXCLUDED.sum,
+    min = LEAST(metric_buckets_1m.min, EXCLUDED.min),
+    max = GREATEST(metric_buckets_1m.max, EXCLUDED.max)", connection);
+
+            var tagsKey = MetricRollup.GetTagsKey(tags);
+            command.Parameters.AddWithValue("name", name);
+            command.Parameters.AddWithValue("tagsKey", tagsKey);
+            command.Parameters.Add(new NpgsqlParameter("tags", NpgsqlDbType.Jsonb) { Value = tagsKey });
+            command.Parameters.AddWithValue("bucket", MetricRollup.GetBucketStart(ToUtc(timestamp), MetricResolution.Minute));
+            command.Parameters.AddWithValue("value", value);
+            await command.ExecuteNonQueryAsync();
+        }
+
+        /// <inheritdoc/>
+        public async Task<IReadOnlyList<MetricBucket>> QueryAsync(MetricQuery query)
+        {
+            if (string.IsNullOrWhiteSpace(query?.Name))
+            {
+                throw new ArgumentException("Metric name is required", nameof(query));
+            }
+
+            var resolution = MetricRollup.SelectResolution(query, DateTime.UtcNow, _configuration);
+
+            await using var connection = await OpenConnectionAsync();
+            await using var command = new NpgsqlCommand($@"
+SELECT name, tags, bucket, count, sum, min, max
+FROM {Tables[resolution]}
+WHERE name = @name AND bucket >= @start AND bucket < @end AND tags @> @tags
+ORDER BY tags_key, bucket", connection);
+
+            command.Parameters.AddWithValue("name", query.Name);
+            command.Parameters.AddWithValue("start", MetricRollup.GetBucketStart(ToUtc(query.StartTime), resolution));
+            command.Parameters.AddWithValue("end", ToUtc(query.EndTime));
+            command.Parameters.Add(new NpgsqlParameter("tags", NpgsqlDbType.Jsonb) { Value = MetricRollup.GetTagsKey(query.Tags) });
+
+            var buckets = new List<MetricBucket>();
+            await using var reader = await command.ExecuteReaderAsync();
+            while (await reader.ReadAsync())
+            {
+                buckets.Add(new MetricBucket
+                {
+                    Name = reader.GetString(0),
+                    Tags = JsonSerializer.Deserialize<Dictionary<string, string>>(reader.GetString(1)),
+                    Resolution = resolution,
+                    Timestamp = DateTime.SpecifyKind(reader.GetDateTime(2), DateTimeKind.Utc),
+                    Count = reader.GetInt64(3),
+                    Sum = reader.GetDouble(4),
+                    Min = reader.GetDouble(5),
+                    Max = reader.GetDouble(6)
+                });
+            }
+
+            return buckets;
+        }
+
+        /// <inheritdoc/>
+        public async Task<int> RollupAsync(DateTime now)
+        {
+            now = ToUtc(now);
+
+            await using var connection = await OpenConnectionAsync();
+            await using var transaction = await connection.BeginTransactionAsync();
+
+            await using (var lockCommand = new NpgsqlCommand($"SELECT pg_try_advisory_xact_lock({RollupLockKey})", connection, transaction))
+            {
+                if (!(bool)await lockCommand.ExecuteScalarAsync())
+                {
+                    _logger.LogDebug("Metric rollup is running on another instance");
+                    return 0;
+                }
+            }
+
+            var written = 0;
+            for (var i = 1; i < MetricRollup.Resolutions.Count; i++)
+            {
+                written += await RollupAsync(connection, transaction, MetricRollup.Resolutions[i - 1], MetricRollup.Resolutions[i], now);
+            }
+
+            var deleted = 0;
+            foreach (var resolution in MetricRollup.Resolutions)
+            {
+                await using var command = new NpgsqlCommand($"DELETE FROM {Tables[resolution]} WHERE bucket < @cutoff", connection, transaction);
+                command.Parameters.AddWithValue("cutoff", now - MetricRollup.GetRetention(resolution, _configuration));
+                deleted += await command.ExecuteNonQueryAsync();
+            }
+
+            await transaction.CommitAsync();
+
+            _logger.LogDebug("Metric rollup wrote {Written} buckets and expired {Deleted}", written, deleted);
+            return written;
+        }
+
+        /// <inheritdoc/>
+        public void Dispose()
+        {
+            _dataSource.Dispose();
+            _migrationLock.Dispose();
+        }
+
+        private async Task<int> RollupAsync(NpgsqlConnection connection, NpgsqlTransaction transaction, MetricResolution source, MetricResolution target, DateTime now)
+        {
+            var stateKey = target.ToString();
+
+            DateTime from;
+            await using (var command = new NpgsqlCommand("SELECT rolled_up_to FROM metric_rollup_state WHERE resolution = @resolution", connection, transaction))
+            {
+                command.Parameters.AddWithValue("resolution", stateKey);
+                var rolledUpTo = await command.ExecuteScalarAsync();
+
+                // The first rollup covers whatever the source still retains
+                from = rolledUpTo is DateTime time
+                    ? DateTime.SpecifyKind(time, DateTimeKind.Utc)
+                    : now - MetricRollup.GetRetention(source, _configuration);
+            }
+
+            // Start at the bucket that was still open last time so it is recomputed with its remaining samples
+            from = MetricRollup.GetBucketStart(from, target);
+            var unit = target == MetricResolution.Hour ? "hour" : "day";
+
+            int written;
+            await using (var command = new NpgsqlCommand($@"
+INSERT INTO {Tables[target]} (name, tags_key, tags, bucket, count, sum, min, max)
+SELECT name, tags_key, (array_agg(tags))[1], date_trunc('{unit}', bucket AT TIME ZONE 'UTC') AT TIME ZONE 'UTC',
+       SUM(count), SUM(sum), MIN(min), MAX(max)
+FROM {Tables[source]}
+WHERE bucket >= @from AND bucket < @now
+GROUP BY name, tags_key, date_trunc('{unit}', bucket AT TIME ZONE 'UTC')
+ON CONFLICT (name, tags_key, bucket) DO UPDATE SET
+    count = EXCLUDED.count,
+    sum = EXCLUDED.sum,
+    min = EXCLUDED.min,
+    max = EXCLUDED.max", connection, transaction))
+            {
+                command.Parameters.AddWithValue("from", from);
+                command.Parameters.AddWithValue("now", now);
+                written = await command.ExecuteNonQueryAsync();
+            }
+
+            await using (var command = new NpgsqlCommand(@"
+INSERT INTO metric_rollup_state (resolution, rolled_up_to) VALUES (@resolution, @rolledUpTo)
+ON CONFLICT (resolution) DO UPDATE SET rolled_up_to = EXCLUDED.rolled_up_to", connection, transaction))
+            {
+                command.Parameters.AddWithValue("resolution", stateKey);
+                command.Parameters.AddWithValue("rolledUpTo", now);
+                await command.ExecuteNonQueryAsync();
+            }
+
+            return written;
+        }
+
+        private async Task<NpgsqlConnection> OpenConnectionAsync()
+        {
+            if (!_migrated)
+            {
+                await MigrateAsync();
+            }
+
+            return await _dataSource.OpenConnectionAsync();
+        }
+
+        private async Task MigrateAsync()
+        {
+            await _migrationLock.WaitAsync();
+            try
+            {
+                if (_migrated)
+                {
+                    return;
+                }
+
+                await using var connection = await _dataSource.OpenConnectionAsync();
+                await using var transaction = await connection.BeginTransactionAsync();
+
+                await ExecuteAsync(connection, transaction, $"SELECT pg_advisory_xact_lock({MigrationLockKey})");
+                await ExecuteAsync(connection, transaction, @"
+CREATE TABLE IF NOT EXISTS metric_schema_migrations (
+    version integer PRIMARY KEY,
+    description text NOT NULL,
+    applied_at timestamptz NOT NULL
+)");
+
+                int currentVersion;
+                await using (var command = new NpgsqlCommand("SELECT COALESCE(MAX(version), 0) FROM metric_schema_migrations", connection, transaction))
+                {
+                    currentVersion = Convert.ToInt32(await command.ExecuteScalarAsync());
+                }
+
+                foreach (var migration in Migrations)
+                {
+                    if (migration.Version <= currentVersion)
+                    {
+                        continue;
+                    }
+
+                    _logger.LogInformation("Applying metrics schema migration {Version}: {Description}", migration.Version, migration.Description);
+
+                    await ExecuteAsync(connection, transaction, migration.Sql);
+                    await using var record = new NpgsqlCommand(
+                        "INSERT INTO metric_schema_migrations (version, description, applied_at) VALUES (@version, @description, @appliedAt)",
+                        connection,
+                        transaction);
+                    record.Parameters.AddWithValue("version", migration.Version);
+                    record.Parameters.AddWithValue("description", migration.Description);
+                    record.Parameters.AddWithValue("appliedAt", DateTime.UtcNow);
+                    await record.ExecuteNonQueryAsync();
+                }
+
+                if (_configuration.UseTimescaleDb)
+                {
+                    await ExecuteAsync(connection, transaction, "CREATE EXTENSION IF NOT EXISTS timescaledb");
+                    foreach (var table in Tables.Values)
+                    {
+                        await ExecuteAsync(connection, transaction,
+                            $"SELECT create_hypertable('{table}', 'bucket', if_not_exists => TRUE, migrate_data => TRUE)");
+                    }
+                }
+
+                await transaction.CommitAsync();
+                _migrated = true;
+            }
+            finally
+            {
+                _migrationLock.Release();
+            }
+        }
+
+        private static DateTime ToUtc(DateTime time)
+        {
+            // timestamptz only accepts UTC values
+            return time.Kind switch
+            {
+                DateTimeKind.Local => time.ToUniversalTime(),
+                DateTimeKind.Unspecified => DateTime.SpecifyKind(time, DateTimeKind.Utc),
+                _ => time
+            };
+        }
+
+        private static async Task ExecuteAsync(NpgsqlConnection connection, NpgsqlTransaction transaction, string sql)
+        {
+            await using var command = new NpgsqlCommand(sql, connection, transaction);
+            await command.ExecuteNonQueryAsync();
+        }
+    }
+}
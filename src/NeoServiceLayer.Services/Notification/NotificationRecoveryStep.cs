@@ -0,0 +1,73 @@
+using System;
+using System.Linq;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Enums;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Notification.Repositories;
+
+namespace NeoServiceLayer.Services.Notification
+{
+    /// <summary>
+    /// Returns notifications that were being delivered when the previous process stopped to the pending queue
+    /// </summary>
+    /// <remarks>
+    /// Delivery is at least once: a notification that reached some channels before the stop is sent to them again.
+    /// </remarks>
+    public class NotificationRecoveryStep : IStartupRecoveryStep
+    {
+        private const int BatchSize = 100;
+
+        private readonly INotificationRepository _notificationRepository;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="NotificationRecoveryStep"/> class
+        /// </summary>
+        /// <param name="notificationRepository">Notification repository</param>
+        public NotificationRecoveryStep(INotificationRepository notificationRepository)
+        {
+            _notificationRepository = notificationRepository;
+        }
+
+        /// <inheritdoc/>
+        public string Name => "Notifications";
+
+        /// <inheritdoc/>
+        public async Task<StartupRecoveryStepResult> RecoverAsync(DateTime processStartTime)
+        {
+            var result = new StartupRecoveryStepResult();
+
+            var offset = 0;
+            while (true)
+            {
+                var processing = (await _notificationRepository.GetByStatusAsync(NotificationStatus.Processing, BatchSize, offset)).ToList();
+                if (processing.Count == 0)
+                {
+                    break;
+                }
+
+                foreach (var notification in processing)
+                {
+                    if (notification.CreatedAt >= processStartTime)
+                    {
+                        offset++;
+                        continue;
+                    }
+
+                    notification.Status = NotificationStatus.Pending;
+                    await _notificationRepository.UpdateAsync(notification);
+
+                    result.Resumed++;
+                    result.Details.Add($"Notification {notification.Id} requeued");
+                }
+
+                if (processing.Count < BatchSize)
+                {
+                    break;
+                }
+            }
+
+            return result;
+        }
+    }
+}
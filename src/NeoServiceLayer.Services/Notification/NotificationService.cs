@@ -10,6 +10,7 @@ using NeoServiceLayer.Core.Enums;
 using NotificationPriorityEnum = NeoServiceLayer.Core.Enums.NotificationPriority;
 using NeoServiceLayer.Core.Interfaces;
 using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Diagnostics;
 using NeoServiceLayer.Services.Notification.Repositories;
 
 namespace NeoServiceLayer.Services.Notification
@@ -26,6 +27,7 @@ namespace NeoServiceLayer.Services.Notification
         private readonly IEnumerable<INotificationProvider> _providers;
         private readonly NotificationConfiguration _configuration;
 
+        private readonly ICrashReporter _crashReporter;
         private Timer _processingTimer;
         private readonly SemaphoreSlim _processingSemaphore = new SemaphoreSlim(1, 1);
 
@@ -38,13 +40,15 @@ namespace NeoServiceLayer.Services.Notification
         /// <param name="preferencesRepository">Preferences repository</param>
         /// <param name="providers">Notification providers</param>
         /// <param name="configuration">Configuration</param>
+        /// <param name="crashReporter">Crash reporter for background work</param>
         public NotificationService(
             ILogger<NotificationService> logger,
             INotificationRepository notificationRepository,
             INotificationTemplateRepository templateRepository,
             IUserNotificationPreferencesRepository preferencesRepository,
             IEnumerable<INotificationProvider> providers,
-            IOptions<NotificationConfiguration> configuration)
+            IOptions<NotificationConfiguration> configuration,
+            ICrashReporter crashReporter = null)
         {
             _logger = logger;
             _notificationRepository = notificationRepository;
@@ -52,10 +56,11 @@ namespace NeoServiceLayer.Services.Notification
             _preferencesRepository = preferencesRepository;
             _providers = providers;
             _configuration = configuration.Value;
+            _crashReporter = crashReporter;
 
             // Start processing timer
             _processingTimer = new Timer(
-                async _ => await ProcessNotificationsAsync(),
+                _crashReporter.Guard(_logger, "Notification.ProcessNotifications", ProcessNotificationsAsync),
                 null,
                 TimeSpan.FromSeconds(5),
                 TimeSpan.FromSeconds(_configuration.ProcessingIntervalSeconds));
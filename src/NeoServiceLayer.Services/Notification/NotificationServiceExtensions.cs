@@ -24,9 +24,23 @@ namespace NeoServiceLayer.Services.Notification
             services.AddSingleton<INotificationProvider, EmailNotificationProvider>();
             services.AddSingleton<INotificationProvider, SmsNotificationProvider>();
             services.AddSingleton<INotificationProvider, WebhookNotificationProvider>();
+            services.AddSingleton<INotificationProvider, TelegramNotificationProvider>();
 
             // Register services
             services.AddSingleton<INotificationService, NotificationService>();
+            services.AddNotificationRecovery();
+
+            return services;
+        }
+
+        /// <summary>
+        /// Adds the startup recovery step that requeues notifications left processing
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddNotificationRecovery(this IServiceCollection services)
+        {
+            services.AddSingleton<IStartupRecoveryStep, NotificationRecoveryStep>();
 
             return services;
         }
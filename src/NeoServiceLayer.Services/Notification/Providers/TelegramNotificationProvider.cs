@@ -0,0 +1,134 @@
+using System;
+using System.Net.Http;
+using System.Text;
+using System.Text.Json;
+using System.Text.RegularExpressions;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Notification.Providers
+{
+    /// <summary>
+    /// Implementation of the Telegram notification provider
+    /// </summary>
+    /// <remarks>
+    /// Messages are sent through the Bot API using the token in the "TelegramBotToken" option. The chat is read from
+    /// the notification's "TelegramChatId" data entry; "TelegramParseMode" may be set to "MarkdownV2" or "HTML".
+    /// </remarks>
+    public class TelegramNotificationProvider : ITelegramNotificationProvider
+    {
+        private const int MaxMessageLength = 4096;
+        private static readonly Regex ChatIdPattern = new Regex(@"^(-?\d+|@[A-Za-z][A-Za-z0-9_]{4,31})$", RegexOptions.Compiled);
+
+        private readonly ILogger<TelegramNotificationProvider> _logger;
+        private readonly NotificationProviderConfiguration _configuration;
+        private readonly HttpClient _httpClient;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="TelegramNotificationProvider"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="configuration">Configuration</param>
+        public TelegramNotificationProvider(ILogger<TelegramNotificationProvider> logger, IOptions<NotificationProviderConfiguration> configuration)
+        {
+            _logger = logger;
+            _configuration = configuration.Value;
+            _httpClient = new HttpClient();
+
+            _configuration.Options.TryGetValue("Timeout", out var timeoutStr);
+            _httpClient.Timeout = int.TryParse(timeoutStr, out var timeout) && timeout > 0
+                ? TimeSpan.FromSeconds(timeout)
+                : TimeSpan.FromSeconds(30);
+        }
+
+        /// <inheritdoc/>
+        public NotificationChannel Channel => NotificationChannel.Telegram;
+
+        /// <inheritdoc/>
+        public string Name => "Telegram";
+
+        /// <inheritdoc/>
+        public string Description => "Sends notifications via a Telegram bot";
+
+        /// <inheritdoc/>
+        public bool IsEnabled => _configuration.IsEnabled;
+
+        /// <inheritdoc/>
+        public NotificationProviderConfiguration Configuration => _configuration;
+
+        /// <inheritdoc/>
+        public async Task<(NotificationDeliveryStatus Status, string ErrorMessage)> SendAsync(Core.Models.Notification notification)
+        {
+            _logger.LogInformation("Sending Telegram notification: {Id}", notification.Id);
+
+            try
+            {
+                if (!Validate(notification))
+                {
+                    return (NotificationDeliveryStatus.Failed, "Invalid notification");
+                }
+
+                if (!_configuration.Options.TryGetValue("TelegramBotToken", out var botToken) || string.IsNullOrEmpty(botToken))
+                {
+                    return (NotificationDeliveryStatus.Failed, "Telegram bot token not configured");
+                }
+
+                var text = string.IsNullOrEmpty(notification.Subject)
+                    ? notification.Content
+                    : $"{notification.Subject}\n\n{notification.Content}";
+                if (text.Length > MaxMessageLength)
+                {
+                    text = text.Substring(0, MaxMessageLength);
+                }
+
+                notification.Data.TryGetValue("TelegramParseMode", out var parseMode);
+                var payload = new
+                {
+                    chat_id = notification.Data["TelegramChatId"].ToString(),
+                    text,
+                    parse_mode = parseMode?.ToString()
+                };
+
+                var content = new StringContent(JsonSerializer.Serialize(payload), Encoding.UTF8, "application/json");
+                var response = await _httpClient.PostAsync($"https://api.telegram.org/bot{botToken}/sendMessage", content);
+                if (response.IsSuccessStatusCode)
+                {
+                    return (NotificationDeliveryStatus.Delivered, null);
+                }
+
+                var errorContent = await response.Content.ReadAsStringAsync();
+                return (NotificationDeliveryStatus.Failed, $"Telegram error: {response.StatusCode} - {errorContent}");
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error sending Telegram notification: {Id}", notification.Id);
+                return (NotificationDeliveryStatus.Failed, ex.Message);
+            }
+        }
+
+        /// <inheritdoc/>
+        public bool Validate(Core.Models.Notification notification)
+        {
+            if (notification == null || string.IsNullOrEmpty(notification.Content))
+            {
+                return false;
+            }
+
+            if (!notification.Data.TryGetValue("TelegramChatId", out var chatIdObj) || chatIdObj == null)
+            {
+                return false;
+            }
+
+            return ValidateChatId(chatIdObj.ToString());
+        }
+
+        /// <inheritdoc/>
+        public bool ValidateChatId(string chatId)
+        {
+            return !string.IsNullOrEmpty(chatId) && ChatIdPattern.IsMatch(chatId);
+        }
+    }
+}
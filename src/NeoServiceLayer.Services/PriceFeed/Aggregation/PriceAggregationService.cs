@@ -0,0 +1,317 @@
+using System;
+using System.Collections.Generic;
+using System.Diagnostics;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.PriceFeed.DataSources;
+using NeoServiceLayer.Services.PriceFeed.Repositories;
+
+namespace NeoServiceLayer.Services.PriceFeed.Aggregation
+{
+    /// <summary>
+    /// Aggregates prices fetched directly from the configured HTTP price sources
+    /// </summary>
+    /// <remarks>
+    /// Every active source that supports the symbol is fetched in parallel. Prices older than the allowed age are
+    /// rejected as stale, prices too far from the median of the rest are rejected as outliers, and the aggregate is
+    /// the median of what remains. Nothing is published unless the minimum number of sources agree.
+    /// </remarks>
+    public class PriceAggregationService : IPriceAggregationService
+    {
+        private readonly ILogger<PriceAggregationService> _logger;
+        private readonly IPriceSourceRepository _sourceRepository;
+        private readonly IPriceRepository _priceRepository;
+        private readonly PriceDataSourceFactory _dataSourceFactory;
+        private readonly IPriceFeedService _priceFeedService;
+        private readonly PriceSourceHealthTracker _healthTracker;
+        private readonly PriceAggregationConfiguration _configuration;
+        private readonly IPricePublicationRepository _publicationRepository;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PriceAggregationService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="sourceRepository">Price source repository</param>
+        /// <param name="priceRepository">Price repository</param>
+        /// <param name="dataSourceFactory">Factory for the data sources that fetch each source</param>
+        /// <param name="priceFeedService">Price feed service, used to publish to the oracle contract</param>
+        /// <param name="healthTracker">Per-source health tracker</param>
+        /// <param name="configuration">Aggregation configuration</param>
+        /// <param name="publicationRepository">Repository of the last price published for each pair</param>
+        public PriceAggregationService(
+            ILogger<PriceAggregationService> logger,
+            IPriceSourceRepository sourceRepository,
+            IPriceRepository priceRepository,
+            PriceDataSourceFactory dataSourceFactory,
+            IPriceFeedService priceFeedService,
+            PriceSourceHealthTracker healthTracker,
+            IOptions<PriceAggregationConfiguration> configuration = null,
+            IPricePublicationRepository publicationRepository = null)
+        {
+            _logger = logger;
+            _sourceRepository = sourceRepository;
+            _priceRepository = priceRepository;
+            _dataSourceFactory = dataSourceFactory;
+            _priceFeedService = priceFeedService;
+            _healthTracker = healthTracker;
+            _configuration = configuration?.Value ?? new PriceAggregationConfiguration();
+            _publicationRepository = publicationRepository;
+        }
+
+        /// <inheritdoc/>
+        public async Task<PriceAggregation> AggregateAsync(string symbol, string baseCurrency = "USD")
+        {
+            if (string.IsNullOrWhiteSpace(symbol))
+            {
+                throw new ValidationException("Symbol is required");
+            }
+
+            if (string.IsNullOrWhiteSpace(baseCurrency))
+            {
+                throw new ValidationException("Base currency is required");
+            }
+
+            symbol = symbol.ToUpperInvariant();
+            baseCurrency = baseCurrency.ToUpperInvariant();
+
+            var sources = (await _sourceRepository.GetActiveSourcesAsync())
+                .Where(s => s.SupportedAssets == null || s.SupportedAssets.Count == 0 ||
+                            s.SupportedAssets.Contains(symbol, StringComparer.OrdinalIgnoreCase))
+                .ToList();
+
+            var observations = await Task.WhenAll(sources.Select(s => FetchAsync(s, symbol, baseCurrency)));
+            var weights = sources.ToDictionary(s => s.Id, s => s.Weight);
+
+            var aggregation = Aggregate(symbol, baseCurrency, observations, _configuration, DateTime.UtcNow);
+            if (aggregation.Price != null)
+            {
+                foreach (var sourcePrice in aggregation.Price.SourcePrices)
+                {
+                    sourcePrice.Weight = weights.TryGetValue(sourcePrice.SourceId, out var weight) ? weight : 1;
+                }
+            }
+
+            foreach (var observation in aggregation.Observations)
+            {
+                _healthTracker.Record(observation);
+            }
+
+            _logger.LogInformation(
+                "Aggregated {Symbol}/{BaseCurrency} from {Accepted} of {Total} sources: {Price}",
+                symbol,
+                baseCurrency,
+                aggregation.Observations.Count(o => o.Status == PriceObservationStatus.Accepted),
+                aggregation.Observations.Count,
+                aggregation.Price?.Value);
+
+            return aggregation;
+        }
+
+        /// <inheritdoc/>
+        public async Task<PriceAggregation> AggregateAndPublishAsync(string symbol, string baseCurrency = "USD")
+        {
+            var aggregation = await AggregateAsync(symbol, baseCurrency);
+            if (aggregation.Price == null)
+            {
+                var accepted = aggregation.Observations.Count(o => o.Status == PriceObservationStatus.Accepted);
+                throw new PriceFeedException(
+                    $"Only {accepted} of {aggregation.Observations.Count} sources agreed on {aggregation.Symbol}/{aggregation.BaseCurrency}; " +
+                    $"{_configuration.MinimumSources} are required");
+            }
+
+            return await PublishAsync(aggregation, PricePublicationReason.Manual);
+        }
+
+        /// <inheritdoc/>
+        public async Task<PriceAggregation> PublishAsync(PriceAggregation aggregation, PricePublicationReason reason)
+        {
+            if (aggregation?.Price == null)
+            {
+                throw new PriceFeedException("The aggregation has no price to publish");
+            }
+
+            var previous = _publicationRepository != null
+                ? await _publicationRepository.GetAsync(aggregation.Symbol, aggregation.BaseCurrency)
+                : null;
+
+            aggregation.Price = await _priceRepository.CreateAsync(aggregation.Price);
+            aggregation.TransactionHash = await _priceFeedService.SubmitToOracleAsync(aggregation.Price);
+
+            _logger.LogInformation(
+                "Published aggregated {Symbol}/{BaseCurrency} price {Price} ({Reason}) in transaction {TransactionHash}",
+                aggregation.Symbol,
+                aggregation.BaseCurrency,
+                aggregation.Price.Value,
+                reason,
+                aggregation.TransactionHash);
+
+            if (_publicationRepository != null)
+            {
+                await _publicationRepository.SaveAsync(new PricePublication
+                {
+                    Symbol = aggregation.Symbol,
+                    BaseCurrency = aggregation.BaseCurrency,
+                    Value = aggregation.Price.Value,
+                    TransactionHash = aggregation.TransactionHash,
+                    Reason = reason,
+                    DeviationPercent = previous != null ? DeviationPercent(previous.Value, aggregation.Price.Value) : null,
+                    PublishedAt = DateTime.UtcNow
+                });
+            }
+
+            return aggregation;
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<PriceSourceHealth>> GetSourceHealthAsync()
+        {
+            return Task.FromResult(_healthTracker.GetAll());
+        }
+
+        /// <summary>
+        /// Classifies each observation and takes the median of the accepted prices
+        /// </summary>
+        /// <param name="symbol">Symbol</param>
+        /// <param name="baseCurrency">Base currency</param>
+        /// <param name="observations">What each source reported; failed observations are left as they are</param>
+        /// <param name="configuration">Aggregation configuration</param>
+        /// <param name="now">Current time, used to judge staleness</param>
+        /// <returns>The aggregation; its price is null if fewer than the minimum number of sources were accepted</returns>
+        public static PriceAggregation Aggregate(
+            string symbol,
+            string baseCurrency,
+            IEnumerable<PriceSourceObservation> observations,
+            PriceAggregationConfiguration configuration,
+            DateTime now)
+        {
+            var aggregation = new PriceAggregation
+            {
+                Symbol = symbol,
+                BaseCurrency = baseCurrency,
+                Observations = observations.ToList(),
+                Timestamp = now
+            };
+
+            var fresh = new List<PriceSourceObservation>();
+            foreach (var observation in aggregation.Observations.Where(o => o.Status != PriceObservationStatus.Failed))
+            {
+                if (observation.Timestamp.HasValue && (now - observation.Timestamp.Value).TotalSeconds > configuration.MaxPriceAgeSeconds)
+                {
+                    observation.Status = PriceObservationStatus.Stale;
+                }
+                else
+                {
+                    fresh.Add(observation);
+                }
+            }
+
+            if (fresh.Count == 0)
+            {
+                return aggregation;
+            }
+
+            var rawMedian = Median(fresh.Select(o => o.Value.Value));
+            aggregation.RawMedian = rawMedian;
+
+            foreach (var observation in fresh)
+            {
+                observation.DeviationPercent = DeviationPercent(rawMedian, observation.Value.Value);
+                observation.Status = observation.DeviationPercent > configuration.MaxDeviationPercent
+                    ? PriceObservationStatus.Outlier
+                    : PriceObservationStatus.Accepted;
+            }
+
+            var accepted = fresh.Where(o => o.Status == PriceObservationStatus.Accepted).ToList();
+            if (accepted.Count < Math.Max(1, configuration.MinimumSources))
+            {
+                return aggregation;
+            }
+
+            aggregation.Price = new Price
+            {
+                Id = Guid.NewGuid(),
+                Symbol = symbol,
+                BaseCurrency = baseCurrency,
+                Value = Median(accepted.Select(o => o.Value.Value)),
+                Timestamp = now,
+                CreatedAt = now,
+                Source = "aggregate",
+                ConfidenceScore = (int)Math.Round(100.0 * accepted.Count / aggregation.Observations.Count),
+                SourcePrices = accepted.Select(o => new SourcePrice
+                {
+                    Id = Guid.NewGuid(),
+                    SourceId = o.SourceId,
+                    SourceName = o.SourceName,
+                    Value = o.Value.Value,
+                    Timestamp = o.Timestamp ?? now,
+                    Weight = 1
+                }).ToList()
+            };
+
+            return aggregation;
+        }
+
+        private async Task<PriceSourceObservation> FetchAsync(PriceSource source, string symbol, string baseCurrency)
+        {
+            var observation = new PriceSourceObservation
+            {
+                SourceId = source.Id,
+                SourceName = source.Name
+            };
+
+            var timeout = TimeSpan.FromSeconds(source.TimeoutSeconds > 0 ? source.TimeoutSeconds : _configuration.DefaultTimeoutSeconds);
+            var stopwatch = Stopwatch.StartNew();
+            try
+            {
+                var dataSource = _dataSourceFactory.CreateDataSource(source);
+                var fetch = dataSource.FetchPriceForSymbolAsync(symbol, baseCurrency);
+                if (await Task.WhenAny(fetch, Task.Delay(timeout)) != fetch)
+                {
+                    throw new TimeoutException($"No response within {timeout.TotalSeconds} seconds");
+                }
+
+                var price = await fetch;
+                if (price == null || price.Value <= 0)
+                {
+                    throw new PriceFeedException("Source returned no price");
+                }
+
+                observation.Status = PriceObservationStatus.Accepted;
+                observation.Value = price.Value;
+                observation.Timestamp = price.Timestamp == default ? null : price.Timestamp;
+            }
+            catch (Exception ex)
+            {
+                _logger.LogWarning(ex, "Error fetching {Symbol}/{BaseCurrency} from price source {SourceName}", symbol, baseCurrency, source.Name);
+                observation.Status = PriceObservationStatus.Failed;
+                observation.Error = ex.Message;
+            }
+
+            observation.LatencyMs = stopwatch.ElapsedMilliseconds;
+            return observation;
+        }
+
+        /// <summary>
+        /// Gets the relative change between two prices
+        /// </summary>
+        /// <param name="reference">Reference price</param>
+        /// <param name="value">New price</param>
+        /// <returns>The absolute change as a percentage of the reference, or 0 if the reference is 0</returns>
+        public static decimal DeviationPercent(decimal reference, decimal value)
+        {
+            return reference == 0 ? 0 : Math.Round(Math.Abs(value - reference) / reference * 100, 4);
+        }
+
+        private static decimal Median(IEnumerable<decimal> values)
+        {
+            var sorted = values.OrderBy(v => v).ToList();
+            var middle = sorted.Count / 2;
+            return sorted.Count % 2 == 1 ? sorted[middle] : (sorted[middle - 1] + sorted[middle]) / 2;
+        }
+    }
+}
@@ -0,0 +1,280 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.DependencyInjection;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Diagnostics;
+using NeoServiceLayer.Services.PriceFeed.Repositories;
+
+namespace NeoServiceLayer.Services.PriceFeed.Aggregation
+{
+    /// <summary>
+    /// Publishes aggregated prices on chain only when they move or a heartbeat elapses
+    /// </summary>
+    /// <remarks>
+    /// Every check aggregates the pair's price from its sources. The price is published when it deviates more than the
+    /// pair's threshold from the last published price, when the heartbeat has elapsed since that publication, or when
+    /// the pair has never been published. Otherwise no transaction is sent, which saves GAS while prices are stable.
+    /// </remarks>
+    public class PricePublicationService : IPricePublicationService, IManagedService, IDisposable
+    {
+        private readonly ILogger<PricePublicationService> _logger;
+        private readonly IServiceScopeFactory _scopeFactory;
+        private readonly IPricePublicationRepository _publicationRepository;
+        private readonly PricePublicationConfiguration _configuration;
+        private readonly ILeadershipService _leadership;
+        private readonly IMaintenanceService _maintenanceService;
+        private readonly ICrashReporter _crashReporter;
+        private readonly SemaphoreSlim _cycleSemaphore = new SemaphoreSlim(1, 1);
+        private Timer _checkTimer;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PricePublicationService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="scopeFactory">Scope factory used to resolve the aggregation service for each check</param>
+        /// <param name="publicationRepository">Repository of the last price published for each pair</param>
+        /// <param name="configuration">Publication configuration</param>
+        /// <param name="leadership">Leadership service; only the leader publishes in the background</param>
+        /// <param name="maintenanceService">Maintenance service; nothing is published in the background during maintenance</param>
+        /// <param name="crashReporter">Crash reporter for background work</param>
+        public PricePublicationService(
+            ILogger<PricePublicationService> logger,
+            IServiceScopeFactory scopeFactory,
+            IPricePublicationRepository publicationRepository,
+            IOptions<PricePublicationConfiguration> configuration = null,
+            ILeadershipService leadership = null,
+            IMaintenanceService maintenanceService = null,
+            ICrashReporter crashReporter = null)
+        {
+            _logger = logger;
+            _scopeFactory = scopeFactory;
+            _publicationRepository = publicationRepository;
+            _configuration = configuration?.Value ?? new PricePublicationConfiguration();
+            _leadership = leadership;
+            _maintenanceService = maintenanceService;
+            _crashReporter = crashReporter;
+        }
+
+        /// <inheritdoc/>
+        public async Task<PricePublicationResult> PublishIfDueAsync(string symbol, string baseCurrency = "USD")
+        {
+            var pair = _configuration.Pairs.FirstOrDefault(p =>
+                string.Equals(p.Symbol, symbol, StringComparison.OrdinalIgnoreCase) &&
+                string.Equals(p.BaseCurrency, baseCurrency, StringComparison.OrdinalIgnoreCase));
+
+            return await PublishIfDueAsync(
+                symbol,
+                baseCurrency,
+                pair?.DeviationThresholdPercent ?? _configuration.DeviationThresholdPercent,
+                pair?.HeartbeatSeconds ?? _configuration.HeartbeatSeconds);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<PricePublicationResult>> PublishDueAsync()
+        {
+            var results = new List<PricePublicationResult>();
+            foreach (var pair in _configuration.Pairs.Where(p => !string.IsNullOrWhiteSpace(p.Symbol)))
+            {
+                var baseCurrency = string.IsNullOrWhiteSpace(pair.BaseCurrency) ? "USD" : pair.BaseCurrency;
+                try
+                {
+                    results.Add(await PublishIfDueAsync(
+                        pair.Symbol,
+                        baseCurrency,
+                        pair.DeviationThresholdPercent ?? _configuration.DeviationThresholdPercent,
+                        pair.HeartbeatSeconds ?? _configuration.HeartbeatSeconds));
+                }
+                catch (Exception ex)
+                {
+                    _logger.LogError(ex, "Error publishing {Symbol}/{BaseCurrency}", pair.Symbol, baseCurrency);
+                    results.Add(new PricePublicationResult
+                    {
+                        Symbol = pair.Symbol.ToUpperInvariant(),
+                        BaseCurrency = baseCurrency.ToUpperInvariant(),
+                        Error = ex.Message
+                    });
+                }
+            }
+
+            return results;
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<PricePublication>> GetPublicationsAsync()
+        {
+            return _publicationRepository.GetAllAsync();
+        }
+
+        /// <inheritdoc/>
+        public string Name => Constants.ManagedServices.PricePublication;
+
+        /// <inheritdoc/>
+        public IReadOnlyCollection<string> DependsOn { get; } = new[] { Constants.ManagedServices.Leadership };
+
+        /// <inheritdoc/>
+        public Task StartAsync()
+        {
+            if (!_configuration.Enabled || _checkTimer != null)
+            {
+                return Task.CompletedTask;
+            }
+
+            _logger.LogInformation(
+                "Checking {Count} price pairs for publication every {Interval} seconds",
+                _configuration.Pairs.Count,
+                _configuration.CheckIntervalSeconds);
+
+            _checkTimer = new Timer(
+                _crashReporter.Guard(_logger, "PriceFeed.Publish", RunCycleAsync),
+                null,
+                TimeSpan.FromSeconds(_configuration.CheckIntervalSeconds),
+                TimeSpan.FromSeconds(_configuration.CheckIntervalSeconds));
+
+            return Task.CompletedTask;
+        }
+
+        /// <inheritdoc/>
+        public async Task StopAsync()
+        {
+            _checkTimer?.Change(Timeout.Infinite, Timeout.Infinite);
+            _checkTimer?.Dispose();
+            _checkTimer = null;
+
+            // Let a cycle already running finish
+            await _cycleSemaphore.WaitAsync();
+            _cycleSemaphore.Release();
+        }
+
+        /// <inheritdoc/>
+        public ManagedServiceHealth GetHealth()
+        {
+            if (!_configuration.Enabled)
+            {
+                return ManagedServiceHealth.Of(Name, ManagedServiceStatus.Disabled);
+            }
+
+            return ManagedServiceHealth.Of(Name, _checkTimer != null ? ManagedServiceStatus.Running : ManagedServiceStatus.Stopped);
+        }
+
+        /// <summary>
+        /// Checks every configured pair, unless another instance leads or maintenance is active
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task RunCycleAsync()
+        {
+            if (!await _cycleSemaphore.WaitAsync(0))
+            {
+                return;
+            }
+
+            try
+            {
+                if (_leadership?.IsLeader == false)
+                {
+                    return;
+                }
+
+                if (_maintenanceService != null && await _maintenanceService.IsActiveAsync())
+                {
+                    return;
+                }
+
+                await PublishDueAsync();
+            }
+            finally
+            {
+                _cycleSemaphore.Release();
+            }
+        }
+
+        /// <summary>
+        /// Decides whether a price should be published
+        /// </summary>
+        /// <param name="previous">Last publication of the pair, or null if it was never published</param>
+        /// <param name="value">Aggregated price</param>
+        /// <param name="now">Current time</param>
+        /// <param name="deviationThresholdPercent">Deviation, in percent, above which the price is published</param>
+        /// <param name="heartbeatSeconds">Time after which the price is published even if it has not moved</param>
+        /// <returns>Why the price should be published, or null if it should not</returns>
+        public static PricePublicationReason? Decide(
+            PricePublication previous,
+            decimal value,
+            DateTime now,
+            decimal deviationThresholdPercent,
+            int heartbeatSeconds)
+        {
+            if (previous == null)
+            {
+                return PricePublicationReason.Initial;
+            }
+
+            if (PriceAggregationService.DeviationPercent(previous.Value, value) > deviationThresholdPercent)
+            {
+                return PricePublicationReason.Deviation;
+            }
+
+            if (heartbeatSeconds > 0 && (now - previous.PublishedAt).TotalSeconds >= heartbeatSeconds)
+            {
+                return PricePublicationReason.Heartbeat;
+            }
+
+            return null;
+        }
+
+        /// <inheritdoc/>
+        public void Dispose()
+        {
+            _checkTimer?.Dispose();
+            _cycleSemaphore.Dispose();
+        }
+
+        private async Task<PricePublicationResult> PublishIfDueAsync(string symbol, string baseCurrency, decimal deviationThresholdPercent, int heartbeatSeconds)
+        {
+            using var scope = _scopeFactory.CreateScope();
+            var aggregationService = scope.ServiceProvider.GetRequiredService<IPriceAggregationService>();
+
+            var aggregation = await aggregationService.AggregateAsync(symbol, baseCurrency);
+            var result = new PricePublicationResult
+            {
+                Symbol = aggregation.Symbol,
+                BaseCurrency = aggregation.BaseCurrency,
+                Aggregation = aggregation,
+                PreviousPublication = await _publicationRepository.GetAsync(aggregation.Symbol, aggregation.BaseCurrency)
+            };
+
+            if (aggregation.Price == null)
+            {
+                result.Error = "Too few sources agreed on a price";
+                _logger.LogWarning("Not publishing {Symbol}/{BaseCurrency}: {Error}", result.Symbol, result.BaseCurrency, result.Error);
+                return result;
+            }
+
+            if (result.PreviousPublication != null)
+            {
+                result.DeviationPercent = PriceAggregationService.DeviationPercent(result.PreviousPublication.Value, aggregation.Price.Value);
+            }
+
+            result.Reason = Decide(result.PreviousPublication, aggregation.Price.Value, DateTime.UtcNow, deviationThresholdPercent, heartbeatSeconds);
+            if (result.Reason == null)
+            {
+                _logger.LogDebug(
+                    "Not publishing {Symbol}/{BaseCurrency}: {Deviation}% from the last published price is within {Threshold}%",
+                    result.Symbol,
+                    result.BaseCurrency,
+                    result.DeviationPercent,
+                    deviationThresholdPercent);
+                return result;
+            }
+
+            await aggregationService.PublishAsync(aggregation, result.Reason.Value);
+            return result;
+        }
+    }
+}
@@ -0,0 +1,108 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.Linq;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.PriceFeed.Aggregation
+{
+    /// <summary>
+    /// Keeps per-source health counters across aggregations
+    /// </summary>
+    /// <remarks>
+    /// Counters live in memory and restart with the process. A source is unhealthy after the configured number of
+    /// consecutive failures, and degraded while its latest fetch failed or its latest price was rejected.
+    /// </remarks>
+    public class PriceSourceHealthTracker
+    {
+        private readonly ConcurrentDictionary<Guid, PriceSourceHealth> _health = new ConcurrentDictionary<Guid, PriceSourceHealth>();
+        private readonly PriceAggregationConfiguration _configuration;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PriceSourceHealthTracker"/> class
+        /// </summary>
+        /// <param name="configuration">Aggregation configuration</param>
+        public PriceSourceHealthTracker(IOptions<PriceAggregationConfiguration> configuration = null)
+        {
+            _configuration = configuration?.Value ?? new PriceAggregationConfiguration();
+        }
+
+        /// <summary>
+        /// Records the outcome of one fetch
+        /// </summary>
+        /// <param name="observation">What the source reported</param>
+        public void Record(PriceSourceObservation observation)
+        {
+            var health = _health.GetOrAdd(observation.SourceId, id => new PriceSourceHealth { SourceId = id });
+            lock (health)
+            {
+                health.SourceName = observation.SourceName;
+                health.LastLatencyMs = observation.LatencyMs;
+
+                if (observation.Status == PriceObservationStatus.Failed)
+                {
+                    health.FailureCount++;
+                    health.ConsecutiveFailures++;
+                    health.LastFailureAt = DateTime.UtcNow;
+                    health.LastError = observation.Error;
+                }
+                else
+                {
+                    health.AverageLatencyMs = (health.AverageLatencyMs * health.SuccessCount + observation.LatencyMs) / (health.SuccessCount + 1);
+                    health.SuccessCount++;
+                    health.ConsecutiveFailures = 0;
+                    health.LastSuccessAt = DateTime.UtcNow;
+                    health.LastDeviationPercent = observation.DeviationPercent;
+
+                    if (observation.Status == PriceObservationStatus.Outlier)
+                    {
+                        health.OutlierCount++;
+                    }
+                    else if (observation.Status == PriceObservationStatus.Stale)
+                    {
+                        health.StaleCount++;
+                    }
+                }
+
+                health.Status = health.ConsecutiveFailures >= _configuration.UnhealthyAfterFailures
+                    ? "Unhealthy"
+                    : observation.Status == PriceObservationStatus.Accepted ? "Healthy" : "Degraded";
+            }
+        }
+
+        /// <summary>
+        /// Gets a snapshot of every tracked source
+        /// </summary>
+        /// <returns>Source health, by source name</returns>
+        public IEnumerable<PriceSourceHealth> GetAll()
+        {
+            return _health.Values
+                .Select(health =>
+                {
+                    lock (health)
+                    {
+                        return new PriceSourceHealth
+                        {
+                            SourceId = health.SourceId,
+                            SourceName = health.SourceName,
+                            Status = health.Status,
+                            SuccessCount = health.SuccessCount,
+                            FailureCount = health.FailureCount,
+                            OutlierCount = health.OutlierCount,
+                            StaleCount = health.StaleCount,
+                            ConsecutiveFailures = health.ConsecutiveFailures,
+                            LastLatencyMs = health.LastLatencyMs,
+                            AverageLatencyMs = health.AverageLatencyMs,
+                            LastDeviationPercent = health.LastDeviationPercent,
+                            LastSuccessAt = health.LastSuccessAt,
+                            LastFailureAt = health.LastFailureAt,
+                            LastError = health.LastError
+                        };
+                    }
+                })
+                .OrderBy(h => h.SourceName)
+                .ToList();
+        }
+    }
+}
@@ -0,0 +1,223 @@
+using System;
+using System.Buffers.Binary;
+using System.Collections.Generic;
+using System.IO;
+using System.Linq;
+using System.Security.Cryptography;
+using System.Text.Json;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.PriceFeed.Contract
+{
+    /// <summary>
+    /// Deploys and updates the on-chain PriceFeed contract from its compiled NEF and manifest
+    /// </summary>
+    /// <remarks>
+    /// The artifacts are validated here (NEF magic and checksum, manifest name and ABI) so that a broken build is
+    /// rejected before anything is signed. Signing and sending happen in the enclave, with the same wallets used to
+    /// submit prices.
+    /// </remarks>
+    public class PriceFeedContractService : IPriceFeedContractService
+    {
+        private const uint NefMagic = 0x3346454E;
+
+        private static readonly string[] RequiredMethods =
+        {
+            Constants.PriceFeedContractMethods.GetLatestRoundId,
+            Constants.PriceFeedContractMethods.GetRound,
+            Constants.PriceFeedContractMethods.Update
+        };
+
+        private readonly ILogger<PriceFeedContractService> _logger;
+        private readonly IEnclaveService _enclaveService;
+        private readonly PriceFeedContractConfiguration _configuration;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PriceFeedContractService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="enclaveService">Enclave service</param>
+        /// <param name="configuration">Contract configuration</param>
+        public PriceFeedContractService(
+            ILogger<PriceFeedContractService> logger,
+            IEnclaveService enclaveService,
+            IOptions<PriceFeedContractConfiguration> configuration)
+        {
+            _logger = logger;
+            _enclaveService = enclaveService;
+            _configuration = configuration.Value;
+        }
+
+        /// <inheritdoc/>
+        public async Task<PriceFeedContractArtifacts> LoadArtifactsAsync()
+        {
+            if (string.IsNullOrEmpty(_configuration.NefPath) || string.IsNullOrEmpty(_configuration.ManifestPath))
+            {
+                throw new PriceFeedException("PriceFeed contract NEF and manifest paths are not configured");
+            }
+
+            byte[] nef;
+            string manifest;
+            try
+            {
+                nef = await File.ReadAllBytesAsync(_configuration.NefPath);
+                manifest = await File.ReadAllTextAsync(_configuration.ManifestPath);
+            }
+            catch (IOException ex)
+            {
+                throw new PriceFeedException($"Error reading PriceFeed contract artifacts: {ex.Message}", ex);
+            }
+
+            var checksum = ValidateNef(nef);
+            var (name, methods) = ParseManifest(manifest);
+
+            var missing = RequiredMethods.Where(m => !methods.Contains(m)).ToList();
+            if (missing.Count > 0)
+            {
+                throw new PriceFeedException($"PriceFeed contract manifest does not declare: {string.Join(", ", missing)}");
+            }
+
+            return new PriceFeedContractArtifacts
+            {
+                Name = name,
+                Nef = Convert.ToBase64String(nef),
+                NefChecksum = checksum,
+                Manifest = manifest,
+                Methods = methods.ToList()
+            };
+        }
+
+        /// <inheritdoc/>
+        public Task<PriceFeedContractDeployment> DeployAsync(PriceFeedContractDeploymentRequest request)
+        {
+            return SubmitAsync("deploy", null, request);
+        }
+
+        /// <inheritdoc/>
+        public Task<PriceFeedContractDeployment> UpdateAsync(PriceFeedContractDeploymentRequest request)
+        {
+            if (string.IsNullOrEmpty(_configuration.ContractHash))
+            {
+                throw new PriceFeedException("PriceFeed contract hash is not configured; deploy the contract first");
+            }
+
+            return SubmitAsync("update", _configuration.ContractHash, request);
+        }
+
+        private async Task<PriceFeedContractDeployment> SubmitAsync(string operation, string contractHash, PriceFeedContractDeploymentRequest request)
+        {
+            if (request == null || request.WalletId == Guid.Empty || request.AccountId == Guid.Empty || string.IsNullOrEmpty(request.Password))
+            {
+                throw new PriceFeedException("Wallet information is required to sign the PriceFeed contract deployment");
+            }
+
+            var artifacts = await LoadArtifactsAsync();
+
+            _logger.LogWarning("Submitting PriceFeed contract {Operation}: {Name}, NEF checksum {Checksum}, network {Network}",
+                operation, artifacts.Name, artifacts.NefChecksum, request.Network);
+
+            var response = await _enclaveService.SendRequestAsync<object, PriceFeedContractDeployment>(
+                Constants.EnclaveServiceTypes.PriceFeed,
+                Constants.PriceFeedOperations.DeployContract,
+                new
+                {
+                    Operation = operation,
+                    ContractHash = contractHash,
+                    artifacts.Nef,
+                    artifacts.Manifest,
+                    request.WalletId,
+                    request.AccountId,
+                    request.Password,
+                    request.Network
+                });
+
+            if (string.IsNullOrEmpty(response?.TransactionHash))
+            {
+                throw new PriceFeedException($"Enclave did not return a transaction hash for the PriceFeed contract {operation}");
+            }
+
+            _logger.LogWarning("Submitted PriceFeed contract {Operation} in transaction {TransactionHash}", operation, response.TransactionHash);
+
+            return new PriceFeedContractDeployment
+            {
+                Operation = operation,
+                Name = artifacts.Name,
+                ContractHash = contractHash,
+                NefChecksum = artifacts.NefChecksum,
+                Network = request.Network,
+                TransactionHash = response.TransactionHash,
+                SubmittedAt = DateTime.UtcNow
+            };
+        }
+
+        /// <summary>
+        /// Checks the NEF magic and checksum
+        /// </summary>
+        /// <param name="nef">NEF file</param>
+        /// <returns>The checksum in hex</returns>
+        private static string ValidateNef(byte[] nef)
+        {
+            // magic (4) + compiler (64) + source (>= 1) + reserved (1) + tokens (>= 1) + reserved (2) + script (>= 2) + checksum (4)
+            if (nef == null || nef.Length < 79)
+            {
+                throw new PriceFeedException("PriceFeed contract NEF is truncated");
+            }
+
+            if (BinaryPrimitives.ReadUInt32LittleEndian(nef) != NefMagic)
+            {
+                throw new PriceFeedException("PriceFeed contract NEF has an invalid magic number");
+            }
+
+            var body = nef.AsSpan(0, nef.Length - 4);
+            var expected = SHA256.HashData(SHA256.HashData(body)).AsSpan(0, 4);
+            var actual = nef.AsSpan(nef.Length - 4);
+            if (!expected.SequenceEqual(actual))
+            {
+                throw new PriceFeedException("PriceFeed contract NEF checksum does not match its content");
+            }
+
+            return Convert.ToHexString(actual).ToLowerInvariant();
+        }
+
+        private static (string name, HashSet<string> methods) ParseManifest(string manifest)
+        {
+            try
+            {
+                using var document = JsonDocument.Parse(manifest);
+                var root = document.RootElement;
+
+                var name = root.TryGetProperty("name", out var nameElement) ? nameElement.GetString() : null;
+                if (string.IsNullOrEmpty(name))
+                {
+                    throw new PriceFeedException("PriceFeed contract manifest has no name");
+                }
+
+                var methods = new HashSet<string>(StringComparer.Ordinal);
+                if (root.TryGetProperty("abi", out var abi)
+                    && abi.TryGetProperty("methods", out var methodElements)
+                    && methodElements.ValueKind == JsonValueKind.Array)
+                {
+                    foreach (var method in methodElements.EnumerateArray())
+                    {
+                        if (method.TryGetProperty("name", out var methodName) && methodName.ValueKind == JsonValueKind.String)
+                        {
+                            methods.Add(methodName.GetString());
+                        }
+                    }
+                }
+
+                return (name, methods);
+            }
+            catch (JsonException ex)
+            {
+                throw new PriceFeedException($"PriceFeed contract manifest is not valid JSON: {ex.Message}", ex);
+            }
+        }
+    }
+}
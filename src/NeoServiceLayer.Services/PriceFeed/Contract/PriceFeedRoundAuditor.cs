@@ -0,0 +1,219 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Linq;
+using System.Numerics;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Services.PriceFeed.Repositories;
+
+namespace NeoServiceLayer.Services.PriceFeed.Contract
+{
+    /// <summary>
+    /// Compares the rounds stored in the on-chain PriceFeed contract against the prices the service aggregated
+    /// </summary>
+    /// <remarks>
+    /// Rounds are read as <c>getRound(pair, roundId)</c> returning <c>[price, timestampMs]</c>, with the price
+    /// scaled by <see cref="PriceFeedContractConfiguration.Decimals"/>. Each round is matched with the internal price
+    /// closest to its timestamp; a round with no internal price within the match window, or whose price deviates by
+    /// more than the tolerance, is reported as a divergence.
+    /// </remarks>
+    public class PriceFeedRoundAuditor : IPriceFeedRoundAuditor
+    {
+        private readonly ILogger<PriceFeedRoundAuditor> _logger;
+        private readonly INeoRpcClient _rpcClient;
+        private readonly IPriceRepository _priceRepository;
+        private readonly PriceFeedContractConfiguration _configuration;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PriceFeedRoundAuditor"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="rpcClient">Neo RPC client</param>
+        /// <param name="priceRepository">Price repository</param>
+        /// <param name="configuration">Contract configuration</param>
+        public PriceFeedRoundAuditor(
+            ILogger<PriceFeedRoundAuditor> logger,
+            INeoRpcClient rpcClient,
+            IPriceRepository priceRepository,
+            IOptions<PriceFeedContractConfiguration> configuration)
+        {
+            _logger = logger;
+            _rpcClient = rpcClient;
+            _priceRepository = priceRepository;
+            _configuration = configuration.Value;
+        }
+
+        /// <inheritdoc/>
+        public async Task<PriceFeedRoundAuditReport> AuditAsync(string symbol, string baseCurrency, int? rounds = null)
+        {
+            if (string.IsNullOrEmpty(_configuration.ContractHash))
+            {
+                throw new PriceFeedException("PriceFeed contract hash is not configured");
+            }
+
+            if (string.IsNullOrEmpty(symbol) || string.IsNullOrEmpty(baseCurrency))
+            {
+                throw new PriceFeedException("Symbol and base currency are required");
+            }
+
+            var count = rounds ?? _configuration.DefaultAuditRounds;
+            if (count <= 0)
+            {
+                throw new PriceFeedException("Number of rounds must be greater than zero");
+            }
+
+            var contractHash = _configuration.ContractHash;
+            var pair = $"{symbol}/{baseCurrency}";
+
+            var latest = await _rpcClient.InvokeFunctionAsync(
+                contractHash,
+                Constants.PriceFeedContractMethods.GetLatestRoundId,
+                new[] { NeoContractParameter.String(pair) });
+
+            if (!latest.IsHalt || !TryParseInteger(latest.Stack.FirstOrDefault(), out var latestRoundId))
+            {
+                throw new PriceFeedException($"Could not read the latest round of {pair} from {contractHash}: {latest.Exception}");
+            }
+
+            var report = new PriceFeedRoundAuditReport
+            {
+                Symbol = symbol,
+                BaseCurrency = baseCurrency,
+                ContractHash = contractHash,
+                LatestRoundId = (long)latestRoundId,
+                AuditedAt = DateTime.UtcNow
+            };
+
+            var firstRoundId = Math.Max(1, report.LatestRoundId - count + 1);
+            var roundIds = new List<long>();
+            for (var id = firstRoundId; id <= report.LatestRoundId; id++)
+            {
+                roundIds.Add(id);
+            }
+
+            if (roundIds.Count == 0)
+            {
+                return report;
+            }
+
+            var results = await _rpcClient.InvokeFunctionBatchAsync(roundIds.Select(id => new NeoInvocation
+            {
+                ScriptHash = contractHash,
+                Operation = Constants.PriceFeedContractMethods.GetRound,
+                Args = new List<NeoContractParameter> { NeoContractParameter.String(pair), NeoContractParameter.Integer(id) }
+            }));
+
+            var onChain = new List<(long roundId, decimal value, DateTime timestamp)>();
+            for (var i = 0; i < roundIds.Count; i++)
+            {
+                if (TryParseRound(results[i], out var value, out var timestamp))
+                {
+                    onChain.Add((roundIds[i], value, timestamp));
+                }
+                else
+                {
+                    report.Divergences.Add(new PriceFeedRoundDivergence { RoundId = roundIds[i], Reason = "Unreadable" });
+                }
+            }
+
+            report.RoundsAudited = roundIds.Count;
+
+            if (onChain.Count > 0)
+            {
+                var window = TimeSpan.FromSeconds(_configuration.AuditMatchWindowSeconds);
+                var prices = (await _priceRepository.GetPricesInRangeAsync(
+                    symbol,
+                    baseCurrency,
+                    onChain.Min(r => r.timestamp) - window,
+                    onChain.Max(r => r.timestamp) + window)).ToList();
+
+                foreach (var round in onChain)
+                {
+                    var divergence = Compare(round.roundId, round.value, round.timestamp, prices, window);
+                    if (divergence != null)
+                    {
+                        report.Divergences.Add(divergence);
+                    }
+                }
+            }
+
+            report.Divergences = report.Divergences.OrderBy(d => d.RoundId).ToList();
+
+            if (!report.IsConsistent)
+            {
+                _logger.LogWarning("PriceFeed audit of {Pair} on {ContractHash} found {DivergenceCount} divergent rounds out of {RoundCount}",
+                    pair, contractHash, report.Divergences.Count, report.RoundsAudited);
+            }
+
+            return report;
+        }
+
+        private PriceFeedRoundDivergence Compare(long roundId, decimal value, DateTime timestamp, List<Price> prices, TimeSpan window)
+        {
+            var closest = prices
+                .Where(p => (p.Timestamp - timestamp).Duration() <= window)
+                .OrderBy(p => (p.Timestamp - timestamp).Duration())
+                .FirstOrDefault();
+
+            if (closest == null)
+            {
+                return new PriceFeedRoundDivergence
+                {
+                    RoundId = roundId,
+                    Reason = "Missing",
+                    OnChainValue = value,
+                    OnChainTimestamp = timestamp
+                };
+            }
+
+            var deviation = closest.Value == 0 ? 100m : Math.Abs(value - closest.Value) / closest.Value * 100m;
+            if (deviation <= _configuration.AuditTolerancePercent)
+            {
+                return null;
+            }
+
+            return new PriceFeedRoundDivergence
+            {
+                RoundId = roundId,
+                Reason = "Deviation",
+                OnChainValue = value,
+                OnChainTimestamp = timestamp,
+                ServiceValue = closest.Value,
+                ServiceTimestamp = closest.Timestamp,
+                DeviationPercent = Math.Round(deviation, 4)
+            };
+        }
+
+        private bool TryParseRound(NeoInvokeResult result, out decimal value, out DateTime timestamp)
+        {
+            value = 0;
+            timestamp = default;
+
+            var round = result?.IsHalt == true ? result.Stack.FirstOrDefault() : null;
+            if (round?.Items == null || round.Items.Count < 2
+                || !TryParseInteger(round.Items[0], out var rawValue)
+                || !TryParseInteger(round.Items[1], out var rawTimestamp))
+            {
+                return false;
+            }
+
+            value = (decimal)rawValue / (decimal)BigInteger.Pow(10, _configuration.Decimals);
+            timestamp = DateTimeOffset.FromUnixTimeMilliseconds((long)rawTimestamp).UtcDateTime;
+            return true;
+        }
+
+        private static bool TryParseInteger(NeoStackItem item, out BigInteger value)
+        {
+            value = BigInteger.Zero;
+            return item?.Type == "Integer"
+                && BigInteger.TryParse(item.Value, NumberStyles.AllowLeadingSign, CultureInfo.InvariantCulture, out value);
+        }
+    }
+}
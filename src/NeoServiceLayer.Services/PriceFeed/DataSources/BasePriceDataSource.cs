@@ -14,6 +14,7 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
     {
         protected readonly ILogger _logger;
         protected readonly HttpClient _httpClient;
+        protected PriceSource _source;
         protected PriceSourceConfig _config;
 
         /// <summary>
@@ -37,9 +38,10 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
         public abstract IEnumerable<string> SupportedAssets { get; }
 
         /// <inheritdoc/>
-        public virtual void Initialize(PriceSourceConfig config)
+        public virtual void Initialize(PriceSource source)
         {
-            _config = config ?? throw new ArgumentNullException(nameof(config));
+            _source = source ?? throw new ArgumentNullException(nameof(source));
+            _config = source.Config ?? new PriceSourceConfig();
         }
 
         /// <inheritdoc/>
@@ -109,8 +111,8 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
                     new SourcePrice
                     {
                         Id = Guid.NewGuid(),
-                        SourceId = Guid.NewGuid(),
-                        SourceName = Name,
+                        SourceId = _source?.Id ?? Guid.Empty,
+                        SourceName = _source?.Name ?? Name,
                         Value = value,
                         Timestamp = timestamp,
                         Weight = 100
@@ -3,6 +3,7 @@ using System.Collections.Generic;
 using System.Linq;
 using System.Net.Http;
 using System.Text.Json;
+using System.Text.Json.Serialization;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
 using NeoServiceLayer.Core.Models;
@@ -14,6 +15,13 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
     /// </summary>
     public class BinanceDataSource : BasePriceDataSource
     {
+        // Binance returns lower-case names and prices as strings
+        private static readonly JsonSerializerOptions JsonOptions = new JsonSerializerOptions
+        {
+            PropertyNameCaseInsensitive = true,
+            NumberHandling = JsonNumberHandling.AllowReadingFromString
+        };
+
         private readonly HashSet<string> _supportedAssets;
 
         /// <summary>
@@ -55,7 +63,7 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
                 response.EnsureSuccessStatusCode();
 
                 var content = await response.Content.ReadAsStringAsync();
-                var data = JsonSerializer.Deserialize<List<BinanceTickerPrice>>(content);
+                var data = JsonSerializer.Deserialize<List<BinanceTickerPrice>>(content, JsonOptions);
 
                 // Get timestamp
                 var timestamp = DateTime.UtcNow;
@@ -97,7 +105,7 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
                 response.EnsureSuccessStatusCode();
 
                 var content = await response.Content.ReadAsStringAsync();
-                var data = JsonSerializer.Deserialize<BinanceTickerPrice>(content);
+                var data = JsonSerializer.Deserialize<BinanceTickerPrice>(content, JsonOptions);
 
                 return CreatePrice(symbol, baseCurrency, data.Price, DateTime.UtcNow);
             }
@@ -101,7 +101,7 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
                 response.EnsureSuccessStatusCode();
 
                 var content = await response.Content.ReadAsStringAsync();
-                var data = JsonSerializer.Deserialize<Dictionary<string, Dictionary<string, object>>>(content);
+                var data = JsonSerializer.Deserialize<Dictionary<string, Dictionary<string, JsonElement>>>(content);
 
                 foreach (var item in data)
                 {
@@ -116,8 +116,8 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
                     if (priceData.TryGetValue(baseCurrency.ToLower(), out var priceObj) &&
                         priceData.TryGetValue("last_updated_at", out var timestampObj))
                     {
-                        var price = Convert.ToDecimal(priceObj);
-                        var timestamp = DateTimeOffset.FromUnixTimeSeconds(Convert.ToInt64(timestampObj)).UtcDateTime;
+                        var price = priceObj.GetDecimal();
+                        var timestamp = DateTimeOffset.FromUnixTimeSeconds(timestampObj.GetInt64()).UtcDateTime;
 
                         prices.Add(CreatePrice(symbol, baseCurrency, price, timestamp));
                     }
@@ -149,14 +149,14 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
                 response.EnsureSuccessStatusCode();
 
                 var content = await response.Content.ReadAsStringAsync();
-                var data = JsonSerializer.Deserialize<Dictionary<string, Dictionary<string, object>>>(content);
+                var data = JsonSerializer.Deserialize<Dictionary<string, Dictionary<string, JsonElement>>>(content);
 
                 if (data.TryGetValue(coinId, out var priceData) &&
                     priceData.TryGetValue(baseCurrency.ToLower(), out var priceObj) &&
                     priceData.TryGetValue("last_updated_at", out var timestampObj))
                 {
-                    var price = Convert.ToDecimal(priceObj);
-                    var timestamp = DateTimeOffset.FromUnixTimeSeconds(Convert.ToInt64(timestampObj)).UtcDateTime;
+                    var price = priceObj.GetDecimal();
+                    var timestamp = DateTimeOffset.FromUnixTimeSeconds(timestampObj.GetInt64()).UtcDateTime;
 
                     return CreatePrice(symbol, baseCurrency, price, timestamp);
                 }
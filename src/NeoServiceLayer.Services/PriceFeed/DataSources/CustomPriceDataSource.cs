@@ -1,5 +1,6 @@
 using System;
 using System.Collections.Generic;
+using System.Globalization;
 using System.Linq;
 using System.Net.Http;
 using System.Text.Json;
@@ -10,7 +11,7 @@ using NeoServiceLayer.Core.Models;
 namespace NeoServiceLayer.Services.PriceFeed.DataSources
 {
     /// <summary>
-    /// Custom price data source implementation
+    /// Price data source for any HTTP endpoint, configured by the price source's URL and request configuration
     /// </summary>
     public class CustomPriceDataSource : BasePriceDataSource
     {
@@ -36,7 +37,9 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
         public override PriceSourceType Type => PriceSourceType.Custom;
 
         /// <inheritdoc/>
-        public override IEnumerable<string> SupportedAssets => new[] { "BTC", "ETH", "NEO", "GAS" };
+        public override IEnumerable<string> SupportedAssets => _source?.SupportedAssets?.Count > 0
+            ? _source.SupportedAssets
+            : new List<string> { "BTC", "ETH", "NEO", "GAS" };
 
         /// <inheritdoc/>
         public override async Task<IEnumerable<Price>> FetchPricesAsync(string baseCurrency = "USD")
@@ -67,17 +70,23 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
 
             try
             {
-                // Build request URL with query parameters
-                var requestUrl = "https://api.example.com/price";
-                var queryParams = new Dictionary<string, string>();
+                if (string.IsNullOrEmpty(_source?.Url))
+                {
+                    throw new InvalidOperationException($"Price source {_source?.Name ?? Name} has no URL");
+                }
+
+                // {symbol} and {base} in the URL, query parameters and body are replaced with the requested pair
+                var requestUrl = Substitute(_source.Url, symbol, baseCurrency);
+                var queryParams = _config.QueryParams?.ToDictionary(p => p.Key, p => Substitute(p.Value, symbol, baseCurrency))
+                    ?? new Dictionary<string, string>();
 
-                // Add symbol and base currency to query parameters if not already present
-                if (!queryParams.ContainsKey("symbol"))
+                // Add symbol and base currency to query parameters unless the URL already carries them
+                if (!_source.Url.Contains("{symbol}") && !queryParams.ContainsKey("symbol"))
                 {
                     queryParams["symbol"] = symbol;
                 }
 
-                if (!queryParams.ContainsKey("base"))
+                if (!_source.Url.Contains("{base}") && !queryParams.ContainsKey("base"))
                 {
                     queryParams["base"] = baseCurrency;
                 }
@@ -90,13 +99,27 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
                 }
 
                 // Create request message
-                var request = new HttpRequestMessage(HttpMethod.Get, requestUrl);
+                var request = new HttpRequestMessage(new HttpMethod(_config.HttpMethod ?? "GET"), requestUrl);
 
                 // Add headers
                 request.Headers.Add("User-Agent", "NeoServiceLayer/1.0");
+                foreach (var header in _config.Headers ?? new Dictionary<string, string>())
+                {
+                    request.Headers.TryAddWithoutValidation(header.Key, header.Value);
+                }
+
+                if (!string.IsNullOrEmpty(_source.ApiKey) && !request.Headers.Contains("X-API-Key"))
+                {
+                    request.Headers.Add("X-API-Key", _source.ApiKey);
+                }
 
-                // Add request body for POST requests if needed
-                // request.Content = new StringContent("{}", System.Text.Encoding.UTF8, "application/json");
+                if (!string.IsNullOrEmpty(_config.RequestBody))
+                {
+                    request.Content = new StringContent(
+                        Substitute(_config.RequestBody, symbol, baseCurrency),
+                        System.Text.Encoding.UTF8,
+                        _config.ContentType ?? "application/json");
+                }
 
                 // Send request
                 var response = await _httpClient.SendAsync(request);
@@ -104,19 +127,29 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
 
                 // Parse response
                 var content = await response.Content.ReadAsStringAsync();
-                var jsonDocument = JsonDocument.Parse(content);
+                using var jsonDocument = JsonDocument.Parse(content);
 
                 // Extract price using JSON path
-                var priceElement = GetJsonElementByPath(jsonDocument.RootElement, "price");
+                var priceElement = GetJsonElementByPath(jsonDocument.RootElement, _config.PriceJsonPath ?? "price");
                 if (!priceElement.HasValue)
                 {
                     throw new InvalidOperationException("Price not found in response");
                 }
 
-                var price = priceElement.Value.GetDecimal();
+                var price = priceElement.Value.ValueKind == JsonValueKind.String
+                    ? decimal.Parse(priceElement.Value.GetString(), NumberStyles.Float, CultureInfo.InvariantCulture)
+                    : priceElement.Value.GetDecimal();
 
-                // Use current time as timestamp
-                DateTime timestamp = DateTime.UtcNow;
+                // Use the response's timestamp when the source says where it is, otherwise the current time
+                var timestamp = DateTime.UtcNow;
+                if (!string.IsNullOrEmpty(_config.TimestampJsonPath))
+                {
+                    var timestampElement = GetJsonElementByPath(jsonDocument.RootElement, _config.TimestampJsonPath);
+                    if (timestampElement.HasValue)
+                    {
+                        timestamp = ParseTimestamp(timestampElement.Value);
+                    }
+                }
 
                 return CreatePrice(symbol, baseCurrency, price, timestamp);
             }
@@ -127,6 +160,28 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
             }
         }
 
+        private static string Substitute(string value, string symbol, string baseCurrency)
+        {
+            return value?.Replace("{symbol}", symbol).Replace("{base}", baseCurrency);
+        }
+
+        private DateTime ParseTimestamp(JsonElement element)
+        {
+            if (element.ValueKind == JsonValueKind.Number)
+            {
+                // Unix time, in milliseconds when the value is too large to be seconds
+                var value = element.GetInt64();
+                return value > 100_000_000_000
+                    ? DateTimeOffset.FromUnixTimeMilliseconds(value).UtcDateTime
+                    : DateTimeOffset.FromUnixTimeSeconds(value).UtcDateTime;
+            }
+
+            var text = element.GetString();
+            return string.IsNullOrEmpty(_config.TimestampFormat)
+                ? DateTime.Parse(text, CultureInfo.InvariantCulture, DateTimeStyles.AdjustToUniversal | DateTimeStyles.AssumeUniversal)
+                : DateTime.ParseExact(text, _config.TimestampFormat, CultureInfo.InvariantCulture, DateTimeStyles.AdjustToUniversal | DateTimeStyles.AssumeUniversal);
+        }
+
         /// <summary>
         /// Gets a JSON element by path
         /// </summary>
@@ -26,10 +26,10 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
         IEnumerable<string> SupportedAssets { get; }
 
         /// <summary>
-        /// Initializes the data source with configuration
+        /// Initializes the data source with a configured price source
         /// </summary>
-        /// <param name="config">Configuration for the data source</param>
-        void Initialize(PriceSourceConfig config);
+        /// <param name="source">Price source, including its URL, credentials and request configuration</param>
+        void Initialize(PriceSource source);
 
         /// <summary>
         /// Fetches prices for all supported assets
@@ -32,7 +32,7 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
         /// </summary>
         /// <param name="source">Price source configuration</param>
         /// <returns>Price data source</returns>
-        public IPriceDataSource CreateDataSource(PriceSource source)
+        public virtual IPriceDataSource CreateDataSource(PriceSource source)
         {
             if (source == null)
             {
@@ -41,19 +41,20 @@ namespace NeoServiceLayer.Services.PriceFeed.DataSources
 
             try
             {
+                // Each source gets its own instance, since data sources hold their source's configuration
                 IPriceDataSource dataSource = source.Type switch
                 {
                     PriceSourceType.Aggregator when source.Name.Equals("CoinGecko", StringComparison.OrdinalIgnoreCase) =>
-                        _serviceProvider.GetRequiredService<CoinGeckoDataSource>(),
+                        ActivatorUtilities.CreateInstance<CoinGeckoDataSource>(_serviceProvider),
                     PriceSourceType.Exchange when source.Name.Equals("Binance", StringComparison.OrdinalIgnoreCase) =>
-                        _serviceProvider.GetRequiredService<BinanceDataSource>(),
-                    PriceSourceType.Custom when source.Name.Equals("Custom", StringComparison.OrdinalIgnoreCase) =>
-                        _serviceProvider.GetRequiredService<CustomPriceDataSource>(),
+                        ActivatorUtilities.CreateInstance<BinanceDataSource>(_serviceProvider),
+                    PriceSourceType.Custom =>
+                        ActivatorUtilities.CreateInstance<CustomPriceDataSource>(_serviceProvider),
                     _ => throw new ArgumentException($"Unsupported price source type: {source.Type} - {source.Name}")
                 };
 
                 // Initialize the data source with the configuration
-                dataSource.Initialize(source.Config);
+                dataSource.Initialize(source);
 
                 return dataSource;
             }
@@ -0,0 +1,371 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Linq;
+using System.Net.Http;
+using System.Numerics;
+using System.Text;
+using System.Text.Json;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.DependencyInjection;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Services.Diagnostics;
+
+namespace NeoServiceLayer.Services.PriceFeed.GasPrices
+{
+    /// <summary>
+    /// Tracks fee levels on Neo and configured EVM chains, and compares the USD cost of a plain transfer on each
+    /// </summary>
+    /// <remarks>
+    /// Neo's fee per byte and exec fee factor are read from the Policy contract through the configured Neo node. The
+    /// reference transfer pays the fee per byte for its size plus its execution units times the exec fee factor. EVM
+    /// chains are asked for <c>eth_gasPrice</c> on a public JSON-RPC endpoint, and the reference transfer pays it for
+    /// its gas units. Costs are converted to USD with the latest price of each chain's native token. Fee levels are
+    /// read-only and kept in memory, so every instance refreshes its own.
+    /// </remarks>
+    public class GasPriceOracleService : IGasPriceOracleService, IManagedService, IDisposable
+    {
+        /// <summary>
+        /// Name Neo's fee level is reported under
+        /// </summary>
+        public const string NeoChain = "neo";
+
+        private const decimal DatoshiPerGas = 100_000_000m;
+        private const decimal WeiPerEther = 1_000_000_000_000_000_000m;
+
+        private readonly ILogger<GasPriceOracleService> _logger;
+        private readonly IServiceScopeFactory _scopeFactory;
+        private readonly INeoRpcClient _neoRpcClient;
+        private readonly HttpClient _httpClient;
+        private readonly GasPriceOracleConfiguration _configuration;
+        private readonly ICrashReporter _crashReporter;
+        private readonly ConcurrentDictionary<string, ChainGasPrice> _prices = new ConcurrentDictionary<string, ChainGasPrice>(StringComparer.OrdinalIgnoreCase);
+        private readonly SemaphoreSlim _refreshSemaphore = new SemaphoreSlim(1, 1);
+        private Timer _refreshTimer;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="GasPriceOracleService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="scopeFactory">Scope factory used to resolve the price feed service for each refresh</param>
+        /// <param name="neoRpcClient">Neo RPC client; Neo is not tracked without one</param>
+        /// <param name="httpClient">HTTP client for the EVM chains' endpoints</param>
+        /// <param name="configuration">Oracle configuration</param>
+        /// <param name="crashReporter">Crash reporter for background work</param>
+        public GasPriceOracleService(
+            ILogger<GasPriceOracleService> logger,
+            IServiceScopeFactory scopeFactory,
+            INeoRpcClient neoRpcClient = null,
+            HttpClient httpClient = null,
+            IOptions<GasPriceOracleConfiguration> configuration = null,
+            ICrashReporter crashReporter = null)
+        {
+            _logger = logger;
+            _scopeFactory = scopeFactory;
+            _neoRpcClient = neoRpcClient;
+            _httpClient = httpClient ?? new HttpClient();
+            _configuration = configuration?.Value ?? new GasPriceOracleConfiguration();
+            _crashReporter = crashReporter;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<ChainGasPrice>> RefreshAsync()
+        {
+            await _refreshSemaphore.WaitAsync();
+            try
+            {
+                return await RefreshChainsAsync();
+            }
+            finally
+            {
+                _refreshSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<ChainGasPrice>> GetGasPricesAsync()
+        {
+            if (_prices.IsEmpty)
+            {
+                await RefreshAsync();
+            }
+
+            var now = DateTime.UtcNow;
+            return _prices.Values
+                .Select(p => WithStaleness(p, now))
+                .OrderBy(p => p.Chain, StringComparer.OrdinalIgnoreCase)
+                .ToList();
+        }
+
+        /// <inheritdoc/>
+        public IReadOnlyList<ChainGasPrice> GetCachedGasPrices()
+        {
+            var now = DateTime.UtcNow;
+            return _prices.Values
+                .Select(p => WithStaleness(p, now))
+                .Where(p => !p.IsStale)
+                .OrderBy(p => p.Chain, StringComparer.OrdinalIgnoreCase)
+                .ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<ChainGasPrice> GetCheapestChainAsync(IEnumerable<string> chains = null)
+        {
+            return SelectCheapest(await GetGasPricesAsync(), chains);
+        }
+
+        /// <inheritdoc/>
+        public string Name => Constants.ManagedServices.GasPriceOracle;
+
+        /// <inheritdoc/>
+        public IReadOnlyCollection<string> DependsOn { get; } = Array.Empty<string>();
+
+        /// <inheritdoc/>
+        public Task StartAsync()
+        {
+            if (!_configuration.Enabled || _refreshTimer != null)
+            {
+                return Task.CompletedTask;
+            }
+
+            _logger.LogInformation(
+                "Refreshing gas prices of Neo and {Count} external chains every {Interval} seconds",
+                _configuration.Chains.Count,
+                _configuration.RefreshIntervalSeconds);
+
+            _refreshTimer = new Timer(
+                _crashReporter.Guard(_logger, "PriceFeed.GasPrices", RunCycleAsync),
+                null,
+                TimeSpan.Zero,
+                TimeSpan.FromSeconds(_configuration.RefreshIntervalSeconds));
+
+            return Task.CompletedTask;
+        }
+
+        /// <inheritdoc/>
+        public async Task StopAsync()
+        {
+            _refreshTimer?.Change(Timeout.Infinite, Timeout.Infinite);
+            _refreshTimer?.Dispose();
+            _refreshTimer = null;
+
+            // Let a cycle already running finish
+            await _refreshSemaphore.WaitAsync();
+            _refreshSemaphore.Release();
+        }
+
+        /// <inheritdoc/>
+        public ManagedServiceHealth GetHealth()
+        {
+            if (!_configuration.Enabled)
+            {
+                return ManagedServiceHealth.Of(Name, ManagedServiceStatus.Disabled);
+            }
+
+            return ManagedServiceHealth.Of(Name, _refreshTimer != null ? ManagedServiceStatus.Running : ManagedServiceStatus.Stopped);
+        }
+
+        /// <summary>
+        /// Refreshes every chain, unless a refresh is already running
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task RunCycleAsync()
+        {
+            if (!await _refreshSemaphore.WaitAsync(0))
+            {
+                return;
+            }
+
+            try
+            {
+                await RefreshChainsAsync();
+            }
+            finally
+            {
+                _refreshSemaphore.Release();
+            }
+        }
+
+        /// <summary>
+        /// Picks the fresh fee level with the lowest USD transfer cost
+        /// </summary>
+        /// <param name="prices">Fee levels</param>
+        /// <param name="chains">Chains to choose from, or null for every chain</param>
+        /// <returns>The cheapest fee level, or null if none is fresh and priced in USD</returns>
+        public static ChainGasPrice SelectCheapest(IEnumerable<ChainGasPrice> prices, IEnumerable<string> chains = null)
+        {
+            var allowed = chains?.Where(c => !string.IsNullOrWhiteSpace(c)).ToHashSet(StringComparer.OrdinalIgnoreCase);
+            return prices
+                .Where(p => !p.IsStale && p.TransferFeeUsd.HasValue)
+                .Where(p => allowed == null || allowed.Count == 0 || allowed.Contains(p.Chain))
+                .OrderBy(p => p.TransferFeeUsd.Value)
+                .ThenBy(p => p.Chain, StringComparer.OrdinalIgnoreCase)
+                .FirstOrDefault();
+        }
+
+        /// <inheritdoc/>
+        public void Dispose()
+        {
+            _refreshTimer?.Dispose();
+            _refreshSemaphore.Dispose();
+        }
+
+        private async Task<IEnumerable<ChainGasPrice>> RefreshChainsAsync()
+        {
+            var reads = new List<(string Chain, string NativeSymbol, Task<ChainGasPrice> Read)>();
+            if (_neoRpcClient != null)
+            {
+                reads.Add((NeoChain, "GAS", ReadNeoAsync()));
+            }
+
+            foreach (var chain in _configuration.Chains.Where(c => !string.IsNullOrWhiteSpace(c.Name) && !string.IsNullOrWhiteSpace(c.RpcUrl)))
+            {
+                reads.Add((chain.Name.ToLowerInvariant(), chain.NativeSymbol?.ToUpperInvariant(), ReadEvmAsync(chain)));
+            }
+
+            var results = new List<ChainGasPrice>();
+            foreach (var (chain, nativeSymbol, read) in reads)
+            {
+                ChainGasPrice price;
+                try
+                {
+                    price = await read;
+                    price.NativePriceUsd = await GetNativePriceUsdAsync(nativeSymbol);
+                    price.TransferFeeUsd = price.NativePriceUsd * price.TransferFee;
+                }
+                catch (Exception ex)
+                {
+                    _logger.LogWarning(ex, "Error reading the gas price of {Chain}", chain);
+
+                    // The last good fee level is kept, and goes stale unless a later read succeeds
+                    price = _prices.TryGetValue(chain, out var previous)
+                        ? WithStaleness(previous, DateTime.UtcNow)
+                        : new ChainGasPrice { Chain = chain, NativeSymbol = nativeSymbol, IsStale = true };
+                    price.Error = ex.Message;
+                }
+
+                _prices[chain] = price;
+                results.Add(price);
+            }
+
+            return results;
+        }
+
+        private async Task<ChainGasPrice> ReadNeoAsync()
+        {
+            var results = await _neoRpcClient.InvokeFunctionBatchAsync(new[]
+            {
+                new NeoInvocation { ScriptHash = Constants.NeoConfig.PolicyContractHash, Operation = "getFeePerByte" },
+                new NeoInvocation { ScriptHash = Constants.NeoConfig.PolicyContractHash, Operation = "getExecFeeFactor" }
+            });
+
+            var feePerByte = ReadInteger(results[0], "getFeePerByte");
+            var execFeeFactor = ReadInteger(results[1], "getExecFeeFactor");
+
+            var transferDatoshi = feePerByte * _configuration.NeoTransferBytes + execFeeFactor * _configuration.NeoTransferExecutionUnits;
+            return new ChainGasPrice
+            {
+                Chain = NeoChain,
+                NativeSymbol = "GAS",
+                GasPrice = feePerByte / DatoshiPerGas,
+                ExecFeeFactor = execFeeFactor,
+                TransferFee = transferDatoshi / DatoshiPerGas,
+                UpdatedAt = DateTime.UtcNow
+            };
+        }
+
+        private async Task<ChainGasPrice> ReadEvmAsync(GasPriceChain chain)
+        {
+            var body = JsonSerializer.Serialize(new { jsonrpc = "2.0", id = 1, method = "eth_gasPrice", @params = Array.Empty<object>() });
+
+            using var timeout = new CancellationTokenSource(TimeSpan.FromSeconds(_configuration.TimeoutSeconds));
+            using var content = new StringContent(body, Encoding.UTF8, "application/json");
+            using var response = await _httpClient.PostAsync(chain.RpcUrl, content, timeout.Token);
+            response.EnsureSuccessStatusCode();
+
+            using var document = JsonDocument.Parse(await response.Content.ReadAsStringAsync(timeout.Token));
+            if (document.RootElement.TryGetProperty("error", out var error) && error.ValueKind != JsonValueKind.Null)
+            {
+                throw new InvalidOperationException($"eth_gasPrice failed: {error}");
+            }
+
+            var wei = ParseHexQuantity(document.RootElement.GetProperty("result").GetString());
+            return new ChainGasPrice
+            {
+                Chain = chain.Name.ToLowerInvariant(),
+                NativeSymbol = chain.NativeSymbol?.ToUpperInvariant(),
+                GasPrice = (decimal)wei / WeiPerEther,
+                TransferFee = (decimal)(wei * chain.TransferGasUnits) / WeiPerEther,
+                UpdatedAt = DateTime.UtcNow
+            };
+        }
+
+        private async Task<decimal?> GetNativePriceUsdAsync(string symbol)
+        {
+            if (string.IsNullOrEmpty(symbol))
+            {
+                return null;
+            }
+
+            try
+            {
+                using var scope = _scopeFactory.CreateScope();
+                var priceFeedService = scope.ServiceProvider.GetService<IPriceFeedService>();
+                var price = priceFeedService == null ? null : await priceFeedService.GetLatestPriceAsync(symbol, "USD");
+                return price?.Value;
+            }
+            catch (Exception ex)
+            {
+                // A chain without a USD price is still reported, but not compared
+                _logger.LogWarning(ex, "Error getting the USD price of {Symbol}", symbol);
+                return null;
+            }
+        }
+
+        private ChainGasPrice WithStaleness(ChainGasPrice price, DateTime now)
+        {
+            return new ChainGasPrice
+            {
+                Chain = price.Chain,
+                NativeSymbol = price.NativeSymbol,
+                GasPrice = price.GasPrice,
+                ExecFeeFactor = price.ExecFeeFactor,
+                TransferFee = price.TransferFee,
+                NativePriceUsd = price.NativePriceUsd,
+                TransferFeeUsd = price.TransferFeeUsd,
+                UpdatedAt = price.UpdatedAt,
+                IsStale = price.UpdatedAt == default || (now - price.UpdatedAt).TotalSeconds > _configuration.MaxAgeSeconds,
+                Error = price.Error
+            };
+        }
+
+        private static long ReadInteger(NeoInvokeResult result, string operation)
+        {
+            if (!result.IsHalt || result.Stack.Count == 0 ||
+                !long.TryParse(result.Stack[0].Value, NumberStyles.AllowLeadingSign, CultureInfo.InvariantCulture, out var value))
+            {
+                throw new InvalidOperationException($"{operation} on the Policy contract faulted: {result.Exception}");
+            }
+
+            return value;
+        }
+
+        private static BigInteger ParseHexQuantity(string quantity)
+        {
+            if (quantity == null || !quantity.StartsWith("0x", StringComparison.OrdinalIgnoreCase))
+            {
+                throw new FormatException($"'{quantity}' is not a hex quantity");
+            }
+
+            // The leading zero keeps the value from being read as negative
+            return BigInteger.Parse("0" + quantity.Substring(2), NumberStyles.AllowHexSpecifier, CultureInfo.InvariantCulture);
+        }
+    }
+}
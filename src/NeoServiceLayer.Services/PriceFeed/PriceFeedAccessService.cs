@@ -0,0 +1,155 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.PriceFeed
+{
+    /// <summary>
+    /// Implementation of the price feed access service
+    /// </summary>
+    /// <remarks>
+    /// Tiers are ranked by their position in <see cref="PriceFeedAccessConfiguration.Tiers"/>. An account whose
+    /// stored tier is no longer configured is treated as being on the default tier.
+    /// </remarks>
+    public class PriceFeedAccessService : IPriceFeedAccessService
+    {
+        private readonly ILogger<PriceFeedAccessService> _logger;
+        private readonly IAccountService _accountService;
+        private readonly PriceFeedAccessConfiguration _configuration;
+        private readonly Dictionary<string, string> _symbolTiers;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PriceFeedAccessService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="accountService">Account service</param>
+        /// <param name="configuration">Price feed access configuration</param>
+        public PriceFeedAccessService(
+            ILogger<PriceFeedAccessService> logger,
+            IAccountService accountService,
+            IOptions<PriceFeedAccessConfiguration> configuration)
+        {
+            _logger = logger;
+            _accountService = accountService;
+            _configuration = configuration.Value;
+            _symbolTiers = new Dictionary<string, string>(_configuration.SymbolTiers ?? new Dictionary<string, string>(), StringComparer.OrdinalIgnoreCase);
+        }
+
+        /// <inheritdoc/>
+        public IReadOnlyList<PriceFeedTier> GetTiers()
+        {
+            return _configuration.Tiers?.Count > 0
+                ? _configuration.Tiers
+                : new List<PriceFeedTier> { new PriceFeedTier { Name = _configuration.DefaultTier, Price = 0 } };
+        }
+
+        /// <inheritdoc/>
+        public string GetRequiredTier(string symbol)
+        {
+            return symbol != null && _symbolTiers.TryGetValue(symbol, out var tier) ? tier : null;
+        }
+
+        /// <inheritdoc/>
+        public async Task<string> GetTierAsync(Guid accountId)
+        {
+            var account = await _accountService.GetByIdAsync(accountId);
+            return ResolveTier(account?.PriceFeedTier);
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> CanAccessAsync(Guid? accountId, string symbol)
+        {
+            var requiredTier = GetRequiredTier(symbol);
+            if (requiredTier == null)
+            {
+                return true;
+            }
+
+            var tier = accountId.HasValue ? await GetTierAsync(accountId.Value) : _configuration.DefaultTier;
+            return GetRank(tier) >= GetRequiredRank(requiredTier);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IReadOnlyCollection<string>> GetDeniedSymbolsAsync(Guid? accountId)
+        {
+            if (_symbolTiers.Count == 0)
+            {
+                return Array.Empty<string>();
+            }
+
+            var rank = GetRank(accountId.HasValue ? await GetTierAsync(accountId.Value) : _configuration.DefaultTier);
+            return _symbolTiers
+                .Where(s => GetRequiredRank(s.Value) > rank)
+                .Select(s => s.Key)
+                .ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<Core.Models.Account> ChangeTierAsync(Guid accountId, string tier)
+        {
+            var target = GetTiers().FirstOrDefault(t => string.Equals(t.Name, tier, StringComparison.OrdinalIgnoreCase));
+            if (target == null)
+            {
+                throw new ValidationException($"Unknown price feed tier: {tier}");
+            }
+
+            var account = await _accountService.GetByIdAsync(accountId);
+            if (account == null)
+            {
+                throw new ResourceNotFoundException("Account", accountId.ToString());
+            }
+
+            if (string.Equals(ResolveTier(account.PriceFeedTier), target.Name, StringComparison.OrdinalIgnoreCase))
+            {
+                return account;
+            }
+
+            if (account.Credits < target.Price)
+            {
+                throw new ValidationException($"The {target.Name} tier costs {target.Price} credits, but the account has {account.Credits}");
+            }
+
+            // The charge and the tier are saved together so a failed update neither bills nor upgrades the account
+            account.Credits -= target.Price;
+            account.PriceFeedTier = target.Name;
+            account.UpdatedAt = DateTime.UtcNow;
+            var updatedAccount = await _accountService.UpdateAsync(account);
+
+            _logger.LogInformation("Moved account {AccountId} to price feed tier {Tier} for {Price} credits", accountId, target.Name, target.Price);
+
+            return updatedAccount;
+        }
+
+        private string ResolveTier(string tier)
+        {
+            return tier != null && GetRank(tier) >= 0 ? tier : _configuration.DefaultTier;
+        }
+
+        // A symbol mapped to a tier that is not configured is closed to everyone rather than open
+        private int GetRequiredRank(string tier)
+        {
+            var rank = GetRank(tier);
+            return rank >= 0 ? rank : int.MaxValue;
+        }
+
+        private int GetRank(string tier)
+        {
+            var tiers = GetTiers();
+            for (var i = 0; i < tiers.Count; i++)
+            {
+                if (string.Equals(tiers[i].Name, tier, StringComparison.OrdinalIgnoreCase))
+                {
+                    return i;
+                }
+            }
+
+            return -1;
+        }
+    }
+}
@@ -25,6 +25,7 @@ namespace NeoServiceLayer.Services.PriceFeed
         private readonly IPriceHistoryRepository _historyRepository;
         private readonly IEnclaveService _enclaveService;
         private readonly IWalletService _walletService;
+        private readonly IServerEventPublisher _eventPublisher;
 
         /// <summary>
         /// Initializes a new instance of the <see cref="PriceFeedService"/> class
@@ -35,13 +36,15 @@ namespace NeoServiceLayer.Services.PriceFeed
         /// <param name="historyRepository">Price history repository</param>
         /// <param name="enclaveService">Enclave service</param>
         /// <param name="walletService">Wallet service</param>
+        /// <param name="eventPublisher">Publisher of price updates to event stream subscribers</param>
         public PriceFeedService(
             ILogger<PriceFeedService> logger,
             IPriceRepository priceRepository,
             IPriceSourceRepository sourceRepository,
             IPriceHistoryRepository historyRepository,
             IEnclaveService enclaveService,
-            IWalletService walletService)
+            IWalletService walletService,
+            IServerEventPublisher eventPublisher = null)
         {
             _logger = logger;
             _priceRepository = priceRepository;
@@ -49,6 +52,7 @@ namespace NeoServiceLayer.Services.PriceFeed
             _historyRepository = historyRepository;
             _enclaveService = enclaveService;
             _walletService = walletService;
+            _eventPublisher = eventPublisher;
         }
 
         /// <inheritdoc/>
@@ -110,6 +114,7 @@ namespace NeoServiceLayer.Services.PriceFeed
                         foreach (var price in prices)
                         {
                             await _priceRepository.CreateAsync(price);
+                            PublishPriceUpdated(price);
                         }
 
                         return prices;
@@ -177,6 +182,7 @@ namespace NeoServiceLayer.Services.PriceFeed
                 foreach (var price in prices)
                 {
                     await _priceRepository.CreateAsync(price);
+                    PublishPriceUpdated(price);
                 }
 
                 _logger.LogInformation("Fetched {Count} prices for symbol: {Symbol}, base currency: {BaseCurrency}", prices.Count, symbol, baseCurrency);
@@ -234,6 +240,7 @@ namespace NeoServiceLayer.Services.PriceFeed
                 foreach (var price in prices)
                 {
                     await _priceRepository.CreateAsync(price);
+                    PublishPriceUpdated(price);
                 }
 
                 // Update source last successful fetch time
@@ -848,5 +855,15 @@ namespace NeoServiceLayer.Services.PriceFeed
                 throw new PriceFeedException("Error getting supported base currencies", ex);
             }
         }
+
+        private void PublishPriceUpdated(Price price)
+        {
+            _eventPublisher?.Publish(new ServerEvent
+            {
+                Topic = ServerEventTopics.Prices,
+                Type = "updated",
+                Data = new { price.Symbol, price.BaseCurrency, price.Value, price.ConfidenceScore, price.Timestamp }
+            });
+        }
     }
 }
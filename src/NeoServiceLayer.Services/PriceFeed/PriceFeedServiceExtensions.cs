@@ -2,8 +2,11 @@ using System;
 using System.Net.Http;
 using Microsoft.Extensions.DependencyInjection;
 using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Services.PriceFeed.Aggregation;
+using NeoServiceLayer.Services.PriceFeed.Contract;
 using NeoServiceLayer.Services.PriceFeed.DataProcessors;
 using NeoServiceLayer.Services.PriceFeed.DataSources;
+using NeoServiceLayer.Services.PriceFeed.GasPrices;
 using NeoServiceLayer.Services.PriceFeed.Repositories;
 
 namespace NeoServiceLayer.Services.PriceFeed
@@ -23,6 +26,7 @@ namespace NeoServiceLayer.Services.PriceFeed
             // Register repositories
             services.AddSingleton<IPriceRepository, PriceRepository>();
             services.AddSingleton<IPriceSourceRepository, PriceSourceRepository>();
+            services.AddSingleton<IPricePublicationRepository, PricePublicationRepository>();
             services.AddSingleton<IPriceHistoryRepository, PriceHistoryRepository>();
 
             // Register data sources
@@ -39,6 +43,19 @@ namespace NeoServiceLayer.Services.PriceFeed
 
             // Register main service
             services.AddSingleton<IPriceFeedService, PriceFeedService>();
+            services.AddScoped<IPriceFeedAccessService, PriceFeedAccessService>();
+
+            // Register source aggregation
+            services.AddSingleton<PriceSourceHealthTracker>();
+            services.AddSingleton<IPriceAggregationService, PriceAggregationService>();
+            services.AddSingleton<IPricePublicationService, PricePublicationService>();
+
+            // Register cross-chain gas price tracking
+            services.AddSingleton<IGasPriceOracleService, GasPriceOracleService>();
+
+            // Register on-chain contract tooling
+            services.AddSingleton<IPriceFeedContractService, PriceFeedContractService>();
+            services.AddSingleton<IPriceFeedRoundAuditor, PriceFeedRoundAuditor>();
 
             return services;
         }
@@ -0,0 +1,33 @@
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.PriceFeed.Repositories
+{
+    /// <summary>
+    /// Interface for the repository of the last price published for each pair
+    /// </summary>
+    public interface IPricePublicationRepository
+    {
+        /// <summary>
+        /// Gets the last publication of a pair
+        /// </summary>
+        /// <param name="symbol">Symbol</param>
+        /// <param name="baseCurrency">Base currency</param>
+        /// <returns>The publication if the pair was ever published, null otherwise</returns>
+        Task<PricePublication> GetAsync(string symbol, string baseCurrency);
+
+        /// <summary>
+        /// Gets the last publication of every pair
+        /// </summary>
+        /// <returns>List of publications</returns>
+        Task<IEnumerable<PricePublication>> GetAllAsync();
+
+        /// <summary>
+        /// Saves a pair's publication, replacing the previous one
+        /// </summary>
+        /// <param name="publication">Publication</param>
+        /// <returns>The saved publication</returns>
+        Task<PricePublication> SaveAsync(PricePublication publication);
+    }
+}
@@ -0,0 +1,67 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Storage.Repositories;
+
+namespace NeoServiceLayer.Services.PriceFeed.Repositories
+{
+    /// <summary>
+    /// Implementation of the price publication repository, keyed by pair
+    /// </summary>
+    public class PricePublicationRepository : BaseRepository<PricePublication, string>, IPricePublicationRepository
+    {
+        private readonly ILogger<PricePublicationRepository> _logger;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="PricePublicationRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageService">Storage service</param>
+        public PricePublicationRepository(
+            ILogger<PricePublicationRepository> logger,
+            IStorageService storageService)
+            : base(logger, storageService, Guid.Parse("00000000-0000-0000-0000-000000000001"), "pricePublications")
+        {
+            _logger = logger;
+        }
+
+        /// <inheritdoc/>
+        protected override string GetId(PricePublication entity)
+        {
+            return GetPairKey(entity.Symbol, entity.BaseCurrency);
+        }
+
+        /// <inheritdoc/>
+        protected override void SetId(PricePublication entity, string id)
+        {
+            var parts = id.Split('-', 2);
+            entity.Symbol = parts[0];
+            entity.BaseCurrency = parts.Length > 1 ? parts[1] : null;
+        }
+
+        /// <inheritdoc/>
+        public async Task<PricePublication> GetAsync(string symbol, string baseCurrency)
+        {
+            _logger.LogDebug("Getting price publication for {Symbol}/{BaseCurrency}", symbol, baseCurrency);
+
+            return await GetByIdAsync(GetPairKey(symbol, baseCurrency));
+        }
+
+        /// <inheritdoc/>
+        public async Task<PricePublication> SaveAsync(PricePublication publication)
+        {
+            _logger.LogInformation("Saving price publication for {Symbol}/{BaseCurrency}: {Value}",
+                publication.Symbol, publication.BaseCurrency, publication.Value);
+
+            // Storing under the pair's key replaces the previous publication
+            return await CreateAsync(publication);
+        }
+
+        private static string GetPairKey(string symbol, string baseCurrency)
+        {
+            return $"{symbol.ToUpperInvariant()}-{baseCurrency.ToUpperInvariant()}";
+        }
+    }
+}
@@ -0,0 +1,198 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.IO;
+using System.Linq;
+using System.Reflection;
+using System.Security.Cryptography;
+using System.Text;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Provenance
+{
+    /// <summary>
+    /// Implementation of the provenance service
+    /// </summary>
+    /// <remarks>
+    /// The git commit, builder and build time come from assembly metadata set at build time (see the API project).
+    /// Dependency digests are taken from the assemblies on disk when first requested, so they describe the files
+    /// actually loaded rather than what the build intended to ship.
+    /// </remarks>
+    public class ProvenanceService : IProvenanceService
+    {
+        private static readonly TimeSpan BindingLifetime = TimeSpan.FromMinutes(5);
+
+        private readonly ILogger<ProvenanceService> _logger;
+        private readonly IEnclaveService _enclaveService;
+        private readonly ProvenanceConfiguration _configuration;
+        private readonly Lazy<BuildProvenance> _build;
+        private EnclaveProvenanceBinding _binding;
+        private DateTime _bindingExpiresAt;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ProvenanceService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="enclaveService">Enclave service</param>
+        /// <param name="configuration">Provenance configuration</param>
+        public ProvenanceService(ILogger<ProvenanceService> logger, IEnclaveService enclaveService, IOptions<ProvenanceConfiguration> configuration)
+        {
+            _logger = logger;
+            _enclaveService = enclaveService;
+            _configuration = configuration?.Value ?? new ProvenanceConfiguration();
+            _build = new Lazy<BuildProvenance>(ReadBuildProvenance);
+        }
+
+        /// <inheritdoc/>
+        public BuildProvenance GetBuildProvenance()
+        {
+            return _build.Value;
+        }
+
+        /// <inheritdoc/>
+        public async Task<ProvenanceStatement> GetStatementAsync()
+        {
+            var build = _build.Value;
+            var statement = new ProvenanceStatement
+            {
+                Build = build,
+                StatementDigest = ComputeStatementDigest(build),
+                GeneratedAt = DateTime.UtcNow
+            };
+
+            if (_configuration.BindToEnclave)
+            {
+                statement.Enclave = await GetBindingAsync(statement.StatementDigest);
+            }
+
+            return statement;
+        }
+
+        /// <summary>
+        /// Computes the digest the enclave signs
+        /// </summary>
+        /// <param name="build">Build metadata</param>
+        /// <returns>Hex SHA-256 digest over one "name=value" line per field</returns>
+        public static string ComputeStatementDigest(BuildProvenance build)
+        {
+            var content = new StringBuilder()
+                .Append("version=").Append(build.Version).Append('\n')
+                .Append("gitCommit=").Append(build.GitCommit).Append('\n')
+                .Append("builder=").Append(build.Builder).Append('\n')
+                .Append("buildTime=").Append(build.BuildTime?.ToString("o", CultureInfo.InvariantCulture)).Append('\n')
+                .Append("dependencies=").Append(build.DependenciesDigest).Append('\n');
+
+            return Sha256Hex(Encoding.UTF8.GetBytes(content.ToString()));
+        }
+
+        /// <summary>
+        /// Computes the digest over all dependency digests
+        /// </summary>
+        /// <param name="dependencies">Dependency digests</param>
+        /// <returns>Hex SHA-256 digest over one "name sha256" line per dependency, ordered by name</returns>
+        public static string ComputeDependenciesDigest(IEnumerable<DependencyDigest> dependencies)
+        {
+            var content = new StringBuilder();
+            foreach (var dependency in dependencies.OrderBy(d => d.Name, StringComparer.Ordinal))
+            {
+                content.Append(dependency.Name).Append(' ').Append(dependency.Sha256).Append('\n');
+            }
+
+            return Sha256Hex(Encoding.UTF8.GetBytes(content.ToString()));
+        }
+
+        private async Task<EnclaveProvenanceBinding> GetBindingAsync(string statementDigest)
+        {
+            if (_binding != null && DateTime.UtcNow < _bindingExpiresAt)
+            {
+                return _binding;
+            }
+
+            try
+            {
+                var signature = await _enclaveService.SendRequestAsync<object, EnclaveProvenanceBinding>(
+                    Constants.EnclaveServiceTypes.Attestation,
+                    Constants.AttestationOperations.SignProvenance,
+                    new { StatementDigest = statementDigest });
+                var document = await _enclaveService.GetAttestationDocumentAsync();
+
+                _binding = new EnclaveProvenanceBinding
+                {
+                    AttestationDocument = Convert.ToBase64String(document),
+                    PublicKey = signature.PublicKey,
+                    Signature = signature.Signature
+                };
+                _bindingExpiresAt = DateTime.UtcNow.Add(BindingLifetime);
+                return _binding;
+            }
+            catch (Exception ex)
+            {
+                _logger.LogWarning(ex, "Error binding provenance statement to the enclave");
+                return new EnclaveProvenanceBinding { Error = "The enclave could not attest this statement" };
+            }
+        }
+
+        private BuildProvenance ReadBuildProvenance()
+        {
+            var assembly = Assembly.GetEntryAssembly() ?? typeof(ProvenanceService).Assembly;
+            var metadata = assembly.GetCustomAttributes<AssemblyMetadataAttribute>()
+                .Where(a => !string.IsNullOrEmpty(a.Value))
+                .GroupBy(a => a.Key)
+                .ToDictionary(g => g.Key, g => g.Last().Value);
+
+            var build = new BuildProvenance
+            {
+                Version = assembly.GetCustomAttribute<AssemblyInformationalVersionAttribute>()?.InformationalVersion
+                    ?? assembly.GetName().Version?.ToString(),
+                GitCommit = metadata.GetValueOrDefault("GitCommit"),
+                Builder = metadata.GetValueOrDefault("Builder"),
+                BuildTime = DateTime.TryParse(metadata.GetValueOrDefault("BuildTime"), CultureInfo.InvariantCulture, DateTimeStyles.AdjustToUniversal, out var buildTime)
+                    ? buildTime
+                    : null,
+                Dependencies = ReadDependencies()
+            };
+            build.DependenciesDigest = ComputeDependenciesDigest(build.Dependencies);
+
+            _logger.LogInformation("Build provenance: version {Version}, commit {GitCommit}, {DependencyCount} assemblies, dependencies digest {DependenciesDigest}",
+                build.Version, build.GitCommit ?? "unknown", build.Dependencies.Count, build.DependenciesDigest);
+
+            return build;
+        }
+
+        private List<DependencyDigest> ReadDependencies()
+        {
+            var dependencies = new List<DependencyDigest>();
+            foreach (var path in Directory.EnumerateFiles(AppContext.BaseDirectory, "*.dll").OrderBy(p => p, StringComparer.Ordinal))
+            {
+                string version = null;
+                try
+                {
+                    version = AssemblyName.GetAssemblyName(path).Version?.ToString();
+                }
+                catch (BadImageFormatException)
+                {
+                    // Native libraries have no assembly version but are still digested
+                }
+
+                dependencies.Add(new DependencyDigest
+                {
+                    Name = Path.GetFileName(path),
+                    Version = version,
+                    Sha256 = Sha256Hex(File.ReadAllBytes(path))
+                });
+            }
+
+            return dependencies;
+        }
+
+        private static string Sha256Hex(byte[] content)
+        {
+            return Convert.ToHexString(SHA256.HashData(content)).ToLowerInvariant();
+        }
+    }
+}
@@ -0,0 +1,92 @@
+using System;
+using System.Diagnostics;
+using System.Net.Http;
+using System.Text;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Models;
+using Newtonsoft.Json;
+
+namespace NeoServiceLayer.Services.Quorum
+{
+    /// <summary>
+    /// Quorum node backed by a peer instance's enclave, reached through the peer's API
+    /// </summary>
+    /// <remarks>
+    /// The request carries the secrets granted to the function, so peers should be reached over HTTPS.
+    /// </remarks>
+    public class HttpQuorumNode : IQuorumNode
+    {
+        /// <summary>
+        /// Header carrying the peer's node key
+        /// </summary>
+        public const string NodeKeyHeader = "X-Quorum-Node-Key";
+
+        /// <summary>
+        /// Path of the peer endpoint that runs a quorum execution on its enclave
+        /// </summary>
+        public const string ExecutePath = "api/quorum/node/execute";
+
+        private readonly ILogger<HttpQuorumNode> _logger;
+        private readonly QuorumNodeConfiguration _node;
+        private readonly HttpClient _httpClient;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="HttpQuorumNode"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="node">Peer configuration</param>
+        /// <param name="timeout">How long to wait for the peer's result</param>
+        /// <param name="httpClient">HTTP client; one is created when omitted</param>
+        public HttpQuorumNode(ILogger<HttpQuorumNode> logger, QuorumNodeConfiguration node, TimeSpan timeout, HttpClient httpClient = null)
+        {
+            _logger = logger;
+            _node = node;
+            _httpClient = httpClient ?? new HttpClient();
+            _httpClient.Timeout = timeout;
+        }
+
+        /// <inheritdoc/>
+        public string Id => _node.Id;
+
+        /// <inheritdoc/>
+        public async Task<QuorumNodeResult> ExecuteAsync(QuorumNodeRequest request, CancellationToken cancellationToken)
+        {
+            var stopwatch = Stopwatch.StartNew();
+
+            try
+            {
+                using var message = new HttpRequestMessage(HttpMethod.Post, new Uri(new Uri(_node.Url.TrimEnd('/') + "/"), ExecutePath))
+                {
+                    Content = new StringContent(JsonConvert.SerializeObject(request), Encoding.UTF8, "application/json")
+                };
+                message.Headers.Add(NodeKeyHeader, _node.Key);
+
+                using var response = await _httpClient.SendAsync(message, cancellationToken);
+                var body = await response.Content.ReadAsStringAsync(cancellationToken);
+                if (!response.IsSuccessStatusCode)
+                {
+                    throw new HttpRequestException($"Peer answered {(int)response.StatusCode}: {body}");
+                }
+
+                var result = JsonConvert.DeserializeObject<QuorumNodeResult>(body) ?? new QuorumNodeResult { Error = "Peer returned no result" };
+
+                // Results are recorded under the ID this instance knows the peer by
+                result.NodeId = Id;
+                result.DurationMs = stopwatch.ElapsedMilliseconds;
+                return result;
+            }
+            catch (Exception ex)
+            {
+                _logger.LogWarning(ex, "Quorum execution {ExecutionId} failed on peer {NodeId}", request.ExecutionId, Id);
+                return new QuorumNodeResult
+                {
+                    NodeId = Id,
+                    Error = ex is TaskCanceledException ? "Timed out waiting for the peer" : ex.Message,
+                    DurationMs = stopwatch.ElapsedMilliseconds
+                };
+            }
+        }
+    }
+}
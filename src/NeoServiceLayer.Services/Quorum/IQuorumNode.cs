@@ -0,0 +1,25 @@
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Quorum
+{
+    /// <summary>
+    /// A node with its own enclave that can run one part of a quorum execution
+    /// </summary>
+    public interface IQuorumNode
+    {
+        /// <summary>
+        /// Gets the node ID recorded with its results
+        /// </summary>
+        string Id { get; }
+
+        /// <summary>
+        /// Runs the function on the node's enclave
+        /// </summary>
+        /// <param name="request">Execution request</param>
+        /// <param name="cancellationToken">Cancellation token</param>
+        /// <returns>The node's result; failures are reported in the result rather than thrown</returns>
+        Task<QuorumNodeResult> ExecuteAsync(QuorumNodeRequest request, CancellationToken cancellationToken);
+    }
+}
@@ -0,0 +1,69 @@
+using System;
+using System.Diagnostics;
+using System.Security.Cryptography;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Quorum
+{
+    /// <summary>
+    /// Quorum node backed by this instance's enclave
+    /// </summary>
+    public class LocalQuorumNode : IQuorumNode
+    {
+        private readonly ILogger<LocalQuorumNode> _logger;
+        private readonly IEnclaveService _enclaveService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="LocalQuorumNode"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="enclaveService">Enclave service</param>
+        /// <param name="configuration">Quorum configuration</param>
+        public LocalQuorumNode(ILogger<LocalQuorumNode> logger, IEnclaveService enclaveService, IOptions<QuorumConfiguration> configuration)
+        {
+            _logger = logger;
+            _enclaveService = enclaveService;
+            Id = configuration.Value.NodeId;
+        }
+
+        /// <inheritdoc/>
+        public string Id { get; }
+
+        /// <inheritdoc/>
+        public async Task<QuorumNodeResult> ExecuteAsync(QuorumNodeRequest request, CancellationToken cancellationToken)
+        {
+            var stopwatch = Stopwatch.StartNew();
+            var result = new QuorumNodeResult { NodeId = Id };
+
+            try
+            {
+                result.Output = await _enclaveService.SendRequestAsync<object, object>(
+                    Constants.EnclaveServiceTypes.Function,
+                    request.Operation ?? Constants.FunctionOperations.ExecuteFunction,
+                    request.ExecuteRequest);
+                result.Succeeded = true;
+
+                // The digest identifies the enclave image and key the result came from
+                var attestation = await _enclaveService.GetAttestationDocumentAsync();
+                if (attestation != null && attestation.Length > 0)
+                {
+                    result.AttestationDigest = Convert.ToHexString(SHA256.HashData(attestation)).ToLowerInvariant();
+                }
+            }
+            catch (Exception ex)
+            {
+                _logger.LogWarning(ex, "Quorum execution {ExecutionId} failed on the local enclave", request.ExecutionId);
+                result.Error = ex.InnerException?.Message ?? ex.Message;
+            }
+
+            result.DurationMs = stopwatch.ElapsedMilliseconds;
+            return result;
+        }
+    }
+}
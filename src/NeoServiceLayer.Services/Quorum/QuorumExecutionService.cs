@@ -0,0 +1,380 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Linq;
+using System.Security.Cryptography;
+using System.Text;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using Newtonsoft.Json;
+using Newtonsoft.Json.Linq;
+using FunctionRepositories = NeoServiceLayer.Services.Function.Repositories;
+
+namespace NeoServiceLayer.Services.Quorum
+{
+    /// <summary>
+    /// Runs critical functions on several independent nodes and acts only on results enough of them agree on
+    /// </summary>
+    /// <remarks>
+    /// Every node gets the same request and runs it on its own enclave. Results are compared on the function's
+    /// <c>Result</c>, or on the value at the policy's result path, either exactly or numerically within a tolerance
+    /// of the median. Each node's result is recorded with the digest of its enclave's attestation document. The
+    /// owner is alerted whenever a node disagrees or fails, and at high priority when the quorum is not reached.
+    /// </remarks>
+    public class QuorumExecutionService : IQuorumExecutionService
+    {
+        private readonly ILogger<QuorumExecutionService> _logger;
+        private readonly IQuorumExecutionRepository _repository;
+        private readonly FunctionRepositories.IFunctionRepository _functionRepository;
+        private readonly IReadOnlyList<IQuorumNode> _nodes;
+        private readonly INotificationService _notificationService;
+        private readonly IServerEventPublisher _eventPublisher;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="QuorumExecutionService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="repository">Quorum execution repository</param>
+        /// <param name="functionRepository">Function repository the quorum policy is stored in</param>
+        /// <param name="nodes">Nodes in the order they are used; the local enclave comes first</param>
+        /// <param name="notificationService">Notification service disagreement alerts are sent through</param>
+        /// <param name="eventPublisher">Publisher of disagreement events to event stream subscribers</param>
+        public QuorumExecutionService(
+            ILogger<QuorumExecutionService> logger,
+            IQuorumExecutionRepository repository,
+            FunctionRepositories.IFunctionRepository functionRepository,
+            IEnumerable<IQuorumNode> nodes,
+            INotificationService notificationService = null,
+            IServerEventPublisher eventPublisher = null)
+        {
+            _logger = logger;
+            _repository = repository;
+            _functionRepository = functionRepository;
+            _nodes = nodes.ToList();
+            _notificationService = notificationService;
+            _eventPublisher = eventPublisher;
+        }
+
+        /// <inheritdoc/>
+        public async Task<QuorumExecution> ExecuteAsync(Core.Models.Function function, Guid executionId, string operation, object executeRequest)
+        {
+            var policy = function.Quorum ?? throw new ArgumentException("The function has no quorum policy", nameof(function));
+            var nodes = _nodes.Take(policy.Nodes).ToList();
+            if (nodes.Count < policy.Nodes)
+            {
+                _logger.LogWarning("Function {FunctionId} asks for {Nodes} quorum nodes but only {Available} are configured",
+                    function.Id, policy.Nodes, nodes.Count);
+            }
+
+            var record = new QuorumExecution
+            {
+                Id = Guid.NewGuid(),
+                ExecutionId = executionId,
+                FunctionId = function.Id,
+                AccountId = function.AccountId,
+                Policy = policy,
+                StartedAt = DateTime.UtcNow
+            };
+
+            var request = new QuorumNodeRequest
+            {
+                ExecutionId = executionId,
+                FunctionId = function.Id,
+                Operation = operation,
+                ExecuteRequest = executeRequest
+            };
+            var results = await Task.WhenAll(nodes.Select(node => node.ExecuteAsync(request, CancellationToken.None)));
+
+            record.Results = results.ToList();
+            record.CompletedAt = DateTime.UtcNow;
+            Compare(record);
+
+            await _repository.CreateAsync(record);
+
+            _logger.LogInformation("Quorum execution {ExecutionId} of function {FunctionId}: {AgreeingNodes} of {NodeCount} nodes agreed, {Threshold} required",
+                executionId, function.Id, record.AgreeingNodes, record.Results.Count, policy.Threshold);
+
+            if (!record.Agreed || record.Results.Any(r => !r.Agreed))
+            {
+                await AlertAsync(function, record);
+            }
+
+            return record;
+        }
+
+        /// <inheritdoc/>
+        public Task<QuorumNodeResult> ExecuteOnLocalNodeAsync(QuorumNodeRequest request)
+        {
+            _logger.LogInformation("Running quorum execution {ExecutionId} of function {FunctionId} for a peer", request.ExecutionId, request.FunctionId);
+
+            return _nodes[0].ExecuteAsync(request, CancellationToken.None);
+        }
+
+        /// <inheritdoc/>
+        public async Task<Core.Models.Function> SetPolicyAsync(Guid functionId, QuorumPolicy policy)
+        {
+            if (policy != null)
+            {
+                policy.Comparison = (policy.Comparison ?? QuorumComparisons.Exact).Trim().ToLowerInvariant();
+                ValidatePolicy(policy);
+            }
+
+            var function = await _functionRepository.GetByIdAsync(functionId);
+            if (function == null)
+            {
+                throw new ResourceNotFoundException("Function", functionId.ToString());
+            }
+
+            function.Quorum = policy;
+            function.UpdatedAt = DateTime.UtcNow;
+            await _functionRepository.UpdateAsync(function);
+
+            _logger.LogInformation("Set quorum policy of function {FunctionId} to {Threshold} of {Nodes} nodes",
+                functionId, policy?.Threshold, policy?.Nodes);
+
+            return function;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<QuorumExecution>> GetExecutionsAsync(Guid functionId)
+        {
+            var executions = await _repository.GetByFunctionIdAsync(functionId);
+
+            return executions.OrderByDescending(e => e.StartedAt);
+        }
+
+        /// <inheritdoc/>
+        public Task<QuorumExecution> GetByExecutionIdAsync(Guid executionId)
+        {
+            return _repository.GetByExecutionIdAsync(executionId);
+        }
+
+        private void ValidatePolicy(QuorumPolicy policy)
+        {
+            if (policy.Nodes < 2)
+            {
+                throw new ValidationException("A quorum needs at least 2 nodes");
+            }
+
+            if (policy.Nodes > _nodes.Count)
+            {
+                throw new ValidationException($"The quorum asks for {policy.Nodes} nodes but only {_nodes.Count} are configured");
+            }
+
+            // A majority threshold means two disjoint groups of nodes can never both agree
+            if (policy.Threshold * 2 <= policy.Nodes || policy.Threshold > policy.Nodes)
+            {
+                throw new ValidationException($"The threshold must be more than half of the {policy.Nodes} nodes and at most all of them");
+            }
+
+            if (policy.Comparison != QuorumComparisons.Exact && policy.Comparison != QuorumComparisons.Numeric)
+            {
+                throw new ValidationException($"Comparison must be '{QuorumComparisons.Exact}' or '{QuorumComparisons.Numeric}'");
+            }
+
+            if (policy.Tolerance < 0 || policy.Tolerance >= 1)
+            {
+                throw new ValidationException("Tolerance must be at least 0 and less than 1");
+            }
+        }
+
+        /// <summary>
+        /// Finds the largest group of agreeing results and marks the record agreed if it reaches the threshold
+        /// </summary>
+        /// <param name="record">Quorum execution with the node results filled in</param>
+        private static void Compare(QuorumExecution record)
+        {
+            var succeeded = record.Results.Where(r => r.Succeeded).ToList();
+            foreach (var result in succeeded)
+            {
+                result.ComparedValue = Canonicalize(GetComparedValue(result.Output, record.Policy.ResultPath));
+                result.ResultDigest = Convert.ToHexString(SHA256.HashData(Encoding.UTF8.GetBytes(result.ComparedValue))).ToLowerInvariant();
+            }
+
+            var agreeing = record.Policy.Comparison == QuorumComparisons.Numeric
+                ? FindNumericAgreement(succeeded, record.Policy.Tolerance)
+                : succeeded.GroupBy(r => r.ResultDigest).OrderByDescending(g => g.Count()).FirstOrDefault()?.ToList() ?? new List<QuorumNodeResult>();
+
+            record.AgreeingNodes = agreeing.Count;
+            record.Agreed = agreeing.Count >= record.Policy.Threshold;
+            if (!record.Agreed)
+            {
+                return;
+            }
+
+            foreach (var result in agreeing)
+            {
+                result.Agreed = true;
+            }
+
+            // The agreeing group is ordered so the local enclave, or the result nearest the median, is acted on
+            record.SelectedNodeId = agreeing[0].NodeId;
+            record.AgreedOutput = agreeing[0].Output;
+        }
+
+        private static List<QuorumNodeResult> FindNumericAgreement(List<QuorumNodeResult> results, decimal tolerance)
+        {
+            var values = new List<(QuorumNodeResult Result, decimal Value)>();
+            foreach (var result in results)
+            {
+                if (TryGetNumber(JToken.Parse(result.ComparedValue), out var value))
+                {
+                    values.Add((result, value));
+                }
+                else
+                {
+                    result.Error = $"Result {result.ComparedValue} is not a number";
+                }
+            }
+
+            if (values.Count == 0)
+            {
+                return new List<QuorumNodeResult>();
+            }
+
+            var sorted = values.Select(v => v.Value).OrderBy(v => v).ToList();
+            var median = sorted.Count % 2 == 1
+                ? sorted[sorted.Count / 2]
+                : (sorted[sorted.Count / 2 - 1] + sorted[sorted.Count / 2]) / 2;
+            var allowed = tolerance * Math.Abs(median);
+
+            return values
+                .Where(v => Math.Abs(v.Value - median) <= allowed)
+                .OrderBy(v => Math.Abs(v.Value - median))
+                .Select(v => v.Result)
+                .ToList();
+        }
+
+        private static bool TryGetNumber(JToken token, out decimal value)
+        {
+            value = 0;
+            switch (token?.Type)
+            {
+                case JTokenType.Integer:
+                case JTokenType.Float:
+                    value = token.Value<decimal>();
+                    return true;
+                case JTokenType.String:
+                    return decimal.TryParse(token.Value<string>(), NumberStyles.Float, CultureInfo.InvariantCulture, out value);
+                default:
+                    return false;
+            }
+        }
+
+        /// <summary>
+        /// Gets the value results are compared on: the function's <c>Result</c>, or the value at the result path within it
+        /// </summary>
+        private static JToken GetComparedValue(object output, string resultPath)
+        {
+            if (output == null)
+            {
+                return JValue.CreateNull();
+            }
+
+            var token = JToken.FromObject(output);
+
+            // The sandbox reports gas, logs and timings next to the result; those differ between nodes
+            if (token is JObject response && response.TryGetValue("Result", out var result))
+            {
+                token = result;
+            }
+
+            return string.IsNullOrEmpty(resultPath) ? token : token.SelectToken(resultPath) ?? JValue.CreateNull();
+        }
+
+        private static string Canonicalize(JToken token)
+        {
+            return Sort(token).ToString(Formatting.None);
+        }
+
+        private static JToken Sort(JToken token)
+        {
+            switch (token)
+            {
+                case JObject obj:
+                    return new JObject(obj.Properties().OrderBy(p => p.Name, StringComparer.Ordinal).Select(p => new JProperty(p.Name, Sort(p.Value))));
+                case JArray array:
+                    return new JArray(array.Select(Sort));
+                default:
+                    return token;
+            }
+        }
+
+        private async Task AlertAsync(Core.Models.Function function, QuorumExecution record)
+        {
+            var dissenting = record.Results.Where(r => !r.Agreed).ToList();
+            var subject = record.Agreed
+                ? $"Quorum node disagreed: {function.Name}"
+                : $"Quorum not reached: {function.Name}";
+            var content = record.Agreed
+                ? $"{record.AgreeingNodes} of {record.Results.Count} nodes agreed; {string.Join(", ", dissenting.Select(Describe))}"
+                : $"Only {record.AgreeingNodes} of {record.Results.Count} nodes agreed and {record.Policy.Threshold} are required, so the result was not acted on; " +
+                  string.Join(", ", record.Results.Select(Describe));
+
+            _logger.LogWarning("{Subject} (execution {ExecutionId}): {Content}", subject, record.ExecutionId, content);
+
+            _eventPublisher?.Publish(new ServerEvent
+            {
+                Topic = ServerEventTopics.Executions,
+                Type = "quorum-disagreement",
+                AccountId = record.AccountId,
+                Data = new
+                {
+                    record.ExecutionId,
+                    record.FunctionId,
+                    record.Agreed,
+                    record.AgreeingNodes,
+                    record.Policy.Threshold,
+                    DissentingNodes = dissenting.Select(r => r.NodeId).ToList()
+                }
+            });
+
+            if (_notificationService == null)
+            {
+                return;
+            }
+
+            try
+            {
+                await _notificationService.SendNotificationAsync(new Core.Models.Notification
+                {
+                    AccountId = record.AccountId,
+                    Type = NotificationType.Security,
+                    Priority = record.Agreed ? NotificationPriority.Normal : NotificationPriority.High,
+                    Subject = subject,
+                    Content = content,
+                    Channels = new List<NotificationChannel> { NotificationChannel.InApp },
+                    Data = new Dictionary<string, object>
+                    {
+                        { "FunctionId", record.FunctionId },
+                        { "ExecutionId", record.ExecutionId },
+                        { "QuorumExecutionId", record.Id },
+                        { "Agreed", record.Agreed },
+                        { "AgreeingNodes", record.AgreeingNodes },
+                        { "Threshold", record.Policy.Threshold }
+                    }
+                });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error sending quorum alert for execution {ExecutionId}", record.ExecutionId);
+            }
+        }
+
+        private static string Describe(QuorumNodeResult result)
+        {
+            return result.Succeeded && result.Error == null
+                ? $"{result.NodeId} returned {Truncate(result.ComparedValue)}"
+                : $"{result.NodeId} failed: {result.Error}";
+        }
+
+        private static string Truncate(string value)
+        {
+            return value.Length <= 100 ? value : value.Substring(0, 100) + "...";
+        }
+    }
+}
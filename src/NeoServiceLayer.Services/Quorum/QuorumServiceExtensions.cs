@@ -0,0 +1,47 @@
+using System;
+using System.Collections.Generic;
+using Microsoft.Extensions.Configuration;
+using Microsoft.Extensions.DependencyInjection;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Quorum.Repositories;
+
+namespace NeoServiceLayer.Services.Quorum
+{
+    /// <summary>
+    /// Extension methods for registering quorum execution services
+    /// </summary>
+    public static class QuorumServiceExtensions
+    {
+        /// <summary>
+        /// Adds quorum execution services to the service collection
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <param name="configuration">Configuration</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddQuorumServices(this IServiceCollection services, IConfiguration configuration)
+        {
+            var quorumConfig = configuration?.GetSection("Quorum").Get<QuorumConfiguration>() ?? new QuorumConfiguration();
+            services.Configure<QuorumConfiguration>(options => configuration?.GetSection("Quorum").Bind(options));
+
+            // Register repositories
+            services.AddSingleton<IQuorumExecutionRepository, QuorumExecutionRepository>();
+
+            // Register nodes; the local enclave is registered first so it is always part of a quorum
+            services.AddSingleton<IQuorumNode, LocalQuorumNode>();
+            foreach (var node in quorumConfig.Nodes ?? new List<QuorumNodeConfiguration>())
+            {
+                services.AddSingleton<IQuorumNode>(provider => new HttpQuorumNode(
+                    provider.GetRequiredService<ILogger<HttpQuorumNode>>(),
+                    node,
+                    TimeSpan.FromSeconds(quorumConfig.NodeTimeoutSeconds)));
+            }
+
+            // Register services
+            services.AddSingleton<IQuorumExecutionService, QuorumExecutionService>();
+
+            return services;
+        }
+    }
+}
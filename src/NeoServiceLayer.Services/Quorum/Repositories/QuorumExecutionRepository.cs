@@ -0,0 +1,55 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Quorum.Repositories
+{
+    /// <summary>
+    /// Repository for quorum execution records
+    /// </summary>
+    public class QuorumExecutionRepository : IQuorumExecutionRepository
+    {
+        private readonly ILogger<QuorumExecutionRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private readonly string _collectionName = "quorum_executions";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="QuorumExecutionRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public QuorumExecutionRepository(ILogger<QuorumExecutionRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<QuorumExecution> CreateAsync(QuorumExecution execution)
+        {
+            _logger.LogInformation("Storing quorum execution {ExecutionId} of function {FunctionId}", execution.ExecutionId, execution.FunctionId);
+
+            await _storageProvider.CreateAsync(_collectionName, execution);
+
+            return execution;
+        }
+
+        /// <inheritdoc/>
+        public async Task<QuorumExecution> GetByExecutionIdAsync(Guid executionId)
+        {
+            var executions = await _storageProvider.GetByFilterAsync<QuorumExecution>(_collectionName, e => e.ExecutionId == executionId);
+
+            return executions.FirstOrDefault();
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<QuorumExecution>> GetByFunctionIdAsync(Guid functionId)
+        {
+            return await _storageProvider.GetByFilterAsync<QuorumExecution>(_collectionName, e => e.FunctionId == functionId);
+        }
+    }
+}
@@ -0,0 +1,23 @@
+using Microsoft.Extensions.DependencyInjection;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Services.Recovery
+{
+    /// <summary>
+    /// Extension methods for registering startup recovery
+    /// </summary>
+    public static class RecoveryServiceExtensions
+    {
+        /// <summary>
+        /// Adds the startup recovery service, which runs the recovery steps each service registers
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddStartupRecovery(this IServiceCollection services)
+        {
+            services.AddSingleton<IStartupRecoveryService, StartupRecoveryService>();
+
+            return services;
+        }
+    }
+}
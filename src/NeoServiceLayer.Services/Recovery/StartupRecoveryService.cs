@@ -0,0 +1,110 @@
+using System;
+using System.Collections.Generic;
+using System.Diagnostics;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Recovery
+{
+    /// <summary>
+    /// Implementation of the startup recovery service
+    /// </summary>
+    /// <remarks>
+    /// Runs before the API accepts requests. Steps only touch work started before this process, so running
+    /// recovery again while the service is up is safe. With leadership enabled only the instance holding the lease
+    /// runs the steps, so a standby starting up, or a replica restarting while another instance leads, leaves the
+    /// leader's in-flight work alone.
+    /// </remarks>
+    public class StartupRecoveryService : IStartupRecoveryService
+    {
+        private readonly ILogger<StartupRecoveryService> _logger;
+        private readonly IEnumerable<IStartupRecoveryStep> _steps;
+        private readonly ILeadershipService _leadership;
+        private readonly DateTime _processStartTime;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="StartupRecoveryService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="steps">Recovery steps, run in registration order</param>
+        /// <param name="leadership">Leadership lease; when set, only the instance holding it runs the steps</param>
+        public StartupRecoveryService(ILogger<StartupRecoveryService> logger, IEnumerable<IStartupRecoveryStep> steps, ILeadershipService leadership = null)
+            : this(logger, steps, Process.GetCurrentProcess().StartTime.ToUniversalTime(), leadership)
+        {
+        }
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="StartupRecoveryService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="steps">Recovery steps, run in registration order</param>
+        /// <param name="processStartTime">Start time of the current process, in UTC</param>
+        /// <param name="leadership">Leadership lease; when set, only the instance holding it runs the steps</param>
+        public StartupRecoveryService(
+            ILogger<StartupRecoveryService> logger,
+            IEnumerable<IStartupRecoveryStep> steps,
+            DateTime processStartTime,
+            ILeadershipService leadership = null)
+        {
+            _logger = logger;
+            _steps = steps;
+            _processStartTime = processStartTime;
+            _leadership = leadership;
+        }
+
+        /// <inheritdoc/>
+        public async Task<StartupRecoveryReport> RecoverAsync()
+        {
+            var report = new StartupRecoveryReport
+            {
+                ProcessStartTime = _processStartTime,
+                StartedAt = DateTime.UtcNow
+            };
+
+            if (_leadership?.IsLeader == false)
+            {
+                // The leader may still be working on what looks in doubt from here; this instance recovers on takeover
+                _logger.LogInformation("Instance {InstanceId} does not hold the leadership lease; leaving startup recovery to the leader",
+                    _leadership.InstanceId);
+
+                report.Skipped = true;
+                report.CompletedAt = DateTime.UtcNow;
+                return report;
+            }
+
+            foreach (var step in _steps)
+            {
+                StartupRecoveryStepResult result;
+                try
+                {
+                    result = await step.RecoverAsync(_processStartTime);
+                    result.Step = step.Name;
+                }
+                catch (Exception ex)
+                {
+                    // Left for the next run; the rest of the service can still start
+                    _logger.LogError(ex, "Startup recovery step {Step} failed", step.Name);
+                    result = new StartupRecoveryStepResult { Step = step.Name, Error = ex.Message };
+                }
+
+                if (result.Resumed > 0 || result.Resolved > 0)
+                {
+                    _logger.LogWarning("Startup recovery step {Step} resumed {Resumed} and resolved {Resolved} items: {Details}",
+                        result.Step, result.Resumed, result.Resolved, string.Join("; ", result.Details));
+                }
+
+                report.Steps.Add(result);
+            }
+
+            report.CompletedAt = DateTime.UtcNow;
+
+            _logger.LogInformation("Startup recovery finished: {StepCount} steps, {Resumed} resumed, {Resolved} resolved, {Failed} failed",
+                report.Steps.Count, report.Steps.Sum(s => s.Resumed), report.Steps.Sum(s => s.Resolved), report.Steps.Count(s => s.Error != null));
+
+            return report;
+        }
+    }
+}
@@ -0,0 +1,306 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Diagnostics;
+
+namespace NeoServiceLayer.Services.Scheduling
+{
+    /// <summary>
+    /// Elects one active instance of the Functions/Trigger service through a lease in the shared store
+    /// </summary>
+    /// <remarks>
+    /// Every instance checks the lease each renew interval. The holder extends it and stores its handoff state;
+    /// the others stay warm, mirroring that state, and take the lease over once it has expired. A leader that cannot
+    /// renew stops acting as leader when its lease runs out, before any standby can take over.
+    /// </remarks>
+    public class LeadershipService : ILeadershipService, IManagedService, IDisposable
+    {
+        private readonly ILogger<LeadershipService> _logger;
+        private readonly IServiceLeaseRepository _repository;
+        private readonly ICrashReporter _crashReporter;
+        private readonly LeadershipConfiguration _configuration;
+        private readonly ConcurrentDictionary<string, string> _state = new ConcurrentDictionary<string, string>();
+        private readonly SemaphoreSlim _renewSemaphore = new SemaphoreSlim(1, 1);
+
+        private Timer _renewTimer;
+        private bool _isLeader;
+        private DateTime _leaseExpiresAt;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="LeadershipService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="repository">Service lease repository</param>
+        /// <param name="configuration">Leadership configuration</param>
+        /// <param name="crashReporter">Crash reporter for the renewal timer</param>
+        public LeadershipService(
+            ILogger<LeadershipService> logger,
+            IServiceLeaseRepository repository,
+            IOptions<LeadershipConfiguration> configuration,
+            ICrashReporter crashReporter = null)
+        {
+            _logger = logger;
+            _repository = repository;
+            _crashReporter = crashReporter;
+            _configuration = configuration.Value;
+            InstanceId = string.IsNullOrEmpty(_configuration.InstanceId)
+                ? $"{Environment.MachineName}:{Environment.ProcessId}"
+                : _configuration.InstanceId;
+        }
+
+        /// <inheritdoc/>
+        public string InstanceId { get; }
+
+        /// <inheritdoc/>
+        public bool IsLeader => !_configuration.Enabled || (_isLeader && DateTime.UtcNow < _leaseExpiresAt);
+
+        /// <inheritdoc/>
+        public event EventHandler LeadershipAcquired;
+
+        /// <inheritdoc/>
+        public string Name => Constants.ManagedServices.Leadership;
+
+        /// <inheritdoc/>
+        public IReadOnlyCollection<string> DependsOn { get; } = Array.Empty<string>();
+
+        /// <inheritdoc/>
+        public async Task StartAsync()
+        {
+            if (!_configuration.Enabled || _renewTimer != null)
+            {
+                return;
+            }
+
+            _logger.LogInformation("Instance {InstanceId} competing for lease {LeaseName}", InstanceId, _configuration.LeaseName);
+
+            await RenewAsync();
+
+            _renewTimer = new Timer(
+                _crashReporter.Guard(_logger, "Leadership.Renew", RenewAsync),
+                null,
+                TimeSpan.FromSeconds(_configuration.RenewIntervalSeconds),
+                TimeSpan.FromSeconds(_configuration.RenewIntervalSeconds));
+        }
+
+        /// <inheritdoc/>
+        public async Task StopAsync()
+        {
+            _renewTimer?.Change(Timeout.Infinite, Timeout.Infinite);
+            _renewTimer?.Dispose();
+            _renewTimer = null;
+
+            // A renewal already running could take the lease again after it is released
+            await _renewSemaphore.WaitAsync();
+            _renewSemaphore.Release();
+
+            if (!_configuration.Enabled || !_isLeader)
+            {
+                return;
+            }
+
+            _isLeader = false;
+
+            try
+            {
+                var lease = await _repository.GetByIdAsync(_configuration.LeaseName);
+                if (lease?.HolderId == InstanceId)
+                {
+                    lease.State = new Dictionary<string, string>(_state);
+                    lease.ExpiresAt = DateTime.UtcNow;
+                    await _repository.UpdateAsync(lease);
+
+                    _logger.LogInformation("Instance {InstanceId} released lease {LeaseName}", InstanceId, _configuration.LeaseName);
+                }
+            }
+            catch (Exception ex)
+            {
+                // The lease expires on its own
+                _logger.LogWarning(ex, "Error releasing lease {LeaseName}", _configuration.LeaseName);
+            }
+        }
+
+        /// <inheritdoc/>
+        public ManagedServiceHealth GetHealth()
+        {
+            if (!_configuration.Enabled)
+            {
+                return ManagedServiceHealth.Of(Name, ManagedServiceStatus.Disabled, "Every instance acts as leader");
+            }
+
+            return ManagedServiceHealth.Of(
+                Name,
+                _renewTimer != null ? ManagedServiceStatus.Running : ManagedServiceStatus.Stopped,
+                IsLeader ? $"{InstanceId} holds the lease" : $"{InstanceId} is on standby");
+        }
+
+        /// <inheritdoc/>
+        public string GetState(string key)
+        {
+            return _state.TryGetValue(key, out var value) ? value : null;
+        }
+
+        /// <inheritdoc/>
+        public void SetState(string key, string value)
+        {
+            _state[key] = value;
+        }
+
+        /// <inheritdoc/>
+        public Task<ServiceLease> GetLeaseAsync()
+        {
+            return _repository.GetByIdAsync(_configuration.LeaseName);
+        }
+
+        /// <inheritdoc/>
+        public async Task<ServiceLease> ForceFailoverAsync(string requestedBy)
+        {
+            if (!_configuration.Enabled)
+            {
+                throw new InvalidOperationException("Leadership is not enabled");
+            }
+
+            var lease = await _repository.GetByIdAsync(_configuration.LeaseName);
+            if (lease == null || string.IsNullOrEmpty(lease.HolderId))
+            {
+                throw new InvalidOperationException("No instance holds the lease");
+            }
+
+            var now = DateTime.UtcNow;
+
+            _logger.LogWarning("Forcing failover of lease {LeaseName} from {HolderId}, requested by {RequestedBy}",
+                lease.Id, lease.HolderId, requestedBy);
+
+            lease.ExcludedHolderId = lease.HolderId;
+            lease.ExcludedUntil = now.AddSeconds(_configuration.FailoverCooldownSeconds);
+            lease.ExpiresAt = now;
+            lease.FailoverRequestedBy = requestedBy;
+
+            return await _repository.UpdateAsync(lease);
+        }
+
+        /// <summary>
+        /// Renews the lease if held, takes it over if expired, or otherwise mirrors the leader's state
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task RenewAsync()
+        {
+            if (!await _renewSemaphore.WaitAsync(0))
+            {
+                return;
+            }
+
+            try
+            {
+                var now = DateTime.UtcNow;
+                var lease = await _repository.GetByIdAsync(_configuration.LeaseName);
+
+                if (lease == null)
+                {
+                    var created = await _repository.CreateIfNotExistsAsync(NewTerm(null, now));
+                    SetLeadership(created, now);
+                    return;
+                }
+
+                var excluded = lease.ExcludedHolderId == InstanceId && lease.ExcludedUntil > now;
+
+                if (lease.HolderId == InstanceId && lease.ExpiresAt > now && !excluded)
+                {
+                    lease.RenewedAt = now;
+                    lease.ExpiresAt = now.AddSeconds(_configuration.LeaseSeconds);
+                    lease.State = new Dictionary<string, string>(_state);
+                    await _repository.UpdateAsync(lease);
+                    SetLeadership(lease, now);
+                    return;
+                }
+
+                if (lease.ExpiresAt <= now && !excluded)
+                {
+                    foreach (var entry in lease.State ?? new Dictionary<string, string>())
+                    {
+                        _state[entry.Key] = entry.Value;
+                    }
+
+                    await _repository.UpdateAsync(NewTerm(lease, now));
+
+                    // Another standby may have taken over in the same interval; the last write wins
+                    SetLeadership(await _repository.GetByIdAsync(_configuration.LeaseName), now);
+                    return;
+                }
+
+                SetLeadership(lease, now);
+            }
+            catch (Exception ex)
+            {
+                // A leader keeps acting until its lease runs out, see IsLeader
+                _logger.LogError(ex, "Error renewing lease {LeaseName}", _configuration.LeaseName);
+            }
+            finally
+            {
+                _renewSemaphore.Release();
+            }
+        }
+
+        private ServiceLease NewTerm(ServiceLease previous, DateTime now)
+        {
+            return new ServiceLease
+            {
+                Id = _configuration.LeaseName,
+                HolderId = InstanceId,
+                Term = (previous?.Term ?? 0) + 1,
+                AcquiredAt = now,
+                RenewedAt = now,
+                ExpiresAt = now.AddSeconds(_configuration.LeaseSeconds),
+                State = previous?.State ?? new Dictionary<string, string>(),
+                ExcludedHolderId = previous?.ExcludedHolderId,
+                ExcludedUntil = previous?.ExcludedUntil,
+                FailoverRequestedBy = previous?.FailoverRequestedBy
+            };
+        }
+
+        private void SetLeadership(ServiceLease lease, DateTime now)
+        {
+            var isLeader = lease?.HolderId == InstanceId && lease.ExpiresAt > now;
+
+            if (isLeader && !_isLeader)
+            {
+                _logger.LogWarning("Instance {InstanceId} acquired lease {LeaseName}, term {Term}", InstanceId, lease.Id, lease.Term);
+            }
+            else if (!isLeader && _isLeader)
+            {
+                _logger.LogWarning("Instance {InstanceId} lost lease {LeaseName} to {HolderId}", InstanceId, _configuration.LeaseName, lease?.HolderId);
+            }
+
+            // A standby mirrors the leader's handoff state so it can resume where the leader stopped
+            if (!isLeader && lease?.State != null)
+            {
+                foreach (var entry in lease.State)
+                {
+                    _state[entry.Key] = entry.Value;
+                }
+            }
+
+            var acquired = isLeader && !_isLeader;
+            _isLeader = isLeader;
+            _leaseExpiresAt = isLeader ? lease.ExpiresAt : DateTime.MinValue;
+
+            if (acquired)
+            {
+                LeadershipAcquired?.Invoke(this, EventArgs.Empty);
+            }
+        }
+
+        /// <inheritdoc/>
+        public void Dispose()
+        {
+            _renewTimer?.Dispose();
+            _renewSemaphore.Dispose();
+        }
+    }
+}
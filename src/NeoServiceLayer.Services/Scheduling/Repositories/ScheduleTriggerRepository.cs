@@ -0,0 +1,87 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Scheduling.Repositories
+{
+    /// <summary>
+    /// Repository for schedule triggers
+    /// </summary>
+    public class ScheduleTriggerRepository : IScheduleTriggerRepository
+    {
+        private readonly ILogger<ScheduleTriggerRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private readonly string _collectionName = "schedule_triggers";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ScheduleTriggerRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public ScheduleTriggerRepository(ILogger<ScheduleTriggerRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<ScheduleTrigger> CreateAsync(ScheduleTrigger trigger)
+        {
+            _logger.LogInformation("Creating schedule trigger {Name} for function {FunctionId}", trigger.Name, trigger.FunctionId);
+
+            await _storageProvider.CreateAsync(_collectionName, trigger);
+
+            return trigger;
+        }
+
+        /// <inheritdoc/>
+        public async Task<ScheduleTrigger> UpdateAsync(ScheduleTrigger trigger)
+        {
+            _logger.LogDebug("Updating schedule trigger: {Id}", trigger.Id);
+
+            await _storageProvider.UpdateAsync<ScheduleTrigger, Guid>(_collectionName, trigger.Id, trigger);
+
+            return trigger;
+        }
+
+        /// <inheritdoc/>
+        public async Task<ScheduleTrigger> GetByIdAsync(Guid id)
+        {
+            return await _storageProvider.GetByIdAsync<ScheduleTrigger, Guid>(_collectionName, id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<ScheduleTrigger>> GetByAccountIdAsync(Guid accountId)
+        {
+            var triggers = await _storageProvider.GetAllAsync<ScheduleTrigger>(_collectionName);
+
+            return triggers.Where(t => t.AccountId == accountId);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<ScheduleTrigger>> GetAllAsync()
+        {
+            return await _storageProvider.GetAllAsync<ScheduleTrigger>(_collectionName);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<ScheduleTrigger>> GetDueAsync(DateTime now)
+        {
+            var triggers = await _storageProvider.GetAllAsync<ScheduleTrigger>(_collectionName);
+
+            return triggers.Where(t => t.Enabled && t.NextRunAt.HasValue && t.NextRunAt.Value <= now);
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAsync(Guid id)
+        {
+            _logger.LogInformation("Deleting schedule trigger: {Id}", id);
+
+            return await _storageProvider.DeleteAsync<ScheduleTrigger, Guid>(_collectionName, id);
+        }
+    }
+}
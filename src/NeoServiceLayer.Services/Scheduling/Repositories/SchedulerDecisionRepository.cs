@@ -0,0 +1,66 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Scheduling.Repositories
+{
+    /// <summary>
+    /// Repository for the scheduler's replay log
+    /// </summary>
+    public class SchedulerDecisionRepository : ISchedulerDecisionRepository
+    {
+        private readonly ILogger<SchedulerDecisionRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private readonly string _collectionName = "scheduler_decisions";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SchedulerDecisionRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public SchedulerDecisionRepository(ILogger<SchedulerDecisionRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<SchedulerDecision> CreateAsync(SchedulerDecision decision)
+        {
+            _logger.LogDebug("Logging scheduler decision {Kind} for trigger {TriggerId}", decision.Kind, decision.TriggerId);
+
+            return await _storageProvider.CreateAsync(_collectionName, decision);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<SchedulerDecision>> GetRangeAsync(DateTime from, DateTime to, Guid? triggerId = null)
+        {
+            var decisions = await _storageProvider.GetByFilterAsync<SchedulerDecision>(_collectionName, d =>
+                d.At >= from && d.At < to && (triggerId == null || d.TriggerId == triggerId));
+
+            return decisions
+                .OrderBy(d => d.At)
+                .ThenBy(d => d.InstanceId, StringComparer.Ordinal)
+                .ThenBy(d => d.Sequence)
+                .ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<SchedulerDecision>> GetBeforeAsync(DateTime cutoff, int limit)
+        {
+            var decisions = await _storageProvider.GetByFilterAsync<SchedulerDecision>(_collectionName, d => d.At < cutoff);
+
+            return decisions.OrderBy(d => d.At).ThenBy(d => d.Id).Take(limit).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAsync(Guid id)
+        {
+            return await _storageProvider.DeleteAsync<SchedulerDecision, Guid>(_collectionName, id);
+        }
+    }
+}
@@ -0,0 +1,75 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Scheduling.Repositories
+{
+    /// <summary>
+    /// Repository for service leadership leases
+    /// </summary>
+    /// <remarks>
+    /// As with trigger firings, MongoDB refuses a second insert of the same lease. Takeovers and renewals are plain
+    /// updates, so callers read the lease back to confirm they hold it.
+    /// </remarks>
+    public class ServiceLeaseRepository : IServiceLeaseRepository
+    {
+        private readonly ILogger<ServiceLeaseRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private readonly string _collectionName = "service_leases";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ServiceLeaseRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public ServiceLeaseRepository(ILogger<ServiceLeaseRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<ServiceLease> GetByIdAsync(string name)
+        {
+            return await _storageProvider.GetByIdAsync<ServiceLease, string>(_collectionName, name);
+        }
+
+        /// <inheritdoc/>
+        public async Task<ServiceLease> CreateIfNotExistsAsync(ServiceLease lease)
+        {
+            var existing = await GetByIdAsync(lease.Id);
+            if (existing != null)
+            {
+                return existing;
+            }
+
+            try
+            {
+                await _storageProvider.CreateAsync(_collectionName, lease);
+            }
+            catch (Exception ex)
+            {
+                existing = await GetByIdAsync(lease.Id);
+                if (existing == null)
+                {
+                    throw;
+                }
+
+                _logger.LogDebug(ex, "Lease {Name} was created concurrently", lease.Id);
+                return existing;
+            }
+
+            return await GetByIdAsync(lease.Id) ?? lease;
+        }
+
+        /// <inheritdoc/>
+        public async Task<ServiceLease> UpdateAsync(ServiceLease lease)
+        {
+            await _storageProvider.UpdateAsync<ServiceLease, string>(_collectionName, lease.Id, lease);
+
+            return lease;
+        }
+    }
+}
@@ -0,0 +1,190 @@
+using System;
+using System.Collections.Generic;
+using System.Globalization;
+using System.Linq;
+using System.Text;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Scheduling.Repositories
+{
+    /// <summary>
+    /// Repository for trigger firing tokens
+    /// </summary>
+    /// <remarks>
+    /// MongoDB refuses a second insert with the same ID, and because the ID is a hash of the firing, a collection
+    /// sharded on <c>_id</c> routes every claim for one firing to the same shard. Stores that overwrite on insert
+    /// are checked by reading the claim back, which narrows but does not close the race between instances.
+    /// </remarks>
+    public class TriggerFiringRepository : ITriggerFiringRepository
+    {
+        private readonly ILogger<TriggerFiringRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private readonly string _collectionName = "trigger_firings";
+        private readonly string _statisticsCollectionName = "trigger_firing_statistics";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="TriggerFiringRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public TriggerFiringRepository(ILogger<TriggerFiringRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<TriggerFiring> CreateIfNotExistsAsync(TriggerFiring firing)
+        {
+            var existing = await GetByIdAsync(firing.Id);
+            if (existing != null)
+            {
+                return existing;
+            }
+
+            try
+            {
+                await _storageProvider.CreateAsync(_collectionName, firing);
+            }
+            catch (Exception ex)
+            {
+                existing = await GetByIdAsync(firing.Id);
+                if (existing == null)
+                {
+                    throw;
+                }
+
+                _logger.LogDebug(ex, "Trigger firing {Id} was claimed concurrently", firing.Id);
+                return existing;
+            }
+
+            return await GetByIdAsync(firing.Id) ?? firing;
+        }
+
+        /// <inheritdoc/>
+        public async Task<TriggerFiring> GetByIdAsync(Guid id)
+        {
+            return await _storageProvider.GetByIdAsync<TriggerFiring, Guid>(_collectionName, id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<TriggerFiring> UpdateAsync(TriggerFiring firing)
+        {
+            await _storageProvider.UpdateAsync<TriggerFiring, Guid>(_collectionName, firing.Id, firing);
+
+            return firing;
+        }
+
+        /// <inheritdoc/>
+        public async Task<TriggerFiringPage> GetPageAsync(TriggerFiringQuery query)
+        {
+            var after = DecodeCursor(query.Cursor);
+
+            var firings = await _storageProvider.GetByFilterAsync<TriggerFiring>(_collectionName, f =>
+                f.TriggerId == query.TriggerId &&
+                (query.Status == null || f.Status == query.Status) &&
+                (query.From == null || f.ScheduledFor >= query.From) &&
+                (query.To == null || f.ScheduledFor < query.To) &&
+                (after == null || IsBefore(f, after.Value.ScheduledFor, after.Value.Id)));
+
+            // One extra firing tells whether there is a next page
+            var items = firings
+                .OrderByDescending(f => f.ScheduledFor)
+                .ThenByDescending(f => f.Id)
+                .Take(query.Limit + 1)
+                .ToList();
+
+            var page = new TriggerFiringPage { Items = items.Take(query.Limit).ToList() };
+            if (items.Count > query.Limit)
+            {
+                var last = page.Items[^1];
+                page.NextCursor = EncodeCursor(last.ScheduledFor, last.Id);
+            }
+
+            return page;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<TriggerFiring>> GetByTriggerIdAsync(Guid triggerId)
+        {
+            return await _storageProvider.GetByFilterAsync<TriggerFiring>(_collectionName, f => f.TriggerId == triggerId);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<TriggerFiring>> GetScheduledBeforeAsync(DateTime cutoff, int limit)
+        {
+            var firings = await _storageProvider.GetByFilterAsync<TriggerFiring>(_collectionName, f => f.ScheduledFor < cutoff);
+
+            return firings.OrderBy(f => f.ScheduledFor).ThenBy(f => f.Id).Take(limit).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> DeleteAsync(Guid id)
+        {
+            return await _storageProvider.DeleteAsync<TriggerFiring, Guid>(_collectionName, id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<TriggerFiringStatistics> GetStatisticsAsync(Guid triggerId)
+        {
+            return await _storageProvider.GetByIdAsync<TriggerFiringStatistics, Guid>(_statisticsCollectionName, triggerId);
+        }
+
+        /// <inheritdoc/>
+        public async Task<TriggerFiringStatistics> SaveStatisticsAsync(TriggerFiringStatistics statistics)
+        {
+            statistics.Id = statistics.TriggerId;
+            statistics.UpdatedAt = DateTime.UtcNow;
+
+            if (await GetStatisticsAsync(statistics.Id) == null)
+            {
+                return await _storageProvider.CreateAsync(_statisticsCollectionName, statistics);
+            }
+
+            return await _storageProvider.UpdateAsync<TriggerFiringStatistics, Guid>(_statisticsCollectionName, statistics.Id, statistics);
+        }
+
+        // Pages are ordered by fire time then ID, both descending
+        private static bool IsBefore(TriggerFiring firing, DateTime scheduledFor, Guid id)
+        {
+            return firing.ScheduledFor < scheduledFor || (firing.ScheduledFor == scheduledFor && firing.Id.CompareTo(id) < 0);
+        }
+
+        private static string EncodeCursor(DateTime scheduledFor, Guid id)
+        {
+            var text = $"{scheduledFor.Ticks.ToString(CultureInfo.InvariantCulture)}:{id:N}";
+            return Convert.ToBase64String(Encoding.UTF8.GetBytes(text)).TrimEnd('=').Replace('+', '-').Replace('/', '_');
+        }
+
+        private static (DateTime ScheduledFor, Guid Id)? DecodeCursor(string cursor)
+        {
+            if (string.IsNullOrEmpty(cursor))
+            {
+                return null;
+            }
+
+            try
+            {
+                var base64 = cursor.Replace('-', '+').Replace('_', '/');
+                base64 = base64.PadRight(base64.Length + (4 - base64.Length % 4) % 4, '=');
+                var parts = Encoding.UTF8.GetString(Convert.FromBase64String(base64)).Split(':');
+                if (parts.Length == 2 &&
+                    long.TryParse(parts[0], NumberStyles.None, CultureInfo.InvariantCulture, out var ticks) &&
+                    ticks <= DateTime.MaxValue.Ticks &&
+                    Guid.TryParseExact(parts[1], "N", out var id))
+                {
+                    return (new DateTime(ticks, DateTimeKind.Utc), id);
+                }
+            }
+            catch (FormatException)
+            {
+            }
+
+            throw new ValidationException("Invalid cursor");
+        }
+    }
+}
@@ -0,0 +1,615 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using NeoServiceLayer.Services.Diagnostics;
+
+namespace NeoServiceLayer.Services.Scheduling
+{
+    /// <summary>
+    /// Runs functions on cron schedules, once per fire time across all instances
+    /// </summary>
+    /// <remarks>
+    /// Triggers and their next fire times live in the shared store. With <c>Leadership:Enabled</c> only the lease holder
+    /// looks for due triggers, and a standby takes over when the leader's lease expires. Each firing is also claimed
+    /// through its idempotency token before the function runs, so two instances that both believe they may fire,
+    /// such as during a takeover or with leadership disabled, still run it once. Fire times missed while no instance
+    /// was firing are coalesced into a single run. Triggers due in the same poll run on a bounded pool, taken in turn
+    /// from each account so one account with many triggers does not delay everyone else's. Every due, fire, skip and
+    /// reschedule decision is kept in the replay log, which <see cref="ReplayAsync"/> checks against the triggers.
+    /// </remarks>
+    public class ScheduleTriggerService : IScheduleTriggerService, IManagedService, IDisposable
+    {
+        private const string TriggerType = "schedule";
+
+        private readonly ILogger<ScheduleTriggerService> _logger;
+        private readonly IScheduleTriggerRepository _repository;
+        private readonly IFunctionService _functionService;
+        private readonly TriggerFiringCoordinator _firingCoordinator;
+        private readonly SchedulerConfiguration _configuration;
+        private readonly ILeadershipService _leadership;
+        private readonly IMaintenanceService _maintenanceService;
+        private readonly IServerEventPublisher _eventPublisher;
+        private readonly ICrashReporter _crashReporter;
+        private readonly IMetricsService _metricsService;
+        private readonly SchedulerReplayLog _replayLog;
+        private readonly SemaphoreSlim _pollSemaphore = new SemaphoreSlim(1, 1);
+        private readonly SemaphoreSlim _updateSemaphore = new SemaphoreSlim(1, 1);
+        private readonly ConcurrentQueue<double> _lateness = new ConcurrentQueue<double>();
+        private readonly string _instanceId;
+
+        private long _totalRuns;
+        private int _lastPollDueCount;
+        private DateTime? _lastPollAt;
+
+        private Timer _pollTimer;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="ScheduleTriggerService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="repository">Schedule trigger repository</param>
+        /// <param name="functionService">Function service</param>
+        /// <param name="firingCoordinator">Coordinator of firing idempotency tokens</param>
+        /// <param name="configuration">Scheduler configuration</param>
+        /// <param name="leadership">Leadership service; only the leader fires triggers</param>
+        /// <param name="maintenanceService">Maintenance service; triggers do not fire while maintenance is active</param>
+        /// <param name="eventPublisher">Publisher of trigger events to event stream subscribers</param>
+        /// <param name="crashReporter">Crash reporter for the poll timer</param>
+        /// <param name="metricsService">Metrics service; each run's lateness is recorded as a custom metric</param>
+        /// <param name="replayLog">Replay log the scheduler's decisions are recorded in</param>
+        public ScheduleTriggerService(
+            ILogger<ScheduleTriggerService> logger,
+            IScheduleTriggerRepository repository,
+            IFunctionService functionService,
+            TriggerFiringCoordinator firingCoordinator,
+            IOptions<SchedulerConfiguration> configuration,
+            ILeadershipService leadership = null,
+            IMaintenanceService maintenanceService = null,
+            IServerEventPublisher eventPublisher = null,
+            ICrashReporter crashReporter = null,
+            IMetricsService metricsService = null,
+            SchedulerReplayLog replayLog = null)
+        {
+            _logger = logger;
+            _repository = repository;
+            _functionService = functionService;
+            _firingCoordinator = firingCoordinator;
+            _configuration = configuration.Value;
+            _leadership = leadership;
+            _maintenanceService = maintenanceService;
+            _eventPublisher = eventPublisher;
+            _crashReporter = crashReporter;
+            _metricsService = metricsService;
+            _replayLog = replayLog;
+            _instanceId = leadership?.InstanceId ?? $"{Environment.MachineName}:{Environment.ProcessId}";
+        }
+
+        /// <inheritdoc/>
+        public async Task<ScheduleTrigger> CreateAsync(ScheduleTrigger trigger)
+        {
+            var schedule = await ValidateAsync(trigger);
+
+            var existing = await _repository.GetByAccountIdAsync(trigger.AccountId);
+            if (existing.Count() >= _configuration.MaxTriggersPerAccount)
+            {
+                throw new ValidationException($"Schedule trigger limit of {_configuration.MaxTriggersPerAccount} reached for account");
+            }
+
+            trigger.Id = Guid.NewGuid();
+            trigger.CreatedAt = DateTime.UtcNow;
+            trigger.UpdatedAt = trigger.CreatedAt;
+            trigger.NextRunAt = schedule.GetNextOccurrence(trigger.CreatedAt);
+            trigger.LastRunAt = null;
+            trigger.LastRunInstanceId = null;
+            trigger.LastError = null;
+            trigger.RunCount = 0;
+
+            _logger.LogInformation("Creating schedule trigger {Name} ({CronExpression}) for function {FunctionId}, first run at {NextRunAt}",
+                trigger.Name, trigger.CronExpression, trigger.FunctionId, trigger.NextRunAt);
+            var created = await _repository.CreateAsync(trigger);
+
+            await RecordAsync(SchedulerDecisionKind.Reschedule, created, null, created.NextRunAt, SchedulerReplayLog.CreatedReason);
+            return created;
+        }
+
+        /// <inheritdoc/>
+        public async Task<ScheduleTrigger> UpdateAsync(ScheduleTrigger trigger)
+        {
+            await _updateSemaphore.WaitAsync();
+            try
+            {
+                var existing = await _repository.GetByIdAsync(trigger.Id);
+                if (existing == null)
+                {
+                    throw new ResourceNotFoundException("ScheduleTrigger", trigger.Id.ToString());
+                }
+
+                if (trigger.Revision != existing.Revision)
+                {
+                    throw new ConcurrencyConflictException("ScheduleTrigger", trigger.Id.ToString(), existing.Revision);
+                }
+
+                trigger.AccountId = existing.AccountId;
+                var schedule = await ValidateAsync(trigger);
+
+                var now = DateTime.UtcNow;
+                trigger.CreatedAt = existing.CreatedAt;
+                trigger.UpdatedAt = now;
+                trigger.Revision = existing.Revision + 1;
+                trigger.LastRunAt = existing.LastRunAt;
+                trigger.LastRunInstanceId = existing.LastRunInstanceId;
+                trigger.LastError = existing.LastError;
+                trigger.RunCount = existing.RunCount;
+
+                // A new schedule, or re-enabling, starts from the next matching time rather than catching up
+                trigger.NextRunAt = trigger.CronExpression == existing.CronExpression && existing.Enabled
+                    ? existing.NextRunAt
+                    : schedule.GetNextOccurrence(now);
+
+                var updated = await _repository.UpdateAsync(trigger);
+
+                var reason = trigger.Enabled != existing.Enabled
+                    ? (trigger.Enabled ? SchedulerReplayLog.EnabledReason : SchedulerReplayLog.DisabledReason)
+                    : trigger.CronExpression != existing.CronExpression ? SchedulerReplayLog.ScheduleChangedReason : null;
+                if (reason != null)
+                {
+                    await RecordAsync(SchedulerDecisionKind.Reschedule, updated, null, updated.NextRunAt, reason);
+                }
+
+                return updated;
+            }
+            finally
+            {
+                _updateSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public Task<ScheduleTrigger> GetByIdAsync(Guid id)
+        {
+            return _repository.GetByIdAsync(id);
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<ScheduleTrigger>> GetByAccountIdAsync(Guid accountId)
+        {
+            return _repository.GetByAccountIdAsync(accountId);
+        }
+
+        /// <inheritdoc/>
+        public Task<bool> DeleteAsync(Guid id)
+        {
+            return _repository.DeleteAsync(id);
+        }
+
+        /// <inheritdoc/>
+        public Task<SchedulerLateness> GetLatenessAsync()
+        {
+            var samples = _lateness.ToArray().OrderBy(l => l).ToList();
+            var lateness = new SchedulerLateness
+            {
+                TotalRuns = Interlocked.Read(ref _totalRuns),
+                SampleSize = samples.Count,
+                LastPollDueCount = _lastPollDueCount,
+                LastPollAt = _lastPollAt
+            };
+
+            if (samples.Count > 0)
+            {
+                lateness.AverageMs = Math.Round(samples.Average(), 1);
+                lateness.P50Ms = Percentile(samples, 50);
+                lateness.P95Ms = Percentile(samples, 95);
+                lateness.P99Ms = Percentile(samples, 99);
+                lateness.MaxMs = samples[^1];
+            }
+
+            return Task.FromResult(lateness);
+        }
+
+        /// <inheritdoc/>
+        public Task<TriggerFiringPage> GetFiringsAsync(TriggerFiringQuery query)
+        {
+            return _firingCoordinator.GetHistoryAsync(query);
+        }
+
+        /// <inheritdoc/>
+        public Task<TriggerFiringStatistics> GetFiringStatisticsAsync(Guid id)
+        {
+            return _firingCoordinator.GetStatisticsAsync(TriggerType, id);
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<SchedulerDecision>> GetDecisionsAsync(DateTime from, DateTime to, Guid? triggerId = null)
+        {
+            ValidateReplayWindow(from, to);
+
+            return _replayLog.GetDecisionsAsync(from, to, triggerId);
+        }
+
+        /// <inheritdoc/>
+        public async Task<SchedulerReplayReport> ReplayAsync(DateTime from, DateTime to, IEnumerable<ScheduleTrigger> triggers = null)
+        {
+            ValidateReplayWindow(from, to);
+
+            // A fire time is only looked for once a poll has had the chance to see it
+            var polled = DateTime.UtcNow.AddSeconds(-2 * _configuration.PollIntervalSeconds);
+            if (to > polled)
+            {
+                to = polled;
+            }
+
+            if (to <= from)
+            {
+                throw new ValidationException("The window has not been polled yet");
+            }
+
+            // Decisions about a fire time can come after it, as late as the firing lease when a claim is abandoned
+            var decisions = await _replayLog.GetDecisionsAsync(from, to.AddSeconds(_configuration.FiringLeaseSeconds));
+            triggers ??= await _repository.GetAllAsync();
+
+            return SchedulerReplay.Replay(triggers, decisions, from, to);
+        }
+
+        /// <inheritdoc/>
+        public string Name => Constants.ManagedServices.Scheduler;
+
+        /// <inheritdoc/>
+        public IReadOnlyCollection<string> DependsOn { get; } = new[] { Constants.ManagedServices.Leadership, Constants.ManagedServices.Functions };
+
+        /// <inheritdoc/>
+        public Task StartAsync()
+        {
+            if (!_configuration.Enabled || _pollTimer != null)
+            {
+                return Task.CompletedTask;
+            }
+
+            _logger.LogInformation("Instance {InstanceId} firing schedule triggers, checking every {Interval} seconds",
+                _instanceId, _configuration.PollIntervalSeconds);
+
+            _pollTimer = new Timer(
+                _crashReporter.Guard(_logger, "Scheduler.Poll", FireDueTriggersAsync),
+                null,
+                TimeSpan.FromSeconds(_configuration.PollIntervalSeconds),
+                TimeSpan.FromSeconds(_configuration.PollIntervalSeconds));
+
+            return Task.CompletedTask;
+        }
+
+        /// <inheritdoc/>
+        public async Task StopAsync()
+        {
+            _pollTimer?.Change(Timeout.Infinite, Timeout.Infinite);
+            _pollTimer?.Dispose();
+            _pollTimer = null;
+
+            // Let a cycle already running finish
+            await _pollSemaphore.WaitAsync();
+            _pollSemaphore.Release();
+        }
+
+        /// <inheritdoc/>
+        public ManagedServiceHealth GetHealth()
+        {
+            if (!_configuration.Enabled)
+            {
+                return ManagedServiceHealth.Of(Name, ManagedServiceStatus.Disabled);
+            }
+
+            return ManagedServiceHealth.Of(Name, _pollTimer != null ? ManagedServiceStatus.Running : ManagedServiceStatus.Stopped);
+        }
+
+        /// <summary>
+        /// Fires every trigger whose next fire time has passed, if this instance is the leader
+        /// </summary>
+        /// <returns>The number of triggers this instance ran</returns>
+        public async Task<int> FireDueTriggersAsync()
+        {
+            if (!await _pollSemaphore.WaitAsync(0))
+            {
+                return 0;
+            }
+
+            try
+            {
+                if (_leadership?.IsLeader == false)
+                {
+                    return 0;
+                }
+
+                if (_maintenanceService != null && await _maintenanceService.IsActiveAsync())
+                {
+                    if (_replayLog?.Enabled == true)
+                    {
+                        foreach (var trigger in await _repository.GetDueAsync(DateTime.UtcNow))
+                        {
+                            await RecordAsync(SchedulerDecisionKind.Skip, trigger, trigger.NextRunAt, reason: "maintenance active");
+                        }
+                    }
+
+                    return 0;
+                }
+
+                var now = DateTime.UtcNow;
+                var due = OrderFairly(await _repository.GetDueAsync(now));
+                if (due.Count == 0)
+                {
+                    return 0;
+                }
+
+                foreach (var trigger in due)
+                {
+                    await RecordAsync(SchedulerDecisionKind.Due, trigger, trigger.NextRunAt);
+                }
+
+                _lastPollDueCount = due.Count;
+                _lastPollAt = now;
+
+                var fired = 0;
+                using var slots = new SemaphoreSlim(Math.Max(1, _configuration.MaxConcurrentFirings));
+                await Task.WhenAll(due.Select(async trigger =>
+                {
+                    await slots.WaitAsync();
+                    try
+                    {
+                        if (await FireAsync(trigger, now))
+                        {
+                            Interlocked.Increment(ref fired);
+                        }
+                    }
+                    catch (Exception ex)
+                    {
+                        _logger.LogError(ex, "Error firing schedule trigger {TriggerId}", trigger.Id);
+                    }
+                    finally
+                    {
+                        slots.Release();
+                    }
+                }));
+
+                return fired;
+            }
+            finally
+            {
+                _pollSemaphore.Release();
+            }
+        }
+
+        // Returns whether this instance ran the firing
+        private async Task<bool> FireAsync(ScheduleTrigger trigger, DateTime now)
+        {
+            var scheduledFor = trigger.NextRunAt.Value;
+
+            var claim = await _firingCoordinator.ClaimAsync(
+                TriggerType, trigger.Id, scheduledFor, TimeSpan.FromSeconds(_configuration.FiringLeaseSeconds));
+            if (!claim.Acquired)
+            {
+                var reason = claim.Abandoned
+                    ? $"claim abandoned by {claim.Firing.InstanceId}"
+                    : $"{claim.Firing.Status.ToString().ToLowerInvariant()} by {claim.Firing.InstanceId}";
+                await RecordAsync(SchedulerDecisionKind.Skip, trigger, scheduledFor, reason: reason);
+
+                // Another instance ran this fire time, or stopped while running it; either way it is not run again
+                if (claim.Abandoned || claim.Firing.Status != TriggerFiringStatus.Claimed)
+                {
+                    await AdvanceAsync(trigger.Id, scheduledFor, now, false);
+                }
+
+                return false;
+            }
+
+            await RecordAsync(SchedulerDecisionKind.Fire, trigger, scheduledFor);
+
+            // Move on before running, so a slow function does not hold back the schedule
+            var advanced = await AdvanceAsync(trigger.Id, scheduledFor, now, true);
+            var runCount = advanced?.RunCount ?? trigger.RunCount + 1;
+
+            _logger.LogInformation("Instance {InstanceId} running function {FunctionId} for schedule trigger {TriggerId} at {ScheduledFor}",
+                _instanceId, trigger.FunctionId, trigger.Id, scheduledFor);
+            await RecordLatenessAsync(trigger, scheduledFor);
+
+            string error = null;
+            try
+            {
+                var parameters = new Dictionary<string, object>(trigger.Parameters ?? new Dictionary<string, object>())
+                {
+                    ["scheduledFor"] = scheduledFor
+                };
+                await _functionService.ExecuteAsync(trigger.FunctionId, parameters);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogWarning(ex, "Schedule trigger {TriggerId} failed to run function {FunctionId}", trigger.Id, trigger.FunctionId);
+                error = ex.Message;
+            }
+
+            await _firingCoordinator.CompleteAsync(claim.Firing, error == null, error);
+
+            var stored = await _repository.GetByIdAsync(trigger.Id);
+            if (stored != null && stored.LastRunAt == scheduledFor)
+            {
+                stored.LastError = error;
+                await _repository.UpdateAsync(stored);
+            }
+
+            _eventPublisher?.Publish(new ServerEvent
+            {
+                Topic = ServerEventTopics.Triggers,
+                Type = TriggerType,
+                AccountId = trigger.AccountId,
+                Data = new { TriggerId = trigger.Id, trigger.FunctionId, ScheduledFor = scheduledFor, Succeeded = error == null, RunCount = runCount }
+            });
+
+            return true;
+        }
+
+        private async Task<ScheduleTrigger> AdvanceAsync(Guid triggerId, DateTime scheduledFor, DateTime now, bool ran)
+        {
+            // Only the instance that sees the fire time it claimed moves the trigger on
+            var stored = await _repository.GetByIdAsync(triggerId);
+            if (stored == null || stored.NextRunAt != scheduledFor)
+            {
+                return null;
+            }
+
+            // Fire times missed while no instance was firing are skipped rather than run back to back
+            stored.NextRunAt = CronSchedule.TryParse(stored.CronExpression, out var schedule, out _)
+                ? schedule.GetNextOccurrence(now > scheduledFor ? now : scheduledFor)
+                : null;
+            stored.UpdatedAt = now;
+
+            if (ran)
+            {
+                stored.LastRunAt = scheduledFor;
+                stored.LastRunInstanceId = _instanceId;
+                stored.RunCount++;
+            }
+
+            var updated = await _repository.UpdateAsync(stored);
+
+            var reason = schedule?.GetNextOccurrence(scheduledFor) <= now ? SchedulerReplayLog.CoalescedReason : ran ? "ran" : "ran elsewhere";
+            await RecordAsync(SchedulerDecisionKind.Reschedule, updated, scheduledFor, updated.NextRunAt, reason);
+
+            return updated;
+        }
+
+        private Task RecordAsync(SchedulerDecisionKind kind, ScheduleTrigger trigger, DateTime? scheduledFor, DateTime? nextRunAt = null, string reason = null)
+        {
+            return _replayLog?.RecordAsync(kind, trigger, scheduledFor, nextRunAt, reason) ?? Task.CompletedTask;
+        }
+
+        private void ValidateReplayWindow(DateTime from, DateTime to)
+        {
+            if (_replayLog?.Enabled != true)
+            {
+                throw new ValidationException("The scheduler replay log is disabled");
+            }
+
+            if (to <= from)
+            {
+                throw new ValidationException("The end of the window must be after its start");
+            }
+        }
+
+        /// <summary>
+        /// Orders due triggers by taking each account's earliest remaining trigger in turn
+        /// </summary>
+        /// <param name="due">Due triggers</param>
+        /// <returns>The triggers, interleaved by account; accounts whose triggers are most overdue go first</returns>
+        public static List<ScheduleTrigger> OrderFairly(IEnumerable<ScheduleTrigger> due)
+        {
+            var queues = due
+                .GroupBy(t => t.AccountId)
+                .Select(g => new Queue<ScheduleTrigger>(g.OrderBy(t => t.NextRunAt)))
+                .OrderBy(q => q.Peek().NextRunAt)
+                .ToList();
+
+            var ordered = new List<ScheduleTrigger>();
+            while (queues.Count > 0)
+            {
+                foreach (var queue in queues)
+                {
+                    ordered.Add(queue.Dequeue());
+                }
+
+                queues.RemoveAll(q => q.Count == 0);
+            }
+
+            return ordered;
+        }
+
+        private async Task RecordLatenessAsync(ScheduleTrigger trigger, DateTime scheduledFor)
+        {
+            var lateness = Math.Max(0, (DateTime.UtcNow - scheduledFor).TotalMilliseconds);
+
+            Interlocked.Increment(ref _totalRuns);
+            _lateness.Enqueue(lateness);
+            while (_lateness.Count > Math.Max(1, _configuration.LatenessSampleSize))
+            {
+                _lateness.TryDequeue(out _);
+            }
+
+            if (_metricsService == null)
+            {
+                return;
+            }
+
+            try
+            {
+                await _metricsService.RecordCustomMetricAsync("scheduler_fire_lateness_ms", lateness, new Dictionary<string, string>
+                {
+                    ["trigger_id"] = trigger.Id.ToString(),
+                    ["account_id"] = trigger.AccountId.ToString()
+                });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogWarning(ex, "Error recording lateness of schedule trigger {TriggerId}", trigger.Id);
+            }
+        }
+
+        private static double Percentile(List<double> sorted, int percentile)
+        {
+            var index = (int)Math.Ceiling(percentile / 100.0 * sorted.Count) - 1;
+            return sorted[Math.Clamp(index, 0, sorted.Count - 1)];
+        }
+
+        private async Task<CronSchedule> ValidateAsync(ScheduleTrigger trigger)
+        {
+            if (trigger == null)
+            {
+                throw new ArgumentNullException(nameof(trigger));
+            }
+
+            if (string.IsNullOrWhiteSpace(trigger.Name))
+            {
+                throw new ValidationException("Schedule trigger name is required");
+            }
+
+            if (!CronSchedule.TryParse(trigger.CronExpression, out var schedule, out var error))
+            {
+                throw new ValidationException($"Invalid cron expression: {error}");
+            }
+
+            if (schedule.GetNextOccurrence(DateTime.UtcNow) == null)
+            {
+                throw new ValidationException("The cron expression never fires");
+            }
+
+            var function = await _functionService.GetByIdAsync(trigger.FunctionId);
+            if (function == null)
+            {
+                throw new ResourceNotFoundException("Function", trigger.FunctionId.ToString());
+            }
+
+            if (function.AccountId != trigger.AccountId)
+            {
+                throw new ForbiddenAccessException("Function", trigger.FunctionId.ToString(), trigger.AccountId.ToString());
+            }
+
+            trigger.CronExpression = schedule.Expression;
+            trigger.Tags = trigger.Tags.NormalizeTags();
+
+            return schedule;
+        }
+
+        /// <inheritdoc/>
+        public void Dispose()
+        {
+            _pollTimer?.Dispose();
+            _pollSemaphore.Dispose();
+            _updateSemaphore.Dispose();
+        }
+    }
+}
@@ -0,0 +1,178 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.Scheduling
+{
+    /// <summary>
+    /// Replays the scheduler's logged decisions against a set of schedule triggers
+    /// </summary>
+    /// <remarks>
+    /// Each trigger's fire times in the window are computed from its cron expression and matched with the runs, skips
+    /// and reschedules in the log. A fire time with no run is explained by a skip, by a later reschedule that coalesced
+    /// it, or by the trigger having been disabled; otherwise it is missed. The replay depends only on its inputs, so
+    /// the same log and trigger set always give the same report.
+    /// </remarks>
+    public static class SchedulerReplay
+    {
+        /// <summary>
+        /// Maximum number of expected fire times computed per trigger
+        /// </summary>
+        public const int MaxFireTimesPerTrigger = 20000;
+
+        /// <summary>
+        /// Replays decisions against triggers
+        /// </summary>
+        /// <param name="triggers">Triggers to replay; their cron expressions, enabled state and creation times give the expected fire times</param>
+        /// <param name="decisions">Logged decisions; those made after the window still explain fire times in it</param>
+        /// <param name="from">Start of the window, inclusive</param>
+        /// <param name="to">End of the window, exclusive</param>
+        /// <returns>The report</returns>
+        public static SchedulerReplayReport Replay(IEnumerable<ScheduleTrigger> triggers, IEnumerable<SchedulerDecision> decisions, DateTime from, DateTime to)
+        {
+            var ordered = decisions
+                .OrderBy(d => d.At)
+                .ThenBy(d => d.InstanceId, StringComparer.Ordinal)
+                .ThenBy(d => d.Sequence)
+                .ToList();
+            var byTrigger = ordered.ToLookup(d => d.TriggerId);
+
+            var report = new SchedulerReplayReport { From = from, To = to, DecisionCount = ordered.Count };
+            foreach (var trigger in triggers.OrderBy(t => t.Id))
+            {
+                var result = ReplayTrigger(trigger, byTrigger[trigger.Id].ToList(), from, to);
+                report.Triggers.Add(result);
+
+                report.Missed += result.FireTimes.Count(f => f.Outcome == SchedulerReplayOutcome.Missed);
+                report.Duplicates += result.FireTimes.Count(f => f.Outcome == SchedulerReplayOutcome.Duplicate);
+                report.Unexpected += result.FireTimes.Count(f => f.Outcome == SchedulerReplayOutcome.Unexpected);
+            }
+
+            return report;
+        }
+
+        private static SchedulerReplayTrigger ReplayTrigger(ScheduleTrigger trigger, List<SchedulerDecision> decisions, DateTime from, DateTime to)
+        {
+            var expected = GetExpectedFireTimes(trigger, from, to);
+            var runs = decisions
+                .Where(d => d.Kind == SchedulerDecisionKind.Fire && d.ScheduledFor >= from && d.ScheduledFor < to)
+                .GroupBy(d => d.ScheduledFor.Value)
+                .ToDictionary(g => g.Key, g => g.ToList());
+
+            var result = new SchedulerReplayTrigger
+            {
+                TriggerId = trigger.Id,
+                Name = trigger.Name,
+                CronExpression = trigger.CronExpression
+            };
+
+            foreach (var scheduledFor in expected.Union(runs.Keys).OrderBy(t => t))
+            {
+                var fireTime = new SchedulerReplayFireTime
+                {
+                    ScheduledFor = scheduledFor,
+                    FirstDueAt = decisions.FirstOrDefault(d => d.Kind == SchedulerDecisionKind.Due && d.ScheduledFor == scheduledFor)?.At
+                };
+
+                if (runs.TryGetValue(scheduledFor, out var fired))
+                {
+                    fireTime.Runs = fired.Count;
+                    fireTime.InstanceIds = fired.Select(d => d.InstanceId).ToList();
+
+                    if (!expected.Contains(scheduledFor))
+                    {
+                        fireTime.Outcome = SchedulerReplayOutcome.Unexpected;
+                        fireTime.Reason = $"Not a fire time of '{trigger.CronExpression}'";
+                    }
+                    else if (fired.Count > 1)
+                    {
+                        fireTime.Outcome = SchedulerReplayOutcome.Duplicate;
+                        fireTime.Reason = $"Ran {fired.Count} times, on {string.Join(", ", fireTime.InstanceIds.Distinct())}";
+                    }
+                    else
+                    {
+                        fireTime.Outcome = SchedulerReplayOutcome.Fired;
+                    }
+                }
+                else
+                {
+                    Explain(fireTime, decisions);
+                }
+
+                result.FireTimes.Add(fireTime);
+            }
+
+            return result;
+        }
+
+        private static void Explain(SchedulerReplayFireTime fireTime, List<SchedulerDecision> decisions)
+        {
+            var scheduledFor = fireTime.ScheduledFor;
+
+            var skip = decisions.LastOrDefault(d => d.Kind == SchedulerDecisionKind.Skip && d.ScheduledFor == scheduledFor);
+            if (skip != null)
+            {
+                fireTime.Outcome = SchedulerReplayOutcome.Skipped;
+                fireTime.Reason = $"Skipped by {skip.InstanceId} at {skip.At:o}: {skip.Reason}";
+                return;
+            }
+
+            // A run moved on from an earlier fire time to one after this one, so this one was passed over
+            var coalescing = decisions.FirstOrDefault(d =>
+                d.Kind == SchedulerDecisionKind.Reschedule &&
+                d.ScheduledFor < scheduledFor &&
+                d.At >= scheduledFor &&
+                (d.NextRunAt == null || d.NextRunAt > scheduledFor));
+            if (coalescing != null)
+            {
+                fireTime.Outcome = SchedulerReplayOutcome.Coalesced;
+                fireTime.Reason = $"Not fired until {coalescing.At:o}, then coalesced with {coalescing.ScheduledFor:o}";
+                return;
+            }
+
+            // The last change to the trigger before the fire time, other than moving on from a run
+            var change = decisions.LastOrDefault(d => d.Kind == SchedulerDecisionKind.Reschedule && d.ScheduledFor == null && d.At <= scheduledFor);
+            if (change?.Reason == SchedulerReplayLog.DisabledReason)
+            {
+                fireTime.Outcome = SchedulerReplayOutcome.Skipped;
+                fireTime.Reason = $"Trigger disabled at {change.At:o}";
+                return;
+            }
+
+            if (change?.NextRunAt > scheduledFor)
+            {
+                fireTime.Outcome = SchedulerReplayOutcome.Skipped;
+                fireTime.Reason = $"Trigger {change.Reason} at {change.At:o}, next fire time {change.NextRunAt:o}";
+                return;
+            }
+
+            fireTime.Outcome = SchedulerReplayOutcome.Missed;
+            fireTime.Reason = fireTime.FirstDueAt.HasValue
+                ? $"Found due at {fireTime.FirstDueAt:o} but neither run nor skipped"
+                : "Never found due; no instance was firing or the trigger's next fire time was elsewhere";
+        }
+
+        private static HashSet<DateTime> GetExpectedFireTimes(ScheduleTrigger trigger, DateTime from, DateTime to)
+        {
+            var expected = new HashSet<DateTime>();
+            if (!trigger.Enabled || !CronSchedule.TryParse(trigger.CronExpression, out var schedule, out _))
+            {
+                return expected;
+            }
+
+            // The first run of a trigger is the first fire time after it was created
+            var after = trigger.CreatedAt > from ? trigger.CreatedAt : from.AddMinutes(-1);
+            for (var next = schedule.GetNextOccurrence(after); next.HasValue && next.Value < to && expected.Count < MaxFireTimesPerTrigger; next = schedule.GetNextOccurrence(next.Value))
+            {
+                if (next.Value >= from)
+                {
+                    expected.Add(next.Value);
+                }
+            }
+
+            return expected;
+        }
+    }
+}
@@ -0,0 +1,167 @@
+using System;
+using System.Collections.Concurrent;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Scheduling
+{
+    /// <summary>
+    /// Records every decision the cron scheduler makes, so missed or duplicate firings can be replayed later
+    /// </summary>
+    /// <remarks>
+    /// A trigger that stays due, or keeps being skipped for the same reason, is seen again on every poll; only the
+    /// first of those decisions is kept. Recording never fails the scheduler: a decision that cannot be stored is
+    /// logged and dropped.
+    /// </remarks>
+    public class SchedulerReplayLog
+    {
+        /// <summary>
+        /// Reason of the reschedule that sets a new trigger's first fire time
+        /// </summary>
+        public const string CreatedReason = "created";
+
+        /// <summary>
+        /// Reason of the reschedule after a trigger's cron expression changed
+        /// </summary>
+        public const string ScheduleChangedReason = "schedule changed";
+
+        /// <summary>
+        /// Reason of the reschedule after a trigger was enabled
+        /// </summary>
+        public const string EnabledReason = "enabled";
+
+        /// <summary>
+        /// Reason of the reschedule after a trigger was disabled
+        /// </summary>
+        public const string DisabledReason = "disabled";
+
+        /// <summary>
+        /// Reason of the reschedule after fire times were missed while no instance was firing
+        /// </summary>
+        public const string CoalescedReason = "missed fire times coalesced";
+
+        private readonly ILogger<SchedulerReplayLog> _logger;
+        private readonly ISchedulerDecisionRepository _repository;
+        private readonly SchedulerConfiguration _configuration;
+        private readonly ConcurrentDictionary<(Guid TriggerId, SchedulerDecisionKind Kind), (DateTime? ScheduledFor, string Reason)> _lastRepeatable =
+            new ConcurrentDictionary<(Guid, SchedulerDecisionKind), (DateTime?, string)>();
+        private readonly string _instanceId;
+        private long _sequence;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SchedulerReplayLog"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="repository">Scheduler decision repository</param>
+        /// <param name="configuration">Scheduler configuration</param>
+        /// <param name="leadership">Leadership service, whose instance ID is recorded with each decision</param>
+        public SchedulerReplayLog(
+            ILogger<SchedulerReplayLog> logger,
+            ISchedulerDecisionRepository repository,
+            IOptions<SchedulerConfiguration> configuration = null,
+            ILeadershipService leadership = null)
+        {
+            _logger = logger;
+            _repository = repository;
+            _configuration = configuration?.Value ?? new SchedulerConfiguration();
+            _instanceId = leadership?.InstanceId ?? $"{Environment.MachineName}:{Environment.ProcessId}";
+        }
+
+        /// <summary>
+        /// Gets a value indicating whether decisions are recorded
+        /// </summary>
+        public bool Enabled => _configuration.ReplayLogEnabled;
+
+        /// <summary>
+        /// Records a decision
+        /// </summary>
+        /// <param name="kind">Kind of decision</param>
+        /// <param name="trigger">Trigger the decision is about</param>
+        /// <param name="scheduledFor">Fire time the decision is about</param>
+        /// <param name="nextRunAt">Next fire time set by a reschedule</param>
+        /// <param name="reason">Why the fire time was skipped or the trigger rescheduled</param>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task RecordAsync(SchedulerDecisionKind kind, ScheduleTrigger trigger, DateTime? scheduledFor, DateTime? nextRunAt = null, string reason = null)
+        {
+            if (!Enabled)
+            {
+                return;
+            }
+
+            if (kind == SchedulerDecisionKind.Due || kind == SchedulerDecisionKind.Skip)
+            {
+                var key = (trigger.Id, kind);
+                var value = (scheduledFor, reason);
+                if (_lastRepeatable.TryGetValue(key, out var last) && last == value)
+                {
+                    return;
+                }
+
+                _lastRepeatable[key] = value;
+            }
+
+            var decision = new SchedulerDecision
+            {
+                Id = Guid.NewGuid(),
+                InstanceId = _instanceId,
+                Sequence = Interlocked.Increment(ref _sequence),
+                At = DateTime.UtcNow,
+                Kind = kind,
+                TriggerId = trigger.Id,
+                AccountId = trigger.AccountId,
+                ScheduledFor = scheduledFor,
+                NextRunAt = nextRunAt,
+                Reason = reason
+            };
+
+            try
+            {
+                await _repository.CreateAsync(decision);
+            }
+            catch (Exception ex)
+            {
+                _logger.LogWarning(ex, "Error logging scheduler decision {Kind} for trigger {TriggerId}", kind, trigger.Id);
+            }
+        }
+
+        /// <summary>
+        /// Gets the decisions made in a time window, in the order they were made
+        /// </summary>
+        /// <param name="from">Start of the window, inclusive</param>
+        /// <param name="to">End of the window, exclusive</param>
+        /// <param name="triggerId">Trigger the decisions must be about, or null for every trigger</param>
+        /// <returns>The decisions</returns>
+        public Task<IEnumerable<SchedulerDecision>> GetDecisionsAsync(DateTime from, DateTime to, Guid? triggerId = null)
+        {
+            return _repository.GetRangeAsync(from, to, triggerId);
+        }
+
+        /// <summary>
+        /// Removes decisions made before a cutoff
+        /// </summary>
+        /// <param name="cutoff">Time before which decisions are removed</param>
+        /// <param name="batchSize">Maximum number of decisions to remove</param>
+        /// <returns>The number of decisions removed</returns>
+        public async Task<int> PruneAsync(DateTime cutoff, int batchSize)
+        {
+            var expired = (await _repository.GetBeforeAsync(cutoff, batchSize)).ToList();
+            foreach (var decision in expired)
+            {
+                await _repository.DeleteAsync(decision.Id);
+            }
+
+            if (expired.Count > 0)
+            {
+                _logger.LogInformation("Pruned {Count} scheduler decisions made before {Cutoff}", expired.Count, cutoff);
+            }
+
+            return expired.Count;
+        }
+    }
+}
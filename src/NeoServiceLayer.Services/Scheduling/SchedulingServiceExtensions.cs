@@ -0,0 +1,35 @@
+using Microsoft.Extensions.DependencyInjection;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Services.Scheduling.Repositories;
+
+namespace NeoServiceLayer.Services.Scheduling
+{
+    /// <summary>
+    /// Extension methods for registering scheduling services
+    /// </summary>
+    public static class SchedulingServiceExtensions
+    {
+        /// <summary>
+        /// Adds scheduling services to the service collection
+        /// </summary>
+        /// <param name="services">Service collection</param>
+        /// <returns>Service collection</returns>
+        public static IServiceCollection AddSchedulingServices(this IServiceCollection services)
+        {
+            // Register repositories
+            services.AddSingleton<ITriggerFiringRepository, TriggerFiringRepository>();
+            services.AddSingleton<IServiceLeaseRepository, ServiceLeaseRepository>();
+            services.AddSingleton<IScheduleTriggerRepository, ScheduleTriggerRepository>();
+            services.AddSingleton<ISchedulerDecisionRepository, SchedulerDecisionRepository>();
+
+            // Register services
+            services.AddSingleton<TriggerFiringCoordinator>();
+            services.AddSingleton<TriggerFiringRetentionService>();
+            services.AddSingleton<SchedulerReplayLog>();
+            services.AddSingleton<ILeadershipService, LeadershipService>();
+            services.AddSingleton<IScheduleTriggerService, ScheduleTriggerService>();
+
+            return services;
+        }
+    }
+}
@@ -0,0 +1,235 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Security.Cryptography;
+using System.Text;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Scheduling
+{
+    /// <summary>
+    /// Ensures each scheduled firing of a trigger runs its action on at most one instance
+    /// </summary>
+    /// <remarks>
+    /// An instance claims the firing's idempotency token after deciding the trigger is due and before running the
+    /// action. Another instance that finds the trigger due afterwards, because the first one stopped or the
+    /// triggers were rebalanced, finds the token and does not run the action again.
+    /// </remarks>
+    public class TriggerFiringCoordinator
+    {
+        /// <summary>
+        /// The most firings a history page returns
+        /// </summary>
+        public const int MaxPageSize = 200;
+
+        private readonly ILogger<TriggerFiringCoordinator> _logger;
+        private readonly ITriggerFiringRepository _repository;
+        private readonly string _instanceId = $"{Environment.MachineName}:{Environment.ProcessId}";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="TriggerFiringCoordinator"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="repository">Trigger firing repository</param>
+        public TriggerFiringCoordinator(ILogger<TriggerFiringCoordinator> logger, ITriggerFiringRepository repository)
+        {
+            _logger = logger;
+            _repository = repository;
+        }
+
+        /// <summary>
+        /// Claims a scheduled firing
+        /// </summary>
+        /// <param name="triggerType">Kind of trigger, e.g. "report"</param>
+        /// <param name="triggerId">Trigger ID</param>
+        /// <param name="scheduledFor">Time the firing was scheduled for</param>
+        /// <param name="lease">How long a claim may stay unfinished before it is treated as abandoned</param>
+        /// <returns>The claim result</returns>
+        public async Task<TriggerFiringClaim> ClaimAsync(string triggerType, Guid triggerId, DateTime scheduledFor, TimeSpan lease)
+        {
+            var firing = new TriggerFiring
+            {
+                Id = ComputeToken(triggerType, triggerId, scheduledFor),
+                TriggerType = triggerType,
+                TriggerId = triggerId,
+                ScheduledFor = scheduledFor,
+                ClaimId = Guid.NewGuid(),
+                InstanceId = _instanceId,
+                Status = TriggerFiringStatus.Claimed,
+                ClaimedAt = DateTime.UtcNow
+            };
+
+            var stored = await _repository.CreateIfNotExistsAsync(firing);
+            if (stored.ClaimId == firing.ClaimId)
+            {
+                return new TriggerFiringClaim { Acquired = true, Firing = stored };
+            }
+
+            var abandoned = stored.Status == TriggerFiringStatus.Claimed && stored.ClaimedAt.Add(lease) < DateTime.UtcNow;
+            if (abandoned)
+            {
+                _logger.LogWarning("Firing of {TriggerType} {TriggerId} scheduled for {ScheduledFor} was claimed by {InstanceId} at {ClaimedAt} and never finished; it will not be run again",
+                    triggerType, triggerId, scheduledFor, stored.InstanceId, stored.ClaimedAt);
+            }
+            else
+            {
+                _logger.LogDebug("Firing of {TriggerType} {TriggerId} scheduled for {ScheduledFor} is already {Status} by {InstanceId}",
+                    triggerType, triggerId, scheduledFor, stored.Status, stored.InstanceId);
+            }
+
+            return new TriggerFiringClaim { Abandoned = abandoned, Firing = stored };
+        }
+
+        /// <summary>
+        /// Records the outcome of a claimed firing's action
+        /// </summary>
+        /// <param name="firing">The claimed firing</param>
+        /// <param name="succeeded">Whether the action succeeded</param>
+        /// <param name="error">Error of a failed action</param>
+        public async Task CompleteAsync(TriggerFiring firing, bool succeeded, string error = null)
+        {
+            firing.Status = succeeded ? TriggerFiringStatus.Completed : TriggerFiringStatus.Failed;
+            firing.CompletedAt = DateTime.UtcNow;
+            firing.Error = error;
+
+            try
+            {
+                await _repository.UpdateAsync(firing);
+            }
+            catch (Exception ex)
+            {
+                // The claim alone already prevents a second run
+                _logger.LogWarning(ex, "Error recording outcome of trigger firing {Id}", firing.Id);
+            }
+        }
+
+        /// <summary>
+        /// Gets a page of a trigger's firing history, newest first
+        /// </summary>
+        /// <param name="query">Page request</param>
+        /// <returns>The page</returns>
+        public Task<TriggerFiringPage> GetHistoryAsync(TriggerFiringQuery query)
+        {
+            if (query.Limit < 1 || query.Limit > MaxPageSize)
+            {
+                throw new ValidationException($"Limit must be between 1 and {MaxPageSize}");
+            }
+
+            if (query.From != null && query.To != null && query.From >= query.To)
+            {
+                throw new ValidationException("From must be before to");
+            }
+
+            return _repository.GetPageAsync(query);
+        }
+
+        /// <summary>
+        /// Gets the outcome counts of all of a trigger's firings, including those pruned from the history
+        /// </summary>
+        /// <param name="triggerType">Kind of trigger</param>
+        /// <param name="triggerId">Trigger ID</param>
+        /// <returns>The statistics</returns>
+        public async Task<TriggerFiringStatistics> GetStatisticsAsync(string triggerType, Guid triggerId)
+        {
+            var statistics = await _repository.GetStatisticsAsync(triggerId)
+                ?? new TriggerFiringStatistics { Id = triggerId, TriggerType = triggerType, TriggerId = triggerId };
+
+            // Firings up to the compaction point may still be stored if a prune was interrupted; they are counted already
+            var live = (await _repository.GetByTriggerIdAsync(triggerId))
+                .Where(f => statistics.CompactedThrough == null || f.ScheduledFor > statistics.CompactedThrough);
+            Accumulate(statistics, live);
+
+            return statistics;
+        }
+
+        /// <summary>
+        /// Removes firings scheduled before a cutoff from the history, adding them to their triggers' statistics first
+        /// </summary>
+        /// <param name="cutoff">Scheduled fire time before which firings are removed</param>
+        /// <param name="batchSize">Maximum number of firings to remove</param>
+        /// <returns>The number of firings removed</returns>
+        public async Task<int> PruneAsync(DateTime cutoff, int batchSize)
+        {
+            var expired = (await _repository.GetScheduledBeforeAsync(cutoff, batchSize)).ToList();
+
+            foreach (var group in expired.GroupBy(f => f.TriggerId))
+            {
+                var statistics = await _repository.GetStatisticsAsync(group.Key)
+                    ?? new TriggerFiringStatistics { TriggerType = group.First().TriggerType, TriggerId = group.Key };
+
+                // Counting before deleting means an interrupted prune leaves firings that are already counted,
+                // which the compaction point excludes the next time
+                var uncounted = group
+                    .Where(f => statistics.CompactedThrough == null || f.ScheduledFor > statistics.CompactedThrough)
+                    .ToList();
+                if (uncounted.Count > 0)
+                {
+                    Accumulate(statistics, uncounted);
+                    statistics.Compacted += uncounted.Count;
+                    statistics.CompactedThrough = uncounted.Max(f => f.ScheduledFor);
+                    await _repository.SaveStatisticsAsync(statistics);
+                }
+
+                foreach (var firing in group)
+                {
+                    await _repository.DeleteAsync(firing.Id);
+                }
+            }
+
+            if (expired.Count > 0)
+            {
+                _logger.LogInformation("Pruned {Count} trigger firings scheduled before {Cutoff}", expired.Count, cutoff);
+            }
+
+            return expired.Count;
+        }
+
+        private static void Accumulate(TriggerFiringStatistics statistics, IEnumerable<TriggerFiring> firings)
+        {
+            foreach (var firing in firings)
+            {
+                switch (firing.Status)
+                {
+                    case TriggerFiringStatus.Completed:
+                        statistics.Completed++;
+                        break;
+                    case TriggerFiringStatus.Failed:
+                        statistics.Failed++;
+                        break;
+                    default:
+                        statistics.Claimed++;
+                        break;
+                }
+
+                if (statistics.FirstScheduledFor == null || firing.ScheduledFor < statistics.FirstScheduledFor)
+                {
+                    statistics.FirstScheduledFor = firing.ScheduledFor;
+                }
+
+                if (statistics.LastScheduledFor == null || firing.ScheduledFor > statistics.LastScheduledFor)
+                {
+                    statistics.LastScheduledFor = firing.ScheduledFor;
+                }
+            }
+        }
+
+        /// <summary>
+        /// Computes the idempotency token of a firing
+        /// </summary>
+        /// <param name="triggerType">Kind of trigger</param>
+        /// <param name="triggerId">Trigger ID</param>
+        /// <param name="scheduledFor">Time the firing was scheduled for</param>
+        /// <returns>The first 16 bytes of SHA-256 over "type:id:ticks", with the fire time in UTC</returns>
+        public static Guid ComputeToken(string triggerType, Guid triggerId, DateTime scheduledFor)
+        {
+            var ticks = scheduledFor.Kind == DateTimeKind.Local ? scheduledFor.ToUniversalTime().Ticks : scheduledFor.Ticks;
+            var hash = SHA256.HashData(Encoding.UTF8.GetBytes($"{triggerType}:{triggerId:N}:{ticks}"));
+
+            return new Guid(hash.AsSpan(0, 16));
+        }
+    }
+}
@@ -0,0 +1,193 @@
+using System;
+using System.Collections.Generic;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Diagnostics;
+
+namespace NeoServiceLayer.Services.Scheduling
+{
+    /// <summary>
+    /// Prunes old trigger firings from the history, keeping their outcome counts in each trigger's statistics
+    /// </summary>
+    /// <remarks>
+    /// Only the leader prunes. Each cycle removes batches of the oldest expired firings until none are left or the
+    /// per-cycle batch limit is reached, so a large backlog is worked off over several cycles rather than at once.
+    /// The retention period should be far longer than the firing lease: a pruned token no longer stops a second
+    /// instance from running the same fire time. Decisions older than their own retention period are removed from the
+    /// scheduler's replay log in the same cycle.
+    /// </remarks>
+    public class TriggerFiringRetentionService : IManagedService, IDisposable
+    {
+        private const int MaxBatchesPerCycle = 10;
+
+        private readonly ILogger<TriggerFiringRetentionService> _logger;
+        private readonly TriggerFiringCoordinator _firingCoordinator;
+        private readonly SchedulerConfiguration _configuration;
+        private readonly ILeadershipService _leadership;
+        private readonly IMaintenanceService _maintenanceService;
+        private readonly ICrashReporter _crashReporter;
+        private readonly SchedulerReplayLog _replayLog;
+        private readonly SemaphoreSlim _cycleSemaphore = new SemaphoreSlim(1, 1);
+        private Timer _cycleTimer;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="TriggerFiringRetentionService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="firingCoordinator">Coordinator of firing idempotency tokens</param>
+        /// <param name="configuration">Scheduler configuration</param>
+        /// <param name="leadership">Leadership service; only the leader prunes</param>
+        /// <param name="maintenanceService">Maintenance service; nothing is pruned while maintenance is active</param>
+        /// <param name="crashReporter">Crash reporter for background work</param>
+        /// <param name="replayLog">Scheduler replay log pruned alongside the firings</param>
+        public TriggerFiringRetentionService(
+            ILogger<TriggerFiringRetentionService> logger,
+            TriggerFiringCoordinator firingCoordinator,
+            IOptions<SchedulerConfiguration> configuration = null,
+            ILeadershipService leadership = null,
+            IMaintenanceService maintenanceService = null,
+            ICrashReporter crashReporter = null,
+            SchedulerReplayLog replayLog = null)
+        {
+            _logger = logger;
+            _firingCoordinator = firingCoordinator;
+            _configuration = configuration?.Value ?? new SchedulerConfiguration();
+            _leadership = leadership;
+            _maintenanceService = maintenanceService;
+            _crashReporter = crashReporter;
+            _replayLog = replayLog;
+        }
+
+        /// <inheritdoc/>
+        public string Name => Constants.ManagedServices.TriggerFiringRetention;
+
+        /// <inheritdoc/>
+        public IReadOnlyCollection<string> DependsOn { get; } = new[] { Constants.ManagedServices.Leadership };
+
+        /// <summary>
+        /// Starts pruning on a timer, unless retention is disabled for both firings and the replay log
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public Task StartAsync()
+        {
+            if ((_configuration.FiringRetentionDays <= 0 && !PrunesReplayLog) || _cycleTimer != null)
+            {
+                return Task.CompletedTask;
+            }
+
+            _logger.LogInformation("Keeping trigger firings for {Days} days, pruning every {Interval} seconds",
+                _configuration.FiringRetentionDays, _configuration.FiringRetentionIntervalSeconds);
+
+            _cycleTimer = new Timer(
+                _crashReporter.Guard(_logger, "Scheduler.FiringRetention", RunCycleAsync),
+                null,
+                TimeSpan.FromSeconds(_configuration.FiringRetentionIntervalSeconds),
+                TimeSpan.FromSeconds(_configuration.FiringRetentionIntervalSeconds));
+
+            return Task.CompletedTask;
+        }
+
+        /// <summary>
+        /// Stops pruning
+        /// </summary>
+        /// <returns>A task that represents the asynchronous operation</returns>
+        public async Task StopAsync()
+        {
+            _cycleTimer?.Change(Timeout.Infinite, Timeout.Infinite);
+            _cycleTimer?.Dispose();
+            _cycleTimer = null;
+
+            // Let a cycle already running finish
+            await _cycleSemaphore.WaitAsync();
+            _cycleSemaphore.Release();
+        }
+
+        /// <inheritdoc/>
+        public ManagedServiceHealth GetHealth()
+        {
+            if (_configuration.FiringRetentionDays <= 0 && !PrunesReplayLog)
+            {
+                return ManagedServiceHealth.Of(Name, ManagedServiceStatus.Disabled);
+            }
+
+            return ManagedServiceHealth.Of(Name, _cycleTimer != null ? ManagedServiceStatus.Running : ManagedServiceStatus.Stopped);
+        }
+
+        /// <summary>
+        /// Prunes firings and replay log decisions older than their retention periods, if this instance is the leader
+        /// </summary>
+        /// <returns>The number of firings pruned</returns>
+        public async Task<int> RunCycleAsync()
+        {
+            if (!await _cycleSemaphore.WaitAsync(0))
+            {
+                return 0;
+            }
+
+            try
+            {
+                if (_leadership?.IsLeader == false)
+                {
+                    return 0;
+                }
+
+                if (_maintenanceService != null && await _maintenanceService.IsActiveAsync())
+                {
+                    return 0;
+                }
+
+                var batchSize = Math.Max(1, _configuration.FiringRetentionBatchSize);
+
+                if (PrunesReplayLog)
+                {
+                    var decisionCutoff = DateTime.UtcNow.AddDays(-_configuration.ReplayLogRetentionDays);
+                    for (var batch = 0; batch < MaxBatchesPerCycle; batch++)
+                    {
+                        if (await _replayLog.PruneAsync(decisionCutoff, batchSize) < batchSize)
+                        {
+                            break;
+                        }
+                    }
+                }
+
+                if (_configuration.FiringRetentionDays <= 0)
+                {
+                    return 0;
+                }
+
+                var cutoff = DateTime.UtcNow.AddDays(-_configuration.FiringRetentionDays);
+
+                var pruned = 0;
+                for (var batch = 0; batch < MaxBatchesPerCycle; batch++)
+                {
+                    var count = await _firingCoordinator.PruneAsync(cutoff, batchSize);
+                    pruned += count;
+                    if (count < batchSize)
+                    {
+                        break;
+                    }
+                }
+
+                return pruned;
+            }
+            finally
+            {
+                _cycleSemaphore.Release();
+            }
+        }
+
+        private bool PrunesReplayLog => _replayLog != null && _configuration.ReplayLogRetentionDays > 0;
+
+        /// <inheritdoc/>
+        public void Dispose()
+        {
+            _cycleTimer?.Dispose();
+            _cycleSemaphore.Dispose();
+        }
+    }
+}
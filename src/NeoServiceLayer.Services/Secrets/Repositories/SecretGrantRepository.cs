@@ -0,0 +1,71 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.Secrets.Repositories
+{
+    /// <summary>
+    /// Repository for secret grants
+    /// </summary>
+    public class SecretGrantRepository : ISecretGrantRepository
+    {
+        private readonly ILogger<SecretGrantRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private const string CollectionName = "secret_grants";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SecretGrantRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public SecretGrantRepository(ILogger<SecretGrantRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<SecretGrant> CreateAsync(SecretGrant grant)
+        {
+            ValidationUtility.ValidateNotNull(grant, nameof(grant));
+            ValidationUtility.ValidateGuid(grant.Id, "Grant ID");
+
+            _logger.LogInformation("Storing grant {Id} of secret {SecretId}", grant.Id, grant.SecretId);
+            await _storageProvider.CreateAsync(CollectionName, grant);
+
+            return grant;
+        }
+
+        /// <inheritdoc/>
+        public Task<SecretGrant> GetByIdAsync(Guid id)
+        {
+            return _storageProvider.GetByIdAsync<SecretGrant, Guid>(CollectionName, id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<SecretGrant>> GetBySecretIdAsync(Guid secretId)
+        {
+            var grants = await _storageProvider.GetByFilterAsync<SecretGrant>(CollectionName, g => g.SecretId == secretId);
+            return grants.OrderByDescending(g => g.CreatedAt).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<SecretGrant>> GetByGranteeAccountIdAsync(Guid accountId)
+        {
+            var grants = await _storageProvider.GetByFilterAsync<SecretGrant>(CollectionName, g => g.GranteeAccountId == accountId);
+            return grants.OrderByDescending(g => g.CreatedAt).ToList();
+        }
+
+        /// <inheritdoc/>
+        public Task<bool> DeleteAsync(Guid id)
+        {
+            _logger.LogInformation("Deleting secret grant {Id}", id);
+            return _storageProvider.DeleteAsync<SecretGrant, Guid>(CollectionName, id);
+        }
+    }
+}
@@ -0,0 +1,212 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using FunctionRepositories = NeoServiceLayer.Services.Function.Repositories;
+
+namespace NeoServiceLayer.Services.Secrets
+{
+    /// <summary>
+    /// Shares secrets with functions and other users through grants
+    /// </summary>
+    /// <remarks>
+    /// A secret is readable by its owner's functions listed in its allowed functions, as before. A grant adds one
+    /// function, whoever owns it, or every function of another user. Either way the function still has to list the
+    /// secret among its secret IDs, and only active grants count, so expiring or revoking a grant cuts off the next
+    /// execution. Grants never expose the value through the API; it is only unsealed inside the enclave.
+    /// </remarks>
+    public class SecretGrantService : ISecretGrantService
+    {
+        private readonly ILogger<SecretGrantService> _logger;
+        private readonly ISecretGrantRepository _grantRepository;
+        private readonly ISecretsService _secretsService;
+        private readonly FunctionRepositories.IFunctionRepository _functionRepository;
+        private readonly IAccountService _accountService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SecretGrantService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="grantRepository">Secret grant repository</param>
+        /// <param name="secretsService">Secrets service</param>
+        /// <param name="functionRepository">Function repository</param>
+        /// <param name="accountService">Account service, used to find users by Neo address</param>
+        public SecretGrantService(
+            ILogger<SecretGrantService> logger,
+            ISecretGrantRepository grantRepository,
+            ISecretsService secretsService,
+            FunctionRepositories.IFunctionRepository functionRepository,
+            IAccountService accountService)
+        {
+            _logger = logger;
+            _grantRepository = grantRepository;
+            _secretsService = secretsService;
+            _functionRepository = functionRepository;
+            _accountService = accountService;
+        }
+
+        /// <inheritdoc/>
+        public async Task<SecretGrant> GrantToFunctionAsync(Guid secretId, Guid functionId, DateTime? expiresAt = null)
+        {
+            var secret = await GetSecretAsync(secretId);
+            ValidateExpiry(expiresAt);
+
+            var function = await _functionRepository.GetByIdAsync(functionId);
+            if (function == null)
+            {
+                throw new ResourceNotFoundException("Function", functionId.ToString());
+            }
+
+            var grants = await _grantRepository.GetBySecretIdAsync(secretId);
+            if (grants.Any(g => g.GranteeType == SecretGranteeType.Function && g.GranteeFunctionId == functionId && g.IsActive(DateTime.UtcNow)))
+            {
+                throw new ValidationException("The function already has an active grant of this secret");
+            }
+
+            return await CreateAsync(new SecretGrant
+            {
+                Id = Guid.NewGuid(),
+                SecretId = secret.Id,
+                SecretName = secret.Name,
+                OwnerAccountId = secret.AccountId,
+                GranteeType = SecretGranteeType.Function,
+                GranteeFunctionId = function.Id,
+                GranteeAccountId = function.AccountId,
+                ExpiresAt = expiresAt,
+                CreatedAt = DateTime.UtcNow
+            });
+        }
+
+        /// <inheritdoc/>
+        public async Task<SecretGrant> GrantToAddressAsync(Guid secretId, string neoAddress, DateTime? expiresAt = null)
+        {
+            var secret = await GetSecretAsync(secretId);
+            ValidateExpiry(expiresAt);
+
+            if (string.IsNullOrWhiteSpace(neoAddress))
+            {
+                throw new ValidationException("A Neo address is required");
+            }
+
+            var account = await _accountService.GetByNeoAddressAsync(neoAddress.Trim());
+            if (account == null)
+            {
+                throw new ResourceNotFoundException($"No user has the Neo address {neoAddress}");
+            }
+
+            if (account.Id == secret.AccountId)
+            {
+                throw new ValidationException("A secret cannot be granted to its owner");
+            }
+
+            var grants = await _grantRepository.GetBySecretIdAsync(secretId);
+            if (grants.Any(g => g.GranteeType == SecretGranteeType.Account && g.GranteeAccountId == account.Id && g.IsActive(DateTime.UtcNow)))
+            {
+                throw new ValidationException("The user already has an active grant of this secret");
+            }
+
+            return await CreateAsync(new SecretGrant
+            {
+                Id = Guid.NewGuid(),
+                SecretId = secret.Id,
+                SecretName = secret.Name,
+                OwnerAccountId = secret.AccountId,
+                GranteeType = SecretGranteeType.Account,
+                GranteeAccountId = account.Id,
+                GranteeAddress = account.NeoAddress,
+                ExpiresAt = expiresAt,
+                CreatedAt = DateTime.UtcNow
+            });
+        }
+
+        /// <inheritdoc/>
+        public Task<SecretGrant> GetByIdAsync(Guid id)
+        {
+            return _grantRepository.GetByIdAsync(id);
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<SecretGrant>> GetBySecretIdAsync(Guid secretId)
+        {
+            return _grantRepository.GetBySecretIdAsync(secretId);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<SecretGrant>> GetSharedWithAccountAsync(Guid accountId)
+        {
+            var now = DateTime.UtcNow;
+            var grants = await _grantRepository.GetByGranteeAccountIdAsync(accountId);
+
+            // Grants to the user's own functions are not sharing
+            return grants.Where(g => g.OwnerAccountId != accountId && g.IsActive(now)).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> RevokeAsync(Guid id)
+        {
+            var grant = await _grantRepository.GetByIdAsync(id);
+            if (grant == null)
+            {
+                return false;
+            }
+
+            _logger.LogInformation("Revoking grant {Id} of secret {SecretId} from account {GranteeAccountId}", id, grant.SecretId, grant.GranteeAccountId);
+            return await _grantRepository.DeleteAsync(id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> CanFunctionReadAsync(Secret secret, Core.Models.Function function)
+        {
+            var now = DateTime.UtcNow;
+            if (secret == null || function == null || (secret.ExpiresAt.HasValue && secret.ExpiresAt.Value < now))
+            {
+                return false;
+            }
+
+            if (secret.AccountId == function.AccountId && secret.AllowedFunctionIds?.Contains(function.Id) == true)
+            {
+                return true;
+            }
+
+            var grants = await _grantRepository.GetBySecretIdAsync(secret.Id);
+            return grants.Any(g => g.IsActive(now) && (
+                (g.GranteeType == SecretGranteeType.Function && g.GranteeFunctionId == function.Id) ||
+                (g.GranteeType == SecretGranteeType.Account && g.GranteeAccountId == function.AccountId)));
+        }
+
+        private async Task<Secret> GetSecretAsync(Guid secretId)
+        {
+            var secret = await _secretsService.GetByIdAsync(secretId);
+            if (secret == null)
+            {
+                throw new ResourceNotFoundException("Secret", secretId.ToString());
+            }
+
+            return secret;
+        }
+
+        private static void ValidateExpiry(DateTime? expiresAt)
+        {
+            if (expiresAt.HasValue && expiresAt.Value <= DateTime.UtcNow)
+            {
+                throw new ValidationException("Expiration date must be in the future");
+            }
+        }
+
+        private async Task<SecretGrant> CreateAsync(SecretGrant grant)
+        {
+            _logger.LogInformation(
+                "Granting secret {SecretId} to {GranteeType} {Grantee} until {ExpiresAt}",
+                grant.SecretId,
+                grant.GranteeType,
+                grant.GranteeFunctionId?.ToString() ?? grant.GranteeAddress,
+                grant.ExpiresAt?.ToString("o") ?? "revoked");
+
+            return await _grantRepository.CreateAsync(grant);
+        }
+    }
+}
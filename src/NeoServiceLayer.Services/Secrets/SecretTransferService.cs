@@ -0,0 +1,279 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Secrets.Repositories;
+
+namespace NeoServiceLayer.Services.Secrets
+{
+    /// <summary>
+    /// Moves secrets between environments, such as from staging to production
+    /// </summary>
+    /// <remarks>
+    /// Export hands the sealed values to the enclave, which unseals them and encrypts them to the target
+    /// environment's transfer key; import hands the bundle to the target's enclave, which decrypts it and seals the
+    /// values again. The host only ever sees names, descriptions and ciphertext, so plaintext values never leave an
+    /// enclave. The enclave refuses to export to a key that is not the transfer key of its own environment or of an
+    /// environment in its trusted list. Allowed functions and grants are environment specific and are not transferred.
+    /// Export and import are protected operations, so the enclave manager attaches a capability token to both.
+    /// </remarks>
+    public class SecretTransferService : ISecretTransferService
+    {
+        private readonly ILogger<SecretTransferService> _logger;
+        private readonly ISecretsRepository _secretsRepository;
+        private readonly IEnclaveService _enclaveService;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SecretTransferService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="secretsRepository">Secrets repository</param>
+        /// <param name="enclaveService">Enclave service</param>
+        public SecretTransferService(ILogger<SecretTransferService> logger, ISecretsRepository secretsRepository, IEnclaveService enclaveService)
+        {
+            _logger = logger;
+            _secretsRepository = secretsRepository;
+            _enclaveService = enclaveService;
+        }
+
+        /// <inheritdoc/>
+        public Task<SecretTransferKey> GetTransferKeyAsync()
+        {
+            return _enclaveService.SendRequestAsync<object, SecretTransferKey>(
+                Constants.EnclaveServiceTypes.Secrets,
+                Constants.SecretsOperations.GetTransferKey,
+                new { });
+        }
+
+        /// <inheritdoc/>
+        public async Task<SecretTransferBundle> ExportAsync(Guid accountId, string recipientPublicKey, IEnumerable<string> names = null)
+        {
+            if (string.IsNullOrWhiteSpace(recipientPublicKey))
+            {
+                throw new ValidationException("The recipient environment's transfer key is required");
+            }
+
+            var secrets = (await _secretsRepository.GetByAccountIdAsync(accountId)).ToList();
+            var requested = names?.Where(n => !string.IsNullOrWhiteSpace(n)).Distinct(StringComparer.Ordinal).ToList();
+            if (requested != null && requested.Count > 0)
+            {
+                var byName = secrets
+                    .GroupBy(s => s.Name, StringComparer.Ordinal)
+                    .ToDictionary(g => g.Key, g => g.First(), StringComparer.Ordinal);
+
+                var missing = requested.FirstOrDefault(n => !byName.ContainsKey(n));
+                if (missing != null)
+                {
+                    throw new ResourceNotFoundException($"Secret {missing} does not exist");
+                }
+
+                secrets = requested.Select(n => byName[n]).ToList();
+            }
+
+            if (secrets.Count == 0)
+            {
+                throw new ValidationException("There are no secrets to export");
+            }
+
+            _logger.LogInformation("Exporting {Count} secrets, AccountId: {AccountId}", secrets.Count, accountId);
+
+            try
+            {
+                return await _enclaveService.SendRequestAsync<SecretExportRequest, SecretTransferBundle>(
+                    Constants.EnclaveServiceTypes.Secrets,
+                    Constants.SecretsOperations.ExportSecrets,
+                    new SecretExportRequest
+                    {
+                        AccountId = accountId,
+                        RecipientPublicKey = recipientPublicKey.Trim(),
+                        Secrets = secrets
+                    });
+            }
+            catch (Exception ex)
+            {
+                // The enclave only exports to the transfer keys of this environment and the environments it trusts
+                _logger.LogWarning(ex, "Enclave refused to export secrets, AccountId: {AccountId}", accountId);
+                throw new SecretsException($"The secrets could not be exported: {ex.Message}", ex);
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<SecretImportResult> ImportAsync(Guid accountId, SecretTransferBundle bundle, bool overwrite = false)
+        {
+            if (bundle == null || bundle.Names == null || bundle.Names.Count == 0)
+            {
+                throw new ValidationException("The bundle contains no secrets");
+            }
+
+            if (bundle.Names.Any(n => string.IsNullOrWhiteSpace(n) || n.Length < 3 || n.Length > 100))
+            {
+                throw new ValidationException("Secret names must be between 3 and 100 characters");
+            }
+
+            if (bundle.Names.Distinct(StringComparer.Ordinal).Count() != bundle.Names.Count)
+            {
+                throw new ValidationException("The bundle contains a secret name more than once");
+            }
+
+            var existingSecrets = (await _secretsRepository.GetByAccountIdAsync(accountId)).ToList();
+            var existingByName = existingSecrets
+                .GroupBy(s => s.Name, StringComparer.Ordinal)
+                .ToDictionary(g => g.Key, g => g.First(), StringComparer.Ordinal);
+
+            var conflicts = bundle.Names.Where(existingByName.ContainsKey).ToList();
+            if (conflicts.Count > 0 && !overwrite)
+            {
+                throw new SecretsException($"Secrets already exist: {string.Join(", ", conflicts)}; import with overwrite to update them");
+            }
+
+            var newCount = bundle.Names.Count - conflicts.Count;
+            if (existingSecrets.Count + newCount > Constants.SecretsConfig.MaxSecretsPerAccount)
+            {
+                throw new SecretsException($"Account secret quota of {Constants.SecretsConfig.MaxSecretsPerAccount} would be exceeded");
+            }
+
+            // Existing secrets keep their IDs so functions and grants that reference them keep working
+            var secretIds = bundle.Names.ToDictionary(
+                n => n,
+                n => existingByName.TryGetValue(n, out var existing) ? existing.Id : Guid.NewGuid(),
+                StringComparer.Ordinal);
+
+            List<SecretImportEntry> entries;
+            try
+            {
+                entries = await _enclaveService.SendRequestAsync<SecretImportRequest, List<SecretImportEntry>>(
+                    Constants.EnclaveServiceTypes.Secrets,
+                    Constants.SecretsOperations.ImportSecrets,
+                    new SecretImportRequest
+                    {
+                        AccountId = accountId,
+                        Bundle = bundle,
+                        SecretIds = secretIds
+                    });
+            }
+            catch (Exception ex)
+            {
+                _logger.LogWarning(ex, "Enclave rejected secrets bundle for key {RecipientKeyId}, AccountId: {AccountId}", bundle.RecipientKeyId, accountId);
+                throw new SecretsException($"The bundle could not be imported: {ex.Message}", ex);
+            }
+
+            var result = new SecretImportResult();
+            var created = new List<Secret>();
+            var snapshots = new List<Secret>();
+
+            try
+            {
+                foreach (var entry in entries)
+                {
+                    var now = DateTime.UtcNow;
+                    Secret secret;
+
+                    if (existingByName.TryGetValue(entry.Name, out var existing))
+                    {
+                        snapshots.Add(CloneSecret(existing));
+
+                        existing.EncryptedValue = entry.EncryptedValue;
+                        existing.Description = entry.Description ?? existing.Description;
+                        existing.ExpiresAt = entry.ExpiresAt;
+                        existing.Version++;
+                        existing.Revision++;
+                        existing.UpdatedAt = now;
+
+                        secret = await _secretsRepository.UpdateAsync(existing);
+                    }
+                    else
+                    {
+                        secret = new Secret
+                        {
+                            Id = entry.SecretId,
+                            Name = entry.Name,
+                            Description = entry.Description,
+                            EncryptedValue = entry.EncryptedValue,
+                            Version = 1,
+                            AccountId = accountId,
+                            AllowedFunctionIds = new List<Guid>(),
+                            CreatedAt = now,
+                            UpdatedAt = now,
+                            ExpiresAt = entry.ExpiresAt
+                        };
+
+                        await _secretsRepository.CreateAsync(secret);
+                        created.Add(secret);
+                    }
+
+                    result.Items.Add(new SecretImportItem
+                    {
+                        Name = secret.Name,
+                        SecretId = secret.Id,
+                        Action = existing != null ? "Updated" : "Created",
+                        Version = secret.Version
+                    });
+                }
+            }
+            catch (Exception ex)
+            {
+                _logger.LogError(ex, "Error storing imported secrets, rolling back, AccountId: {AccountId}", accountId);
+                await RollbackAsync(created, snapshots);
+                throw new SecretsException("Error importing secrets", ex);
+            }
+
+            _logger.LogInformation(
+                "Imported {Count} secrets from bundle exported at {ExportedAt}, AccountId: {AccountId}",
+                result.Items.Count,
+                bundle.ExportedAt,
+                accountId);
+
+            return result;
+        }
+
+        private async Task RollbackAsync(List<Secret> created, List<Secret> snapshots)
+        {
+            foreach (var secret in created)
+            {
+                try
+                {
+                    await _secretsRepository.DeleteAsync(secret.Id);
+                }
+                catch (Exception ex)
+                {
+                    _logger.LogError(ex, "Error rolling back imported secret: {Id}", secret.Id);
+                }
+            }
+
+            foreach (var snapshot in snapshots)
+            {
+                try
+                {
+                    await _secretsRepository.UpdateAsync(snapshot);
+                }
+                catch (Exception ex)
+                {
+                    _logger.LogError(ex, "Error rolling back updated secret: {Id}", snapshot.Id);
+                }
+            }
+        }
+
+        private static Secret CloneSecret(Secret secret)
+        {
+            return new Secret
+            {
+                Id = secret.Id,
+                Name = secret.Name,
+                Description = secret.Description,
+                EncryptedValue = secret.EncryptedValue,
+                Version = secret.Version,
+                AccountId = secret.AccountId,
+                AllowedFunctionIds = secret.AllowedFunctionIds != null ? new List<Guid>(secret.AllowedFunctionIds) : null,
+                CreatedAt = secret.CreatedAt,
+                UpdatedAt = secret.UpdatedAt,
+                Revision = secret.Revision,
+                ExpiresAt = secret.ExpiresAt
+            };
+        }
+    }
+}
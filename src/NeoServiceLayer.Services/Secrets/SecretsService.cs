@@ -1,5 +1,7 @@
 using System;
 using System.Collections.Generic;
+using System.Linq;
+using System.Text;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
 using NeoServiceLayer.Core;
@@ -74,6 +76,147 @@ namespace NeoServiceLayer.Services.Secrets
             }
         }
 
+        /// <inheritdoc/>
+        public async Task<BulkSecretResult> BulkUpsertAsync(List<BulkSecretItem> items, Guid accountId)
+        {
+            _logger.LogInformation("Bulk provisioning {Count} secrets, AccountId: {AccountId}", items?.Count ?? 0, accountId);
+
+            if (items == null || items.Count == 0)
+            {
+                throw new SecretsException("At least one secret is required");
+            }
+
+            if (items.Count > Constants.SecretsConfig.MaxBulkItems)
+            {
+                throw new SecretsException($"A bulk request may contain at most {Constants.SecretsConfig.MaxBulkItems} secrets");
+            }
+
+            var existingSecrets = (await _secretsRepository.GetByAccountIdAsync(accountId)).ToList();
+            var existingByName = existingSecrets
+                .GroupBy(s => s.Name, StringComparer.Ordinal)
+                .ToDictionary(g => g.Key, g => g.First(), StringComparer.Ordinal);
+
+            var result = new BulkSecretResult();
+            foreach (var item in items)
+            {
+                result.Items.Add(new BulkSecretItemResult
+                {
+                    Name = item?.Name,
+                    Action = item?.Name != null && existingByName.ContainsKey(item.Name) ? "Updated" : "Created",
+                    Error = ValidateBulkItem(item)
+                });
+            }
+
+            // Reject names that appear more than once in the same request
+            foreach (var group in result.Items.Where(r => r.Error == null).GroupBy(r => r.Name).Where(g => g.Count() > 1))
+            {
+                foreach (var duplicate in group)
+                {
+                    duplicate.Error = "Secret name appears more than once in the request";
+                }
+            }
+
+            // Check the account quota against the secrets that would be created
+            var newCount = result.Items.Count(r => r.Action == "Created");
+            if (existingSecrets.Count + newCount > Constants.SecretsConfig.MaxSecretsPerAccount)
+            {
+                foreach (var itemResult in result.Items.Where(r => r.Action == "Created" && r.Error == null))
+                {
+                    itemResult.Error = $"Account secret quota of {Constants.SecretsConfig.MaxSecretsPerAccount} would be exceeded";
+                }
+            }
+
+            if (result.Items.Any(r => r.Error != null))
+            {
+                foreach (var itemResult in result.Items)
+                {
+                    itemResult.Action = "None";
+                    itemResult.Error ??= "Not applied because other secrets in the request failed validation";
+                }
+
+                _logger.LogWarning("Bulk secret provisioning rejected during validation, AccountId: {AccountId}", accountId);
+                return result;
+            }
+
+            // Apply all items, remembering how to undo each one
+            var created = new List<Secret>();
+            var snapshots = new List<Secret>();
+
+            for (var i = 0; i < items.Count; i++)
+            {
+                var item = items[i];
+                var itemResult = result.Items[i];
+
+                try
+                {
+                    Secret secret;
+                    if (existingByName.TryGetValue(item.Name, out var existing))
+                    {
+                        snapshots.Add(CloneSecret(existing));
+
+                        var updatedSecret = await _enclaveService.SendRequestAsync<SecretUpdateValueRequest, Secret>(
+                            Constants.EnclaveServiceTypes.Secrets,
+                            Constants.SecretsOperations.UpdateSecretValue,
+                            new SecretUpdateValueRequest { Id = existing.Id, Value = item.Value });
+
+                        existing.Version = updatedSecret.Version;
+                        existing.EncryptedValue = updatedSecret.EncryptedValue;
+                        existing.Description = item.Description ?? existing.Description;
+                        existing.AllowedFunctionIds = item.AllowedFunctionIds ?? existing.AllowedFunctionIds;
+                        existing.ExpiresAt = item.ExpiresAt ?? existing.ExpiresAt;
+                        existing.UpdatedAt = DateTime.UtcNow;
+                        existing.Revision++;
+
+                        secret = await _secretsRepository.UpdateAsync(existing);
+                    }
+                    else
+                    {
+                        secret = await _enclaveService.SendRequestAsync<SecretCreateRequest, Secret>(
+                            Constants.EnclaveServiceTypes.Secrets,
+                            Constants.SecretsOperations.CreateSecret,
+                            new SecretCreateRequest
+                            {
+                                Name = item.Name,
+                                Value = item.Value,
+                                Description = item.Description,
+                                AccountId = accountId,
+                                AllowedFunctionIds = item.AllowedFunctionIds ?? new List<Guid>(),
+                                ExpiresAt = item.ExpiresAt
+                            });
+
+                        await _secretsRepository.CreateAsync(secret);
+                        created.Add(secret);
+                    }
+
+                    itemResult.SecretId = secret.Id;
+                    itemResult.Version = secret.Version;
+                    itemResult.Success = true;
+                }
+                catch (Exception ex)
+                {
+                    _logger.LogError(ex, "Error applying secret {Name} in bulk request, rolling back", item.Name);
+
+                    itemResult.Error = "Error applying secret";
+                    await RollbackBulkAsync(created, snapshots, accountId);
+
+                    foreach (var other in result.Items)
+                    {
+                        other.Success = false;
+                        other.SecretId = other.Action == "Created" ? null : other.SecretId;
+                        other.Version = null;
+                        other.Error ??= "Rolled back because another secret in the request failed";
+                    }
+
+                    result.RolledBack = true;
+                    return result;
+                }
+            }
+
+            result.Success = true;
+            _logger.LogInformation("Bulk provisioned {Count} secrets successfully, AccountId: {AccountId}", items.Count, accountId);
+            return result;
+        }
+
         /// <inheritdoc/>
         public async Task<Secret> GetByIdAsync(Guid id)
         {
@@ -138,6 +281,7 @@ namespace NeoServiceLayer.Services.Secrets
                 secret.Version = updatedSecret.Version;
                 secret.EncryptedValue = updatedSecret.EncryptedValue;
                 secret.UpdatedAt = DateTime.UtcNow;
+                secret.Revision++;
 
                 await _secretsRepository.UpdateAsync(secret);
 
@@ -164,6 +308,7 @@ namespace NeoServiceLayer.Services.Secrets
 
             secret.AllowedFunctionIds = allowedFunctionIds;
             secret.UpdatedAt = DateTime.UtcNow;
+            secret.Revision++;
 
             await _secretsRepository.UpdateAsync(secret);
 
@@ -238,6 +383,7 @@ namespace NeoServiceLayer.Services.Secrets
                 secret.Version = rotatedSecret.Version;
                 secret.EncryptedValue = rotatedSecret.EncryptedValue;
                 secret.UpdatedAt = DateTime.UtcNow;
+                secret.Revision++;
 
                 await _secretsRepository.UpdateAsync(secret);
 
@@ -310,5 +456,89 @@ namespace NeoServiceLayer.Services.Secrets
                 return false;
             }
         }
+
+        private static string ValidateBulkItem(BulkSecretItem item)
+        {
+            if (item == null)
+            {
+                return "Secret is required";
+            }
+
+            if (string.IsNullOrWhiteSpace(item.Name) || item.Name.Length < 3 || item.Name.Length > 100)
+            {
+                return "Name must be between 3 and 100 characters";
+            }
+
+            if (string.IsNullOrEmpty(item.Value))
+            {
+                return "Value is required";
+            }
+
+            if (Encoding.UTF8.GetByteCount(item.Value) > Constants.SecretsConfig.MaxValueSizeBytes)
+            {
+                return $"Value exceeds the maximum size of {Constants.SecretsConfig.MaxValueSizeBytes} bytes";
+            }
+
+            if (item.Description != null && item.Description.Length > 500)
+            {
+                return "Description must be at most 500 characters";
+            }
+
+            if (item.ExpiresAt.HasValue && item.ExpiresAt.Value <= DateTime.UtcNow)
+            {
+                return "Expiration date must be in the future";
+            }
+
+            return null;
+        }
+
+        private async Task RollbackBulkAsync(List<Secret> created, List<Secret> snapshots, Guid accountId)
+        {
+            foreach (var secret in created)
+            {
+                try
+                {
+                    await _secretsRepository.DeleteAsync(secret.Id);
+                    await _enclaveService.SendRequestAsync<object, object>(
+                        Constants.EnclaveServiceTypes.Secrets,
+                        Constants.SecretsOperations.DeleteSecret,
+                        new { SecretId = secret.Id, AccountId = accountId });
+                }
+                catch (Exception ex)
+                {
+                    _logger.LogError(ex, "Error rolling back created secret: {Id}", secret.Id);
+                }
+            }
+
+            foreach (var snapshot in snapshots)
+            {
+                try
+                {
+                    await _secretsRepository.UpdateAsync(snapshot);
+                }
+                catch (Exception ex)
+                {
+                    _logger.LogError(ex, "Error rolling back updated secret: {Id}", snapshot.Id);
+                }
+            }
+        }
+
+        private static Secret CloneSecret(Secret secret)
+        {
+            return new Secret
+            {
+                Id = secret.Id,
+                Name = secret.Name,
+                Description = secret.Description,
+                EncryptedValue = secret.EncryptedValue,
+                Version = secret.Version,
+                AccountId = secret.AccountId,
+                AllowedFunctionIds = secret.AllowedFunctionIds != null ? new List<Guid>(secret.AllowedFunctionIds) : null,
+                CreatedAt = secret.CreatedAt,
+                UpdatedAt = secret.UpdatedAt,
+                Revision = secret.Revision,
+                ExpiresAt = secret.ExpiresAt
+            };
+        }
     }
 }
@@ -18,9 +18,12 @@ namespace NeoServiceLayer.Services.Secrets
         {
             // Register repositories
             services.AddSingleton<ISecretsRepository, SecretsRepository>();
+            services.AddSingleton<ISecretGrantRepository, SecretGrantRepository>();
 
             // Register services
             services.AddSingleton<ISecretsService, SecretsService>();
+            services.AddSingleton<ISecretGrantService, SecretGrantService>();
+            services.AddSingleton<ISecretTransferService, SecretTransferService>();
 
             return services;
         }
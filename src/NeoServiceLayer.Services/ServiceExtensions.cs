@@ -1,14 +1,29 @@
 using Microsoft.Extensions.Configuration;
 using Microsoft.Extensions.DependencyInjection;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
 using NeoServiceLayer.Services.Account;
 using NeoServiceLayer.Services.Analytics;
+using NeoServiceLayer.Services.Automation;
+using NeoServiceLayer.Services.Blockchain;
 using NeoServiceLayer.Services.Deployment;
+using NeoServiceLayer.Services.Diagnostics;
 using NeoServiceLayer.Services.Enclave;
+using NeoServiceLayer.Services.Encryption;
 using NeoServiceLayer.Services.EventMonitoring;
+using NeoServiceLayer.Services.Events;
 using NeoServiceLayer.Services.Function;
+using NeoServiceLayer.Services.GasBank;
+using NeoServiceLayer.Services.JobSpecs;
+using NeoServiceLayer.Services.Logging;
+using NeoServiceLayer.Services.Maintenance;
 using NeoServiceLayer.Services.Metrics;
 using NeoServiceLayer.Services.Notification;
 using NeoServiceLayer.Services.PriceFeed;
+using NeoServiceLayer.Services.Provenance;
+using NeoServiceLayer.Services.Quorum;
+using NeoServiceLayer.Services.Recovery;
+using NeoServiceLayer.Services.Scheduling;
 using NeoServiceLayer.Services.Secrets;
 using NeoServiceLayer.Services.Storage;
 using NeoServiceLayer.Services.Wallet;
@@ -32,25 +47,61 @@ namespace NeoServiceLayer.Services
             services.AddStorageServices(configuration);
             services.AddDatabaseServices(configuration);
 
+            // Add crash reporting so background work in other services is covered
+            services.AddDiagnosticsServices();
+
+            // Add the server event hub that services publish to
+            services.AddServerEventServices();
+
             // Add enclave service as it's a dependency for other services
             services.AddEnclaveServices();
 
+            // Add per-account data keys used to encrypt execution data at rest
+            services.AddEncryptionServices();
+
+            // Add blockchain client
+            services.AddBlockchainServices();
+
             // Add core services
             services.AddAccountServices();
             services.AddWalletServices();
             services.AddSecretsServices();
             services.AddFunctionServices();
 
+            // Add quorum execution of critical functions across independent nodes
+            services.AddQuorumServices(configuration);
+            services.AddGasBankServices(configuration);
+
+            // Add maintenance mode, which queues function invocations and pauses triggers
+            services.AddMaintenanceServices();
+
+            // Add idempotency tokens so a scheduled firing runs on one instance only
+            services.AddSchedulingServices();
+
+            // Add contract upkeeps paid from GasBank accounts
+            services.AddAutomationServices();
+
+            // Add import of Chainlink job specs as functions, triggers and upkeeps
+            services.AddJobSpecServices();
+
             // Add monitoring and analytics services
             services.AddEventMonitoringServices();
-            services.AddMetricsServices();
+            services.AddMetricsServices(configuration);
             services.AddAnalyticsServices();
             services.AddPriceFeedServices();
             services.AddNotificationServices();
+            services.AddLogAlertServices(configuration);
 
             // Add deployment services
             services.AddDeploymentServices();
 
+            // Add build provenance, reported by GET /version
+            services.AddSingleton<IProvenanceService, ProvenanceService>();
+            services.Configure<ProvenanceConfiguration>(options => configuration.GetSection("Provenance").Bind(options));
+
+            // Add startup recovery, which runs the recovery steps registered above
+            services.AddStartupRecovery();
+
             return services;
         }
     }
@@ -0,0 +1,56 @@
+using System;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Services.Storage.Providers;
+
+namespace NeoServiceLayer.Services.Storage.Migration.Migrations
+{
+    /// <summary>
+    /// Migration to index the tags of functions and triggers
+    /// </summary>
+    public class Migration_003_AddTagIndexes : BaseMigration
+    {
+        /// <inheritdoc/>
+        public override string Name => "AddTagIndexes";
+
+        /// <inheritdoc/>
+        public override int Version => 3;
+
+        /// <inheritdoc/>
+        public override string Description => "Adds indexes on the tags of functions, event subscriptions and webhook triggers";
+
+        /// <inheritdoc/>
+        public override async Task UpAsync(IStorageProvider provider)
+        {
+            // Only MongoDB provider supports indexes; tag arrays get multikey indexes
+            if (provider is MongoDbStorageProvider mongoProvider)
+            {
+                await mongoProvider.CreateIndexAsync("functions", "Tags", false);
+                await mongoProvider.CreateIndexAsync("event_subscriptions", "Tags", false);
+                await mongoProvider.CreateIndexAsync("webhook_triggers", "Tags", false);
+            }
+            else
+            {
+                // Skip for other providers
+                Console.WriteLine("Skipping tag index creation for non-MongoDB provider");
+            }
+        }
+
+        /// <inheritdoc/>
+        public override async Task DownAsync(IStorageProvider provider)
+        {
+            // Only MongoDB provider supports indexes
+            if (provider is MongoDbStorageProvider mongoProvider)
+            {
+                await mongoProvider.DropIndexAsync("functions", "Tags");
+                await mongoProvider.DropIndexAsync("event_subscriptions", "Tags");
+                await mongoProvider.DropIndexAsync("webhook_triggers", "Tags");
+            }
+            else
+            {
+                // Skip for other providers
+                Console.WriteLine("Skipping tag index removal for non-MongoDB provider");
+            }
+        }
+    }
+}
@@ -0,0 +1,76 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Storage.Versioning
+{
+    /// <summary>
+    /// Implementation of the record migration service
+    /// </summary>
+    /// <remarks>
+    /// Reads are upgraded lazily, so the migration is never required; it rewrites old records so that the upgrade
+    /// steps for them can eventually be retired. Each record is written back on its own, so the migration can run
+    /// while the service is up and can be run again after a partial failure.
+    /// </remarks>
+    public class RecordMigrationService : IRecordMigrationService
+    {
+        private readonly ILogger<RecordMigrationService> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private readonly IEnumerable<IRecordUpgrader> _upgraders;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="RecordMigrationService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        /// <param name="upgraders">Record upgraders</param>
+        public RecordMigrationService(ILogger<RecordMigrationService> logger, IStorageProvider storageProvider, IEnumerable<IRecordUpgrader> upgraders)
+        {
+            _logger = logger;
+            _upgraders = upgraders;
+
+            // The upgraders need records as stored to tell which ones are behind
+            _storageProvider = storageProvider is VersionedStorageProviderDecorator versioned ? versioned.InnerProvider : storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<RecordMigrationReport> MigrateAsync()
+        {
+            var report = new RecordMigrationReport { StartedAt = DateTime.UtcNow };
+
+            foreach (var upgrader in _upgraders)
+            {
+                RecordMigrationResult result;
+                try
+                {
+                    result = await upgrader.MigrateAsync(_storageProvider);
+                }
+                catch (Exception ex)
+                {
+                    _logger.LogError(ex, "Migrating {RecordType} records in {Collection} failed", upgrader.RecordType.Name, upgrader.Collection);
+                    result = new RecordMigrationResult
+                    {
+                        RecordType = upgrader.RecordType.Name,
+                        Collection = upgrader.Collection,
+                        CurrentVersion = upgrader.CurrentVersion,
+                        Error = ex.Message
+                    };
+                }
+
+                if (result.Upgraded > 0 || result.Failed > 0 || result.Newer > 0)
+                {
+                    _logger.LogWarning("Migrated {RecordType} records in {Collection} to schema version {Version}: {Upgraded} of {Scanned} upgraded, {Failed} failed, {Newer} newer",
+                        result.RecordType, result.Collection, result.CurrentVersion, result.Upgraded, result.Scanned, result.Failed, result.Newer);
+                }
+
+                report.Results.Add(result);
+            }
+
+            report.CompletedAt = DateTime.UtcNow;
+            return report;
+        }
+    }
+}
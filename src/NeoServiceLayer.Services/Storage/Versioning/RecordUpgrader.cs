@@ -0,0 +1,101 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Storage.Versioning
+{
+    /// <summary>
+    /// Base class for upgrading stored records of one type one schema version at a time
+    /// </summary>
+    /// <typeparam name="T">Record type</typeparam>
+    /// <remarks>
+    /// <see cref="Steps"/>[n] moves a record from version n to version n + 1, so the current version is the
+    /// number of steps. Steps are only ever appended: a released step must keep working on the records it was
+    /// written for.
+    /// </remarks>
+    public abstract class RecordUpgrader<T> : IRecordUpgrader where T : class, IVersionedRecord
+    {
+        /// <inheritdoc/>
+        public Type RecordType => typeof(T);
+
+        /// <inheritdoc/>
+        public abstract string Collection { get; }
+
+        /// <inheritdoc/>
+        public int CurrentVersion => Steps.Count;
+
+        /// <summary>
+        /// Gets the upgrade steps, in version order
+        /// </summary>
+        protected abstract IReadOnlyList<Action<T>> Steps { get; }
+
+        /// <summary>
+        /// Gets the key a record is stored under
+        /// </summary>
+        /// <param name="record">Record</param>
+        /// <returns>The record key</returns>
+        protected abstract Guid GetKey(T record);
+
+        /// <inheritdoc/>
+        public bool Upgrade(object record)
+        {
+            return record is T typed && Upgrade(typed);
+        }
+
+        /// <inheritdoc/>
+        public async Task<RecordMigrationResult> MigrateAsync(IStorageProvider storageProvider)
+        {
+            var result = new RecordMigrationResult
+            {
+                RecordType = typeof(T).Name,
+                Collection = Collection,
+                CurrentVersion = CurrentVersion
+            };
+
+            foreach (var record in await storageProvider.GetAllAsync<T>(Collection))
+            {
+                result.Scanned++;
+
+                if (record.SchemaVersion > CurrentVersion)
+                {
+                    result.Newer++;
+                    continue;
+                }
+
+                try
+                {
+                    if (Upgrade(record))
+                    {
+                        await storageProvider.UpdateAsync<T, Guid>(Collection, GetKey(record), record);
+                        result.Upgraded++;
+                    }
+                }
+                catch (Exception ex)
+                {
+                    result.Failed++;
+                    result.Details.Add($"{typeof(T).Name} {GetKey(record)}: {ex.Message}");
+                }
+            }
+
+            return result;
+        }
+
+        private bool Upgrade(T record)
+        {
+            if (record.SchemaVersion >= CurrentVersion)
+            {
+                return false;
+            }
+
+            for (var version = Math.Max(0, record.SchemaVersion); version < CurrentVersion; version++)
+            {
+                Steps[version](record);
+            }
+
+            record.SchemaVersion = CurrentVersion;
+            return true;
+        }
+    }
+}
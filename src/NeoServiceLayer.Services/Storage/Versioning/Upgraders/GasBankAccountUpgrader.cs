@@ -0,0 +1,25 @@
+using System;
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Storage.Versioning.Upgraders
+{
+    /// <summary>
+    /// Upgrades stored GasBank accounts
+    /// </summary>
+    public class GasBankAccountUpgrader : RecordUpgrader<GasBankAccount>
+    {
+        /// <inheritdoc/>
+        public override string Collection => "gasbank_accounts";
+
+        /// <inheritdoc/>
+        protected override IReadOnlyList<Action<GasBankAccount>> Steps { get; } = new Action<GasBankAccount>[]
+        {
+            // 1: accounts written without tags
+            account => account.Tags ??= new Dictionary<string, string>()
+        };
+
+        /// <inheritdoc/>
+        protected override Guid GetKey(GasBankAccount record) => record.Id;
+    }
+}
@@ -0,0 +1,25 @@
+using System;
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Storage.Versioning.Upgraders
+{
+    /// <summary>
+    /// Upgrades stored GasBank allocations
+    /// </summary>
+    public class GasBankAllocationUpgrader : RecordUpgrader<GasBankAllocation>
+    {
+        /// <inheritdoc/>
+        public override string Collection => "gasbank_allocations";
+
+        /// <inheritdoc/>
+        protected override IReadOnlyList<Action<GasBankAllocation>> Steps { get; } = new Action<GasBankAllocation>[]
+        {
+            // 1: allocations written before versioning already have the version 1 shape
+            allocation => { }
+        };
+
+        /// <inheritdoc/>
+        protected override Guid GetKey(GasBankAllocation record) => record.Id;
+    }
+}
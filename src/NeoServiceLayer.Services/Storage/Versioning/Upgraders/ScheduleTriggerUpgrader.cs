@@ -0,0 +1,29 @@
+using System;
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Storage.Versioning.Upgraders
+{
+    /// <summary>
+    /// Upgrades stored schedule triggers
+    /// </summary>
+    public class ScheduleTriggerUpgrader : RecordUpgrader<ScheduleTrigger>
+    {
+        /// <inheritdoc/>
+        public override string Collection => "schedule_triggers";
+
+        /// <inheritdoc/>
+        protected override IReadOnlyList<Action<ScheduleTrigger>> Steps { get; } = new Action<ScheduleTrigger>[]
+        {
+            // 1: triggers written before parameters and tags were added
+            trigger =>
+            {
+                trigger.Parameters ??= new Dictionary<string, object>();
+                trigger.Tags ??= new List<string>();
+            }
+        };
+
+        /// <inheritdoc/>
+        protected override Guid GetKey(ScheduleTrigger record) => record.Id;
+    }
+}
@@ -0,0 +1,32 @@
+using System;
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Storage.Versioning.Upgraders
+{
+    /// <summary>
+    /// Upgrades stored secrets
+    /// </summary>
+    public class SecretUpgrader : RecordUpgrader<Secret>
+    {
+        /// <inheritdoc/>
+        public override string Collection => "secrets";
+
+        /// <inheritdoc/>
+        protected override IReadOnlyList<Action<Secret>> Steps { get; } = new Action<Secret>[]
+        {
+            // 1: secrets written without an access list or value version; no function may read them until granted
+            secret =>
+            {
+                secret.AllowedFunctionIds ??= new List<Guid>();
+                if (secret.Version < 1)
+                {
+                    secret.Version = 1;
+                }
+            }
+        };
+
+        /// <inheritdoc/>
+        protected override Guid GetKey(Secret record) => record.Id;
+    }
+}
@@ -0,0 +1,170 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+
+namespace NeoServiceLayer.Services.Storage.Versioning
+{
+    /// <summary>
+    /// Decorator for storage providers that upgrades versioned records on read and stamps them on write
+    /// </summary>
+    /// <remarks>
+    /// Upgrades are applied to the records returned, not written back; stored records reach the current
+    /// version the next time they are updated, or all at once through <see cref="RecordMigrationService"/>.
+    /// Filters see the upgraded record. Records of types without an upgrader pass through unchanged.
+    /// </remarks>
+    public class VersionedStorageProviderDecorator : IStorageProvider
+    {
+        private readonly IStorageProvider _innerProvider;
+        private readonly Dictionary<Type, IRecordUpgrader> _upgraders;
+        private readonly ILogger<VersionedStorageProviderDecorator> _logger;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="VersionedStorageProviderDecorator"/> class
+        /// </summary>
+        /// <param name="innerProvider">Inner storage provider</param>
+        /// <param name="upgraders">Record upgraders, one per record type</param>
+        /// <param name="logger">Logger</param>
+        public VersionedStorageProviderDecorator(
+            IStorageProvider innerProvider,
+            IEnumerable<IRecordUpgrader> upgraders,
+            ILogger<VersionedStorageProviderDecorator> logger)
+        {
+            _innerProvider = innerProvider;
+            _upgraders = upgraders.ToDictionary(u => u.RecordType);
+            _logger = logger;
+        }
+
+        /// <summary>
+        /// Gets the decorated provider, which returns records as stored
+        /// </summary>
+        public IStorageProvider InnerProvider => _innerProvider;
+
+        /// <inheritdoc/>
+        public string Name => _innerProvider.Name;
+
+        /// <inheritdoc/>
+        public string Type => _innerProvider.Type;
+
+        /// <inheritdoc/>
+        public Task<bool> InitializeAsync()
+        {
+            return _innerProvider.InitializeAsync();
+        }
+
+        /// <inheritdoc/>
+        public Task<bool> HealthCheckAsync()
+        {
+            return _innerProvider.HealthCheckAsync();
+        }
+
+        /// <inheritdoc/>
+        public Task<T> CreateAsync<T>(string collection, T entity) where T : class
+        {
+            Stamp(entity);
+            return _innerProvider.CreateAsync(collection, entity);
+        }
+
+        /// <inheritdoc/>
+        public async Task<T> GetByIdAsync<T, TKey>(string collection, TKey id) where T : class
+        {
+            return Upgrade(collection, await _innerProvider.GetByIdAsync<T, TKey>(collection, id));
+        }
+
+        /// <inheritdoc/>
+        public Task<IEnumerable<T>> GetByFilterAsync<T>(string collection, Func<T, bool> filter) where T : class
+        {
+            if (!_upgraders.ContainsKey(typeof(T)))
+            {
+                return _innerProvider.GetByFilterAsync(collection, filter);
+            }
+
+            return _innerProvider.GetByFilterAsync<T>(collection, entity => filter(Upgrade(collection, entity)));
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<T>> GetAllAsync<T>(string collection) where T : class
+        {
+            var entities = await _innerProvider.GetAllAsync<T>(collection);
+
+            if (!_upgraders.ContainsKey(typeof(T)))
+            {
+                return entities;
+            }
+
+            return entities.Select(entity => Upgrade(collection, entity)).ToList();
+        }
+
+        /// <inheritdoc/>
+        public Task<T> UpdateAsync<T, TKey>(string collection, TKey id, T entity) where T : class
+        {
+            Stamp(entity);
+            return _innerProvider.UpdateAsync(collection, id, entity);
+        }
+
+        /// <inheritdoc/>
+        public Task<bool> DeleteAsync<T, TKey>(string collection, TKey id) where T : class
+        {
+            return _innerProvider.DeleteAsync<T, TKey>(collection, id);
+        }
+
+        /// <inheritdoc/>
+        public Task<int> CountAsync<T>(string collection, Func<T, bool> filter = null) where T : class
+        {
+            if (filter == null || !_upgraders.ContainsKey(typeof(T)))
+            {
+                return _innerProvider.CountAsync(collection, filter);
+            }
+
+            return _innerProvider.CountAsync<T>(collection, entity => filter(Upgrade(collection, entity)));
+        }
+
+        /// <inheritdoc/>
+        public Task<bool> CollectionExistsAsync(string collection)
+        {
+            return _innerProvider.CollectionExistsAsync(collection);
+        }
+
+        /// <inheritdoc/>
+        public Task<bool> CreateCollectionAsync(string collection)
+        {
+            return _innerProvider.CreateCollectionAsync(collection);
+        }
+
+        /// <inheritdoc/>
+        public Task<bool> DeleteCollectionAsync(string collection)
+        {
+            return _innerProvider.DeleteCollectionAsync(collection);
+        }
+
+        private T Upgrade<T>(string collection, T entity) where T : class
+        {
+            if (entity == null || !_upgraders.TryGetValue(typeof(T), out var upgrader))
+            {
+                return entity;
+            }
+
+            var storedVersion = ((IVersionedRecord)entity).SchemaVersion;
+            if (upgrader.Upgrade(entity))
+            {
+                _logger.LogDebug("Upgraded {RecordType} in {Collection} from schema version {StoredVersion} to {CurrentVersion}",
+                    typeof(T).Name, collection, storedVersion, upgrader.CurrentVersion);
+            }
+
+            return entity;
+        }
+
+        private void Stamp<T>(T entity) where T : class
+        {
+            // A record being written has been built or upgraded by the current code; one written by a newer
+            // version keeps its version so that version's upgrader does not run its steps again
+            if (entity is IVersionedRecord record && _upgraders.TryGetValue(typeof(T), out var upgrader) &&
+                record.SchemaVersion < upgrader.CurrentVersion)
+            {
+                record.SchemaVersion = upgrader.CurrentVersion;
+            }
+        }
+    }
+}
@@ -0,0 +1,395 @@
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Linq;
+using System.Security.Cryptography;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.Wallet
+{
+    /// <summary>
+    /// Collects the signatures of m-of-n multi-signature contract invocations and sends them once enough are in
+    /// </summary>
+    /// <remarks>
+    /// A proposal fixes the sender account, the invocation script, the fee limit and a nonce, and every signer signs
+    /// the same digest of them with their wallet, possibly hours apart. The proposal is stored between signatures, so
+    /// it survives restarts. The signature that reaches the threshold sends the transaction through the enclave, which
+    /// checks the sender against the signers' keys before it assembles the witness.
+    /// </remarks>
+    public class MultiSigService : IMultiSigService
+    {
+        /// <summary>
+        /// How long signatures are collected when the proposal does not say
+        /// </summary>
+        public static readonly TimeSpan DefaultLifetime = TimeSpan.FromDays(1);
+
+        /// <summary>
+        /// Longest time signatures may be collected for
+        /// </summary>
+        public static readonly TimeSpan MaxLifetime = TimeSpan.FromDays(7);
+
+        private const decimal GasFactor = 100_000_000m;
+
+        private readonly ILogger<MultiSigService> _logger;
+        private readonly IMultiSigTransactionRepository _repository;
+        private readonly IWalletService _walletService;
+        private readonly IEnclaveService _enclaveService;
+        private readonly SemaphoreSlim _signSemaphore = new SemaphoreSlim(1, 1);
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="MultiSigService"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="repository">Store of transactions awaiting signatures</param>
+        /// <param name="walletService">Wallet service signers sign with</param>
+        /// <param name="enclaveService">Enclave service that sends the signed transactions</param>
+        public MultiSigService(
+            ILogger<MultiSigService> logger,
+            IMultiSigTransactionRepository repository,
+            IWalletService walletService,
+            IEnclaveService enclaveService)
+        {
+            _logger = logger;
+            _repository = repository;
+            _walletService = walletService;
+            _enclaveService = enclaveService;
+        }
+
+        /// <inheritdoc/>
+        public async Task<MultiSigTransaction> ProposeAsync(
+            Guid accountId,
+            int threshold,
+            IEnumerable<string> publicKeys,
+            string contractHash,
+            string operation,
+            IEnumerable<NeoContractParameter> args,
+            decimal systemFeeLimit,
+            TimeSpan? lifetime = null)
+        {
+            if (!contractHash.IsValidScriptHash())
+            {
+                throw new ValidationException("Invalid contract script hash format");
+            }
+
+            if (systemFeeLimit <= 0)
+            {
+                throw new ValidationException("The system fee limit must be greater than zero");
+            }
+
+            var collectFor = lifetime ?? DefaultLifetime;
+            if (collectFor <= TimeSpan.Zero || collectFor > MaxLifetime)
+            {
+                throw new ValidationException($"Signatures can be collected for at most {MaxLifetime.TotalDays} days");
+            }
+
+            List<string> keys;
+            byte[] verificationScript;
+            byte[] script;
+            var argList = args?.ToList() ?? new List<NeoContractParameter>();
+            try
+            {
+                keys = NeoAccountUtility.SortPublicKeys(publicKeys ?? Enumerable.Empty<string>());
+                verificationScript = NeoAccountUtility.CreateMultiSigVerificationScript(threshold, keys);
+                script = NeoScriptBuilder.CreateInvocationScript(contractHash, operation, argList);
+            }
+            catch (FormatException ex)
+            {
+                throw new ValidationException(ex.Message, ex);
+            }
+
+            var senderScriptHash = NeoAccountUtility.GetScriptHash(verificationScript);
+            var now = DateTime.UtcNow;
+            var transaction = new MultiSigTransaction
+            {
+                Id = Guid.NewGuid(),
+                AccountId = accountId,
+                Threshold = threshold,
+                PublicKeys = keys,
+                VerificationScript = Convert.ToBase64String(verificationScript),
+                SenderScriptHash = senderScriptHash,
+                SenderAddress = NeoAccountUtility.ToAddress(senderScriptHash),
+                ContractHash = contractHash.ToLowerInvariant(),
+                Operation = operation,
+                Args = argList,
+                Script = Convert.ToBase64String(script),
+                SystemFeeLimit = systemFeeLimit,
+                Nonce = (uint)RandomNumberGenerator.GetInt32(int.MaxValue),
+                Status = MultiSigTransactionStatus.Pending,
+                ExpiresAt = now + collectFor,
+                CreatedAt = now,
+                UpdatedAt = now
+            };
+            transaction.SignData = ComputeSignData(transaction);
+
+            _logger.LogInformation("Account {AccountId} proposed {Operation} on {ContractHash} from {Threshold}-of-{KeyCount} account {SenderAddress}",
+                accountId, operation, transaction.ContractHash, threshold, keys.Count, transaction.SenderAddress);
+
+            return await _repository.CreateAsync(transaction);
+        }
+
+        /// <inheritdoc/>
+        public async Task<MultiSigTransaction> SignAsync(Guid id, Guid walletId, string password)
+        {
+            await _signSemaphore.WaitAsync();
+            try
+            {
+                var transaction = await GetOpenAsync(id);
+                if (transaction.Status != MultiSigTransactionStatus.Pending)
+                {
+                    throw new ValidationException($"The transaction is {transaction.Status} and takes no more signatures");
+                }
+
+                var wallet = await _walletService.GetByIdAsync(walletId);
+                if (wallet == null)
+                {
+                    throw new ResourceNotFoundException("Wallet", walletId.ToString());
+                }
+
+                var publicKey = wallet.PublicKey?.ToLowerInvariant();
+                if (publicKey == null || !transaction.PublicKeys.Contains(publicKey))
+                {
+                    throw new ValidationException("The wallet does not hold one of the signers' keys");
+                }
+
+                if (transaction.Signatures.Any(s => s.PublicKey == publicKey))
+                {
+                    throw new ValidationException("The wallet has already signed the transaction");
+                }
+
+                var signature = await _walletService.SignDataAsync(walletId, password, Convert.FromHexString(transaction.SignData));
+                transaction.Signatures.Add(new MultiSigSignature
+                {
+                    PublicKey = publicKey,
+                    WalletId = walletId,
+                    AccountId = wallet.AccountId ?? Guid.Empty,
+                    Signature = signature,
+                    SignedAt = DateTime.UtcNow
+                });
+
+                _logger.LogInformation("Multi-signature transaction {Id} has {Count} of {Threshold} signatures",
+                    id, transaction.Signatures.Count, transaction.Threshold);
+
+                if (transaction.Signatures.Count >= transaction.Threshold)
+                {
+                    await SendAsync(transaction);
+                }
+
+                return await _repository.UpdateAsync(transaction);
+            }
+            finally
+            {
+                _signSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<MultiSigTransaction> RetrySendAsync(Guid id)
+        {
+            await _signSemaphore.WaitAsync();
+            try
+            {
+                var transaction = await GetOpenAsync(id);
+                if (transaction.Status != MultiSigTransactionStatus.Failed)
+                {
+                    throw new ValidationException($"The transaction is {transaction.Status}; only transactions that failed to send can be retried");
+                }
+
+                await SendAsync(transaction);
+                return await _repository.UpdateAsync(transaction);
+            }
+            finally
+            {
+                _signSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<MultiSigTransaction> CancelAsync(Guid id)
+        {
+            await _signSemaphore.WaitAsync();
+            try
+            {
+                var transaction = await GetOpenAsync(id);
+                if (transaction.Status != MultiSigTransactionStatus.Pending && transaction.Status != MultiSigTransactionStatus.Failed)
+                {
+                    throw new ValidationException($"The transaction is {transaction.Status} and cannot be cancelled");
+                }
+
+                transaction.Status = MultiSigTransactionStatus.Cancelled;
+                _logger.LogInformation("Multi-signature transaction {Id} was cancelled", id);
+
+                return await _repository.UpdateAsync(transaction);
+            }
+            finally
+            {
+                _signSemaphore.Release();
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<MultiSigTransaction> GetByIdAsync(Guid id)
+        {
+            var transaction = await _repository.GetByIdAsync(id);
+            return transaction == null ? null : await ExpireIfDueAsync(transaction);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<MultiSigTransaction>> GetByAccountIdAsync(Guid accountId)
+        {
+            var transactions = new List<MultiSigTransaction>();
+            foreach (var transaction in await _repository.GetByAccountIdAsync(accountId))
+            {
+                transactions.Add(await ExpireIfDueAsync(transaction));
+            }
+
+            return transactions;
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<MultiSigTransaction>> GetAwaitingSignatureAsync(Guid accountId)
+        {
+            var keys = await GetAccountKeysAsync(accountId);
+            var now = DateTime.UtcNow;
+            var pending = await _repository.GetPendingByPublicKeysAsync(keys);
+
+            return pending
+                .Where(t => t.ExpiresAt > now)
+                .Where(t => t.PublicKeys.Any(key => keys.Contains(key) && t.Signatures.All(s => s.PublicKey != key)))
+                .ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<bool> IsParticipantAsync(MultiSigTransaction transaction, Guid accountId)
+        {
+            if (transaction.AccountId == accountId)
+            {
+                return true;
+            }
+
+            var keys = await GetAccountKeysAsync(accountId);
+            return transaction.PublicKeys.Any(keys.Contains);
+        }
+
+        private async Task<MultiSigTransaction> GetOpenAsync(Guid id)
+        {
+            var transaction = await _repository.GetByIdAsync(id);
+            if (transaction == null)
+            {
+                throw new ResourceNotFoundException("MultiSigTransaction", id.ToString());
+            }
+
+            return await ExpireIfDueAsync(transaction);
+        }
+
+        private async Task<MultiSigTransaction> ExpireIfDueAsync(MultiSigTransaction transaction)
+        {
+            var open = transaction.Status == MultiSigTransactionStatus.Pending || transaction.Status == MultiSigTransactionStatus.Failed;
+            if (!open || transaction.ExpiresAt > DateTime.UtcNow)
+            {
+                return transaction;
+            }
+
+            transaction.Status = MultiSigTransactionStatus.Expired;
+            _logger.LogInformation("Multi-signature transaction {Id} expired with {Count} of {Threshold} signatures",
+                transaction.Id, transaction.Signatures.Count, transaction.Threshold);
+
+            return await _repository.UpdateAsync(transaction);
+        }
+
+        private async Task SendAsync(MultiSigTransaction transaction)
+        {
+            // The witness carries exactly the threshold of signatures, in the order of the keys they belong to
+            var signatures = transaction.Signatures
+                .OrderBy(s => transaction.PublicKeys.IndexOf(s.PublicKey))
+                .Take(transaction.Threshold)
+                .Select(s => new { s.PublicKey, s.Signature })
+                .ToList();
+
+            try
+            {
+                var response = await _enclaveService.SendRequestAsync<object, MultiSigSendResponse>(
+                    Constants.EnclaveServiceTypes.Wallet,
+                    Constants.WalletOperations.SendMultiSig,
+                    new
+                    {
+                        transaction.AccountId,
+                        transaction.Threshold,
+                        transaction.PublicKeys,
+                        transaction.VerificationScript,
+                        transaction.Script,
+                        transaction.SystemFeeLimit,
+                        transaction.Nonce,
+                        transaction.SignData,
+                        Signatures = signatures
+                    });
+
+                if (string.IsNullOrEmpty(response?.TransactionHash))
+                {
+                    throw new WalletException("The enclave returned no transaction hash");
+                }
+
+                transaction.Status = MultiSigTransactionStatus.Sent;
+                transaction.TransactionHash = response.TransactionHash;
+                transaction.SentAt = DateTime.UtcNow;
+                transaction.Error = null;
+
+                _logger.LogInformation("Sent multi-signature transaction {Id} from {SenderAddress}: {TransactionHash}",
+                    transaction.Id, transaction.SenderAddress, transaction.TransactionHash);
+            }
+            catch (Exception ex)
+            {
+                // The signatures stay valid, so sending can be retried without signing again
+                transaction.Status = MultiSigTransactionStatus.Failed;
+                transaction.Error = ex.Message;
+
+                _logger.LogError(ex, "Error sending multi-signature transaction {Id}", transaction.Id);
+            }
+        }
+
+        private async Task<HashSet<string>> GetAccountKeysAsync(Guid accountId)
+        {
+            var wallets = await _walletService.GetByAccountIdAsync(accountId);
+            return new HashSet<string>(
+                wallets.Where(w => !string.IsNullOrEmpty(w.PublicKey)).Select(w => w.PublicKey.ToLowerInvariant()),
+                StringComparer.Ordinal);
+        }
+
+        private static string ComputeSignData(MultiSigTransaction transaction)
+        {
+            using (var stream = new MemoryStream())
+            using (var writer = new BinaryWriter(stream))
+            {
+                writer.Write(Convert.FromHexString(transaction.SenderScriptHash.Substring(2)));
+                writer.Write(Convert.FromBase64String(transaction.Script));
+                writer.Write((long)decimal.Round(transaction.SystemFeeLimit * GasFactor));
+                writer.Write(transaction.Nonce);
+                writer.Flush();
+
+                using (var sha256 = SHA256.Create())
+                {
+                    return Convert.ToHexString(sha256.ComputeHash(stream.ToArray())).ToLowerInvariant();
+                }
+            }
+        }
+
+        /// <summary>
+        /// Response of the enclave to a multi-signature send request
+        /// </summary>
+        private class MultiSigSendResponse
+        {
+            /// <summary>
+            /// Gets or sets the hash of the sent transaction
+            /// </summary>
+            public string TransactionHash { get; set; }
+        }
+    }
+}
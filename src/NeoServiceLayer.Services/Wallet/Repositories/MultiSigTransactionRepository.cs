@@ -0,0 +1,88 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+
+namespace NeoServiceLayer.Services.Wallet.Repositories
+{
+    /// <summary>
+    /// Repository for multi-signature transactions awaiting signatures
+    /// </summary>
+    public class MultiSigTransactionRepository : IMultiSigTransactionRepository
+    {
+        private readonly ILogger<MultiSigTransactionRepository> _logger;
+        private readonly IStorageProvider _storageProvider;
+        private const string CollectionName = "multisig_transactions";
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="MultiSigTransactionRepository"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="storageProvider">Storage provider</param>
+        public MultiSigTransactionRepository(ILogger<MultiSigTransactionRepository> logger, IStorageProvider storageProvider)
+        {
+            _logger = logger;
+            _storageProvider = storageProvider;
+        }
+
+        /// <inheritdoc/>
+        public async Task<MultiSigTransaction> CreateAsync(MultiSigTransaction transaction)
+        {
+            ValidationUtility.ValidateNotNull(transaction, nameof(transaction));
+            ValidationUtility.ValidateGuid(transaction.Id, "Transaction ID");
+
+            _logger.LogInformation("Storing multi-signature transaction {Id} from {SenderAddress}", transaction.Id, transaction.SenderAddress);
+            await _storageProvider.CreateAsync(CollectionName, transaction);
+
+            return transaction;
+        }
+
+        /// <inheritdoc/>
+        public Task<MultiSigTransaction> GetByIdAsync(Guid id)
+        {
+            return _storageProvider.GetByIdAsync<MultiSigTransaction, Guid>(CollectionName, id);
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<MultiSigTransaction>> GetByAccountIdAsync(Guid accountId)
+        {
+            var transactions = await _storageProvider.GetByFilterAsync<MultiSigTransaction>(
+                CollectionName,
+                t => t.AccountId == accountId);
+
+            return transactions.OrderByDescending(t => t.CreatedAt).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<IEnumerable<MultiSigTransaction>> GetPendingByPublicKeysAsync(IEnumerable<string> publicKeys)
+        {
+            var keys = new HashSet<string>(publicKeys, StringComparer.OrdinalIgnoreCase);
+            if (keys.Count == 0)
+            {
+                return new List<MultiSigTransaction>();
+            }
+
+            var transactions = await _storageProvider.GetByFilterAsync<MultiSigTransaction>(
+                CollectionName,
+                t => t.Status == MultiSigTransactionStatus.Pending && t.PublicKeys.Any(keys.Contains));
+
+            return transactions.OrderBy(t => t.CreatedAt).ToList();
+        }
+
+        /// <inheritdoc/>
+        public async Task<MultiSigTransaction> UpdateAsync(MultiSigTransaction transaction)
+        {
+            ValidationUtility.ValidateNotNull(transaction, nameof(transaction));
+            ValidationUtility.ValidateGuid(transaction.Id, "Transaction ID");
+
+            transaction.UpdatedAt = DateTime.UtcNow;
+            await _storageProvider.UpdateAsync<MultiSigTransaction, Guid>(CollectionName, transaction.Id, transaction);
+
+            return transaction;
+        }
+    }
+}
@@ -0,0 +1,324 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.DependencyInjection;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+
+namespace NeoServiceLayer.Services.Wallet
+{
+    /// <summary>
+    /// Spreads trigger transactions across the accounts of the service wallets
+    /// </summary>
+    /// <remarks>
+    /// Neo N3 has no account nonces, so transactions from one sender do not have to be ordered. They conflict in the
+    /// mempool when the sender cannot cover the fees of all its pending transactions, which is what happens when many
+    /// triggers fire in the same block from one account. Each signer therefore has a cap on transactions waiting for
+    /// a block, and the fee limits of those being sent are reserved against its GAS balance. Balances are read from
+    /// the chain periodically; fees sent in between are deducted locally. A signer whose send fails is left out for a
+    /// backoff that doubles with each further failure.
+    /// </remarks>
+    public class SignerPool : ISignerPool
+    {
+        // Leases never completed or failed, e.g. because the caller crashed, are dropped after this long
+        private static readonly TimeSpan LeaseTimeout = TimeSpan.FromMinutes(5);
+        private static readonly TimeSpan MaxBackoff = TimeSpan.FromMinutes(10);
+
+        private readonly ILogger<SignerPool> _logger;
+        private readonly IServiceScopeFactory _scopeFactory;
+        private readonly SignerPoolConfiguration _configuration;
+        private readonly object _lock = new object();
+        private readonly SemaphoreSlim _refreshLock = new SemaphoreSlim(1, 1);
+        private List<Signer> _signers = new List<Signer>();
+        private DateTime _refreshedAt = DateTime.MinValue;
+        private int _nextIndex;
+
+        /// <summary>
+        /// Initializes a new instance of the <see cref="SignerPool"/> class
+        /// </summary>
+        /// <param name="logger">Logger</param>
+        /// <param name="scopeFactory">Scope factory for the wallet service</param>
+        /// <param name="configuration">Signer pool configuration</param>
+        public SignerPool(
+            ILogger<SignerPool> logger,
+            IServiceScopeFactory scopeFactory,
+            IOptions<SignerPoolConfiguration> configuration = null)
+        {
+            _logger = logger;
+            _scopeFactory = scopeFactory;
+            _configuration = configuration?.Value ?? new SignerPoolConfiguration();
+        }
+
+        /// <inheritdoc/>
+        public bool IsEnabled => _configuration.Enabled;
+
+        /// <inheritdoc/>
+        public async Task<SignerLease> AcquireAsync(decimal feeLimit)
+        {
+            await RefreshAsync();
+
+            lock (_lock)
+            {
+                var now = DateTime.UtcNow;
+                var candidates = _signers.Where(s => IsAvailable(s, feeLimit, now)).ToList();
+                if (candidates.Count == 0)
+                {
+                    _logger.LogWarning("No signer can take a transaction with a fee limit of {FeeLimit} GAS", feeLimit);
+                    return null;
+                }
+
+                Signer signer;
+                if (string.Equals(_configuration.Strategy, SignerPoolStrategies.RoundRobin, StringComparison.OrdinalIgnoreCase))
+                {
+                    signer = candidates
+                        .OrderBy(s => (_signers.IndexOf(s) - _nextIndex + _signers.Count) % _signers.Count)
+                        .First();
+                    _nextIndex = (_signers.IndexOf(signer) + 1) % _signers.Count;
+                }
+                else
+                {
+                    signer = candidates.OrderBy(s => s.LastUsedAt ?? DateTime.MinValue).First();
+                }
+
+                var lease = new SignerLease
+                {
+                    Id = Guid.NewGuid(),
+                    WalletId = signer.WalletId,
+                    Address = signer.Address,
+                    FeeLimit = feeLimit,
+                    AcquiredAt = now
+                };
+
+                signer.Pending.Add(new PendingTransaction { LeaseId = lease.Id, FeeLimit = feeLimit, AcquiredAt = now });
+                signer.LastUsedAt = now;
+
+                return lease;
+            }
+        }
+
+        /// <inheritdoc/>
+        public void Complete(SignerLease lease, string transactionHash)
+        {
+            lock (_lock)
+            {
+                var signer = _signers.FirstOrDefault(s => s.WalletId == lease.WalletId);
+                var pending = signer?.Pending.FirstOrDefault(p => p.LeaseId == lease.Id);
+                if (pending == null)
+                {
+                    return;
+                }
+
+                pending.SentAt = DateTime.UtcNow;
+                signer.GasBalance -= lease.FeeLimit;
+                signer.SentTransactions++;
+                signer.LastTransactionHash = transactionHash;
+                signer.ConsecutiveFailures = 0;
+                signer.BackoffUntil = null;
+            }
+        }
+
+        /// <inheritdoc/>
+        public void Fail(SignerLease lease, string error)
+        {
+            lock (_lock)
+            {
+                var signer = _signers.FirstOrDefault(s => s.WalletId == lease.WalletId);
+                if (signer == null)
+                {
+                    return;
+                }
+
+                signer.Pending.RemoveAll(p => p.LeaseId == lease.Id);
+                signer.ConsecutiveFailures++;
+                signer.LastError = error;
+
+                var backoff = TimeSpan.FromSeconds(_configuration.FailureBackoffSeconds * Math.Pow(2, Math.Min(signer.ConsecutiveFailures - 1, 16)));
+                signer.BackoffUntil = DateTime.UtcNow + (backoff < MaxBackoff ? backoff : MaxBackoff);
+
+                _logger.LogWarning("Signer {Address} failed to send a transaction and is left out until {BackoffUntil}: {Error}",
+                    signer.Address, signer.BackoffUntil, error);
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<IReadOnlyList<SignerStatus>> GetStatusAsync()
+        {
+            await RefreshAsync();
+
+            lock (_lock)
+            {
+                var now = DateTime.UtcNow;
+                return _signers.Select(s => new SignerStatus
+                {
+                    WalletId = s.WalletId,
+                    Address = s.Address,
+                    GasBalance = s.GasBalance,
+                    BalanceCheckedAt = s.BalanceCheckedAt,
+                    ReservedGas = s.Pending.Where(p => p.SentAt == null).Sum(p => p.FeeLimit),
+                    PendingTransactions = s.Pending.Count,
+                    SentTransactions = s.SentTransactions,
+                    LastUsedAt = s.LastUsedAt,
+                    LastTransactionHash = s.LastTransactionHash,
+                    ConsecutiveFailures = s.ConsecutiveFailures,
+                    LastError = s.LastError,
+                    BackoffUntil = s.BackoffUntil,
+                    IsAvailable = IsAvailable(s, 0, now)
+                }).ToList();
+            }
+        }
+
+        /// <summary>
+        /// Reloads the pool's wallets and their balances when the refresh interval has passed
+        /// </summary>
+        private async Task RefreshAsync()
+        {
+            if (DateTime.UtcNow - _refreshedAt < TimeSpan.FromSeconds(_configuration.BalanceRefreshSeconds))
+            {
+                return;
+            }
+
+            await _refreshLock.WaitAsync();
+            try
+            {
+                if (DateTime.UtcNow - _refreshedAt < TimeSpan.FromSeconds(_configuration.BalanceRefreshSeconds))
+                {
+                    return;
+                }
+
+                using var scope = _scopeFactory.CreateScope();
+                var walletService = scope.ServiceProvider.GetRequiredService<IWalletService>();
+
+                IEnumerable<Core.Models.Wallet> wallets;
+                if (_configuration.WalletIds?.Count > 0)
+                {
+                    var configured = new List<Core.Models.Wallet>();
+                    foreach (var walletId in _configuration.WalletIds)
+                    {
+                        var wallet = await walletService.GetByIdAsync(walletId);
+                        if (wallet == null)
+                        {
+                            _logger.LogWarning("Signer pool wallet {WalletId} not found", walletId);
+                            continue;
+                        }
+
+                        configured.Add(wallet);
+                    }
+
+                    wallets = configured;
+                }
+                else
+                {
+                    wallets = await walletService.GetServiceWalletsAsync();
+                }
+
+                var balances = new Dictionary<Guid, decimal>();
+                var errors = new Dictionary<Guid, string>();
+                foreach (var wallet in wallets)
+                {
+                    try
+                    {
+                        balances[wallet.Id] = await walletService.GetGasBalanceAsync(wallet.Address);
+                    }
+                    catch (Exception ex)
+                    {
+                        _logger.LogWarning(ex, "Error reading the GAS balance of signer {Address}", wallet.Address);
+                        errors[wallet.Id] = ex.Message;
+                    }
+                }
+
+                lock (_lock)
+                {
+                    var now = DateTime.UtcNow;
+                    var existing = _signers.ToDictionary(s => s.WalletId);
+                    _signers = wallets.Select(wallet =>
+                    {
+                        var signer = existing.TryGetValue(wallet.Id, out var known)
+                            ? known
+                            : new Signer { WalletId = wallet.Id };
+                        signer.Address = wallet.Address;
+
+                        if (balances.TryGetValue(wallet.Id, out var balance))
+                        {
+                            signer.GasBalance = balance;
+                            signer.BalanceCheckedAt = now;
+                        }
+                        else if (errors.TryGetValue(wallet.Id, out var error))
+                        {
+                            signer.LastError = error;
+                        }
+
+                        return signer;
+                    }).ToList();
+
+                    _nextIndex = _signers.Count == 0 ? 0 : _nextIndex % _signers.Count;
+                    _refreshedAt = now;
+                }
+            }
+            finally
+            {
+                _refreshLock.Release();
+            }
+        }
+
+        private bool IsAvailable(Signer signer, decimal feeLimit, DateTime now)
+        {
+            // Sent transactions are assumed to be in a block once the timeout passes; their fees are already deducted
+            signer.Pending.RemoveAll(p => p.SentAt != null
+                ? p.SentAt.Value.AddSeconds(_configuration.PendingTimeoutSeconds) <= now
+                : p.AcquiredAt + LeaseTimeout <= now);
+
+            if (signer.BalanceCheckedAt == null || string.IsNullOrEmpty(signer.Address))
+            {
+                return false;
+            }
+
+            if (signer.BackoffUntil > now || signer.Pending.Count >= Math.Max(1, _configuration.MaxPendingTransactions))
+            {
+                return false;
+            }
+
+            var reserved = signer.Pending.Where(p => p.SentAt == null).Sum(p => p.FeeLimit);
+            return signer.GasBalance - reserved - _configuration.MinimumGasBalance >= feeLimit;
+        }
+
+        private class Signer
+        {
+            public Guid WalletId { get; set; }
+
+            public string Address { get; set; }
+
+            public decimal GasBalance { get; set; }
+
+            public DateTime? BalanceCheckedAt { get; set; }
+
+            public List<PendingTransaction> Pending { get; } = new List<PendingTransaction>();
+
+            public long SentTransactions { get; set; }
+
+            public DateTime? LastUsedAt { get; set; }
+
+            public string LastTransactionHash { get; set; }
+
+            public int ConsecutiveFailures { get; set; }
+
+            public string LastError { get; set; }
+
+            public DateTime? BackoffUntil { get; set; }
+        }
+
+        private class PendingTransaction
+        {
+            public Guid LeaseId { get; set; }
+
+            public decimal FeeLimit { get; set; }
+
+            public DateTime AcquiredAt { get; set; }
+
+            public DateTime? SentAt { get; set; }
+        }
+    }
+}
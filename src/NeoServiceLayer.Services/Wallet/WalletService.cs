@@ -1,11 +1,13 @@
 using System;
 using System.Collections.Generic;
+using System.Linq;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
 using NeoServiceLayer.Core;
 using NeoServiceLayer.Core.Exceptions;
 using NeoServiceLayer.Core.Interfaces;
 using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
 using NeoServiceLayer.Core.Extensions;
 using NeoServiceLayer.Services.Wallet.Repositories;
 using NeoServiceLayer.Services.Common.Utilities;
@@ -740,6 +742,188 @@ namespace NeoServiceLayer.Services.Wallet
             }
         }
 
+        /// <inheritdoc/>
+        public async Task<string> TransferGasMultiAsync(Guid walletId, string password, IDictionary<string, decimal> transfers)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["WalletId"] = walletId,
+                ["RecipientCount"] = transfers?.Count ?? 0
+            };
+
+            Common.Utilities.LoggingUtility.LogOperationStart(_logger, "TransferGasMulti", requestId, additionalData);
+
+            try
+            {
+                // Validate input
+                Common.Utilities.ValidationUtility.ValidateGuid(walletId, "Wallet ID");
+                Common.Utilities.ValidationUtility.ValidateNotNullOrEmpty(password, "Password");
+                Common.Utilities.ValidationUtility.ValidateNotNull(transfers, "Transfers");
+
+                if (transfers.Count == 0)
+                {
+                    throw new WalletException("At least one transfer is required");
+                }
+
+                foreach (var transfer in transfers)
+                {
+                    if (!NeoServiceLayer.Core.Extensions.StringExtensions.IsValidNeoAddress(transfer.Key))
+                    {
+                        throw new WalletException($"Invalid Neo address format: {transfer.Key}");
+                    }
+
+                    Common.Utilities.ValidationUtility.ValidateGreaterThanZero(transfer.Value, "Amount");
+                }
+
+                var result = await Common.Utilities.ExceptionUtility.ExecuteWithExceptionHandlingAsync<WalletService, string>(
+                    _logger,
+                    async () =>
+                    {
+                        // Get wallet from repository
+                        var wallet = await _walletRepository.GetByIdAsync(walletId);
+                        if (wallet == null)
+                        {
+                            throw new WalletException("Wallet not found");
+                        }
+
+                        additionalData["FromAddress"] = wallet.Address;
+
+                        // Send transfer request to enclave
+                        var transferRequest = new
+                        {
+                            WalletId = walletId,
+                            AccountId = wallet.AccountId ?? Guid.Empty,
+                            Password = password,
+                            Transfers = transfers.Select(t => new { ToAddress = t.Key, Amount = t.Value }).ToList()
+                        };
+
+                        var transferResult = await _enclaveService.SendRequestAsync<object, object>(
+                            Constants.EnclaveServiceTypes.Wallet,
+                            Constants.WalletOperations.TransferGasMulti,
+                            transferRequest);
+
+                        // Extract transaction hash from result
+                        var transactionHash = transferResult.GetType().GetProperty("TransactionHash")?.GetValue(transferResult)?.ToString();
+
+                        if (string.IsNullOrEmpty(transactionHash))
+                        {
+                            throw new WalletException("Failed to get transaction hash from transfer result");
+                        }
+
+                        additionalData["TransactionHash"] = transactionHash;
+
+                        return transactionHash;
+                    },
+                    "TransferGasMulti",
+                    requestId,
+                    additionalData);
+
+                if (!result.success)
+                {
+                    throw new WalletException("Failed to transfer GAS");
+                }
+
+                Common.Utilities.LoggingUtility.LogOperationSuccess(_logger, "TransferGasMulti", requestId, 0, additionalData);
+
+                return result.result;
+            }
+            catch (Exception ex)
+            {
+                Common.Utilities.LoggingUtility.LogOperationFailure(_logger, "TransferGasMulti", requestId, ex, 0, additionalData);
+                throw new WalletException("Error transferring GAS", ex);
+            }
+        }
+
+        /// <inheritdoc/>
+        public async Task<string> InvokeContractAsync(Guid walletId, string password, string scriptHash, string operation, IEnumerable<NeoContractParameter> args, decimal systemFeeLimit)
+        {
+            var requestId = Guid.NewGuid().ToString();
+            var additionalData = new Dictionary<string, object>
+            {
+                ["WalletId"] = walletId,
+                ["ScriptHash"] = scriptHash,
+                ["Operation"] = operation,
+                ["SystemFeeLimit"] = systemFeeLimit
+            };
+
+            Common.Utilities.LoggingUtility.LogOperationStart(_logger, "InvokeContract", requestId, additionalData);
+
+            try
+            {
+                // Validate input
+                Common.Utilities.ValidationUtility.ValidateGuid(walletId, "Wallet ID");
+                Common.Utilities.ValidationUtility.ValidateNotNullOrEmpty(password, "Password");
+                Common.Utilities.ValidationUtility.ValidateNotNullOrEmpty(operation, "Operation");
+                Common.Utilities.ValidationUtility.ValidateGreaterThanZero(systemFeeLimit, "System fee limit");
+
+                if (!NeoServiceLayer.Core.Extensions.StringExtensions.IsValidScriptHash(scriptHash))
+                {
+                    throw new WalletException("Invalid script hash format");
+                }
+
+                var result = await Common.Utilities.ExceptionUtility.ExecuteWithExceptionHandlingAsync<WalletService, string>(
+                    _logger,
+                    async () =>
+                    {
+                        // Get wallet from repository
+                        var wallet = await _walletRepository.GetByIdAsync(walletId);
+                        if (wallet == null)
+                        {
+                            throw new WalletException("Wallet not found");
+                        }
+
+                        additionalData["FromAddress"] = wallet.Address;
+
+                        // Send invocation request to enclave
+                        var invokeRequest = new
+                        {
+                            WalletId = walletId,
+                            AccountId = wallet.AccountId ?? Guid.Empty,
+                            Password = password,
+                            ScriptHash = scriptHash,
+                            Operation = operation,
+                            Args = args?.ToList() ?? new List<NeoContractParameter>(),
+                            SystemFeeLimit = systemFeeLimit
+                        };
+
+                        var invokeResult = await _enclaveService.SendRequestAsync<object, object>(
+                            Constants.EnclaveServiceTypes.Wallet,
+                            Constants.WalletOperations.InvokeWrite,
+                            invokeRequest);
+
+                        // Extract transaction hash from result
+                        var transactionHash = invokeResult.GetType().GetProperty("TransactionHash")?.GetValue(invokeResult)?.ToString();
+
+                        if (string.IsNullOrEmpty(transactionHash))
+                        {
+                            throw new WalletException("Failed to get transaction hash from invocation result");
+                        }
+
+                        additionalData["TransactionHash"] = transactionHash;
+
+                        return transactionHash;
+                    },
+                    "InvokeContract",
+                    requestId,
+                    additionalData);
+
+                if (!result.success)
+                {
+                    throw new WalletException("Failed to invoke contract");
+                }
+
+                Common.Utilities.LoggingUtility.LogOperationSuccess(_logger, "InvokeContract", requestId, 0, additionalData);
+
+                return result.result;
+            }
+            catch (Exception ex)
+            {
+                Common.Utilities.LoggingUtility.LogOperationFailure(_logger, "InvokeContract", requestId, ex, 0, additionalData);
+                throw new WalletException("Error invoking contract", ex);
+            }
+        }
+
         /// <inheritdoc/>
         public async Task<string> TransferTokenAsync(Guid walletId, string password, string toAddress, string tokenHash, decimal amount)
         {
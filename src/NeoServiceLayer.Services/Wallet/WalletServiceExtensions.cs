@@ -18,9 +18,12 @@ namespace NeoServiceLayer.Services.Wallet
         {
             // Register repositories
             services.AddSingleton<IWalletRepository, WalletRepository>();
+            services.AddSingleton<IMultiSigTransactionRepository, MultiSigTransactionRepository>();
 
             // Register services
             services.AddSingleton<IWalletService, WalletService>();
+            services.AddSingleton<ISignerPool, SignerPool>();
+            services.AddSingleton<IMultiSigService, MultiSigService>();
 
             return services;
         }
@@ -0,0 +1,10 @@
+function goldenArithmetic(params) {
+    return {
+        sum: params.a + params.b,
+        product: params.a * params.b,
+        quotient: params.a / params.b,
+        remainder: params.a % params.b,
+        rounded: Math.round(params.a / params.b),
+        largest: Math.max(params.a, params.b)
+    };
+}
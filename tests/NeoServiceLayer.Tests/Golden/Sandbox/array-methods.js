@@ -0,0 +1,12 @@
+function goldenArrayMethods(params) {
+    const numbers = params.numbers;
+    const sorted = numbers.slice().sort((a, b) => a - b);
+    return {
+        sorted: sorted,
+        doubled: numbers.map(n => n * 2),
+        evens: numbers.filter(n => n % 2 === 0),
+        total: numbers.reduce((sum, n) => sum + n, 0),
+        firstAboveFour: numbers.find(n => n > 4),
+        indexOfEight: numbers.indexOf(8)
+    };
+}
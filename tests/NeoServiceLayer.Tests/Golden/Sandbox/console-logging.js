@@ -0,0 +1,8 @@
+function goldenConsoleLogging(params) {
+    console.log('starting');
+    console.warn('careful');
+    console.error('failed step');
+    console.debug('details');
+    console.log(`processed ${params.items.length} items`);
+    return 'done';
+}
@@ -0,0 +1,3 @@
+function goldenEnvironment(params) {
+    console.log(`network is ${env[params.key]}`);
+}
@@ -0,0 +1,9 @@
+function goldenJsonRoundtrip(params) {
+    const serialized = JSON.stringify({ asset: params.asset, amounts: params.amounts, memo: null });
+    const parsed = JSON.parse(serialized);
+    return {
+        serialized: serialized,
+        parsed: parsed,
+        nestedAsset: params.order.asset.symbol
+    };
+}
@@ -0,0 +1,10 @@
+function goldenStrings(params) {
+    const words = params.sentence.split(' ');
+    return {
+        greeting: `Hello, ${params.name.toUpperCase()}!`,
+        reversed: words.slice().reverse().join(' '),
+        padded: String(params.code).padStart(5, '0'),
+        length: params.sentence.length,
+        includesBeta: params.sentence.includes('beta')
+    };
+}
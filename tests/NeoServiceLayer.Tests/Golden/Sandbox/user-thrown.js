@@ -0,0 +1,6 @@
+function goldenUserThrown(params) {
+    if (params.amount <= 0) {
+        throw new Error('invalid amount');
+    }
+    return params.amount;
+}
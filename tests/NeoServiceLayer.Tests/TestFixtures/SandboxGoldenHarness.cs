@@ -0,0 +1,173 @@
+using System.Text.RegularExpressions;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Enclave.Enclave.Execution;
+using NeoServiceLayer.Enclave.Enclave.Services;
+using Newtonsoft.Json;
+using Newtonsoft.Json.Linq;
+
+namespace NeoServiceLayer.Tests.TestFixtures
+{
+    /// <summary>
+    /// Runs the sandbox golden corpus and compares each function's output against its stored golden file
+    /// </summary>
+    /// <remarks>
+    /// Each case in Golden/Sandbox is a <c>name.js</c> source with a <c>name.input.json</c> holding the entry
+    /// point and parameters, and a <c>name.golden.json</c> holding the expected output. Only the parts of the
+    /// output that describe behavior are compared: the result, the captured logs, and for failing functions the
+    /// error code and message. Timings, memory and gas figures vary between runs and are left out.
+    /// Set NSL_UPDATE_GOLDEN=1 to rewrite the golden files from the current runtime instead of comparing.
+    /// </remarks>
+    public class SandboxGoldenHarness
+    {
+        public const string UpdateVariable = "NSL_UPDATE_GOLDEN";
+
+        private static readonly Regex ErrorLocation = new Regex(@" \(line \d+, column \d+\)$", RegexOptions.Compiled);
+
+        private readonly NodeJsRuntime _runtime;
+
+        public SandboxGoldenHarness()
+        {
+            var walletService = new EnclaveWalletService(new Mock<ILogger<EnclaveWalletService>>().Object);
+            var priceFeedService = new EnclavePriceFeedService(new Mock<ILogger<EnclavePriceFeedService>>().Object, walletService);
+            var secretsService = new EnclaveSecretsService(new Mock<ILogger<EnclaveSecretsService>>().Object);
+
+            var functionExecutor = new Mock<FunctionExecutor>(
+                new Mock<ILogger<FunctionExecutor>>().Object,
+                null,
+                new DotNetRuntime(new Mock<ILogger<DotNetRuntime>>().Object),
+                new PythonRuntime(new Mock<ILogger<PythonRuntime>>().Object));
+            var functionService = new EnclaveFunctionService(
+                new Mock<ILogger<EnclaveFunctionService>>().Object,
+                functionExecutor.Object);
+
+            _runtime = new NodeJsRuntime(
+                new Mock<ILogger<NodeJsRuntime>>().Object,
+                priceFeedService,
+                secretsService,
+                walletService,
+                functionService);
+        }
+
+        /// <summary>
+        /// Gets the directory holding the golden corpus
+        /// </summary>
+        public static string CorpusDirectory => Path.Combine(AppContext.BaseDirectory, "Golden", "Sandbox");
+
+        /// <summary>
+        /// Gets whether golden files should be rewritten rather than compared
+        /// </summary>
+        public static bool UpdateMode => Environment.GetEnvironmentVariable(UpdateVariable) == "1";
+
+        /// <summary>
+        /// Gets the names of the cases in the corpus
+        /// </summary>
+        public static IEnumerable<string> GetCaseNames()
+        {
+            if (!Directory.Exists(CorpusDirectory))
+            {
+                return Enumerable.Empty<string>();
+            }
+
+            return Directory.GetFiles(CorpusDirectory, "*.js")
+                .Select(Path.GetFileNameWithoutExtension)
+                .OrderBy(name => name, StringComparer.Ordinal)
+                .ToList()!;
+        }
+
+        /// <summary>
+        /// Runs a case and returns its normalized output
+        /// </summary>
+        /// <param name="caseName">Case name</param>
+        /// <returns>Normalized output</returns>
+        public async Task<JObject> RunAsync(string caseName)
+        {
+            var sourceCode = await File.ReadAllTextAsync(Path.Combine(CorpusDirectory, caseName + ".js"));
+            var input = JObject.Parse(await File.ReadAllTextAsync(Path.Combine(CorpusDirectory, caseName + ".input.json")));
+            var entryPoint = input.Value<string>("entryPoint") ?? caseName;
+            var parameters = input["parameters"]?.ToObject<Dictionary<string, object>>() ?? new Dictionary<string, object>();
+
+            var context = new FunctionExecutionContext
+            {
+                FunctionId = Guid.Empty,
+                AccountId = Guid.Empty,
+                MaxExecutionTime = 5000,
+                MaxMemory = 128,
+                EnvironmentVariables = input["environment"]?.ToObject<Dictionary<string, string>>() ?? new Dictionary<string, string>()
+            };
+
+            try
+            {
+                var compiled = await _runtime.CompileAsync(sourceCode, entryPoint);
+                var output = JObject.FromObject(await _runtime.ExecuteAsync(compiled, entryPoint, parameters, context));
+                return new JObject
+                {
+                    ["result"] = output["Result"] ?? JValue.CreateNull(),
+                    ["logs"] = output["Logs"] ?? new JArray()
+                };
+            }
+            catch (SandboxException ex)
+            {
+                // Error locations depend on the wrapper's line offsets, which are not part of the contract
+                return new JObject
+                {
+                    ["error"] = new JObject
+                    {
+                        ["code"] = ex.ErrorCode,
+                        ["message"] = ErrorLocation.Replace(ex.Message, string.Empty)
+                    }
+                };
+            }
+        }
+
+        /// <summary>
+        /// Runs a case and compares its output against the golden file, or rewrites the golden file in update mode
+        /// </summary>
+        /// <param name="caseName">Case name</param>
+        /// <returns>A description of the mismatch, or null when the output matches</returns>
+        public async Task<string?> VerifyAsync(string caseName)
+        {
+            var actual = await RunAsync(caseName);
+            var goldenPath = Path.Combine(CorpusDirectory, caseName + ".golden.json");
+
+            if (UpdateMode)
+            {
+                // Write to the source tree rather than the build output, so the change can be reviewed and committed
+                var directory = FindSourceCorpusDirectory() ?? CorpusDirectory;
+                await File.WriteAllTextAsync(Path.Combine(directory, caseName + ".golden.json"), actual.ToString(Formatting.Indented) + Environment.NewLine);
+                return null;
+            }
+
+            if (!File.Exists(goldenPath))
+            {
+                return $"No golden file for '{caseName}'. Run the tests with {UpdateVariable}=1 to create it.";
+            }
+
+            var expected = JObject.Parse(await File.ReadAllTextAsync(goldenPath));
+            if (JToken.DeepEquals(expected, actual))
+            {
+                return null;
+            }
+
+            return $"Output of '{caseName}' differs from its golden file.{Environment.NewLine}" +
+                $"Expected:{Environment.NewLine}{expected.ToString(Formatting.Indented)}{Environment.NewLine}" +
+                $"Actual:{Environment.NewLine}{actual.ToString(Formatting.Indented)}";
+        }
+
+        private static string? FindSourceCorpusDirectory()
+        {
+            var directory = new DirectoryInfo(AppContext.BaseDirectory);
+            while (directory != null)
+            {
+                var candidate = Path.Combine(directory.FullName, "Golden", "Sandbox");
+                if (Directory.Exists(candidate) && File.Exists(Path.Combine(directory.FullName, "NeoServiceLayer.Tests.csproj")))
+                {
+                    return candidate;
+                }
+                directory = directory.Parent;
+            }
+            return null;
+        }
+    }
+}
@@ -0,0 +1,56 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Account;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class AccountLimitsServiceTests
+    {
+        [Fact]
+        public async Task GetLimitsAsync_ReportsConfiguredLimitsAndUsage()
+        {
+            // Arrange
+            var accountId = Guid.NewGuid();
+            var functionService = new Mock<IFunctionService>();
+            functionService.Setup(s => s.GetByAccountIdAsync(accountId))
+                .ReturnsAsync(new List<Function> { new Function(), new Function() });
+            var secretsService = new Mock<ISecretsService>();
+            secretsService.Setup(s => s.GetByAccountIdAsync(accountId))
+                .ReturnsAsync(new List<Secret> { new Secret() });
+            var executionTracker = new Mock<IExecutionTracker>();
+            executionTracker.Setup(t => t.GetAll()).Returns(new List<ActiveExecution>
+            {
+                new ActiveExecution { AccountId = accountId },
+                new ActiveExecution { AccountId = Guid.NewGuid() }
+            });
+
+            var service = new AccountLimitsService(
+                new Mock<ILogger<AccountLimitsService>>().Object,
+                functionService.Object,
+                secretsService.Object,
+                Options.Create(new AccountLimitsConfiguration { MaxFunctions = 5, MaxConcurrentExecutions = 3 }),
+                executionTracker.Object);
+
+            // Act
+            var limits = await service.GetLimitsAsync(accountId);
+
+            // Assert
+            Assert.Equal(5, limits.Functions.Limit);
+            Assert.Equal(2, limits.Functions.Used);
+            Assert.Equal(3, limits.Functions.Remaining);
+            Assert.Equal(1, limits.ConcurrentExecutions.Used);
+            Assert.Equal(Constants.SecretsConfig.MaxSecretsPerAccount, limits.Secrets.Limit);
+            Assert.Equal(1, limits.Secrets.Used);
+            Assert.Equal(30000, limits.MaxExecutionTimeMs);
+            Assert.Null(limits.RateLimit);
+        }
+    }
+}
@@ -0,0 +1,106 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.ApiCredentials;
+using NeoServiceLayer.Services.ApiCredentials.Repositories;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ApiCredentialServiceTests
+    {
+        private readonly List<ApiCredential> _credentials = new List<ApiCredential>();
+        private readonly ApiCredentialService _service;
+        private readonly Guid _accountId = Guid.NewGuid();
+
+        public ApiCredentialServiceTests()
+        {
+            var repository = new Mock<IApiCredentialRepository>();
+            repository.Setup(r => r.CreateAsync(It.IsAny<ApiCredential>()))
+                .ReturnsAsync((ApiCredential c) =>
+                {
+                    _credentials.Add(c);
+                    return c;
+                });
+            repository.Setup(r => r.GetByIdAsync(It.IsAny<Guid>()))
+                .ReturnsAsync((Guid id) => _credentials.FirstOrDefault(c => c.Id == id));
+            repository.Setup(r => r.GetByAccountIdAsync(It.IsAny<Guid>()))
+                .ReturnsAsync((Guid accountId) => _credentials.Where(c => c.AccountId == accountId).ToList());
+            repository.Setup(r => r.UpdateAsync(It.IsAny<ApiCredential>()))
+                .ReturnsAsync((ApiCredential c) => c);
+
+            _service = new ApiCredentialService(
+                new Mock<ILogger<ApiCredentialService>>().Object,
+                repository.Object,
+                Options.Create(new ApiCredentialConfiguration { MaxKeysPerAccount = 2 }));
+        }
+
+        [Fact]
+        public async Task CreateAsync_ThenAuthenticateAsync_ReturnsCredentialWithScopes()
+        {
+            // Act
+            var created = await _service.CreateAsync(_accountId, "billing", new[] { ApiScopes.FunctionsInvoke });
+            var credential = await _service.AuthenticateAsync(created.Key);
+
+            // Assert
+            Assert.NotNull(credential);
+            Assert.Equal(_accountId, credential.AccountId);
+            Assert.Equal(new[] { ApiScopes.FunctionsInvoke }, credential.Scopes);
+            Assert.StartsWith(credential.KeyPrefix, created.Key);
+            Assert.DoesNotContain(created.Key, credential.KeyHash);
+            Assert.NotNull(credential.LastUsedAt);
+        }
+
+        [Fact]
+        public async Task AuthenticateAsync_WrongSecretOrRevokedKey_ReturnsNull()
+        {
+            // Arrange
+            var created = await _service.CreateAsync(_accountId, "billing", new[] { ApiScopes.SecretsWrite });
+            var tampered = created.Key.Substring(0, created.Key.Length - 1) + (created.Key.EndsWith("A") ? "B" : "A");
+
+            // Act
+            var wrongSecret = await _service.AuthenticateAsync(tampered);
+            await _service.RevokeAsync(created.Credential.Id, _accountId);
+            var revoked = await _service.AuthenticateAsync(created.Key);
+
+            // Assert
+            Assert.Null(wrongSecret);
+            Assert.Null(revoked);
+        }
+
+        [Fact]
+        public async Task CreateAsync_UnknownScopeOrTooManyKeys_Throws()
+        {
+            // Arrange
+            await _service.CreateAsync(_accountId, "one", new[] { ApiScopes.TriggersManage });
+            await _service.CreateAsync(_accountId, "two", new[] { ApiScopes.TriggersManage });
+
+            // Act & Assert
+            await Assert.ThrowsAsync<ArgumentException>(() => _service.CreateAsync(Guid.NewGuid(), "bad", new[] { "admin:all" }));
+            await Assert.ThrowsAsync<NeoServiceLayer.Core.Exceptions.ValidationException>(() =>
+                _service.CreateAsync(_accountId, "three", new[] { ApiScopes.TriggersManage }));
+        }
+
+        [Fact]
+        public void TryCountRequest_OverLimit_ReturnsFalse()
+        {
+            // Arrange
+            var credentialId = Guid.NewGuid();
+
+            // Act
+            var first = _service.TryCountRequest(credentialId, 2, 60);
+            var second = _service.TryCountRequest(credentialId, 2, 60);
+            var third = _service.TryCountRequest(credentialId, 2, 60);
+
+            // Assert
+            Assert.True(first);
+            Assert.True(second);
+            Assert.False(third);
+        }
+    }
+}
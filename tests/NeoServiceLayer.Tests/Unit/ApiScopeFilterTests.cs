@@ -0,0 +1,124 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Security.Claims;
+using Microsoft.AspNetCore.Http;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.AspNetCore.Mvc.Abstractions;
+using Microsoft.AspNetCore.Mvc.Filters;
+using Microsoft.AspNetCore.Routing;
+using NeoServiceLayer.API.Auth;
+using NeoServiceLayer.API.Filters;
+using NeoServiceLayer.Core.Models;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ApiScopeFilterTests
+    {
+        private readonly ApiScopeFilter _filter = new ApiScopeFilter();
+
+        [Fact]
+        public void OnAuthorization_ReadWithoutReadScope_IsForbidden()
+        {
+            // Arrange
+            var context = CreateContext("GET", new[] { ApiScopes.FunctionsInvoke });
+
+            // Act
+            _filter.OnAuthorization(context);
+
+            // Assert
+            var result = Assert.IsType<ObjectResult>(context.Result);
+            Assert.Equal(StatusCodes.Status403Forbidden, result.StatusCode);
+        }
+
+        [Fact]
+        public void OnAuthorization_ReadWithAccountRead_IsAllowed()
+        {
+            // Arrange
+            var context = CreateContext("GET", new[] { ApiScopes.AccountRead });
+
+            // Act
+            _filter.OnAuthorization(context);
+
+            // Assert
+            Assert.Null(context.Result);
+        }
+
+        [Fact]
+        public void OnAuthorization_SecretsReadWithAccountReadOnly_IsForbidden()
+        {
+            // Arrange
+            var context = CreateContext("GET", new[] { ApiScopes.AccountRead }, new ApiReadScopeAttribute(ApiScopes.SecretsRead));
+
+            // Act
+            _filter.OnAuthorization(context);
+
+            // Assert
+            var result = Assert.IsType<ObjectResult>(context.Result);
+            Assert.Equal(StatusCodes.Status403Forbidden, result.StatusCode);
+        }
+
+        [Fact]
+        public void OnAuthorization_SecretsReadWithSecretsRead_IsAllowed()
+        {
+            // Arrange
+            var context = CreateContext("GET", new[] { ApiScopes.SecretsRead }, new ApiReadScopeAttribute(ApiScopes.SecretsRead));
+
+            // Act
+            _filter.OnAuthorization(context);
+
+            // Assert
+            Assert.Null(context.Result);
+        }
+
+        [Fact]
+        public void OnAuthorization_UnscopedChange_IsForbiddenWhateverTheScopes()
+        {
+            // Arrange
+            var context = CreateContext("POST", ApiScopes.All.ToArray());
+
+            // Act
+            _filter.OnAuthorization(context);
+
+            // Assert
+            var result = Assert.IsType<ObjectResult>(context.Result);
+            Assert.Equal(StatusCodes.Status403Forbidden, result.StatusCode);
+        }
+
+        [Fact]
+        public void OnAuthorization_BearerUser_IsNotChecked()
+        {
+            // Arrange
+            var httpContext = new DefaultHttpContext
+            {
+                User = new ClaimsPrincipal(new ClaimsIdentity(new[] { new Claim(ClaimTypes.NameIdentifier, "user-1") }, "Bearer"))
+            };
+            httpContext.Request.Method = "GET";
+            var context = CreateContext(httpContext, new ApiReadScopeAttribute(ApiScopes.SecretsRead));
+
+            // Act
+            _filter.OnAuthorization(context);
+
+            // Assert
+            Assert.Null(context.Result);
+        }
+
+        private static AuthorizationFilterContext CreateContext(string method, string[] scopes, params object[] metadata)
+        {
+            var claims = new List<Claim> { new Claim(ApiKeyAuthenticationHandler.CredentialTypeClaimType, "account") };
+            claims.AddRange(scopes.Select(s => new Claim(ApiKeyAuthenticationHandler.ScopeClaimType, s)));
+
+            var httpContext = new DefaultHttpContext { User = new ClaimsPrincipal(new ClaimsIdentity(claims, "ApiKey")) };
+            httpContext.Request.Method = method;
+            return CreateContext(httpContext, metadata);
+        }
+
+        private static AuthorizationFilterContext CreateContext(HttpContext httpContext, params object[] metadata)
+        {
+            var actionDescriptor = new ActionDescriptor { EndpointMetadata = metadata.ToList() };
+            return new AuthorizationFilterContext(
+                new ActionContext(httpContext, new RouteData(), actionDescriptor),
+                new List<IFilterMetadata>());
+        }
+    }
+}
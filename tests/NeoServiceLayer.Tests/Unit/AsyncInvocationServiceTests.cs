@@ -0,0 +1,99 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Function;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class AsyncInvocationServiceTests : IDisposable
+    {
+        private readonly List<AsyncInvocation> _invocations = new List<AsyncInvocation>();
+        private readonly Mock<IAsyncInvocationRepository> _repositoryMock;
+        private readonly Mock<IFunctionService> _functionServiceMock;
+        private readonly AsyncInvocationService _service;
+
+        public AsyncInvocationServiceTests()
+        {
+            _repositoryMock = new Mock<IAsyncInvocationRepository>();
+            _repositoryMock
+                .Setup(x => x.CreateAsync(It.IsAny<AsyncInvocation>()))
+                .ReturnsAsync((AsyncInvocation invocation) =>
+                {
+                    lock (_invocations)
+                    {
+                        _invocations.Add(invocation);
+                    }
+
+                    return invocation;
+                });
+            _repositoryMock
+                .Setup(x => x.GetByStatusAsync(It.IsAny<AsyncInvocationStatus>()))
+                .ReturnsAsync((AsyncInvocationStatus status) =>
+                {
+                    lock (_invocations)
+                    {
+                        return _invocations.Where(i => i.Status == status).ToList();
+                    }
+                });
+            _repositoryMock.Setup(x => x.UpdateAsync(It.IsAny<AsyncInvocation>())).ReturnsAsync((AsyncInvocation invocation) => invocation);
+            _repositoryMock.Setup(x => x.GetDueCallbacksAsync(It.IsAny<DateTime>())).ReturnsAsync(new List<AsyncInvocation>());
+
+            _functionServiceMock = new Mock<IFunctionService>();
+
+            _service = new AsyncInvocationService(
+                new Mock<ILogger<AsyncInvocationService>>().Object,
+                _repositoryMock.Object,
+                _functionServiceMock.Object,
+                Options.Create(new AsyncInvocationConfiguration { PollIntervalSeconds = 3600 }));
+        }
+
+        public void Dispose()
+        {
+            _service.Dispose();
+        }
+
+        [Fact]
+        public async Task EnqueueAsync_RunsInvocationInBackgroundAndKeepsResult()
+        {
+            // Arrange
+            var functionId = Guid.NewGuid();
+            var parameters = new Dictionary<string, object> { ["symbol"] = "NEO" };
+            var execution = new TaskCompletionSource<object>();
+            _functionServiceMock
+                .Setup(x => x.ExecuteAsync(functionId, parameters, "session"))
+                .Returns(execution.Task);
+
+            // Act
+            var invocation = await _service.EnqueueAsync(functionId, Guid.NewGuid(), parameters, "session");
+            execution.SetResult(12.34);
+
+            for (var i = 0; i < 50 && !invocation.IsFinished; i++)
+            {
+                await Task.Delay(100);
+            }
+
+            // Assert
+            Assert.Equal(AsyncInvocationStatus.Completed, invocation.Status);
+            Assert.Equal(12.34, invocation.Result);
+            Assert.Equal(AsyncCallbackStatus.None, invocation.CallbackStatus);
+            Assert.NotNull(invocation.CompletedAt);
+        }
+
+        [Fact]
+        public async Task EnqueueAsync_InvalidCallbackUrl_ThrowsValidationException()
+        {
+            // Act & Assert
+            await Assert.ThrowsAsync<ValidationException>(() =>
+                _service.EnqueueAsync(Guid.NewGuid(), Guid.NewGuid(), null, callbackUrl: "ftp://example.com/hook"));
+            _repositoryMock.Verify(x => x.CreateAsync(It.IsAny<AsyncInvocation>()), Times.Never);
+        }
+    }
+}
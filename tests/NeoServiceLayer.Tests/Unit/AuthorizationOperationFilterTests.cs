@@ -0,0 +1,146 @@
+using System.Linq;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.AspNetCore.Mvc;
+using Microsoft.AspNetCore.Mvc.ApiExplorer;
+using Microsoft.OpenApi.Any;
+using Microsoft.OpenApi.Models;
+using Moq;
+using NeoServiceLayer.API.Filters;
+using NeoServiceLayer.API.Swagger;
+using NeoServiceLayer.Core.Models;
+using Swashbuckle.AspNetCore.SwaggerGen;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class AuthorizationOperationFilterTests
+    {
+        private readonly AuthorizationOperationFilter _filter = new AuthorizationOperationFilter();
+
+        private OpenApiOperation Apply(string actionName)
+        {
+            var operation = new OpenApiOperation();
+            var context = new OperationFilterContext(
+                new ApiDescription(),
+                new Mock<ISchemaGenerator>().Object,
+                new SchemaRepository(),
+                typeof(SampleController).GetMethod(actionName));
+
+            _filter.Apply(operation, context);
+            return operation;
+        }
+
+        [Fact]
+        public void Apply_AuthorizedController_AcceptsBearerOrApiKey()
+        {
+            // Act
+            var operation = Apply(nameof(SampleController.Get));
+
+            // Assert
+            Assert.Equal(2, operation.Security.Count);
+            Assert.Equal(new[] { "Bearer", "ApiKey" }, operation.Security.Select(r => r.Keys.Single().Reference.Id));
+            Assert.True(operation.Responses.ContainsKey("401"));
+            Assert.False(operation.Responses.ContainsKey("403"));
+        }
+
+        [Fact]
+        public void Apply_AllowAnonymous_AddsNoSecurity()
+        {
+            // Act
+            var operation = Apply(nameof(SampleController.Health));
+
+            // Assert
+            Assert.Empty(operation.Security);
+            Assert.Empty(operation.Responses);
+        }
+
+        [Fact]
+        public void Apply_ScopedAction_AddsScopeExtension()
+        {
+            // Act
+            var operation = Apply(nameof(SampleController.Execute));
+
+            // Assert
+            Assert.Equal(ApiScopes.FunctionsInvoke, ((OpenApiString)operation.Extensions["x-api-scope"]).Value);
+            Assert.Contains(ApiScopes.FunctionsInvoke, operation.Description);
+            Assert.True(operation.Responses.ContainsKey("403"));
+        }
+
+        [Fact]
+        public void Apply_ReadAction_AddsReadScopeExtension()
+        {
+            // Act
+            var operation = Apply(nameof(SampleController.List));
+
+            // Assert
+            Assert.Equal(ApiScopes.AccountRead, ((OpenApiString)operation.Extensions["x-api-scope"]).Value);
+            Assert.True(operation.Responses.ContainsKey("403"));
+        }
+
+        [Fact]
+        public void Apply_ReadActionOfScopedController_AddsControllerReadScope()
+        {
+            // Arrange
+            var operation = new OpenApiOperation();
+            var context = new OperationFilterContext(
+                new ApiDescription(),
+                new Mock<ISchemaGenerator>().Object,
+                new SchemaRepository(),
+                typeof(SampleSecretsController).GetMethod(nameof(SampleSecretsController.List)));
+
+            // Act
+            _filter.Apply(operation, context);
+
+            // Assert
+            Assert.Equal(ApiScopes.SecretsRead, ((OpenApiString)operation.Extensions["x-api-scope"]).Value);
+        }
+
+        [Fact]
+        public void Apply_RoleRestrictedAction_Adds403()
+        {
+            // Act
+            var operation = Apply(nameof(SampleController.Purge));
+
+            // Assert
+            Assert.Equal("Requires role Admin", operation.Responses["403"].Description);
+        }
+
+        [Authorize]
+        private class SampleController
+        {
+            public void Get()
+            {
+            }
+
+            [AllowAnonymous]
+            public void Health()
+            {
+            }
+
+            [ApiScope(ApiScopes.FunctionsInvoke)]
+            public void Execute()
+            {
+            }
+
+            [HttpGet]
+            public void List()
+            {
+            }
+
+            [Authorize(Roles = "Admin")]
+            public void Purge()
+            {
+            }
+        }
+
+        [Authorize]
+        [ApiReadScope(ApiScopes.SecretsRead)]
+        private class SampleSecretsController
+        {
+            [HttpGet]
+            public void List()
+            {
+            }
+        }
+    }
+}
@@ -0,0 +1,128 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Services.Automation;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class AutomationServiceTests
+    {
+        private const string ContractHash = "0x1234567890abcdef1234567890abcdef12345678";
+
+        private readonly Mock<IUpkeepRepository> _upkeepRepository = new Mock<IUpkeepRepository>();
+        private readonly Mock<INeoRpcClient> _rpcClient = new Mock<INeoRpcClient>();
+        private readonly Mock<IGasBankService> _gasBankService = new Mock<IGasBankService>();
+        private readonly Mock<IWalletService> _walletService = new Mock<IWalletService>();
+        private readonly AutomationService _service;
+
+        private readonly GasBankAccount _gasBankAccount = new GasBankAccount
+        {
+            Id = Guid.NewGuid(),
+            AccountId = Guid.NewGuid(),
+            WalletId = Guid.NewGuid(),
+            Balance = 10m
+        };
+
+        public AutomationServiceTests()
+        {
+            _upkeepRepository.Setup(r => r.UpdateAsync(It.IsAny<Upkeep>())).ReturnsAsync((Upkeep upkeep) => upkeep);
+            _gasBankService.Setup(s => s.GetByIdAsync(_gasBankAccount.Id)).ReturnsAsync(_gasBankAccount);
+            _rpcClient
+                .Setup(c => c.InvokeFunctionAsync(ContractHash, "checkUpkeep", It.IsAny<IEnumerable<NeoContractParameter>>()))
+                .ReturnsAsync(new NeoInvokeResult
+                {
+                    State = "HALT",
+                    Stack = new List<NeoStackItem>
+                    {
+                        new NeoStackItem
+                        {
+                            Type = "Array",
+                            Items = new List<NeoStackItem>
+                            {
+                                new NeoStackItem { Type = "Boolean", Value = "true" },
+                                new NeoStackItem { Type = "ByteString", Value = Convert.ToBase64String(new byte[] { 1, 2 }) }
+                            }
+                        }
+                    }
+                });
+
+            _service = new AutomationService(
+                new Mock<ILogger<AutomationService>>().Object,
+                _upkeepRepository.Object,
+                _rpcClient.Object,
+                _gasBankService.Object,
+                _walletService.Object,
+                Options.Create(new AutomationConfiguration()));
+        }
+
+        private Upkeep CreateUpkeep(decimal gasLimit)
+        {
+            return new Upkeep
+            {
+                Id = Guid.NewGuid(),
+                AccountId = _gasBankAccount.AccountId,
+                Name = "rebalance",
+                ContractHash = ContractHash,
+                GasBankAccountId = _gasBankAccount.Id,
+                GasLimit = gasLimit,
+                Status = UpkeepStatus.Active
+            };
+        }
+
+        private void SetupPerformGas(string gasConsumed)
+        {
+            _rpcClient
+                .Setup(c => c.InvokeFunctionAsync(ContractHash, "performUpkeep", It.IsAny<IEnumerable<NeoContractParameter>>()))
+                .ReturnsAsync(new NeoInvokeResult { State = "HALT", GasConsumed = gasConsumed });
+        }
+
+        [Fact]
+        public async Task CheckAndPerformAsync_UpkeepNeeded_SendsPerformUpkeepAndChargesGasBank()
+        {
+            // Arrange
+            var upkeep = CreateUpkeep(1m);
+            SetupPerformGas("25000000");
+            _walletService
+                .Setup(w => w.InvokeContractAsync(_gasBankAccount.WalletId, It.IsAny<string>(), ContractHash, "performUpkeep",
+                    It.IsAny<IEnumerable<NeoContractParameter>>(), 1m))
+                .ReturnsAsync("0xabc");
+
+            // Act
+            var result = await _service.CheckAndPerformAsync(upkeep);
+
+            // Assert
+            Assert.Null(result.LastError);
+            Assert.Equal(1, result.PerformCount);
+            Assert.Equal(0.25m, result.TotalGasSpent);
+            Assert.Equal("0xabc", result.LastTransactionHash);
+            _gasBankService.Verify(s => s.ChargeNetworkFeeAsync(_gasBankAccount.Id, 0.25m, "0xabc", It.IsAny<string>(), upkeep.Id), Times.Once);
+        }
+
+        [Fact]
+        public async Task CheckAndPerformAsync_GasAboveLimit_DoesNotSend()
+        {
+            // Arrange
+            var upkeep = CreateUpkeep(0.1m);
+            SetupPerformGas("25000000");
+
+            // Act
+            var result = await _service.CheckAndPerformAsync(upkeep);
+
+            // Assert
+            Assert.Contains("gas limit", result.LastError);
+            Assert.Equal(0, result.PerformCount);
+            Assert.NotNull(result.LastCheckedAt);
+            _walletService.Verify(w => w.InvokeContractAsync(It.IsAny<Guid>(), It.IsAny<string>(), It.IsAny<string>(), It.IsAny<string>(),
+                It.IsAny<IEnumerable<NeoContractParameter>>(), It.IsAny<decimal>()), Times.Never);
+            _gasBankService.Verify(s => s.ChargeNetworkFeeAsync(It.IsAny<Guid>(), It.IsAny<decimal>(), It.IsAny<string>(),
+                It.IsAny<string>(), It.IsAny<Guid?>()), Times.Never);
+        }
+    }
+}
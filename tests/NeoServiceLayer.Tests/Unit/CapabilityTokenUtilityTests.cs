@@ -0,0 +1,139 @@
+using System;
+using System.Collections.Generic;
+using System.Text;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class CapabilityTokenUtilityTests
+    {
+        private static readonly DateTime Now = new DateTime(2024, 1, 1, 12, 0, 0, DateTimeKind.Utc);
+        private static readonly byte[] Key = Encoding.UTF8.GetBytes("0123456789abcdef0123456789abcdef");
+
+        private static TeeCapabilityClaims CreateClaims()
+        {
+            return new TeeCapabilityClaims
+            {
+                TokenId = Guid.NewGuid().ToString(),
+                Subject = "pricefeed-service",
+                Scopes = new List<string> { "pricefeed.submitToOracle" },
+                IssuedAt = Now,
+                ExpiresAt = Now.AddMinutes(5)
+            };
+        }
+
+        [Fact]
+        public void TryValidate_ValidToken_ReturnsClaims()
+        {
+            // Arrange
+            var token = CapabilityTokenUtility.Create(CreateClaims(), Key);
+
+            // Act
+            var valid = CapabilityTokenUtility.TryValidate(token, Key, Now.AddMinutes(1), out var claims, out var error);
+
+            // Assert
+            Assert.True(valid);
+            Assert.Null(error);
+            Assert.Equal("pricefeed-service", claims.Subject);
+            Assert.Equal(new[] { "pricefeed.submitToOracle" }, claims.Scopes);
+        }
+
+        [Fact]
+        public void TryValidate_TamperedClaims_Fails()
+        {
+            // Arrange
+            var token = CapabilityTokenUtility.Create(CreateClaims(), Key);
+            var widened = CreateClaims();
+            widened.Scopes = new List<string> { "wallet.*" };
+            var forged = CapabilityTokenUtility.Create(widened, Key).Split('.')[1];
+            var parts = token.Split('.');
+            var tampered = $"{parts[0]}.{forged}.{parts[2]}";
+
+            // Act
+            var valid = CapabilityTokenUtility.TryValidate(tampered, Key, Now, out var claims, out var error);
+
+            // Assert
+            Assert.False(valid);
+            Assert.Null(claims);
+            Assert.Equal("Capability token signature is invalid", error);
+        }
+
+        [Fact]
+        public void TryValidate_ExpiredToken_Fails()
+        {
+            // Arrange
+            var token = CapabilityTokenUtility.Create(CreateClaims(), Key);
+
+            // Act
+            var valid = CapabilityTokenUtility.TryValidate(token, Key, Now.AddMinutes(5), out _, out var error);
+
+            // Assert
+            Assert.False(valid);
+            Assert.Equal("Capability token has expired", error);
+        }
+
+        [Fact]
+        public void TryValidate_DifferentKey_Fails()
+        {
+            // Arrange
+            var token = CapabilityTokenUtility.Create(CreateClaims(), Key);
+            var otherKey = Encoding.UTF8.GetBytes("fedcba9876543210fedcba9876543210");
+
+            // Act
+            var valid = CapabilityTokenUtility.TryValidate(token, otherKey, Now, out _, out _);
+
+            // Assert
+            Assert.False(valid);
+        }
+
+        [Theory]
+        [InlineData(null)]
+        [InlineData("")]
+        [InlineData("not-a-token")]
+        [InlineData("v1.!!!.!!!")]
+        public void TryValidate_MalformedToken_Fails(string token)
+        {
+            // Act
+            var valid = CapabilityTokenUtility.TryValidate(token, Key, Now, out var claims, out var error);
+
+            // Assert
+            Assert.False(valid);
+            Assert.Null(claims);
+            Assert.NotNull(error);
+        }
+
+        [Fact]
+        public void IsInScope_Wildcard_CoversEveryOperationOfService()
+        {
+            // Arrange
+            var scopes = new[] { "secrets.*", "wallet.transferGas" };
+
+            // Act & Assert
+            Assert.True(CapabilityTokenUtility.IsInScope(scopes, "secrets", "getSecretValue"));
+            Assert.True(CapabilityTokenUtility.IsInScope(scopes, "wallet", "transferGas"));
+            Assert.False(CapabilityTokenUtility.IsInScope(scopes, "wallet", "transferNeo"));
+        }
+
+        [Fact]
+        public void IsProtected_SigningSealingAndAttestation_AreProtected()
+        {
+            // Act & Assert
+            Assert.True(CapabilityTokenUtility.IsProtected(Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.TransferGas));
+            Assert.True(CapabilityTokenUtility.IsProtected(Constants.EnclaveServiceTypes.Secrets, Constants.SecretsOperations.GetSecretValue));
+            Assert.True(CapabilityTokenUtility.IsProtected(Constants.EnclaveServiceTypes.Attestation, "getDocument"));
+            Assert.False(CapabilityTokenUtility.IsProtected(Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.GetBalance));
+        }
+
+        [Fact]
+        public void IsUnauthorizedError_OnlyMatchesTokenRejections()
+        {
+            // Act & Assert
+            Assert.True(CapabilityTokenUtility.IsUnauthorizedError($"{CapabilityTokenUtility.UnauthorizedErrorPrefix}Capability token has expired"));
+            Assert.False(CapabilityTokenUtility.IsUnauthorizedError("Insufficient balance"));
+            Assert.False(CapabilityTokenUtility.IsUnauthorizedError(null));
+        }
+    }
+}
@@ -0,0 +1,59 @@
+using System.Collections.Generic;
+using NeoServiceLayer.Services.EventMonitoring;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ConditionExpressionTests
+    {
+        private static readonly Dictionary<string, object> Transfer = new Dictionary<string, object>
+        {
+            ["from"] = null,
+            ["to"] = "0x4D4F6E6F6E6F6E6F6E6F6E6F6E6F6E6F6E6F6E6F",
+            ["amount"] = "123456789012345678901234567890",
+            ["standard"] = "NEP-17",
+            ["arg3"] = new Dictionary<string, object> { ["balance"] = "1500", ["threshold"] = "1000", ["flags"] = new List<object> { true, "memo" } }
+        };
+
+        [Theory]
+        [InlineData("amount > 1000", true)]
+        [InlineData("amount > 123456789012345678901234567889", true)]
+        [InlineData("amount <= 1000.5", false)]
+        [InlineData("arg3.balance >= arg3.threshold", true)]
+        [InlineData("arg3.flags[0] == true AND arg3.flags[1] startsWith 'me'", true)]
+        [InlineData("to == \"0x4d4f6e6f6e6f6e6f6e6f6e6f6e6f6e6f6e6f6e6f\"", true)]
+        [InlineData("from == null && standard != \"NEP-11\"", true)]
+        [InlineData("standard == 'NEP-11' OR NOT (amount < 0)", true)]
+        [InlineData("missing > 0 || arg3.flags[0] > 1", false)]
+        public void Evaluate_ComparesByStackItemType(string expression, bool expected)
+        {
+            // Arrange
+            var condition = ConditionExpression.Parse(expression);
+
+            // Act
+            var result = condition.Evaluate(Transfer);
+
+            // Assert
+            Assert.Equal(expected, result);
+        }
+
+        [Theory]
+        [InlineData("")]
+        [InlineData("amount >")]
+        [InlineData("(amount > 1")]
+        [InlineData("amount > 1 AND")]
+        [InlineData("amount = 1")]
+        [InlineData("items[x] == 1")]
+        [InlineData("memo == 'open")]
+        public void TryParse_InvalidExpression_ReturnsError(string expression)
+        {
+            // Act
+            var parsed = ConditionExpression.TryParse(expression, out var condition, out var error);
+
+            // Assert
+            Assert.False(parsed);
+            Assert.Null(condition);
+            Assert.False(string.IsNullOrEmpty(error));
+        }
+    }
+}
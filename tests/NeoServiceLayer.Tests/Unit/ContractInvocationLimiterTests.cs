@@ -0,0 +1,63 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Enclave.Enclave.Execution;
+using NeoServiceLayer.Enclave.Enclave.Models;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ContractInvocationLimiterTests
+    {
+        private const string ContractHash = "0xef4073a0f2b305a38ec4050e4d3d28bc40ea63f5";
+        private const string OtherContractHash = "0xd2a4cff31913016155e38e474a2c06d08be276cf";
+
+        private static ContractInvocationLimiter CreateLimiter(ContractInvocationLimitOptions options)
+        {
+            return new ContractInvocationLimiter(new Mock<ILogger<ContractInvocationLimiter>>().Object, Options.Create(options));
+        }
+
+        [Fact]
+        public async Task AcquireAsync_Shed_DropsInvocationsOverLimitAcrossAccounts()
+        {
+            // Arrange
+            var limiter = CreateLimiter(new ContractInvocationLimitOptions
+            {
+                MaxInvocationsPerWindow = 2,
+                WindowSeconds = 60,
+                OverflowAction = ContractInvocationOverflowAction.Shed
+            });
+
+            // Act
+            await limiter.AcquireAsync(ContractHash, Guid.NewGuid());
+            await limiter.AcquireAsync(ContractHash.Substring(2).ToUpperInvariant(), Guid.NewGuid());
+
+            // Assert
+            await Assert.ThrowsAsync<InvalidOperationException>(() => limiter.AcquireAsync(ContractHash, Guid.NewGuid()));
+            await limiter.AcquireAsync(OtherContractHash, Guid.NewGuid());
+        }
+
+        [Fact]
+        public async Task AcquireAsync_Queue_WaitsForNextWindow()
+        {
+            // Arrange
+            var limiter = CreateLimiter(new ContractInvocationLimitOptions
+            {
+                WindowSeconds = 1,
+                MaxQueueWaitSeconds = 5,
+                ContractLimits = new Dictionary<string, int> { [ContractHash] = 1 }
+            });
+            await limiter.AcquireAsync(ContractHash, Guid.NewGuid());
+            var started = DateTime.UtcNow;
+
+            // Act
+            await limiter.AcquireAsync(ContractHash, Guid.NewGuid());
+
+            // Assert
+            Assert.True(DateTime.UtcNow - started >= TimeSpan.FromMilliseconds(500));
+        }
+    }
+}
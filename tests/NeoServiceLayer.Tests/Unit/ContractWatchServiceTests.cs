@@ -0,0 +1,93 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.EventMonitoring;
+using NeoServiceLayer.Services.EventMonitoring.Repositories;
+using NeoServiceLayer.Services.Storage.Providers;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ContractWatchServiceTests
+    {
+        private const string ContractHash = "0x48c40d4666f93408be1bef038b6722404d9a4c2a";
+
+        private readonly Guid _accountId = Guid.NewGuid();
+        private readonly Mock<IEventSubscriptionRepository> _subscriptionRepository = new Mock<IEventSubscriptionRepository>();
+        private readonly Mock<INotificationService> _notificationService = new Mock<INotificationService>();
+        private readonly ContractWatchService _service;
+
+        public ContractWatchServiceTests()
+        {
+            var store = new InMemoryStorageProvider(new Mock<ILogger<InMemoryStorageProvider>>().Object);
+            var repository = new WatchedContractRepository(new Mock<ILogger<WatchedContractRepository>>().Object, store);
+
+            _service = new ContractWatchService(
+                new Mock<ILogger<ContractWatchService>>().Object,
+                repository,
+                _subscriptionRepository.Object,
+                _notificationService.Object);
+        }
+
+        [Fact]
+        public async Task ProcessEventsAsync_WatchedContractUpdated_AlertsAndPausesItsSubscriptions()
+        {
+            // Arrange
+            var watch = await _service.WatchAsync(new WatchedContract
+            {
+                AccountId = _accountId,
+                ContractHash = ContractHash.ToUpperInvariant().Replace("0X", "0x"),
+                PauseDependentSubscriptions = true
+            });
+            var dependent = new EventSubscription { Id = Guid.NewGuid(), AccountId = _accountId, ContractHash = ContractHash, Status = EventSubscriptionStatus.Active };
+            var unrelated = new EventSubscription { Id = Guid.NewGuid(), AccountId = _accountId, ContractHash = Constants.NeoConfig.GasTokenHash, Status = EventSubscriptionStatus.Active };
+            _subscriptionRepository.Setup(r => r.GetByAccountAsync(_accountId)).ReturnsAsync(new[] { dependent, unrelated });
+
+            var update = new BlockEvent
+            {
+                TransactionHash = "0x01",
+                BlockHeight = 500,
+                ContractHash = Constants.NeoConfig.ContractManagementHash,
+                EventName = ContractWatchService.UpdateEventName,
+                EventData = new Dictionary<string, object> { ["arg0"] = ContractHash }
+            };
+
+            // Act: the same block is read twice, as after a leadership hand-off
+            var alerted = await _service.ProcessEventsAsync(new[] { update });
+            var repeated = await _service.ProcessEventsAsync(new[] { update });
+
+            // Assert
+            Assert.Equal(1, alerted);
+            Assert.Equal(0, repeated);
+
+            var stored = await _service.GetByIdAsync(watch.Id);
+            Assert.Equal(1, stored.UpdateCount);
+            Assert.Equal(500, stored.LastChangeBlockHeight);
+            Assert.False(stored.Destroyed);
+
+            Assert.Equal(EventSubscriptionStatus.Paused, dependent.Status);
+            Assert.Equal(EventSubscriptionStatus.Active, unrelated.Status);
+            _notificationService.Verify(n => n.SendNotificationAsync(It.Is<Notification>(
+                notification => notification.AccountId == _accountId &&
+                    ((List<Guid>)notification.Data["PausedSubscriptions"]).Single() == dependent.Id)), Times.Once);
+        }
+
+        [Fact]
+        public async Task WatchAsync_ContractAlreadyWatched_ThrowsValidationException()
+        {
+            // Arrange
+            await _service.WatchAsync(new WatchedContract { AccountId = _accountId, ContractHash = ContractHash });
+
+            // Act & Assert
+            await Assert.ThrowsAsync<ValidationException>(() =>
+                _service.WatchAsync(new WatchedContract { AccountId = _accountId, ContractHash = ContractHash }));
+        }
+    }
+}
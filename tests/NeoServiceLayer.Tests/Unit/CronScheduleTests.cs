@@ -0,0 +1,55 @@
+using System;
+using NeoServiceLayer.Core.Utilities;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class CronScheduleTests
+    {
+        [Theory]
+        [InlineData("*/15 * * * *", "2026-03-10T10:07:00", "2026-03-10T10:15:00")]
+        [InlineData("0 9 * * 1-5", "2026-03-13T09:00:00", "2026-03-16T09:00:00")]
+        [InlineData("30 2 1 * *", "2026-12-05T00:00:00", "2027-01-01T02:30:00")]
+        [InlineData("0 0 * * 7", "2026-03-10T00:00:00", "2026-03-15T00:00:00")]
+        public void GetNextOccurrence_ReturnsFirstMatchingMinuteAfterTime(string expression, string after, string expected)
+        {
+            // Arrange
+            var schedule = CronSchedule.Parse(expression);
+
+            // Act
+            var next = schedule.GetNextOccurrence(DateTime.SpecifyKind(DateTime.Parse(after), DateTimeKind.Utc));
+
+            // Assert
+            Assert.Equal(DateTime.SpecifyKind(DateTime.Parse(expected), DateTimeKind.Utc), next);
+        }
+
+        [Fact]
+        public void GetNextOccurrence_DayOfMonthAndDayOfWeekRestricted_MatchesEither()
+        {
+            // Arrange: the 1st of the month or any Friday
+            var schedule = CronSchedule.Parse("0 12 1 * 5");
+
+            // Act
+            var next = schedule.GetNextOccurrence(new DateTime(2026, 3, 10, 0, 0, 0, DateTimeKind.Utc));
+
+            // Assert
+            Assert.Equal(new DateTime(2026, 3, 13, 12, 0, 0, DateTimeKind.Utc), next);
+        }
+
+        [Theory]
+        [InlineData("* * * *")]
+        [InlineData("60 * * * *")]
+        [InlineData("* * * * */0")]
+        [InlineData("5-1 * * * *")]
+        public void TryParse_InvalidExpression_ReturnsError(string expression)
+        {
+            // Act
+            var parsed = CronSchedule.TryParse(expression, out var schedule, out var error);
+
+            // Assert
+            Assert.False(parsed);
+            Assert.Null(schedule);
+            Assert.False(string.IsNullOrEmpty(error));
+        }
+    }
+}
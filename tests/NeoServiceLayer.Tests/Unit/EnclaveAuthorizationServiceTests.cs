@@ -0,0 +1,60 @@
+using System.Text;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Enclave.Enclave.Models;
+using NeoServiceLayer.Enclave.Enclave.Services;
+using Xunit;
+using CoreEnclaveRequest = NeoServiceLayer.Core.Models.EnclaveRequest;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class EnclaveAuthorizationServiceTests
+    {
+        private readonly EnclaveAuthorizationService _service = new EnclaveAuthorizationService(
+            new Mock<ILogger<EnclaveAuthorizationService>>().Object,
+            Options.Create(new TeeAuthorizationOptions { Enabled = true }));
+
+        [Theory]
+        [InlineData(Constants.SecretsOperations.GenerateDataKey)]
+        [InlineData(Constants.SecretsOperations.DecryptDataKey)]
+        [InlineData(Constants.SecretsOperations.ExportSecrets)]
+        [InlineData(Constants.SecretsOperations.ImportSecrets)]
+        public void Authorize_UnsealingRequestWithoutToken_IsRefused(string operation)
+        {
+            // Arrange
+            var request = new CoreEnclaveRequest
+            {
+                ServiceType = Constants.EnclaveServiceTypes.Secrets,
+                Operation = operation,
+                Payload = Encoding.UTF8.GetBytes("{\"wrappedKey\":\"AAAA\"}")
+            };
+
+            // Act
+            var authorized = _service.Authorize(request, out var error);
+
+            // Assert
+            Assert.False(authorized);
+            Assert.False(string.IsNullOrEmpty(error));
+        }
+
+        [Fact]
+        public void Authorize_UnprotectedOperationWithoutToken_IsAccepted()
+        {
+            // Arrange
+            var request = new CoreEnclaveRequest
+            {
+                ServiceType = Constants.EnclaveServiceTypes.Secrets,
+                Operation = Constants.SecretsOperations.HasAccess
+            };
+
+            // Act
+            var authorized = _service.Authorize(request, out var error);
+
+            // Assert
+            Assert.True(authorized);
+            Assert.Null(error);
+        }
+    }
+}
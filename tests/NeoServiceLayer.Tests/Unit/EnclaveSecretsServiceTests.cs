@@ -1,10 +1,13 @@
 using System;
+using System.Security.Cryptography;
 using System.Text;
 using System.Text.Json;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
 using Moq;
 using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Utilities;
 using NeoServiceLayer.Enclave.Enclave.Models;
 using NeoServiceLayer.Enclave.Enclave.Services;
 using NeoServiceLayer.Tests.Mocks;
@@ -282,5 +285,40 @@ namespace NeoServiceLayer.Tests.Unit
             Assert.False(response.Success);
             Assert.Contains("Failed to process secrets request", response.ErrorMessage, StringComparison.OrdinalIgnoreCase);
         }
+
+        [Fact]
+        public async Task SealedData_UnsealsInAnotherInstanceWithTheSameMasterKey()
+        {
+            // Arrange
+            var options = Options.Create(new EnclaveSealingOptions { MasterKey = Convert.ToBase64String(RandomNumberGenerator.GetBytes(32)) });
+            var sealingEnclave = new EnclaveSecretsService(_loggerMock.Object, options);
+            var restartedEnclave = new EnclaveSecretsService(_loggerMock.Object, options);
+            var otherEnvironment = new EnclaveSecretsService(_loggerMock.Object, Options.Create(new EnclaveSealingOptions()));
+            var accountId = Guid.NewGuid();
+
+            var dataKey = JsonUtility.Deserialize<DataKey>(await sealingEnclave.HandleRequestAsync(
+                Constants.SecretsOperations.GenerateDataKey,
+                JsonUtility.SerializeToUtf8Bytes(new { AccountId = accountId })));
+            var secret = JsonUtility.Deserialize<Secret>(await sealingEnclave.HandleRequestAsync(
+                Constants.SecretsOperations.CreateSecret,
+                JsonUtility.SerializeToUtf8Bytes(new { Name = "API Key", Value = "s3cr3t", AccountId = accountId })));
+            var decryptPayload = JsonUtility.SerializeToUtf8Bytes(new { AccountId = accountId, dataKey.Ciphertext });
+
+            // Act
+            var unwrapped = JsonUtility.Deserialize<DataKey>(await restartedEnclave.HandleRequestAsync(Constants.SecretsOperations.DecryptDataKey, decryptPayload));
+            var value = restartedEnclave.UnsealSecretValue(secret.EncryptedValue, secret.Id, accountId);
+
+            // Assert
+            Assert.Equal(dataKey.Plaintext, unwrapped.Plaintext);
+            Assert.Equal("s3cr3t", value);
+            await Assert.ThrowsAnyAsync<Exception>(() => otherEnvironment.HandleRequestAsync(Constants.SecretsOperations.DecryptDataKey, decryptPayload));
+        }
+
+        private class DataKey
+        {
+            public string Plaintext { get; set; }
+
+            public string Ciphertext { get; set; }
+        }
     }
 }
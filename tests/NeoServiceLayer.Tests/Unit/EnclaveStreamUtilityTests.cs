@@ -0,0 +1,76 @@
+using System;
+using System.IO;
+using System.Text;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using NeoServiceLayer.Enclave.Enclave.Services;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class EnclaveStreamUtilityTests
+    {
+        [Fact]
+        public async Task WriteFrameAsync_ReadFrameAsync_RoundTripsFramesInOrder()
+        {
+            // Arrange
+            var stream = new MemoryStream();
+            await EnclaveStreamUtility.WriteFrameAsync(stream, new EnclaveStreamFrame
+            {
+                RequestId = "req-1",
+                Type = EnclaveStreamFrameTypes.Input,
+                Sequence = 0,
+                Data = Encoding.UTF8.GetBytes("{\"a\":1}")
+            });
+            await EnclaveStreamUtility.WriteFrameAsync(stream, new EnclaveStreamFrame
+            {
+                RequestId = "req-1",
+                Type = EnclaveStreamFrameTypes.End,
+                Sequence = 1
+            });
+            stream.Position = 0;
+
+            // Act
+            var first = await EnclaveStreamUtility.ReadFrameAsync(stream);
+            var second = await EnclaveStreamUtility.ReadFrameAsync(stream);
+
+            // Assert
+            Assert.Equal(EnclaveStreamFrameTypes.Input, first.Type);
+            Assert.Equal("{\"a\":1}", Encoding.UTF8.GetString(first.Data));
+            Assert.Equal(EnclaveStreamFrameTypes.End, second.Type);
+            Assert.Equal(1, second.Sequence);
+        }
+
+        [Fact]
+        public async Task ReadMessageAsync_LengthAboveLimit_ThrowsInvalidDataException()
+        {
+            // Arrange
+            var stream = new MemoryStream();
+            await EnclaveStreamUtility.WriteMessageAsync(stream, new byte[64]);
+            stream.Position = 0;
+
+            // Act & Assert
+            await Assert.ThrowsAsync<InvalidDataException>(() => EnclaveStreamUtility.ReadMessageAsync(stream, maxMessageSize: 32));
+        }
+
+        [Fact]
+        public void VerifyResult_SignedByEnclave_AcceptsOriginalAndRejectsTampered()
+        {
+            // Arrange
+            using var signingService = new EnclaveResultSigningService(Mock.Of<ILogger<EnclaveResultSigningService>>());
+            var frame = signingService.CreateResultFrame("req-1", Encoding.UTF8.GetBytes("{\"result\":42}"), 3);
+
+            // Act
+            var valid = EnclaveStreamUtility.VerifyResult(frame, signingService.PublicKey);
+            frame.Data = Encoding.UTF8.GetBytes("{\"result\":43}");
+            var tampered = EnclaveStreamUtility.VerifyResult(frame, signingService.PublicKey);
+
+            // Assert
+            Assert.True(valid);
+            Assert.False(tampered);
+        }
+    }
+}
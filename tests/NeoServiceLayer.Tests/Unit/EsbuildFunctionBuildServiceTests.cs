@@ -0,0 +1,55 @@
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Function.Build;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class EsbuildFunctionBuildServiceTests
+    {
+        // Line 1 maps to index.ts 1:1 and line 2 maps to index.ts 3:3
+        private const string SourceMap = "{\"version\":3,\"sources\":[\"index.ts\"],\"mappings\":\"AAAA;AAEE\"}";
+
+        private readonly EsbuildFunctionBuildService _service = new EsbuildFunctionBuildService(
+            new Mock<ILogger<EsbuildFunctionBuildService>>().Object,
+            Options.Create(new FunctionBuildConfiguration()));
+
+        [Fact]
+        public void TryGetSourcePosition_MappedLine_ReturnsSourcePosition()
+        {
+            // Arrange
+            var reader = SourceMapReader.Parse(SourceMap);
+
+            // Act
+            var mapped = reader.TryGetSourcePosition(2, 1, out var source, out var sourceLine, out var sourceColumn);
+
+            // Assert
+            Assert.True(mapped);
+            Assert.Equal("index.ts", source);
+            Assert.Equal(3, sourceLine);
+            Assert.Equal(3, sourceColumn);
+        }
+
+        [Fact]
+        public void MapError_CompiledPosition_RewritesToSourcePosition()
+        {
+            // Act
+            var message = _service.MapError("x is not defined (line 2, column 1)", SourceMap);
+
+            // Assert
+            Assert.Equal("x is not defined (index.ts line 3, column 3)", message);
+        }
+
+        [Fact]
+        public void MapError_UnmappedPosition_LeavesMessageUnchanged()
+        {
+            // Act
+            var message = _service.MapError("x is not defined (line 9, column 1)", SourceMap);
+
+            // Assert
+            Assert.Equal("x is not defined (line 9, column 1)", message);
+        }
+    }
+}
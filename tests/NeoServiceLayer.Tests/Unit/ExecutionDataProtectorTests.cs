@@ -0,0 +1,107 @@
+using System;
+using System.Collections.Generic;
+using System.Text.Json;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using NeoServiceLayer.Services.Encryption;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ExecutionDataProtectorTests
+    {
+        private readonly Guid _accountId = Guid.NewGuid();
+        private readonly byte[] _key = EncryptionUtility.GenerateRandomKey();
+        private readonly Mock<IDataKeyService> _mockDataKeyService;
+        private readonly ExecutionDataProtector _protector;
+
+        public ExecutionDataProtectorTests()
+        {
+            _mockDataKeyService = new Mock<IDataKeyService>();
+            _mockDataKeyService.Setup(s => s.GetOrCreateKeyAsync(_accountId)).ReturnsAsync(_key);
+            _mockDataKeyService.Setup(s => s.GetKeyAsync(_accountId)).ReturnsAsync(_key);
+
+            _protector = new ExecutionDataProtector(
+                new Mock<ILogger<ExecutionDataProtector>>().Object,
+                _mockDataKeyService.Object,
+                Options.Create(new DataEncryptionConfiguration()));
+        }
+
+        [Fact]
+        public async Task ProtectAsync_ThenUnprotectAsync_RoundTripsExecutionData()
+        {
+            // Arrange
+            var execution = CreateExecution();
+
+            // Act
+            var stored = await _protector.ProtectAsync(execution);
+            var read = await _protector.UnprotectAsync(stored);
+
+            // Assert
+            Assert.Null(stored.Input);
+            Assert.Null(stored.Output);
+            Assert.Empty(stored.Logs);
+            Assert.DoesNotContain("private-input", stored.EncryptedData);
+            Assert.Equal("private-input", execution.Input);
+            Assert.Equal("private-input", ((JsonElement)read.Input).GetString());
+            Assert.Equal("private-output", ((JsonElement)read.Output).GetString());
+            Assert.Equal(new List<string> { "private-log" }, read.Logs);
+            Assert.Null(read.EncryptedData);
+            Assert.Equal("Completed", read.Status);
+        }
+
+        [Fact]
+        public async Task UnprotectAsync_RevokedKey_ReturnsRecordWithoutData()
+        {
+            // Arrange
+            var stored = await _protector.ProtectAsync(CreateExecution());
+            _mockDataKeyService.Setup(s => s.GetKeyAsync(_accountId)).ReturnsAsync((byte[])null);
+
+            // Act
+            var read = await _protector.UnprotectAsync(stored);
+
+            // Assert
+            Assert.Null(read.Input);
+            Assert.Null(read.Output);
+            Assert.Empty(read.Logs);
+            Assert.Equal(stored.Id, read.Id);
+        }
+
+        [Fact]
+        public async Task ProtectLogAsync_ThenUnprotectLogAsync_RoundTripsMessage()
+        {
+            // Arrange
+            var log = new FunctionLog { Id = Guid.NewGuid(), AccountId = _accountId, Level = "Info", Message = "private-log" };
+
+            // Act
+            var stored = await _protector.ProtectLogAsync(log);
+            var read = await _protector.UnprotectLogAsync(stored);
+
+            // Assert
+            Assert.True(stored.IsEncrypted);
+            Assert.NotEqual("private-log", stored.Message);
+            Assert.False(read.IsEncrypted);
+            Assert.Equal("private-log", read.Message);
+        }
+
+        private FunctionExecutionResult CreateExecution()
+        {
+            return new FunctionExecutionResult
+            {
+                Id = Guid.NewGuid(),
+                FunctionId = Guid.NewGuid(),
+                AccountId = _accountId,
+                Input = "private-input",
+                Output = "private-output",
+                Logs = new List<string> { "private-log" },
+                Status = "Completed",
+                StartTime = DateTime.UtcNow
+            };
+        }
+    }
+}
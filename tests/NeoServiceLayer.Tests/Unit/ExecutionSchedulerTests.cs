@@ -0,0 +1,192 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.DependencyInjection;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Function;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ExecutionSchedulerTests
+    {
+        private readonly Guid _paidAccountId = Guid.NewGuid();
+        private readonly Guid _freeAccountId = Guid.NewGuid();
+        private readonly Mock<IFeatureFlagService> _featureFlags = new Mock<IFeatureFlagService>();
+        private readonly Mock<IServiceScopeFactory> _scopeFactory = new Mock<IServiceScopeFactory>();
+
+        public ExecutionSchedulerTests()
+        {
+            var accountService = new Mock<IAccountService>();
+            accountService.Setup(a => a.GetByIdAsync(_paidAccountId)).ReturnsAsync(new Account { Id = _paidAccountId, ExecutionTier = "paid" });
+            accountService.Setup(a => a.GetByIdAsync(_freeAccountId)).ReturnsAsync(new Account { Id = _freeAccountId });
+
+            var provider = new Mock<IServiceProvider>();
+            provider.Setup(p => p.GetService(typeof(IAccountService))).Returns(accountService.Object);
+            var scope = new Mock<IServiceScope>();
+            scope.Setup(s => s.ServiceProvider).Returns(provider.Object);
+            _scopeFactory.Setup(f => f.CreateScope()).Returns(scope.Object);
+
+            _featureFlags.Setup(f => f.IsEnabled(It.IsAny<string>(), It.IsAny<bool>())).Returns((string name, bool defaultValue) => defaultValue);
+        }
+
+        [Fact]
+        public async Task AcquireAsync_Saturated_SharesSlotsByWeightAndStillServesFreeTier()
+        {
+            // Arrange
+            var scheduler = CreateScheduler(maxQueueLength: 100);
+            var slot = await scheduler.AcquireAsync(_freeAccountId);
+            var free = Enumerable.Range(0, 5).Select(_ => scheduler.AcquireAsync(_freeAccountId)).ToList();
+            var paid = Enumerable.Range(0, 5).Select(_ => scheduler.AcquireAsync(_paidAccountId)).ToList();
+
+            // Act
+            var started = await ReleaseInTurnAsync(slot, free.Concat(paid).ToList(), 5);
+
+            // Assert
+            Assert.Equal(4, started.Count(paid.Contains));
+            Assert.Equal(1, started.Count(free.Contains));
+            Assert.Equal(5, scheduler.GetStatus().Queued);
+        }
+
+        [Fact]
+        public async Task AcquireAsync_PrioritizationFlagOff_StartsInArrivalOrder()
+        {
+            // Arrange
+            _featureFlags.Setup(f => f.IsEnabled(ExecutionScheduler.PrioritizationFlag, It.IsAny<bool>())).Returns(false);
+            var scheduler = CreateScheduler(maxQueueLength: 100);
+            var slot = await scheduler.AcquireAsync(_freeAccountId);
+            var free = Enumerable.Range(0, 3).Select(_ => scheduler.AcquireAsync(_freeAccountId)).ToList();
+            var paid = Enumerable.Range(0, 3).Select(_ => scheduler.AcquireAsync(_paidAccountId)).ToList();
+
+            // Act
+            var started = await ReleaseInTurnAsync(slot, free.Concat(paid).ToList(), 3);
+
+            // Assert
+            Assert.Equal(free, started);
+        }
+
+        [Fact]
+        public void GetTiers_WeightFlag_OverridesConfiguredWeight()
+        {
+            // Arrange
+            _featureFlags.Setup(f => f.GetValue(ExecutionScheduler.WeightFlagPrefix + "free")).Returns("4");
+            var scheduler = CreateScheduler(maxQueueLength: 100);
+
+            // Act
+            var tiers = scheduler.GetTiers();
+            var status = scheduler.GetStatus();
+
+            // Assert
+            Assert.Equal(4, tiers.Single(t => t.Name == "free").Weight);
+            Assert.Equal(0.5, status.Tiers.Single(t => t.Name == "paid").GuaranteedShare);
+        }
+
+        [Fact]
+        public async Task AcquireAsync_QueueFull_ThrowsAndCountsRejection()
+        {
+            // Arrange
+            var scheduler = CreateScheduler(maxQueueLength: 1);
+            using var slot = await scheduler.AcquireAsync(_paidAccountId);
+            var queued = scheduler.AcquireAsync(_paidAccountId);
+
+            // Act & Assert
+            await Assert.ThrowsAsync<FunctionException>(() => scheduler.AcquireAsync(_freeAccountId));
+            Assert.Equal(1, scheduler.GetStatus().Tiers.Single(t => t.Name == "free").Rejected);
+            Assert.False(queued.IsCompleted);
+        }
+
+        [Fact]
+        public async Task AcquireAsync_AccountAtLimit_WaitsWithoutHoldingUpOtherAccounts()
+        {
+            // Arrange
+            var scheduler = CreateScheduler(maxQueueLength: 100, maxConcurrentExecutions: 2, maxConcurrentPerAccount: 1);
+            var slot = await scheduler.AcquireAsync(_freeAccountId);
+
+            // Act
+            var sameAccount = scheduler.AcquireAsync(_freeAccountId);
+            var otherAccount = scheduler.AcquireAsync(_paidAccountId);
+            var status = scheduler.GetStatus();
+            slot.Dispose();
+
+            // Assert
+            Assert.True(otherAccount.IsCompleted);
+            Assert.Equal(1, status.Throttled);
+            (await sameAccount).Dispose();
+        }
+
+        [Fact]
+        public async Task AcquireAsync_Saturated_StartsInteractiveBeforeTriggered()
+        {
+            // Arrange
+            var scheduler = CreateScheduler(maxQueueLength: 100);
+            var slot = await scheduler.AcquireAsync(_freeAccountId);
+            var triggered = Enumerable.Range(0, 2).Select(_ => scheduler.AcquireAsync(_freeAccountId, ExecutionLane.Triggered)).ToList();
+            var interactive = Enumerable.Range(0, 2).Select(_ => scheduler.AcquireAsync(_freeAccountId, ExecutionLane.Interactive)).ToList();
+            var queued = scheduler.GetStatus().Tiers.Single(t => t.Name == "free");
+
+            // Act
+            var started = await ReleaseInTurnAsync(slot, triggered.Concat(interactive).ToList(), 4);
+
+            // Assert
+            Assert.Equal(2, queued.QueuedTriggered);
+            Assert.Equal(interactive.Concat(triggered), started);
+        }
+
+        [Fact]
+        public void GetWorkerPoolSize_UsesSmallestOfConfiguredProcessorAndMemorySizes()
+        {
+            // Arrange
+            var configuration = new ExecutionSchedulingConfiguration { MaxConcurrentExecutions = 64, ExecutionsPerCpu = 2, MemoryPerExecutionMb = 256 };
+
+            // Act
+            var memoryBound = ExecutionScheduler.GetWorkerPoolSize(configuration, 4, 1024L * 1024 * 1024);
+            var processorBound = ExecutionScheduler.GetWorkerPoolSize(configuration, 4, 16L * 1024 * 1024 * 1024);
+            var unlimited = ExecutionScheduler.GetWorkerPoolSize(new ExecutionSchedulingConfiguration { MaxConcurrentExecutions = 0 }, 4, 0);
+
+            // Assert
+            Assert.Equal(4, memoryBound);
+            Assert.Equal(8, processorBound);
+            Assert.Equal(0, unlimited);
+        }
+
+        private ExecutionScheduler CreateScheduler(int maxQueueLength, int maxConcurrentExecutions = 1, int maxConcurrentPerAccount = 0)
+        {
+            return new ExecutionScheduler(
+                new Mock<ILogger<ExecutionScheduler>>().Object,
+                Options.Create(new ExecutionSchedulingConfiguration
+                {
+                    MaxConcurrentExecutions = maxConcurrentExecutions,
+                    MaxConcurrentPerAccount = maxConcurrentPerAccount,
+                    MaxQueueLength = maxQueueLength,
+                    QueueTimeoutSeconds = 60,
+                    StarvationThresholdSeconds = 0
+                }),
+                _scopeFactory.Object,
+                _featureFlags.Object);
+        }
+
+        // Releases the held slot and each slot started after it, returning the waits in the order they started
+        private static async Task<List<Task<IDisposable>>> ReleaseInTurnAsync(IDisposable slot, List<Task<IDisposable>> waiting, int count)
+        {
+            var pending = waiting.ToList();
+            var started = new List<Task<IDisposable>>();
+
+            for (var i = 0; i < count; i++)
+            {
+                slot.Dispose();
+                var next = await Task.WhenAny(pending);
+                pending.Remove(next);
+                started.Add(next);
+                slot = await next;
+            }
+
+            return started;
+        }
+    }
+}
@@ -0,0 +1,115 @@
+using System;
+using System.Linq;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Function;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ExecutionTrackerTests
+    {
+        private readonly ExecutionTracker _tracker;
+
+        public ExecutionTrackerTests()
+        {
+            _tracker = new ExecutionTracker(new Mock<ILogger<ExecutionTracker>>().Object);
+        }
+
+        [Fact]
+        public void Register_AddsRunningExecution()
+        {
+            // Arrange
+            var execution = new ActiveExecution
+            {
+                ExecutionId = Guid.NewGuid(),
+                FunctionId = Guid.NewGuid(),
+                StartTime = DateTime.UtcNow
+            };
+
+            // Act
+            _tracker.Register(execution);
+
+            // Assert
+            var active = _tracker.GetAll().ToList();
+            Assert.Single(active);
+            Assert.Equal("Running", active[0].Status);
+        }
+
+        [Fact]
+        public void MarkKilled_RunningExecution_ReturnsTrueOnce()
+        {
+            // Arrange
+            var executionId = Guid.NewGuid();
+            _tracker.Register(new ActiveExecution { ExecutionId = executionId, StartTime = DateTime.UtcNow });
+
+            // Act
+            var first = _tracker.MarkKilled(executionId, "admin");
+            var second = _tracker.MarkKilled(executionId, "admin");
+
+            // Assert
+            Assert.True(first);
+            Assert.False(second);
+            Assert.True(_tracker.IsKilled(executionId));
+            Assert.Equal("admin", _tracker.Get(executionId).KilledBy);
+        }
+
+        [Fact]
+        public void ClearKill_KilledExecution_CanBeKilledAgain()
+        {
+            // Arrange
+            var executionId = Guid.NewGuid();
+            _tracker.Register(new ActiveExecution { ExecutionId = executionId, StartTime = DateTime.UtcNow });
+            _tracker.MarkKilled(executionId, "admin");
+
+            // Act
+            _tracker.ClearKill(executionId);
+
+            // Assert
+            Assert.False(_tracker.IsKilled(executionId));
+            Assert.Equal("Running", _tracker.Get(executionId).Status);
+            Assert.True(_tracker.MarkKilled(executionId, "admin"));
+        }
+
+        [Fact]
+        public void UpdateMemoryUsage_RunningExecution_StoresUsage()
+        {
+            // Arrange
+            var executionId = Guid.NewGuid();
+            _tracker.Register(new ActiveExecution { ExecutionId = executionId, StartTime = DateTime.UtcNow });
+
+            // Act
+            _tracker.UpdateMemoryUsage(executionId, 42);
+
+            // Assert
+            Assert.Equal(42, _tracker.Get(executionId).MemoryUsageMb);
+        }
+
+        [Fact]
+        public void MarkKilled_UnknownExecution_ReturnsFalse()
+        {
+            // Act
+            var result = _tracker.MarkKilled(Guid.NewGuid(), "admin");
+
+            // Assert
+            Assert.False(result);
+        }
+
+        [Fact]
+        public void Complete_RemovesExecution()
+        {
+            // Arrange
+            var executionId = Guid.NewGuid();
+            _tracker.Register(new ActiveExecution { ExecutionId = executionId, StartTime = DateTime.UtcNow });
+
+            // Act
+            var removed = _tracker.Complete(executionId);
+
+            // Assert
+            Assert.NotNull(removed);
+            Assert.Empty(_tracker.GetAll());
+            Assert.Null(_tracker.Get(executionId));
+        }
+    }
+}
@@ -0,0 +1,94 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Enums;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Function;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class FunctionAccessControlServiceTests
+    {
+        private readonly Guid _ownerId = Guid.NewGuid();
+        private readonly Function _function;
+        private readonly List<FunctionPermission> _permissions = new List<FunctionPermission>();
+        private readonly FunctionAccessControlService _service;
+
+        public FunctionAccessControlServiceTests()
+        {
+            _function = new Function { Id = Guid.NewGuid(), AccountId = _ownerId };
+
+            var functionRepository = new Mock<IFunctionRepository>();
+            functionRepository.Setup(r => r.GetByIdAsync(_function.Id)).ReturnsAsync(_function);
+
+            var permissionRepository = new Mock<IFunctionPermissionRepository>();
+            permissionRepository
+                .Setup(r => r.GetByFunctionIdAndPrincipalAsync(_function.Id, It.IsAny<string>(), It.IsAny<string>()))
+                .ReturnsAsync((Guid _, string principalId, string principalType) =>
+                    _permissions.FindAll(p => p.PrincipalId == principalId && p.PrincipalType == principalType));
+            permissionRepository
+                .Setup(r => r.CreateAsync(It.IsAny<FunctionPermission>()))
+                .ReturnsAsync((FunctionPermission p) =>
+                {
+                    _permissions.Add(p);
+                    return p;
+                });
+
+            _service = new FunctionAccessControlService(
+                new Mock<ILogger<FunctionAccessControlService>>().Object,
+                permissionRepository.Object,
+                new Mock<IFunctionAccessPolicyRepository>().Object,
+                new Mock<IFunctionAccessRequestRepository>().Object,
+                functionRepository.Object);
+        }
+
+        [Fact]
+        public async Task AuthorizeInvokeAsync_Owner_IsAllowed()
+        {
+            // Act
+            var result = await _service.AuthorizeInvokeAsync(_function.Id, _ownerId.ToString(), "user");
+
+            // Assert
+            Assert.Equal(InvokeAuthorizationResult.Allowed, result);
+        }
+
+        [Fact]
+        public async Task AuthorizeInvokeAsync_NoGrant_IsDenied()
+        {
+            // Act
+            var result = await _service.AuthorizeInvokeAsync(_function.Id, Guid.NewGuid().ToString(), "user");
+
+            // Assert
+            Assert.Equal(InvokeAuthorizationResult.Denied, result);
+        }
+
+        [Fact]
+        public async Task AuthorizeInvokeAsync_GrantWithRateLimit_IsRateLimitedAfterLimit()
+        {
+            // Arrange
+            await _service.GrantInvokeAsync(_function.Id, "ci-deployer", "apikey", _ownerId, rateLimit: 2);
+
+            // Act
+            var first = await _service.AuthorizeInvokeAsync(_function.Id, "ci-deployer", "apikey");
+            var second = await _service.AuthorizeInvokeAsync(_function.Id, "ci-deployer", "apikey");
+            var third = await _service.AuthorizeInvokeAsync(_function.Id, "ci-deployer", "apikey");
+
+            // Assert
+            Assert.Equal(InvokeAuthorizationResult.Allowed, first);
+            Assert.Equal(InvokeAuthorizationResult.Allowed, second);
+            Assert.Equal(InvokeAuthorizationResult.RateLimited, third);
+        }
+
+        [Fact]
+        public async Task GrantInvokeAsync_UnknownPrincipalType_Throws()
+        {
+            // Act & Assert
+            await Assert.ThrowsAsync<ArgumentException>(() =>
+                _service.GrantInvokeAsync(_function.Id, "someone", "group", _ownerId));
+        }
+    }
+}
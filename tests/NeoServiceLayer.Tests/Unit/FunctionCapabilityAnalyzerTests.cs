@@ -0,0 +1,84 @@
+using System.Linq;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Function;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class FunctionCapabilityAnalyzerTests
+    {
+        private readonly FunctionCapabilityAnalyzer _analyzer = new FunctionCapabilityAnalyzer();
+
+        [Fact]
+        public void Analyze_DetectsCapabilitiesWithLinesAndIgnoresComments()
+        {
+            // Arrange
+            var code = string.Join("\n",
+                "// neoService.blockchain.invokeWrite is not used here",
+                "async function main(params) {",
+                "    const { getSecret } = neoService.secrets;",
+                "    const key = await getSecret('apiKey');",
+                "    /* fetch('https://example.com') */",
+                "    const res = await fetch('https://api.example.com/price');",
+                "    return res;",
+                "}");
+
+            // Act
+            var report = _analyzer.Analyze("JavaScript", code, new[] { FunctionCapabilities.Secrets });
+
+            // Assert
+            Assert.True(report.Analyzed);
+            Assert.Equal(new[] { FunctionCapabilities.Network, FunctionCapabilities.Secrets }, report.DetectedCapabilities);
+            Assert.Equal(new[] { FunctionCapabilities.Network }, report.UndeclaredCapabilities);
+            Assert.False(report.IsWithinPermissions);
+            Assert.Equal(new[] { 3, 4 }, report.Usages.Where(u => u.Capability == FunctionCapabilities.Secrets).Select(u => u.Line));
+            Assert.Equal(6, Assert.Single(report.Usages, u => u.Capability == FunctionCapabilities.Network).Line);
+        }
+
+        [Fact]
+        public void Analyze_DynamicSdkAccess_ReportsEveryCapability()
+        {
+            // Arrange
+            var code = "async function main(params) { return await neoService[params.ns][params.op](); }";
+
+            // Act
+            var report = _analyzer.Analyze("TypeScript", code, new string[0]);
+
+            // Assert
+            Assert.Equal(FunctionCapabilities.All.OrderBy(c => c), report.UndeclaredCapabilities.OrderBy(c => c));
+        }
+
+        [Fact]
+        public void Analyze_NoDeclaredPermissions_IsNotRestricted()
+        {
+            // Act
+            var report = _analyzer.Analyze("JavaScript", "function main() { return neoService.blockchain.invokeWrite('0x00', 'mint'); }", null);
+
+            // Assert
+            Assert.Equal(new[] { FunctionCapabilities.Transactions }, report.DetectedCapabilities);
+            Assert.Empty(report.UndeclaredCapabilities);
+            Assert.True(report.IsWithinPermissions);
+        }
+
+        [Fact]
+        public void Analyze_StakingCalls_RequireStakingButNotGetVote()
+        {
+            // Arrange
+            var code = string.Join("\n",
+                "async function main(params) {",
+                "    const state = await neoService.staking.getVote(params.address);",
+                "    await neoService.staking.vote(params.walletId, params.password, params.candidate);",
+                "    return await neoService.staking.unwrapBneo(params.walletId, params.password, 1);",
+                "}");
+
+            // Act
+            var readOnly = _analyzer.Analyze("JavaScript", "function main(p) { return neoService.staking.getVote(p.address); }", new string[0]);
+            var report = _analyzer.Analyze("JavaScript", code, new[] { FunctionCapabilities.Transactions });
+
+            // Assert
+            Assert.True(readOnly.IsWithinPermissions);
+            Assert.Equal(new[] { FunctionCapabilities.Staking }, report.UndeclaredCapabilities);
+            Assert.Equal(new[] { 3, 4 }, report.Usages.Select(u => u.Line));
+        }
+    }
+}
@@ -0,0 +1,78 @@
+using System;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Function;
+using FunctionSecurityEventRepository = NeoServiceLayer.Services.Function.Repositories.FunctionSecurityEventRepository;
+using NeoServiceLayer.Services.Storage.Providers;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class FunctionSecurityMonitorTests
+    {
+        private readonly Mock<IFunctionRepository> _mockFunctionRepository;
+        private readonly FunctionSecurityMonitor _monitor;
+        private readonly Function _function;
+
+        public FunctionSecurityMonitorTests()
+        {
+            var storageProvider = new InMemoryStorageProvider(new Mock<ILogger<InMemoryStorageProvider>>().Object);
+            var eventRepository = new FunctionSecurityEventRepository(new Mock<ILogger<FunctionSecurityEventRepository>>().Object, storageProvider);
+
+            _function = new Function { Id = Guid.NewGuid(), AccountId = Guid.NewGuid(), Name = "fn", Status = "Active" };
+            _mockFunctionRepository = new Mock<IFunctionRepository>();
+            _mockFunctionRepository.Setup(r => r.GetByIdAsync(_function.Id)).ReturnsAsync(_function);
+            _mockFunctionRepository.Setup(r => r.UpdateAsync(_function.Id, It.IsAny<Function>())).ReturnsAsync((Guid _, Function f) => f);
+
+            _monitor = new FunctionSecurityMonitor(
+                new Mock<ILogger<FunctionSecurityMonitor>>().Object,
+                eventRepository,
+                _mockFunctionRepository.Object,
+                Options.Create(new FunctionSecurityConfiguration { UndefinedServiceThreshold = 2 }));
+        }
+
+        private static FunctionSecurityEvent[] UndefinedServiceEvents(int count)
+        {
+            return Enumerable.Range(0, count)
+                .Select(i => new FunctionSecurityEvent { Type = FunctionSecurityEventType.UndefinedService, Target = $"missing{i}" })
+                .ToArray();
+        }
+
+        [Fact]
+        public async Task RecordAsync_OverThreshold_DisablesFunctionPendingReview()
+        {
+            // Arrange
+            await _monitor.RecordAsync(_function, Guid.NewGuid(), UndefinedServiceEvents(2));
+
+            // Act
+            var disabled = await _monitor.RecordAsync(_function, Guid.NewGuid(), UndefinedServiceEvents(1));
+
+            // Assert
+            Assert.True(disabled);
+            Assert.Equal(FunctionSecurityMonitor.PendingReviewStatus, _function.Status);
+            var summary = Assert.Single(await _monitor.GetSummariesAsync(_function.AccountId));
+            Assert.Equal(3, summary.Counts[FunctionSecurityEventType.UndefinedService]);
+        }
+
+        [Fact]
+        public async Task ApproveAsync_PendingReview_ReactivatesAndIgnoresEarlierEvents()
+        {
+            // Arrange
+            await _monitor.RecordAsync(_function, Guid.NewGuid(), UndefinedServiceEvents(3));
+
+            // Act
+            await _monitor.ApproveAsync(_function.Id, "admin");
+            var disabled = await _monitor.RecordAsync(_function, Guid.NewGuid(), UndefinedServiceEvents(1));
+
+            // Assert
+            Assert.False(disabled);
+            Assert.Equal("Active", _function.Status);
+            Assert.NotNull(_function.SecurityReviewedAt);
+        }
+    }
+}
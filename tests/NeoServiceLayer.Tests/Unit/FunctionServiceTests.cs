@@ -349,5 +349,218 @@ namespace NeoServiceLayer.Tests.Unit
                     It.IsAny<object>()),
                 Times.Once);
         }
+
+        [Fact]
+        public async Task ExecuteAsync_FunctionWithSecrets_SendsOnlyGrantedSecrets()
+        {
+            // Arrange
+            var functionId = Guid.NewGuid();
+            var accountId = Guid.NewGuid();
+            var granted = new Secret { Id = Guid.NewGuid(), Name = "granted", EncryptedValue = "sealed-1", AccountId = accountId, AllowedFunctionIds = new List<Guid> { functionId } };
+            var notAllowing = new Secret { Id = Guid.NewGuid(), Name = "not-allowing", EncryptedValue = "sealed-2", AccountId = accountId, AllowedFunctionIds = new List<Guid>() };
+            var otherAccount = new Secret { Id = Guid.NewGuid(), Name = "other-account", EncryptedValue = "sealed-3", AccountId = Guid.NewGuid(), AllowedFunctionIds = new List<Guid> { functionId } };
+
+            var function = new Function
+            {
+                Id = functionId,
+                Name = "TestFunction",
+                Runtime = FunctionRuntime.JavaScript.ToString(),
+                SourceCode = "function main(params) { return params; }",
+                EntryPoint = "main",
+                AccountId = accountId,
+                Status = "Active",
+                SecretIds = new List<Guid> { granted.Id, notAllowing.Id, otherAccount.Id }
+            };
+
+            _functionRepositoryMock.Setup(x => x.GetByIdAsync(functionId)).ReturnsAsync(function);
+            _executionRepositoryMock
+                .Setup(x => x.CreateAsync(It.IsAny<FunctionExecutionResult>()))
+                .ReturnsAsync((FunctionExecutionResult e) => e);
+            _executionRepositoryMock
+                .Setup(x => x.UpdateAsync(It.IsAny<Guid>(), It.IsAny<FunctionExecutionResult>()))
+                .ReturnsAsync((Guid id, FunctionExecutionResult e) => e);
+            _functionRepositoryMock
+                .Setup(x => x.UpdateAsync(It.IsAny<Guid>(), It.IsAny<Function>()))
+                .ReturnsAsync((Guid id, Function f) => f);
+
+            foreach (var secret in new[] { granted, notAllowing, otherAccount })
+            {
+                _secretsServiceMock.Setup(x => x.GetByIdAsync(secret.Id)).ReturnsAsync(secret);
+            }
+
+            object executeRequest = null;
+            _enclaveServiceMock
+                .Setup(x => x.SendRequestAsync<object, object>(
+                    Core.Constants.EnclaveServiceTypes.Function,
+                    Core.Constants.FunctionOperations.ExecuteFunction,
+                    It.IsAny<object>()))
+                .Callback<string, string, object>((_, _, request) => executeRequest = request)
+                .ReturnsAsync(new object());
+
+            // Act
+            await _functionService.ExecuteAsync(functionId, new Dictionary<string, object>());
+
+            // Assert
+            var secrets = (List<object>)executeRequest.GetType().GetProperty("Secrets").GetValue(executeRequest);
+            var sent = Assert.Single(secrets);
+            Assert.Equal(granted.Id, sent.GetType().GetProperty("Id").GetValue(sent));
+            Assert.Equal("sealed-1", sent.GetType().GetProperty("EncryptedValue").GetValue(sent));
+        }
+
+        [Fact]
+        public async Task CreateFunctionAsync_FunctionLimitReached_ThrowsFunctionException()
+        {
+            // Arrange
+            var accountId = Guid.NewGuid();
+            _functionRepositoryMock
+                .Setup(x => x.CountByAccountIdAsync(accountId))
+                .ReturnsAsync(new AccountLimitsConfiguration().MaxFunctions);
+
+            // Act & Assert
+            await Assert.ThrowsAsync<Core.Exceptions.FunctionException>(() =>
+                _functionService.CreateFunctionAsync("TestFunction", null, FunctionRuntime.JavaScript, "function main() {}", "main", accountId));
+            _functionRepositoryMock.Verify(x => x.CreateAsync(It.IsAny<Function>()), Times.Never);
+        }
+
+        [Fact]
+        public async Task GetActiveExecutionsAsync_RunningExecution_ReportsMemoryFromEnclave()
+        {
+            // Arrange
+            var executionId = Guid.NewGuid();
+            var tracker = CreateTracker(executionId);
+            _enclaveServiceMock
+                .Setup(x => x.SendRequestAsync<object, List<ExecutionMemoryUsage>>(
+                    Core.Constants.EnclaveServiceTypes.Function,
+                    Core.Constants.FunctionOperations.GetExecutionMemory,
+                    It.IsAny<object>()))
+                .ReturnsAsync(new List<ExecutionMemoryUsage>
+                {
+                    new ExecutionMemoryUsage { ExecutionId = executionId, MemoryUsageMb = 12.5 }
+                });
+            var service = CreateService(tracker);
+
+            // Act
+            var active = await service.GetActiveExecutionsAsync();
+
+            // Assert
+            var execution = Assert.Single(active);
+            Assert.Equal(12.5, execution.MemoryUsageMb);
+        }
+
+        [Fact]
+        public async Task KillExecutionAsync_EnclaveKilledExecution_ReturnsTrue()
+        {
+            // Arrange
+            var executionId = Guid.NewGuid();
+            var tracker = CreateTracker(executionId);
+            SetupKillResult(executionId, true);
+            var service = CreateService(tracker);
+
+            // Act
+            var killed = await service.KillExecutionAsync(executionId, "admin");
+
+            // Assert
+            Assert.True(killed);
+            Assert.True(tracker.IsKilled(executionId));
+        }
+
+        [Fact]
+        public async Task KillExecutionAsync_NotRunningInEnclave_ReturnsFalseAndKeepsExecutionRunning()
+        {
+            // Arrange
+            var executionId = Guid.NewGuid();
+            var tracker = CreateTracker(executionId);
+            SetupKillResult(executionId, false);
+            var service = CreateService(tracker);
+
+            // Act
+            var killed = await service.KillExecutionAsync(executionId, "admin");
+
+            // Assert
+            Assert.False(killed);
+            Assert.False(tracker.IsKilled(executionId));
+            Assert.Equal("Running", tracker.Get(executionId).Status);
+        }
+
+        [Fact]
+        public async Task KillExecutionAsync_EnclaveUnreachable_ThrowsAndKeepsExecutionRunning()
+        {
+            // Arrange
+            var executionId = Guid.NewGuid();
+            var tracker = CreateTracker(executionId);
+            _enclaveServiceMock
+                .Setup(x => x.SendRequestAsync<object, ExecutionKillResult>(
+                    Core.Constants.EnclaveServiceTypes.Function,
+                    Core.Constants.FunctionOperations.KillExecution,
+                    It.IsAny<object>()))
+                .ThrowsAsync(new InvalidOperationException("Enclave is not running"));
+            var service = CreateService(tracker);
+
+            // Act & Assert
+            await Assert.ThrowsAsync<Core.Exceptions.FunctionException>(() => service.KillExecutionAsync(executionId, "admin"));
+            Assert.False(tracker.IsKilled(executionId));
+            Assert.Null(tracker.Get(executionId).KilledBy);
+        }
+
+        [Fact]
+        public async Task StopAsync_RunningExecution_WaitsForItAndRefusesNewExecutions()
+        {
+            // Arrange
+            var executionId = Guid.NewGuid();
+            var tracker = CreateTracker(executionId);
+            var function = new Function
+            {
+                Id = Guid.NewGuid(),
+                Name = "TestFunction",
+                Runtime = FunctionRuntime.JavaScript.ToString(),
+                SourceCode = "function main() { return 1; }",
+                EntryPoint = "main",
+                AccountId = Guid.NewGuid(),
+                Status = "Active"
+            };
+            _functionRepositoryMock.Setup(x => x.GetByIdAsync(function.Id)).ReturnsAsync(function);
+            var service = CreateService(tracker);
+
+            // Act
+            var stop = service.StopAsync();
+            await Task.Delay(300);
+            var stoppedWhileRunning = stop.IsCompleted;
+            tracker.Complete(executionId);
+            await stop.WaitAsync(TimeSpan.FromSeconds(5));
+
+            // Assert
+            Assert.False(stoppedWhileRunning);
+            Assert.Equal(ManagedServiceStatus.Stopped, service.GetHealth().Status);
+            await Assert.ThrowsAsync<Core.Exceptions.FunctionException>(() => service.ExecuteAsync(function.Id, new Dictionary<string, object>()));
+            _executionRepositoryMock.Verify(x => x.CreateAsync(It.IsAny<FunctionExecutionResult>()), Times.Never);
+        }
+
+        private static ExecutionTracker CreateTracker(Guid executionId)
+        {
+            var tracker = new ExecutionTracker(new Mock<ILogger<ExecutionTracker>>().Object);
+            tracker.Register(new ActiveExecution { ExecutionId = executionId, StartTime = DateTime.UtcNow });
+            return tracker;
+        }
+
+        private void SetupKillResult(Guid executionId, bool killed)
+        {
+            _enclaveServiceMock
+                .Setup(x => x.SendRequestAsync<object, ExecutionKillResult>(
+                    Core.Constants.EnclaveServiceTypes.Function,
+                    Core.Constants.FunctionOperations.KillExecution,
+                    It.IsAny<object>()))
+                .ReturnsAsync(new ExecutionKillResult { ExecutionId = executionId, Killed = killed, Timestamp = DateTime.UtcNow });
+        }
+
+        private FunctionService CreateService(ExecutionTracker tracker)
+        {
+            return new FunctionService(
+                _loggerMock.Object,
+                _functionRepositoryMock.Object,
+                _executionRepositoryMock.Object,
+                _enclaveServiceMock.Object,
+                _secretsServiceMock.Object,
+                tracker);
+        }
     }
 }
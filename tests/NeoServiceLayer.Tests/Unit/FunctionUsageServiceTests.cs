@@ -0,0 +1,82 @@
+using System;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Function;
+using NeoServiceLayer.Services.Function.Repositories;
+using NeoServiceLayer.Services.Storage.Providers;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class FunctionUsageServiceTests
+    {
+        private readonly FunctionUsageService _service;
+
+        public FunctionUsageServiceTests()
+        {
+            var storageProvider = new InMemoryStorageProvider(new Mock<ILogger<InMemoryStorageProvider>>().Object);
+            var repository = new FunctionDailyUsageRepository(new Mock<ILogger<FunctionDailyUsageRepository>>().Object, storageProvider);
+            _service = new FunctionUsageService(new Mock<ILogger<FunctionUsageService>>().Object, repository);
+        }
+
+        [Fact]
+        public async Task GetDailyStatsAsync_RecordedExecutions_ReportsPercentilesErrorsAndPeaks()
+        {
+            // Arrange
+            var functionId = Guid.NewGuid();
+            var day = new DateTime(2024, 5, 1, 0, 0, 0, DateTimeKind.Utc);
+            for (var i = 1; i <= 20; i++)
+            {
+                await _service.RecordAsync(new FunctionExecutionResult
+                {
+                    Id = Guid.NewGuid(),
+                    FunctionId = functionId,
+                    Status = i == 7 ? "Failed" : "Completed",
+                    StartTime = day.AddHours(i),
+                    DurationMs = i * 10,
+                    MemoryUsageMb = i == 3 ? 48 : 12,
+                    GasCharged = 0.5m
+                });
+            }
+
+            await _service.RecordAsync(new FunctionExecutionResult
+            {
+                Id = Guid.NewGuid(),
+                FunctionId = functionId,
+                Status = "Completed",
+                StartTime = day.AddDays(1),
+                DurationMs = 5
+            });
+
+            // Act
+            var stats = (await _service.GetDailyStatsAsync(functionId, day, day.AddDays(1))).ToList();
+
+            // Assert
+            Assert.Equal(2, stats.Count);
+            var first = stats[0];
+            Assert.Equal(day, first.Date);
+            Assert.Equal(20, first.Invocations);
+            Assert.Equal(1, first.Errors);
+            Assert.Equal(0.05, first.ErrorRate, 3);
+            Assert.Equal(105, first.AverageDurationMs);
+            Assert.InRange(first.P50DurationMs, 100, 125);
+            Assert.InRange(first.P95DurationMs, 190, 200);
+            Assert.Equal(200, first.MaxDurationMs);
+            Assert.Equal(48, first.PeakMemoryMb);
+            Assert.Equal(10m, first.GasCharged);
+            Assert.Equal(1, stats[1].Invocations);
+        }
+
+        [Fact]
+        public async Task GetDailyStatsAsync_ReversedRange_ThrowsValidationException()
+        {
+            // Act & Assert
+            await Assert.ThrowsAsync<ValidationException>(() =>
+                _service.GetDailyStatsAsync(Guid.NewGuid(), DateTime.UtcNow, DateTime.UtcNow.AddDays(-1)));
+        }
+    }
+}
@@ -0,0 +1,97 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Function;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class FunctionVersionServiceTests
+    {
+        private readonly List<FunctionVersion> _versions = new List<FunctionVersion>();
+        private readonly Mock<IFunctionVersionRepository> _versionRepository = new Mock<IFunctionVersionRepository>();
+        private readonly Mock<IFunctionService> _functionService = new Mock<IFunctionService>();
+        private readonly Function _function = new Function
+        {
+            Id = Guid.NewGuid(),
+            AccountId = Guid.NewGuid(),
+            SourceCode = "function main() { return 0; }",
+            EntryPoint = "main",
+            MaxExecutionTime = 30000,
+            MaxMemory = 128
+        };
+        private readonly FunctionVersionService _service;
+
+        public FunctionVersionServiceTests()
+        {
+            _versionRepository.Setup(r => r.GetByFunctionIdAsync(_function.Id))
+                .ReturnsAsync(() => _versions.OrderByDescending(v => v.Number).ToList());
+            _versionRepository.Setup(r => r.CreateAsync(It.IsAny<FunctionVersion>()))
+                .ReturnsAsync((FunctionVersion v) => { _versions.Add(v); return v; });
+            _versionRepository.Setup(r => r.UpdateAsync(It.IsAny<FunctionVersion>()))
+                .ReturnsAsync((FunctionVersion v) => v);
+
+            _functionService.Setup(s => s.GetByIdAsync(_function.Id)).ReturnsAsync(_function);
+            _functionService.Setup(s => s.UpdateSourceCodeAsync(_function.Id, It.IsAny<string>()))
+                .ReturnsAsync((Guid id, string code) => { _function.SourceCode = code; return _function; });
+            _functionService.Setup(s => s.UpdateAsync(It.IsAny<Function>())).ReturnsAsync((Function f) => f);
+
+            _service = new FunctionVersionService(
+                Mock.Of<ILogger<FunctionVersionService>>(),
+                _versionRepository.Object,
+                _functionService.Object);
+        }
+
+        [Fact]
+        public async Task DeployAsync_NumbersVersionsAndPromotesOnRequest()
+        {
+            // Act
+            var first = await _service.DeployAsync(_function.Id, new FunctionVersion { SourceCode = "v1" }, true);
+            var second = await _service.DeployAsync(_function.Id, new FunctionVersion { SourceCode = "v2", Label = "2.0.0" }, false);
+
+            // Assert
+            Assert.Equal(1, first.Number);
+            Assert.Equal("1", first.Label);
+            Assert.True(first.IsLive);
+            Assert.Equal(2, second.Number);
+            Assert.False(second.IsLive);
+            Assert.Equal("main", second.EntryPoint);
+            Assert.Equal("v1", _function.SourceCode);
+            Assert.Equal(first.Id, _function.LiveVersionId);
+        }
+
+        [Fact]
+        public async Task RollbackAsync_ReturnsToPreviouslyLiveVersion()
+        {
+            // Arrange
+            await _service.DeployAsync(_function.Id, new FunctionVersion { SourceCode = "v1" }, true);
+            await _service.DeployAsync(_function.Id, new FunctionVersion { SourceCode = "v2" }, false);
+            await _service.DeployAsync(_function.Id, new FunctionVersion { SourceCode = "v3" }, true);
+
+            // Act
+            var live = await _service.RollbackAsync(_function.Id);
+
+            // Assert
+            Assert.Equal(1, live.Number);
+            Assert.Equal("v1", _function.SourceCode);
+            Assert.Equal(1, _function.LiveVersionNumber);
+            Assert.Single(_versions, v => v.IsLive);
+        }
+
+        [Fact]
+        public async Task DeleteVersionAsync_LiveVersion_ThrowsValidationException()
+        {
+            // Arrange
+            await _service.DeployAsync(_function.Id, new FunctionVersion { SourceCode = "v1" }, true);
+
+            // Act & Assert
+            await Assert.ThrowsAsync<ValidationException>(() => _service.DeleteVersionAsync(_function.Id, 1));
+        }
+    }
+}
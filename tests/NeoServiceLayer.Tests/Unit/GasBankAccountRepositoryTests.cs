@@ -0,0 +1,73 @@
+using System;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.GasBank.Repositories;
+using NeoServiceLayer.Services.Storage.Providers;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class GasBankAccountRepositoryTests
+    {
+        private readonly GasBankTransactionRepository _transactionRepository;
+        private readonly GasBankAccountRepository _repository;
+
+        public GasBankAccountRepositoryTests()
+        {
+            var storageProvider = new InMemoryStorageProvider(new Mock<ILogger<InMemoryStorageProvider>>().Object);
+            _transactionRepository = new GasBankTransactionRepository(new Mock<ILogger<GasBankTransactionRepository>>().Object, storageProvider);
+            var allocationRepository = new GasBankAllocationRepository(new Mock<ILogger<GasBankAllocationRepository>>().Object, storageProvider);
+            _repository = new GasBankAccountRepository(
+                new Mock<ILogger<GasBankAccountRepository>>().Object, storageProvider, _transactionRepository, allocationRepository);
+        }
+
+        private static GasBankTransaction Deposit(GasBankAccount account, decimal amount)
+        {
+            account.Balance += amount;
+            return new GasBankTransaction
+            {
+                Id = Guid.NewGuid(),
+                GasBankAccountId = account.Id,
+                Type = GasBankTransactionType.Deposit,
+                Amount = amount,
+                BalanceAfter = account.Balance,
+                Timestamp = DateTime.UtcNow
+            };
+        }
+
+        [Fact]
+        public async Task UpdateBalanceAsync_ConcurrentDeposits_RecordsEveryDeposit()
+        {
+            // Arrange
+            var account = await _repository.CreateAsync(new GasBankAccount { Id = Guid.NewGuid(), AccountId = Guid.NewGuid(), Name = "Main" });
+
+            // Act
+            await Task.WhenAll(Enumerable.Range(0, 20).Select(_ => Task.Run(() => _repository.UpdateBalanceAsync(account.Id, a => Deposit(a, 5)))));
+
+            // Assert
+            var stored = await _repository.GetByIdAsync(account.Id);
+            var transactions = (await _transactionRepository.GetByGasBankAccountIdAsync(account.Id)).ToList();
+            Assert.Equal(100, stored.Balance);
+            Assert.Equal(20, transactions.Count);
+            Assert.Equal(Enumerable.Range(1, 20).Select(i => i * 5m), transactions.Select(t => t.BalanceAfter).OrderBy(b => b));
+        }
+
+        [Fact]
+        public async Task UpdateBalanceAsync_CallbackThrows_RecordsNoTransaction()
+        {
+            // Arrange
+            var account = await _repository.CreateAsync(new GasBankAccount { Id = Guid.NewGuid(), AccountId = Guid.NewGuid(), Name = "Main" });
+
+            // Act
+            await Assert.ThrowsAsync<InvalidOperationException>(() => _repository.UpdateBalanceAsync(account.Id, _ => throw new InvalidOperationException("Insufficient balance")));
+            var missing = await _repository.UpdateBalanceAsync(Guid.NewGuid(), a => Deposit(a, 5));
+
+            // Assert
+            Assert.Null(missing);
+            Assert.Empty(await _transactionRepository.GetByGasBankAccountIdAsync(account.Id));
+        }
+    }
+}
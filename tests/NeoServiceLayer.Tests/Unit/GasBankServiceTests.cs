@@ -0,0 +1,567 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Services.GasBank;
+using NeoServiceLayer.Services.GasBank.Repositories;
+using NeoServiceLayer.Services.Storage.Providers;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class GasBankServiceTests : IDisposable
+    {
+        private const string ColdWalletAddress = "NXV7ZhHiyMn9SLdRcgYE8S7GZY4PjuLxrA";
+        private const string RecipientAddress = "NZNovWJ2a4uYBG3XJx6m2tuPKMDMXqQ8LE";
+
+        private readonly GasBankAccount _account;
+        private readonly List<GasBankWithdrawal> _withdrawals = new List<GasBankWithdrawal>();
+        private readonly List<GasBankTransaction> _transactions = new List<GasBankTransaction>();
+        private readonly Dictionary<Guid, GasBankWithdrawalStatus> _storedStatuses = new Dictionary<Guid, GasBankWithdrawalStatus>();
+        private readonly Mock<IWalletService> _walletService = new Mock<IWalletService>();
+        private readonly Mock<INeoRpcClient> _neoRpcClient = new Mock<INeoRpcClient>();
+        private readonly Mock<IGasBankWithdrawalRepository> _withdrawalRepository;
+        private readonly Mock<IGasBankAccountRepository> _accountRepository;
+        private readonly Mock<IGasBankTransactionRepository> _transactionRepository;
+        private readonly GasBankService _service;
+
+        public GasBankServiceTests()
+        {
+            _account = new GasBankAccount
+            {
+                Id = Guid.NewGuid(),
+                AccountId = Guid.NewGuid(),
+                Name = "Treasury",
+                WalletId = Guid.NewGuid(),
+                NeoAddress = RecipientAddress,
+                Balance = 1000,
+                HotBalance = 150
+            };
+
+            var accountRepository = new Mock<IGasBankAccountRepository>();
+            accountRepository.Setup(r => r.GetByIdAsync(_account.Id)).ReturnsAsync(_account);
+            accountRepository.Setup(r => r.GetAllAsync()).ReturnsAsync(new[] { _account });
+            accountRepository.Setup(r => r.UpdateBalanceAsync(It.IsAny<Guid>(), It.IsAny<Func<GasBankAccount, GasBankTransaction>>()))
+                .ReturnsAsync((Guid id, Func<GasBankAccount, GasBankTransaction> apply) =>
+                {
+                    if (id != _account.Id)
+                    {
+                        return null;
+                    }
+
+                    var transaction = apply(_account);
+                    if (transaction != null)
+                    {
+                        _transactions.Add(transaction);
+                    }
+
+                    return _account;
+                });
+            accountRepository.Setup(r => r.UpdateBalanceWithEntriesAsync(It.IsAny<Guid>(), It.IsAny<Func<GasBankAccount, IEnumerable<GasBankTransaction>>>()))
+                .ReturnsAsync((Guid id, Func<GasBankAccount, IEnumerable<GasBankTransaction>> apply) =>
+                {
+                    if (id != _account.Id)
+                    {
+                        return null;
+                    }
+
+                    _transactions.AddRange(apply(_account));
+                    return _account;
+                });
+
+            var transactionRepository = new Mock<IGasBankTransactionRepository>();
+            transactionRepository.Setup(r => r.CreateAsync(It.IsAny<GasBankTransaction>()))
+                .ReturnsAsync((GasBankTransaction t) =>
+                {
+                    _transactions.Add(t);
+                    return t;
+                });
+            transactionRepository.Setup(r => r.GetByGasBankAccountIdAsync(It.IsAny<Guid>()))
+                .ReturnsAsync((Guid id) => _transactions.Where(t => t.GasBankAccountId == id).ToList());
+
+            var withdrawalRepository = new Mock<IGasBankWithdrawalRepository>();
+            withdrawalRepository.Setup(r => r.CreateAsync(It.IsAny<GasBankWithdrawal>()))
+                .ReturnsAsync((GasBankWithdrawal w) =>
+                {
+                    _withdrawals.Add(w);
+                    _storedStatuses[w.Id] = w.Status;
+                    return w;
+                });
+            withdrawalRepository.Setup(r => r.GetByStatusAsync(It.IsAny<GasBankWithdrawalStatus>()))
+                .ReturnsAsync((GasBankWithdrawalStatus status) => _withdrawals.Where(w => w.Status == status).ToList());
+            withdrawalRepository.Setup(r => r.UpdateAsync(It.IsAny<GasBankWithdrawal>()))
+                .ReturnsAsync((GasBankWithdrawal w) =>
+                {
+                    _storedStatuses[w.Id] = w.Status;
+                    return w;
+                });
+            withdrawalRepository.Setup(r => r.UpdateAsync(It.IsAny<GasBankWithdrawal>(), It.IsAny<GasBankWithdrawalStatus>()))
+                .ReturnsAsync((GasBankWithdrawal w, GasBankWithdrawalStatus expectedStatus) =>
+                {
+                    if (!_storedStatuses.TryGetValue(w.Id, out var stored) || stored != expectedStatus)
+                    {
+                        return false;
+                    }
+
+                    _storedStatuses[w.Id] = w.Status;
+                    return true;
+                });
+            withdrawalRepository.Setup(r => r.GetByIdAsync(It.IsAny<Guid>()))
+                .ReturnsAsync((Guid id) => _withdrawals.FirstOrDefault(w => w.Id == id));
+            _withdrawalRepository = withdrawalRepository;
+            _accountRepository = accountRepository;
+
+            _walletService.Setup(w => w.TransferGasAsync(It.IsAny<Guid>(), It.IsAny<string>(), It.IsAny<string>(), It.IsAny<decimal>()))
+                .ReturnsAsync("0xabc");
+
+            // Transactions are ten blocks deep unless a test says otherwise
+            _neoRpcClient.Setup(c => c.GetBlockCountAsync()).ReturnsAsync(100);
+            _neoRpcClient.Setup(c => c.GetTransactionHeightsAsync(It.IsAny<IEnumerable<string>>()))
+                .ReturnsAsync((IEnumerable<string> hashes) => hashes.Select(h => (long?)90).ToList());
+
+            _transactionRepository = transactionRepository;
+
+            _service = CreateService(new GasBankConfiguration
+            {
+                ColdWalletAddress = ColdWalletAddress,
+                HotWalletFloat = 100,
+                MinimumSweepAmount = 10,
+                SweepIntervalSeconds = 3600,
+                WithdrawalConfirmationIntervalSeconds = 0
+            });
+        }
+
+        public void Dispose()
+        {
+            _service.Dispose();
+        }
+
+        [Fact]
+        public async Task WithdrawAsync_ExceedsHotBalance_QueuesWithoutTransfer()
+        {
+            // Act
+            var withdrawal = await _service.WithdrawAsync(_account.Id, 400, RecipientAddress);
+
+            // Assert
+            Assert.Equal(GasBankWithdrawalStatus.Queued, withdrawal.Status);
+            Assert.Null(withdrawal.TransactionHash);
+            Assert.Equal(600, _account.Balance);
+            Assert.Equal(150, _account.HotBalance);
+            _walletService.Verify(w => w.TransferGasAsync(It.IsAny<Guid>(), It.IsAny<string>(), It.IsAny<string>(), It.IsAny<decimal>()), Times.Never);
+        }
+
+        [Fact]
+        public async Task BalanceChanges_AreWrittenWithTheirLedgerEntries()
+        {
+            // Act
+            await _service.WithdrawAsync(_account.Id, 100, RecipientAddress);
+            await _service.WithdrawAsync(_account.Id, 400, RecipientAddress);
+            await _service.AllocateToFunctionAsync(_account.Id, Guid.NewGuid(), 50);
+            await _service.DistributeAsync(_account.Id, 20, new List<GasPayoutRecipient>
+            {
+                new GasPayoutRecipient { Address = RecipientAddress, Weight = 1 },
+                new GasPayoutRecipient { Address = ColdWalletAddress, Weight = 1 }
+            });
+
+            // Assert
+            _accountRepository.Verify(r => r.UpdateAsync(It.IsAny<GasBankAccount>()), Times.Never);
+            Assert.Equal(
+                new[]
+                {
+                    GasBankTransactionType.Withdrawal, GasBankTransactionType.Withdrawal, GasBankTransactionType.Allocation,
+                    GasBankTransactionType.Payout, GasBankTransactionType.Payout
+                },
+                _transactions.Select(t => t.Type));
+            Assert.Equal(480, _account.Balance);
+            Assert.Equal(50, _account.AllocatedAmount);
+            Assert.Equal(30, _account.HotBalance);
+        }
+
+        [Fact]
+        public async Task GetTotalsAsync_IncludesQueuedWithdrawals()
+        {
+            // Arrange
+            await _service.WithdrawAsync(_account.Id, 400, RecipientAddress);
+
+            // Act
+            var totals = await _service.GetTotalsAsync();
+
+            // Assert
+            Assert.Equal(1, totals.AccountCount);
+            Assert.Equal(600, totals.Balance);
+            Assert.Equal(150, totals.HotBalance);
+            Assert.Equal(1, totals.QueuedWithdrawals);
+            Assert.Equal(400, totals.QueuedWithdrawalAmount);
+            Assert.Equal(0, totals.InDoubtWithdrawals);
+        }
+
+        [Fact]
+        public async Task ConfirmReplenishmentAsync_SendsQueuedWithdrawals()
+        {
+            // Arrange
+            var withdrawal = await _service.WithdrawAsync(_account.Id, 400, RecipientAddress);
+
+            // Act
+            await _service.ConfirmReplenishmentAsync(_account.Id, 300, "0xdef", "operator");
+
+            // Assert
+            Assert.Equal(GasBankWithdrawalStatus.Completed, withdrawal.Status);
+            Assert.Equal("0xabc", withdrawal.TransactionHash);
+            Assert.Equal(50, _account.HotBalance);
+            Assert.Contains(_transactions, t => t.Type == GasBankTransactionType.Replenishment && t.TransactionHash == "0xdef");
+            _walletService.Verify(w => w.TransferGasAsync(_account.WalletId, It.IsAny<string>(), RecipientAddress, 400), Times.Once);
+        }
+
+        [Fact]
+        public async Task ConfirmReplenishmentAsync_QueuedSendFails_RetriesWithBackoffThenReturnsAmount()
+        {
+            // Arrange
+            var withdrawal = await _service.WithdrawAsync(_account.Id, 400, RecipientAddress);
+            _walletService.Setup(w => w.TransferGasAsync(It.IsAny<Guid>(), It.IsAny<string>(), RecipientAddress, It.IsAny<decimal>()))
+                .ThrowsAsync(new InvalidOperationException("RPC unavailable"));
+
+            // Act
+            await _service.ConfirmReplenishmentAsync(_account.Id, 300, "0xdef", "operator");
+            var firstAttempt = (withdrawal.Status, withdrawal.Attempts, withdrawal.NextAttemptAt);
+
+            await _service.ConfirmReplenishmentAsync(_account.Id, 10, "0xdef2", "operator");
+            var attemptsDuringBackoff = withdrawal.Attempts;
+
+            withdrawal.Attempts = 4;
+            withdrawal.NextAttemptAt = DateTime.UtcNow.AddSeconds(-1);
+            await _service.ConfirmReplenishmentAsync(_account.Id, 10, "0xdef3", "operator");
+
+            // Assert
+            Assert.Equal(GasBankWithdrawalStatus.Queued, firstAttempt.Status);
+            Assert.Equal(1, firstAttempt.Attempts);
+            Assert.True(firstAttempt.NextAttemptAt > DateTime.UtcNow);
+            Assert.Equal(1, attemptsDuringBackoff);
+
+            Assert.Equal(GasBankWithdrawalStatus.Failed, withdrawal.Status);
+            Assert.Equal("RPC unavailable", withdrawal.LastError);
+            Assert.NotNull(withdrawal.StatusReason);
+            Assert.Equal(1000, _account.Balance);
+            Assert.Equal(470, _account.HotBalance);
+            Assert.Contains(_transactions, t => t.Type == GasBankTransactionType.Deposit && t.RelatedEntityId == withdrawal.Id);
+        }
+
+        [Fact]
+        public async Task ConfirmReplenishmentAsync_MoreThanColdBalance_Throws()
+        {
+            // Act & Assert
+            await Assert.ThrowsAsync<GasBankException>(() =>
+                _service.ConfirmReplenishmentAsync(_account.Id, 900, "0xdef", "operator"));
+        }
+
+        [Fact]
+        public async Task SweepToColdWalletAsync_MovesExcessAboveFloat()
+        {
+            // Act
+            var sweeps = (await _service.SweepToColdWalletAsync()).ToList();
+
+            // Assert
+            var sweep = Assert.Single(sweeps);
+            Assert.Equal(50, sweep.Amount);
+            Assert.Equal(100, _account.HotBalance);
+            Assert.Equal(1000, _account.Balance);
+            _walletService.Verify(w => w.TransferGasAsync(_account.WalletId, It.IsAny<string>(), ColdWalletAddress, 50), Times.Once);
+        }
+
+        [Fact]
+        public async Task WithdrawAsync_TransferFails_QueuesForRetry()
+        {
+            // Arrange
+            _walletService.Setup(w => w.TransferGasAsync(It.IsAny<Guid>(), It.IsAny<string>(), It.IsAny<string>(), It.IsAny<decimal>()))
+                .ThrowsAsync(new InvalidOperationException("RPC unavailable"));
+
+            // Act
+            var withdrawal = await _service.WithdrawAsync(_account.Id, 100, RecipientAddress);
+
+            // Assert
+            Assert.Equal(GasBankWithdrawalStatus.Queued, withdrawal.Status);
+            Assert.Equal(1, withdrawal.Attempts);
+            Assert.Equal("RPC unavailable", withdrawal.LastError);
+            Assert.True(withdrawal.NextAttemptAt > DateTime.UtcNow);
+            Assert.Equal(900, _account.Balance);
+            Assert.Equal(150, _account.HotBalance);
+            Assert.Equal(GasBankTransactionType.Withdrawal, Assert.Single(_transactions).Type);
+        }
+
+        [Fact]
+        public async Task WithdrawAsync_TransferFailsWithoutColdStorage_QueuesLaterWithdrawalsBehindIt()
+        {
+            // Arrange
+            using var service = CreateService(new GasBankConfiguration { SweepIntervalSeconds = 3600, WithdrawalConfirmationIntervalSeconds = 0 });
+            _walletService.SetupSequence(w => w.TransferGasAsync(It.IsAny<Guid>(), It.IsAny<string>(), It.IsAny<string>(), It.IsAny<decimal>()))
+                .ThrowsAsync(new InvalidOperationException("RPC unavailable"))
+                .ReturnsAsync("0xabc");
+
+            // Act
+            var failed = await service.WithdrawAsync(_account.Id, 100, RecipientAddress);
+            var later = await service.WithdrawAsync(_account.Id, 20, RecipientAddress);
+
+            // Assert
+            Assert.Equal(GasBankWithdrawalStatus.Queued, failed.Status);
+            Assert.NotNull(failed.NextAttemptAt);
+            Assert.Equal(GasBankWithdrawalStatus.Queued, later.Status);
+            Assert.Equal(880, _account.Balance);
+            _walletService.Verify(w => w.TransferGasAsync(It.IsAny<Guid>(), It.IsAny<string>(), It.IsAny<string>(), It.IsAny<decimal>()), Times.Once);
+        }
+
+        [Fact]
+        public async Task WithdrawAsync_TransferFailsWithNoAttemptsLeft_ReturnsAmountAndMarksFailed()
+        {
+            // Arrange
+            using var service = CreateService(new GasBankConfiguration
+            {
+                MaxWithdrawalAttempts = 1,
+                SweepIntervalSeconds = 3600,
+                WithdrawalConfirmationIntervalSeconds = 0
+            });
+            _walletService.Setup(w => w.TransferGasAsync(It.IsAny<Guid>(), It.IsAny<string>(), It.IsAny<string>(), It.IsAny<decimal>()))
+                .ThrowsAsync(new InvalidOperationException("RPC unavailable"));
+
+            // Act
+            await Assert.ThrowsAsync<GasBankException>(() => service.WithdrawAsync(_account.Id, 100, RecipientAddress));
+
+            // Assert
+            var withdrawal = Assert.Single(_withdrawals);
+            Assert.Equal(GasBankWithdrawalStatus.Failed, withdrawal.Status);
+            Assert.Equal(1000, _account.Balance);
+            Assert.Equal(150, _account.HotBalance);
+            Assert.Empty(_transactions);
+        }
+
+        [Fact]
+        public async Task ResolveInDoubtWithdrawalAsync_RecoveredSendingWithdrawal_ReturnsAmountWhenNotSent()
+        {
+            // Arrange: the process stopped after the amount was taken but before the transfer was recorded
+            var withdrawal = new GasBankWithdrawal
+            {
+                Id = Guid.NewGuid(),
+                GasBankAccountId = _account.Id,
+                Amount = 100,
+                ToAddress = RecipientAddress,
+                Status = GasBankWithdrawalStatus.Sending,
+                RequestedAt = DateTime.UtcNow.AddMinutes(-5)
+            };
+            _withdrawals.Add(withdrawal);
+            _account.Balance -= 100;
+            _account.HotBalance -= 100;
+
+            var step = new GasBankWithdrawalRecoveryStep(_withdrawalRepository.Object);
+
+            // Act
+            var recovery = await step.RecoverAsync(DateTime.UtcNow);
+            var rerun = await step.RecoverAsync(DateTime.UtcNow);
+            var resolved = await _service.ResolveInDoubtWithdrawalAsync(withdrawal.Id, null, "operator");
+
+            // Assert
+            Assert.Equal(1, recovery.Resolved);
+            Assert.Equal(0, rerun.Resolved);
+            Assert.Equal(GasBankWithdrawalStatus.Failed, resolved.Status);
+            Assert.Equal(1000, _account.Balance);
+            Assert.Equal(150, _account.HotBalance);
+            _walletService.Verify(w => w.TransferGasAsync(It.IsAny<Guid>(), It.IsAny<string>(), It.IsAny<string>(), It.IsAny<decimal>()), Times.Never);
+        }
+    
+        [Fact]
+        public async Task ConfirmWithdrawalsAsync_FaultedTransfer_ReturnsAmount()
+        {
+            // Arrange
+            await _service.WithdrawAsync(_account.Id, 100, RecipientAddress);
+            _neoRpcClient.Setup(c => c.GetApplicationLogsAsync(It.IsAny<IEnumerable<string>>()))
+                .ReturnsAsync(new[]
+                {
+                    new NeoApplicationLog
+                    {
+                        TxId = "0xabc",
+                        Executions = { new NeoApplicationExecution { VmState = "FAULT", Exception = "insufficient funds" } }
+                    }
+                });
+
+            // Act
+            var changed = await _service.ConfirmWithdrawalsAsync();
+
+            // Assert
+            var withdrawal = Assert.Single(changed);
+            Assert.Equal(GasBankWithdrawalStatus.FailedOnChain, withdrawal.Status);
+            Assert.Equal("insufficient funds", withdrawal.LastError);
+            Assert.Equal(1000, _account.Balance);
+            Assert.Equal(150, _account.HotBalance);
+            Assert.Contains(_transactions, t => t.Type == GasBankTransactionType.Deposit && t.RelatedEntityId == withdrawal.Id);
+        }
+
+        [Fact]
+        public async Task ConfirmWithdrawalsAsync_FaultedTransferSettledElsewhere_DoesNotReturnAmountAgain()
+        {
+            // Arrange: another instance already returned the amount
+            var sent = await _service.WithdrawAsync(_account.Id, 100, RecipientAddress);
+            _storedStatuses[sent.Id] = GasBankWithdrawalStatus.FailedOnChain;
+            _neoRpcClient.Setup(c => c.GetApplicationLogsAsync(It.IsAny<IEnumerable<string>>()))
+                .ReturnsAsync(new[]
+                {
+                    new NeoApplicationLog
+                    {
+                        TxId = "0xabc",
+                        Executions = { new NeoApplicationExecution { VmState = "FAULT", Exception = "insufficient funds" } }
+                    }
+                });
+
+            // Act
+            var changed = await _service.ConfirmWithdrawalsAsync();
+
+            // Assert
+            Assert.Empty(changed);
+            Assert.Equal(900, _account.Balance);
+            Assert.Equal(50, _account.HotBalance);
+            Assert.DoesNotContain(_transactions, t => t.Type == GasBankTransactionType.Deposit);
+        }
+
+        [Fact]
+        public async Task ConfirmWithdrawalsAsync_Standby_LeavesWithdrawalsToTheLeader()
+        {
+            // Arrange
+            var leadership = new Mock<ILeadershipService>();
+            leadership.Setup(l => l.IsLeader).Returns(false);
+            using var service = CreateService(
+                new GasBankConfiguration { SweepIntervalSeconds = 3600, WithdrawalConfirmationIntervalSeconds = 0 },
+                leadership.Object);
+            await service.WithdrawAsync(_account.Id, 100, RecipientAddress);
+
+            // Act
+            var changed = await service.ConfirmWithdrawalsAsync();
+
+            // Assert
+            Assert.Empty(changed);
+            Assert.Equal(GasBankWithdrawalStatus.Completed, Assert.Single(_withdrawals).Status);
+            _neoRpcClient.Verify(c => c.GetApplicationLogsAsync(It.IsAny<IEnumerable<string>>()), Times.Never);
+        }
+
+        [Fact]
+        public async Task ConfirmWithdrawalsAsync_HaltedTransfer_Confirms()
+        {
+            // Arrange
+            await _service.WithdrawAsync(_account.Id, 100, RecipientAddress);
+            _neoRpcClient.Setup(c => c.GetApplicationLogsAsync(It.IsAny<IEnumerable<string>>()))
+                .ReturnsAsync(new[]
+                {
+                    new NeoApplicationLog
+                    {
+                        TxId = "0xabc",
+                        Executions = { new NeoApplicationExecution { VmState = "HALT" } }
+                    }
+                });
+
+            // Act
+            var changed = await _service.ConfirmWithdrawalsAsync();
+
+            // Assert
+            var withdrawal = Assert.Single(changed);
+            Assert.Equal(GasBankWithdrawalStatus.Confirmed, withdrawal.Status);
+            Assert.NotNull(withdrawal.ConfirmedAt);
+            Assert.Equal(900, _account.Balance);
+            Assert.Equal(50, _account.HotBalance);
+        }
+
+        [Fact]
+        public async Task ConfirmWithdrawalsAsync_HaltedTransferTooShallow_WaitsForConfirmations()
+        {
+            // Arrange
+            await _service.WithdrawAsync(_account.Id, 100, RecipientAddress);
+            _neoRpcClient.Setup(c => c.GetApplicationLogsAsync(It.IsAny<IEnumerable<string>>()))
+                .ReturnsAsync(new[]
+                {
+                    new NeoApplicationLog
+                    {
+                        TxId = "0xabc",
+                        Executions = { new NeoApplicationExecution { VmState = "HALT" } }
+                    }
+                });
+            _neoRpcClient.Setup(c => c.GetTransactionHeightsAsync(It.IsAny<IEnumerable<string>>()))
+                .ReturnsAsync(new long?[] { 98 });
+
+            // Act
+            var changed = await _service.ConfirmWithdrawalsAsync();
+
+            // Assert
+            Assert.Empty(changed);
+            var withdrawal = Assert.Single(_withdrawals);
+            Assert.Equal(GasBankWithdrawalStatus.Completed, withdrawal.Status);
+            Assert.Equal(2, withdrawal.Confirmations);
+            Assert.Null(withdrawal.ConfirmedAt);
+        }
+
+        [Fact]
+        public async Task ConfirmReplenishmentAsync_UnknownTransaction_ThrowsWithoutCrediting()
+        {
+            // Arrange
+            _neoRpcClient.Setup(c => c.GetTransactionHeightsAsync(It.IsAny<IEnumerable<string>>()))
+                .ReturnsAsync(new long?[] { null });
+
+            // Act & Assert
+            await Assert.ThrowsAsync<GasBankException>(() =>
+                _service.ConfirmReplenishmentAsync(_account.Id, 100, "0xdef", "operator"));
+            Assert.Equal(150, _account.HotBalance);
+        }
+
+        [Fact]
+        public async Task ChargeFunctionExecutionAsync_ConcurrentCharges_DrawDownTheAllocationOnceEach()
+        {
+            // Arrange
+            var storageProvider = new InMemoryStorageProvider(new Mock<ILogger<InMemoryStorageProvider>>().Object);
+            var transactionRepository = new GasBankTransactionRepository(new Mock<ILogger<GasBankTransactionRepository>>().Object, storageProvider);
+            var allocationRepository = new GasBankAllocationRepository(new Mock<ILogger<GasBankAllocationRepository>>().Object, storageProvider);
+            var accountRepository = new GasBankAccountRepository(
+                new Mock<ILogger<GasBankAccountRepository>>().Object, storageProvider, transactionRepository, allocationRepository);
+            var service = new GasBankService(
+                new Mock<ILogger<GasBankService>>().Object,
+                accountRepository,
+                allocationRepository,
+                transactionRepository,
+                _walletService.Object,
+                new Mock<IEnclaveService>().Object,
+                _withdrawalRepository.Object);
+            var functionId = Guid.NewGuid();
+            var account = await accountRepository.CreateAsync(new GasBankAccount { Id = Guid.NewGuid(), AccountId = Guid.NewGuid(), Name = "Main", Balance = 100 });
+            await service.AllocateToFunctionAsync(account.Id, functionId, 50);
+
+            // Act
+            await Task.WhenAll(Enumerable.Range(0, 20).Select(_ =>
+                Task.Run(() => service.ChargeFunctionExecutionAsync(account.AccountId, functionId, Guid.NewGuid(), 2))));
+
+            // Assert
+            var allocation = (await allocationRepository.GetByFunctionIdAsync(functionId)).Single();
+            var stored = await accountRepository.GetByIdAsync(account.Id);
+            var charges = (await transactionRepository.GetByGasBankAccountIdAsync(account.Id))
+                .Where(t => t.Type == GasBankTransactionType.FunctionExecution)
+                .ToList();
+            Assert.Equal(20, charges.Count);
+            Assert.Equal(10, allocation.Amount);
+            Assert.Equal(10, stored.AllocatedAmount);
+            Assert.Equal(60, stored.Balance);
+        }
+
+        private GasBankService CreateService(GasBankConfiguration configuration, ILeadershipService leadership = null)
+        {
+            return new GasBankService(
+                new Mock<ILogger<GasBankService>>().Object,
+                _accountRepository.Object,
+                new Mock<IGasBankAllocationRepository>().Object,
+                _transactionRepository.Object,
+                _walletService.Object,
+                new Mock<IEnclaveService>().Object,
+                _withdrawalRepository.Object,
+                configuration: Options.Create(configuration),
+                neoRpcClient: _neoRpcClient.Object,
+                leadership: leadership);
+        }
+    }
+}
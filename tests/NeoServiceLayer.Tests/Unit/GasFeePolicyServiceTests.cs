@@ -0,0 +1,161 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.GasBank;
+using NeoServiceLayer.Services.GasBank.Repositories;
+using Newtonsoft.Json;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class GasFeePolicyServiceTests
+    {
+        private const string Contract = "0x1a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d";
+
+        private readonly Dictionary<Guid, GasFeePolicyTemplate> _templates = new Dictionary<Guid, GasFeePolicyTemplate>();
+        private readonly Dictionary<Guid, GasFeePolicy> _policies = new Dictionary<Guid, GasFeePolicy>();
+        private readonly Mock<IGasBankService> _gasBankService = new Mock<IGasBankService>();
+        private readonly Guid _admin = Guid.NewGuid();
+        private readonly GasBankAccount _sponsorAccount;
+        private readonly GasFeePolicyService _service;
+
+        public GasFeePolicyServiceTests()
+        {
+            _sponsorAccount = new GasBankAccount { Id = Guid.NewGuid(), AccountId = _admin, Balance = 10 };
+
+            var accountRepository = new Mock<IGasBankAccountRepository>();
+            accountRepository.Setup(r => r.GetByIdAsync(_sponsorAccount.Id)).ReturnsAsync(_sponsorAccount);
+
+            var templateRepository = new Mock<IGasFeePolicyTemplateRepository>();
+            templateRepository.Setup(r => r.CreateAsync(It.IsAny<GasFeePolicyTemplate>()))
+                .ReturnsAsync((GasFeePolicyTemplate t) => { _templates[t.Id] = Copy(t); return t; });
+            templateRepository.Setup(r => r.GetByIdAsync(It.IsAny<Guid>()))
+                .ReturnsAsync((Guid id) => _templates.TryGetValue(id, out var t) ? Copy(t) : null);
+            templateRepository.Setup(r => r.UpdateAsync(It.IsAny<GasFeePolicyTemplate>(), It.IsAny<int>()))
+                .ReturnsAsync((GasFeePolicyTemplate t, int expected) =>
+                {
+                    if (_templates[t.Id].LatestVersion != expected)
+                    {
+                        return false;
+                    }
+
+                    _templates[t.Id] = Copy(t);
+                    return true;
+                });
+
+            var policyRepository = new Mock<IGasFeePolicyRepository>();
+            policyRepository.Setup(r => r.CreateAsync(It.IsAny<GasFeePolicy>()))
+                .ReturnsAsync((GasFeePolicy p) => { _policies[p.Id] = Copy(p); return p; });
+            policyRepository.Setup(r => r.GetByIdAsync(It.IsAny<Guid>()))
+                .ReturnsAsync((Guid id) => _policies.TryGetValue(id, out var p) ? Copy(p) : null);
+            policyRepository.Setup(r => r.GetByAccountIdAsync(It.IsAny<Guid>()))
+                .ReturnsAsync((Guid id) => _policies.Values.Where(p => p.AccountId == id || p.SponsorAccountId == id).Select(Copy).ToList());
+            policyRepository.Setup(r => r.GetByTemplateIdAsync(It.IsAny<Guid>()))
+                .ReturnsAsync((Guid id) => _policies.Values.Where(p => p.TemplateId == id).Select(Copy).ToList());
+            policyRepository.Setup(r => r.UpdateAsync(It.IsAny<GasFeePolicy>()))
+                .ReturnsAsync((GasFeePolicy p) => { _policies[p.Id] = Copy(p); return p; });
+
+            _service = new GasFeePolicyService(
+                Mock.Of<ILogger<GasFeePolicyService>>(),
+                templateRepository.Object,
+                policyRepository.Object,
+                accountRepository.Object,
+                _gasBankService.Object);
+        }
+
+        [Fact]
+        public void Merge_OverridesReplaceOnlyTheRulesTheySet()
+        {
+            // Arrange
+            var rules = new GasFeePolicyRules { ContractHash = Contract, Methods = new List<string> { "transfer" }, MaxFeePerTransaction = 0.05m };
+
+            // Act
+            var merged = GasFeePolicyRules.Merge(rules, new GasFeePolicyRules { MaxFeePerTransaction = 0.02m, DailyLimit = 1 });
+
+            // Assert
+            Assert.Equal(Contract, merged.ContractHash);
+            Assert.Equal(new[] { "transfer" }, merged.Methods);
+            Assert.Equal(0.02m, merged.MaxFeePerTransaction);
+            Assert.Equal(1m, merged.DailyLimit);
+        }
+
+        [Fact]
+        public async Task ApplyTemplateAsync_FollowingPoliciesPickUpNewVersion()
+        {
+            // Arrange
+            var template = await _service.CreateTemplateAsync(_admin, "Transfers", null, false, TransferRules(0.05m));
+            var users = new[] { Guid.NewGuid(), Guid.NewGuid() };
+
+            // Act
+            var applied = await _service.ApplyTemplateAsync(template.Id, _admin, _sponsorAccount.Id, users, null);
+            await _service.PublishVersionAsync(template.Id, _admin, TransferRules(0.1m), "Raise the cap");
+            var policy = await _service.GetPolicyAsync(applied.Created[0].Id);
+
+            // Assert
+            Assert.Equal(2, applied.Created.Count);
+            Assert.Empty(applied.Updated);
+            Assert.Equal(0.1m, policy.EffectiveRules.MaxFeePerTransaction);
+            await Assert.ThrowsAsync<ForbiddenAccessException>(() =>
+                _service.ApplyTemplateAsync(template.Id, Guid.NewGuid(), _sponsorAccount.Id, users, null));
+        }
+
+        [Fact]
+        public async Task ApplyTemplateAsync_ExistingPolicy_KeepsOverridesAndPinsVersion()
+        {
+            // Arrange
+            var template = await _service.CreateTemplateAsync(_admin, "Transfers", null, false, TransferRules(0.05m));
+            await _service.PublishVersionAsync(template.Id, _admin, TransferRules(0.1m), null);
+            var user = Guid.NewGuid();
+            var policy = await _service.CreatePolicyAsync(template.Id, _admin, _sponsorAccount.Id, user, null, new GasFeePolicyRules { DailyLimit = 2 });
+
+            // Act
+            var applied = await _service.ApplyTemplateAsync(template.Id, _admin, _sponsorAccount.Id, new[] { user }, 1);
+
+            // Assert
+            Assert.Empty(applied.Created);
+            Assert.Equal(policy.Id, applied.Updated.Single().Id);
+            Assert.Equal(0.05m, applied.Updated[0].EffectiveRules.MaxFeePerTransaction);
+            Assert.Equal(2m, applied.Updated[0].EffectiveRules.DailyLimit);
+        }
+
+        [Fact]
+        public async Task SponsorAsync_ChargesSponsorWithinRulesAndDailyLimit()
+        {
+            // Arrange
+            var template = await _service.CreateTemplateAsync(_admin, "Transfers", null, false, TransferRules(0.05m));
+            var user = Guid.NewGuid();
+            var policy = await _service.CreatePolicyAsync(template.Id, _admin, _sponsorAccount.Id, user, null, new GasFeePolicyRules { DailyLimit = 0.07m });
+
+            // Act
+            var first = await _service.SponsorAsync(user, Contract, "transfer", 0.04m, "0x01");
+            var overLimit = await _service.SponsorAsync(user, Contract, "transfer", 0.04m, "0x02");
+            var otherMethod = await _service.SponsorAsync(user, Contract, "approve", 0.01m, "0x03");
+            var tooExpensive = await _service.SponsorAsync(user, Contract, "transfer", 0.06m, "0x04");
+
+            // Assert
+            Assert.Equal(policy.Id, first.Id);
+            Assert.Equal(0.04m, _policies[policy.Id].SpentToday);
+            Assert.Null(overLimit);
+            Assert.Null(otherMethod);
+            Assert.Null(tooExpensive);
+            _gasBankService.Verify(g => g.ChargeNetworkFeeAsync(_sponsorAccount.Id, 0.04m, "0x01", It.IsAny<string>(), policy.Id), Times.Once);
+            _gasBankService.Verify(g => g.ChargeNetworkFeeAsync(It.IsAny<Guid>(), It.IsAny<decimal>(), It.IsAny<string>(), It.IsAny<string>(), It.IsAny<Guid?>()), Times.Once);
+        }
+
+        private static GasFeePolicyRules TransferRules(decimal maxFee)
+        {
+            return new GasFeePolicyRules { ContractHash = Contract, Methods = new List<string> { "transfer" }, MaxFeePerTransaction = maxFee };
+        }
+
+        private static T Copy<T>(T value)
+        {
+            return JsonConvert.DeserializeObject<T>(JsonConvert.SerializeObject(value));
+        }
+    }
+}
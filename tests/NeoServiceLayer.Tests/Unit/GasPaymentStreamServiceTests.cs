@@ -0,0 +1,238 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.GasBank;
+using NeoServiceLayer.Services.GasBank.Repositories;
+using Newtonsoft.Json;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class GasPaymentStreamServiceTests
+    {
+        private readonly Dictionary<Guid, GasBankAccount> _accounts = new Dictionary<Guid, GasBankAccount>();
+        private readonly Dictionary<Guid, GasPaymentStream> _streams = new Dictionary<Guid, GasPaymentStream>();
+        private readonly List<GasBankTransaction> _transactions = new List<GasBankTransaction>();
+        private readonly Mock<IWalletService> _walletService = new Mock<IWalletService>();
+        private readonly GasBankAccount _payer;
+        private readonly GasBankAccount _payee;
+        private readonly Mock<IGasPaymentStreamRepository> _streamRepository = new Mock<IGasPaymentStreamRepository>();
+        private readonly GasPaymentStreamService _service;
+
+        public GasPaymentStreamServiceTests()
+        {
+            _payer = AddAccount(100);
+            _payee = AddAccount(0);
+
+            var accountRepository = new Mock<IGasBankAccountRepository>();
+            accountRepository.Setup(r => r.GetByIdAsync(It.IsAny<Guid>()))
+                .ReturnsAsync((Guid id) => _accounts.GetValueOrDefault(id));
+            accountRepository.Setup(r => r.UpdateBalanceAsync(It.IsAny<Guid>(), It.IsAny<Func<GasBankAccount, GasBankTransaction>>()))
+                .ReturnsAsync((Guid id, Func<GasBankAccount, GasBankTransaction> apply) =>
+                {
+                    var transaction = apply(_accounts[id]);
+                    if (transaction != null)
+                    {
+                        _transactions.Add(transaction);
+                    }
+
+                    return _accounts[id];
+                });
+
+            var streamRepository = _streamRepository;
+            streamRepository.Setup(r => r.CreateAsync(It.IsAny<GasPaymentStream>()))
+                .ReturnsAsync((GasPaymentStream s) => { _streams[s.Id] = Copy(s); return s; });
+            streamRepository.Setup(r => r.GetByIdAsync(It.IsAny<Guid>()))
+                .ReturnsAsync((Guid id) => _streams.TryGetValue(id, out var s) ? Copy(s) : null);
+            streamRepository.Setup(r => r.GetByStatusAsync(It.IsAny<GasPaymentStreamStatus>()))
+                .ReturnsAsync((GasPaymentStreamStatus status) => _streams.Values.Where(s => s.Status == status).Select(Copy).ToList());
+            streamRepository.Setup(r => r.UpdateAsync(It.IsAny<GasPaymentStream>(), It.IsAny<GasPaymentStreamStatus>()))
+                .ReturnsAsync((GasPaymentStream s, GasPaymentStreamStatus expected) =>
+                {
+                    if (_streams[s.Id].Status != expected)
+                    {
+                        return false;
+                    }
+
+                    _streams[s.Id] = Copy(s);
+                    return true;
+                });
+
+            _walletService.Setup(w => w.TransferGasAsync(_payer.WalletId, It.IsAny<string>(), _payee.NeoAddress, It.IsAny<decimal>()))
+                .ReturnsAsync("0xsettlement");
+
+            _service = new GasPaymentStreamService(
+                Mock.Of<ILogger<GasPaymentStreamService>>(),
+                streamRepository.Object,
+                accountRepository.Object,
+                _walletService.Object);
+        }
+
+        [Fact]
+        public void CalculateAccrued_StopsAtCapAndExpiry()
+        {
+            // Arrange
+            var start = new DateTime(2026, 1, 1, 0, 0, 0, DateTimeKind.Utc);
+            var stream = new GasPaymentStream { RatePerSecond = 0.5m, Cap = 40, StartedAt = start, ExpiresAt = start.AddSeconds(60) };
+
+            // Act & Assert
+            Assert.Equal(5m, GasPaymentStreamService.CalculateAccrued(stream, start.AddSeconds(10)));
+            Assert.Equal(40m, GasPaymentStreamService.CalculateAccrued(stream, start.AddSeconds(90)));
+            Assert.Equal(0m, GasPaymentStreamService.CalculateAccrued(stream, start.AddSeconds(-1)));
+        }
+
+        [Fact]
+        public async Task OpenAsync_ReservesCap()
+        {
+            // Act
+            var stream = await _service.OpenAsync(NewStream(cap: 30));
+
+            // Assert
+            Assert.Equal(GasPaymentStreamStatus.Open, stream.Status);
+            Assert.Equal(_payee.AccountId, stream.PayeeAccountId);
+            Assert.Equal(30m, _payer.AllocatedAmount);
+            Assert.Contains(_transactions, t => t.Type == GasBankTransactionType.Allocation && t.RelatedEntityId == stream.Id);
+            await Assert.ThrowsAsync<GasBankException>(() => _service.OpenAsync(NewStream(cap: 80)));
+        }
+
+        [Fact]
+        public async Task CloseAsync_ByPayee_SettlesAccruedAmountAndReleasesRest()
+        {
+            // Arrange
+            var stream = await _service.OpenAsync(NewStream(cap: 30));
+            _streams[stream.Id].StartedAt = DateTime.UtcNow.AddSeconds(-100);
+
+            // Act
+            var settled = await _service.CloseAsync(stream.Id, _payee.AccountId);
+
+            // Assert
+            Assert.Equal(GasPaymentStreamStatus.Settled, settled.Status);
+            Assert.Equal(_payee.AccountId, settled.ClosedBy);
+            Assert.InRange(settled.AccruedAmount, 10m, 10.1m);
+            Assert.Equal("0xsettlement", settled.SettlementTransactionHash);
+            Assert.Equal(100m - settled.AccruedAmount, _payer.Balance);
+            Assert.Equal(0m, _payer.AllocatedAmount);
+            Assert.Equal(settled.AccruedAmount, _payee.Balance);
+            Assert.Contains(_transactions, t => t.Type == GasBankTransactionType.StreamPayment && t.Amount == settled.AccruedAmount);
+            Assert.Contains(_transactions, t => t.Type == GasBankTransactionType.StreamReceipt && t.GasBankAccountId == _payee.Id);
+            Assert.Contains(_transactions, t => t.Type == GasBankTransactionType.Deallocation && t.Amount == 30m - settled.AccruedAmount);
+        }
+
+        [Fact]
+        public async Task CloseAsync_TransferFails_KeepsStreamClosedForRetry()
+        {
+            // Arrange
+            var stream = await _service.OpenAsync(NewStream(cap: 30));
+            _streams[stream.Id].StartedAt = DateTime.UtcNow.AddSeconds(-10);
+            _walletService.Setup(w => w.TransferGasAsync(It.IsAny<Guid>(), It.IsAny<string>(), It.IsAny<string>(), It.IsAny<decimal>()))
+                .ThrowsAsync(new InvalidOperationException("RPC unavailable"));
+
+            // Act
+            var closed = await _service.CloseAsync(stream.Id, _payer.AccountId);
+
+            // Assert
+            Assert.Equal(GasPaymentStreamStatus.Closed, closed.Status);
+            Assert.Equal("RPC unavailable", closed.LastError);
+            Assert.Equal(100m, _payer.Balance);
+            Assert.Equal(30m, _payer.AllocatedAmount);
+        }
+
+        [Fact]
+        public async Task RecoverAsync_StreamSettlingAtRestart_IsInDoubtUntilResolvedAsSent()
+        {
+            // Arrange: the previous process sent the settlement but stopped before recording it
+            var stream = await _service.OpenAsync(NewStream(cap: 30));
+            var stored = _streams[stream.Id];
+            stored.Status = GasPaymentStreamStatus.Settling;
+            stored.AccruedAmount = 5;
+            stored.SettlementTransactionHash = "0xsettlement";
+            stored.UpdatedAt = DateTime.UtcNow.AddMinutes(-5);
+            var step = new GasPaymentStreamRecoveryStep(_streamRepository.Object);
+
+            // Act
+            var recovery = await step.RecoverAsync(DateTime.UtcNow);
+            var inDoubt = await _service.GetInDoubtAsync();
+            await _service.RunCycleAsync();
+            var resolved = await _service.ResolveInDoubtAsync(stream.Id, "0xsettlement", "operator");
+
+            // Assert
+            Assert.Equal(1, recovery.Resolved);
+            Assert.Equal(stream.Id, Assert.Single(inDoubt).Id);
+            Assert.Equal(GasPaymentStreamStatus.Settled, resolved.Status);
+            Assert.Equal(95m, _payer.Balance);
+            Assert.Equal(0m, _payer.AllocatedAmount);
+            Assert.Equal(5m, _payee.Balance);
+            _walletService.Verify(w => w.TransferGasAsync(It.IsAny<Guid>(), It.IsAny<string>(), It.IsAny<string>(), It.IsAny<decimal>()), Times.Never);
+        }
+
+        [Fact]
+        public async Task ResolveInDoubtAsync_NotSent_SettlesAgainOnNextCycle()
+        {
+            // Arrange
+            var stream = await _service.OpenAsync(NewStream(cap: 30));
+            var stored = _streams[stream.Id];
+            stored.Status = GasPaymentStreamStatus.InDoubt;
+            stored.AccruedAmount = 5;
+
+            // Act
+            var resolved = await _service.ResolveInDoubtAsync(stream.Id, null, "operator");
+            await _service.RunCycleAsync();
+
+            // Assert
+            Assert.Equal(GasPaymentStreamStatus.Closed, resolved.Status);
+            Assert.Equal(GasPaymentStreamStatus.Settled, _streams[stream.Id].Status);
+            Assert.Equal(95m, _payer.Balance);
+            _walletService.Verify(w => w.TransferGasAsync(_payer.WalletId, It.IsAny<string>(), _payee.NeoAddress, 5m), Times.Once);
+        }
+
+        [Fact]
+        public async Task CloseAsync_ByOtherAccount_ThrowsForbiddenAccessException()
+        {
+            // Arrange
+            var stream = await _service.OpenAsync(NewStream(cap: 30));
+
+            // Act & Assert
+            await Assert.ThrowsAsync<ForbiddenAccessException>(() => _service.CloseAsync(stream.Id, Guid.NewGuid()));
+        }
+
+        private GasPaymentStream NewStream(decimal cap)
+        {
+            return new GasPaymentStream
+            {
+                PayerAccountId = _payer.AccountId,
+                PayerGasBankAccountId = _payer.Id,
+                PayeeGasBankAccountId = _payee.Id,
+                RatePerSecond = 0.1m,
+                Cap = cap,
+                ExpiresAt = DateTime.UtcNow.AddHours(1)
+            };
+        }
+
+        private GasBankAccount AddAccount(decimal balance)
+        {
+            var account = new GasBankAccount
+            {
+                Id = Guid.NewGuid(),
+                AccountId = Guid.NewGuid(),
+                Balance = balance,
+                HotBalance = balance,
+                WalletId = Guid.NewGuid(),
+                NeoAddress = "N" + Guid.NewGuid().ToString("N")
+            };
+
+            _accounts[account.Id] = account;
+            return account;
+        }
+
+        private static GasPaymentStream Copy(GasPaymentStream stream)
+        {
+            return JsonConvert.DeserializeObject<GasPaymentStream>(JsonConvert.SerializeObject(stream));
+        }
+    }
+}
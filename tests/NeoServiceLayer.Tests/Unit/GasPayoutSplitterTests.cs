@@ -0,0 +1,83 @@
+using System.Collections.Generic;
+using System.Linq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.GasBank;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class GasPayoutSplitterTests
+    {
+        private const string AddressA = "NXV7ZhHiyM1aHXwpVsRZC6BwNFP2jghXAq";
+        private const string AddressB = "NZNovCXi3cfqyLZB4bYj8nYZ3gVVRmV6Xq";
+        private const string AddressC = "NbnjKGMBJzJ6j5PHeYhjJDaQ5Vy5UYu4Fv";
+
+        [Fact]
+        public void Split_ByWeight_ReturnsProportionalShares()
+        {
+            // Arrange
+            var recipients = new List<GasPayoutRecipient>
+            {
+                new GasPayoutRecipient { Address = AddressA, Weight = 3 },
+                new GasPayoutRecipient { Address = AddressB, Weight = 1 }
+            };
+
+            // Act
+            var shares = GasPayoutSplitter.Split(10m, recipients);
+
+            // Assert
+            Assert.Equal(7.5m, shares[0].Value);
+            Assert.Equal(2.5m, shares[1].Value);
+        }
+
+        [Fact]
+        public void Split_UnevenAmount_GivesDustToLargestWeight()
+        {
+            // Arrange
+            var recipients = new List<GasPayoutRecipient>
+            {
+                new GasPayoutRecipient { Address = AddressA, Weight = 1 },
+                new GasPayoutRecipient { Address = AddressB, Weight = 2 },
+                new GasPayoutRecipient { Address = AddressC, Weight = 1 }
+            };
+
+            // Act
+            var shares = GasPayoutSplitter.Split(0.00000007m, recipients);
+
+            // Assert
+            Assert.Equal(0.00000007m, shares.Sum(s => s.Value));
+            Assert.Equal(0.00000001m, shares[0].Value);
+            Assert.Equal(0.00000005m, shares[1].Value);
+            Assert.Equal(0.00000001m, shares[2].Value);
+        }
+
+        [Fact]
+        public void Split_DuplicateRecipient_Throws()
+        {
+            // Arrange
+            var recipients = new List<GasPayoutRecipient>
+            {
+                new GasPayoutRecipient { Address = AddressA, Weight = 1 },
+                new GasPayoutRecipient { Address = AddressA, Weight = 1 }
+            };
+
+            // Act & Assert
+            Assert.Throws<GasBankException>(() => GasPayoutSplitter.Split(1m, recipients));
+        }
+
+        [Fact]
+        public void Split_AmountTooSmall_Throws()
+        {
+            // Arrange
+            var recipients = new List<GasPayoutRecipient>
+            {
+                new GasPayoutRecipient { Address = AddressA, Weight = 1 },
+                new GasPayoutRecipient { Address = AddressB, Weight = 1 }
+            };
+
+            // Act & Assert
+            Assert.Throws<GasBankException>(() => GasPayoutSplitter.Split(0.00000001m, recipients));
+        }
+    }
+}
@@ -0,0 +1,116 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Net;
+using System.Net.Http;
+using System.Text;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.DependencyInjection;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Services.PriceFeed.GasPrices;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class GasPriceOracleServiceTests
+    {
+        private readonly Mock<INeoRpcClient> _neoRpcClient = new Mock<INeoRpcClient>();
+        private readonly Mock<IPriceFeedService> _priceFeedService = new Mock<IPriceFeedService>();
+        private readonly GasPriceOracleService _service;
+
+        public GasPriceOracleServiceTests()
+        {
+            // 1000 datoshi per byte and an exec fee factor of 30
+            _neoRpcClient.Setup(c => c.InvokeFunctionBatchAsync(It.IsAny<IEnumerable<NeoInvocation>>()))
+                .ReturnsAsync(new List<NeoInvokeResult>
+                {
+                    new NeoInvokeResult { State = "HALT", Stack = new List<NeoStackItem> { new NeoStackItem { Type = "Integer", Value = "1000" } } },
+                    new NeoInvokeResult { State = "HALT", Stack = new List<NeoStackItem> { new NeoStackItem { Type = "Integer", Value = "30" } } }
+                });
+            _priceFeedService.Setup(p => p.GetLatestPriceAsync("GAS", "USD")).ReturnsAsync(new Price { Symbol = "GAS", Value = 5m });
+            _priceFeedService.Setup(p => p.GetLatestPriceAsync("ETH", "USD")).ReturnsAsync(new Price { Symbol = "ETH", Value = 2000m });
+
+            var scopeFactory = new ServiceCollection()
+                .AddSingleton(_priceFeedService.Object)
+                .BuildServiceProvider()
+                .GetRequiredService<IServiceScopeFactory>();
+
+            var configuration = Options.Create(new GasPriceOracleConfiguration
+            {
+                NeoTransferBytes = 250,
+                NeoTransferExecutionUnits = 66000,
+                Chains = new List<GasPriceChain>
+                {
+                    new GasPriceChain { Name = "Ethereum", RpcUrl = "https://eth.example", NativeSymbol = "eth", TransferGasUnits = 21000 }
+                }
+            });
+
+            // 20 gwei
+            var httpClient = new HttpClient(new StubHandler("{\"jsonrpc\":\"2.0\",\"id\":1,\"result\":\"0x4a817c800\"}"));
+
+            _service = new GasPriceOracleService(
+                Mock.Of<ILogger<GasPriceOracleService>>(),
+                scopeFactory,
+                _neoRpcClient.Object,
+                httpClient,
+                configuration);
+        }
+
+        [Fact]
+        public async Task RefreshAsync_ReadsNeoAndEvmChains_PricesTransfersInUsd()
+        {
+            // Act
+            var prices = (await _service.RefreshAsync()).ToDictionary(p => p.Chain);
+
+            // Assert
+            var neo = prices["neo"];
+            Assert.Equal(0.00001m, neo.GasPrice);
+            Assert.Equal(30, neo.ExecFeeFactor);
+            Assert.Equal(0.0223m, neo.TransferFee);
+            Assert.Equal(0.1115m, neo.TransferFeeUsd);
+
+            var ethereum = prices["ethereum"];
+            Assert.Equal("ETH", ethereum.NativeSymbol);
+            Assert.Equal(0.00000002m, ethereum.GasPrice);
+            Assert.Equal(0.00042m, ethereum.TransferFee);
+            Assert.Equal(0.84m, ethereum.TransferFeeUsd);
+            Assert.Null(ethereum.Error);
+        }
+
+        [Fact]
+        public async Task GetCheapestChainAsync_SkipsChainsOutsideTheCandidates()
+        {
+            // Act
+            var cheapest = await _service.GetCheapestChainAsync();
+            var cheapestEvm = await _service.GetCheapestChainAsync(new[] { "ETHEREUM", "bsc" });
+
+            // Assert
+            Assert.Equal("neo", cheapest.Chain);
+            Assert.Equal("ethereum", cheapestEvm.Chain);
+            Assert.Equal(2, _service.GetCachedGasPrices().Count);
+        }
+
+        private class StubHandler : HttpMessageHandler
+        {
+            private readonly string _response;
+
+            public StubHandler(string response)
+            {
+                _response = response;
+            }
+
+            protected override Task<HttpResponseMessage> SendAsync(HttpRequestMessage request, CancellationToken cancellationToken)
+            {
+                return Task.FromResult(new HttpResponseMessage(HttpStatusCode.OK)
+                {
+                    Content = new StringContent(_response, Encoding.UTF8, "application/json")
+                });
+            }
+        }
+    }
+}
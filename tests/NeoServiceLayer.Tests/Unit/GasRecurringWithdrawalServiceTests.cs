@@ -0,0 +1,142 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.GasBank;
+using NeoServiceLayer.Services.GasBank.Repositories;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class GasRecurringWithdrawalServiceTests
+    {
+        private const string ToAddress = "NXV7ZhHiyM1aHXwpVsRZC6BwNFP2jghXAq";
+
+        private readonly GasBankAccount _gasBankAccount;
+        private readonly GasRecurringWithdrawal _withdrawal;
+        private readonly List<GasRecurringWithdrawalOccurrence> _occurrences = new List<GasRecurringWithdrawalOccurrence>();
+        private readonly Mock<IGasBankService> _gasBankService = new Mock<IGasBankService>();
+        private readonly Mock<IGasRecurringWithdrawalRepository> _repository = new Mock<IGasRecurringWithdrawalRepository>();
+        private readonly GasRecurringWithdrawalService _service;
+
+        public GasRecurringWithdrawalServiceTests()
+        {
+            _gasBankAccount = new GasBankAccount { Id = Guid.NewGuid(), AccountId = Guid.NewGuid(), Balance = 150, AllocatedAmount = 20 };
+            _withdrawal = new GasRecurringWithdrawal
+            {
+                Id = Guid.NewGuid(),
+                AccountId = _gasBankAccount.AccountId,
+                GasBankAccountId = _gasBankAccount.Id,
+                ToAddress = ToAddress,
+                Schedule = "0 0 * * 1",
+                Floor = 100,
+                Status = GasRecurringWithdrawalStatus.Active,
+                NextRunAt = DateTime.UtcNow.AddMinutes(-1)
+            };
+
+            var accountRepository = new Mock<IGasBankAccountRepository>();
+            accountRepository.Setup(r => r.GetByIdAsync(_gasBankAccount.Id)).ReturnsAsync(_gasBankAccount);
+
+            _repository.Setup(r => r.GetByIdAsync(_withdrawal.Id)).ReturnsAsync(() => _withdrawal);
+            _repository.Setup(r => r.GetDueAsync(It.IsAny<DateTime>()))
+                .ReturnsAsync((DateTime now) => new[] { _withdrawal }.Where(w => w.Status == GasRecurringWithdrawalStatus.Active && w.NextRunAt <= now).ToList());
+            _repository.Setup(r => r.GetOccurrenceAsync(_withdrawal.Id, It.IsAny<DateTime>()))
+                .ReturnsAsync((Guid id, DateTime scheduledFor) => _occurrences.FirstOrDefault(o => o.ScheduledFor == scheduledFor));
+            _repository.Setup(r => r.SaveOccurrenceAsync(It.IsAny<GasRecurringWithdrawalOccurrence>()))
+                .ReturnsAsync((GasRecurringWithdrawalOccurrence o) =>
+                {
+                    _occurrences.RemoveAll(existing => existing.Id == o.Id);
+                    _occurrences.Add(o);
+                    return o;
+                });
+
+            _gasBankService.Setup(s => s.WithdrawAsync(_gasBankAccount.Id, It.IsAny<decimal>(), ToAddress))
+                .ReturnsAsync((Guid id, decimal amount, string toAddress) => new GasBankWithdrawal { Id = Guid.NewGuid(), Amount = amount });
+
+            _service = new GasRecurringWithdrawalService(
+                new Mock<ILogger<GasRecurringWithdrawalService>>().Object,
+                _repository.Object,
+                accountRepository.Object,
+                _gasBankService.Object);
+        }
+
+        [Fact]
+        public async Task RunCycleAsync_Sweep_WithdrawsUnallocatedBalanceAboveFloor()
+        {
+            // Act
+            await _service.RunCycleAsync();
+
+            // Assert
+            _gasBankService.Verify(s => s.WithdrawAsync(_gasBankAccount.Id, 30, ToAddress), Times.Once);
+            var occurrence = Assert.Single(_occurrences);
+            Assert.Equal(GasRecurringWithdrawalOccurrenceStatus.Completed, occurrence.Status);
+            Assert.Equal(30, occurrence.Amount);
+            Assert.NotNull(occurrence.WithdrawalId);
+            Assert.True(_withdrawal.NextRunAt > DateTime.UtcNow);
+            Assert.Equal(DayOfWeek.Monday, _withdrawal.NextRunAt.Value.DayOfWeek);
+        }
+
+        [Fact]
+        public async Task RunCycleAsync_SweepBelowMinimum_SkipsOccurrence()
+        {
+            // Arrange
+            _withdrawal.MinimumAmount = 50;
+
+            // Act
+            await _service.RunCycleAsync();
+
+            // Assert
+            _gasBankService.Verify(s => s.WithdrawAsync(It.IsAny<Guid>(), It.IsAny<decimal>(), It.IsAny<string>()), Times.Never);
+            Assert.Equal(GasRecurringWithdrawalOccurrenceStatus.Skipped, Assert.Single(_occurrences).Status);
+            Assert.True(_withdrawal.NextRunAt > DateTime.UtcNow);
+        }
+
+        [Fact]
+        public async Task RunCycleAsync_WithdrawalFails_RetriesSameOccurrenceOnNextCycle()
+        {
+            // Arrange
+            var scheduledFor = _withdrawal.NextRunAt;
+            _gasBankService.Setup(s => s.WithdrawAsync(It.IsAny<Guid>(), It.IsAny<decimal>(), It.IsAny<string>()))
+                .ThrowsAsync(new GasBankException("Insufficient hot wallet balance"));
+
+            // Act
+            await _service.RunCycleAsync();
+            await _service.RunCycleAsync();
+
+            // Assert
+            var occurrence = Assert.Single(_occurrences);
+            Assert.Equal(GasRecurringWithdrawalOccurrenceStatus.Failed, occurrence.Status);
+            Assert.Equal(2, occurrence.Attempts);
+            Assert.Equal(scheduledFor, _withdrawal.NextRunAt);
+            Assert.Equal("Insufficient hot wallet balance", _withdrawal.LastError);
+        }
+
+        [Fact]
+        public async Task RunCycleAsync_FailedAfterNextOccurrenceIsDue_RecordsMissedAndMovesOn()
+        {
+            // Arrange
+            _withdrawal.Schedule = "* * * * *";
+            _withdrawal.NextRunAt = new DateTime(2024, 1, 1, 0, 0, 0, DateTimeKind.Utc);
+            _withdrawal.Amount = 500;
+
+            // Act
+            await _service.RunCycleAsync();
+
+            // Assert
+            Assert.Equal(GasRecurringWithdrawalOccurrenceStatus.Missed, Assert.Single(_occurrences).Status);
+            Assert.Equal(new DateTime(2024, 1, 1, 0, 1, 0, DateTimeKind.Utc), _withdrawal.NextRunAt);
+        }
+
+        [Fact]
+        public async Task PauseAsync_OtherAccount_ThrowsForbiddenAccessException()
+        {
+            // Act & Assert
+            await Assert.ThrowsAsync<ForbiddenAccessException>(() => _service.PauseAsync(_withdrawal.Id, Guid.NewGuid()));
+        }
+    }
+}
@@ -0,0 +1,122 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Services.EventMonitoring;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class GovernanceEventSourceTests
+    {
+        private static readonly byte[] KeyA = Enumerable.Repeat((byte)0x0a, 33).ToArray();
+        private static readonly byte[] KeyB = Enumerable.Repeat((byte)0x0b, 33).ToArray();
+
+        private readonly Mock<INeoRpcClient> _rpcClientMock = new Mock<INeoRpcClient>();
+        private readonly Dictionary<(string ScriptHash, string Operation), NeoStackItem> _results = new Dictionary<(string ScriptHash, string Operation), NeoStackItem>();
+        private readonly GovernanceEventSource _source;
+
+        public GovernanceEventSourceTests()
+        {
+            _source = new GovernanceEventSource(new Mock<ILogger<GovernanceEventSource>>().Object, _rpcClientMock.Object);
+            _rpcClientMock
+                .Setup(x => x.InvokeFunctionBatchAsync(It.IsAny<IEnumerable<NeoInvocation>>()))
+                .ReturnsAsync((IEnumerable<NeoInvocation> invocations) => invocations
+                    .Select(i => new NeoInvokeResult { State = "HALT", Stack = new List<NeoStackItem> { _results[(i.ScriptHash, i.Operation)] } })
+                    .ToList());
+        }
+
+        [Fact]
+        public async Task PollAsync_ReportsChangesSinceBaseline()
+        {
+            // Arrange
+            SetupState(committee: new[] { KeyA }, votes: new Dictionary<byte[], long> { [KeyA] = 900, [KeyB] = 10 }, feePerByte: "1000");
+            var baseline = await _source.PollAsync(100);
+            SetupState(committee: new[] { KeyB }, votes: new Dictionary<byte[], long> { [KeyA] = 900, [KeyB] = 1500 }, feePerByte: "2000");
+
+            // Act
+            var events = await _source.PollAsync(101);
+
+            // Assert
+            Assert.Empty(baseline);
+
+            var committee = Assert.Single(events, e => e.EventName == GovernanceEventSource.CommitteeChangedEventName);
+            Assert.Equal(Hex(KeyB), committee.EventData["added"]);
+            Assert.Equal(Hex(KeyA), committee.EventData["removed"]);
+
+            var votes = Assert.Single(events, e => e.EventName == GovernanceEventSource.CandidateVotesChangedEventName);
+            Assert.Equal(Hex(KeyB), votes.EventData["candidate"]);
+            Assert.Equal("1500", votes.EventData["votes"]);
+            Assert.Equal("10", votes.EventData["previousVotes"]);
+
+            var policy = Assert.Single(events, e => e.EventName == GovernanceEventSource.PolicyChangedEventName);
+            Assert.Equal(Constants.NeoConfig.PolicyContractHash, policy.ContractHash);
+            Assert.Equal("feePerByte", policy.EventData["parameter"]);
+            Assert.Equal("2000", policy.EventData["value"]);
+            _rpcClientMock.Verify(x => x.InvokeFunctionBatchAsync(It.IsAny<IEnumerable<NeoInvocation>>()), Times.Exactly(2));
+            _rpcClientMock.Verify(x => x.InvokeFunctionAsync(It.IsAny<string>(), It.IsAny<string>(), It.IsAny<IEnumerable<NeoContractParameter>>()), Times.Never);
+        }
+
+        [Fact]
+        public void IsGovernanceSubscription_MatchesOnlyGovernanceEventsOfNativeContracts()
+        {
+            Assert.True(GovernanceEventSource.IsGovernanceSubscription(new EventSubscription
+            {
+                ContractHash = Constants.NeoConfig.NeoTokenHash,
+                EventName = GovernanceEventSource.CandidateVotesChangedEventName
+            }));
+            Assert.False(GovernanceEventSource.IsGovernanceSubscription(new EventSubscription
+            {
+                ContractHash = Constants.NeoConfig.NeoTokenHash,
+                EventName = "Transfer"
+            }));
+            Assert.False(GovernanceEventSource.IsGovernanceSubscription(new EventSubscription
+            {
+                ContractHash = Constants.NeoConfig.GasTokenHash,
+                EventName = GovernanceEventSource.PolicyChangedEventName
+            }));
+        }
+
+        private void SetupState(byte[][] committee, Dictionary<byte[], long> votes, string feePerByte)
+        {
+            Setup(Constants.NeoConfig.NeoTokenHash, "getCommittee", new NeoStackItem
+            {
+                Type = "Array",
+                Items = committee.Select(ByteString).ToList()
+            });
+            Setup(Constants.NeoConfig.NeoTokenHash, "getCandidates", new NeoStackItem
+            {
+                Type = "Array",
+                Items = votes.Select(v => new NeoStackItem
+                {
+                    Type = "Struct",
+                    Items = new List<NeoStackItem> { ByteString(v.Key), new NeoStackItem { Type = "Integer", Value = v.Value.ToString() } }
+                }).ToList()
+            });
+            Setup(Constants.NeoConfig.PolicyContractHash, "getFeePerByte", new NeoStackItem { Type = "Integer", Value = feePerByte });
+            Setup(Constants.NeoConfig.PolicyContractHash, "getExecFeeFactor", new NeoStackItem { Type = "Integer", Value = "30" });
+            Setup(Constants.NeoConfig.PolicyContractHash, "getStoragePrice", new NeoStackItem { Type = "Integer", Value = "100000" });
+        }
+
+        private void Setup(string contractHash, string operation, NeoStackItem result)
+        {
+            _results[(contractHash, operation)] = result;
+        }
+
+        private static NeoStackItem ByteString(byte[] value)
+        {
+            return new NeoStackItem { Type = "ByteString", Value = Convert.ToBase64String(value) };
+        }
+
+        private static string Hex(byte[] value)
+        {
+            return Convert.ToHexString(value).ToLowerInvariant();
+        }
+    }
+}
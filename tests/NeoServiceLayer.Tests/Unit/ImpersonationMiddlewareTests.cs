@@ -0,0 +1,114 @@
+using System;
+using System.Security.Claims;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Http;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.API.Middleware;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ImpersonationMiddlewareTests
+    {
+        private const string SupportUserId = "support-1";
+
+        private readonly Guid _accountId = Guid.NewGuid();
+        private readonly Mock<IImpersonationService> _impersonationService = new Mock<IImpersonationService>();
+        private ImpersonationAuditEntry _recorded;
+
+        public ImpersonationMiddlewareTests()
+        {
+            _impersonationService.Setup(s => s.GetActiveGrantAsync(_accountId, SupportUserId))
+                .ReturnsAsync(new ImpersonationGrant { Id = Guid.NewGuid(), AccountId = _accountId, SupportUserId = SupportUserId });
+            _impersonationService.Setup(s => s.RecordAsync(It.IsAny<ImpersonationAuditEntry>()))
+                .ReturnsAsync((ImpersonationAuditEntry e) => _recorded = e);
+        }
+
+        private async Task<ClaimsPrincipal> InvokeAsync(HttpContext context)
+        {
+            ClaimsPrincipal seen = null;
+            var middleware = new ImpersonationMiddleware(
+                c =>
+                {
+                    seen = c.User;
+                    return Task.CompletedTask;
+                },
+                new Mock<ILogger<ImpersonationMiddleware>>().Object,
+                Options.Create(new ImpersonationConfiguration()));
+
+            await middleware.InvokeAsync(context, _impersonationService.Object);
+            return seen;
+        }
+
+        private DefaultHttpContext CreateContext(string method, string path)
+        {
+            var context = new DefaultHttpContext();
+            context.Request.Method = method;
+            context.Request.Path = path;
+            context.Request.Headers[ImpersonationMiddleware.ImpersonateHeader] = _accountId.ToString();
+            context.User = new ClaimsPrincipal(new ClaimsIdentity(new[]
+            {
+                new Claim(ClaimTypes.NameIdentifier, SupportUserId),
+                new Claim(ClaimTypes.Role, "Admin")
+            }, "Bearer"));
+            return context;
+        }
+
+        [Fact]
+        public async Task InvokeAsync_ActiveGrant_RunsAsAccountAndRecords()
+        {
+            // Arrange
+            var context = CreateContext("GET", "/api/function");
+
+            // Act
+            var user = await InvokeAsync(context);
+
+            // Assert
+            Assert.Equal(_accountId.ToString(), user.FindFirst(ClaimTypes.NameIdentifier)?.Value);
+            Assert.Equal(SupportUserId, user.FindFirst(ImpersonationMiddleware.ImpersonatorClaimType)?.Value);
+            Assert.False(user.IsInRole("Admin"));
+            Assert.True(_recorded.Allowed);
+            Assert.Equal("/api/function", _recorded.Path);
+        }
+
+        [Theory]
+        [InlineData("POST", "/api/function")]
+        [InlineData("GET", "/api/functionmarketplace")]
+        public async Task InvokeAsync_WriteOrOtherPath_IsRefusedAndRecorded(string method, string path)
+        {
+            // Arrange
+            var context = CreateContext(method, path);
+
+            // Act
+            var user = await InvokeAsync(context);
+
+            // Assert
+            Assert.Null(user);
+            Assert.Equal(StatusCodes.Status403Forbidden, context.Response.StatusCode);
+            Assert.False(_recorded.Allowed);
+            Assert.NotNull(_recorded.DeniedReason);
+        }
+
+        [Fact]
+        public async Task InvokeAsync_NoActiveGrant_IsRefusedAndRecorded()
+        {
+            // Arrange
+            _impersonationService.Setup(s => s.GetActiveGrantAsync(_accountId, SupportUserId))
+                .ReturnsAsync((ImpersonationGrant)null);
+            var context = CreateContext("GET", "/api/function");
+
+            // Act
+            var user = await InvokeAsync(context);
+
+            // Assert
+            Assert.Null(user);
+            Assert.Equal(StatusCodes.Status403Forbidden, context.Response.StatusCode);
+            Assert.False(_recorded.Allowed);
+            Assert.Null(_recorded.GrantId);
+        }
+    }
+}
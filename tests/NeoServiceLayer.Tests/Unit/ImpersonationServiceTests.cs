@@ -0,0 +1,165 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Reflection;
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Authorization;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Api.Controllers;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Impersonation;
+using NeoServiceLayer.Services.Impersonation.Repositories;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ImpersonationServiceTests
+    {
+        private const string SupportUserId = "support-1";
+        private const string AdminUserId = "admin-1";
+
+        private readonly Guid _accountId = Guid.NewGuid();
+        private readonly List<ImpersonationGrant> _grants = new List<ImpersonationGrant>();
+        private readonly List<ImpersonationAuditEntry> _audit = new List<ImpersonationAuditEntry>();
+        private readonly ImpersonationService _service;
+
+        public ImpersonationServiceTests()
+        {
+            var grantRepository = new Mock<IImpersonationGrantRepository>();
+            grantRepository.Setup(r => r.CreateAsync(It.IsAny<ImpersonationGrant>()))
+                .ReturnsAsync((ImpersonationGrant g) =>
+                {
+                    _grants.Add(g);
+                    return g;
+                });
+            grantRepository.Setup(r => r.GetByIdAsync(It.IsAny<Guid>()))
+                .ReturnsAsync((Guid id) => _grants.FirstOrDefault(g => g.Id == id));
+            grantRepository.Setup(r => r.GetByAccountIdAsync(It.IsAny<Guid>()))
+                .ReturnsAsync((Guid accountId) => _grants.Where(g => g.AccountId == accountId).ToList());
+            grantRepository.Setup(r => r.UpdateAsync(It.IsAny<ImpersonationGrant>()))
+                .ReturnsAsync((ImpersonationGrant g) => g);
+
+            var auditRepository = new Mock<IImpersonationAuditRepository>();
+            auditRepository.Setup(r => r.CreateAsync(It.IsAny<ImpersonationAuditEntry>()))
+                .ReturnsAsync((ImpersonationAuditEntry e) =>
+                {
+                    _audit.Add(e);
+                    return e;
+                });
+            auditRepository.Setup(r => r.GetByAccountIdAsync(It.IsAny<Guid>()))
+                .ReturnsAsync((Guid accountId) => _audit.Where(e => e.AccountId == accountId).ToList());
+
+            _service = new ImpersonationService(
+                new Mock<ILogger<ImpersonationService>>().Object,
+                grantRepository.Object,
+                auditRepository.Object);
+        }
+
+        [Fact]
+        public async Task GetActiveGrantAsync_ExpiredGrant_ReturnsNull()
+        {
+            // Arrange
+            _grants.Add(new ImpersonationGrant
+            {
+                Id = Guid.NewGuid(),
+                AccountId = _accountId,
+                SupportUserId = SupportUserId,
+                Kind = ImpersonationGrantKind.Consent,
+                CreatedAt = DateTime.UtcNow.AddHours(-2),
+                ExpiresAt = DateTime.UtcNow.AddHours(-1)
+            });
+
+            // Act
+            var grant = await _service.GetActiveGrantAsync(_accountId, SupportUserId);
+
+            // Assert
+            Assert.Null(grant);
+        }
+
+        [Fact]
+        public async Task GetActiveGrantAsync_RevokedGrant_ReturnsNull()
+        {
+            // Arrange
+            var consent = await _service.GrantConsentAsync(_accountId, SupportUserId, "Debugging a failing function");
+            Assert.NotNull(await _service.GetActiveGrantAsync(_accountId, SupportUserId));
+
+            // Act
+            await _service.RevokeAsync(consent.Id, _accountId.ToString(), _accountId);
+            var grant = await _service.GetActiveGrantAsync(_accountId, SupportUserId);
+
+            // Assert
+            Assert.Null(grant);
+        }
+
+        [Theory]
+        [InlineData(null)]
+        [InlineData("")]
+        [InlineData(SupportUserId)]
+        public async Task ApproveBreakGlassAsync_WithoutAnotherApprover_Throws(string approvedBy)
+        {
+            // Act & Assert
+            await Assert.ThrowsAsync<ArgumentException>(() =>
+                _service.ApproveBreakGlassAsync(_accountId, SupportUserId, "Incident 42", approvedBy));
+            Assert.Empty(_grants);
+        }
+
+        [Fact]
+        public async Task ApproveBreakGlassAsync_ApprovedByAdmin_GrantsAccess()
+        {
+            // Act
+            var grant = await _service.ApproveBreakGlassAsync(_accountId, SupportUserId, "Incident 42", AdminUserId);
+
+            // Assert
+            Assert.Equal(ImpersonationGrantKind.BreakGlass, grant.Kind);
+            Assert.Equal(AdminUserId, grant.GrantedBy);
+            Assert.Equal(grant.Id, (await _service.GetActiveGrantAsync(_accountId, SupportUserId))?.Id);
+        }
+
+        [Fact]
+        public void BreakGlassApproval_RequiresAdminRole()
+        {
+            // Act
+            var authorize = typeof(AdminImpersonationController).GetCustomAttribute<AuthorizeAttribute>();
+
+            // Assert
+            Assert.NotNull(authorize);
+            Assert.Equal("Admin", authorize.Roles);
+        }
+
+        [Fact]
+        public async Task RecordAsync_AllowedAndDeniedRequests_AreBothWrittenToTheAuditLog()
+        {
+            // Arrange
+            var allowed = new ImpersonationAuditEntry
+            {
+                AccountId = _accountId,
+                SupportUserId = SupportUserId,
+                Method = "GET",
+                Path = "/api/function",
+                Allowed = true,
+                StatusCode = 200
+            };
+            var denied = new ImpersonationAuditEntry
+            {
+                AccountId = _accountId,
+                SupportUserId = SupportUserId,
+                Method = "POST",
+                Path = "/api/function",
+                DeniedReason = "Impersonation is read-only",
+                StatusCode = 403
+            };
+
+            // Act
+            await _service.RecordAsync(allowed);
+            await _service.RecordAsync(denied);
+            var audit = (await _service.GetAuditAsync(_accountId)).ToList();
+
+            // Assert
+            Assert.Equal(2, audit.Count);
+            Assert.Contains(audit, e => e.Allowed && e.Method == "GET");
+            Assert.Contains(audit, e => !e.Allowed && e.DeniedReason == "Impersonation is read-only");
+            Assert.All(audit, e => Assert.NotEqual(default(DateTime), e.Timestamp));
+        }
+    }
+}
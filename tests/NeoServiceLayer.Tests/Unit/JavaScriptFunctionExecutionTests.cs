@@ -3,9 +3,11 @@ using System.Collections.Generic;
 using System.Threading.Tasks;
 using Microsoft.Extensions.Logging;
 using Moq;
+using NeoServiceLayer.Core.Exceptions;
 using NeoServiceLayer.Enclave.Enclave.Execution;
 using Xunit;
 using CoreEvent = NeoServiceLayer.Core.Models.Event;
+using FunctionErrorCodes = NeoServiceLayer.Core.Models.FunctionErrorCodes;
 using EnclaveEvent = NeoServiceLayer.Enclave.Enclave.Models.Event;
 
 namespace NeoServiceLayer.Tests.Unit
@@ -100,7 +102,8 @@ namespace NeoServiceLayer.Tests.Unit
             var entryPoint = "add";
 
             // Act & Assert
-            await Assert.ThrowsAsync<Exception>(() => _nodeJsRuntime.CompileAsync(sourceCode, entryPoint));
+            var exception = await Assert.ThrowsAsync<SandboxException>(() => _nodeJsRuntime.CompileAsync(sourceCode, entryPoint));
+            Assert.Equal(FunctionErrorCodes.SyntaxError, exception.ErrorCode);
         }
 
         [Fact]
@@ -115,7 +118,8 @@ namespace NeoServiceLayer.Tests.Unit
             var entryPoint = "nonExistentFunction";
 
             // Act & Assert
-            await Assert.ThrowsAsync<Exception>(() => _nodeJsRuntime.CompileAsync(sourceCode, entryPoint));
+            var exception = await Assert.ThrowsAsync<SandboxException>(() => _nodeJsRuntime.CompileAsync(sourceCode, entryPoint));
+            Assert.Equal(FunctionErrorCodes.SyntaxError, exception.ErrorCode);
         }
 
         [Fact]
@@ -152,6 +156,235 @@ namespace NeoServiceLayer.Tests.Unit
             Assert.Equal(12.0, resultObj);
         }
 
+        [Fact]
+        public async Task ExecuteAsync_FunctionThrows_ReportsUserThrown()
+        {
+            // Arrange
+            var sourceCode = @"
+                function fail(params) {
+                    throw new Error('bad input');
+                }
+            ";
+            var compiledFunction = await _nodeJsRuntime.CompileAsync(sourceCode, "fail");
+            var context = new FunctionExecutionContext { MaxExecutionTime = 5000, MaxMemory = 128 };
+
+            // Act & Assert
+            var exception = await Assert.ThrowsAsync<SandboxException>(() =>
+                _nodeJsRuntime.ExecuteAsync(compiledFunction, "fail", new Dictionary<string, object>(), context));
+            Assert.Equal(FunctionErrorCodes.UserThrown, exception.ErrorCode);
+            Assert.Contains("bad input", exception.Message);
+        }
+
+        [Fact]
+        public async Task ExecuteAsync_EndlessLoop_ReportsTimeoutError()
+        {
+            // Arrange
+            var sourceCode = @"
+                function spin(params) {
+                    while (true) { }
+                }
+            ";
+            var compiledFunction = await _nodeJsRuntime.CompileAsync(sourceCode, "spin");
+            var context = new FunctionExecutionContext { MaxExecutionTime = 5000, MaxMemory = 128 };
+
+            // Act & Assert
+            var exception = await Assert.ThrowsAsync<SandboxException>(() =>
+                _nodeJsRuntime.ExecuteAsync(compiledFunction, "spin", new Dictionary<string, object>(), context));
+            Assert.Equal(FunctionErrorCodes.TimeoutError, exception.ErrorCode);
+            Assert.True(FunctionErrorCodes.IsTransient(exception.ErrorCode));
+        }
+
+        [Fact]
+        public async Task ExecuteAsync_TimeoutHandlerFinishesWithinGracePeriod_RunsHandlerAndReportsTimeoutError()
+        {
+            // Arrange
+            var sourceCode = @"
+                function spin(params) {
+                    neoService.onTimeout(function (info) {
+                        console.log('cleaned up within ' + info.graceMs + 'ms');
+                    });
+                    while (true) { }
+                }
+            ";
+            var compiledFunction = await _nodeJsRuntime.CompileAsync(sourceCode, "spin");
+            var logs = new List<string>();
+            var context = new FunctionExecutionContext { MaxExecutionTime = 5000, MaxMemory = 128, OnLog = logs.Add };
+
+            // Act
+            var exception = await Assert.ThrowsAsync<SandboxException>(() =>
+                _nodeJsRuntime.ExecuteAsync(compiledFunction, "spin", new Dictionary<string, object>(), context));
+
+            // Assert
+            Assert.Equal(FunctionErrorCodes.TimeoutError, exception.ErrorCode);
+            Assert.Contains(logs, l => l.Contains($"cleaned up within {context.TimeoutGracePeriod}ms"));
+        }
+
+        [Fact]
+        public async Task ExecuteAsync_TimeoutHandlerOverrunsGracePeriod_IsCutOff()
+        {
+            // Arrange
+            var sourceCode = @"
+                function spin(params) {
+                    neoService.onTimeout(function () {
+                        console.log('cleanup started');
+                        while (true) { }
+                        console.log('cleanup finished');
+                    });
+                    while (true) { }
+                }
+            ";
+            var compiledFunction = await _nodeJsRuntime.CompileAsync(sourceCode, "spin");
+            var logs = new List<string>();
+            var context = new FunctionExecutionContext { MaxExecutionTime = 5000, MaxMemory = 128, OnLog = logs.Add };
+
+            // Act
+            var executing = _nodeJsRuntime.ExecuteAsync(compiledFunction, "spin", new Dictionary<string, object>(), context);
+            var completed = await Task.WhenAny(executing, Task.Delay(TimeSpan.FromSeconds(30)));
+
+            // Assert
+            Assert.Same(executing, completed);
+            var exception = await Assert.ThrowsAsync<SandboxException>(() => executing);
+            Assert.Equal(FunctionErrorCodes.TimeoutError, exception.ErrorCode);
+            Assert.Contains(logs, l => l.Contains("cleanup started"));
+            Assert.DoesNotContain(logs, l => l.Contains("cleanup finished"));
+        }
+
+        [Fact]
+        public async Task ExecuteAsync_TimeoutHandlerThrows_ReportsOriginalTimeoutError()
+        {
+            // Arrange
+            var sourceCode = @"
+                function spin(params) {
+                    neoService.onTimeout(function () {
+                        throw new Error('cleanup failed');
+                    });
+                    while (true) { }
+                }
+            ";
+            var compiledFunction = await _nodeJsRuntime.CompileAsync(sourceCode, "spin");
+            var context = new FunctionExecutionContext { MaxExecutionTime = 5000, MaxMemory = 128 };
+
+            // Act
+            var exception = await Assert.ThrowsAsync<SandboxException>(() =>
+                _nodeJsRuntime.ExecuteAsync(compiledFunction, "spin", new Dictionary<string, object>(), context));
+
+            // Assert
+            Assert.Equal(FunctionErrorCodes.TimeoutError, exception.ErrorCode);
+            Assert.DoesNotContain("cleanup failed", exception.Message);
+        }
+
+        [Fact]
+        public async Task ExecuteAsync_EndlessLoopWithGasMeter_ReportsGasExhausted()
+        {
+            // Arrange
+            var sourceCode = @"
+                function spin(params) {
+                    while (true) { }
+                }
+            ";
+            var compiledFunction = await _nodeJsRuntime.CompileAsync(sourceCode, "spin");
+            var context = new FunctionExecutionContext { MaxExecutionTime = 5000, MaxMemory = 128, GasMeter = new GasMeter(500) };
+
+            // Act & Assert
+            var exception = await Assert.ThrowsAsync<SandboxException>(() =>
+                _nodeJsRuntime.ExecuteAsync(compiledFunction, "spin", new Dictionary<string, object>(), context));
+            Assert.Equal(FunctionErrorCodes.GasExhausted, exception.ErrorCode);
+            Assert.Equal(500, exception.GasUsed);
+            Assert.False(FunctionErrorCodes.IsTransient(exception.ErrorCode));
+        }
+
+        [Fact]
+        public async Task ExecuteAsync_AllocatesPastMaxMemory_ReportsMemoryLimitError()
+        {
+            // Arrange
+            var sourceCode = @"
+                function hoard(params) {
+                    var chunks = [];
+                    while (true) { chunks.push('x'.repeat(10000)); }
+                }
+            ";
+            var compiledFunction = await _nodeJsRuntime.CompileAsync(sourceCode, "hoard");
+            var context = new FunctionExecutionContext { MaxExecutionTime = 5000, MaxMemory = 1 };
+
+            // Act & Assert
+            var exception = await Assert.ThrowsAsync<SandboxException>(() =>
+                _nodeJsRuntime.ExecuteAsync(compiledFunction, "hoard", new Dictionary<string, object>(), context));
+            Assert.Equal(FunctionErrorCodes.MemoryLimitError, exception.ErrorCode);
+            Assert.True(context.MemoryMeter.Used > 1024 * 1024);
+        }
+
+        [Fact]
+        public async Task ExecuteAsync_WithSessionKey_KeepsStateUntilAnInvocationFails()
+        {
+            // Arrange
+            var runtime = new NodeJsRuntime(_loggerMock.Object, null, null, null, null,
+                sessionRegistry: new SandboxSessionRegistry(Mock.Of<ILogger<SandboxSessionRegistry>>()));
+            var sourceCode = @"
+                let count = 0;
+                function counter(params) {
+                    count += 1;
+                    if (params.fail) { throw new Error('failed mid-update'); }
+                    return count;
+                }
+            ";
+            var compiledFunction = await runtime.CompileAsync(sourceCode, "counter");
+            var functionId = Guid.NewGuid();
+            async Task<object> InvokeAsync(bool fail)
+            {
+                var context = new FunctionExecutionContext { FunctionId = functionId, MaxExecutionTime = 5000, MaxMemory = 128, SessionKey = "s1" };
+                return await runtime.ExecuteAsync(compiledFunction, "counter", new Dictionary<string, object> { { "fail", fail } }, context);
+            }
+
+            // Act
+            var first = await InvokeAsync(false);
+            var second = await InvokeAsync(false);
+            await Assert.ThrowsAsync<SandboxException>(() => InvokeAsync(true));
+            var afterFailure = await InvokeAsync(false);
+
+            // Assert
+            Assert.Equal(1.0, Property(first, "Result"));
+            Assert.Equal(false, Property(Property(first, "Session"), "Resumed"));
+            Assert.Equal(2.0, Property(second, "Result"));
+            Assert.Equal(true, Property(Property(second, "Session"), "Resumed"));
+            Assert.Equal(1.0, Property(afterFailure, "Result"));
+            Assert.Equal(false, Property(Property(afterFailure, "Session"), "Resumed"));
+        }
+
+        [Fact]
+        public async Task ExecuteAsync_WithEnginePool_CachesScriptAndKeepsNoStateBetweenInvocations()
+        {
+            // Arrange
+            var enginePool = new SandboxEnginePool(Mock.Of<ILogger<SandboxEnginePool>>());
+            var runtime = new NodeJsRuntime(_loggerMock.Object, null, null, null, null, enginePool: enginePool);
+            var sourceCode = @"
+                let count = 0;
+                function counter(params) {
+                    count += 1;
+                    var leaked = [].leaked === true;
+                    Array.prototype.leaked = true;
+                    return { count: count, leaked: leaked };
+                }
+            ";
+            var compiledFunction = await runtime.CompileAsync(sourceCode, "counter");
+            var functionId = Guid.NewGuid();
+            async Task<object> InvokeAsync()
+            {
+                var context = new FunctionExecutionContext { FunctionId = functionId, MaxExecutionTime = 5000, MaxMemory = 128 };
+                return await runtime.ExecuteAsync(compiledFunction, "counter", new Dictionary<string, object>(), context);
+            }
+
+            // Act
+            var first = await InvokeAsync();
+            var second = await InvokeAsync();
+
+            // Assert
+            var secondResult = (Dictionary<string, object>)Property(second, "Result");
+            Assert.Equal(1.0, ((Dictionary<string, object>)Property(first, "Result"))["count"]);
+            Assert.Equal(1.0, secondResult["count"]);
+            Assert.Equal(false, secondResult["leaked"]);
+            Assert.Equal(2, enginePool.CachedScriptCount);
+        }
+
         [Fact]
         public async Task ExecuteAsync_WithConsoleLog_CapturesLogs()
         {
@@ -193,6 +426,74 @@ namespace NeoServiceLayer.Tests.Unit
             Assert.Contains("Processing request with id: test-123", logs[0]);
         }
 
+        [Fact]
+        public async Task ExecuteAsync_WithAmountHelper_ComputesExactTokenAmounts()
+        {
+            // Arrange
+            var sourceCode = @"
+                function payout(params) {
+                    var balance = neoService.amount.fromInteger(params.balance, 8);
+                    var fee = balance.mul('0.003');
+                    return balance.sub(fee).toInteger() + ' ' + neoService.amount.parse('0.1', 8).add('0.2').toString();
+                }
+            ";
+            var entryPoint = "payout";
+            var parameters = new Dictionary<string, object>
+            {
+                { "balance", "150000000" }
+            };
+
+            var compiledFunction = await _nodeJsRuntime.CompileAsync(sourceCode, entryPoint);
+            var context = new FunctionExecutionContext
+            {
+                FunctionId = Guid.NewGuid(),
+                AccountId = Guid.NewGuid(),
+                MaxExecutionTime = 5000,
+                MaxMemory = 128
+            };
+
+            // Act
+            var result = await _nodeJsRuntime.ExecuteAsync(compiledFunction, entryPoint, parameters, context);
+
+            // Assert
+            var resultObj = result.GetType().GetProperty("Result")?.GetValue(result);
+            Assert.Equal("149550000 0.3", resultObj);
+        }
+
+        [Fact]
+        public async Task ExecuteAsync_WithJsonHelper_DiffsAndPatchesSnapshots()
+        {
+            // Arrange
+            var sourceCode = @"
+                function compare(params) {
+                    var before = { height: 100, owners: ['a', 'b'], meta: { name: 'x' } };
+                    var after = { height: 101, owners: ['a'], meta: { name: 'x', 'a/b': true } };
+                    var patch = neoService.json.diff(before, after);
+                    var patched = neoService.json.patch(before, patch);
+                    return JSON.stringify(patch) + ' ' + (JSON.stringify(patched) === JSON.stringify(after)) + ' ' + before.height;
+                }
+            ";
+            var entryPoint = "compare";
+
+            var compiledFunction = await _nodeJsRuntime.CompileAsync(sourceCode, entryPoint);
+            var context = new FunctionExecutionContext
+            {
+                FunctionId = Guid.NewGuid(),
+                AccountId = Guid.NewGuid(),
+                MaxExecutionTime = 5000,
+                MaxMemory = 128
+            };
+
+            // Act
+            var result = await _nodeJsRuntime.ExecuteAsync(compiledFunction, entryPoint, new Dictionary<string, object>(), context);
+
+            // Assert
+            var resultObj = result.GetType().GetProperty("Result")?.GetValue(result);
+            Assert.Equal(
+                "[{\"op\":\"replace\",\"path\":\"/height\",\"value\":101},{\"op\":\"remove\",\"path\":\"/owners/1\"},{\"op\":\"add\",\"path\":\"/meta/a~1b\",\"value\":true}] true 100",
+                resultObj);
+        }
+
         [Fact]
         public async Task ExecuteAsync_WithEnvironmentVariables_CanAccessEnvVars()
         {
@@ -363,7 +664,10 @@ namespace NeoServiceLayer.Tests.Unit
             Assert.Equal(12345.0, data["blockHeight"]);
             Assert.Equal(6.0, data["confirmations"]);
         }
-    }
 
-    // This class is no longer needed as we're using the actual CompiledJsFunction class from NodeJsRuntime
+        private static object Property(object value, string name)
+        {
+            return value.GetType().GetProperty(name)?.GetValue(value);
+        }
+    }
 }
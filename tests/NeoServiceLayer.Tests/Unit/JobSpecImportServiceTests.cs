@@ -0,0 +1,110 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Enums;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.JobSpecs;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class JobSpecImportServiceTests
+    {
+        private const string CronSpec = @"
+type = ""cron""
+schemaVersion = 1
+name = ""eth-usd""
+externalJobID = ""0eec7e1d-d0d2-476c-a1a8-72dfb6633f46""
+schedule = ""CRON_TZ=UTC 0 */5 * * * *""
+observationSource = """"""
+    fetch [type=""http"" method=GET url=""https://example.com/price""];
+    parse [type=""jsonparse"" path=""data,price""];
+    multiply [type=""multiply"" times=100];
+    fetch -> parse -> multiply;
+""""""
+";
+
+        private readonly Mock<IFunctionService> _functionService = new Mock<IFunctionService>();
+        private readonly Mock<IScheduleTriggerService> _scheduleTriggerService = new Mock<IScheduleTriggerService>();
+        private readonly Mock<IWebhookTriggerService> _webhookTriggerService = new Mock<IWebhookTriggerService>();
+        private readonly Mock<IAutomationService> _automationService = new Mock<IAutomationService>();
+        private readonly JobSpecImportService _service;
+
+        public JobSpecImportServiceTests()
+        {
+            _service = new JobSpecImportService(
+                new Mock<ILogger<JobSpecImportService>>().Object,
+                _functionService.Object,
+                _scheduleTriggerService.Object,
+                _webhookTriggerService.Object,
+                _automationService.Object);
+        }
+
+        [Fact]
+        public async Task ImportAsync_CronJobDryRun_ConvertsScheduleAndPipelineWithoutCreating()
+        {
+            // Act
+            var result = await _service.ImportAsync(Guid.NewGuid(), CronSpec, new JobSpecImportOptions { DryRun = true });
+
+            // Assert
+            Assert.False(result.Created);
+            Assert.Equal("eth-usd", result.Name);
+            Assert.Equal("*/5 * * * *", result.ScheduleTrigger.CronExpression);
+            Assert.Empty(result.Warnings);
+            Assert.Contains(FunctionCapabilities.Network, result.Function.Permissions);
+            Assert.Contains("const task_parse = jsonPath(", result.Function.SourceCode);
+            Assert.Contains("[\"data\", \"price\"]", result.Function.SourceCode);
+            Assert.Contains("return task_multiply;", result.Function.SourceCode);
+            _functionService.VerifyNoOtherCalls();
+            _scheduleTriggerService.VerifyNoOtherCalls();
+        }
+
+        [Fact]
+        public async Task ImportAsync_TriggerCreationFails_DeletesFunction()
+        {
+            // Arrange
+            var function = new Function { Id = Guid.NewGuid() };
+            _functionService
+                .Setup(s => s.CreateFunctionAsync(It.IsAny<string>(), It.IsAny<string>(), FunctionRuntime.JavaScript, It.IsAny<string>(), "main",
+                    It.IsAny<Guid>(), It.IsAny<int>(), It.IsAny<int>(), It.IsAny<List<Guid>>(), It.IsAny<Dictionary<string, string>>(), It.IsAny<List<string>>()))
+                .ReturnsAsync(function);
+            _scheduleTriggerService
+                .Setup(s => s.CreateAsync(It.IsAny<ScheduleTrigger>()))
+                .ThrowsAsync(new ValidationException("Too many triggers"));
+
+            // Act & Assert
+            await Assert.ThrowsAsync<ValidationException>(() => _service.ImportAsync(Guid.NewGuid(), CronSpec, new JobSpecImportOptions()));
+            _functionService.Verify(s => s.DeleteAsync(function.Id), Times.Once);
+        }
+
+        [Fact]
+        public async Task ImportAsync_KeeperJobWithoutContractHash_ThrowsValidationException()
+        {
+            // Arrange
+            var spec = "type = \"keeper\"\nname = \"upkeep\"\ncontractAddress = \"0x7b3EC232b08BD7b4b3305BE0C044D907B2DF960B\"\n";
+
+            // Act & Assert
+            await Assert.ThrowsAsync<ValidationException>(() =>
+                _service.ImportAsync(Guid.NewGuid(), spec, new JobSpecImportOptions { GasBankAccountId = Guid.NewGuid() }));
+            _automationService.VerifyNoOtherCalls();
+        }
+
+        [Theory]
+        [InlineData("@every 15m", "*/15 * * * *")]
+        [InlineData("@every 6h", "0 */6 * * *")]
+        [InlineData("@daily", "0 0 * * *")]
+        [InlineData("0 30 9 * * 1-5", "30 9 * * 1-5")]
+        public void ConvertSchedule_ChainlinkSchedule_ReturnsFiveFieldExpression(string schedule, string expected)
+        {
+            // Act
+            var expression = JobSpecImportService.ConvertSchedule(schedule, new List<string>());
+
+            // Assert
+            Assert.Equal(expected, expression);
+        }
+    }
+}
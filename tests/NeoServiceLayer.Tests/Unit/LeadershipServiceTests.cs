@@ -0,0 +1,106 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Scheduling;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class LeadershipServiceTests
+    {
+        private readonly Mock<IServiceLeaseRepository> _repository = new Mock<IServiceLeaseRepository>();
+        private ServiceLease _stored;
+
+        public LeadershipServiceTests()
+        {
+            _repository.Setup(r => r.GetByIdAsync("functions")).ReturnsAsync(() => _stored);
+            _repository.Setup(r => r.CreateIfNotExistsAsync(It.IsAny<ServiceLease>()))
+                .ReturnsAsync((ServiceLease lease) => _stored ??= lease);
+            _repository.Setup(r => r.UpdateAsync(It.IsAny<ServiceLease>()))
+                .ReturnsAsync((ServiceLease lease) => _stored = lease);
+        }
+
+        private LeadershipService CreateInstance(string instanceId)
+        {
+            return new LeadershipService(
+                new Mock<ILogger<LeadershipService>>().Object,
+                _repository.Object,
+                Options.Create(new LeadershipConfiguration { Enabled = true, InstanceId = instanceId }));
+        }
+
+        [Fact]
+        public async Task RenewAsync_ExpiredLease_StandbyTakesOverWithHandoffState()
+        {
+            // Arrange
+            var primary = CreateInstance("primary");
+            var standby = CreateInstance("standby");
+            await primary.RenewAsync();
+            primary.SetState("eventMonitoring.lastProcessedBlock", "42");
+            await primary.RenewAsync();
+
+            // Act
+            await standby.RenewAsync();
+            var mirrored = standby.GetState("eventMonitoring.lastProcessedBlock");
+            var wasLeader = standby.IsLeader;
+            _stored.ExpiresAt = DateTime.UtcNow.AddSeconds(-1);
+            await standby.RenewAsync();
+
+            // Assert
+            Assert.False(wasLeader);
+            Assert.Equal("42", mirrored);
+            Assert.True(standby.IsLeader);
+            Assert.Equal("standby", _stored.HolderId);
+            Assert.Equal(2, _stored.Term);
+            Assert.Equal("42", _stored.State["eventMonitoring.lastProcessedBlock"]);
+        }
+
+        [Fact]
+        public async Task RenewAsync_TakesOverExpiredLease_RaisesLeadershipAcquiredOnce()
+        {
+            // Arrange
+            var primary = CreateInstance("primary");
+            var standby = CreateInstance("standby");
+            var acquired = 0;
+            standby.LeadershipAcquired += (_, _) => acquired++;
+            await primary.RenewAsync();
+            await standby.RenewAsync();
+            var acquiredAsStandby = acquired;
+
+            // Act
+            _stored.ExpiresAt = DateTime.UtcNow.AddSeconds(-1);
+            await standby.RenewAsync();
+            await standby.RenewAsync();
+
+            // Assert
+            Assert.Equal(0, acquiredAsStandby);
+            Assert.Equal(1, acquired);
+        }
+
+        [Fact]
+        public async Task ForceFailoverAsync_LeaderStepsDownAndCannotReclaim()
+        {
+            // Arrange
+            var primary = CreateInstance("primary");
+            var standby = CreateInstance("standby");
+            await primary.RenewAsync();
+
+            // Act
+            await standby.ForceFailoverAsync("admin");
+            await primary.RenewAsync();
+            var primaryAfterFailover = primary.IsLeader;
+            await standby.RenewAsync();
+            await primary.RenewAsync();
+
+            // Assert
+            Assert.False(primaryAfterFailover);
+            Assert.True(standby.IsLeader);
+            Assert.False(primary.IsLeader);
+            Assert.Equal("primary", _stored.ExcludedHolderId);
+            Assert.Equal("admin", _stored.FailoverRequestedBy);
+        }
+    }
+}
@@ -0,0 +1,118 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Logging;
+using NeoServiceLayer.Services.Logging.Repositories;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class LogAlertServiceTests
+    {
+        private readonly Dictionary<Guid, LogAlertRule> _rules = new Dictionary<Guid, LogAlertRule>();
+        private readonly Mock<INotificationService> _notificationService = new Mock<INotificationService>();
+        private readonly LogAlertLoggerProvider _provider;
+        private readonly LogAlertService _service;
+        private readonly Guid _admin = Guid.NewGuid();
+
+        public LogAlertServiceTests()
+        {
+            var configuration = Options.Create(new LogAlertConfiguration { EvaluationIntervalSeconds = 0 });
+            var queue = new LogAlertQueue(configuration);
+            _provider = new LogAlertLoggerProvider(queue);
+
+            var repository = new Mock<ILogAlertRuleRepository>();
+            repository.Setup(r => r.CreateAsync(It.IsAny<LogAlertRule>()))
+                .ReturnsAsync((LogAlertRule rule) => { rule.Id = Guid.NewGuid(); _rules[rule.Id] = rule; return rule; });
+            repository.Setup(r => r.GetAllAsync()).ReturnsAsync(() => _rules.Values.ToList());
+            repository.Setup(r => r.GetEnabledAsync()).ReturnsAsync(() => _rules.Values.Where(r => r.IsEnabled).ToList());
+            repository.Setup(r => r.UpdateAsync(It.IsAny<LogAlertRule>()))
+                .ReturnsAsync((LogAlertRule rule) => { _rules[rule.Id] = rule; return rule; });
+
+            _service = new LogAlertService(
+                Mock.Of<ILogger<LogAlertService>>(),
+                repository.Object,
+                queue,
+                _notificationService.Object,
+                configuration);
+        }
+
+        [Fact]
+        public async Task EvaluateAsync_SingleMatch_FiresOnceUntilResolved()
+        {
+            // Arrange
+            var rule = await _service.CreateAlertRuleAsync(new LogAlertRule
+            {
+                AccountId = _admin,
+                Name = "Balance lock rollback",
+                Pattern = "CRITICAL ERROR: Failed to rollback balance lock",
+                MinimumLevel = LogLevel.Error
+            });
+            var logger = _provider.CreateLogger("NeoServiceLayer.Services.GasBank.GasBankService");
+
+            // Act
+            logger.LogError("CRITICAL ERROR: Failed to rollback balance lock for account {AccountId}", Guid.NewGuid());
+            logger.LogInformation("CRITICAL ERROR: Failed to rollback balance lock (below minimum level)");
+            var first = await _service.EvaluateAsync();
+            logger.LogError("CRITICAL ERROR: Failed to rollback balance lock for account {AccountId}", Guid.NewGuid());
+            var second = await _service.EvaluateAsync();
+
+            // Assert
+            Assert.Equal(1, first);
+            Assert.Equal(0, second);
+            Assert.Equal(MetricAlertState.Firing, _rules[rule.Id].State);
+            Assert.Equal(2, _rules[rule.Id].MatchCount);
+            _notificationService.Verify(n => n.SendNotificationAsync(It.Is<Notification>(x =>
+                x.AccountId == _admin && x.Priority == NotificationPriority.High)), Times.Once);
+        }
+
+        [Fact]
+        public async Task EvaluateAsync_FieldMatchBelowThreshold_DoesNotFire()
+        {
+            // Arrange
+            var accountId = Guid.NewGuid();
+            await _service.CreateAlertRuleAsync(new LogAlertRule
+            {
+                AccountId = _admin,
+                Name = "Repeated withdrawal failures",
+                Category = "NeoServiceLayer.Services.GasBank",
+                MinimumLevel = LogLevel.Warning,
+                Fields = new Dictionary<string, string> { ["AccountId"] = accountId.ToString() },
+                Threshold = 3,
+                WindowSeconds = 60
+            });
+            var logger = _provider.CreateLogger("NeoServiceLayer.Services.GasBank.GasBankService");
+
+            // Act
+            logger.LogWarning("Withdrawal failed for account {AccountId}", accountId);
+            logger.LogWarning("Withdrawal failed for account {AccountId}", Guid.NewGuid());
+            _provider.CreateLogger("NeoServiceLayer.Services.Function.FunctionService").LogWarning("Withdrawal failed for account {AccountId}", accountId);
+            logger.LogWarning("Withdrawal failed for account {AccountId}", accountId);
+            var belowThreshold = await _service.EvaluateAsync();
+            logger.LogWarning("Withdrawal failed for account {AccountId}", accountId);
+            var atThreshold = await _service.EvaluateAsync();
+
+            // Assert
+            Assert.Equal(0, belowThreshold);
+            Assert.Equal(1, atThreshold);
+        }
+
+        [Fact]
+        public async Task CreateAlertRuleAsync_InvalidPattern_ThrowsArgumentException()
+        {
+            // Act & Assert
+            await Assert.ThrowsAsync<ArgumentException>(() => _service.CreateAlertRuleAsync(new LogAlertRule
+            {
+                AccountId = _admin,
+                Name = "Broken",
+                Pattern = "(unclosed"
+            }));
+        }
+    }
+}
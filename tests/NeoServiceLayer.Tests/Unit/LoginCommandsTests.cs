@@ -0,0 +1,123 @@
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Net;
+using System.Net.Http;
+using System.Text;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Cli;
+using NeoServiceLayer.Cli.Commands;
+using NeoServiceLayer.Cli.Profiles;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class LoginCommandsTests : IDisposable
+    {
+        private readonly string _directory = Path.Combine(Path.GetTempPath(), "nsl-tests-" + Guid.NewGuid().ToString("N"));
+        private readonly StubHandler _handler = new StubHandler();
+        private readonly StringWriter _output = new StringWriter();
+        private readonly ProfileStore _profiles;
+        private readonly FileCredentialStore _credentials;
+        private readonly ClientFactory _clientFactory;
+
+        public LoginCommandsTests()
+        {
+            _profiles = new ProfileStore(_directory);
+            _credentials = new FileCredentialStore(_directory);
+            _clientFactory = new ClientFactory(_profiles, _credentials, () => new HttpClient(_handler));
+        }
+
+        public void Dispose()
+        {
+            if (Directory.Exists(_directory))
+            {
+                Directory.Delete(_directory, true);
+            }
+        }
+
+        private static CommandLineArguments Parse(params string[] args)
+        {
+            return CommandLineArguments.Parse(args, "api-key-stdin");
+        }
+
+        [Fact]
+        public async Task LoginAsync_WithPassword_CachesSessionUnderProfile()
+        {
+            // Arrange
+            _handler.Enqueue("POST /api/auth/login", "{\"accessToken\":\"access-1\",\"refreshToken\":\"refresh-1\",\"expiresAt\":\"2099-01-01T00:00:00Z\"}");
+            var commands = new LoginCommands(_profiles, _credentials, _clientFactory, new StringReader("secret\n"), _output);
+
+            // Act
+            var exitCode = await commands.RunAsync(Parse("login", "--profile", "staging", "--url", "http://staging:5000", "--username", "alice"));
+
+            // Assert
+            Assert.Equal(ExitCodes.Success, exitCode);
+            Assert.Contains("\"password\":\"secret\"", _handler.LastBody);
+
+            var configuration = _profiles.Load();
+            Assert.Equal("staging", configuration.CurrentProfile);
+            Assert.Equal("http://staging:5000", configuration.Profiles["staging"].Url);
+            Assert.Equal("alice", configuration.Profiles["staging"].Username);
+
+            var credential = await _credentials.GetAsync("staging");
+            Assert.Equal("access-1", credential.AccessToken);
+            Assert.Equal("refresh-1", credential.RefreshToken);
+        }
+
+        [Fact]
+        public async Task CreateAsync_ExpiredSession_RefreshesAndStoresToken()
+        {
+            // Arrange
+            _profiles.Save(new ProfileConfiguration
+            {
+                CurrentProfile = "staging",
+                Profiles = { ["staging"] = new CliProfile { Url = "http://staging:5000", AuthMethod = CliProfile.PasswordAuth, Username = "alice" } }
+            });
+            await _credentials.SaveAsync("staging", new StoredCredential { AccessToken = "old", RefreshToken = "refresh-1", ExpiresAt = DateTime.UtcNow.AddMinutes(-5) });
+            _handler.Enqueue("POST /api/auth/refresh", "{\"accessToken\":\"access-2\",\"refreshToken\":\"refresh-2\",\"expiresAt\":\"2099-01-01T00:00:00Z\"}");
+
+            // Act
+            await _clientFactory.CreateAsync(Parse("price", "list", "--profile", "staging"));
+
+            // Assert
+            Assert.Equal(new List<string> { "POST /api/auth/refresh" }, _handler.Requests);
+            Assert.Contains("refresh-1", _handler.LastBody);
+
+            var credential = await _credentials.GetAsync("staging");
+            Assert.Equal("access-2", credential.AccessToken);
+            Assert.Equal("refresh-2", credential.RefreshToken);
+        }
+
+        private class StubHandler : HttpMessageHandler
+        {
+            private readonly Dictionary<string, string> _responses = new Dictionary<string, string>();
+
+            public List<string> Requests { get; } = new List<string>();
+
+            public string LastBody { get; private set; }
+
+            public void Enqueue(string request, string body)
+            {
+                _responses[request] = body;
+            }
+
+            protected override async Task<HttpResponseMessage> SendAsync(HttpRequestMessage request, CancellationToken cancellationToken)
+            {
+                var key = $"{request.Method.Method} {request.RequestUri.AbsolutePath}";
+                Requests.Add(key);
+
+                if (request.Content != null)
+                {
+                    LastBody = await request.Content.ReadAsStringAsync(cancellationToken);
+                }
+
+                return new HttpResponseMessage(HttpStatusCode.OK)
+                {
+                    Content = new StringContent(_responses[key], Encoding.UTF8, "application/json")
+                };
+            }
+        }
+    }
+}
@@ -0,0 +1,101 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Maintenance;
+using NeoServiceLayer.Services.Maintenance.Repositories;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class MaintenanceServiceTests : IDisposable
+    {
+        private readonly List<MaintenanceWindow> _windows = new List<MaintenanceWindow>();
+        private readonly MaintenanceService _service;
+
+        public MaintenanceServiceTests()
+        {
+            var repository = new Mock<IMaintenanceWindowRepository>();
+            repository.Setup(r => r.GetAllAsync()).ReturnsAsync(() => _windows.ToList());
+            repository.Setup(r => r.CreateAsync(It.IsAny<MaintenanceWindow>()))
+                .ReturnsAsync((MaintenanceWindow w) =>
+                {
+                    w.Id = Guid.NewGuid();
+                    _windows.Add(w);
+                    return w;
+                });
+            repository.Setup(r => r.UpdateAsync(It.IsAny<MaintenanceWindow>()))
+                .ReturnsAsync((MaintenanceWindow w) => w);
+
+            _service = new MaintenanceService(
+                new Mock<ILogger<MaintenanceService>>().Object,
+                repository.Object,
+                new Mock<IFunctionService>().Object,
+                Options.Create(new MaintenanceConfiguration { CheckIntervalSeconds = 3600, MaxQueuedInvocations = 1 }));
+        }
+
+        public void Dispose()
+        {
+            _service.Dispose();
+        }
+
+        [Fact]
+        public async Task EnableAsync_ThenDisableAsync_TogglesActiveStatus()
+        {
+            // Act
+            var enabled = await _service.EnableAsync("Database upgrade", "admin");
+            var disabled = await _service.DisableAsync("admin");
+
+            // Assert
+            Assert.True(enabled.IsActive);
+            Assert.Equal("Database upgrade", enabled.Reason);
+            Assert.Null(enabled.ExpectedEndTime);
+            Assert.Equal(300, enabled.RetryAfterSeconds);
+            Assert.False(disabled.IsActive);
+            Assert.False(await _service.IsActiveAsync());
+        }
+
+        [Fact]
+        public async Task ScheduleAsync_FutureWindow_IsUpcomingButNotActive()
+        {
+            // Act
+            await _service.ScheduleAsync(DateTime.UtcNow.AddHours(1), DateTime.UtcNow.AddHours(2), "Node upgrade", "admin");
+            var status = await _service.GetStatusAsync();
+
+            // Assert
+            Assert.False(status.IsActive);
+            Assert.Equal("Node upgrade", Assert.Single(status.UpcomingWindows).Reason);
+        }
+
+        [Fact]
+        public async Task ScheduleAsync_EndBeforeStart_Throws()
+        {
+            // Act & Assert
+            await Assert.ThrowsAsync<ArgumentException>(() =>
+                _service.ScheduleAsync(DateTime.UtcNow.AddHours(2), DateTime.UtcNow.AddHours(1), null, "admin"));
+        }
+
+        [Fact]
+        public async Task EnqueueInvocationAsync_QueueFull_ThrowsMaintenanceException()
+        {
+            // Arrange
+            await _service.EnableAsync(null, "admin", DateTime.UtcNow.AddMinutes(10));
+            var first = await _service.EnqueueInvocationAsync(Guid.NewGuid(), Guid.NewGuid(), null);
+
+            // Act
+            var exception = await Assert.ThrowsAsync<MaintenanceException>(() =>
+                _service.EnqueueInvocationAsync(Guid.NewGuid(), Guid.NewGuid(), null));
+
+            // Assert
+            Assert.Equal(1, first.Position);
+            Assert.NotNull(exception.ExpectedEndTime);
+            Assert.Equal(1, (await _service.GetStatusAsync()).QueuedInvocations);
+        }
+    }
+}
@@ -0,0 +1,104 @@
+using System;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Metrics;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class MetricAlertRuleEvaluatorTests
+    {
+        private static readonly DateTime Now = new DateTime(2024, 1, 1, 12, 0, 0, DateTimeKind.Utc);
+
+        [Fact]
+        public void Advance_ConditionHoldsWithoutDuration_FiresImmediately()
+        {
+            // Arrange
+            var rule = new MetricAlertRule { DurationSeconds = 0 };
+
+            // Act
+            var transition = MetricAlertRuleEvaluator.Advance(rule, true, Now);
+
+            // Assert
+            Assert.Equal(MetricAlertTransition.Fired, transition);
+            Assert.Equal(MetricAlertState.Firing, rule.State);
+            Assert.Equal(Now, rule.LastFiredAt);
+        }
+
+        [Fact]
+        public void Advance_ConditionHoldsForDuration_FiresOnlyAfterDuration()
+        {
+            // Arrange
+            var rule = new MetricAlertRule { DurationSeconds = 120 };
+
+            // Act
+            var first = MetricAlertRuleEvaluator.Advance(rule, true, Now);
+            var second = MetricAlertRuleEvaluator.Advance(rule, true, Now.AddSeconds(60));
+            var third = MetricAlertRuleEvaluator.Advance(rule, true, Now.AddSeconds(120));
+            var fourth = MetricAlertRuleEvaluator.Advance(rule, true, Now.AddSeconds(180));
+
+            // Assert
+            Assert.Equal(MetricAlertTransition.Pending, first);
+            Assert.Equal(MetricAlertTransition.None, second);
+            Assert.Equal(MetricAlertTransition.Fired, third);
+            Assert.Equal(MetricAlertTransition.None, fourth);
+            Assert.Equal(MetricAlertState.Firing, rule.State);
+        }
+
+        [Fact]
+        public void Advance_ConditionClearsWhilePending_ResetsWithoutNotification()
+        {
+            // Arrange
+            var rule = new MetricAlertRule { DurationSeconds = 300 };
+            MetricAlertRuleEvaluator.Advance(rule, true, Now);
+
+            // Act
+            var transition = MetricAlertRuleEvaluator.Advance(rule, false, Now.AddSeconds(60));
+
+            // Assert
+            Assert.Equal(MetricAlertTransition.None, transition);
+            Assert.Equal(MetricAlertState.Ok, rule.State);
+            Assert.Null(rule.PendingSince);
+        }
+
+        [Fact]
+        public void Advance_ConditionClearsWhileFiring_Resolves()
+        {
+            // Arrange
+            var rule = new MetricAlertRule();
+            MetricAlertRuleEvaluator.Advance(rule, true, Now);
+
+            // Act
+            var transition = MetricAlertRuleEvaluator.Advance(rule, false, Now.AddSeconds(60));
+
+            // Assert
+            Assert.Equal(MetricAlertTransition.Resolved, transition);
+            Assert.Equal(MetricAlertState.Ok, rule.State);
+        }
+
+        [Theory]
+        [InlineData(5, ">", 4, true)]
+        [InlineData(4, ">", 4, false)]
+        [InlineData(4, ">=", 4, true)]
+        [InlineData(3, "<", 4, true)]
+        [InlineData(4, "<=", 4, true)]
+        [InlineData(4, "==", 4, true)]
+        [InlineData(4, "!=", 4, false)]
+        public void Compare_SupportedOperators_ReturnsExpected(double value, string @operator, double threshold, bool expected)
+        {
+            // Act
+            var result = MetricAlertRuleEvaluator.Compare(value, @operator, threshold);
+
+            // Assert
+            Assert.Equal(expected, result);
+        }
+
+        [Fact]
+        public void Aggregate_NoValues_ReturnsNullExceptForCount()
+        {
+            // Act & Assert
+            Assert.Null(MetricAlertRuleEvaluator.Aggregate(Array.Empty<double>(), MetricAlertAggregation.Average));
+            Assert.Equal(0d, MetricAlertRuleEvaluator.Aggregate(Array.Empty<double>(), MetricAlertAggregation.Count));
+            Assert.Equal(6d, MetricAlertRuleEvaluator.Aggregate(new[] { 1d, 2d, 3d }, MetricAlertAggregation.Sum));
+        }
+    }
+}
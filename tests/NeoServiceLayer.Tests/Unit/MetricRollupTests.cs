@@ -0,0 +1,71 @@
+using System;
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Metrics.Storage;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class MetricRollupTests
+    {
+        private static readonly DateTime Now = new DateTime(2026, 3, 10, 12, 34, 56, DateTimeKind.Utc);
+
+        [Theory]
+        [InlineData(MetricResolution.Minute, "2026-03-10T12:34:00")]
+        [InlineData(MetricResolution.Hour, "2026-03-10T12:00:00")]
+        [InlineData(MetricResolution.Day, "2026-03-10T00:00:00")]
+        public void GetBucketStart_TruncatesToResolution(MetricResolution resolution, string expected)
+        {
+            // Act
+            var start = MetricRollup.GetBucketStart(Now, resolution);
+
+            // Assert
+            Assert.Equal(DateTime.SpecifyKind(DateTime.Parse(expected), DateTimeKind.Utc), start);
+        }
+
+        [Theory]
+        [InlineData(6, MetricResolution.Minute)]
+        [InlineData(72, MetricResolution.Hour)]
+        [InlineData(24 * 30, MetricResolution.Hour)]
+        [InlineData(24 * 365, MetricResolution.Day)]
+        public void SelectResolution_PicksFinestRetainedResolutionWithinPointLimit(int hours, MetricResolution expected)
+        {
+            // Arrange
+            var query = new MetricQuery { Name = "latency", StartTime = Now.AddHours(-hours), EndTime = Now };
+
+            // Act
+            var resolution = MetricRollup.SelectResolution(query, Now, new MetricsStorageConfiguration());
+
+            // Assert
+            Assert.Equal(expected, resolution);
+        }
+
+        [Fact]
+        public void Aggregate_Average_IsWeightedBySampleCount()
+        {
+            // Arrange
+            var buckets = new List<MetricBucket>
+            {
+                new MetricBucket { Count = 1, Sum = 100, Min = 100, Max = 100 },
+                new MetricBucket { Count = 3, Sum = 60, Min = 10, Max = 30 }
+            };
+
+            // Act & Assert
+            Assert.Equal(40, MetricRollup.Aggregate(buckets, MetricAlertAggregation.Average));
+            Assert.Equal(10, MetricRollup.Aggregate(buckets, MetricAlertAggregation.Min));
+            Assert.Equal(4, MetricRollup.Aggregate(buckets, MetricAlertAggregation.Count));
+            Assert.Null(MetricRollup.Aggregate(new List<MetricBucket>(), MetricAlertAggregation.Max));
+        }
+
+        [Fact]
+        public void GetTagsKey_IgnoresTagOrder()
+        {
+            // Act
+            var first = MetricRollup.GetTagsKey(new Dictionary<string, string> { ["b"] = "2", ["a"] = "1" });
+            var second = MetricRollup.GetTagsKey(new Dictionary<string, string> { ["a"] = "1", ["b"] = "2" });
+
+            // Assert
+            Assert.Equal(first, second);
+        }
+    }
+}
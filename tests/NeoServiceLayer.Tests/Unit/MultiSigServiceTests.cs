@@ -0,0 +1,114 @@
+using System;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using NeoServiceLayer.Services.Storage.Providers;
+using NeoServiceLayer.Services.Wallet;
+using NeoServiceLayer.Services.Wallet.Repositories;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class MultiSigServiceTests
+    {
+        private static readonly string[] Keys =
+        {
+            "03b209fd4f53a7170ea4444e0cb0a6bb6a53c2bd016926989cf85f9b0fba17a70c",
+            "02df48f60e8f3e01c48ff40b9b7f1310d7a8b2a193188befe1c2e3df740e895093",
+            "026ca1b1e3f9a6cd2bd2eb6b6f5cf2d1c5cf6d6b84c5d1ed8a63fd88a5e0b0a1b2"
+        };
+
+        private readonly Mock<IWalletService> _walletService = new Mock<IWalletService>();
+        private readonly Mock<IEnclaveService> _enclaveService = new Mock<IEnclaveService>();
+        private readonly MultiSigService _service;
+
+        public MultiSigServiceTests()
+        {
+            var storageProvider = new InMemoryStorageProvider(new Mock<ILogger<InMemoryStorageProvider>>().Object);
+            var repository = new MultiSigTransactionRepository(new Mock<ILogger<MultiSigTransactionRepository>>().Object, storageProvider);
+            _service = new MultiSigService(new Mock<ILogger<MultiSigService>>().Object, repository, _walletService.Object, _enclaveService.Object);
+
+            _walletService
+                .Setup(w => w.SignDataAsync(It.IsAny<Guid>(), It.IsAny<string>(), It.IsAny<byte[]>()))
+                .ReturnsAsync((Guid walletId, string password, byte[] data) => "0x" + walletId.ToString("N"));
+
+            // The response type is private to the service, so it is built from the requested type
+            _enclaveService
+                .Setup(e => e.SendRequestAsync<object, It.IsAnyType>(
+                    Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.SendMultiSig, It.IsAny<object>()))
+                .Returns(new InvocationFunc(invocation =>
+                {
+                    var responseType = invocation.Method.GetGenericArguments()[1];
+                    var response = Activator.CreateInstance(responseType);
+                    responseType.GetProperty("TransactionHash").SetValue(response, "0xsent");
+                    return typeof(Task).GetMethod(nameof(Task.FromResult)).MakeGenericMethod(responseType).Invoke(null, new[] { response });
+                }));
+        }
+
+        private Guid AddWallet(string publicKey)
+        {
+            var wallet = new Core.Models.Wallet { Id = Guid.NewGuid(), AccountId = Guid.NewGuid(), PublicKey = publicKey };
+            _walletService.Setup(w => w.GetByIdAsync(wallet.Id)).ReturnsAsync(wallet);
+            return wallet.Id;
+        }
+
+        [Fact]
+        public void CreateMultiSigVerificationScript_KeysInAnyOrder_DeriveSameAccount()
+        {
+            // Act
+            var script = NeoAccountUtility.CreateMultiSigVerificationScript(2, Keys);
+            var reversed = NeoAccountUtility.CreateMultiSigVerificationScript(2, Keys.Reverse());
+            var scriptHash = NeoAccountUtility.GetScriptHash(script);
+
+            // Assert
+            Assert.Equal(script, reversed);
+            Assert.StartsWith("120c21026ca1b1", Convert.ToHexString(script).ToLowerInvariant());
+            Assert.EndsWith("13419ed0dc3a", Convert.ToHexString(script).ToLowerInvariant());
+            Assert.Equal("0x33e89013f1733cb3563c49b9cee5af38135dad23", scriptHash);
+            Assert.Equal("NPAcWyvsEeSEze5uaNsMn4AofG8Ftf7int", NeoAccountUtility.ToAddress(scriptHash));
+        }
+
+        [Fact]
+        public async Task SignAsync_ThresholdReached_SendsOnceWithCollectedSignatures()
+        {
+            // Arrange
+            var first = AddWallet(Keys[0]);
+            var second = AddWallet(Keys[1]);
+            var third = AddWallet(Keys[2]);
+            var proposal = await _service.ProposeAsync(Guid.NewGuid(), 2, Keys, "0x1234567890abcdef1234567890abcdef12345678", "setOwner", null, 1m);
+
+            // Act
+            var afterFirst = await _service.SignAsync(proposal.Id, first, "password");
+            var firstStatus = afterFirst.Status;
+            var afterSecond = await _service.SignAsync(proposal.Id, second, "password");
+
+            // Assert
+            Assert.Equal("NPAcWyvsEeSEze5uaNsMn4AofG8Ftf7int", proposal.SenderAddress);
+            Assert.Equal(MultiSigTransactionStatus.Pending, firstStatus);
+            Assert.Equal(MultiSigTransactionStatus.Sent, afterSecond.Status);
+            Assert.Equal("0xsent", afterSecond.TransactionHash);
+            Assert.Equal(2, afterSecond.Signatures.Count);
+            _enclaveService.Verify(e => e.SendRequestAsync<object, It.IsAnyType>(
+                Constants.EnclaveServiceTypes.Wallet, Constants.WalletOperations.SendMultiSig, It.IsAny<object>()), Times.Once);
+            await Assert.ThrowsAsync<ValidationException>(() => _service.SignAsync(proposal.Id, third, "password"));
+        }
+
+        [Fact]
+        public async Task SignAsync_WalletNotASigner_ThrowsValidationException()
+        {
+            // Arrange
+            var outsider = AddWallet("02" + new string('a', 64));
+            var proposal = await _service.ProposeAsync(Guid.NewGuid(), 1, Keys, "0x1234567890abcdef1234567890abcdef12345678", "setOwner", null, 1m);
+
+            // Act & Assert
+            await Assert.ThrowsAsync<ValidationException>(() => _service.SignAsync(proposal.Id, outsider, "password"));
+            Assert.Equal(MultiSigTransactionStatus.Pending, (await _service.GetByIdAsync(proposal.Id)).Status);
+        }
+    }
+}
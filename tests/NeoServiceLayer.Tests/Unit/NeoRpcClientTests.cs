@@ -0,0 +1,338 @@
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Linq;
+using System.Net;
+using System.Net.Http;
+using System.Text;
+using System.Text.Json;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Extensions;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Services.Blockchain;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class NeoRpcClientTests
+    {
+        private const string Address = "NiHURyS83nX2mpxtA7xq84cGxVbHojj5Wc";
+        private const string ScriptHash = "0xef4073a0f2b305a38ec4050e4d3d28bc40ea63f5";
+        private const string RootHash = "0x7c3b6b2b7d6c7d1f4d4b1c6a0a7f2e3e1a2b3c4d5e6f708192a3b4c5d6e7f809";
+
+        private readonly StubHandler _handler;
+        private readonly NeoRpcClient _client;
+
+        public NeoRpcClientTests()
+        {
+            _handler = new StubHandler();
+            _client = new NeoRpcClient(
+                new Mock<ILogger<NeoRpcClient>>().Object,
+                Options.Create(new NeoRpcConfiguration { RpcUrl = "http://localhost:10332" }),
+                new HttpClient(_handler));
+        }
+
+        [Fact]
+        public void ToScriptHash_ValidAddress_ReturnsBigEndianScriptHash()
+        {
+            // Act
+            var scriptHash = Address.ToScriptHash();
+
+            // Assert
+            Assert.Equal(ScriptHash, scriptHash);
+        }
+
+        [Fact]
+        public async Task GetBalanceAtAsync_PinsStateRootAndInvokesHistoric()
+        {
+            // Arrange
+            _handler.Responses["getstateroot"] = "{\"version\":0,\"index\":1000,\"roothash\":\"" + RootHash + "\"}";
+            _handler.Responses["invokefunctionhistoric"] = "{\"script\":\"\",\"state\":\"HALT\",\"gasconsumed\":\"100\",\"exception\":null,\"stack\":[{\"type\":\"Integer\",\"value\":\"1500000000\"}]}";
+
+            // Act
+            var balance = await _client.GetBalanceAtAsync(1000, Address, Constants.NeoConfig.GasTokenHash);
+
+            // Assert
+            Assert.Equal(1500000000, (long)balance);
+            Assert.Equal(new[] { "getstateroot", "invokefunctionhistoric" }, _handler.Methods);
+
+            var historicParams = _handler.Params[1];
+            Assert.Equal(RootHash, historicParams[0].GetString());
+            Assert.Equal(Constants.NeoConfig.GasTokenHash, historicParams[1].GetString());
+            Assert.Equal("balanceOf", historicParams[2].GetString());
+            Assert.Equal(ScriptHash, historicParams[3][0].GetProperty("value").GetString());
+        }
+
+        [Fact]
+        public async Task GetBalanceAsync_ServesCachedBalanceUntilTransferInvalidatesIt()
+        {
+            // Arrange
+            _handler.Responses["invokefunction"] = "{\"state\":\"HALT\",\"stack\":[{\"type\":\"Integer\",\"value\":\"100\"}]}";
+
+            // Act
+            var first = await _client.GetBalanceAsync(Address, Constants.NeoConfig.GasTokenHash);
+            _handler.Responses["invokefunction"] = "{\"state\":\"HALT\",\"stack\":[{\"type\":\"Integer\",\"value\":\"250\"}]}";
+            var cached = await _client.GetBalanceAsync(Address, Constants.NeoConfig.GasTokenHash);
+            _client.InvalidateBalance(ScriptHash, Constants.NeoConfig.GasTokenHash);
+            var refreshed = await _client.GetBalanceAsync(Address, Constants.NeoConfig.GasTokenHash);
+
+            // Assert
+            Assert.Equal(100, (long)first);
+            Assert.Equal(100, (long)cached);
+            Assert.Equal(250, (long)refreshed);
+            Assert.Equal(new[] { "invokefunction", "invokefunction" }, _handler.Methods);
+        }
+
+        [Fact]
+        public async Task InvokeFunctionAtAsync_RpcError_ThrowsNeoRpcException()
+        {
+            // Arrange
+            _handler.Errors["getstateroot"] = "Unknown state root";
+
+            // Act & Assert
+            await Assert.ThrowsAsync<NeoRpcException>(() =>
+                _client.InvokeFunctionAtAsync(5, ScriptHash, "symbol"));
+        }
+
+        [Fact]
+        public async Task GetNep11OwnerAsync_ReturnsBigEndianScriptHash()
+        {
+            // Arrange
+            _handler.Responses["invokefunction"] = "{\"state\":\"HALT\",\"stack\":[{\"type\":\"ByteString\",\"value\":\"9WPqQLwoPU0OBcSOowWz8qBzQO8=\"}]}";
+
+            // Act
+            var owner = await _client.GetNep11OwnerAsync(Constants.NeoConfig.GasTokenHash, "0a0b");
+
+            // Assert
+            Assert.Equal(ScriptHash, owner);
+            Assert.Equal("ownerOf", _handler.Params[0][1].GetString());
+            Assert.Equal("ByteArray", _handler.Params[0][2][0].GetProperty("type").GetString());
+            Assert.Equal("Cgs=", _handler.Params[0][2][0].GetProperty("value").GetString());
+        }
+
+        [Fact]
+        public async Task GetNep11TokensOfAsync_TraversesIteratorAndTerminatesSession()
+        {
+            // Arrange
+            _handler.Responses["invokefunction"] = "{\"state\":\"HALT\",\"session\":\"s-1\",\"stack\":[{\"type\":\"InteropInterface\",\"interface\":\"IIterator\",\"id\":\"it-1\"}]}";
+            _handler.Responses["traverseiterator"] = "[{\"type\":\"ByteString\",\"value\":\"AQ==\"},{\"type\":\"ByteString\",\"value\":\"Cgs=\"}]";
+            _handler.Responses["terminatesession"] = "true";
+
+            // Act
+            var tokens = await _client.GetNep11TokensOfAsync(Constants.NeoConfig.GasTokenHash, Address, 10);
+
+            // Assert
+            Assert.Equal(new[] { "01", "0a0b" }, tokens);
+            Assert.Equal(new[] { "invokefunction", "traverseiterator", "terminatesession" }, _handler.Methods);
+            Assert.Equal("s-1", _handler.Params[1][0].GetString());
+            Assert.Equal("it-1", _handler.Params[1][1].GetString());
+            Assert.Equal(10, _handler.Params[1][2].GetInt32());
+        }
+
+        [Fact]
+        public async Task GetNep11PropertiesAsync_DecodesMapEntries()
+        {
+            // Arrange
+            _handler.Responses["invokefunction"] = "{\"state\":\"HALT\",\"stack\":[{\"type\":\"Map\",\"value\":[{\"key\":{\"type\":\"ByteString\",\"value\":\"bmFtZQ==\"},\"value\":{\"type\":\"ByteString\",\"value\":\"UHVuayAjMQ==\"}}]}]}";
+
+            // Act
+            var properties = await _client.GetNep11PropertiesAsync(Constants.NeoConfig.GasTokenHash, "01");
+
+            // Assert
+            Assert.Equal("Punk #1", properties["name"]);
+        }
+
+        [Fact]
+        public async Task InvokeFunctionBatchAsync_SendsOneRequestAndMatchesResponsesById()
+        {
+            // Arrange
+            _handler.Responses["invokefunction:" + Constants.NeoConfig.NeoTokenHash] = "{\"state\":\"HALT\",\"stack\":[{\"type\":\"Integer\",\"value\":\"1\"}]}";
+            _handler.Responses["invokefunction:" + Constants.NeoConfig.GasTokenHash] = "{\"state\":\"HALT\",\"stack\":[{\"type\":\"Integer\",\"value\":\"2\"}]}";
+
+            // Act
+            var results = await _client.InvokeFunctionBatchAsync(new[]
+            {
+                new NeoInvocation { ScriptHash = Constants.NeoConfig.NeoTokenHash, Operation = "totalSupply" },
+                new NeoInvocation { ScriptHash = Constants.NeoConfig.GasTokenHash, Operation = "totalSupply" }
+            });
+
+            // Assert
+            Assert.Equal(1, _handler.Requests);
+            Assert.Equal("1", results[0].Stack[0].Value);
+            Assert.Equal("2", results[1].Stack[0].Value);
+        }
+
+        [Fact]
+        public async Task GetApplicationLogsAsync_SplitsBatchesAndReturnsNullForUnknownTransactions()
+        {
+            // Arrange
+            var client = new NeoRpcClient(
+                new Mock<ILogger<NeoRpcClient>>().Object,
+                Options.Create(new NeoRpcConfiguration { RpcUrl = "http://localhost:10332", MaxBatchSize = 2 }),
+                new HttpClient(_handler));
+            _handler.Responses["getapplicationlog"] = "{\"txid\":\"0x01\",\"executions\":[{\"trigger\":\"Application\",\"vmstate\":\"HALT\",\"gasconsumed\":\"100\",\"stack\":[],\"notifications\":[{\"contract\":\"" + Constants.NeoConfig.GasTokenHash + "\",\"eventname\":\"Transfer\",\"state\":{\"type\":\"Array\",\"value\":[]}}]}]}";
+            _handler.Errors["getapplicationlog:0x02"] = "Unknown transaction";
+
+            // Act
+            var logs = await client.GetApplicationLogsAsync(new[] { "0x01", "0x02", "0x03" });
+
+            // Assert
+            Assert.Equal(2, _handler.Requests);
+            Assert.True(logs[0].IsHalt);
+            Assert.Equal("Transfer", logs[0].Executions[0].Notifications[0].EventName);
+            Assert.Null(logs[1]);
+            Assert.NotNull(logs[2]);
+        }
+
+        [Fact]
+        public async Task GetApplicationLogsAsync_WithResponseCache_FetchesEachLogOnceAndSkipsErrors()
+        {
+            // Arrange
+            var directory = Path.Combine(Path.GetTempPath(), Guid.NewGuid().ToString("N"));
+            var configuration = Options.Create(new NeoRpcConfiguration
+            {
+                RpcUrl = "http://localhost:10332",
+                ResponseCacheEnabled = true,
+                ResponseCacheDirectory = directory
+            });
+            NeoRpcClient CreateClient() => new NeoRpcClient(
+                new Mock<ILogger<NeoRpcClient>>().Object,
+                configuration,
+                new HttpClient(_handler),
+                new NeoRpcResponseCache(new Mock<ILogger<NeoRpcResponseCache>>().Object, configuration));
+            _handler.Responses["getapplicationlog"] = "{\"txid\":\"0x01\",\"executions\":[{\"trigger\":\"Application\",\"vmstate\":\"HALT\",\"stack\":[],\"notifications\":[]}]}";
+            _handler.Errors["getapplicationlog:0x02"] = "Unknown transaction";
+
+            try
+            {
+                // Act
+                await CreateClient().GetApplicationLogsAsync(new[] { "0x01", "0x02" });
+                _handler.Methods.Clear();
+                _handler.Params.Clear();
+
+                // A new client reads the cache back from disk
+                var logs = await CreateClient().GetApplicationLogsAsync(new[] { "0x01", "0x02" });
+
+                // Assert
+                Assert.True(logs[0].IsHalt);
+                Assert.Null(logs[1]);
+                Assert.Single(_handler.Params);
+                Assert.Equal("0x02", _handler.Params[0][0].GetString());
+            }
+            finally
+            {
+                Directory.Delete(directory, true);
+            }
+        }
+
+        [Fact]
+        public async Task GetBlockCountAsync_NodeUnreachable_FailsOverAndTakesNodeOutOfRotation()
+        {
+            // Arrange
+            var client = new NeoRpcClient(
+                new Mock<ILogger<NeoRpcClient>>().Object,
+                Options.Create(new NeoRpcConfiguration
+                {
+                    RpcUrl = "http://node1:10332",
+                    Nodes = new List<NeoRpcNode>
+                    {
+                        new NeoRpcNode { Url = "http://node1:10332" },
+                        new NeoRpcNode { Url = "http://node2:10332" }
+                    },
+                    HealthCheckIntervalSeconds = 0,
+                    UnhealthyAfterFailures = 1
+                }),
+                new HttpClient(_handler));
+            _handler.Responses["getblockcount"] = "100";
+            _handler.DownHosts.Add("node1");
+
+            // Act
+            var first = await client.GetBlockCountAsync();
+            var second = await client.GetBlockCountAsync();
+            var statuses = client.GetNodeStatuses();
+
+            // Assert
+            Assert.Equal(100, first);
+            Assert.Equal(100, second);
+            Assert.Equal(new[] { "node1", "node2", "node2" }, _handler.Hosts);
+            Assert.False(statuses[0].IsHealthy);
+            Assert.Equal(1, statuses[0].Errors);
+            Assert.True(statuses[1].IsHealthy);
+            Assert.Equal(2, statuses[1].Requests);
+        }
+
+        private class StubHandler : HttpMessageHandler
+        {
+            public Dictionary<string, string> Responses { get; } = new Dictionary<string, string>();
+
+            public Dictionary<string, string> Errors { get; } = new Dictionary<string, string>();
+
+            public List<string> Methods { get; } = new List<string>();
+
+            public HashSet<string> DownHosts { get; } = new HashSet<string>();
+
+            public List<string> Hosts { get; } = new List<string>();
+
+            public List<JsonElement> Params { get; } = new List<JsonElement>();
+
+            public int Requests { get; private set; }
+
+            protected override async Task<HttpResponseMessage> SendAsync(HttpRequestMessage request, CancellationToken cancellationToken)
+            {
+                Hosts.Add(request.RequestUri.Host);
+                if (DownHosts.Contains(request.RequestUri.Host))
+                {
+                    throw new HttpRequestException("Connection refused");
+                }
+
+                var body = await request.Content.ReadAsStringAsync();
+                using var document = JsonDocument.Parse(body);
+                Requests++;
+
+                string payload;
+                if (document.RootElement.ValueKind == JsonValueKind.Array)
+                {
+                    // Answer batches in reverse order; clients must match responses by ID
+                    var responses = document.RootElement.EnumerateArray().Select(Respond).Reverse();
+                    payload = "[" + string.Join(",", responses) + "]";
+                }
+                else
+                {
+                    payload = Respond(document.RootElement);
+                }
+
+                return new HttpResponseMessage(HttpStatusCode.OK)
+                {
+                    Content = new StringContent(payload, Encoding.UTF8, "application/json")
+                };
+            }
+
+            private string Respond(JsonElement request)
+            {
+                var id = request.GetProperty("id").GetInt32();
+                var method = request.GetProperty("method").GetString();
+                var parameters = request.GetProperty("params").Clone();
+
+                Methods.Add(method);
+                Params.Add(parameters);
+
+                var key = parameters.GetArrayLength() > 0 && parameters[0].ValueKind == JsonValueKind.String
+                    ? method + ":" + parameters[0].GetString()
+                    : method;
+                var error = Errors.TryGetValue(key, out var keyedError) ? keyedError : Errors.GetValueOrDefault(method);
+                var result = Responses.TryGetValue(key, out var keyedResponse) ? keyedResponse : Responses.GetValueOrDefault(method);
+
+                return error != null
+                    ? "{\"jsonrpc\":\"2.0\",\"id\":" + id + ",\"error\":{\"code\":-100,\"message\":\"" + error + "\"}}"
+                    : "{\"jsonrpc\":\"2.0\",\"id\":" + id + ",\"result\":" + result + "}";
+            }
+        }
+    }
+}
@@ -0,0 +1,73 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Text.Json;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Core.Utilities;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class NeoScriptBuilderTests
+    {
+        [Fact]
+        public void CreateInvocationScript_NoArguments_MatchesNodeScript()
+        {
+            // Act
+            var script = NeoScriptBuilder.CreateInvocationScript(Constants.NeoConfig.GasTokenHash, "symbol", null);
+
+            // Assert: the script a Neo node builds for invokefunction GAS symbol
+            Assert.Equal("wh8MBnN5bWJvbAwUz3bii9AGLEpHjuNVYQETGfPPpNJBYn1bUg==", Convert.ToBase64String(script));
+        }
+
+        [Theory]
+        [InlineData("Integer", "1000", "01e803")]
+        [InlineData("Integer", "128", "018000")]
+        [InlineData("Integer", "-1000", "0118fc")]
+        [InlineData("Integer", "-1", "0f")]
+        [InlineData("Integer", "16", "20")]
+        [InlineData("Boolean", "true", "08")]
+        [InlineData("String", "hi", "0c026869")]
+        [InlineData("Hash160", "0xd2a4cff31913016155e38e474a2c06d08be276cf", "0c14cf76e28bd0062c4a478ee35561011319f3cfa4d2")]
+        [InlineData("ByteArray", "AQI=", "0c020102")]
+        public void EmitPush_Scalar_EncodesByType(string type, string value, string expectedHex)
+        {
+            // Act
+            var script = new NeoScriptBuilder().EmitPush(new NeoContractParameter { Type = type, Value = value }).ToArray();
+
+            // Assert
+            Assert.Equal(expectedHex, Convert.ToHexString(script).ToLowerInvariant());
+        }
+
+        [Fact]
+        public void EmitPush_ArrayAndMapFromJson_PacksItemsInOrder()
+        {
+            // Arrange: one typed parameter and one plain value, as functions pass them
+            var json = JsonDocument.Parse(@"[
+                { ""type"": ""Array"", ""value"": [ { ""type"": ""Integer"", ""value"": ""1000"" }, { ""type"": ""Boolean"", ""value"": true } ] },
+                { ""a"": ""b"" }
+            ]").RootElement;
+            var parameters = json.EnumerateArray().Select(NeoContractParameter.FromJson).ToList();
+
+            // Act
+            var array = new NeoScriptBuilder().EmitPush(parameters[0]).ToArray();
+            var map = new NeoScriptBuilder().EmitPush(parameters[1]).ToArray();
+
+            // Assert: items are pushed last to first, then counted and packed
+            Assert.Equal("0801e80312c0", Convert.ToHexString(array).ToLowerInvariant());
+            Assert.Equal("0c01620c016111be", Convert.ToHexString(map).ToLowerInvariant());
+        }
+
+        [Fact]
+        public void CreateInvocationScript_InvalidHash160Argument_ThrowsFormatException()
+        {
+            // Arrange
+            var args = new List<NeoContractParameter> { NeoContractParameter.Hash160("0x1234") };
+
+            // Act & Assert
+            Assert.Throws<FormatException>(() =>
+                NeoScriptBuilder.CreateInvocationScript(Constants.NeoConfig.GasTokenHash, "balanceOf", args));
+        }
+    }
+}
@@ -0,0 +1,162 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Net;
+using System.Net.Http;
+using System.Text;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Client;
+using NeoServiceLayer.Core.Models;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class NeoServiceLayerClientTests
+    {
+        private static readonly Guid FunctionId = Guid.Parse("0b1f8b8e-7a55-4f4c-9a53-0d0a2d3b4c5e");
+
+        private readonly StubHandler _handler;
+
+        public NeoServiceLayerClientTests()
+        {
+            _handler = new StubHandler();
+        }
+
+        private NeoServiceLayerClient CreateClient(NeoServiceLayerClientOptions options = null)
+        {
+            options ??= new NeoServiceLayerClientOptions { AccessToken = "static-token" };
+            options.BaseUrl = "http://localhost:5000";
+            options.RetryDelay = TimeSpan.Zero;
+            return new NeoServiceLayerClient(options, new HttpClient(_handler));
+        }
+
+        [Fact]
+        public async Task GetAsync_TransientFailure_RetriesAndSendsBearerToken()
+        {
+            // Arrange
+            var client = CreateClient();
+            _handler.Enqueue("GET", $"/api/function/{FunctionId}", HttpStatusCode.ServiceUnavailable, "");
+            _handler.Enqueue("GET", $"/api/function/{FunctionId}", HttpStatusCode.OK, "{\"id\":\"" + FunctionId + "\",\"name\":\"hello\"}");
+
+            // Act
+            var function = await client.Functions.GetAsync(FunctionId);
+
+            // Assert
+            Assert.Equal("hello", function.Name);
+            Assert.Equal(2, _handler.Requests.Count);
+            Assert.All(_handler.Requests, r => Assert.Equal("Bearer static-token", r.Authorization));
+        }
+
+        [Fact]
+        public async Task ExecuteAsync_TransientFailure_IsNotRetried()
+        {
+            // Arrange
+            var client = CreateClient();
+            _handler.Enqueue("POST", $"/api/function/{FunctionId}/execute", HttpStatusCode.ServiceUnavailable, "{\"message\":\"busy\"}");
+
+            // Act
+            var exception = await Assert.ThrowsAsync<NeoServiceLayerApiException>(() => client.Functions.ExecuteAsync(FunctionId));
+
+            // Assert
+            Assert.Equal(HttpStatusCode.ServiceUnavailable, exception.StatusCode);
+            Assert.Equal("busy", exception.Message);
+            Assert.Single(_handler.Requests);
+        }
+
+        [Fact]
+        public async Task Request_WithCredentials_LogsInAndRenewsSessionOnUnauthorized()
+        {
+            // Arrange
+            var client = CreateClient(new NeoServiceLayerClientOptions { Username = "alice", Password = "secret" });
+            var expiresAt = DateTime.UtcNow.AddHours(1).ToString("o");
+            _handler.Enqueue("POST", "/api/auth/login", HttpStatusCode.OK,
+                "{\"accessToken\":\"first\",\"refreshToken\":\"refresh\",\"expiresAt\":\"" + expiresAt + "\"}");
+            _handler.Enqueue("GET", "/api/secrets", HttpStatusCode.Unauthorized, "");
+            _handler.Enqueue("POST", "/api/auth/refresh", HttpStatusCode.OK,
+                "{\"accessToken\":\"second\",\"refreshToken\":\"refresh2\",\"expiresAt\":\"" + expiresAt + "\"}");
+            _handler.Enqueue("GET", "/api/secrets", HttpStatusCode.OK, "[]");
+
+            // Act
+            var secrets = await client.Secrets.ListAsync();
+
+            // Assert
+            Assert.Empty(secrets);
+            Assert.Equal(
+                new[] { "POST /api/auth/login", "GET /api/secrets", "POST /api/auth/refresh", "GET /api/secrets" },
+                _handler.Requests.Select(r => $"{r.Method} {r.Path}"));
+            Assert.Equal("Bearer first", _handler.Requests[1].Authorization);
+            Assert.Equal("Bearer second", _handler.Requests[3].Authorization);
+        }
+
+        [Fact]
+        public async Task GetLogsAsync_IteratesUntilShortPage()
+        {
+            // Arrange
+            var client = CreateClient();
+            var subscriptionId = Guid.NewGuid();
+            var path = $"/api/eventmonitoring/subscriptions/{subscriptionId}/logs";
+            _handler.Enqueue("GET", path, HttpStatusCode.OK, "[{\"id\":\"" + Guid.NewGuid() + "\"},{\"id\":\"" + Guid.NewGuid() + "\"}]");
+            _handler.Enqueue("GET", path, HttpStatusCode.OK, "[{\"id\":\"" + Guid.NewGuid() + "\"}]");
+
+            // Act
+            var logs = new List<EventLog>();
+            await foreach (var log in client.Triggers.GetLogsAsync(subscriptionId, pageSize: 2))
+            {
+                logs.Add(log);
+            }
+
+            // Assert
+            Assert.Equal(3, logs.Count);
+            Assert.Equal(new[] { "?limit=2&offset=0", "?limit=2&offset=2" }, _handler.Requests.Select(r => r.Query));
+        }
+
+        private class RecordedRequest
+        {
+            public string Method { get; set; }
+
+            public string Path { get; set; }
+
+            public string Query { get; set; }
+
+            public string Authorization { get; set; }
+        }
+
+        private class StubHandler : HttpMessageHandler
+        {
+            private readonly Dictionary<string, Queue<(HttpStatusCode Status, string Body)>> _responses =
+                new Dictionary<string, Queue<(HttpStatusCode Status, string Body)>>();
+
+            public List<RecordedRequest> Requests { get; } = new List<RecordedRequest>();
+
+            public void Enqueue(string method, string path, HttpStatusCode status, string body)
+            {
+                var key = $"{method} {path}";
+                if (!_responses.TryGetValue(key, out var queue))
+                {
+                    queue = new Queue<(HttpStatusCode Status, string Body)>();
+                    _responses[key] = queue;
+                }
+
+                queue.Enqueue((status, body));
+            }
+
+            protected override Task<HttpResponseMessage> SendAsync(HttpRequestMessage request, CancellationToken cancellationToken)
+            {
+                Requests.Add(new RecordedRequest
+                {
+                    Method = request.Method.Method,
+                    Path = request.RequestUri.AbsolutePath,
+                    Query = request.RequestUri.Query,
+                    Authorization = request.Headers.Authorization?.ToString()
+                });
+
+                var (status, body) = _responses[$"{request.Method.Method} {request.RequestUri.AbsolutePath}"].Dequeue();
+                return Task.FromResult(new HttpResponseMessage(status)
+                {
+                    Content = new StringContent(body, Encoding.UTF8, "application/json")
+                });
+            }
+        }
+    }
+}
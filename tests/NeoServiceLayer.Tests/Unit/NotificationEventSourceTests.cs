@@ -0,0 +1,111 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Text;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Services.EventMonitoring;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class NotificationEventSourceTests
+    {
+        private const string ContractHash = "0xd2a4cff31913016155e38e474a2c06d08be276cf";
+
+        private readonly Mock<INeoRpcClient> _rpcClientMock = new Mock<INeoRpcClient>();
+        private readonly NotificationEventSource _source;
+
+        public NotificationEventSourceTests()
+        {
+            _source = new NotificationEventSource(new Mock<ILogger<NotificationEventSource>>().Object, _rpcClientMock.Object);
+        }
+
+        [Fact]
+        public async Task GetEventsAsync_ReturnsNotificationsOfHaltedExecutions()
+        {
+            // Arrange
+            var blockTime = new DateTime(2026, 3, 10, 12, 0, 0, DateTimeKind.Utc);
+            _rpcClientMock.Setup(x => x.GetBlockAsync(42)).ReturnsAsync(new NeoBlock
+            {
+                Hash = "0xblock",
+                Index = 42,
+                Timestamp = blockTime,
+                TransactionHashes = new List<string> { "0xtx1", "0xtx2", "0xtx3" }
+            });
+            _rpcClientMock.Setup(x => x.GetApplicationLogsAsync(It.IsAny<IEnumerable<string>>())).ReturnsAsync(new List<NeoApplicationLog>
+            {
+                Log("0xtx1", "HALT", Notification("OrderFilled", Bytes("BTC"), Integer("125"))),
+                Log("0xtx2", "FAULT", Notification("OrderFilled", Bytes("ETH"), Integer("1"))),
+                null
+            });
+
+            // Act
+            var events = await _source.GetEventsAsync(42);
+
+            // Assert
+            var blockEvent = Assert.Single(events);
+            Assert.Equal("0xtx1", blockEvent.TransactionHash);
+            Assert.Equal("0xblock", blockEvent.BlockHash);
+            Assert.Equal(42, blockEvent.BlockHeight);
+            Assert.Equal(blockTime, blockEvent.BlockTimestamp);
+            Assert.Equal(ContractHash, blockEvent.ContractHash);
+            Assert.Equal("OrderFilled", blockEvent.EventName);
+            Assert.Equal("BTC", blockEvent.EventData["arg0"]);
+            Assert.Equal("125", blockEvent.EventData["arg1"]);
+        }
+
+        [Fact]
+        public void Decode_TransferNotification_UsesTransferNames()
+        {
+            // Arrange
+            var from = Enumerable.Range(1, 20).Select(i => (byte)i).ToArray();
+            var notification = Notification("Transfer", Bytes(from), new NeoStackItem { Type = "Any" }, Integer("500"));
+
+            // Act
+            var eventData = NotificationEventSource.Decode(notification);
+
+            // Assert
+            Assert.Equal("0x" + Convert.ToHexString(from.Reverse().ToArray()).ToLowerInvariant(), eventData["from"]);
+            Assert.Null(eventData["to"]);
+            Assert.Equal("500", eventData["amount"]);
+            Assert.Equal("NEP-17", eventData["standard"]);
+        }
+
+        private static NeoApplicationLog Log(string txId, string vmState, NeoContractNotification notification)
+        {
+            return new NeoApplicationLog
+            {
+                TxId = txId,
+                Executions = new List<NeoApplicationExecution>
+                {
+                    new NeoApplicationExecution
+                    {
+                        Trigger = "Application",
+                        VmState = vmState,
+                        Notifications = new List<NeoContractNotification> { notification }
+                    }
+                }
+            };
+        }
+
+        private static NeoContractNotification Notification(string eventName, params NeoStackItem[] args)
+        {
+            return new NeoContractNotification
+            {
+                Contract = ContractHash,
+                EventName = eventName,
+                State = new NeoStackItem { Type = "Array", Items = args.ToList() }
+            };
+        }
+
+        private static NeoStackItem Bytes(string text) => Bytes(Encoding.UTF8.GetBytes(text));
+
+        private static NeoStackItem Bytes(byte[] value) => new NeoStackItem { Type = "ByteString", Value = Convert.ToBase64String(value) };
+
+        private static NeoStackItem Integer(string value) => new NeoStackItem { Type = "Integer", Value = value };
+    }
+}
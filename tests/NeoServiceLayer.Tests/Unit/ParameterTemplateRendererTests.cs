@@ -0,0 +1,89 @@
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Services.Function;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ParameterTemplateRendererTests
+    {
+        private readonly Dictionary<string, object> _context = new Dictionary<string, object>
+        {
+            ["event"] = new Dictionary<string, object>
+            {
+                ["txHash"] = "0xabc",
+                ["blockHeight"] = 42L,
+                ["data"] = new Dictionary<string, object> { ["to"] = "0x01", ["amount"] = "500" },
+                ["state"] = new List<object> { "0x00", "0x01", "500", new List<object> { 1L, 2L } }
+            }
+        };
+
+        [Fact]
+        public void Render_WholePlaceholder_KeepsValueType()
+        {
+            // Arrange
+            var template = new Dictionary<string, object>
+            {
+                ["amount"] = "{{event.state[2]}}",
+                ["height"] = "{{ event.blockHeight }}",
+                ["ids"] = "{{event.state[3]}}",
+                ["fixed"] = 7L
+            };
+
+            // Act
+            var parameters = ParameterTemplateRenderer.Render(template, _context);
+
+            // Assert
+            Assert.Equal("500", parameters["amount"]);
+            Assert.Equal(42L, parameters["height"]);
+            Assert.Equal(new List<object> { 1L, 2L }, parameters["ids"]);
+            Assert.Equal(7L, parameters["fixed"]);
+        }
+
+        [Fact]
+        public void Render_EmbeddedPlaceholders_AreInterpolated()
+        {
+            // Arrange
+            var template = new Dictionary<string, object>
+            {
+                ["memo"] = "Pay {{event.data.to}} from {{event.txHash}}",
+                ["nested"] = new Dictionary<string, object> { ["to"] = "{{event.data.to}}" }
+            };
+
+            // Act
+            var parameters = ParameterTemplateRenderer.Render(template, _context);
+
+            // Assert
+            Assert.Equal("Pay 0x01 from 0xabc", parameters["memo"]);
+            Assert.Equal("0x01", ((Dictionary<string, object>)parameters["nested"])["to"]);
+        }
+
+        [Fact]
+        public void Render_MissingValue_Throws()
+        {
+            // Arrange
+            var template = new Dictionary<string, object> { ["amount"] = "{{event.state[9]}}" };
+
+            // Act & Assert
+            Assert.Throws<ValidationException>(() => ParameterTemplateRenderer.Render(template, _context));
+        }
+
+        [Theory]
+        [InlineData("{{event.data.to}}", true)]
+        [InlineData("{{conditions[0].actual}}", true)]
+        [InlineData("{{secrets.key}}", false)]
+        [InlineData("{{event.data['to']}}", false)]
+        [InlineData("{{event..to}}", false)]
+        public void Validate_ChecksPathsAndRoots(string value, bool valid)
+        {
+            // Arrange
+            var template = new Dictionary<string, object> { ["value"] = value };
+
+            // Act
+            var exception = Record.Exception(() => ParameterTemplateRenderer.Validate(template, "event", "conditions"));
+
+            // Assert
+            Assert.Equal(valid, exception == null);
+        }
+    }
+}
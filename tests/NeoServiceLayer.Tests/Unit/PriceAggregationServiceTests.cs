@@ -0,0 +1,126 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.PriceFeed.Aggregation;
+using NeoServiceLayer.Services.PriceFeed.DataSources;
+using NeoServiceLayer.Services.PriceFeed.Repositories;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class PriceAggregationServiceTests
+    {
+        private readonly List<PriceSource> _sources = new List<PriceSource>();
+        private readonly Dictionary<Guid, Func<Task<Price>>> _fetches = new Dictionary<Guid, Func<Task<Price>>>();
+        private readonly Mock<IPriceRepository> _priceRepository = new Mock<IPriceRepository>();
+        private readonly Mock<IPriceFeedService> _priceFeedService = new Mock<IPriceFeedService>();
+        private readonly PriceAggregationService _service;
+
+        public PriceAggregationServiceTests()
+        {
+            var sourceRepository = new Mock<IPriceSourceRepository>();
+            sourceRepository.Setup(r => r.GetActiveSourcesAsync()).ReturnsAsync(() => _sources);
+
+            var factory = new Mock<PriceDataSourceFactory>(Mock.Of<IServiceProvider>(), Mock.Of<ILogger<PriceDataSourceFactory>>());
+            factory.Setup(f => f.CreateDataSource(It.IsAny<PriceSource>()))
+                .Returns((PriceSource source) =>
+                {
+                    var dataSource = new Mock<IPriceDataSource>();
+                    dataSource.Setup(d => d.FetchPriceForSymbolAsync(It.IsAny<string>(), It.IsAny<string>()))
+                        .Returns(() => _fetches[source.Id]());
+                    return dataSource.Object;
+                });
+
+            _priceRepository.Setup(r => r.CreateAsync(It.IsAny<Price>())).ReturnsAsync((Price p) => p);
+            _priceFeedService.Setup(s => s.SubmitToOracleAsync(It.IsAny<Price>())).ReturnsAsync("0xabc");
+
+            _service = new PriceAggregationService(
+                Mock.Of<ILogger<PriceAggregationService>>(),
+                sourceRepository.Object,
+                _priceRepository.Object,
+                factory.Object,
+                _priceFeedService.Object,
+                new PriceSourceHealthTracker());
+        }
+
+        [Fact]
+        public async Task AggregateAsync_RejectsOutliersAndStalePricesAndTakesMedian()
+        {
+            // Arrange
+            AddSource("Binance", 10.00m);
+            AddSource("CoinGecko", 10.10m);
+            AddSource("Custom", 10.04m);
+            AddSource("Broken", 13.00m);
+            AddSource("Lagging", 10.02m, DateTime.UtcNow.AddHours(-1));
+
+            // Act
+            var aggregation = await _service.AggregateAsync("neo");
+
+            // Assert
+            Assert.Equal(10.04m, aggregation.Price.Value);
+            Assert.Equal("NEO", aggregation.Price.Symbol);
+            Assert.Equal(60, aggregation.Price.ConfidenceScore);
+            Assert.Equal(3, aggregation.Price.SourcePrices.Count);
+            Assert.Equal(PriceObservationStatus.Outlier, Observation(aggregation, "Broken").Status);
+            Assert.Equal(PriceObservationStatus.Stale, Observation(aggregation, "Lagging").Status);
+        }
+
+        [Fact]
+        public async Task AggregateAndPublishAsync_TooFewSourcesAgree_PublishesNothing()
+        {
+            // Arrange
+            AddSource("Binance", 10.00m);
+            AddSource("CoinGecko", 12.00m);
+            var failing = AddSource("Custom", 0);
+            _fetches[failing.Id] = () => throw new PriceFeedException("Service unavailable");
+
+            // Act & Assert
+            await Assert.ThrowsAsync<PriceFeedException>(() => _service.AggregateAndPublishAsync("NEO"));
+            _priceFeedService.Verify(s => s.SubmitToOracleAsync(It.IsAny<Price>()), Times.Never);
+            _priceRepository.Verify(r => r.CreateAsync(It.IsAny<Price>()), Times.Never);
+        }
+
+        [Fact]
+        public async Task AggregateAndPublishAsync_PublishesAndTracksSourceHealth()
+        {
+            // Arrange
+            AddSource("Binance", 10.00m);
+            AddSource("CoinGecko", 10.02m);
+            var failing = AddSource("Custom", 0);
+            _fetches[failing.Id] = () => throw new PriceFeedException("Service unavailable");
+
+            // Act
+            var aggregation = await _service.AggregateAndPublishAsync("NEO");
+            await _service.AggregateAsync("NEO");
+            await _service.AggregateAsync("NEO");
+            var health = (await _service.GetSourceHealthAsync()).ToDictionary(h => h.SourceName);
+
+            // Assert
+            Assert.Equal("0xabc", aggregation.TransactionHash);
+            _priceFeedService.Verify(s => s.SubmitToOracleAsync(It.Is<Price>(p => p.Value == 10.01m)), Times.Once);
+            Assert.Equal("Healthy", health["Binance"].Status);
+            Assert.Equal(3, health["Binance"].SuccessCount);
+            Assert.Equal("Unhealthy", health["Custom"].Status);
+            Assert.Equal("Service unavailable", health["Custom"].LastError);
+        }
+
+        private PriceSource AddSource(string name, decimal value, DateTime? timestamp = null)
+        {
+            var source = new PriceSource { Id = Guid.NewGuid(), Name = name, Type = PriceSourceType.Custom, Weight = 1 };
+            _sources.Add(source);
+            _fetches[source.Id] = () => Task.FromResult(new Price { Value = value, Timestamp = timestamp ?? DateTime.UtcNow });
+            return source;
+        }
+
+        private static PriceSourceObservation Observation(PriceAggregation aggregation, string sourceName)
+        {
+            return aggregation.Observations.Single(o => o.SourceName == sourceName);
+        }
+    }
+}
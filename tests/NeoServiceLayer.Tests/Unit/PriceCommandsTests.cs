@@ -0,0 +1,127 @@
+using System;
+using System.Collections.Generic;
+using System.IO;
+using System.Net;
+using System.Net.Http;
+using System.Text;
+using System.Threading;
+using System.Threading.Tasks;
+using NeoServiceLayer.Cli;
+using NeoServiceLayer.Cli.Commands;
+using NeoServiceLayer.Client;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class PriceCommandsTests
+    {
+        private const string LatestNeo = "{\"symbol\":\"NEO\",\"baseCurrency\":\"USD\",\"value\":12.5,\"timestamp\":\"2024-01-01T00:00:00Z\"," +
+            "\"sourcePrices\":[{\"sourceName\":\"a\",\"value\":12.4,\"weight\":1},{\"sourceName\":\"b\",\"value\":12.6,\"weight\":1}]}";
+
+        private readonly StubHandler _handler = new StubHandler();
+        private readonly StringWriter _output = new StringWriter();
+
+        private PriceCommands CreateCommands(string input)
+        {
+            var client = new NeoServiceLayerClient(
+                new NeoServiceLayerClientOptions { BaseUrl = "http://localhost:5000", AccessToken = "token", RetryDelay = TimeSpan.Zero },
+                new HttpClient(_handler));
+
+            return new PriceCommands(client, new StringReader(input), _output);
+        }
+
+        private static CommandLineArguments Parse(params string[] args)
+        {
+            return CommandLineArguments.Parse(args, "history", "force", "yes");
+        }
+
+        [Fact]
+        public void Parse_FlagsBeforeOperands_DoNotConsumeThem()
+        {
+            // Act
+            var arguments = Parse("price", "publish", "--force", "neo", "--reason", "stalled", "--value=1.5");
+
+            // Assert
+            Assert.Equal(new List<string> { "price", "publish", "neo" }, arguments.Positionals);
+            Assert.True(arguments.HasFlag("force"));
+            Assert.Equal("stalled", arguments.GetOption("reason"));
+            Assert.Equal("1.5", arguments.GetOption("value"));
+        }
+
+        [Fact]
+        public async Task PublishAsync_WithoutForce_ThrowsWithoutCallingApi()
+        {
+            // Arrange
+            var commands = CreateCommands("NEO\n");
+
+            // Act & Assert
+            await Assert.ThrowsAsync<CommandException>(() => commands.RunAsync(Parse("price", "publish", "NEO", "--reason", "stalled")));
+            Assert.Empty(_handler.Requests);
+        }
+
+        [Fact]
+        public async Task PublishAsync_ConfirmationMismatch_AbortsWithoutPublishing()
+        {
+            // Arrange
+            var commands = CreateCommands("GAS\n");
+            _handler.Enqueue("GET /api/pricefeed/latest/NEO", LatestNeo);
+
+            // Act
+            var exitCode = await commands.RunAsync(Parse("price", "publish", "neo", "--force", "--reason", "stalled"));
+
+            // Assert
+            Assert.Equal(ExitCodes.Failure, exitCode);
+            Assert.Contains("Aborted", _output.ToString());
+            Assert.Equal(new List<string> { "GET /api/pricefeed/latest/NEO" }, _handler.Requests);
+        }
+
+        [Fact]
+        public async Task PublishAsync_Confirmed_PublishesLatestRound()
+        {
+            // Arrange
+            var commands = CreateCommands("NEO\n");
+            _handler.Enqueue("GET /api/pricefeed/latest/NEO", LatestNeo);
+            _handler.Enqueue("POST /api/pricefeed/publish/NEO", "{\"transactionHash\":\"0xabc\",\"price\":" + LatestNeo + "}");
+
+            // Act
+            var exitCode = await commands.RunAsync(Parse("price", "publish", "NEO", "--force", "--reason", "stalled"));
+
+            // Assert
+            Assert.Equal(ExitCodes.Success, exitCode);
+            Assert.Contains("spread 1.6%", _output.ToString());
+            Assert.Contains("Transaction: 0xabc", _output.ToString());
+            Assert.Contains("\"force\":true", _handler.LastBody);
+            Assert.Contains("\"reason\":\"stalled\"", _handler.LastBody);
+        }
+
+        private class StubHandler : HttpMessageHandler
+        {
+            private readonly Dictionary<string, string> _responses = new Dictionary<string, string>();
+
+            public List<string> Requests { get; } = new List<string>();
+
+            public string LastBody { get; private set; }
+
+            public void Enqueue(string request, string body)
+            {
+                _responses[request] = body;
+            }
+
+            protected override async Task<HttpResponseMessage> SendAsync(HttpRequestMessage request, CancellationToken cancellationToken)
+            {
+                var key = $"{request.Method.Method} {request.RequestUri.AbsolutePath}";
+                Requests.Add(key);
+
+                if (request.Content != null)
+                {
+                    LastBody = await request.Content.ReadAsStringAsync(cancellationToken);
+                }
+
+                return new HttpResponseMessage(HttpStatusCode.OK)
+                {
+                    Content = new StringContent(_responses[key], Encoding.UTF8, "application/json")
+                };
+            }
+        }
+    }
+}
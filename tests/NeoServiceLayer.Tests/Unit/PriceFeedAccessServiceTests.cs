@@ -0,0 +1,79 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.PriceFeed;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class PriceFeedAccessServiceTests
+    {
+        private readonly Mock<IAccountService> _accountServiceMock = new Mock<IAccountService>();
+        private readonly PriceFeedAccessService _service;
+
+        public PriceFeedAccessServiceTests()
+        {
+            var configuration = new PriceFeedAccessConfiguration
+            {
+                DefaultTier = "free",
+                Tiers = new List<PriceFeedTier>
+                {
+                    new PriceFeedTier { Name = "free", Price = 0 },
+                    new PriceFeedTier { Name = "pro", Price = 100 },
+                    new PriceFeedTier { Name = "enterprise", Price = 1000 }
+                },
+                SymbolTiers = new Dictionary<string, string>
+                {
+                    ["EURUSD"] = "pro",
+                    ["XAUUSD"] = "enterprise"
+                }
+            };
+
+            _accountServiceMock.Setup(x => x.UpdateAsync(It.IsAny<Account>())).ReturnsAsync((Account a) => a);
+            _service = new PriceFeedAccessService(
+                new Mock<ILogger<PriceFeedAccessService>>().Object,
+                _accountServiceMock.Object,
+                Options.Create(configuration));
+        }
+
+        [Fact]
+        public async Task CanAccessAsync_IncludesSymbolsOfLowerTiers()
+        {
+            // Arrange
+            var accountId = Guid.NewGuid();
+            _accountServiceMock.Setup(x => x.GetByIdAsync(accountId)).ReturnsAsync(new Account { Id = accountId, PriceFeedTier = "pro" });
+
+            // Act & Assert
+            Assert.True(await _service.CanAccessAsync(accountId, "NEO"));
+            Assert.True(await _service.CanAccessAsync(accountId, "eurusd"));
+            Assert.False(await _service.CanAccessAsync(accountId, "XAUUSD"));
+            Assert.False(await _service.CanAccessAsync(null, "EURUSD"));
+            Assert.Equal(new[] { "XAUUSD" }, await _service.GetDeniedSymbolsAsync(accountId));
+        }
+
+        [Fact]
+        public async Task ChangeTierAsync_ChargesTierPriceAndRejectsInsufficientCredits()
+        {
+            // Arrange
+            var accountId = Guid.NewGuid();
+            var account = new Account { Id = accountId, Credits = 150 };
+            _accountServiceMock.Setup(x => x.GetByIdAsync(accountId)).ReturnsAsync(account);
+
+            // Act
+            var upgraded = await _service.ChangeTierAsync(accountId, "pro");
+
+            // Assert
+            Assert.Equal("pro", upgraded.PriceFeedTier);
+            Assert.Equal(50, upgraded.Credits);
+            await Assert.ThrowsAsync<ValidationException>(() => _service.ChangeTierAsync(accountId, "enterprise"));
+            await Assert.ThrowsAsync<ValidationException>(() => _service.ChangeTierAsync(accountId, "platinum"));
+            Assert.Equal("pro", account.PriceFeedTier);
+        }
+    }
+}
@@ -0,0 +1,120 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Services.PriceFeed.Contract;
+using NeoServiceLayer.Services.PriceFeed.Repositories;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class PriceFeedRoundAuditorTests
+    {
+        private const string ContractHash = "0x1234567890abcdef1234567890abcdef12345678";
+
+        private static readonly DateTime RoundTime = new DateTime(2026, 1, 1, 12, 0, 0, DateTimeKind.Utc);
+
+        private readonly Mock<INeoRpcClient> _rpcClient = new Mock<INeoRpcClient>();
+        private readonly Mock<IPriceRepository> _priceRepository = new Mock<IPriceRepository>();
+        private readonly PriceFeedRoundAuditor _auditor;
+
+        public PriceFeedRoundAuditorTests()
+        {
+            _auditor = new PriceFeedRoundAuditor(
+                new Mock<ILogger<PriceFeedRoundAuditor>>().Object,
+                _rpcClient.Object,
+                _priceRepository.Object,
+                Options.Create(new PriceFeedContractConfiguration { ContractHash = ContractHash, AuditTolerancePercent = 1m }));
+        }
+
+        private static NeoStackItem Integer(long value)
+        {
+            return new NeoStackItem { Type = "Integer", Value = value.ToString() };
+        }
+
+        private static NeoInvokeResult Round(decimal price, DateTime timestamp)
+        {
+            return new NeoInvokeResult
+            {
+                State = "HALT",
+                Stack = new List<NeoStackItem>
+                {
+                    new NeoStackItem
+                    {
+                        Type = "Array",
+                        Items = new List<NeoStackItem>
+                        {
+                            Integer((long)(price * 100_000_000m)),
+                            Integer(new DateTimeOffset(timestamp).ToUnixTimeMilliseconds())
+                        }
+                    }
+                }
+            };
+        }
+
+        [Fact]
+        public async Task AuditAsync_FlagsDeviatingMissingAndUnreadableRounds()
+        {
+            // Arrange
+            _rpcClient
+                .Setup(c => c.InvokeFunctionAsync(ContractHash, "getLatestRoundId", It.IsAny<IEnumerable<NeoContractParameter>>()))
+                .ReturnsAsync(new NeoInvokeResult { State = "HALT", Stack = new List<NeoStackItem> { Integer(4) } });
+            _rpcClient
+                .Setup(c => c.InvokeFunctionBatchAsync(It.IsAny<IEnumerable<NeoInvocation>>()))
+                .ReturnsAsync(new List<NeoInvokeResult>
+                {
+                    Round(10.00m, RoundTime),
+                    Round(10.50m, RoundTime.AddMinutes(10)),
+                    Round(11.00m, RoundTime.AddHours(2)),
+                    new NeoInvokeResult { State = "FAULT", Exception = "round not found" }
+                });
+            _priceRepository
+                .Setup(r => r.GetPricesInRangeAsync("NEO", "USD", It.IsAny<DateTime>(), It.IsAny<DateTime>()))
+                .ReturnsAsync(new List<Price>
+                {
+                    new Price { Symbol = "NEO", BaseCurrency = "USD", Value = 10.05m, Timestamp = RoundTime.AddSeconds(30) },
+                    new Price { Symbol = "NEO", BaseCurrency = "USD", Value = 10.00m, Timestamp = RoundTime.AddMinutes(10) }
+                });
+
+            // Act
+            var report = await _auditor.AuditAsync("NEO", "USD", 4);
+
+            // Assert
+            Assert.Equal(4, report.LatestRoundId);
+            Assert.Equal(4, report.RoundsAudited);
+            Assert.False(report.IsConsistent);
+            Assert.Equal(new long[] { 2, 3, 4 }, report.Divergences.ConvertAll(d => d.RoundId));
+            Assert.Equal("Deviation", report.Divergences[0].Reason);
+            Assert.Equal(5m, report.Divergences[0].DeviationPercent);
+            Assert.Equal("Missing", report.Divergences[1].Reason);
+            Assert.Equal("Unreadable", report.Divergences[2].Reason);
+        }
+
+        [Fact]
+        public async Task AuditAsync_FewerRoundsThanRequested_AuditsFromFirstRound()
+        {
+            // Arrange
+            _rpcClient
+                .Setup(c => c.InvokeFunctionAsync(ContractHash, "getLatestRoundId", It.IsAny<IEnumerable<NeoContractParameter>>()))
+                .ReturnsAsync(new NeoInvokeResult { State = "HALT", Stack = new List<NeoStackItem> { Integer(1) } });
+            _rpcClient
+                .Setup(c => c.InvokeFunctionBatchAsync(It.IsAny<IEnumerable<NeoInvocation>>()))
+                .ReturnsAsync(new List<NeoInvokeResult> { Round(10.00m, RoundTime) });
+            _priceRepository
+                .Setup(r => r.GetPricesInRangeAsync("NEO", "USD", It.IsAny<DateTime>(), It.IsAny<DateTime>()))
+                .ReturnsAsync(new List<Price> { new Price { Value = 10.00m, Timestamp = RoundTime } });
+
+            // Act
+            var report = await _auditor.AuditAsync("NEO", "USD", 20);
+
+            // Assert
+            Assert.Equal(1, report.RoundsAudited);
+            Assert.True(report.IsConsistent);
+        }
+    }
+}
@@ -0,0 +1,119 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.DependencyInjection;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.PriceFeed.Aggregation;
+using NeoServiceLayer.Services.PriceFeed.Repositories;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class PricePublicationServiceTests
+    {
+        private readonly Mock<IPriceAggregationService> _aggregationService = new Mock<IPriceAggregationService>();
+        private readonly Mock<IPricePublicationRepository> _publicationRepository = new Mock<IPricePublicationRepository>();
+        private readonly PricePublicationService _service;
+        private decimal? _price;
+
+        public PricePublicationServiceTests()
+        {
+            _aggregationService.Setup(a => a.AggregateAsync(It.IsAny<string>(), It.IsAny<string>()))
+                .ReturnsAsync((string symbol, string baseCurrency) => new PriceAggregation
+                {
+                    Symbol = symbol.ToUpperInvariant(),
+                    BaseCurrency = baseCurrency.ToUpperInvariant(),
+                    Price = _price == null ? null : new Price { Symbol = symbol, BaseCurrency = baseCurrency, Value = _price.Value }
+                });
+            _aggregationService.Setup(a => a.PublishAsync(It.IsAny<PriceAggregation>(), It.IsAny<PricePublicationReason>()))
+                .ReturnsAsync((PriceAggregation aggregation, PricePublicationReason reason) => aggregation);
+
+            var scopeFactory = new ServiceCollection()
+                .AddSingleton(_aggregationService.Object)
+                .BuildServiceProvider()
+                .GetRequiredService<IServiceScopeFactory>();
+
+            var configuration = Options.Create(new PricePublicationConfiguration
+            {
+                DeviationThresholdPercent = 0.5m,
+                HeartbeatSeconds = 3600,
+                Pairs = new List<PricePublicationPair>
+                {
+                    new PricePublicationPair { Symbol = "NEO", BaseCurrency = "USD", DeviationThresholdPercent = 1 }
+                }
+            });
+
+            _service = new PricePublicationService(
+                Mock.Of<ILogger<PricePublicationService>>(),
+                scopeFactory,
+                _publicationRepository.Object,
+                configuration);
+        }
+
+        [Fact]
+        public async Task PublishIfDueAsync_WithinPairThreshold_DoesNotPublish()
+        {
+            // Arrange
+            _price = 10.08m;
+            _publicationRepository.Setup(r => r.GetAsync("NEO", "USD"))
+                .ReturnsAsync(new PricePublication { Symbol = "NEO", BaseCurrency = "USD", Value = 10m, PublishedAt = DateTime.UtcNow });
+
+            // Act
+            var result = await _service.PublishIfDueAsync("neo");
+
+            // Assert
+            Assert.Null(result.Reason);
+            Assert.Equal(0.8m, result.DeviationPercent);
+            _aggregationService.Verify(a => a.PublishAsync(It.IsAny<PriceAggregation>(), It.IsAny<PricePublicationReason>()), Times.Never);
+        }
+
+        [Fact]
+        public async Task PublishDueAsync_DeviationAboveThreshold_Publishes()
+        {
+            // Arrange
+            _price = 10.2m;
+            _publicationRepository.Setup(r => r.GetAsync("NEO", "USD"))
+                .ReturnsAsync(new PricePublication { Symbol = "NEO", BaseCurrency = "USD", Value = 10m, PublishedAt = DateTime.UtcNow });
+
+            // Act
+            var results = await _service.PublishDueAsync();
+
+            // Assert
+            var result = Assert.Single(results);
+            Assert.Equal(PricePublicationReason.Deviation, result.Reason);
+            _aggregationService.Verify(a => a.PublishAsync(It.IsAny<PriceAggregation>(), PricePublicationReason.Deviation), Times.Once);
+        }
+
+        [Fact]
+        public async Task PublishIfDueAsync_NoAgreedPrice_ReturnsErrorWithoutPublishing()
+        {
+            // Arrange
+            _price = null;
+
+            // Act
+            var result = await _service.PublishIfDueAsync("GAS");
+
+            // Assert
+            Assert.NotNull(result.Error);
+            Assert.Null(result.Reason);
+            _aggregationService.Verify(a => a.PublishAsync(It.IsAny<PriceAggregation>(), It.IsAny<PricePublicationReason>()), Times.Never);
+        }
+
+        [Fact]
+        public void Decide_HeartbeatElapsedWithoutDeviation_ReturnsHeartbeat()
+        {
+            // Arrange
+            var now = DateTime.UtcNow;
+            var previous = new PricePublication { Value = 10m, PublishedAt = now.AddSeconds(-3600) };
+
+            // Act & Assert
+            Assert.Equal(PricePublicationReason.Heartbeat, PricePublicationService.Decide(previous, 10m, now, 0.5m, 3600));
+            Assert.Null(PricePublicationService.Decide(previous, 10m, now, 0.5m, 7200));
+            Assert.Equal(PricePublicationReason.Initial, PricePublicationService.Decide(null, 10m, now, 0.5m, 3600));
+        }
+    }
+}
@@ -0,0 +1,65 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Provenance;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ProvenanceServiceTests
+    {
+        private readonly Mock<IEnclaveService> _enclaveService = new Mock<IEnclaveService>();
+
+        private ProvenanceService CreateService(bool bindToEnclave)
+        {
+            return new ProvenanceService(
+                new Mock<ILogger<ProvenanceService>>().Object,
+                _enclaveService.Object,
+                Options.Create(new ProvenanceConfiguration { BindToEnclave = bindToEnclave }));
+        }
+
+        [Fact]
+        public async Task GetStatementAsync_NotInTee_DigestsLoadedAssembliesWithoutEnclave()
+        {
+            // Arrange
+            var service = CreateService(false);
+
+            // Act
+            var statement = await service.GetStatementAsync();
+
+            // Assert
+            Assert.NotEmpty(statement.Build.Dependencies);
+            Assert.Contains(statement.Build.Dependencies, d => d.Name == "NeoServiceLayer.Services.dll" && d.Sha256.Length == 64);
+            Assert.Equal(ProvenanceService.ComputeDependenciesDigest(statement.Build.Dependencies), statement.Build.DependenciesDigest);
+            Assert.Equal(ProvenanceService.ComputeStatementDigest(statement.Build), statement.StatementDigest);
+            Assert.Null(statement.Enclave);
+            _enclaveService.Verify(e => e.GetAttestationDocumentAsync(), Times.Never);
+        }
+
+        [Fact]
+        public async Task GetStatementAsync_BindToEnclave_ReturnsEnclaveSignatureAndAttestation()
+        {
+            // Arrange
+            _enclaveService
+                .Setup(e => e.SendRequestAsync<object, EnclaveProvenanceBinding>(
+                    Constants.EnclaveServiceTypes.Attestation, Constants.AttestationOperations.SignProvenance, It.IsAny<object>()))
+                .ReturnsAsync(new EnclaveProvenanceBinding { PublicKey = "key", Signature = "signature" });
+            _enclaveService.Setup(e => e.GetAttestationDocumentAsync()).ReturnsAsync(new byte[] { 1, 2, 3 });
+            var service = CreateService(true);
+
+            // Act
+            var statement = await service.GetStatementAsync();
+
+            // Assert
+            Assert.Equal("key", statement.Enclave.PublicKey);
+            Assert.Equal("signature", statement.Enclave.Signature);
+            Assert.Equal(Convert.ToBase64String(new byte[] { 1, 2, 3 }), statement.Enclave.AttestationDocument);
+            Assert.Null(statement.Enclave.Error);
+        }
+    }
+}
@@ -0,0 +1,111 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Quorum;
+using Xunit;
+using FunctionRepositories = NeoServiceLayer.Services.Function.Repositories;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class QuorumExecutionServiceTests
+    {
+        private readonly Mock<IQuorumExecutionRepository> _repository = new Mock<IQuorumExecutionRepository>();
+        private readonly Mock<FunctionRepositories.IFunctionRepository> _functionRepository = new Mock<FunctionRepositories.IFunctionRepository>();
+        private readonly Mock<INotificationService> _notificationService = new Mock<INotificationService>();
+
+        public QuorumExecutionServiceTests()
+        {
+            _repository.Setup(r => r.CreateAsync(It.IsAny<QuorumExecution>())).ReturnsAsync((QuorumExecution e) => e);
+        }
+
+        private QuorumExecutionService CreateService(params IQuorumNode[] nodes)
+        {
+            return new QuorumExecutionService(
+                new Mock<ILogger<QuorumExecutionService>>().Object,
+                _repository.Object,
+                _functionRepository.Object,
+                nodes,
+                _notificationService.Object);
+        }
+
+        private static IQuorumNode Node(string id, object output)
+        {
+            var node = new Mock<IQuorumNode>();
+            node.SetupGet(n => n.Id).Returns(id);
+            node.Setup(n => n.ExecuteAsync(It.IsAny<QuorumNodeRequest>(), It.IsAny<CancellationToken>()))
+                .ReturnsAsync(new QuorumNodeResult { NodeId = id, Succeeded = true, Output = output });
+            return node.Object;
+        }
+
+        private static Function PriceFunction(string comparison, decimal tolerance = 0)
+        {
+            return new Function
+            {
+                Id = Guid.NewGuid(),
+                AccountId = Guid.NewGuid(),
+                Name = "publish-price",
+                Quorum = new QuorumPolicy { Nodes = 3, Threshold = 2, Comparison = comparison, ResultPath = "price", Tolerance = tolerance }
+            };
+        }
+
+        [Fact]
+        public async Task ExecuteAsync_NumericResultsWithinTolerance_AgreesAndAlertsOnOutlier()
+        {
+            // Arrange
+            var service = CreateService(
+                Node("local", new { Result = new { price = 10.00m }, GasUsed = 10 }),
+                Node("node-2", new { Result = new { price = 10.02m }, GasUsed = 12 }),
+                Node("node-3", new { Result = new { price = 11.50m }, GasUsed = 11 }));
+            var function = PriceFunction(QuorumComparisons.Numeric, 0.005m);
+
+            // Act
+            var execution = await service.ExecuteAsync(function, Guid.NewGuid(), "executeFunction", new { });
+
+            // Assert
+            Assert.True(execution.Agreed);
+            Assert.Equal(2, execution.AgreeingNodes);
+            Assert.Equal(new[] { "local", "node-2" }, execution.Results.Where(r => r.Agreed).Select(r => r.NodeId).OrderBy(id => id));
+            Assert.Contains(execution.SelectedNodeId, new[] { "local", "node-2" });
+            _repository.Verify(r => r.CreateAsync(execution), Times.Once);
+            _notificationService.Verify(n => n.SendNotificationAsync(It.Is<Notification>(x => x.Priority == NotificationPriority.Normal)), Times.Once);
+        }
+
+        [Fact]
+        public async Task ExecuteAsync_ExactResultsDiffer_DoesNotAgree()
+        {
+            // Arrange
+            var service = CreateService(
+                Node("local", new { Result = new { price = "10", source = "a" } }),
+                Node("node-2", new { Result = new { price = "11", source = "a" } }),
+                Node("node-3", new { Result = new { price = "12", source = "a" } }));
+
+            // Act
+            var execution = await service.ExecuteAsync(PriceFunction(QuorumComparisons.Exact), Guid.NewGuid(), "executeFunction", new { });
+
+            // Assert
+            Assert.False(execution.Agreed);
+            Assert.Null(execution.AgreedOutput);
+            Assert.Equal(3, execution.Results.Select(r => r.ResultDigest).Distinct().Count());
+            _notificationService.Verify(n => n.SendNotificationAsync(It.Is<Notification>(x => x.Priority == NotificationPriority.High)), Times.Once);
+        }
+
+        [Fact]
+        public async Task SetPolicyAsync_ThresholdNotMajority_ThrowsValidationException()
+        {
+            // Arrange
+            var service = CreateService(Node("local", null), Node("node-2", null), Node("node-3", null), Node("node-4", null));
+
+            // Act & Assert
+            await Assert.ThrowsAsync<ValidationException>(() =>
+                service.SetPolicyAsync(Guid.NewGuid(), new QuorumPolicy { Nodes = 4, Threshold = 2 }));
+            _functionRepository.Verify(r => r.UpdateAsync(It.IsAny<Function>()), Times.Never);
+        }
+    }
+}
@@ -0,0 +1,49 @@
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Models.Analytics;
+using NeoServiceLayer.Services.Analytics;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ReportTemplateRendererTests
+    {
+        [Fact]
+        public void Render_Markdown_InsertsValuesAndRepeatsEachBlocks()
+        {
+            // Arrange
+            var model = new Dictionary<string, object>
+            {
+                ["report"] = new Dictionary<string, object> { ["name"] = "Daily balances" },
+                ["output"] = new
+                {
+                    total = 42.5,
+                    accounts = new[]
+                    {
+                        new { name = "alice", balance = 40 },
+                        new { name = "bob", balance = 2.5 }
+                    }
+                }
+            };
+            var template = "# {{report.name}}\n{{#each output.accounts}}{{@index}}. {{this.name}}: {{this.balance}}\n{{/each}}Total: {{output.total}}{{output.missing}}";
+
+            // Act
+            var result = ReportTemplateRenderer.Render(template, ReportTemplateFormat.Markdown, model);
+
+            // Assert
+            Assert.Equal("# Daily balances\n0. alice: 40\n1. bob: 2.5\nTotal: 42.5", result);
+        }
+
+        [Fact]
+        public void Render_Html_EncodesValues()
+        {
+            // Arrange
+            var model = new { output = new { note = "<script>alert(1)</script>", owner = "Smith & Co" } };
+
+            // Act
+            var result = ReportTemplateRenderer.Render("<p>{{output.note}}</p><p>{{ output.owner }}</p>", ReportTemplateFormat.Html, model);
+
+            // Assert
+            Assert.Equal("<p>&lt;script&gt;alert(1)&lt;/script&gt;</p><p>Smith &amp; Co</p>", result);
+        }
+    }
+}
@@ -0,0 +1,67 @@
+using System.Threading.Tasks;
+using Microsoft.AspNetCore.Http;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.API.Middleware;
+using NeoServiceLayer.Core.Utilities;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class RequestIdMiddlewareTests
+    {
+        private static async Task<(string TraceIdentifier, string RequestId)> InvokeAsync(string incomingRequestId)
+        {
+            string ambientRequestId = null;
+            var middleware = new RequestIdMiddleware(
+                _ =>
+                {
+                    ambientRequestId = RequestContext.RequestId;
+                    return Task.CompletedTask;
+                },
+                new Mock<ILogger<RequestIdMiddleware>>().Object);
+
+            var context = new DefaultHttpContext();
+            if (incomingRequestId != null)
+            {
+                context.Request.Headers[RequestContext.RequestIdHeader] = incomingRequestId;
+            }
+
+            await middleware.InvokeAsync(context);
+            return (context.TraceIdentifier, ambientRequestId);
+        }
+
+        [Fact]
+        public async Task InvokeAsync_ValidIncomingId_IsReused()
+        {
+            // Act
+            var (traceIdentifier, requestId) = await InvokeAsync("client-req.42_a");
+
+            // Assert
+            Assert.Equal("client-req.42_a", traceIdentifier);
+            Assert.Equal("client-req.42_a", requestId);
+        }
+
+        [Fact]
+        public async Task InvokeAsync_NoIncomingId_GeneratesOne()
+        {
+            // Act
+            var (traceIdentifier, requestId) = await InvokeAsync(null);
+
+            // Assert
+            Assert.Equal(32, traceIdentifier.Length);
+            Assert.Equal(traceIdentifier, requestId);
+        }
+
+        [Fact]
+        public async Task InvokeAsync_InvalidIncomingId_IsReplaced()
+        {
+            // Act
+            var (traceIdentifier, _) = await InvokeAsync("bad id\r\nX-Injected: 1");
+
+            // Assert
+            Assert.NotEqual("bad id\r\nX-Injected: 1", traceIdentifier);
+            Assert.Equal(32, traceIdentifier.Length);
+        }
+    }
+}
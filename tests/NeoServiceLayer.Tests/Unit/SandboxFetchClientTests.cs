@@ -0,0 +1,106 @@
+using System;
+using System.Collections.Generic;
+using System.Net;
+using System.Net.Http;
+using System.Threading;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Enclave.Enclave.Execution;
+using NeoServiceLayer.Enclave.Enclave.Models;
+using Xunit;
+using FunctionErrorCodes = NeoServiceLayer.Core.Models.FunctionErrorCodes;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class SandboxFetchClientTests
+    {
+        private readonly StubHandler _handler = new StubHandler();
+        private readonly SandboxFetchClient _client;
+
+        public SandboxFetchClientTests()
+        {
+            var options = Options.Create(new SandboxFetchOptions
+            {
+                Enabled = true,
+                AllowedHosts = new List<string> { "api.example.com", "*.prices.io" },
+                MaxResponseBytes = 16,
+                MaxRequestsPerExecution = 2
+            });
+
+            _client = new SandboxFetchClient(new Mock<ILogger<SandboxFetchClient>>().Object, options, _handler);
+        }
+
+        [Theory]
+        [InlineData("https://api.example.com/v1", true)]
+        [InlineData("https://API.EXAMPLE.COM/v1", true)]
+        [InlineData("https://eu.prices.io/gas", true)]
+        [InlineData("https://prices.io/gas", false)]
+        [InlineData("https://api.example.com.evil.net/v1", false)]
+        public void IsHostAllowed_MatchesAllowlist(string url, bool expected)
+        {
+            // Act
+            var allowed = _client.IsHostAllowed(new Uri(url));
+
+            // Assert
+            Assert.Equal(expected, allowed);
+        }
+
+        [Fact]
+        public async Task FetchAsync_ReturnsResponseAndCountsUsage()
+        {
+            // Arrange
+            _handler.Body = "{\"usd\":1.5}";
+            var usage = new SandboxFetchUsage();
+
+            // Act
+            var response = await _client.FetchAsync(new SandboxFetchRequest { Url = "https://api.example.com/rates" }, usage, CancellationToken.None);
+
+            // Assert
+            Assert.Equal(200, response.Status);
+            Assert.Equal("{\"usd\":1.5}", response.Body);
+            Assert.Equal(1, usage.Requests);
+            Assert.Equal(11, usage.Bytes);
+        }
+
+        [Fact]
+        public async Task FetchAsync_ResponseOverCap_IsRefused()
+        {
+            // Arrange
+            _handler.Body = new string('x', 17);
+
+            // Act & Assert
+            var exception = await Assert.ThrowsAsync<SandboxException>(() =>
+                _client.FetchAsync(new SandboxFetchRequest { Url = "https://api.example.com/big" }, new SandboxFetchUsage(), CancellationToken.None));
+            Assert.Equal(FunctionErrorCodes.HostCallDenied, exception.ErrorCode);
+        }
+
+        [Fact]
+        public async Task FetchAsync_OverRequestBudget_IsRefused()
+        {
+            // Arrange
+            var usage = new SandboxFetchUsage { Requests = 2 };
+
+            // Act & Assert
+            var exception = await Assert.ThrowsAsync<SandboxException>(() =>
+                _client.FetchAsync(new SandboxFetchRequest { Url = "https://api.example.com/rates" }, usage, CancellationToken.None));
+            Assert.Equal(FunctionErrorCodes.HostCallDenied, exception.ErrorCode);
+            Assert.Equal(0, _handler.Calls);
+        }
+
+        private class StubHandler : HttpMessageHandler
+        {
+            public string Body { get; set; } = string.Empty;
+
+            public int Calls { get; private set; }
+
+            protected override Task<HttpResponseMessage> SendAsync(HttpRequestMessage request, CancellationToken cancellationToken)
+            {
+                Calls++;
+                return Task.FromResult(new HttpResponseMessage(HttpStatusCode.OK) { Content = new StringContent(Body) });
+            }
+        }
+    }
+}
@@ -0,0 +1,37 @@
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using NeoServiceLayer.Tests.TestFixtures;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class SandboxGoldenTests
+    {
+        private readonly SandboxGoldenHarness _harness = new SandboxGoldenHarness();
+
+        public static IEnumerable<object[]> Cases() =>
+            SandboxGoldenHarness.GetCaseNames().Select(name => new object[] { name });
+
+        [Fact]
+        public void Corpus_IsNotEmpty()
+        {
+            // Act
+            var cases = SandboxGoldenHarness.GetCaseNames();
+
+            // Assert
+            Assert.NotEmpty(cases);
+        }
+
+        [Theory]
+        [MemberData(nameof(Cases))]
+        public async Task Execute_MatchesGoldenOutput(string caseName)
+        {
+            // Act
+            var mismatch = await _harness.VerifyAsync(caseName);
+
+            // Assert
+            Assert.True(mismatch == null, mismatch);
+        }
+    }
+}
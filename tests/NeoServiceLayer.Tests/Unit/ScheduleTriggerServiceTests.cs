@@ -0,0 +1,191 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Scheduling;
+using NeoServiceLayer.Services.Scheduling.Repositories;
+using NeoServiceLayer.Services.Storage.Providers;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ScheduleTriggerServiceTests
+    {
+        private readonly InMemoryStorageProvider _sharedStore = new InMemoryStorageProvider(new Mock<ILogger<InMemoryStorageProvider>>().Object);
+        private readonly Mock<IFunctionService> _functionService = new Mock<IFunctionService>();
+        private readonly ScheduleTriggerRepository _triggerRepository;
+
+        public ScheduleTriggerServiceTests()
+        {
+            _triggerRepository = new ScheduleTriggerRepository(new Mock<ILogger<ScheduleTriggerRepository>>().Object, _sharedStore);
+        }
+
+        private ScheduleTriggerService CreateInstance(IScheduleTriggerRepository triggerRepository)
+        {
+            var firingRepository = new TriggerFiringRepository(new Mock<ILogger<TriggerFiringRepository>>().Object, _sharedStore);
+            var coordinator = new TriggerFiringCoordinator(new Mock<ILogger<TriggerFiringCoordinator>>().Object, firingRepository);
+
+            return new ScheduleTriggerService(
+                new Mock<ILogger<ScheduleTriggerService>>().Object,
+                triggerRepository,
+                _functionService.Object,
+                coordinator,
+                Options.Create(new SchedulerConfiguration()));
+        }
+
+        [Fact]
+        public async Task FireDueTriggersAsync_TwoInstancesSeeSameFireTime_FunctionRunsOnce()
+        {
+            // Arrange
+            var fireTime = DateTime.UtcNow.AddMinutes(-3);
+            var trigger = await _triggerRepository.CreateAsync(new ScheduleTrigger
+            {
+                Id = Guid.NewGuid(),
+                AccountId = Guid.NewGuid(),
+                FunctionId = Guid.NewGuid(),
+                Name = "hourly",
+                CronExpression = "0 * * * *",
+                NextRunAt = fireTime
+            });
+
+            // The second instance read the trigger before the first moved it on
+            var staleRepository = new Mock<IScheduleTriggerRepository>();
+            staleRepository.Setup(r => r.GetDueAsync(It.IsAny<DateTime>())).ReturnsAsync(new[]
+            {
+                new ScheduleTrigger { Id = trigger.Id, AccountId = trigger.AccountId, FunctionId = trigger.FunctionId, CronExpression = trigger.CronExpression, NextRunAt = fireTime }
+            });
+            staleRepository.Setup(r => r.GetByIdAsync(trigger.Id)).Returns(() => _triggerRepository.GetByIdAsync(trigger.Id));
+            staleRepository.Setup(r => r.UpdateAsync(It.IsAny<ScheduleTrigger>())).Returns((ScheduleTrigger t) => _triggerRepository.UpdateAsync(t));
+
+            var first = CreateInstance(_triggerRepository);
+            var second = CreateInstance(staleRepository.Object);
+
+            // Act
+            var firstRuns = await first.FireDueTriggersAsync();
+            var secondRuns = await second.FireDueTriggersAsync();
+
+            // Assert
+            Assert.Equal(1, firstRuns);
+            Assert.Equal(0, secondRuns);
+            _functionService.Verify(s => s.ExecuteAsync(trigger.FunctionId, It.IsAny<Dictionary<string, object>>()), Times.Once);
+
+            var stored = await _triggerRepository.GetByIdAsync(trigger.Id);
+            Assert.Equal(1, stored.RunCount);
+            Assert.Equal(fireTime, stored.LastRunAt);
+            Assert.True(stored.NextRunAt > DateTime.UtcNow);
+        }
+
+        [Fact]
+        public void OrderFairly_OneAccountWithManyDueTriggers_InterleavesAccounts()
+        {
+            // Arrange
+            var busy = Guid.NewGuid();
+            var quiet = Guid.NewGuid();
+            var start = DateTime.UtcNow.AddMinutes(-10);
+            var due = Enumerable.Range(0, 3)
+                .Select(i => new ScheduleTrigger { Id = Guid.NewGuid(), AccountId = busy, NextRunAt = start.AddSeconds(i) })
+                .Append(new ScheduleTrigger { Id = Guid.NewGuid(), AccountId = quiet, NextRunAt = start.AddMinutes(1) })
+                .ToList();
+
+            // Act
+            var ordered = ScheduleTriggerService.OrderFairly(due);
+
+            // Assert
+            Assert.Equal(new[] { busy, quiet, busy, busy }, ordered.Select(t => t.AccountId));
+            Assert.Equal(due[0].Id, ordered[0].Id);
+        }
+
+        [Fact]
+        public async Task FireDueTriggersAsync_SeveralDueTriggers_RunsAllAndRecordsLateness()
+        {
+            // Arrange
+            var fireTime = DateTime.UtcNow.AddSeconds(-30);
+            for (var i = 0; i < 5; i++)
+            {
+                await _triggerRepository.CreateAsync(new ScheduleTrigger
+                {
+                    Id = Guid.NewGuid(),
+                    AccountId = Guid.NewGuid(),
+                    FunctionId = Guid.NewGuid(),
+                    Name = $"trigger-{i}",
+                    CronExpression = "* * * * *",
+                    NextRunAt = fireTime
+                });
+            }
+
+            var service = CreateInstance(_triggerRepository);
+
+            // Act
+            var runs = await service.FireDueTriggersAsync();
+            var lateness = await service.GetLatenessAsync();
+
+            // Assert
+            Assert.Equal(5, runs);
+            Assert.Equal(5, lateness.TotalRuns);
+            Assert.Equal(5, lateness.LastPollDueCount);
+            Assert.True(lateness.P50Ms >= 30000);
+            Assert.True(lateness.MaxMs >= lateness.P95Ms);
+        }
+
+        [Fact]
+        public async Task CreateAsync_InvalidCronExpression_ThrowsValidationException()
+        {
+            // Arrange
+            var service = CreateInstance(_triggerRepository);
+
+            // Act & Assert
+            await Assert.ThrowsAsync<ValidationException>(() => service.CreateAsync(new ScheduleTrigger
+            {
+                AccountId = Guid.NewGuid(),
+                FunctionId = Guid.NewGuid(),
+                Name = "broken",
+                CronExpression = "61 * * * *"
+            }));
+        }
+
+        [Fact]
+        public async Task UpdateAsync_StaleRevision_ThrowsConcurrencyConflictException()
+        {
+            // Arrange
+            var accountId = Guid.NewGuid();
+            var functionId = Guid.NewGuid();
+            _functionService.Setup(f => f.GetByIdAsync(functionId)).ReturnsAsync(new Function { Id = functionId, AccountId = accountId });
+
+            var service = CreateInstance(_triggerRepository);
+            var created = await service.CreateAsync(new ScheduleTrigger
+            {
+                AccountId = accountId,
+                FunctionId = functionId,
+                Name = "hourly",
+                CronExpression = "0 * * * *"
+            });
+
+            // Two clients both read the trigger at its first revision
+            var revision = created.Revision;
+            ScheduleTrigger Edit(string cronExpression) => new ScheduleTrigger
+            {
+                Id = created.Id,
+                AccountId = accountId,
+                FunctionId = functionId,
+                Name = "hourly",
+                CronExpression = cronExpression,
+                Revision = revision
+            };
+
+            // Act
+            var first = await service.UpdateAsync(Edit("30 * * * *"));
+            var conflict = await Assert.ThrowsAsync<ConcurrencyConflictException>(() => service.UpdateAsync(Edit("15 * * * *")));
+
+            // Assert
+            Assert.Equal(1, first.Revision);
+            Assert.Equal(1, conflict.CurrentRevision);
+            Assert.Equal("30 * * * *", (await service.GetByIdAsync(created.Id)).CronExpression);
+        }
+    }
+}
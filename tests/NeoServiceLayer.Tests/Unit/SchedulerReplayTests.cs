@@ -0,0 +1,105 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Scheduling;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class SchedulerReplayTests
+    {
+        private static readonly DateTime From = new DateTime(2024, 1, 31, 10, 0, 0, DateTimeKind.Utc);
+
+        private readonly ScheduleTrigger _trigger = new ScheduleTrigger
+        {
+            Id = Guid.NewGuid(),
+            AccountId = Guid.NewGuid(),
+            Name = "quarter-hourly",
+            CronExpression = "*/15 * * * *",
+            Enabled = true,
+            CreatedAt = From.AddDays(-1)
+        };
+
+        private long _sequence;
+
+        [Fact]
+        public void Replay_ClassifiesEachFireTime()
+        {
+            // Arrange
+            var decisions = new List<SchedulerDecision>
+            {
+                // 10:00 ran once
+                Decision(SchedulerDecisionKind.Due, From.AddSeconds(3), From),
+                Decision(SchedulerDecisionKind.Fire, From.AddSeconds(3), From),
+                Decision(SchedulerDecisionKind.Reschedule, From.AddSeconds(4), From, From.AddMinutes(15), "ran"),
+
+                // 10:15 ran on two instances after the first one's claim was taken as abandoned
+                Decision(SchedulerDecisionKind.Fire, From.AddMinutes(15).AddSeconds(2), From.AddMinutes(15)),
+                Decision(SchedulerDecisionKind.Fire, From.AddMinutes(20).AddSeconds(5), From.AddMinutes(15), instanceId: "b"),
+                Decision(SchedulerDecisionKind.Reschedule, From.AddMinutes(20).AddSeconds(6), From.AddMinutes(15), From.AddMinutes(30), "ran", "b"),
+
+                // 10:30 was found due and then nothing happened; 10:45 was never seen
+                Decision(SchedulerDecisionKind.Due, From.AddMinutes(30).AddSeconds(1), From.AddMinutes(30)),
+
+                // 11:00 and 11:15 were missed during an outage and folded into the 10:30 run at 11:20
+                Decision(SchedulerDecisionKind.Fire, From.AddMinutes(80), From.AddMinutes(30)),
+                Decision(SchedulerDecisionKind.Reschedule, From.AddMinutes(80), From.AddMinutes(30), From.AddMinutes(90), SchedulerReplayLog.CoalescedReason)
+            };
+
+            // Act
+            var report = SchedulerReplay.Replay(new[] { _trigger }, decisions, From, From.AddMinutes(90));
+
+            // Assert
+            var outcomes = report.Triggers.Single().FireTimes.ToDictionary(f => f.ScheduledFor, f => f.Outcome);
+            Assert.Equal(SchedulerReplayOutcome.Fired, outcomes[From]);
+            Assert.Equal(SchedulerReplayOutcome.Duplicate, outcomes[From.AddMinutes(15)]);
+            Assert.Equal(SchedulerReplayOutcome.Fired, outcomes[From.AddMinutes(30)]);
+            Assert.Equal(SchedulerReplayOutcome.Coalesced, outcomes[From.AddMinutes(45)]);
+            Assert.Equal(SchedulerReplayOutcome.Coalesced, outcomes[From.AddMinutes(60)]);
+            Assert.Equal(SchedulerReplayOutcome.Coalesced, outcomes[From.AddMinutes(75)]);
+            Assert.Equal(1, report.Duplicates);
+            Assert.Equal(0, report.Missed);
+        }
+
+        [Fact]
+        public void Replay_UnexplainedFireTime_IsMissed()
+        {
+            // Arrange
+            var decisions = new List<SchedulerDecision>
+            {
+                Decision(SchedulerDecisionKind.Due, From.AddSeconds(2), From),
+                Decision(SchedulerDecisionKind.Skip, From.AddMinutes(15).AddSeconds(2), From.AddMinutes(15), reason: "maintenance active")
+            };
+
+            // Act
+            var report = SchedulerReplay.Replay(new[] { _trigger }, decisions, From, From.AddMinutes(30));
+
+            // Assert
+            var fireTimes = report.Triggers.Single().FireTimes;
+            Assert.Equal(SchedulerReplayOutcome.Missed, fireTimes[0].Outcome);
+            Assert.Equal(From.AddSeconds(2), fireTimes[0].FirstDueAt);
+            Assert.Equal(SchedulerReplayOutcome.Skipped, fireTimes[1].Outcome);
+            Assert.Contains("maintenance active", fireTimes[1].Reason);
+            Assert.Equal(1, report.Missed);
+        }
+
+        private SchedulerDecision Decision(
+            SchedulerDecisionKind kind, DateTime at, DateTime? scheduledFor, DateTime? nextRunAt = null, string reason = null, string instanceId = "a")
+        {
+            return new SchedulerDecision
+            {
+                Id = Guid.NewGuid(),
+                InstanceId = instanceId,
+                Sequence = ++_sequence,
+                At = at,
+                Kind = kind,
+                TriggerId = _trigger.Id,
+                AccountId = _trigger.AccountId,
+                ScheduledFor = scheduledFor,
+                NextRunAt = nextRunAt,
+                Reason = reason
+            };
+        }
+    }
+}
@@ -0,0 +1,81 @@
+using System;
+using System.Collections.Generic;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Secrets;
+using Xunit;
+using FunctionRepositories = NeoServiceLayer.Services.Function.Repositories;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class SecretGrantServiceTests
+    {
+        private readonly Mock<ISecretGrantRepository> _grantRepositoryMock;
+        private readonly Mock<ISecretsService> _secretsServiceMock;
+        private readonly Mock<IAccountService> _accountServiceMock;
+        private readonly SecretGrantService _service;
+
+        public SecretGrantServiceTests()
+        {
+            _grantRepositoryMock = new Mock<ISecretGrantRepository>();
+            _secretsServiceMock = new Mock<ISecretsService>();
+            _accountServiceMock = new Mock<IAccountService>();
+
+            _service = new SecretGrantService(
+                new Mock<ILogger<SecretGrantService>>().Object,
+                _grantRepositoryMock.Object,
+                _secretsServiceMock.Object,
+                new Mock<FunctionRepositories.IFunctionRepository>().Object,
+                _accountServiceMock.Object);
+        }
+
+        [Fact]
+        public async Task CanFunctionReadAsync_OnlyActiveAccountGrantsCount()
+        {
+            // Arrange
+            var secret = new Secret { Id = Guid.NewGuid(), AccountId = Guid.NewGuid(), AllowedFunctionIds = new List<Guid>() };
+            var function = new Function { Id = Guid.NewGuid(), AccountId = Guid.NewGuid() };
+            var grant = new SecretGrant
+            {
+                Id = Guid.NewGuid(),
+                SecretId = secret.Id,
+                OwnerAccountId = secret.AccountId,
+                GranteeType = SecretGranteeType.Account,
+                GranteeAccountId = function.AccountId,
+                ExpiresAt = DateTime.UtcNow.AddHours(1)
+            };
+
+            _grantRepositoryMock
+                .Setup(x => x.GetBySecretIdAsync(secret.Id))
+                .ReturnsAsync(() => new List<SecretGrant> { grant });
+
+            // Act
+            var allowed = await _service.CanFunctionReadAsync(secret, function);
+            grant.ExpiresAt = DateTime.UtcNow.AddMinutes(-1);
+            var allowedAfterExpiry = await _service.CanFunctionReadAsync(secret, function);
+
+            // Assert
+            Assert.True(allowed);
+            Assert.False(allowedAfterExpiry);
+        }
+
+        [Fact]
+        public async Task GrantToAddressAsync_OwnerAddress_ThrowsValidationException()
+        {
+            // Arrange
+            var owner = new Account { Id = Guid.NewGuid(), NeoAddress = "NZNovaRQm8DH5nVuaLjMh1Pr5TnbvQ2VWg" };
+            var secret = new Secret { Id = Guid.NewGuid(), Name = "API Key", AccountId = owner.Id };
+
+            _secretsServiceMock.Setup(x => x.GetByIdAsync(secret.Id)).ReturnsAsync(secret);
+            _accountServiceMock.Setup(x => x.GetByNeoAddressAsync(owner.NeoAddress)).ReturnsAsync(owner);
+
+            // Act & Assert
+            await Assert.ThrowsAsync<ValidationException>(() => _service.GrantToAddressAsync(secret.Id, owner.NeoAddress));
+            _grantRepositoryMock.Verify(x => x.CreateAsync(It.IsAny<SecretGrant>()), Times.Never);
+        }
+    }
+}
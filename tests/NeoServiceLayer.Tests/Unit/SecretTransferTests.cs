@@ -0,0 +1,161 @@
+using System;
+using System.Collections.Generic;
+using System.Security.Cryptography;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Utilities;
+using NeoServiceLayer.Enclave.Enclave.Services;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class SecretTransferTests
+    {
+        private readonly EnclaveSecretsService _enclave;
+
+        public SecretTransferTests()
+        {
+            _enclave = new EnclaveSecretsService(new Mock<ILogger<EnclaveSecretsService>>().Object);
+        }
+
+        [Fact]
+        public async Task ExportThenImport_SealsValueUnderTargetSecretAndAccount()
+        {
+            // Arrange
+            var sourceAccountId = Guid.NewGuid();
+            var targetAccountId = Guid.NewGuid();
+            var targetSecretId = Guid.NewGuid();
+            var secret = await CreateSecretAsync(sourceAccountId, "API Key", "s3cr3t");
+            var key = await SendAsync<SecretTransferKey>(Constants.SecretsOperations.GetTransferKey, new { });
+
+            // Act
+            var bundle = await SendAsync<SecretTransferBundle>(Constants.SecretsOperations.ExportSecrets, new SecretExportRequest
+            {
+                AccountId = sourceAccountId,
+                RecipientPublicKey = key.PublicKey,
+                Secrets = new List<Secret> { secret }
+            });
+            var entries = await SendAsync<List<SecretImportEntry>>(Constants.SecretsOperations.ImportSecrets, new SecretImportRequest
+            {
+                AccountId = targetAccountId,
+                Bundle = bundle,
+                SecretIds = new Dictionary<string, Guid> { ["API Key"] = targetSecretId }
+            });
+
+            // Assert
+            Assert.Equal(key.KeyId, bundle.RecipientKeyId);
+            Assert.DoesNotContain("s3cr3t", JsonUtility.Serialize(bundle));
+            var entry = Assert.Single(entries);
+            Assert.Equal(targetSecretId, entry.SecretId);
+            Assert.Equal("s3cr3t", _enclave.UnsealSecretValue(entry.EncryptedValue, targetSecretId, targetAccountId));
+        }
+
+        [Fact]
+        public async Task Import_RenamedBundle_IsRejected()
+        {
+            // Arrange
+            var accountId = Guid.NewGuid();
+            var secret = await CreateSecretAsync(accountId, "API Key", "s3cr3t");
+            var key = await SendAsync<SecretTransferKey>(Constants.SecretsOperations.GetTransferKey, new { });
+            var bundle = await SendAsync<SecretTransferBundle>(Constants.SecretsOperations.ExportSecrets, new SecretExportRequest
+            {
+                AccountId = accountId,
+                RecipientPublicKey = key.PublicKey,
+                Secrets = new List<Secret> { secret }
+            });
+            bundle.Names = new List<string> { "Other Key" };
+
+            // Act & Assert
+            await Assert.ThrowsAsync<InvalidOperationException>(() => SendAsync<List<SecretImportEntry>>(
+                Constants.SecretsOperations.ImportSecrets,
+                new SecretImportRequest
+                {
+                    AccountId = accountId,
+                    Bundle = bundle,
+                    SecretIds = new Dictionary<string, Guid> { ["API Key"] = Guid.NewGuid(), ["Other Key"] = Guid.NewGuid() }
+                }));
+        }
+
+        [Fact]
+        public async Task Export_UntrustedRecipientKey_IsRejected()
+        {
+            // Arrange
+            var accountId = Guid.NewGuid();
+            var secret = await CreateSecretAsync(accountId, "API Key", "s3cr3t");
+            using var attackerKey = ECDiffieHellman.Create(ECCurve.NamedCurves.nistP256);
+
+            // Act & Assert
+            await Assert.ThrowsAsync<InvalidOperationException>(() => SendAsync<SecretTransferBundle>(
+                Constants.SecretsOperations.ExportSecrets,
+                new SecretExportRequest
+                {
+                    AccountId = accountId,
+                    RecipientPublicKey = Convert.ToBase64String(attackerKey.ExportSubjectPublicKeyInfo()),
+                    Secrets = new List<Secret> { secret }
+                }));
+        }
+
+        [Fact]
+        public async Task Export_ToTrustedEnvironment_ImportsAfterTheTargetRestarts()
+        {
+            // Arrange
+            var logger = new Mock<ILogger<EnclaveSecretsService>>().Object;
+            var productionSealing = Options.Create(new Enclave.Enclave.Models.EnclaveSealingOptions
+            {
+                MasterKey = Convert.ToBase64String(RandomNumberGenerator.GetBytes(32))
+            });
+            var production = new EnclaveSecretsService(logger, productionSealing);
+            var productionKey = await SendAsync<SecretTransferKey>(production, Constants.SecretsOperations.GetTransferKey, new { });
+            var staging = new EnclaveSecretsService(logger, null, Options.Create(new Enclave.Enclave.Models.EnclaveSecretTransferOptions
+            {
+                TrustedKeys = new List<Enclave.Enclave.Models.TrustedTransferKey>
+                {
+                    new Enclave.Enclave.Models.TrustedTransferKey { Name = "production", PublicKey = productionKey.PublicKey }
+                }
+            }));
+            var accountId = Guid.NewGuid();
+            var secretId = Guid.NewGuid();
+            var secret = await SendAsync<Secret>(staging, Constants.SecretsOperations.CreateSecret, new { Name = "API Key", Value = "s3cr3t", AccountId = accountId });
+
+            // Act
+            var bundle = await SendAsync<SecretTransferBundle>(staging, Constants.SecretsOperations.ExportSecrets, new SecretExportRequest
+            {
+                AccountId = accountId,
+                RecipientPublicKey = productionKey.PublicKey,
+                Secrets = new List<Secret> { secret }
+            });
+            var restartedProduction = new EnclaveSecretsService(logger, productionSealing);
+            var entries = await SendAsync<List<SecretImportEntry>>(restartedProduction, Constants.SecretsOperations.ImportSecrets, new SecretImportRequest
+            {
+                AccountId = accountId,
+                Bundle = bundle,
+                SecretIds = new Dictionary<string, Guid> { ["API Key"] = secretId }
+            });
+
+            // Assert
+            Assert.Equal(productionKey.KeyId, bundle.RecipientKeyId);
+            var entry = Assert.Single(entries);
+            Assert.Equal("s3cr3t", restartedProduction.UnsealSecretValue(entry.EncryptedValue, secretId, accountId));
+        }
+
+        private Task<Secret> CreateSecretAsync(Guid accountId, string name, string value)
+        {
+            return SendAsync<Secret>(Constants.SecretsOperations.CreateSecret, new { Name = name, Value = value, AccountId = accountId });
+        }
+
+        private Task<T> SendAsync<T>(string operation, object request)
+        {
+            return SendAsync<T>(_enclave, operation, request);
+        }
+
+        private static async Task<T> SendAsync<T>(EnclaveSecretsService enclave, string operation, object request)
+        {
+            var response = await enclave.HandleRequestAsync(operation, JsonUtility.SerializeToUtf8Bytes(request));
+            return JsonUtility.Deserialize<T>(response);
+        }
+    }
+}
@@ -422,5 +422,84 @@ namespace NeoServiceLayer.Tests.Unit
                     It.IsAny<object>()),
                 Times.Never);
         }
+
+        [Fact]
+        public async Task BulkUpsertAsync_InvalidItem_AppliesNothing()
+        {
+            // Arrange
+            var accountId = Guid.NewGuid();
+            var items = new List<BulkSecretItem>
+            {
+                new BulkSecretItem { Name = "ValidSecret", Value = "value" },
+                new BulkSecretItem { Name = "EmptySecret", Value = "" }
+            };
+
+            _secretsRepositoryMock
+                .Setup(x => x.GetByAccountIdAsync(accountId))
+                .ReturnsAsync(new List<Secret>());
+
+            // Act
+            var result = await _secretsService.BulkUpsertAsync(items, accountId);
+
+            // Assert
+            Assert.False(result.Success);
+            Assert.False(result.RolledBack);
+            Assert.All(result.Items, i => Assert.False(i.Success));
+            Assert.Equal("Value is required", result.Items[1].Error);
+            _secretsRepositoryMock.Verify(x => x.CreateAsync(It.IsAny<Secret>()), Times.Never);
+        }
+
+        [Fact]
+        public async Task BulkUpsertAsync_ApplyFails_RollsBackCreatedSecrets()
+        {
+            // Arrange
+            var accountId = Guid.NewGuid();
+            var firstSecret = new Secret { Id = Guid.NewGuid(), Name = "FirstSecret", AccountId = accountId, Version = 1 };
+            var items = new List<BulkSecretItem>
+            {
+                new BulkSecretItem { Name = "FirstSecret", Value = "value1" },
+                new BulkSecretItem { Name = "SecondSecret", Value = "value2" }
+            };
+
+            _secretsRepositoryMock
+                .Setup(x => x.GetByAccountIdAsync(accountId))
+                .ReturnsAsync(new List<Secret>());
+
+            _enclaveServiceMock
+                .SetupSequence(x => x.SendRequestAsync<SecretCreateRequest, Secret>(
+                    Core.Constants.EnclaveServiceTypes.Secrets,
+                    Core.Constants.SecretsOperations.CreateSecret,
+                    It.IsAny<SecretCreateRequest>()))
+                .ReturnsAsync(firstSecret)
+                .ThrowsAsync(new Exception("Enclave unavailable"));
+
+            _secretsRepositoryMock
+                .Setup(x => x.CreateAsync(It.IsAny<Secret>()))
+                .ReturnsAsync((Secret s) => s);
+
+            // Act
+            var result = await _secretsService.BulkUpsertAsync(items, accountId);
+
+            // Assert
+            Assert.False(result.Success);
+            Assert.True(result.RolledBack);
+            Assert.Null(result.Items[0].SecretId);
+            Assert.Equal("Error applying secret", result.Items[1].Error);
+            _secretsRepositoryMock.Verify(x => x.DeleteAsync(firstSecret.Id), Times.Once);
+        }
+
+        [Fact]
+        public async Task BulkUpsertAsync_TooManyItems_ThrowsSecretsException()
+        {
+            // Arrange
+            var items = new List<BulkSecretItem>();
+            for (var i = 0; i <= Core.Constants.SecretsConfig.MaxBulkItems; i++)
+            {
+                items.Add(new BulkSecretItem { Name = $"Secret{i}", Value = "value" });
+            }
+
+            // Act & Assert
+            await Assert.ThrowsAsync<SecretsException>(() => _secretsService.BulkUpsertAsync(items, Guid.NewGuid()));
+        }
     }
 }
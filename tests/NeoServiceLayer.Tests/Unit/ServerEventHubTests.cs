@@ -0,0 +1,67 @@
+using System;
+using System.Collections.Generic;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Events;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ServerEventHubTests
+    {
+        private readonly ServerEventHub _hub = new ServerEventHub(
+            new Mock<ILogger<ServerEventHub>>().Object,
+            Options.Create(new ServerEventConfiguration { BufferSize = 2 }));
+
+        private static List<ServerEvent> Drain(Core.Interfaces.IServerEventSubscription subscription)
+        {
+            var events = new List<ServerEvent>();
+            while (subscription.Events.TryRead(out var serverEvent))
+            {
+                events.Add(serverEvent);
+            }
+
+            return events;
+        }
+
+        [Fact]
+        public void Publish_OtherAccountOrTopic_IsNotDelivered()
+        {
+            // Arrange
+            var accountId = Guid.NewGuid();
+            using var subscription = _hub.Subscribe(accountId, false);
+            subscription.Subscribe(new[] { ServerEventTopics.Executions, ServerEventTopics.Prices });
+
+            // Act
+            _hub.Publish(new ServerEvent { Topic = ServerEventTopics.Executions, AccountId = Guid.NewGuid() });
+            _hub.Publish(new ServerEvent { Topic = ServerEventTopics.GasBank, AccountId = accountId });
+            _hub.Publish(new ServerEvent { Topic = ServerEventTopics.Executions, AccountId = accountId, Type = "completed" });
+            _hub.Publish(new ServerEvent { Topic = ServerEventTopics.Prices, Type = "updated" });
+
+            // Assert
+            var events = Drain(subscription);
+            Assert.Equal(new[] { "completed", "updated" }, events.ConvertAll(e => e.Type));
+        }
+
+        [Fact]
+        public void Publish_SlowSubscriber_DropsOldestAndCountsThem()
+        {
+            // Arrange
+            using var subscription = _hub.Subscribe(Guid.NewGuid(), false);
+            subscription.Subscribe(new[] { ServerEventTopics.Prices });
+
+            // Act
+            for (var i = 0; i < 5; i++)
+            {
+                _hub.Publish(new ServerEvent { Topic = ServerEventTopics.Prices, Type = i.ToString() });
+            }
+
+            // Assert
+            Assert.Equal(new[] { "3", "4" }, Drain(subscription).ConvertAll(e => e.Type));
+            Assert.Equal(3, subscription.TakeDroppedCount());
+            Assert.Equal(0, subscription.TakeDroppedCount());
+        }
+    }
+}
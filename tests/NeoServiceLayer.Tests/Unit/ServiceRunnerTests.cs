@@ -0,0 +1,82 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Lifecycle;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class ServiceRunnerTests
+    {
+        private readonly List<string> _calls = new List<string>();
+
+        [Fact]
+        public async Task StopAllAsync_StopsInReverseDependencyOrderAndReportsTimeouts()
+        {
+            // Arrange
+            var automation = CreateService("Automation", new[] { "Leadership", "GasBank" });
+            var leadership = CreateService("Leadership");
+            var gasBank = CreateService("GasBank");
+            var scheduler = CreateService("Scheduler", new[] { "Leadership" }, stop: new TaskCompletionSource<bool>().Task);
+
+            var runner = new ServiceRunner(
+                new Mock<ILogger<ServiceRunner>>().Object,
+                new[] { automation.Object, leadership.Object, scheduler.Object, gasBank.Object },
+                Options.Create(new ServiceRunnerConfiguration
+                {
+                    StopTimeoutSeconds = 5,
+                    ServiceStopTimeouts = new Dictionary<string, int> { ["Scheduler"] = 1 }
+                }));
+
+            // Act
+            await runner.StartAllAsync();
+            var report = await runner.StopAllAsync();
+
+            // Assert
+            Assert.Equal(
+                new[]
+                {
+                    "start Leadership", "start Scheduler", "start GasBank", "start Automation",
+                    "stop Automation", "stop GasBank", "stop Scheduler", "stop Leadership"
+                },
+                _calls);
+            Assert.Equal(new[] { "Automation", "GasBank", "Scheduler", "Leadership" }, report.Services.Select(s => s.Name));
+            Assert.Equal(ServiceStopOutcome.TimedOut, report.Services.Single(s => s.Name == "Scheduler").Outcome);
+            Assert.All(report.Services.Where(s => s.Name != "Scheduler"), s => Assert.Equal(ServiceStopOutcome.Stopped, s.Outcome));
+            Assert.False(report.Succeeded);
+        }
+
+        [Fact]
+        public void Constructor_DependencyCycle_Throws()
+        {
+            // Arrange
+            var services = new[]
+            {
+                CreateService("Automation", new[] { "GasBank" }).Object,
+                CreateService("GasBank", new[] { "Automation" }).Object
+            };
+
+            // Act & Assert
+            Assert.Throws<InvalidOperationException>(() => new ServiceRunner(
+                new Mock<ILogger<ServiceRunner>>().Object,
+                services,
+                Options.Create(new ServiceRunnerConfiguration())));
+        }
+
+        private Mock<IManagedService> CreateService(string name, string[] dependsOn = null, Task stop = null)
+        {
+            var service = new Mock<IManagedService>();
+            service.Setup(x => x.Name).Returns(name);
+            service.Setup(x => x.DependsOn).Returns(dependsOn ?? Array.Empty<string>());
+            service.Setup(x => x.StartAsync()).Callback(() => _calls.Add($"start {name}")).Returns(Task.CompletedTask);
+            service.Setup(x => x.StopAsync()).Callback(() => _calls.Add($"stop {name}")).Returns(stop ?? Task.CompletedTask);
+            return service;
+        }
+    }
+}
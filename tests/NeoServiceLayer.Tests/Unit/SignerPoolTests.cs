@@ -0,0 +1,98 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.DependencyInjection;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Wallet;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class SignerPoolTests
+    {
+        private readonly List<Wallet> _wallets = new List<Wallet>
+        {
+            new Wallet { Id = Guid.NewGuid(), Address = "NAddress1", IsServiceWallet = true },
+            new Wallet { Id = Guid.NewGuid(), Address = "NAddress2", IsServiceWallet = true }
+        };
+
+        private readonly Dictionary<string, decimal> _balances = new Dictionary<string, decimal>
+        {
+            ["NAddress1"] = 10,
+            ["NAddress2"] = 10
+        };
+
+        private SignerPool CreatePool(string strategy = SignerPoolStrategies.LeastRecentlyUsed)
+        {
+            var walletService = new Mock<IWalletService>();
+            walletService.Setup(w => w.GetServiceWalletsAsync()).ReturnsAsync(() => _wallets);
+            walletService.Setup(w => w.GetGasBalanceAsync(It.IsAny<string>())).ReturnsAsync((string address) => _balances[address]);
+
+            var serviceProvider = new Mock<IServiceProvider>();
+            serviceProvider.Setup(p => p.GetService(typeof(IWalletService))).Returns(walletService.Object);
+            var scope = new Mock<IServiceScope>();
+            scope.Setup(s => s.ServiceProvider).Returns(serviceProvider.Object);
+            var scopeFactory = new Mock<IServiceScopeFactory>();
+            scopeFactory.Setup(f => f.CreateScope()).Returns(scope.Object);
+
+            return new SignerPool(
+                new Mock<ILogger<SignerPool>>().Object,
+                scopeFactory.Object,
+                Options.Create(new SignerPoolConfiguration { Enabled = true, Strategy = strategy, MaxPendingTransactions = 1 }));
+        }
+
+        [Fact]
+        public async Task AcquireAsync_SignerWithPendingTransaction_PicksAnotherSigner()
+        {
+            // Arrange
+            var pool = CreatePool();
+            var first = await pool.AcquireAsync(1);
+            pool.Complete(first, "0x01");
+
+            // Act
+            var second = await pool.AcquireAsync(1);
+            var third = await pool.AcquireAsync(1);
+
+            // Assert
+            Assert.NotEqual(first.WalletId, second.WalletId);
+            Assert.Null(third);
+        }
+
+        [Fact]
+        public async Task AcquireAsync_BalanceBelowFeeAndMinimum_SkipsSigner()
+        {
+            // Arrange
+            _balances["NAddress1"] = 1.5m;
+            var pool = CreatePool();
+
+            // Act
+            var lease = await pool.AcquireAsync(1);
+
+            // Assert
+            Assert.Equal(_wallets[1].Id, lease.WalletId);
+        }
+
+        [Fact]
+        public async Task Fail_BacksOffSigner()
+        {
+            // Arrange
+            var pool = CreatePool(SignerPoolStrategies.RoundRobin);
+            var lease = await pool.AcquireAsync(1);
+
+            // Act
+            pool.Fail(lease, "RPC timeout");
+
+            // Assert
+            var status = (await pool.GetStatusAsync()).Single(s => s.WalletId == lease.WalletId);
+            Assert.False(status.IsAvailable);
+            Assert.Equal(1, status.ConsecutiveFailures);
+            Assert.Equal(0, status.PendingTransactions);
+            Assert.NotEqual(lease.WalletId, (await pool.AcquireAsync(1)).WalletId);
+        }
+    }
+}
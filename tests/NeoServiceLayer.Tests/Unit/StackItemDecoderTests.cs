@@ -0,0 +1,62 @@
+using System;
+using System.Collections.Generic;
+using System.Text;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Core.Utilities;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class StackItemDecoderTests
+    {
+        [Theory]
+        [InlineData("150000000", 8, "1.5")]
+        [InlineData("-1", 8, "-0.00000001")]
+        [InlineData("200000000", 8, "2")]
+        [InlineData("123456789012345678901234567890", 0, "123456789012345678901234567890")]
+        public void FormatInteger_AppliesDecimals(string value, int decimals, string expected)
+        {
+            // Act & Assert
+            Assert.Equal(expected, StackItemDecoder.FormatInteger(value, decimals));
+        }
+
+        [Fact]
+        public void Decode_MapOfMixedItems_DecodesRecursively()
+        {
+            // Arrange
+            var hash = new byte[20];
+            hash[0] = 0xab;
+            var map = new NeoStackItem
+            {
+                Type = "Map",
+                Items = new List<NeoStackItem>
+                {
+                    Entry(Bytes(Encoding.UTF8.GetBytes("owner")), Bytes(hash)),
+                    Entry(Bytes(Encoding.UTF8.GetBytes("flags")), new NeoStackItem
+                    {
+                        Type = "Array",
+                        Items = new List<NeoStackItem>
+                        {
+                            new NeoStackItem { Type = "Boolean", Value = "true" },
+                            Bytes(new byte[] { 0x00, 0xff })
+                        }
+                    })
+                }
+            };
+
+            // Act
+            var decoded = Assert.IsType<Dictionary<string, object>>(StackItemDecoder.Decode(map));
+
+            // Assert
+            Assert.Equal("0x" + new string('0', 38) + "ab", decoded["owner"]);
+            var flags = Assert.IsType<List<object>>(decoded["flags"]);
+            Assert.Equal(true, flags[0]);
+            Assert.Equal("00ff", flags[1]);
+        }
+
+        private static NeoStackItem Bytes(byte[] value) => new NeoStackItem { Type = "ByteString", Value = Convert.ToBase64String(value) };
+
+        private static NeoStackItem Entry(NeoStackItem key, NeoStackItem value) =>
+            new NeoStackItem { Type = "Struct", Items = new List<NeoStackItem> { key, value } };
+    }
+}
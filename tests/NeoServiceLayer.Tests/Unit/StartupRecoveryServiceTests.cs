@@ -0,0 +1,65 @@
+using System;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Recovery;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class StartupRecoveryServiceTests
+    {
+        private readonly DateTime _processStartTime = DateTime.UtcNow.AddMinutes(-1);
+        private readonly Mock<IStartupRecoveryStep> _step = new Mock<IStartupRecoveryStep>();
+        private readonly Mock<ILeadershipService> _leadership = new Mock<ILeadershipService>();
+
+        public StartupRecoveryServiceTests()
+        {
+            _step.Setup(s => s.Name).Returns("FunctionExecutions");
+            _step.Setup(s => s.RecoverAsync(_processStartTime)).ReturnsAsync(new StartupRecoveryStepResult { Resolved = 1 });
+            _leadership.Setup(l => l.InstanceId).Returns("standby");
+        }
+
+        private StartupRecoveryService CreateService()
+        {
+            return new StartupRecoveryService(
+                new Mock<ILogger<StartupRecoveryService>>().Object,
+                new[] { _step.Object },
+                _processStartTime,
+                _leadership.Object);
+        }
+
+        [Fact]
+        public async Task RecoverAsync_Standby_LeavesTheLeadersWorkAlone()
+        {
+            // Arrange
+            _leadership.Setup(l => l.IsLeader).Returns(false);
+
+            // Act
+            var report = await CreateService().RecoverAsync();
+
+            // Assert
+            Assert.True(report.Skipped);
+            Assert.Empty(report.Steps);
+            _step.Verify(s => s.RecoverAsync(It.IsAny<DateTime>()), Times.Never);
+        }
+
+        [Fact]
+        public async Task RecoverAsync_Leader_RunsEveryStep()
+        {
+            // Arrange
+            _leadership.Setup(l => l.IsLeader).Returns(true);
+
+            // Act
+            var report = await CreateService().RecoverAsync();
+
+            // Assert
+            Assert.False(report.Skipped);
+            var step = Assert.Single(report.Steps);
+            Assert.Equal("FunctionExecutions", step.Step);
+            Assert.Equal(1, step.Resolved);
+        }
+    }
+}
@@ -0,0 +1,90 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Microsoft.Extensions.Options;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Core.Models.Blockchain;
+using NeoServiceLayer.Services.Diagnostics;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class SystemVerificationServiceTests
+    {
+        private readonly Mock<INeoRpcClient> _neoRpcClientMock;
+        private readonly Mock<IWalletService> _walletServiceMock;
+        private readonly Mock<IEnclaveService> _enclaveServiceMock;
+        private readonly Mock<IPriceFeedService> _priceFeedServiceMock;
+        private readonly SystemVerificationService _service;
+
+        public SystemVerificationServiceTests()
+        {
+            _neoRpcClientMock = new Mock<INeoRpcClient>();
+            _walletServiceMock = new Mock<IWalletService>();
+            _enclaveServiceMock = new Mock<IEnclaveService>();
+            _priceFeedServiceMock = new Mock<IPriceFeedService>();
+
+            _service = new SystemVerificationService(
+                new Mock<ILogger<SystemVerificationService>>().Object,
+                Options.Create(new SystemVerificationConfiguration { CheckTimeoutSeconds = 5 }),
+                _neoRpcClientMock.Object,
+                _walletServiceMock.Object,
+                _enclaveServiceMock.Object,
+                null,
+                _priceFeedServiceMock.Object);
+        }
+
+        [Fact]
+        public async Task VerifyAsync_ReportsEachCheckAndFailsWhenAnyCheckFails()
+        {
+            // Arrange
+            var healthySource = new PriceSource { Id = Guid.NewGuid(), Name = "Binance" };
+            var downSource = new PriceSource { Id = Guid.NewGuid(), Name = "Kraken" };
+
+            _neoRpcClientMock.Setup(x => x.GetBlockCountAsync()).ReturnsAsync(5_000_000);
+            _neoRpcClientMock.Setup(x => x.GetNodeStatuses()).Returns(new List<NeoRpcNodeStatus>
+            {
+                new NeoRpcNodeStatus { Url = "http://node1:10332", IsHealthy = true },
+                new NeoRpcNodeStatus { Url = "http://node2:10332", IsHealthy = false }
+            });
+            _walletServiceMock.Setup(x => x.GetServiceWalletsAsync()).ReturnsAsync(new List<Wallet>());
+            _enclaveServiceMock.Setup(x => x.GetAttestationDocumentAsync()).ReturnsAsync(Array.Empty<byte>());
+            _priceFeedServiceMock.Setup(x => x.GetActiveSourcesAsync()).ReturnsAsync(new[] { healthySource, downSource });
+            _priceFeedServiceMock
+                .Setup(x => x.FetchPriceFromSourceAsync(healthySource.Id, It.IsAny<string>()))
+                .ReturnsAsync(new[] { new Price { Symbol = "NEO", Value = 12.34m } });
+            _priceFeedServiceMock
+                .Setup(x => x.FetchPriceFromSourceAsync(downSource.Id, It.IsAny<string>()))
+                .ThrowsAsync(new InvalidOperationException("Source timed out"));
+
+            // Act
+            var report = await _service.VerifyAsync();
+
+            // Assert
+            var checks = report.Checks.ToDictionary(c => c.Name);
+            Assert.Equal(SystemVerificationChecks.All, report.Checks.Select(c => c.Name));
+            Assert.Equal(SystemVerificationStatus.Passed, checks[SystemVerificationChecks.Rpc].Status);
+            Assert.Contains("1 of 2 nodes", checks[SystemVerificationChecks.Rpc].Detail);
+            Assert.Equal(SystemVerificationStatus.Skipped, checks[SystemVerificationChecks.Wallet].Status);
+            Assert.Equal(SystemVerificationStatus.Failed, checks[SystemVerificationChecks.Attestation].Status);
+            Assert.Equal(SystemVerificationStatus.Skipped, checks[SystemVerificationChecks.Storage].Status);
+            Assert.Equal(SystemVerificationStatus.Passed, checks[SystemVerificationChecks.Prices].Status);
+            Assert.Contains("Kraken: Source timed out", checks[SystemVerificationChecks.Prices].Detail);
+            Assert.Equal(SystemVerificationStatus.Failed, checks[SystemVerificationChecks.Sandbox].Status);
+            Assert.False(report.Succeeded);
+        }
+
+        [Fact]
+        public async Task VerifyAsync_UnknownCheck_ThrowsValidationException()
+        {
+            // Act & Assert
+            await Assert.ThrowsAsync<ValidationException>(() => _service.VerifyAsync(new[] { "rpc", "disk" }));
+            _neoRpcClientMock.Verify(x => x.GetBlockCountAsync(), Times.Never);
+        }
+    }
+}
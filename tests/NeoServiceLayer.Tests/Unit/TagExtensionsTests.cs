@@ -0,0 +1,46 @@
+using System.Collections.Generic;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Extensions;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class TagExtensionsTests
+    {
+        [Fact]
+        public void NormalizeTags_MixedCaseAndDuplicates_ReturnsDistinctLowerCase()
+        {
+            // Arrange
+            var tags = new List<string> { " Env:Prod ", "env:prod", "", "team-a" };
+
+            // Act
+            var normalized = tags.NormalizeTags();
+
+            // Assert
+            Assert.Equal(new List<string> { "env:prod", "team-a" }, normalized);
+        }
+
+        [Fact]
+        public void NormalizeTags_InvalidCharacters_Throws()
+        {
+            // Arrange
+            var tags = new List<string> { "cost center" };
+
+            // Act & Assert
+            Assert.Throws<ValidationException>(() => tags.NormalizeTags());
+        }
+
+        [Fact]
+        public void HasAllTags_RequiresEveryRequestedTag()
+        {
+            // Arrange
+            var tags = new List<string> { "env:prod", "team-a" };
+
+            // Act & Assert
+            Assert.True(tags.HasAllTags(new[] { "ENV:PROD" }));
+            Assert.True(tags.HasAllTags(new List<string>()));
+            Assert.False(tags.HasAllTags(new[] { "env:prod", "team-b" }));
+            Assert.False(((List<string>)null).HasAllTags(new[] { "env:prod" }));
+        }
+    }
+}
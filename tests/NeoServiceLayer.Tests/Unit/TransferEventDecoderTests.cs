@@ -0,0 +1,66 @@
+using System;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.EventMonitoring;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class TransferEventDecoderTests
+    {
+        private const string ScriptHash = "0xef4073a0f2b305a38ec4050e4d3d28bc40ea63f5";
+
+        private static byte[] LittleEndian(string scriptHash)
+        {
+            var bytes = Convert.FromHexString(scriptHash.Substring(2));
+            Array.Reverse(bytes);
+            return bytes;
+        }
+
+        [Fact]
+        public void Decode_Nep11Transfer_ReturnsNamedParametersWithTokenId()
+        {
+            // Act
+            var eventData = TransferEventDecoder.Decode(new object[] { null, LittleEndian(ScriptHash), 1, new byte[] { 0x0a, 0x0b } });
+
+            // Assert
+            Assert.Null(eventData["from"]);
+            Assert.Equal(ScriptHash, eventData["to"]);
+            Assert.Equal("1", eventData["amount"]);
+            Assert.Equal("0a0b", eventData["tokenId"]);
+            Assert.Equal("NEP-11", eventData["standard"]);
+        }
+
+        [Fact]
+        public void Decode_Nep17Transfer_HasNoTokenId()
+        {
+            // Act
+            var eventData = TransferEventDecoder.Decode(new object[] { LittleEndian(ScriptHash), null, 100 });
+
+            // Assert
+            Assert.Equal(ScriptHash, eventData["from"]);
+            Assert.False(eventData.ContainsKey("tokenId"));
+            Assert.Equal("NEP-17", eventData["standard"]);
+        }
+
+        [Fact]
+        public void MatchesEventName_Nep11Subscription_MatchesOnlyNftTransfers()
+        {
+            // Arrange
+            var nftTransfer = new BlockEvent
+            {
+                EventName = "Transfer",
+                EventData = TransferEventDecoder.Decode(new object[] { null, LittleEndian(ScriptHash), 1, new byte[] { 0x01 } })
+            };
+            var tokenTransfer = new BlockEvent
+            {
+                EventName = "Transfer",
+                EventData = TransferEventDecoder.Decode(new object[] { null, LittleEndian(ScriptHash), 100 })
+            };
+
+            // Act & Assert
+            Assert.True(TransferEventDecoder.MatchesEventName(TransferEventDecoder.Nep11TransferEventName, nftTransfer));
+            Assert.False(TransferEventDecoder.MatchesEventName(TransferEventDecoder.Nep11TransferEventName, tokenTransfer));
+            Assert.True(TransferEventDecoder.MatchesEventName("transfer", tokenTransfer));
+        }
+    }
+}
@@ -0,0 +1,136 @@
+using System;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Scheduling;
+using NeoServiceLayer.Services.Scheduling.Repositories;
+using NeoServiceLayer.Services.Storage.Providers;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class TriggerFiringCoordinatorTests
+    {
+        private static readonly DateTime FireTime = new DateTime(2026, 1, 1, 9, 0, 0, DateTimeKind.Utc);
+
+        private readonly InMemoryStorageProvider _sharedStore = new InMemoryStorageProvider(new Mock<ILogger<InMemoryStorageProvider>>().Object);
+
+        private TriggerFiringCoordinator CreateInstance()
+        {
+            var repository = new TriggerFiringRepository(new Mock<ILogger<TriggerFiringRepository>>().Object, _sharedStore);
+            return new TriggerFiringCoordinator(new Mock<ILogger<TriggerFiringCoordinator>>().Object, repository);
+        }
+
+        [Fact]
+        public async Task ClaimAsync_InstanceCrashedAfterClaim_OtherInstanceDoesNotRunFiring()
+        {
+            // Arrange
+            var triggerId = Guid.NewGuid();
+            var crashed = CreateInstance();
+            var failover = CreateInstance();
+
+            // Act: the first instance finds the trigger due, claims it and stops before running the action
+            var first = await crashed.ClaimAsync("report", triggerId, FireTime, TimeSpan.FromMinutes(5));
+            var second = await failover.ClaimAsync("report", triggerId, FireTime, TimeSpan.FromMinutes(5));
+            var nextFiring = await failover.ClaimAsync("report", triggerId, FireTime.AddHours(1), TimeSpan.FromMinutes(5));
+
+            // Assert
+            Assert.True(first.Acquired);
+            Assert.False(second.Acquired);
+            Assert.False(second.Abandoned);
+            Assert.Equal(TriggerFiringStatus.Claimed, second.Firing.Status);
+            Assert.True(nextFiring.Acquired);
+        }
+
+        [Fact]
+        public async Task ClaimAsync_ClaimOlderThanLease_ReportsAbandonedWithoutRunningAgain()
+        {
+            // Arrange
+            var triggerId = Guid.NewGuid();
+            await CreateInstance().ClaimAsync("report", triggerId, FireTime, TimeSpan.FromMilliseconds(1));
+            await Task.Delay(20);
+
+            // Act
+            var claim = await CreateInstance().ClaimAsync("report", triggerId, FireTime, TimeSpan.FromMilliseconds(1));
+
+            // Assert
+            Assert.False(claim.Acquired);
+            Assert.True(claim.Abandoned);
+        }
+
+        [Fact]
+        public async Task ClaimAsync_CompletedFiring_IsNotAbandonedOrRunAgain()
+        {
+            // Arrange
+            var triggerId = Guid.NewGuid();
+            var instance = CreateInstance();
+            var first = await instance.ClaimAsync("report", triggerId, FireTime, TimeSpan.FromMilliseconds(1));
+            await instance.CompleteAsync(first.Firing, true);
+            await Task.Delay(20);
+
+            // Act
+            var claim = await CreateInstance().ClaimAsync("report", triggerId, FireTime, TimeSpan.FromMilliseconds(1));
+
+            // Assert
+            Assert.False(claim.Acquired);
+            Assert.False(claim.Abandoned);
+            Assert.Equal(TriggerFiringStatus.Completed, claim.Firing.Status);
+            Assert.Equal(TriggerFiringCoordinator.ComputeToken("report", triggerId, FireTime.ToLocalTime()), claim.Firing.Id);
+        }
+
+        [Fact]
+        public async Task GetHistoryAsync_PagesThroughFiringsNewestFirstWithCursor()
+        {
+            // Arrange
+            var triggerId = Guid.NewGuid();
+            var instance = CreateInstance();
+            for (var hour = 0; hour < 5; hour++)
+            {
+                var claim = await instance.ClaimAsync("schedule", triggerId, FireTime.AddHours(hour), TimeSpan.FromMinutes(5));
+                await instance.CompleteAsync(claim.Firing, hour != 2, hour == 2 ? "boom" : null);
+            }
+
+            // Act
+            var first = await instance.GetHistoryAsync(new TriggerFiringQuery { TriggerId = triggerId, Limit = 2 });
+            var second = await instance.GetHistoryAsync(new TriggerFiringQuery { TriggerId = triggerId, Limit = 2, Cursor = first.NextCursor });
+            var last = await instance.GetHistoryAsync(new TriggerFiringQuery { TriggerId = triggerId, Limit = 2, Cursor = second.NextCursor });
+            var failed = await instance.GetHistoryAsync(new TriggerFiringQuery { TriggerId = triggerId, Status = TriggerFiringStatus.Failed });
+
+            // Assert
+            Assert.Equal(new[] { FireTime.AddHours(4), FireTime.AddHours(3) }, first.Items.Select(f => f.ScheduledFor));
+            Assert.Equal(new[] { FireTime.AddHours(2), FireTime.AddHours(1) }, second.Items.Select(f => f.ScheduledFor));
+            Assert.Equal(new[] { FireTime }, last.Items.Select(f => f.ScheduledFor));
+            Assert.Null(last.NextCursor);
+            Assert.Equal("boom", Assert.Single(failed.Items).Error);
+        }
+
+        [Fact]
+        public async Task PruneAsync_RemovesExpiredFiringsAndKeepsTheirCounts()
+        {
+            // Arrange
+            var triggerId = Guid.NewGuid();
+            var instance = CreateInstance();
+            for (var day = 0; day < 4; day++)
+            {
+                var claim = await instance.ClaimAsync("schedule", triggerId, FireTime.AddDays(day), TimeSpan.FromMinutes(5));
+                await instance.CompleteAsync(claim.Firing, day != 0, day == 0 ? "boom" : null);
+            }
+
+            // Act
+            var pruned = await instance.PruneAsync(FireTime.AddDays(2), 100);
+            var history = await instance.GetHistoryAsync(new TriggerFiringQuery { TriggerId = triggerId });
+            var statistics = await instance.GetStatisticsAsync("schedule", triggerId);
+
+            // Assert
+            Assert.Equal(2, pruned);
+            Assert.Equal(2, history.Items.Count);
+            Assert.Equal(3, statistics.Completed);
+            Assert.Equal(1, statistics.Failed);
+            Assert.Equal(2, statistics.Compacted);
+            Assert.Equal(FireTime, statistics.FirstScheduledFor);
+            Assert.Equal(FireTime.AddDays(3), statistics.LastScheduledFor);
+        }
+    }
+}
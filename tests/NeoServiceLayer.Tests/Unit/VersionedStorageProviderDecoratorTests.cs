@@ -0,0 +1,71 @@
+using System;
+using System.Collections.Generic;
+using System.Linq;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Storage.Providers;
+using NeoServiceLayer.Services.Storage.Versioning;
+using NeoServiceLayer.Services.Storage.Versioning.Upgraders;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class VersionedStorageProviderDecoratorTests
+    {
+        private readonly InMemoryStorageProvider _innerProvider = new InMemoryStorageProvider(new Mock<ILogger<InMemoryStorageProvider>>().Object);
+        private readonly IRecordUpgrader[] _upgraders = { new ScheduleTriggerUpgrader(), new SecretUpgrader() };
+        private readonly VersionedStorageProviderDecorator _provider;
+
+        public VersionedStorageProviderDecoratorTests()
+        {
+            _provider = new VersionedStorageProviderDecorator(_innerProvider, _upgraders, new Mock<ILogger<VersionedStorageProviderDecorator>>().Object);
+        }
+
+        [Fact]
+        public async Task GetByFilterAsync_RecordWrittenBeforeVersioning_FilterSeesUpgradedRecord()
+        {
+            // Arrange
+            var functionId = Guid.NewGuid();
+            await _innerProvider.CreateAsync("secrets", new Secret { Id = Guid.NewGuid(), Name = "legacy", AllowedFunctionIds = null });
+            await _provider.CreateAsync("secrets", new Secret { Id = Guid.NewGuid(), Name = "current", AllowedFunctionIds = new List<Guid> { functionId } });
+
+            // Act
+            var secrets = (await _provider.GetByFilterAsync<Secret>("secrets", s => s.AllowedFunctionIds.Contains(functionId))).ToList();
+            var legacy = (await _provider.GetAllAsync<Secret>("secrets")).Single(s => s.Name == "legacy");
+
+            // Assert
+            Assert.Equal("current", Assert.Single(secrets).Name);
+            Assert.Equal(1, secrets[0].SchemaVersion);
+            Assert.Empty(legacy.AllowedFunctionIds);
+            Assert.Equal(1, legacy.Version);
+            Assert.Equal(1, legacy.SchemaVersion);
+        }
+
+        [Fact]
+        public async Task MigrateAsync_OldAndNewerRecords_RewritesOnlyOldOnes()
+        {
+            // Arrange
+            await _innerProvider.CreateAsync("schedule_triggers", new ScheduleTrigger { Id = Guid.NewGuid(), Parameters = null, Tags = null });
+            await _innerProvider.CreateAsync("schedule_triggers", new ScheduleTrigger { Id = Guid.NewGuid(), SchemaVersion = 1 });
+            await _innerProvider.CreateAsync("schedule_triggers", new ScheduleTrigger { Id = Guid.NewGuid(), SchemaVersion = 7, Tags = null });
+            var service = new RecordMigrationService(new Mock<ILogger<RecordMigrationService>>().Object, _provider, _upgraders);
+
+            // Act
+            var report = await service.MigrateAsync();
+
+            // Assert
+            Assert.True(report.Succeeded);
+            var triggers = Assert.Single(report.Results, r => r.RecordType == nameof(ScheduleTrigger));
+            Assert.Equal(3, triggers.Scanned);
+            Assert.Equal(1, triggers.Upgraded);
+            Assert.Equal(1, triggers.Newer);
+
+            var stored = await _innerProvider.GetAllAsync<ScheduleTrigger>("schedule_triggers");
+            Assert.All(stored.Where(t => t.SchemaVersion == 1), t => Assert.NotNull(t.Tags));
+            Assert.Null(stored.Single(t => t.SchemaVersion == 7).Tags);
+        }
+    }
+}
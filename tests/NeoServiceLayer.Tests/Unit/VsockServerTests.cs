@@ -34,7 +34,7 @@ namespace NeoServiceLayer.Tests.Unit
 
             _accountServiceMock = new Mock<EnclaveAccountService>(MockBehavior.Loose, new object[] { Mock.Of<ILogger<EnclaveAccountService>>() });
             _walletServiceMock = new Mock<EnclaveWalletService>(MockBehavior.Loose, new object[] { Mock.Of<ILogger<EnclaveWalletService>>() });
-            _secretsServiceMock = new Mock<EnclaveSecretsService>(MockBehavior.Loose, new object[] { Mock.Of<ILogger<EnclaveSecretsService>>() });
+            _secretsServiceMock = new Mock<EnclaveSecretsService>(MockBehavior.Loose, new object[] { Mock.Of<ILogger<EnclaveSecretsService>>(), null, null });
 
             // Create a mock function executor
             _mockFunctionExecutor = new MockFunctionExecutor(Mock.Of<ILogger<NeoServiceLayer.Enclave.Enclave.Execution.FunctionExecutor>>());
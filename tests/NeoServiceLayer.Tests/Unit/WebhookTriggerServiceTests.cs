@@ -0,0 +1,181 @@
+using System;
+using System.Collections.Generic;
+using System.Security.Cryptography;
+using System.Text;
+using System.Threading.Tasks;
+using Microsoft.Extensions.Logging;
+using Moq;
+using NeoServiceLayer.Core.Exceptions;
+using NeoServiceLayer.Core.Interfaces;
+using NeoServiceLayer.Core.Models;
+using NeoServiceLayer.Services.Function;
+using Xunit;
+
+namespace NeoServiceLayer.Tests.Unit
+{
+    public class WebhookTriggerServiceTests
+    {
+        private const string GitHubPush = "{\"ref\":\"refs/heads/main\",\"repository\":{\"full_name\":\"neo/app\"},\"commits\":[{\"id\":\"a1\"},{\"id\":\"b2\"}]}";
+
+        private readonly Mock<IWebhookTriggerRepository> _triggerRepository = new Mock<IWebhookTriggerRepository>();
+        private readonly Mock<IFunctionService> _functionService = new Mock<IFunctionService>();
+        private readonly WebhookTriggerService _service;
+
+        public WebhookTriggerServiceTests()
+        {
+            _service = new WebhookTriggerService(
+                Mock.Of<ILogger<WebhookTriggerService>>(),
+                _triggerRepository.Object,
+                _functionService.Object);
+        }
+
+        [Fact]
+        public void Transform_AppliesExtractionHeadersAndStaticValues()
+        {
+            // Arrange
+            var trigger = new WebhookTrigger
+            {
+                Transformation = new WebhookTransformation
+                {
+                    Extract = new Dictionary<string, string>
+                    {
+                        ["repo"] = "$.repository.full_name",
+                        ["commitIds"] = "$.commits[*].id",
+                        ["branch"] = "$.missing"
+                    },
+                    Headers = new Dictionary<string, string> { ["X-GitHub-Event"] = "event" },
+                    Static = new Dictionary<string, object> { ["repo"] = "pinned", ["network"] = "testnet" }
+                }
+            };
+            var headers = new Dictionary<string, string> { ["x-github-event"] = "push" };
+
+            // Act
+            var parameters = _service.Transform(trigger, GitHubPush, headers);
+
+            // Assert
+            Assert.Equal("pinned", parameters["repo"]);
+            Assert.Equal(new object[] { "a1", "b2" }, Assert.IsType<List<object>>(parameters["commitIds"]));
+            Assert.Equal("push", parameters["event"]);
+            Assert.Equal("testnet", parameters["network"]);
+            Assert.False(parameters.ContainsKey("branch"));
+            Assert.False(parameters.ContainsKey("payload"));
+        }
+
+        [Fact]
+        public void Transform_RequiredParameterMissing_ThrowsValidationException()
+        {
+            // Arrange
+            var trigger = new WebhookTrigger
+            {
+                Transformation = new WebhookTransformation
+                {
+                    Extract = new Dictionary<string, string> { ["amount"] = "$.data.object.amount" },
+                    Required = new List<string> { "amount" }
+                }
+            };
+
+            // Act & Assert
+            Assert.Throws<ValidationException>(() => _service.Transform(trigger, GitHubPush, null));
+        }
+
+        [Fact]
+        public async Task IngestAsync_SignedDelivery_ExecutesFunctionWithTransformedParameters()
+        {
+            // Arrange
+            var trigger = new WebhookTrigger
+            {
+                Id = Guid.NewGuid(),
+                FunctionId = Guid.NewGuid(),
+                SigningSecret = "s3cret",
+                Transformation = new WebhookTransformation
+                {
+                    Extract = new Dictionary<string, string> { ["ref"] = "$.ref" }
+                }
+            };
+            _triggerRepository.Setup(r => r.GetByIdAsync(trigger.Id)).ReturnsAsync(trigger);
+            _functionService
+                .Setup(s => s.ExecuteAsync(trigger.FunctionId, It.IsAny<Dictionary<string, object>>()))
+                .ReturnsAsync("ok");
+
+            using var hmac = new HMACSHA256(Encoding.UTF8.GetBytes("s3cret"));
+            var signature = "sha256=" + Convert.ToHexString(hmac.ComputeHash(Encoding.UTF8.GetBytes(GitHubPush))).ToLowerInvariant();
+
+            // Act
+            var result = await _service.IngestAsync(trigger.Id, GitHubPush, new Dictionary<string, string> { ["X-Hub-Signature-256"] = signature });
+
+            // Assert
+            Assert.Equal("ok", result);
+            Assert.Equal(1, trigger.TriggerCount);
+            _functionService.Verify(s => s.ExecuteAsync(
+                trigger.FunctionId,
+                It.Is<Dictionary<string, object>>(p => (string)p["ref"] == "refs/heads/main")), Times.Once);
+        }
+
+        [Fact]
+        public async Task IngestAsync_InvalidSignature_ThrowsAndDoesNotExecute()
+        {
+            // Arrange
+            var trigger = new WebhookTrigger { Id = Guid.NewGuid(), FunctionId = Guid.NewGuid(), SigningSecret = "s3cret" };
+            _triggerRepository.Setup(r => r.GetByIdAsync(trigger.Id)).ReturnsAsync(trigger);
+
+            // Act & Assert
+            await Assert.ThrowsAsync<ForbiddenAccessException>(() =>
+                _service.IngestAsync(trigger.Id, GitHubPush, new Dictionary<string, string> { ["X-Hub-Signature-256"] = "sha256=00" }));
+            _functionService.Verify(s => s.ExecuteAsync(It.IsAny<Guid>(), It.IsAny<Dictionary<string, object>>()), Times.Never);
+        }
+
+        [Fact]
+        public async Task IngestAsync_HttpResponseMode_MapsFunctionResultToHttpResponse()
+        {
+            // Arrange
+            var trigger = new WebhookTrigger { Id = Guid.NewGuid(), FunctionId = Guid.NewGuid(), ResponseMode = WebhookResponseModes.Http };
+            _triggerRepository.Setup(r => r.GetByIdAsync(trigger.Id)).ReturnsAsync(trigger);
+            _functionService
+                .Setup(s => s.ExecuteAsync(trigger.FunctionId, It.IsAny<Dictionary<string, object>>()))
+                .ReturnsAsync(new
+                {
+                    FunctionId = trigger.FunctionId,
+                    Result = new
+                    {
+                        Result = new Dictionary<string, object>
+                        {
+                            ["status"] = 201.0,
+                            ["headers"] = new Dictionary<string, object> { ["X-Request-Id"] = "abc", ["Set-Cookie"] = "session=1" },
+                            ["body"] = new Dictionary<string, object> { ["ok"] = true }
+                        },
+                        Logs = new List<string>()
+                    }
+                });
+
+            // Act
+            var result = await _service.IngestAsync(trigger.Id, "{}", null);
+
+            // Assert
+            var response = Assert.IsType<FunctionHttpResponse>(result);
+            Assert.Equal(201, response.StatusCode);
+            Assert.Equal("abc", response.Headers["X-Request-Id"]);
+            Assert.False(response.Headers.ContainsKey("Set-Cookie"));
+            Assert.Equal("application/json; charset=utf-8", response.Headers["Content-Type"]);
+            Assert.Equal("{\"ok\":true}", response.Body);
+        }
+
+        [Fact]
+        public async Task IngestAsync_HttpResponseModeWithInvalidStatus_Returns502()
+        {
+            // Arrange
+            var trigger = new WebhookTrigger { Id = Guid.NewGuid(), FunctionId = Guid.NewGuid(), ResponseMode = WebhookResponseModes.Http };
+            _triggerRepository.Setup(r => r.GetByIdAsync(trigger.Id)).ReturnsAsync(trigger);
+            _functionService
+                .Setup(s => s.ExecuteAsync(trigger.FunctionId, It.IsAny<Dictionary<string, object>>()))
+                .ReturnsAsync(new Dictionary<string, object> { ["status"] = "teapot", ["body"] = "hello" });
+
+            // Act
+            var result = await _service.IngestAsync(trigger.Id, "{}", null);
+
+            // Assert
+            var response = Assert.IsType<FunctionHttpResponse>(result);
+            Assert.Equal(502, response.StatusCode);
+            Assert.Contains("status must be a number", response.Body);
+        }
+    }
+}